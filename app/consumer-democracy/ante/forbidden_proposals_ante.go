@@ -9,13 +9,13 @@ import (
 )
 
 type ForbiddenProposalsDecorator struct {
-	isLegacyProposalWhitelisted func(govv1beta1.Content) bool
-	isModuleWhiteList           func(string) bool
+	isLegacyProposalWhitelisted func(sdk.Context, govv1beta1.Content) bool
+	isModuleWhiteList           func(sdk.Context, string) bool
 }
 
 func NewForbiddenProposalsDecorator(
-	whiteListFn func(govv1beta1.Content) bool,
-	isModuleWhiteList func(string) bool,
+	whiteListFn func(sdk.Context, govv1beta1.Content) bool,
+	isModuleWhiteList func(sdk.Context, string) bool,
 ) ForbiddenProposalsDecorator {
 	return ForbiddenProposalsDecorator{
 		isLegacyProposalWhitelisted: whiteListFn,
@@ -41,13 +41,13 @@ func (decorator ForbiddenProposalsDecorator) AnteHandle(ctx sdk.Context, tx sdk.
 				if err != nil {
 					return ctx, fmt.Errorf("tx contains invalid LegacyContent")
 				}
-				if !decorator.isLegacyProposalWhitelisted(content) {
+				if !decorator.isLegacyProposalWhitelisted(ctx, content) {
 					return ctx, fmt.Errorf("tx contains unsupported proposal message types at height %d", currHeight)
 				}
 				continue
 			}
 			// not legacy gov proposal content and not whitelisted
-			if !decorator.isModuleWhiteList(message.TypeUrl) {
+			if !decorator.isModuleWhiteList(ctx, message.TypeUrl) {
 				return ctx, fmt.Errorf("tx contains unsupported proposal message types at height %d", currHeight)
 			}
 		}
@@ -6,6 +6,9 @@ import (
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/stretchr/testify/require"
 
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -15,8 +18,23 @@ import (
 
 	app "github.com/cosmos/interchain-security/v6/app/consumer-democracy"
 	"github.com/cosmos/interchain-security/v6/app/consumer-democracy/ante"
+	ccvgovkeeper "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/keeper"
+	ccvgovtypes "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
 )
 
+// newForbiddenProposalsDecorator returns a ForbiddenProposalsDecorator that consults a governance
+// whitelist initialized with the default whitelist, together with the whitelist keeper and context
+func newForbiddenProposalsDecorator(t *testing.T) (ante.ForbiddenProposalsDecorator, ccvgovkeeper.Keeper, sdk.Context) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(ccvgovtypes.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	whitelistKeeper := ccvgovkeeper.NewKeeper(app.MakeTestEncodingConfig().Codec, key,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	whitelistKeeper.InitGenesis(ctx, ccvgovtypes.DefaultGenesisState())
+	handler := ante.NewForbiddenProposalsDecorator(whitelistKeeper.IsLegacyProposalWhitelisted, whitelistKeeper.IsModuleWhitelisted)
+	return handler, whitelistKeeper, ctx
+}
+
 // in SDKv47 parameter updates full params object is required
 // either all params can be updated or none can be updated
 func TestForbiddenProposalsDecorator(t *testing.T) {
@@ -24,13 +42,13 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 
 	// here we try to set whatever params exist to their default values
 	// the actual parameter setting is not important, what's being tested is the ante handle filter
-	// Note: mint params CAN be changed according to WhiteListModule in proposals_whitelisting.go
+	// Note: mint params CAN be changed according to the default governance whitelist
 	updateMintParams := &minttypes.MsgUpdateParams{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		Params:    minttypes.DefaultParams(),
 	}
 
-	// Note: auth params CANNOT be changed according to WhiteListModule in proposals_whitelisting.go
+	// Note: auth params CANNOT be changed according to the default governance whitelist
 	updateAuthParams := &authtypes.MsgUpdateParams{
 		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		Params:    authtypes.DefaultParams(),
@@ -38,13 +56,11 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 
 	testCases := []struct {
 		name      string
-		ctx       sdk.Context
 		msgs      []sdk.Msg
 		expectErr bool
 	}{
 		{
 			name: "Allowed param change - mint module",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newParamChangeProposalMsg([]sdk.Msg{updateMintParams}),
 			},
@@ -52,7 +68,6 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "Forbidden param change - auth module",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newParamChangeProposalMsg([]sdk.Msg{updateAuthParams}),
 			},
@@ -60,7 +75,6 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "Allowed and forbidden param changes in the same msg",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newParamChangeProposalMsg([]sdk.Msg{updateMintParams, updateAuthParams}),
 			},
@@ -68,7 +82,6 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "Allowed and forbidden param changes in different msg",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newParamChangeProposalMsg([]sdk.Msg{updateMintParams}),
 				newParamChangeProposalMsg([]sdk.Msg{updateAuthParams}),
@@ -81,12 +94,12 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 		tc := tc
 
 		t.Run(tc.name, func(t *testing.T) {
-			handler := ante.NewForbiddenProposalsDecorator(app.IsProposalWhitelisted, app.IsModuleWhiteList)
+			handler, _, ctx := newForbiddenProposalsDecorator(t)
 
 			txBuilder := txCfg.NewTxBuilder()
 			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
 
-			_, err := handler.AnteHandle(tc.ctx, txBuilder.GetTx(), false,
+			_, err := handler.AnteHandle(ctx, txBuilder.GetTx(), false,
 				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
 			if tc.expectErr {
 				require.Error(t, err)
@@ -97,6 +110,41 @@ func TestForbiddenProposalsDecorator(t *testing.T) {
 	}
 }
 
+// TestForbiddenProposalsDecoratorWithUpdatedWhitelist tests that a proposal that is rejected
+// becomes acceptable once its messages are added to the governance whitelist at runtime
+func TestForbiddenProposalsDecoratorWithUpdatedWhitelist(t *testing.T) {
+	txCfg := app.MakeTestEncodingConfig().TxConfig
+	handler, whitelistKeeper, ctx := newForbiddenProposalsDecorator(t)
+
+	updateAuthParams := &authtypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    authtypes.DefaultParams(),
+	}
+	txBuilder := txCfg.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(newParamChangeProposalMsg([]sdk.Msg{updateAuthParams})))
+	anteHandle := func() error {
+		_, err := handler.AnteHandle(ctx, txBuilder.GetTx(), false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+		return err
+	}
+
+	// auth params cannot be changed according to the default governance whitelist
+	require.Error(t, anteHandle())
+
+	// add the auth params update to the whitelist
+	whitelist := whitelistKeeper.GetWhitelist(ctx)
+	whitelist.MsgTypeUrls = append(whitelist.MsgTypeUrls, sdk.MsgTypeURL(updateAuthParams))
+	msgServer := ccvgovkeeper.NewMsgServerImpl(&whitelistKeeper)
+	_, err := msgServer.UpdateWhitelist(ctx, &ccvgovtypes.MsgUpdateWhitelist{
+		Authority: whitelistKeeper.GetAuthority(),
+		Whitelist: whitelist,
+	})
+	require.NoError(t, err)
+
+	// the proposal becomes acceptable
+	require.NoError(t, anteHandle())
+}
+
 // Legacy parameter proposals are not supported in cosmos-sdk v0.50
 // since modules parameters were moved to their respective modules
 // this test is to ensure that legacy parameter proposals are not allowed
@@ -105,13 +153,11 @@ func TestForbiddenLegacyProposalsDecorator(t *testing.T) {
 
 	testCases := []struct {
 		name      string
-		ctx       sdk.Context
 		msgs      []sdk.Msg
 		expectErr bool
 	}{
 		{
 			name: "Forbidden param change",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyParamChangeProposalMsg([]proposal.ParamChange{
 					{Subspace: authtypes.ModuleName, Key: "MaxMemoCharacters", Value: ""},
@@ -121,7 +167,6 @@ func TestForbiddenLegacyProposalsDecorator(t *testing.T) {
 		},
 		{
 			name: "Multiple forbidden param changes in the same msg",
-			ctx:  sdk.Context{},
 			msgs: []sdk.Msg{
 				newLegacyParamChangeProposalMsg([]proposal.ParamChange{
 					{Subspace: ibctransfertypes.ModuleName, Key: "SendEnabled", Value: "true"},
@@ -136,12 +181,12 @@ func TestForbiddenLegacyProposalsDecorator(t *testing.T) {
 		tc := tc
 
 		t.Run(tc.name, func(t *testing.T) {
-			handler := ante.NewForbiddenProposalsDecorator(app.IsProposalWhitelisted, app.IsModuleWhiteList)
+			handler, _, ctx := newForbiddenProposalsDecorator(t)
 
 			txBuilder := txCfg.NewTxBuilder()
 			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
 
-			_, err := handler.AnteHandle(tc.ctx, txBuilder.GetTx(), false,
+			_, err := handler.AnteHandle(ctx, txBuilder.GetTx(), false,
 				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
 			if tc.expectErr {
 				require.Error(t, err)
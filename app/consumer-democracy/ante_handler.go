@@ -13,6 +13,7 @@ import (
 	democracyante "github.com/cosmos/interchain-security/v6/app/consumer-democracy/ante"
 	consumerante "github.com/cosmos/interchain-security/v6/app/consumer/ante"
 	ibcconsumerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/consumer/keeper"
+	ccvgovkeeper "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/keeper"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -20,8 +21,9 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper      *ibckeeper.Keeper
-	ConsumerKeeper ibcconsumerkeeper.Keeper
+	IBCKeeper          *ibckeeper.Keeper
+	ConsumerKeeper     ibcconsumerkeeper.Keeper
+	GovWhitelistKeeper ccvgovkeeper.Keeper
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -45,7 +47,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewExtensionOptionsDecorator(nil),
 		consumerante.NewMsgFilterDecorator(options.ConsumerKeeper),
 		consumerante.NewDisabledModulesDecorator("/cosmos.evidence", "/cosmos.slashing"),
-		democracyante.NewForbiddenProposalsDecorator(IsProposalWhitelisted, IsModuleWhiteList),
+		democracyante.NewForbiddenProposalsDecorator(
+			options.GovWhitelistKeeper.IsLegacyProposalWhitelisted, options.GovWhitelistKeeper.IsModuleWhitelisted),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
@@ -115,6 +115,8 @@ import (
 	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 	ccvdistr "github.com/cosmos/interchain-security/v6/x/ccv/democracy/distribution"
 	ccvgov "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance"
+	ccvgovkeeper "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/keeper"
+	ccvgovtypes "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
 	ccvstaking "github.com/cosmos/interchain-security/v6/x/ccv/democracy/staking"
 )
 
@@ -167,6 +169,7 @@ var (
 		ibctm.AppModuleBasic{},
 		consumer.AppModuleBasic{},
 		consensus.AppModuleBasic{},
+		ccvgov.WhitelistAppModuleBasic{},
 	)
 
 	// module account permissions
@@ -223,6 +226,7 @@ type App struct { // nolint: golint
 	AuthzKeeper           authzkeeper.Keeper
 	ConsumerKeeper        consumerkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
+	GovWhitelistKeeper    ccvgovkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper         capabilitykeeper.ScopedKeeper
@@ -271,7 +275,7 @@ func New(
 		govtypes.StoreKey, paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey,
 		capabilitytypes.StoreKey, authzkeeper.StoreKey, consensusparamtypes.StoreKey,
-		consumertypes.StoreKey,
+		consumertypes.StoreKey, ccvgovtypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := storetypes.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -422,15 +426,26 @@ func New(
 	ccvgovRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper))
 	govConfig := govtypes.DefaultConfig()
+	// the recovery of the provider client via governance requires the substitute client
+	// to be approved by governance beforehand (see MsgApproveProviderClientSubstitute)
+	govRouter := consumerkeeper.NewProviderClientSubstitutionRouter(app.MsgServiceRouter(), &app.ConsumerKeeper)
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, runtime.NewKVStoreService(keys[govtypes.StoreKey]), app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, app.DistrKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		app.StakingKeeper, app.DistrKeeper, govRouter, govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	govKeeper.SetLegacyRouter(ccvgovRouter)
 
 	app.GovKeeper = *govKeeper
 
+	// the proposals that can be passed through governance are stored in the governance whitelist,
+	// which can only be updated through governance (see MsgUpdateWhitelist)
+	app.GovWhitelistKeeper = ccvgovkeeper.NewKeeper(
+		appCodec,
+		keys[ccvgovtypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// pre-initialize ConsumerKeeper to satsfy ibckeeper.NewKeeper
 	// which would panic on nil or zero keeper
 	// ConsumerKeeper implements StakingKeeper but all function calls result in no-ops so this is safe
@@ -497,6 +512,15 @@ func New(
 
 	// register slashing module StakingHooks to the consumer keeper
 	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
+
+	// set the consensus public key the local node signs with, which is used to warn validators
+	// that sign with a provider key they reassigned to a different consumer key
+	if localValidatorPubKey, found, err := consumerkeeper.LoadLocalValidatorPubKey(appOpts, homePath); err != nil {
+		logger.Error("cannot load the consensus public key of the local node", "error", err)
+	} else if found {
+		app.ConsumerKeeper.SetLocalValidatorPubKey(localValidatorPubKey)
+	}
+
 	consumerModule := consumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(consumertypes.ModuleName))
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -549,11 +573,13 @@ func New(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		ccvgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper, IsProposalWhitelisted, app.GetSubspace(govtypes.ModuleName), IsModuleWhiteList),
+		ccvgov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper,
+			app.GovWhitelistKeeper.IsLegacyProposalWhitelisted, app.GetSubspace(govtypes.ModuleName), app.GovWhitelistKeeper.IsModuleWhitelisted),
+		ccvgov.NewWhitelistAppModule(app.GovWhitelistKeeper),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.ConsumerKeeper, app.GetSubspace(slashingtypes.ModuleName), app.interfaceRegistry),
 		ccvdistr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper, authtypes.FeeCollectorName, app.GetSubspace(distrtypes.ModuleName)),
-		ccvstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
+		ccvstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.ConsumerKeeper, app.GetSubspace(stakingtypes.ModuleName)),
 		upgrade.NewAppModule(&app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -650,6 +676,7 @@ func New(
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
 		consumertypes.ModuleName,
+		ccvgovtypes.ModuleName,
 		crisistypes.ModuleName,
 	)
 
@@ -676,6 +703,9 @@ func New(
 					fromVM[moduleName] = module.ConsensusVersion()
 				}
 			}
+			// the governance whitelist is added by the upgrade, i.e., RunMigrations initializes it
+			// with the default whitelist
+			delete(fromVM, ccvgovtypes.ModuleName)
 
 			// For a new consumer chain, this code (together with the entire SetUpgradeHandler) is not needed at all,
 			// upgrade handler code is application specific. However, as an example, standalone to consumer
@@ -696,6 +726,10 @@ func New(
 			appCodec.MustUnmarshalJSON(appState[consumertypes.ModuleName], &consumerGenesis)
 
 			consumerGenesis.PreCCV = true
+			// abort the upgrade before any state changes if the consumer genesis state is not valid for a changeover
+			if _, err := app.ConsumerKeeper.ValidateChangeover(sdkCtx, &consumerGenesis); err != nil {
+				return fromVM, fmt.Errorf("invalid standalone to consumer changeover: %w", err)
+			}
 			app.ConsumerKeeper.InitGenesis(sdkCtx, &consumerGenesis)
 
 			app.Logger().Info("start to run module migrations...")
@@ -718,7 +752,7 @@ func New(
 		// is needed for standalone chains that're changing over to a consumer chain, with a consumer ccv module.
 		// When a chain starts from height 0 (like for testing purposes in this repo), the following code is not needed.
 		storeUpgrades := storetypes.StoreUpgrades{
-			Added: []string{consumertypes.ModuleName},
+			Added: []string{consumertypes.ModuleName, ccvgovtypes.StoreKey},
 		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
@@ -747,8 +781,9 @@ func New(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper:      app.IBCKeeper,
-			ConsumerKeeper: app.ConsumerKeeper,
+			IBCKeeper:          app.IBCKeeper,
+			ConsumerKeeper:     app.ConsumerKeeper,
+			GovWhitelistKeeper: app.GovWhitelistKeeper,
 		},
 	)
 	if err != nil {
@@ -394,6 +394,15 @@ func New(
 
 	// register slashing module Slashing hooks to the consumer keeper
 	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
+
+	// set the consensus public key the local node signs with, which is used to warn validators
+	// that sign with a provider key they reassigned to a different consumer key
+	if localValidatorPubKey, found, err := ibcconsumerkeeper.LoadLocalValidatorPubKey(appOpts, homePath); err != nil {
+		logger.Error("cannot load the consensus public key of the local node", "error", err)
+	} else if found {
+		app.ConsumerKeeper.SetLocalValidatorPubKey(localValidatorPubKey)
+	}
+
 	consumerModule := ibcconsumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(ibcconsumertypes.ModuleName))
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -126,6 +126,8 @@ func transformToV33(jsonRaw []byte, ctx client.Context) ([]byte, error) {
 	if exists {
 		delete(tmp, "retry_delay_period")
 	}
+	// remove denom_redistribution_fractions from 'params' (introduced in v6.x)
+	delete(tmp, "denom_redistribution_fractions")
 	params, err = json.Marshal(tmp)
 	if err != nil {
 		return nil, err
@@ -141,6 +143,8 @@ func transformToV33(jsonRaw []byte, ctx client.Context) ([]byte, error) {
 		return nil, fmt.Errorf("unmarshalling 'GenesisState' failed: %v", err)
 	}
 	genState["params"] = params
+	// the consumer genesis version is not known to v3.3.x
+	delete(genState, "ccv_genesis_version")
 
 	result, err = json.Marshal(genState)
 	if err != nil {
@@ -173,6 +177,8 @@ func transformToV2(jsonRaw []byte, ctx client.Context, removePreHashKey bool) (j
 	if exists {
 		delete(paramsMap, "retry_delay_period")
 	}
+	// remove denom_redistribution_fractions from 'params' (introduced in v6.x)
+	delete(paramsMap, "denom_redistribution_fractions")
 	params, err = json.Marshal(paramsMap)
 	if err != nil {
 		return nil, err
@@ -236,6 +242,8 @@ func transformToV2(jsonRaw []byte, ctx client.Context, removePreHashKey bool) (j
 
 	// delete .provider entry (introduced in v3.3.x)
 	delete(genState, "provider")
+	// delete the consumer genesis version (introduced in v6.x)
+	delete(genState, "ccv_genesis_version")
 
 	// Marshall final result
 	result, err = json.Marshal(genState)
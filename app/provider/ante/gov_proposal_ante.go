@@ -0,0 +1,65 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// GovProposalValidationDecorator defines an AnteHandler decorator that performs the
+// keeper-independent validation of the provider messages embedded in a governance proposal.
+// This enables rejecting an invalid proposal (e.g., a proposal with an invalid
+// `MsgCreateConsumer`) already in CheckTx, instead of only when the proposal is submitted.
+type GovProposalValidationDecorator struct{}
+
+func NewGovProposalValidationDecorator() GovProposalValidationDecorator {
+	return GovProposalValidationDecorator{}
+}
+
+func (gpvd GovProposalValidationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		submitProposal, ok := msg.(*govv1.MsgSubmitProposal)
+		if !ok {
+			continue
+		}
+		if err := validateProposalMsgs(submitProposal); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validateProposalMsgs validates the provider messages embedded in a governance proposal
+func validateProposalMsgs(submitProposal *govv1.MsgSubmitProposal) error {
+	proposalMsgs, err := submitProposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	for _, proposalMsg := range proposalMsgs {
+		var err error
+		switch m := proposalMsg.(type) {
+		case *providertypes.MsgCreateConsumer:
+			err = m.ValidateBasic()
+		case *providertypes.MsgUpdateConsumer:
+			err = m.ValidateBasic()
+		case *providertypes.MsgSetConsumerVerified:
+			err = m.ValidateBasic()
+		case *providertypes.MsgSetConsumerEvidenceSubmissionPaused:
+			err = m.ValidateBasic()
+		default:
+			continue
+		}
+		if err != nil {
+			return errorsmod.Wrapf(govtypes.ErrInvalidProposalMsg, "%s: %s", sdk.MsgTypeURL(proposalMsg), err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	appencoding "github.com/cosmos/interchain-security/v6/app/encoding"
+	"github.com/cosmos/interchain-security/v6/app/provider/ante"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func noOpAnteDecorator() sdk.AnteHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+}
+
+func TestGovProposalValidationDecorator(t *testing.T) {
+	txCfg := appencoding.MakeTestEncodingConfig().TxConfig
+
+	authority := sdk.AccAddress([]byte("authority")).String()
+	metadata := providertypes.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
+
+	validInitializationParameters := providertypes.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(1, 4),
+		GenesisHash:                       []byte{0x01},
+		BinaryHash:                        []byte{0x01},
+		SpawnTime:                         time.Now().UTC(),
+		UnbondingPeriod:                   time.Duration(100000000000),
+		CcvTimeoutPeriod:                  time.Duration(100000000000),
+		TransferTimeoutPeriod:             time.Duration(100000000000),
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10,
+		HistoricalEntries:                 10000,
+	}
+	invalidInitializationParameters := validInitializationParameters
+	invalidInitializationParameters.UnbondingPeriod = 0
+
+	newSubmitProposal := func(msgs ...sdk.Msg) *govv1.MsgSubmitProposal {
+		msg, err := govv1.NewMsgSubmitProposal(msgs, sdk.NewCoins(), authority, "", "title", "summary", false)
+		require.NoError(t, err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		msgs      []sdk.Msg
+		expectErr bool
+	}{
+		{
+			name:      "tx without proposals",
+			msgs:      []sdk.Msg{&banktypes.MsgSend{}},
+			expectErr: false,
+		},
+		{
+			name:      "proposal without provider messages",
+			msgs:      []sdk.Msg{newSubmitProposal(&banktypes.MsgSend{})},
+			expectErr: false,
+		},
+		{
+			name: "proposal with valid MsgCreateConsumer",
+			msgs: []sdk.Msg{newSubmitProposal(&providertypes.MsgCreateConsumer{
+				Submitter:                authority,
+				ChainId:                  "chain-1",
+				Metadata:                 metadata,
+				InitializationParameters: &validInitializationParameters,
+			})},
+			expectErr: false,
+		},
+		{
+			name: "proposal with MsgCreateConsumer with invalid initialization parameters",
+			msgs: []sdk.Msg{newSubmitProposal(&providertypes.MsgCreateConsumer{
+				Submitter:                authority,
+				ChainId:                  "chain-1",
+				Metadata:                 metadata,
+				InitializationParameters: &invalidInitializationParameters,
+			})},
+			expectErr: true,
+		},
+		{
+			name: "proposal with MsgCreateConsumer with a chain id that does not match the initial height",
+			msgs: []sdk.Msg{newSubmitProposal(&providertypes.MsgCreateConsumer{
+				Submitter:                authority,
+				ChainId:                  "chain",
+				Metadata:                 metadata,
+				InitializationParameters: &validInitializationParameters,
+			})},
+			expectErr: true,
+		},
+		{
+			name: "proposal with valid MsgUpdateConsumer",
+			msgs: []sdk.Msg{newSubmitProposal(&providertypes.MsgUpdateConsumer{
+				Owner:                  authority,
+				ConsumerId:             "0",
+				PowerShapingParameters: &providertypes.PowerShapingParameters{Top_N: 95},
+			})},
+			expectErr: false,
+		},
+		{
+			name: "proposal with MsgUpdateConsumer with invalid power-shaping parameters",
+			msgs: []sdk.Msg{newSubmitProposal(
+				&banktypes.MsgSend{},
+				&providertypes.MsgUpdateConsumer{
+					Owner:                  authority,
+					ConsumerId:             "0",
+					PowerShapingParameters: &providertypes.PowerShapingParameters{Top_N: 10},
+				})},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			handler := ante.NewGovProposalValidationDecorator()
+
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := handler.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, noOpAnteDecorator())
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -9,14 +9,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	providerante "github.com/cosmos/interchain-security/v6/app/provider/ante"
+	ccvproviderante "github.com/cosmos/interchain-security/v6/x/ccv/provider/ante"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
-// channel keeper.
+// channel keeper, the provider keeper and the upgrade keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCKeeper *ibckeeper.Keeper
+	IBCKeeper      *ibckeeper.Keeper
+	ProviderKeeper ccvproviderante.ProviderKeeper
+	UpgradeKeeper  ccvproviderante.UpgradeKeeper
 }
 
 func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
@@ -29,6 +34,12 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.SignModeHandler == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
+	if options.ProviderKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "provider keeper is required for AnteHandler")
+	}
+	if options.UpgradeKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "upgrade keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -39,6 +50,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(nil),
 		ante.NewValidateBasicDecorator(),
+		providerante.NewGovProposalValidationDecorator(),
+		ccvproviderante.NewUpgradeQuietPeriodDecorator(options.ProviderKeeper, options.UpgradeKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
@@ -36,6 +36,7 @@ import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	evidencetypes "cosmossdk.io/x/evidence/types"
 	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -59,7 +60,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
-	sigtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -453,12 +453,16 @@ func New(
 		app.AccountKeeper,
 		app.DistrKeeper,
 		app.BankKeeper,
+		app.UpgradeKeeper,
 		govkeeper.Keeper{}, // will be set after the GovKeeper is created
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 		authtypes.FeeCollectorName,
 	)
+	// NOTE: the staking module of the SDK does not support the tokenization of shares (LSM), so the
+	// ProviderKeeper keeps its default no-op TokenizedSharesKeeper. Apps with an LSM-capable staking
+	// module should set it with app.ProviderKeeper.SetTokenizedSharesKeeper(app.StakingKeeper).
 
 	govConfig := govtypes.DefaultConfig()
 	app.GovKeeper = govkeeper.NewKeeper(
@@ -579,13 +583,20 @@ func New(
 	ModuleBasics.RegisterLegacyAminoCodec(app.legacyAmino)
 	ModuleBasics.RegisterInterfaces(app.interfaceRegistry)
 
-	enabledSignModes := append(authtx.DefaultSignModes,
-		sigtypes.SignMode_SIGN_MODE_TEXTUAL)
+	// SIGN_MODE_TEXTUAL is enabled as a custom sign mode in order to
+	// render the consumer public keys of the provider messages
+	textualSignModeHandler, err := providertypes.NewTextualSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
+		FileResolver:        interfaceRegistry,
+	})
+	if err != nil {
+		panic(err)
+	}
 	txConfigOpts := authtx.ConfigOptions{
-		EnabledSignModes:           enabledSignModes,
-		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
+		EnabledSignModes: authtx.DefaultSignModes,
+		CustomSignModes:  []signing.SignModeHandler{textualSignModeHandler},
 	}
-	txConfig, err := authtx.NewTxConfigWithOptions(
+	txConfig, err = authtx.NewTxConfigWithOptions(
 		appCodec,
 		txConfigOpts,
 	)
@@ -748,7 +759,9 @@ func New(
 				SignModeHandler: txConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper: app.IBCKeeper,
+			IBCKeeper:      app.IBCKeeper,
+			ProviderKeeper: app.ProviderKeeper,
+			UpgradeKeeper:  app.UpgradeKeeper,
 		},
 	)
 	if err != nil {
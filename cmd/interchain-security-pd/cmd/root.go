@@ -12,6 +12,8 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/log"
 	confixcmd "cosmossdk.io/tools/confix/cmd"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
@@ -27,7 +29,6 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
@@ -39,6 +40,7 @@ import (
 
 	appEncoding "github.com/cosmos/interchain-security/v6/app/encoding"
 	providerApp "github.com/cosmos/interchain-security/v6/app/provider"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -86,9 +88,18 @@ func NewRootCmd() *cobra.Command {
 			// sets the RPC client needed for SIGN_MODE_TEXTUAL. This sign mode
 			// is only available if the client is online.
 			if !initClientCtx.Offline {
+				// SIGN_MODE_TEXTUAL is enabled as a custom sign mode in order to
+				// render the consumer public keys of the provider messages
+				textualSignModeHandler, err := providertypes.NewTextualSignModeHandler(textual.SignModeOptions{
+					CoinMetadataQuerier: txmodule.NewGRPCCoinMetadataQueryFn(initClientCtx),
+					FileResolver:        encodingConfig.InterfaceRegistry,
+				})
+				if err != nil {
+					return err
+				}
 				txConfigOpts := tx.ConfigOptions{
-					EnabledSignModes:           append(tx.DefaultSignModes, signing.SignMode_SIGN_MODE_TEXTUAL),
-					TextualCoinMetadataQueryFn: txmodule.NewGRPCCoinMetadataQueryFn(initClientCtx),
+					EnabledSignModes: tx.DefaultSignModes,
+					CustomSignModes:  []txsigning.SignModeHandler{textualSignModeHandler},
 				}
 				txConfigWithTextual, err := tx.NewTxConfigWithOptions(
 					codec.NewProtoCodec(encodingConfig.InterfaceRegistry),
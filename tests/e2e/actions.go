@@ -3286,11 +3286,15 @@ func (tr Chain) AdvanceTimeForChain(chain ChainID, duration time.Duration) {
 }
 
 func (tr Commands) AssignConsumerPubKey(action e2e.AssignConsumerPubKeyAction, gas, home, node string, verbose bool) ([]byte, error) {
+	// a key assignment has to carry the key assignment nonce of the validator
+	// once the validator assigned a consumer key on the consumer chain
+	nonce := tr.GetKeyAssignmentNonce(action.Chain, action.Validator)
 	assignKey := fmt.Sprintf(
-		`%s tx provider assign-consensus-key %s '%s' --from validator%s --chain-id %s --home %s --node %s --gas %s --keyring-backend test -y -o json`,
+		`%s tx provider assign-consensus-key %s '%s' --nonce %d --from validator%s --chain-id %s --home %s --node %s --gas %s --keyring-backend test -y -o json`,
 		tr.chainConfigs[ChainID("provi")].BinaryName,
 		string(tr.chainConfigs[action.Chain].ConsumerId),
 		action.ConsumerPubkey,
+		nonce,
 		action.Validator,
 		tr.chainConfigs[ChainID("provi")].ChainId,
 		home,
@@ -3401,3 +3405,65 @@ func (tr Chain) transferIbcToken(
 	// wait for inclusion in a block -> '--broadcast-mode block' is deprecated
 	tr.waitBlocks(action.Chain, 2, 30*time.Second)
 }
+
+type ConsumerHardForkAction struct {
+	Chain         ChainID
+	From          ValidatorID
+	ConsumerChain ChainID
+	NewChainId    string
+	InitialHeight clienttypes.Height
+
+	// depending on the execution, this action might throw an error (e.g., when the new chain id is in use)
+	ExpectError   bool
+	ExpectedError string
+}
+
+func (tr Chain) consumerHardFork(action ConsumerHardForkAction, verbose bool) {
+	// Note: to get error response reported back from this command '--gas auto' needs to be set.
+	gas := "auto"
+	// Unfortunately, --gas auto does not work with CometMock. so when using CometMock, just use --gas 9000000 then
+	if tr.testConfig.useCometmock {
+		gas = "9000000"
+	}
+
+	// Use: "consumer-hard-fork [consumer-id] [new-chain-id] [initial-height]"
+	hardFork := fmt.Sprintf(
+		`%s tx provider consumer-hard-fork %s %s %s --from validator%s --chain-id %s --home %s --node %s --gas %s --keyring-backend test -y -o json`,
+		tr.testConfig.chainConfigs[action.Chain].BinaryName,
+		string(tr.testConfig.chainConfigs[action.ConsumerChain].ConsumerId),
+		action.NewChainId,
+		action.InitialHeight.String(),
+		action.From,
+		tr.testConfig.chainConfigs[action.Chain].ChainId,
+		tr.getValidatorHome(action.Chain, action.From),
+		tr.getValidatorNode(action.Chain, action.From),
+		gas,
+	)
+
+	cmd := tr.target.ExecCommand(
+		"/bin/bash", "-c",
+		hardFork,
+	)
+
+	if verbose {
+		fmt.Println("consumerHardFork cmd:", cmd.String())
+	}
+
+	bz, err := cmd.CombinedOutput()
+	if err != nil && !action.ExpectError {
+		log.Fatalf("unexpected error during consumer hard fork - output: %s, err: %s", string(bz), err)
+	}
+
+	if action.ExpectError && !tr.testConfig.useCometmock { // error report only works with --gas auto, which does not work with CometMock, so ignore
+		if err == nil || !strings.Contains(string(bz), action.ExpectedError) {
+			log.Fatalf("expected error not raised: expected: '%s', got '%s'", action.ExpectedError, (bz))
+		}
+
+		if verbose {
+			fmt.Printf("got expected error during consumer hard fork | err: %s | output: %s \n", err, string(bz))
+		}
+	}
+
+	// wait for inclusion in a block -> '--broadcast-mode block' is deprecated
+	tr.waitBlocks(action.Chain, 2, 30*time.Second)
+}
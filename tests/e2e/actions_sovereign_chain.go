@@ -113,10 +113,10 @@ type UpgradeProposalAction struct {
 
 func (tr *Chain) submitUpgradeProposal(action UpgradeProposalAction, verbose bool) {
 	// Get authority address
-	binary := tr.testConfig.chainConfigs[ChainID("sover")].BinaryName
+	binary := tr.testConfig.chainConfigs[action.ChainID].BinaryName
 	cmd := tr.target.ExecCommand(binary,
 		"query", "upgrade", "authority",
-		"--node", tr.getValidatorNode(ChainID("sover"), action.Proposer),
+		"--node", tr.getValidatorNode(action.ChainID, action.Proposer),
 		"-o", "json")
 	bz, err := cmd.CombinedOutput()
 	if err != nil {
@@ -142,7 +142,7 @@ func (tr *Chain) submitUpgradeProposal(action UpgradeProposalAction, verbose boo
 			"@type": "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
 			"authority": "%s",
 			"plan": {
-				"name": "sovereign-changeover",
+				"name": "%s",
 				"height": "%d",
 				"info": "my upgrade info",
 				"upgraded_client_state": null
@@ -154,7 +154,7 @@ func (tr *Chain) submitUpgradeProposal(action UpgradeProposalAction, verbose boo
 	"summary": "%s",
 	"deposit": "%s",
 	"expedited": %t
-}`, authority.Address, action.UpgradeHeight, metadata, action.UpgradeTitle, summary, deposit, action.Expedited)
+}`, authority.Address, action.UpgradeTitle, action.UpgradeHeight, metadata, action.UpgradeTitle, summary, deposit, action.Expedited)
 
 	//#nosec G204 -- bypass unsafe quoting warning (no production code)
 	proposalPath := "/temp-proposal.json"
@@ -171,9 +171,9 @@ func (tr *Chain) submitUpgradeProposal(action UpgradeProposalAction, verbose boo
 		"--gas", "900000",
 		"--from", "validator"+string(action.Proposer),
 		"--keyring-backend", "test",
-		"--chain-id", string(tr.testConfig.chainConfigs[ChainID("sover")].ChainId),
-		"--home", tr.getValidatorHome(ChainID("sover"), action.Proposer),
-		"--node", tr.getValidatorNode(ChainID("sover"), action.Proposer),
+		"--chain-id", string(tr.testConfig.chainConfigs[action.ChainID].ChainId),
+		"--home", tr.getValidatorHome(action.ChainID, action.Proposer),
+		"--node", tr.getValidatorNode(action.ChainID, action.Proposer),
 		"-y")
 
 	if verbose {
@@ -132,6 +132,12 @@ var stepChoices = map[string]StepChoice{
 		description: "multi consumer tests",
 		testConfig:  MulticonsumerTestCfg,
 	},
+	"multiconsumer-consumer-double-sign": {
+		name:        "multiconsumer-consumer-double-sign",
+		steps:       multiConsumerDoubleSignOnConsumerSteps,
+		description: "test that a validator tombstoned for double signing on a consumer chain is removed from all consumer chains",
+		testConfig:  MulticonsumerTestCfg,
+	},
 	"consumer-misbehaviour": {
 		name:        "consumer-misbehaviour",
 		steps:       consumerMisbehaviourSteps,
@@ -258,6 +264,42 @@ var stepChoices = map[string]StepChoice{
 		description: "test permissionless ics topN transformation",
 		testConfig:  PermissionlessTestCfg,
 	},
+	"consumer-hard-fork-chain-id-in-use": {
+		name:        "consumer-hard-fork-chain-id-in-use",
+		steps:       stepsConsumerHardForkChainIdInUse(),
+		description: "test that a consumer chain cannot hard fork to the chain id of another launched consumer chain",
+		testConfig:  MulticonsumerTestCfg,
+	},
+	"consumer-launch-conflict": {
+		name:        "consumer-launch-conflict",
+		steps:       stepsConsumerLaunchConflict(),
+		description: "test that a consumer chain is not launched while another consumer chain with the same chain id is launched",
+		testConfig:  PermissionlessTestCfg,
+	},
+	"consumer-plain-handshake-version": {
+		name:        "consumer-plain-handshake-version",
+		steps:       stepsConsumerPlainHandshakeVersion(),
+		description: "test that the CCV channel is established when the consumer chain proposes the plain CCV version",
+		testConfig:  MulticonsumerTestCfg,
+	},
+	"provider-upgrade-notice": {
+		name:        "provider-upgrade-notice",
+		steps:       stepsProviderUpgradeNotice(),
+		description: "test that provider upgrade notices are only sent to the consumer chains that accept them",
+		testConfig:  MulticonsumerTestCfg,
+	},
+	"consumer-removal-packet-in-flight": {
+		name:        "consumer-removal-packet-in-flight",
+		steps:       stepsConsumerRemovalWithPacketInFlight(),
+		description: "test that a consumer chain can be removed while a VSC packet sent to it is in flight",
+		testConfig:  MulticonsumerTestCfg,
+	},
+	"opt-in-after-stop": {
+		name:        "opt-in-after-stop",
+		steps:       stepsOptInAfterStop(),
+		description: "test that validators cannot opt in to a consumer chain right after it is stopped",
+		testConfig:  MulticonsumerTestCfg,
+	},
 	"inactive-vals-outside-max-validators": {
 		name:        "inactive-vals-outside-max-validators",
 		steps:       stepsInactiveValsTopNReproduce(),
@@ -213,6 +213,10 @@ func intPtr(i int) *int {
 	return &i
 }
 
+func strPtr(s string) *string {
+	return &s
+}
+
 type Commands struct {
 	containerConfig  *ContainerConfig
 	validatorConfigs map[ValidatorID]ValidatorConfig
@@ -688,6 +692,25 @@ func (tr Commands) GetConsumerAddress(consumerChain ChainID, validator Validator
 	return addr
 }
 
+// GetKeyAssignmentNonce returns the key assignment nonce of the validator on the consumer chain
+func (tr Commands) GetKeyAssignmentNonce(consumerChain ChainID, validator ValidatorID) uint64 {
+	binaryName := tr.chainConfigs[ChainID("provi")].BinaryName
+	consumerId := tr.chainConfigs[consumerChain].ConsumerId
+	cmd := tr.target.ExecCommand(binaryName,
+
+		"query", "provider", "validator-consumer-key",
+		string(consumerId), tr.validatorConfigs[validator].ValconsAddress,
+		`--node`, tr.GetQueryNode(ChainID("provi")),
+		`-o`, `json`,
+	)
+	bz, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+
+	return gjson.Get(string(bz), "key_assignment_nonce").Uint()
+}
+
 func (tr Commands) GetProviderAddressFromConsumer(consumerChain ChainID, validator ValidatorID) string {
 	binaryName := tr.chainConfigs[ChainID("provi")].BinaryName
 	consumerId := tr.chainConfigs[consumerChain].ConsumerId
@@ -770,6 +793,27 @@ func (tr Commands) GetPendingPacketQueueSize(chain ChainID) uint {
 	return uint(len(packetData))
 }
 
+// GetProviderUpgradeNotice returns the name of the provider upgrade notified to the consumer chain,
+// or an empty string if no provider upgrade is notified
+func (tr Commands) GetProviderUpgradeNotice(chain ChainID) string {
+	binaryName := tr.chainConfigs[chain].BinaryName
+	cmd := tr.target.ExecCommand(binaryName,
+		"query", "ccvconsumer", "provider-upgrade-notice",
+		`--node`, tr.GetQueryNode(chain),
+		`-o`, `json`,
+	)
+	bz, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Fatal(err, "\n", string(bz))
+	}
+
+	if !gjson.ValidBytes(bz) {
+		panic("invalid json response from query ccvconsumer provider-upgrade-notice: " + string(bz))
+	}
+
+	return gjson.Get(string(bz), "notice.name").String()
+}
+
 // GetClientFrozenHeight returns the frozen height for a client with the given client ID
 // by querying the hosting chain with the given chainID
 func (tr Commands) GetClientFrozenHeight(chain ChainID, clientID string) (uint64, uint64) {
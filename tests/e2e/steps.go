@@ -107,6 +107,11 @@ var multipleConsumers = concatSteps(
 	stepsMultiConsumerDoubleSign("consu", "densu"), // double sign on one of the chains
 )
 
+var multiConsumerDoubleSignOnConsumerSteps = concatSteps(
+	stepsStartChains([]string{"consu", "densu"}, false),
+	stepsMultiConsumerDoubleSignOnConsumer("consu", "densu"), // double sign on one of the consumer chains
+)
+
 var changeoverSteps = concatSteps(
 	// start sovereign chain and test delegation operation
 
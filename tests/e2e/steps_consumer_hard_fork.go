@@ -0,0 +1,91 @@
+package main
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	e2e "github.com/cosmos/interchain-security/v6/tests/e2e/testlib"
+)
+
+// stepsConsumerHardForkChainIdInUse tests that a launched consumer chain cannot
+// hard fork to the chain id of another launched consumer chain
+// - start two permissionless consumer chains with different chain ids
+// - try to hard fork one consumer chain to the chain id of the other one
+// - check that the hard fork is rejected and that both consumer chains keep receiving VSC packets
+func stepsConsumerHardForkChainIdInUse() []Step {
+	s := concatSteps(
+		stepStartProviderChain(),
+		stepsStartPermissionlessChain("consu", "consu", []string{"consu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0),
+		stepsStartPermissionlessChain("densu", "densu", []string{"densu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 1),
+		[]Step{
+			{
+				Action: ConsumerHardForkAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("consu"),
+					NewChainId:    "densu",
+					InitialHeight: clienttypes.Height{RevisionNumber: 0, RevisionHeight: 1},
+					ExpectError:   true,
+					ExpectedError: "a consumer chain with the same chain id is already launched",
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"consu": true, "densu": true},
+					},
+				},
+			},
+			{
+				Action: DelegateTokensAction{
+					Chain:  ChainID("provi"),
+					From:   ValidatorID("alice"),
+					To:     ValidatorID("alice"),
+					Amount: 11000000,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 500,
+						},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("consu"),
+					Port:    "provider",
+					Channel: 0,
+				},
+				State: State{
+					ChainID("consu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("densu"),
+					Port:    "provider",
+					Channel: 1,
+				},
+				State: State{
+					ChainID("densu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+					},
+				},
+			},
+		},
+	)
+	return s
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	e2e "github.com/cosmos/interchain-security/v6/tests/e2e/testlib"
+)
+
+// stepsConsumerLaunchConflict tests that a consumer chain is not launched
+// while another consumer chain with the same chain id is launched
+// - start a permissionless consumer chain "cons1" with chain id "consu"
+// - try to launch a permissionless consumer chain "cons2" with the same chain id
+// - check that "cons2" is not launched, i.e., its opted-in validators do not have to validate it
+// - remove "cons1" and check that "cons2" is launched while its launch is still retried
+func stepsConsumerLaunchConflict() []Step {
+	s := concatSteps(
+		stepStartProviderChain(),
+		stepsStartPermissionlessChain("cons1", "consu", []string{"cons1"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0),
+		[]Step{
+			{
+				Action: CreateConsumerChainAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("cons2"), // test chain "cons2" is configured with ChainID "consu"
+					InitParams: &InitializationParameters{
+						InitialHeight: clienttypes.Height{RevisionNumber: 0, RevisionHeight: 1},
+						SpawnTime:     uint(time.Minute * 3),
+					},
+					PowerShapingParams: &PowerShapingParameters{
+						TopN: 0,
+					},
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"cons1": true, "cons2": true},
+					},
+				},
+			},
+			{
+				Action: OptInAction{
+					Chain:     ChainID("cons2"),
+					Validator: ValidatorID("alice"),
+				},
+				State: State{},
+			},
+			{
+				Action: UpdateConsumerChainAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("cons2"),
+					InitParams: &InitializationParameters{
+						InitialHeight: clienttypes.Height{RevisionNumber: 0, RevisionHeight: 1},
+						SpawnTime:     0, // launch now
+					},
+					PowerShapingParams: &PowerShapingParameters{
+						TopN: 0,
+					},
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						// "cons2" cannot launch since "cons1" with the same chain id is launched
+						ProposedConsumerChains: &[]string{"cons2"},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"cons1"},
+							ValidatorID("bob"):   {"cons1"},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+			{
+				Action: RemoveConsumerChainAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("cons1"),
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						// the retried launch of "cons2" succeeds once "cons1" is stopped
+						ConsumerChains:         &map[ChainID]bool{"cons2": true},
+						ProposedConsumerChains: &[]string{},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"cons2"},
+							ValidatorID("bob"):   {},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+		},
+	)
+	return s
+}
@@ -0,0 +1,59 @@
+package main
+
+// stepsConsumerPlainHandshakeVersion tests that the CCV channel is established when the consumer chain
+// proposes the plain CCV version, as it does with a consumer genesis generated by a provider running
+// a previous ICS version (i.e., without the `provider_accepts_handshake_metadata` consumer param)
+// - start a permissionless consumer chain whose genesis does not set `provider_accepts_handshake_metadata`
+// - check that the CCV channel is established, i.e., the consumer chain applies VSC packets
+func stepsConsumerPlainHandshakeVersion() []Step {
+	startSteps := stepsStartPermissionlessChain("consu", "consu", []string{"consu"},
+		[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0)
+	for i, step := range startSteps {
+		if action, ok := step.Action.(StartConsumerChainAction); ok {
+			action.GenesisChanges = ".app_state.ccvconsumer.params.provider_accepts_handshake_metadata = false"
+			startSteps[i].Action = action
+		}
+	}
+
+	s := concatSteps(
+		stepStartProviderChain(),
+		startSteps,
+		[]Step{
+			{
+				Action: DelegateTokensAction{
+					Chain:  ChainID("provi"),
+					From:   ValidatorID("alice"),
+					To:     ValidatorID("alice"),
+					Amount: 11000000,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 500,
+						},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("consu"),
+					Port:    "provider",
+					Channel: 0,
+				},
+				State: State{
+					ChainID("consu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+					},
+				},
+			},
+		},
+	)
+	return s
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	e2e "github.com/cosmos/interchain-security/v6/tests/e2e/testlib"
+)
+
+// stepsConsumerRemovalWithPacketInFlight tests that a consumer chain can be removed
+// while a VSC packet sent to it is not acknowledged yet
+// - start a permissionless consumer chain "consu"
+// - change the voting power of a validator so that a VSC packet is sent to "consu", but do not relay it
+// - remove "consu" and relay the packets that are still in flight on its CCV channel
+// - check that the provider is not stuck, i.e., that the VSC packets sent to another consumer chain are still relayed
+func stepsConsumerRemovalWithPacketInFlight() []Step {
+	s := concatSteps(
+		stepStartProviderChain(),
+		stepsStartPermissionlessChain("consu", "consu", []string{"consu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0),
+		stepsStartPermissionlessChain("densu", "densu", []string{"densu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 1),
+		[]Step{
+			{
+				Action: DelegateTokensAction{
+					Chain:  ChainID("provi"),
+					From:   ValidatorID("alice"),
+					To:     ValidatorID("alice"),
+					Amount: 11000000,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 500,
+						},
+					},
+					// the VSC packet is not relayed yet
+					ChainID("consu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 500,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+					},
+				},
+			},
+			{
+				Action: RemoveConsumerChainAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("consu"),
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"densu": true},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"densu"},
+							ValidatorID("bob"):   {"densu"},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+			{
+				// the packets still in flight on the CCV channel of the removed consumer chain
+				// are handled gracefully by the provider
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("consu"),
+					Port:    "provider",
+					Channel: 0,
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"densu": true},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("densu"),
+					Port:    "provider",
+					Channel: 1,
+				},
+				State: State{
+					ChainID("densu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+					},
+				},
+			},
+		},
+	)
+	return s
+}
@@ -227,3 +227,114 @@ func stepsMultiConsumerDoubleSign(consumer1, consumer2 string) []Step {
 		},
 	}
 }
+
+// stepsMultiConsumerDoubleSignOnConsumer simulates double signing on a consumer chain and checks that the
+// tombstoned validator is removed from the validator sets of all the consumer chains, including the consumer
+// chain on which the infraction did not occur
+func stepsMultiConsumerDoubleSignOnConsumer(consumer1, consumer2 string) []Step {
+	return []Step{
+		{
+			Action: DoublesignSlashAction{
+				Chain:     ChainID(consumer1),
+				Validator: ValidatorID("bob"),
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   500,
+						ValidatorID("carol"): 500,
+					},
+				},
+				ChainID(consumer1): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   500,
+						ValidatorID("carol"): 500,
+					},
+				},
+				ChainID(consumer2): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   500,
+						ValidatorID("carol"): 500,
+					},
+				},
+			},
+		},
+		{
+			// detect the double voting infraction on consumer1,
+			// which tombstones bob on the provider
+			Action: DetectConsumerEvidenceAction{
+				Chain:     ChainID(consumer1),
+				Submitter: ValidatorID("bob"),
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   0,
+						ValidatorID("carol"): 500,
+					},
+				},
+				ChainID(consumer2): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   500, // removal NOT YET relayed to consumer2
+						ValidatorID("carol"): 500,
+					},
+				},
+			},
+		},
+		{
+			// relay the removal of bob to consumer2
+			Action: RelayPacketsAction{
+				ChainA:  ChainID("provi"),
+				ChainB:  ChainID(consumer2),
+				Port:    "provider",
+				Channel: 1, // consumer2 channel
+			},
+			State: State{
+				ChainID(consumer1): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   500, // removal NOT YET relayed to consumer1
+						ValidatorID("carol"): 500,
+					},
+				},
+				ChainID(consumer2): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   0,
+						ValidatorID("carol"): 500,
+					},
+				},
+			},
+		},
+		{
+			// relay the removal of bob to consumer1
+			Action: RelayPacketsAction{
+				ChainA:  ChainID("provi"),
+				ChainB:  ChainID(consumer1),
+				Port:    "provider",
+				Channel: 0, // consumer1 channel
+			},
+			State: State{
+				ChainID(consumer1): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   0,
+						ValidatorID("carol"): 500,
+					},
+				},
+				ChainID(consumer2): ChainState{
+					ValPowers: &map[ValidatorID]uint{
+						ValidatorID("alice"): 500,
+						ValidatorID("bob"):   0,
+						ValidatorID("carol"): 500,
+					},
+				},
+			},
+		},
+	}
+}
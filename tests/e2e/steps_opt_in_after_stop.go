@@ -0,0 +1,77 @@
+package main
+
+import (
+	e2e "github.com/cosmos/interchain-security/v6/tests/e2e/testlib"
+)
+
+// stepsOptInAfterStop tests that validators cannot opt in to a consumer chain right after it is stopped
+// - start the permissionless consumer chains "consu" and "densu" with "alice" and "bob" opted in
+// - stop "consu"
+// - check that opting in to "consu" right after the stop is rejected with an error naming the stopped phase,
+// both for a validator that was not opted in and for a validator that was opted in
+// - check that the validators that are opted in to "densu" keep validating it
+func stepsOptInAfterStop() []Step {
+	s := concatSteps(
+		stepStartProviderChain(),
+		stepsStartPermissionlessChain("consu", "consu", []string{"consu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0),
+		stepsStartPermissionlessChain("densu", "densu", []string{"densu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 1),
+		[]Step{
+			{
+				Action: RemoveConsumerChainAction{
+					Chain:         ChainID("provi"),
+					From:          ValidatorID("alice"),
+					ConsumerChain: ChainID("consu"),
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"densu": true},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"densu"},
+							ValidatorID("bob"):   {"densu"},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+			{
+				Action: OptInAction{
+					Chain:         ChainID("consu"),
+					Validator:     ValidatorID("carol"),
+					ExpectError:   true,
+					ExpectedError: "cannot opt in to consumer chain in phase CONSUMER_PHASE_STOPPED",
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"densu": true},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"densu"},
+							ValidatorID("bob"):   {"densu"},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+			{
+				Action: OptInAction{
+					Chain:         ChainID("consu"),
+					Validator:     ValidatorID("alice"),
+					ExpectError:   true,
+					ExpectedError: "cannot opt in to consumer chain in phase CONSUMER_PHASE_STOPPED",
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"densu": true},
+						HasToValidate: &map[ValidatorID][]ChainID{
+							ValidatorID("alice"): {"densu"},
+							ValidatorID("bob"):   {"densu"},
+							ValidatorID("carol"): {},
+						},
+					},
+				},
+			},
+		},
+	)
+	return s
+}
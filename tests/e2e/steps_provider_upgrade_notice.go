@@ -0,0 +1,135 @@
+package main
+
+import (
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	e2e "github.com/cosmos/interchain-security/v6/tests/e2e/testlib"
+)
+
+// stepsProviderUpgradeNotice tests that provider upgrade notices are only sent to the consumer chains
+// that accept them, i.e., that declared it in their handshake metadata
+// - start the provider chain with `send_upgrade_notices` enabled
+// - start a consumer chain "consu" that accepts provider upgrade notices
+// - start a consumer chain "densu" that proposes the plain CCV version, as consumer chains running a previous ICS version do
+// - schedule an upgrade of the provider chain
+// - check that "consu" is notified about the upgrade and that "densu" is not notified, but keeps applying VSC packets
+func stepsProviderUpgradeNotice() []Step {
+	providerSteps := stepStartProviderChain()
+	for i, step := range providerSteps {
+		if action, ok := step.Action.(StartChainAction); ok {
+			action.GenesisChanges = ".app_state.provider.params.send_upgrade_notices = true"
+			providerSteps[i].Action = action
+		}
+	}
+	plainHandshakeSteps := stepsStartPermissionlessChain("densu", "densu", []string{"densu"},
+		[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 1)
+	for i, step := range plainHandshakeSteps {
+		if action, ok := step.Action.(StartConsumerChainAction); ok {
+			action.GenesisChanges = ".app_state.ccvconsumer.params.provider_accepts_handshake_metadata = false"
+			plainHandshakeSteps[i].Action = action
+		}
+	}
+
+	s := concatSteps(
+		providerSteps,
+		stepsStartPermissionlessChain("consu", "consu", []string{"consu"},
+			[]ValidatorID{ValidatorID("alice"), ValidatorID("bob")}, 0),
+		plainHandshakeSteps,
+		[]Step{
+			{
+				Action: UpgradeProposalAction{
+					ChainID:       ChainID("provi"),
+					UpgradeTitle:  "provider-upgrade",
+					Proposer:      ValidatorID("alice"),
+					UpgradeHeight: 10000,
+					Expedited:     false,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						Proposals: &map[uint]Proposal{
+							1: UpgradeProposal{
+								Title:         "provider-upgrade",
+								UpgradeHeight: 10000,
+								Type:          "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
+								Deposit:       10000000,
+								Status:        gov.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD.String(),
+							},
+						},
+					},
+				},
+			},
+			{
+				Action: VoteGovProposalAction{
+					Chain:      ChainID("provi"),
+					From:       []ValidatorID{ValidatorID("alice"), ValidatorID("bob"), ValidatorID("carol")},
+					Vote:       []string{"yes", "yes", "yes"},
+					PropNumber: 1,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						Proposals: &map[uint]Proposal{
+							1: UpgradeProposal{
+								Title:         "provider-upgrade",
+								UpgradeHeight: 10000,
+								Type:          "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal",
+								Deposit:       10000000,
+								Status:        gov.ProposalStatus_PROPOSAL_STATUS_PASSED.String(),
+							},
+						},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("consu"),
+					Port:    "provider",
+					Channel: 0,
+				},
+				State: State{
+					ChainID("consu"): ChainState{
+						ProviderUpgradeNotice: strPtr("provider-upgrade"),
+					},
+				},
+			},
+			{
+				Action: DelegateTokensAction{
+					Chain:  ChainID("provi"),
+					From:   ValidatorID("alice"),
+					To:     ValidatorID("alice"),
+					Amount: 11000000,
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 500,
+						},
+					},
+				},
+			},
+			{
+				Action: RelayPacketsAction{
+					ChainA:  ChainID("provi"),
+					ChainB:  ChainID("densu"),
+					Port:    "provider",
+					Channel: 1,
+				},
+				State: State{
+					ChainID("provi"): e2e.ChainState{
+						ConsumerChains: &map[ChainID]bool{"consu": true, "densu": true},
+					},
+					ChainID("densu"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 511,
+							ValidatorID("bob"):   500,
+							ValidatorID("carol"): 0,
+						},
+						ProviderUpgradeNotice: strPtr(""),
+					},
+				},
+			},
+		},
+	)
+	return s
+}
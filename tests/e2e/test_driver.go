@@ -239,6 +239,11 @@ func (td *DefaultDriver) GetChainState(chain ChainID, modelState ChainState) e2e
 		chainState.ConsumerPendingPacketQueueSize = &pendingPacketQueueSize
 	}
 
+	if modelState.ProviderUpgradeNotice != nil {
+		providerUpgradeNotice := chainDriver.target.GetProviderUpgradeNotice(chain)
+		chainState.ProviderUpgradeNotice = &providerUpgradeNotice
+	}
+
 	if *verbose {
 		log.Println("Done getting chain state:\n" + pretty.Sprint(chainState))
 	}
@@ -255,7 +260,7 @@ func (td *DefaultDriver) runAction(action interface{}) error {
 		target := td.getTargetDriver(action.Chain)
 		target.startSovereignChain(action, td.verbose)
 	case UpgradeProposalAction:
-		target := td.getTargetDriver(ChainID("sover"))
+		target := td.getTargetDriver(action.ChainID)
 		target.submitUpgradeProposal(action, td.verbose)
 	case WaitUntilBlockAction:
 		target := td.getTargetDriver(action.Chain)
@@ -420,6 +425,9 @@ func (td *DefaultDriver) runAction(action interface{}) error {
 	case TransferIbcTokenAction:
 		target := td.getTargetDriver(action.Chain)
 		target.transferIbcToken(action, td.verbose)
+	case ConsumerHardForkAction:
+		target := td.getTargetDriver(action.Chain)
+		target.consumerHardFork(action, td.verbose)
 	default:
 		log.Fatalf("unknown action in testRun %s: %#v", td.testCfg.name, action)
 	}
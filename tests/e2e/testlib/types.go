@@ -42,6 +42,7 @@ type ChainCommands interface {
 	GetRegisteredConsumerRewardDenoms(chain ChainID) []string
 	GetSlashMeter() int64
 	GetPendingPacketQueueSize(chain ChainID) uint
+	GetProviderUpgradeNotice(chain ChainID) string
 	GetProposedConsumerChains(chain ChainID) []string
 	GetQueryNode(chain ChainID) string
 	GetQueryNodeRPCAddress(chain ChainID) string
@@ -188,6 +189,7 @@ type ChainState struct {
 	HasToValidate                  *map[ValidatorID][]ChainID // only relevant to provider chain
 	InflationRateChange            *int                       // whether the inflation rate between two blocks changes negatively (any negative number), is equal (0), or changes positively (any positive number)
 	ConsumerCommissionRates        *map[ValidatorID]float64
+	ProviderUpgradeNotice          *string // Only relevant to consumer chains: name of the notified provider upgrade, empty if none
 }
 
 // custom marshal and unmarshal functions for the chainstate that convert proposals to/from the auxiliary type with type info
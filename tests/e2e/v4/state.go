@@ -641,6 +641,10 @@ func (tr Commands) GetConsumerCommissionRate(chain ChainID, validator ValidatorI
 	panic("'GetConsumerCommissionRate' is not implemented in this version")
 }
 
+func (tr Commands) GetProviderUpgradeNotice(chain ChainID) string {
+	panic("'GetProviderUpgradeNotice' is not implemented in this version")
+}
+
 func (tr Commands) GetInflationRate(
 	chain ChainID,
 ) float64 {
@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"time"
+
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestConsumerUnbondingPeriodMismatch tests that the provider refuses to open the CCV channel
+// with a consumer chain that runs with a shorter unbonding period than the one in its initialization parameters.
+// @Long Description@
+// * Set up the connections between the provider and the consumer chain.
+// * Shorten the unbonding period of the consumer chain beyond the tolerated deviation.
+// * Check that the consumer opens the CCV channel, but that the provider refuses it.
+// * Restore the unbonding period of the consumer chain within the tolerated deviation.
+// * Check that the CCV channel handshake completes.
+func (s *CCVTestSuite) TestConsumerUnbondingPeriodMismatch() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	unbondingPeriod := initializationParameters.UnbondingPeriod
+	s.Require().Equal(unbondingPeriod, consumerKeeper.GetUnbondingPeriod(s.consumerCtx()))
+
+	s.coordinator.CreateConnections(s.path)
+
+	// the consumer chain is misconfigured with a shorter unbonding period
+	consumerKeeper.SetUnbondingPeriod(s.consumerCtx(),
+		unbondingPeriod-providertypes.MaxConsumerUnbondingPeriodDeviation-time.Minute)
+
+	err = s.path.EndpointA.ChanOpenInit()
+	s.Require().NoError(err)
+	err = s.path.EndpointB.ChanOpenTry()
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), providertypes.ErrInvalidConsumerUnbondingPeriod.Error())
+	_, found := providerKeeper.GetConsumerIdToChannelId(s.providerCtx(), consumerId)
+	s.Require().False(found)
+
+	// an unbonding period within the tolerated deviation is accepted;
+	// note that the version of the consumer endpoint was replaced by the refused handshake metadata
+	consumerKeeper.SetUnbondingPeriod(s.consumerCtx(),
+		unbondingPeriod-providertypes.MaxConsumerUnbondingPeriodDeviation)
+	s.path.EndpointA.ChannelConfig.Version = ccv.Version
+	s.ExecuteCCVChannelHandshake(s.path)
+
+	channelId, found := providerKeeper.GetConsumerIdToChannelId(s.providerCtx(), consumerId)
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointB.ChannelID, channelId)
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TestCreateConsumerThroughGovernance tests that a consumer chain created through a governance proposal records
+// the id of the proposal, and that the proposal id is validated against the proposal whose messages are executed.
+// @Long Description@
+// * Submit a governance proposal that creates a consumer chain and declares the id of the proposal.
+// * Check that the message is rejected if the proposal is not being executed, or if the proposal does not contain it.
+// * Execute the proposal and check that the consumer chain is created by governance through the proposal,
+// as returned by the chain queries.
+func (s *CCVTestSuite) TestCreateConsumerThroughGovernance() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	govKeeper := s.providerApp.GetTestGovKeeper()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	ctx := s.providerCtx()
+
+	params, err := govKeeper.Params.Get(ctx)
+	s.Require().NoError(err)
+	votingPeriod := 3 * time.Second
+	params.VotingPeriod = &votingPeriod
+	err = govKeeper.Params.Set(ctx, params)
+	s.Require().NoError(err)
+
+	proposalId, err := govKeeper.ProposalID.Peek(ctx)
+	s.Require().NoError(err)
+	nextConsumerId, found := providerKeeper.GetConsumerId(ctx)
+	s.Require().True(found)
+	consumerId := strconv.FormatUint(nextConsumerId, 10)
+
+	msg := &types.MsgCreateConsumer{
+		Submitter:  providerKeeper.GetAuthority(),
+		ChainId:    "gov-consumer-1",
+		Metadata:   types.ConsumerMetadata{Name: "gov consumer", Description: "created through governance", Metadata: "{}"},
+		ProposalId: proposalId,
+	}
+	err = submitProposalWithDepositAndVote(*govKeeper, ctx, []sdk.Msg{msg}, s.providerChain.SenderAccounts,
+		s.providerChain.SenderAccount.GetAddress(), params.MinDeposit)
+	s.Require().NoError(err)
+
+	// the proposal is not executed before the end of its voting period
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = msgServer.CreateConsumer(cachedCtx, msg)
+	s.Require().ErrorIs(err, types.ErrInvalidProposalId)
+
+	// the proposal does not contain a different message
+	proposal, err := govKeeper.Proposals.Get(ctx, proposalId)
+	s.Require().NoError(err)
+	cachedCtx, _ = ctx.WithBlockTime(*proposal.VotingEndTime).CacheContext()
+	otherMsg := *msg
+	otherMsg.ChainId = "gov-consumer-2"
+	_, err = msgServer.CreateConsumer(cachedCtx, &otherMsg)
+	s.Require().ErrorIs(err, types.ErrInvalidProposalId)
+
+	// the proposal is executed once its voting period ends
+	s.providerChain.CurrentHeader.Time = s.providerChain.CurrentHeader.Time.Add(votingPeriod)
+	s.providerChain.NextBlock()
+	ctx = s.providerCtx()
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposalId)
+	s.Require().NoError(err)
+	s.Require().Equal(govv1.StatusPassed, proposal.Status, proposal.FailedReason)
+
+	expectedRecord := types.ConsumerCreationRecord{CreatedByGovernance: true, ProposalId: proposalId}
+	record, found := providerKeeper.GetConsumerCreationRecord(ctx, consumerId)
+	s.Require().True(found)
+	s.Require().Equal(expectedRecord, record)
+
+	chain, err := providerKeeper.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: consumerId})
+	s.Require().NoError(err)
+	s.Require().Equal(msg.ChainId, chain.ChainId)
+	s.Require().Equal(&expectedRecord, chain.CreationRecord)
+}
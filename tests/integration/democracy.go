@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkdistrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
@@ -19,6 +20,8 @@ import (
 	icstestingutils "github.com/cosmos/interchain-security/v6/testutil/ibc_testing"
 	testutil "github.com/cosmos/interchain-security/v6/testutil/integration"
 	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccvgovtypes "github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+	democracystakingtypes "github.com/cosmos/interchain-security/v6/x/ccv/democracy/staking/types"
 )
 
 type ConsumerDemocracyTestSuite struct {
@@ -286,6 +289,76 @@ func (s *ConsumerDemocracyTestSuite) TestDemocracyGovernanceWhitelisting() {
 	s.Assert().Equal(votersOldBalances, getAccountsBalances(s.consumerCtx(), bankKeeper, bondDenom, votingAccounts))
 }
 
+// TestDemocracyGovernanceWhitelistUpdate checks that the governance whitelist can be updated through governance,
+// so that previously forbidden proposals are accepted.
+// @Long Description@
+// * Set up a democracy consumer chain.
+// * Submit a proposal containing changes to the auth module parameters.
+// * Check that the proposal is not executed, since the change to the auth module is not whitelisted.
+// * Submit a proposal that adds the changes to the auth module parameters to the governance whitelist.
+// * Check that the proposal is executed and that the whitelist query returns the new entry.
+// * Submit again the proposal containing changes to the auth module parameters.
+// * Check that the proposal is executed, since the change to the auth module is whitelisted now.
+func (s *ConsumerDemocracyTestSuite) TestDemocracyGovernanceWhitelistUpdate() {
+	govKeeper := s.consumerApp.GetTestGovKeeper()
+	params, err := govKeeper.Params.Get(s.consumerCtx())
+	s.Require().NoError(err)
+
+	accountKeeper := s.consumerApp.GetTestAccountKeeper()
+	votingAccounts := s.consumerChain.SenderAccounts
+	depositAmount := params.MinDeposit
+	duration := (3 * time.Second)
+	params.VotingPeriod = &duration
+	err = govKeeper.Params.Set(s.consumerCtx(), params)
+	s.Require().NoError(err)
+	proposer := s.consumerChain.SenderAccount
+	s.consumerChain.NextBlock()
+
+	queryClient := ccvgovtypes.NewQueryClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: s.consumerApp.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             s.consumerCtx(),
+	})
+	resp, err := queryClient.QueryWhitelist(s.consumerCtx(), &ccvgovtypes.QueryWhitelistRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(ccvgovtypes.DefaultWhitelist(), resp.Whitelist)
+
+	// executeProposal submits a proposal with the given messages and executes it (or deletes it if forbidden)
+	executeProposal := func(msgs ...sdk.Msg) {
+		err := submitProposalWithDepositAndVote(govKeeper, s.consumerCtx(), msgs, votingAccounts, proposer.GetAddress(), depositAmount)
+		s.Require().NoError(err)
+		s.consumerChain.CurrentHeader.Time = s.consumerChain.CurrentHeader.Time.Add(*params.VotingPeriod)
+		s.consumerChain.NextBlock()
+	}
+
+	newAuthParamValue := uint64(128)
+	authParams := accountKeeper.GetParams(s.consumerCtx())
+	s.Require().NotEqual(newAuthParamValue, authParams.MaxMemoCharacters)
+	authParams.MaxMemoCharacters = newAuthParamValue
+	updateAuthParamsMsg := &authtypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    authParams,
+	}
+
+	// the proposal is not executed, since the change to the auth module is not whitelisted
+	executeProposal(updateAuthParamsMsg)
+	s.Require().NotEqual(newAuthParamValue, accountKeeper.GetParams(s.consumerCtx()).MaxMemoCharacters)
+
+	// add the change to the auth module to the whitelist
+	newWhitelist := resp.Whitelist
+	newWhitelist.MsgTypeUrls = append(newWhitelist.MsgTypeUrls, sdk.MsgTypeURL(updateAuthParamsMsg))
+	executeProposal(&ccvgovtypes.MsgUpdateWhitelist{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Whitelist: newWhitelist,
+	})
+	resp, err = queryClient.QueryWhitelist(s.consumerCtx(), &ccvgovtypes.QueryWhitelistRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(newWhitelist, resp.Whitelist)
+
+	// the previously forbidden proposal is executed
+	executeProposal(updateAuthParamsMsg)
+	s.Require().Equal(newAuthParamValue, accountKeeper.GetParams(s.consumerCtx()).MaxMemoCharacters)
+}
+
 // TestDemocracyMsgUpdateParams checks that the consumer parameters can be updated through a governance proposal.
 // @Long Description@
 // * Set up a democracy consumer chain.
@@ -340,6 +413,70 @@ func (s *ConsumerDemocracyTestSuite) TestDemocracyMsgUpdateParams() {
 	s.Assert().Equal(votersOldBalances, getAccountsBalances(s.consumerCtx(), bankKeeper, bondDenom, votingAccounts))
 }
 
+// TestDemocracyRepresentativesQueries tests the democracy staking queries wired into the democracy consumer app.
+// @Long Description@
+// * Set up a democracy consumer chain.
+// * Query the representatives and check that they match the validators of the democracy staking module,
+// that none of them is flagged as block producer, and that the number of CCV validators is returned.
+// * Query the consumer key of every representative and check that it matches the CCV validator set.
+// * Check that querying an unknown representative fails.
+func (s *ConsumerDemocracyTestSuite) TestDemocracyRepresentativesQueries() {
+	s.consumerChain.NextBlock()
+	stakingKeeper := s.consumerApp.GetTestStakingKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+
+	queryClient := democracystakingtypes.NewQueryClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: s.consumerApp.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             s.consumerCtx(),
+	})
+
+	validators, err := stakingKeeper.GetAllValidators(s.consumerCtx())
+	s.Require().NoError(err)
+	ccValidators := consumerKeeper.GetAllCCValidator(s.consumerCtx())
+
+	resp, err := queryClient.QueryRepresentatives(s.consumerCtx(), &democracystakingtypes.QueryRepresentativesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(resp.Representatives, len(validators))
+	s.Require().Equal(uint32(len(ccValidators)), resp.CcvValidatorCount)
+
+	representatives := map[string]democracystakingtypes.Representative{}
+	for _, representative := range resp.Representatives {
+		s.Require().False(representative.IsBlockProducer)
+		representatives[representative.OperatorAddress] = representative
+	}
+	for _, validator := range validators {
+		representative, found := representatives[validator.OperatorAddress]
+		s.Require().True(found)
+		s.Require().Equal(validator.GetTokens(), representative.Tokens)
+		s.Require().Equal(validator.GetDelegatorShares(), representative.DelegatorShares)
+		s.Require().Equal(validator.GetStatus(), representative.Status)
+
+		consAddr, err := validator.GetConsAddr()
+		s.Require().NoError(err)
+		ccValidator, found := consumerKeeper.GetCCValidator(s.consumerCtx(), consAddr)
+
+		keyResp, err := queryClient.QueryRepresentativeConsumerKey(s.consumerCtx(),
+			&democracystakingtypes.QueryRepresentativeConsumerKeyRequest{OperatorAddress: validator.OperatorAddress})
+		s.Require().NoError(err)
+		s.Require().Equal(found, keyResp.HasConsumerKey)
+		s.Require().Equal(ccValidator.Power, keyResp.CcvPower)
+		s.Require().Equal(sdk.ConsAddress(consAddr).String(), keyResp.ConsensusAddress)
+	}
+
+	// the consensus keys of the representatives are the ones of the CCV validators in the test setup
+	keyResp, err := queryClient.QueryRepresentativeConsumerKey(s.consumerCtx(),
+		&democracystakingtypes.QueryRepresentativeConsumerKeyRequest{OperatorAddress: validators[0].OperatorAddress})
+	s.Require().NoError(err)
+	s.Require().True(keyResp.HasConsumerKey)
+
+	// querying an unknown representative fails
+	_, err = queryClient.QueryRepresentativeConsumerKey(s.consumerCtx(),
+		&democracystakingtypes.QueryRepresentativeConsumerKeyRequest{
+			OperatorAddress: sdk.ValAddress(s.consumerChain.SenderAccount.GetAddress()).String(),
+		})
+	s.Require().Error(err)
+}
+
 func submitProposalWithDepositAndVote(govKeeper govkeeper.Keeper, ctx sdk.Context, msgs []sdk.Msg,
 	accounts []ibctesting.SenderAccount, proposer sdk.AccAddress, depositAmount sdk.Coins,
 ) error {
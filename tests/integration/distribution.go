@@ -554,6 +554,7 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 		setup            func(sdk.Context, *providerkeeper.Keeper, icstestingutils.TestBankKeeper)
 		rewardsAllocated bool
 		expErr           bool
+		rewardsDiverted  bool
 	}{
 		{
 			"invalid IBC packet",
@@ -562,6 +563,7 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			},
 			false,
 			true,
+			false,
 		},
 		{
 			"IBC packet sender isn't a consumer chain",
@@ -571,6 +573,7 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			},
 			false,
 			false,
+			false,
 		},
 		{
 			"IBC Transfer recipient is not the consumer rewards pool address",
@@ -580,12 +583,14 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			},
 			false,
 			false,
+			false,
 		},
 		{
 			"IBC Transfer coin denom isn't registered",
 			func(ctx sdk.Context, keeper *providerkeeper.Keeper, bankKeeper icstestingutils.TestBankKeeper) {},
 			true, // even if the denom is not registered/allowlisted, the rewards are still allocated by denom
 			false,
+			false,
 		},
 		{
 			"successful token transfer to empty pool",
@@ -597,6 +602,7 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			},
 			true,
 			false,
+			false,
 		},
 		{
 			"successful token transfer to filled pool",
@@ -625,6 +631,30 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			},
 			true,
 			false,
+			false,
+		},
+		{
+			"IBC Transfer received on a channel other than the registered reward channel",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper, bankKeeper icstestingutils.TestBankKeeper) {
+				keeper.SetConsumerRewardChannel(ctx, s.getFirstBundle().ConsumerId, "channel-99")
+			},
+			false,
+			false,
+			true,
+		},
+		{
+			"IBC Transfer with a consumer reward memo received on a spoofed channel",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper, bankKeeper icstestingutils.TestBankKeeper) {
+				// the channel cannot be identified as belonging to the consumer chain
+				packet.DestinationChannel = "CorruptedChannelId"
+				memo, err := ccv.CreateTransferMemo(s.getFirstBundle().ConsumerId, s.getFirstBundle().Chain.ChainID)
+				s.Require().NoError(err)
+				data.Memo = memo
+				packet.Data = data.GetBytes()
+			},
+			false,
+			false,
+			true,
 		},
 	}
 
@@ -682,6 +712,8 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 
 			// save the IBC transfer rewards transferred
 			rewardsPoolBalance := bankKeeper.GetAllBalances(s.providerCtx(), sdk.MustAccAddressFromBech32(data.Receiver))
+			communityPoolAddr := s.providerApp.GetTestDistributionKeeper().GetDistributionAccount(s.providerCtx()).GetAddress()
+			communityPoolBalance := bankKeeper.GetAllBalances(s.providerCtx(), communityPoolAddr)
 
 			// save the consumer's rewards allocated
 			ibcDenom := getIBCDenom(packet.DestinationPort, packet.DestinationChannel)
@@ -704,12 +736,24 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 			s.Require().NoError(err)
 			rewardsAllocated := rewardsAllocatedByDenom.Rewards.Sub(consumerRewardsAllocations.Rewards)
 
-			if !tc.expErr {
+			if tc.rewardsDiverted {
+				s.Require().True(ack.Success())
+				// verify that the IBC coins were sent to the community pool instead of being allocated to the consumer
+				s.Require().Empty(rewardsTransferred)
+				s.Require().Empty(rewardsAllocated)
+				communityPoolRewards := bankKeeper.GetAllBalances(s.providerCtx(), communityPoolAddr).Sub(communityPoolBalance...)
+				s.Require().Equal(sdk.Coins{expRewards}, communityPoolRewards)
+			} else if !tc.expErr {
 				s.Require().True(ack.Success())
 				// verify that the consumer rewards pool received the IBC coins
 				s.Require().Equal(rewardsTransferred, sdk.Coins{expRewards})
 
 				if tc.rewardsAllocated {
+					// verify that the channel is registered as the reward channel of the consumer
+					rewardChannelId, found := providerKeeper.GetConsumerRewardChannel(s.providerCtx(), s.getFirstBundle().ConsumerId)
+					s.Require().True(found)
+					s.Require().Equal(packet.DestinationChannel, rewardChannelId)
+
 					// check the data receiver address is set to the consumer rewards pool address
 					s.Require().Equal(data.GetReceiver(), providerKeeper.GetConsumerRewardsPoolAddressStr(s.providerCtx()))
 
@@ -726,6 +770,167 @@ func (s *CCVTestSuite) TestIBCTransferMiddleware() {
 	}
 }
 
+// TestRewardAttributionLog tests that the IBC transfer OnRecvPacket callback records
+// its decision on every transfer to the consumer rewards pool.
+// @Long Description@
+// * Set up IBC and transfer channels.
+// * Simulate token transfers to the consumer rewards pool with a malformed memo, with an unknown consumer id,
+// with a denom that is not allowlisted, and with an allowlisted denom.
+// * Ensure that a reward attribution event with the expected decision is emitted for every transfer
+// and that only the decisions on transfers attributed to the consumer chain are added to its reward attribution log.
+func (s *CCVTestSuite) TestRewardAttributionLog() {
+	var (
+		data   transfertypes.FungibleTokenPacketData
+		packet channeltypes.Packet
+	)
+
+	// the denom of the transferred tokens on the provider chain
+	getIBCDenom := func() string {
+		return transfertypes.ParseDenomTrace(
+			transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom),
+		).IBCDenom()
+	}
+
+	testCases := []struct {
+		name               string
+		setup              func(sdk.Context, *providerkeeper.Keeper)
+		expConsumerId      func() string
+		expDecision        providertypes.RewardAttributionDecision
+		expRecordedInLog   bool
+		expRewardAllocated bool
+	}{
+		{
+			"malformed memo",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper) {
+				// the channel cannot be identified as belonging to the consumer chain
+				packet.DestinationChannel = "CorruptedChannelId"
+				data.Memo = `{"provider":`
+				packet.Data = data.GetBytes()
+			},
+			func() string { return "" },
+			providertypes.REWARD_ATTRIBUTION_DECISION_MALFORMED_MEMO,
+			false,
+			false,
+		},
+		{
+			"unknown consumer id",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper) {
+				memo, err := ccv.CreateTransferMemo("42", "unknown-chain")
+				s.Require().NoError(err)
+				data.Memo = memo
+				packet.Data = data.GetBytes()
+			},
+			func() string { return "42" },
+			providertypes.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER,
+			false,
+			false,
+		},
+		{
+			"denom not allowlisted",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper) {},
+			func() string { return s.getFirstBundle().ConsumerId },
+			providertypes.REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED,
+			true,
+			true, // even if the denom is not allowlisted, the rewards are still allocated by denom
+		},
+		{
+			"rewards credited to the consumer chain",
+			func(ctx sdk.Context, keeper *providerkeeper.Keeper) {
+				keeper.SetConsumerRewardDenom(ctx, getIBCDenom())
+			},
+			func() string { return s.getFirstBundle().ConsumerId },
+			providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.SetupCCVChannel(s.path)
+			s.SetupTransferChannel()
+
+			providerKeeper := s.providerApp.GetProviderKeeper()
+			consumerId := s.getFirstBundle().ConsumerId
+			amount := math.NewInt(100)
+
+			data = transfertypes.NewFungibleTokenPacketData( // can be explicitly changed in setup
+				sdk.DefaultBondDenom,
+				amount.String(),
+				authtypes.NewModuleAddress(consumertypes.ConsumerToSendToProviderName).String(),
+				providerKeeper.GetConsumerRewardsPoolAddressStr(s.providerCtx()),
+				"",
+			)
+
+			packet = channeltypes.NewPacket( // can be explicitly changed in setup
+				data.GetBytes(),
+				uint64(1),
+				s.transferPath.EndpointA.ChannelConfig.PortID,
+				s.transferPath.EndpointA.ChannelID,
+				s.transferPath.EndpointB.ChannelConfig.PortID,
+				s.transferPath.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+
+			tc.setup(s.providerCtx(), &providerKeeper)
+
+			cbs, ok := s.providerChain.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+			s.Require().True(ok)
+
+			// execute middleware OnRecvPacket logic
+			ctx := s.providerCtx().WithEventManager(sdk.NewEventManager())
+			ack := cbs.OnRecvPacket(ctx, packet, sdk.AccAddress{})
+			s.Require().True(ack.Success())
+
+			// verify that a reward attribution event with the expected decision is emitted
+			var attributes map[string]string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != providertypes.EventTypeRewardAttribution {
+					continue
+				}
+				s.Require().Nil(attributes, "more than one reward attribution event emitted")
+				attributes = map[string]string{}
+				for _, attr := range event.Attributes {
+					attributes[attr.Key] = attr.Value
+				}
+			}
+			s.Require().NotNil(attributes, "no reward attribution event emitted")
+			s.Require().Equal(tc.expConsumerId(), attributes[providertypes.AttributeConsumerId])
+			s.Require().Equal(packet.DestinationChannel, attributes[providertypes.AttributeReceivedChannelId])
+			s.Require().Equal(amount.String(), attributes[providertypes.AttributeRewardAmount])
+			s.Require().Equal(tc.expDecision.String(), attributes[providertypes.AttributeRewardAttributionDecision])
+
+			// verify the reward attribution log of the consumer chain
+			res, err := providerKeeper.QueryRewardAttributionLog(s.providerCtx(),
+				&providertypes.QueryRewardAttributionLogRequest{ConsumerId: consumerId})
+			s.Require().NoError(err)
+			if tc.expRecordedInLog {
+				s.Require().Len(res.Records, 1)
+				record := res.Records[0]
+				s.Require().Equal(tc.expDecision, record.Decision)
+				s.Require().Equal(packet.DestinationChannel, record.ChannelId)
+				s.Require().Equal(data.Sender, record.Sender)
+				s.Require().Equal(getIBCDenom(), record.Denom)
+				s.Require().Equal(amount.String(), record.Amount)
+				s.Require().Equal(s.providerCtx().BlockHeight(), record.Height)
+			} else {
+				s.Require().Empty(res.Records)
+			}
+
+			// verify the rewards allocation of the consumer chain
+			alloc, err := providerKeeper.GetConsumerRewardsAllocationByDenom(s.providerCtx(), consumerId, getIBCDenom())
+			s.Require().NoError(err)
+			if tc.expRewardAllocated {
+				s.Require().Equal(sdk.NewDecCoinsFromCoins(sdk.NewCoin(getIBCDenom(), amount)), alloc.Rewards)
+			} else {
+				s.Require().Empty(alloc.Rewards)
+			}
+		})
+	}
+}
+
 // TestAllocateTokens is a happy-path test of the consumer rewards pool allocation
 // to opted-in validators and the community pool.
 // @Long Description@
@@ -837,6 +1042,152 @@ func (s *CCVTestSuite) TestAllocateTokens() {
 	s.Require().Equal(lastCommPool.Add(totalRewardsDistributed...), getDistrAcctBalFn(providerCtx))
 }
 
+// TestRewardsDistributionWithValidatorBreakdown tests the distribution of ICS rewards that a consumer chain
+// breaks down by the consumer validators that generated them.
+// @Long Description@
+// * Set up IBC and transfer channels.
+// * Simulate a transfer of ICS rewards to the consumer rewards pool with a reward memo that attributes the rewards
+// to two consumer validators, and a transfer with a reward memo with a malformed breakdown.
+// * Begin a new block to cause the rewards to be distributed, and check that the attributed rewards are allocated
+// directly to the two validators, while the rewards of the malformed breakdown are distributed pro rata.
+func (s *CCVTestSuite) TestRewardsDistributionWithValidatorBreakdown() {
+	testCases := []struct {
+		name          string
+		amounts       []int64
+		expAttributed bool
+	}{
+		{
+			"two-validator breakdown",
+			[]int64{700, 300},
+			true,
+		},
+		{
+			"malformed breakdown, i.e., the amounts do not sum to the transferred amount",
+			[]int64{700, 200},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.SetupCCVChannel(s.path)
+			s.SetupTransferChannel()
+
+			providerKeeper := s.providerApp.GetProviderKeeper()
+			distributionKeeper := s.providerApp.GetTestDistributionKeeper()
+			consumerId := s.getFirstBundle().ConsumerId
+			amount := math.NewInt(1000)
+
+			// break the rewards down by the first two consumer validators
+			consumerValSet, err := providerKeeper.GetConsumerValSet(s.providerCtx(), consumerId)
+			s.Require().NoError(err)
+			s.Require().Greater(len(consumerValSet), 2)
+			breakdown := []ccv.ValidatorRewardShare{}
+			for i, shareAmount := range tc.amounts {
+				consumerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(*consumerValSet[i].PublicKey)
+				s.Require().NoError(err)
+				breakdown = append(breakdown, ccv.ValidatorRewardShare{
+					ConsumerConsAddr: consumerAddr.String(),
+					Amount:           math.NewInt(shareAmount).String(),
+				})
+			}
+			memo, err := ccv.CreateTransferMemoWithBreakdown(consumerId, s.getFirstBundle().Chain.ChainID, breakdown)
+			s.Require().NoError(err)
+
+			data := transfertypes.NewFungibleTokenPacketData(
+				sdk.DefaultBondDenom,
+				amount.String(),
+				authtypes.NewModuleAddress(consumertypes.ConsumerToSendToProviderName).String(),
+				providerKeeper.GetConsumerRewardsPoolAddressStr(s.providerCtx()),
+				memo,
+			)
+			packet := channeltypes.NewPacket(
+				data.GetBytes(),
+				uint64(1),
+				s.transferPath.EndpointA.ChannelConfig.PortID,
+				s.transferPath.EndpointA.ChannelID,
+				s.transferPath.EndpointB.ChannelConfig.PortID,
+				s.transferPath.EndpointB.ChannelID,
+				clienttypes.NewHeight(1, 100),
+				0,
+			)
+			ibcDenom := transfertypes.ParseDenomTrace(
+				transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, sdk.DefaultBondDenom),
+			).IBCDenom()
+			providerKeeper.SetConsumerRewardDenom(s.providerCtx(), ibcDenom)
+
+			// execute middleware OnRecvPacket logic
+			cbs, ok := s.providerChain.App.GetIBCKeeper().Router.GetRoute(transfertypes.ModuleName)
+			s.Require().True(ok)
+			ack := cbs.OnRecvPacket(s.providerCtx(), packet, sdk.AccAddress{})
+			s.Require().True(ack.Success())
+
+			// verify that the rewards are credited to the consumer chain and attributed only for a valid breakdown
+			alloc, err := providerKeeper.GetConsumerRewardsAllocationByDenom(s.providerCtx(), consumerId, ibcDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewDecCoinsFromCoins(sdk.NewCoin(ibcDenom, amount)), alloc.Rewards)
+			if tc.expAttributed {
+				s.Require().Len(alloc.ValidatorRewards, 2)
+				for i, validatorRewards := range alloc.ValidatorRewards {
+					s.Require().Equal(consumerValSet[i].ProviderConsAddr, validatorRewards.ProviderConsAddr)
+					s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(ibcDenom, math.NewInt(tc.amounts[i]))), validatorRewards.Rewards)
+				}
+			} else {
+				s.Require().Empty(alloc.ValidatorRewards)
+				res, err := providerKeeper.QueryRewardAttributionLog(s.providerCtx(),
+					&providertypes.QueryRewardAttributionLogRequest{ConsumerId: consumerId})
+				s.Require().NoError(err)
+				s.Require().Len(res.Records, 1)
+				s.Require().Equal(providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, res.Records[0].Decision)
+				s.Require().Contains(res.Records[0].Reason, "malformed per-validator breakdown")
+			}
+
+			// increase the block height so validators are eligible for consumer rewards (see `IsEligibleForConsumerRewards`)
+			numberOfBlocksToStartReceivingRewards := providerKeeper.GetNumberOfEpochsToStartReceivingRewards(
+				s.providerCtx()) * providerKeeper.GetBlocksPerEpoch(s.providerCtx())
+			providerCtx := s.providerCtx().WithBlockHeight(numberOfBlocksToStartReceivingRewards + s.providerCtx().BlockHeight())
+
+			// execute BeginBlock to trigger the token allocation
+			providerKeeper.BeginBlockRD(providerCtx)
+
+			alloc, err = providerKeeper.GetConsumerRewardsAllocationByDenom(providerCtx, consumerId, ibcDenom)
+			s.Require().NoError(err)
+			s.Require().Empty(alloc.ValidatorRewards)
+
+			// compute the expected validators token allocation by subtracting the community tax
+			communityTax, err := distributionKeeper.GetCommunityTax(providerCtx)
+			s.Require().NoError(err)
+			voteMultiplier := math.LegacyOneDec().Sub(communityTax)
+			validatorsRewards, _ := sdk.NewDecCoinsFromCoins(sdk.NewCoin(ibcDenom, amount)).MulDecTruncate(voteMultiplier).TruncateDecimal()
+			expRewards := make([]sdk.DecCoins, len(consumerValSet))
+			proRataRewards := sdk.NewDecCoinsFromCoins(validatorsRewards...)
+			if tc.expAttributed {
+				for i, shareAmount := range tc.amounts {
+					attributed, _ := sdk.NewDecCoins(sdk.NewDecCoin(ibcDenom, math.NewInt(shareAmount))).MulDecTruncate(voteMultiplier).TruncateDecimal()
+					expRewards[i] = sdk.NewDecCoinsFromCoins(attributed...)
+					proRataRewards = proRataRewards.Sub(expRewards[i])
+				}
+			}
+			// note that the validators have the same voting power to keep things simple
+			for i := range expRewards {
+				expRewards[i] = expRewards[i].Add(proRataRewards.QuoDec(math.LegacyNewDec(int64(len(consumerValSet))))...)
+			}
+
+			// verify the validator tokens allocation
+			for i, consumerVal := range consumerValSet {
+				val, err := s.providerApp.GetTestStakingKeeper().GetValidatorByConsAddr(providerCtx, consumerVal.ProviderConsAddr)
+				s.Require().NoError(err)
+				valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+				s.Require().NoError(err)
+				valRewards, err := distributionKeeper.GetValidatorOutstandingRewards(providerCtx, valAddr)
+				s.Require().NoError(err)
+				s.Require().Equal(expRewards[i], valRewards.Rewards, "validator %d", i)
+			}
+		})
+	}
+}
+
 // getEscrowBalance gets the current balances in the escrow account holding the transferred tokens to the provider
 func (s *CCVTestSuite) getEscrowBalance() sdk.Coins {
 	consumerBankKeeper := s.consumerApp.GetTestBankKeeper()
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestConsumerDormancy tests that no VSC packets are sent to a dormant consumer chain
+// and that the accumulated validator updates are sent as a single VSC packet once the chain resumes.
+// @Long Description@
+// * Set up a CCV channel and set the dormancy period on the provider.
+// * Bond tokens on the provider and send a VSC packet to the consumer without relaying it.
+// * Increment the time beyond the dormancy period and check that the consumer chain becomes dormant.
+// * Bond tokens on the provider over multiple epochs and check that no VSC packets are sent.
+// * Relay the first VSC packet and its acknowledgement and check that the consumer chain resumes.
+// * Check that a single catch-up VSC packet is sent at the next epoch and that, once it is relayed,
+// the consumer validator set matches the one computed on the provider.
+func (s *CCVTestSuite) TestConsumerDormancy() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	dormancyPeriod := time.Hour
+	params := providerKeeper.GetParams(s.providerCtx())
+	params.DormancyPeriod = dormancyPeriod
+	providerKeeper.SetParams(s.providerCtx(), params)
+
+	bondAmt := math.NewInt(1000000)
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+
+	// send a VSC packet to the consumer, but do not relay it
+	delegate(s, delAddr, bondAmt)
+	s.nextEpoch()
+	commitments := s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.providerCtx(), ccv.ProviderPortID, s.path.EndpointB.ChannelID)
+	s.Require().Len(commitments, 1)
+	s.Require().False(providerKeeper.IsConsumerDormant(s.providerCtx(), consumerId))
+
+	// increment the time beyond the dormancy period
+	incrementTime(s, dormancyPeriod+time.Minute)
+
+	// no VSC packets are sent to the dormant consumer chain
+	for i := 0; i < 2; i++ {
+		delegate(s, delAddr, bondAmt)
+		s.nextEpoch()
+		s.Require().True(providerKeeper.IsConsumerDormant(s.providerCtx(), consumerId))
+		s.Require().Empty(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId))
+		nextSeq, found := s.providerApp.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(
+			s.providerCtx(), ccv.ProviderPortID, s.path.EndpointB.ChannelID)
+		s.Require().True(found)
+		s.Require().Equal(commitments[0].Sequence+1, nextSeq)
+	}
+
+	chain, err := providerKeeper.GetConsumerChain(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	s.Require().True(chain.Dormant)
+
+	// relay the VSC packet and its acknowledgement, which resumes the consumer chain
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	s.Require().False(providerKeeper.IsConsumerDormant(s.providerCtx(), consumerId))
+
+	// the accumulated validator updates are sent as a single VSC packet
+	s.nextEpoch()
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	s.consumerChain.NextBlock()
+
+	// the consumer validator set matches the one computed on the provider
+	providerValSet, err := providerKeeper.GetConsumerValSet(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	consumerValSet := consumerKeeper.GetAllCCValidator(s.consumerCtx())
+	s.Require().Len(consumerValSet, len(providerValSet))
+	consumerPowers := map[string]int64{}
+	for _, val := range consumerValSet {
+		consumerPowers[string(val.Address)] = val.Power
+	}
+	for _, val := range providerValSet {
+		pubKey, err := cryptocodec.FromCmtProtoPublicKey(*val.PublicKey)
+		s.Require().NoError(err)
+		s.Require().Equal(val.Power, consumerPowers[string(pubKey.Address())])
+	}
+}
@@ -1,6 +1,11 @@
 package integration
 
 import (
+	gomath "math"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
 	"cosmossdk.io/math"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -234,7 +239,8 @@ func (s *CCVTestSuite) TestHandleConsumerDoubleVoting() {
 			// we remove the consumer key assigned to the validator otherwise
 			// HandleConsumerDoubleVoting uses the consumer key to verify the signature
 			if tc.ev.VoteA.ValidatorAddress.String() != consuVal.Address.String() {
-				s.providerApp.GetProviderKeeper().DeleteKeyAssignments(provCtx, s.getFirstBundle().ConsumerId)
+				_, _, err := s.providerApp.GetProviderKeeper().DeleteKeyAssignments(provCtx, s.getFirstBundle().ConsumerId, gomath.MaxInt64)
+				s.Require().NoError(err)
 			}
 
 			// convert validator public key
@@ -244,6 +250,7 @@ func (s *CCVTestSuite) TestHandleConsumerDoubleVoting() {
 			err = s.providerApp.GetProviderKeeper().HandleConsumerDoubleVoting(
 				provCtx,
 				tc.consumerId,
+				s.getFirstBundle().Chain.ChainID,
 				tc.ev,
 				pk,
 			)
@@ -404,6 +411,7 @@ func (s *CCVTestSuite) TestHandleConsumerDoubleVotingSlashesUndelegationsAndRele
 		err = s.providerApp.GetProviderKeeper().HandleConsumerDoubleVoting(
 			s.providerCtx(),
 			s.getFirstBundle().ConsumerId,
+			s.getFirstBundle().Chain.ChainID,
 			evidence,
 			pk,
 		)
@@ -426,3 +434,105 @@ func (s *CCVTestSuite) TestHandleConsumerDoubleVotingSlashesUndelegationsAndRele
 		s.Require().Equal(delegations.GetShares(), delShares.Add(redelShares).Sub(redelShares.Mul(slashFraction)))
 	})
 }
+
+// TestHandleConsumerDoubleVotingAfterRevisionUpgrade tests the handling of double voting evidence from a consumer chain
+// whose client was upgraded to a newer revision of the consumer chain, i.e., whose chain id changed from `{name}-1` to `{name}-2`.
+// @Long Description@
+// * Set up a CCV channel.
+// * Simulate a client upgrade of the consumer client to a newer revision of the consumer chain whose heights restart.
+// * Check that double voting evidence of the previous revision that is older than the equivocation evidence min height,
+// and double voting evidence of another chain are rejected.
+// * Check that valid double voting evidence of the previous revision is accepted and that the stored consumer chain id
+// is updated, while the equivocation evidence min height of the previous revision is kept.
+// * Check that valid double voting evidence of the new revision is accepted,
+// even though its height is smaller than the equivocation evidence min height of the previous revision.
+func (s *CCVTestSuite) TestHandleConsumerDoubleVotingAfterRevisionUpgrade() {
+	s.SetupCCVChannel(s.path)
+	// required to have the consumer client revision height greater than 0
+	s.SendEmptyVSCPacket()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	// create signing info for all validators
+	for _, v := range s.providerChain.Vals.Validators {
+		s.setDefaultValSigningInfo(*v)
+	}
+
+	// the evidence of the previous revision is not older than the equivocation evidence min height
+	providerKeeper.SetEquivocationEvidenceMinHeight(s.providerCtx(), consumerId, uint64(s.consumerCtx().BlockHeight()))
+
+	// simulate the upgrade of the consumer client from the first revision of the consumer chain to the next one;
+	// note that the chain ids of the test chains are not in the revision format
+	prevChainId := s.getFirstBundle().Chain.ChainID + "-1"
+	newChainId := s.getFirstBundle().Chain.ChainID + "-2"
+	providerKeeper.SetConsumerChainId(s.providerCtx(), consumerId, prevChainId)
+	clientState, ok := s.providerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.providerCtx(), s.path.EndpointB.ClientID)
+	s.Require().True(ok)
+	upgradedClientState := *clientState.(*ibctm.ClientState)
+	upgradedClientState.ChainId = newChainId
+	upgradedClientState.LatestHeight = clienttypes.NewHeight(2, 1)
+	s.providerApp.GetIBCKeeper().ClientKeeper.SetClientState(s.providerCtx(), s.path.EndpointB.ClientID, &upgradedClientState)
+
+	consuValSet, err := tmtypes.ValidatorSetFromProto(s.consumerChain.LastHeader.ValidatorSet)
+	s.Require().NoError(err)
+	consuVal := consuValSet.Validators[0]
+	consuSigner := s.consumerChain.Signers[consuVal.Address.String()]
+	pk, err := cryptocodec.FromCmtPubKeyInterface(consuVal.PubKey)
+	s.Require().NoError(err)
+
+	consuAddr := types.NewConsumerConsAddress(sdk.ConsAddress(consuVal.Address.Bytes()))
+	provAddr := providerKeeper.GetProviderAddrFromConsumerAddr(s.providerCtx(), consumerId, consuAddr)
+
+	blockID1 := testutil.MakeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := testutil.MakeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	// makeEvidence creates a duplicate vote evidence at the given height, signed with the given chain id
+	makeEvidence := func(height int64, chainId string) *tmtypes.DuplicateVoteEvidence {
+		return &tmtypes.DuplicateVoteEvidence{
+			VoteA:            testutil.MakeAndSignVote(blockID1, height, s.consumerCtx().BlockTime(), consuValSet, consuSigner, chainId),
+			VoteB:            testutil.MakeAndSignVote(blockID2, height, s.consumerCtx().BlockTime(), consuValSet, consuSigner, chainId),
+			ValidatorPower:   consuVal.VotingPower,
+			TotalVotingPower: consuVal.VotingPower,
+			Timestamp:        s.consumerCtx().BlockTime(),
+		}
+	}
+
+	minHeight := s.consumerCtx().BlockHeight()
+
+	// the evidence of the previous revision is older than its equivocation evidence min height
+	provCtx, _ := s.providerCtx().CacheContext()
+	err = providerKeeper.HandleConsumerDoubleVoting(provCtx, consumerId, prevChainId, makeEvidence(minHeight-1, prevChainId), pk)
+	s.Require().Error(err)
+	s.Require().False(s.providerApp.GetTestSlashingKeeper().IsTombstoned(provCtx, provAddr.ToSdkConsAddr()))
+
+	// the evidence is of another chain
+	otherChainId := "other-2"
+	provCtx, _ = s.providerCtx().CacheContext()
+	err = providerKeeper.HandleConsumerDoubleVoting(provCtx, consumerId, otherChainId, makeEvidence(minHeight, otherChainId), pk)
+	s.Require().Error(err)
+	s.Require().False(s.providerApp.GetTestSlashingKeeper().IsTombstoned(provCtx, provAddr.ToSdkConsAddr()))
+
+	// the evidence of the previous revision is still accepted after the upgrade
+	provCtx, _ = s.providerCtx().CacheContext()
+	err = providerKeeper.HandleConsumerDoubleVoting(provCtx, consumerId, prevChainId, makeEvidence(minHeight, prevChainId), pk)
+	s.Require().NoError(err)
+	s.Require().True(s.providerApp.GetTestStakingKeeper().IsValidatorJailed(provCtx, provAddr.ToSdkConsAddr()))
+	s.Require().True(s.providerApp.GetTestSlashingKeeper().IsTombstoned(provCtx, provAddr.ToSdkConsAddr()))
+
+	chainId, err := providerKeeper.GetConsumerChainId(provCtx, consumerId)
+	s.Require().NoError(err)
+	s.Require().Equal(newChainId, chainId)
+	s.Require().Equal(uint64(minHeight), providerKeeper.GetEquivocationEvidenceMinHeight(provCtx, consumerId))
+	minChainId, found := providerKeeper.GetEquivocationEvidenceMinChainId(provCtx, consumerId)
+	s.Require().True(found)
+	s.Require().Equal(prevChainId, minChainId)
+
+	// the evidence of the new revision is accepted even though its height is smaller than
+	// the equivocation evidence min height of the previous revision
+	provCtx = s.providerCtx()
+	err = providerKeeper.HandleConsumerDoubleVoting(provCtx, consumerId, newChainId, makeEvidence(1, newChainId), pk)
+	s.Require().NoError(err)
+	s.Require().True(s.providerApp.GetTestStakingKeeper().IsValidatorJailed(provCtx, provAddr.ToSdkConsAddr()))
+	s.Require().True(s.providerApp.GetTestSlashingKeeper().IsTombstoned(provCtx, provAddr.ToSdkConsAddr()))
+}
@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TestConsumerEconomicSecurity tests that the economic security of a consumer chain is recorded at every epoch
+// and that the records reflect the changes of the stake backing the consumer validator set.
+// @Long Description@
+// * Set up a CCV channel and move the provider forward by an epoch.
+// * Bond tokens on the provider and move the provider forward by two more epochs.
+// * Check that a record is added at every epoch and that the total power and tokens of the consumer
+// validator set increase by the bonded amount from the epoch following the delegation.
+// * Check that the records are returned by the query within a height range and that they are pruned
+// once they are older than the retention window.
+func (s *CCVTestSuite) TestConsumerEconomicSecurity() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	// the economic security of the consumer chain at the first epoch
+	s.nextEpoch()
+	records := providerKeeper.GetConsumerEconomicSecurity(s.providerCtx(), consumerId, 0, 0)
+	s.Require().NotEmpty(records)
+	first := records[len(records)-1]
+	s.Require().Equal(consumerId, first.ConsumerId)
+	s.Require().Positive(first.TotalPower)
+	s.Require().Equal(sdk.TokensFromConsensusPower(first.TotalPower, sdk.DefaultPowerReduction), first.TotalTokens)
+	s.Require().Equal(uint32(len(s.providerChain.Vals.Validators)), first.ValidatorCount)
+
+	// bond tokens on the provider in the middle of the second epoch
+	bondAmt := math.NewInt(3000000)
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	delegate(s, delAddr, bondAmt)
+	bondedPower := sdk.TokensToConsensusPower(bondAmt, sdk.DefaultPowerReduction)
+
+	s.nextEpoch()
+	s.nextEpoch()
+
+	records = providerKeeper.GetConsumerEconomicSecurity(s.providerCtx(), consumerId, first.Height, 0)
+	s.Require().Len(records, 3)
+	s.Require().Equal(first, records[0])
+	for _, record := range records[1:] {
+		s.Require().Greater(record.Height, first.Height)
+		s.Require().Equal(first.TotalPower+bondedPower, record.TotalPower)
+		s.Require().Equal(first.TotalTokens.Add(bondAmt), record.TotalTokens)
+		s.Require().Equal(first.ValidatorCount, record.ValidatorCount)
+	}
+	s.Require().Less(records[1].Height, records[2].Height)
+
+	// the query returns the records within the height range
+	resp, err := providerKeeper.QueryConsumerEconomicSecurity(s.providerCtx(),
+		&providertypes.QueryConsumerEconomicSecurityRequest{
+			ConsumerId:  consumerId,
+			StartHeight: records[1].Height,
+			EndHeight:   records[2].Height - 1,
+		})
+	s.Require().NoError(err)
+	s.Require().Equal(records[1:2], resp.Records)
+
+	// the records older than the retention window are pruned
+	params := providerKeeper.GetParams(s.providerCtx())
+	params.NumberOfEpochsToRetainEconomicSecurity = 1
+	providerKeeper.SetParams(s.providerCtx(), params)
+	s.nextEpoch()
+	records = providerKeeper.GetConsumerEconomicSecurity(s.providerCtx(), consumerId, 0, 0)
+	s.Require().Len(records, 1)
+	s.Require().Equal(first.TotalPower+bondedPower, records[0].TotalPower)
+}
@@ -139,14 +139,19 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	s.Require().NotEmpty(consumerPackets)
 	s.Require().Len(consumerPackets, 2, "unexpected number of pending data packets")
 
+	// use the latest vscID sent to the consumer, i.e., a vscID that is mapped to a height on the provider
+	vscIdToHeights := providerKeeper.GetAllVscIdToHeights(s.providerCtx(), s.getFirstBundle().ConsumerId)
+	s.Require().NotEmpty(vscIdToHeights)
+	vscId := vscIdToHeights[len(vscIdToHeights)-1].VscId
+
 	// try to send slash packet for downtime infraction
 	addr := ed25519.GenPrivKey().PubKey().Address()
 	val := abci.Validator{Address: addr, Power: 1}
-	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, 2, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, vscId, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 	// try to send slash packet for the same downtime infraction
-	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, 3, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, vscId, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 	// try to send slash packet for the double-sign infraction
-	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, 3, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, vscId, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
 
 	// check that the packets were added to the list of pending data packets
 	consumerPackets = consumerKeeper.GetPendingPackets(s.consumerCtx())
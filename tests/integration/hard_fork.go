@@ -0,0 +1,128 @@
+package integration
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestConsumerHardFork tests that a consumer chain that hard forks to a new chain ID
+// resumes receiving VSC packets over a new CCV channel.
+// @Long Description@
+// * Set up a CCV channel and relay a VSC packet to the consumer.
+// * Hard fork the consumer chain on the provider through its owner, i.e., governance for Top N chains,
+// and check that the CCV channel to the previous consumer chain is closed.
+// * Start the forked consumer chain from the exported consumer genesis state without the provider channel.
+// * Establish a new CCV channel with the forked consumer chain.
+// * Bond tokens on the provider, relay the VSC packet to the forked consumer chain, and check that
+// the consumer validator set of the forked consumer chain matches the one computed on the provider.
+func (s *CCVTestSuite) TestConsumerHardFork() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	bondAmt := math.NewInt(1000000)
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+
+	// relay a VSC packet to the consumer before the hard fork
+	delegate(s, delAddr, bondAmt)
+	s.nextEpoch()
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	s.consumerChain.NextBlock()
+
+	// the consumer chain is a Top N chain and hence the hard fork is approved by governance
+	providerKeeper.SetConsumerOwnerAddress(s.providerCtx(), consumerId, providerKeeper.GetAuthority())
+
+	// hard fork the consumer chain, i.e., the forked chain starts at height 2 of revision 1
+	newChainID := "testchain2-1"
+	initialHeight := clienttypes.NewHeight(1, 2)
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	resp, err := msgServer.ConsumerHardFork(s.providerCtx(), &providertypes.MsgConsumerHardFork{
+		ConsumerId:    consumerId,
+		Owner:         providerKeeper.GetAuthority(),
+		NewChainId:    newChainID,
+		InitialHeight: initialHeight,
+	})
+	s.Require().NoError(err)
+	s.coordinator.CommitBlock(s.providerChain)
+
+	chainId, err := providerKeeper.GetConsumerChainId(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	s.Require().Equal(newChainID, chainId)
+	s.Require().Equal(providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+
+	// the CCV channel to the previous consumer chain is closed
+	channel, found := s.providerApp.GetIBCKeeper().ChannelKeeper.GetChannel(
+		s.providerCtx(), ccv.ProviderPortID, s.path.EndpointB.ChannelID)
+	s.Require().True(found)
+	s.Require().Equal(channeltypes.CLOSED, channel.State)
+	_, found = providerKeeper.GetConsumerIdToChannelId(s.providerCtx(), consumerId)
+	s.Require().False(found)
+
+	// start the forked consumer chain
+	bundle := s.setupHardForkedConsumerCallback(&s.Suite, s.coordinator, consumerId, newChainID)
+	s.registerPacketSniffer(bundle.Chain)
+	s.Require().Equal(initialHeight.RevisionHeight, uint64(bundle.GetCtx().BlockHeight()))
+
+	bundle.Path = ibctesting.NewPath(bundle.Chain, s.providerChain)
+	bundle.Path.EndpointB.ClientID = resp.ClientId
+	// the forked consumer chain keeps its client to the provider, which is modeled by creating a client
+	// to the provider on the forked chain
+	err = bundle.Path.EndpointA.CreateClient()
+	s.Require().NoError(err)
+
+	// the forked consumer chain starts from the exported consumer genesis state,
+	// from which the provider channel is removed so that a new CCV channel is established
+	genesisState := consumerKeeper.ExportGenesis(s.consumerCtx())
+	genesisState.ProviderClientId = bundle.Path.EndpointA.ClientID
+	genesisState.ProviderChannelId = ""
+	s.Require().False(genesisState.NewChain)
+	s.NotPanics(func() {
+		bundle.GetKeeper().InitGenesis(bundle.GetCtx(), genesisState)
+	})
+
+	bundle.Path.EndpointA.ChannelConfig.PortID = ccv.ConsumerPortID
+	bundle.Path.EndpointB.ChannelConfig.PortID = ccv.ProviderPortID
+	bundle.Path.EndpointA.ChannelConfig.Version = ccv.Version
+	bundle.Path.EndpointB.ChannelConfig.Version = ccv.Version
+	bundle.Path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	bundle.Path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	s.coordinator.CommitBlock(bundle.Chain)
+
+	// establish a new CCV channel with the forked consumer chain
+	s.SetupCCVChannel(bundle.Path)
+	channelId, found := providerKeeper.GetConsumerIdToChannelId(s.providerCtx(), consumerId)
+	s.Require().True(found)
+	s.Require().Equal(bundle.Path.EndpointB.ChannelID, channelId)
+
+	// the VSC packets are sent to the forked consumer chain
+	delegate(s, delAddr, bondAmt)
+	s.nextEpoch()
+	relayAllCommittedPackets(s, s.providerChain, bundle.Path, ccv.ProviderPortID, bundle.Path.EndpointB.ChannelID, 1)
+	bundle.Chain.NextBlock()
+
+	// the consumer validator set of the forked consumer chain matches the one computed on the provider
+	providerValSet, err := providerKeeper.GetConsumerValSet(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	consumerValSet := bundle.GetKeeper().GetAllCCValidator(bundle.GetCtx())
+	s.Require().Len(consumerValSet, len(providerValSet))
+	consumerPowers := map[string]int64{}
+	for _, val := range consumerValSet {
+		consumerPowers[string(val.Address)] = val.Power
+	}
+	for _, val := range providerValSet {
+		pubKey, err := cryptocodec.FromCmtProtoPublicKey(*val.PublicKey)
+		s.Require().NoError(err)
+		s.Require().Equal(val.Power, consumerPowers[string(pubKey.Address())])
+	}
+}
@@ -10,6 +10,7 @@ import (
 	tmencoding "github.com/cometbft/cometbft/crypto/encoding"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
@@ -320,6 +321,83 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 	}
 }
 
+// TestKeyAssignmentInLaunchBlock tests that a key assigned in the same block in which the consumer chain launches
+// is taken into account.
+// @Long Description@
+// The consumer genesis is created when the consumer chain launches in BeginBlock (see BeginBlockLaunchConsumers),
+// i.e., before any MsgAssignConsumerKey of the same block is executed. The test launches a consumer chain,
+// assigns a consumer key in the same block and checks that the consumer genesis contains the previous key,
+// while the first VSCPacket queued for the consumer chain replaces the previous key with the assigned key.
+func (s *CCVTestSuite) TestKeyAssignmentInLaunchBlock() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	ctx := s.providerCtx()
+
+	// register and initialize a consumer chain that is due to launch in the current block
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "launch-block-consumer")
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime()
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	s.Require().NoError(err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters())
+	s.Require().NoError(err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime)
+	s.Require().NoError(err)
+
+	// opt in all validators
+	lastVals, err := providerKeeper.GetLastBondedValidators(ctx)
+	s.Require().NoError(err)
+	for _, v := range lastVals {
+		consAddr, err := v.GetConsAddr()
+		s.Require().NoError(err)
+		providerKeeper.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+	}
+
+	// launch the consumer chain as in BeginBlock; note that the staking module
+	// tracks the historical info of the block before the provider launches consumers
+	err = s.providerApp.GetTestStakingKeeper().TrackHistoricalInfo(ctx)
+	s.Require().NoError(err)
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// assign a consumer key in the same block, i.e., after BeginBlock
+	validator, consumerKey := generateNewConsumerKey(s, 0)
+	err = providerKeeper.AssignConsumerKey(ctx, consumerId, validator, consumerKey)
+	s.Require().NoError(err)
+	providerKey, err := validator.CmtConsPublicKey()
+	s.Require().NoError(err)
+
+	// the consumer genesis contains the provider key of the validator
+	genesis, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	s.Require().True(found)
+	inGenesis := false
+	for _, update := range genesis.Provider.InitialValSet {
+		s.Require().False(update.PubKey.Equal(consumerKey))
+		if update.PubKey.Equal(providerKey) {
+			inGenesis = true
+		}
+	}
+	s.Require().True(inGenesis)
+
+	// the first VSCPacket replaces the provider key with the assigned consumer key
+	s.nextEpoch()
+	pendingPackets := providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId)
+	s.Require().Len(pendingPackets, 1)
+	addsConsumerKey, removesProviderKey := false, false
+	for _, update := range pendingPackets[0].ValidatorUpdates {
+		if update.PubKey.Equal(consumerKey) && update.Power > 0 {
+			addsConsumerKey = true
+		}
+		if update.PubKey.Equal(providerKey) && update.Power == 0 {
+			removesProviderKey = true
+		}
+	}
+	s.Require().True(addsConsumerKey)
+	s.Require().True(removesProviderKey)
+}
+
 // generateNewConsumerKey generate new consumer key for the validator with valIndex
 func generateNewConsumerKey(s *CCVTestSuite, valIndex int) (stakingtypes.Validator, tmprotocrypto.PublicKey) {
 	// get validator
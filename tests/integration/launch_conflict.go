@@ -0,0 +1,115 @@
+package integration
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TestConsumerLaunchConflict tests that only the first of two consumer chains with the same chain id is launched.
+// @Long Description@
+// * Register and initialize two consumer chains with the same chain id that are due to launch in the current block,
+// and check that the chain id conflict is flagged before the launch.
+// * Launch the consumer chains as in BeginBlock and check that only the first consumer chain is launched,
+// while the second one stays initialized and a consumer_launch_conflict event is emitted.
+// * Check that the second consumer chain is still not launched in the next block.
+// * Remove the second consumer chain through its owner and check that the conflict is resolved.
+func (s *CCVTestSuite) TestConsumerLaunchConflict() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	ctx := s.providerCtx()
+
+	lastVals, err := providerKeeper.GetLastBondedValidators(ctx)
+	s.Require().NoError(err)
+
+	// register and initialize two consumer chains with the same chain id
+	chainId := "competing-consumer"
+	owner := s.providerChain.SenderAccount.GetAddress().String()
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime()
+	consumerIds := []string{}
+	for i := 0; i < 2; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, chainId)
+		providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, owner)
+		err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+		s.Require().NoError(err)
+		err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters())
+		s.Require().NoError(err)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
+		err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime)
+		s.Require().NoError(err)
+
+		// opt in all validators
+		for _, v := range lastVals {
+			consAddr, err := v.GetConsAddr()
+			s.Require().NoError(err)
+			providerKeeper.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	// the conflict is flagged before the launch
+	res, err := providerKeeper.QueryConsumerChainIdConflicts(ctx, &types.QueryConsumerChainIdConflictsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ConsumerChainIdConflict{{ChainId: chainId, ConsumerIds: consumerIds}}, res.Conflicts)
+
+	// launch the consumer chains as in BeginBlock
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = s.providerApp.GetTestStakingKeeper().TrackHistoricalInfo(ctx)
+	s.Require().NoError(err)
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	s.Require().NoError(err)
+
+	// only the first consumer chain is launched
+	s.Require().Equal(types.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerIds[0]))
+	_, found := providerKeeper.GetConsumerClientId(ctx, consumerIds[0])
+	s.Require().True(found)
+	s.Require().Equal(types.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerIds[1]))
+	_, found = providerKeeper.GetConsumerClientId(ctx, consumerIds[1])
+	s.Require().False(found)
+	s.requireLaunchConflictEvent(ctx.EventManager().Events(), consumerIds[1], consumerIds[0])
+
+	res, err = providerKeeper.QueryConsumerChainIdConflicts(ctx, &types.QueryConsumerChainIdConflictsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ConsumerChainIdConflict{
+		{ChainId: chainId, ConsumerIds: consumerIds, LaunchedConsumerId: consumerIds[0]},
+	}, res.Conflicts)
+
+	// the second consumer chain is not launched in the next block either
+	s.providerChain.NextBlock()
+	ctx = s.providerCtx().WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(types.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerIds[1]))
+	s.requireLaunchConflictEvent(ctx.EventManager().Events(), consumerIds[1], consumerIds[0])
+
+	// the owner removes the second consumer chain
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	_, err = msgServer.RemoveConsumer(ctx, &types.MsgRemoveConsumer{ConsumerId: consumerIds[1], Owner: owner})
+	s.Require().NoError(err)
+	s.Require().Equal(types.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, consumerIds[1]))
+
+	res, err = providerKeeper.QueryConsumerChainIdConflicts(ctx, &types.QueryConsumerChainIdConflictsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Conflicts)
+}
+
+// requireLaunchConflictEvent checks that the events contain a single consumer_launch_conflict event
+// for the consumer chain with `consumerId` that conflicts with the launched consumer chain with `conflictingConsumerId`
+func (s *CCVTestSuite) requireLaunchConflictEvent(events sdk.Events, consumerId, conflictingConsumerId string) {
+	conflictEvents := sdk.Events{}
+	for _, event := range events {
+		if event.Type == types.EventTypeConsumerLaunchConflict {
+			conflictEvents = append(conflictEvents, event)
+		}
+	}
+	s.Require().Len(conflictEvents, 1)
+	attr, found := conflictEvents[0].GetAttribute(types.AttributeConsumerId)
+	s.Require().True(found)
+	s.Require().Equal(consumerId, attr.Value)
+	attr, found = conflictEvents[0].GetAttribute(types.AttributeConflictingConsumerId)
+	s.Require().True(found)
+	s.Require().Equal(conflictingConsumerId, attr.Value)
+}
@@ -1,18 +1,25 @@
 package integration
 
 import (
+	"strconv"
 	"time"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	testutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 // TestHandleConsumerMisbehaviour tests the handling of consumer misbehavior.
@@ -67,9 +74,21 @@ func (s *CCVTestSuite) TestHandleConsumerMisbehaviour() {
 	validator, _ := s.getValByIdx(0)
 	initialTokens := math.LegacyNewDecFromInt(validator.GetTokens())
 
-	err := s.providerApp.GetProviderKeeper().HandleConsumerMisbehaviour(s.providerCtx(), s.getFirstBundle().ConsumerId, *misb)
+	ctx := s.providerCtx()
+	err := s.providerApp.GetProviderKeeper().HandleConsumerMisbehaviour(ctx, s.getFirstBundle().ConsumerId, *misb)
 	s.NoError(err)
 
+	// verify that a consumer_validator_jailed event is emitted for every validator
+	jailedEvents := map[string]sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeConsumerValidatorJailed {
+			attr, found := event.GetAttribute(types.AttributeConsumerValidatorAddress)
+			s.Require().True(found)
+			jailedEvents[attr.Value] = event
+		}
+	}
+	s.Require().Len(jailedEvents, len(clientTMValset.Validators))
+
 	// verify that validators are jailed, tombstoned, and slashed
 	for _, v := range clientTMValset.Validators {
 		consuAddr := sdk.ConsAddress(v.Address.Bytes())
@@ -79,6 +98,23 @@ func (s *CCVTestSuite) TestHandleConsumerMisbehaviour() {
 		s.Require().True(val.Jailed)
 		s.Require().True(s.providerApp.GetTestSlashingKeeper().IsTombstoned(s.providerCtx(), provAddr.ToSdkConsAddr()))
 
+		consumerAddr := types.NewConsumerConsAddress(consuAddr)
+		event, found := jailedEvents[consumerAddr.String()]
+		s.Require().True(found)
+		expectedAttributes := map[string]string{
+			types.AttributeConsumerId:               s.getFirstBundle().ConsumerId,
+			types.AttributeConsumerChainId:          s.getFirstBundle().Chain.ChainID,
+			ccv.AttributeInfractionType:             stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN.String(),
+			types.AttributeConsumerInfractionHeight: strconv.FormatUint(clientHeight.RevisionHeight+1, 10),
+			types.AttributeProviderInfractionHeight: strconv.FormatInt(ctx.BlockHeight(), 10),
+			types.AttributeProviderValidatorAddress: provAddr.String(),
+		}
+		for key, value := range expectedAttributes {
+			attr, found := event.GetAttribute(key)
+			s.Require().True(found, key)
+			s.Require().Equal(value, attr.Value, key)
+		}
+
 		validator, _ := s.providerApp.GetTestStakingKeeper().GetValidator(s.providerCtx(), provAddr.ToSdkConsAddr().Bytes())
 		slashFraction, err := s.providerApp.GetTestSlashingKeeper().SlashFractionDoubleSign(s.providerCtx())
 		s.Require().NoError(err)
@@ -593,3 +629,214 @@ func (s *CCVTestSuite) TestCheckMisbehaviour() {
 		})
 	}
 }
+
+// TestCheckMisbehaviourGasConsumption tests that checking a consumer misbehaviour consumes gas
+// proportional to the validator sets of its headers and that cheap rejections consume minimal gas.
+// @Long Description@
+// * Set up a CCV channel and create two valid misbehaviours whose conflicting headers have validator sets of different sizes.
+// * Check that the gas consumed by checking the misbehaviours differs by the gas charged per signature
+// for the difference of the validator set sizes.
+// * Check that misbehaviours for an unknown consumer id, older than the min equivocation evidence height
+// or for a frozen client are rejected without consuming the gas charged for verifying the headers.
+func (s *CCVTestSuite) TestCheckMisbehaviourGasConsumption() {
+	s.SetupCCVChannel(s.path)
+	// required to have the consumer client revision height greater than 0
+	s.SendEmptyVSCPacket()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	headerTs := s.providerCtx().BlockTime().Add(time.Minute)
+	clientHeight := s.consumerChain.LastHeader.TrustedHeight
+	clientTMValset := tmtypes.NewValidatorSet(s.consumerChain.Vals.Validators)
+	clientSigners := s.consumerChain.Signers
+
+	clientHeader := s.consumerChain.CreateTMClientHeader(
+		s.getFirstBundle().Chain.ChainID,
+		int64(clientHeight.RevisionHeight+1),
+		clientHeight,
+		headerTs,
+		clientTMValset,
+		clientTMValset,
+		clientTMValset,
+		clientSigners,
+	)
+
+	// create a conflicting header signed by the whole validator set
+	conflictingHeader := s.consumerChain.CreateTMClientHeader(
+		s.getFirstBundle().Chain.ChainID,
+		int64(clientHeight.RevisionHeight+1),
+		clientHeight,
+		// use a different block time to change the header BlockID
+		headerTs.Add(time.Hour),
+		clientTMValset,
+		clientTMValset,
+		clientTMValset,
+		clientSigners,
+	)
+
+	// create a conflicting header signed by an alternative validator set
+	// with more than 1/3 of the trusted validator set
+	altValset := tmtypes.NewValidatorSet(s.consumerChain.Vals.Validators[0:2])
+	altSigners := make(map[string]tmtypes.PrivValidator, 2)
+	altSigners[clientTMValset.Validators[0].Address.String()] = clientSigners[clientTMValset.Validators[0].Address.String()]
+	altSigners[clientTMValset.Validators[1].Address.String()] = clientSigners[clientTMValset.Validators[1].Address.String()]
+	conflictingHeaderWithAltValset := s.consumerChain.CreateTMClientHeader(
+		s.getFirstBundle().Chain.ChainID,
+		int64(clientHeight.RevisionHeight+1),
+		clientHeight,
+		headerTs,
+		altValset,
+		altValset,
+		clientTMValset,
+		altSigners,
+	)
+
+	misbehaviour := ibctmtypes.Misbehaviour{
+		ClientId: s.path.EndpointA.ClientID,
+		Header1:  clientHeader,
+		Header2:  conflictingHeader,
+	}
+	misbehaviourWithAltValset := ibctmtypes.Misbehaviour{
+		ClientId: s.path.EndpointA.ClientID,
+		Header1:  clientHeader,
+		Header2:  conflictingHeaderWithAltValset,
+	}
+
+	// checkMisbehaviour checks the given misbehaviour and returns the consumed gas
+	checkMisbehaviour := func(consumerId string, misbehaviour ibctmtypes.Misbehaviour) (storetypes.Gas, error) {
+		ctx := s.providerCtx().WithGasMeter(storetypes.NewInfiniteGasMeter())
+		err := providerKeeper.CheckMisbehaviour(ctx, consumerId, misbehaviour)
+		return ctx.GasMeter().GasConsumed(), err
+	}
+
+	// the gas consumed scales with the validator sets of the headers
+	gas, err := checkMisbehaviour(consumerId, misbehaviour)
+	s.Require().NoError(err)
+	numValidators := 4 * len(clientTMValset.Validators)
+	s.Require().GreaterOrEqual(gas, uint64(types.MisbehaviourVerificationBaseGas+numValidators*types.MisbehaviourVerificationGasPerSignature))
+
+	gasWithAltValset, err := checkMisbehaviour(consumerId, misbehaviourWithAltValset)
+	s.Require().NoError(err)
+	valsetSizeDiff := len(clientTMValset.Validators) - len(altValset.Validators)
+	s.Require().Equal(uint64(valsetSizeDiff*types.MisbehaviourVerificationGasPerSignature), gas-gasWithAltValset)
+
+	// cheap rejections do not consume the gas charged for verifying the headers
+	gas, err = checkMisbehaviour("1000", misbehaviour)
+	s.Require().Error(err)
+	s.Require().Less(gas, uint64(types.MisbehaviourVerificationBaseGas))
+
+	providerKeeper.SetEquivocationEvidenceMinHeight(s.providerCtx(), consumerId, clientHeight.RevisionHeight+2)
+	gas, err = checkMisbehaviour(consumerId, misbehaviour)
+	s.Require().Error(err)
+	s.Require().Less(gas, uint64(types.MisbehaviourVerificationBaseGas))
+	providerKeeper.SetEquivocationEvidenceMinHeight(s.providerCtx(), consumerId, 0)
+
+	clientState, ok := s.providerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.providerCtx(), s.path.EndpointA.ClientID)
+	s.Require().True(ok)
+	frozenClientState := *clientState.(*ibctmtypes.ClientState)
+	frozenClientState.FrozenHeight = ibctmtypes.FrozenHeight
+	s.providerApp.GetIBCKeeper().ClientKeeper.SetClientState(s.providerCtx(), s.path.EndpointA.ClientID, &frozenClientState)
+	gas, err = checkMisbehaviour(consumerId, misbehaviour)
+	s.Require().ErrorIs(err, clienttypes.ErrClientFrozen)
+	s.Require().Less(gas, uint64(types.MisbehaviourVerificationBaseGas))
+}
+
+// TestConsumerEvidenceSubmissionPaused tests that the submission of equivocation evidence for a consumer chain
+// can be paused and resumed by governance and that pausing does not affect the handling of downtime slash packets.
+// @Long Description@
+// * Set up a CCV channel and construct a Misbehaviour object with two conflicting headers.
+// * Pause the submission of equivocation evidence for the consumer chain through governance.
+// * Check that the misbehaviour is rejected while paused and that no validator is tombstoned.
+// * Check that a downtime slash packet from the consumer chain is still handled, i.e., the validator is jailed.
+// * Resume the submission of equivocation evidence and check that the misbehaviour is accepted.
+func (s *CCVTestSuite) TestConsumerEvidenceSubmissionPaused() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+	// required to have the consumer client revision height greater than 0
+	s.SendEmptyVSCPacket()
+
+	for _, v := range s.providerChain.Vals.Validators {
+		s.setDefaultValSigningInfo(*v)
+	}
+
+	altTime := s.providerCtx().BlockTime().Add(time.Minute)
+	clientHeight := s.consumerChain.LastHeader.TrustedHeight
+	clientTMValset := tmtypes.NewValidatorSet(s.consumerChain.Vals.Validators)
+	clientSigners := s.consumerChain.Signers
+
+	misb := &ibctmtypes.Misbehaviour{
+		ClientId: s.path.EndpointA.ClientID,
+		Header1: s.consumerChain.CreateTMClientHeader(
+			s.getFirstBundle().Chain.ChainID,
+			int64(clientHeight.RevisionHeight+1),
+			clientHeight,
+			altTime,
+			clientTMValset,
+			clientTMValset,
+			clientTMValset,
+			clientSigners,
+		),
+		Header2: s.consumerChain.CreateTMClientHeader(
+			s.getFirstBundle().Chain.ChainID,
+			int64(clientHeight.RevisionHeight+1),
+			clientHeight,
+			altTime.Add(10*time.Second),
+			clientTMValset,
+			clientTMValset,
+			clientTMValset,
+			clientSigners,
+		),
+	}
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	_, err := msgServer.SetConsumerEvidenceSubmissionPaused(s.providerCtx(), &types.MsgSetConsumerEvidenceSubmissionPaused{
+		ConsumerId: consumerId,
+		Paused:     true,
+		Authority:  providerKeeper.GetAuthority(),
+	})
+	s.Require().NoError(err)
+
+	// the misbehaviour is rejected while the evidence submission is paused
+	_, err = msgServer.SubmitConsumerMisbehaviour(s.providerCtx(), &types.MsgSubmitConsumerMisbehaviour{
+		Submitter:    s.providerChain.SenderAccount.GetAddress().String(),
+		Misbehaviour: misb,
+		ConsumerId:   consumerId,
+	})
+	s.Require().ErrorIs(err, types.ErrEvidenceSubmissionPaused)
+	for _, v := range s.providerChain.Vals.Validators {
+		s.Require().False(s.providerApp.GetTestSlashingKeeper().IsTombstoned(s.providerCtx(), sdk.ConsAddress(v.Address)))
+	}
+
+	// downtime slash packets are still handled while the evidence submission is paused
+	tmVal := s.providerChain.Vals.Validators[0]
+	providerKeeper.HandleSlashPacket(s.providerCtx(), consumerId,
+		*ccv.NewSlashPacketData(
+			abci.Validator{Address: tmVal.Address, Power: 0},
+			uint64(0),
+			stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		),
+	)
+	s.Require().True(s.providerApp.GetTestStakingKeeper().IsValidatorJailed(s.providerCtx(), sdk.ConsAddress(tmVal.Address)))
+
+	// the misbehaviour is accepted once the evidence submission is resumed
+	_, err = msgServer.SetConsumerEvidenceSubmissionPaused(s.providerCtx(), &types.MsgSetConsumerEvidenceSubmissionPaused{
+		ConsumerId: consumerId,
+		Paused:     false,
+		Authority:  providerKeeper.GetAuthority(),
+	})
+	s.Require().NoError(err)
+	_, err = msgServer.SubmitConsumerMisbehaviour(s.providerCtx(), &types.MsgSubmitConsumerMisbehaviour{
+		Submitter:    s.providerChain.SenderAccount.GetAddress().String(),
+		Misbehaviour: misb,
+		ConsumerId:   consumerId,
+	})
+	s.Require().NoError(err)
+	for _, v := range clientTMValset.Validators {
+		provAddr := providerKeeper.GetProviderAddrFromConsumerAddr(s.providerCtx(), consumerId,
+			types.NewConsumerConsAddress(sdk.ConsAddress(v.Address.Bytes())))
+		s.Require().True(s.providerApp.GetTestSlashingKeeper().IsTombstoned(s.providerCtx(), provAddr.ToSdkConsAddr()))
+	}
+}
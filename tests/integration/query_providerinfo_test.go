@@ -19,4 +19,5 @@ func (s *CCVTestSuite) TestQueryProviderInfo() {
 	s.Require().Equal(chainInfo.Consumer.ConnectionID, "connection-0")
 	s.Require().Equal(chainInfo.Provider.ChannelID, "channel-0")
 	s.Require().Equal(chainInfo.Consumer.ChannelID, "channel-0")
+	s.Require().Equal(chainInfo.ProviderFeePoolAddr, s.providerApp.GetProviderKeeper().GetConsumerRewardsPoolAddressStr(s.providerCtx()))
 }
@@ -40,6 +40,12 @@ type SetupConsumerCallback func(s *suite.Suite, coord *ibctesting.Coordinator, i
 	consumerBundle *icstestingutils.ConsumerBundle,
 )
 
+// Callback for instantiating a new consumer test chain and consumer app
+// that model the hard fork of an existing consumer chain to a new chain ID.
+type SetupHardForkedConsumerCallback func(s *suite.Suite, coord *ibctesting.Coordinator, consumerId, chainID string) (
+	consumerBundle *icstestingutils.ConsumerBundle,
+)
+
 // CCVTestSuite is an in-mem test suite which implements the standard group of tests validating
 // the integration functionality of ccv enabled chains.
 // Any method implemented for this struct will be ran when suite.Run() is called.
@@ -48,6 +54,8 @@ type CCVTestSuite struct {
 	coordinator           *ibctesting.Coordinator
 	setupProviderCallback SetupProviderCallback
 	setupConsumerCallback SetupConsumerCallback
+	// setupHardForkedConsumerCallback is not called in SetupTest
+	setupHardForkedConsumerCallback SetupHardForkedConsumerCallback
 
 	providerChain *ibctesting.TestChain
 	providerApp   testutil.ProviderApp
@@ -104,6 +112,15 @@ func NewCCVTestSuite[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 		return icstestingutils.AddConsumer[Tp, Tc](coordinator, s, index, consumerAppIniter)
 	}
 
+	ccvSuite.setupHardForkedConsumerCallback = func(
+		s *suite.Suite,
+		coordinator *ibctesting.Coordinator,
+		consumerId string,
+		chainID string,
+	) *icstestingutils.ConsumerBundle {
+		return icstestingutils.AddHardForkedConsumer[Tp, Tc](coordinator, s, consumerId, chainID, consumerAppIniter)
+	}
+
 	ccvSuite.skippedTests = make(map[string]bool)
 	for _, testName := range skippedTests {
 		ccvSuite.skippedTests[testName] = true
@@ -205,7 +205,11 @@ func (s *CCVTestSuite) TestSlashPacketAcknowledgement() {
 		1, s.path, clienttypes.Height{}, 0)
 
 	// Map infraction height on provider so validation passes and provider returns valid ack result
-	providerKeeper.SetValsetUpdateBlockHeight(s.providerCtx(), spd.ValsetUpdateId, 47923)
+	providerKeeper.SetVscIdToHeight(s.providerCtx(), s.getFirstBundle().ConsumerId, providertypes.VscIdToHeight{
+		VscId:     spd.ValsetUpdateId,
+		Height:    47923,
+		Timestamp: s.providerCtx().BlockTime(),
+	})
 
 	ackResult, err := providerKeeper.OnRecvSlashPacket(s.providerCtx(), packet, spd)
 	s.Require().NotNil(ackResult)
@@ -331,15 +335,17 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 
 	// Check ValidateSlashPacket
 	// Expect an error if a mapping of the infraction height cannot be found;
-	// just set the vscID of the slash packet to the latest mapped vscID +1
-	valsetUpdateBlockHeights := providerKeeper.GetAllValsetUpdateBlockHeights(ctx)
-	latestMappedValsetUpdateId := valsetUpdateBlockHeights[len(valsetUpdateBlockHeights)-1].ValsetUpdateId
-	slashPacketData.ValsetUpdateId = latestMappedValsetUpdateId + 1
+	// just set the vscID of the slash packet to the next vscID, which was not yet sent to the consumer chain
+	slashPacketData.ValsetUpdateId = providerKeeper.GetValidatorSetUpdateId(ctx)
 	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
 	suite.Require().Error(err, "ValidateSlashPacket should fail - no infraction height mapping")
 
-	// Restore slashPacketData to be valid
-	slashPacketData.ValsetUpdateId = latestMappedValsetUpdateId
+	// Restore slashPacketData to be valid, i.e., map its vscID to the current height
+	providerKeeper.SetVscIdToHeight(ctx, firstBundle.ConsumerId, providertypes.VscIdToHeight{
+		VscId:     slashPacketData.ValsetUpdateId,
+		Height:    uint64(ctx.BlockHeight()),
+		Timestamp: ctx.BlockTime(),
+	})
 
 	// Expect no error if validator does not exist
 	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
@@ -743,3 +749,71 @@ func handleEquivocationEvidence(ctx context.Context, k integration.ConsumerApp,
 	}
 	return evidenceKeeper.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// TestTombstonedValidatorRemovedFromConsumers tests that a tombstoned validator is removed from the validator sets
+// of all the consumer chains within one block, i.e., without waiting for the end of the epoch.
+// @Long Description@
+// * Set up CCV channels with all the consumer chains.
+// * Jail and tombstone a validator on the provider in the middle of an epoch.
+// * Check that no VSC packet is sent before the removals queued for the consumer chains are sent.
+// * Check that the consumer chains carrying the validator are returned by the provider.
+// * Commit one block on the provider and relay the VSC packets to each consumer chain.
+// * Check that the validator is removed from the validator set of each consumer chain and that,
+// once the VSC packets are acknowledged, no consumer chain carries the validator anymore.
+func (s *CCVTestSuite) TestTombstonedValidatorRemovedFromConsumers() {
+	s.SetupAllCCVChannels()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	providerStakingKeeper := s.providerApp.GetTestStakingKeeper()
+
+	tmVal := s.providerChain.Vals.Validators[0]
+	providerConsAddr := providertypes.NewProviderConsAddress(sdk.ConsAddress(tmVal.Address))
+	s.setDefaultValSigningInfo(*tmVal)
+
+	// the validator is tombstoned in the middle of an epoch
+	s.Require().Positive(providerKeeper.BlocksUntilNextEpoch(s.providerCtx()))
+	ctx := s.providerCtx()
+	providerKeeper.JailAndTombstoneValidator(ctx, providerConsAddr)
+	// the VSC packets are queued and sent at the end of the block; they are sent here
+	// explicitly and hence are parsed from the emitted events
+	s.Require().Empty(ParsePacketsFromEvents(ctx.EventManager().ABCIEvents()))
+	s.Require().NoError(providerKeeper.SendValidatorRemovals(ctx))
+	sentPackets := map[string]channeltypes.Packet{}
+	for _, packet := range ParsePacketsFromEvents(ctx.EventManager().ABCIEvents()) {
+		sentPackets[packet.SourceChannel] = packet
+	}
+	s.Require().Len(sentPackets, len(s.consumerBundles))
+
+	stakingVal, err := providerStakingKeeper.GetValidatorByConsAddr(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().NoError(err)
+	s.Require().True(stakingVal.Jailed)
+
+	// all the consumer chains carry the validator until they acknowledge its removal
+	carryingConsumerIds := providerKeeper.GetConsumerChainsCarryingValidator(s.providerCtx(), providerConsAddr)
+	s.Require().Len(carryingConsumerIds, len(s.consumerBundles))
+
+	// the VSC packets removing the validator are sent in the next block
+	s.providerChain.NextBlock()
+	s.Require().Positive(providerKeeper.BlocksUntilNextEpoch(s.providerCtx()))
+	for _, bundle := range s.consumerBundles {
+		// the validator uses its provider key on the consumer chains unless it assigned a consumer key
+		consumerConsAddr := providerConsAddr.ToSdkConsAddr()
+		if consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(s.providerCtx(), bundle.ConsumerId, providerConsAddr); found {
+			pubKey, err := cryptocodec.FromCmtProtoPublicKey(consumerKey)
+			s.Require().NoError(err)
+			consumerConsAddr = sdk.GetConsAddress(pubKey)
+		}
+		_, found := bundle.GetKeeper().GetCCValidator(bundle.GetCtx(), consumerConsAddr)
+		s.Require().True(found)
+
+		packet, found := sentPackets[bundle.Path.EndpointB.ChannelID]
+		s.Require().True(found, "no VSC packet sent to consumer %s", bundle.ConsumerId)
+		s.Require().NoError(bundle.Path.RelayPacket(packet))
+		bundle.Chain.NextBlock()
+
+		_, found = bundle.GetKeeper().GetCCValidator(bundle.GetCtx(), consumerConsAddr)
+		s.Require().False(found, "validator not removed from consumer %s", bundle.ConsumerId)
+	}
+
+	s.Require().Empty(providerKeeper.GetConsumerChainsCarryingValidator(s.providerCtx(), providerConsAddr))
+}
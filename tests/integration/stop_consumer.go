@@ -1,6 +1,8 @@
 package integration
 
 import (
+	"time"
+
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	"cosmossdk.io/math"
@@ -8,6 +10,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
@@ -145,6 +148,116 @@ func (s *CCVTestSuite) TestStopConsumerOnChannelClosed() {
 	// s.Require().False(found)
 }
 
+// TestStopConsumerWithPacketsInFlight tests that packets still in flight on the CCV channel
+// when a consumer chain is deleted can be timed out without errors.
+// @Long Description@
+// * Set up CCV channel and commit a VSC packet on the provider without relaying it to the consumer.
+// * Stop and delete the consumer chain.
+// * Increment the time past the timeout of the VSC packet and time the packet out.
+// * Check that the packet commitment is removed and that no state of the deleted consumer chain is recreated.
+func (s *CCVTestSuite) TestStopConsumerWithPacketsInFlight() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+	channelID := s.path.EndpointB.ChannelID
+
+	// use a short CCV timeout period so that the VSC packet times out quickly
+	ccvTimeoutPeriod := time.Hour
+	err := providerKeeper.SetConsumerTimeoutPeriods(s.providerCtx(), consumerId, types.ConsumerTimeoutPeriods{
+		CcvTimeoutPeriod:      ccvTimeoutPeriod,
+		TransferTimeoutPeriod: ccv.DefaultTransferTimeoutPeriod,
+	})
+	s.Require().NoError(err)
+
+	// commit a VSC packet on the provider that is not relayed to the consumer
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(1000000))
+	s.nextEpoch()
+	commitments := s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.providerCtx(), ccv.ProviderPortID, channelID)
+	s.Require().Len(commitments, 1)
+	packet, found := s.getSentPacket(s.providerChain, commitments[0].Sequence, channelID)
+	s.Require().True(found)
+
+	// stop and delete the consumer chain while the VSC packet is in flight
+	providerKeeper.SetConsumerPhase(s.providerCtx(), consumerId, types.CONSUMER_PHASE_STOPPED)
+	err = providerKeeper.DeleteConsumerChain(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	s.providerChain.NextBlock()
+	s.checkConsumerChainIsRemoved(consumerId, true)
+
+	// time out the VSC packet
+	incrementTime(s, ccvTimeoutPeriod+time.Minute)
+	err = s.path.EndpointB.TimeoutPacket(packet)
+	s.Require().NoError(err)
+
+	// the packet commitment is removed and the state of the deleted consumer chain is not recreated
+	commitments = s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.providerCtx(), ccv.ProviderPortID, channelID)
+	s.Require().Empty(commitments)
+	s.Require().Equal(types.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+	s.checkConsumerChainIsRemoved(consumerId, true)
+}
+
+// TestOptInAfterStop tests that validators cannot opt in to a consumer chain once it is stopped.
+// @Long Description@
+// * Set up CCV channel and record the opted-in validators of the consumer chain.
+// * Stop the consumer chain and opt in right after the stop.
+// * Check that the opt-in is rejected with an error naming the stopped phase and that no opt-in state is created.
+// * Delete the consumer chain and check that the existing opt-in state is removed and that opting in is still rejected.
+func (s *CCVTestSuite) TestOptInAfterStop() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+	s.SendEmptyVSCPacket()
+
+	// require validators to acknowledge terms, so that an accepted opt-in would record the acknowledged terms
+	metadata, err := providerKeeper.GetConsumerMetadata(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	metadata.TermsHash = "terms"
+	s.Require().NoError(providerKeeper.SetConsumerMetadata(s.providerCtx(), consumerId, metadata))
+
+	optedIn := providerKeeper.GetAllOptedIn(s.providerCtx(), consumerId)
+	s.Require().NotEmpty(optedIn)
+
+	// stop the consumer chain
+	err = providerKeeper.StopAndPrepareForConsumerRemoval(s.providerCtx(), consumerId,
+		types.CONSUMER_REMOVAL_REASON_OWNER_REQUEST, "")
+	s.Require().NoError(err)
+
+	// opt in right after the stop
+	tmValidator := s.providerChain.Vals.Validators[0]
+	valAddr := sdk.ValAddress(tmValidator.Address)
+	providerAddr := types.NewProviderConsAddress(sdk.ConsAddress(tmValidator.Address))
+	msgOptIn := types.MsgOptIn{
+		ProviderAddr:          valAddr.String(),
+		ConsumerId:            consumerId,
+		AcknowledgedTermsHash: "terms",
+		Signer:                s.providerChain.SenderAccount.GetAddress().String(),
+	}
+	_, err = msgServer.OptIn(s.providerCtx(), &msgOptIn)
+	s.Require().ErrorIs(err, types.ErrInvalidPhase)
+	s.Require().ErrorContains(err, types.CONSUMER_PHASE_STOPPED.String())
+
+	// no opt-in state is created, while the existing opt-in state is kept until the deletion
+	s.Require().ElementsMatch(optedIn, providerKeeper.GetAllOptedIn(s.providerCtx(), consumerId))
+	_, found := providerKeeper.GetAcknowledgedConsumerTerms(s.providerCtx(), consumerId, providerAddr)
+	s.Require().False(found)
+
+	// delete the consumer chain; its opt-in state is removed in EndBlock
+	err = providerKeeper.DeleteConsumerChain(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	s.providerChain.NextBlock()
+	s.Require().Empty(providerKeeper.GetAllOptedIn(s.providerCtx(), consumerId))
+
+	_, err = msgServer.OptIn(s.providerCtx(), &msgOptIn)
+	s.Require().ErrorIs(err, types.ErrInvalidPhase)
+	s.Require().ErrorContains(err, types.CONSUMER_PHASE_DELETED.String())
+	s.Require().Empty(providerKeeper.GetAllOptedIn(s.providerCtx(), consumerId))
+}
+
 func (s *CCVTestSuite) checkConsumerChainIsRemoved(consumerId string, checkChannel bool) {
 	channelID := s.path.EndpointB.ChannelID
 	providerKeeper := s.providerApp.GetProviderKeeper()
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 
 	"cosmossdk.io/math"
 
@@ -137,9 +138,11 @@ func (s *CCVTestSuite) TestBasicSlashPacketThrottling() {
 		s.Require().NoError(err)
 		s.Require().False(vals[2].IsJailed())
 
-		// Assert slash meter value is still the same
+		// Assert slash meter value is still negative, noting that the slash meter is replenished
+		// by the allowance accrued during the blocks committed since the first slash
 		slashMeter = s.providerApp.GetProviderKeeper().GetSlashMeter(s.providerCtx())
-		s.Require().Equal(tc.expectedMeterAfterFirstSlash, slashMeter.Int64())
+		s.Require().GreaterOrEqual(slashMeter.Int64(), tc.expectedMeterAfterFirstSlash)
+		s.Require().True(slashMeter.IsNegative())
 
 		// For the remainder of this test we use a cached context in which we can mutate block time
 		cacheCtx := s.providerCtx()
@@ -677,6 +680,94 @@ func (s *CCVTestSuite) TestSlashAllValidators() { //nolint:govet // this is a te
 	// "applying the validator changes would result in empty set".
 }
 
+// TestSlashMeterContinuousReplenishment tests that the slash meter drained by a burst of slash packets
+// recovers a little every block, instead of once per replenish period.
+// @Long Description@
+// * Set up all CCV channels and validator powers, and set the slash meter parameters.
+// * Receive a burst of slash packets in a single block and check that the first two validators are jailed,
+// while the slash packet for the third validator is bounced since the slash meter is negative.
+// * Commit blocks for two replenish periods and check that the slash meter is replenished in every block
+// by the allowance accrued during the block, and that at the end of every replenish period it was replenished
+// by the whole allowance.
+// * Check that the slash packet for the third validator is handled once the slash meter is not negative anymore.
+func (s *CCVTestSuite) TestSlashMeterContinuousReplenishment() {
+	s.SetupAllCCVChannels()
+
+	// Setup validator powers to be 25%, 25%, 25%, 25%
+	s.setupValidatorPowers([]int64{1000, 1000, 1000, 1000})
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	replenishPeriod := time.Minute
+	params := providerKeeper.GetParams(s.providerCtx())
+	params.SlashMeterReplenishFraction = "0.25"
+	params.SlashMeterReplenishPeriod = replenishPeriod
+	providerKeeper.SetParams(s.providerCtx(), params)
+	providerKeeper.InitializeSlashMeter(s.providerCtx())
+	s.Require().Equal(int64(1000), providerKeeper.GetSlashMeter(s.providerCtx()).Int64())
+
+	firstBundle := s.getFirstBundle()
+	var (
+		timeoutHeight    = clienttypes.Height{}
+		timeoutTimestamp = uint64(firstBundle.GetCtx().BlockTime().Add(ccvtypes.DefaultCCVTimeoutPeriod).UnixNano())
+	)
+	recvSlashPacket := func(tmVal tmtypes.Validator, ibcSeqNum uint64) ccvtypes.PacketAckResult {
+		data := s.constructSlashPacketFromConsumer(firstBundle, tmVal, stakingtypes.Infraction_INFRACTION_DOWNTIME, ibcSeqNum).GetData()
+		consumerPacketData, err := provider.UnmarshalConsumerPacketData(data) // Same func used by provider's OnRecvPacket
+		s.Require().NoError(err)
+		packet := s.newPacketFromConsumer(data, ibcSeqNum, firstBundle.Path, timeoutHeight, timeoutTimestamp)
+		ackResult, err := providerKeeper.OnRecvSlashPacket(s.providerCtx(), packet, *consumerPacketData.GetSlashPacketData())
+		s.Require().NoError(err)
+		return ackResult
+	}
+
+	// Receive a burst of slash packets for the first three validators in the same block
+	var valsToSlash []tmtypes.Validator
+	for _, val := range s.providerChain.Vals.Validators[:3] {
+		s.setDefaultValSigningInfo(*val)
+		valsToSlash = append(valsToSlash, *val)
+	}
+	s.Require().Equal(ccvtypes.SlashPacketHandledResult, recvSlashPacket(valsToSlash[0], 1))
+	s.Require().Equal(ccvtypes.SlashPacketHandledResult, recvSlashPacket(valsToSlash[1], 2))
+	s.Require().Equal(ccvtypes.SlashPacketBouncedResult, recvSlashPacket(valsToSlash[2], 3))
+	s.Require().Equal(int64(-1000), providerKeeper.GetSlashMeter(s.providerCtx()).Int64())
+
+	// Execute the block in which the slash packets were received
+	s.coordinator.CommitBlock(s.providerChain)
+	s.confirmValidatorJailed(valsToSlash[0], true)
+	s.confirmValidatorJailed(valsToSlash[1], true)
+	s.confirmValidatorNotJailed(valsToSlash[2], 1000)
+
+	// The allowance is 25% of the remaining total power
+	allowance := providerKeeper.GetSlashMeterAllowance(s.providerCtx())
+	s.Require().Equal(int64(500), allowance.Int64())
+	maxBlockReplenishment := allowance.MulRaw(int64(ibctesting.TimeIncrement)).QuoRaw(int64(replenishPeriod)).AddRaw(1)
+
+	// Commit blocks for two replenish periods
+	blocksPerPeriod := int(replenishPeriod / ibctesting.TimeIncrement)
+	meter := providerKeeper.GetSlashMeter(s.providerCtx())
+	replenishedMeter := meter
+	for i := 1; i <= 2*blocksPerPeriod; i++ {
+		s.coordinator.CommitBlock(s.providerChain)
+
+		// The slash meter is replenished in every block by the allowance accrued during the block
+		newMeter := providerKeeper.GetSlashMeter(s.providerCtx())
+		s.Require().True(newMeter.GT(meter))
+		s.Require().True(newMeter.Sub(meter).LTE(maxBlockReplenishment))
+		meter = newMeter
+
+		// At the end of every replenish period, the slash meter was replenished by the whole allowance
+		if i%blocksPerPeriod == 0 {
+			replenishedMeter = replenishedMeter.Add(allowance)
+			s.Require().Equal(replenishedMeter.Int64(), meter.Int64())
+		}
+	}
+	s.Require().True(meter.IsZero())
+
+	// The slash packet for the third validator is handled now
+	s.Require().Equal(ccvtypes.SlashPacketHandledResult, recvSlashPacket(valsToSlash[2], 4))
+	s.confirmValidatorJailed(valsToSlash[2], false)
+}
+
 func (s *CCVTestSuite) confirmValidatorJailed(tmVal tmtypes.Validator, checkPower bool) {
 	providerKeeper := s.providerApp.GetProviderKeeper()
 	sdkVal, err := s.providerApp.GetTestStakingKeeper().GetValidator(
@@ -506,6 +506,17 @@ func createConsumerGenesis(modelParams ModelParams, providerChain *ibctesting.Te
 		[]string{},
 		ccvtypes.DefaultRetryDelayPeriod,
 		"",
+		"",
+		nil,
+
+		ccvtypes.DefaultProviderClientExpiryWarningFraction,
+		ccvtypes.DefaultHaltOnProviderClientExpiry,
+		ccvtypes.DefaultProviderClientExpiryHaltDelay,
+		0,
+		"",
+		nil,
+		ccvtypes.DefaultExpectedProviderEpochDuration,
+		ccvtypes.DefaultValsetStalenessWarningEpochs,
 	)
 
 	return consumertypes.NewInitialGenesisState(consumerClientState, providerConsState, valUpdates, params)
@@ -19,6 +19,7 @@ import (
 	testutil "github.com/cosmos/interchain-security/v6/testutil/integration"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	consumerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/consumer/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 )
 
@@ -228,3 +229,51 @@ func AddConsumer[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 		TopN:       powerShapingParameters.Top_N,
 	}
 }
+
+// AddHardForkedConsumer adds a new consumer chain with `chainID` to the coordinator, which models the hard fork
+// of the consumer chain with `consumerId` to `chainID` (see MsgConsumerHardFork), and returns its consumer bundle.
+// The new consumer chain starts with the current consumer validator set of `consumerId` on the provider.
+// Note that the CCV module of the new consumer chain must still be initialized with a restart genesis state.
+//
+// This method must be called after AddProvider.
+func AddHardForkedConsumer[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
+	coordinator *ibctesting.Coordinator,
+	s *suite.Suite,
+	consumerId string,
+	chainID string,
+	appIniter ValSetAppIniter,
+) *ConsumerBundle {
+	providerChain := coordinator.Chains[provChainID]
+	providerApp := providerChain.App.(Tp)
+	providerKeeper := providerApp.GetProviderKeeper()
+
+	// use the current consumer validator set as the valset on the forked consumer
+	consumerValSet, err := providerKeeper.GetConsumerValSet(providerChain.GetContext(), consumerId)
+	s.Require().NoError(err)
+	valUpdates := providerkeeper.DiffValidators([]providertypes.ConsensusValidator{}, consumerValSet)
+	valz, err := tmtypes.PB2TM.ValidatorUpdates(valUpdates)
+	s.Require().NoError(err)
+
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(providerChain.GetContext(), consumerId)
+	s.Require().NoError(err)
+
+	// create and instantiate the forked consumer chain;
+	// note that the signers of assigned consumer keys are added to the provider chain
+	ibctesting.DefaultTestingAppInit = appIniter(valUpdates)
+	testChain := ibctesting.NewTestChainWithValSet(s.T(), coordinator, chainID,
+		tmtypes.NewValidatorSet(valz), providerChain.Signers)
+	coordinator.Chains[chainID] = testChain
+
+	consumerToReturn, ok := testChain.App.(Tc)
+	if !ok {
+		panic(fmt.Sprintf("consumer app type returned from app initer does not match app type passed in as type param: %T, %T",
+			testChain.App, *new(Tc)))
+	}
+
+	return &ConsumerBundle{
+		ConsumerId: consumerId,
+		Chain:      testChain,
+		App:        consumerToReturn,
+		TopN:       powerShapingParameters.Top_N,
+	}
+}
@@ -61,10 +61,18 @@ func TestDemocracyGovernanceWhitelisting(t *testing.T) {
 	runConsumerDemocracyTestByName(t, "TestDemocracyGovernanceWhitelisting")
 }
 
+func TestDemocracyGovernanceWhitelistUpdate(t *testing.T) {
+	runConsumerDemocracyTestByName(t, "TestDemocracyGovernanceWhitelistUpdate")
+}
+
 func TestDemocracyMsgUpdateParams(t *testing.T) {
 	runConsumerDemocracyTestByName(t, "TestDemocracyMsgUpdateParams")
 }
 
+func TestDemocracyRepresentativesQueries(t *testing.T) {
+	runConsumerDemocracyTestByName(t, "TestDemocracyRepresentativesQueries")
+}
+
 //
 // Distribution tests
 //
@@ -97,6 +105,22 @@ func TestConsumerPacketSendExpiredClient(t *testing.T) {
 	runCCVTestByName(t, "TestConsumerPacketSendExpiredClient")
 }
 
+func TestConsumerDormancy(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerDormancy")
+}
+
+func TestConsumerHardFork(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerHardFork")
+}
+
+//
+// Channel handshake tests
+//
+
+func TestConsumerUnbondingPeriodMismatch(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerUnbondingPeriodMismatch")
+}
+
 //
 // Normal operations tests
 //
@@ -145,6 +169,10 @@ func TestCISBeforeCCVEstablished(t *testing.T) {
 	runCCVTestByName(t, "TestCISBeforeCCVEstablished")
 }
 
+func TestTombstonedValidatorRemovedFromConsumers(t *testing.T) {
+	runCCVTestByName(t, "TestTombstonedValidatorRemovedFromConsumers")
+}
+
 //
 // Stop consumer tests
 //
@@ -157,6 +185,14 @@ func TestStopConsumerOnChannelClosed(t *testing.T) {
 	runCCVTestByName(t, "TestStopConsumerOnChannelClosed")
 }
 
+func TestStopConsumerWithPacketsInFlight(t *testing.T) {
+	runCCVTestByName(t, "TestStopConsumerWithPacketsInFlight")
+}
+
+func TestOptInAfterStop(t *testing.T) {
+	runCCVTestByName(t, "TestOptInAfterStop")
+}
+
 //
 // Throttle tests
 //
@@ -177,6 +213,10 @@ func TestDoubleSignDoesNotAffectThrottling(t *testing.T) {
 	runCCVTestByName(t, "TestDoubleSignDoesNotAffectThrottling")
 }
 
+func TestSlashMeterContinuousReplenishment(t *testing.T) {
+	runCCVTestByName(t, "TestSlashMeterContinuousReplenishment")
+}
+
 func TestSlashingSmallValidators(t *testing.T) {
 	runCCVTestByName(t, "TestSlashingSmallValidators")
 }
@@ -225,6 +265,10 @@ func TestHandleConsumerMisbehaviour(t *testing.T) {
 	runCCVTestByName(t, "TestHandleConsumerMisbehaviour")
 }
 
+func TestConsumerEvidenceSubmissionPaused(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerEvidenceSubmissionPaused")
+}
+
 func TestGetByzantineValidators(t *testing.T) {
 	runCCVTestByName(t, "TestGetByzantineValidators")
 }
@@ -233,6 +277,10 @@ func TestCheckMisbehaviour(t *testing.T) {
 	runCCVTestByName(t, "TestCheckMisbehaviour")
 }
 
+func TestCheckMisbehaviourGasConsumption(t *testing.T) {
+	runCCVTestByName(t, "TestCheckMisbehaviourGasConsumption")
+}
+
 //
 // Consumer Equivocation test
 //
@@ -241,6 +289,10 @@ func TestHandleConsumerDoubleVoting(t *testing.T) {
 	runCCVTestByName(t, "TestHandleConsumerDoubleVoting")
 }
 
+func TestHandleConsumerDoubleVotingAfterRevisionUpgrade(t *testing.T) {
+	runCCVTestByName(t, "TestHandleConsumerDoubleVotingAfterRevisionUpgrade")
+}
+
 func TestHandleConsumerDoubleVotingSlashesUndelegationsAndRelegations(t *testing.T) {
 	runCCVTestByName(t, "TestHandleConsumerDoubleVotingSlashesUndelegationsAndRelegations")
 }
@@ -257,6 +309,18 @@ func TestKeyAssignment(t *testing.T) {
 	runCCVTestByName(t, "TestKeyAssignment")
 }
 
+func TestKeyAssignmentInLaunchBlock(t *testing.T) {
+	runCCVTestByName(t, "TestKeyAssignmentInLaunchBlock")
+}
+
+func TestConsumerLaunchConflict(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerLaunchConflict")
+}
+
+func TestCreateConsumerThroughGovernance(t *testing.T) {
+	runCCVTestByName(t, "TestCreateConsumerThroughGovernance")
+}
+
 //
 // Provider gov hooks test
 //
@@ -273,10 +337,18 @@ func TestIBCTransferMiddleware(t *testing.T) {
 	runCCVTestByName(t, "TestIBCTransferMiddleware")
 }
 
+func TestRewardAttributionLog(t *testing.T) {
+	runCCVTestByName(t, "TestRewardAttributionLog")
+}
+
 func TestAllocateTokens(t *testing.T) {
 	runCCVTestByName(t, "TestAllocateTokens")
 }
 
+func TestRewardsDistributionWithValidatorBreakdown(t *testing.T) {
+	runCCVTestByName(t, "TestRewardsDistributionWithValidatorBreakdown")
+}
+
 func TestAllocateTokensToConsumerValidatorsWithDifferentValidatorHeights(t *testing.T) {
 	runCCVTestByName(t, "TestAllocateTokensToConsumerValidatorsWithDifferentValidatorHeights")
 }
@@ -292,3 +364,7 @@ func TestMultiConsumerRewardsDistribution(t *testing.T) {
 func TestTooManyLastValidators(t *testing.T) {
 	runCCVTestByName(t, "TestTooManyLastValidators")
 }
+
+func TestConsumerEconomicSecurity(t *testing.T) {
+	runCCVTestByName(t, "TestConsumerEconomicSecurity")
+}
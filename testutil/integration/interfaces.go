@@ -114,6 +114,7 @@ type TestStakingKeeper interface {
 	GetParams(ctx context.Context) (stakingtypes.Params, error)
 	SetParams(ctx context.Context, p stakingtypes.Params) error
 	ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates []abci.ValidatorUpdate, err error)
+	TrackHistoricalInfo(ctx context.Context) error
 }
 
 type TestBankKeeper interface {
@@ -216,9 +216,13 @@ func GetMocksForSlashValidator(
 // SetupMocksForLastBondedValidatorsExpectation sets up the expectation for the `GetBondedValidatorsByPower` and `MaxValidators` methods of the `mockStakingKeeper` object.
 // These are needed in particular when calling `GetLastBondedValidators` from the provider keeper.
 // Times is the number of times the expectation should be called. Provide -1 for `AnyTimes“.
+//
+// The `PowerReduction` method is also expected any number of times, as it is needed when the next consumer
+// validator sets are computed from the bonded validators (see RecordConsumerEconomicSecurity).
 func SetupMocksForLastBondedValidatorsExpectation(mockStakingKeeper *MockStakingKeeper, maxValidators uint32, vals []stakingtypes.Validator, times int) {
 	validatorsCall := mockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(vals, nil)
 	maxValidatorsCall := mockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(maxValidators, nil)
+	mockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
 
 	if times == -1 {
 		validatorsCall.AnyTimes()
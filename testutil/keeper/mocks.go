@@ -12,15 +12,17 @@ import (
 	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
 	types "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 	types0 "github.com/cometbft/cometbft/abci/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
-	types2 "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
-	types4 "github.com/cosmos/ibc-go/modules/capability/types"
-	types5 "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
-	types6 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
-	types7 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
-	types8 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types3 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types4 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types5 "github.com/cosmos/ibc-go/modules/capability/types"
+	types6 "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	types7 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	types8 "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	types9 "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	exported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -79,10 +81,10 @@ func (mr *MockStakingKeeperMockRecorder) BondedRatio(ctx interface{}) *gomock.Ca
 }
 
 // Delegation mocks base method.
-func (m *MockStakingKeeper) Delegation(ctx context.Context, addr types1.AccAddress, valAddr types1.ValAddress) (types3.DelegationI, error) {
+func (m *MockStakingKeeper) Delegation(ctx context.Context, addr types1.AccAddress, valAddr types1.ValAddress) (types4.DelegationI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delegation", ctx, addr, valAddr)
-	ret0, _ := ret[0].(types3.DelegationI)
+	ret0, _ := ret[0].(types4.DelegationI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -94,10 +96,10 @@ func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, addr, valAddr interface
 }
 
 // GetBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]types3.Validator, error) {
+func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
-	ret0, _ := ret[0].([]types3.Validator)
+	ret0, _ := ret[0].([]types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -139,10 +141,10 @@ func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator int
 }
 
 // GetRedelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetRedelegationByUnbondingID(ctx context.Context, id uint64) (types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegationByUnbondingID(ctx context.Context, id uint64) (types4.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegationByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.Redelegation)
+	ret0, _ := ret[0].(types4.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -154,10 +156,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegationByUnbondingID(ctx, id in
 }
 
 // GetRedelegationsFromSrcValidator mocks base method.
-func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types1.ValAddress) ([]types4.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegationsFromSrcValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.Redelegation)
+	ret0, _ := ret[0].([]types4.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -169,10 +171,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegationsFromSrcValidator(ctx, v
 }
 
 // GetUnbondingDelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegationByUnbondingID(ctx context.Context, id uint64) (types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegationByUnbondingID(ctx context.Context, id uint64) (types4.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegationByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.UnbondingDelegation)
+	ret0, _ := ret[0].(types4.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -184,10 +186,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegationByUnbondingID(ctx
 }
 
 // GetUnbondingDelegationsFromValidator mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types1.ValAddress) ([]types4.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegationsFromValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.UnbondingDelegation)
+	ret0, _ := ret[0].([]types4.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -199,10 +201,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegationsFromValidator(ct
 }
 
 // GetUnbondingType mocks base method.
-func (m *MockStakingKeeper) GetUnbondingType(ctx context.Context, id uint64) (types3.UnbondingType, error) {
+func (m *MockStakingKeeper) GetUnbondingType(ctx context.Context, id uint64) (types4.UnbondingType, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingType", ctx, id)
-	ret0, _ := ret[0].(types3.UnbondingType)
+	ret0, _ := ret[0].(types4.UnbondingType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -214,10 +216,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingType(ctx, id interface{}) *
 }
 
 // GetValidator mocks base method.
-func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -229,10 +231,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *go
 }
 
 // GetValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -244,10 +246,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr in
 }
 
 // GetValidatorByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetValidatorByUnbondingID(ctx context.Context, id uint64) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidatorByUnbondingID(ctx context.Context, id uint64) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -289,7 +291,7 @@ func (mr *MockStakingKeeperMockRecorder) IsValidatorJailed(ctx, addr interface{}
 }
 
 // IterateBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types3.ValidatorI) bool) error {
+func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types4.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateBondedValidatorsByPower", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -303,7 +305,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(arg0, ar
 }
 
 // IterateDelegations mocks base method.
-func (m *MockStakingKeeper) IterateDelegations(ctx context.Context, delegator types1.AccAddress, fn func(int64, types3.DelegationI) bool) error {
+func (m *MockStakingKeeper) IterateDelegations(ctx context.Context, delegator types1.AccAddress, fn func(int64, types4.DelegationI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateDelegations", ctx, delegator, fn)
 	ret0, _ := ret[0].(error)
@@ -331,7 +333,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateLastValidatorPowers(ctx, cb inte
 }
 
 // IterateValidators mocks base method.
-func (m *MockStakingKeeper) IterateValidators(ctx context.Context, f func(int64, types3.ValidatorI) bool) error {
+func (m *MockStakingKeeper) IterateValidators(ctx context.Context, f func(int64, types4.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateValidators", ctx, f)
 	ret0, _ := ret[0].(error)
@@ -432,7 +434,7 @@ func (mr *MockStakingKeeperMockRecorder) Slash(ctx, consAddr, infractionHeight,
 }
 
 // SlashRedelegation mocks base method.
-func (m *MockStakingKeeper) SlashRedelegation(ctx context.Context, srcValidator types3.Validator, redelegation types3.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
+func (m *MockStakingKeeper) SlashRedelegation(ctx context.Context, srcValidator types4.Validator, redelegation types4.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashRedelegation", ctx, srcValidator, redelegation, infractionHeight, slashFactor)
 	ret0, _ := ret[0].(math.Int)
@@ -447,7 +449,7 @@ func (mr *MockStakingKeeperMockRecorder) SlashRedelegation(ctx, srcValidator, re
 }
 
 // SlashUnbondingDelegation mocks base method.
-func (m *MockStakingKeeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation types3.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
+func (m *MockStakingKeeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation types4.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashUnbondingDelegation", ctx, unbondingDelegation, infractionHeight, slashFactor)
 	ret0, _ := ret[0].(math.Int)
@@ -462,7 +464,7 @@ func (mr *MockStakingKeeperMockRecorder) SlashUnbondingDelegation(ctx, unbonding
 }
 
 // SlashWithInfractionReason mocks base method.
-func (m *MockStakingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types1.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction types3.Infraction) (math.Int, error) {
+func (m *MockStakingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types1.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction types4.Infraction) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, infractionHeight, power, slashFactor, infraction)
 	ret0, _ := ret[0].(math.Int)
@@ -550,10 +552,10 @@ func (mr *MockStakingKeeperMockRecorder) Unjail(ctx, addr interface{}) *gomock.C
 }
 
 // Validator mocks base method.
-func (m *MockStakingKeeper) Validator(ctx context.Context, addr types1.ValAddress) (types3.ValidatorI, error) {
+func (m *MockStakingKeeper) Validator(ctx context.Context, addr types1.ValAddress) (types4.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validator", ctx, addr)
-	ret0, _ := ret[0].(types3.ValidatorI)
+	ret0, _ := ret[0].(types4.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -579,10 +581,10 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorAddressCodec() *gomock.Call {
 }
 
 // ValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types3.ValidatorI, error) {
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types4.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
-	ret0, _ := ret[0].(types3.ValidatorI)
+	ret0, _ := ret[0].(types4.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -631,11 +633,26 @@ func (mr *MockSlashingKeeperMockRecorder) DowntimeJailDuration(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DowntimeJailDuration), arg0)
 }
 
+// GetParams mocks base method.
+func (m *MockSlashingKeeper) GetParams(arg0 context.Context) (types3.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", arg0)
+	ret0, _ := ret[0].(types3.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockSlashingKeeperMockRecorder) GetParams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockSlashingKeeper)(nil).GetParams), arg0)
+}
+
 // GetValidatorSigningInfo mocks base method.
-func (m *MockSlashingKeeper) GetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress) (types2.ValidatorSigningInfo, error) {
+func (m *MockSlashingKeeper) GetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress) (types3.ValidatorSigningInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorSigningInfo", arg0, arg1)
-	ret0, _ := ret[0].(types2.ValidatorSigningInfo)
+	ret0, _ := ret[0].(types3.ValidatorSigningInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -674,8 +691,22 @@ func (mr *MockSlashingKeeperMockRecorder) JailUntil(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), arg0, arg1, arg2)
 }
 
+// SetParams mocks base method.
+func (m *MockSlashingKeeper) SetParams(arg0 context.Context, arg1 types3.Params) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParams", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParams indicates an expected call of SetParams.
+func (mr *MockSlashingKeeperMockRecorder) SetParams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParams", reflect.TypeOf((*MockSlashingKeeper)(nil).SetParams), arg0, arg1)
+}
+
 // SetValidatorSigningInfo mocks base method.
-func (m *MockSlashingKeeper) SetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress, arg2 types2.ValidatorSigningInfo) error {
+func (m *MockSlashingKeeper) SetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress, arg2 types3.ValidatorSigningInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorSigningInfo", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -756,7 +787,7 @@ func (m *MockChannelKeeper) EXPECT() *MockChannelKeeperMockRecorder {
 }
 
 // ChanCloseInit mocks base method.
-func (m *MockChannelKeeper) ChanCloseInit(ctx types1.Context, portID, channelID string, chanCap *types4.Capability) error {
+func (m *MockChannelKeeper) ChanCloseInit(ctx types1.Context, portID, channelID string, chanCap *types5.Capability) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ChanCloseInit", ctx, portID, channelID, chanCap)
 	ret0, _ := ret[0].(error)
@@ -769,11 +800,25 @@ func (mr *MockChannelKeeperMockRecorder) ChanCloseInit(ctx, portID, channelID, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChanCloseInit", reflect.TypeOf((*MockChannelKeeper)(nil).ChanCloseInit), ctx, portID, channelID, chanCap)
 }
 
+// GetAllChannelsWithPortPrefix mocks base method.
+func (m *MockChannelKeeper) GetAllChannelsWithPortPrefix(ctx types1.Context, portPrefix string) []types9.IdentifiedChannel {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllChannelsWithPortPrefix", ctx, portPrefix)
+	ret0, _ := ret[0].([]types9.IdentifiedChannel)
+	return ret0
+}
+
+// GetAllChannelsWithPortPrefix indicates an expected call of GetAllChannelsWithPortPrefix.
+func (mr *MockChannelKeeperMockRecorder) GetAllChannelsWithPortPrefix(ctx, portPrefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllChannelsWithPortPrefix", reflect.TypeOf((*MockChannelKeeper)(nil).GetAllChannelsWithPortPrefix), ctx, portPrefix)
+}
+
 // GetChannel mocks base method.
-func (m *MockChannelKeeper) GetChannel(ctx types1.Context, srcPort, srcChan string) (types8.Channel, bool) {
+func (m *MockChannelKeeper) GetChannel(ctx types1.Context, srcPort, srcChan string) (types9.Channel, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannel", ctx, srcPort, srcChan)
-	ret0, _ := ret[0].(types8.Channel)
+	ret0, _ := ret[0].(types9.Channel)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -816,7 +861,7 @@ func (mr *MockChannelKeeperMockRecorder) GetNextSequenceSend(ctx, portID, channe
 }
 
 // SendPacket mocks base method.
-func (m *MockChannelKeeper) SendPacket(ctx types1.Context, chanCap *types4.Capability, sourcePort, sourceChannel string, timeoutHeight types6.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+func (m *MockChannelKeeper) SendPacket(ctx types1.Context, chanCap *types5.Capability, sourcePort, sourceChannel string, timeoutHeight types7.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendPacket", ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	ret0, _ := ret[0].(uint64)
@@ -831,7 +876,7 @@ func (mr *MockChannelKeeperMockRecorder) SendPacket(ctx, chanCap, sourcePort, so
 }
 
 // WriteAcknowledgement mocks base method.
-func (m *MockChannelKeeper) WriteAcknowledgement(ctx types1.Context, chanCap *types4.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error {
+func (m *MockChannelKeeper) WriteAcknowledgement(ctx types1.Context, chanCap *types5.Capability, packet exported.PacketI, acknowledgement exported.Acknowledgement) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WriteAcknowledgement", ctx, chanCap, packet, acknowledgement)
 	ret0, _ := ret[0].(error)
@@ -868,10 +913,10 @@ func (m *MockPortKeeper) EXPECT() *MockPortKeeperMockRecorder {
 }
 
 // BindPort mocks base method.
-func (m *MockPortKeeper) BindPort(ctx types1.Context, portID string) *types4.Capability {
+func (m *MockPortKeeper) BindPort(ctx types1.Context, portID string) *types5.Capability {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BindPort", ctx, portID)
-	ret0, _ := ret[0].(*types4.Capability)
+	ret0, _ := ret[0].(*types5.Capability)
 	return ret0
 }
 
@@ -905,10 +950,10 @@ func (m *MockConnectionKeeper) EXPECT() *MockConnectionKeeperMockRecorder {
 }
 
 // GetConnection mocks base method.
-func (m *MockConnectionKeeper) GetConnection(ctx types1.Context, connectionID string) (types7.ConnectionEnd, bool) {
+func (m *MockConnectionKeeper) GetConnection(ctx types1.Context, connectionID string) (types8.ConnectionEnd, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetConnection", ctx, connectionID)
-	ret0, _ := ret[0].(types7.ConnectionEnd)
+	ret0, _ := ret[0].(types8.ConnectionEnd)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1067,7 +1112,7 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 }
 
 // AllocateTokensToValidator mocks base method.
-func (m *MockDistributionKeeper) AllocateTokensToValidator(ctx context.Context, validator types3.ValidatorI, reward types1.DecCoins) error {
+func (m *MockDistributionKeeper) AllocateTokensToValidator(ctx context.Context, validator types4.ValidatorI, reward types1.DecCoins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AllocateTokensToValidator", ctx, validator, reward)
 	ret0, _ := ret[0].(error)
@@ -1197,6 +1242,20 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// HasDenomMetaData mocks base method.
+func (m *MockBankKeeper) HasDenomMetaData(ctx context.Context, denom string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDenomMetaData", ctx, denom)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasDenomMetaData indicates an expected call of HasDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) HasDenomMetaData(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).HasDenomMetaData), ctx, denom)
+}
+
 // SendCoinsFromModuleToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
 	m.ctrl.T.Helper()
@@ -1211,6 +1270,18 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderMod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
 }
 
+// SetDenomMetaData mocks base method.
+func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types2.Metadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDenomMetaData", ctx, denomMetaData)
+}
+
+// SetDenomMetaData indicates an expected call of SetDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -1286,10 +1357,10 @@ func (m *MockIBCTransferKeeper) EXPECT() *MockIBCTransferKeeperMockRecorder {
 }
 
 // Transfer mocks base method.
-func (m *MockIBCTransferKeeper) Transfer(arg0 context.Context, arg1 *types5.MsgTransfer) (*types5.MsgTransferResponse, error) {
+func (m *MockIBCTransferKeeper) Transfer(arg0 context.Context, arg1 *types6.MsgTransfer) (*types6.MsgTransferResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Transfer", arg0, arg1)
-	ret0, _ := ret[0].(*types5.MsgTransferResponse)
+	ret0, _ := ret[0].(*types6.MsgTransferResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1324,10 +1395,10 @@ func (m *MockIBCCoreKeeper) EXPECT() *MockIBCCoreKeeperMockRecorder {
 }
 
 // ChannelOpenInit mocks base method.
-func (m *MockIBCCoreKeeper) ChannelOpenInit(goCtx context.Context, msg *types8.MsgChannelOpenInit) (*types8.MsgChannelOpenInitResponse, error) {
+func (m *MockIBCCoreKeeper) ChannelOpenInit(goCtx context.Context, msg *types9.MsgChannelOpenInit) (*types9.MsgChannelOpenInitResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ChannelOpenInit", goCtx, msg)
-	ret0, _ := ret[0].(*types8.MsgChannelOpenInitResponse)
+	ret0, _ := ret[0].(*types9.MsgChannelOpenInitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1362,7 +1433,7 @@ func (m *MockScopedKeeper) EXPECT() *MockScopedKeeperMockRecorder {
 }
 
 // AuthenticateCapability mocks base method.
-func (m *MockScopedKeeper) AuthenticateCapability(ctx types1.Context, cap *types4.Capability, name string) bool {
+func (m *MockScopedKeeper) AuthenticateCapability(ctx types1.Context, cap *types5.Capability, name string) bool {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AuthenticateCapability", ctx, cap, name)
 	ret0, _ := ret[0].(bool)
@@ -1376,7 +1447,7 @@ func (mr *MockScopedKeeperMockRecorder) AuthenticateCapability(ctx, cap, name in
 }
 
 // ClaimCapability mocks base method.
-func (m *MockScopedKeeper) ClaimCapability(ctx types1.Context, cap *types4.Capability, name string) error {
+func (m *MockScopedKeeper) ClaimCapability(ctx types1.Context, cap *types5.Capability, name string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ClaimCapability", ctx, cap, name)
 	ret0, _ := ret[0].(error)
@@ -1390,10 +1461,10 @@ func (mr *MockScopedKeeperMockRecorder) ClaimCapability(ctx, cap, name interface
 }
 
 // GetCapability mocks base method.
-func (m *MockScopedKeeper) GetCapability(ctx types1.Context, name string) (*types4.Capability, bool) {
+func (m *MockScopedKeeper) GetCapability(ctx types1.Context, name string) (*types5.Capability, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCapability", ctx, name)
-	ret0, _ := ret[0].(*types4.Capability)
+	ret0, _ := ret[0].(*types5.Capability)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -1403,3 +1474,79 @@ func (mr *MockScopedKeeperMockRecorder) GetCapability(ctx, name interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapability", reflect.TypeOf((*MockScopedKeeper)(nil).GetCapability), ctx, name)
 }
+
+// MockUpgradeKeeper is a mock of UpgradeKeeper interface.
+type MockUpgradeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgradeKeeperMockRecorder
+}
+
+// MockUpgradeKeeperMockRecorder is the mock recorder for MockUpgradeKeeper.
+type MockUpgradeKeeperMockRecorder struct {
+	mock *MockUpgradeKeeper
+}
+
+// NewMockUpgradeKeeper creates a new mock instance.
+func NewMockUpgradeKeeper(ctrl *gomock.Controller) *MockUpgradeKeeper {
+	mock := &MockUpgradeKeeper{ctrl: ctrl}
+	mock.recorder = &MockUpgradeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgradeKeeper) EXPECT() *MockUpgradeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetUpgradePlan mocks base method.
+func (m *MockUpgradeKeeper) GetUpgradePlan(ctx context.Context) (upgradetypes.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpgradePlan", ctx)
+	ret0, _ := ret[0].(upgradetypes.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpgradePlan indicates an expected call of GetUpgradePlan.
+func (mr *MockUpgradeKeeperMockRecorder) GetUpgradePlan(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpgradePlan", reflect.TypeOf((*MockUpgradeKeeper)(nil).GetUpgradePlan), ctx)
+}
+
+// MockTokenizedSharesKeeper is a mock of TokenizedSharesKeeper interface.
+type MockTokenizedSharesKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenizedSharesKeeperMockRecorder
+}
+
+// MockTokenizedSharesKeeperMockRecorder is the mock recorder for MockTokenizedSharesKeeper.
+type MockTokenizedSharesKeeperMockRecorder struct {
+	mock *MockTokenizedSharesKeeper
+}
+
+// NewMockTokenizedSharesKeeper creates a new mock instance.
+func NewMockTokenizedSharesKeeper(ctrl *gomock.Controller) *MockTokenizedSharesKeeper {
+	mock := &MockTokenizedSharesKeeper{ctrl: ctrl}
+	mock.recorder = &MockTokenizedSharesKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenizedSharesKeeper) EXPECT() *MockTokenizedSharesKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetValidatorTokenizedShareTokens mocks base method.
+func (m *MockTokenizedSharesKeeper) GetValidatorTokenizedShareTokens(ctx context.Context, valAddr types1.ValAddress) (math.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorTokenizedShareTokens", ctx, valAddr)
+	ret0, _ := ret[0].(math.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorTokenizedShareTokens indicates an expected call of GetValidatorTokenizedShareTokens.
+func (mr *MockTokenizedSharesKeeperMockRecorder) GetValidatorTokenizedShareTokens(ctx, valAddr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorTokenizedShareTokens", reflect.TypeOf((*MockTokenizedSharesKeeper)(nil).GetValidatorTokenizedShareTokens), ctx, valAddr)
+}
@@ -94,6 +94,8 @@ type MockedKeepers struct {
 	*MockIBCTransferKeeper
 	*MockIBCCoreKeeper
 	*MockDistributionKeeper
+	*MockUpgradeKeeper
+	*MockTokenizedSharesKeeper
 	// *MockGovKeeper
 }
 
@@ -112,12 +114,15 @@ func NewMockedKeepers(ctrl *gomock.Controller) MockedKeepers {
 		MockIBCTransferKeeper:  NewMockIBCTransferKeeper(ctrl),
 		MockIBCCoreKeeper:      NewMockIBCCoreKeeper(ctrl),
 		MockDistributionKeeper: NewMockDistributionKeeper(ctrl),
+		MockUpgradeKeeper:      NewMockUpgradeKeeper(ctrl),
+
+		MockTokenizedSharesKeeper: NewMockTokenizedSharesKeeper(ctrl),
 	}
 }
 
 // NewInMemProviderKeeper instantiates an in-mem provider keeper from params and mocked keepers
 func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) providerkeeper.Keeper {
-	return providerkeeper.NewKeeper(
+	k := providerkeeper.NewKeeper(
 		params.Cdc,
 		params.StoreKey,
 		*params.ParamsSubspace,
@@ -131,6 +136,7 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		mocks.MockAccountKeeper,
 		mocks.MockDistributionKeeper,
 		mocks.MockBankKeeper,
+		mocks.MockUpgradeKeeper,
 		// mocks.MockGovKeeper,
 		govkeeper.Keeper{}, // HACK: to make parts of the test work
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -138,6 +144,8 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		address.NewBech32Codec("cosmosvalcons"),
 		authtypes.FeeCollectorName,
 	)
+	k.SetTokenizedSharesKeeper(mocks.MockTokenizedSharesKeeper)
+	return k
 }
 
 // NewInMemConsumerKeeper instantiates an in-mem consumer keeper from params and mocked keepers
@@ -235,6 +243,8 @@ func SetupForDeleteConsumerChain(t *testing.T, ctx sdk.Context,
 	require.NoError(t, err)
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, GetTestPowerShapingParameters())
 	require.NoError(t, err)
+	err = providerKeeper.SetConsumerEndpointInfo(ctx, consumerId, providertypes.EndpointInfo{GenesisUrl: "https://consumer.io/genesis.json"})
+	require.NoError(t, err)
 
 	// set the chain to initialized so that we can create a consumer client
 	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
@@ -265,6 +275,8 @@ func TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t *testing.T, ctx sdk
 	require.False(t, found)
 	_, found = providerKeeper.GetInitChainHeight(ctx, consumerId)
 	require.False(t, found)
+	_, found = providerKeeper.GetConsumerGenesisHash(ctx, consumerId)
+	require.False(t, found)
 	acks := providerKeeper.GetSlashAcks(ctx, consumerId)
 	require.Empty(t, acks)
 
@@ -274,6 +286,8 @@ func TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t *testing.T, ctx sdk
 	require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, consumerId))
 	require.Empty(t, providerKeeper.GetAllCommissionRateValidators(ctx, consumerId))
 	require.Zero(t, providerKeeper.GetEquivocationEvidenceMinHeight(ctx, consumerId))
+	_, err := providerKeeper.GetConsumerEndpointInfo(ctx, consumerId)
+	require.Error(t, err)
 }
 
 func GetTestConsumerMetadata() providertypes.ConsumerMetadata {
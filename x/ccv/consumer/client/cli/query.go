@@ -1,14 +1,29 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 )
 
+const (
+	// FlagStandaloneValidators is the flag for the file with the validators of the standalone chain
+	FlagStandaloneValidators = "standalone-validators"
+	// FlagSetPreCCV is the flag for setting the preCCV flag of the consumer genesis state
+	FlagSetPreCCV = "set-pre-ccv"
+)
+
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,7 +38,14 @@ func NewQueryCmd() *cobra.Command {
 		CmdNextFeeDistribution(),
 		CmdProviderInfo(),
 		CmdThrottleState(),
+		CmdSlashPacketRetryState(),
+		CmdHistoricalEntries(),
+		CmdProviderClientExpiry(),
+		CmdPendingVSCMaturities(),
+		CmdProviderUpgradeNotice(),
+		CmdValsetStaleness(),
 		CmdParams(),
+		CmdValidateChangeover(),
 	)
 
 	return cmd
@@ -110,6 +132,60 @@ func CmdThrottleState() *cobra.Command {
 	return cmd
 }
 
+func CmdSlashPacketRetryState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-packet-retry-state",
+		Short: "Query the slash packets queued to be sent to the provider and their retry state",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySlashPacketRetryStateRequest{}
+			res, err := queryClient.QuerySlashPacketRetryState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHistoricalEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-entries",
+		Short: "Query the number of historical entries to persist and the heights of the stored historical entries",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryHistoricalEntriesRequest{}
+			res, err := queryClient.QueryHistoricalEntries(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "params",
@@ -136,3 +212,193 @@ func CmdParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdProviderClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-client-expiry",
+		Short: "Query the expiry time of the provider client and whether the provider client expired",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderClientExpiryRequest{}
+			res, err := queryClient.QueryProviderClientExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingVSCMaturities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-vsc-maturities",
+		Short: "Query the VSC packets whose maturity time has not elapsed yet and the counters of the VSC maturities",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingVSCMaturitiesRequest{}
+			res, err := queryClient.QueryPendingVSCMaturities(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderUpgradeNotice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-upgrade-notice",
+		Short: "Query the scheduled upgrade of the provider chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderUpgradeNoticeRequest{}
+			res, err := queryClient.QueryProviderUpgradeNotice(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValsetStaleness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "valset-staleness",
+		Short: "Query the blocks and time elapsed since the last VSC packet was applied",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValsetStalenessRequest{}
+			res, err := queryClient.QueryValsetStaleness(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdValidateChangeover validates the consumer genesis state of a standalone to consumer changeover
+// (see types.ValidateChangeover). The validation runs locally.
+func CmdValidateChangeover() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-changeover [genesis-file]",
+		Short: "Validate the consumer genesis state of a standalone to consumer changeover",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Validate the consumer genesis state in the %s section of the genesis file [genesis-file],
+as read by the upgrade handler of a standalone to consumer changeover, before the upgrade height.
+The validation runs locally and checks the preCCV flag, the provider client and consensus states, the parameters
+and the initial validator set. If the --%s flag is set, then the initial validator set is also checked against
+the bonded validators in the file (e.g., as returned by the staking validators query with --output json).
+If the upgrade handler sets the preCCV flag of the consumer genesis state, then set the --%s flag.
+
+Example:
+$ %s query %s validate-changeover genesis.json --%s validators.json --%s
+`, types.ModuleName, FlagStandaloneValidators, FlagSetPreCCV, version.AppName, types.ModuleName,
+				FlagStandaloneValidators, FlagSetPreCCV),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(filepath.Clean(args[0]))
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			consumerGenesisBz, found := appState[types.ModuleName]
+			if !found {
+				return fmt.Errorf("genesis file does not contain the %s genesis state", types.ModuleName)
+			}
+			var consumerGenesis types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(consumerGenesisBz, &consumerGenesis); err != nil {
+				return fmt.Errorf("consumer genesis unmarshalling failed: %w", err)
+			}
+
+			setPreCCV, err := cmd.Flags().GetBool(FlagSetPreCCV)
+			if err != nil {
+				return err
+			}
+			if setPreCCV {
+				consumerGenesis.PreCCV = true
+			}
+
+			standaloneValset := []stakingtypes.Validator{}
+			validatorsFile, err := cmd.Flags().GetString(FlagStandaloneValidators)
+			if err != nil {
+				return err
+			}
+			if validatorsFile != "" {
+				bz, err := os.ReadFile(filepath.Clean(validatorsFile))
+				if err != nil {
+					return err
+				}
+				var validators stakingtypes.QueryValidatorsResponse
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &validators); err != nil {
+					return fmt.Errorf("standalone validators unmarshalling failed: %w", err)
+				}
+				for _, val := range validators.Validators {
+					if val.IsBonded() {
+						standaloneValset = append(standaloneValset, val)
+					}
+				}
+			}
+
+			summary, err := types.ValidateChangeover(consumerGenesis, standaloneValset)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf(
+				"changeover is valid: %d provider validators, %d standalone validators (%d in both), %d validator updates\n",
+				summary.NumProviderValidators, summary.NumStandaloneValidators, summary.NumOverlappingValidators,
+				len(summary.ValidatorUpdates)))
+		},
+	}
+
+	cmd.Flags().String(FlagStandaloneValidators, "", "the file with the validators of the standalone chain")
+	cmd.Flags().Bool(FlagSetPreCCV, false, "set the preCCV flag of the consumer genesis state, as done by the upgrade handler")
+
+	return cmd
+}
@@ -70,7 +70,27 @@ func (am AppModule) OnChanOpenInit(
 		return "", err
 	}
 
-	return version, nil
+	// providers running a previous ICS version only accept the plain version
+	if !am.keeper.GetProviderAcceptsHandshakeMetadata(ctx) {
+		return version, nil
+	}
+
+	// declare the unbonding period of the consumer chain, so that the provider
+	// can verify it against the initialization parameters of the consumer chain,
+	// and that the consumer chain accepts provider upgrade notices in VSC packets;
+	// note that the metadata is JSON encoded as the channel version must be a valid UTF-8 string
+	unbondingPeriod := am.keeper.GetUnbondingPeriod(ctx)
+	md := types.HandshakeMetadata{
+		Version:                       version,
+		ConsumerUnbondingPeriod:       &unbondingPeriod,
+		AcceptsProviderUpgradeNotices: true,
+	}
+	mdBz, err := types.ModuleCdc.MarshalJSON(&md)
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrInvalidHandshakeMetadata,
+			"error marshalling ibc-init metadata: %v", err)
+	}
+	return string(mdBz), nil
 }
 
 // validateCCVChannelParams validates a ccv channel
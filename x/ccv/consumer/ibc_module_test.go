@@ -68,6 +68,21 @@ func TestOnChanOpenInit(t *testing.T) {
 				)
 			}, true,
 		},
+		{
+			"success with a provider that does not accept the handshake metadata", func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				consumerParams := keeper.GetConsumerParams(params.ctx)
+				consumerParams.ProviderAcceptsHandshakeMetadata = false
+				keeper.SetParams(params.ctx, consumerParams)
+				gomock.InOrder(
+					mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+						params.ctx, params.chanCap, host.ChannelCapabilityPath(
+							params.portID, params.channelID)).Return(nil).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
 		{
 			"invalid non-empty IBC module version",
 			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
@@ -129,6 +144,9 @@ func TestOnChanOpenInit(t *testing.T) {
 
 		consumerKeeper.SetPort(ctx, ccv.ConsumerPortID)
 		consumerKeeper.SetProviderClientID(ctx, "clientIDToProvider")
+		consumerParams := ccv.DefaultParams()
+		consumerParams.ProviderAcceptsHandshakeMetadata = true
+		consumerKeeper.SetParams(ctx, consumerParams)
 
 		// Instantiate valid params as default. Individual test cases mutate these as needed.
 		params := params{
@@ -156,9 +174,20 @@ func TestOnChanOpenInit(t *testing.T) {
 		)
 
 		if tc.expPass {
-			// assert correct version
-			require.Equal(t, ccv.Version, version)
 			require.NoError(t, err)
+			if !consumerKeeper.GetProviderAcceptsHandshakeMetadata(params.ctx) {
+				// assert the plain version
+				require.Equal(t, ccv.Version, version)
+				ctrl.Finish()
+				continue
+			}
+			// assert correct version, declared unbonding period, and acceptance of provider upgrade notices
+			var md ccv.HandshakeMetadata
+			require.NoError(t, ccv.ModuleCdc.UnmarshalJSON([]byte(version), &md))
+			require.Equal(t, ccv.Version, md.Version)
+			require.NotNil(t, md.ConsumerUnbondingPeriod)
+			require.Equal(t, consumerKeeper.GetUnbondingPeriod(params.ctx), *md.ConsumerUnbondingPeriod)
+			require.True(t, md.AcceptsProviderUpgradeNotices)
 		} else {
 			require.Error(t, err)
 			// assert version string is empty
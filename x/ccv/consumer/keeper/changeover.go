@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 )
 
 // ChangeoverIsComplete returns whether the standalone to consumer changeover process is complete.
@@ -34,20 +38,13 @@ func (k Keeper) ChangeoverToConsumer(ctx sdk.Context) (initialValUpdates []abci.
 
 	// Add validator updates to initialValUpdates, such that the "old" validators returned from standalone staking module
 	// are given zero power, and the provider validators are given their full power.
-	initialUpdatesFlag := make(map[string]bool)
-	for _, val := range initialValUpdates {
-		initialUpdatesFlag[val.PubKey.String()] = true
-	}
-
 	standaloneValset, err := k.GetLastStandaloneValidators(ctx)
 	if err != nil {
 		panic(err)
 	}
-	for _, val := range standaloneValset {
-		zeroPowerUpdate := val.ABCIValidatorUpdateZero()
-		if !initialUpdatesFlag[zeroPowerUpdate.PubKey.String()] {
-			initialValUpdates = append(initialValUpdates, zeroPowerUpdate)
-		}
+	initialValUpdates, err = types.ChangeoverValidatorUpdates(initialValUpdates, standaloneValset)
+	if err != nil {
+		panic(err)
 	}
 
 	// Note: this method should only be executed once as a part of the changeover process.
@@ -57,3 +54,37 @@ func (k Keeper) ChangeoverToConsumer(ctx sdk.Context) (initialValUpdates []abci.
 	k.Logger(ctx).Info("ICS changeover complete - you are now a consumer chain!")
 	return initialValUpdates
 }
+
+// ValidateChangeover validates the consumer genesis state `genesisState` of a standalone to consumer changeover
+// against the last bonded validators of the standalone staking module (see types.ValidateChangeover).
+// It is meant to be called from the upgrade handler of the changeover before InitGenesis, so that the upgrade
+// aborts with a descriptive error before any state changes. It also returns an error if the standalone staking
+// keeper is not set or if the consumer module was already initialized.
+func (k Keeper) ValidateChangeover(ctx sdk.Context, genesisState *types.GenesisState) (types.ChangeoverSummary, error) {
+	if k.standaloneStakingKeeper == nil {
+		return types.ChangeoverSummary{}, errorsmod.Wrap(types.ErrInvalidChangeover, "standalone staking keeper is not set")
+	}
+	if k.IsPreCCV(ctx) || k.IsPrevStandaloneChain(ctx) {
+		return types.ChangeoverSummary{}, errorsmod.Wrap(types.ErrInvalidChangeover, "changeover was already initiated")
+	}
+	if _, found := k.GetProviderClientID(ctx); found {
+		return types.ChangeoverSummary{}, errorsmod.Wrap(types.ErrInvalidChangeover, "consumer module was already initialized")
+	}
+
+	standaloneValset, err := k.GetLastBondedValidators(ctx)
+	if err != nil {
+		return types.ChangeoverSummary{}, errorsmod.Wrapf(types.ErrInvalidChangeover,
+			"cannot get standalone validators: %s", err.Error())
+	}
+	summary, err := types.ValidateChangeover(*genesisState, standaloneValset)
+	if err != nil {
+		return types.ChangeoverSummary{}, err
+	}
+
+	k.Logger(ctx).Info("validated standalone to consumer changeover",
+		"provider validators", summary.NumProviderValidators,
+		"standalone validators", summary.NumStandaloneValidators,
+		"overlapping validators", summary.NumOverlappingValidators,
+	)
+	return summary, nil
+}
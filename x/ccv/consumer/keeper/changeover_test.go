@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 
 	sdkcryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -12,6 +16,8 @@ import (
 
 	"github.com/cosmos/interchain-security/v6/testutil/crypto"
 	uthelpers "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 func TestChangeoverToConsumer(t *testing.T) {
@@ -171,3 +177,69 @@ func TestChangeoverToConsumer(t *testing.T) {
 		require.Len(t, returnedInitialValUpdates, tc.expectedReturnValUpdatesLen)
 	}
 }
+
+// TestValidateChangeover tests that the consumer genesis state of a changeover is validated against the last bonded
+// validators of the standalone staking module, and that the validation fails without modifying the state if the
+// changeover cannot happen
+func TestValidateChangeover(t *testing.T) {
+	cIds := crypto.GenMultipleCryptoIds(3, 7834)
+	sovVals := []stakingtypes.Validator{cIds[1].SDKStakingValidator(), cIds[2].SDKStakingValidator()}
+
+	gs := consumertypes.NewInitialGenesisState(
+		ibctmtypes.NewClientState("provider", ibctmtypes.DefaultTrustLevel, 2*time.Hour, ccv.DefaultConsumerUnbondingPeriod,
+			10*time.Second, clienttypes.NewHeight(0, 4), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"}),
+		ibctmtypes.NewConsensusState(time.Now(), commitmenttypes.NewMerkleRoot([]byte("apphash")), []byte{}),
+		[]abci.ValidatorUpdate{
+			{Power: 10, PubKey: cIds[0].TMProtoCryptoPublicKey()},
+			{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		},
+		ccv.DefaultParams(),
+	)
+	gs.Params.Enabled = true
+	gs.PreCCV = true
+
+	keeperParams := uthelpers.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, mocks := uthelpers.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	// the standalone staking keeper is not set
+	_, err := consumerKeeper.ValidateChangeover(ctx, gs)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidChangeover)
+
+	uthelpers.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, sovVals, -1)
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+
+	summary, err := consumerKeeper.ValidateChangeover(ctx, gs)
+	require.NoError(t, err)
+	require.Equal(t, consumertypes.ChangeoverSummary{
+		NumProviderValidators:    2,
+		NumStandaloneValidators:  2,
+		NumOverlappingValidators: 1,
+		ValidatorUpdates: []abci.ValidatorUpdate{
+			gs.Provider.InitialValSet[0],
+			gs.Provider.InitialValSet[1],
+			{Power: 0, PubKey: cIds[2].TMProtoCryptoPublicKey()},
+		},
+	}, summary)
+
+	// the validator updates are the ones returned at the changeover
+	consumerKeeper.SetPreCCVTrue(ctx)
+	consumerKeeper.MarkAsPrevStandaloneChain(ctx)
+	consumerKeeper.SetInitialValSet(ctx, gs.Provider.InitialValSet)
+	require.Equal(t, summary.ValidatorUpdates, consumerKeeper.ChangeoverToConsumer(ctx))
+
+	// the changeover was already initiated
+	_, err = consumerKeeper.ValidateChangeover(ctx, gs)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidChangeover)
+
+	// an invalid consumer genesis state is rejected before any state changes
+	consumerKeeper, ctx, ctrl, mocks = uthelpers.GetConsumerKeeperAndCtx(t, uthelpers.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	uthelpers.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, sovVals, -1)
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+	gs.Params.UnbondingPeriod = ccv.DefaultConsumerUnbondingPeriod + time.Hour
+	_, err = consumerKeeper.ValidateChangeover(ctx, gs)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidChangeover)
+	require.False(t, consumerKeeper.IsPreCCV(ctx))
+	require.Empty(t, consumerKeeper.GetInitialValSet(ctx))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+)
+
+// SetApprovedProviderClientSubstitute sets the client id of the client approved by governance
+// as substitute of the provider client
+func (k Keeper) SetApprovedProviderClientSubstitute(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ApprovedProviderClientSubstituteKey(), []byte(clientID))
+}
+
+// GetApprovedProviderClientSubstitute returns the client id of the client approved by governance
+// as substitute of the provider client
+func (k Keeper) GetApprovedProviderClientSubstitute(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	clientIdBytes := store.Get(types.ApprovedProviderClientSubstituteKey())
+	if clientIdBytes == nil {
+		return "", false
+	}
+	return string(clientIdBytes), true
+}
+
+// DeleteApprovedProviderClientSubstitute deletes the approved substitute of the provider client
+func (k Keeper) DeleteApprovedProviderClientSubstitute(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ApprovedProviderClientSubstituteKey())
+}
+
+// ValidateProviderClientSubstitution returns an error if `subjectClientID` is the provider client
+// and `substituteClientID` was not approved by governance as its substitute. Otherwise, the approval
+// is consumed, i.e., every substitution of the provider client must be approved separately.
+//
+// Note that the substitution of a client that is not the provider client is always allowed.
+func (k Keeper) ValidateProviderClientSubstitution(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+	providerClientID, found := k.GetProviderClientID(ctx)
+	if !found || providerClientID != subjectClientID {
+		return nil
+	}
+
+	approvedClientID, found := k.GetApprovedProviderClientSubstitute(ctx)
+	if !found || approvedClientID != substituteClientID {
+		return errorsmod.Wrapf(types.ErrUnapprovedProviderClientSubstitute,
+			"cannot substitute provider client %s with client %s", subjectClientID, substituteClientID)
+	}
+
+	k.DeleteApprovedProviderClientSubstitute(ctx)
+	k.Logger(ctx).Info("provider client substitution approved",
+		"provider client", providerClientID,
+		"substitute client", substituteClientID,
+	)
+	return nil
+}
+
+// ProviderClientSubstitutionRouter wraps a message router (e.g., the one used by the gov module
+// to execute the messages of proposals) and rejects the recovery of the provider client
+// (i.e., MsgRecoverClient) with a substitute client that was not approved by governance.
+type ProviderClientSubstitutionRouter struct {
+	baseapp.MessageRouter
+	keeper *Keeper
+}
+
+var _ baseapp.MessageRouter = ProviderClientSubstitutionRouter{}
+
+// NewProviderClientSubstitutionRouter returns a new ProviderClientSubstitutionRouter.
+// Note that the keeper is passed by reference as the router is usually created before
+// the consumer keeper is initialized.
+func NewProviderClientSubstitutionRouter(router baseapp.MessageRouter, keeper *Keeper) ProviderClientSubstitutionRouter {
+	return ProviderClientSubstitutionRouter{
+		MessageRouter: router,
+		keeper:        keeper,
+	}
+}
+
+// Handler implements baseapp.MessageRouter
+func (r ProviderClientSubstitutionRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.wrapHandler(sdk.MsgTypeURL(msg), r.MessageRouter.Handler(msg))
+}
+
+// HandlerByTypeURL implements baseapp.MessageRouter
+func (r ProviderClientSubstitutionRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r.wrapHandler(typeURL, r.MessageRouter.HandlerByTypeURL(typeURL))
+}
+
+// wrapHandler wraps the handler of MsgRecoverClient with ValidateProviderClientSubstitution
+func (r ProviderClientSubstitutionRouter) wrapHandler(typeURL string, handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil || typeURL != sdk.MsgTypeURL(&clienttypes.MsgRecoverClient{}) {
+		return handler
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		recoverMsg, ok := msg.(*clienttypes.MsgRecoverClient)
+		if !ok {
+			return nil, fmt.Errorf("unexpected message type: %T", msg)
+		}
+		if err := r.keeper.ValidateProviderClientSubstitution(ctx, recoverMsg.SubjectClientId, recoverMsg.SubstituteClientId); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+)
+
+// TestApproveProviderClientSubstitute tests that only governance can approve a substitute of the provider client
+func TestApproveProviderClientSubstitute(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+	msgServer := consumerkeeper.NewMsgServerImpl(&consumerKeeper)
+
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "07-tendermint-1").Return(&ibctmtypes.ClientState{}, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "07-tendermint-2").Return(nil, false).Times(1)
+
+	testCases := []struct {
+		name               string
+		authority          string
+		substituteClientId string
+		expErr             bool
+	}{
+		{
+			name:               "invalid authority",
+			authority:          "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu",
+			substituteClientId: "07-tendermint-1",
+			expErr:             true,
+		},
+		{
+			name:               "invalid client id",
+			authority:          consumerKeeper.GetAuthority(),
+			substituteClientId: "invalid client id",
+			expErr:             true,
+		},
+		{
+			name:               "provider client as substitute",
+			authority:          consumerKeeper.GetAuthority(),
+			substituteClientId: "07-tendermint-0",
+			expErr:             true,
+		},
+		{
+			name:               "unknown client",
+			authority:          consumerKeeper.GetAuthority(),
+			substituteClientId: "07-tendermint-2",
+			expErr:             true,
+		},
+		{
+			name:               "valid approval",
+			authority:          consumerKeeper.GetAuthority(),
+			substituteClientId: "07-tendermint-1",
+			expErr:             false,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := msgServer.ApproveProviderClientSubstitute(ctx, &types.MsgApproveProviderClientSubstitute{
+			Authority:          tc.authority,
+			SubstituteClientId: tc.substituteClientId,
+		})
+		approvedClientId, found := consumerKeeper.GetApprovedProviderClientSubstitute(ctx)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+			require.False(t, found, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.True(t, found, tc.name)
+			require.Equal(t, tc.substituteClientId, approvedClientId, tc.name)
+		}
+	}
+}
+
+// TestValidateProviderClientSubstitution tests that the provider client can only be substituted
+// with an approved substitute client and that every approval can be used only once
+func TestValidateProviderClientSubstitution(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// substitutions are allowed before the provider client is set
+	require.NoError(t, consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-0", "07-tendermint-1"))
+
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+
+	// unapproved substitution of the provider client
+	err := consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-0", "07-tendermint-1")
+	require.ErrorIs(t, err, types.ErrUnapprovedProviderClientSubstitute)
+
+	// substitution of another client
+	require.NoError(t, consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-2", "07-tendermint-1"))
+
+	// substitution of the provider client with another client than the approved one
+	consumerKeeper.SetApprovedProviderClientSubstitute(ctx, "07-tendermint-1")
+	err = consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-0", "07-tendermint-3")
+	require.ErrorIs(t, err, types.ErrUnapprovedProviderClientSubstitute)
+
+	// approved substitution of the provider client
+	require.NoError(t, consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-0", "07-tendermint-1"))
+	_, found := consumerKeeper.GetApprovedProviderClientSubstitute(ctx)
+	require.False(t, found)
+
+	// the approval is consumed
+	err = consumerKeeper.ValidateProviderClientSubstitution(ctx, "07-tendermint-0", "07-tendermint-1")
+	require.ErrorIs(t, err, types.ErrUnapprovedProviderClientSubstitute)
+}
+
+// mockMessageRouter is a message router that counts the executed messages
+type mockMessageRouter struct {
+	executed *int
+}
+
+func (r mockMessageRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+}
+
+func (r mockMessageRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		*r.executed++
+		return &sdk.Result{}, nil
+	}
+}
+
+// TestProviderClientSubstitutionRouter tests that the router executes MsgRecoverClient
+// messages for the provider client only if the substitute client was approved
+func TestProviderClientSubstitutionRouter(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+
+	executed := 0
+	router := consumerkeeper.NewProviderClientSubstitutionRouter(mockMessageRouter{executed: &executed}, &consumerKeeper)
+
+	recoverMsg := &clienttypes.MsgRecoverClient{
+		SubjectClientId:    "07-tendermint-0",
+		SubstituteClientId: "07-tendermint-1",
+		Signer:             consumerKeeper.GetAuthority(),
+	}
+	handlers := []baseapp.MsgServiceHandler{
+		router.Handler(recoverMsg),
+		router.HandlerByTypeURL(sdk.MsgTypeURL(recoverMsg)),
+	}
+
+	for _, handler := range handlers {
+		// unapproved substitution
+		_, err := handler(ctx, recoverMsg)
+		require.ErrorIs(t, err, types.ErrUnapprovedProviderClientSubstitute)
+
+		// approved substitution
+		consumerKeeper.SetApprovedProviderClientSubstitute(ctx, "07-tendermint-1")
+		_, err = handler(ctx, recoverMsg)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, executed)
+
+	// the recovery of other clients and other messages are not affected
+	otherRecoverMsg := &clienttypes.MsgRecoverClient{
+		SubjectClientId:    "07-tendermint-2",
+		SubstituteClientId: "07-tendermint-1",
+		Signer:             consumerKeeper.GetAuthority(),
+	}
+	_, err := router.Handler(otherRecoverMsg)(ctx, otherRecoverMsg)
+	require.NoError(t, err)
+
+	updateParamsMsg := &types.MsgUpdateParams{Authority: consumerKeeper.GetAuthority()}
+	_, err = router.Handler(updateParamsMsg)(ctx, updateParamsMsg)
+	require.NoError(t, err)
+	require.Equal(t, 4, executed)
+}
@@ -47,22 +47,15 @@ func (k Keeper) EndBlockRD(ctx sdk.Context) {
 }
 
 // DistributeRewardsInternally splits the block rewards according to the
-// ConsumerRedistributionFrac param.
+// ConsumerRedistributionFrac param and its denom-specific overrides.
 // Returns true if it's time to send rewards to provider
 func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	consumerFeePoolAddr := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
 	fpTokens := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
 
 	// split the fee pool, send the consumer's fraction to the consumer redistribution address
-	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
-	decFPTokens := sdk.NewDecCoinsFromCoins(fpTokens...)
-	// NOTE the truncated decimal remainder will be sent to the provider fee pool
-	consRedistrTokens, _ := decFPTokens.MulDec(frac).TruncateDecimal()
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
+	consRedistrTokens, remainingTokens := k.splitFeePoolTokens(ctx, fpTokens)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
 		types.ConsumerRedistributeName, consRedistrTokens)
 	if err != nil {
 		// SendCoinsFromModuleToModule will panic if either module account does not exist,
@@ -77,7 +70,6 @@ func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	// tokens do not go through the consumer redistribute split twice in the
 	// event that the transfer fails the tokens are returned to the consumer
 	// chain.
-	remainingTokens := fpTokens.Sub(consRedistrTokens...)
 	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
 		types.ConsumerToSendToProviderName, remainingTokens)
 	if err != nil {
@@ -89,6 +81,38 @@ func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	}
 }
 
+// splitFeePoolTokens splits the fee pool tokens into the tokens allocated to the consumer redistribution
+// address and the tokens sent to the provider. The tokens of every denom are split according to the
+// denom-specific redistribution fraction, if any, and according to ConsumerRedistributionFrac otherwise.
+// NOTE the truncated decimal remainders are allocated to the provider.
+func (k Keeper) splitFeePoolTokens(ctx sdk.Context, fpTokens sdk.Coins) (consumerTokens, providerTokens sdk.Coins) {
+	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
+	if err != nil {
+		// ConsumerRedistributionFrac was already validated when set as a param
+		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
+	}
+	denomFracs := make(map[string]math.LegacyDec)
+	for _, denomFrac := range k.GetDenomRedistributionFractions(ctx) {
+		dec, err := math.LegacyNewDecFromStr(denomFrac.Fraction)
+		if err != nil {
+			// DenomRedistributionFractions were already validated when set as a param
+			panic(fmt.Errorf("redistribution fraction of denom %s is invalid: %w", denomFrac.Denom, err))
+		}
+		denomFracs[denomFrac.Denom] = dec
+	}
+
+	consumerTokens = sdk.NewCoins()
+	for _, token := range fpTokens {
+		denomFrac, found := denomFracs[token.Denom]
+		if !found {
+			denomFrac = frac
+		}
+		amount := math.LegacyNewDecFromInt(token.Amount).Mul(denomFrac).TruncateInt()
+		consumerTokens = consumerTokens.Add(sdk.NewCoin(token.Denom, amount))
+	}
+	return consumerTokens, fpTokens.Sub(consumerTokens...)
+}
+
 // Check whether it's time to send rewards to provider
 func (k Keeper) shouldSendRewardsToProvider(ctx sdk.Context) bool {
 	bpdt := k.GetBlocksPerDistributionTransmission(ctx)
@@ -255,25 +279,18 @@ func (k Keeper) GetEstimatedNextFeeDistribution(ctx sdk.Context) types.NextFeeDi
 	consumerFeePoolAddr := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
 	total := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
 
-	fracParam := k.GetConsumerRedistributionFrac(ctx)
-	frac, err := math.LegacyNewDecFromStr(fracParam)
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
-
 	totalTokens := sdk.NewDecCoinsFromCoins(total...)
 	// truncated decimals are implicitly added to provider
-	consumerTokens, _ := totalTokens.MulDec(frac).TruncateDecimal()
-	providerTokens := total.Sub(consumerTokens...)
+	consumerTokens, providerTokens := k.splitFeePoolTokens(ctx, total)
 
 	return types.NextFeeDistributionEstimate{
-		CurrentHeight:        ctx.BlockHeight(),
-		LastHeight:           lastH.GetHeight(),
-		NextHeight:           nextH,
-		DistributionFraction: fracParam,
-		Total:                totalTokens.String(),
-		ToProvider:           sdk.NewDecCoinsFromCoins(providerTokens...).String(),
-		ToConsumer:           sdk.NewDecCoinsFromCoins(consumerTokens...).String(),
+		CurrentHeight:              ctx.BlockHeight(),
+		LastHeight:                 lastH.GetHeight(),
+		NextHeight:                 nextH,
+		DistributionFraction:       k.GetConsumerRedistributionFrac(ctx),
+		Total:                      totalTokens.String(),
+		ToProvider:                 sdk.NewDecCoinsFromCoins(providerTokens...).String(),
+		ToConsumer:                 sdk.NewDecCoinsFromCoins(consumerTokens...).String(),
+		DenomDistributionFractions: k.GetDenomRedistributionFractions(ctx),
 	}
 }
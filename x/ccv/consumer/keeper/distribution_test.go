@@ -71,6 +71,64 @@ func TestGetEstimatedNextFeeDistribution(t *testing.T) {
 	require.EqualValues(t, expect, res, "fee distribution data does not match")
 }
 
+// TestDistributeRewardsInternallyWithDenomRedistributionFractions tests that the fee pool tokens of every denom
+// are split according to the denom-specific redistribution fraction, falling back to the global fraction
+func TestDistributeRewardsInternallyWithDenomRedistributionFractions(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctx := keeperParams.Ctx
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	consumerKeeper := testkeeper.NewInMemConsumerKeeper(keeperParams, mocks)
+	params := ccvtypes.DefaultParams()
+	params.ConsumerRedistributionFraction = "0.75"
+	params.DenomRedistributionFractions = []ccvtypes.DenomRedistributionFraction{
+		// the consumer chain retains all of its own token
+		{Denom: "untrn", Fraction: "1"},
+		{Denom: "ustake", Fraction: "0.1"},
+	}
+	consumerKeeper.SetParams(ctx, params)
+
+	feePoolTokens := sdk.NewCoins(
+		sdk.NewInt64Coin("ucons", 1000),
+		sdk.NewInt64Coin("untrn", 1000),
+		sdk.NewInt64Coin("ustake", 999),
+	)
+	// the truncated remainders are sent to the provider
+	expectedConsumerTokens := sdk.NewCoins(
+		sdk.NewInt64Coin("ucons", 750),
+		sdk.NewInt64Coin("untrn", 1000),
+		sdk.NewInt64Coin("ustake", 99),
+	)
+	expectedProviderTokens := sdk.NewCoins(
+		sdk.NewInt64Coin("ucons", 250),
+		sdk.NewInt64Coin("ustake", 900),
+	)
+	mAcc := authTypes.NewModuleAccount(&authTypes.BaseAccount{}, "", "auth")
+
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authTypes.FeeCollectorName).Return(mAcc).Times(1),
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(feePoolTokens).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerRedistributeName, expectedConsumerTokens).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerToSendToProviderName, expectedProviderTokens).Return(nil).Times(1),
+	)
+	consumerKeeper.DistributeRewardsInternally(ctx)
+
+	// the estimate of the next fee distribution uses the same split
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authTypes.FeeCollectorName).Return(mAcc).Times(1),
+		mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(feePoolTokens).Times(1),
+	)
+	res := consumerKeeper.GetEstimatedNextFeeDistribution(ctx)
+	require.Equal(t, "0.75", res.DistributionFraction)
+	require.Equal(t, params.DenomRedistributionFractions, res.DenomDistributionFractions)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(expectedConsumerTokens...).String(), res.ToConsumer)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(expectedProviderTokens...).String(), res.ToProvider)
+}
+
 func TestAllowedRewardDenoms(t *testing.T) {
 	keeperParams := testkeeper.NewInMemKeeperParams(t)
 	ctx := keeperParams.Ctx
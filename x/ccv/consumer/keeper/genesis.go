@@ -65,6 +65,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		// set provider client id.
 		k.SetProviderClientID(ctx, clientID)
 
+		// warn if the local node signs with the provider key of a validator that assigned a consumer key
+		k.CheckLocalValidatorKey(ctx, state.Provider.AssignedProviderKeys)
+
+		// register the consumer denom metadata sent by the provider, if any
+		k.RegisterConsumerDenomMetadata(ctx)
+
+		// apply the downtime window sent by the provider, if any
+		if err := k.ApplyDowntimeWindowParams(ctx); err != nil {
+			// If the downtime window cannot be applied, the chain MUST NOT start
+			panic(err)
+		}
+
 		// set default value for valset update ID
 		k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
 
@@ -75,17 +87,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 			k.SetProviderChannel(ctx, state.ProviderChannelId)
 			// set all unbonding sequences
 			for _, mp := range state.MaturingPackets {
-				k.SetPacketMaturityTime(ctx, mp.VscId, mp.MaturityTime)
+				k.SetPacketMaturityTime(ctx, mp.VscId, mp.ReceivedTime, mp.MaturityTime)
 			}
-			// set outstanding downtime slashing requests
-			for _, od := range state.OutstandingDowntimeSlashing {
-				consAddr, err := sdk.ConsAddressFromBech32(od.ValidatorConsensusAddress)
-				if err != nil {
-					panic(err)
-				}
-				k.SetOutstandingDowntime(ctx, consAddr)
-			}
-
 			// set last transmission block height
 			k.SetLastTransmissionBlockHeight(ctx, state.LastTransmissionBlockHeight)
 		}
@@ -93,8 +96,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		// Set pending consumer packets, using the depreciated ConsumerPacketDataList type
 		// that exists for genesis.
 		// note that the list includes pending mature VSC packet only if the handshake is completed
-		for _, packet := range state.PendingConsumerPackets.List {
-			k.AppendPendingPacket(ctx, packet.Type, packet.Data)
+		if len(state.PendingConsumerPacketIndices) == 0 {
+			for _, packet := range state.PendingConsumerPackets.List {
+				k.AppendPendingPacket(ctx, packet.Type, packet.Data)
+			}
+		} else {
+			// keep the indices of the pending packets, so that the order of the queue is preserved;
+			// note that the indices are validated to be strictly increasing by ValidateGenesis
+			for i, packet := range state.PendingConsumerPackets.List {
+				k.SetPendingPacketWithIdx(ctx, state.PendingConsumerPacketIndices[i], packet)
+			}
+			k.SetPendingPacketsIdx(ctx, state.PendingConsumerPacketIndices[len(state.PendingConsumerPacketIndices)-1]+1)
+		}
+
+		// set the slash record of the slash packet waiting to be handled by the provider,
+		// so that the consumer keeps following the retry protocol after restart
+		if state.SlashRecord != nil {
+			k.SetSlashRecord(ctx, *state.SlashRecord)
 		}
 
 		// set height to valset update id mapping
@@ -112,6 +130,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 
 	// populate cross chain validators states with initial valset
 	k.ApplyCCValidatorChanges(ctx, state.Provider.InitialValSet)
+
+	// set outstanding downtime slashing requests of a chain that restarts with the CCV channel established;
+	// note that this must happen after populating the cross chain validators, which clears the outstanding
+	// downtime flags of new validators
+	if !state.NewChain && state.ProviderChannelId != "" {
+		for _, od := range state.OutstandingDowntimeSlashing {
+			consAddr, err := sdk.ConsAddressFromBech32(od.ValidatorConsensusAddress)
+			if err != nil {
+				panic(err)
+			}
+			k.SetOutstandingDowntime(ctx, consAddr)
+		}
+	}
+
 	return state.Provider.InitialValSet
 }
 
@@ -125,10 +157,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 	// export the current validator set
 	valset := k.MustGetCurrentValidatorsAsABCIUpdates(ctx)
 
-	// export pending packets using the depreciated ConsumerPacketDataList type
-	pendingPackets := k.GetPendingPackets(ctx)
+	// export pending packets using the depreciated ConsumerPacketDataList type,
+	// together with their indices in the pending packets queue
 	pendingPacketsDepreciated := types.ConsumerPacketDataList{}
-	pendingPacketsDepreciated.List = append(pendingPacketsDepreciated.List, pendingPackets...)
+	var pendingPacketIndices []uint64
+	for _, packet := range k.GetAllPendingPacketsWithIdx(ctx) {
+		pendingPacketsDepreciated.List = append(pendingPacketsDepreciated.List, packet.ConsumerPacketData)
+		pendingPacketIndices = append(pendingPacketIndices, packet.Idx)
+	}
 
 	// export all the states created after a provider channel got established
 	if channelID, ok := k.GetProviderChannel(ctx); ok {
@@ -171,5 +207,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 		)
 	}
 
+	genesis.PendingConsumerPacketIndices = pendingPacketIndices
+
+	// export the slash record, if a slash packet is waiting to be handled by the provider
+	if slashRecord, found := k.GetSlashRecord(ctx); found {
+		genesis.SlashRecord = &slashRecord
+	}
+
 	return genesis
 }
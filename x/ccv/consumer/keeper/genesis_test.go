@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -10,12 +11,17 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v6/testutil/crypto"
@@ -229,6 +235,267 @@ func TestInitGenesis(t *testing.T) {
 	}
 }
 
+// TestInitGenesisChecksLocalValidatorKey tests that InitGenesis detects a local node
+// that signs with the provider key of a validator that assigned a different consumer key.
+func TestInitGenesisChecksLocalValidatorKey(t *testing.T) {
+	provClientID := "tendermint-07"
+
+	// the consumer key assigned by the validator and its provider key
+	consumerId := crypto.NewCryptoIdentityFromIntSeed(1)
+	providerId := crypto.NewCryptoIdentityFromIntSeed(2)
+	otherId := crypto.NewCryptoIdentityFromIntSeed(3)
+
+	validator := tmtypes.NewValidator(consumerId.TMCryptoPubKey(), 1)
+	valset := []abci.ValidatorUpdate{tmtypes.TM2PB.ValidatorUpdate(validator)}
+
+	provConsState := ibctmtypes.NewConsensusState(
+		time.Time{},
+		commitmenttypes.NewMerkleRoot([]byte("apphash")),
+		tmtypes.NewValidatorSet([]*tmtypes.Validator{validator}).Hash(),
+	)
+	provClientState := ibctmtypes.NewClientState(
+		"provider",
+		ibctmtypes.DefaultTrustLevel,
+		0,
+		stakingtypes.DefaultUnbondingTime,
+		time.Second*10,
+		clienttypes.Height{},
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	testCases := []struct {
+		name        string
+		localKey    *crypto.CryptoIdentity
+		expMisusage bool
+	}{
+		{"local key not set", nil, false},
+		{"local node signs with the assigned consumer key", consumerId, false},
+		{"local node signs with an unrelated key", otherId, false},
+		{"local node signs with the provider key", providerId, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+
+			if tc.localKey != nil {
+				consumerKeeper.SetLocalValidatorPubKey(tc.localKey.TMProtoCryptoPublicKey())
+			}
+
+			gomock.InOrder(
+				testkeeper.ExpectGetCapabilityMock(ctx, mocks, 1),
+				testkeeper.ExpectCreateClientMock(ctx, mocks, provClientID, provClientState, provConsState),
+			)
+
+			genesis := consumertypes.NewInitialGenesisState(provClientState, provConsState, valset, params)
+			genesis.Provider.AssignedProviderKeys = []tmprotocrypto.PublicKey{providerId.TMProtoCryptoPublicKey()}
+			consumerKeeper.InitGenesis(ctx, genesis)
+
+			found := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == ccv.EventTypeProviderKeyOnConsumer {
+					found = true
+					attr, ok := event.GetAttribute(ccv.AttributeProviderValidatorAddress)
+					require.True(t, ok)
+					require.Equal(t, providerId.SDKValConsAddress().String(), attr.Value)
+				}
+			}
+			require.Equal(t, tc.expMisusage, found)
+
+			// the check does not prevent the chain from starting
+			require.Equal(t, validator.Address.Bytes(), consumerKeeper.GetAllCCValidator(ctx)[0].Address)
+		})
+	}
+}
+
+// TestInitGenesisRegistersConsumerDenomMetadata tests that the consumer denom metadata
+// sent by the provider in the consumer params is registered with the bank keeper at InitGenesis
+func TestInitGenesisRegistersConsumerDenomMetadata(t *testing.T) {
+	provClientID := "tendermint-07"
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	tmPK, err := cryptocodec.ToCmtPubKeyInterface(pubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(tmPK, 1)
+	valset := []abci.ValidatorUpdate{tmtypes.TM2PB.ValidatorUpdate(validator)}
+
+	provConsState := ibctmtypes.NewConsensusState(
+		time.Time{},
+		commitmenttypes.NewMerkleRoot([]byte("apphash")),
+		tmtypes.NewValidatorSet([]*tmtypes.Validator{validator}).Hash(),
+	)
+	provClientState := ibctmtypes.NewClientState(
+		"provider",
+		ibctmtypes.DefaultTrustLevel,
+		0,
+		stakingtypes.DefaultUnbondingTime,
+		time.Second*10,
+		clienttypes.Height{},
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+
+	metadata := banktypes.Metadata{
+		Description: "The native staking token of the consumer chain",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ucons", Exponent: 0},
+			{Denom: "cons", Exponent: 6},
+		},
+		Base:    "ucons",
+		Display: "cons",
+		Name:    "Consumer",
+		Symbol:  "CONS",
+	}
+
+	testCases := []struct {
+		name           string
+		metadata       *banktypes.Metadata
+		alreadyPresent bool
+		expRegistered  bool
+	}{
+		{"no denom metadata", nil, false, false},
+		{"denom metadata already present", &metadata, true, false},
+		{"denom metadata registered", &metadata, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+
+			params := ccv.DefaultParams()
+			params.Enabled = true
+			if tc.metadata != nil {
+				params.ConsumerDenom = tc.metadata.Base
+				params.ConsumerDenomMetadata = tc.metadata
+			}
+
+			expectations := []*gomock.Call{
+				testkeeper.ExpectGetCapabilityMock(ctx, mocks, 1),
+				testkeeper.ExpectCreateClientMock(ctx, mocks, provClientID, provClientState, provConsState),
+			}
+			if tc.metadata != nil {
+				expectations = append(expectations,
+					mocks.MockBankKeeper.EXPECT().HasDenomMetaData(ctx, tc.metadata.Base).Return(tc.alreadyPresent).Times(1),
+				)
+			}
+			if tc.expRegistered {
+				expectations = append(expectations,
+					mocks.MockBankKeeper.EXPECT().SetDenomMetaData(ctx, *tc.metadata).Times(1),
+				)
+			}
+			gomock.InOrder(expectations...)
+
+			genesis := consumertypes.NewInitialGenesisState(provClientState, provConsState, valset, params)
+			consumerKeeper.InitGenesis(ctx, genesis)
+
+			require.Equal(t, params.ConsumerDenom, consumerKeeper.GetConsumerParams(ctx).ConsumerDenom)
+		})
+	}
+}
+
+// TestInitGenesisAppliesDowntimeWindow tests that the downtime window carried in the consumer params
+// overrides the slashing params of a new consumer chain, while unset values keep the genesis values
+func TestInitGenesisAppliesDowntimeWindow(t *testing.T) {
+	provClientID := "tendermint-07"
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	tmPK, err := cryptocodec.ToCmtPubKeyInterface(pubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(tmPK, 1)
+	valset := []abci.ValidatorUpdate{tmtypes.TM2PB.ValidatorUpdate(validator)}
+
+	provConsState := ibctmtypes.NewConsensusState(
+		time.Time{},
+		commitmenttypes.NewMerkleRoot([]byte("apphash")),
+		tmtypes.NewValidatorSet([]*tmtypes.Validator{validator}).Hash(),
+	)
+	provClientState := ibctmtypes.NewClientState(
+		"provider",
+		ibctmtypes.DefaultTrustLevel,
+		0,
+		stakingtypes.DefaultUnbondingTime,
+		time.Second*10,
+		clienttypes.Height{},
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+
+	genesisSlashingParams := slashingtypes.DefaultParams()
+
+	testCases := []struct {
+		name                  string
+		signedBlocksWindow    int64
+		minSignedPerWindow    string
+		expSlashingParamsSet  bool
+		expSignedBlocksWindow int64
+		expMinSignedPerWindow math.LegacyDec
+	}{
+		{
+			"no downtime window", 0, "", false,
+			genesisSlashingParams.SignedBlocksWindow, genesisSlashingParams.MinSignedPerWindow,
+		},
+		{
+			"only signed blocks window", 10000, "", true,
+			10000, genesisSlashingParams.MinSignedPerWindow,
+		},
+		{
+			"only min signed per window", 0, "0.05", true,
+			genesisSlashingParams.SignedBlocksWindow, math.LegacyNewDecWithPrec(5, 2),
+		},
+		{
+			"both downtime window params", 10000, "0.05", true,
+			10000, math.LegacyNewDecWithPrec(5, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+
+			params := ccv.DefaultParams()
+			params.Enabled = true
+			params.SignedBlocksWindow = tc.signedBlocksWindow
+			params.MinSignedPerWindow = tc.minSignedPerWindow
+
+			effectiveSlashingParams := genesisSlashingParams
+			expectations := []*gomock.Call{
+				testkeeper.ExpectGetCapabilityMock(ctx, mocks, 1),
+				testkeeper.ExpectCreateClientMock(ctx, mocks, provClientID, provClientState, provConsState),
+			}
+			if tc.expSlashingParamsSet {
+				expectations = append(expectations,
+					mocks.MockSlashingKeeper.EXPECT().GetParams(ctx).Return(genesisSlashingParams, nil).Times(1),
+					mocks.MockSlashingKeeper.EXPECT().SetParams(ctx, gomock.Any()).DoAndReturn(
+						func(_ context.Context, p slashingtypes.Params) error {
+							effectiveSlashingParams = p
+							return nil
+						}).Times(1),
+				)
+			}
+			gomock.InOrder(expectations...)
+
+			genesis := consumertypes.NewInitialGenesisState(provClientState, provConsState, valset, params)
+			consumerKeeper.InitGenesis(ctx, genesis)
+
+			require.Equal(t, tc.expSignedBlocksWindow, effectiveSlashingParams.SignedBlocksWindow)
+			require.True(t, tc.expMinSignedPerWindow.Equal(effectiveSlashingParams.MinSignedPerWindow))
+			// the remaining slashing params keep their genesis values
+			require.Equal(t, genesisSlashingParams.DowntimeJailDuration, effectiveSlashingParams.DowntimeJailDuration)
+			require.Equal(t, genesisSlashingParams.SlashFractionDowntime, effectiveSlashingParams.SlashFractionDowntime)
+		})
+	}
+}
+
 // TestExportGenesis tests that a consumer chain genesis is correctly exported to genesis
 // It covers the restart of chain when a CCV channel is or isn't established yet.
 func TestExportGenesis(t *testing.T) {
@@ -339,7 +606,7 @@ func TestExportGenesis(t *testing.T) {
 				}
 
 				// populate the required states for an established CCV channel
-				ck.SetPacketMaturityTime(ctx, matPackets[0].VscId, matPackets[0].MaturityTime)
+				ck.SetPacketMaturityTime(ctx, matPackets[0].VscId, matPackets[0].ReceivedTime, matPackets[0].MaturityTime)
 				ck.SetOutstandingDowntime(ctx, sdk.ConsAddress(validator.Address.Bytes()))
 				ck.SetLastTransmissionBlockHeight(ctx, ltbh)
 			},
@@ -372,12 +639,167 @@ func TestExportGenesis(t *testing.T) {
 			// export states to genesis
 			gotGen := consumerKeeper.ExportGenesis(ctx)
 
-			// check obtained genesis
+			// check obtained genesis, where the pending packets are exported
+			// together with their indices in the pending packets queue
+			tc.expGenesis.PendingConsumerPacketIndices = []uint64{0, 1}
 			require.EqualValues(t, tc.expGenesis, gotGen)
 		})
 	}
 }
 
+// TestSlashRecordGenesisRoundTrip tests that the slash record of a pending slash packet
+// survives an export and import of the consumer genesis, e.g., across a consumer upgrade
+func TestSlashRecordGenesisRoundTrip(t *testing.T) {
+	provClientID := "tendermint-07"
+	provChannelID := "provChannelID"
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	abciValidator := abci.Validator{Address: pubKey.Address(), Power: int64(1)}
+
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	// populate the consumer state of a chain that is waiting on the provider to handle a slash packet
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetProviderClientID(ctx, provClientID)
+	consumerKeeper.SetProviderChannel(ctx, provChannelID)
+	cVal, err := consumertypes.NewCCValidator(pubKey.Address(), 1, pubKey)
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, cVal)
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: ccv.NewSlashPacketData(abciValidator, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+	})
+	slashRecord := consumertypes.SlashRecord{
+		WaitingOnReply: false,
+		SendTime:       time.Now().UTC(),
+		SendAttempts:   3,
+	}
+	consumerKeeper.SetSlashRecord(ctx, slashRecord)
+
+	exportedGenesis := consumerKeeper.ExportGenesis(ctx)
+	require.NotNil(t, exportedGenesis.SlashRecord)
+	require.Equal(t, slashRecord, *exportedGenesis.SlashRecord)
+	require.NoError(t, exportedGenesis.Validate())
+
+	// import the exported genesis into a fresh consumer chain
+	restartedKeeper, restartedCtx, restartedCtrl, restartedMocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer restartedCtrl.Finish()
+	gomock.InOrder(
+		testkeeper.ExpectGetCapabilityMock(restartedCtx, restartedMocks, 1),
+	)
+	restartedKeeper.InitGenesis(restartedCtx, exportedGenesis)
+
+	gotSlashRecord, found := restartedKeeper.GetSlashRecord(restartedCtx)
+	require.True(t, found)
+	require.Equal(t, slashRecord, gotSlashRecord)
+	require.Equal(t, consumerKeeper.GetPendingPackets(ctx), restartedKeeper.GetPendingPackets(restartedCtx))
+	// the slash packet is retried after the restart as it was bounced before the export
+	require.False(t, restartedKeeper.PacketSendingPermitted(restartedCtx.WithBlockTime(slashRecord.SendTime)))
+	require.True(t, restartedKeeper.PacketSendingPermitted(
+		restartedCtx.WithBlockTime(slashRecord.SendTime.Add(params.RetryDelayPeriod).Add(time.Second))))
+
+	// exporting again results in the same genesis
+	require.Equal(t, exportedGenesis, restartedKeeper.ExportGenesis(restartedCtx))
+
+	// no slash record is exported when no slash packet is waiting to be handled
+	consumerKeeper.ClearSlashRecord(ctx)
+	require.Nil(t, consumerKeeper.ExportGenesis(ctx).SlashRecord)
+}
+
+// TestPendingPacketsAndOutstandingDowntimesGenesisRoundTrip tests that the pending packets queue and
+// the outstanding downtime flags survive an export and import of the consumer genesis, e.g., across a consumer upgrade
+func TestPendingPacketsAndOutstandingDowntimesGenesisRoundTrip(t *testing.T) {
+	provClientID := "tendermint-07"
+	provChannelID := "provChannelID"
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	abciValidator := abci.Validator{Address: pubKey.Address(), Power: int64(1)}
+	downtimeAddrs := []sdk.ConsAddress{
+		sdk.ConsAddress(pubKey.Address()),
+		sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	// populate the consumer state of a chain with an established CCV channel
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetProviderClientID(ctx, provClientID)
+	consumerKeeper.SetProviderChannel(ctx, provChannelID)
+	cVal, err := consumertypes.NewCCValidator(pubKey.Address(), 1, pubKey)
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, cVal)
+	for _, addr := range downtimeAddrs {
+		consumerKeeper.SetOutstandingDowntime(ctx, addr)
+	}
+
+	// enqueue three packets and send the first one, so that the pending packets queue starts at index 1
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(1),
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: ccv.NewSlashPacketData(abciValidator, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(2),
+	})
+	consumerKeeper.DeleteHeadOfPendingPackets(ctx)
+	pendingPackets := consumerKeeper.GetAllPendingPacketsWithIdx(ctx)
+	require.Len(t, pendingPackets, 2)
+
+	exportedGenesis := consumerKeeper.ExportGenesis(ctx)
+	require.NoError(t, exportedGenesis.Validate())
+	require.Equal(t, []uint64{1, 2}, exportedGenesis.PendingConsumerPacketIndices)
+	require.Len(t, exportedGenesis.OutstandingDowntimeSlashing, len(downtimeAddrs))
+
+	// import the exported genesis into a fresh consumer chain
+	importGenesis := func(genesis *consumertypes.GenesisState) (consumerkeeper.Keeper, sdk.Context) {
+		restartedKeeper, restartedCtx, restartedCtrl, restartedMocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		t.Cleanup(restartedCtrl.Finish)
+		gomock.InOrder(
+			testkeeper.ExpectGetCapabilityMock(restartedCtx, restartedMocks, 1),
+		)
+		restartedKeeper.InitGenesis(restartedCtx, genesis)
+		return restartedKeeper, restartedCtx
+	}
+	restartedKeeper, restartedCtx := importGenesis(exportedGenesis)
+
+	require.Equal(t, pendingPackets, restartedKeeper.GetAllPendingPacketsWithIdx(restartedCtx))
+	for _, addr := range downtimeAddrs {
+		require.True(t, restartedKeeper.OutstandingDowntime(restartedCtx, addr))
+	}
+	require.Equal(t, consumerKeeper.GetAllOutstandingDowntimes(ctx), restartedKeeper.GetAllOutstandingDowntimes(restartedCtx))
+
+	// exporting again results in the same genesis
+	require.Equal(t, exportedGenesis, restartedKeeper.ExportGenesis(restartedCtx))
+
+	// packets enqueued after the restart are appended to the end of the queue
+	restartedKeeper.AppendPendingPacket(restartedCtx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(3),
+	})
+	restartedPackets := restartedKeeper.GetAllPendingPacketsWithIdx(restartedCtx)
+	require.Len(t, restartedPackets, 3)
+	require.Equal(t, uint64(3), restartedPackets[2].Idx)
+	require.Equal(t, uint64(3), restartedPackets[2].GetVscMaturedPacketData().ValsetUpdateId)
+
+	// a genesis exported without the pending packet indices, e.g., by a previous version,
+	// enqueues the pending packets in the same order starting from index zero
+	legacyGenesis := *exportedGenesis
+	legacyGenesis.PendingConsumerPacketIndices = nil
+	require.NoError(t, legacyGenesis.Validate())
+	legacyKeeper, legacyCtx := importGenesis(&legacyGenesis)
+	legacyPackets := legacyKeeper.GetAllPendingPacketsWithIdx(legacyCtx)
+	require.Len(t, legacyPackets, len(pendingPackets))
+	for i, packet := range legacyPackets {
+		require.Equal(t, uint64(i), packet.Idx)
+		require.Equal(t, pendingPackets[i].ConsumerPacketData, packet.ConsumerPacketData)
+	}
+}
+
 // assert that the default CCV consumer port ID is stored and bounded
 func assertConsumerPortIsBound(t *testing.T, ctx sdk.Context, ck *consumerkeeper.Keeper) {
 	t.Helper()
@@ -74,3 +74,142 @@ func (k Keeper) QueryThrottleState(c context.Context,
 	}
 	return &resp, nil
 }
+
+func (k Keeper) QuerySlashPacketRetryState(c context.Context, //nolint:golint
+	req *types.QuerySlashPacketRetryStateRequest,
+) (*types.QuerySlashPacketRetryStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := types.QuerySlashPacketRetryStateResponse{
+		SlashPackets: []types.SlashPacketRetryState{},
+	}
+
+	slashRecord, slashRecordFound := k.GetSlashRecord(ctx)
+	retryDelayPeriod := k.GetRetryDelayPeriod(ctx)
+
+	for _, packet := range k.GetPendingPackets(ctx) {
+		if packet.Type != ccvtypes.SlashPacket {
+			continue
+		}
+		data := packet.GetSlashPacketData()
+		state := types.SlashPacketRetryState{
+			ValidatorAddress: sdk.ConsAddress(data.Validator.Address).String(),
+			Infraction:       data.Infraction,
+			ValsetUpdateId:   data.ValsetUpdateId,
+		}
+		// the slash record refers to the first slash packet in the queue,
+		// as no other packets are sent until it is handled by the provider
+		if len(resp.SlashPackets) == 0 && slashRecordFound {
+			lastAttemptTime := slashRecord.SendTime
+			nextRetryTime := slashRecord.SendTime.Add(retryDelayPeriod)
+			state.SendAttempts = slashRecord.SendAttempts
+			state.LastAttemptTime = &lastAttemptTime
+			state.NextRetryTime = &nextRetryTime
+			state.WaitingOnReply = slashRecord.WaitingOnReply
+		}
+		resp.SlashPackets = append(resp.SlashPackets, state)
+	}
+
+	return &resp, nil
+}
+
+func (k Keeper) QueryHistoricalEntries(c context.Context, //nolint:golint
+	req *types.QueryHistoricalEntriesRequest,
+) (*types.QueryHistoricalEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryHistoricalEntriesResponse{
+		HistoricalEntries: k.GetHistoricalEntries(ctx),
+		Heights:           k.GetAllHistoricalInfoHeights(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryProviderClientExpiry(c context.Context, //nolint:golint
+	req *types.QueryProviderClientExpiryRequest,
+) (*types.QueryProviderClientExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "provider client not found")
+	}
+	expiryTime, _, err := k.GetProviderClientExpiryTime(ctx, clientID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	haltHeight, expired := k.GetProviderClientExpired(ctx)
+
+	return &types.QueryProviderClientExpiryResponse{
+		ClientId:   clientID,
+		ExpiryTime: expiryTime,
+		Expired:    expired,
+		HaltHeight: haltHeight,
+	}, nil
+}
+
+func (k Keeper) QueryPendingVSCMaturities(c context.Context, //nolint:golint
+	req *types.QueryPendingVSCMaturitiesRequest,
+) (*types.QueryPendingVSCMaturitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingVSCMaturitiesResponse{
+		PendingMaturities: k.GetPendingVSCMaturities(ctx),
+		Counters:          k.GetVSCMaturityCounters(ctx),
+	}, nil
+}
+
+func (k Keeper) QueryProviderUpgradeNotice(c context.Context, //nolint:golint
+	req *types.QueryProviderUpgradeNoticeRequest,
+) (*types.QueryProviderUpgradeNoticeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryProviderUpgradeNoticeResponse{}
+	if notice, found := k.GetProviderUpgradeNotice(ctx); found {
+		resp.Notice = &notice
+	}
+	return resp, nil
+}
+
+func (k Keeper) QueryValsetStaleness(c context.Context, //nolint:golint
+	req *types.QueryValsetStalenessRequest,
+) (*types.QueryValsetStalenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingVSCMaturedPackets := k.GetPendingVSCMaturedPacketsCount(ctx)
+	resp := &types.QueryValsetStalenessResponse{
+		PendingVscMaturedPackets: pendingVSCMaturedPackets,
+		MaturitySendsBackedUp:    pendingVSCMaturedPackets > 0,
+		StalenessThreshold:       k.GetValsetStalenessThreshold(ctx),
+	}
+	if lastVSC, found := k.GetLastAppliedVSC(ctx); found {
+		resp.LastAppliedVsc = &lastVSC
+		resp.BlocksSinceLastVsc = ctx.BlockHeight() - lastVSC.Height
+		resp.TimeSinceLastVsc = ctx.BlockTime().Sub(lastVSC.Time)
+		resp.Stale = resp.StalenessThreshold > 0 && resp.TimeSinceLastVsc > resp.StalenessThreshold
+	}
+	return resp, nil
+}
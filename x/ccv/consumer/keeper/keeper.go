@@ -3,6 +3,8 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/spf13/cast"
 
 	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
@@ -19,11 +22,16 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmtypes "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
@@ -47,13 +55,17 @@ type Keeper struct {
 	// before the chain went through a standalone to consumer changeover.
 	// This keeper is not used for consumers that launched with ICS, and is therefore set after the constructor.
 	standaloneStakingKeeper ccv.StakingKeeper
-	slashingKeeper          ccv.SlashingKeeper
-	hooks                   ccv.ConsumerHooks
-	bankKeeper              ccv.BankKeeper
-	authKeeper              ccv.AccountKeeper
-	ibcTransferKeeper       ccv.IBCTransferKeeper
-	ibcCoreKeeper           ccv.IBCCoreKeeper
-	feeCollectorName        string
+	// localValidatorPubKey is the consensus public key the local node signs with.
+	// It is node-local configuration that is optionally set after the constructor
+	// and it is only used to detect misconfigured validator nodes.
+	localValidatorPubKey *tmprotocrypto.PublicKey
+	slashingKeeper       ccv.SlashingKeeper
+	hooks                ccv.ConsumerHooks
+	bankKeeper           ccv.BankKeeper
+	authKeeper           ccv.AccountKeeper
+	ibcTransferKeeper    ccv.IBCTransferKeeper
+	ibcCoreKeeper        ccv.IBCCoreKeeper
+	feeCollectorName     string
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -121,17 +133,53 @@ func (k *Keeper) SetStandaloneStakingKeeper(sk ccv.StakingKeeper) {
 	k.standaloneStakingKeeper = sk
 }
 
+// SetLocalValidatorPubKey sets the consensus public key the local node signs with,
+// e.g., as read by the app from the node's private validator key file.
+func (k *Keeper) SetLocalValidatorPubKey(pubKey tmprotocrypto.PublicKey) {
+	k.localValidatorPubKey = &pubKey
+}
+
+// LoadLocalValidatorPubKey loads the consensus public key from the private validator key file of the node,
+// as configured in the app options. It returns false if the node does not sign with a private validator
+// key file, e.g., if it is not a validator node or if it uses a remote signer.
+func LoadLocalValidatorPubKey(appOpts servertypes.AppOptions, homePath string) (tmprotocrypto.PublicKey, bool, error) {
+	keyFile := cast.ToString(appOpts.Get("priv_validator_key_file"))
+	if keyFile == "" || cast.ToString(appOpts.Get("priv_validator_laddr")) != "" {
+		return tmprotocrypto.PublicKey{}, false, nil
+	}
+	if !filepath.IsAbs(keyFile) {
+		keyFile = filepath.Join(homePath, keyFile)
+	}
+
+	bz, err := os.ReadFile(keyFile)
+	if os.IsNotExist(err) {
+		return tmprotocrypto.PublicKey{}, false, nil
+	} else if err != nil {
+		return tmprotocrypto.PublicKey{}, false, err
+	}
+	var pvKey privval.FilePVKey
+	if err := tmjson.Unmarshal(bz, &pvKey); err != nil {
+		return tmprotocrypto.PublicKey{}, false, fmt.Errorf("failed to unmarshal private validator key file %s: %w", keyFile, err)
+	}
+	pubKey, err := cryptoenc.PubKeyToProto(pvKey.PubKey)
+	if err != nil {
+		return tmprotocrypto.PublicKey{}, false, err
+	}
+	return pubKey, true, nil
+}
+
 // Validates that the consumer keeper is initialized with non-zero and
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 19 {
-		panic("number of fields in consumer keeper is not 16")
+	if reflect.ValueOf(k).NumField() != 20 {
+		panic("number of fields in consumer keeper is not 20")
 	}
 
-	// Note 116 / 16 fields will be validated,
+	// Note 16 / 20 fields will be validated,
 	// hooks are explicitly set after the constructor,
 	// stakingKeeper is optionally set after the constructor,
+	// localValidatorPubKey is optionally set after the constructor,
 
 	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                           // 1
 	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 2
@@ -409,12 +457,14 @@ func (k Keeper) GetAllPacketMaturityTimes(ctx sdk.Context) (maturingVSCPackets [
 	return maturingVSCPackets
 }
 
-// SetPacketMaturityTime sets the maturity time for a given received VSC packet id
-func (k Keeper) SetPacketMaturityTime(ctx sdk.Context, vscId uint64, maturityTime time.Time) {
+// SetPacketMaturityTime sets the maturity time for a given received VSC packet id,
+// together with the time at which the VSC packet was received
+func (k Keeper) SetPacketMaturityTime(ctx sdk.Context, vscId uint64, receivedTime, maturityTime time.Time) {
 	store := ctx.KVStore(k.storeKey)
 	maturingVSCPacket := types.MaturingVSCPacket{
 		VscId:        vscId,
 		MaturityTime: maturityTime,
+		ReceivedTime: receivedTime,
 	}
 	bz, err := maturingVSCPacket.Marshal()
 	if err != nil {
@@ -689,15 +739,26 @@ func (k Keeper) DeleteAllPendingDataPackets(ctx sdk.Context) {
 // AppendPendingPacket enqueues the given data packet to the end of the pending data packets queue
 func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPacketDataType, data ccv.ExportedIsConsumerPacketData_Data) {
 	idx := k.getAndIncrementPendingPacketsIdx(ctx) // for FIFO queue
-	key := types.PendingDataPacketsV1Key(idx)
+	k.SetPendingPacketWithIdx(ctx, idx, ccv.NewConsumerPacketData(packetType, data))
+}
+
+// SetPendingPacketWithIdx stores the given data packet under the given index of the pending data packets queue.
+//
+// Note that this method does not update the pending packets index, see SetPendingPacketsIdx.
+func (k Keeper) SetPendingPacketWithIdx(ctx sdk.Context, idx uint64, cpd ccv.ConsumerPacketData) {
 	store := ctx.KVStore(k.storeKey)
-	cpd := ccv.NewConsumerPacketData(packetType, data)
 	bz, err := cpd.Marshal()
 	if err != nil {
 		// This should never happen
 		panic(fmt.Errorf("failed to marshal ConsumerPacketData: %w", err))
 	}
-	store.Set(key, bz)
+	store.Set(types.PendingDataPacketsV1Key(idx), bz)
+}
+
+// SetPendingPacketsIdx sets the index under which the next data packet is enqueued in the pending data packets queue
+func (k Keeper) SetPendingPacketsIdx(ctx sdk.Context, idx uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingPacketsIndexKey(), sdk.Uint64ToBigEndian(idx))
 }
 
 func (k Keeper) MarkAsPrevStandaloneChain(ctx sdk.Context) {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"bytes"
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
@@ -12,13 +13,18 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmed25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/privval"
 
 	"github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/consumer/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
@@ -229,7 +235,7 @@ func TestPacketMaturityTime(t *testing.T) {
 
 	// test SetPacketMaturityTime
 	for _, packet := range packets {
-		ck.SetPacketMaturityTime(ctx, packet.VscId, packet.MaturityTime)
+		ck.SetPacketMaturityTime(ctx, packet.VscId, packet.ReceivedTime, packet.MaturityTime)
 	}
 
 	// test PacketMaturityTimeExists
@@ -609,3 +615,36 @@ func TestDeleteHeadOfPendingPackets(t *testing.T) {
 	require.Len(t, pp, 1)
 	require.Equal(t, pp[0].Type, ccv.VscMaturedPacket)
 }
+
+// TestLoadLocalValidatorPubKey tests that the consensus public key of the node is loaded
+// from its private validator key file, unless the node signs with a remote signer
+func TestLoadLocalValidatorPubKey(t *testing.T) {
+	homePath := t.TempDir()
+	filePV := privval.NewFilePV(tmed25519.GenPrivKey(), filepath.Join(homePath, "priv_validator_key.json"), filepath.Join(homePath, "priv_validator_state.json"))
+	filePV.Save()
+	expectedPubKey, err := cryptoenc.PubKeyToProto(filePV.Key.PubKey)
+	require.NoError(t, err)
+
+	// the key file path is relative to the home directory
+	pubKey, found, err := consumerkeeper.LoadLocalValidatorPubKey(simtestutil.AppOptionsMap{
+		"priv_validator_key_file": "priv_validator_key.json",
+	}, homePath)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, expectedPubKey, pubKey)
+
+	// the node uses a remote signer
+	_, found, err = consumerkeeper.LoadLocalValidatorPubKey(simtestutil.AppOptionsMap{
+		"priv_validator_key_file": "priv_validator_key.json",
+		"priv_validator_laddr":    "tcp://127.0.0.1:26659",
+	}, homePath)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// the node has no key file
+	_, found, err = consumerkeeper.LoadLocalValidatorPubKey(simtestutil.AppOptionsMap{
+		"priv_validator_key_file": "missing_key.json",
+	}, homePath)
+	require.NoError(t, err)
+	require.False(t, found)
+}
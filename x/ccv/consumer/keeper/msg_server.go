@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
@@ -38,3 +41,27 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ApproveProviderClientSubstitute approves the substitution of the provider client with the given substitute client
+func (k msgServer) ApproveProviderClientSubstitute(goCtx context.Context, msg *types.MsgApproveProviderClientSubstitute) (*types.MsgApproveProviderClientSubstituteResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.SubstituteClientId); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid substitute client id: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if providerClientID, found := k.GetProviderClientID(ctx); found && providerClientID == msg.SubstituteClientId {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the provider client %s cannot be its own substitute", providerClientID)
+	}
+	if _, found := k.clientKeeper.GetClientState(ctx, msg.SubstituteClientId); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot find substitute client %s", msg.SubstituteClientId)
+	}
+
+	k.Keeper.SetApprovedProviderClientSubstitute(ctx, msg.SubstituteClientId)
+
+	return &types.MsgApproveProviderClientSubstituteResponse{}, nil
+}
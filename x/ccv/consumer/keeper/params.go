@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -95,6 +97,13 @@ func (k Keeper) GetConsumerRedistributionFrac(ctx sdk.Context) string {
 	return params.ConsumerRedistributionFraction
 }
 
+// GetDenomRedistributionFractions returns the denom-specific overrides of the fraction of tokens
+// allocated to the consumer redistribution address during distribution events
+func (k Keeper) GetDenomRedistributionFractions(ctx sdk.Context) []ccvtypes.DenomRedistributionFraction {
+	params := k.GetConsumerParams(ctx)
+	return params.DenomRedistributionFractions
+}
+
 // GetHistoricalEntries returns the number of historical info entries to persist in store
 func (k Keeper) GetHistoricalEntries(ctx sdk.Context) int64 {
 	params := k.GetConsumerParams(ctx)
@@ -128,7 +137,86 @@ func (k Keeper) GetRetryDelayPeriod(ctx sdk.Context) time.Duration {
 	return params.RetryDelayPeriod
 }
 
+// GetProviderClientExpiryWarningFraction returns the fraction of the trusting period of the provider client
+// below which warning events are emitted
+func (k Keeper) GetProviderClientExpiryWarningFraction(ctx sdk.Context) string {
+	params := k.GetConsumerParams(ctx)
+	return params.ProviderClientExpiryWarningFraction
+}
+
+// GetHaltOnProviderClientExpiry returns whether the consumer chain halts once the provider client expired
+func (k Keeper) GetHaltOnProviderClientExpiry(ctx sdk.Context) bool {
+	params := k.GetConsumerParams(ctx)
+	return params.HaltOnProviderClientExpiry
+}
+
+// GetProviderClientExpiryHaltDelay returns the number of blocks after the expiry of the provider client
+// is detected at which the consumer chain halts
+func (k Keeper) GetProviderClientExpiryHaltDelay(ctx sdk.Context) int64 {
+	params := k.GetConsumerParams(ctx)
+	return params.ProviderClientExpiryHaltDelay
+}
+
+// GetExpectedProviderEpochDuration returns the expected duration of an epoch of the provider chain
+func (k Keeper) GetExpectedProviderEpochDuration(ctx sdk.Context) time.Duration {
+	params := k.GetConsumerParams(ctx)
+	return params.ExpectedProviderEpochDuration
+}
+
+// GetValsetStalenessWarningEpochs returns the number of expected provider epochs without any VSC packet
+// applied after which warning events are emitted
+func (k Keeper) GetValsetStalenessWarningEpochs(ctx sdk.Context) int64 {
+	params := k.GetConsumerParams(ctx)
+	return params.ValsetStalenessWarningEpochs
+}
+
+// GetProviderAcceptsHandshakeMetadata returns whether the provider chain accepts the handshake metadata
+// of the consumer chain in OnChanOpenTry
+func (k Keeper) GetProviderAcceptsHandshakeMetadata(ctx sdk.Context) bool {
+	params := k.GetConsumerParams(ctx)
+	return params.ProviderAcceptsHandshakeMetadata
+}
+
 func (k Keeper) GetConsumerId(ctx sdk.Context) string {
 	params := k.GetConsumerParams(ctx)
 	return params.ConsumerId
 }
+
+// RegisterConsumerDenomMetadata registers the consumer denom metadata carried in the
+// consumer params with the bank keeper, unless metadata for that denom is already present
+func (k Keeper) RegisterConsumerDenomMetadata(ctx sdk.Context) {
+	params := k.GetConsumerParams(ctx)
+	if params.ConsumerDenomMetadata == nil {
+		return
+	}
+	if k.bankKeeper.HasDenomMetaData(ctx, params.ConsumerDenom) {
+		return
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, *params.ConsumerDenomMetadata)
+}
+
+// ApplyDowntimeWindowParams overrides the SignedBlocksWindow and MinSignedPerWindow params
+// of the slashing module with the values carried in the consumer params, if any.
+// Note that unset values (i.e., zero and empty) keep the values of the genesis file.
+func (k Keeper) ApplyDowntimeWindowParams(ctx sdk.Context) error {
+	params := k.GetConsumerParams(ctx)
+	if params.SignedBlocksWindow == 0 && params.MinSignedPerWindow == "" {
+		return nil
+	}
+
+	slashingParams, err := k.slashingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.SignedBlocksWindow != 0 {
+		slashingParams.SignedBlocksWindow = params.SignedBlocksWindow
+	}
+	if params.MinSignedPerWindow != "" {
+		minSignedPerWindow, err := math.LegacyNewDecFromStr(params.MinSignedPerWindow)
+		if err != nil {
+			return err
+		}
+		slashingParams.MinSignedPerWindow = minSignedPerWindow
+	}
+	return k.slashingKeeper.SetParams(ctx, slashingParams)
+}
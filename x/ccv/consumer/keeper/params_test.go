@@ -32,6 +32,17 @@ func TestParams(t *testing.T) {
 		provideRewardDenoms,
 		ccv.DefaultRetryDelayPeriod,
 		"0",
+		"",
+		nil,
+
+		ccv.DefaultProviderClientExpiryWarningFraction,
+		ccv.DefaultHaltOnProviderClientExpiry,
+		ccv.DefaultProviderClientExpiryHaltDelay,
+		0,
+		"",
+		nil,
+		ccv.DefaultExpectedProviderEpochDuration,
+		ccv.DefaultValsetStalenessWarningEpochs,
 	) // these are the default params, IBC suite independently sets enabled=true
 
 	params := consumerKeeper.GetConsumerParams(ctx)
@@ -39,10 +50,12 @@ func TestParams(t *testing.T) {
 
 	newParams := ccv.NewParams(false, 1000,
 		"channel-2", "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm",
-		7*24*time.Hour, 25*time.Hour, "0.5", 500, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, "1")
+		7*24*time.Hour, 25*time.Hour, "0.5", 500, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, "1", "", nil, "0.5", true, 100, 1000, "0.1",
+		[]ccv.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}}, 2*time.Hour, 3)
 	consumerKeeper.SetParams(ctx, newParams)
 	params = consumerKeeper.GetConsumerParams(ctx)
 	require.Equal(t, newParams, params)
+	require.Equal(t, 6*time.Hour, consumerKeeper.GetValsetStalenessThreshold(ctx))
 
 	consumerKeeper.SetBlocksPerDistributionTransmission(ctx, 10)
 	gotBPDT := consumerKeeper.GetBlocksPerDistributionTransmission(ctx)
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"time"
+
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+)
+
+// SetProviderClientExpired marks the provider client as expired and stores the height
+// at which the consumer chain halts as a result (zero if the chain does not halt)
+func (k Keeper) SetProviderClientExpired(ctx sdk.Context, haltHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(haltHeight))
+	store.Set(types.ProviderClientExpiredKey(), bz)
+}
+
+// GetProviderClientExpired returns whether the expiry of the provider client was detected
+// and the height at which the consumer chain halts as a result (zero if the chain does not halt)
+func (k Keeper) GetProviderClientExpired(ctx sdk.Context) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderClientExpiredKey())
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// DeleteProviderClientExpired clears the expiry of the provider client
+func (k Keeper) DeleteProviderClientExpired(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProviderClientExpiredKey())
+}
+
+// GetProviderClientExpiryTime returns the time at which the provider client expires, i.e.,
+// the timestamp of its latest consensus state plus its trusting period, together with its trusting period
+func (k Keeper) GetProviderClientExpiryTime(ctx sdk.Context, clientID string) (time.Time, time.Duration, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return time.Time{}, 0, errorsmod.Wrapf(types.ErrInvalidProviderClient, "client state not found for client %s", clientID)
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return time.Time{}, 0, errorsmod.Wrapf(types.ErrInvalidProviderClient, "unexpected client state type %T", clientState)
+	}
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, tmClientState.LatestHeight)
+	if !found {
+		return time.Time{}, 0, errorsmod.Wrapf(types.ErrInvalidProviderClient,
+			"consensus state not found for client %s at height %s", clientID, tmClientState.LatestHeight)
+	}
+	tmConsensusState, ok := consensusState.(*ibctm.ConsensusState)
+	if !ok {
+		return time.Time{}, 0, errorsmod.Wrapf(types.ErrInvalidProviderClient, "unexpected consensus state type %T", consensusState)
+	}
+	return tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod), tmClientState.TrustingPeriod, nil
+}
+
+// CheckProviderClientExpiry compares the time at which the provider client expires with the current block time.
+//
+// While less than ProviderClientExpiryWarningFraction of the trusting period remains, a provider_client_expiring
+// event is emitted every block, with its severity escalating from warning to critical once less than half of that
+// remains. Once the provider client expired, it is marked as expired and a provider_client_expired event is emitted
+// every block. If HaltOnProviderClientExpiry is set, the consumer chain halts (i.e., panics) ProviderClientExpiryHaltDelay
+// blocks after the expiry was detected; the halt height is announced in the provider_client_expired events so that the
+// validators can coordinate the recovery of the chain.
+//
+// Note that if the provider client is recovered (e.g., substituted through governance) before the chain halts,
+// the expiry of the provider client is cleared.
+func (k Keeper) CheckProviderClientExpiry(ctx sdk.Context) error {
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		// the provider client is not yet created, e.g., before the changeover of a standalone chain
+		return nil
+	}
+
+	expiryTime, trustingPeriod, err := k.GetProviderClientExpiryTime(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	timeUntilExpiry := expiryTime.Sub(ctx.BlockTime())
+	haltHeight, expired := k.GetProviderClientExpired(ctx)
+
+	if timeUntilExpiry > 0 {
+		if expired {
+			k.DeleteProviderClientExpired(ctx)
+			k.Logger(ctx).Info("provider client was recovered", "provider client", clientID, "expiry time", expiryTime)
+		}
+
+		warningFraction := k.GetProviderClientExpiryWarningFraction(ctx)
+		if warningFraction == "" {
+			return nil
+		}
+		fraction, err := math.LegacyNewDecFromStr(warningFraction)
+		if err != nil {
+			return fmt.Errorf("parsing provider client expiry warning fraction: %w", err)
+		}
+		warningPeriod := time.Duration(fraction.MulInt64(int64(trustingPeriod)).TruncateInt64())
+		if timeUntilExpiry >= warningPeriod {
+			return nil
+		}
+
+		severity := types.SeverityWarning
+		if timeUntilExpiry < warningPeriod/2 {
+			severity = types.SeverityCritical
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProviderClientExpiring,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeProviderClientID, clientID),
+				sdk.NewAttribute(types.AttributeProviderClientExpiryTime, expiryTime.UTC().String()),
+				sdk.NewAttribute(types.AttributeTimeUntilExpiry, timeUntilExpiry.String()),
+				sdk.NewAttribute(types.AttributeSeverity, severity),
+			),
+		)
+		return nil
+	}
+
+	haltOnExpiry := k.GetHaltOnProviderClientExpiry(ctx)
+	if !expired || (haltOnExpiry && haltHeight == 0) {
+		if haltOnExpiry {
+			haltHeight = ctx.BlockHeight() + k.GetProviderClientExpiryHaltDelay(ctx)
+		}
+		k.SetProviderClientExpired(ctx, haltHeight)
+		k.Logger(ctx).Error("provider client expired - the consumer chain cannot receive validator set updates anymore",
+			"provider client", clientID,
+			"expiry time", expiryTime,
+			"halt height", haltHeight,
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderClientExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProviderClientID, clientID),
+			sdk.NewAttribute(types.AttributeProviderClientExpiryTime, expiryTime.UTC().String()),
+			sdk.NewAttribute(types.AttributeHaltHeight, strconv.FormatInt(haltHeight, 10)),
+		),
+	)
+
+	if haltOnExpiry && haltHeight > 0 && ctx.BlockHeight() >= haltHeight {
+		panic(fmt.Sprintf("halting consumer chain at height %d since the provider client %s expired at %s",
+			ctx.BlockHeight(), clientID, expiryTime))
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+)
+
+// TestCheckProviderClientExpiry tests that events are emitted while the provider client is about to expire,
+// that the provider client is marked as expired once it expired, and that the chain halts if enabled
+func TestCheckProviderClientExpiry(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// without a provider client there is nothing to check
+	require.NoError(t, consumerKeeper.CheckProviderClientExpiry(ctx))
+	_, err := consumerKeeper.QueryProviderClientExpiry(ctx, &types.QueryProviderClientExpiryRequest{})
+	require.Error(t, err)
+
+	clientID := "07-tendermint-0"
+	trustingPeriod := 30 * time.Hour
+	latestHeight := clienttypes.NewHeight(0, 10)
+	consensusTimestamp := time.Unix(1000000, 0).UTC()
+	consumerKeeper.SetProviderClientID(ctx, clientID)
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), clientID).Return(
+		&ibctmtypes.ClientState{TrustingPeriod: trustingPeriod, LatestHeight: latestHeight}, true,
+	).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientID, latestHeight).DoAndReturn(
+		func(sdk.Context, string, ibcexported.Height) (ibcexported.ConsensusState, bool) {
+			return &ibctmtypes.ConsensusState{Timestamp: consensusTimestamp}, true
+		},
+	).AnyTimes()
+
+	params := consumerKeeper.GetConsumerParams(ctx)
+	params.ProviderClientExpiryWarningFraction = "0.33"
+	params.HaltOnProviderClientExpiry = false
+	params.ProviderClientExpiryHaltDelay = 5
+	consumerKeeper.SetParams(ctx, params)
+
+	// checkAtTime checks the expiry of the provider client at the given time and height
+	// and returns the emitted events
+	checkAtTime := func(blockTime time.Time, height int64) sdk.Events {
+		ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, consumerKeeper.CheckProviderClientExpiry(ctx))
+		return ctx.EventManager().Events()
+	}
+	requireEvent := func(events sdk.Events, eventType, attrKey, attrValue string) {
+		require.Len(t, events, 1)
+		require.Equal(t, eventType, events[0].Type)
+		attr, found := events[0].GetAttribute(attrKey)
+		require.True(t, found)
+		require.Equal(t, attrValue, attr.Value)
+	}
+
+	// more than a third of the trusting period remains
+	events := checkAtTime(consensusTimestamp.Add(10*time.Hour), 1)
+	require.Empty(t, events)
+
+	// less than a third of the trusting period remains
+	events = checkAtTime(consensusTimestamp.Add(21*time.Hour), 2)
+	requireEvent(events, types.EventTypeProviderClientExpiring, types.AttributeSeverity, types.SeverityWarning)
+
+	// less than a sixth of the trusting period remains
+	events = checkAtTime(consensusTimestamp.Add(26*time.Hour), 3)
+	requireEvent(events, types.EventTypeProviderClientExpiring, types.AttributeSeverity, types.SeverityCritical)
+	_, expired := consumerKeeper.GetProviderClientExpired(ctx)
+	require.False(t, expired)
+
+	// no warnings are emitted if the warning fraction is not set
+	params.ProviderClientExpiryWarningFraction = ""
+	consumerKeeper.SetParams(ctx, params)
+	events = checkAtTime(consensusTimestamp.Add(26*time.Hour), 4)
+	require.Empty(t, events)
+
+	// the provider client expired, but the chain does not halt
+	events = checkAtTime(consensusTimestamp.Add(trustingPeriod), 5)
+	requireEvent(events, types.EventTypeProviderClientExpired, types.AttributeHaltHeight, "0")
+	haltHeight, expired := consumerKeeper.GetProviderClientExpired(ctx)
+	require.True(t, expired)
+	require.Zero(t, haltHeight)
+
+	res, err := consumerKeeper.QueryProviderClientExpiry(ctx, &types.QueryProviderClientExpiryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryProviderClientExpiryResponse{
+		ClientId:   clientID,
+		ExpiryTime: consensusTimestamp.Add(trustingPeriod),
+		Expired:    true,
+		HaltHeight: 0,
+	}, res)
+
+	// once halting is enabled, the chain halts after the halt delay
+	params.HaltOnProviderClientExpiry = true
+	consumerKeeper.SetParams(ctx, params)
+	events = checkAtTime(consensusTimestamp.Add(trustingPeriod+time.Hour), 100)
+	requireEvent(events, types.EventTypeProviderClientExpired, types.AttributeHaltHeight, "105")
+	for height := int64(101); height < 105; height++ {
+		events = checkAtTime(consensusTimestamp.Add(trustingPeriod+time.Hour), height)
+		requireEvent(events, types.EventTypeProviderClientExpired, types.AttributeHaltHeight, "105")
+	}
+	require.Panics(t, func() {
+		checkAtTime(consensusTimestamp.Add(trustingPeriod+2*time.Hour), 105)
+	})
+
+	// the expiry is cleared if the provider client is recovered before the halt height
+	consensusTimestamp = consensusTimestamp.Add(trustingPeriod + time.Hour)
+	events = checkAtTime(consensusTimestamp.Add(time.Hour), 104)
+	require.Empty(t, events)
+	_, expired = consumerKeeper.GetProviderClientExpired(ctx)
+	require.False(t, expired)
+	require.NotPanics(t, func() {
+		checkAtTime(consensusTimestamp.Add(2*time.Hour), 105)
+	})
+}
@@ -48,6 +48,7 @@ func (k Keeper) GetProviderInfo(ctx sdk.Context) (*types.QueryProviderInfoRespon
 			ConnectionID: providerConnection.GetConnectionID(),
 			ChannelID:    providerChannelID,
 		},
+		ProviderFeePoolAddr: k.GetProviderFeePoolAddrStr(ctx),
 	}
 
 	return &resp, nil
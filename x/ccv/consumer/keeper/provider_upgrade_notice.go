@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// GetProviderUpgradeNotice returns the latest notice about an upgrade of the provider chain,
+// and false if no upgrade of the provider chain is scheduled
+func (k Keeper) GetProviderUpgradeNotice(ctx sdk.Context) (ccv.ProviderUpgradeNotice, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderUpgradeNoticeKey())
+	if bz == nil {
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+	var notice ccv.ProviderUpgradeNotice
+	if err := notice.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the notice is assumed to be correctly serialized in SetProviderUpgradeNotice.
+		panic(fmt.Errorf("failed to unmarshal ProviderUpgradeNotice: %w", err))
+	}
+	return notice, true
+}
+
+// SetProviderUpgradeNotice sets the latest notice about an upgrade of the provider chain
+func (k Keeper) SetProviderUpgradeNotice(ctx sdk.Context, notice ccv.ProviderUpgradeNotice) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := notice.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the notice is validated when the VSC packet is received and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal ProviderUpgradeNotice: %w", err))
+	}
+	store.Set(types.ProviderUpgradeNoticeKey(), bz)
+}
+
+// DeleteProviderUpgradeNotice deletes the latest notice about an upgrade of the provider chain
+func (k Keeper) DeleteProviderUpgradeNotice(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProviderUpgradeNoticeKey())
+}
+
+// HandleProviderUpgradeNotice stores the notice about an upgrade of the provider chain received
+// in the VSC packet with `vscID` and emits an event. A cleared notice, i.e., one signaling that the
+// previously communicated upgrade is no longer scheduled, deletes the stored notice instead.
+func (k Keeper) HandleProviderUpgradeNotice(ctx sdk.Context, vscID uint64, notice ccv.ProviderUpgradeNotice) {
+	if notice.IsCleared() {
+		prevNotice, found := k.GetProviderUpgradeNotice(ctx)
+		if !found {
+			return
+		}
+		k.DeleteProviderUpgradeNotice(ctx)
+		k.Logger(ctx).Info("provider upgrade notice cleared",
+			"vscID", vscID,
+			"upgrade name", prevNotice.Name,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProviderUpgradeNoticeCleared,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeUpgradeName, prevNotice.Name),
+				sdk.NewAttribute(types.AttributeUpgradeHeight, strconv.FormatInt(prevNotice.Height, 10)),
+			),
+		)
+		return
+	}
+
+	k.SetProviderUpgradeNotice(ctx, notice)
+	k.Logger(ctx).Info("provider upgrade notice received",
+		"vscID", vscID,
+		"upgrade name", notice.Name,
+		"upgrade height", notice.Height,
+		"estimated time", notice.EstimatedTime,
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderUpgradeNotice,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeUpgradeName, notice.Name),
+			sdk.NewAttribute(types.AttributeUpgradeHeight, strconv.FormatInt(notice.Height, 10)),
+			sdk.NewAttribute(types.AttributeUpgradeEstimatedTime, notice.EstimatedTime.Format(time.RFC3339)),
+		),
+	)
+}
@@ -69,7 +69,7 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 
 	// Save maturity time and packet
 	maturityTime := ctx.BlockTime().Add(k.GetUnbondingPeriod(ctx))
-	k.SetPacketMaturityTime(ctx, newChanges.ValsetUpdateId, maturityTime)
+	k.SetPacketMaturityTime(ctx, newChanges.ValsetUpdateId, ctx.BlockTime(), maturityTime)
 	k.Logger(ctx).Debug("packet maturity time was set",
 		"vscID", newChanges.ValsetUpdateId,
 		"maturity time (utc)", maturityTime.UTC(),
@@ -81,6 +81,13 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
 	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
 
+	// record the last applied VSC packet to track the staleness of the validator set
+	k.SetLastAppliedVSC(ctx, types.LastAppliedVSC{
+		VscId:  newChanges.ValsetUpdateId,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+
 	// remove outstanding slashing flags of the validators
 	// for which the slashing was acknowledged by the provider chain
 	for _, ack := range newChanges.GetSlashAcks() {
@@ -98,6 +105,31 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 		k.DeleteOutstandingDowntime(ctx, consAddr)
 	}
 
+	// update the provider fee pool address if the provider chain sent a new one;
+	// note that providers that do not support such updates never set it
+	prevFeePoolAddr := k.GetProviderFeePoolAddrStr(ctx)
+	if newChanges.ProviderFeePoolAddr != "" && newChanges.ProviderFeePoolAddr != prevFeePoolAddr {
+		k.SetProviderFeePoolAddrStr(ctx, newChanges.ProviderFeePoolAddr)
+		k.Logger(ctx).Info("provider fee pool address updated",
+			"vscID", newChanges.ValsetUpdateId,
+			"previous address", prevFeePoolAddr,
+			"address", newChanges.ProviderFeePoolAddr,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProviderFeePoolAddrUpdated,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeProviderFeePoolAddr, newChanges.ProviderFeePoolAddr),
+			),
+		)
+	}
+
+	// update the provider upgrade notice if the provider chain sent one;
+	// note that providers only set it once the scheduled upgrade changes
+	if newChanges.ProviderUpgradeNotice != nil {
+		k.HandleProviderUpgradeNotice(ctx, newChanges.ValsetUpdateId, *newChanges.ProviderUpgradeNotice)
+	}
+
 	k.Logger(ctx).Info("finished receiving/handling VSCPacket",
 		"vscID", newChanges.ValsetUpdateId,
 		"len updates", len(newChanges.ValidatorUpdates),
@@ -112,7 +144,14 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 // operations that resulted in validator updates included in that VSC have matured on
 // the consumer chain.
 func (k Keeper) QueueVSCMaturedPackets(ctx sdk.Context) {
-	for _, maturityTime := range k.GetElapsedPacketMaturityTimes(ctx) {
+	maturedVSCPackets := k.GetElapsedPacketMaturityTimes(ctx)
+	if len(maturedVSCPackets) > 0 {
+		counters := k.GetVSCMaturityCounters(ctx)
+		counters.Queued += uint64(len(maturedVSCPackets))
+		k.SetVSCMaturityCounters(ctx, counters)
+	}
+
+	for _, maturityTime := range maturedVSCPackets {
 		// construct validator set change packet data
 		vscPacket := ccv.NewVSCMaturedPacketData(maturityTime.VscId)
 
@@ -182,6 +221,7 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 			sdk.NewAttribute(ccv.AttributeValidatorAddress, sdk.ConsAddress(validator.Address).String()),
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(valsetUpdateID))),
 			sdk.NewAttribute(ccv.AttributeInfractionType, infraction.String()),
+			sdk.NewAttribute(ccv.AttributeInfractionTypeValue, strconv.Itoa(int(infraction))),
 		),
 	)
 }
@@ -202,6 +242,7 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 
 	pending := k.GetAllPendingPacketsWithIdx(ctx)
 	idxsForDeletion := []uint64{}
+	sentVSCMaturedPackets := uint64(0)
 	for _, p := range pending {
 		if !k.PacketSendingPermitted(ctx) {
 			break
@@ -242,6 +283,12 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 		}
 		// Otherwise the vsc matured will be deleted
 		idxsForDeletion = append(idxsForDeletion, p.Idx)
+		sentVSCMaturedPackets++
+	}
+	if sentVSCMaturedPackets > 0 {
+		counters := k.GetVSCMaturityCounters(ctx)
+		counters.Sent += sentVSCMaturedPackets
+		k.SetVSCMaturityCounters(ctx, counters)
 	}
 	// Delete pending packets that were successfully sent and did not return an error from SendIBCPacket
 	k.DeletePendingDataPackets(ctx, idxsForDeletion...)
@@ -245,6 +245,134 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 	require.Equal(t, valUpdates[1], gotPendingChanges.ValidatorUpdates[0]) // Only latest update should be kept
 }
 
+// TestOnRecvVSCPacketWithProviderFeePoolAddr tests that the provider fee pool address is updated
+// only by VSC packets that carry a new address
+func TestOnRecvVSCPacketWithProviderFeePoolAddr(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+	consumerKeeper.SetProviderFeePoolAddrStr(ctx, "handshake-fee-pool-address")
+
+	// recvPacket receives the given VSC packet data from its wire bytes and returns the emitted events
+	recvPacket := func(vscData types.ValidatorSetChangePacketData) sdk.Events {
+		packet := channeltypes.NewPacket(vscData.GetBytes(), vscData.ValsetUpdateId, types.ProviderPortID,
+			providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
+		var data types.ValidatorSetChangePacketData
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, data))
+		return ctx.EventManager().Events()
+	}
+
+	// packets without the address (e.g., from providers that do not send it) keep the handshake address
+	events := recvPacket(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 1, nil))
+	require.Empty(t, events)
+	require.Equal(t, "handshake-fee-pool-address", consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+
+	// packets with a new address update it
+	vscData := types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 2, nil)
+	vscData.ProviderFeePoolAddr = "new-fee-pool-address"
+	events = recvPacket(vscData)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeProviderFeePoolAddrUpdated, events[0].Type)
+	attr, found := events[0].GetAttribute(consumertypes.AttributeProviderFeePoolAddr)
+	require.True(t, found)
+	require.Equal(t, "new-fee-pool-address", attr.Value)
+	require.Equal(t, "new-fee-pool-address", consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+
+	// packets with the same address do not update it again
+	vscData.ValsetUpdateId = 3
+	events = recvPacket(vscData)
+	require.Empty(t, events)
+	require.Equal(t, "new-fee-pool-address", consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+
+	events = recvPacket(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 4, nil))
+	require.Empty(t, events)
+	require.Equal(t, "new-fee-pool-address", consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+}
+
+// TestOnRecvVSCPacketWithProviderUpgradeNotice tests that the provider upgrade notice is stored
+// when received in a VSC packet and deleted once the provider chain clears it
+func TestOnRecvVSCPacketWithProviderUpgradeNotice(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// recvPacket receives the given VSC packet data from its wire bytes and returns the emitted events
+	recvPacket := func(vscData types.ValidatorSetChangePacketData) sdk.Events {
+		packet := channeltypes.NewPacket(vscData.GetBytes(), vscData.ValsetUpdateId, types.ProviderPortID,
+			providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
+		var data types.ValidatorSetChangePacketData
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, data))
+		return ctx.EventManager().Events()
+	}
+	queryNotice := func() *types.ProviderUpgradeNotice {
+		res, err := consumerKeeper.QueryProviderUpgradeNotice(ctx, &consumertypes.QueryProviderUpgradeNoticeRequest{})
+		require.NoError(t, err)
+		return res.Notice
+	}
+
+	// packets without a notice do not change anything
+	events := recvPacket(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 1, nil))
+	require.Empty(t, events)
+	require.Nil(t, queryNotice())
+
+	// packets clearing a notice that was never received do not change anything
+	vscData := types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 2, nil)
+	vscData.ProviderUpgradeNotice = &types.ProviderUpgradeNotice{}
+	events = recvPacket(vscData)
+	require.Empty(t, events)
+	require.Nil(t, queryNotice())
+
+	// packets with a notice store it
+	notice := types.ProviderUpgradeNotice{
+		Name:          "v7",
+		Height:        1000,
+		EstimatedTime: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	vscData = types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 3, nil)
+	vscData.ProviderUpgradeNotice = &notice
+	events = recvPacket(vscData)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeProviderUpgradeNotice, events[0].Type)
+	for key, value := range map[string]string{
+		consumertypes.AttributeUpgradeName:          "v7",
+		consumertypes.AttributeUpgradeHeight:        "1000",
+		consumertypes.AttributeUpgradeEstimatedTime: "2026-01-02T03:04:05Z",
+	} {
+		attr, found := events[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+	require.Equal(t, &notice, queryNotice())
+
+	// packets without a notice keep the stored one
+	events = recvPacket(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 4, nil))
+	require.Empty(t, events)
+	require.Equal(t, &notice, queryNotice())
+
+	// packets clearing the notice delete it
+	vscData = types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 5, nil)
+	vscData.ProviderUpgradeNotice = &types.ProviderUpgradeNotice{}
+	events = recvPacket(vscData)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeProviderUpgradeNoticeCleared, events[0].Type)
+	attr, found := events[0].GetAttribute(consumertypes.AttributeUpgradeName)
+	require.True(t, found)
+	require.Equal(t, "v7", attr.Value)
+	require.Nil(t, queryNotice())
+}
+
 // TestSendPackets tests the SendPackets method failing
 func TestSendPacketsFailure(t *testing.T) {
 	// Keeper setup
@@ -317,6 +445,9 @@ func TestSendPackets(t *testing.T) {
 	require.Equal(t, types.SlashPacket, pendingPackets[0].Type)
 	require.Equal(t, types.VscMaturedPacket, pendingPackets[1].Type)
 
+	// The two vsc matured packets should be counted as sent
+	require.Equal(t, uint64(2), consumerKeeper.GetVSCMaturityCounters(ctx).Sent)
+
 	// Packet sending not permitted
 	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
 
@@ -338,6 +469,7 @@ func TestSendPackets(t *testing.T) {
 	// No packets should be left
 	pendingPackets = consumerKeeper.GetPendingPackets(ctx)
 	require.Equal(t, 0, len(pendingPackets))
+	require.Equal(t, uint64(3), consumerKeeper.GetVSCMaturityCounters(ctx).Sent)
 }
 
 // TestOnAcknowledgementPacketError tests application logic for ERROR acknowledgments of sent VSCMatured and Slash packets
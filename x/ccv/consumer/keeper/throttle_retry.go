@@ -64,7 +64,12 @@ func (k Keeper) UpdateSlashRecordOnSend(ctx sdktypes.Context) {
 		ctx.BlockTime(), // sendTime
 		true,            // waitingOnReply
 	)
-	// We don't mind overwriting here, since this is either a retry or the first time we send a slash
+	// We don't mind overwriting here, since this is either a retry or the first time we send a slash.
+	// In case of a retry, only the number of send attempts is carried over.
+	if prevRecord, found := k.GetSlashRecord(ctx); found {
+		record.SendAttempts = prevRecord.SendAttempts
+	}
+	record.SendAttempts++
 	k.SetSlashRecord(ctx, record)
 }
 
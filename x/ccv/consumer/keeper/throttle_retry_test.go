@@ -6,6 +6,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	testutil "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
@@ -86,9 +91,80 @@ func TestThrottleRetryCRUD(t *testing.T) {
 	require.True(t, slashRecord.WaitingOnReply)
 	require.Equal(t, ctx.BlockTime(), slashRecord.SendTime)               // New SendTime expected
 	require.Equal(t, oldBlocktime.Add(2*time.Hour), slashRecord.SendTime) // Sanity check
+	require.Equal(t, uint32(1), slashRecord.SendAttempts)                 // SendAttempts carried over and incremented
+
+	// Every retry increments SendAttempts
+	consumerKeeper.UpdateSlashRecordOnBounce(ctx)
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	slashRecord, found = consumerKeeper.GetSlashRecord(ctx)
+	require.True(t, found)
+	require.Equal(t, uint32(2), slashRecord.SendAttempts)
 
 	consumerKeeper.ClearSlashRecord(ctx)
 	slashRecord, found = consumerKeeper.GetSlashRecord(ctx)
 	require.False(t, found)
 	require.Zero(t, slashRecord)
+
+	// A new slash record starts with a single send attempt
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	slashRecord, found = consumerKeeper.GetSlashRecord(ctx)
+	require.True(t, found)
+	require.Equal(t, uint32(1), slashRecord.SendAttempts)
+}
+
+func TestQuerySlashPacketRetryState(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccvtypes.DefaultParams())
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	// no pending slash packets
+	res, err := consumerKeeper.QuerySlashPacketRetryState(ctx, &consumertypes.QuerySlashPacketRetryStateRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.SlashPackets)
+
+	valAddrs := []sdktypes.ConsAddress{[]byte("validator-1"), []byte("validator-2")}
+	infractions := []stakingtypes.Infraction{stakingtypes.Infraction_INFRACTION_DOWNTIME, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN}
+	for i := range valAddrs {
+		consumerKeeper.AppendPendingPacket(ctx, ccvtypes.SlashPacket, &ccvtypes.ConsumerPacketData_SlashPacketData{
+			SlashPacketData: ccvtypes.NewSlashPacketData(abci.Validator{Address: valAddrs[i], Power: 5}, uint64(i+1), infractions[i]),
+		})
+	}
+
+	// pending slash packets that have not been sent yet
+	res, err = consumerKeeper.QuerySlashPacketRetryState(ctx, &consumertypes.QuerySlashPacketRetryStateRequest{})
+	require.NoError(t, err)
+	expectedSlashPackets := []consumertypes.SlashPacketRetryState{
+		{
+			ValidatorAddress: valAddrs[0].String(),
+			Infraction:       infractions[0],
+			ValsetUpdateId:   1,
+		},
+		{
+			ValidatorAddress: valAddrs[1].String(),
+			Infraction:       infractions[1],
+			ValsetUpdateId:   2,
+		},
+	}
+	require.Equal(t, expectedSlashPackets, res.SlashPackets)
+
+	// the first slash packet is sent twice and bounced the second time
+	sendTime := ctx.BlockTime()
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	ctx = ctx.WithBlockTime(sendTime.Add(time.Hour))
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	consumerKeeper.UpdateSlashRecordOnBounce(ctx)
+
+	res, err = consumerKeeper.QuerySlashPacketRetryState(ctx, &consumertypes.QuerySlashPacketRetryStateRequest{})
+	require.NoError(t, err)
+	lastAttemptTime := sendTime.Add(time.Hour)
+	nextRetryTime := lastAttemptTime.Add(consumerKeeper.GetRetryDelayPeriod(ctx))
+	expectedSlashPackets[0].SendAttempts = 2
+	expectedSlashPackets[0].LastAttemptTime = &lastAttemptTime
+	expectedSlashPackets[0].NextRetryTime = &nextRetryTime
+	expectedSlashPackets[0].WaitingOnReply = false
+	require.Equal(t, expectedSlashPackets, res.SlashPackets)
+
+	_, err = consumerKeeper.QuerySlashPacketRetryState(ctx, nil)
+	require.Error(t, err)
 }
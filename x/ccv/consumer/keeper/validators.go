@@ -2,18 +2,20 @@ package keeper
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 //
@@ -21,6 +23,45 @@ import (
 // for previously unimplemented methods, if they're implemented to solve the above issue.
 //
 
+// CheckLocalValidatorKey checks whether the local node signs with one of the given provider
+// consensus public keys, i.e., the provider keys of validators that assigned a different
+// consumer key. This indicates a misconfigured node that runs the provider key on the consumer
+// chain instead of the assigned consumer key. If this is the case, an error is logged and
+// an event is emitted. Returns true if the local key matches one of the provider keys.
+//
+// Note that the check is a guard rail based on node-local configuration and
+// it does not modify any state.
+func (k Keeper) CheckLocalValidatorKey(ctx sdk.Context, providerKeys []tmprotocrypto.PublicKey) bool {
+	if k.localValidatorPubKey == nil {
+		return false
+	}
+	for _, providerKey := range providerKeys {
+		if !providerKey.Equal(*k.localValidatorPubKey) {
+			continue
+		}
+		consAddr, err := ccv.TMCryptoPublicKeyToConsAddr(providerKey)
+		if err != nil {
+			k.Logger(ctx).Error("cannot compute the consensus address of the local validator key", "error", err)
+			return true
+		}
+		k.Logger(ctx).Error(
+			"MISCONFIGURED VALIDATOR: the local node signs with its provider consensus key, "+
+				"although a different consumer key was assigned on the provider; "+
+				"run the node with the assigned consumer key instead",
+			"providerConsAddr", consAddr.String(),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				ccv.EventTypeProviderKeyOnConsumer,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeProviderValidatorAddress, consAddr.String()),
+			),
+		)
+		return true
+	}
+	return false
+}
+
 // ApplyCCValidatorChanges applies the given changes to the cross-chain validators states
 // and returns updates to forward to tendermint.
 func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.ValidatorUpdate) []abci.ValidatorUpdate {
@@ -234,6 +275,37 @@ func (k Keeper) DeleteHistoricalInfo(goCtx context.Context, height int64) error
 	return nil
 }
 
+// GetAllHistoricalInfoHeights returns the heights of all the historical info entries
+// stored on the consumer chain, in ascending order
+func (k Keeper) GetAllHistoricalInfoHeights(ctx sdk.Context) []int64 {
+	heights := []int64{}
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.HistoricalInfoKeyPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, types.HeightFromHistoricalInfoKey(iterator.Key()))
+	}
+
+	return heights
+}
+
+// getHistoricalInfoHeightsUpTo returns the heights of all the historical info entries
+// stored at heights smaller than or equal to the given height
+func (k Keeper) getHistoricalInfoHeightsUpTo(ctx sdk.Context, height int64) []int64 {
+	heights := []int64{}
+	store := ctx.KVStore(k.storeKey)
+	// the end key is exclusive, hence height+1
+	iterator := store.Iterator(types.HistoricalInfoKey(0), types.HistoricalInfoKey(height+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, types.HeightFromHistoricalInfoKey(iterator.Key()))
+	}
+
+	return heights
+}
+
 // TrackHistoricalInfo saves the latest historical-info and deletes the oldest
 // heights that are below pruning height
 func (k Keeper) TrackHistoricalInfo(goCtx context.Context) error {
@@ -245,19 +317,12 @@ func (k Keeper) TrackHistoricalInfo(goCtx context.Context) error {
 	// In most cases, this will involve removing a single historical entry.
 	// In the rare scenario when the historical entries gets reduced to a lower value k'
 	// from the original value k. k - k' entries must be deleted from the store.
-	// Since the entries to be deleted are always in a continuous range, we can iterate
-	// over the historical entries starting from the most recent version to be pruned
-	// and then return at the first empty entry.
-	for i := ctx.BlockHeight() - numHistoricalEntries; i >= 0; i-- {
-		_, err := k.GetHistoricalInfo(ctx, i)
-		if err != nil {
-			if errors.Is(err, stakingtypes.ErrNoHistoricalInfo) {
-				break
+	// Note that if the historical entries is set to zero, all the entries are deleted.
+	if pruneHeight := ctx.BlockHeight() - numHistoricalEntries; pruneHeight >= 0 {
+		for _, height := range k.getHistoricalInfoHeightsUpTo(ctx, pruneHeight) {
+			if err := k.DeleteHistoricalInfo(ctx, height); err != nil {
+				return err
 			}
-			return err
-		}
-		if err = k.DeleteHistoricalInfo(ctx, i); err != nil {
-			return err
 		}
 	}
 
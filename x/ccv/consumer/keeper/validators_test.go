@@ -19,6 +19,7 @@ import (
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 // TestApplyCCValidatorChanges tests the ApplyCCValidatorChanges method for a consumer keeper
@@ -247,6 +248,66 @@ func TestHistoricalInfo(t *testing.T) {
 	require.True(t, IsValSetSorted(recv.Valset, sdk.DefaultPowerReduction), "HistoricalInfo validators is not sorted")
 }
 
+// TestTrackHistoricalInfo tests that TrackHistoricalInfo prunes the stored historical info
+// to exactly the number of entries given by the HistoricalEntries param, including when
+// the param is lowered at runtime or set to zero
+func TestTrackHistoricalInfo(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	SetCCValidators(t, consumerKeeper, ctx, GenerateValidators(t))
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	setHistoricalEntries := func(historicalEntries int64) {
+		params := consumerKeeper.GetConsumerParams(ctx)
+		params.HistoricalEntries = historicalEntries
+		require.NoError(t, params.Validate())
+		consumerKeeper.SetParams(ctx, params)
+	}
+	trackHistoricalInfo := func(height int64) {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, consumerKeeper.TrackHistoricalInfo(ctx))
+	}
+	queryHeights := func() []int64 {
+		resp, err := consumerKeeper.QueryHistoricalEntries(ctx, &types.QueryHistoricalEntriesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, consumerKeeper.GetHistoricalEntries(ctx), resp.HistoricalEntries)
+		require.Equal(t, consumerKeeper.GetAllHistoricalInfoHeights(ctx), resp.Heights)
+		return resp.Heights
+	}
+
+	// with 5 historical entries, only the last 5 heights are kept
+	setHistoricalEntries(5)
+	for height := int64(1); height <= 10; height++ {
+		trackHistoricalInfo(height)
+	}
+	require.Equal(t, []int64{6, 7, 8, 9, 10}, queryHeights())
+	_, err := consumerKeeper.GetHistoricalInfo(ctx, 5)
+	require.ErrorIs(t, err, stakingtypes.ErrNoHistoricalInfo)
+
+	// lowering the param prunes the older entries on the next block
+	setHistoricalEntries(2)
+	trackHistoricalInfo(11)
+	require.Equal(t, []int64{10, 11}, queryHeights())
+
+	// raising the param does not prune anything
+	setHistoricalEntries(3)
+	trackHistoricalInfo(12)
+	require.Equal(t, []int64{10, 11, 12}, queryHeights())
+
+	// setting the param to zero prunes all the entries and persists none
+	setHistoricalEntries(0)
+	trackHistoricalInfo(13)
+	require.Empty(t, queryHeights())
+	trackHistoricalInfo(14)
+	require.Empty(t, queryHeights())
+
+	// a negative number of historical entries is invalid
+	params := consumerKeeper.GetConsumerParams(ctx)
+	params.HistoricalEntries = -1
+	require.Error(t, params.Validate())
+}
+
 // IsValSetSorted reports whether valset is sorted.
 func IsValSetSorted(data []stakingtypes.Validator, powerReduction math.Int) bool {
 	n := len(data)
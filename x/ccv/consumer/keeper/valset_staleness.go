@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// SetLastAppliedVSC sets the last VSC packet applied by the consumer chain
+func (k Keeper) SetLastAppliedVSC(ctx sdk.Context, lastVSC types.LastAppliedVSC) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := lastVSC.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the last applied VSC is instantiated by the keeper and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal LastAppliedVSC: %w", err))
+	}
+	store.Set(types.LastAppliedVSCKey(), bz)
+}
+
+// GetLastAppliedVSC returns the last VSC packet applied by the consumer chain, if any
+func (k Keeper) GetLastAppliedVSC(ctx sdk.Context) (types.LastAppliedVSC, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastAppliedVSCKey())
+	if bz == nil {
+		return types.LastAppliedVSC{}, false
+	}
+	var lastVSC types.LastAppliedVSC
+	if err := lastVSC.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the last applied VSC is assumed to be correctly serialized in SetLastAppliedVSC.
+		panic(fmt.Errorf("failed to unmarshal LastAppliedVSC: %w", err))
+	}
+	return lastVSC, true
+}
+
+// GetPendingVSCMaturedPacketsCount returns the number of VSCMatured packets queued
+// but not yet sent to the provider chain
+func (k Keeper) GetPendingVSCMaturedPacketsCount(ctx sdk.Context) uint64 {
+	count := uint64(0)
+	for _, packet := range k.GetPendingPackets(ctx) {
+		if packet.Type == ccv.VscMaturedPacket {
+			count++
+		}
+	}
+	return count
+}
+
+// GetValsetStalenessThreshold returns the time without any VSC packet applied after which
+// the validator set of the consumer chain is considered stale, i.e., ValsetStalenessWarningEpochs
+// times ExpectedProviderEpochDuration. A zero threshold means that the staleness warnings are disabled.
+func (k Keeper) GetValsetStalenessThreshold(ctx sdk.Context) time.Duration {
+	params := k.GetConsumerParams(ctx)
+	return time.Duration(params.ValsetStalenessWarningEpochs) * params.ExpectedProviderEpochDuration
+}
+
+// CheckValsetStaleness updates the telemetry gauges about the staleness of the validator set of
+// the consumer chain, i.e., the blocks and time elapsed since the last VSC packet was applied and
+// the number of VSCMatured packets that could not be sent yet. Once the time elapsed since the last
+// VSC packet was applied exceeds the staleness threshold, a valset_stale event is emitted once per
+// threshold period, i.e., when the threshold is crossed and then every time another threshold elapses.
+//
+// Note that the provider chain only sends VSC packets when the validator set changes, so the events
+// are a signal for the operators to check the relaying of the CCV channel, rather than a proof that it halted.
+// Also note that nothing is checked before the first VSC packet is applied.
+func (k Keeper) CheckValsetStaleness(ctx sdk.Context) {
+	pendingVSCMaturedPackets := k.GetPendingVSCMaturedPacketsCount(ctx)
+	telemetry.SetGauge(float32(pendingVSCMaturedPackets), types.ModuleName, "pending_vsc_matured_packets")
+
+	lastVSC, found := k.GetLastAppliedVSC(ctx)
+	if !found {
+		return
+	}
+	blocksSinceLastVSC := ctx.BlockHeight() - lastVSC.Height
+	timeSinceLastVSC := ctx.BlockTime().Sub(lastVSC.Time)
+	telemetry.SetGauge(float32(lastVSC.VscId), types.ModuleName, "last_applied_vsc_id")
+	telemetry.SetGauge(float32(blocksSinceLastVSC), types.ModuleName, "blocks_since_last_vsc")
+	telemetry.SetGauge(float32(timeSinceLastVSC.Seconds()), types.ModuleName, "seconds_since_last_vsc")
+
+	threshold := k.GetValsetStalenessThreshold(ctx)
+	if threshold == 0 || timeSinceLastVSC <= threshold {
+		return
+	}
+	// the number of thresholds elapsed since the last VSC packet was applied,
+	// e.g., 1 right after the threshold is crossed
+	elapsedThresholds := uint64((timeSinceLastVSC - 1) / threshold)
+	if elapsedThresholds <= lastVSC.StalenessWarnings {
+		return
+	}
+	lastVSC.StalenessWarnings = elapsedThresholds
+	k.SetLastAppliedVSC(ctx, lastVSC)
+
+	k.Logger(ctx).Warn("validator set is stale - no VSC packet was applied for more than the staleness threshold",
+		"last vscID", lastVSC.VscId,
+		"blocks since last VSC", blocksSinceLastVSC,
+		"time since last VSC", timeSinceLastVSC,
+		"threshold", threshold,
+		"pending VSCMatured packets", pendingVSCMaturedPackets,
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValsetStale,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(lastVSC.VscId, 10)),
+			sdk.NewAttribute(types.AttributeLastVSCHeight, strconv.FormatInt(lastVSC.Height, 10)),
+			sdk.NewAttribute(types.AttributeBlocksSinceLastVSC, strconv.FormatInt(blocksSinceLastVSC, 10)),
+			sdk.NewAttribute(types.AttributeTimeSinceLastVSC, timeSinceLastVSC.String()),
+			sdk.NewAttribute(types.AttributeStalenessThreshold, threshold.String()),
+			sdk.NewAttribute(types.AttributeMaturitySendsBackedUp, strconv.FormatBool(pendingVSCMaturedPackets > 0)),
+		),
+	)
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestValsetStaleness tests that the last applied VSC packet is recorded when receiving VSC packets
+// and that valset_stale events are emitted once per staleness threshold once no VSC packet was applied
+// for longer than the staleness threshold, e.g., because the relaying of the CCV channel halted
+func TestValsetStaleness(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccv.DefaultParams()
+	params.ExpectedProviderEpochDuration = time.Hour
+	params.ValsetStalenessWarningEpochs = 3
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+
+	// checkAtTime checks the staleness of the validator set at the given time and height
+	// and returns the emitted events
+	checkAtTime := func(blockTime time.Time, height int64) sdk.Events {
+		ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		consumerKeeper.CheckValsetStaleness(ctx)
+		return ctx.EventManager().Events()
+	}
+
+	// nothing is checked before the first VSC packet is applied
+	now := time.Now().UTC()
+	require.Empty(t, checkAtTime(now.Add(10*time.Hour), 1))
+	resp, err := consumerKeeper.QueryValsetStaleness(ctx, &consumertypes.QueryValsetStalenessRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp.LastAppliedVsc)
+	require.False(t, resp.Stale)
+
+	// receive a VSC packet
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+	vscPacket := ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 7, nil)
+	packet := channeltypes.NewPacket(vscPacket.GetBytes(), 1, ccv.ProviderPortID, "channel-1", ccv.ConsumerPortID, "channel-0",
+		clienttypes.NewHeight(1, 0), 0)
+	require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, vscPacket))
+	lastVSC, found := consumerKeeper.GetLastAppliedVSC(ctx)
+	require.True(t, found)
+	require.Equal(t, consumertypes.LastAppliedVSC{VscId: 7, Height: 10, Time: now}, lastVSC)
+
+	// the relaying halts: no VSC packet is received anymore and the VSCMatured packets cannot be sent
+	require.Empty(t, checkAtTime(now.Add(2*time.Hour), 1210))
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket,
+		&ccv.ConsumerPacketData_VscMaturedPacketData{VscMaturedPacketData: ccv.NewVSCMaturedPacketData(6)})
+	require.Empty(t, checkAtTime(now.Add(3*time.Hour), 1810))
+
+	// the staleness threshold is exceeded
+	events := checkAtTime(now.Add(3*time.Hour+time.Second), 1811)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeValsetStale, events[0].Type)
+	for key, value := range map[string]string{
+		ccv.AttributeValSetUpdateID:                  "7",
+		consumertypes.AttributeLastVSCHeight:         "10",
+		consumertypes.AttributeBlocksSinceLastVSC:    "1801",
+		consumertypes.AttributeTimeSinceLastVSC:      (3*time.Hour + time.Second).String(),
+		consumertypes.AttributeStalenessThreshold:    (3 * time.Hour).String(),
+		consumertypes.AttributeMaturitySendsBackedUp: "true",
+	} {
+		attr, found := events[0].GetAttribute(key)
+		require.True(t, found)
+		require.Equal(t, value, attr.Value)
+	}
+
+	// the warning is recorded, so that it is not emitted again during the same threshold period
+	lastVSC.StalenessWarnings = 1
+	resp, err = consumerKeeper.QueryValsetStaleness(ctx, &consumertypes.QueryValsetStalenessRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &consumertypes.QueryValsetStalenessResponse{
+		LastAppliedVsc:           &lastVSC,
+		BlocksSinceLastVsc:       1801,
+		TimeSinceLastVsc:         3*time.Hour + time.Second,
+		PendingVscMaturedPackets: 1,
+		MaturitySendsBackedUp:    true,
+		StalenessThreshold:       3 * time.Hour,
+		Stale:                    true,
+	}, resp)
+
+	require.Empty(t, checkAtTime(now.Add(3*time.Hour+2*time.Second), 1812))
+	require.Empty(t, checkAtTime(now.Add(6*time.Hour), 3610))
+
+	// the warning is emitted again once another threshold elapsed
+	events = checkAtTime(now.Add(6*time.Hour+time.Second), 3611)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeValsetStale, events[0].Type)
+	require.Empty(t, checkAtTime(now.Add(6*time.Hour+2*time.Second), 3612))
+
+	// no events are emitted if the staleness warnings are disabled
+	params.ValsetStalenessWarningEpochs = 0
+	consumerKeeper.SetParams(ctx, params)
+	require.Empty(t, checkAtTime(now.Add(10*time.Hour), 6010))
+
+	// the validator set is not stale anymore once a VSC packet is received
+	params.ValsetStalenessWarningEpochs = 3
+	consumerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(now.Add(11 * time.Hour)).WithBlockHeight(6610)
+	vscPacket = ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 8, nil)
+	packet = channeltypes.NewPacket(vscPacket.GetBytes(), 2, ccv.ProviderPortID, "channel-1", ccv.ConsumerPortID, "channel-0",
+		clienttypes.NewHeight(1, 0), 0)
+	require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, vscPacket))
+	require.Empty(t, checkAtTime(now.Add(12*time.Hour), 7210))
+
+	// the staleness warnings of the new VSC packet start from scratch
+	events = checkAtTime(now.Add(14*time.Hour+time.Second), 8411)
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeValsetStale, events[0].Type)
+}
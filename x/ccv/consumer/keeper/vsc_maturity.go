@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// GetVSCMaturityCounters returns the counters of the VSC maturities
+func (k Keeper) GetVSCMaturityCounters(ctx sdk.Context) types.VSCMaturityCounters {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VSCMaturityCountersKey())
+	if bz == nil {
+		return types.VSCMaturityCounters{}
+	}
+	var counters types.VSCMaturityCounters
+	if err := counters.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the counters are assumed to be correctly serialized in SetVSCMaturityCounters.
+		panic(fmt.Errorf("failed to unmarshal VSCMaturityCounters: %w", err))
+	}
+	return counters
+}
+
+// SetVSCMaturityCounters sets the counters of the VSC maturities
+func (k Keeper) SetVSCMaturityCounters(ctx sdk.Context, counters types.VSCMaturityCounters) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := counters.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the counters are instantiated by the keeper and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal VSCMaturityCounters: %w", err))
+	}
+	store.Set(types.VSCMaturityCountersKey(), bz)
+}
+
+// GetPendingVSCMaturities returns the maturing VSC packets whose maturity time has not elapsed yet,
+// sorted by maturity times
+func (k Keeper) GetPendingVSCMaturities(ctx sdk.Context) []types.MaturingVSCPacket {
+	pending := []types.MaturingVSCPacket{}
+	for _, maturingVSCPacket := range k.GetAllPacketMaturityTimes(ctx) {
+		if ctx.BlockTime().Before(maturingVSCPacket.MaturityTime) {
+			pending = append(pending, maturingVSCPacket)
+		}
+	}
+	return pending
+}
+
+// PruneStaleVSCMaturities removes the maturing VSC packets that were received more than
+// an unbonding period ago, but whose maturity time has not elapsed yet, e.g., because the
+// unbonding period was decreased since they were received. Note that the maturing VSC packets
+// whose maturity time elapsed are expected to be removed by QueueVSCMaturedPackets, while the
+// ones without a received time (i.e., received before the received times were recorded) are
+// never considered stale.
+func (k Keeper) PruneStaleVSCMaturities(ctx sdk.Context) {
+	pruneThreshold := ctx.BlockTime().Add(-k.GetUnbondingPeriod(ctx))
+
+	pruned := uint64(0)
+	for _, maturingVSCPacket := range k.GetPendingVSCMaturities(ctx) {
+		if maturingVSCPacket.ReceivedTime.IsZero() || maturingVSCPacket.ReceivedTime.After(pruneThreshold) {
+			continue
+		}
+
+		k.DeletePacketMaturityTimes(ctx, maturingVSCPacket.VscId, maturingVSCPacket.MaturityTime)
+		pruned++
+
+		k.Logger(ctx).Info("stale maturing VSC packet was pruned",
+			"vscID", maturingVSCPacket.VscId,
+			"received time (utc)", maturingVSCPacket.ReceivedTime.UTC(),
+			"maturity time (utc)", maturingVSCPacket.MaturityTime.UTC(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVSCMaturityPruned,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeChainID, ctx.ChainID()),
+				sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(maturingVSCPacket.VscId, 10)),
+				sdk.NewAttribute(types.AttributeReceivedTime, maturingVSCPacket.ReceivedTime.String()),
+				sdk.NewAttribute(types.AttributeMaturityTime, maturingVSCPacket.MaturityTime.String()),
+			),
+		)
+	}
+
+	if pruned > 0 {
+		counters := k.GetVSCMaturityCounters(ctx)
+		counters.Pruned += pruned
+		k.SetVSCMaturityCounters(ctx, counters)
+	}
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestVSCMaturitiesAfterOfflinePeriod tests the pending VSC maturities, the VSC maturity counters,
+// and the pruning of stale VSC maturities after the consumer chain was offline for a while
+func TestVSCMaturitiesAfterOfflinePeriod(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 24 * time.Hour
+	params := ccv.DefaultParams()
+	params.UnbondingPeriod = unbondingPeriod
+	consumerKeeper.SetParams(ctx, params)
+
+	// receive VSC packets 1 and 2 an hour apart
+	now := time.Now().UTC()
+	receivedPackets := []consumertypes.MaturingVSCPacket{
+		{VscId: 1, ReceivedTime: now, MaturityTime: now.Add(unbondingPeriod)},
+		{VscId: 2, ReceivedTime: now.Add(time.Hour), MaturityTime: now.Add(time.Hour + unbondingPeriod)},
+	}
+	for _, packet := range receivedPackets {
+		consumerKeeper.SetPacketMaturityTime(ctx, packet.VscId, packet.ReceivedTime, packet.MaturityTime)
+	}
+	// a VSC packet received before the received times were recorded
+	legacyPacket := consumertypes.MaturingVSCPacket{VscId: 0, MaturityTime: now.Add(2 * unbondingPeriod)}
+	consumerKeeper.SetPacketMaturityTime(ctx, legacyPacket.VscId, legacyPacket.ReceivedTime, legacyPacket.MaturityTime)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	res, err := consumerKeeper.QueryPendingVSCMaturities(ctx, &consumertypes.QueryPendingVSCMaturitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []consumertypes.MaturingVSCPacket{receivedPackets[0], receivedPackets[1], legacyPacket}, res.PendingMaturities)
+	require.Equal(t, consumertypes.VSCMaturityCounters{}, res.Counters)
+
+	// the consumer chain is offline for an unbonding period, hence VSC packet 1 matures once it is back online
+	ctx = ctx.WithBlockTime(now.Add(unbondingPeriod + 30*time.Minute)).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.QueueVSCMaturedPackets(ctx)
+	consumerKeeper.PruneStaleVSCMaturities(ctx)
+
+	require.Equal(t, []consumertypes.MaturingVSCPacket{receivedPackets[1], legacyPacket}, consumerKeeper.GetPendingVSCMaturities(ctx))
+	require.Equal(t, consumertypes.VSCMaturityCounters{Queued: 1}, consumerKeeper.GetVSCMaturityCounters(ctx))
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, uint64(1), pendingPackets[0].GetVscMaturedPacketData().ValsetUpdateId)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, consumertypes.EventTypeVSCMaturityPruned, event.Type)
+	}
+
+	// the unbonding period is decreased, hence VSC packet 2 is stale, while the legacy VSC packet is kept
+	params.UnbondingPeriod = 12 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.QueueVSCMaturedPackets(ctx)
+	consumerKeeper.PruneStaleVSCMaturities(ctx)
+
+	res, err = consumerKeeper.QueryPendingVSCMaturities(ctx, &consumertypes.QueryPendingVSCMaturitiesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []consumertypes.MaturingVSCPacket{legacyPacket}, res.PendingMaturities)
+	require.Equal(t, consumertypes.VSCMaturityCounters{Queued: 1, Pruned: 1}, res.Counters)
+	require.False(t, consumerKeeper.PacketMaturityTimeExists(ctx, receivedPackets[1].VscId, receivedPackets[1].MaturityTime))
+	// no VSCMatured packet is queued for the pruned VSC packet
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 1)
+
+	var prunedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == consumertypes.EventTypeVSCMaturityPruned {
+			prunedEvents = append(prunedEvents, event)
+		}
+	}
+	require.Len(t, prunedEvents, 1)
+	attr, found := prunedEvents[0].GetAttribute(ccv.AttributeValSetUpdateID)
+	require.True(t, found)
+	require.Equal(t, "2", attr.Value)
+	attr, found = prunedEvents[0].GetAttribute(consumertypes.AttributeReceivedTime)
+	require.True(t, found)
+	require.Equal(t, receivedPackets[1].ReceivedTime.String(), attr.Value)
+}
@@ -25,6 +25,16 @@ func GetConsumerParamsLegacy(ctx sdk.Context, paramSpace ccvtypes.LegacyParamSub
 		getProviderRewardDenoms(ctx, paramSpace),
 		getRetryDelayPeriod(ctx, paramSpace),
 		"0",
+		"",
+		nil,
+		ccvtypes.DefaultProviderClientExpiryWarningFraction,
+		ccvtypes.DefaultHaltOnProviderClientExpiry,
+		ccvtypes.DefaultProviderClientExpiryHaltDelay,
+		0,
+		"",
+		nil,
+		ccvtypes.DefaultExpectedProviderEpochDuration,
+		ccvtypes.DefaultValsetStalenessWarningEpochs,
 	)
 }
 
@@ -0,0 +1,33 @@
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// MigrateValsetStalenessParams initializes the ExpectedProviderEpochDuration and ValsetStalenessWarningEpochs params,
+// which are not set on the consumer chains that started before the staleness of the validator set was checked.
+func MigrateValsetStalenessParams(ctx sdk.Context, cdc codec.BinaryCodec, store storetypes.KVStore) error {
+	ctx.Logger().Info("starting consumer valset staleness params migration")
+	var params ccvtypes.ConsumerParams
+	if bz := store.Get(consumertypes.ParametersKey()); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.ExpectedProviderEpochDuration = ccvtypes.DefaultExpectedProviderEpochDuration
+	params.ValsetStalenessWarningEpochs = ccvtypes.DefaultValsetStalenessWarningEpochs
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(consumertypes.ParametersKey(), cdc.MustMarshal(&params))
+	ctx.Logger().Info("successfully migrated consumer valset staleness params")
+	return nil
+}
@@ -0,0 +1,41 @@
+package v4
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	"github.com/cosmos/interchain-security/v6/app/encoding"
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+func TestMigrateValsetStalenessParams(t *testing.T) {
+	cdc := encoding.MakeTestEncodingConfig().Codec
+	storeKey := storetypes.NewKVStoreKey("ccvconsumer")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// the params of a consumer chain that started before the valset staleness params were introduced
+	params := ccvtypes.DefaultParams()
+	params.ExpectedProviderEpochDuration = 0
+	params.ValsetStalenessWarningEpochs = 0
+	params.HistoricalEntries = 100
+	store.Set(consumertypes.ParametersKey(), cdc.MustMarshal(&params))
+
+	err := MigrateValsetStalenessParams(ctx, cdc, store)
+	require.NoError(t, err)
+
+	// check that the valset staleness params are set to the defaults and that the other params are kept
+	migratedParams := ccvtypes.ConsumerParams{}
+	require.NoError(t, cdc.Unmarshal(store.Get(consumertypes.ParametersKey()), &migratedParams))
+	require.Equal(t, ccvtypes.DefaultExpectedProviderEpochDuration, migratedParams.ExpectedProviderEpochDuration)
+	require.Equal(t, ccvtypes.DefaultValsetStalenessWarningEpochs, migratedParams.ValsetStalenessWarningEpochs)
+	params.ExpectedProviderEpochDuration = ccvtypes.DefaultExpectedProviderEpochDuration
+	params.ValsetStalenessWarningEpochs = ccvtypes.DefaultValsetStalenessWarningEpochs
+	require.Equal(t, params, migratedParams)
+}
@@ -131,6 +131,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(consumertypes.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 2 -> 3", consumertypes.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(consumertypes.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 3 -> 4", consumertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the consumer module. It returns
@@ -150,7 +153,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 {
-	return 3
+	return 4
 }
 
 // BeginBlock implements the AppModule interface
@@ -177,6 +180,13 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 	if err != nil {
 		am.keeper.Logger(ctx).Warn("failed to track historical info", "error", err)
 	}
+
+	// note that the chain panics if the provider client expired and the halt height is reached
+	if err := am.keeper.CheckProviderClientExpiry(ctx); err != nil {
+		am.keeper.Logger(ctx).Warn("failed to check the expiry of the provider client", "error", err)
+	}
+
+	am.keeper.CheckValsetStaleness(ctx)
 	return nil
 }
 
@@ -201,6 +211,9 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 	// Packet ordering is managed by the PendingPackets queue.
 	am.keeper.QueueVSCMaturedPackets(ctx)
 
+	// remove the maturing VSC packets that are stale, i.e., received more than an unbonding period ago
+	am.keeper.PruneStaleVSCMaturities(ctx)
+
 	// panics on invalid packets and unexpected send errors
 	am.keeper.SendPackets(ctx)
 
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// ChangeoverSummary summarizes the validator set transition of a standalone to consumer changeover
+// as validated by ValidateChangeover
+type ChangeoverSummary struct {
+	// the number of validators in the initial validator set, i.e., the provider validators
+	NumProviderValidators int
+	// the number of validators in the standalone validator set
+	NumStandaloneValidators int
+	// the number of validators that are both in the initial and in the standalone validator sets
+	NumOverlappingValidators int
+	// the validator updates returned to CometBFT at the changeover (see ChangeoverValidatorUpdates)
+	ValidatorUpdates []abci.ValidatorUpdate
+}
+
+// ValidateChangeover validates the consumer genesis state `genesisState` of a standalone chain that changes over
+// to a consumer chain, with `standaloneValset` the last bonded validators of the standalone chain. In addition to
+// the validation of the genesis state (see GenesisState.Validate), it checks that:
+//   - the preCCV flag is set, i.e., the genesis state is the one passed to InitGenesis in the upgrade handler;
+//   - the consumer module is enabled and the genesis state is the one of a new chain, i.e., it contains
+//     the provider client and consensus states, which are valid;
+//   - the unbonding period of the consumer chain does not exceed the unbonding period of the provider client;
+//   - the validators of the initial validator set have valid and distinct public keys and positive powers;
+//   - the validator updates of the changeover (see ChangeoverValidatorUpdates) can be computed.
+//
+// As it does not access any state, ValidateChangeover can be called both offline (e.g., before the upgrade)
+// and from the upgrade handler before any state changes.
+func ValidateChangeover(genesisState GenesisState, standaloneValset []stakingtypes.Validator) (ChangeoverSummary, error) {
+	if !genesisState.PreCCV {
+		return ChangeoverSummary{}, errorsmod.Wrap(ErrInvalidChangeover,
+			"the preCCV flag must be set in the consumer genesis state of a changeover")
+	}
+	if !genesisState.Params.Enabled {
+		return ChangeoverSummary{}, errorsmod.Wrap(ErrInvalidChangeover,
+			"the consumer module must be enabled in the consumer genesis state of a changeover")
+	}
+	if !genesisState.NewChain {
+		return ChangeoverSummary{}, errorsmod.Wrap(ErrInvalidChangeover,
+			"the consumer genesis state of a changeover must be the one of a new chain")
+	}
+	if err := genesisState.Validate(); err != nil {
+		return ChangeoverSummary{}, errorsmod.Wrapf(ErrInvalidChangeover, "invalid consumer genesis state: %s", err.Error())
+	}
+
+	// the consumer chain must unbond before the provider chain, i.e., its evidence must be submitted
+	// to the provider before the stake of the misbehaving validator is unbonded on the provider
+	providerUnbondingPeriod := genesisState.Provider.ClientState.UnbondingPeriod
+	if genesisState.Params.UnbondingPeriod > providerUnbondingPeriod {
+		return ChangeoverSummary{}, errorsmod.Wrapf(ErrInvalidChangeover,
+			"consumer unbonding period (%s) exceeds the unbonding period of the provider client (%s)",
+			genesisState.Params.UnbondingPeriod, providerUnbondingPeriod)
+	}
+
+	providerValidators := map[string]bool{}
+	for i, val := range genesisState.Provider.InitialValSet {
+		if _, err := cryptocodec.FromCmtProtoPublicKey(val.PubKey); err != nil {
+			return ChangeoverSummary{}, errorsmod.Wrapf(ErrInvalidChangeover,
+				"invalid public key of validator %d of the initial validator set: %s", i, err.Error())
+		}
+		if val.Power <= 0 {
+			return ChangeoverSummary{}, errorsmod.Wrapf(ErrInvalidChangeover,
+				"validator %d of the initial validator set has a non-positive power (%d)", i, val.Power)
+		}
+		if providerValidators[val.PubKey.String()] {
+			return ChangeoverSummary{}, errorsmod.Wrapf(ErrInvalidChangeover,
+				"validator %d of the initial validator set is a duplicate", i)
+		}
+		providerValidators[val.PubKey.String()] = true
+	}
+
+	valUpdates, err := ChangeoverValidatorUpdates(genesisState.Provider.InitialValSet, standaloneValset)
+	if err != nil {
+		return ChangeoverSummary{}, errorsmod.Wrap(ErrInvalidChangeover, err.Error())
+	}
+
+	return ChangeoverSummary{
+		NumProviderValidators:    len(genesisState.Provider.InitialValSet),
+		NumStandaloneValidators:  len(standaloneValset),
+		NumOverlappingValidators: len(genesisState.Provider.InitialValSet) + len(standaloneValset) - len(valUpdates),
+		ValidatorUpdates:         valUpdates,
+	}, nil
+}
+
+// ChangeoverValidatorUpdates returns the validator updates given to CometBFT at the changeover, i.e., the validators of
+// `initialValSet` with their full power and the validators of `standaloneValset` that are not in `initialValSet`
+// with zero power
+func ChangeoverValidatorUpdates(
+	initialValSet []abci.ValidatorUpdate,
+	standaloneValset []stakingtypes.Validator,
+) ([]abci.ValidatorUpdate, error) {
+	valUpdates := make([]abci.ValidatorUpdate, 0, len(initialValSet)+len(standaloneValset))
+	valUpdates = append(valUpdates, initialValSet...)
+
+	initialUpdatesFlag := make(map[string]bool)
+	for _, val := range initialValSet {
+		initialUpdatesFlag[val.PubKey.String()] = true
+	}
+
+	for _, val := range standaloneValset {
+		if val.ConsensusPubkey == nil {
+			return nil, fmt.Errorf("standalone validator %s has no public key", val.GetOperator())
+		}
+		pubKey, err := val.CmtConsPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key of standalone validator %s: %w", val.GetOperator(), err)
+		}
+		if !initialUpdatesFlag[pubKey.String()] {
+			valUpdates = append(valUpdates, abci.ValidatorUpdate{PubKey: pubKey, Power: 0})
+		}
+	}
+	return valUpdates, nil
+}
@@ -0,0 +1,174 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v6/testutil/crypto"
+	"github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// changeoverGenesisState returns a valid consumer genesis state of a standalone to consumer changeover
+// with the validators of `cIds` as initial validator set
+func changeoverGenesisState(cIds []*crypto.CryptoIdentity) types.GenesisState {
+	validators := []*tmtypes.Validator{}
+	for _, cId := range cIds {
+		validators = append(validators, cId.TMValidator(10))
+	}
+	valSet := tmtypes.NewValidatorSet(validators)
+
+	cs := ibctmtypes.NewClientState(chainID, ibctmtypes.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift,
+		height, commitmenttypes.GetSDKSpecs(), upgradePath)
+	consensusState := ibctmtypes.NewConsensusState(time.Now(), commitmenttypes.NewMerkleRoot([]byte("apphash")), valSet.Hash())
+
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	gs := types.NewInitialGenesisState(cs, consensusState, tmtypes.TM2PB.ValidatorUpdates(valSet), params)
+	gs.PreCCV = true
+	return *gs
+}
+
+// TestValidateChangeover tests the validation of the consumer genesis state of a standalone to consumer changeover
+// over fixtures with common mistakes
+func TestValidateChangeover(t *testing.T) {
+	cIds := crypto.GenMultipleCryptoIds(4, 342)
+	providerCIds := cIds[:3]
+	// the last provider validator is also a standalone validator
+	standaloneValset := []stakingtypes.Validator{cIds[2].SDKStakingValidator(), cIds[3].SDKStakingValidator()}
+
+	testCases := []struct {
+		name           string
+		malleate       func(gs *types.GenesisState)
+		expErrContains string
+	}{
+		{
+			"valid changeover",
+			func(gs *types.GenesisState) {},
+			"",
+		},
+		{
+			"preCCV flag not set",
+			func(gs *types.GenesisState) { gs.PreCCV = false },
+			"preCCV flag must be set",
+		},
+		{
+			"consumer module disabled",
+			func(gs *types.GenesisState) { gs.Params.Enabled = false },
+			"must be enabled",
+		},
+		{
+			"restart genesis state",
+			func(gs *types.GenesisState) {
+				gs.NewChain = false
+				gs.ProviderClientId = "07-tendermint-0"
+				gs.Provider.ClientState = nil
+				gs.Provider.ConsensusState = nil
+			},
+			"must be the one of a new chain",
+		},
+		{
+			"missing provider client state",
+			func(gs *types.GenesisState) { gs.Provider.ClientState = nil },
+			"provider client state cannot be nil",
+		},
+		{
+			"wrong revision of the provider client",
+			func(gs *types.GenesisState) { gs.Provider.ClientState.LatestHeight = clienttypes.NewHeight(1, 4) },
+			"latest height revision number must match chain id revision number",
+		},
+		{
+			"invalid provider consensus state",
+			func(gs *types.GenesisState) { gs.Provider.ConsensusState.Timestamp = time.Time{} },
+			"provider consensus state invalid",
+		},
+		{
+			"empty initial validator set",
+			func(gs *types.GenesisState) { gs.Provider.InitialValSet = []abci.ValidatorUpdate{} },
+			"initial validator set is empty",
+		},
+		{
+			"consumer unbonding period exceeds the provider unbonding period",
+			func(gs *types.GenesisState) { gs.Params.UnbondingPeriod = ubdPeriod + time.Hour },
+			"exceeds the unbonding period of the provider client",
+		},
+		{
+			"invalid parameters",
+			func(gs *types.GenesisState) { gs.Params.CcvTimeoutPeriod = 0 },
+			"invalid consumer genesis state",
+		},
+		{
+			"zero power validator in the initial validator set",
+			func(gs *types.GenesisState) { gs.Provider.InitialValSet[1].Power = 0 },
+			"non-positive power",
+		},
+		{
+			"duplicate validator in the initial validator set",
+			func(gs *types.GenesisState) {
+				gs.Provider.InitialValSet = append(gs.Provider.InitialValSet, gs.Provider.InitialValSet[0])
+			},
+			"is a duplicate",
+		},
+		{
+			"invalid public key in the initial validator set",
+			func(gs *types.GenesisState) { gs.Provider.InitialValSet[0].PubKey = tmprotocrypto.PublicKey{} },
+			"invalid public key",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := changeoverGenesisState(providerCIds)
+			tc.malleate(&gs)
+
+			summary, err := types.ValidateChangeover(gs, standaloneValset)
+			if tc.expErrContains != "" {
+				require.ErrorIs(t, err, types.ErrInvalidChangeover)
+				require.ErrorContains(t, err, tc.expErrContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 3, summary.NumProviderValidators)
+			require.Equal(t, 2, summary.NumStandaloneValidators)
+			require.Equal(t, 1, summary.NumOverlappingValidators)
+			// the provider validators and the standalone validator that is not a provider validator with zero power
+			require.Len(t, summary.ValidatorUpdates, 4)
+			require.Equal(t, abci.ValidatorUpdate{PubKey: cIds[3].TMProtoCryptoPublicKey(), Power: 0}, summary.ValidatorUpdates[3])
+		})
+	}
+}
+
+// TestChangeoverValidatorUpdates tests that the validators of the standalone validator set that are not
+// in the initial validator set are given zero power
+func TestChangeoverValidatorUpdates(t *testing.T) {
+	cIds := crypto.GenMultipleCryptoIds(3, 98234)
+	initialValSet := []abci.ValidatorUpdate{
+		{PubKey: cIds[0].TMProtoCryptoPublicKey(), Power: 5},
+		{PubKey: cIds[1].TMProtoCryptoPublicKey(), Power: 7},
+	}
+
+	valUpdates, err := types.ChangeoverValidatorUpdates(initialValSet,
+		[]stakingtypes.Validator{cIds[1].SDKStakingValidator(), cIds[2].SDKStakingValidator()})
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{
+		initialValSet[0],
+		initialValSet[1],
+		{PubKey: cIds[2].TMProtoCryptoPublicKey(), Power: 0},
+	}, valUpdates)
+
+	// a standalone validator with an invalid public key
+	_, err = types.ChangeoverValidatorUpdates(initialValSet, []stakingtypes.Validator{{OperatorAddress: "invalid"}})
+	require.Error(t, err)
+}
@@ -114,6 +114,8 @@ func (m *CrossChainValidator) GetOptedOut() bool {
 type SlashRecord struct {
 	WaitingOnReply bool      `protobuf:"varint,1,opt,name=waiting_on_reply,json=waitingOnReply,proto3" json:"waiting_on_reply,omitempty"`
 	SendTime       time.Time `protobuf:"bytes,2,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+	// the number of times the slash packet was sent to the provider chain
+	SendAttempts uint32 `protobuf:"varint,3,opt,name=send_attempts,json=sendAttempts,proto3" json:"send_attempts,omitempty"`
 }
 
 func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
@@ -163,9 +165,160 @@ func (m *SlashRecord) GetSendTime() time.Time {
 	return time.Time{}
 }
 
+func (m *SlashRecord) GetSendAttempts() uint32 {
+	if m != nil {
+		return m.SendAttempts
+	}
+	return 0
+}
+
+// VSCMaturityCounters counts the VSC maturities of the consumer chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type VSCMaturityCounters struct {
+	// the number of VSCMatured packets queued to be sent to the provider chain
+	Queued uint64 `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// the number of VSCMatured packets sent to the provider chain
+	Sent uint64 `protobuf:"varint,2,opt,name=sent,proto3" json:"sent,omitempty"`
+	// the number of stale maturing vsc packets pruned without being matured
+	Pruned uint64 `protobuf:"varint,3,opt,name=pruned,proto3" json:"pruned,omitempty"`
+}
+
+func (m *VSCMaturityCounters) Reset()         { *m = VSCMaturityCounters{} }
+func (m *VSCMaturityCounters) String() string { return proto.CompactTextString(m) }
+func (*VSCMaturityCounters) ProtoMessage()    {}
+func (*VSCMaturityCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{2}
+}
+func (m *VSCMaturityCounters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VSCMaturityCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VSCMaturityCounters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VSCMaturityCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VSCMaturityCounters.Merge(m, src)
+}
+func (m *VSCMaturityCounters) XXX_Size() int {
+	return m.Size()
+}
+func (m *VSCMaturityCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_VSCMaturityCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VSCMaturityCounters proto.InternalMessageInfo
+
+func (m *VSCMaturityCounters) GetQueued() uint64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *VSCMaturityCounters) GetSent() uint64 {
+	if m != nil {
+		return m.Sent
+	}
+	return 0
+}
+
+func (m *VSCMaturityCounters) GetPruned() uint64 {
+	if m != nil {
+		return m.Pruned
+	}
+	return 0
+}
+
+// LastAppliedVSC records the last VSC packet applied by the consumer chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type LastAppliedVSC struct {
+	// the valset update id of the VSC packet
+	VscId uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// the block height at which the VSC packet was received
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time at which the VSC packet was received
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the number of staleness thresholds elapsed since the VSC packet was received
+	// for which a valset_stale event was already emitted
+	StalenessWarnings uint64 `protobuf:"varint,4,opt,name=staleness_warnings,json=stalenessWarnings,proto3" json:"staleness_warnings,omitempty"`
+}
+
+func (m *LastAppliedVSC) Reset()         { *m = LastAppliedVSC{} }
+func (m *LastAppliedVSC) String() string { return proto.CompactTextString(m) }
+func (*LastAppliedVSC) ProtoMessage()    {}
+func (*LastAppliedVSC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{3}
+}
+func (m *LastAppliedVSC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastAppliedVSC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastAppliedVSC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastAppliedVSC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastAppliedVSC.Merge(m, src)
+}
+func (m *LastAppliedVSC) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastAppliedVSC) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastAppliedVSC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastAppliedVSC proto.InternalMessageInfo
+
+func (m *LastAppliedVSC) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *LastAppliedVSC) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LastAppliedVSC) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *LastAppliedVSC) GetStalenessWarnings() uint64 {
+	if m != nil {
+		return m.StalenessWarnings
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CrossChainValidator)(nil), "interchain_security.ccv.consumer.v1.CrossChainValidator")
 	proto.RegisterType((*SlashRecord)(nil), "interchain_security.ccv.consumer.v1.SlashRecord")
+	proto.RegisterType((*VSCMaturityCounters)(nil), "interchain_security.ccv.consumer.v1.VSCMaturityCounters")
+	proto.RegisterType((*LastAppliedVSC)(nil), "interchain_security.ccv.consumer.v1.LastAppliedVSC")
 }
 
 func init() {
@@ -173,35 +326,44 @@ func init() {
 }
 
 var fileDescriptor_5b27a82b276e7f93 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xb6, 0x50, 0xdc, 0x0d, 0x42, 0xc8, 0x44, 0xc2, 0xcd, 0xc1, 0x8e, 0xc2, 0xc5, 0x97,
-	0xda, 0x6a, 0x2a, 0x71, 0x40, 0xe2, 0xd0, 0xf4, 0xc8, 0xa1, 0xc8, 0x20, 0x90, 0xb8, 0x58, 0xeb,
-	0xf5, 0xe2, 0x58, 0xd8, 0x3b, 0xab, 0xfd, 0x71, 0x59, 0x9e, 0xa2, 0x0f, 0xc3, 0x43, 0x14, 0x4e,
-	0x3d, 0x72, 0x2a, 0x28, 0x79, 0x03, 0x9e, 0x00, 0xf9, 0x27, 0x41, 0xfc, 0xdc, 0x66, 0xbe, 0xd9,
-	0x6f, 0xe6, 0x9b, 0xd9, 0x0f, 0x2f, 0x4a, 0xae, 0x99, 0xa4, 0x2b, 0x52, 0xf2, 0x54, 0x31, 0x6a,
-	0x64, 0xa9, 0x6d, 0x4c, 0x69, 0x13, 0x53, 0xe0, 0xca, 0xd4, 0x4c, 0xc6, 0xcd, 0xc9, 0x2e, 0x8e,
-	0x84, 0x04, 0x0d, 0xee, 0x93, 0xff, 0x70, 0x22, 0x4a, 0x9b, 0x68, 0xf7, 0xae, 0x39, 0x99, 0x1e,
-	0x15, 0x00, 0x45, 0xc5, 0xe2, 0x8e, 0x92, 0x99, 0xf7, 0x31, 0xe1, 0xb6, 0xe7, 0x4f, 0x27, 0x05,
-	0x14, 0xd0, 0x85, 0x71, 0x1b, 0x0d, 0xe8, 0x11, 0x05, 0x55, 0x83, 0x4a, 0xfb, 0x42, 0x9f, 0x0c,
-	0xa5, 0xe0, 0xef, 0x5e, 0xba, 0xac, 0x99, 0xd2, 0xa4, 0x16, 0xfd, 0x83, 0xf9, 0x17, 0x84, 0x1f,
-	0x9d, 0x4b, 0x50, 0xea, 0xbc, 0x15, 0xf5, 0x86, 0x54, 0x65, 0x4e, 0x34, 0x48, 0xd7, 0xc3, 0xf7,
-	0x48, 0x9e, 0x4b, 0xa6, 0x94, 0x87, 0x66, 0x28, 0xbc, 0x9f, 0x6c, 0x53, 0x77, 0x82, 0xef, 0x0a,
-	0xb8, 0x64, 0xd2, 0xdb, 0x9b, 0xa1, 0x70, 0x3f, 0xe9, 0x13, 0x97, 0xe0, 0x03, 0x61, 0xb2, 0x0f,
-	0xcc, 0x7a, 0xfb, 0x33, 0x14, 0x8e, 0x17, 0x93, 0xa8, 0x9f, 0x1c, 0x6d, 0x27, 0x47, 0x67, 0xdc,
-	0x2e, 0x4f, 0x7f, 0xde, 0x06, 0x8f, 0x2d, 0xa9, 0xab, 0x67, 0xf3, 0x76, 0x63, 0xc6, 0x95, 0x51,
-	0x69, 0xcf, 0x9b, 0x7f, 0xfd, 0x7c, 0x3c, 0x19, 0xb4, 0x53, 0x69, 0x85, 0x86, 0xe8, 0xa5, 0xc9,
-	0x5e, 0x30, 0x9b, 0x0c, 0x8d, 0xdd, 0x00, 0x1f, 0x82, 0xd0, 0x2c, 0x4f, 0xc1, 0x68, 0xef, 0xce,
-	0x0c, 0x85, 0xce, 0x72, 0xcf, 0x43, 0x89, 0xd3, 0x81, 0x17, 0x46, 0xcf, 0x3f, 0xe1, 0xf1, 0xab,
-	0x8a, 0xa8, 0x55, 0xc2, 0x28, 0xc8, 0xdc, 0x0d, 0xf1, 0xc3, 0x4b, 0x52, 0xea, 0x92, 0x17, 0x29,
-	0xf0, 0x54, 0x32, 0x51, 0xd9, 0x6e, 0x17, 0x27, 0x79, 0x30, 0xe0, 0x17, 0x3c, 0x69, 0x51, 0xf7,
-	0x0c, 0x1f, 0x2a, 0xc6, 0xf3, 0xb4, 0x3d, 0x4e, 0xb7, 0xd6, 0x78, 0x31, 0xfd, 0x47, 0xff, 0xeb,
-	0xed, 0xe5, 0x96, 0xce, 0xf5, 0x6d, 0x30, 0xba, 0xfa, 0x1e, 0xa0, 0xc4, 0x69, 0x69, 0x6d, 0x61,
-	0xf9, 0xf6, 0x7a, 0xed, 0xa3, 0x9b, 0xb5, 0x8f, 0x7e, 0xac, 0x7d, 0x74, 0xb5, 0xf1, 0x47, 0x37,
-	0x1b, 0x7f, 0xf4, 0x6d, 0xe3, 0x8f, 0xde, 0x3d, 0x2f, 0x4a, 0xbd, 0x32, 0x59, 0x44, 0xa1, 0x1e,
-	0xfe, 0x26, 0xfe, 0xed, 0x82, 0xe3, 0x9d, 0x73, 0x9a, 0xa7, 0xf1, 0xc7, 0x3f, 0xed, 0xa3, 0xad,
-	0x60, 0x2a, 0x3b, 0xe8, 0x04, 0x9c, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xba, 0xf9, 0x06,
-	0x6f, 0x02, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcb, 0x6e, 0x13, 0x31,
+	0x14, 0x8d, 0xdb, 0x69, 0x48, 0xdd, 0x87, 0xc0, 0x0d, 0x90, 0x76, 0x91, 0x44, 0xe9, 0x26, 0x9b,
+	0xce, 0xa8, 0xad, 0x84, 0x10, 0x12, 0x8b, 0x24, 0x2b, 0x04, 0xa8, 0xc8, 0x45, 0xad, 0x60, 0x33,
+	0x72, 0x3c, 0x66, 0x32, 0x62, 0xc6, 0x1e, 0xfc, 0x98, 0x32, 0x7f, 0xd1, 0x3f, 0x60, 0xcb, 0x07,
+	0xf0, 0x11, 0x85, 0x55, 0x97, 0xac, 0x0a, 0x6a, 0xff, 0x80, 0x2f, 0x40, 0xe3, 0x71, 0x82, 0x78,
+	0x6c, 0xd8, 0xdd, 0x73, 0xae, 0xcf, 0xf5, 0x3d, 0xbe, 0xbe, 0xf0, 0x20, 0xe1, 0x9a, 0x49, 0x3a,
+	0x23, 0x09, 0x0f, 0x15, 0xa3, 0x46, 0x26, 0xba, 0x0c, 0x28, 0x2d, 0x02, 0x2a, 0xb8, 0x32, 0x19,
+	0x93, 0x41, 0xb1, 0xbf, 0x88, 0xfd, 0x5c, 0x0a, 0x2d, 0xd0, 0xee, 0x3f, 0x34, 0x3e, 0xa5, 0x85,
+	0xbf, 0x38, 0x57, 0xec, 0xef, 0x6c, 0xc7, 0x42, 0xc4, 0x29, 0x0b, 0xac, 0x64, 0x6a, 0xde, 0x04,
+	0x84, 0x97, 0xb5, 0x7e, 0xa7, 0x1d, 0x8b, 0x58, 0xd8, 0x30, 0xa8, 0x22, 0xc7, 0x6e, 0x53, 0xa1,
+	0x32, 0xa1, 0xc2, 0x3a, 0x51, 0x03, 0x97, 0xea, 0xfd, 0x59, 0x4b, 0x27, 0x19, 0x53, 0x9a, 0x64,
+	0x79, 0x7d, 0x60, 0xf0, 0x19, 0xc0, 0xad, 0x89, 0x14, 0x4a, 0x4d, 0xaa, 0xa6, 0x4e, 0x48, 0x9a,
+	0x44, 0x44, 0x0b, 0x89, 0x3a, 0xf0, 0x16, 0x89, 0x22, 0xc9, 0x94, 0xea, 0x80, 0x3e, 0x18, 0xae,
+	0xe3, 0x39, 0x44, 0x6d, 0xb8, 0x92, 0x8b, 0x33, 0x26, 0x3b, 0x4b, 0x7d, 0x30, 0x5c, 0xc6, 0x35,
+	0x40, 0x04, 0x36, 0x73, 0x33, 0x7d, 0xcb, 0xca, 0xce, 0x72, 0x1f, 0x0c, 0xd7, 0x0e, 0xda, 0x7e,
+	0x7d, 0xb3, 0x3f, 0xbf, 0xd9, 0x1f, 0xf1, 0x72, 0x7c, 0xf8, 0xe3, 0xaa, 0x77, 0xbf, 0x24, 0x59,
+	0xfa, 0x68, 0x50, 0x39, 0x66, 0x5c, 0x19, 0x15, 0xd6, 0xba, 0xc1, 0x97, 0x4f, 0x7b, 0x6d, 0xd7,
+	0x3b, 0x95, 0x65, 0xae, 0x85, 0xff, 0xc2, 0x4c, 0x9f, 0xb2, 0x12, 0xbb, 0xc2, 0xa8, 0x07, 0x57,
+	0x45, 0xae, 0x59, 0x14, 0x0a, 0xa3, 0x3b, 0x5e, 0x1f, 0x0c, 0x5b, 0xe3, 0xa5, 0x0e, 0xc0, 0x2d,
+	0x4b, 0x1e, 0x19, 0x3d, 0xf8, 0x00, 0xe0, 0xda, 0x71, 0x4a, 0xd4, 0x0c, 0x33, 0x2a, 0x64, 0x84,
+	0x86, 0xf0, 0xf6, 0x19, 0x49, 0x74, 0xc2, 0xe3, 0x50, 0xf0, 0x50, 0xb2, 0x3c, 0x2d, 0xad, 0x99,
+	0x16, 0xde, 0x74, 0xfc, 0x11, 0xc7, 0x15, 0x8b, 0x46, 0x70, 0x55, 0x31, 0x1e, 0x85, 0xd5, 0xeb,
+	0x58, 0x5f, 0x6b, 0x07, 0x3b, 0x7f, 0x19, 0x78, 0x39, 0x7f, 0xba, 0x71, 0xeb, 0xe2, 0xaa, 0xd7,
+	0x38, 0xff, 0xd6, 0x03, 0xb8, 0x55, 0xc9, 0xaa, 0x04, 0xda, 0x85, 0x1b, 0xb6, 0x04, 0xd1, 0x9a,
+	0x65, 0xb9, 0x56, 0xf6, 0x1d, 0x36, 0xf0, 0x7a, 0x45, 0x8e, 0x1c, 0x37, 0x78, 0x05, 0xb7, 0x4e,
+	0x8e, 0x27, 0xcf, 0x89, 0xb6, 0x93, 0x9f, 0x08, 0x53, 0x7d, 0x07, 0x85, 0xee, 0xc1, 0xe6, 0x3b,
+	0xc3, 0x0c, 0x8b, 0x6c, 0x7b, 0x1e, 0x76, 0x08, 0x21, 0xe8, 0x29, 0xc6, 0xb5, 0xed, 0xc8, 0xc3,
+	0x36, 0xae, 0xce, 0xe6, 0xd2, 0x70, 0x16, 0xd9, 0x0b, 0x3c, 0xec, 0xd0, 0xe0, 0x23, 0x80, 0x9b,
+	0xcf, 0x88, 0xd2, 0xa3, 0x3c, 0x4f, 0x13, 0x16, 0x9d, 0x1c, 0x4f, 0xd0, 0x5d, 0xd8, 0x2c, 0x14,
+	0x0d, 0x93, 0x79, 0xd9, 0x95, 0x42, 0xd1, 0x27, 0x51, 0x55, 0x61, 0xc6, 0x92, 0x78, 0xa6, 0xdd,
+	0x04, 0x1d, 0x42, 0x0f, 0xa1, 0x67, 0xfd, 0x2f, 0xff, 0x87, 0x7f, 0xab, 0x40, 0x7b, 0x10, 0x29,
+	0x4d, 0x52, 0xc6, 0x99, 0x52, 0xe1, 0x19, 0x91, 0x3c, 0xe1, 0xb1, 0xb2, 0x23, 0xf2, 0xf0, 0x9d,
+	0x45, 0xe6, 0xd4, 0x25, 0xc6, 0xa7, 0x17, 0xd7, 0x5d, 0x70, 0x79, 0xdd, 0x05, 0xdf, 0xaf, 0xbb,
+	0xe0, 0xfc, 0xa6, 0xdb, 0xb8, 0xbc, 0xe9, 0x36, 0xbe, 0xde, 0x74, 0x1b, 0xaf, 0x1f, 0xc7, 0x89,
+	0x9e, 0x99, 0xa9, 0x4f, 0x45, 0xe6, 0xfe, 0x71, 0xf0, 0x6b, 0x63, 0xf6, 0x16, 0x5b, 0x56, 0x3c,
+	0x08, 0xde, 0xff, 0xbe, 0x6a, 0xba, 0xcc, 0x99, 0x9a, 0x36, 0x6d, 0xaf, 0x87, 0x3f, 0x07, 0x00,
+	0x4e, 0xf2, 0x85, 0xf4, 0x9b, 0x03, 0x00, 0x00,
 }
 
 func (m *CrossChainValidator) Marshal() (dAtA []byte, err error) {
@@ -281,6 +443,11 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SendAttempts != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.SendAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
 	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime):])
 	if err2 != nil {
 		return 0, err2
@@ -302,6 +469,90 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VSCMaturityCounters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSCMaturityCounters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VSCMaturityCounters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pruned != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Pruned))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sent != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Sent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Queued))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastAppliedVSC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastAppliedVSC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastAppliedVSC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StalenessWarnings != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.StalenessWarnings))
+		i--
+		dAtA[i] = 0x20
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintConsumer(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VscId != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovConsumer(v)
 	base := offset
@@ -347,6 +598,47 @@ func (m *SlashRecord) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
 	n += 1 + l + sovConsumer(uint64(l))
+	if m.SendAttempts != 0 {
+		n += 1 + sovConsumer(uint64(m.SendAttempts))
+	}
+	return n
+}
+
+func (m *VSCMaturityCounters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued != 0 {
+		n += 1 + sovConsumer(uint64(m.Queued))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovConsumer(uint64(m.Sent))
+	}
+	if m.Pruned != 0 {
+		n += 1 + sovConsumer(uint64(m.Pruned))
+	}
+	return n
+}
+
+func (m *LastAppliedVSC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovConsumer(uint64(m.VscId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovConsumer(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovConsumer(uint64(l))
+	if m.StalenessWarnings != 0 {
+		n += 1 + sovConsumer(uint64(m.StalenessWarnings))
+	}
 	return n
 }
 
@@ -597,6 +889,272 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAttempts", wireType)
+			}
+			m.SendAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VSCMaturityCounters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VSCMaturityCounters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VSCMaturityCounters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			m.Queued = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Queued |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			m.Pruned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pruned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastAppliedVSC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastAppliedVSC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastAppliedVSC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalenessWarnings", wireType)
+			}
+			m.StalenessWarnings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalenessWarnings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConsumer(dAtA[iNdEx:])
@@ -8,4 +8,7 @@ import (
 var (
 	ErrNoProposerChannelId                  = errorsmod.Register(ModuleName, 1, "no established CCV channel")
 	ErrConsumerRewardDenomAlreadyRegistered = errorsmod.Register(ModuleName, 2, "consumer reward denom already registered")
+	ErrUnapprovedProviderClientSubstitute   = errorsmod.Register(ModuleName, 3, "provider client substitute not approved by governance")
+	ErrInvalidProviderClient                = errorsmod.Register(ModuleName, 4, "invalid provider client")
+	ErrInvalidChangeover                    = errorsmod.Register(ModuleName, 5, "invalid standalone to consumer changeover")
 )
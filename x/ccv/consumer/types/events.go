@@ -4,10 +4,19 @@ const (
 	AttributeConsumerHeight = "consumer_height"
 	AttributeTimestamp      = "timestamp"
 
-	EventTypeFeeDistribution          = "fee_distribution"
-	EventTypeVSCMatured               = "vsc_matured"
-	EventTypeConsumerSlashRequest     = "consumer_slash_request"
-	EventTypeFeeTransferChannelOpened = "fee_transfer_channel_opened"
+	EventTypeFeeDistribution            = "fee_distribution"
+	EventTypeVSCMatured                 = "vsc_matured"
+	EventTypeVSCMaturityPruned          = "vsc_maturity_pruned"
+	EventTypeConsumerSlashRequest       = "consumer_slash_request"
+	EventTypeFeeTransferChannelOpened   = "fee_transfer_channel_opened"
+	EventTypeProviderClientExpiring     = "provider_client_expiring"
+	EventTypeProviderClientExpired      = "provider_client_expired"
+	EventTypeProviderFeePoolAddrUpdated = "provider_fee_pool_addr_updated"
+
+	EventTypeProviderUpgradeNotice        = "provider_upgrade_notice"
+	EventTypeProviderUpgradeNoticeCleared = "provider_upgrade_notice_cleared"
+
+	EventTypeValsetStale = "valset_stale"
 
 	AttributeDistributionCurrentHeight = "current_distribution_height"
 	//#nosec G101 -- (false positive) this is not a hardcoded credential
@@ -15,4 +24,28 @@ const (
 	AttributeDistributionFraction   = "distribution_fraction"
 	AttributeDistributionTotal      = "total"
 	AttributeDistributionToProvider = "provider_amount"
+
+	AttributeProviderClientID         = "provider_client_id"
+	AttributeProviderClientExpiryTime = "expiry_time"
+	AttributeTimeUntilExpiry          = "time_until_expiry"
+	AttributeSeverity                 = "severity"
+	AttributeHaltHeight               = "halt_height"
+
+	AttributeProviderFeePoolAddr = "provider_fee_pool_addr"
+
+	AttributeUpgradeName          = "upgrade_name"
+	AttributeUpgradeHeight        = "upgrade_height"
+	AttributeUpgradeEstimatedTime = "estimated_time"
+
+	AttributeReceivedTime = "received_time"
+	AttributeMaturityTime = "maturity_time"
+
+	AttributeLastVSCHeight         = "last_vsc_height"
+	AttributeBlocksSinceLastVSC    = "blocks_since_last_vsc"
+	AttributeTimeSinceLastVSC      = "time_since_last_vsc"
+	AttributeStalenessThreshold    = "staleness_threshold"
+	AttributeMaturitySendsBackedUp = "maturity_sends_backed_up"
+
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
 )
@@ -5,6 +5,8 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
@@ -76,8 +78,14 @@ func NewInitialGenesisState(cs *ibctmtypes.ClientState, consState *ibctmtypes.Co
 //   - Params, InitialValset, ProviderID, channelID, HeightToValidatorSetUpdateID // mandatory
 //   - MaturingVSCPackets, OutstandingDowntime, PendingConsumerPacket, LastTransmissionBlockHeight // optional
 //
+// On restart, the outstanding downtime flags must be set for valid and distinct consensus addresses
+// and the pending consumer packet indices, if any, must be strictly increasing.
+//
 
 func (gs GenesisState) Validate() error {
+	if err := ccv.ValidateCcvGenesisVersion(gs.CcvGenesisVersion); err != nil {
+		return err
+	}
 	if !gs.Params.Enabled {
 		return nil
 	}
@@ -116,6 +124,12 @@ func (gs GenesisState) Validate() error {
 		if gs.LastTransmissionBlockHeight.Height != 0 {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "last transmission block height must be empty for new chain")
 		}
+		if gs.SlashRecord != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "slash record must be empty for new chain")
+		}
+		if len(gs.PendingConsumerPacketIndices) != 0 {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "pending consumer packet indices must be empty for new chain")
+		}
 	} else {
 		// NOTE: For restart genesis, we will verify initial validator set in InitGenesis.
 		if gs.ProviderClientId == "" {
@@ -144,6 +158,18 @@ func (gs GenesisState) Validate() error {
 				}
 			}
 		}
+		if gs.SlashRecord != nil {
+			hasSlashPacket := false
+			for _, packet := range gs.PendingConsumerPackets.List {
+				if packet.Type == ccv.SlashPacket {
+					hasSlashPacket = true
+					break
+				}
+			}
+			if !hasSlashPacket {
+				return errorsmod.Wrap(ccv.ErrInvalidGenesis, "slash record must be empty when there are no pending slash packets")
+			}
+		}
 		/* 		if gs.HeightToValsetUpdateId == nil {
 			return errorsmod.Wrap(
 				ccv.ErrInvalidGenesis,
@@ -158,6 +184,50 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrap(err, "invalid unbonding sequences")
 			}
 		}
+		if err := validateOutstandingDowntimes(gs.OutstandingDowntimeSlashing); err != nil {
+			return err
+		}
+		if err := validatePendingConsumerPacketIndices(gs.PendingConsumerPackets, gs.PendingConsumerPacketIndices); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOutstandingDowntimes validates that the outstanding downtime flags are set
+// for valid and distinct validator consensus addresses
+func validateOutstandingDowntimes(downtimes []OutstandingDowntime) error {
+	seen := map[string]bool{}
+	for _, od := range downtimes {
+		consAddr, err := sdk.ConsAddressFromBech32(od.ValidatorConsensusAddress)
+		if err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "invalid outstanding downtime validator consensus address %s: %s",
+				od.ValidatorConsensusAddress, err.Error())
+		}
+		if seen[string(consAddr)] {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "duplicate outstanding downtime for validator consensus address %s",
+				od.ValidatorConsensusAddress)
+		}
+		seen[string(consAddr)] = true
+	}
+	return nil
+}
+
+// validatePendingConsumerPacketIndices validates that, if set, there is one index per pending consumer packet
+// and that the indices are strictly increasing, i.e., that they preserve the order of the pending packets queue
+func validatePendingConsumerPacketIndices(packets ConsumerPacketDataList, indices []uint64) error {
+	if len(indices) == 0 {
+		return nil
+	}
+	if len(indices) != len(packets.List) {
+		return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "number of pending consumer packet indices (%d) does not match number of pending consumer packets (%d)",
+			len(indices), len(packets.List))
+	}
+	for i := 1; i < len(indices); i++ {
+		if indices[i] <= indices[i-1] {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "pending consumer packet indices must be strictly increasing: index %d follows index %d",
+				indices[i], indices[i-1])
+		}
 	}
 	return nil
 }
@@ -62,6 +62,17 @@ type GenesisState struct {
 	// flag indicating whether the consumer CCV module starts in pre-CCV state
 	PreCCV   bool               `protobuf:"varint,13,opt,name=preCCV,proto3" json:"preCCV,omitempty"`
 	Provider types.ProviderInfo `protobuf:"bytes,14,opt,name=provider,proto3" json:"provider"`
+	// SlashRecord of the slash packet at the head of the pending consumer packets.
+	// Nil on new chain, filled in on restart if a slash packet is waiting to be handled by the provider.
+	SlashRecord *SlashRecord `protobuf:"bytes,15,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
+	// PendingConsumerPacketIndices are the indices of the pending consumer packets
+	// in the pending packets queue, i.e., the i-th index belongs to the i-th pending packet.
+	// Nil on new chain, filled in on restart. If nil on restart, e.g., for a genesis state
+	// exported by a previous version, the pending packets are enqueued starting from index zero.
+	PendingConsumerPacketIndices []uint64 `protobuf:"varint,16,rep,packed,name=pending_consumer_packet_indices,json=pendingConsumerPacketIndices,proto3" json:"pending_consumer_packet_indices,omitempty"`
+	// the version of the consumer genesis format generated by the provider (empty if unknown).
+	// Genesis states of an unsupported version are rejected.
+	CcvGenesisVersion string `protobuf:"bytes,17,opt,name=ccv_genesis_version,json=ccvGenesisVersion,proto3" json:"ccv_genesis_version,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -198,6 +209,27 @@ func (m *GenesisState) GetProvider() types.ProviderInfo {
 	return types.ProviderInfo{}
 }
 
+func (m *GenesisState) GetSlashRecord() *SlashRecord {
+	if m != nil {
+		return m.SlashRecord
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingConsumerPacketIndices() []uint64 {
+	if m != nil {
+		return m.PendingConsumerPacketIndices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCcvGenesisVersion() string {
+	if m != nil {
+		return m.CcvGenesisVersion
+	}
+	return ""
+}
+
 // HeightValsetUpdateID represents a mapping internal to the consumer CCV module
 // which links a block height to each recv valset update id.
 type HeightToValsetUpdateID struct {
@@ -352,6 +384,9 @@ func (m *LastTransmissionBlockHeight) GetHeight() int64 {
 type MaturingVSCPacket struct {
 	VscId        uint64    `protobuf:"varint,1,opt,name=vscId,proto3" json:"vscId,omitempty"`
 	MaturityTime time.Time `protobuf:"bytes,2,opt,name=maturity_time,json=maturityTime,proto3,stdtime" json:"maturity_time"`
+	// the time at which the vsc packet was received; zero for the packets
+	// received before the received times were recorded
+	ReceivedTime time.Time `protobuf:"bytes,3,opt,name=received_time,json=receivedTime,proto3,stdtime" json:"received_time"`
 }
 
 func (m *MaturingVSCPacket) Reset()         { *m = MaturingVSCPacket{} }
@@ -401,6 +436,13 @@ func (m *MaturingVSCPacket) GetMaturityTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MaturingVSCPacket) GetReceivedTime() time.Time {
+	if m != nil {
+		return m.ReceivedTime
+	}
+	return time.Time{}
+}
+
 // ConsumerPacketDataList is a list of consumer packet data packets.
 //
 // Note this type is used internally to the consumer CCV module
@@ -463,64 +505,71 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 912 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcf, 0x6f, 0x23, 0x35,
-	0x14, 0xee, 0xb4, 0xdd, 0x90, 0xb8, 0xed, 0x6e, 0xd7, 0x5d, 0xa2, 0xa1, 0x11, 0x69, 0x14, 0x84,
-	0x14, 0xf1, 0xc3, 0x43, 0x8a, 0x58, 0x21, 0x21, 0x10, 0x24, 0x95, 0x68, 0x50, 0x11, 0x55, 0xda,
-	0x0d, 0xd2, 0x5e, 0x46, 0x8e, 0xc7, 0x3b, 0xb1, 0x76, 0xc6, 0x1e, 0x8d, 0x9d, 0x09, 0x15, 0xe2,
-	0xc2, 0x95, 0xcb, 0xfe, 0x59, 0x7b, 0xdc, 0x03, 0x07, 0x4e, 0x80, 0xda, 0x7f, 0x04, 0xd9, 0xe3,
-	0x99, 0x24, 0x34, 0xed, 0xe6, 0x16, 0xcf, 0x7b, 0xef, 0xfb, 0xde, 0xfb, 0xde, 0x7b, 0x76, 0x40,
-	0x97, 0x71, 0x45, 0x53, 0x32, 0xc1, 0x8c, 0xfb, 0x92, 0x92, 0x69, 0xca, 0xd4, 0x95, 0x47, 0x48,
-	0xe6, 0x11, 0xc1, 0xe5, 0x34, 0xa6, 0xa9, 0x97, 0x75, 0xbd, 0x90, 0x72, 0x2a, 0x99, 0x44, 0x49,
-	0x2a, 0x94, 0x80, 0x1f, 0xac, 0x08, 0x41, 0x84, 0x64, 0xa8, 0x08, 0x41, 0x59, 0xf7, 0xf0, 0xb3,
-	0xbb, 0x70, 0xb3, 0xae, 0x27, 0x27, 0x38, 0xa5, 0x81, 0x5f, 0xba, 0x1b, 0xd8, 0x43, 0x8f, 0x8d,
-	0x89, 0x17, 0xb1, 0x70, 0xa2, 0x48, 0xc4, 0x28, 0x57, 0xd2, 0x53, 0x94, 0x07, 0x34, 0x8d, 0x19,
-	0x57, 0x3a, 0x6a, 0x7e, 0xb2, 0x01, 0x4f, 0x42, 0x11, 0x0a, 0xf3, 0xd3, 0xd3, 0xbf, 0xec, 0xd7,
-	0x0f, 0xef, 0x21, 0x9e, 0xb1, 0x94, 0x5a, 0xb7, 0xa3, 0x50, 0x88, 0x30, 0xa2, 0x9e, 0x39, 0x8d,
-	0xa7, 0x2f, 0x3c, 0xc5, 0x62, 0x2a, 0x15, 0x8e, 0x13, 0xeb, 0xd0, 0x58, 0x60, 0xc7, 0x63, 0xc2,
-	0x3c, 0x75, 0x95, 0x50, 0x2b, 0x41, 0xfb, 0xcf, 0x1a, 0xd8, 0xfd, 0x3e, 0x17, 0xe5, 0x42, 0x61,
-	0x45, 0xe1, 0x29, 0xa8, 0x24, 0x38, 0xc5, 0xb1, 0x74, 0x9d, 0x96, 0xd3, 0xd9, 0x39, 0xfe, 0x08,
-	0xdd, 0x25, 0x52, 0xd6, 0x45, 0x7d, 0x5b, 0xf8, 0xb9, 0x89, 0xe8, 0x6d, 0xbf, 0xfe, 0xfb, 0x68,
-	0x63, 0x68, 0xe3, 0xe1, 0x27, 0x00, 0x26, 0xa9, 0xc8, 0x58, 0x40, 0x53, 0x3f, 0x17, 0xc2, 0x67,
-	0x81, 0xbb, 0xd9, 0x72, 0x3a, 0xb5, 0xe1, 0x7e, 0x61, 0xe9, 0x1b, 0xc3, 0x20, 0x80, 0x08, 0x1c,
-	0xcc, 0xbd, 0x27, 0x98, 0x73, 0x1a, 0x69, 0xf7, 0x2d, 0xe3, 0xfe, 0xb8, 0x74, 0xcf, 0x2d, 0x83,
-	0x00, 0x36, 0x40, 0x8d, 0xd3, 0x99, 0x6f, 0xf2, 0x72, 0xb7, 0x5b, 0x4e, 0xa7, 0x3a, 0xac, 0x72,
-	0x3a, 0xeb, 0xeb, 0x33, 0x24, 0xe0, 0xdd, 0xff, 0x53, 0x4b, 0x5d, 0x9d, 0xfb, 0xc0, 0xd4, 0xf4,
-	0x31, 0x62, 0x63, 0x82, 0x16, 0x3b, 0x84, 0x16, 0x7a, 0xa2, 0xeb, 0x32, 0x5f, 0x8d, 0x20, 0xbd,
-	0x4d, 0xd7, 0x19, 0x1e, 0x2c, 0xa7, 0x9b, 0x2b, 0x15, 0x01, 0x77, 0x4e, 0x22, 0xb8, 0xa4, 0x5c,
-	0x4e, 0xa5, 0xe5, 0xa9, 0x18, 0x1e, 0xf4, 0x56, 0x9e, 0x22, 0x6c, 0x4e, 0x55, 0x2f, 0xa9, 0x96,
-	0x6c, 0x30, 0x04, 0xfb, 0x31, 0x56, 0xd3, 0x94, 0xf1, 0xd0, 0x4f, 0x30, 0x79, 0x49, 0x95, 0x74,
-	0xdf, 0x69, 0x6d, 0x75, 0x76, 0x8e, 0x9f, 0xa2, 0x35, 0xc6, 0x18, 0xfd, 0x68, 0x83, 0x47, 0x17,
-	0xfd, 0x73, 0x13, 0x6e, 0xbb, 0xf5, 0xa8, 0x40, 0xcd, 0xbf, 0x4a, 0x78, 0x0e, 0x1e, 0x31, 0xce,
-	0x14, 0xc3, 0x91, 0x9f, 0xe1, 0xc8, 0x97, 0x54, 0xb9, 0x55, 0xc3, 0xd3, 0x5a, 0x4c, 0x5e, 0x0f,
-	0x12, 0x1a, 0xe1, 0x88, 0x05, 0x58, 0x89, 0xf4, 0x59, 0x12, 0xe8, 0xfc, 0x2b, 0x1a, 0xd1, 0x75,
-	0x86, 0x7b, 0x16, 0x60, 0x84, 0xa3, 0x0b, 0xaa, 0xe0, 0x6f, 0xe0, 0x70, 0x42, 0xb5, 0x08, 0xbe,
-	0x12, 0x1a, 0x53, 0x52, 0xe5, 0x4f, 0x4d, 0x84, 0xee, 0x70, 0xcd, 0x80, 0x7f, 0xb5, 0x56, 0x11,
-	0xa7, 0x06, 0xe6, 0x52, 0x8c, 0x0c, 0x48, 0xce, 0x3a, 0x38, 0xb1, 0x95, 0xd4, 0x27, 0xab, 0xac,
-	0x01, 0xfc, 0xdd, 0x01, 0xef, 0x8b, 0xa9, 0x92, 0x0a, 0xf3, 0x40, 0xab, 0x17, 0x88, 0x19, 0xd7,
-	0x3b, 0xe2, 0xcb, 0x08, 0xcb, 0x09, 0xe3, 0xa1, 0x0b, 0x4c, 0x0a, 0x5f, 0xae, 0x95, 0xc2, 0x4f,
-	0x73, 0xa4, 0x13, 0x0b, 0x64, 0xf9, 0x1b, 0xe2, 0xb6, 0xe9, 0xc2, 0x52, 0xc0, 0x5f, 0x81, 0x9b,
-	0xd0, 0x9c, 0xbf, 0x40, 0x2b, 0xdb, 0xb8, 0x63, 0x86, 0x65, 0x3d, 0x05, 0xe6, 0x1b, 0xa7, 0x63,
-	0x4f, 0xb0, 0xc2, 0x67, 0x4c, 0x16, 0xbd, 0xac, 0x5b, 0x8a, 0x65, 0x27, 0x09, 0xff, 0x70, 0x40,
-	0x33, 0xc2, 0x52, 0xf9, 0x2a, 0xc5, 0x5c, 0xc6, 0x4c, 0x4a, 0x26, 0xb8, 0x3f, 0x8e, 0x04, 0x79,
-	0xe9, 0xe7, 0xa2, 0xb9, 0xbb, 0x26, 0x87, 0x6f, 0xd7, 0xca, 0xe1, 0x0c, 0x4b, 0x75, 0xb9, 0x80,
-	0xd4, 0xd3, 0x40, 0x79, 0x6b, 0x0a, 0x29, 0xa2, 0xbb, 0x5d, 0x60, 0x1d, 0x54, 0x92, 0x94, 0xf6,
-	0xfb, 0x23, 0x77, 0xcf, 0xac, 0xad, 0x3d, 0xc1, 0x1f, 0x40, 0xb5, 0x98, 0x7d, 0xf7, 0xa1, 0x49,
-	0xa7, 0x73, 0xdf, 0xdd, 0x73, 0x6e, 0x7d, 0x07, 0xfc, 0x85, 0xb0, 0xb4, 0x65, 0x7c, 0xfb, 0x39,
-	0xa8, 0xaf, 0x9e, 0x15, 0xcd, 0x6e, 0x4b, 0xd6, 0xf7, 0xdb, 0xf6, 0xd0, 0x9e, 0x60, 0x07, 0xec,
-	0xdf, 0x1a, 0xcd, 0x4d, 0xe3, 0xf1, 0x30, 0x5b, 0x9a, 0xa7, 0xf6, 0x33, 0x70, 0xb0, 0x62, 0x08,
-	0xe0, 0x37, 0xa0, 0x91, 0x15, 0xfb, 0xb0, 0x70, 0x1f, 0xe0, 0x20, 0x48, 0xa9, 0xcc, 0x6f, 0xd3,
-	0xda, 0xf0, 0xbd, 0xd2, 0xa5, 0x5c, 0xef, 0xef, 0x72, 0x87, 0xf6, 0x17, 0xa0, 0x71, 0x76, 0xbf,
-	0x6a, 0x0b, 0x79, 0x6f, 0x15, 0x79, 0xb7, 0x15, 0x78, 0x7c, 0x6b, 0xb5, 0xe1, 0x13, 0xf0, 0x20,
-	0x93, 0x64, 0x10, 0xd8, 0x1a, 0xf3, 0x03, 0x1c, 0x80, 0xbd, 0x7c, 0xd9, 0xd5, 0x95, 0xaf, 0x53,
-	0x36, 0xf5, 0xed, 0x1c, 0x1f, 0xa2, 0xfc, 0x05, 0x41, 0xc5, 0x0b, 0x82, 0x2e, 0x8b, 0x17, 0xa4,
-	0x57, 0xd5, 0xba, 0xbe, 0xfa, 0xe7, 0xc8, 0x19, 0xee, 0x16, 0xa1, 0xda, 0xd8, 0x1e, 0x83, 0xfa,
-	0xea, 0x49, 0x84, 0xa7, 0x60, 0x3b, 0x62, 0x52, 0x67, 0xb9, 0x95, 0xdf, 0x80, 0xeb, 0xbc, 0x1e,
-	0x05, 0x82, 0xed, 0xa3, 0x41, 0xe8, 0xfd, 0xfc, 0xfa, 0xba, 0xe9, 0xbc, 0xb9, 0x6e, 0x3a, 0xff,
-	0x5e, 0x37, 0x9d, 0x57, 0x37, 0xcd, 0x8d, 0x37, 0x37, 0xcd, 0x8d, 0xbf, 0x6e, 0x9a, 0x1b, 0xcf,
-	0xbf, 0x0e, 0x99, 0x9a, 0x4c, 0xc7, 0x88, 0x88, 0xd8, 0x23, 0x42, 0xc6, 0x42, 0x7a, 0x73, 0x9a,
-	0x4f, 0xcb, 0xb7, 0x32, 0x7b, 0xea, 0xfd, 0xb2, 0xfc, 0x0f, 0xc0, 0xbc, 0x7c, 0xe3, 0x8a, 0x29,
-	0xf4, 0xf3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x67, 0x80, 0x40, 0x32, 0x08, 0x00, 0x00,
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0x23, 0x45,
+	0x17, 0x4e, 0xc7, 0x1e, 0xff, 0x4e, 0x39, 0x99, 0x24, 0x95, 0xf9, 0xad, 0x26, 0x06, 0xc7, 0x32,
+	0x42, 0xb2, 0xb8, 0x74, 0x8f, 0x83, 0x18, 0x21, 0x21, 0x10, 0xd8, 0x41, 0xc4, 0x28, 0x88, 0xa8,
+	0x9d, 0x31, 0xd2, 0x6c, 0x5a, 0xe5, 0xea, 0x9a, 0x76, 0x69, 0xda, 0x55, 0x56, 0x57, 0xb9, 0x43,
+	0x84, 0xd8, 0xb0, 0x65, 0x33, 0x8f, 0xc0, 0x9b, 0xb0, 0x9d, 0xe5, 0x2c, 0x59, 0x01, 0x4a, 0x5e,
+	0x04, 0xd5, 0xa5, 0x7d, 0x99, 0x38, 0xc1, 0xec, 0x5c, 0x3e, 0xe7, 0xfb, 0xbe, 0x73, 0xab, 0x53,
+	0x0d, 0xda, 0x94, 0x49, 0x92, 0xe2, 0x11, 0xa2, 0x2c, 0x14, 0x04, 0x4f, 0x53, 0x2a, 0xaf, 0x7c,
+	0x8c, 0x33, 0x1f, 0x73, 0x26, 0xa6, 0x63, 0x92, 0xfa, 0x59, 0xdb, 0x8f, 0x09, 0x23, 0x82, 0x0a,
+	0x6f, 0x92, 0x72, 0xc9, 0xe1, 0xbb, 0x2b, 0x20, 0x1e, 0xc6, 0x99, 0x97, 0x43, 0xbc, 0xac, 0x7d,
+	0xf8, 0xf8, 0x2e, 0xde, 0xac, 0xed, 0x8b, 0x11, 0x4a, 0x49, 0x14, 0xce, 0xdc, 0x35, 0xed, 0xe1,
+	0xf1, 0x3a, 0x91, 0xbc, 0x81, 0xf1, 0xe9, 0x10, 0xfb, 0x09, 0x8d, 0x47, 0x12, 0x27, 0x94, 0x30,
+	0x29, 0x7c, 0x49, 0x58, 0x44, 0xd2, 0x31, 0x65, 0x52, 0xb9, 0xcf, 0x4f, 0x16, 0xf0, 0x28, 0xe6,
+	0x31, 0xd7, 0x3f, 0x7d, 0xf5, 0xcb, 0xfe, 0xfb, 0xde, 0x3d, 0xc1, 0x5e, 0xd2, 0x94, 0x58, 0xb7,
+	0xa3, 0x98, 0xf3, 0x38, 0x21, 0xbe, 0x3e, 0x0d, 0xa7, 0xcf, 0x7d, 0x49, 0xc7, 0x44, 0x48, 0x34,
+	0x9e, 0x58, 0x87, 0xda, 0x82, 0x3a, 0x1a, 0x62, 0xea, 0xcb, 0xab, 0x09, 0xb1, 0x65, 0x6b, 0xfe,
+	0x56, 0x01, 0xdb, 0xdf, 0x98, 0x42, 0xf6, 0x25, 0x92, 0x04, 0x9e, 0x82, 0xd2, 0x04, 0xa5, 0x68,
+	0x2c, 0x5c, 0xa7, 0xe1, 0xb4, 0x2a, 0xc7, 0xef, 0x7b, 0x77, 0x15, 0x36, 0x6b, 0x7b, 0x5d, 0x9b,
+	0xf8, 0xb9, 0x46, 0x74, 0x8a, 0xaf, 0xfe, 0x3c, 0xda, 0x08, 0x2c, 0x1e, 0x7e, 0x08, 0xe0, 0x24,
+	0xe5, 0x19, 0x8d, 0x48, 0x1a, 0x9a, 0x42, 0x84, 0x34, 0x72, 0x37, 0x1b, 0x4e, 0x6b, 0x2b, 0xd8,
+	0xcb, 0x2d, 0x5d, 0x6d, 0xe8, 0x45, 0xd0, 0x03, 0x07, 0x73, 0xef, 0x11, 0x62, 0x8c, 0x24, 0xca,
+	0xbd, 0xa0, 0xdd, 0xf7, 0x67, 0xee, 0xc6, 0xd2, 0x8b, 0x60, 0x0d, 0x6c, 0x31, 0x72, 0x19, 0xea,
+	0xb8, 0xdc, 0x62, 0xc3, 0x69, 0x95, 0x83, 0x32, 0x23, 0x97, 0x5d, 0x75, 0x86, 0x18, 0xfc, 0xff,
+	0x4d, 0x69, 0xa1, 0xb2, 0x73, 0x1f, 0xe8, 0x9c, 0x3e, 0xf0, 0xe8, 0x10, 0x7b, 0x8b, 0x1d, 0xf2,
+	0x16, 0x7a, 0xa2, 0xf2, 0xd2, 0xff, 0xea, 0x82, 0x74, 0x36, 0x5d, 0x27, 0x38, 0x58, 0x0e, 0xd7,
+	0x54, 0x2a, 0x01, 0xee, 0x5c, 0x84, 0x33, 0x41, 0x98, 0x98, 0x0a, 0xab, 0x53, 0xd2, 0x3a, 0xde,
+	0xbf, 0xea, 0xe4, 0xb0, 0xb9, 0x54, 0x75, 0x26, 0xb5, 0x64, 0x83, 0x31, 0xd8, 0x1b, 0x23, 0x39,
+	0x4d, 0x29, 0x8b, 0xc3, 0x09, 0xc2, 0x2f, 0x88, 0x14, 0xee, 0xff, 0x1a, 0x85, 0x56, 0xe5, 0xf8,
+	0x89, 0xb7, 0xc6, 0xe8, 0x7b, 0xdf, 0x59, 0xf0, 0xa0, 0xdf, 0x3d, 0xd7, 0x70, 0xdb, 0xad, 0xdd,
+	0x9c, 0xd5, 0xfc, 0x2b, 0xe0, 0x39, 0xd8, 0xa5, 0x8c, 0x4a, 0x8a, 0x92, 0x30, 0x43, 0x49, 0x28,
+	0x88, 0x74, 0xcb, 0x5a, 0xa7, 0xb1, 0x18, 0xbc, 0x1a, 0x24, 0x6f, 0x80, 0x12, 0x1a, 0x21, 0xc9,
+	0xd3, 0xa7, 0x93, 0x48, 0xc5, 0x5f, 0x52, 0x8c, 0xae, 0x13, 0xec, 0x58, 0x82, 0x01, 0x4a, 0xfa,
+	0x44, 0xc2, 0x9f, 0xc1, 0xe1, 0x88, 0xa8, 0x22, 0x84, 0x92, 0x2b, 0x4e, 0x41, 0x64, 0x38, 0xd5,
+	0x08, 0xd5, 0xe1, 0x2d, 0x4d, 0xfe, 0xd9, 0x5a, 0x49, 0x9c, 0x6a, 0x9a, 0x0b, 0x3e, 0xd0, 0x24,
+	0x46, 0xb5, 0x77, 0x62, 0x33, 0xa9, 0x8e, 0x56, 0x59, 0x23, 0xf8, 0x8b, 0x03, 0xde, 0xe1, 0x53,
+	0x29, 0x24, 0x62, 0x91, 0xaa, 0x5e, 0xc4, 0x2f, 0x99, 0xba, 0x23, 0xa1, 0x48, 0x90, 0x18, 0x51,
+	0x16, 0xbb, 0x40, 0x87, 0xf0, 0xe9, 0x5a, 0x21, 0x7c, 0x3f, 0x67, 0x3a, 0xb1, 0x44, 0x56, 0xbf,
+	0xc6, 0x6f, 0x9b, 0xfa, 0x56, 0x02, 0xfe, 0x04, 0xdc, 0x09, 0x31, 0xfa, 0x39, 0xdb, 0xac, 0x8d,
+	0x15, 0x3d, 0x2c, 0xeb, 0x55, 0x60, 0x7e, 0xe3, 0x14, 0xf6, 0x04, 0x49, 0x74, 0x46, 0x45, 0xde,
+	0xcb, 0xaa, 0x95, 0x58, 0x76, 0x12, 0xf0, 0x57, 0x07, 0xd4, 0x13, 0x24, 0x64, 0x28, 0x53, 0xc4,
+	0xc4, 0x98, 0x0a, 0x41, 0x39, 0x0b, 0x87, 0x09, 0xc7, 0x2f, 0x42, 0x53, 0x34, 0x77, 0x5b, 0xc7,
+	0xf0, 0xe5, 0x5a, 0x31, 0x9c, 0x21, 0x21, 0x2f, 0x16, 0x98, 0x3a, 0x8a, 0xc8, 0xb4, 0x26, 0x2f,
+	0x45, 0x72, 0xb7, 0x0b, 0xac, 0x82, 0xd2, 0x24, 0x25, 0xdd, 0xee, 0xc0, 0xdd, 0xd1, 0xd7, 0xd6,
+	0x9e, 0xe0, 0xb7, 0xa0, 0x9c, 0xcf, 0xbe, 0xfb, 0x50, 0x87, 0xd3, 0xba, 0x6f, 0xf7, 0x9c, 0x5b,
+	0xdf, 0x1e, 0x7b, 0xce, 0xad, 0xec, 0x0c, 0x0f, 0xfb, 0x60, 0x5b, 0x77, 0x37, 0x4c, 0x09, 0xe6,
+	0x69, 0xe4, 0xee, 0x6a, 0xbe, 0xc7, 0x6b, 0xa5, 0xa7, 0x7b, 0x16, 0x68, 0x5c, 0x50, 0x11, 0xf3,
+	0x03, 0xfc, 0x1a, 0x1c, 0xdd, 0xd1, 0xc3, 0x90, 0xb2, 0x88, 0x62, 0x22, 0xdc, 0xbd, 0x46, 0xa1,
+	0x55, 0x0c, 0xde, 0x5e, 0xd9, 0x87, 0x9e, 0xf1, 0x51, 0x9b, 0x0e, 0xe3, 0x2c, 0xb4, 0xcf, 0x57,
+	0x98, 0x91, 0x54, 0x55, 0xc8, 0xdd, 0x37, 0x9b, 0x0e, 0xe3, 0xcc, 0xee, 0xe3, 0x81, 0x31, 0x34,
+	0x9f, 0x81, 0xea, 0xea, 0xb9, 0x57, 0x95, 0xb4, 0xed, 0x53, 0xbb, 0xba, 0x18, 0xd8, 0x13, 0x6c,
+	0x81, 0xbd, 0x5b, 0xd7, 0x6c, 0x53, 0x7b, 0x3c, 0xcc, 0x96, 0xee, 0x46, 0xf3, 0x29, 0x38, 0x58,
+	0x31, 0xd0, 0xf0, 0x0b, 0x50, 0xcb, 0xf2, 0xbb, 0xbd, 0xb0, 0xdb, 0x50, 0x14, 0xa5, 0x44, 0x98,
+	0x97, 0x61, 0x2b, 0x78, 0x6b, 0xe6, 0x32, 0x5b, 0x55, 0x5f, 0x19, 0x87, 0xe6, 0x27, 0xa0, 0x76,
+	0x76, 0xff, 0x04, 0x2c, 0xc4, 0x5d, 0xc8, 0xe3, 0x6e, 0xfe, 0xee, 0x80, 0xfd, 0x5b, 0x7b, 0x0a,
+	0x3e, 0x02, 0x0f, 0x32, 0x81, 0x7b, 0x91, 0x4d, 0xd2, 0x1c, 0x60, 0x0f, 0xec, 0x98, 0xcd, 0x25,
+	0xaf, 0x42, 0x15, 0xb3, 0x4e, 0xb0, 0x72, 0x7c, 0xe8, 0x99, 0xe7, 0xd0, 0xcb, 0x9f, 0x43, 0xef,
+	0x22, 0x7f, 0x0e, 0x3b, 0x65, 0x35, 0x24, 0x2f, 0xff, 0x3a, 0x72, 0x82, 0xed, 0x1c, 0xaa, 0x8c,
+	0x8a, 0x2a, 0x25, 0x98, 0xd0, 0x8c, 0x44, 0x86, 0xaa, 0xf0, 0x5f, 0xa8, 0x72, 0xa8, 0x32, 0x36,
+	0x87, 0xa0, 0xba, 0xfa, 0x86, 0xc2, 0x53, 0x50, 0x4c, 0xa8, 0x50, 0x19, 0x17, 0xcc, 0xcb, 0xb0,
+	0xce, 0xab, 0x9a, 0x33, 0xd8, 0xf9, 0xd6, 0x0c, 0x9d, 0x1f, 0x5e, 0x5d, 0xd7, 0x9d, 0xd7, 0xd7,
+	0x75, 0xe7, 0xef, 0xeb, 0xba, 0xf3, 0xf2, 0xa6, 0xbe, 0xf1, 0xfa, 0xa6, 0xbe, 0xf1, 0xc7, 0x4d,
+	0x7d, 0xe3, 0xd9, 0xe7, 0x31, 0x95, 0xa3, 0xe9, 0xd0, 0xc3, 0x7c, 0xec, 0x63, 0x2e, 0xc6, 0x5c,
+	0xf8, 0x73, 0x99, 0x8f, 0x66, 0xdf, 0x10, 0xd9, 0x13, 0xff, 0xc7, 0xe5, 0x6f, 0x18, 0xfd, 0x45,
+	0x30, 0x2c, 0xe9, 0x44, 0x3f, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x80, 0xe3, 0x06, 0xdf, 0x7e,
+	0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -543,6 +592,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CcvGenesisVersion) > 0 {
+		i -= len(m.CcvGenesisVersion)
+		copy(dAtA[i:], m.CcvGenesisVersion)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CcvGenesisVersion)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PendingConsumerPacketIndices) > 0 {
+		dAtA2 := make([]byte, len(m.PendingConsumerPacketIndices)*10)
+		var j1 int
+		for _, num := range m.PendingConsumerPacketIndices {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	{
 		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -811,12 +901,20 @@ func (m *MaturingVSCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MaturityTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime):])
-	if err7 != nil {
-		return 0, err7
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n7
-	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i -= n10
+	i = encodeVarintGenesis(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MaturityTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGenesis(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.VscId != 0 {
@@ -935,6 +1033,21 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Provider.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingConsumerPacketIndices) > 0 {
+		l = 0
+		for _, e := range m.PendingConsumerPacketIndices {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
+	l = len(m.CcvGenesisVersion)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -989,6 +1102,8 @@ func (m *MaturingVSCPacket) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MaturityTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1486,6 +1601,150 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingConsumerPacketIndices = append(m.PendingConsumerPacketIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingConsumerPacketIndices) == 0 {
+					m.PendingConsumerPacketIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingConsumerPacketIndices = append(m.PendingConsumerPacketIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingConsumerPacketIndices", wireType)
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvGenesisVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CcvGenesisVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1827,6 +2086,39 @@ func (m *MaturingVSCPacket) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
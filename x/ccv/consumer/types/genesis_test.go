@@ -188,6 +188,20 @@ func TestValidateInitialGenesisState(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid new consumer genesis state: non-empty slash record",
+			&types.GenesisState{
+				Params:   params,
+				NewChain: true,
+				Provider: ccv.ProviderInfo{
+					ClientState:    cs,
+					ConsensusState: consensusState,
+					InitialValSet:  valUpdates,
+				},
+				SlashRecord: &types.SlashRecord{WaitingOnReply: true, SendTime: time.Now().UTC(), SendAttempts: 1},
+			},
+			true,
+		},
 		{
 			"invalid new consumer genesis state: nil initial validator set",
 			types.NewInitialGenesisState(cs, consensusState, nil, params),
@@ -234,6 +248,16 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					"",
+					nil,
+					ccv.DefaultProviderClientExpiryWarningFraction,
+					ccv.DefaultHaltOnProviderClientExpiry,
+					ccv.DefaultProviderClientExpiryHaltDelay,
+					0,
+					"",
+					nil,
+					ccv.DefaultExpectedProviderEpochDuration,
+					ccv.DefaultValsetStalenessWarningEpochs,
 				)),
 			true,
 		},
@@ -254,6 +278,46 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					"",
+					nil,
+					ccv.DefaultProviderClientExpiryWarningFraction,
+					ccv.DefaultHaltOnProviderClientExpiry,
+					ccv.DefaultProviderClientExpiryHaltDelay,
+					0,
+					"",
+					nil,
+					ccv.DefaultExpectedProviderEpochDuration,
+					ccv.DefaultValsetStalenessWarningEpochs,
+				)),
+			true,
+		},
+		{
+			"invalid new consumer genesis state: invalid params - denomRedistributionFractions",
+			types.NewInitialGenesisState(cs, consensusState, valUpdates,
+				ccv.NewParams(
+					true,
+					ccv.DefaultBlocksPerDistributionTransmission,
+					"",
+					"",
+					ccv.DefaultCCVTimeoutPeriod,
+					ccv.DefaultTransferTimeoutPeriod,
+					ccv.DefaultConsumerRedistributeFrac,
+					ccv.DefaultHistoricalEntries,
+					ccv.DefaultConsumerUnbondingPeriod,
+					[]string{},
+					[]string{},
+					ccv.DefaultRetryDelayPeriod,
+					"1",
+					"",
+					nil,
+					ccv.DefaultProviderClientExpiryWarningFraction,
+					ccv.DefaultHaltOnProviderClientExpiry,
+					ccv.DefaultProviderClientExpiryHaltDelay,
+					0,
+					"",
+					[]ccv.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1.1"}},
+					ccv.DefaultExpectedProviderEpochDuration,
+					ccv.DefaultValsetStalenessWarningEpochs,
 				)),
 			true,
 		},
@@ -443,6 +507,78 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 				}, nil, types.LastTransmissionBlockHeight{Height: int64(1)}, params),
 			true,
 		},
+		{
+			"valid restart consumer genesis state: slash record of a pending slash packet",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{slashConsumerPacket}},
+					nil, types.LastTransmissionBlockHeight{Height: 100}, params)
+				gs.SlashRecord = &types.SlashRecord{WaitingOnReply: true, SendTime: time.Now().UTC(), SendAttempts: 2}
+				return gs
+			}(),
+			false,
+		},
+		{
+			"invalid restart consumer genesis state: slash record without pending slash packets",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{matConsumerPacket}},
+					nil, types.LastTransmissionBlockHeight{Height: 100}, params)
+				gs.SlashRecord = &types.SlashRecord{WaitingOnReply: true, SendTime: time.Now().UTC(), SendAttempts: 2}
+				return gs
+			}(),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: invalid outstanding downtime address",
+			types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{},
+				[]types.OutstandingDowntime{{ValidatorConsensusAddress: "cosmosvalconsxxx"}},
+				types.LastTransmissionBlockHeight{}, params),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: duplicate outstanding downtime",
+			types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{},
+				[]types.OutstandingDowntime{
+					{ValidatorConsensusAddress: sdk.ConsAddress(validator.Address.Bytes()).String()},
+					{ValidatorConsensusAddress: sdk.ConsAddress(validator.Address.Bytes()).String()},
+				},
+				types.LastTransmissionBlockHeight{}, params),
+			true,
+		},
+		{
+			"valid restart consumer genesis state: strictly increasing pending consumer packet indices",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{matConsumerPacket, slashConsumerPacket}},
+					nil, types.LastTransmissionBlockHeight{Height: 100}, params)
+				gs.PendingConsumerPacketIndices = []uint64{3, 7}
+				return gs
+			}(),
+			false,
+		},
+		{
+			"invalid restart consumer genesis state: pending consumer packet indices not strictly increasing",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{matConsumerPacket, slashConsumerPacket}},
+					nil, types.LastTransmissionBlockHeight{Height: 100}, params)
+				gs.PendingConsumerPacketIndices = []uint64{3, 3}
+				return gs
+			}(),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: pending consumer packet indices do not match pending consumer packets",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, heightToValsetUpdateID,
+					types.ConsumerPacketDataList{List: []ccv.ConsumerPacketData{matConsumerPacket, slashConsumerPacket}},
+					nil, types.LastTransmissionBlockHeight{Height: 100}, params)
+				gs.PendingConsumerPacketIndices = []uint64{3}
+				return gs
+			}(),
+			true,
+		},
 		{
 			"invalid restart consumer genesis state: invalid params",
 			types.NewRestartGenesisState("ccvclient", "ccvchannel", nil, valUpdates, nil, types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{},
@@ -460,6 +596,16 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					"",
+					nil,
+					ccv.DefaultProviderClientExpiryWarningFraction,
+					ccv.DefaultHaltOnProviderClientExpiry,
+					ccv.DefaultProviderClientExpiryHaltDelay,
+					0,
+					"",
+					nil,
+					ccv.DefaultExpectedProviderEpochDuration,
+					ccv.DefaultValsetStalenessWarningEpochs,
 				)),
 			true,
 		},
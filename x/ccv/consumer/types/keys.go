@@ -80,6 +80,16 @@ const (
 	SlashRecordKeyName = "SlashRecordKey"
 
 	ParametersKeyName = "ParametersKey"
+
+	ApprovedProviderClientSubstituteKeyName = "ApprovedProviderClientSubstituteKey"
+
+	ProviderClientExpiredKeyName = "ProviderClientExpiredKey"
+
+	VSCMaturityCountersKeyName = "VSCMaturityCountersKey"
+
+	ProviderUpgradeNoticeKeyName = "ProviderUpgradeNoticeKey"
+
+	LastAppliedVSCKeyName = "LastAppliedVSCKey"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -162,6 +172,24 @@ func getKeyPrefixes() map[string]byte {
 		// ParametersKey is the key for storing the consumer's parameters.
 		ParametersKeyName: 22,
 
+		// ApprovedProviderClientSubstituteKey is the key for storing the client id of the client
+		// approved by governance as substitute of the provider client
+		ApprovedProviderClientSubstituteKeyName: 23,
+
+		// ProviderClientExpiredKey is the key for storing whether the expiry of the provider client
+		// was detected and the height at which the consumer chain halts as a result
+		ProviderClientExpiredKeyName: 24,
+
+		// VSCMaturityCountersKey is the key for storing the counters of the VSC maturities
+		VSCMaturityCountersKeyName: 25,
+
+		// ProviderUpgradeNoticeKey is the key for storing the latest notice
+		// about an upgrade of the provider chain
+		ProviderUpgradeNoticeKeyName: 26,
+
+		// LastAppliedVSCKey is the key for storing the last VSC packet applied by the consumer chain
+		LastAppliedVSCKeyName: 27,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -263,6 +291,11 @@ func HistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKeyPrefix(), hBytes...)
 }
 
+// HeightFromHistoricalInfoKey returns the height of a HistoricalInfoKey
+func HeightFromHistoricalInfoKey(key []byte) int64 {
+	return int64(binary.BigEndian.Uint64(key[1:]))
+}
+
 // PacketMaturityTimeKeyPrefix returns the key prefix for storing maturity time for each received VSC packet
 func PacketMaturityTimeKeyPrefix() []byte {
 	return []byte{mustGetKeyPrefix(PacketMaturityTimeKeyName)}
@@ -359,6 +392,33 @@ func ParametersKey() []byte {
 	return []byte{mustGetKeyPrefix(ParametersKeyName)}
 }
 
+// ApprovedProviderClientSubstituteKey returns the key for storing the client id of the client
+// approved by governance as substitute of the provider client
+func ApprovedProviderClientSubstituteKey() []byte {
+	return []byte{mustGetKeyPrefix(ApprovedProviderClientSubstituteKeyName)}
+}
+
+// ProviderClientExpiredKey returns the key for storing whether the expiry of the provider client
+// was detected and the height at which the consumer chain halts as a result
+func ProviderClientExpiredKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderClientExpiredKeyName)}
+}
+
+// VSCMaturityCountersKey returns the key for storing the counters of the VSC maturities
+func VSCMaturityCountersKey() []byte {
+	return []byte{mustGetKeyPrefix(VSCMaturityCountersKeyName)}
+}
+
+// ProviderUpgradeNoticeKey returns the key for storing the latest notice about an upgrade of the provider chain
+func ProviderUpgradeNoticeKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderUpgradeNoticeKeyName)}
+}
+
+// LastAppliedVSCKey returns the key for storing the last VSC packet applied by the consumer chain
+func LastAppliedVSCKey() []byte {
+	return []byte{mustGetKeyPrefix(LastAppliedVSCKeyName)}
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
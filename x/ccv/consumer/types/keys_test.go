@@ -71,6 +71,16 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(22), consumertypes.ParametersKey()[0])
 	i++
+	require.Equal(t, byte(23), consumertypes.ApprovedProviderClientSubstituteKey()[0])
+	i++
+	require.Equal(t, byte(24), consumertypes.ProviderClientExpiredKey()[0])
+	i++
+	require.Equal(t, byte(25), consumertypes.VSCMaturityCountersKey()[0])
+	i++
+	require.Equal(t, byte(26), consumertypes.ProviderUpgradeNoticeKey()[0])
+	i++
+	require.Equal(t, byte(27), consumertypes.LastAppliedVSCKey()[0])
+	i++
 
 	prefixes := consumertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -121,5 +131,10 @@ func getAllFullyDefinedKeys() [][]byte {
 		consumertypes.PendingPacketsIndexKey(),
 		consumertypes.SlashRecordKey(),
 		consumertypes.ParametersKey(),
+		consumertypes.ApprovedProviderClientSubstituteKey(),
+		consumertypes.ProviderClientExpiredKey(),
+		consumertypes.VSCMaturityCountersKey(),
+		consumertypes.ProviderUpgradeNoticeKey(),
+		consumertypes.LastAppliedVSCKey(),
 	}
 }
@@ -6,12 +6,24 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 // Tests the validation of consumer params that happens at genesis
 func TestValidateParams(t *testing.T) {
 	consumerId := "13"
+	consumerDenomMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ucons", Exponent: 0},
+			{Denom: "cons", Exponent: 6},
+		},
+		Base:    "ucons",
+		Display: "cons",
+		Name:    "Consumer",
+		Symbol:  "CONS",
+	}
 
 	testCases := []struct {
 		name    string
@@ -21,67 +33,132 @@ func TestValidateParams(t *testing.T) {
 		{"default params", ccvtypes.DefaultParams(), true},
 		{
 			"custom valid params",
-			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), true,
+			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), true,
 		},
 		{
 			"custom invalid params, block per dist transmission",
-			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, dist transmission channel",
-			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, ccv timeout",
-			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, transfer timeout",
-			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is over 1",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, bad consumer redist fraction ",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, negative num historical entries",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, negative unbonding period",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, invalid reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, invalid provider reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, retry delay period is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, retry delay period is zero",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId, "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, consumer ID is blank",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, ""), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "", "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
 		},
 		{
 			"custom invalid params, consumer ID is not a uint64",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId"), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId", "", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom valid params, consumer denom with metadata",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "ucons", &consumerDenomMetadata, "0.33", false, 1000, 0, "", nil, time.Hour, 4), true,
+		},
+		{
+			"custom invalid params, invalid consumer denom",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "u", nil, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, consumer denom metadata base does not match consumer denom",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "uother", &consumerDenomMetadata, "0.33", false, 1000, 0, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom valid params, provider client expiry warnings disabled",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "", true, 0, 0, "", nil, time.Hour, 4), true,
+		},
+		{
+			"custom invalid params, provider client expiry warning fraction",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "1.5", false, 1000, 0, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, provider client expiry halt delay",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", true, -1, 0, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom valid params, downtime window",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 10000, "0.05", nil, time.Hour, 4), true,
+		},
+		{
+			"custom invalid params, negative signed blocks window",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, -1, "", nil, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, min signed per window over 1",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "1.1", nil, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, bad min signed per window",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 10000, "notFrac", nil, time.Hour, 4), false,
+		},
+		{
+			"custom valid params, denom redistribution fractions",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "",
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}, {Denom: "ustake", Fraction: "0"}}, time.Hour, 4), true,
+		},
+		{
+			"custom invalid params, invalid denom of denom redistribution fraction",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "",
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "u", Fraction: "1"}}, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, duplicate denom redistribution fraction",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "",
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}, {Denom: "untrn", Fraction: "0.5"}}, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, denom redistribution fraction over 1",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "",
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1.5"}}, time.Hour, 4), false,
+		},
+		{
+			"custom invalid params, bad denom redistribution fraction",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, "", nil, "0.33", false, 1000, 0, "",
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: ""}}, time.Hour, 4), false,
 		},
 	}
 
@@ -6,23 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/interchain-security/v6/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -46,6 +52,9 @@ type NextFeeDistributionEstimate struct {
 	ToProvider string `protobuf:"bytes,6,opt,name=toProvider,proto3" json:"toProvider,omitempty"`
 	// amount distributed (kept) by consumer chain
 	ToConsumer string `protobuf:"bytes,7,opt,name=toConsumer,proto3" json:"toConsumer,omitempty"`
+	// denom-specific ratios between consumer and provider fee distribution,
+	// overriding distribution_fraction for the listed denoms
+	DenomDistributionFractions []types.DenomRedistributionFraction `protobuf:"bytes,8,rep,name=denom_distribution_fractions,json=denomDistributionFractions,proto3" json:"denom_distribution_fractions"`
 }
 
 func (m *NextFeeDistributionEstimate) Reset()         { *m = NextFeeDistributionEstimate{} }
@@ -130,6 +139,13 @@ func (m *NextFeeDistributionEstimate) GetToConsumer() string {
 	return ""
 }
 
+func (m *NextFeeDistributionEstimate) GetDenomDistributionFractions() []types.DenomRedistributionFraction {
+	if m != nil {
+		return m.DenomDistributionFractions
+	}
+	return nil
+}
+
 type QueryNextFeeDistributionEstimateRequest struct {
 }
 
@@ -335,6 +351,8 @@ var xxx_messageInfo_QueryProviderInfoRequest proto.InternalMessageInfo
 type QueryProviderInfoResponse struct {
 	Consumer ChainInfo `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer"`
 	Provider ChainInfo `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	// the address of the provider fee pool to which the consumer rewards are sent
+	ProviderFeePoolAddr string `protobuf:"bytes,3,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 }
 
 func (m *QueryProviderInfoResponse) Reset()         { *m = QueryProviderInfoResponse{} }
@@ -384,6 +402,13 @@ func (m *QueryProviderInfoResponse) GetProvider() ChainInfo {
 	return ChainInfo{}
 }
 
+func (m *QueryProviderInfoResponse) GetProviderFeePoolAddr() string {
+	if m != nil {
+		return m.ProviderFeePoolAddr
+	}
+	return ""
+}
+
 type QueryThrottleStateRequest struct {
 }
 
@@ -472,25 +497,21 @@ func (m *QueryThrottleStateResponse) GetPacketDataQueue() []types.ConsumerPacket
 	return nil
 }
 
-type ChainInfo struct {
-	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	ConnectionID string `protobuf:"bytes,3,opt,name=connectionID,proto3" json:"connectionID,omitempty"`
-	ChannelID    string `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+type QuerySlashPacketRetryStateRequest struct {
 }
 
-func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
-func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
-func (*ChainInfo) ProtoMessage()    {}
-func (*ChainInfo) Descriptor() ([]byte, []int) {
+func (m *QuerySlashPacketRetryStateRequest) Reset()         { *m = QuerySlashPacketRetryStateRequest{} }
+func (m *QuerySlashPacketRetryStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashPacketRetryStateRequest) ProtoMessage()    {}
+func (*QuerySlashPacketRetryStateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f627751d3cc10225, []int{9}
 }
-func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+func (m *QuerySlashPacketRetryStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySlashPacketRetryStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySlashPacketRetryStateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -500,853 +521,3700 @@ func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChainInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainInfo.Merge(m, src)
+func (m *QuerySlashPacketRetryStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashPacketRetryStateRequest.Merge(m, src)
 }
-func (m *ChainInfo) XXX_Size() int {
+func (m *QuerySlashPacketRetryStateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChainInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
+func (m *QuerySlashPacketRetryStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashPacketRetryStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+var xxx_messageInfo_QuerySlashPacketRetryStateRequest proto.InternalMessageInfo
 
-func (m *ChainInfo) GetChainID() string {
-	if m != nil {
-		return m.ChainID
-	}
-	return ""
+type QuerySlashPacketRetryStateResponse struct {
+	SlashPackets []SlashPacketRetryState `protobuf:"bytes,1,rep,name=slash_packets,json=slashPackets,proto3" json:"slash_packets"`
 }
 
-func (m *ChainInfo) GetClientID() string {
-	if m != nil {
-		return m.ClientID
-	}
-	return ""
+func (m *QuerySlashPacketRetryStateResponse) Reset()         { *m = QuerySlashPacketRetryStateResponse{} }
+func (m *QuerySlashPacketRetryStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashPacketRetryStateResponse) ProtoMessage()    {}
+func (*QuerySlashPacketRetryStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{10}
 }
-
-func (m *ChainInfo) GetConnectionID() string {
-	if m != nil {
-		return m.ConnectionID
+func (m *QuerySlashPacketRetryStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashPacketRetryStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashPacketRetryStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QuerySlashPacketRetryStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashPacketRetryStateResponse.Merge(m, src)
+}
+func (m *QuerySlashPacketRetryStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashPacketRetryStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashPacketRetryStateResponse.DiscardUnknown(m)
 }
 
-func (m *ChainInfo) GetChannelID() string {
+var xxx_messageInfo_QuerySlashPacketRetryStateResponse proto.InternalMessageInfo
+
+func (m *QuerySlashPacketRetryStateResponse) GetSlashPackets() []SlashPacketRetryState {
 	if m != nil {
-		return m.ChannelID
+		return m.SlashPackets
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
-	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+type QueryHistoricalEntriesRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
+func (m *QueryHistoricalEntriesRequest) Reset()         { *m = QueryHistoricalEntriesRequest{} }
+func (m *QueryHistoricalEntriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalEntriesRequest) ProtoMessage()    {}
+func (*QueryHistoricalEntriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{11}
 }
-
-var fileDescriptor_f627751d3cc10225 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xd3, 0x36, 0xbb, 0x99, 0x2e, 0x42, 0x3b, 0x04, 0xc9, 0x78, 0x57, 0xa1, 0x32, 0x20,
-	0xc2, 0x4a, 0xb1, 0x93, 0xac, 0x44, 0x97, 0xc3, 0xb2, 0xa8, 0x0d, 0x55, 0x23, 0x01, 0x6a, 0xdd,
-	0x4a, 0x08, 0x2e, 0x66, 0x3a, 0x99, 0x26, 0x16, 0x89, 0xc7, 0x9d, 0x19, 0x9b, 0xf6, 0x86, 0xe0,
-	0x8e, 0x90, 0xf8, 0x26, 0x7c, 0x01, 0xae, 0x95, 0x38, 0x50, 0x89, 0x03, 0x70, 0x41, 0xa8, 0xe5,
-	0x43, 0x70, 0x44, 0x33, 0x1e, 0xa7, 0x4e, 0x9b, 0x26, 0x6e, 0xe1, 0xe6, 0x79, 0x7f, 0x7e, 0xef,
-	0xf7, 0x7b, 0x33, 0xef, 0x25, 0xc0, 0x0d, 0x42, 0x41, 0x18, 0x1e, 0xa2, 0x20, 0xf4, 0x39, 0xc1,
-	0x31, 0x0b, 0xc4, 0x89, 0x8b, 0x71, 0xe2, 0x62, 0x1a, 0xf2, 0x78, 0x4c, 0x98, 0x9b, 0xb4, 0xdd,
-	0xa3, 0x98, 0xb0, 0x13, 0x27, 0x62, 0x54, 0x50, 0xf8, 0xc6, 0x8c, 0x04, 0x07, 0xe3, 0xc4, 0xc9,
-	0x12, 0x9c, 0xa4, 0x6d, 0xb5, 0x6e, 0x42, 0x4d, 0xda, 0x2e, 0x1f, 0x22, 0x46, 0xfa, 0xfe, 0x24,
-	0x5c, 0xc1, 0x5a, 0xb5, 0x01, 0x1d, 0x50, 0xf5, 0xe9, 0xca, 0x2f, 0x6d, 0x7d, 0x3c, 0xa0, 0x74,
-	0x30, 0x22, 0x2e, 0x8a, 0x02, 0x17, 0x85, 0x21, 0x15, 0x48, 0x04, 0x34, 0xe4, 0xda, 0xdb, 0x29,
-	0xc2, 0xfd, 0x4a, 0x9d, 0xb7, 0xe6, 0x30, 0xfb, 0x2a, 0x60, 0x24, 0x0d, 0xb3, 0xbf, 0x2b, 0x83,
-	0x47, 0x9f, 0x90, 0x63, 0xb1, 0x45, 0x48, 0x37, 0xe0, 0x82, 0x05, 0x07, 0xb1, 0xac, 0xfc, 0x21,
-	0x17, 0xc1, 0x18, 0x09, 0x02, 0xdf, 0x04, 0x2f, 0xe1, 0x98, 0x31, 0x12, 0x8a, 0x6d, 0x12, 0x0c,
-	0x86, 0xc2, 0x34, 0xd6, 0x8c, 0xc6, 0x92, 0x37, 0x6d, 0x84, 0x75, 0x00, 0x46, 0x88, 0x67, 0x21,
-	0x65, 0x15, 0x92, 0xb3, 0x48, 0x7f, 0x48, 0x8e, 0x33, 0xff, 0x52, 0xea, 0xbf, 0xb4, 0xc0, 0xa7,
-	0xe0, 0xd5, 0x7e, 0xae, 0xba, 0x7f, 0xc8, 0x10, 0x96, 0x1f, 0xe6, 0xf2, 0x9a, 0xd1, 0xa8, 0x7a,
-	0xb5, 0xbc, 0x73, 0x4b, 0xfb, 0x60, 0x0d, 0xac, 0x08, 0x2a, 0xd0, 0xc8, 0x5c, 0x51, 0x41, 0xe9,
-	0x41, 0x96, 0x12, 0x74, 0x87, 0xd1, 0x24, 0xe8, 0x13, 0x66, 0x56, 0x94, 0x2b, 0x67, 0x49, 0xfd,
-	0x9b, 0xba, 0x57, 0xe6, 0xbd, 0xcc, 0x9f, 0x59, 0xec, 0x77, 0xc0, 0xdb, 0xbb, 0xf2, 0x15, 0xcc,
-	0x69, 0x8a, 0x47, 0x8e, 0x62, 0xc2, 0x85, 0xfd, 0xb5, 0x01, 0x1a, 0x8b, 0x63, 0x79, 0x44, 0x43,
-	0x4e, 0xe0, 0x3e, 0x58, 0xee, 0x23, 0x81, 0x54, 0xff, 0x56, 0x3b, 0x1f, 0x38, 0x05, 0x5e, 0x97,
-	0x33, 0x0f, 0x57, 0xa1, 0xd9, 0x35, 0x00, 0x15, 0x83, 0x1d, 0xc4, 0xd0, 0x98, 0x67, 0xc4, 0x7c,
-	0xf0, 0xca, 0x94, 0x55, 0x53, 0xd8, 0x06, 0x95, 0x48, 0x59, 0x34, 0x89, 0x27, 0x37, 0x92, 0x48,
-	0xda, 0x4e, 0xd6, 0x90, 0x14, 0x63, 0x63, 0xf9, 0xf4, 0xcf, 0xd7, 0x4b, 0x9e, 0xce, 0xb7, 0x2d,
-	0x60, 0xa6, 0x05, 0x74, 0x57, 0x7b, 0xe1, 0x21, 0xcd, 0x8a, 0xff, 0x64, 0x80, 0xd7, 0x66, 0x38,
-	0x35, 0x87, 0x1d, 0x70, 0x3f, 0x53, 0xa8, 0x59, 0x38, 0x85, 0x5a, 0xb1, 0x29, 0xdd, 0x12, 0x49,
-	0x33, 0x99, 0xa0, 0x48, 0xc4, 0x28, 0xbb, 0xee, 0xf2, 0x7f, 0x41, 0xcc, 0x50, 0xec, 0x47, 0x5a,
-	0xc0, 0xfe, 0x90, 0x51, 0x21, 0x46, 0x64, 0x4f, 0xe4, 0x2e, 0xfd, 0x0f, 0x03, 0x58, 0xb3, 0xbc,
-	0x5a, 0xdf, 0x67, 0xe0, 0x01, 0x1f, 0x21, 0x3e, 0xf4, 0x19, 0xc1, 0x94, 0xf5, 0xb5, 0xc6, 0x56,
-	0x21, 0x46, 0x7b, 0x32, 0xd1, 0x53, 0x79, 0x8a, 0x93, 0xe1, 0xad, 0xf2, 0x4b, 0x13, 0xfc, 0x02,
-	0x3c, 0x8c, 0x10, 0xfe, 0x92, 0x08, 0x5f, 0x5e, 0xbd, 0x7f, 0x14, 0x93, 0x98, 0x98, 0xe5, 0xb5,
-	0xa5, 0xb9, 0x8a, 0xa7, 0x6e, 0x52, 0x26, 0x77, 0x91, 0x40, 0x5a, 0xf1, 0xcb, 0xd1, 0xc4, 0xb2,
-	0x2b, 0xc1, 0xec, 0x6f, 0x0d, 0x50, 0x9d, 0xb4, 0x05, 0x9a, 0xe0, 0x9e, 0x02, 0xec, 0x75, 0x95,
-	0x8a, 0xaa, 0x97, 0x1d, 0xa1, 0x05, 0xee, 0xe3, 0x51, 0x40, 0x42, 0xd1, 0xeb, 0xaa, 0x96, 0x57,
-	0xbd, 0xc9, 0x19, 0xda, 0xe0, 0x01, 0xa6, 0x61, 0x48, 0xd4, 0x8c, 0xf6, 0xba, 0x6a, 0xd8, 0xab,
-	0xde, 0x94, 0x0d, 0x3e, 0x06, 0x55, 0x3c, 0x44, 0x61, 0x48, 0x46, 0xbd, 0xae, 0x1e, 0xf1, 0x4b,
-	0x43, 0xe7, 0xb7, 0x0a, 0x58, 0x51, 0x1d, 0x86, 0xff, 0x18, 0xfa, 0x9d, 0xcd, 0x18, 0x04, 0xf8,
-	0x51, 0xa1, 0x9e, 0x16, 0x9c, 0x65, 0xeb, 0xe3, 0xff, 0x09, 0x2d, 0x7d, 0x06, 0xf6, 0x8b, 0x6f,
-	0x7e, 0xfd, 0xfb, 0x87, 0xf2, 0x7b, 0x70, 0x7d, 0xf1, 0xcf, 0x8e, 0x5c, 0x83, 0xcd, 0x43, 0x42,
-	0x9a, 0xf9, 0x25, 0x07, 0x7f, 0x34, 0xc0, 0x6a, 0x6e, 0x86, 0xe1, 0x7a, 0x71, 0x7e, 0x53, 0xbb,
-	0xc0, 0x7a, 0x76, 0xfb, 0x44, 0xad, 0xa1, 0xa5, 0x34, 0x3c, 0x81, 0x8d, 0xc5, 0x1a, 0xd2, 0xb5,
-	0x00, 0x7f, 0x36, 0xc0, 0xc3, 0x6b, 0xa3, 0x0f, 0x9f, 0xdf, 0x82, 0xc1, 0xf5, 0x7d, 0x62, 0xbd,
-	0x7f, 0xd7, 0x74, 0x2d, 0x63, 0x5d, 0xc9, 0x68, 0x43, 0xb7, 0x80, 0x0c, 0x9d, 0xdf, 0x0c, 0x24,
-	0xef, 0x5f, 0x0c, 0xbd, 0x5c, 0xa7, 0x26, 0x1d, 0xde, 0x82, 0xcf, 0xac, 0x05, 0x62, 0xbd, 0xb8,
-	0x73, 0xbe, 0x16, 0xf4, 0x4c, 0x09, 0xea, 0xc0, 0xd6, 0x62, 0x41, 0x42, 0x03, 0xf8, 0x5c, 0x22,
-	0x6c, 0x7c, 0x7a, 0x7a, 0x5e, 0x37, 0xce, 0xce, 0xeb, 0xc6, 0x5f, 0xe7, 0x75, 0xe3, 0xfb, 0x8b,
-	0x7a, 0xe9, 0xec, 0xa2, 0x5e, 0xfa, 0xfd, 0xa2, 0x5e, 0xfa, 0xfc, 0xf9, 0x20, 0x10, 0xc3, 0xf8,
-	0xc0, 0xc1, 0x74, 0xec, 0x62, 0xca, 0xc7, 0x94, 0xe7, 0xc0, 0x9b, 0x13, 0xf0, 0xe4, 0x5d, 0xf7,
-	0xf8, 0x4a, 0x85, 0x93, 0x88, 0xf0, 0x83, 0x8a, 0xfa, 0x33, 0xf1, 0xf4, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x26, 0x7b, 0xb9, 0x64, 0x65, 0x09, 0x00, 0x00,
+func (m *QueryHistoricalEntriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHistoricalEntriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalEntriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHistoricalEntriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalEntriesRequest.Merge(m, src)
+}
+func (m *QueryHistoricalEntriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalEntriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalEntriesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryHistoricalEntriesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+type QueryHistoricalEntriesResponse struct {
+	// the number of historical entries to persist, i.e., the HistoricalEntries param
+	HistoricalEntries int64 `protobuf:"varint,1,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+	// the heights of the historical entries currently stored, in ascending order
+	Heights []int64 `protobuf:"varint,2,rep,packed,name=heights,proto3" json:"heights,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryHistoricalEntriesResponse) Reset()         { *m = QueryHistoricalEntriesResponse{} }
+func (m *QueryHistoricalEntriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHistoricalEntriesResponse) ProtoMessage()    {}
+func (*QueryHistoricalEntriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{12}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryHistoricalEntriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
-	out := new(QueryNextFeeDistributionEstimateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricalEntriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHistoricalEntriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryHistoricalEntriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHistoricalEntriesResponse.Merge(m, src)
+}
+func (m *QueryHistoricalEntriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHistoricalEntriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHistoricalEntriesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
-	out := new(QueryProviderInfoResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHistoricalEntriesResponse proto.InternalMessageInfo
+
+func (m *QueryHistoricalEntriesResponse) GetHistoricalEntries() int64 {
+	if m != nil {
+		return m.HistoricalEntries
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHistoricalEntriesResponse) GetHeights() []int64 {
+	if m != nil {
+		return m.Heights
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+type QueryProviderClientExpiryRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryProviderClientExpiryRequest) Reset()         { *m = QueryProviderClientExpiryRequest{} }
+func (m *QueryProviderClientExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryRequest) ProtoMessage()    {}
+func (*QueryProviderClientExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{13}
 }
-
-func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryProviderClientExpiryRequest proto.InternalMessageInfo
+
+type QueryProviderClientExpiryResponse struct {
+	// the client id of the provider client
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the time at which the provider client expires, i.e., the timestamp of
+	// its latest consensus state plus its trusting period
+	ExpiryTime time.Time `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// whether the expiry of the provider client was detected
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// the height at which the consumer chain halts because the provider client
+	// expired; zero if the consumer chain does not halt
+	HaltHeight int64 `protobuf:"varint,4,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
 }
 
-func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextFeeDistributionEstimateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+func (m *QueryProviderClientExpiryResponse) Reset()         { *m = QueryProviderClientExpiryResponse{} }
+func (m *QueryProviderClientExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryResponse) ProtoMessage()    {}
+func (*QueryProviderClientExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{14}
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.Merge(m, src)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
+var xxx_messageInfo_QueryProviderClientExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderClientExpiryResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *QueryProviderClientExpiryResponse) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryProviderClientExpiryResponse) GetExpired() bool {
+	if m != nil {
+		return m.Expired
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryProviderInfo(ctx, in)
+	return false
+}
+
+func (m *QueryProviderClientExpiryResponse) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPendingVSCMaturitiesRequest struct {
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.consumer.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryNextFeeDistribution",
-			Handler:    _Query_QueryNextFeeDistribution_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryProviderInfo",
-			Handler:    _Query_QueryProviderInfo_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+func (m *QueryPendingVSCMaturitiesRequest) Reset()         { *m = QueryPendingVSCMaturitiesRequest{} }
+func (m *QueryPendingVSCMaturitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVSCMaturitiesRequest) ProtoMessage()    {}
+func (*QueryPendingVSCMaturitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{15}
 }
-
-func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingVSCMaturitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVSCMaturitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVSCMaturitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPendingVSCMaturitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVSCMaturitiesRequest.Merge(m, src)
+}
+func (m *QueryPendingVSCMaturitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVSCMaturitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVSCMaturitiesRequest.DiscardUnknown(m)
 }
 
-func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPendingVSCMaturitiesRequest proto.InternalMessageInfo
+
+type QueryPendingVSCMaturitiesResponse struct {
+	// the vsc packets received whose maturity time has not elapsed yet,
+	// in ascending order of their maturity times
+	PendingMaturities []MaturingVSCPacket `protobuf:"bytes,1,rep,name=pending_maturities,json=pendingMaturities,proto3" json:"pending_maturities"`
+	// the counters of the VSC maturities
+	Counters VSCMaturityCounters `protobuf:"bytes,2,opt,name=counters,proto3" json:"counters"`
 }
 
-func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToConsumer) > 0 {
-		i -= len(m.ToConsumer)
-		copy(dAtA[i:], m.ToConsumer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ToProvider) > 0 {
-		i -= len(m.ToProvider)
-		copy(dAtA[i:], m.ToProvider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DistributionFraction) > 0 {
-		i -= len(m.DistributionFraction)
-		copy(dAtA[i:], m.DistributionFraction)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LastHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CurrentHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPendingVSCMaturitiesResponse) Reset()         { *m = QueryPendingVSCMaturitiesResponse{} }
+func (m *QueryPendingVSCMaturitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVSCMaturitiesResponse) ProtoMessage()    {}
+func (*QueryPendingVSCMaturitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{16}
+}
+func (m *QueryPendingVSCMaturitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVSCMaturitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVSCMaturitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingVSCMaturitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVSCMaturitiesResponse.Merge(m, src)
+}
+func (m *QueryPendingVSCMaturitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVSCMaturitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVSCMaturitiesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingVSCMaturitiesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingVSCMaturitiesResponse) GetPendingMaturities() []MaturingVSCPacket {
+	if m != nil {
+		return m.PendingMaturities
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingVSCMaturitiesResponse) GetCounters() VSCMaturityCounters {
+	if m != nil {
+		return m.Counters
+	}
+	return VSCMaturityCounters{}
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryProviderUpgradeNoticeRequest struct {
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderUpgradeNoticeRequest) Reset()         { *m = QueryProviderUpgradeNoticeRequest{} }
+func (m *QueryProviderUpgradeNoticeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUpgradeNoticeRequest) ProtoMessage()    {}
+func (*QueryProviderUpgradeNoticeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{17}
+}
+func (m *QueryProviderUpgradeNoticeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderUpgradeNoticeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUpgradeNoticeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProviderUpgradeNoticeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUpgradeNoticeRequest.Merge(m, src)
+}
+func (m *QueryProviderUpgradeNoticeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderUpgradeNoticeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUpgradeNoticeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProviderUpgradeNoticeRequest proto.InternalMessageInfo
+
+type QueryProviderUpgradeNoticeResponse struct {
+	// the scheduled upgrade of the provider chain;
+	// not set if no upgrade of the provider chain is scheduled
+	Notice *types.ProviderUpgradeNotice `protobuf:"bytes,1,opt,name=notice,proto3" json:"notice,omitempty"`
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProviderUpgradeNoticeResponse) Reset()         { *m = QueryProviderUpgradeNoticeResponse{} }
+func (m *QueryProviderUpgradeNoticeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderUpgradeNoticeResponse) ProtoMessage()    {}
+func (*QueryProviderUpgradeNoticeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{18}
+}
+func (m *QueryProviderUpgradeNoticeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderUpgradeNoticeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderUpgradeNoticeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProviderUpgradeNoticeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderUpgradeNoticeResponse.Merge(m, src)
+}
+func (m *QueryProviderUpgradeNoticeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderUpgradeNoticeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderUpgradeNoticeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderUpgradeNoticeResponse proto.InternalMessageInfo
+
+func (m *QueryProviderUpgradeNoticeResponse) GetNotice() *types.ProviderUpgradeNotice {
+	if m != nil {
+		return m.Notice
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValsetStalenessRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValsetStalenessRequest) Reset()         { *m = QueryValsetStalenessRequest{} }
+func (m *QueryValsetStalenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetStalenessRequest) ProtoMessage()    {}
+func (*QueryValsetStalenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{19}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetStalenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetStalenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetStalenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetStalenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetStalenessRequest.Merge(m, src)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryValsetStalenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValsetStalenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetStalenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValsetStalenessRequest proto.InternalMessageInfo
+
+type QueryValsetStalenessResponse struct {
+	// the last VSC packet applied by the consumer chain;
+	// not set if no VSC packet was applied yet
+	LastAppliedVsc *LastAppliedVSC `protobuf:"bytes,1,opt,name=last_applied_vsc,json=lastAppliedVsc,proto3" json:"last_applied_vsc,omitempty"`
+	// the number of blocks since the last VSC packet was applied
+	BlocksSinceLastVsc int64 `protobuf:"varint,2,opt,name=blocks_since_last_vsc,json=blocksSinceLastVsc,proto3" json:"blocks_since_last_vsc,omitempty"`
+	// the time elapsed since the last VSC packet was applied
+	TimeSinceLastVsc time.Duration `protobuf:"bytes,3,opt,name=time_since_last_vsc,json=timeSinceLastVsc,proto3,stdduration" json:"time_since_last_vsc"`
+	// the number of VSCMatured packets queued but not yet sent to the provider chain
+	PendingVscMaturedPackets uint64 `protobuf:"varint,4,opt,name=pending_vsc_matured_packets,json=pendingVscMaturedPackets,proto3" json:"pending_vsc_matured_packets,omitempty"`
+	// whether the VSCMatured packets are backed up, i.e., whether some VSCMatured
+	// packets could not be sent to the provider chain
+	MaturitySendsBackedUp bool `protobuf:"varint,5,opt,name=maturity_sends_backed_up,json=maturitySendsBackedUp,proto3" json:"maturity_sends_backed_up,omitempty"`
+	// the time without any VSC packet applied after which the validator set
+	// is considered stale; zero if the staleness warnings are disabled
+	StalenessThreshold time.Duration `protobuf:"bytes,6,opt,name=staleness_threshold,json=stalenessThreshold,proto3,stdduration" json:"staleness_threshold"`
+	// whether the validator set is considered stale
+	Stale bool `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (m *QueryValsetStalenessResponse) Reset()         { *m = QueryValsetStalenessResponse{} }
+func (m *QueryValsetStalenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValsetStalenessResponse) ProtoMessage()    {}
+func (*QueryValsetStalenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{20}
+}
+func (m *QueryValsetStalenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValsetStalenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValsetStalenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValsetStalenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValsetStalenessResponse.Merge(m, src)
 }
-
-func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetStalenessResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValsetStalenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValsetStalenessResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValsetStalenessResponse proto.InternalMessageInfo
+
+func (m *QueryValsetStalenessResponse) GetLastAppliedVsc() *LastAppliedVSC {
+	if m != nil {
+		return m.LastAppliedVsc
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetStalenessResponse) GetBlocksSinceLastVsc() int64 {
+	if m != nil {
+		return m.BlocksSinceLastVsc
+	}
+	return 0
 }
 
-func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryValsetStalenessResponse) GetTimeSinceLastVsc() time.Duration {
+	if m != nil {
+		return m.TimeSinceLastVsc
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetStalenessResponse) GetPendingVscMaturedPackets() uint64 {
+	if m != nil {
+		return m.PendingVscMaturedPackets
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValsetStalenessResponse) GetMaturitySendsBackedUp() bool {
+	if m != nil {
+		return m.MaturitySendsBackedUp
+	}
+	return false
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValsetStalenessResponse) GetStalenessThreshold() time.Duration {
+	if m != nil {
+		return m.StalenessThreshold
+	}
+	return 0
 }
 
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValsetStalenessResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SlashPacketRetryState describes a slash packet queued to be sent to the provider
+type SlashPacketRetryState struct {
+	// The consensus address of the validator to be slashed
+	ValidatorAddress string            `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Infraction       types1.Infraction `protobuf:"varint,2,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	ValsetUpdateId   uint64            `protobuf:"varint,3,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// The number of times the slash packet was sent to the provider
+	SendAttempts uint32 `protobuf:"varint,4,opt,name=send_attempts,json=sendAttempts,proto3" json:"send_attempts,omitempty"`
+	// The last time the slash packet was sent to the provider (nil if never sent)
+	LastAttemptTime *time.Time `protobuf:"bytes,5,opt,name=last_attempt_time,json=lastAttemptTime,proto3,stdtime" json:"last_attempt_time,omitempty"`
+	// The earliest time the slash packet is sent again if bounced by the provider,
+	// i.e., the last attempt time plus the RetryDelayPeriod (nil if never sent)
+	NextRetryTime *time.Time `protobuf:"bytes,6,opt,name=next_retry_time,json=nextRetryTime,proto3,stdtime" json:"next_retry_time,omitempty"`
+	// Whether the consumer is waiting on a reply from the provider for the slash packet
+	WaitingOnReply bool `protobuf:"varint,7,opt,name=waiting_on_reply,json=waitingOnReply,proto3" json:"waiting_on_reply,omitempty"`
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketDataQueue) > 0 {
-		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.SlashRecord != nil {
-		{
-			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *SlashPacketRetryState) Reset()         { *m = SlashPacketRetryState{} }
+func (m *SlashPacketRetryState) String() string { return proto.CompactTextString(m) }
+func (*SlashPacketRetryState) ProtoMessage()    {}
+func (*SlashPacketRetryState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{21}
+}
+func (m *SlashPacketRetryState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashPacketRetryState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashPacketRetryState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SlashPacketRetryState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashPacketRetryState.Merge(m, src)
+}
+func (m *SlashPacketRetryState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashPacketRetryState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashPacketRetryState.DiscardUnknown(m)
 }
 
-func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SlashPacketRetryState proto.InternalMessageInfo
+
+func (m *SlashPacketRetryState) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SlashPacketRetryState) GetInfraction() types1.Infraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return types1.Infraction_INFRACTION_UNSPECIFIED
 }
 
-func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+func (m *SlashPacketRetryState) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlashPacketRetryState) GetSendAttempts() uint32 {
+	if m != nil {
+		return m.SendAttempts
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *NextFeeDistributionEstimate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrentHeight != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentHeight))
+
+func (m *SlashPacketRetryState) GetLastAttemptTime() *time.Time {
+	if m != nil {
+		return m.LastAttemptTime
 	}
-	if m.LastHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastHeight))
+	return nil
+}
+
+func (m *SlashPacketRetryState) GetNextRetryTime() *time.Time {
+	if m != nil {
+		return m.NextRetryTime
 	}
-	if m.NextHeight != 0 {
-		n += 1 + sovQuery(uint64(m.NextHeight))
+	return nil
+}
+
+func (m *SlashPacketRetryState) GetWaitingOnReply() bool {
+	if m != nil {
+		return m.WaitingOnReply
 	}
-	l = len(m.DistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ToProvider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ToConsumer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return false
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type ChainInfo struct {
+	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ConnectionID string `protobuf:"bytes,3,opt,name=connectionID,proto3" json:"connectionID,omitempty"`
+	ChannelID    string `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+}
+
+func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
+func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainInfo) ProtoMessage()    {}
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{22}
+}
+func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *ChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfo.Merge(m, src)
+}
+func (m *ChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+
+func (m *ChainInfo) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ChainInfo) GetClientID() string {
+	if m != nil {
+		return m.ClientID
 	}
-	return n
+	return ""
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainInfo) GetConnectionID() string {
+	if m != nil {
+		return m.ConnectionID
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChainInfo) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryProviderInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
+	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*QuerySlashPacketRetryStateRequest)(nil), "interchain_security.ccv.consumer.v1.QuerySlashPacketRetryStateRequest")
+	proto.RegisterType((*QuerySlashPacketRetryStateResponse)(nil), "interchain_security.ccv.consumer.v1.QuerySlashPacketRetryStateResponse")
+	proto.RegisterType((*QueryHistoricalEntriesRequest)(nil), "interchain_security.ccv.consumer.v1.QueryHistoricalEntriesRequest")
+	proto.RegisterType((*QueryHistoricalEntriesResponse)(nil), "interchain_security.ccv.consumer.v1.QueryHistoricalEntriesResponse")
+	proto.RegisterType((*QueryProviderClientExpiryRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryRequest")
+	proto.RegisterType((*QueryProviderClientExpiryResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryResponse")
+	proto.RegisterType((*QueryPendingVSCMaturitiesRequest)(nil), "interchain_security.ccv.consumer.v1.QueryPendingVSCMaturitiesRequest")
+	proto.RegisterType((*QueryPendingVSCMaturitiesResponse)(nil), "interchain_security.ccv.consumer.v1.QueryPendingVSCMaturitiesResponse")
+	proto.RegisterType((*QueryProviderUpgradeNoticeRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderUpgradeNoticeRequest")
+	proto.RegisterType((*QueryProviderUpgradeNoticeResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderUpgradeNoticeResponse")
+	proto.RegisterType((*QueryValsetStalenessRequest)(nil), "interchain_security.ccv.consumer.v1.QueryValsetStalenessRequest")
+	proto.RegisterType((*QueryValsetStalenessResponse)(nil), "interchain_security.ccv.consumer.v1.QueryValsetStalenessResponse")
+	proto.RegisterType((*SlashPacketRetryState)(nil), "interchain_security.ccv.consumer.v1.SlashPacketRetryState")
+	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
+}
+
+var fileDescriptor_f627751d3cc10225 = []byte{
+	// 1830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x37, 0xb5, 0xb2, 0x22, 0x3d, 0x59, 0xb6, 0x35, 0x96, 0x83, 0x0d, 0xe5, 0xac, 0x14, 0x3a,
+	0x45, 0xd5, 0x14, 0xe2, 0x7a, 0xe5, 0xc0, 0x72, 0xdc, 0xb8, 0xb1, 0xa4, 0x95, 0x62, 0x01, 0x76,
+	0xea, 0x50, 0xb2, 0x8b, 0x06, 0x28, 0xd8, 0x11, 0x39, 0xda, 0x25, 0xcc, 0x25, 0x69, 0xce, 0xec,
+	0xc6, 0x3a, 0xb5, 0x68, 0x8f, 0x3d, 0x34, 0x40, 0x2f, 0xed, 0xd7, 0xe8, 0x47, 0xe8, 0x29, 0x40,
+	0x0f, 0x0d, 0xd0, 0x43, 0xd3, 0x4b, 0x53, 0xd8, 0x3d, 0xf4, 0xe0, 0x43, 0x0b, 0xf4, 0xd0, 0x63,
+	0x31, 0x33, 0x8f, 0xdc, 0x5d, 0x89, 0x5a, 0x51, 0x56, 0x6e, 0x3b, 0xef, 0xcf, 0x6f, 0xde, 0xef,
+	0xcd, 0x9b, 0xc7, 0x37, 0x0b, 0xf5, 0x20, 0x12, 0x2c, 0xf5, 0xda, 0x34, 0x88, 0x5c, 0xce, 0xbc,
+	0x6e, 0x1a, 0x88, 0x83, 0xba, 0xe7, 0xf5, 0xea, 0x5e, 0x1c, 0xf1, 0x6e, 0x87, 0xa5, 0xf5, 0x5e,
+	0xa3, 0xfe, 0xac, 0xcb, 0xd2, 0x03, 0x3b, 0x49, 0x63, 0x11, 0x93, 0xeb, 0x05, 0x0e, 0xb6, 0xe7,
+	0xf5, 0xec, 0xcc, 0xc1, 0xee, 0x35, 0xcc, 0x1b, 0xc7, 0xa1, 0xf6, 0x1a, 0x75, 0xde, 0xa6, 0x29,
+	0xf3, 0xdd, 0xdc, 0x5c, 0xc1, 0x9a, 0x73, 0xad, 0xb8, 0x15, 0xab, 0x9f, 0x75, 0xf9, 0x0b, 0xa5,
+	0xd7, 0x5a, 0x71, 0xdc, 0x0a, 0x59, 0x9d, 0x26, 0x41, 0x9d, 0x46, 0x51, 0x2c, 0xa8, 0x08, 0xe2,
+	0x88, 0xa3, 0x76, 0xa5, 0x4c, 0xec, 0x87, 0xf6, 0x69, 0x94, 0xf1, 0x69, 0xb1, 0x88, 0xf1, 0x20,
+	0xdb, 0xe6, 0x3b, 0x23, 0xc8, 0x7c, 0x1e, 0xa4, 0x0c, 0xcd, 0x16, 0x30, 0x56, 0xb5, 0xda, 0xeb,
+	0xee, 0xd7, 0x45, 0xd0, 0x61, 0x5c, 0xd0, 0x4e, 0x82, 0x06, 0xb5, 0xc3, 0x06, 0x7e, 0x37, 0x55,
+	0x7c, 0x50, 0xff, 0xae, 0x17, 0xf3, 0x4e, 0xcc, 0xeb, 0x5c, 0xd0, 0xa7, 0x41, 0xd4, 0xaa, 0xf7,
+	0x1a, 0x7b, 0x4c, 0xd0, 0x46, 0xb6, 0xd6, 0x56, 0xd6, 0x6f, 0x2a, 0x30, 0xff, 0x09, 0x7b, 0x2e,
+	0xb6, 0x18, 0x6b, 0x06, 0x5c, 0xa4, 0xc1, 0x5e, 0x57, 0x62, 0x6c, 0x72, 0x11, 0x74, 0xa8, 0x60,
+	0xe4, 0x5d, 0x98, 0xf1, 0xba, 0x69, 0xca, 0x22, 0x71, 0x9f, 0x05, 0xad, 0xb6, 0xa8, 0x1a, 0x8b,
+	0xc6, 0x52, 0xc5, 0x19, 0x16, 0x92, 0x1a, 0x40, 0x48, 0x79, 0x66, 0x32, 0xa6, 0x4c, 0x06, 0x24,
+	0x52, 0x1f, 0xb1, 0xe7, 0x99, 0xbe, 0xa2, 0xf5, 0x7d, 0x09, 0xb9, 0x09, 0x57, 0xfd, 0x81, 0xdd,
+	0xdd, 0xfd, 0x94, 0x7a, 0xf2, 0x47, 0x75, 0x7c, 0xd1, 0x58, 0x9a, 0x72, 0xe6, 0x06, 0x95, 0x5b,
+	0xa8, 0x23, 0x73, 0x70, 0x5e, 0xc4, 0x82, 0x86, 0xd5, 0xf3, 0xca, 0x48, 0x2f, 0xe4, 0x56, 0x22,
+	0x7e, 0x94, 0xc6, 0xbd, 0xc0, 0x67, 0x69, 0x75, 0x42, 0xa9, 0x06, 0x24, 0x5a, 0xbf, 0x81, 0xa7,
+	0x53, 0x7d, 0x23, 0xd3, 0x67, 0x12, 0xf2, 0x73, 0xb8, 0xe6, 0xb3, 0x28, 0xee, 0xb8, 0x85, 0x01,
+	0xf1, 0xea, 0xe4, 0x62, 0x65, 0x69, 0x7a, 0x65, 0xd5, 0x3e, 0xae, 0x6e, 0x7b, 0x0d, 0xbb, 0x29,
+	0xfd, 0x1d, 0x56, 0x14, 0xf4, 0xfa, 0xf8, 0x97, 0x7f, 0x5f, 0x38, 0xe7, 0x98, 0x6a, 0x8b, 0x66,
+	0x81, 0x01, 0xb7, 0xbe, 0x07, 0xdf, 0xfd, 0x54, 0x5e, 0x90, 0x11, 0xa7, 0xe2, 0xb0, 0x67, 0x5d,
+	0xc6, 0x85, 0xf5, 0x0b, 0x03, 0x96, 0x4e, 0xb6, 0xe5, 0x49, 0x1c, 0x71, 0x46, 0x76, 0x61, 0xdc,
+	0xa7, 0x82, 0xaa, 0x03, 0x9c, 0x5e, 0xb9, 0x67, 0x97, 0xb8, 0x78, 0xf6, 0x28, 0x5c, 0x85, 0x66,
+	0xcd, 0x01, 0x51, 0x11, 0x3c, 0xa2, 0x29, 0xed, 0xf0, 0x2c, 0x30, 0x17, 0xae, 0x0c, 0x49, 0x31,
+	0x84, 0xfb, 0x30, 0x91, 0x28, 0x09, 0x06, 0xf1, 0xde, 0xa8, 0x2c, 0x66, 0x27, 0xa2, 0x31, 0x30,
+	0x71, 0xe8, 0x6f, 0x99, 0x50, 0xd5, 0x1b, 0xe0, 0xb1, 0x6e, 0x47, 0xfb, 0x71, 0xb6, 0xf9, 0x7f,
+	0x0d, 0x78, 0xab, 0x40, 0x89, 0x31, 0x3c, 0x82, 0xc9, 0x8c, 0x21, 0x46, 0x61, 0x97, 0x4a, 0xc5,
+	0x86, 0x54, 0x4b, 0x24, 0x8c, 0x24, 0x47, 0x91, 0x88, 0x49, 0x56, 0x6f, 0x63, 0x67, 0x41, 0xcc,
+	0x50, 0xc8, 0x4d, 0x78, 0x33, 0xfb, 0xed, 0xee, 0x33, 0xe6, 0x26, 0x71, 0x1c, 0xba, 0xd4, 0xf7,
+	0x53, 0x75, 0x75, 0xa6, 0x9c, 0x2b, 0x99, 0x76, 0x8b, 0xb1, 0x47, 0x71, 0x1c, 0xae, 0xf9, 0x7e,
+	0x6a, 0xcd, 0x23, 0xeb, 0xdd, 0x76, 0x1a, 0x0b, 0x11, 0xb2, 0x1d, 0x31, 0x50, 0x29, 0x7f, 0x33,
+	0xc0, 0x2c, 0xd2, 0x62, 0x52, 0x7e, 0x02, 0x17, 0x78, 0x48, 0x79, 0xdb, 0x4d, 0x99, 0x17, 0xa7,
+	0x3e, 0x26, 0xe6, 0x46, 0x29, 0x1a, 0x3b, 0xd2, 0xd1, 0x51, 0x7e, 0x8a, 0x88, 0xe1, 0x4c, 0xf3,
+	0xbe, 0x88, 0xfc, 0x0c, 0x66, 0x13, 0xea, 0x3d, 0x65, 0xc2, 0x95, 0xf5, 0xe2, 0x3e, 0xeb, 0xb2,
+	0x2e, 0xab, 0x8e, 0x2d, 0x56, 0x46, 0xa6, 0x69, 0xe8, 0xf8, 0xa5, 0x73, 0x93, 0x0a, 0x8a, 0x69,
+	0xba, 0x94, 0xe4, 0x92, 0x4f, 0x25, 0x98, 0x75, 0x1d, 0xde, 0x51, 0xd4, 0x54, 0x20, 0xda, 0xdc,
+	0x61, 0x22, 0x3d, 0x18, 0x4a, 0xc0, 0xaf, 0x0d, 0xb0, 0x46, 0x59, 0x61, 0x22, 0x18, 0xcc, 0xe8,
+	0x44, 0xe8, 0x4d, 0x64, 0xa1, 0xca, 0x48, 0xef, 0x94, 0xcf, 0xc4, 0x61, 0x68, 0x8c, 0xfa, 0x02,
+	0xef, 0x2b, 0xb9, 0xb5, 0x00, 0x6f, 0xab, 0x60, 0xee, 0x07, 0x5c, 0xc4, 0x69, 0xe0, 0xd1, 0x70,
+	0x33, 0x12, 0x69, 0xc0, 0xf2, 0x0b, 0x14, 0x40, 0xed, 0x38, 0x03, 0x8c, 0x74, 0x19, 0x48, 0x3b,
+	0x57, 0xba, 0x4c, 0x6b, 0xb1, 0x3b, 0xcf, 0xb6, 0x0f, 0xbb, 0x91, 0x2a, 0xbc, 0xd1, 0x56, 0xbd,
+	0x96, 0xab, 0xe4, 0x57, 0x9c, 0x6c, 0x69, 0x59, 0xb0, 0x38, 0x74, 0x5b, 0x36, 0xc2, 0x80, 0x45,
+	0x62, 0xf3, 0x79, 0x12, 0xa4, 0x07, 0x59, 0x38, 0x7f, 0x34, 0xe0, 0x9d, 0x11, 0x46, 0x18, 0xd2,
+	0x3c, 0x4c, 0x79, 0x4a, 0xee, 0x06, 0xba, 0x84, 0xa6, 0x9c, 0x49, 0x2d, 0xd8, 0xf6, 0xc9, 0x26,
+	0x4c, 0x33, 0x65, 0xee, 0xca, 0x0f, 0x19, 0x5e, 0x14, 0xd3, 0xd6, 0x1f, 0x31, 0x3b, 0xfb, 0x88,
+	0xd9, 0xbb, 0xd9, 0x57, 0x6e, 0x7d, 0x52, 0xe6, 0xed, 0x8b, 0x6f, 0x16, 0x0c, 0x07, 0xb4, 0xa3,
+	0x54, 0x49, 0x1e, 0x6a, 0xc5, 0x7c, 0x75, 0x17, 0x26, 0x9d, 0x6c, 0x49, 0x16, 0x60, 0xba, 0x4d,
+	0x43, 0xe1, 0x6a, 0x5e, 0xea, 0xcb, 0x51, 0x71, 0x40, 0x8a, 0xf4, 0x47, 0xa6, 0x4f, 0x94, 0x45,
+	0x7e, 0x10, 0xb5, 0x9e, 0xec, 0x6c, 0x3c, 0xa4, 0x42, 0x9e, 0xe4, 0x40, 0xde, 0x5f, 0xe5, 0x44,
+	0x0b, 0x8d, 0x90, 0xe8, 0x53, 0x20, 0x89, 0xd6, 0xbb, 0x9d, 0x5c, 0x8b, 0xa5, 0x72, 0xab, 0x54,
+	0xa9, 0x68, 0x50, 0x85, 0xaf, 0x6b, 0x02, 0xcb, 0x64, 0x16, 0x71, 0xfb, 0x9b, 0x92, 0xcf, 0x64,
+	0xc3, 0xea, 0x4a, 0x4c, 0x8e, 0x59, 0xbb, 0x5d, 0x6a, 0x8b, 0x7e, 0xe8, 0x07, 0x1b, 0xe8, 0xdf,
+	0x6f, 0x5d, 0x7a, 0x6d, 0x5d, 0x3f, 0x74, 0xac, 0x8f, 0x93, 0x56, 0x4a, 0x7d, 0xf6, 0x49, 0x2c,
+	0x02, 0x2f, 0xbf, 0x3a, 0x31, 0x58, 0xa3, 0x8c, 0x30, 0x27, 0xdb, 0x30, 0x11, 0x29, 0x09, 0x36,
+	0x8f, 0xc6, 0xa8, 0xcb, 0x5d, 0x0c, 0x85, 0x00, 0xd6, 0xdb, 0x30, 0xaf, 0x36, 0x7c, 0x42, 0x43,
+	0xce, 0xc4, 0x8e, 0xa0, 0x21, 0x8b, 0x18, 0xcf, 0xcf, 0xe8, 0x5f, 0x15, 0xb8, 0x56, 0xac, 0xc7,
+	0x50, 0x7e, 0x0a, 0x97, 0xe5, 0xec, 0xe1, 0xd2, 0x24, 0x09, 0x03, 0xe6, 0xbb, 0x3d, 0xee, 0x61,
+	0x50, 0x37, 0x4b, 0x65, 0xee, 0x01, 0xe5, 0x62, 0x4d, 0xfb, 0x3e, 0xd9, 0xd9, 0x70, 0x2e, 0x86,
+	0x03, 0x6b, 0xee, 0x91, 0x06, 0x5c, 0xdd, 0x0b, 0x63, 0xef, 0x29, 0x77, 0x79, 0x10, 0x79, 0xcc,
+	0x55, 0x7b, 0xc9, 0x3d, 0xf4, 0xdc, 0x43, 0xb4, 0x72, 0x47, 0xea, 0x24, 0x92, 0x74, 0x71, 0xe0,
+	0x8a, 0xac, 0xfa, 0xc3, 0x0e, 0x15, 0x15, 0xd4, 0x5b, 0x47, 0x2e, 0x41, 0x13, 0x27, 0x39, 0x7d,
+	0x07, 0x7e, 0x27, 0xef, 0xc0, 0x65, 0xe9, 0x3f, 0x84, 0x79, 0x17, 0xe6, 0xb3, 0x22, 0xec, 0x71,
+	0x4f, 0x17, 0x22, 0xf3, 0xf3, 0xc6, 0x25, 0xeb, 0x7f, 0xdc, 0xa9, 0xa2, 0xc9, 0x13, 0xee, 0x3d,
+	0xd4, 0x06, 0xd8, 0x82, 0xc8, 0x2a, 0x54, 0xb1, 0x76, 0x0f, 0x5c, 0xce, 0x22, 0x9f, 0xbb, 0x7b,
+	0x52, 0xe3, 0xbb, 0xdd, 0x44, 0x0d, 0x54, 0x93, 0xce, 0xd5, 0x4c, 0xbf, 0x23, 0xd5, 0xeb, 0x4a,
+	0xfb, 0x38, 0x21, 0xbb, 0x70, 0x85, 0x67, 0x29, 0x77, 0x45, 0x3b, 0x65, 0xbc, 0x1d, 0x87, 0x7e,
+	0x75, 0xa2, 0x3c, 0x17, 0x92, 0xfb, 0xef, 0x66, 0xee, 0x72, 0x98, 0x53, 0x52, 0x35, 0x91, 0x4d,
+	0x3a, 0x7a, 0x61, 0xfd, 0xbe, 0x02, 0x57, 0x0b, 0xbb, 0x2a, 0xf9, 0x3e, 0xcc, 0xf6, 0x68, 0x18,
+	0xf8, 0x54, 0xc4, 0xa9, 0xfa, 0x34, 0x32, 0xce, 0xb1, 0xe7, 0x5c, 0xce, 0x15, 0x6b, 0x5a, 0x4e,
+	0xd6, 0x01, 0x82, 0x28, 0x9f, 0x29, 0xe5, 0x31, 0x5d, 0x5c, 0xb1, 0x6c, 0x3d, 0x1f, 0xdb, 0xd9,
+	0x3c, 0x8c, 0xf3, 0xb1, 0xbd, 0x9d, 0x5b, 0x3a, 0x03, 0x5e, 0x64, 0x09, 0x24, 0x2e, 0x67, 0xc2,
+	0xed, 0x26, 0x3e, 0x15, 0x4c, 0xf6, 0xb8, 0x8a, 0xca, 0xf1, 0x45, 0x2d, 0x7f, 0xac, 0xc4, 0xdb,
+	0x3e, 0xb9, 0x0e, 0x33, 0x32, 0xa1, 0x2e, 0x15, 0x82, 0x75, 0x12, 0x3c, 0x8a, 0x19, 0xe7, 0x82,
+	0x14, 0xae, 0xa1, 0x8c, 0x3c, 0x80, 0x59, 0x5d, 0xa3, 0x5a, 0xa0, 0x9b, 0xe2, 0xf9, 0x13, 0x9b,
+	0xe2, 0xb8, 0x6a, 0x88, 0x97, 0x54, 0x45, 0x6a, 0x4f, 0xa9, 0x23, 0xf7, 0xe1, 0x92, 0x9c, 0xa6,
+	0xdd, 0x54, 0x26, 0x48, 0x63, 0x4d, 0x94, 0xc4, 0x9a, 0x91, 0x8e, 0x2a, 0xb1, 0x0a, 0x69, 0x09,
+	0x2e, 0x7f, 0x4e, 0x03, 0x21, 0xab, 0x2a, 0x8e, 0xdc, 0x94, 0x25, 0xe1, 0x01, 0x1e, 0xc9, 0x45,
+	0x94, 0xff, 0x28, 0x72, 0xa4, 0xd4, 0xfa, 0x95, 0x01, 0x53, 0xf9, 0x08, 0x23, 0xfb, 0xb2, 0xba,
+	0x55, 0xdb, 0x4d, 0x3c, 0x85, 0x6c, 0x49, 0x4c, 0xc8, 0x3e, 0x02, 0xcd, 0xea, 0xd8, 0xd0, 0x47,
+	0xa1, 0x49, 0x2c, 0xb8, 0xe0, 0xc5, 0x51, 0xc4, 0x54, 0x8a, 0xb7, 0x9b, 0x38, 0xde, 0x0c, 0xc9,
+	0xc8, 0x35, 0x98, 0xf2, 0xda, 0x34, 0x8a, 0x58, 0xb8, 0xdd, 0xc4, 0xf7, 0x40, 0x5f, 0xb0, 0xf2,
+	0xf5, 0x2c, 0x9c, 0x57, 0xcd, 0x80, 0xfc, 0xcf, 0xc0, 0x99, 0xb0, 0x60, 0x68, 0x25, 0x0f, 0x4a,
+	0x5d, 0xfc, 0x92, 0x73, 0xb7, 0xf9, 0xf0, 0x5b, 0x42, 0xd3, 0xfd, 0xca, 0xfa, 0xe8, 0x97, 0x7f,
+	0xf9, 0xe7, 0x6f, 0xc7, 0x3e, 0x20, 0xab, 0x27, 0xbf, 0x9e, 0xe5, 0x61, 0x2d, 0xef, 0x33, 0xb6,
+	0x3c, 0xf8, 0xb8, 0x20, 0x7f, 0x30, 0x60, 0x7a, 0x60, 0xde, 0x26, 0xab, 0xe5, 0xe3, 0x1b, 0x9a,
+	0xdb, 0xcd, 0xdb, 0xa7, 0x77, 0x44, 0x0e, 0x37, 0x14, 0x87, 0xf7, 0xc8, 0xd2, 0xc9, 0x1c, 0xf4,
+	0x08, 0x4f, 0xfe, 0x64, 0xc0, 0xec, 0x91, 0x31, 0x9d, 0xdc, 0x3d, 0x45, 0x04, 0x47, 0x67, 0x7f,
+	0xf3, 0x87, 0xaf, 0xeb, 0x8e, 0x34, 0x56, 0x15, 0x8d, 0x06, 0xa9, 0x97, 0xa0, 0x81, 0xfe, 0xcb,
+	0x81, 0x8c, 0xfb, 0xcf, 0x06, 0x90, 0xa3, 0x03, 0x36, 0x39, 0x45, 0x3c, 0x45, 0x73, 0xbb, 0xf9,
+	0xd1, 0x6b, 0xfb, 0x23, 0xa1, 0xdb, 0x8a, 0xd0, 0x0a, 0xb9, 0x71, 0x32, 0x21, 0x81, 0x00, 0x2e,
+	0x57, 0xa1, 0xff, 0x27, 0x7b, 0x32, 0x14, 0x37, 0xe0, 0xad, 0xf2, 0x91, 0x8d, 0x1a, 0xcc, 0xcd,
+	0x8f, 0xcf, 0x8c, 0x83, 0x4c, 0xd7, 0x15, 0xd3, 0x0f, 0xc9, 0x9d, 0x93, 0x99, 0x0e, 0x8e, 0xf8,
+	0xd8, 0x33, 0x35, 0xe7, 0x6f, 0x0c, 0x78, 0xb3, 0x78, 0xee, 0x26, 0xeb, 0xe5, 0xe3, 0x3c, 0x6e,
+	0xaa, 0x37, 0x37, 0xce, 0x84, 0x81, 0x3c, 0x3f, 0x54, 0x3c, 0x6f, 0x91, 0xf7, 0x4f, 0xe6, 0x79,
+	0xf4, 0x81, 0x40, 0x5e, 0x1d, 0x7e, 0x1c, 0x0f, 0x4e, 0xf2, 0x64, 0xf3, 0xf4, 0xd7, 0xa7, 0xe0,
+	0xb9, 0x60, 0x6e, 0x9d, 0x15, 0x06, 0xa9, 0xde, 0x53, 0x54, 0xef, 0x90, 0xdb, 0xe5, 0x6f, 0xa3,
+	0x8b, 0x2f, 0x10, 0xfd, 0x64, 0x18, 0xa0, 0x5b, 0x30, 0xcf, 0x9f, 0x8a, 0xee, 0xf1, 0x8f, 0x06,
+	0x73, 0xeb, 0xac, 0x30, 0xaf, 0x41, 0xf7, 0xf0, 0xe4, 0xa7, 0x09, 0xfd, 0x3b, 0xbb, 0xb3, 0x85,
+	0x03, 0x36, 0x79, 0x8d, 0x73, 0x29, 0x7a, 0x11, 0x98, 0x1f, 0x9f, 0x19, 0x07, 0x19, 0xaf, 0x29,
+	0xc6, 0x3f, 0x20, 0x1f, 0x9c, 0xe2, 0x80, 0xbb, 0x1a, 0xc9, 0xd5, 0x8f, 0x05, 0xf2, 0x57, 0x03,
+	0xe6, 0x8a, 0x5e, 0x03, 0xe4, 0x5e, 0xf9, 0x20, 0x8b, 0x1f, 0x1a, 0xe6, 0xda, 0x19, 0x10, 0x90,
+	0xe0, 0x1d, 0x45, 0xf0, 0x7d, 0xb2, 0x72, 0x32, 0x41, 0x9c, 0x2e, 0xf3, 0xd9, 0x78, 0xfd, 0xc7,
+	0x5f, 0xbe, 0xa8, 0x19, 0x5f, 0xbd, 0xa8, 0x19, 0xff, 0x78, 0x51, 0x33, 0xbe, 0x78, 0x59, 0x3b,
+	0xf7, 0xd5, 0xcb, 0xda, 0xb9, 0xaf, 0x5f, 0xd6, 0xce, 0x7d, 0x76, 0xb7, 0x15, 0x88, 0x76, 0x77,
+	0xcf, 0xf6, 0xe2, 0x4e, 0x1d, 0xff, 0xe5, 0xed, 0xc3, 0x2f, 0xe7, 0xf0, 0xbd, 0x5b, 0xf5, 0xe7,
+	0xc3, 0x7b, 0x88, 0x83, 0x84, 0xf1, 0xbd, 0x09, 0x35, 0x0c, 0xde, 0xfc, 0xff, 0x00, 0xb1, 0x51,
+	0x0c, 0xda, 0xad, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QuerySlashPacketRetryState returns the slash packets queued to be sent to the provider,
+	// together with the state of the retries of the slash packet at the head of the queue
+	QuerySlashPacketRetryState(ctx context.Context, in *QuerySlashPacketRetryStateRequest, opts ...grpc.CallOption) (*QuerySlashPacketRetryStateResponse, error)
+	// QueryHistoricalEntries returns the number of historical entries to persist
+	// and the heights of the historical entries currently stored
+	QueryHistoricalEntries(ctx context.Context, in *QueryHistoricalEntriesRequest, opts ...grpc.CallOption) (*QueryHistoricalEntriesResponse, error)
+	// QueryProviderClientExpiry returns the expiry time of the provider client
+	// and whether the provider client expired
+	QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error)
+	// QueryPendingVSCMaturities returns the vsc packets received whose maturity time
+	// has not elapsed yet, together with the counters of the VSC maturities
+	QueryPendingVSCMaturities(ctx context.Context, in *QueryPendingVSCMaturitiesRequest, opts ...grpc.CallOption) (*QueryPendingVSCMaturitiesResponse, error)
+	// QueryProviderUpgradeNotice returns the latest notice received from the provider chain
+	// about a scheduled upgrade of the provider chain
+	QueryProviderUpgradeNotice(ctx context.Context, in *QueryProviderUpgradeNoticeRequest, opts ...grpc.CallOption) (*QueryProviderUpgradeNoticeResponse, error)
+	// QueryValsetStaleness returns how stale the validator set of the consumer chain is,
+	// i.e., the blocks and time elapsed since the last VSC packet was applied, and
+	// whether the VSCMatured packets are backed up
+	QueryValsetStaleness(ctx context.Context, in *QueryValsetStalenessRequest, opts ...grpc.CallOption) (*QueryValsetStalenessResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
+	out := new(QueryNextFeeDistributionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryProviderInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Consumer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
+	out := new(QueryProviderInfoResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SlashRecord != nil {
-		l = m.SlashRecord.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QuerySlashPacketRetryState(ctx context.Context, in *QuerySlashPacketRetryStateRequest, opts ...grpc.CallOption) (*QuerySlashPacketRetryStateResponse, error) {
+	out := new(QuerySlashPacketRetryStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QuerySlashPacketRetryState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PacketDataQueue) > 0 {
-		for _, e := range m.PacketDataQueue {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) QueryHistoricalEntries(ctx context.Context, in *QueryHistoricalEntriesRequest, opts ...grpc.CallOption) (*QueryHistoricalEntriesResponse, error) {
+	out := new(QueryHistoricalEntriesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryHistoricalEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error) {
+	out := new(QueryProviderClientExpiryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingVSCMaturities(ctx context.Context, in *QueryPendingVSCMaturitiesRequest, opts ...grpc.CallOption) (*QueryPendingVSCMaturitiesResponse, error) {
+	out := new(QueryPendingVSCMaturitiesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryPendingVSCMaturities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryProviderUpgradeNotice(ctx context.Context, in *QueryProviderUpgradeNoticeRequest, opts ...grpc.CallOption) (*QueryProviderUpgradeNoticeResponse, error) {
+	out := new(QueryProviderUpgradeNoticeResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderUpgradeNotice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryValsetStaleness(ctx context.Context, in *QueryValsetStalenessRequest, opts ...grpc.CallOption) (*QueryValsetStalenessResponse, error) {
+	out := new(QueryValsetStalenessResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryValsetStaleness", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QuerySlashPacketRetryState returns the slash packets queued to be sent to the provider,
+	// together with the state of the retries of the slash packet at the head of the queue
+	QuerySlashPacketRetryState(context.Context, *QuerySlashPacketRetryStateRequest) (*QuerySlashPacketRetryStateResponse, error)
+	// QueryHistoricalEntries returns the number of historical entries to persist
+	// and the heights of the historical entries currently stored
+	QueryHistoricalEntries(context.Context, *QueryHistoricalEntriesRequest) (*QueryHistoricalEntriesResponse, error)
+	// QueryProviderClientExpiry returns the expiry time of the provider client
+	// and whether the provider client expired
+	QueryProviderClientExpiry(context.Context, *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error)
+	// QueryPendingVSCMaturities returns the vsc packets received whose maturity time
+	// has not elapsed yet, together with the counters of the VSC maturities
+	QueryPendingVSCMaturities(context.Context, *QueryPendingVSCMaturitiesRequest) (*QueryPendingVSCMaturitiesResponse, error)
+	// QueryProviderUpgradeNotice returns the latest notice received from the provider chain
+	// about a scheduled upgrade of the provider chain
+	QueryProviderUpgradeNotice(context.Context, *QueryProviderUpgradeNoticeRequest) (*QueryProviderUpgradeNoticeResponse, error)
+	// QueryValsetStaleness returns how stale the validator set of the consumer chain is,
+	// i.e., the blocks and time elapsed since the last VSC packet was applied, and
+	// whether the VSCMatured packets are backed up
+	QueryValsetStaleness(context.Context, *QueryValsetStalenessRequest) (*QueryValsetStalenessResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QuerySlashPacketRetryState(ctx context.Context, req *QuerySlashPacketRetryStateRequest) (*QuerySlashPacketRetryStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySlashPacketRetryState not implemented")
+}
+func (*UnimplementedQueryServer) QueryHistoricalEntries(ctx context.Context, req *QueryHistoricalEntriesRequest) (*QueryHistoricalEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryHistoricalEntries not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderClientExpiry(ctx context.Context, req *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderClientExpiry not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingVSCMaturities(ctx context.Context, req *QueryPendingVSCMaturitiesRequest) (*QueryPendingVSCMaturitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingVSCMaturities not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderUpgradeNotice(ctx context.Context, req *QueryProviderUpgradeNoticeRequest) (*QueryProviderUpgradeNoticeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderUpgradeNotice not implemented")
+}
+func (*UnimplementedQueryServer) QueryValsetStaleness(ctx context.Context, req *QueryValsetStalenessRequest) (*QueryValsetStalenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValsetStaleness not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextFeeDistributionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySlashPacketRetryState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashPacketRetryStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySlashPacketRetryState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QuerySlashPacketRetryState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySlashPacketRetryState(ctx, req.(*QuerySlashPacketRetryStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryHistoricalEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryHistoricalEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryHistoricalEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryHistoricalEntries(ctx, req.(*QueryHistoricalEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderClientExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderClientExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, req.(*QueryProviderClientExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingVSCMaturities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVSCMaturitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingVSCMaturities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryPendingVSCMaturities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingVSCMaturities(ctx, req.(*QueryPendingVSCMaturitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderUpgradeNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderUpgradeNoticeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderUpgradeNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderUpgradeNotice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderUpgradeNotice(ctx, req.(*QueryProviderUpgradeNoticeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValsetStaleness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetStalenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValsetStaleness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryValsetStaleness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValsetStaleness(ctx, req.(*QueryValsetStalenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.consumer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryNextFeeDistribution",
+			Handler:    _Query_QueryNextFeeDistribution_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryProviderInfo",
+			Handler:    _Query_QueryProviderInfo_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QuerySlashPacketRetryState",
+			Handler:    _Query_QuerySlashPacketRetryState_Handler,
+		},
+		{
+			MethodName: "QueryHistoricalEntries",
+			Handler:    _Query_QueryHistoricalEntries_Handler,
+		},
+		{
+			MethodName: "QueryProviderClientExpiry",
+			Handler:    _Query_QueryProviderClientExpiry_Handler,
+		},
+		{
+			MethodName: "QueryPendingVSCMaturities",
+			Handler:    _Query_QueryPendingVSCMaturities_Handler,
+		},
+		{
+			MethodName: "QueryProviderUpgradeNotice",
+			Handler:    _Query_QueryProviderUpgradeNotice_Handler,
+		},
+		{
+			MethodName: "QueryValsetStaleness",
+			Handler:    _Query_QueryValsetStaleness_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+}
+
+func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomDistributionFractions) > 0 {
+		for iNdEx := len(m.DenomDistributionFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomDistributionFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ToConsumer) > 0 {
+		i -= len(m.ToConsumer)
+		copy(dAtA[i:], m.ToConsumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ToProvider) > 0 {
+		i -= len(m.ToProvider)
+		copy(dAtA[i:], m.ToProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DistributionFraction) > 0 {
+		i -= len(m.DistributionFraction)
+		copy(dAtA[i:], m.DistributionFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketDataQueue) > 0 {
+		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashPacketRetryStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashPacketRetryStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashPacketRetryStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashPacketRetryStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashPacketRetryStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashPacketRetryStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashPackets) > 0 {
+		for iNdEx := len(m.SlashPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalEntriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalEntriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHistoricalEntriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHistoricalEntriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHistoricalEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		dAtA7 := make([]byte, len(m.Heights)*10)
+		var j6 int
+		for _, num1 := range m.Heights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintQuery(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HaltHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVSCMaturitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVSCMaturitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVSCMaturitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVSCMaturitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVSCMaturitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVSCMaturitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Counters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PendingMaturities) > 0 {
+		for iNdEx := len(m.PendingMaturities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingMaturities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUpgradeNoticeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUpgradeNoticeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUpgradeNoticeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderUpgradeNoticeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderUpgradeNoticeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderUpgradeNoticeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Notice != nil {
+		{
+			size, err := m.Notice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetStalenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetStalenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetStalenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValsetStalenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValsetStalenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValsetStalenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StalenessThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StalenessThreshold):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.MaturitySendsBackedUp {
+		i--
+		if m.MaturitySendsBackedUp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingVscMaturedPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingVscMaturedPackets))
+		i--
+		dAtA[i] = 0x20
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeSinceLastVsc, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeSinceLastVsc):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlocksSinceLastVsc != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksSinceLastVsc))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LastAppliedVsc != nil {
+		{
+			size, err := m.LastAppliedVsc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashPacketRetryState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashPacketRetryState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashPacketRetryState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WaitingOnReply {
+		i--
+		if m.WaitingOnReply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextRetryTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextRetryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRetryTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastAttemptTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastAttemptTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastAttemptTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintQuery(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SendAttempts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SendAttempts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NextFeeDistributionEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextHeight))
+	}
+	l = len(m.DistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToConsumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.DenomDistributionFractions) > 0 {
+		for _, e := range m.DenomDistributionFractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Consumer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PacketDataQueue) > 0 {
+		for _, e := range m.PacketDataQueue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashPacketRetryStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashPacketRetryStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashPackets) > 0 {
+		for _, e := range m.SlashPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHistoricalEntriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHistoricalEntriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovQuery(uint64(m.HistoricalEntries))
+	}
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryProviderClientExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderClientExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Expired {
+		n += 2
+	}
+	if m.HaltHeight != 0 {
+		n += 1 + sovQuery(uint64(m.HaltHeight))
+	}
+	return n
+}
+
+func (m *QueryPendingVSCMaturitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingVSCMaturitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingMaturities) > 0 {
+		for _, e := range m.PendingMaturities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Counters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderUpgradeNoticeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderUpgradeNoticeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Notice != nil {
+		l = m.Notice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetStalenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryValsetStalenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastAppliedVsc != nil {
+		l = m.LastAppliedVsc.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlocksSinceLastVsc != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksSinceLastVsc))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeSinceLastVsc)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.PendingVscMaturedPackets != 0 {
+		n += 1 + sovQuery(uint64(m.PendingVscMaturedPackets))
+	}
+	if m.MaturitySendsBackedUp {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StalenessThreshold)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *SlashPacketRetryState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovQuery(uint64(m.Infraction))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovQuery(uint64(m.ValsetUpdateId))
+	}
+	if m.SendAttempts != 0 {
+		n += 1 + sovQuery(uint64(m.SendAttempts))
+	}
+	if m.LastAttemptTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastAttemptTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextRetryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRetryTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WaitingOnReply {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToConsumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomDistributionFractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomDistributionFractions = append(m.DenomDistributionFractions, types.DenomRedistributionFraction{})
+			if err := m.DenomDistributionFractions[len(m.DenomDistributionFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &NextFeeDistributionEstimate{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
+			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashPacketRetryStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashPacketRetryStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashPacketRetryStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashPacketRetryStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashPacketRetryStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashPacketRetryStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashPackets = append(m.SlashPackets, SlashPacketRetryState{})
+			if err := m.SlashPackets[len(m.SlashPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHistoricalEntriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHistoricalEntriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHistoricalEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+func (m *QueryHistoricalEntriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,17 +4237,17 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHistoricalEntriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHistoricalEntriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			m.CurrentHeight = 0
+			m.HistoricalEntries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1389,52 +4257,190 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentHeight |= int64(b&0x7F) << shift
+				m.HistoricalEntries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
-			}
-			m.LastHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
 				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
-			}
-			m.NextHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]int64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderClientExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderClientExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1462,13 +4468,13 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1478,29 +4484,30 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1510,29 +4517,17 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToProvider = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+			m.Expired = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
 			}
-			var stringLen uint64
+			m.HaltHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1542,24 +4537,11 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HaltHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ToConsumer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1581,7 +4563,7 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingVSCMaturitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,10 +4586,10 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingVSCMaturitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingVSCMaturitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1631,7 +4613,7 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingVSCMaturitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,15 +4636,15 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingVSCMaturitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingVSCMaturitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingMaturities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1689,10 +4671,41 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &NextFeeDistributionEstimate{}
+			m.PendingMaturities = append(m.PendingMaturities, MaturingVSCPacket{})
+			if err := m.PendingMaturities[len(m.PendingMaturities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1717,7 +4730,7 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderUpgradeNoticeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1740,10 +4753,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderUpgradeNoticeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderUpgradeNoticeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1767,7 +4780,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderUpgradeNoticeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,15 +4803,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderUpgradeNoticeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderUpgradeNoticeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,7 +4838,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Notice == nil {
+				m.Notice = &types.ProviderUpgradeNotice{}
+			}
+			if err := m.Notice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1850,7 +4866,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetStalenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,10 +4889,10 @@ func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetStalenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetStalenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1900,7 +4916,7 @@ func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetStalenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,15 +4939,15 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetStalenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetStalenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAppliedVsc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1958,13 +4974,35 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastAppliedVsc == nil {
+				m.LastAppliedVsc = &LastAppliedVSC{}
+			}
+			if err := m.LastAppliedVsc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksSinceLastVsc", wireType)
+			}
+			m.BlocksSinceLastVsc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksSinceLastVsc |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSinceLastVsc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1991,60 +5029,102 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeSinceLastVsc, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVscMaturedPackets", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.PendingVscMaturedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingVscMaturedPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturitySendsBackedUp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaturitySendsBackedUp = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalenessThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StalenessThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2066,7 +5146,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *SlashPacketRetryState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,15 +5169,104 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashPacketRetryState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashPacketRetryState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types1.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendAttempts", wireType)
+			}
+			m.SendAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2124,16 +5293,16 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SlashRecord == nil {
-				m.SlashRecord = &SlashRecord{}
+			if m.LastAttemptTime == nil {
+				m.LastAttemptTime = new(time.Time)
 			}
-			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastAttemptTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,11 +5329,33 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
-			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NextRetryTime == nil {
+				m.NextRetryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextRetryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOnReply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitingOnReply = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
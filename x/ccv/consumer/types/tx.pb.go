@@ -124,9 +124,108 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgApproveProviderClientSubstitute defines the message used by governance to approve
+// the substitution of the provider client with the given substitute client
+// (i.e., the recovery of the provider client via MsgRecoverClient)
+type MsgApproveProviderClientSubstitute struct {
+	// signer is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the client id of the client that is approved as substitute of the provider client
+	SubstituteClientId string `protobuf:"bytes,2,opt,name=substitute_client_id,json=substituteClientId,proto3" json:"substitute_client_id,omitempty"`
+}
+
+func (m *MsgApproveProviderClientSubstitute) Reset()         { *m = MsgApproveProviderClientSubstitute{} }
+func (m *MsgApproveProviderClientSubstitute) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveProviderClientSubstitute) ProtoMessage()    {}
+func (*MsgApproveProviderClientSubstitute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{2}
+}
+func (m *MsgApproveProviderClientSubstitute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveProviderClientSubstitute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveProviderClientSubstitute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveProviderClientSubstitute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveProviderClientSubstitute.Merge(m, src)
+}
+func (m *MsgApproveProviderClientSubstitute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveProviderClientSubstitute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveProviderClientSubstitute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveProviderClientSubstitute proto.InternalMessageInfo
+
+func (m *MsgApproveProviderClientSubstitute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgApproveProviderClientSubstitute) GetSubstituteClientId() string {
+	if m != nil {
+		return m.SubstituteClientId
+	}
+	return ""
+}
+
+type MsgApproveProviderClientSubstituteResponse struct {
+}
+
+func (m *MsgApproveProviderClientSubstituteResponse) Reset() {
+	*m = MsgApproveProviderClientSubstituteResponse{}
+}
+func (m *MsgApproveProviderClientSubstituteResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgApproveProviderClientSubstituteResponse) ProtoMessage() {}
+func (*MsgApproveProviderClientSubstituteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{3}
+}
+func (m *MsgApproveProviderClientSubstituteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveProviderClientSubstituteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveProviderClientSubstituteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveProviderClientSubstituteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveProviderClientSubstituteResponse.Merge(m, src)
+}
+func (m *MsgApproveProviderClientSubstituteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveProviderClientSubstituteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveProviderClientSubstituteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveProviderClientSubstituteResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgApproveProviderClientSubstitute)(nil), "interchain_security.ccv.consumer.v1.MsgApproveProviderClientSubstitute")
+	proto.RegisterType((*MsgApproveProviderClientSubstituteResponse)(nil), "interchain_security.ccv.consumer.v1.MsgApproveProviderClientSubstituteResponse")
 }
 
 func init() {
@@ -134,33 +233,38 @@ func init() {
 }
 
 var fileDescriptor_9d7049279494b73f = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0xcc, 0x2b, 0x49,
-	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x2f, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0xd4,
-	0x4f, 0x4e, 0x2e, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x2e, 0xcd, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0xc6, 0xa2, 0x5a, 0x2f, 0x39, 0xb9,
-	0x4c, 0x0f, 0xa6, 0x5a, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c,
-	0x42, 0xf4, 0x49, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x65, 0x45, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f,
-	0x91, 0x80, 0x70, 0xa0, 0x52, 0xe2, 0x10, 0x9e, 0x7e, 0x6e, 0x71, 0x3a, 0xc8, 0x79, 0xb9, 0xc5,
-	0xe9, 0x50, 0x09, 0x03, 0x5c, 0xbe, 0x29, 0x33, 0xd4, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0x89,
-	0x87, 0xbb, 0x14, 0xac, 0x43, 0x69, 0x31, 0x23, 0x17, 0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a,
-	0x62, 0x49, 0x6a, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x3e, 0x48, 0xb7, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22,
-	0x50, 0x37, 0x38, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07,
-	0x21, 0x94, 0x0a, 0x79, 0x70, 0xb1, 0x15, 0x80, 0x4d, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36,
-	0xd2, 0xd2, 0xc3, 0x15, 0x5c, 0x65, 0x86, 0x7a, 0xce, 0x50, 0x77, 0x40, 0xec, 0x74, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xdf, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0xc9, 0x4a,
-	0x92, 0x5c, 0xe2, 0x68, 0x8e, 0x0c, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x9a, 0xc8,
-	0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0xd4, 0xc4, 0xc8, 0xc5, 0x83, 0xe2, 0x0b, 0x13, 0x3d, 0x22,
-	0x22, 0x4b, 0x0f, 0xcd, 0x58, 0x29, 0x1b, 0x72, 0x74, 0xc1, 0x1c, 0x23, 0xc5, 0xda, 0xf0, 0x7c,
-	0x83, 0x16, 0xa3, 0x53, 0xf8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0xa3, 0x54, 0x1f, 0x61, 0x9f,
-	0x2e, 0x3c, 0xca, 0xca, 0xcc, 0xf4, 0x2b, 0x50, 0x53, 0x61, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0x38, 0xd2, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x96, 0x75, 0xd5, 0xb6, 0x02,
-	0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x55, 0x0b, 0x19, 0x45, 0x71, 0x09, 0x34, 0x0d, 0xb2, 0x2d, 0xf1, 0x52, 0x42,
+	0x3b, 0xd3, 0x54, 0xe9, 0x41, 0xf4, 0xd0, 0xf4, 0xa0, 0x1e, 0x82, 0x25, 0x45, 0x04, 0x2f, 0xcb,
+	0x64, 0x76, 0x98, 0x0c, 0x74, 0x67, 0x96, 0x79, 0xb3, 0x43, 0x7b, 0x93, 0x7e, 0x02, 0x3f, 0x81,
+	0x17, 0xbf, 0x40, 0x0f, 0xde, 0xfc, 0x02, 0xbd, 0x59, 0x3c, 0x79, 0x12, 0x49, 0x0e, 0xfd, 0x1a,
+	0x92, 0xdd, 0x4d, 0x42, 0xab, 0x25, 0xa5, 0xf4, 0x12, 0x66, 0xf2, 0xde, 0xff, 0xff, 0x7e, 0xef,
+	0xed, 0xbe, 0xc5, 0xeb, 0x4a, 0x3b, 0x61, 0xf9, 0x80, 0x29, 0x1d, 0x81, 0xe0, 0x99, 0x55, 0xee,
+	0x88, 0x72, 0xee, 0x29, 0x37, 0x1a, 0xb2, 0x44, 0x58, 0xea, 0xdb, 0xd4, 0x1d, 0x92, 0xd4, 0x1a,
+	0x67, 0x82, 0xa7, 0xff, 0xc9, 0x26, 0x9c, 0x7b, 0x32, 0xc9, 0x26, 0xbe, 0xdd, 0x78, 0xcc, 0x12,
+	0xa5, 0x0d, 0xcd, 0x7f, 0x0b, 0x5d, 0xe3, 0x89, 0x34, 0x46, 0x1e, 0x08, 0xca, 0x52, 0x45, 0x99,
+	0xd6, 0xc6, 0x31, 0xa7, 0x8c, 0x86, 0x32, 0x5a, 0x93, 0x46, 0x9a, 0xfc, 0x48, 0xc7, 0xa7, 0xf2,
+	0xdf, 0x65, 0x6e, 0x20, 0x31, 0x10, 0x15, 0x81, 0xe2, 0x52, 0x86, 0x96, 0x8a, 0x1b, 0x4d, 0x40,
+	0x8e, 0xf1, 0x12, 0x90, 0x65, 0x60, 0xf3, 0xaa, 0x6e, 0x7c, 0x9b, 0xc2, 0x80, 0x59, 0x11, 0x47,
+	0x53, 0xd2, 0x5c, 0xd1, 0xfc, 0x8a, 0xf0, 0xa3, 0x2e, 0xc8, 0xf7, 0x69, 0xcc, 0x9c, 0xd8, 0x63,
+	0x96, 0x25, 0x10, 0x6c, 0xe3, 0x2a, 0xcb, 0xdc, 0xc0, 0x8c, 0xd5, 0x75, 0xb4, 0x8a, 0xd6, 0xaa,
+	0x9d, 0xfa, 0xcf, 0x6f, 0x1b, 0xb5, 0x92, 0x61, 0x27, 0x8e, 0xad, 0x00, 0xd8, 0x77, 0x56, 0x69,
+	0xd9, 0x9b, 0xa5, 0x06, 0x6f, 0xf0, 0x62, 0x9a, 0x3b, 0xd4, 0x17, 0x56, 0xd1, 0xda, 0xfd, 0xad,
+	0x16, 0xb9, 0x6a, 0x5c, 0xbe, 0x4d, 0x76, 0x4b, 0x8e, 0xa2, 0x66, 0xe7, 0xee, 0xe9, 0xef, 0x95,
+	0x4a, 0xaf, 0xd4, 0xbf, 0x78, 0x78, 0x7c, 0x7e, 0xd2, 0x9a, 0x39, 0x37, 0x97, 0xf1, 0xd2, 0x25,
+	0xc8, 0x9e, 0x80, 0xd4, 0x68, 0x10, 0xcd, 0x2f, 0x08, 0x37, 0xbb, 0x20, 0x77, 0xd2, 0xd4, 0x1a,
+	0x2f, 0xf6, 0xac, 0xf1, 0x2a, 0x16, 0x76, 0xf7, 0x40, 0x09, 0xed, 0xf6, 0xb3, 0x3e, 0x38, 0xe5,
+	0x32, 0x27, 0x6e, 0xdc, 0xd3, 0x26, 0xae, 0xc1, 0xd4, 0x25, 0xe2, 0xb9, 0x6d, 0xa4, 0xe2, 0xbc,
+	0xc3, 0x6a, 0x2f, 0x98, 0xc5, 0x8a, 0x8a, 0x6f, 0xe3, 0x7f, 0xd8, 0xd7, 0x71, 0x6b, 0x3e, 0xdf,
+	0xa4, 0x9d, 0xad, 0x1f, 0x0b, 0xf8, 0x4e, 0x17, 0x64, 0x70, 0x8c, 0xf0, 0x83, 0x0b, 0x0f, 0xe5,
+	0x39, 0xb9, 0xc6, 0xbb, 0x47, 0x2e, 0x4d, 0xa9, 0xf1, 0xf2, 0x26, 0xaa, 0x09, 0x4c, 0xf0, 0x1d,
+	0xe1, 0x95, 0x79, 0x83, 0x7d, 0x7d, 0xdd, 0x0a, 0x73, 0x8c, 0x1a, 0xef, 0x6e, 0xc9, 0x68, 0x42,
+	0xdf, 0xb8, 0xf7, 0xe9, 0xfc, 0xa4, 0x85, 0x3a, 0x1f, 0x4e, 0x87, 0x21, 0x3a, 0x1b, 0x86, 0xe8,
+	0xcf, 0x30, 0x44, 0x9f, 0x47, 0x61, 0xe5, 0x6c, 0x14, 0x56, 0x7e, 0x8d, 0xc2, 0xca, 0xc7, 0x57,
+	0x52, 0xb9, 0x41, 0xd6, 0x27, 0xdc, 0x24, 0xe5, 0x7e, 0xd1, 0x19, 0xc2, 0xc6, 0x74, 0x7f, 0xfc,
+	0x36, 0x3d, 0xbc, 0xf8, 0x49, 0x70, 0x47, 0xa9, 0x80, 0xfe, 0x62, 0xbe, 0x41, 0xcf, 0xfe, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0x4b, 0xaa, 0x49, 0xa4, 0x43, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +280,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ApproveProviderClientSubstitute(ctx context.Context, in *MsgApproveProviderClientSubstitute, opts ...grpc.CallOption) (*MsgApproveProviderClientSubstituteResponse, error)
 }
 
 type msgClient struct {
@@ -195,9 +300,19 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ApproveProviderClientSubstitute(ctx context.Context, in *MsgApproveProviderClientSubstitute, opts ...grpc.CallOption) (*MsgApproveProviderClientSubstituteResponse, error) {
+	out := new(MsgApproveProviderClientSubstituteResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/ApproveProviderClientSubstitute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ApproveProviderClientSubstitute(context.Context, *MsgApproveProviderClientSubstitute) (*MsgApproveProviderClientSubstituteResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +322,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ApproveProviderClientSubstitute(ctx context.Context, req *MsgApproveProviderClientSubstitute) (*MsgApproveProviderClientSubstituteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProviderClientSubstitute not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +348,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ApproveProviderClientSubstitute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveProviderClientSubstitute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveProviderClientSubstitute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/ApproveProviderClientSubstitute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveProviderClientSubstitute(ctx, req.(*MsgApproveProviderClientSubstitute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "interchain_security.ccv.consumer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +374,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ApproveProviderClientSubstitute",
+			Handler:    _Msg_ApproveProviderClientSubstitute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "interchain_security/ccv/consumer/v1/tx.proto",
@@ -306,6 +446,66 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgApproveProviderClientSubstitute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveProviderClientSubstitute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveProviderClientSubstitute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubstituteClientId) > 0 {
+		i -= len(m.SubstituteClientId)
+		copy(dAtA[i:], m.SubstituteClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SubstituteClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveProviderClientSubstituteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveProviderClientSubstituteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveProviderClientSubstituteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +541,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgApproveProviderClientSubstitute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SubstituteClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveProviderClientSubstituteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -512,6 +738,170 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgApproveProviderClientSubstitute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveProviderClientSubstitute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveProviderClientSubstitute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubstituteClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubstituteClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveProviderClientSubstituteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveProviderClientSubstituteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveProviderClientSubstituteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
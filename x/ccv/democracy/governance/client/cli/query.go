@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+// NewQueryCmd returns a root CLI command handler for all democracy governance whitelist query commands.
+func NewQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the democracy governance whitelist",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdWhitelist(),
+	)
+
+	return cmd
+}
+
+func CmdWhitelist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist",
+		Short: "Query the proposal contents that can be passed through governance",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryWhitelist(cmd.Context(), &types.QueryWhitelistRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// QueryWhitelist returns the governance whitelist
+func (k Keeper) QueryWhitelist(c context.Context, req *types.QueryWhitelistRequest) (*types.QueryWhitelistResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryWhitelistResponse{Whitelist: k.GetWhitelist(ctx)}, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+// Keeper defines the democracy governance whitelist keeper, which stores the proposal
+// contents that the representatives of a democracy consumer chain may pass through governance
+type Keeper struct {
+	// the address capable of executing a MsgUpdateWhitelist message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+}
+
+// NewKeeper creates a new democracy governance whitelist Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		authority: authority,
+		storeKey:  key,
+		cdc:       cdc,
+	}
+}
+
+// GetAuthority returns the x/ccv/democracy/governance module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetWhitelist returns the governance whitelist
+func (k Keeper) GetWhitelist(ctx sdk.Context) types.Whitelist {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.WhitelistKey())
+	var whitelist types.Whitelist
+	if err := k.cdc.Unmarshal(bz, &whitelist); err != nil {
+		// An error here would indicate something is very wrong,
+		// the whitelist is validated before being stored.
+		panic(fmt.Errorf("failed to unmarshal governance whitelist: %w", err))
+	}
+	return whitelist
+}
+
+// SetWhitelist sets the governance whitelist
+func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.Whitelist) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&whitelist)
+	store.Set(types.WhitelistKey(), bz)
+}
+
+// IsModuleWhitelisted returns whether proposals can contain messages with the given type URL
+func (k Keeper) IsModuleWhitelisted(ctx sdk.Context, typeUrl string) bool {
+	for _, whitelistedTypeUrl := range k.GetWhitelist(ctx).MsgTypeUrls {
+		if whitelistedTypeUrl == typeUrl {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLegacyProposalWhitelisted returns whether the given legacy proposal content can be passed,
+// i.e., whether it is a ParameterChangeProposal that changes only whitelisted legacy params
+func (k Keeper) IsLegacyProposalWhitelisted(ctx sdk.Context, content govv1beta1.Content) bool {
+	switch c := content.(type) {
+	case *proposal.ParameterChangeProposal:
+		return k.isLegacyParamChangeWhitelisted(ctx, c.Changes)
+	default:
+		return false
+	}
+}
+
+func (k Keeper) isLegacyParamChangeWhitelisted(ctx sdk.Context, paramChanges []proposal.ParamChange) bool {
+	whitelisted := map[types.LegacyParamChange]struct{}{}
+	for _, paramChange := range k.GetWhitelist(ctx).LegacyParamChanges {
+		whitelisted[paramChange] = struct{}{}
+	}
+	for _, paramChange := range paramChanges {
+		if _, found := whitelisted[types.LegacyParamChange{Subspace: paramChange.Subspace, Key: paramChange.Key}]; !found {
+			return false
+		}
+	}
+	return true
+}
+
+// InitGenesis initializes the governance whitelist from the genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	k.SetWhitelist(ctx, genState.Whitelist)
+}
+
+// ExportGenesis returns the genesis state of the governance whitelist
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetWhitelist(ctx))
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+func setupWhitelistKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, key, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	return k, ctx
+}
+
+// TestWhitelistGenesis tests that the default genesis seeds the whitelist that used to be
+// fixed at compile time and that the whitelist is exported as initialized
+func TestWhitelistGenesis(t *testing.T) {
+	k, ctx := setupWhitelistKeeper(t)
+
+	genState := types.DefaultGenesisState()
+	require.NoError(t, genState.Validate())
+	k.InitGenesis(ctx, genState)
+	require.Equal(t, genState, k.ExportGenesis(ctx))
+
+	require.True(t, k.IsModuleWhitelisted(ctx, "/cosmos.mint.v1beta1.MsgUpdateParams"))
+	require.True(t, k.IsModuleWhitelisted(ctx, "/interchain_security.ccv.democracy.governance.v1.MsgUpdateWhitelist"))
+	require.False(t, k.IsModuleWhitelisted(ctx, "/cosmos.auth.v1beta1.MsgUpdateParams"))
+
+	require.True(t, k.IsLegacyProposalWhitelisted(ctx, &proposal.ParameterChangeProposal{
+		Changes: []proposal.ParamChange{{Subspace: banktypes.ModuleName, Key: "SendEnabled", Value: "true"}},
+	}))
+	require.False(t, k.IsLegacyProposalWhitelisted(ctx, &proposal.ParameterChangeProposal{
+		Changes: []proposal.ParamChange{
+			{Subspace: banktypes.ModuleName, Key: "SendEnabled", Value: "true"},
+			{Subspace: authtypes.ModuleName, Key: "MaxMemoCharacters", Value: "128"},
+		},
+	}))
+}
+
+// TestUpdateWhitelist tests that the whitelist can be updated at runtime by the authority only
+func TestUpdateWhitelist(t *testing.T) {
+	k, ctx := setupWhitelistKeeper(t)
+	k.InitGenesis(ctx, types.DefaultGenesisState())
+	msgServer := keeper.NewMsgServerImpl(&k)
+
+	authParamsTypeUrl := "/cosmos.auth.v1beta1.MsgUpdateParams"
+	newWhitelist := types.DefaultWhitelist()
+	newWhitelist.MsgTypeUrls = append(newWhitelist.MsgTypeUrls, authParamsTypeUrl)
+
+	testCases := []struct {
+		name      string
+		authority string
+		whitelist types.Whitelist
+		expErr    bool
+	}{
+		{
+			name:      "invalid authority",
+			authority: authtypes.NewModuleAddress("other").String(),
+			whitelist: newWhitelist,
+			expErr:    true,
+		},
+		{
+			name:      "invalid type URL",
+			authority: k.GetAuthority(),
+			whitelist: types.Whitelist{MsgTypeUrls: []string{"cosmos.auth.v1beta1.MsgUpdateParams"}},
+			expErr:    true,
+		},
+		{
+			name:      "duplicated type URL",
+			authority: k.GetAuthority(),
+			whitelist: types.Whitelist{MsgTypeUrls: []string{authParamsTypeUrl, authParamsTypeUrl}},
+			expErr:    true,
+		},
+		{
+			name:      "invalid legacy param change",
+			authority: k.GetAuthority(),
+			whitelist: types.Whitelist{LegacyParamChanges: []types.LegacyParamChange{{Subspace: banktypes.ModuleName}}},
+			expErr:    true,
+		},
+		{
+			name:      "valid update",
+			authority: k.GetAuthority(),
+			whitelist: newWhitelist,
+			expErr:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := msgServer.UpdateWhitelist(ctx, &types.MsgUpdateWhitelist{
+			Authority: tc.authority,
+			Whitelist: tc.whitelist,
+		})
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+			require.False(t, k.IsModuleWhitelisted(ctx, authParamsTypeUrl), tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.True(t, k.IsModuleWhitelisted(ctx, authParamsTypeUrl), tc.name)
+		}
+	}
+
+	res, err := k.QueryWhitelist(ctx, &types.QueryWhitelistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, newWhitelist, res.Whitelist)
+}
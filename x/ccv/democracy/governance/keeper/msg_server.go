@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the democracy governance whitelist MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateWhitelist replaces the governance whitelist.
+func (k msgServer) UpdateWhitelist(goCtx context.Context, msg *types.MsgUpdateWhitelist) (*types.MsgUpdateWhitelistResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Whitelist.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetWhitelist(ctx, msg.Whitelist)
+	k.Logger(ctx).Info("governance whitelist updated",
+		"msg type urls", len(msg.Whitelist.MsgTypeUrls),
+		"legacy param changes", len(msg.Whitelist.LegacyParamChanges),
+	)
+
+	return &types.MsgUpdateWhitelistResponse{}, nil
+}
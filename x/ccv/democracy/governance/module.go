@@ -35,8 +35,8 @@ type AppModule struct {
 	gov.AppModule
 
 	keeper                      govkeeper.Keeper
-	isLegacyProposalWhitelisted func(govv1beta1.Content) bool
-	isModuleWhiteList           func(string) bool
+	isLegacyProposalWhitelisted func(sdk.Context, govv1beta1.Content) bool
+	isModuleWhiteList           func(sdk.Context, string) bool
 }
 
 type ParamChangeKey struct {
@@ -48,9 +48,9 @@ func NewAppModule(cdc codec.Codec,
 	keeper govkeeper.Keeper,
 	ak govtypes.AccountKeeper,
 	bk govtypes.BankKeeper,
-	isProposalWhitelisted func(govv1beta1.Content) bool,
+	isProposalWhitelisted func(sdk.Context, govv1beta1.Content) bool,
 	ss govtypes.ParamSubspace,
-	isModuleWhiteList func(string) bool,
+	isModuleWhiteList func(sdk.Context, string) bool,
 ) AppModule {
 	govAppModule := gov.NewAppModule(cdc, &keeper, ak, bk, ss)
 	return AppModule{
@@ -94,12 +94,12 @@ func isProposalWhitelisted(ctx sdk.Context, am AppModule, proposal govv1.Proposa
 			if err != nil {
 				continue
 			}
-			if !am.isLegacyProposalWhitelisted(content) {
+			if !am.isLegacyProposalWhitelisted(ctx, content) {
 				// not whitelisted
 				return false
 			}
 			// not legacy gov proposal content
-		} else if !am.isModuleWhiteList(message.TypeUrl) {
+		} else if !am.isModuleWhiteList(ctx, message.TypeUrl) {
 			// not whitelisted
 			return false
 		}
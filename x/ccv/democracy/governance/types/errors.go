@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Democracy governance whitelist sentinel errors
+var (
+	ErrInvalidWhitelist = errorsmod.Register(ModuleName, 1, "invalid governance whitelist")
+)
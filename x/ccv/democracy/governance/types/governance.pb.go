@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchain_security/ccv/democracy/governance/v1/governance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Whitelist defines the proposal contents that the representatives of a democracy
+// consumer chain may pass through governance
+type Whitelist struct {
+	// the type URLs of the messages that proposals may contain
+	// (e.g., "/cosmos.mint.v1beta1.MsgUpdateParams")
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// the changes of legacy params that legacy ParameterChangeProposals may contain
+	LegacyParamChanges []LegacyParamChange `protobuf:"bytes,2,rep,name=legacy_param_changes,json=legacyParamChanges,proto3" json:"legacy_param_changes"`
+}
+
+func (m *Whitelist) Reset()         { *m = Whitelist{} }
+func (m *Whitelist) String() string { return proto.CompactTextString(m) }
+func (*Whitelist) ProtoMessage()    {}
+func (*Whitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_195dd9bc067cb765, []int{0}
+}
+func (m *Whitelist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Whitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Whitelist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Whitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Whitelist.Merge(m, src)
+}
+func (m *Whitelist) XXX_Size() int {
+	return m.Size()
+}
+func (m *Whitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_Whitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Whitelist proto.InternalMessageInfo
+
+func (m *Whitelist) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Whitelist) GetLegacyParamChanges() []LegacyParamChange {
+	if m != nil {
+		return m.LegacyParamChanges
+	}
+	return nil
+}
+
+// LegacyParamChange identifies a legacy param, i.e., a param of a params subspace
+type LegacyParamChange struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *LegacyParamChange) Reset()         { *m = LegacyParamChange{} }
+func (m *LegacyParamChange) String() string { return proto.CompactTextString(m) }
+func (*LegacyParamChange) ProtoMessage()    {}
+func (*LegacyParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_195dd9bc067cb765, []int{1}
+}
+func (m *LegacyParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyParamChange.Merge(m, src)
+}
+func (m *LegacyParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyParamChange proto.InternalMessageInfo
+
+func (m *LegacyParamChange) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *LegacyParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Whitelist)(nil), "interchain_security.ccv.democracy.governance.v1.Whitelist")
+	proto.RegisterType((*LegacyParamChange)(nil), "interchain_security.ccv.democracy.governance.v1.LegacyParamChange")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/democracy/governance/v1/governance.proto", fileDescriptor_195dd9bc067cb765)
+}
+
+var fileDescriptor_195dd9bc067cb765 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xfb, 0x30,
+	0x1c, 0xc6, 0x9b, 0xed, 0xc7, 0x0f, 0x97, 0x21, 0x68, 0xd9, 0xa1, 0xec, 0x50, 0xc7, 0x4e, 0xbb,
+	0x98, 0x30, 0x05, 0xcf, 0x3a, 0x4f, 0x82, 0x07, 0x19, 0x8a, 0xe0, 0xa5, 0x64, 0x5f, 0x43, 0x5a,
+	0x6c, 0x9b, 0x92, 0x6f, 0x5a, 0x8c, 0xaf, 0xc2, 0xf7, 0xe1, 0x1b, 0xd9, 0x71, 0x47, 0x4f, 0x22,
+	0xdb, 0x1b, 0x91, 0x56, 0x18, 0xc5, 0x3f, 0x07, 0x6f, 0xcf, 0x93, 0xe4, 0xf9, 0x10, 0x9e, 0x87,
+	0x9e, 0x26, 0xb9, 0x95, 0x06, 0x62, 0x91, 0xe4, 0x11, 0x4a, 0x28, 0x4d, 0x62, 0x1d, 0x07, 0xa8,
+	0xf8, 0xbd, 0xcc, 0x34, 0x18, 0x01, 0x8e, 0x2b, 0x5d, 0x49, 0x93, 0x8b, 0x1c, 0x24, 0xaf, 0xa6,
+	0x2d, 0xc7, 0x0a, 0xa3, 0xad, 0xf6, 0xf9, 0x0f, 0x04, 0x06, 0x50, 0xb1, 0x2d, 0x81, 0xb5, 0x32,
+	0xd5, 0x74, 0x38, 0x50, 0x5a, 0xe9, 0x26, 0xcb, 0x6b, 0xf5, 0x89, 0x19, 0xbf, 0x10, 0xda, 0xbb,
+	0x8d, 0x13, 0x2b, 0xd3, 0x04, 0xad, 0x3f, 0xa6, 0xbb, 0x19, 0xaa, 0xc8, 0xba, 0x42, 0x46, 0xa5,
+	0x49, 0x31, 0x20, 0xa3, 0xee, 0xa4, 0x37, 0xef, 0x67, 0xa8, 0xae, 0x5d, 0x21, 0x6f, 0x4c, 0x8a,
+	0xfe, 0x13, 0x1d, 0xa4, 0x52, 0x09, 0x70, 0x51, 0x21, 0x8c, 0xc8, 0x22, 0x88, 0x45, 0xae, 0x24,
+	0x06, 0x9d, 0x51, 0x77, 0xd2, 0x3f, 0x9a, 0xb1, 0x3f, 0xfe, 0x8b, 0x5d, 0x36, 0xb0, 0xab, 0x9a,
+	0x75, 0xde, 0xa0, 0x66, 0xff, 0x96, 0x6f, 0x07, 0xde, 0xdc, 0x4f, 0xbf, 0x5e, 0xe0, 0xf8, 0x8c,
+	0xee, 0x7f, 0x7b, 0xee, 0x0f, 0xe9, 0x0e, 0x96, 0x0b, 0x2c, 0x04, 0xc8, 0x80, 0x8c, 0xc8, 0xa4,
+	0x37, 0xdf, 0x7a, 0x7f, 0x8f, 0x76, 0x1f, 0xa4, 0x0b, 0x3a, 0xcd, 0x71, 0x2d, 0x67, 0xb0, 0x5c,
+	0x87, 0x64, 0xb5, 0x0e, 0xc9, 0xfb, 0x3a, 0x24, 0xcf, 0x9b, 0xd0, 0x5b, 0x6d, 0x42, 0xef, 0x75,
+	0x13, 0x7a, 0x77, 0x17, 0x2a, 0xb1, 0x71, 0xb9, 0x60, 0xa0, 0x33, 0x0e, 0x1a, 0x33, 0x8d, 0xad,
+	0x8e, 0x0f, 0xb7, 0x2b, 0x55, 0x27, 0xfc, 0xf1, 0xf7, 0xa9, 0xea, 0xea, 0x70, 0xf1, 0xbf, 0x29,
+	0xf7, 0xf8, 0x23, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x9f, 0x35, 0xcc, 0xe7, 0x01, 0x00, 0x00,
+}
+
+func (m *Whitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Whitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Whitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LegacyParamChanges) > 0 {
+		for iNdEx := len(m.LegacyParamChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LegacyParamChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintGovernance(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovernance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Whitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	if len(m.LegacyParamChanges) > 0 {
+		for _, e := range m.LegacyParamChanges {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LegacyParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func sovGovernance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovernance(x uint64) (n int) {
+	return sovGovernance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Whitelist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Whitelist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Whitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyParamChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LegacyParamChanges = append(m.LegacyParamChanges, LegacyParamChange{})
+			if err := m.LegacyParamChanges[len(m.LegacyParamChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovernance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovernance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovernance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovernance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovernance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovernance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovernance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName defines the democracy governance whitelist module name
+	ModuleName = "democracywhitelist"
+
+	// StoreKey is the store key string for the democracy governance whitelist
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the democracy governance whitelist
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the democracy governance whitelist
+	QuerierRoute = ModuleName
+)
+
+const (
+	// WhitelistByteKey is the byte key for storing the whitelist
+	WhitelistByteKey byte = iota
+)
+
+// WhitelistKey returns the key for storing the whitelist
+func WhitelistKey() []byte {
+	return []byte{WhitelistByteKey}
+}
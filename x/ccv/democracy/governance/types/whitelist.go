@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DefaultWhitelist returns the whitelist with which democracy consumer chains start,
+// i.e., the whitelist that used to be fixed at compile time in the democracy consumer app
+func DefaultWhitelist() Whitelist {
+	return Whitelist{
+		// add whitelisted module param update messages [cosmos-sdk >= 0.47]
+		MsgTypeUrls: []string{
+			"/cosmos.gov.v1.MsgUpdateParams",
+			"/cosmos.bank.v1beta1.MsgUpdateParams",
+			"/cosmos.staking.v1beta1.MsgUpdateParams",
+			"/cosmos.distribution.v1beta1.MsgUpdateParams",
+			"/cosmos.mint.v1beta1.MsgUpdateParams",
+			"/cosmos.gov.v1beta1.TextProposal",
+			"/ibc.applications.transfer.v1.MsgUpdateParams",
+			"/interchain_security.ccv.consumer.v1.MsgUpdateParams",
+			// the whitelist can only be updated through governance
+			"/interchain_security.ccv.democracy.governance.v1.MsgUpdateWhitelist",
+		},
+		// add whitelisted legacy parameters here [cosmos-sdk <= 0.47]
+		// note that most params have been moved to their respective modules
+		// and they cannot be changed through legacy governance proposals
+		LegacyParamChanges: []LegacyParamChange{
+			{Subspace: banktypes.ModuleName, Key: "SendEnabled"},
+		},
+	}
+}
+
+// Validate checks that the whitelist contains neither empty nor duplicated entries
+func (w Whitelist) Validate() error {
+	typeUrls := map[string]struct{}{}
+	for _, typeUrl := range w.MsgTypeUrls {
+		if !strings.HasPrefix(typeUrl, "/") || strings.TrimSpace(typeUrl) != typeUrl || len(typeUrl) == 1 {
+			return errorsmod.Wrapf(ErrInvalidWhitelist, "invalid message type URL (%s)", typeUrl)
+		}
+		if _, found := typeUrls[typeUrl]; found {
+			return errorsmod.Wrapf(ErrInvalidWhitelist, "duplicated message type URL (%s)", typeUrl)
+		}
+		typeUrls[typeUrl] = struct{}{}
+	}
+
+	paramChanges := map[LegacyParamChange]struct{}{}
+	for _, paramChange := range w.LegacyParamChanges {
+		if strings.TrimSpace(paramChange.Subspace) == "" || strings.TrimSpace(paramChange.Key) == "" {
+			return errorsmod.Wrapf(ErrInvalidWhitelist, "invalid legacy param change (%s)", paramChange)
+		}
+		if _, found := paramChanges[paramChange]; found {
+			return errorsmod.Wrapf(ErrInvalidWhitelist, "duplicated legacy param change (%s)", paramChange)
+		}
+		paramChanges[paramChange] = struct{}{}
+	}
+	return nil
+}
+
+// NewGenesisState creates a new democracy governance whitelist genesis state
+func NewGenesisState(whitelist Whitelist) *GenesisState {
+	return &GenesisState{Whitelist: whitelist}
+}
+
+// DefaultGenesisState returns the default genesis state, which contains the default whitelist
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultWhitelist())
+}
+
+// Validate performs basic genesis state validation
+func (gs GenesisState) Validate() error {
+	if err := gs.Whitelist.Validate(); err != nil {
+		return fmt.Errorf("invalid genesis whitelist: %w", err)
+	}
+	return nil
+}
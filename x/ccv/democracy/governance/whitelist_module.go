@@ -0,0 +1,131 @@
+package governance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/client/cli"
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/governance/types"
+)
+
+var (
+	_ module.AppModule           = (*WhitelistAppModule)(nil)
+	_ module.AppModuleBasic      = (*WhitelistAppModuleBasic)(nil)
+	_ module.HasABCIGenesis      = (*WhitelistAppModule)(nil)
+	_ module.HasName             = (*WhitelistAppModule)(nil)
+	_ module.HasConsensusVersion = (*WhitelistAppModule)(nil)
+	_ module.HasServices         = (*WhitelistAppModule)(nil)
+	_ appmodule.AppModule        = (*WhitelistAppModule)(nil)
+)
+
+// WhitelistAppModuleBasic is the AppModuleBasic of the democracy governance whitelist, i.e.,
+// the module state that defines which proposals the representatives may pass through governance
+type WhitelistAppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (WhitelistAppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec implements AppModuleBasic interface
+func (WhitelistAppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers module concrete types into protobuf Any.
+func (WhitelistAppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the democracy governance whitelist,
+// i.e., the whitelist that used to be fixed at compile time
+func (WhitelistAppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the democracy governance whitelist.
+func (WhitelistAppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the democracy governance whitelist.
+func (WhitelistAppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+}
+
+// GetTxCmd implements AppModuleBasic interface
+func (WhitelistAppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd implements AppModuleBasic interface
+func (WhitelistAppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.NewQueryCmd()
+}
+
+// WhitelistAppModule represents the AppModule of the democracy governance whitelist
+type WhitelistAppModule struct {
+	WhitelistAppModuleBasic
+	keeper keeper.Keeper
+}
+
+// NewWhitelistAppModule creates a new democracy governance whitelist module
+func NewWhitelistAppModule(k keeper.Keeper) WhitelistAppModule {
+	return WhitelistAppModule{
+		keeper: k,
+	}
+}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (WhitelistAppModule) IsAppModule() {}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (WhitelistAppModule) IsOnePerModuleType() {}
+
+// RegisterServices registers module services.
+func (am WhitelistAppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(&am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+}
+
+// InitGenesis performs genesis initialization for the democracy governance whitelist. It returns
+// no validator updates.
+func (am WhitelistAppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	am.keeper.InitGenesis(ctx, &genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the democracy governance whitelist.
+func (am WhitelistAppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := am.keeper.ExportGenesis(ctx)
+	return cdc.MustMarshalJSON(gs)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (WhitelistAppModule) ConsensusVersion() uint64 {
+	return 1
+}
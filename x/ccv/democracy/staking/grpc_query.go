@@ -0,0 +1,97 @@
+package staking
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/democracy/staking/types"
+)
+
+var _ types.QueryServer = Querier{}
+
+// Querier implements the democracy staking query service by joining the representatives
+// of the democracy staking keeper with the cross-chain validators of the consumer keeper
+type Querier struct {
+	stakingKeeper  *keeper.Keeper
+	consumerKeeper types.ConsumerKeeper
+}
+
+// NewQuerier creates a new democracy staking Querier
+func NewQuerier(stakingKeeper *keeper.Keeper, consumerKeeper types.ConsumerKeeper) Querier {
+	return Querier{
+		stakingKeeper:  stakingKeeper,
+		consumerKeeper: consumerKeeper,
+	}
+}
+
+// QueryRepresentatives returns the representatives of the democracy consumer chain
+// and the number of CCV validators, i.e., the actual block producers
+func (q Querier) QueryRepresentatives(goCtx context.Context, req *types.QueryRepresentativesRequest) (*types.QueryRepresentativesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validatorsResp, err := keeper.NewQuerier(q.stakingKeeper).Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	representatives := make([]types.Representative, 0, len(validatorsResp.Validators))
+	for _, validator := range validatorsResp.Validators {
+		representatives = append(representatives, types.Representative{
+			OperatorAddress: validator.OperatorAddress,
+			Moniker:         validator.Description.Moniker,
+			Status:          validator.Status,
+			Tokens:          validator.Tokens,
+			DelegatorShares: validator.DelegatorShares,
+			// representatives are only used for governance; the blocks are produced by the CCV validators
+			IsBlockProducer: false,
+		})
+	}
+
+	return &types.QueryRepresentativesResponse{
+		Representatives:   representatives,
+		CcvValidatorCount: uint32(len(q.consumerKeeper.GetAllCCValidator(ctx))),
+		Pagination:        validatorsResp.Pagination,
+	}, nil
+}
+
+// QueryRepresentativeConsumerKey returns whether the consensus key of a representative
+// is used by one of the CCV validators of the consumer chain
+func (q Querier) QueryRepresentativeConsumerKey(goCtx context.Context, req *types.QueryRepresentativeConsumerKeyRequest) (*types.QueryRepresentativeConsumerKeyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := q.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.OperatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operator address %s: %s", req.OperatorAddress, err)
+	}
+	validator, err := q.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "representative %s not found: %s", req.OperatorAddress, err)
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot get consensus address of representative %s: %s", req.OperatorAddress, err)
+	}
+
+	resp := types.QueryRepresentativeConsumerKeyResponse{
+		ConsensusAddress: sdk.ConsAddress(consAddr).String(),
+	}
+	if ccValidator, found := q.consumerKeeper.GetCCValidator(ctx, consAddr); found {
+		resp.HasConsumerKey = true
+		resp.CcvPower = ccValidator.Power
+	}
+	return &resp, nil
+}
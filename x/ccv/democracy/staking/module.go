@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -13,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+
+	democracytypes "github.com/cosmos/interchain-security/v6/x/ccv/democracy/staking/types"
 )
 
 // Note: for a democracy consumer, this "democracy staking" keeper is only for governance capabilities,
@@ -25,6 +30,7 @@ var (
 
 	_ module.HasABCIGenesis  = AppModule{}
 	_ module.HasABCIEndBlock = AppModule{}
+	_ module.HasServices     = AppModule{}
 )
 
 // AppModule embeds the Cosmos SDK's x/staking AppModuleBasic.
@@ -32,29 +38,58 @@ type AppModuleBasic struct {
 	staking.AppModuleBasic
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes of the underlying x/staking module
+// together with the ones of the democracy staking query service.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	err := democracytypes.RegisterQueryHandlerClient(context.Background(), mux, democracytypes.NewQueryClient(clientCtx))
+	if err != nil {
+		// same behavior as in cosmos-sdk
+		panic(err)
+	}
+}
+
 // AppModule embeds the Cosmos SDK's x/staking AppModule where we only override
 // specific methods.
 type AppModule struct {
 	// embed the Cosmos SDK's x/staking AppModule
 	staking.AppModule
 
-	keeper     keeper.Keeper
-	accKeeper  types.AccountKeeper
-	bankKeeper types.BankKeeper
+	keeper         keeper.Keeper
+	accKeeper      types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	consumerKeeper democracytypes.ConsumerKeeper
 }
 
 // NewAppModule creates a new AppModule object using the native x/staking module
 // AppModule constructor.
-func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, subspace exported.Subspace) AppModule {
+func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper,
+	ck democracytypes.ConsumerKeeper, subspace exported.Subspace,
+) AppModule {
 	stakingAppMod := staking.NewAppModule(cdc, keeper, ak, bk, subspace)
 	return AppModule{
-		AppModule:  stakingAppMod,
-		keeper:     *keeper,
-		accKeeper:  ak,
-		bankKeeper: bk,
+		AppModule:      stakingAppMod,
+		keeper:         *keeper,
+		accKeeper:      ak,
+		bankKeeper:     bk,
+		consumerKeeper: ck,
 	}
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes of the underlying x/staking module
+// together with the ones of the democracy staking query service.
+func (am AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	AppModuleBasic{am.AppModule.AppModuleBasic}.RegisterGRPCGatewayRoutes(clientCtx, mux)
+}
+
+// RegisterServices registers the services of the underlying x/staking module together with
+// the democracy staking query service, which exposes the representatives of the democracy
+// staking module alongside the CCV validators that actually produce the blocks.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	am.AppModule.RegisterServices(cfg)
+	democracytypes.RegisterQueryServer(cfg.QueryServer(), NewQuerier(&am.keeper, am.consumerKeeper))
+}
+
 // InitGenesis delegates the InitGenesis call to the underlying x/staking module,
 // however, it returns no validator updates as validators are tracked via the
 // consumer chain's x/cvv/consumer module and so this module is not responsible
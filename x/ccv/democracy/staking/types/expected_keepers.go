@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
+)
+
+// ConsumerKeeper defines the expected interface of the ccv consumer keeper,
+// i.e., the keeper of the cross-chain validators that produce the blocks of the consumer chain
+type ConsumerKeeper interface {
+	GetCCValidator(ctx sdk.Context, addr []byte) (consumertypes.CrossChainValidator, bool)
+	GetAllCCValidator(ctx sdk.Context) []consumertypes.CrossChainValidator
+}
@@ -0,0 +1,1598 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchain_security/ccv/democracy/staking/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryRepresentativesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRepresentativesRequest) Reset()         { *m = QueryRepresentativesRequest{} }
+func (m *QueryRepresentativesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativesRequest) ProtoMessage()    {}
+func (*QueryRepresentativesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80d45df53a098e7, []int{0}
+}
+func (m *QueryRepresentativesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativesRequest.Merge(m, src)
+}
+func (m *QueryRepresentativesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativesRequest proto.InternalMessageInfo
+
+func (m *QueryRepresentativesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRepresentativesResponse struct {
+	Representatives []Representative `protobuf:"bytes,1,rep,name=representatives,proto3" json:"representatives"`
+	// the number of validators in the CCV validator set, i.e., the actual block producers
+	CcvValidatorCount uint32              `protobuf:"varint,2,opt,name=ccv_validator_count,json=ccvValidatorCount,proto3" json:"ccv_validator_count,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRepresentativesResponse) Reset()         { *m = QueryRepresentativesResponse{} }
+func (m *QueryRepresentativesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativesResponse) ProtoMessage()    {}
+func (*QueryRepresentativesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80d45df53a098e7, []int{1}
+}
+func (m *QueryRepresentativesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativesResponse.Merge(m, src)
+}
+func (m *QueryRepresentativesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativesResponse proto.InternalMessageInfo
+
+func (m *QueryRepresentativesResponse) GetRepresentatives() []Representative {
+	if m != nil {
+		return m.Representatives
+	}
+	return nil
+}
+
+func (m *QueryRepresentativesResponse) GetCcvValidatorCount() uint32 {
+	if m != nil {
+		return m.CcvValidatorCount
+	}
+	return 0
+}
+
+func (m *QueryRepresentativesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// Representative is a validator of the democracy staking module. Representatives are only
+// used for governance and do not produce blocks, which is done by the CCV validators
+type Representative struct {
+	OperatorAddress string           `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	Moniker         string           `protobuf:"bytes,2,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	Status          types.BondStatus `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.staking.v1beta1.BondStatus" json:"status,omitempty"`
+	// the total amount of tokens delegated to the representative
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+	// the total delegator shares issued by the representative
+	DelegatorShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"delegator_shares"`
+	// always false, since representatives are not the block producers of the consumer chain
+	IsBlockProducer bool `protobuf:"varint,6,opt,name=is_block_producer,json=isBlockProducer,proto3" json:"is_block_producer,omitempty"`
+}
+
+func (m *Representative) Reset()         { *m = Representative{} }
+func (m *Representative) String() string { return proto.CompactTextString(m) }
+func (*Representative) ProtoMessage()    {}
+func (*Representative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80d45df53a098e7, []int{2}
+}
+func (m *Representative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Representative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Representative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Representative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Representative.Merge(m, src)
+}
+func (m *Representative) XXX_Size() int {
+	return m.Size()
+}
+func (m *Representative) XXX_DiscardUnknown() {
+	xxx_messageInfo_Representative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Representative proto.InternalMessageInfo
+
+func (m *Representative) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *Representative) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
+	}
+	return ""
+}
+
+func (m *Representative) GetStatus() types.BondStatus {
+	if m != nil {
+		return m.Status
+	}
+	return types.Unspecified
+}
+
+func (m *Representative) GetIsBlockProducer() bool {
+	if m != nil {
+		return m.IsBlockProducer
+	}
+	return false
+}
+
+type QueryRepresentativeConsumerKeyRequest struct {
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+}
+
+func (m *QueryRepresentativeConsumerKeyRequest) Reset()         { *m = QueryRepresentativeConsumerKeyRequest{} }
+func (m *QueryRepresentativeConsumerKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativeConsumerKeyRequest) ProtoMessage()    {}
+func (*QueryRepresentativeConsumerKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80d45df53a098e7, []int{3}
+}
+func (m *QueryRepresentativeConsumerKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativeConsumerKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativeConsumerKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativeConsumerKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativeConsumerKeyRequest.Merge(m, src)
+}
+func (m *QueryRepresentativeConsumerKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativeConsumerKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativeConsumerKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativeConsumerKeyRequest proto.InternalMessageInfo
+
+func (m *QueryRepresentativeConsumerKeyRequest) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+type QueryRepresentativeConsumerKeyResponse struct {
+	// whether the consensus key of the representative is used by a CCV validator
+	HasConsumerKey bool `protobuf:"varint,1,opt,name=has_consumer_key,json=hasConsumerKey,proto3" json:"has_consumer_key,omitempty"`
+	// the consensus address of the representative
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// the voting power of the CCV validator (zero if the representative has no consumer key)
+	CcvPower int64 `protobuf:"varint,3,opt,name=ccv_power,json=ccvPower,proto3" json:"ccv_power,omitempty"`
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) Reset() {
+	*m = QueryRepresentativeConsumerKeyResponse{}
+}
+func (m *QueryRepresentativeConsumerKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRepresentativeConsumerKeyResponse) ProtoMessage()    {}
+func (*QueryRepresentativeConsumerKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e80d45df53a098e7, []int{4}
+}
+func (m *QueryRepresentativeConsumerKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRepresentativeConsumerKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRepresentativeConsumerKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRepresentativeConsumerKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRepresentativeConsumerKeyResponse.Merge(m, src)
+}
+func (m *QueryRepresentativeConsumerKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRepresentativeConsumerKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRepresentativeConsumerKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRepresentativeConsumerKeyResponse proto.InternalMessageInfo
+
+func (m *QueryRepresentativeConsumerKeyResponse) GetHasConsumerKey() bool {
+	if m != nil {
+		return m.HasConsumerKey
+	}
+	return false
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) GetCcvPower() int64 {
+	if m != nil {
+		return m.CcvPower
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryRepresentativesRequest)(nil), "interchain_security.ccv.democracy.staking.v1.QueryRepresentativesRequest")
+	proto.RegisterType((*QueryRepresentativesResponse)(nil), "interchain_security.ccv.democracy.staking.v1.QueryRepresentativesResponse")
+	proto.RegisterType((*Representative)(nil), "interchain_security.ccv.democracy.staking.v1.Representative")
+	proto.RegisterType((*QueryRepresentativeConsumerKeyRequest)(nil), "interchain_security.ccv.democracy.staking.v1.QueryRepresentativeConsumerKeyRequest")
+	proto.RegisterType((*QueryRepresentativeConsumerKeyResponse)(nil), "interchain_security.ccv.democracy.staking.v1.QueryRepresentativeConsumerKeyResponse")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/democracy/staking/v1/query.proto", fileDescriptor_e80d45df53a098e7)
+}
+
+var fileDescriptor_e80d45df53a098e7 = []byte{
+	// 789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x23, 0x35,
+	0x18, 0xcd, 0x24, 0xdd, 0xd0, 0x7a, 0x45, 0x93, 0x9a, 0x45, 0x0a, 0xe9, 0x92, 0x0d, 0x11, 0x2c,
+	0xd1, 0x2e, 0x1d, 0x2b, 0x41, 0x42, 0x68, 0xb5, 0x17, 0xd2, 0xd5, 0xa2, 0xc2, 0x1e, 0xca, 0x04,
+	0x71, 0x58, 0xad, 0x14, 0x1c, 0xcf, 0xa7, 0xc9, 0x28, 0x89, 0x3d, 0xb5, 0x3d, 0x03, 0x11, 0xe2,
+	0x02, 0xe2, 0x8e, 0xc4, 0x95, 0xbf, 0x80, 0xb8, 0xf4, 0x47, 0xf4, 0x58, 0x95, 0x0b, 0xe2, 0x50,
+	0xa1, 0x96, 0x3f, 0xc0, 0x3f, 0x40, 0xe3, 0x71, 0xd2, 0xa4, 0x0d, 0x2d, 0x65, 0x7b, 0xcb, 0xf8,
+	0xfb, 0xde, 0xfb, 0x9e, 0x9f, 0x9f, 0x1d, 0xf4, 0x61, 0xc8, 0x35, 0x48, 0x36, 0xa0, 0x21, 0xef,
+	0x29, 0x60, 0xb1, 0x0c, 0xf5, 0x84, 0x30, 0x96, 0x10, 0x1f, 0xc6, 0x82, 0x49, 0xca, 0x26, 0x44,
+	0x69, 0x3a, 0x0c, 0x79, 0x40, 0x92, 0x16, 0xd9, 0x8b, 0x41, 0x4e, 0xdc, 0x48, 0x0a, 0x2d, 0xf0,
+	0x7b, 0x4b, 0x90, 0x2e, 0x63, 0x89, 0x3b, 0x43, 0xba, 0x16, 0xe9, 0x26, 0xad, 0xea, 0x9d, 0x40,
+	0x04, 0xc2, 0x00, 0x49, 0xfa, 0x2b, 0xe3, 0xa8, 0xde, 0x0d, 0x84, 0x08, 0x46, 0x40, 0x68, 0x14,
+	0x12, 0xca, 0xb9, 0xd0, 0x54, 0x87, 0x82, 0x2b, 0x5b, 0x7d, 0xc0, 0x84, 0x1a, 0x0b, 0x45, 0xfa,
+	0x54, 0x41, 0x36, 0x9a, 0x24, 0xad, 0x3e, 0x68, 0xda, 0x22, 0x11, 0x0d, 0x42, 0x6e, 0x9a, 0x6d,
+	0xef, 0xdb, 0xb6, 0xf7, 0x4c, 0x6c, 0xd6, 0x38, 0x95, 0x90, 0x75, 0xbd, 0x91, 0x75, 0xf5, 0x32,
+	0x21, 0xd9, 0x47, 0x56, 0x6a, 0x00, 0xda, 0xfc, 0x2c, 0x1d, 0xe1, 0x41, 0x24, 0x41, 0x01, 0x4f,
+	0xa5, 0x24, 0xa0, 0x3c, 0xd8, 0x8b, 0x41, 0x69, 0xfc, 0x14, 0xa1, 0xb3, 0x99, 0x15, 0xa7, 0xee,
+	0x34, 0x6f, 0xb7, 0xef, 0xbb, 0x96, 0x21, 0x15, 0xe8, 0x66, 0xde, 0xd8, 0xb9, 0xee, 0x2e, 0x0d,
+	0xc0, 0x62, 0xbd, 0x39, 0x64, 0xe3, 0x87, 0x3c, 0xba, 0xbb, 0x7c, 0x8e, 0x8a, 0x04, 0x57, 0x80,
+	0x47, 0xa8, 0x24, 0x17, 0x4b, 0x15, 0xa7, 0x5e, 0x68, 0xde, 0x6e, 0x3f, 0x76, 0xaf, 0x63, 0xb8,
+	0xbb, 0xc8, 0xdf, 0x59, 0x39, 0x38, 0xbe, 0x97, 0xf3, 0xce, 0x53, 0x63, 0x17, 0xbd, 0xc6, 0x58,
+	0xd2, 0x4b, 0xe8, 0x28, 0xf4, 0xa9, 0x16, 0xb2, 0xc7, 0x44, 0xcc, 0x75, 0x25, 0x5f, 0x77, 0x9a,
+	0xaf, 0x7a, 0x1b, 0x8c, 0x25, 0x5f, 0x4c, 0x2b, 0xdb, 0x69, 0x01, 0x7f, 0xbc, 0x60, 0x43, 0xc1,
+	0xd8, 0xf0, 0xee, 0x95, 0x36, 0x64, 0x5b, 0x5b, 0xf0, 0xe1, 0xfb, 0x02, 0x5a, 0x5f, 0x94, 0x88,
+	0x9f, 0xa1, 0xb2, 0x88, 0x40, 0x1a, 0x19, 0xd4, 0xf7, 0x25, 0x28, 0x65, 0x8c, 0x5e, 0xeb, 0xbc,
+	0x75, 0xb4, 0xbf, 0xf5, 0xa6, 0x1d, 0x32, 0x13, 0xf4, 0x51, 0xd6, 0xd2, 0xd5, 0x32, 0xe4, 0x81,
+	0x57, 0x9a, 0x42, 0xed, 0x32, 0xae, 0xa0, 0x57, 0xc6, 0x82, 0x87, 0x43, 0x90, 0x66, 0x37, 0x6b,
+	0xde, 0xf4, 0x13, 0x3f, 0x42, 0x45, 0xa5, 0xa9, 0x8e, 0x95, 0xd1, 0xbf, 0xde, 0x6e, 0x4c, 0xf5,
+	0x9f, 0xb9, 0x97, 0x89, 0xef, 0x08, 0xee, 0x77, 0x4d, 0xa7, 0x67, 0x11, 0x78, 0x1b, 0x15, 0xb5,
+	0x18, 0x02, 0x57, 0x95, 0x15, 0xa3, 0xec, 0x61, 0x6a, 0xeb, 0x1f, 0xc7, 0xf7, 0x5e, 0xcf, 0x28,
+	0x94, 0x3f, 0x74, 0x43, 0x41, 0xc6, 0x54, 0x0f, 0xdc, 0x1d, 0xae, 0x8f, 0xf6, 0xb7, 0x90, 0xe5,
+	0xde, 0xe1, 0xda, 0xb3, 0x50, 0xfc, 0x02, 0x95, 0x7d, 0x18, 0x41, 0x60, 0x76, 0xaa, 0x06, 0x54,
+	0x82, 0xaa, 0xdc, 0x32, 0x74, 0x2d, 0x4b, 0xb7, 0x79, 0x91, 0xee, 0x19, 0x04, 0x94, 0x4d, 0x9e,
+	0x00, 0x9b, 0x23, 0x7d, 0x02, 0xcc, 0x2b, 0xcd, 0xa8, 0xba, 0x86, 0x09, 0x3f, 0x40, 0x1b, 0xa1,
+	0xea, 0xf5, 0x47, 0x82, 0x0d, 0xd3, 0x9c, 0xfb, 0x31, 0x03, 0x59, 0x29, 0xd6, 0x9d, 0xe6, 0xaa,
+	0x57, 0x0a, 0x55, 0x27, 0x5d, 0xdf, 0xb5, 0xcb, 0x8d, 0x18, 0xbd, 0xb3, 0x24, 0x8c, 0xdb, 0x82,
+	0xab, 0x78, 0x0c, 0xf2, 0x53, 0x98, 0x4c, 0xe3, 0x7f, 0xa3, 0x67, 0xd3, 0xf8, 0xd9, 0x41, 0xf7,
+	0xaf, 0x9a, 0x6b, 0xaf, 0x43, 0x13, 0x95, 0x07, 0x54, 0xf5, 0x98, 0x2d, 0xf5, 0x86, 0x30, 0x31,
+	0x83, 0x57, 0xbd, 0xf5, 0x01, 0x55, 0x73, 0x08, 0xfc, 0x10, 0x6d, 0xa4, 0x5d, 0xc0, 0x55, 0xac,
+	0x66, 0x1a, 0xb3, 0xa3, 0x2f, 0xcf, 0x0a, 0xd3, 0x74, 0x6c, 0xa2, 0xb5, 0x34, 0xf7, 0x91, 0xf8,
+	0x0a, 0xa4, 0x89, 0x41, 0xc1, 0x5b, 0x65, 0x2c, 0xd9, 0x4d, 0xbf, 0xdb, 0xbf, 0xac, 0xa0, 0x5b,
+	0x46, 0x1e, 0xfe, 0xdb, 0x41, 0x77, 0x96, 0xdd, 0x56, 0xbc, 0x73, 0xbd, 0xcb, 0x78, 0xc9, 0xcb,
+	0x52, 0xfd, 0xe4, 0x26, 0xa8, 0x32, 0xb7, 0x1a, 0x9d, 0xef, 0x7e, 0xfb, 0xeb, 0xa7, 0xfc, 0x63,
+	0xfc, 0x88, 0xfc, 0xf7, 0x67, 0xfd, 0xfc, 0x93, 0xf0, 0x6b, 0x1e, 0xd5, 0x2e, 0x3f, 0x1c, 0xdc,
+	0x7d, 0x69, 0xc9, 0x17, 0x23, 0x56, 0xfd, 0xfc, 0x66, 0x49, 0xad, 0x23, 0x7d, 0xe3, 0xc8, 0x0b,
+	0xfc, 0xfc, 0xff, 0x3b, 0x42, 0xbe, 0x39, 0x1f, 0xfd, 0x6f, 0xc9, 0x7c, 0x1e, 0x3b, 0x5f, 0x1e,
+	0x9c, 0xd4, 0x9c, 0xc3, 0x93, 0x9a, 0xf3, 0xe7, 0x49, 0xcd, 0xf9, 0xf1, 0xb4, 0x96, 0x3b, 0x3c,
+	0xad, 0xe5, 0x7e, 0x3f, 0xad, 0xe5, 0x9e, 0x3f, 0x0d, 0x42, 0x3d, 0x88, 0xfb, 0x2e, 0x13, 0x63,
+	0xfb, 0x6f, 0x33, 0x27, 0x63, 0x6b, 0x26, 0x23, 0xf9, 0x80, 0x7c, 0xfd, 0x2f, 0x5a, 0xf4, 0x24,
+	0x02, 0xd5, 0x2f, 0x9a, 0xff, 0xa8, 0xf7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xf7, 0xa0,
+	0x56, 0xae, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// QueryRepresentatives returns the representatives of the democracy consumer chain,
+	// i.e., the validators of the democracy staking module, together with the number
+	// of CCV validators that actually produce the blocks of the chain
+	QueryRepresentatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error)
+	// QueryRepresentativeConsumerKey returns whether the operator of a representative
+	// also runs a CCV validator on the consumer chain, i.e., whether the consensus key
+	// of the representative is in the CCV validator set
+	QueryRepresentativeConsumerKey(ctx context.Context, in *QueryRepresentativeConsumerKeyRequest, opts ...grpc.CallOption) (*QueryRepresentativeConsumerKeyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryRepresentatives(ctx context.Context, in *QueryRepresentativesRequest, opts ...grpc.CallOption) (*QueryRepresentativesResponse, error) {
+	out := new(QueryRepresentativesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.democracy.staking.v1.Query/QueryRepresentatives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRepresentativeConsumerKey(ctx context.Context, in *QueryRepresentativeConsumerKeyRequest, opts ...grpc.CallOption) (*QueryRepresentativeConsumerKeyResponse, error) {
+	out := new(QueryRepresentativeConsumerKeyResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.democracy.staking.v1.Query/QueryRepresentativeConsumerKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// QueryRepresentatives returns the representatives of the democracy consumer chain,
+	// i.e., the validators of the democracy staking module, together with the number
+	// of CCV validators that actually produce the blocks of the chain
+	QueryRepresentatives(context.Context, *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error)
+	// QueryRepresentativeConsumerKey returns whether the operator of a representative
+	// also runs a CCV validator on the consumer chain, i.e., whether the consensus key
+	// of the representative is in the CCV validator set
+	QueryRepresentativeConsumerKey(context.Context, *QueryRepresentativeConsumerKeyRequest) (*QueryRepresentativeConsumerKeyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryRepresentatives(ctx context.Context, req *QueryRepresentativesRequest) (*QueryRepresentativesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRepresentatives not implemented")
+}
+func (*UnimplementedQueryServer) QueryRepresentativeConsumerKey(ctx context.Context, req *QueryRepresentativeConsumerKeyRequest) (*QueryRepresentativeConsumerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRepresentativeConsumerKey not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryRepresentatives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRepresentatives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.democracy.staking.v1.Query/QueryRepresentatives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRepresentatives(ctx, req.(*QueryRepresentativesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRepresentativeConsumerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRepresentativeConsumerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRepresentativeConsumerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.democracy.staking.v1.Query/QueryRepresentativeConsumerKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRepresentativeConsumerKey(ctx, req.(*QueryRepresentativeConsumerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.democracy.staking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryRepresentatives",
+			Handler:    _Query_QueryRepresentatives_Handler,
+		},
+		{
+			MethodName: "QueryRepresentativeConsumerKey",
+			Handler:    _Query_QueryRepresentativeConsumerKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/democracy/staking/v1/query.proto",
+}
+
+func (m *QueryRepresentativesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CcvValidatorCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CcvValidatorCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Representatives) > 0 {
+		for iNdEx := len(m.Representatives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Representatives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Representative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Representative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Representative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsBlockProducer {
+		i--
+		if m.IsBlockProducer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativeConsumerKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativeConsumerKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativeConsumerKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CcvPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CcvPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasConsumerKey {
+		i--
+		if m.HasConsumerKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRepresentativesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRepresentativesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Representatives) > 0 {
+		for _, e := range m.Representatives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CcvValidatorCount != 0 {
+		n += 1 + sovQuery(uint64(m.CcvValidatorCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Representative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsBlockProducer {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRepresentativeConsumerKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRepresentativeConsumerKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasConsumerKey {
+		n += 2
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CcvPower != 0 {
+		n += 1 + sovQuery(uint64(m.CcvPower))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRepresentativesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRepresentativesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRepresentativesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRepresentativesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRepresentativesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRepresentativesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Representatives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Representatives = append(m.Representatives, Representative{})
+			if err := m.Representatives[len(m.Representatives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvValidatorCount", wireType)
+			}
+			m.CcvValidatorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CcvValidatorCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Representative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Representative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Representative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBlockProducer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBlockProducer = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRepresentativeConsumerKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRepresentativeConsumerKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRepresentativeConsumerKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRepresentativeConsumerKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRepresentativeConsumerKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRepresentativeConsumerKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasConsumerKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasConsumerKey = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvPower", wireType)
+			}
+			m.CcvPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CcvPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
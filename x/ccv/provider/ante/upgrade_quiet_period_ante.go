@@ -0,0 +1,100 @@
+package ante
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+type (
+	// ProviderKeeper defines the interface required by the upgrade quiet period decorator
+	// from the provider module keeper.
+	ProviderKeeper interface {
+		GetUpgradeQuietPeriod(ctx sdk.Context) int64
+	}
+
+	// UpgradeKeeper defines the interface required by the upgrade quiet period decorator
+	// from the upgrade module keeper.
+	UpgradeKeeper interface {
+		GetUpgradePlan(ctx context.Context) (upgradetypes.Plan, error)
+	}
+
+	// UpgradeQuietPeriodDecorator defines an AnteHandler decorator that rejects consumer lifecycle
+	// messages (i.e., MsgCreateConsumer, MsgUpdateConsumer, MsgRemoveConsumer and MsgConsumerHardFork)
+	// if an upgrade plan is scheduled within the `UpgradeQuietPeriod` param number of blocks.
+	// This prevents, for example, consumer launches from being scheduled in the middle of the upgrade.
+	// The messages nested in authz MsgExec messages are checked as well.
+	// Note that the other provider messages (e.g., MsgOptIn, MsgOptOut and MsgAssignConsumerKey) are allowed.
+	UpgradeQuietPeriodDecorator struct {
+		providerKeeper ProviderKeeper
+		upgradeKeeper  UpgradeKeeper
+	}
+)
+
+func NewUpgradeQuietPeriodDecorator(providerKeeper ProviderKeeper, upgradeKeeper UpgradeKeeper) UpgradeQuietPeriodDecorator {
+	return UpgradeQuietPeriodDecorator{
+		providerKeeper: providerKeeper,
+		upgradeKeeper:  upgradeKeeper,
+	}
+}
+
+func (uqpd UpgradeQuietPeriodDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	msgTypeURL, found := getConsumerLifecycleMsg(tx.GetMsgs())
+	if !found {
+		return next(ctx, tx, simulate)
+	}
+
+	quietPeriod := uqpd.providerKeeper.GetUpgradeQuietPeriod(ctx)
+	if quietPeriod == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	plan, err := uqpd.upgradeKeeper.GetUpgradePlan(ctx)
+	if errors.Is(err, upgradetypes.ErrNoUpgradePlanFound) {
+		return next(ctx, tx, simulate)
+	}
+	if err != nil {
+		return ctx, err
+	}
+
+	if plan.Height-ctx.BlockHeight() <= quietPeriod {
+		return ctx, errorsmod.Wrapf(providertypes.ErrUpgradeQuietPeriod,
+			"%s cannot be submitted at height %d, since upgrade plan %s is scheduled at height %d (upgrade quiet period: %d blocks)",
+			msgTypeURL, ctx.BlockHeight(), plan.Name, plan.Height, quietPeriod)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getConsumerLifecycleMsg returns the type URL of the first consumer lifecycle message in `msgs`,
+// if any, and whether such a message was found. The messages nested in authz MsgExec messages
+// are checked as well, so that the quiet period cannot be bypassed by executing the messages
+// on behalf of another account.
+func getConsumerLifecycleMsg(msgs []sdk.Msg) (string, bool) {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *providertypes.MsgCreateConsumer,
+			*providertypes.MsgUpdateConsumer,
+			*providertypes.MsgRemoveConsumer,
+			*providertypes.MsgConsumerHardFork:
+			return sdk.MsgTypeURL(msg), true
+		case *authz.MsgExec:
+			nestedMsgs, err := msg.GetMessages()
+			if err != nil {
+				// the nested messages cannot be executed either
+				continue
+			}
+			if msgTypeURL, found := getConsumerLifecycleMsg(nestedMsgs); found {
+				return msgTypeURL, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,202 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	appencoding "github.com/cosmos/interchain-security/v6/app/encoding"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/ante"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+type providerKeeper struct {
+	upgradeQuietPeriod int64
+}
+
+func (k providerKeeper) GetUpgradeQuietPeriod(_ sdk.Context) int64 {
+	return k.upgradeQuietPeriod
+}
+
+type upgradeKeeper struct {
+	plan *upgradetypes.Plan
+}
+
+func (k upgradeKeeper) GetUpgradePlan(_ context.Context) (upgradetypes.Plan, error) {
+	if k.plan == nil {
+		return upgradetypes.Plan{}, upgradetypes.ErrNoUpgradePlanFound
+	}
+	return *k.plan, nil
+}
+
+func noOpAnteDecorator() sdk.AnteHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+}
+
+func TestUpgradeQuietPeriodDecorator(t *testing.T) {
+	txCfg := appencoding.MakeTestEncodingConfig().TxConfig
+
+	quietPeriod := int64(100)
+	plan := &upgradetypes.Plan{Name: "v7", Height: 1000}
+	authzMsgExecCreateConsumer := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&providertypes.MsgCreateConsumer{}})
+	nestedAuthzMsgExecCreateConsumer := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&authzMsgExecCreateConsumer})
+	authzMsgExecOptIn := authz.NewMsgExec(sdk.AccAddress{}, []sdk.Msg{&providertypes.MsgOptIn{}})
+
+	testCases := []struct {
+		name        string
+		blockHeight int64
+		quietPeriod int64
+		plan        *upgradetypes.Plan
+		msgs        []sdk.Msg
+		expectErr   bool
+	}{
+		{
+			name:        "no pending upgrade plan",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        nil,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   false,
+		},
+		{
+			name:        "MsgCreateConsumer during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   true,
+		},
+		{
+			name:        "MsgUpdateConsumer during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgUpdateConsumer{}},
+			expectErr:   true,
+		},
+		{
+			name:        "MsgRemoveConsumer during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgRemoveConsumer{}},
+			expectErr:   true,
+		},
+		{
+			name:        "MsgConsumerHardFork during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgConsumerHardFork{}},
+			expectErr:   true,
+		},
+		{
+			name:        "consumer lifecycle message among other messages during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&banktypes.MsgSend{}, &providertypes.MsgUpdateConsumer{}},
+			expectErr:   true,
+		},
+		{
+			name:        "consumer lifecycle message in authz MsgExec during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&authzMsgExecCreateConsumer},
+			expectErr:   true,
+		},
+		{
+			name:        "consumer lifecycle message in nested authz MsgExec during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&nestedAuthzMsgExecCreateConsumer},
+			expectErr:   true,
+		},
+		{
+			name:        "authz MsgExec without consumer lifecycle messages during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&authzMsgExecOptIn},
+			expectErr:   false,
+		},
+		{
+			name:        "opt-in, opt-out and key assignment during the quiet period",
+			blockHeight: 950,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs: []sdk.Msg{
+				&providertypes.MsgOptIn{},
+				&providertypes.MsgOptOut{},
+				&providertypes.MsgAssignConsumerKey{},
+			},
+			expectErr: false,
+		},
+		{
+			name:        "quiet period disabled",
+			blockHeight: 950,
+			quietPeriod: 0,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   false,
+		},
+		{
+			name:        "one block before the quiet period",
+			blockHeight: plan.Height - quietPeriod - 1,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   false,
+		},
+		{
+			name:        "first block of the quiet period",
+			blockHeight: plan.Height - quietPeriod,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   true,
+		},
+		{
+			name:        "last block before the upgrade",
+			blockHeight: plan.Height - 1,
+			quietPeriod: quietPeriod,
+			plan:        plan,
+			msgs:        []sdk.Msg{&providertypes.MsgCreateConsumer{}},
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			handler := ante.NewUpgradeQuietPeriodDecorator(
+				providerKeeper{upgradeQuietPeriod: tc.quietPeriod},
+				upgradeKeeper{plan: tc.plan},
+			)
+
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			ctx := sdk.Context{}.WithBlockHeight(tc.blockHeight)
+			_, err := handler.AnteHandle(ctx, txBuilder.GetTx(), false, noOpAnteDecorator())
+			if tc.expectErr {
+				require.ErrorIs(t, err, providertypes.ErrUpgradeQuietPeriod)
+				require.ErrorContains(t, err, tc.plan.Name)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,19 +1,40 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/simulation"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+const (
+	// FlagIncludeDeleted is the flag for including the deleted consumer chains in a query
+	FlagIncludeDeleted = "include-deleted"
+	// FlagOutputDir is the flag for the directory to which the consumer genesis states are written
+	FlagOutputDir = "output-dir"
+	// FlagTargetVersion is the flag for the consumer genesis version to which a consumer genesis state is transformed
+	FlagTargetVersion = "target-version"
+	// FlagStartHeight is the flag for the first provider height of a height range
+	FlagStartHeight = "start-height"
+	// FlagEndHeight is the flag for the last provider height of a height range
+	FlagEndHeight = "end-height"
 )
 
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
@@ -27,6 +48,8 @@ func NewQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdConsumerGenesis())
+	cmd.AddCommand(CmdConsumerGenesisBatch())
+	cmd.AddCommand(CmdTransformConsumerGenesis())
 	cmd.AddCommand(CmdConsumerChains())
 	cmd.AddCommand(CmdConsumerValidatorKeyAssignment())
 	cmd.AddCommand(CmdProviderValidatorKey())
@@ -39,8 +62,37 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdConsumerChainsValidatorHasToValidate())
 	cmd.AddCommand(CmdValidatorConsumerCommissionRate())
 	cmd.AddCommand(CmdBlocksUntilNextEpoch())
+	cmd.AddCommand(CmdNextEpoch())
 	cmd.AddCommand(CmdConsumerIdFromClientId())
 	cmd.AddCommand(CmdConsumerChain())
+	cmd.AddCommand(CmdValidatorProviderExposure())
+	cmd.AddCommand(CmdConsumerTopology())
+	cmd.AddCommand(CmdVscIdToHeight())
+	cmd.AddCommand(CmdConsumerRewardChannel())
+	cmd.AddCommand(CmdConsumerEndpoints())
+	cmd.AddCommand(CmdSecurityOverview())
+	cmd.AddCommand(CmdConsumersByOwner())
+	cmd.AddCommand(CmdConsumerChainIdConflicts())
+	cmd.AddCommand(CmdConsumerChainsCarryingValidator())
+	cmd.AddCommand(CmdConsumerLaunchChecklist())
+	cmd.AddCommand(CmdConsumerChainsFullDump())
+	cmd.AddCommand(CmdConsumerValidatorSetAtHeight())
+	cmd.AddCommand(CmdConsumerDump())
+	cmd.AddCommand(CmdRewardAttributionLog())
+	cmd.AddCommand(CmdConsumerClientStatus())
+	cmd.AddCommand(CmdTimeQueue())
+	cmd.AddCommand(CmdDumpStoreKeys())
+	cmd.AddCommand(CmdSkippedDowntimeSlashes())
+	cmd.AddCommand(CmdValidatorConsumerAvailability())
+	cmd.AddCommand(CmdEmergencyOptOutCooldown())
+	cmd.AddCommand(CmdReOptInCooldown())
+	cmd.AddCommand(CmdUnroutableSlashPackets())
+	cmd.AddCommand(CmdPreOptInInfractions())
+	cmd.AddCommand(CmdTopNThreshold())
+	cmd.AddCommand(CmdConsumerEconomicSecurity())
+	cmd.AddCommand(CmdNextConsumerId())
+	cmd.AddCommand(CmdSimulateCreateConsumer())
+	cmd.AddCommand(CmdPendingVSCPackets())
 	return cmd
 }
 
@@ -50,7 +102,17 @@ func CmdConsumerGenesis() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consumer-genesis [consumer-id]",
 		Short: "Query for consumer chain genesis state by consumer id",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for consumer chain genesis state by consumer id.
+If --%s is provided, the genesis state is returned in the format of the given consumer genesis version
+(supported versions: %s).
+
+Example:
+$ %s query provider consumer-genesis 0 --%s %s
+`, FlagTargetVersion, strings.Join(ccvtypes.SupportedConsumerGenesisVersions, ", "),
+				version.AppName, FlagTargetVersion, ccvtypes.ConsumerGenesisVersionV5),
+		),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -58,17 +120,127 @@ func CmdConsumerGenesis() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := types.QueryConsumerGenesisRequest{ConsumerId: args[0]}
+			targetVersion, err := cmd.Flags().GetString(FlagTargetVersion)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryConsumerGenesisRequest{ConsumerId: args[0], TargetVersion: targetVersion}
 			res, err := queryClient.QueryConsumerGenesis(cmd.Context(), &req)
 			if err != nil {
 				return err
 			}
 
+			if targetVersion != "" {
+				return clientCtx.PrintRaw([]byte(res.TransformedGenesisState))
+			}
 			return clientCtx.PrintProto(&res.GenesisState)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagTargetVersion, "", "the consumer genesis version to which the genesis state is transformed")
+
+	return cmd
+}
+
+// CmdTransformConsumerGenesis transforms a consumer genesis state (e.g., as returned by the consumer-genesis query)
+// to the format of the given consumer genesis version. The transformation runs locally.
+func CmdTransformConsumerGenesis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transform-consumer-genesis [genesis-file] [target-version]",
+		Short: "Transform a consumer genesis state to the format of a consumer genesis version",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transform the consumer genesis state in [genesis-file] (e.g., as returned by the consumer-genesis query)
+to the format of the consumer genesis version [target-version] and print the result.
+The transformation runs locally. Supported versions: %s.
+
+Example:
+$ %s query provider transform-consumer-genesis consumer_genesis.json %s
+`, strings.Join(ccvtypes.SupportedConsumerGenesisVersions, ", "), version.AppName, ccvtypes.ConsumerGenesisVersionV4),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+			var gen ccvtypes.ConsumerGenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &gen); err != nil {
+				return fmt.Errorf("consumer genesis unmarshalling failed: %w", err)
+			}
+
+			transformed, err := ccvtypes.TransformConsumerGenesis(gen, args[1])
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(transformed)
+		},
+	}
+
+	return cmd
+}
+
+func CmdConsumerGenesisBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-genesis-batch [consumer-id]...",
+		Short: "Query for the genesis states of multiple consumer chains by consumer id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the genesis states of at most %d consumer chains, together with their phase and chain id.
+The entries of the consumer chains without a genesis state contain the reason why the genesis state is not available.
+If the --%s flag is set, then the genesis state of every consumer chain is written to <consumer-id>.json in the directory.
+Example:
+$ %s query provider consumer-genesis-batch 0 1 2 --%s ./genesis
+`, types.MaxConsumerGenesisBatchSize, FlagOutputDir, version.AppName, FlagOutputDir),
+		),
+		Args: cobra.RangeArgs(1, types.MaxConsumerGenesisBatchSize),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryConsumerGenesisBatchRequest{ConsumerIds: args}
+			res, err := queryClient.QueryConsumerGenesisBatch(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			outputDir, err := cmd.Flags().GetString(FlagOutputDir)
+			if err != nil {
+				return err
+			}
+			if outputDir == "" {
+				return clientCtx.PrintProto(res)
+			}
+
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return fmt.Errorf("creating output directory %s: %w", outputDir, err)
+			}
+			for _, entry := range res.Entries {
+				if entry.GenesisState == nil {
+					cmd.Printf("%s: %s\n", entry.ConsumerId, entry.Error)
+					continue
+				}
+				bz, err := clientCtx.Codec.MarshalJSON(entry.GenesisState)
+				if err != nil {
+					return fmt.Errorf("marshaling genesis state of consumer chain %s: %w", entry.ConsumerId, err)
+				}
+				path := filepath.Join(outputDir, entry.ConsumerId+".json")
+				if err := os.WriteFile(path, bz, 0o644); err != nil {
+					return fmt.Errorf("writing genesis state of consumer chain %s: %w", entry.ConsumerId, err)
+				}
+				cmd.Printf("%s: %s\n", entry.ConsumerId, path)
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagOutputDir, "", "the directory to which the genesis states are written (one JSON file per consumer chain)")
 
 	return cmd
 }
@@ -78,7 +250,8 @@ func CmdConsumerChains() *cobra.Command {
 		Use:   "list-consumer-chains [phase] [limit]",
 		Short: "Query consumer chains for provider chain.",
 		Long: `Query consumer chains for provider chain. An optional
-		integer parameter can be passed for phase filtering of consumer chains,
+		phase parameter can be passed for phase filtering of consumer chains, either as
+		a name (e.g., launched or CONSUMER_PHASE_LAUNCHED) or as an integer
 		(Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5).`,
 		Args: cobra.MaximumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -91,11 +264,11 @@ func CmdConsumerChains() *cobra.Command {
 			req := &types.QueryConsumerChainsRequest{}
 
 			if len(args) >= 1 && args[0] != "" {
-				phase, err := strconv.ParseInt(args[0], 10, 32)
-				if err != nil {
+				if phase, err := strconv.ParseInt(args[0], 10, 32); err == nil {
+					req.Phase = types.ConsumerPhase(phase)
+				} else if req.Phase, err = types.StringToPhase(args[0]); err != nil {
 					return err
 				}
-				req.Phase = types.ConsumerPhase(phase)
 			}
 
 			if len(args) == 2 && args[1] != "" {
@@ -130,7 +303,8 @@ func CmdConsumerValidatorKeyAssignment() *cobra.Command {
 		Short: "Query assigned validator consensus public key for a consumer chain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Returns the currently assigned validator consensus public key for a
-consumer chain, if one has been assigned.
+consumer chain, if one has been assigned, and the key assignment nonce to use
+in the next key assignment of the validator on the consumer chain.
 Example:
 $ %s query provider validator-consumer-key 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 `,
@@ -504,6 +678,135 @@ $ %s validator-consumer-commission-rate 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqh
 	return cmd
 }
 
+func CmdValidatorConsumerAvailability() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-consumer-availability [consumer-id] [provider-validator-address]",
+		Short: "Query whether a validator is available to validate a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a validator is available to validate a consumer chain.
+Unavailable validators remain opted in, but are not part of the validator set of the consumer chain.
+Example:
+$ %s validator-consumer-availability 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryValidatorConsumerAvailability(cmd.Context(),
+				&types.QueryValidatorConsumerAvailabilityRequest{
+					ConsumerId:      args[0],
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEmergencyOptOutCooldown() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "emergency-opt-out-cooldown [consumer-id] [provider-validator-address]",
+		Short: "Query whether a validator cannot opt in to a consumer chain after an emergency opt out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a validator cannot opt in to a consumer chain, since it emergency opted out
+of it less than the emergency opt-out cooldown ago, and the time at which the cooldown ends.
+Example:
+$ %s emergency-opt-out-cooldown 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryEmergencyOptOutCooldown(cmd.Context(),
+				&types.QueryEmergencyOptOutCooldownRequest{
+					ConsumerId:      args[0],
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdReOptInCooldown() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "re-opt-in-cooldown [consumer-id] [provider-validator-address]",
+		Short: "Query whether a validator cannot validate a consumer chain after being jailed for an infraction committed on it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether a validator cannot validate a consumer chain, since it was jailed for an infraction
+committed on it less than the re-opt-in cooldown of the chain ago, and the time at which the cooldown ends.
+Example:
+$ %s re-opt-in-cooldown 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryReOptInCooldown(cmd.Context(),
+				&types.QueryReOptInCooldownRequest{
+					ConsumerId:      args[0],
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdBlocksUntilNextEpoch() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "blocks-until-next-epoch",
@@ -531,6 +834,33 @@ func CmdBlocksUntilNextEpoch() *cobra.Command {
 	return cmd
 }
 
+func CmdNextEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch",
+		Short: "Query the current epoch and the height (and estimated time) at which the next epoch begins and validator updates are sent to consumer chains",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNextEpochRequest{}
+			res, err := queryClient.QueryNextEpoch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdConsumerIdFromClientId() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consumer-id-from-client-id [client-id]",
@@ -584,3 +914,1006 @@ func CmdConsumerChain() *cobra.Command {
 
 	return cmd
 }
+
+// Command to query the exposure of a validator to the consumer chains
+func CmdValidatorProviderExposure() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-exposure [provider-validator-operator-address]",
+		Short: "Query the exposure of a validator to the consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query every consumer chain a validator validates or is opted in to, together with
+its power, consumer commission rate, and whether its membership is forced by Top N or it is pending an opt-out.
+Example:
+$ %s query provider validator-exposure %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+		`, version.AppName, bech32PrefixValAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryValidatorProviderExposure(cmd.Context(),
+				&types.QueryValidatorProviderExposureRequest{
+					ProviderOperatorAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the provider-side client, connection and channels of the launched consumer chains
+func CmdConsumerTopology() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-topology [limit]",
+		Short: "Query the IBC client, connection and channels of the launched consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the provider-side client id, connection id and CCV channel ids, as well as the
+distribution transmission channel, of every launched consumer chain. An optional integer parameter
+can be passed to limit the number of returned consumer chains.
+Example:
+$ %s query provider consumer-topology
+		`, version.AppName),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerTopologyRequest{}
+
+			if len(args) == 1 && args[0] != "" {
+				limit, err := strconv.ParseInt(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Pagination = &query.PageRequest{
+					Limit: uint64(limit),
+				}
+			}
+
+			res, err := queryClient.QueryConsumerTopology(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the provider block height to which a vscID sent to a consumer chain is mapped
+func CmdVscIdToHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vsc-id-to-height [consumer-id] [vsc-id]",
+		Short: "Query the provider block height to which a valset update id sent to a consumer chain is mapped",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the provider block height (and time) to which a valset update id sent to a consumer chain
+is mapped, i.e., the height used as infraction height for slash packets with this valset update id.
+Example:
+$ %s query provider vsc-id-to-height 0 12
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryVscIdToHeight(cmd.Context(),
+				&types.QueryVscIdToHeightRequest{
+					ConsumerId: args[0],
+					VscId:      vscId,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the transfer channel registered as the source of the ICS rewards of a consumer chain
+func CmdConsumerRewardChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-reward-channel [consumer-id]",
+		Short: "Query the transfer channel on which the ICS rewards of a consumer chain are received",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRewardChannelRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerRewardChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the endpoints advertised for a consumer chain
+func CmdConsumerEndpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-endpoints [consumer-id]",
+		Short: "Query the endpoints (e.g., peers, RPC and genesis URLs) advertised for a consumer chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerEndpointsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerEndpoints(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSecurityOverview() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security-overview",
+		Short: "Query the validators securing every launched or initialized consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, for every launched or initialized consumer chain, the opted-in validators,
+the validators that would be selected if the consumer validator set was computed now, and the fraction
+of the total bonded power on the provider securing the chain. It also returns the number of consumer chains
+every bonded validator would secure.
+Example:
+$ %s query provider security-overview
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuerySecurityOverview(cmd.Context(), &types.QuerySecurityOverviewRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the consumer chains owned by an owner address
+func CmdConsumersByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumers-by-owner [owner-address]",
+		Short: "Query the consumer chains owned by an owner address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer chains owned by an owner address, in any phase.
+Example:
+$ %s query provider consumers-by-owner cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumersByOwnerRequest{
+				OwnerAddress: args[0],
+				Pagination:   pageReq,
+			}
+			res, err := queryClient.QueryConsumersByOwner(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumers-by-owner")
+
+	return cmd
+}
+
+// Command to query the chain ids used by more than one registered, initialized, or launched consumer chain
+func CmdConsumerChainIdConflicts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-chain-id-conflicts",
+		Short: "Query the chain ids used by more than one registered, initialized, or launched consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the chain ids used by more than one registered, initialized, or launched consumer chain,
+together with the consumer ids of these chains and the consumer id of the launched chain (if any).
+Note that a consumer chain is not launched while another consumer chain with the same chain id is launched.
+Example:
+$ %s query provider consumer-chain-id-conflicts
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerChainIdConflicts(cmd.Context(), &types.QueryConsumerChainIdConflictsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainsCarryingValidator() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "consumer-chains-carrying-validator [provider-validator-address]",
+		Short: "Query the consumer chains that still carry a given validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the launched consumer chains whose validator set contains the given validator
+or that did not yet acknowledge the removal of the validator, e.g., after the validator was
+tombstoned due to an equivocation on a consumer chain.
+Example:
+$ %s query provider consumer-chains-carrying-validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`, version.AppName, bech32PrefixConsAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryConsumerChainsCarryingValidatorRequest{ProviderAddress: args[0]}
+			res, err := queryClient.QueryConsumerChainsCarryingValidator(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerLaunchChecklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-launch-checklist [consumer-id]",
+		Short: "Query the checks that a consumer chain has to pass before it can launch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the checks that a registered or initialized consumer chain has to pass before it can launch,
+e.g., whether the spawn time is set and in the future, whether validators opted in, or whether reward denoms are allowlisted.
+Every check is returned with whether it passed and with details on its outcome.
+Example:
+$ %s query provider consumer-launch-checklist 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QueryConsumerLaunchChecklistRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerLaunchChecklist(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerChainsFullDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-chains-full-dump",
+		Short: "Query all the stored parameters of every consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, for every consumer chain, its metadata, initialization parameters, power shaping parameters,
+phase, owner, client id, channel id, removal time, allowlist and denylist, e.g., to audit the provider state
+against the governance proposals. The deleted consumer chains are only returned if the --%s flag is set.
+Example:
+$ %s query provider consumer-chains-full-dump --%s --limit 10 --output json
+`, FlagIncludeDeleted, version.AppName, FlagIncludeDeleted),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			includeDeleted, err := cmd.Flags().GetBool(FlagIncludeDeleted)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerChainsFullDumpRequest{
+				IncludeDeleted: includeDeleted,
+				Pagination:     pageReq,
+			}
+			res, err := queryClient.QueryConsumerChainsFullDump(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer-chains-full-dump")
+	cmd.Flags().Bool(FlagIncludeDeleted, false, "include the deleted consumer chains")
+
+	return cmd
+}
+
+// Command to query the validator set of a consumer chain at a given provider height
+func CmdConsumerValidatorSetAtHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-validator-set-at-height [consumer-id] [height]",
+		Short: "Query the validator set of a consumer chain that was in effect at a given provider height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator set of a consumer chain that was in effect at a given provider height,
+i.e., the validator set computed for the consumer chain at the latest epoch boundary at or before the height.
+Only the validator sets of the last NumberOfEpochsToRetainConsumerValsets epochs are retained.
+Example:
+$ %s query provider consumer-validator-set-at-height 0 1200
+		`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryConsumerValidatorSetAtHeight(cmd.Context(),
+				&types.QueryConsumerValidatorSetAtHeightRequest{
+					ConsumerId: args[0],
+					Height:     height,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the complete provider-side state of a consumer chain
+func CmdConsumerDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-dump [consumer-id]",
+		Short: "Query the complete provider-side state stored for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns everything the provider stores for a consumer chain, i.e., its phase, owner, metadata,
+initialization and power shaping parameters, client id, channel id, times, validator set, opted-in validators,
+key assignments, commission rates, allowlist and denylist, and the number of pending VSC packets,
+e.g., to debug a misbehaving consumer chain. For deleted consumer chains only the retained state is returned.
+Example:
+$ %s query provider consumer-dump 0 --output json
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerDumpRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerDump(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the reward attribution log of a consumer chain
+func CmdRewardAttributionLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-attribution-log [consumer-id]",
+		Short: "Query the last reward attribution decisions for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the last decisions of the provider on the transfers to the consumer rewards pool
+attributed to a consumer chain, i.e., whether the rewards were credited to the consumer chain, diverted to
+the community pool, or not distributed since their denom is not allowlisted, from the oldest to the newest.
+Example:
+$ %s query provider reward-attribution-log 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardAttributionLogRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryRewardAttributionLog(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the status of the clients to the launched consumer chains
+func CmdConsumerClientStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-status",
+		Short: "Query the remaining trusting period of the clients to the launched consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, for every launched consumer chain, the status of its client (i.e., active, expiring, or expired),
+the time at which the client expires, and the remaining time until the client expires.
+Example:
+$ %s query provider consumer-client-status
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientStatusRequest{}
+			res, err := queryClient.QueryConsumerClientStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the entries of a time queue
+func CmdTimeQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-queue [queue]",
+		Short: "Query the entries of a time queue",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, in chronological order, the consumer ids stored in a time queue, i.e.,
+the initialized consumer chains to be launched (spawn), the stopped consumer chains to be removed (removal),
+the launched consumer chains to be stopped once their maximum lifetime elapses (stop),
+or the launched consumer chains for which a lifetime reminder is to be emitted (reminder).
+The queue is either one of spawn, removal, stop or reminder, or the key prefix of the time queue.
+Example:
+$ %s query provider time-queue spawn
+$ %s query provider time-queue 51
+`, version.AppName, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			prefix, found := types.TimeQueueKeyPrefixes()[args[0]]
+			if !found {
+				p, err := strconv.ParseUint(args[0], 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid time queue %s: expected spawn, removal, stop, reminder, or a key prefix", args[0])
+				}
+				prefix = byte(p)
+			}
+
+			req := &types.QueryTimeQueueRequest{Prefix: uint32(prefix)}
+			res, err := queryClient.QueryTimeQueue(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDumpStoreKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump-store-keys [prefix]",
+		Short: "Dump the entries of the provider store stored under a key prefix",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, for debugging, the human-readable keys and values of the entries of the provider store
+stored under a key prefix. The prefix is either the name of a key (e.g., ConsumerIdToPhaseKey) or the key prefix.
+Example:
+$ %s query provider dump-store-keys ConsumerIdToPhaseKey
+$ %s query provider dump-store-keys 49
+`, version.AppName, version.AppName),
+		),
+		Args:   cobra.ExactArgs(1),
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			prefix, found := types.GetKeyPrefix(args[0])
+			if !found {
+				p, err := strconv.ParseUint(args[0], 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid prefix %s: expected a key name or a key prefix", args[0])
+				}
+				prefix = byte(p)
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path: fmt.Sprintf("/store/%s/subspace", types.StoreKey),
+				Data: []byte{prefix},
+			})
+			if err != nil {
+				return err
+			}
+			var entries types.StoreEntries
+			if err := entries.Unmarshal(res.Value); err != nil {
+				return fmt.Errorf("failed to unmarshal store entries: %w", err)
+			}
+
+			for _, entry := range entries.Entries {
+				pair := kv.Pair{Key: entry.Key, Value: entry.Value}
+				if _, err := fmt.Fprintln(cmd.OutOrStdout(), simulation.FormatStoreEntry(pair)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the skipped downtime slashes of a consumer chain
+func CmdSkippedDowntimeSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skipped-downtime-slashes [consumer-id]",
+		Short: "Query the downtime slashes of a consumer chain that were skipped due to its downtime grace period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the downtime slashes of a consumer chain that were acknowledged, but not executed,
+since the validator was automatically opted in because it belongs to the top N and its downtime grace period
+had not yet elapsed, from the oldest to the newest.
+Example:
+$ %s query provider skipped-downtime-slashes 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySkippedDowntimeSlashesRequest{ConsumerId: args[0]}
+			res, err := queryClient.QuerySkippedDowntimeSlashes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the unroutable slash packets of a consumer chain
+func CmdUnroutableSlashPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unroutable-slash-packets [consumer-id]",
+		Short: "Query the last slash packets of a consumer chain that could not be routed to a provider validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the last slash packets of a consumer chain whose consumer consensus address
+could not be mapped to a provider validator (e.g., because the key assignment was pruned too early),
+from the oldest to the newest.
+Example:
+$ %s query provider unroutable-slash-packets 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUnroutableSlashPacketsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryUnroutableSlashPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the pre-opt-in infractions of a consumer chain
+func CmdPreOptInInfractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pre-opt-in-infractions [consumer-id]",
+		Short: "Query the downtime infractions of a consumer chain that were dropped, since they were committed before the validators opted in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the downtime infractions of a consumer chain that were dropped without jailing the validators,
+since they were committed before the opt-ins of the validators to the consumer chain became effective,
+from the oldest to the newest.
+Example:
+$ %s query provider pre-opt-in-infractions 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPreOptInInfractionsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryPreOptInInfractions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Command to query the minimum power needed to be opted in automatically to a Top N consumer chain
+func CmdTopNThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-n-threshold [consumer-id]",
+		Short: "Query the minimum power and stake an active validator needs to be opted in automatically to a Top N consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the minimum power (and the equivalent bonded tokens) an active validator currently needs
+to be opted in automatically to a Top N consumer chain, the rank cutoff, i.e., the number of active validators
+that are opted in automatically, and the active validators at the boundary (i.e., with exactly the minimum power).
+For consumer chains that are not Top N chains, the threshold is not applicable.
+Example:
+$ %s query provider top-n-threshold 0
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTopNThresholdRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryTopNThreshold(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerEconomicSecurity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-economic-security [consumer-id]",
+		Short: "Query the economic security of a consumer chain recorded at every epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the economic security of a consumer chain recorded at every epoch, i.e., the total power
+of the consumer validator set, the equivalent provider tokens, and the number of validators, together with
+the provider height and time at which they were recorded. The records can be restricted to a range of provider heights.
+Example:
+$ %s query provider consumer-economic-security 0 --%s 1000 --%s 2000
+`, version.AppName, FlagStartHeight, FlagEndHeight),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerEconomicSecurityRequest{
+				ConsumerId:  args[0],
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			}
+			res, err := queryClient.QueryConsumerEconomicSecurity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagStartHeight, 0, "the first provider height of the records (zero for no lower bound)")
+	cmd.Flags().Int64(FlagEndHeight, 0, "the last provider height of the records (zero for no upper bound)")
+
+	return cmd
+}
+
+func CmdNextConsumerId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-consumer-id",
+		Short: "Query the consumer id assigned to the next created consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the current value of the consumer id counter, i.e., the consumer id that is assigned
+to the next created consumer chain.
+Example:
+$ %s query provider next-consumer-id
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryNextConsumerId(cmd.Context(), &types.QueryNextConsumerIdRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSimulateCreateConsumer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-create-consumer [submitter] [path/to/create_consumer.json]",
+		Short: "Simulate the creation of a consumer chain without persisting it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Runs MsgCreateConsumer as a dry run and returns the consumer id the consumer chain would get,
+the normalized parameters it would be created with, and the failed launch checks as warnings.
+The create_consumer.json file has the same structure as for the create-consumer transaction.
+Note that the consumer id is only a prediction: if other consumer chains are created first,
+the consumer chain gets a different id. The consumer id counter the prediction is based on is also returned.
+Example:
+$ %s query provider simulate-create-consumer cosmos1... create_consumer.json
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			consCreateJson, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			consCreate := types.MsgCreateConsumer{}
+			if err = json.Unmarshal(consCreateJson, &consCreate); err != nil {
+				return fmt.Errorf("consumer data unmarshalling failed: %w", err)
+			}
+
+			msg, err := types.NewMsgCreateConsumer(args[0], consCreate.ChainId, consCreate.Metadata, consCreate.InitializationParameters,
+				consCreate.PowerShapingParameters, consCreate.AllowlistedRewardDenoms)
+			if err != nil {
+				return err
+			}
+
+			req := types.QuerySimulateCreateConsumerRequest{Msg: msg, DryRun: true}
+			res, err := queryClient.SimulateCreateConsumer(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingVSCPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-vsc-packets [consumer-id]",
+		Short: "Query the VSC packets queued to be sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the VSC packets queued on the provider to be sent to a consumer chain, in the order in
+which they are sent, i.e., their valset update ids, their validator updates as consumer consensus addresses
+and powers, the acknowledged slashes, and the provider heights at which they were queued.
+At most %d packets are returned per page.
+Example:
+$ %s query provider pending-vsc-packets 0
+`, types.MaxPendingVSCPacketsPerPage, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingVSCPacketsRequest{
+				ConsumerId: args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.QueryPendingVSCPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-vsc-packets")
+
+	return cmd
+}
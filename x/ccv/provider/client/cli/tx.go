@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/spf13/cobra"
 
@@ -20,9 +22,19 @@ import (
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/client/spec"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 )
 
+// FlagAcknowledgedTermsHash is the flag for the hash of the terms of a consumer chain acknowledged when opting in
+const FlagAcknowledgedTermsHash = "acknowledged-terms-hash"
+
+// FlagSpec is the flag for the path to the YAML spec of a consumer chain to create or update
+const FlagSpec = "spec"
+
+// FlagKeyAssignmentNonce is the flag for the key assignment nonce of a validator on a consumer chain
+const FlagKeyAssignmentNonce = "nonce"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,9 +51,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewCreateConsumerCmd())
 	cmd.AddCommand(NewUpdateConsumerCmd())
 	cmd.AddCommand(NewRemoveConsumerCmd())
+	cmd.AddCommand(NewConsumerHardForkCmd())
 	cmd.AddCommand(NewOptInCmd())
 	cmd.AddCommand(NewOptOutCmd())
+	cmd.AddCommand(NewEmergencyOptOutCmd())
 	cmd.AddCommand(NewSetConsumerCommissionRateCmd())
+	cmd.AddCommand(NewSetConsumerAvailabilityCmd())
 
 	return cmd
 }
@@ -66,7 +81,12 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 
 			providerValAddr := clientCtx.GetFromAddress()
 
-			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), args[1], submitter)
+			nonce, err := cmd.Flags().GetUint64(FlagKeyAssignmentNonce)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgAssignConsumerKey(args[0], sdk.ValAddress(providerValAddr), args[1], submitter, nonce)
 			if err != nil {
 				return err
 			}
@@ -79,6 +99,8 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagKeyAssignmentNonce, 0,
+		"the key assignment nonce of the validator on the consumer chain (see the validator-consumer-key query)")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -254,7 +276,8 @@ where create_consumer.json has the following structure:
     "allowlist": ["cosmosvalcons..."],
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
-    "allow_inactive_vals": false
+    "allow_inactive_vals": false,
+    "max_provider_rank": 0
   },
   "allowlisted_reward_denoms": {
     "denoms": ["ibc/...", "ibc/..."]
@@ -264,8 +287,40 @@ where create_consumer.json has the following structure:
 Note that both 'chain_id' and 'metadata' are mandatory;
 and 'initialization_parameters', 'power_shaping_parameters' and 'allowlisted_reward_denoms' are optional. 
 The parameters not provided are set to their zero value. 
-`, version.AppName)),
-		Args: cobra.ExactArgs(1),
+
+Alternatively, the consumer chain can be created from a YAML spec:
+%s tx provider create-consumer --%s [path/to/create_consumer.yaml]
+
+where create_consumer.yaml has the following structure:
+chain_id: consumer-1
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{"forge_json_url": "...", "stage": "mainnet"}'
+initialization_parameters:
+  initial_height: 1-1
+  genesis_hash: ""
+  binary_hash: ""
+  spawn_time: "2024-08-29T12:26:16Z"
+  unbonding_period: 480h
+  ccv_timeout_period: 672h
+  transfer_timeout_period: 1h
+  consumer_redistribution_fraction: "0.75"
+  blocks_per_distribution_transmission: 1000
+  historical_entries: 10000
+power_shaping_parameters:
+  top_n: 0
+  validators_power_cap: 10
+  allowlist: [cosmosvalcons...]
+allowlisted_reward_denoms: [ibc/...]
+
+Durations are in the Go duration format, times are in the RFC 3339 format and hashes are hex encoded.
+In the spec, the initialization parameters not provided are set to their default values
+(e.g., the default CCV timeout period and the default number of historical entries),
+and the initial height defaults to the height 1 of the revision of the chain id.
+The spec is validated and summarized before the transaction is generated or broadcast.
+`, version.AppName, version.AppName, FlagSpec)),
+		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -280,6 +335,34 @@ The parameters not provided are set to their zero value.
 
 			submitter := clientCtx.GetFromAddress().String()
 
+			specPath, err := cmd.Flags().GetString(FlagSpec)
+			if err != nil {
+				return err
+			}
+			if specPath != "" {
+				if len(args) != 0 {
+					return fmt.Errorf("cannot provide both the consumer parameters and --%s", FlagSpec)
+				}
+				consumerSpecYaml, err := os.ReadFile(specPath)
+				if err != nil {
+					return err
+				}
+				consumerSpec, err := spec.ParseCreateConsumerSpec(consumerSpecYaml)
+				if err != nil {
+					return err
+				}
+				msg, err := consumerSpec.ToMsg(submitter)
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(cmd.ErrOrStderr(), spec.SummarizeMsgCreateConsumer(msg))
+
+				return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("either the consumer parameters or --%s must be provided", FlagSpec)
+			}
+
 			consCreateJson, err := os.ReadFile(args[0])
 			if err != nil {
 				return err
@@ -303,6 +386,7 @@ The parameters not provided are set to their zero value.
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpec, "", "path to the YAML spec of the consumer chain")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -343,7 +427,11 @@ where update_consumer.json has the following structure:
     "consumer_redistribution_fraction": "0.75",
     "blocks_per_distribution_transmission": 1000,
     "historical_entries": 10000,
-    "distribution_transmission_channel": ""
+    "distribution_transmission_channel": "",
+    "max_lifetime": 0,
+    "signed_blocks_window": 0,
+    "min_signed_per_window": "",
+    "denom_redistribution_fractions": [{"denom": "untrn", "fraction": "1"}]
    },
    "power_shaping_parameters": {
     "top_N": 0,
@@ -352,20 +440,90 @@ where update_consumer.json has the following structure:
     "allowlist": ["cosmosvalcons..."],
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
-    "allow_inactive_vals": false
+    "allow_inactive_vals": false,
+    "max_provider_rank": 0
    },
   "allowlisted_reward_denoms": {
     "denoms": ["ibc/...", "ibc/..."]
+  },
+  "reward_channel_id": "channel-1",
+  "endpoint_info": {
+    "persistent_peers": ["<node_id>@<host>:26656"],
+    "seeds": ["<node_id>@<host>:26656"],
+    "rpc_urls": ["https://rpc.consumer.io:443"],
+    "genesis_url": "https://consumer.io/genesis.json"
+  },
+  "timeout_periods": {
+    "ccv_timeout_period": 2419200000000000,
+    "transfer_timeout_period": 3600000000000
+  },
+  "power_shaping_admin": {
+    "address": "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+  },
+  "retain_power_shaping_admin": false,
+  "lifetime_extension": 604800000000000,
+  "upgrade_notice": {
+    "name": "v2.0.0",
+    "height": 1000000,
+    "binary_hash": "7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13",
+    "deadline": "2024-09-30T12:00:00Z"
+  },
+  "rewards_paused": {
+    "paused": true
+  },
+  "allowed_ica_msg_types": {
+    "msg_type_urls": ["/cosmos.gov.v1.MsgVote"]
   }
 }
 
 Note that only 'consumer_id' is mandatory. The others are optional.
 Not providing one of them will leave the existing values unchanged. 
-Providing one of 'metadata', 'initialization_parameters', 'power_shaping_parameters', or 'allowlisted_reward_denoms' 
+Providing one of 'metadata', 'initialization_parameters', 'power_shaping_parameters', 'allowlisted_reward_denoms', 
+'endpoint_info', or 'timeout_periods' 
 will update all the containing fields. 
 If one of the fields is missing, it will be set to its zero value.
-`, version.AppName)),
-		Args: cobra.ExactArgs(1),
+Note that 'initialization_parameters' can only be updated before the chain launches, 
+while 'timeout_periods' can only be updated after the chain launched.
+The 'power_shaping_admin' (an empty address removes it) can send updates that contain only 
+the 'allowlist' and 'denylist' of the 'power_shaping_parameters'. It is removed when the owner changes,
+unless 'retain_power_shaping_admin' is set.
+The 'lifetime_extension' extends the lifetime of a launched chain with a 'max_lifetime' before it elapses.
+The 'upgrade_notice' informs the validators of a launched chain that they must upgrade to the binary with 'binary_hash'
+at the consumer 'height'; it overwrites the notice with the same 'name' and expires after its 'deadline'.
+While 'rewards_paused' is set, the rewards of the chain are held back; unpausing releases them.
+The 'allowed_ica_msg_types' overwrite the message types that the ICA host of the chain accepts.
+
+Alternatively, the consumer chain can be updated from a YAML spec:
+%s tx provider update-consumer --%s [path/to/update_consumer.yaml]
+
+where update_consumer.yaml has the same sections as update_consumer.json, e.g.:
+consumer_id: "0"
+metadata:
+  name: chain consumer
+  description: description
+power_shaping_parameters:
+  top_n: 95
+  downtime_grace_period: 72h
+allowlisted_reward_denoms: [ibc/...]
+timeout_periods:
+  transfer_timeout_period: 2h
+power_shaping_admin: cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s
+lifetime_extension: 168h
+upgrade_notice:
+  name: v2.0.0
+  height: 1000000
+  binary_hash: 7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13
+  deadline: "2024-09-30T12:00:00Z"
+rewards_paused: true
+allowed_ica_msg_types: [/cosmos.gov.v1.MsgVote]
+
+Durations are in the Go duration format, times are in the RFC 3339 format and hashes are hex encoded.
+In the spec, the initialization parameters and timeout periods not provided are set to their default values.
+The default initial height is derived from the chain id of the consumer chain, which is queried from the provider;
+thus, 'initial_height' must be provided with --offline or --generate-only.
+The spec is validated and summarized before the transaction is generated or broadcast.
+`, version.AppName, version.AppName, FlagSpec)),
+		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -380,6 +538,47 @@ If one of the fields is missing, it will be set to its zero value.
 
 			owner := clientCtx.GetFromAddress().String()
 
+			specPath, err := cmd.Flags().GetString(FlagSpec)
+			if err != nil {
+				return err
+			}
+			if specPath != "" {
+				if len(args) != 0 {
+					return fmt.Errorf("cannot provide both the consumer parameters and --%s", FlagSpec)
+				}
+				consumerSpecYaml, err := os.ReadFile(specPath)
+				if err != nil {
+					return err
+				}
+				consumerSpec, err := spec.ParseUpdateConsumerSpec(consumerSpecYaml)
+				if err != nil {
+					return err
+				}
+				// the chain id of the consumer chain determines the default initial height; it is
+				// only queried if the initial height is not provided and the command is not run offline
+				chainId := ""
+				if consumerSpec.InitializationParameters != nil && consumerSpec.InitializationParameters.InitialHeight == "" &&
+					!clientCtx.Offline && !clientCtx.GenerateOnly {
+					queryClient := types.NewQueryClient(clientCtx)
+					res, err := queryClient.QueryConsumerChain(cmd.Context(), &types.QueryConsumerChainRequest{ConsumerId: consumerSpec.ConsumerId})
+					if err != nil {
+						return fmt.Errorf("cannot query the chain id of the consumer chain with consumer id (%s), "+
+							"which is needed to derive the default initial height: %w", consumerSpec.ConsumerId, err)
+					}
+					chainId = res.ChainId
+				}
+				msg, err := consumerSpec.ToMsg(owner, chainId)
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(cmd.ErrOrStderr(), spec.SummarizeMsgUpdateConsumer(msg))
+
+				return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+			}
+			if len(args) != 1 {
+				return fmt.Errorf("either the consumer parameters or --%s must be provided", FlagSpec)
+			}
+
 			consUpdateJson, err := os.ReadFile(args[0])
 			if err != nil {
 				return err
@@ -395,7 +594,10 @@ If one of the fields is missing, it will be set to its zero value.
 			}
 
 			msg, err := types.NewMsgUpdateConsumer(owner, consUpdate.ConsumerId, consUpdate.NewOwnerAddress, consUpdate.Metadata,
-				consUpdate.InitializationParameters, consUpdate.PowerShapingParameters, consUpdate.AllowlistedRewardDenoms)
+				consUpdate.InitializationParameters, consUpdate.PowerShapingParameters, consUpdate.AllowlistedRewardDenoms,
+				consUpdate.RewardChannelId, consUpdate.EndpointInfo, consUpdate.TimeoutPeriods,
+				consUpdate.PowerShapingAdmin, consUpdate.RetainPowerShapingAdmin, consUpdate.LifetimeExtension,
+				consUpdate.UpgradeNotice, consUpdate.RewardsPaused, consUpdate.AllowedIcaMsgTypes)
 			if err != nil {
 				return err
 			}
@@ -408,6 +610,7 @@ If one of the fields is missing, it will be set to its zero value.
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSpec, "", "path to the YAML spec of the consumer chain update")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -420,6 +623,7 @@ func NewRemoveConsumerCmd() *cobra.Command {
 		Short: "remove a consumer chain",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Removes (and stops) a consumer chain. Note that only the owner of the chain can remove it.
+If the chain is not launched yet, its launch is cancelled and the chain is deleted immediately.
 Example:
 %s tx provider remove-consumer [consumer-id]
 `, version.AppName)),
@@ -458,6 +662,58 @@ Example:
 	return cmd
 }
 
+func NewConsumerHardForkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-hard-fork [consumer-id] [new-chain-id] [initial-height]",
+		Short: "update a consumer chain after it hard forked to a new chain id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Updates the chain id of a launched consumer chain after it hard forked and creates a new client
+to the forked chain at the given initial height (i.e., the height of its first block, in the format {revision}-{height}).
+Opt-ins, key assignments and commission rates are preserved. Note that only the owner of the chain can submit the message,
+i.e., the hard fork of a Top N chain must be approved by governance.
+Example:
+%s tx provider consumer-hard-fork [consumer-id] foo-2 2-1
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+			newChainId := args[1]
+			initialHeight, err := clienttypes.ParseHeight(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid initial height: %w", err)
+			}
+
+			msg, err := types.NewMsgConsumerHardFork(owner, consumerId, newChainId, initialHeight)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func NewOptInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "opt-in [consumer-id] [consumer-pubkey]",
@@ -486,8 +742,13 @@ func NewOptInCmd() *cobra.Command {
 				consumerPubKey = ""
 			}
 
+			acknowledgedTermsHash, err := cmd.Flags().GetString(FlagAcknowledgedTermsHash)
+			if err != nil {
+				return err
+			}
+
 			submitter := clientCtx.GetFromAddress().String()
-			msg, err := types.NewMsgOptIn(args[0], sdk.ValAddress(providerValAddr), consumerPubKey, submitter)
+			msg, err := types.NewMsgOptIn(args[0], sdk.ValAddress(providerValAddr), consumerPubKey, submitter, acknowledgedTermsHash)
 			if err != nil {
 				return err
 			}
@@ -500,6 +761,8 @@ func NewOptInCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAcknowledgedTermsHash, "",
+		"the hash of the terms of the consumer chain acknowledged by the validator (required if the consumer chain has terms)")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -545,6 +808,51 @@ func NewOptOutCmd() *cobra.Command {
 	return cmd
 }
 
+func NewEmergencyOptOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emergency-opt-out [consumer-id]",
+		Short: "opts out validator from this consumer chain right away, in return for a penalty",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opts out the validator from a launched consumer chain right away, instead of at the end of the epoch
+			(e.g., if the consumer chain is compromised). In return, the validator is slashed by the emergency opt-out slash fraction
+			and cannot opt in to the consumer chain again during the emergency opt-out cooldown (see the provider params).
+			Validators that have to validate a Top N chain can only emergency opt out if the chain allows it.
+			Example:
+			%s emergency-opt-out 123`,
+				version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			providerValAddr := clientCtx.GetFromAddress()
+
+			submitter := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgEmergencyOptOut(args[0], sdk.ValAddress(providerValAddr), submitter)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func NewSetConsumerCommissionRateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-consumer-commission-rate [consumer-id] [commission-rate]",
@@ -590,3 +898,51 @@ func NewSetConsumerCommissionRateCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSetConsumerAvailabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-consumer-availability [consumer-id] [available]",
+		Short: "set whether a validator is available to validate a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Note that the "available" argument is a boolean. An unavailable validator remains opted in,
+			but is excluded from the validator set of the consumer chain from the next epoch on (e.g., during the maintenance of its consumer node).
+			Validators that have to validate a Top N chain cannot be unavailable.
+			Example:
+			%s set-consumer-availability 123 false`,
+				version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			providerValAddr := clientCtx.GetFromAddress()
+
+			available, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			submitter := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgSetConsumerAvailability(args[0], available, sdk.ValAddress(providerValAddr), submitter)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
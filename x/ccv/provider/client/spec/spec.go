@@ -0,0 +1,454 @@
+package spec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// CreateConsumerSpec is the YAML spec of a MsgCreateConsumer. Only `chain_id` and `metadata` are mandatory.
+// Durations are in the Go duration format (e.g., `504h`), times are in the RFC 3339 format, hashes are
+// hex encoded, and the initial height is in the format `{revision}-{height}`.
+type CreateConsumerSpec struct {
+	ChainId                  string                        `yaml:"chain_id"`
+	Metadata                 *MetadataSpec                 `yaml:"metadata"`
+	InitializationParameters *InitializationParametersSpec `yaml:"initialization_parameters"`
+	PowerShapingParameters   *PowerShapingParametersSpec   `yaml:"power_shaping_parameters"`
+	AllowlistedRewardDenoms  []string                      `yaml:"allowlisted_reward_denoms"`
+}
+
+// UpdateConsumerSpec is the YAML spec of a MsgUpdateConsumer. Only `consumer_id` is mandatory;
+// the sections that are not provided leave the existing values of the consumer chain unchanged.
+type UpdateConsumerSpec struct {
+	ConsumerId               string                        `yaml:"consumer_id"`
+	NewOwnerAddress          string                        `yaml:"new_owner_address"`
+	Metadata                 *MetadataSpec                 `yaml:"metadata"`
+	InitializationParameters *InitializationParametersSpec `yaml:"initialization_parameters"`
+	PowerShapingParameters   *PowerShapingParametersSpec   `yaml:"power_shaping_parameters"`
+	AllowlistedRewardDenoms  []string                      `yaml:"allowlisted_reward_denoms"`
+	RewardChannelId          string                        `yaml:"reward_channel_id"`
+	EndpointInfo             *EndpointInfoSpec             `yaml:"endpoint_info"`
+	TimeoutPeriods           *TimeoutPeriodsSpec           `yaml:"timeout_periods"`
+	PowerShapingAdmin        *string                       `yaml:"power_shaping_admin"`
+	RetainPowerShapingAdmin  bool                          `yaml:"retain_power_shaping_admin"`
+	LifetimeExtension        string                        `yaml:"lifetime_extension"`
+	UpgradeNotice            *UpgradeNoticeSpec            `yaml:"upgrade_notice"`
+	RewardsPaused            *bool                         `yaml:"rewards_paused"`
+	AllowedIcaMsgTypes       []string                      `yaml:"allowed_ica_msg_types"`
+}
+
+// MetadataSpec is the YAML spec of the metadata of a consumer chain
+type MetadataSpec struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	Metadata    string `yaml:"metadata"`
+	TermsHash   string `yaml:"terms_hash"`
+}
+
+// InitializationParametersSpec is the YAML spec of the initialization parameters of a consumer chain.
+// The parameters that are not provided are set to their default values (see `DefaultInitializationParameters`).
+type InitializationParametersSpec struct {
+	InitialHeight                     string `yaml:"initial_height"`
+	GenesisHash                       string `yaml:"genesis_hash"`
+	BinaryHash                        string `yaml:"binary_hash"`
+	SpawnTime                         string `yaml:"spawn_time"`
+	UnbondingPeriod                   string `yaml:"unbonding_period"`
+	CcvTimeoutPeriod                  string `yaml:"ccv_timeout_period"`
+	TransferTimeoutPeriod             string `yaml:"transfer_timeout_period"`
+	ConsumerRedistributionFraction    string `yaml:"consumer_redistribution_fraction"`
+	BlocksPerDistributionTransmission *int64 `yaml:"blocks_per_distribution_transmission"`
+	HistoricalEntries                 *int64 `yaml:"historical_entries"`
+	DistributionTransmissionChannel   string `yaml:"distribution_transmission_channel"`
+	ConsumerDenom                     string `yaml:"consumer_denom"`
+	PreCcvEvidenceMinHeight           uint64 `yaml:"pre_ccv_evidence_min_height"`
+	PreCcvClientId                    string `yaml:"pre_ccv_client_id"`
+	MaxLifetime                       string `yaml:"max_lifetime"`
+	SignedBlocksWindow                int64  `yaml:"signed_blocks_window"`
+	MinSignedPerWindow                string `yaml:"min_signed_per_window"`
+
+	DenomRedistributionFractions []DenomRedistributionFractionSpec `yaml:"denom_redistribution_fractions"`
+}
+
+// DenomRedistributionFractionSpec is the YAML spec of a denom-specific override of the consumer redistribution fraction
+type DenomRedistributionFractionSpec struct {
+	Denom    string `yaml:"denom"`
+	Fraction string `yaml:"fraction"`
+}
+
+// PowerShapingParametersSpec is the YAML spec of the power-shaping parameters of a consumer chain
+type PowerShapingParametersSpec struct {
+	TopN                      uint32   `yaml:"top_n"`
+	ValidatorsPowerCap        uint32   `yaml:"validators_power_cap"`
+	ValidatorSetCap           uint32   `yaml:"validator_set_cap"`
+	Allowlist                 []string `yaml:"allowlist"`
+	Denylist                  []string `yaml:"denylist"`
+	MinStake                  uint64   `yaml:"min_stake"`
+	AllowInactiveVals         bool     `yaml:"allow_inactive_vals"`
+	MaxProviderRank           uint32   `yaml:"max_provider_rank"`
+	DowntimeGracePeriod       string   `yaml:"downtime_grace_period"`
+	AllowTopNEmergencyOptOut  bool     `yaml:"allow_top_n_emergency_opt_out"`
+	ReOptInCooldownAfterSlash string   `yaml:"re_opt_in_cooldown_after_slash"`
+}
+
+// EndpointInfoSpec is the YAML spec of the endpoint information of a consumer chain
+type EndpointInfoSpec struct {
+	PersistentPeers []string `yaml:"persistent_peers"`
+	Seeds           []string `yaml:"seeds"`
+	RpcUrls         []string `yaml:"rpc_urls"`
+	GenesisUrl      string   `yaml:"genesis_url"`
+}
+
+// TimeoutPeriodsSpec is the YAML spec of the timeout periods of a launched consumer chain.
+// The periods that are not provided are set to their default values.
+type TimeoutPeriodsSpec struct {
+	CcvTimeoutPeriod      string `yaml:"ccv_timeout_period"`
+	TransferTimeoutPeriod string `yaml:"transfer_timeout_period"`
+}
+
+// UpgradeNoticeSpec is the YAML spec of an upgrade notice of a launched consumer chain
+type UpgradeNoticeSpec struct {
+	Name       string `yaml:"name"`
+	Height     uint64 `yaml:"height"`
+	BinaryHash string `yaml:"binary_hash"`
+	Deadline   string `yaml:"deadline"`
+}
+
+// ParseCreateConsumerSpec parses the YAML spec of a MsgCreateConsumer. Unknown fields are rejected.
+func ParseCreateConsumerSpec(bz []byte) (CreateConsumerSpec, error) {
+	var spec CreateConsumerSpec
+	if err := yaml.UnmarshalStrict(bz, &spec); err != nil {
+		return CreateConsumerSpec{}, fmt.Errorf("failed to parse create consumer spec: %w", err)
+	}
+	return spec, nil
+}
+
+// ParseUpdateConsumerSpec parses the YAML spec of a MsgUpdateConsumer. Unknown fields are rejected.
+func ParseUpdateConsumerSpec(bz []byte) (UpdateConsumerSpec, error) {
+	var spec UpdateConsumerSpec
+	if err := yaml.UnmarshalStrict(bz, &spec); err != nil {
+		return UpdateConsumerSpec{}, fmt.Errorf("failed to parse update consumer spec: %w", err)
+	}
+	return spec, nil
+}
+
+// DefaultInitializationParameters returns the initialization parameters used for the parameters
+// that are not provided in a spec. The initial height (i.e., `{revision}-1`) depends on the chain id.
+func DefaultInitializationParameters(chainId string) types.ConsumerInitializationParameters {
+	return types.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(clienttypes.ParseChainID(chainId), 1),
+		UnbondingPeriod:                   ccvtypes.DefaultConsumerUnbondingPeriod,
+		CcvTimeoutPeriod:                  ccvtypes.DefaultCCVTimeoutPeriod,
+		TransferTimeoutPeriod:             ccvtypes.DefaultTransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    ccvtypes.DefaultConsumerRedistributeFrac,
+		BlocksPerDistributionTransmission: ccvtypes.DefaultBlocksPerDistributionTransmission,
+		HistoricalEntries:                 ccvtypes.DefaultHistoricalEntries,
+	}
+}
+
+// ToMsg returns the MsgCreateConsumer of the spec signed by `submitter`, after validating it
+// with the same validation as the one performed before the message is handled
+func (spec CreateConsumerSpec) ToMsg(submitter string) (*types.MsgCreateConsumer, error) {
+	if strings.TrimSpace(spec.ChainId) == "" {
+		return nil, fmt.Errorf("chain_id is mandatory")
+	}
+	if spec.Metadata == nil {
+		return nil, fmt.Errorf("metadata is mandatory")
+	}
+
+	var initializationParameters *types.ConsumerInitializationParameters
+	if spec.InitializationParameters != nil {
+		params, err := spec.InitializationParameters.toInitializationParameters(spec.ChainId)
+		if err != nil {
+			return nil, fmt.Errorf("initialization_parameters: %w", err)
+		}
+		initializationParameters = &params
+	}
+
+	var powerShapingParameters *types.PowerShapingParameters
+	if spec.PowerShapingParameters != nil {
+		params, err := spec.PowerShapingParameters.toPowerShapingParameters()
+		if err != nil {
+			return nil, fmt.Errorf("power_shaping_parameters: %w", err)
+		}
+		powerShapingParameters = &params
+	}
+
+	var allowlistedRewardDenoms *types.AllowlistedRewardDenoms
+	if spec.AllowlistedRewardDenoms != nil {
+		allowlistedRewardDenoms = &types.AllowlistedRewardDenoms{Denoms: spec.AllowlistedRewardDenoms}
+	}
+
+	msg, err := types.NewMsgCreateConsumer(submitter, spec.ChainId, spec.Metadata.toMetadata(),
+		initializationParameters, powerShapingParameters, allowlistedRewardDenoms)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ToMsg returns the MsgUpdateConsumer of the spec signed by `owner`, after validating it
+// with the same validation as the one performed before the message is handled. The `chainId` of
+// the consumer chain determines the revision number of the default initial height, and the initial
+// height is validated against it, as when the message is handled. If `chainId` is empty (i.e., not
+// known), `initial_height` is mandatory when the initialization parameters are provided.
+func (spec UpdateConsumerSpec) ToMsg(owner, chainId string) (*types.MsgUpdateConsumer, error) {
+	if strings.TrimSpace(spec.ConsumerId) == "" {
+		return nil, fmt.Errorf("consumer_id is mandatory")
+	}
+
+	var metadata *types.ConsumerMetadata
+	if spec.Metadata != nil {
+		m := spec.Metadata.toMetadata()
+		metadata = &m
+	}
+
+	var initializationParameters *types.ConsumerInitializationParameters
+	if spec.InitializationParameters != nil {
+		if chainId == "" && spec.InitializationParameters.InitialHeight == "" {
+			return nil, fmt.Errorf("initialization_parameters: initial_height is mandatory if the chain id of the consumer chain is not known")
+		}
+		params, err := spec.InitializationParameters.toInitializationParameters(chainId)
+		if err != nil {
+			return nil, fmt.Errorf("initialization_parameters: %w", err)
+		}
+		if chainId != "" {
+			if err := types.ValidateInitialHeight(params.InitialHeight, chainId); err != nil {
+				return nil, fmt.Errorf("initialization_parameters: initial_height: %w", err)
+			}
+		}
+		initializationParameters = &params
+	}
+
+	var powerShapingParameters *types.PowerShapingParameters
+	if spec.PowerShapingParameters != nil {
+		params, err := spec.PowerShapingParameters.toPowerShapingParameters()
+		if err != nil {
+			return nil, fmt.Errorf("power_shaping_parameters: %w", err)
+		}
+		powerShapingParameters = &params
+	}
+
+	var allowlistedRewardDenoms *types.AllowlistedRewardDenoms
+	if spec.AllowlistedRewardDenoms != nil {
+		allowlistedRewardDenoms = &types.AllowlistedRewardDenoms{Denoms: spec.AllowlistedRewardDenoms}
+	}
+
+	var endpointInfo *types.EndpointInfo
+	if spec.EndpointInfo != nil {
+		endpointInfo = &types.EndpointInfo{
+			PersistentPeers: spec.EndpointInfo.PersistentPeers,
+			Seeds:           spec.EndpointInfo.Seeds,
+			RpcUrls:         spec.EndpointInfo.RpcUrls,
+			GenesisUrl:      spec.EndpointInfo.GenesisUrl,
+		}
+	}
+
+	var timeoutPeriods *types.ConsumerTimeoutPeriods
+	if spec.TimeoutPeriods != nil {
+		periods := types.ConsumerTimeoutPeriods{
+			CcvTimeoutPeriod:      ccvtypes.DefaultCCVTimeoutPeriod,
+			TransferTimeoutPeriod: ccvtypes.DefaultTransferTimeoutPeriod,
+		}
+		if err := parseDuration(spec.TimeoutPeriods.CcvTimeoutPeriod, &periods.CcvTimeoutPeriod); err != nil {
+			return nil, fmt.Errorf("timeout_periods: ccv_timeout_period: %w", err)
+		}
+		if err := parseDuration(spec.TimeoutPeriods.TransferTimeoutPeriod, &periods.TransferTimeoutPeriod); err != nil {
+			return nil, fmt.Errorf("timeout_periods: transfer_timeout_period: %w", err)
+		}
+		timeoutPeriods = &periods
+	}
+
+	var powerShapingAdmin *types.PowerShapingAdmin
+	if spec.PowerShapingAdmin != nil {
+		powerShapingAdmin = &types.PowerShapingAdmin{Address: *spec.PowerShapingAdmin}
+	}
+
+	var lifetimeExtension *time.Duration
+	if spec.LifetimeExtension != "" {
+		var extension time.Duration
+		if err := parseDuration(spec.LifetimeExtension, &extension); err != nil {
+			return nil, fmt.Errorf("lifetime_extension: %w", err)
+		}
+		lifetimeExtension = &extension
+	}
+
+	var upgradeNotice *types.ConsumerUpgradeNotice
+	if spec.UpgradeNotice != nil {
+		notice := types.ConsumerUpgradeNotice{
+			Name:       spec.UpgradeNotice.Name,
+			Height:     spec.UpgradeNotice.Height,
+			BinaryHash: spec.UpgradeNotice.BinaryHash,
+		}
+		if err := parseTime(spec.UpgradeNotice.Deadline, &notice.Deadline); err != nil {
+			return nil, fmt.Errorf("upgrade_notice: deadline: %w", err)
+		}
+		upgradeNotice = &notice
+	}
+
+	var rewardsPaused *types.RewardsPaused
+	if spec.RewardsPaused != nil {
+		rewardsPaused = &types.RewardsPaused{Paused: *spec.RewardsPaused}
+	}
+
+	var allowedIcaMsgTypes *types.AllowedIcaMsgTypes
+	if spec.AllowedIcaMsgTypes != nil {
+		allowedIcaMsgTypes = &types.AllowedIcaMsgTypes{MsgTypeUrls: spec.AllowedIcaMsgTypes}
+	}
+
+	msg, err := types.NewMsgUpdateConsumer(owner, spec.ConsumerId, spec.NewOwnerAddress, metadata,
+		initializationParameters, powerShapingParameters, allowlistedRewardDenoms, spec.RewardChannelId,
+		endpointInfo, timeoutPeriods, powerShapingAdmin, spec.RetainPowerShapingAdmin, lifetimeExtension,
+		upgradeNotice, rewardsPaused, allowedIcaMsgTypes)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (spec MetadataSpec) toMetadata() types.ConsumerMetadata {
+	return types.ConsumerMetadata{
+		Name:        spec.Name,
+		Description: spec.Description,
+		Metadata:    spec.Metadata,
+		TermsHash:   spec.TermsHash,
+	}
+}
+
+// toInitializationParameters returns the initialization parameters of the spec,
+// where the parameters that are not provided are set to their default values
+func (spec InitializationParametersSpec) toInitializationParameters(chainId string) (types.ConsumerInitializationParameters, error) {
+	params := DefaultInitializationParameters(chainId)
+
+	if spec.InitialHeight != "" {
+		initialHeight, err := clienttypes.ParseHeight(spec.InitialHeight)
+		if err != nil {
+			return params, fmt.Errorf("initial_height: %w", err)
+		}
+		params.InitialHeight = initialHeight
+	}
+
+	var err error
+	if params.GenesisHash, err = parseHash(spec.GenesisHash); err != nil {
+		return params, fmt.Errorf("genesis_hash: %w", err)
+	}
+	if params.BinaryHash, err = parseHash(spec.BinaryHash); err != nil {
+		return params, fmt.Errorf("binary_hash: %w", err)
+	}
+	if err := parseTime(spec.SpawnTime, &params.SpawnTime); err != nil {
+		return params, fmt.Errorf("spawn_time: %w", err)
+	}
+	if err := parseDuration(spec.UnbondingPeriod, &params.UnbondingPeriod); err != nil {
+		return params, fmt.Errorf("unbonding_period: %w", err)
+	}
+	if err := parseDuration(spec.CcvTimeoutPeriod, &params.CcvTimeoutPeriod); err != nil {
+		return params, fmt.Errorf("ccv_timeout_period: %w", err)
+	}
+	if err := parseDuration(spec.TransferTimeoutPeriod, &params.TransferTimeoutPeriod); err != nil {
+		return params, fmt.Errorf("transfer_timeout_period: %w", err)
+	}
+	if err := parseDuration(spec.MaxLifetime, &params.MaxLifetime); err != nil {
+		return params, fmt.Errorf("max_lifetime: %w", err)
+	}
+
+	if spec.ConsumerRedistributionFraction != "" {
+		params.ConsumerRedistributionFraction = spec.ConsumerRedistributionFraction
+	}
+	if spec.BlocksPerDistributionTransmission != nil {
+		params.BlocksPerDistributionTransmission = *spec.BlocksPerDistributionTransmission
+	}
+	if spec.HistoricalEntries != nil {
+		params.HistoricalEntries = *spec.HistoricalEntries
+	}
+	params.DistributionTransmissionChannel = spec.DistributionTransmissionChannel
+	params.ConsumerDenom = spec.ConsumerDenom
+	params.PreCcvEvidenceMinHeight = spec.PreCcvEvidenceMinHeight
+	params.PreCcvClientId = spec.PreCcvClientId
+	params.SignedBlocksWindow = spec.SignedBlocksWindow
+	params.MinSignedPerWindow = spec.MinSignedPerWindow
+	for _, denomFraction := range spec.DenomRedistributionFractions {
+		params.DenomRedistributionFractions = append(params.DenomRedistributionFractions,
+			ccvtypes.DenomRedistributionFraction{Denom: denomFraction.Denom, Fraction: denomFraction.Fraction})
+	}
+
+	return params, nil
+}
+
+func (spec PowerShapingParametersSpec) toPowerShapingParameters() (types.PowerShapingParameters, error) {
+	params := types.PowerShapingParameters{
+		Top_N:                    spec.TopN,
+		ValidatorsPowerCap:       spec.ValidatorsPowerCap,
+		ValidatorSetCap:          spec.ValidatorSetCap,
+		Allowlist:                spec.Allowlist,
+		Denylist:                 spec.Denylist,
+		MinStake:                 spec.MinStake,
+		AllowInactiveVals:        spec.AllowInactiveVals,
+		MaxProviderRank:          spec.MaxProviderRank,
+		AllowTopNEmergencyOptOut: spec.AllowTopNEmergencyOptOut,
+	}
+	if spec.DowntimeGracePeriod != "" {
+		var gracePeriod time.Duration
+		if err := parseDuration(spec.DowntimeGracePeriod, &gracePeriod); err != nil {
+			return params, fmt.Errorf("downtime_grace_period: %w", err)
+		}
+		params.DowntimeGracePeriod = &gracePeriod
+	}
+	if spec.ReOptInCooldownAfterSlash != "" {
+		var cooldown time.Duration
+		if err := parseDuration(spec.ReOptInCooldownAfterSlash, &cooldown); err != nil {
+			return params, fmt.Errorf("re_opt_in_cooldown_after_slash: %w", err)
+		}
+		params.ReOptInCooldownAfterSlash = &cooldown
+	}
+	return params, nil
+}
+
+// parseDuration parses `value` into `duration`, unless `value` is empty
+func parseDuration(value string, duration *time.Duration) error {
+	if value == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("invalid duration (%s), expected e.g. 504h: %w", value, err)
+	}
+	*duration = d
+	return nil
+}
+
+// parseTime parses `value` in the RFC 3339 format into `t`, unless `value` is empty
+func parseTime(value string, t *time.Time) error {
+	if value == "" {
+		return nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return fmt.Errorf("invalid time (%s), expected the RFC 3339 format: %w", value, err)
+	}
+	*t = parsed.UTC()
+	return nil
+}
+
+// parseHash parses the hex encoded `value`, and returns nil if `value` is empty
+func parseHash(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	hash, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex encoded hash (%s): %w", value, err)
+	}
+	return hash, nil
+}
@@ -0,0 +1,508 @@
+package spec_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/client/spec"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+const (
+	submitter   = "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+	consAddr    = "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
+	rewardDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+)
+
+func TestCreateConsumerSpec(t *testing.T) {
+	testCases := []struct {
+		name        string
+		yaml        string
+		expPass     bool
+		expectation func(*testing.T, *types.MsgCreateConsumer)
+	}{
+		{
+			name: "valid spec",
+			yaml: `
+chain_id: consumer-2
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{"stage": "mainnet"}'
+initialization_parameters:
+  initial_height: 2-5
+  genesis_hash: 0a0b
+  binary_hash: 0c0d
+  spawn_time: "2024-08-29T12:26:16Z"
+  unbonding_period: 480h
+  ccv_timeout_period: 336h
+  transfer_timeout_period: 2h
+  consumer_redistribution_fraction: "0.5"
+  blocks_per_distribution_transmission: 500
+  historical_entries: 100
+  max_lifetime: 8760h
+  denom_redistribution_fractions:
+  - denom: untrn
+    fraction: "1"
+power_shaping_parameters:
+  validators_power_cap: 10
+  validator_set_cap: 20
+  allowlist: [` + consAddr + `]
+  min_stake: 1000
+  max_provider_rank: 50
+allowlisted_reward_denoms: [` + rewardDenom + `]
+`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgCreateConsumer) {
+				t.Helper()
+				require.Equal(t, submitter, msg.Submitter)
+				require.Equal(t, "consumer-2", msg.ChainId)
+				require.Equal(t, types.ConsumerMetadata{
+					Name: "chain consumer", Description: "description", Metadata: `{"stage": "mainnet"}`,
+				}, msg.Metadata)
+				require.Equal(t, types.ConsumerInitializationParameters{
+					InitialHeight:                     clienttypes.NewHeight(2, 5),
+					GenesisHash:                       []byte{0x0a, 0x0b},
+					BinaryHash:                        []byte{0x0c, 0x0d},
+					SpawnTime:                         time.Date(2024, 8, 29, 12, 26, 16, 0, time.UTC),
+					UnbondingPeriod:                   480 * time.Hour,
+					CcvTimeoutPeriod:                  336 * time.Hour,
+					TransferTimeoutPeriod:             2 * time.Hour,
+					ConsumerRedistributionFraction:    "0.5",
+					BlocksPerDistributionTransmission: 500,
+					HistoricalEntries:                 100,
+					MaxLifetime:                       8760 * time.Hour,
+					DenomRedistributionFractions:      []ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}},
+				}, *msg.InitializationParameters)
+				require.Equal(t, types.PowerShapingParameters{
+					ValidatorsPowerCap: 10,
+					ValidatorSetCap:    20,
+					Allowlist:          []string{consAddr},
+					MinStake:           1000,
+					MaxProviderRank:    50,
+				}, *msg.PowerShapingParameters)
+				require.Equal(t, []string{rewardDenom}, msg.AllowlistedRewardDenoms.Denoms)
+			},
+		},
+		{
+			name: "partially-filled spec with defaults",
+			yaml: `
+chain_id: consumer-3
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  spawn_time: "2024-08-29T12:26:16Z"
+`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgCreateConsumer) {
+				t.Helper()
+				expected := spec.DefaultInitializationParameters("consumer-3")
+				expected.SpawnTime = time.Date(2024, 8, 29, 12, 26, 16, 0, time.UTC)
+				require.Equal(t, expected, *msg.InitializationParameters)
+				require.Equal(t, clienttypes.NewHeight(3, 1), msg.InitializationParameters.InitialHeight)
+				require.Equal(t, ccvtypes.DefaultCCVTimeoutPeriod, msg.InitializationParameters.CcvTimeoutPeriod)
+				require.Equal(t, ccvtypes.DefaultHistoricalEntries, msg.InitializationParameters.HistoricalEntries)
+				require.Nil(t, msg.PowerShapingParameters)
+				require.Nil(t, msg.AllowlistedRewardDenoms)
+			},
+		},
+		{
+			name: "spec with only the mandatory fields",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgCreateConsumer) {
+				t.Helper()
+				require.Nil(t, msg.InitializationParameters)
+				require.Nil(t, msg.PowerShapingParameters)
+				require.Nil(t, msg.AllowlistedRewardDenoms)
+			},
+		},
+		{
+			name: "explicit zero values are not replaced by defaults",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  historical_entries: 0
+`,
+			expPass: false,
+		},
+		{
+			name: "missing chain id",
+			yaml: `
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+`,
+			expPass: false,
+		},
+		{
+			name:    "missing metadata",
+			yaml:    "chain_id: consumer",
+			expPass: false,
+		},
+		{
+			name: "unknown field",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  unbonding_periods: 480h
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid duration",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  unbonding_period: 20 days
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid spawn time",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  spawn_time: 29/08/2024
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid genesis hash",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  genesis_hash: not-hex
+`,
+			expPass: false,
+		},
+		{
+			name: "initial height that does not match the chain id",
+			yaml: `
+chain_id: consumer-2
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  initial_height: 1-1
+`,
+			expPass: false,
+		},
+		{
+			name: "top N chain",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+power_shaping_parameters:
+  top_n: 95
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid allowlist",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+power_shaping_parameters:
+  allowlist: [invalid]
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid reward denom",
+			yaml: `
+chain_id: consumer
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+allowlisted_reward_denoms: [ibc/invalid]
+`,
+			expPass: false,
+		},
+		{
+			name:    "invalid yaml",
+			yaml:    "chain_id: [consumer",
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consumerSpec, err := spec.ParseCreateConsumerSpec([]byte(tc.yaml))
+			var msg *types.MsgCreateConsumer
+			if err == nil {
+				msg, err = consumerSpec.ToMsg(submitter)
+			}
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			// the message passes the same validation as the one performed before it is handled
+			require.NoError(t, msg.ValidateBasic())
+			tc.expectation(t, msg)
+		})
+	}
+}
+
+func TestUpdateConsumerSpec(t *testing.T) {
+	testCases := []struct {
+		name        string
+		yaml        string
+		chainId     string
+		expPass     bool
+		expectation func(*testing.T, *types.MsgUpdateConsumer)
+	}{
+		{
+			name: "valid spec",
+			yaml: `
+consumer_id: "0"
+new_owner_address: ` + submitter + `
+power_shaping_parameters:
+  top_n: 95
+  downtime_grace_period: 72h
+  allow_top_n_emergency_opt_out: true
+  re_opt_in_cooldown_after_slash: 168h
+reward_channel_id: channel-1
+endpoint_info:
+  rpc_urls: [https://rpc.consumer.io:443]
+timeout_periods:
+  transfer_timeout_period: 2h
+power_shaping_admin: ""
+lifetime_extension: 168h
+upgrade_notice:
+  name: v2.0.0
+  height: 1000000
+  binary_hash: 7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13
+  deadline: "2024-09-30T12:00:00Z"
+rewards_paused: true
+allowed_ica_msg_types: [/cosmos.gov.v1.MsgVote]
+`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgUpdateConsumer) {
+				t.Helper()
+				require.Equal(t, submitter, msg.Owner)
+				require.Equal(t, "0", msg.ConsumerId)
+				require.Equal(t, submitter, msg.NewOwnerAddress)
+				require.Nil(t, msg.Metadata)
+				require.Nil(t, msg.InitializationParameters)
+				require.Equal(t, uint32(95), msg.PowerShapingParameters.Top_N)
+				require.Equal(t, 72*time.Hour, *msg.PowerShapingParameters.DowntimeGracePeriod)
+				require.True(t, msg.PowerShapingParameters.AllowTopNEmergencyOptOut)
+				require.Equal(t, 168*time.Hour, *msg.PowerShapingParameters.ReOptInCooldownAfterSlash)
+				require.Equal(t, "channel-1", msg.RewardChannelId)
+				require.Equal(t, []string{"https://rpc.consumer.io:443"}, msg.EndpointInfo.RpcUrls)
+				// the periods that are not provided are set to their default values
+				require.Equal(t, types.ConsumerTimeoutPeriods{
+					CcvTimeoutPeriod:      ccvtypes.DefaultCCVTimeoutPeriod,
+					TransferTimeoutPeriod: 2 * time.Hour,
+				}, *msg.TimeoutPeriods)
+				require.Equal(t, types.PowerShapingAdmin{}, *msg.PowerShapingAdmin)
+				require.Equal(t, 168*time.Hour, *msg.LifetimeExtension)
+				require.Equal(t, types.ConsumerUpgradeNotice{
+					Name:       "v2.0.0",
+					Height:     1000000,
+					BinaryHash: "7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13",
+					Deadline:   time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC),
+				}, *msg.UpgradeNotice)
+				require.True(t, msg.RewardsPaused.Paused)
+				require.Equal(t, []string{"/cosmos.gov.v1.MsgVote"}, msg.AllowedIcaMsgTypes.MsgTypeUrls)
+			},
+		},
+		{
+			name:    "spec with only the consumer id",
+			yaml:    `consumer_id: "0"`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgUpdateConsumer) {
+				t.Helper()
+				require.Nil(t, msg.PowerShapingParameters)
+				require.Nil(t, msg.TimeoutPeriods)
+				require.Nil(t, msg.PowerShapingAdmin)
+				require.Nil(t, msg.LifetimeExtension)
+				require.Nil(t, msg.RewardsPaused)
+				require.Nil(t, msg.AllowedIcaMsgTypes)
+			},
+		},
+		{
+			name:    "missing consumer id",
+			yaml:    "new_owner_address: " + submitter,
+			expPass: false,
+		},
+		{
+			name:    "invalid consumer id",
+			yaml:    `consumer_id: consumer`,
+			expPass: false,
+		},
+		{
+			name: "invalid timeout period",
+			yaml: `
+consumer_id: "0"
+timeout_periods:
+  ccv_timeout_period: 4 weeks
+`,
+			expPass: false,
+		},
+		{
+			name: "invalid upgrade notice",
+			yaml: `
+consumer_id: "0"
+upgrade_notice:
+  name: v2.0.0
+`,
+			expPass: false,
+		},
+		{
+			name: "duplicate allowed ICA message types",
+			yaml: `
+consumer_id: "0"
+allowed_ica_msg_types: [/cosmos.gov.v1.MsgVote, /cosmos.gov.v1.MsgVote]
+`,
+			expPass: false,
+		},
+		{
+			name: "unknown field",
+			yaml: `
+consumer_id: "0"
+reward_paused: true
+`,
+			expPass: false,
+		},
+		{
+			name: "default initial height derived from the chain id",
+			yaml: `
+consumer_id: "0"
+initialization_parameters:
+  spawn_time: "2030-01-02T15:04:05Z"
+`,
+			chainId: "consumer-1",
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgUpdateConsumer) {
+				t.Helper()
+				// the default initial height passes the validation performed when the message is handled
+				require.Equal(t, clienttypes.NewHeight(1, 1), msg.InitializationParameters.InitialHeight)
+				require.NoError(t, types.ValidateInitialHeight(msg.InitializationParameters.InitialHeight, "consumer-1"))
+			},
+		},
+		{
+			name: "initial height not matching the chain id",
+			yaml: `
+consumer_id: "0"
+initialization_parameters:
+  initial_height: 0-1
+`,
+			chainId: "consumer-1",
+			expPass: false,
+		},
+		{
+			name: "missing initial height without the chain id",
+			yaml: `
+consumer_id: "0"
+initialization_parameters:
+  spawn_time: "2030-01-02T15:04:05Z"
+`,
+			expPass: false,
+		},
+		{
+			name: "initial height without the chain id",
+			yaml: `
+consumer_id: "0"
+initialization_parameters:
+  initial_height: 1-1
+`,
+			expPass: true,
+			expectation: func(t *testing.T, msg *types.MsgUpdateConsumer) {
+				t.Helper()
+				require.Equal(t, clienttypes.NewHeight(1, 1), msg.InitializationParameters.InitialHeight)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consumerSpec, err := spec.ParseUpdateConsumerSpec([]byte(tc.yaml))
+			var msg *types.MsgUpdateConsumer
+			if err == nil {
+				msg, err = consumerSpec.ToMsg(submitter, tc.chainId)
+			}
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, msg.ValidateBasic())
+			tc.expectation(t, msg)
+		})
+	}
+}
+
+func TestSummarizeMsgs(t *testing.T) {
+	createSpec, err := spec.ParseCreateConsumerSpec([]byte(`
+chain_id: consumer-1
+metadata:
+  name: chain consumer
+  description: description
+  metadata: '{}'
+initialization_parameters:
+  unbonding_period: 480h
+`))
+	require.NoError(t, err)
+	createMsg, err := createSpec.ToMsg(submitter)
+	require.NoError(t, err)
+	summary := spec.SummarizeMsgCreateConsumer(createMsg)
+	require.Contains(t, summary, "Create consumer chain consumer-1")
+	require.Contains(t, summary, "Name: chain consumer")
+	require.Contains(t, summary, "Unbonding period: 480h0m0s")
+	require.Contains(t, summary, "Spawn time: not set")
+
+	rewardsPaused := true
+	updateMsg, err := spec.UpdateConsumerSpec{
+		ConsumerId: "0", RewardsPaused: &rewardsPaused, AllowedIcaMsgTypes: []string{"/cosmos.gov.v1.MsgVote"},
+	}.ToMsg(submitter, "")
+	require.NoError(t, err)
+	summary = spec.SummarizeMsgUpdateConsumer(updateMsg)
+	require.Contains(t, summary, "Update consumer chain with id 0")
+	require.Contains(t, summary, "Rewards paused: true")
+	require.Contains(t, summary, "Allowed ICA message types: /cosmos.gov.v1.MsgVote")
+	require.NotContains(t, summary, "Metadata")
+}
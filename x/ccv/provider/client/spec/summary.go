@@ -0,0 +1,162 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// SummarizeMsgCreateConsumer returns a human-readable summary of a MsgCreateConsumer
+func SummarizeMsgCreateConsumer(msg *types.MsgCreateConsumer) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Create consumer chain %s (submitter: %s)\n", msg.ChainId, msg.Submitter)
+	summarizeMetadata(&sb, msg.Metadata)
+	if msg.InitializationParameters != nil {
+		summarizeInitializationParameters(&sb, *msg.InitializationParameters)
+	}
+	if msg.PowerShapingParameters != nil {
+		summarizePowerShapingParameters(&sb, *msg.PowerShapingParameters)
+	}
+	if msg.AllowlistedRewardDenoms != nil {
+		fmt.Fprintf(&sb, "Allowlisted reward denoms: %s\n", summarizeList(msg.AllowlistedRewardDenoms.Denoms))
+	}
+	return sb.String()
+}
+
+// SummarizeMsgUpdateConsumer returns a human-readable summary of a MsgUpdateConsumer,
+// which only contains the values that are updated
+func SummarizeMsgUpdateConsumer(msg *types.MsgUpdateConsumer) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Update consumer chain with id %s (owner: %s)\n", msg.ConsumerId, msg.Owner)
+	if msg.NewOwnerAddress != "" {
+		fmt.Fprintf(&sb, "New owner: %s\n", msg.NewOwnerAddress)
+	}
+	if msg.Metadata != nil {
+		summarizeMetadata(&sb, *msg.Metadata)
+	}
+	if msg.InitializationParameters != nil {
+		summarizeInitializationParameters(&sb, *msg.InitializationParameters)
+	}
+	if msg.PowerShapingParameters != nil {
+		summarizePowerShapingParameters(&sb, *msg.PowerShapingParameters)
+	}
+	if msg.AllowlistedRewardDenoms != nil {
+		fmt.Fprintf(&sb, "Allowlisted reward denoms: %s\n", summarizeList(msg.AllowlistedRewardDenoms.Denoms))
+	}
+	if msg.RewardChannelId != "" {
+		fmt.Fprintf(&sb, "Reward channel: %s\n", msg.RewardChannelId)
+	}
+	if msg.EndpointInfo != nil {
+		fmt.Fprintf(&sb, "Endpoint info:\n")
+		fmt.Fprintf(&sb, "  Persistent peers: %s\n", summarizeList(msg.EndpointInfo.PersistentPeers))
+		fmt.Fprintf(&sb, "  Seeds: %s\n", summarizeList(msg.EndpointInfo.Seeds))
+		fmt.Fprintf(&sb, "  RPC URLs: %s\n", summarizeList(msg.EndpointInfo.RpcUrls))
+		fmt.Fprintf(&sb, "  Genesis URL: %s\n", msg.EndpointInfo.GenesisUrl)
+	}
+	if msg.TimeoutPeriods != nil {
+		fmt.Fprintf(&sb, "Timeout periods:\n")
+		fmt.Fprintf(&sb, "  CCV timeout period: %s\n", msg.TimeoutPeriods.CcvTimeoutPeriod)
+		fmt.Fprintf(&sb, "  Transfer timeout period: %s\n", msg.TimeoutPeriods.TransferTimeoutPeriod)
+	}
+	if msg.PowerShapingAdmin != nil {
+		if msg.PowerShapingAdmin.Address == "" {
+			fmt.Fprintf(&sb, "Power-shaping admin: removed\n")
+		} else {
+			fmt.Fprintf(&sb, "Power-shaping admin: %s\n", msg.PowerShapingAdmin.Address)
+		}
+	}
+	if msg.RetainPowerShapingAdmin {
+		fmt.Fprintf(&sb, "Retain power-shaping admin: true\n")
+	}
+	if msg.LifetimeExtension != nil {
+		fmt.Fprintf(&sb, "Lifetime extension: %s\n", *msg.LifetimeExtension)
+	}
+	if msg.UpgradeNotice != nil {
+		fmt.Fprintf(&sb, "Upgrade notice %s: binary %s at height %d (deadline: %s)\n", msg.UpgradeNotice.Name,
+			msg.UpgradeNotice.BinaryHash, msg.UpgradeNotice.Height, msg.UpgradeNotice.Deadline.Format(time.RFC3339))
+	}
+	if msg.RewardsPaused != nil {
+		fmt.Fprintf(&sb, "Rewards paused: %t\n", msg.RewardsPaused.Paused)
+	}
+	if msg.AllowedIcaMsgTypes != nil {
+		fmt.Fprintf(&sb, "Allowed ICA message types: %s\n", summarizeList(msg.AllowedIcaMsgTypes.MsgTypeUrls))
+	}
+	return sb.String()
+}
+
+func summarizeMetadata(sb *strings.Builder, metadata types.ConsumerMetadata) {
+	fmt.Fprintf(sb, "Metadata:\n")
+	fmt.Fprintf(sb, "  Name: %s\n", metadata.Name)
+	fmt.Fprintf(sb, "  Description: %s\n", metadata.Description)
+	if metadata.Metadata != "" {
+		fmt.Fprintf(sb, "  Metadata: %s\n", metadata.Metadata)
+	}
+	if metadata.TermsHash != "" {
+		fmt.Fprintf(sb, "  Terms hash: %s\n", metadata.TermsHash)
+	}
+}
+
+func summarizeInitializationParameters(sb *strings.Builder, params types.ConsumerInitializationParameters) {
+	fmt.Fprintf(sb, "Initialization parameters:\n")
+	fmt.Fprintf(sb, "  Initial height: %s\n", params.InitialHeight)
+	fmt.Fprintf(sb, "  Genesis hash: %X\n", params.GenesisHash)
+	fmt.Fprintf(sb, "  Binary hash: %X\n", params.BinaryHash)
+	if params.SpawnTime.IsZero() {
+		fmt.Fprintf(sb, "  Spawn time: not set (the chain is not scheduled to launch)\n")
+	} else {
+		fmt.Fprintf(sb, "  Spawn time: %s\n", params.SpawnTime.Format(time.RFC3339))
+	}
+	fmt.Fprintf(sb, "  Unbonding period: %s\n", params.UnbondingPeriod)
+	fmt.Fprintf(sb, "  CCV timeout period: %s\n", params.CcvTimeoutPeriod)
+	fmt.Fprintf(sb, "  Transfer timeout period: %s\n", params.TransferTimeoutPeriod)
+	fmt.Fprintf(sb, "  Consumer redistribution fraction: %s\n", params.ConsumerRedistributionFraction)
+	for _, denomFraction := range params.DenomRedistributionFractions {
+		fmt.Fprintf(sb, "  Consumer redistribution fraction of %s: %s\n", denomFraction.Denom, denomFraction.Fraction)
+	}
+	fmt.Fprintf(sb, "  Blocks per distribution transmission: %d\n", params.BlocksPerDistributionTransmission)
+	fmt.Fprintf(sb, "  Historical entries: %d\n", params.HistoricalEntries)
+	if params.DistributionTransmissionChannel != "" {
+		fmt.Fprintf(sb, "  Distribution transmission channel: %s\n", params.DistributionTransmissionChannel)
+	}
+	if params.ConsumerDenom != "" {
+		fmt.Fprintf(sb, "  Consumer denom: %s\n", params.ConsumerDenom)
+	}
+	if params.MaxLifetime != 0 {
+		fmt.Fprintf(sb, "  Max lifetime: %s\n", params.MaxLifetime)
+	}
+	if params.SignedBlocksWindow != 0 {
+		fmt.Fprintf(sb, "  Downtime window: %d blocks (min signed per window: %s)\n",
+			params.SignedBlocksWindow, params.MinSignedPerWindow)
+	}
+	if params.PreCcvEvidenceMinHeight != 0 {
+		fmt.Fprintf(sb, "  Pre-CCV evidence: from height %d (client: %s)\n", params.PreCcvEvidenceMinHeight, params.PreCcvClientId)
+	}
+}
+
+func summarizePowerShapingParameters(sb *strings.Builder, params types.PowerShapingParameters) {
+	fmt.Fprintf(sb, "Power-shaping parameters:\n")
+	fmt.Fprintf(sb, "  Top N: %d\n", params.Top_N)
+	fmt.Fprintf(sb, "  Validators power cap: %d\n", params.ValidatorsPowerCap)
+	fmt.Fprintf(sb, "  Validator set cap: %d\n", params.ValidatorSetCap)
+	fmt.Fprintf(sb, "  Allowlist: %s\n", summarizeList(params.Allowlist))
+	fmt.Fprintf(sb, "  Denylist: %s\n", summarizeList(params.Denylist))
+	fmt.Fprintf(sb, "  Min stake: %d\n", params.MinStake)
+	fmt.Fprintf(sb, "  Allow inactive validators: %t\n", params.AllowInactiveVals)
+	fmt.Fprintf(sb, "  Max provider rank: %d\n", params.MaxProviderRank)
+	fmt.Fprintf(sb, "  Allow Top N emergency opt out: %t\n", params.AllowTopNEmergencyOptOut)
+	if params.DowntimeGracePeriod != nil {
+		fmt.Fprintf(sb, "  Downtime grace period: %s\n", *params.DowntimeGracePeriod)
+	}
+	if params.ReOptInCooldownAfterSlash != nil {
+		fmt.Fprintf(sb, "  Re-opt-in cooldown after slash: %s\n", *params.ReOptInCooldownAfterSlash)
+	}
+}
+
+func summarizeList(list []string) string {
+	if len(list) == 0 {
+		return "none"
+	}
+	return strings.Join(list, ", ")
+}
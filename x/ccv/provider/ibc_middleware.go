@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -108,7 +110,11 @@ func (im IBCMiddleware) OnChanCloseConfirm(
 // OnRecvPacket executes the IBC transfer. In case of success,
 // it verifies if the packet sender is a consumer chain
 // and if the received IBC coin is whitelisted. In such instances,
-// it appends the coin to the consumer's chain allocation record
+// it appends the coin to the consumer's chain allocation record.
+// If the reward memo breaks the coin down by consumer validator, the
+// shares are attributed to the corresponding provider validators.
+// The decision on every transfer to the consumer rewards pool is
+// recorded through a reward attribution event (and log entry)
 func (im IBCMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -134,12 +140,24 @@ func (im IBCMiddleware) OnRecvPacket(
 			return ack
 		}
 
+		coinAmt, _ := math.NewIntFromString(data.Amount)
+		coinDenom := GetProviderDenom(data.Denom, packet)
+
+		recordAttribution := func(consumerId string, decision types.RewardAttributionDecision, reason string) {
+			im.keeper.RecordRewardAttribution(ctx, consumerId, packet.DestinationChannel, data.Sender,
+				coinDenom, data.Amount, decision, reason)
+		}
+
 		consumerId := ""
+		// whether the transfer was received on a channel known to belong to the consumer chain
+		fromConsumer := false
 
 		// check if the transfer has the reward memo
-		if rewardMemo, err := ccvtypes.GetRewardMemoFromTransferMemo(data.Memo); err != nil {
+		rewardMemo, memoErr := ccvtypes.GetRewardMemoFromTransferMemo(data.Memo)
+		if memoErr != nil {
 			// check if the transfer is on a channel with the same underlying
 			// client as the CCV channel
+			var err error
 			consumerId, err = im.keeper.IdentifyConsumerIdFromIBCPacket(ctx, packet)
 			if err != nil {
 				// Check if the packet is received on a canonical transfer channels
@@ -153,6 +171,8 @@ func (im IBCMiddleware) OnRecvPacket(
 					srcChainId, err := im.keeper.GetSourceChainIdFromIBCPacket(ctx, packet)
 					if err != nil || srcChainId != "stride-1" {
 						// ignore packet if it's not from Stride
+						recordAttribution("", types.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER,
+							"transfer is not from a consumer chain")
 						return ack
 					}
 					// accept the packet as a potential ICS reward
@@ -160,8 +180,11 @@ func (im IBCMiddleware) OnRecvPacket(
 					// sanity check: make sure this is the consumer ID for Stride
 					strideChainId, err := im.keeper.GetConsumerChainId(ctx, consumerId)
 					if err != nil || srcChainId != strideChainId {
+						recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER,
+							"transfer is not from a consumer chain")
 						return ack
 					}
+					fromConsumer = true
 				} else {
 					if data.Memo == "consumer chain rewards distribution" {
 						// log error message
@@ -173,6 +196,11 @@ func (im IBCMiddleware) OnRecvPacket(
 						)
 					}
 
+					if data.Memo != "" {
+						recordAttribution("", types.REWARD_ATTRIBUTION_DECISION_MALFORMED_MEMO, memoErr.Error())
+					} else {
+						recordAttribution("", types.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER, err.Error())
+					}
 					return ack
 				}
 			}
@@ -190,11 +218,62 @@ func (im IBCMiddleware) OnRecvPacket(
 				"fungibleTokenPacketData", data.String(),
 				"error", err.Error(),
 			)
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER, err.Error())
 			return ack
 		}
 
-		coinAmt, _ := math.NewIntFromString(data.Amount)
-		coinDenom := GetProviderDenom(data.Denom, packet)
+		// record the transfer as a packet received from the consumer chain only if it was received
+		// on a channel with the same underlying client as the CCV channel, i.e., a memo cannot be
+		// used to keep a consumer chain from becoming dormant
+		if packetConsumerId, err := im.keeper.IdentifyConsumerIdFromIBCPacket(ctx, packet); err == nil && packetConsumerId == consumerId {
+			im.keeper.RecordConsumerActivity(ctx, consumerId)
+			fromConsumer = true
+		}
+
+		// divert the rewards to the community pool if they were not received on the reward channel
+		// of the consumer chain, i.e., a memo cannot be used to impersonate a consumer chain
+		if !im.keeper.CheckConsumerRewardChannel(ctx, consumerId, packet.DestinationChannel, fromConsumer) {
+			rewardChannelId, _ := im.keeper.GetConsumerRewardChannel(ctx, consumerId)
+			logger.Error(
+				"received ICS rewards on a channel other than the reward channel of the consumer chain",
+				"consumerId", consumerId,
+				"chainId", chainId,
+				"rewardChannelId", rewardChannelId,
+				"receivedChannelId", packet.DestinationChannel,
+				"denom", coinDenom,
+				"amount", data.Amount,
+			)
+
+			err := im.keeper.DivertRewardsToCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(coinDenom, coinAmt)))
+			if err != nil {
+				logger.Error(
+					"cannot divert ICS rewards to the community pool",
+					"consumerId", consumerId,
+					"packet", packet.String(),
+					"fungibleTokenPacketData", data.String(),
+					"error", err.Error(),
+				)
+				// no attribution is recorded, since the state changes (and events)
+				// of an error acknowledgement are discarded
+				return channeltypes.NewErrorAcknowledgement(err)
+			}
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDivertedRewards,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+					sdk.NewAttribute(types.AttributeRewardChannelId, rewardChannelId),
+					sdk.NewAttribute(types.AttributeReceivedChannelId, packet.DestinationChannel),
+					sdk.NewAttribute(types.AttributeRewardDenom, coinDenom),
+					sdk.NewAttribute(types.AttributeRewardAmount, data.Amount),
+				),
+			)
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_DIVERTED,
+				fmt.Sprintf("received on channel %s instead of reward channel %s", packet.DestinationChannel, rewardChannelId))
+			return ack
+		}
 		logger.Info(
 			"received ICS rewards from consumer chain",
 			"consumerId", consumerId,
@@ -225,6 +304,7 @@ func (im IBCMiddleware) OnRecvPacket(
 				"denom", coinDenom,
 				"error", err.Error(),
 			)
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_FAILED, err.Error())
 			return ack
 		}
 
@@ -233,6 +313,27 @@ func (im IBCMiddleware) OnRecvPacket(
 				Denom:  coinDenom,
 				Amount: coinAmt,
 			}))
+
+		// attribute the rewards to specific validators if the memo breaks them down by consumer validator;
+		// otherwise, or if the breakdown is malformed, the rewards are distributed pro rata
+		creditedReason := ""
+		if rewardMemo.HasBreakdown() {
+			consumerAddrs, amounts, err := rewardMemo.ValidateBreakdown(coinAmt)
+			if err != nil {
+				logger.Error(
+					"malformed per-validator breakdown of ICS rewards, rewards are distributed pro rata",
+					"consumerId", consumerId,
+					"chainId", chainId,
+					"denom", coinDenom,
+					"amount", data.Amount,
+					"error", err.Error(),
+				)
+				creditedReason = fmt.Sprintf("malformed per-validator breakdown: %s", err.Error())
+			} else {
+				attributed := im.keeper.AttributeRewardsToValidators(ctx, consumerId, &alloc, coinDenom, consumerAddrs, amounts)
+				eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeRewardAttributed, attributed.String()))
+			}
+		}
 		err = im.keeper.SetConsumerRewardsAllocationByDenom(ctx, consumerId, coinDenom, alloc)
 		if err != nil {
 			logger.Error(
@@ -243,6 +344,7 @@ func (im IBCMiddleware) OnRecvPacket(
 				"denom", coinDenom,
 				"error", err.Error(),
 			)
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_FAILED, err.Error())
 			return ack
 		}
 
@@ -263,6 +365,15 @@ func (im IBCMiddleware) OnRecvPacket(
 				eventAttributes...,
 			),
 		)
+
+		// the rewards of denoms that are not allowlisted are recorded, but are
+		// not distributed unless the denom is allowlisted
+		if im.keeper.IsAllowlistedRewardDenom(ctx, consumerId, coinDenom) {
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_CREDITED, creditedReason)
+		} else {
+			recordAttribution(consumerId, types.REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED,
+				fmt.Sprintf("denom %s is not allowlisted", coinDenom))
+		}
 	}
 
 	return ack
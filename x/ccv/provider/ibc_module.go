@@ -65,10 +65,9 @@ func (am AppModule) OnChanOpenTry(
 	}
 
 	// ensure the counter party version matches the expected version
-	if counterpartyVersion != ccv.Version {
-		return "", errorsmod.Wrapf(
-			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
-			counterpartyVersion, ccv.Version)
+	consumerMetadata, err := parseConsumerHandshakeMetadata(counterpartyVersion)
+	if err != nil {
+		return "", err
 	}
 
 	// Claim channel capability
@@ -84,6 +83,21 @@ func (am AppModule) OnChanOpenTry(
 		return "", err
 	}
 
+	if consumerMetadata != nil {
+		// the consumer chain must run with the unbonding period in its initialization parameters
+		if err := am.keeper.VerifyConsumerUnbondingPeriod(
+			ctx, connectionHops, *consumerMetadata.ConsumerUnbondingPeriod,
+		); err != nil {
+			return "", err
+		}
+		// provider upgrade notices are only sent to the consumer chains that accept them
+		if consumerMetadata.AcceptsProviderUpgradeNotices {
+			if err := am.keeper.RecordConsumerAcceptsUpgradeNotices(ctx, connectionHops); err != nil {
+				return "", err
+			}
+		}
+	}
+
 	md := ccv.HandshakeMetadata{
 		// NOTE that the fee pool collector address string provided to the
 		// the consumer chain must be excluded from the blocked addresses
@@ -100,6 +114,32 @@ func (am AppModule) OnChanOpenTry(
 	return string(mdBz), nil
 }
 
+// parseConsumerHandshakeMetadata parses the JSON encoded metadata declared by the consumer chain in OnChanOpenInit,
+// which contains the unbonding period of the consumer chain. Consumer chains that do not declare their metadata
+// (i.e., that run a previous ICS version) use the plain CCV version, in which case nil is returned.
+func parseConsumerHandshakeMetadata(counterpartyVersion string) (*ccv.HandshakeMetadata, error) {
+	if counterpartyVersion == ccv.Version {
+		return nil, nil
+	}
+
+	var md ccv.HandshakeMetadata
+	if err := ccv.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &md); err != nil {
+		return nil, errorsmod.Wrapf(
+			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
+			counterpartyVersion, ccv.Version)
+	}
+	if md.Version != ccv.Version {
+		return nil, errorsmod.Wrapf(
+			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
+			md.Version, ccv.Version)
+	}
+	if md.ConsumerUnbondingPeriod == nil {
+		return nil, errorsmod.Wrap(ccv.ErrInvalidHandshakeMetadata,
+			"consumer handshake metadata does not declare the consumer unbonding period")
+	}
+	return &md, nil
+}
+
 // validateCCVChannelParams validates a ccv channel
 func validateCCVChannelParams(
 	ctx sdk.Context,
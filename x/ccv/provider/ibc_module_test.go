@@ -2,6 +2,7 @@ package provider_test
 
 import (
 	"testing"
+	"time"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -47,22 +48,24 @@ func TestOnChanOpenInit(t *testing.T) {
 	require.Error(t, err, "OnChanOpenInit must error on provider chain")
 }
 
+// onChanOpenTryParams are the params for the ChanOpenTry method
+type onChanOpenTryParams struct {
+	ctx                 sdk.Context
+	order               channeltypes.Order
+	connectionHops      []string
+	portID              string
+	channelID           string
+	chanCap             *capabilitytypes.Capability
+	counterparty        channeltypes.Counterparty
+	counterpartyVersion string
+}
+
 // TestOnChanOpenTry validates the provider's OnChanOpenTry implementation against the spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-cotry1
 // Spec tag: [CCV-PCF-COTRY.1]
 func TestOnChanOpenTry(t *testing.T) {
-	// Params for the ChanOpenTry method
-	type params struct {
-		ctx                 sdk.Context
-		order               channeltypes.Order
-		connectionHops      []string
-		portID              string
-		channelID           string
-		chanCap             *capabilitytypes.Capability
-		counterparty        channeltypes.Counterparty
-		counterpartyVersion string
-	}
+	type params = onChanOpenTryParams
 
 	testCases := []struct {
 		name         string
@@ -92,6 +95,45 @@ func TestOnChanOpenTry(t *testing.T) {
 				params.counterpartyVersion = "invalidVersion"
 			}, false,
 		},
+		{
+			"success with consumer unbonding period", func(params *params, keeper *providerkeeper.Keeper) {
+				setConsumerUnbondingPeriod(t, params, keeper, 21*24*time.Hour, 21*24*time.Hour)
+			}, true,
+		},
+		{
+			"success with consumer unbonding period within the tolerated deviation", func(params *params, keeper *providerkeeper.Keeper) {
+				setConsumerUnbondingPeriod(t, params, keeper, 21*24*time.Hour,
+					21*24*time.Hour-providertypes.MaxConsumerUnbondingPeriodDeviation)
+			}, true,
+		},
+		{
+			"consumer unbonding period shorter than in the initialization parameters", func(params *params, keeper *providerkeeper.Keeper) {
+				setConsumerUnbondingPeriod(t, params, keeper, 21*24*time.Hour, 14*24*time.Hour)
+			}, false,
+		},
+		{
+			"consumer unbonding period longer than in the initialization parameters", func(params *params, keeper *providerkeeper.Keeper) {
+				setConsumerUnbondingPeriod(t, params, keeper, 21*24*time.Hour,
+					21*24*time.Hour+providertypes.MaxConsumerUnbondingPeriodDeviation+time.Nanosecond)
+			}, false,
+		},
+		{
+			"consumer handshake metadata without unbonding period", func(params *params, keeper *providerkeeper.Keeper) {
+				md := ccv.HandshakeMetadata{Version: ccv.Version}
+				bz, err := ccv.ModuleCdc.MarshalJSON(&md)
+				require.NoError(t, err)
+				params.counterpartyVersion = string(bz)
+			}, false,
+		},
+		{
+			"consumer handshake metadata with invalid version", func(params *params, keeper *providerkeeper.Keeper) {
+				unbondingPeriod := 21 * 24 * time.Hour
+				md := ccv.HandshakeMetadata{Version: "invalidVersion", ConsumerUnbondingPeriod: &unbondingPeriod}
+				bz, err := ccv.ModuleCdc.MarshalJSON(&md)
+				require.NoError(t, err)
+				params.counterpartyVersion = string(bz)
+			}, false,
+		},
 		{
 			"unexpected client ID mapped to chain ID", func(params *params, keeper *providerkeeper.Keeper) {
 				keeper.SetConsumerClientId(
@@ -140,18 +182,18 @@ func TestOnChanOpenTry(t *testing.T) {
 		moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
 		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
 
-		// Number of calls is not asserted, since not all code paths are hit for failures
-		gomock.InOrder(
-			mocks.MockScopedKeeper.EXPECT().ClaimCapability(
-				params.ctx, params.chanCap, host.ChannelCapabilityPath(params.portID, params.channelID)).AnyTimes(),
-			mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
-				conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
-			).AnyTimes(),
-			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
-				&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
-			).AnyTimes(),
-			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes(),
-		)
+		// Number of calls is not asserted, since not all code paths are hit for failures.
+		// The underlying client is retrieved again when verifying the consumer unbonding period,
+		// hence the calls are not asserted to be in order.
+		mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+			params.ctx, params.chanCap, host.ChannelCapabilityPath(params.portID, params.channelID)).AnyTimes()
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+			conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+		).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
+			&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+		).AnyTimes()
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes()
 
 		tc.mutateParams(&params, &providerKeeper)
 
@@ -181,6 +223,83 @@ func TestOnChanOpenTry(t *testing.T) {
 	}
 }
 
+// setConsumerUnbondingPeriod sets the unbonding period in the initialization parameters of the consumer chain
+// and makes the consumer chain declare `consumerUnbondingPeriod` in its handshake metadata
+func setConsumerUnbondingPeriod(
+	t *testing.T,
+	params *onChanOpenTryParams,
+	keeper *providerkeeper.Keeper,
+	initUnbondingPeriod, consumerUnbondingPeriod time.Duration,
+) {
+	t.Helper()
+	keeper.SetConsumerChainId(params.ctx, "consumerId", "consumerChainID")
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.UnbondingPeriod = initUnbondingPeriod
+	require.NoError(t, keeper.SetConsumerInitializationParameters(params.ctx, "consumerId", initializationParameters))
+
+	md := ccv.HandshakeMetadata{Version: ccv.Version, ConsumerUnbondingPeriod: &consumerUnbondingPeriod}
+	bz, err := ccv.ModuleCdc.MarshalJSON(&md)
+	require.NoError(t, err)
+	params.counterpartyVersion = string(bz)
+}
+
+// TestOnChanOpenTryAcceptsUpgradeNotices tests that the provider records whether
+// the consumer chain accepts provider upgrade notices, as declared in its handshake metadata
+func TestOnChanOpenTryAcceptsUpgradeNotices(t *testing.T) {
+	for _, accepts := range []bool{false, true} {
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+			t, keeperParams)
+		providerModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey)
+
+		providerKeeper.SetPort(ctx, ccv.ProviderPortID)
+		providerKeeper.SetConsumerClientId(ctx, "consumerId", "clientIdToConsumer")
+
+		params := onChanOpenTryParams{
+			ctx:            ctx,
+			order:          channeltypes.ORDERED,
+			connectionHops: []string{"connectionIDToConsumer"},
+			portID:         ccv.ProviderPortID,
+			channelID:      "providerChannelID",
+			chanCap:        &capabilitytypes.Capability{},
+			counterparty:   channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
+		}
+		setConsumerUnbondingPeriod(t, &params, &providerKeeper, 21*24*time.Hour, 21*24*time.Hour)
+		md := ccv.HandshakeMetadata{}
+		require.NoError(t, ccv.ModuleCdc.UnmarshalJSON([]byte(params.counterpartyVersion), &md))
+		md.AcceptsProviderUpgradeNotices = accepts
+		bz, err := ccv.ModuleCdc.MarshalJSON(&md)
+		require.NoError(t, err)
+		params.counterpartyVersion = string(bz)
+
+		moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
+		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
+		mocks.MockScopedKeeper.EXPECT().ClaimCapability(
+			params.ctx, params.chanCap, host.ChannelCapabilityPath(params.portID, params.channelID)).AnyTimes()
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+			conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+		).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
+			&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+		).AnyTimes()
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes()
+
+		_, err = providerModule.OnChanOpenTry(
+			params.ctx,
+			params.order,
+			params.connectionHops,
+			params.portID,
+			params.channelID,
+			params.chanCap,
+			params.counterparty,
+			params.counterpartyVersion,
+		)
+		require.NoError(t, err)
+		require.Equal(t, accepts, providerKeeper.ConsumerAcceptsUpgradeNotices(ctx, "consumerId"))
+		ctrl.Finish()
+	}
+}
+
 // TestOnChanOpenAck tests the provider's OnChanOpenAck method against spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-coack1
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/gogoproto/proto"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+var _ porttypes.Middleware = &ICAControllerMiddleware{}
+
+// ICAControllerMiddleware is an ICS-27 middleware that filters the packets sent by the interchain accounts
+// controller on the channels registered to a consumer chain, i.e., on the interchain accounts controller
+// channels owned by the owner of the consumer chain (see GetConsumerIdOfIcaControllerChannel). The packets
+// that contain message types that are not allowed on the consumer chain (see MsgUpdateConsumer) are rejected.
+//
+// The middleware must wrap both the interchain accounts controller module (i.e., the underlying application)
+// and the ICS4Wrapper passed to the interchain accounts controller keeper, as the packets are sent by the keeper.
+type ICAControllerMiddleware struct {
+	app         porttypes.IBCModule
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewICAControllerMiddleware creates a new ICAControllerMiddleware given the provider keeper,
+// the underlying application and the underlying ICS4Wrapper (e.g., the channel keeper)
+func NewICAControllerMiddleware(app porttypes.IBCModule, ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) ICAControllerMiddleware {
+	return ICAControllerMiddleware{
+		app:         app,
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// OnChanOpenInit implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	return im.app.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCMiddleware interface
+func (im ICAControllerMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
+
+// SendPacket implements the ICS4 Wrapper interface. If the packet is sent on a channel registered
+// to a consumer chain, it checks that the messages of the packet are allowed on the consumer chain
+// before passing the packet to the underlying ICS4Wrapper.
+func (im ICAControllerMiddleware) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if consumerId, found := im.keeper.GetConsumerIdOfIcaControllerChannel(ctx, sourcePort, sourceChannel); found {
+		if err := im.validateIcaPacketData(ctx, consumerId, sourcePort, sourceChannel, data); err != nil {
+			im.keeper.Logger(ctx).Info("interchain account packet rejected",
+				"consumerId", consumerId,
+				"port", sourcePort,
+				"channel", sourceChannel,
+				"error", err.Error(),
+			)
+			return 0, err
+		}
+	}
+	return im.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4 Wrapper interface
+func (im ICAControllerMiddleware) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return im.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the underlying application
+func (im ICAControllerMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// validateIcaPacketData checks that the messages of the interchain accounts packet `data` sent on the channel
+// with `portID` and `channelID` are allowed on the consumer chain with `consumerId`
+func (im ICAControllerMiddleware) validateIcaPacketData(
+	ctx sdk.Context,
+	consumerId string,
+	portID string,
+	channelID string,
+	data []byte,
+) error {
+	allowedMsgTypes, err := im.keeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if len(allowedMsgTypes) == 0 {
+		// the message types are not restricted
+		return nil
+	}
+
+	var packetData icatypes.InterchainAccountPacketData
+	if err := icatypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return errorsmod.Wrapf(types.ErrIcaMsgTypeNotAllowed, "cannot unmarshal interchain account packet data: %s", err.Error())
+	}
+	if packetData.Type != icatypes.EXECUTE_TX {
+		return errorsmod.Wrapf(types.ErrIcaMsgTypeNotAllowed, "unsupported interchain account packet type %s", packetData.Type)
+	}
+
+	appVersion, found := im.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+	metadata, err := icatypes.MetadataFromVersion(appVersion)
+	if err != nil {
+		return err
+	}
+
+	msgTypeUrls, err := icaMsgTypeUrls(packetData.Data, metadata.Encoding)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrIcaMsgTypeNotAllowed, "cannot decode interchain account messages: %s", err.Error())
+	}
+	return im.keeper.ValidateIcaMsgTypes(ctx, consumerId, msgTypeUrls)
+}
+
+// icaMsgTypeUrls returns the type URLs of the messages of the CosmosTx `data` with the given `encoding`.
+// Note that the messages are not unpacked, as their types are not necessarily registered on the provider.
+func icaMsgTypeUrls(data []byte, encoding string) ([]string, error) {
+	msgTypeUrls := []string{}
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		var cosmosTx icatypes.CosmosTx
+		if err := proto.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+		for _, msg := range cosmosTx.Messages {
+			msgTypeUrls = append(msgTypeUrls, msg.TypeUrl)
+		}
+	case icatypes.EncodingProto3JSON:
+		var cosmosTx struct {
+			Messages []struct {
+				TypeUrl string `json:"@type"`
+			} `json:"messages"`
+		}
+		if err := json.Unmarshal(data, &cosmosTx); err != nil {
+			return nil, err
+		}
+		for _, msg := range cosmosTx.Messages {
+			msgTypeUrls = append(msgTypeUrls, msg.TypeUrl)
+		}
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+	return msgTypeUrls, nil
+}
@@ -0,0 +1,184 @@
+package provider_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/gogoproto/proto"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// mockICS4Wrapper records the packets that are passed to it
+type mockICS4Wrapper struct {
+	appVersion  string
+	sentPackets [][]byte
+}
+
+func (w *mockICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	w.sentPackets = append(w.sentPackets, data)
+	return uint64(len(w.sentPackets)), nil
+}
+
+func (w *mockICS4Wrapper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return nil
+}
+
+func (w *mockICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return w.appVersion, true
+}
+
+// icaPacketData returns the data of an interchain accounts packet that executes messages with `msgTypeUrls`
+func icaPacketData(t *testing.T, encoding string, msgTypeUrls ...string) []byte {
+	t.Helper()
+
+	var bz []byte
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		cosmosTx := icatypes.CosmosTx{}
+		for _, msgTypeUrl := range msgTypeUrls {
+			cosmosTx.Messages = append(cosmosTx.Messages, &codectypes.Any{TypeUrl: msgTypeUrl})
+		}
+		var err error
+		bz, err = proto.Marshal(&cosmosTx)
+		require.NoError(t, err)
+	case icatypes.EncodingProto3JSON:
+		bz = []byte(`{"messages":[`)
+		for i, msgTypeUrl := range msgTypeUrls {
+			if i > 0 {
+				bz = append(bz, ',')
+			}
+			bz = append(bz, []byte(`{"@type":"`+msgTypeUrl+`"}`)...)
+		}
+		bz = append(bz, []byte(`]}`)...)
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+	return packetData.GetBytes()
+}
+
+// TestICAControllerMiddlewareSendPacket tests that the interchain accounts packets sent on the channels registered
+// to a consumer chain are filtered according to the allowed interchain account message types of the consumer chain
+func TestICAControllerMiddlewareSendPacket(t *testing.T) {
+	consumerId := "0"
+	owner := "owner"
+	portId := icatypes.ControllerPortPrefix + owner
+	channelId := "channel-0"
+	clientId := "07-tendermint-0"
+	allowedMsgType := "/cosmos.gov.v1.MsgVote"
+	otherMsgType := "/cosmos.bank.v1beta1.MsgSend"
+
+	testCases := []struct {
+		name         string
+		portId       string
+		allowed      []string
+		msgTypeUrls  []string
+		expFiltered  bool
+		registerChan bool
+	}{
+		{
+			"no restriction",
+			portId,
+			[]string{},
+			[]string{allowedMsgType, otherMsgType},
+			false,
+			true,
+		},
+		{
+			"allowed message types",
+			portId,
+			[]string{allowedMsgType},
+			[]string{allowedMsgType, allowedMsgType},
+			false,
+			true,
+		},
+		{
+			"message type not allowed",
+			portId,
+			[]string{allowedMsgType},
+			[]string{allowedMsgType, otherMsgType},
+			true,
+			true,
+		},
+		{
+			"channel not registered to the consumer chain",
+			"icacontroller-other-owner",
+			[]string{allowedMsgType},
+			[]string{otherMsgType},
+			false,
+			false,
+		},
+	}
+
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		for _, tc := range testCases {
+			t.Run(encoding+"/"+tc.name, func(t *testing.T) {
+				providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+				defer ctrl.Finish()
+
+				providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
+				providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, owner)
+				require.NoError(t, providerKeeper.UpdateConsumerAllowedIcaMsgTypes(ctx, consumerId, tc.allowed))
+
+				mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, tc.portId, channelId).
+					Return(channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true).Times(1)
+				mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-0").
+					Return(conntypes.ConnectionEnd{ClientId: clientId}, true).Times(1)
+				mocks.MockClientKeeper.EXPECT().GetClientState(ctx, clientId).
+					Return(&ibctmtypes.ClientState{}, true).Times(1)
+
+				metadata := icatypes.Metadata{
+					Version:  icatypes.Version,
+					Encoding: encoding,
+					TxType:   icatypes.TxTypeSDKMultiMsg,
+				}
+				ics4Wrapper := &mockICS4Wrapper{appVersion: string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))}
+				middleware := provider.NewICAControllerMiddleware(nil, ics4Wrapper, providerKeeper)
+
+				data := icaPacketData(t, encoding, tc.msgTypeUrls...)
+				_, err := middleware.SendPacket(ctx, nil, tc.portId, channelId, clienttypes.NewHeight(1, 100), 0, data)
+				if tc.expFiltered {
+					require.ErrorIs(t, err, providertypes.ErrIcaMsgTypeNotAllowed)
+					require.Empty(t, ics4Wrapper.sentPackets)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, [][]byte{data}, ics4Wrapper.sentPackets)
+				}
+			})
+		}
+	}
+
+	// packets sent on other ports are not filtered
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ics4Wrapper := &mockICS4Wrapper{}
+	middleware := provider.NewICAControllerMiddleware(nil, ics4Wrapper, providerKeeper)
+	_, err := middleware.SendPacket(ctx, nil, "transfer", channelId, clienttypes.NewHeight(1, 100), 0, []byte("data"))
+	require.NoError(t, err)
+	require.Len(t, ics4Wrapper.sentPackets, 1)
+}
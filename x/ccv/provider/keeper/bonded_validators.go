@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// BondedValidator is a bonded validator of the provider chain together with the data derived from it
+// that is needed to compute the validator sets of the consumer chains
+type BondedValidator struct {
+	Validator stakingtypes.Validator
+	// the consensus address of the validator on the provider chain
+	ProviderAddr types.ProviderConsAddress
+	// the last validator power of the validator in the staking module
+	Power int64
+	// the power of the validator that counts towards the Top N of the consumer chains, i.e., its last
+	// validator power without the power of its tokenized shares if ExcludeTokenizedSharesFromTopN is
+	// enabled, and its last validator power otherwise (see GetTopNPower)
+	TopNPower int64
+}
+
+// BondedValidatorSet contains the last bonded validators of the provider chain and the provider active validators,
+// i.e., the bonded validators that participate in the consensus of the provider chain.
+//
+// The validator set is retrieved and converted once per block (see GetLastBondedValidatorSet) and shared across
+// the computations of the validator sets of all the consumer chains. Note that the validators must not be modified.
+type BondedValidatorSet struct {
+	// the last bonded validators sorted by bonded tokens in descending order
+	Bonded []BondedValidator
+	// the provider active validators sorted by power in descending order
+	Active []BondedValidator
+	// the ranks (starting from 0) of the bonded validators by bonded tokens, with ties broken by provider
+	// consensus address, indexed by provider consensus address (see ComputeValidatorsWithinMaxProviderRank)
+	ranks map[string]int
+	// the participations of the validators in the launched consumer chains (see GetConsumerParticipations)
+	participations *ConsumerParticipations
+}
+
+// GetLastBondedValidatorSet returns the last bonded validators and the provider active validators. Both are retrieved
+// with a single iteration over the bonded validators in the staking module, and the last validator power of every
+// validator is retrieved only once.
+func (k Keeper) GetLastBondedValidatorSet(ctx sdk.Context) (BondedValidatorSet, error) {
+	maxVals, err := k.stakingKeeper.MaxValidators(ctx)
+	if err != nil {
+		return BondedValidatorSet{}, err
+	}
+	maxProviderConsensusVals := k.GetMaxProviderConsensusValidators(ctx)
+
+	// get the bonded validators from the staking module, sorted by power
+	validators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	if err != nil {
+		return BondedValidatorSet{}, err
+	}
+
+	// the last bonded validators are the first `MaxValidators` validators, while the provider
+	// active validators are the first `MaxProviderConsensusValidators` validators
+	numBonded := min(len(validators), int(maxVals))
+	numActive := min(int64(len(validators)), maxProviderConsensusVals)
+	bondedValidators, err := k.toBondedValidators(ctx, validators[:max(numBonded, int(numActive))])
+	if err != nil {
+		return BondedValidatorSet{}, err
+	}
+
+	validatorSet := newBondedValidatorSet(bondedValidators[:numBonded], bondedValidators[:numActive])
+	validatorSet.participations = k.GetConsumerParticipations(ctx)
+	return validatorSet, nil
+}
+
+// NewBondedValidatorSet returns the BondedValidatorSet with the given last bonded validators and provider
+// active validators, which are retrieved by the caller (e.g., with GetLastBondedValidators and
+// GetLastProviderConsensusActiveValidators, respectively)
+func (k Keeper) NewBondedValidatorSet(
+	ctx sdk.Context,
+	bondedValidators []stakingtypes.Validator,
+	activeValidators []stakingtypes.Validator,
+) (BondedValidatorSet, error) {
+	bonded, err := k.toBondedValidators(ctx, bondedValidators)
+	if err != nil {
+		return BondedValidatorSet{}, err
+	}
+	active, err := k.toBondedValidators(ctx, activeValidators)
+	if err != nil {
+		return BondedValidatorSet{}, err
+	}
+	validatorSet := newBondedValidatorSet(bonded, active)
+	validatorSet.participations = k.GetConsumerParticipations(ctx)
+	return validatorSet, nil
+}
+
+// newBondedValidatorSet returns the BondedValidatorSet with the given bonded and active validators.
+// The bonded validators are copied before being sorted by bonded tokens.
+func newBondedValidatorSet(bonded, active []BondedValidator) BondedValidatorSet {
+	sortedBonded := make([]BondedValidator, len(bonded))
+	copy(sortedBonded, bonded)
+	sort.SliceStable(sortedBonded, func(i, j int) bool {
+		return sortedBonded[i].Validator.GetBondedTokens().GT(sortedBonded[j].Validator.GetBondedTokens())
+	})
+
+	ranked := make([]BondedValidator, len(sortedBonded))
+	copy(ranked, sortedBonded)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		tokensI, tokensJ := ranked[i].Validator.GetBondedTokens(), ranked[j].Validator.GetBondedTokens()
+		if !tokensI.Equal(tokensJ) {
+			return tokensI.GT(tokensJ)
+		}
+		return bytes.Compare(ranked[i].ProviderAddr.ToSdkConsAddr(), ranked[j].ProviderAddr.ToSdkConsAddr()) < 0
+	})
+	ranks := make(map[string]int, len(ranked))
+	for rank, val := range ranked {
+		ranks[val.ProviderAddr.String()] = rank
+	}
+
+	return BondedValidatorSet{
+		Bonded: sortedBonded,
+		Active: active,
+		ranks:  ranks,
+	}
+}
+
+// isWithinMaxProviderRank returns true if the validator with `providerAddr` is ranked within the top `maxProviderRank`
+// bonded validators, or if `maxProviderRank` is zero
+func (s BondedValidatorSet) isWithinMaxProviderRank(providerAddr types.ProviderConsAddress, maxProviderRank uint32) bool {
+	if maxProviderRank == 0 {
+		return true
+	}
+	rank, found := s.ranks[providerAddr.String()]
+	return found && rank < int(maxProviderRank)
+}
+
+// toBondedValidators converts the given staking `validators` into bonded validators
+func (k Keeper) toBondedValidators(ctx sdk.Context, validators []stakingtypes.Validator) ([]BondedValidator, error) {
+	bondedValidators := make([]BondedValidator, 0, len(validators))
+	excludeTokenizedShares := k.GetExcludeTokenizedSharesFromTopN(ctx)
+	for _, val := range validators {
+		bondedValidator, err := k.toBondedValidator(ctx, val, excludeTokenizedShares)
+		if err != nil {
+			return nil, err
+		}
+		bondedValidators = append(bondedValidators, bondedValidator)
+	}
+	return bondedValidators, nil
+}
+
+// toBondedValidator converts the given staking `validator` into a bonded validator
+func (k Keeper) toBondedValidator(
+	ctx sdk.Context,
+	validator stakingtypes.Validator,
+	excludeTokenizedShares bool,
+) (BondedValidator, error) {
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return BondedValidator{}, err
+	}
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return BondedValidator{}, fmt.Errorf("could not retrieve validator's (%+v) power: %w", validator, err)
+	}
+	topNPower, err := k.getTopNPower(ctx, valAddr, power, excludeTokenizedShares)
+	if err != nil {
+		return BondedValidator{}, err
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return BondedValidator{}, fmt.Errorf("could not retrieve validator's (%+v) consensus address: %w", validator, err)
+	}
+	return BondedValidator{
+		Validator:    validator,
+		ProviderAddr: types.NewProviderConsAddress(consAddr),
+		Power:        power,
+		TopNPower:    topNPower,
+	}, nil
+}
+
+// GetTopNPower returns the power of the validator with `valAddr` and last validator `power` that counts towards
+// the Top N of the consumer chains. If ExcludeTokenizedSharesFromTopN is enabled, the power of the tokenized shares
+// of the validator (as reported by the tokenized shares keeper) is excluded. Otherwise, `power` is returned.
+func (k Keeper) GetTopNPower(ctx sdk.Context, valAddr sdk.ValAddress, power int64) (int64, error) {
+	return k.getTopNPower(ctx, valAddr, power, k.GetExcludeTokenizedSharesFromTopN(ctx))
+}
+
+// getTopNPower returns the power of the validator that counts towards the Top N of the consumer chains (see GetTopNPower)
+func (k Keeper) getTopNPower(ctx sdk.Context, valAddr sdk.ValAddress, power int64, excludeTokenizedShares bool) (int64, error) {
+	if !excludeTokenizedShares {
+		return power, nil
+	}
+	tokenizedTokens, err := k.tokenizedSharesKeeper.GetValidatorTokenizedShareTokens(ctx, valAddr)
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve validator's (%s) tokenized share tokens: %w", valAddr.String(), err)
+	}
+	tokenizedPower := sdk.TokensToConsensusPower(tokenizedTokens, k.stakingKeeper.PowerReduction(ctx))
+	return max(power-tokenizedPower, 0), nil
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// setupConsumersWithPowerShaping sets up `numConsumers` launched consumer chains with varied power-shaping
+// parameters, to which every other validator of `providerAddrs` is opted in
+func setupConsumersWithPowerShaping(
+	tb testing.TB,
+	pk providerkeeper.Keeper,
+	ctx sdk.Context,
+	numConsumers int,
+	providerAddrs []types.ProviderConsAddress,
+) []string {
+	tb.Helper()
+
+	var consumerIds []string
+	for i := 0; i < numConsumers; i++ {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain-%d", i))
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+		powerShapingParameters := types.PowerShapingParameters{}
+		switch i % 5 {
+		case 0:
+			powerShapingParameters.Top_N = 50 + uint32(i%50)
+		case 1:
+			powerShapingParameters.Top_N = 95
+			powerShapingParameters.ValidatorsPowerCap = 10
+			powerShapingParameters.Denylist = []string{providerAddrs[0].String()}
+		case 2:
+			powerShapingParameters.ValidatorSetCap = uint32(len(providerAddrs) / 4)
+			powerShapingParameters.AllowInactiveVals = true
+		case 3:
+			powerShapingParameters.MinStake = sdk.TokensFromConsensusPower(int64(len(providerAddrs)/2), sdk.DefaultPowerReduction).Uint64()
+			powerShapingParameters.AllowInactiveVals = true
+		case 4:
+			powerShapingParameters.MaxProviderRank = uint32(len(providerAddrs) / 3)
+		}
+		require.NoError(tb, pk.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters))
+		for _, providerAddr := range powerShapingParameters.Denylist {
+			consAddr, err := sdk.ConsAddressFromBech32(providerAddr)
+			require.NoError(tb, err)
+			pk.SetDenylist(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+		}
+
+		for j := i % 2; j < len(providerAddrs); j += 2 {
+			pk.SetOptedIn(ctx, consumerId, providerAddrs[j])
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// computeConsumerNextValSetPerConsumer computes the next validator set of the consumer chain with `consumerId`
+// by retrieving the bonded and active validators from the staking module for the consumer chain alone
+func computeConsumerNextValSetPerConsumer(
+	pk providerkeeper.Keeper,
+	ctx sdk.Context,
+	consumerId string,
+) ([]types.ConsensusValidator, error) {
+	bondedValidators, err := pk.GetLastBondedValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	activeValidators, err := pk.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	powerShapingParameters, err := pk.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+
+	minPower := int64(0)
+	if powerShapingParameters.Top_N > 0 {
+		minPower, err = pk.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		if err != nil {
+			return nil, err
+		}
+		pk.SetMinimumPowerInTopN(ctx, consumerId, minPower)
+		if err := pk.OptInTopNValidators(ctx, consumerId, activeValidators, minPower); err != nil {
+			return nil, err
+		}
+	}
+
+	nextValidators, _, err := pk.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+	if err != nil {
+		return nil, err
+	}
+	if err := pk.SetConsumerValSet(ctx, consumerId, nextValidators); err != nil {
+		return nil, err
+	}
+	return nextValidators, nil
+}
+
+// TestGetLastBondedValidatorSet tests that the bonded validators and the active validators of the validator set
+// retrieved at once are the ones retrieved with GetLastBondedValidators and GetLastProviderConsensusActiveValidators
+func TestGetLastBondedValidatorSet(t *testing.T) {
+	testCases := []struct {
+		name                           string
+		maxValidators                  uint32
+		maxProviderConsensusValidators int64
+	}{
+		{"more bonded than active validators", 8, 5},
+		{"more active than bonded validators", 5, 8},
+		{"fewer validators than both limits", 20, 15},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			params := types.DefaultParams()
+			params.MaxProviderConsensusValidators = tc.maxProviderConsensusValidators
+			pk.SetParams(ctx, params)
+
+			vals, _ := createBondedValidatorsAndMocks(mocks, 10, 10, 9, 7, 7, 7, 4, 3, 2, 1)
+			testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, tc.maxValidators, vals, -1)
+
+			validatorSet, err := pk.GetLastBondedValidatorSet(ctx)
+			require.NoError(t, err)
+
+			bondedValidators, err := pk.GetLastBondedValidators(ctx)
+			require.NoError(t, err)
+			activeValidators, err := pk.GetLastProviderConsensusActiveValidators(ctx)
+			require.NoError(t, err)
+			expectedValidatorSet, err := pk.NewBondedValidatorSet(ctx, bondedValidators, activeValidators)
+			require.NoError(t, err)
+			require.Equal(t, expectedValidatorSet, validatorSet)
+
+			toValidators := func(bondedValidators []providerkeeper.BondedValidator) []stakingtypes.Validator {
+				validators := []stakingtypes.Validator{}
+				for _, val := range bondedValidators {
+					validators = append(validators, val.Validator)
+				}
+				return validators
+			}
+			require.ElementsMatch(t, bondedValidators, toValidators(validatorSet.Bonded))
+			require.Equal(t, activeValidators, toValidators(validatorSet.Active))
+		})
+	}
+}
+
+// TestComputeConsumerNextValSetWithSharedValidatorSet tests that the validator sets of consumer chains with varied
+// power-shaping parameters that are computed from a validator set shared across the consumer chains are the ones
+// computed by retrieving the bonded and active validators for every consumer chain
+func TestComputeConsumerNextValSetWithSharedValidatorSet(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	numValidators := 40
+	params := types.DefaultParams()
+	params.MaxProviderConsensusValidators = 30
+	pk.SetParams(ctx, params)
+
+	// validators with pairwise equal powers
+	powers := make([]int64, numValidators)
+	for i := range powers {
+		powers[i] = int64(numValidators - i/2)
+	}
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, powers...)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 35, vals, -1)
+
+	consumerIds := setupConsumersWithPowerShaping(t, pk, ctx, 10, providerAddrs)
+
+	perConsumerCtx, _ := ctx.CacheContext()
+	sharedCtx, _ := ctx.CacheContext()
+	validatorSet, err := pk.GetLastBondedValidatorSet(sharedCtx)
+	require.NoError(t, err)
+
+	for _, consumerId := range consumerIds {
+		expectedValidators, err := computeConsumerNextValSetPerConsumer(pk, perConsumerCtx, consumerId)
+		require.NoError(t, err)
+		require.NotEmpty(t, expectedValidators)
+
+		_, err = pk.ComputeConsumerNextValSet(sharedCtx, validatorSet, consumerId, nil)
+		require.NoError(t, err)
+		validators, err := pk.GetConsumerValSet(sharedCtx, consumerId)
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, expectedValidators, validators, "consumerId(%s)", consumerId)
+		require.ElementsMatch(t, pk.GetAllOptedIn(perConsumerCtx, consumerId), pk.GetAllOptedIn(sharedCtx, consumerId))
+	}
+}
+
+// BenchmarkComputeConsumerNextValSets benchmarks the computation of the validator sets of 50 consumer chains
+// with 500 bonded validators, either by retrieving the bonded and active validators for every consumer chain
+// or by retrieving them once and sharing them across the consumer chains
+func BenchmarkComputeConsumerNextValSets(b *testing.B) {
+	keeperParams := testkeeper.NewInMemKeeperParams(b)
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	pk := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+
+	numValidators := 500
+	params := types.DefaultParams()
+	params.MaxProviderConsensusValidators = int64(numValidators)
+	pk.SetParams(ctx, params)
+
+	powers := make([]int64, numValidators)
+	for i := range powers {
+		powers[i] = int64(numValidators - i)
+	}
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, powers...)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, uint32(numValidators), vals, -1)
+
+	consumerIds := setupConsumersWithPowerShaping(b, pk, ctx, 50, providerAddrs)
+
+	b.Run("per consumer", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cachedCtx, _ := ctx.CacheContext()
+			for _, consumerId := range consumerIds {
+				if _, err := computeConsumerNextValSetPerConsumer(pk, cachedCtx, consumerId); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("shared", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			cachedCtx, _ := ctx.CacheContext()
+			validatorSet, err := pk.GetLastBondedValidatorSet(cachedCtx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, consumerId := range consumerIds {
+				if _, err := pk.ComputeConsumerNextValSet(cachedCtx, validatorSet, consumerId, nil); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	metrics "github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// GetConsumerClientExpiry returns the status and the remaining trusting period of the client to the
+// consumer chain with `consumerId`. Only the client state and the latest consensus state of the client
+// are read, i.e., the expiry time is the timestamp of the latest consensus state plus the trusting period.
+func (k Keeper) GetConsumerClientExpiry(ctx sdk.Context, consumerId string) (types.ConsumerClientExpiry, error) {
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return types.ConsumerClientExpiry{}, err
+	}
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return types.ConsumerClientExpiry{}, errorsmod.Wrapf(ccv.ErrClientNotFound,
+			"cannot find client for consumer chain with consumer id %s", consumerId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return types.ConsumerClientExpiry{}, errorsmod.Wrapf(ccv.ErrClientNotFound,
+			"client state not found for client %s", clientId)
+	}
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return types.ConsumerClientExpiry{}, fmt.Errorf("unexpected client state type %T for client %s", clientState, clientId)
+	}
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientId, tmClientState.LatestHeight)
+	if !found {
+		return types.ConsumerClientExpiry{}, errorsmod.Wrapf(ccv.ErrClientNotFound,
+			"consensus state not found for client %s at height %s", clientId, tmClientState.LatestHeight)
+	}
+	tmConsensusState, ok := consensusState.(*ibctm.ConsensusState)
+	if !ok {
+		return types.ConsumerClientExpiry{}, fmt.Errorf("unexpected consensus state type %T for client %s", consensusState, clientId)
+	}
+
+	expiryTime := tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod).UTC()
+	remainingTime := expiryTime.Sub(ctx.BlockTime())
+	status := types.CONSUMER_CLIENT_STATUS_ACTIVE
+	if remainingTime <= 0 {
+		status = types.CONSUMER_CLIENT_STATUS_EXPIRED
+		remainingTime = 0
+	} else {
+		warningPeriod, err := k.getConsumerClientExpiryWarningPeriod(ctx, tmClientState.TrustingPeriod)
+		if err != nil {
+			return types.ConsumerClientExpiry{}, err
+		}
+		if remainingTime < warningPeriod {
+			status = types.CONSUMER_CLIENT_STATUS_EXPIRING
+		}
+	}
+
+	return types.ConsumerClientExpiry{
+		ConsumerId:     consumerId,
+		ChainId:        chainId,
+		ClientId:       clientId,
+		Status:         status,
+		TrustingPeriod: tmClientState.TrustingPeriod,
+		ExpiryTime:     expiryTime,
+		RemainingTime:  remainingTime,
+	}, nil
+}
+
+// getConsumerClientExpiryWarningPeriod returns the period before the expiry of a client with `trustingPeriod`
+// during which warning events are emitted, i.e., ConsumerClientExpiryWarningFraction of the trusting period
+func (k Keeper) getConsumerClientExpiryWarningPeriod(ctx sdk.Context, trustingPeriod time.Duration) (time.Duration, error) {
+	warningFraction := k.GetConsumerClientExpiryWarningFraction(ctx)
+	if warningFraction == "" {
+		return 0, nil
+	}
+	fraction, err := math.LegacyNewDecFromStr(warningFraction)
+	if err != nil {
+		return 0, fmt.Errorf("parsing consumer client expiry warning fraction: %w", err)
+	}
+	return time.Duration(fraction.MulInt64(int64(trustingPeriod)).TruncateInt64()), nil
+}
+
+// GetAllConsumerClientExpiries returns the status and the remaining trusting period of the clients
+// to all the launched consumer chains
+func (k Keeper) GetAllConsumerClientExpiries(ctx sdk.Context) ([]types.ConsumerClientExpiry, error) {
+	clients := []types.ConsumerClientExpiry{}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		client, err := k.GetConsumerClientExpiry(ctx, consumerId)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+// EndBlockCheckConsumerClientsExpiry checks, at the boundaries of an epoch, the remaining trusting period
+// of the clients to all the launched consumer chains. For every client, it sets a telemetry gauge with the
+// number of seconds until the client expires. While less than ConsumerClientExpiryWarningFraction of the
+// trusting period remains, a consumer_client_expiring event is emitted every epoch, and once the client
+// expired, a consumer_client_expired event is emitted every epoch, e.g., so that relayers can be alerted
+// to update the client before a client substitution is required.
+func (k Keeper) EndBlockCheckConsumerClientsExpiry(ctx sdk.Context) {
+	if k.BlocksUntilNextEpoch(ctx) != 0 {
+		return
+	}
+
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		client, err := k.GetConsumerClientExpiry(ctx, consumerId)
+		if err != nil {
+			k.Logger(ctx).Error("failed to check the expiry of the consumer client",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "consumer_client_seconds_until_expiry"},
+			float32(client.RemainingTime.Seconds()),
+			[]metrics.Label{telemetry.NewLabel(types.AttributeConsumerId, consumerId)},
+		)
+
+		var eventType string
+		switch client.Status {
+		case types.CONSUMER_CLIENT_STATUS_EXPIRING:
+			eventType = types.EventTypeConsumerClientExpiring
+		case types.CONSUMER_CLIENT_STATUS_EXPIRED:
+			eventType = types.EventTypeConsumerClientExpired
+			k.Logger(ctx).Error("consumer client expired - the client needs to be substituted",
+				"consumerId", consumerId,
+				"chainId", client.ChainId,
+				"clientId", client.ClientId,
+				"expiryTime", client.ExpiryTime,
+			)
+		default:
+			continue
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				eventType,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, client.ChainId),
+				sdk.NewAttribute(types.AttributeConsumerClientId, client.ClientId),
+				sdk.NewAttribute(types.AttributeConsumerClientExpiryTime, client.ExpiryTime.String()),
+				sdk.NewAttribute(types.AttributeTimeUntilExpiry, client.RemainingTime.String()),
+			),
+		)
+	}
+}
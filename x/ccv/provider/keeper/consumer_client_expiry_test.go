@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TestConsumerClientExpiry tests that the status of the consumer clients changes as the block time advances
+// toward their expiry, and that warning events are emitted at the boundaries of an epoch once less than
+// ConsumerClientExpiryWarningFraction of the trusting period remains
+func TestConsumerClientExpiry(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.ConsumerClientExpiryWarningFraction = "0.33"
+	providerKeeper.SetParams(ctx, params)
+
+	// consumer chain "0" is launched, while consumer chain "1" is only initialized
+	clientId := "07-tendermint-0"
+	trustingPeriod := 30 * time.Hour
+	latestHeight := clienttypes.NewHeight(0, 10)
+	consensusTimestamp := time.Unix(1000000, 0).UTC()
+	for i, phase := range []providertypes.ConsumerPhase{providertypes.CONSUMER_PHASE_LAUNCHED, providertypes.CONSUMER_PHASE_INITIALIZED} {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, phase)
+		if i == 0 {
+			providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
+		}
+	}
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), clientId).Return(
+		&ibctmtypes.ClientState{TrustingPeriod: trustingPeriod, LatestHeight: latestHeight}, true,
+	).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), clientId, latestHeight).DoAndReturn(
+		func(sdk.Context, string, ibcexported.Height) (ibcexported.ConsensusState, bool) {
+			return &ibctmtypes.ConsensusState{Timestamp: consensusTimestamp}, true
+		},
+	).AnyTimes()
+
+	// checkAtTime checks the expiry of the consumer clients at the given time and height
+	// and returns the status of the client to consumer chain "0" and the emitted events
+	checkAtTime := func(blockTime time.Time, height int64) (providertypes.ConsumerClientExpiry, sdk.Events) {
+		ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		res, err := providerKeeper.QueryConsumerClientStatus(ctx, &providertypes.QueryConsumerClientStatusRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.Clients, 1)
+		providerKeeper.EndBlockCheckConsumerClientsExpiry(ctx)
+		return res.Clients[0], ctx.EventManager().Events()
+	}
+	requireEvent := func(events sdk.Events, eventType string, remainingTime time.Duration) {
+		require.Len(t, events, 1)
+		require.Equal(t, eventType, events[0].Type)
+		attr, found := events[0].GetAttribute(providertypes.AttributeConsumerClientId)
+		require.True(t, found)
+		require.Equal(t, clientId, attr.Value)
+		attr, found = events[0].GetAttribute(providertypes.AttributeTimeUntilExpiry)
+		require.True(t, found)
+		require.Equal(t, remainingTime.String(), attr.Value)
+	}
+
+	// more than a third of the trusting period remains
+	client, events := checkAtTime(consensusTimestamp.Add(10*time.Hour), 10)
+	require.Equal(t, providertypes.ConsumerClientExpiry{
+		ConsumerId:     "0",
+		ChainId:        CONSUMER_CHAIN_ID,
+		ClientId:       clientId,
+		Status:         providertypes.CONSUMER_CLIENT_STATUS_ACTIVE,
+		TrustingPeriod: trustingPeriod,
+		ExpiryTime:     consensusTimestamp.Add(trustingPeriod),
+		RemainingTime:  20 * time.Hour,
+	}, client)
+	require.Empty(t, events)
+
+	// less than a third of the trusting period remains
+	client, events = checkAtTime(consensusTimestamp.Add(21*time.Hour), 20)
+	require.Equal(t, providertypes.CONSUMER_CLIENT_STATUS_EXPIRING, client.Status)
+	require.Equal(t, 9*time.Hour, client.RemainingTime)
+	requireEvent(events, providertypes.EventTypeConsumerClientExpiring, 9*time.Hour)
+
+	// the events are only emitted at the boundaries of an epoch
+	client, events = checkAtTime(consensusTimestamp.Add(22*time.Hour), 21)
+	require.Equal(t, providertypes.CONSUMER_CLIENT_STATUS_EXPIRING, client.Status)
+	require.Empty(t, events)
+
+	// no warnings are emitted if the warning fraction is not set
+	params.ConsumerClientExpiryWarningFraction = ""
+	providerKeeper.SetParams(ctx, params)
+	client, events = checkAtTime(consensusTimestamp.Add(29*time.Hour), 30)
+	require.Equal(t, providertypes.CONSUMER_CLIENT_STATUS_ACTIVE, client.Status)
+	require.Equal(t, time.Hour, client.RemainingTime)
+	require.Empty(t, events)
+
+	// the client expired
+	client, events = checkAtTime(consensusTimestamp.Add(31*time.Hour), 40)
+	require.Equal(t, providertypes.CONSUMER_CLIENT_STATUS_EXPIRED, client.Status)
+	require.Equal(t, time.Duration(0), client.RemainingTime)
+	requireEvent(events, providertypes.EventTypeConsumerClientExpired, 0)
+
+	// the client is active again once it is updated
+	consensusTimestamp = consensusTimestamp.Add(31 * time.Hour)
+	client, events = checkAtTime(consensusTimestamp, 50)
+	require.Equal(t, providertypes.CONSUMER_CLIENT_STATUS_ACTIVE, client.Status)
+	require.Equal(t, trustingPeriod, client.RemainingTime)
+	require.Empty(t, events)
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"time"
 
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	evidencetypes "cosmossdk.io/x/evidence/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -18,6 +20,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
@@ -28,14 +31,20 @@ import (
 // Double Voting section
 //
 
-// HandleConsumerDoubleVoting verifies a double voting evidence for a given a consumer id
-// and a public key and, if successful, executes the slashing, jailing, and tombstoning of the malicious validator.
+// HandleConsumerDoubleVoting verifies a double voting evidence for a given a consumer id, the chain id
+// of the evidence, and a public key and, if successful, executes the slashing, jailing, and tombstoning
+// of the malicious validator.
 func (k Keeper) HandleConsumerDoubleVoting(
 	ctx sdk.Context,
 	consumerId string,
+	chainId string,
 	evidence *tmtypes.DuplicateVoteEvidence,
 	pubkey cryptotypes.PubKey,
 ) error {
+	if k.IsConsumerEvidenceSubmissionPaused(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrEvidenceSubmissionPaused, "consumer id: %s", consumerId)
+	}
+
 	// check that the evidence is for an ICS consumer chain
 	if _, found := k.GetConsumerClientId(ctx, consumerId); !found {
 		return errorsmod.Wrapf(
@@ -45,42 +54,45 @@ func (k Keeper) HandleConsumerDoubleVoting(
 		)
 	}
 
-	// check that the evidence is not too old
-	minHeight := k.GetEquivocationEvidenceMinHeight(ctx, consumerId)
-	if uint64(evidence.VoteA.Height) < minHeight {
-		return errorsmod.Wrapf(
-			ccvtypes.ErrInvalidDoubleVotingEvidence,
-			"evidence for consumer chain %s is too old - evidence height (%d), min (%d)",
-			consumerId,
-			evidence.VoteA.Height,
-			minHeight,
-		)
-	}
+	// the evidence of any revision of the consumer chain since its launch (or last hard fork) is accepted,
+	// i.e., the evidence of the previous revisions remains valid after the consumer chain is upgraded
+	minHeight, revisionErr := k.GetEquivocationEvidenceMinHeightOfChainId(ctx, consumerId, chainId)
 
-	// get the chainId of this consumer chain to verify the double-voting evidence
-	chainId, err := k.GetConsumerChainId(ctx, consumerId)
-	if err != nil {
-		return err
-	}
+	consumerAddr := types.NewConsumerConsAddress(sdk.ConsAddress(evidence.VoteA.ValidatorAddress.Bytes()))
 
-	// verifies the double voting evidence using the consumer chain public key
-	if err = k.VerifyDoubleVotingEvidence(*evidence, chainId, pubkey); err != nil {
-		return err
-	}
+	var providerAddr types.ProviderConsAddress
+	var err error
 
-	// get the validator's consensus address on the provider
-	providerAddr := k.GetProviderAddrFromConsumerAddr(
-		ctx,
-		consumerId,
-		types.NewConsumerConsAddress(sdk.ConsAddress(evidence.VoteA.ValidatorAddress.Bytes())),
-	)
+	if revisionErr != nil || uint64(evidence.VoteA.Height) < minHeight {
+		// the evidence is from before the consumer became a CCV chain (i.e., it is either older than
+		// the min height or signed with the chain id of a previous revision of the standalone chain),
+		// i.e., it can only be handled if the consumer owner opted in
+		ccvMinHeight := k.GetEquivocationEvidenceMinHeight(ctx, consumerId)
+		chainId, providerAddr, err = k.VerifyPreCCVDoubleVotingEvidence(ctx, consumerId, evidence, pubkey, ccvMinHeight)
+		if err != nil {
+			return err
+		}
+	} else {
+		// verifies the double voting evidence using the consumer chain public key
+		if err = k.VerifyDoubleVotingEvidence(*evidence, chainId, pubkey); err != nil {
+			return err
+		}
 
-	if err = k.SlashValidator(ctx, providerAddr); err != nil {
+		// get the validator's consensus address on the provider
+		providerAddr = k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerAddr)
+	}
+
+	if err = k.SlashValidator(ctx, consumerId, providerAddr, evidence.VoteA.Timestamp); err != nil {
 		return err
 	}
 	if err = k.JailAndTombstoneValidator(ctx, providerAddr); err != nil {
 		return err
 	}
+	// equivocations are not mapped to provider heights, i.e., the provider height
+	// is the height at which the validator is jailed
+	k.emitConsumerValidatorJailedEvent(ctx, consumerId, chainId, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+		uint64(evidence.VoteA.Height), uint64(ctx.BlockHeight()), providerAddr, consumerAddr)
+	k.RecordReOptInCooldown(ctx, consumerId, providerAddr)
 
 	k.Logger(ctx).Info(
 		"confirmed equivocation",
@@ -92,6 +104,116 @@ func (k Keeper) HandleConsumerDoubleVoting(
 	return nil
 }
 
+// VerifyPreCCVDoubleVotingEvidence verifies a double voting evidence from the pre-CCV (i.e., standalone)
+// history of a changeover consumer chain, i.e., evidence with a height smaller than `ccvMinHeight`.
+// The evidence is verified against the pre-CCV client set by the consumer owner and the offending
+// validator is mapped to a provider validator only through a registered consumer key assignment.
+// It returns the chain id of the standalone chain and the provider address of the offending validator.
+func (k Keeper) VerifyPreCCVDoubleVotingEvidence(
+	ctx sdk.Context,
+	consumerId string,
+	evidence *tmtypes.DuplicateVoteEvidence,
+	pubkey cryptotypes.PubKey,
+	ccvMinHeight uint64,
+) (string, types.ProviderConsAddress, error) {
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return "", types.ProviderConsAddress{}, err
+	}
+
+	// check that the consumer owner opted in and that the evidence is not too old
+	minHeight := initializationParameters.PreCcvEvidenceMinHeight
+	if minHeight == 0 || uint64(evidence.VoteA.Height) < minHeight {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"evidence for consumer chain %s is too old - evidence height (%d), min (%d), pre-CCV min (%d)",
+			consumerId,
+			evidence.VoteA.Height,
+			ccvMinHeight,
+			minHeight,
+		)
+	}
+
+	clientId := initializationParameters.PreCcvClientId
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"cannot find pre-CCV client %s for consumer chain %s",
+			clientId,
+			consumerId,
+		)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"pre-CCV client %s is not a Tendermint client: %T",
+			clientId,
+			clientState,
+		)
+	}
+
+	// check that the pre-CCV client tracks the standalone chain that became the consumer chain,
+	// i.e., that its chain id is the chain id of the consumer chain or a revision of it
+	consumerChainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return "", types.ProviderConsAddress{}, err
+	}
+	if tmClientState.ChainId != consumerChainId && !IsRevisionOfChainId(consumerChainId, tmClientState.ChainId) {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"pre-CCV client %s tracks chain %s, which is neither consumer chain %s nor a revision of it",
+			clientId,
+			tmClientState.ChainId,
+			consumerChainId,
+		)
+	}
+
+	// check that the evidence matches the block header tracked by the pre-CCV client at the evidence height
+	evidenceHeight := ibcclienttypes.NewHeight(tmClientState.LatestHeight.RevisionNumber, uint64(evidence.VoteA.Height))
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientId, evidenceHeight)
+	if !found {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"cannot find consensus state of pre-CCV client %s at height %s",
+			clientId,
+			evidenceHeight,
+		)
+	}
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok || !tmConsensusState.Timestamp.Equal(evidence.Timestamp) {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"evidence timestamp (%s) does not match the consensus state of pre-CCV client %s at height %s",
+			evidence.Timestamp,
+			clientId,
+			evidenceHeight,
+		)
+	}
+
+	// the votes are signed with the chain id of the standalone chain
+	chainId := tmClientState.ChainId
+	if err := k.VerifyDoubleVotingEvidence(*evidence, chainId, pubkey); err != nil {
+		return "", types.ProviderConsAddress{}, err
+	}
+
+	// the standalone validator can only be identified with a provider validator
+	// through a consumer key assignment; there is no default mapping
+	consumerAddr := types.NewConsumerConsAddress(sdk.ConsAddress(evidence.VoteA.ValidatorAddress.Bytes()))
+	providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	if !found {
+		return "", types.ProviderConsAddress{}, errorsmod.Wrapf(
+			ccvtypes.ErrInvalidDoubleVotingEvidence,
+			"cannot map pre-CCV validator %s to a provider validator: no consumer key assignment for consumer chain %s",
+			consumerAddr.String(),
+			consumerId,
+		)
+	}
+
+	return chainId, providerAddr, nil
+}
+
 // VerifyDoubleVotingEvidence verifies a double voting evidence
 // for a given chain id and a validator public key
 func (k Keeper) VerifyDoubleVotingEvidence(
@@ -167,6 +289,10 @@ func (k Keeper) VerifyDoubleVotingEvidence(
 func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, consumerId string, misbehaviour ibctmtypes.Misbehaviour) error {
 	logger := k.Logger(ctx)
 
+	if k.IsConsumerEvidenceSubmissionPaused(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrEvidenceSubmissionPaused, "consumer id: %s", consumerId)
+	}
+
 	// Check that the misbehaviour is valid and that the client consensus states at trusted heights are within trusting period
 	if err := k.CheckMisbehaviour(ctx, consumerId, misbehaviour); err != nil {
 		logger.Info("Misbehaviour rejected", err.Error())
@@ -188,12 +314,9 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, consumerId string, m
 
 	// slash, jail, and tombstone the Byzantine validators
 	for _, v := range byzantineValidators {
-		providerAddr := k.GetProviderAddrFromConsumerAddr(
-			ctx,
-			consumerId,
-			types.NewConsumerConsAddress(sdk.ConsAddress(v.Address.Bytes())),
-		)
-		err := k.SlashValidator(ctx, providerAddr)
+		consumerAddr := types.NewConsumerConsAddress(sdk.ConsAddress(v.Address.Bytes()))
+		providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerAddr)
+		err := k.SlashValidator(ctx, consumerId, providerAddr, misbehaviour.Header1.GetTime())
 		if err != nil {
 			logger.Error("failed to slash validator: %s", err)
 			continue
@@ -205,6 +328,10 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, consumerId string, m
 		if err != nil {
 			panic(err)
 		}
+		k.emitConsumerValidatorJailedEvent(ctx, consumerId, misbehaviour.Header1.Header.ChainID,
+			stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN, uint64(misbehaviour.Header1.Header.Height),
+			uint64(ctx.BlockHeight()), providerAddr, consumerAddr)
+		k.RecordReOptInCooldown(ctx, consumerId, providerAddr)
 
 		provAddrs = append(provAddrs, providerAddr)
 	}
@@ -300,18 +427,22 @@ func headerToLightBlock(h ibctmtypes.Header) (*tmtypes.LightBlock, error) {
 }
 
 // CheckMisbehaviour checks that headers in the given misbehaviour forms
-// a valid light client attack from an ICS consumer chain and that the light client isn't expired
+// a valid light client attack from an ICS consumer chain and that the light client isn't expired.
+//
+// Note that the cheap checks are performed first and that gas proportional to the validator sets
+// of the headers is consumed before the expensive verification of the headers.
 func (k Keeper) CheckMisbehaviour(ctx sdk.Context, consumerId string, misbehaviour ibctmtypes.Misbehaviour) error {
 	chainId := misbehaviour.Header1.Header.ChainID
 
-	consumerChainId, err := k.GetConsumerChainId(ctx, consumerId)
+	// the headers of any revision of the consumer chain since its launch (or last hard fork) are accepted
+	// (e.g., `foo-1` and `foo-2`), as the verification of the headers by the consumer client below fails
+	// for any chain id other than the one of the (upgraded) consumer client
+	minHeight, err := k.GetEquivocationEvidenceMinHeightOfChainId(ctx, consumerId, chainId)
 	if err != nil {
-		return err
-	} else if consumerChainId != chainId {
-		return fmt.Errorf("incorrect misbehaviour for a different chain id (%s) than that of the consumer chain %s (consumerId: %s)",
+		return fmt.Errorf("incorrect misbehaviour for a different chain id (%s) than that of the consumer chain (consumerId: %s): %w",
 			chainId,
-			consumerChainId,
-			consumerId)
+			consumerId,
+			err)
 	}
 
 	// check that the misbehaviour is for an ICS consumer chain
@@ -334,7 +465,6 @@ func (k Keeper) CheckMisbehaviour(ctx sdk.Context, consumerId string, misbehavio
 	}
 
 	// Check that the evidence is not too old
-	minHeight := k.GetEquivocationEvidenceMinHeight(ctx, consumerId)
 	evidenceHeight := misbehaviour.Header1.GetHeight().GetRevisionHeight()
 	// Note that the revision number is not relevant for checking the age of evidence
 	// as it's already part of the chain ID and the minimum height is mapped to chain IDs
@@ -352,6 +482,14 @@ func (k Keeper) CheckMisbehaviour(ctx sdk.Context, consumerId string, misbehavio
 	if !found {
 		return errorsmod.Wrapf(ibcclienttypes.ErrClientNotFound, "cannot find client state for client with ID %s", clientId)
 	}
+	if tmClientState, ok := clientState.(*ibctmtypes.ClientState); ok && !tmClientState.FrozenHeight.IsZero() {
+		return errorsmod.Wrapf(ibcclienttypes.ErrClientFrozen, "client with ID %s is frozen", clientId)
+	}
+
+	// The verification of the headers below is expensive. Thus, charge gas proportional to the
+	// validator sets of the headers, i.e., to the number of signatures that may be verified, but
+	// only after the cheap checks above passed
+	ctx.GasMeter().ConsumeGas(misbehaviourVerificationGas(misbehaviour), "verify consumer misbehaviour")
 
 	clientStore := k.clientKeeper.ClientStore(ctx, clientId)
 
@@ -371,6 +509,19 @@ func (k Keeper) CheckMisbehaviour(ctx sdk.Context, consumerId string, misbehavio
 	return nil
 }
 
+// misbehaviourVerificationGas returns the gas charged for verifying the headers of the given misbehaviour,
+// i.e., a base gas plus a constant per validator in the validator sets and trusted validator sets of the headers
+func misbehaviourVerificationGas(misbehaviour ibctmtypes.Misbehaviour) storetypes.Gas {
+	numValidators := 0
+	for _, header := range []*ibctmtypes.Header{misbehaviour.Header1, misbehaviour.Header2} {
+		if header == nil {
+			continue
+		}
+		numValidators += len(header.ValidatorSet.GetValidators()) + len(header.TrustedValidators.GetValidators())
+	}
+	return types.MisbehaviourVerificationBaseGas + uint64(numValidators)*types.MisbehaviourVerificationGasPerSignature
+}
+
 // Check if the given block headers have conflicting state transitions.
 // Note that this method was copied from ConflictingHeaderIsInvalid in CometBFT,
 // see https://github.com/cometbft/cometbft/blob/v0.34.27/types/evidence.go#L285
@@ -444,7 +595,196 @@ func (k Keeper) JailAndTombstoneValidator(ctx sdk.Context, providerAddr types.Pr
 	// Note that we cannot simply use the fact that a validator is jailed to avoid slashing more than once
 	// because then a validator could i) perform an equivocation, ii) get jailed (e.g., through downtime)
 	// and in such a case the validator would not get slashed when we call `SlashValidator`.
-	return k.slashingKeeper.Tombstone(ctx, providerAddr.ToSdkConsAddr())
+	if err := k.slashingKeeper.Tombstone(ctx, providerAddr.ToSdkConsAddr()); err != nil {
+		return err
+	}
+
+	// remove the tombstoned validator from the consumer chains right away instead of at the end of the epoch
+	return k.RemoveValidatorFromConsumers(ctx, providerAddr)
+}
+
+// RemoveValidatorFromConsumers removes the validator with the given provider consensus address from the validator
+// sets of all the launched consumer chains without waiting for the end of the epoch. For every consumer chain whose
+// validator set contains the validator, a VSC packet with a zero-power update for the validator is queued and sent
+// at the end of the block (see SendValidatorRemovals) together with any other pending VSC packets.
+// Dormant consumer chains are skipped, as the validator is removed from their validator sets once they resume.
+//
+// Note that all these VSC packets share the same valset update ID, which is then incremented as at the end of an epoch.
+func (k Keeper) RemoveValidatorFromConsumers(ctx sdk.Context, providerAddr types.ProviderConsAddress) error {
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+
+	numConsumersWithPackets := 0
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED || k.IsConsumerDormant(ctx, consumerId) {
+			continue
+		}
+		removed, err := k.removeValidatorFromConsumer(ctx, consumerId, providerAddr, valUpdateID)
+		if err != nil {
+			return err
+		}
+		if removed {
+			numConsumersWithPackets++
+		}
+	}
+
+	if numConsumersWithPackets > 0 {
+		k.IncrementValidatorSetUpdateId(ctx)
+	}
+
+	return nil
+}
+
+// removeValidatorFromConsumer removes the validator with the given provider consensus address from the validator set
+// of the consumer chain with `consumerId` by queueing a VSC packet with valset update id `valUpdateID` and a zero-power
+// update for the validator. The packet is sent at the end of the block together with any other pending VSC packets.
+// It returns false if the validator set of the consumer chain does not contain the validator.
+//
+// Note that the caller is responsible for incrementing the valset update id.
+func (k Keeper) removeValidatorFromConsumer(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	valUpdateID uint64,
+) (bool, error) {
+	consumerValidator, found := k.GetConsumerValidator(ctx, consumerId, providerAddr)
+	if !found {
+		return false, nil
+	}
+
+	k.DeleteConsumerValidator(ctx, consumerId, providerAddr)
+	packet := ccvtypes.NewValidatorSetChangePacketData(
+		[]abci.ValidatorUpdate{{PubKey: *consumerValidator.PublicKey, Power: 0}},
+		valUpdateID,
+		nil,
+	)
+	k.AppendPendingVSCPackets(ctx, consumerId, packet)
+	k.SetPendingValidatorRemoval(ctx, consumerId, providerAddr, valUpdateID)
+	// map the vscID to the height of the next block, as it is done when queueing VSC packets
+	k.SetVscIdToHeight(ctx, consumerId, types.VscIdToHeight{
+		VscId:     valUpdateID,
+		Height:    uint64(ctx.BlockHeight()) + 1,
+		Timestamp: ctx.BlockTime(),
+	})
+	k.Logger(ctx).Info("VSCPacket removing validator enqueued:",
+		"consumerId", consumerId,
+		"vscID", valUpdateID,
+		"provider address", providerAddr.String(),
+	)
+
+	k.SetConsumerWithValidatorRemovalsToSend(ctx, consumerId)
+	return true, nil
+}
+
+// SendValidatorRemovals sends the pending VSC packets of the consumer chains for which VSC packets removing
+// validators were queued in the current block (see RemoveValidatorFromConsumers), unless the consumer chains
+// are no longer launched or are dormant. If the CCV channel is not established for a consumer chain,
+// the packets remain queued until the channel is established.
+func (k Keeper) SendValidatorRemovals(ctx sdk.Context) error {
+	for _, consumerId := range k.GetConsumersWithValidatorRemovalsToSend(ctx) {
+		k.DeleteConsumerWithValidatorRemovalsToSend(ctx, consumerId)
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED || k.IsConsumerDormant(ctx, consumerId) {
+			continue
+		}
+		if channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
+			if err := k.SendVSCPacketsToChain(ctx, consumerId, channelId); err != nil {
+				return fmt.Errorf("sending VSCPacket to consumer, consumerId(%s): %w", consumerId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SetConsumerWithValidatorRemovalsToSend marks the consumer chain with `consumerId` as having VSC packets
+// removing validators that are to be sent at the end of the block
+func (k Keeper) SetConsumerWithValidatorRemovalsToSend(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumersWithValidatorRemovalsToSendKey(consumerId), []byte{})
+}
+
+// DeleteConsumerWithValidatorRemovalsToSend removes the mark set by SetConsumerWithValidatorRemovalsToSend
+func (k Keeper) DeleteConsumerWithValidatorRemovalsToSend(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumersWithValidatorRemovalsToSendKey(consumerId))
+}
+
+// GetConsumersWithValidatorRemovalsToSend returns the ids of the consumer chains with VSC packets
+// removing validators that are to be sent at the end of the block
+func (k Keeper) GetConsumersWithValidatorRemovalsToSend(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumersWithValidatorRemovalsToSendKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized.
+			panic(fmt.Errorf("failed to parse consumer id: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// GetConsumerChainsCarryingValidator returns the launched consumer chains that still carry the validator with the
+// given provider consensus address, i.e., whose consumer validator set contains the validator or that did not yet
+// acknowledge the VSC packet removing the validator after it was tombstoned (see RemoveValidatorFromConsumers)
+func (k Keeper) GetConsumerChainsCarryingValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress) []string {
+	consumerIds := []string{}
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		if _, pendingRemoval := k.GetPendingValidatorRemoval(ctx, consumerId, providerAddr); pendingRemoval ||
+			k.IsConsumerValidator(ctx, consumerId, providerAddr) {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+	return consumerIds
+}
+
+// SetPendingValidatorRemoval records that the VSC packet with the given valset update id removes
+// the validator with the given provider consensus address from the consumer chain
+func (k Keeper) SetPendingValidatorRemoval(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, vscId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToPendingValidatorRemovalKey(consumerId, providerAddr), sdk.Uint64ToBigEndian(vscId))
+}
+
+// GetPendingValidatorRemoval returns the valset update id of the VSC packet removing the validator with
+// the given provider consensus address from the consumer chain, if the removal was not yet acknowledged
+func (k Keeper) GetPendingValidatorRemoval(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPendingValidatorRemovalKey(consumerId, providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteAcknowledgedValidatorRemovals deletes the pending validator removals of the consumer chain that
+// were sent in VSC packets with a valset update id up to the given (acknowledged) valset update id.
+// Note that this relies on the CCV channel being ordered.
+func (k Keeper) DeleteAcknowledgedValidatorRemovals(ctx sdk.Context, consumerId string, ackedVscId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsumerIdToPendingValidatorRemovalsKeyPrefix(consumerId))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if sdk.BigEndianToUint64(iterator.Value()) <= ackedVscId {
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+	}
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// DeleteAllPendingValidatorRemovals deletes all the pending validator removals of the consumer chain
+func (k Keeper) DeleteAllPendingValidatorRemovals(ctx sdk.Context, consumerId string) {
+	k.DeleteAcknowledgedValidatorRemovals(ctx, consumerId, ^uint64(0))
 }
 
 // ComputePowerToSlash computes the power to be slashed based on the tokens in non-matured `undelegations` and
@@ -480,8 +820,45 @@ func (k Keeper) ComputePowerToSlash(ctx sdk.Context, validator stakingtypes.Vali
 	return power + undelegationsAndRedelegationsInPower
 }
 
-// SlashValidator slashes validator with given provider Address
-func (k Keeper) SlashValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress) error {
+// GetConsumerPowerAtInfraction returns the power that the validator with `providerAddr` had on the consumer chain
+// with `consumerId` at `infractionTime`, together with the provider height to which the infraction is mapped.
+// The infraction is mapped to the last VSC id sent to the consumer chain before `infractionTime` (see VscIdToHeight)
+// and the power is looked up in the consumer validator set retained at the provider height of that VSC id.
+// It returns false if this history is not retained (e.g., it was pruned) or the validator is not part of it.
+func (k Keeper) GetConsumerPowerAtInfraction(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	infractionTime time.Time,
+) (power, providerHeight int64, found bool) {
+	var vscIdToHeight *types.VscIdToHeight
+	for _, v := range k.GetAllVscIdToHeights(ctx, consumerId) {
+		if v.Timestamp.After(infractionTime) {
+			break
+		}
+		vscIdToHeight = &v
+	}
+	if vscIdToHeight == nil {
+		return 0, 0, false
+	}
+
+	snapshot, _, found := k.GetConsumerValSetSnapshotAtHeight(ctx, consumerId, int64(vscIdToHeight.Height))
+	if !found {
+		return 0, 0, false
+	}
+	for _, val := range snapshot.Validators {
+		if bytes.Equal(val.ProviderConsAddr, providerAddr.ToSdkConsAddr()) {
+			return val.Power, int64(vscIdToHeight.Height), true
+		}
+	}
+	return 0, 0, false
+}
+
+// SlashValidator slashes the validator with the given provider address for an equivocation committed
+// on the consumer chain with `consumerId` at `infractionTime`. The validator is slashed for the power it had
+// on the consumer chain at the infraction (see GetConsumerPowerAtInfraction). If this power is not retained,
+// the validator is slashed for its current power plus the power of its unbonding delegations and redelegations.
+func (k Keeper) SlashValidator(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, infractionTime time.Time) error {
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
 	if err != nil && errors.Is(err, stakingtypes.ErrNoValidatorFound) {
 		return errorsmod.Wrapf(slashingtypes.ErrNoValidatorForAddress, "provider consensus address: %s", providerAddr.String())
@@ -502,21 +879,35 @@ func (k Keeper) SlashValidator(ctx sdk.Context, providerAddr types.ProviderConsA
 		return err
 	}
 
-	undelegations, err := k.stakingKeeper.GetUnbondingDelegationsFromValidator(ctx, valAddr)
-	if err != nil {
-		return err
-	}
-	redelegations, err := k.stakingKeeper.GetRedelegationsFromSrcValidator(ctx, valAddr)
-	if err != nil {
-		return err
-	}
-	lastPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
-	if err != nil {
-		return err
-	}
+	var totalPower, infractionHeight int64
+	if power, providerHeight, found := k.GetConsumerPowerAtInfraction(ctx, consumerId, providerAddr, infractionTime); found {
+		// the staking module slashes the undelegations and redelegations
+		// created after the infraction height
+		totalPower = power
+		infractionHeight = min(providerHeight, ctx.BlockHeight())
+	} else {
+		k.Logger(ctx).Warn("the consumer power of the validator at the infraction is not retained, slashing the current power",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"infractionTime", infractionTime,
+		)
+
+		undelegations, err := k.stakingKeeper.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		redelegations, err := k.stakingKeeper.GetRedelegationsFromSrcValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		lastPower, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return err
+		}
 
-	powerReduction := k.stakingKeeper.PowerReduction(ctx)
-	totalPower := k.ComputePowerToSlash(ctx, validator, undelegations, redelegations, lastPower, powerReduction)
+		powerReduction := k.stakingKeeper.PowerReduction(ctx)
+		totalPower = k.ComputePowerToSlash(ctx, validator, undelegations, redelegations, lastPower, powerReduction)
+	}
 
 	slashFraction, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
 	if err != nil {
@@ -527,7 +918,7 @@ func (k Keeper) SlashValidator(ctx sdk.Context, providerAddr types.ProviderConsA
 		return err
 	}
 
-	_, err = k.stakingKeeper.SlashWithInfractionReason(ctx, consAdrr, 0, totalPower, slashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	_, err = k.stakingKeeper.SlashWithInfractionReason(ctx, consAdrr, infractionHeight, totalPower, slashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
 	return err
 }
 
@@ -563,3 +954,50 @@ func (k Keeper) DeleteEquivocationEvidenceMinHeight(ctx sdk.Context, consumerId
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.EquivocationEvidenceMinHeightKey(consumerId))
 }
+
+// SetEquivocationEvidenceMinChainId sets the chain id of the earliest revision of the consumer chain
+// with `consumerId` whose equivocation evidence is valid, i.e., to which the minimum height applies
+func (k Keeper) SetEquivocationEvidenceMinChainId(ctx sdk.Context, consumerId, chainId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EquivocationEvidenceMinChainIdKey(consumerId), []byte(chainId))
+}
+
+// GetEquivocationEvidenceMinChainId returns the chain id of the earliest revision of the consumer chain
+// with `consumerId` whose equivocation evidence is valid, and false if the chain id of the consumer chain
+// was not updated to a newer revision, i.e., if it is the chain id of the consumer chain
+func (k Keeper) GetEquivocationEvidenceMinChainId(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EquivocationEvidenceMinChainIdKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeleteEquivocationEvidenceMinChainId deletes the chain id of the earliest revision of the consumer chain
+// with `consumerId` whose equivocation evidence is valid
+func (k Keeper) DeleteEquivocationEvidenceMinChainId(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EquivocationEvidenceMinChainIdKey(consumerId))
+}
+
+// SetConsumerEvidenceSubmissionPaused pauses the submission of equivocation evidence for the consumer chain
+// with this consumer id, i.e., both misbehaviour and double voting evidence are rejected until resumed by governance
+func (k Keeper) SetConsumerEvidenceSubmissionPaused(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EvidenceSubmissionPausedConsumerKey(consumerId), []byte{})
+}
+
+// IsConsumerEvidenceSubmissionPaused checks if the submission of equivocation evidence for the consumer chain
+// with this consumer id is paused
+func (k Keeper) IsConsumerEvidenceSubmissionPaused(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.EvidenceSubmissionPausedConsumerKey(consumerId))
+}
+
+// DeleteConsumerEvidenceSubmissionPaused resumes the submission of equivocation evidence for the consumer chain
+// with this consumer id
+func (k Keeper) DeleteConsumerEvidenceSubmissionPaused(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EvidenceSubmissionPausedConsumerKey(consumerId))
+}
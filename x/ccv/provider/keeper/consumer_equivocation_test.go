@@ -2,9 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +19,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -21,6 +27,7 @@ import (
 	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 func TestVerifyDoubleVotingEvidence(t *testing.T) {
@@ -319,6 +326,258 @@ func TestVerifyDoubleVotingEvidence(t *testing.T) {
 	}
 }
 
+// TestHandleConsumerPreCCVDoubleVoting tests the handling of double voting evidence
+// from the pre-CCV (i.e., standalone) history of a changeover consumer chain
+func TestHandleConsumerPreCCVDoubleVoting(t *testing.T) {
+	consumerId := CONSUMER_ID
+	// the standalone chain became the next revision of its chain id when it became a consumer chain
+	consumerChainId := "consumer-2"
+	standaloneChainId := "consumer-1"
+	preCCVClientId := "07-tendermint-5"
+	ccvMinHeight := uint64(100)
+	preCCVMinHeight := uint64(50)
+	evidenceHeight := int64(60)
+	evidenceTime := time.Now().UTC()
+
+	// the standalone validator that double votes
+	signer := tmtypes.NewMockPV()
+	val := tmtypes.NewValidator(signer.PrivKey.PubKey(), 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{val})
+	valPubkey, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
+	require.NoError(t, err)
+	consumerAddr := types.NewConsumerConsAddress(sdk.ConsAddress(val.Address))
+
+	// the provider validator that assigned the standalone key as its consumer key
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerAddr := providerIdentity.ProviderConsAddress()
+	providerVal := providerIdentity.SDKStakingValidator()
+	providerVal.Status = stakingtypes.Bonded
+
+	blockID1 := cryptotestutil.MakeBlockID([]byte("blockhash"), 1000, []byte("partshash"))
+	blockID2 := cryptotestutil.MakeBlockID([]byte("blockhash2"), 1000, []byte("partshash"))
+
+	// makeEvidence creates a duplicate vote evidence at the given height,
+	// signed with the given chain id
+	makeEvidence := func(height int64, chainId string) *tmtypes.DuplicateVoteEvidence {
+		return &tmtypes.DuplicateVoteEvidence{
+			VoteA:            cryptotestutil.MakeAndSignVote(blockID1, height, evidenceTime, valSet, signer, chainId),
+			VoteB:            cryptotestutil.MakeAndSignVote(blockID2, height, evidenceTime, valSet, signer, chainId),
+			ValidatorPower:   val.VotingPower,
+			TotalVotingPower: val.VotingPower,
+			Timestamp:        evidenceTime,
+		}
+	}
+
+	preCCVClientState := &ibctmtypes.ClientState{
+		ChainId:      standaloneChainId,
+		LatestHeight: clienttypes.NewHeight(1, ccvMinHeight),
+	}
+	expectPreCCVClient := func(ctx sdk.Context, mocks testkeeper.MockedKeepers, timestamp time.Time) []*gomock.Call {
+		return []*gomock.Call{
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, preCCVClientId).
+				Return(preCCVClientState, true).Times(1),
+			mocks.MockClientKeeper.EXPECT().GetClientConsensusState(ctx, preCCVClientId, clienttypes.NewHeight(1, uint64(evidenceHeight))).
+				Return(&ibctmtypes.ConsensusState{Timestamp: timestamp}, true).Times(1),
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		preCCVMinHeight uint64
+		keyAssigned     bool
+		evidence        *tmtypes.DuplicateVoteEvidence
+		expectedCalls   func(sdk.Context, testkeeper.MockedKeepers) []*gomock.Call
+		expPass         bool
+	}{
+		{
+			"consumer owner did not opt in - shouldn't pass",
+			0,
+			true,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(sdk.Context, testkeeper.MockedKeepers) []*gomock.Call { return nil },
+			false,
+		},
+		{
+			"evidence older than the pre-CCV min height - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(int64(preCCVMinHeight)-1, standaloneChainId),
+			func(sdk.Context, testkeeper.MockedKeepers) []*gomock.Call { return nil },
+			false,
+		},
+		{
+			"pre-CCV client not found - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return []*gomock.Call{
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, preCCVClientId).
+						Return(nil, false).Times(1),
+				}
+			},
+			false,
+		},
+		{
+			"pre-CCV client tracks another chain - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, "other-1"),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return []*gomock.Call{
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, preCCVClientId).
+						Return(&ibctmtypes.ClientState{ChainId: "other-1", LatestHeight: clienttypes.NewHeight(1, ccvMinHeight)}, true).Times(1),
+				}
+			},
+			false,
+		},
+		{
+			"pre-CCV client has no consensus state at the evidence height - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return []*gomock.Call{
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, preCCVClientId).
+						Return(preCCVClientState, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().GetClientConsensusState(ctx, preCCVClientId, gomock.Any()).
+						Return(nil, false).Times(1),
+				}
+			},
+			false,
+		},
+		{
+			"evidence timestamp doesn't match the pre-CCV consensus state - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return expectPreCCVClient(ctx, mocks, evidenceTime.Add(time.Second))
+			},
+			false,
+		},
+		{
+			"votes not signed with the standalone chain id - shouldn't pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, consumerChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return expectPreCCVClient(ctx, mocks, evidenceTime)
+			},
+			false,
+		},
+		{
+			"standalone key not assigned as consumer key - shouldn't pass",
+			preCCVMinHeight,
+			false,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				return expectPreCCVClient(ctx, mocks, evidenceTime)
+			},
+			false,
+		},
+		{
+			"valid pre-CCV evidence - should pass",
+			preCCVMinHeight,
+			true,
+			makeEvidence(evidenceHeight, standaloneChainId),
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) []*gomock.Call {
+				slashFraction := math.LegacyNewDecWithPrec(5, 2)
+				return append(expectPreCCVClient(ctx, mocks, evidenceTime),
+					// slash the provider validator
+					mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
+						Return(providerVal, nil).Times(1),
+					mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerAddr.ToSdkConsAddr()).
+						Return(false).Times(1),
+					mocks.MockStakingKeeper.EXPECT().GetUnbondingDelegationsFromValidator(ctx, gomock.Any()).
+						Return(nil, nil).Times(1),
+					mocks.MockStakingKeeper.EXPECT().GetRedelegationsFromSrcValidator(ctx, gomock.Any()).
+						Return(nil, nil).Times(1),
+					mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, gomock.Any()).
+						Return(int64(100), nil).Times(1),
+					mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).
+						Return(sdk.DefaultPowerReduction).Times(1),
+					mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(ctx).
+						Return(slashFraction, nil).Times(1),
+					mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(ctx, providerAddr.ToSdkConsAddr(), int64(0), int64(100),
+						slashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN).
+						Return(math.NewInt(5), nil).Times(1),
+					// jail and tombstone the provider validator
+					mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
+						Return(providerVal, nil).Times(1),
+					mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerAddr.ToSdkConsAddr()).
+						Return(false).Times(1),
+					mocks.MockStakingKeeper.EXPECT().Jail(ctx, providerAddr.ToSdkConsAddr()).
+						Times(1),
+					mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerAddr.ToSdkConsAddr(), evidencetypes.DoubleSignJailEndTime).
+						Times(1),
+					mocks.MockSlashingKeeper.EXPECT().Tombstone(ctx, providerAddr.ToSdkConsAddr()).
+						Times(1),
+				)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			// setup a launched changeover consumer chain
+			providerKeeper.SetConsumerChainId(ctx, consumerId, consumerChainId)
+			providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+			providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, ccvMinHeight)
+			initializationParameters := testkeeper.GetTestInitializationParameters()
+			initializationParameters.InitialHeight = clienttypes.NewHeight(2, ccvMinHeight)
+			if tc.preCCVMinHeight != 0 {
+				initializationParameters.PreCcvEvidenceMinHeight = tc.preCCVMinHeight
+				initializationParameters.PreCcvClientId = preCCVClientId
+			}
+			err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+			require.NoError(t, err)
+			if tc.keyAssigned {
+				providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
+			}
+
+			ctx = ctx.WithBlockHeight(500).WithEventManager(sdk.NewEventManager())
+			// the consumer client was not upgraded
+			gomock.InOrder(append([]*gomock.Call{
+				mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientId").
+					Return(&ibctmtypes.ClientState{ChainId: consumerChainId}, true).Times(1),
+			}, tc.expectedCalls(ctx, mocks)...)...)
+
+			err = providerKeeper.HandleConsumerDoubleVoting(ctx, consumerId, standaloneChainId, tc.evidence, valPubkey)
+			if tc.expPass {
+				require.NoError(t, err)
+
+				// a consumer_validator_jailed event is emitted for the double-signing validator
+				events := ctx.EventManager().Events()
+				require.Len(t, events, 1)
+				require.Equal(t, types.EventTypeConsumerValidatorJailed, events[0].Type)
+				expectedAttributes := map[string]string{
+					types.AttributeConsumerId:               consumerId,
+					types.AttributeConsumerChainId:          standaloneChainId,
+					ccvtypes.AttributeInfractionType:        stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN.String(),
+					ccvtypes.AttributeInfractionTypeValue:   "1",
+					types.AttributeConsumerInfractionHeight: strconv.FormatInt(evidenceHeight, 10),
+					types.AttributeProviderInfractionHeight: "500",
+					types.AttributeProviderValidatorAddress: providerAddr.String(),
+					types.AttributeConsumerValidatorAddress: consumerAddr.String(),
+				}
+				for key, value := range expectedAttributes {
+					attr, found := events[0].GetAttribute(key)
+					require.True(t, found, key)
+					require.Equal(t, value, attr.Value, key)
+				}
+			} else {
+				require.Error(t, err)
+				require.Empty(t, ctx.EventManager().Events())
+			}
+		})
+	}
+}
+
 // TestJailAndTombstoneValidator tests that the jailing of a validator is only executed
 // under the conditions that the validator is neither unbonded, nor jailed, nor tombstoned.
 func TestJailAndTombstoneValidator(t *testing.T) {
@@ -640,6 +899,7 @@ func TestComputePowerToSlash(t *testing.T) {
 
 // TestSlashValidator asserts that `SlashValidator` calls the staking module's `Slash` method
 // with the correct arguments (i.e., `infractionHeight` of 0 and the expected slash power)
+// if the consumer power of the validator at the infraction is not retained
 func TestSlashValidator(t *testing.T) {
 	keeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -741,7 +1001,94 @@ func TestSlashValidator(t *testing.T) {
 	}
 
 	gomock.InOrder(expectedCalls...)
-	keeper.SlashValidator(ctx, providerAddr)
+	err = keeper.SlashValidator(ctx, CONSUMER_ID, providerAddr, now)
+	require.NoError(t, err)
+}
+
+// TestSlashValidatorWithHistoricalConsumerPower asserts that `SlashValidator` slashes the validator
+// for the power it had on the consumer chain at the infraction, even if its power changed since then
+func TestSlashValidatorWithHistoricalConsumerPower(t *testing.T) {
+	keeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	pubKey, _ := cryptocodec.FromCmtPubKeyInterface(tmtypes.NewMockPV().PrivKey.PubKey())
+	validator, err := stakingtypes.NewValidator(
+		sdk.ValAddress(pubKey.Address()).String(),
+		pubKey,
+		stakingtypes.NewDescription("", "", "", "", ""),
+	)
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+
+	consAddr, _ := validator.GetConsAddr()
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	// the validator had a power of 1000 when VSC id 1 was queued at height 10, and
+	// a power of 3000 (e.g., after a delegation) when VSC id 2 was queued at height 20
+	t0 := time.Unix(1000000, 0).UTC()
+	t1 := t0.Add(time.Hour)
+	for i, power := range []int64{1000, 3000} {
+		height := int64(10 * (i + 1))
+		err := keeper.SetConsumerValSetSnapshot(ctx, CONSUMER_ID, height, []types.ConsensusValidator{
+			{ProviderConsAddr: consAddr, Power: power},
+		})
+		require.NoError(t, err)
+		keeper.SetVscIdToHeight(ctx, CONSUMER_ID, types.VscIdToHeight{
+			VscId:     uint64(i + 1),
+			Height:    uint64(height + 1),
+			Timestamp: t0.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	ctx = ctx.WithBlockHeight(30).WithBlockTime(t1.Add(time.Hour))
+
+	slashFraction, _ := math.LegacyNewDecFromStr("0.5")
+
+	testCases := []struct {
+		name                     string
+		infractionTime           time.Time
+		expectedInfractionHeight int64
+		expectedSlashPower       int64
+	}{
+		{
+			"infraction before the delegation",
+			t0.Add(time.Minute),
+			11,
+			1000,
+		},
+		{
+			"infraction after the delegation",
+			t1.Add(time.Minute),
+			21,
+			3000,
+		},
+	}
+	for _, tc := range testCases {
+		power, providerHeight, found := keeper.GetConsumerPowerAtInfraction(ctx, CONSUMER_ID, providerAddr, tc.infractionTime)
+		require.True(t, found, tc.name)
+		require.Equal(t, tc.expectedSlashPower, power, tc.name)
+		require.Equal(t, tc.expectedInfractionHeight, providerHeight, tc.name)
+
+		gomock.InOrder(
+			mocks.MockStakingKeeper.EXPECT().
+				GetValidatorByConsAddr(ctx, gomock.Any()).
+				Return(validator, nil),
+			mocks.MockSlashingKeeper.EXPECT().
+				IsTombstoned(ctx, consAddr).
+				Return(false),
+			mocks.MockSlashingKeeper.EXPECT().
+				SlashFractionDoubleSign(ctx).
+				Return(slashFraction, nil),
+			mocks.MockStakingKeeper.EXPECT().
+				SlashWithInfractionReason(ctx, consAddr, tc.expectedInfractionHeight, tc.expectedSlashPower, slashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN).
+				Return(math.NewInt(tc.expectedSlashPower), nil),
+		)
+		err := keeper.SlashValidator(ctx, CONSUMER_ID, providerAddr, tc.infractionTime)
+		require.NoError(t, err, tc.name)
+	}
+
+	// the history before the first VSC id is not retained
+	_, _, found := keeper.GetConsumerPowerAtInfraction(ctx, CONSUMER_ID, providerAddr, t0.Add(-time.Minute))
+	require.False(t, found)
 }
 
 // TestSlashValidatorDoesNotSlashIfValidatorIsUnbonded asserts that `SlashValidator` does not call
@@ -768,7 +1115,8 @@ func TestSlashValidatorDoesNotSlashIfValidatorIsUnbonded(t *testing.T) {
 	}
 
 	gomock.InOrder(expectedCalls...)
-	keeper.SlashValidator(ctx, providerAddr)
+	err := keeper.SlashValidator(ctx, CONSUMER_ID, providerAddr, ctx.BlockTime())
+	require.Error(t, err)
 }
 
 func TestEquivocationEvidenceMinHeightCRUD(t *testing.T) {
@@ -788,3 +1136,121 @@ func TestEquivocationEvidenceMinHeightCRUD(t *testing.T) {
 	height = keeper.GetEquivocationEvidenceMinHeight(ctx, chainID)
 	require.Zero(t, height, "equivocation evidence min height should be 0")
 }
+
+// TestRemoveValidatorFromConsumers tests that a tombstoned validator is removed right away from the validator sets
+// of the launched consumer chains that contain it and that these chains carry the validator until the removal is acknowledged
+func TestRemoveValidatorFromConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(7842335)
+	providerAddr := identity.ProviderConsAddress()
+	pubKey := identity.TMProtoCryptoPublicKey()
+	otherIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(7842336)
+	otherProviderAddr := otherIdentity.ProviderConsAddress()
+	otherPubKey := otherIdentity.TMProtoCryptoPublicKey()
+
+	// consumers "0" and "1" are launched and only consumer "0" contains the validator,
+	// consumer "2" contains the validator but is stopped, and consumer "3" contains the validator but is dormant
+	for _, consumerId := range []string{"0", "1", "2", "3"} {
+		providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerValidator(ctx, consumerId, types.ConsensusValidator{
+			ProviderConsAddr: otherProviderAddr.ToSdkConsAddr(),
+			Power:            1,
+			PublicKey:        &otherPubKey,
+		})
+		require.NoError(t, err)
+	}
+	providerKeeper.SetConsumerPhase(ctx, "2", types.CONSUMER_PHASE_STOPPED)
+	providerKeeper.SetConsumerDormant(ctx, "3")
+	for _, consumerId := range []string{"0", "2", "3"} {
+		err := providerKeeper.SetConsumerValidator(ctx, consumerId, types.ConsensusValidator{
+			ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+			Power:            10,
+			PublicKey:        &pubKey,
+		})
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"0", "3"}, providerKeeper.GetConsumerChainsCarryingValidator(ctx, providerAddr))
+
+	valUpdateID := uint64(5)
+	providerKeeper.SetValidatorSetUpdateId(ctx, valUpdateID)
+	require.NoError(t, providerKeeper.RemoveValidatorFromConsumers(ctx, providerAddr))
+
+	// only consumer "0" has a pending VSC packet removing the validator
+	require.False(t, providerKeeper.IsConsumerValidator(ctx, "0", providerAddr))
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, "0")
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, valUpdateID, pendingPackets[0].ValsetUpdateId)
+	require.Len(t, pendingPackets[0].ValidatorUpdates, 1)
+	require.Equal(t, pubKey, pendingPackets[0].ValidatorUpdates[0].PubKey)
+	require.Zero(t, pendingPackets[0].ValidatorUpdates[0].Power)
+	vscIdToHeight, found := providerKeeper.GetVscIdToHeight(ctx, "0", valUpdateID)
+	require.True(t, found)
+	require.Equal(t, uint64(ctx.BlockHeight())+1, vscIdToHeight.Height)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "1"))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "2"))
+	require.True(t, providerKeeper.IsConsumerValidator(ctx, "2", providerAddr))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "3"))
+	require.True(t, providerKeeper.IsConsumerValidator(ctx, "3", providerAddr))
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+
+	// the VSC packet of consumer "0" is sent at the end of the block
+	require.Equal(t, []string{"0"}, providerKeeper.GetConsumersWithValidatorRemovalsToSend(ctx))
+
+	// consumer "0" carries the validator until the removal is acknowledged,
+	// while the dormant consumer "3" carries the validator until it resumes
+	require.Equal(t, []string{"0", "3"}, providerKeeper.GetConsumerChainsCarryingValidator(ctx, providerAddr))
+	providerKeeper.DeleteAcknowledgedValidatorRemovals(ctx, "0", valUpdateID-1)
+	require.Equal(t, []string{"0", "3"}, providerKeeper.GetConsumerChainsCarryingValidator(ctx, providerAddr))
+	providerKeeper.DeleteAcknowledgedValidatorRemovals(ctx, "0", valUpdateID)
+	require.Equal(t, []string{"3"}, providerKeeper.GetConsumerChainsCarryingValidator(ctx, providerAddr))
+
+	// removing a validator that is not part of any consumer validator set does not increment the valset update id
+	require.NoError(t, providerKeeper.RemoveValidatorFromConsumers(ctx, providerAddr))
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+}
+
+// TestSendValidatorRemovals tests that the VSC packets removing validators are sent at the end of the block
+// to the launched consumer chains with an established CCV channel that are not dormant
+func TestSendValidatorRemovals(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	var sentChannels []string
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, gomock.Any()).Return(
+		channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(
+		&capabilitytypes.Capability{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), ccvtypes.ProviderPortID, gomock.Any(),
+		clienttypes.Height{}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *capabilitytypes.Capability, _, channelId string, _ clienttypes.Height, _ uint64, _ []byte) (uint64, error) {
+			sentChannels = append(sentChannels, channelId)
+			return uint64(len(sentChannels)), nil
+		}).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ConsumerRewardsPool).Return(
+		authtypes.NewEmptyModuleAccount(types.ConsumerRewardsPool)).AnyTimes()
+
+	// consumer "0" is launched, consumer "1" is dormant, consumer "2" is stopped,
+	// and consumer "3" has no established CCV channel
+	for _, consumerId := range []string{"0", "1", "2", "3"} {
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		if consumerId != "3" {
+			providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channel-"+consumerId)
+		}
+		providerKeeper.AppendPendingVSCPackets(ctx, consumerId, ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+		providerKeeper.SetConsumerWithValidatorRemovalsToSend(ctx, consumerId)
+	}
+	providerKeeper.SetConsumerDormant(ctx, "1")
+	providerKeeper.SetConsumerPhase(ctx, "2", types.CONSUMER_PHASE_STOPPED)
+
+	require.NoError(t, providerKeeper.SendValidatorRemovals(ctx))
+	require.Equal(t, []string{"channel-0"}, sentChannels)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "0"))
+	for _, consumerId := range []string{"1", "2", "3"} {
+		require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId), 1)
+	}
+	require.Empty(t, providerKeeper.GetConsumersWithValidatorRemovalsToSend(ctx))
+}
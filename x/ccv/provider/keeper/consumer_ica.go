@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// GetConsumerAllowedIcaMsgTypes returns the message types that the interchain accounts controlled by the owner
+// of the consumer chain with `consumerId` are allowed to execute on the consumer chain. An empty list means that
+// the message types are not restricted.
+func (k Keeper) GetConsumerAllowedIcaMsgTypes(ctx sdk.Context, consumerId string) ([]string, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToAllowedIcaMsgTypesKey(consumerId))
+	if bz == nil {
+		return []string{}, nil
+	}
+
+	var allowedIcaMsgTypes types.AllowedIcaMsgTypes
+	if err := allowedIcaMsgTypes.Unmarshal(bz); err != nil {
+		return []string{}, err
+	}
+	return allowedIcaMsgTypes.MsgTypeUrls, nil
+}
+
+// SetConsumerAllowedIcaMsgTypes sets the message types that the interchain accounts controlled by the owner
+// of the consumer chain with `consumerId` are allowed to execute on the consumer chain
+func (k Keeper) SetConsumerAllowedIcaMsgTypes(ctx sdk.Context, consumerId string, msgTypeUrls []string) error {
+	store := ctx.KVStore(k.storeKey)
+	allowedIcaMsgTypes := types.AllowedIcaMsgTypes{MsgTypeUrls: msgTypeUrls}
+	bz, err := allowedIcaMsgTypes.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.ConsumerIdToAllowedIcaMsgTypesKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerAllowedIcaMsgTypes deletes the message types that the interchain accounts controlled by the owner
+// of the consumer chain with `consumerId` are allowed to execute on the consumer chain
+func (k Keeper) DeleteConsumerAllowedIcaMsgTypes(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToAllowedIcaMsgTypesKey(consumerId))
+}
+
+// UpdateConsumerAllowedIcaMsgTypes overwrites the allowed interchain account message types of the consumer chain
+// with `consumerId` with the provided `msgTypeUrls`. An empty list removes the restriction.
+func (k Keeper) UpdateConsumerAllowedIcaMsgTypes(ctx sdk.Context, consumerId string, msgTypeUrls []string) error {
+	if len(msgTypeUrls) == 0 {
+		k.DeleteConsumerAllowedIcaMsgTypes(ctx, consumerId)
+		return nil
+	}
+	if err := types.ValidateAllowedIcaMsgTypes(types.AllowedIcaMsgTypes{MsgTypeUrls: msgTypeUrls}); err != nil {
+		return err
+	}
+	return k.SetConsumerAllowedIcaMsgTypes(ctx, consumerId, msgTypeUrls)
+}
+
+// GetConsumerIdOfIcaControllerChannel returns the consumer id of the consumer chain to which the channel with
+// `portId` and `channelId` is registered, i.e., the channel is an interchain accounts controller channel whose
+// owner is the owner of the consumer chain (e.g., the gov module for a Top N chain) and that is built on top
+// of the client to the consumer chain. It returns false if the channel is not registered to a consumer chain.
+func (k Keeper) GetConsumerIdOfIcaControllerChannel(ctx sdk.Context, portId, channelId string) (string, bool) {
+	if !strings.HasPrefix(portId, icatypes.ControllerPortPrefix) {
+		return "", false
+	}
+	icaOwner := strings.TrimPrefix(portId, icatypes.ControllerPortPrefix)
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portId, channelId)
+	if !found || len(channel.ConnectionHops) != 1 {
+		return "", false
+	}
+	clientId, _, err := k.getUnderlyingClient(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return "", false
+	}
+	consumerId, found := k.GetClientIdToConsumerId(ctx, clientId)
+	if !found {
+		return "", false
+	}
+
+	ownerAddress, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil || ownerAddress != icaOwner {
+		return "", false
+	}
+	return consumerId, true
+}
+
+// ValidateIcaMsgTypes returns an error if any of the message types `msgTypeUrls` is not allowed to be executed
+// by the interchain accounts controlled by the owner of the consumer chain with `consumerId`
+func (k Keeper) ValidateIcaMsgTypes(ctx sdk.Context, consumerId string, msgTypeUrls []string) error {
+	allowedMsgTypes, err := k.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if len(allowedMsgTypes) == 0 {
+		return nil
+	}
+
+	allowed := make(map[string]bool, len(allowedMsgTypes))
+	for _, msgTypeUrl := range allowedMsgTypes {
+		allowed[msgTypeUrl] = true
+	}
+	for _, msgTypeUrl := range msgTypeUrls {
+		if !allowed[msgTypeUrl] {
+			return errorsmod.Wrapf(types.ErrIcaMsgTypeNotAllowed,
+				"message type %s is not allowed on consumer chain %s", msgTypeUrl, consumerId)
+		}
+	}
+	return nil
+}
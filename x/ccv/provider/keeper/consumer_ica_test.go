@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestConsumerAllowedIcaMsgTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	msgTypes, err := providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+
+	expectedMsgTypes := []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgVote"}
+	err = providerKeeper.SetConsumerAllowedIcaMsgTypes(ctx, consumerId, expectedMsgTypes)
+	require.NoError(t, err)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, expectedMsgTypes, msgTypes)
+
+	// the message types of other consumer chains are not affected
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+
+	providerKeeper.DeleteConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+}
+
+func TestUpdateConsumerAllowedIcaMsgTypesKeeper(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	err := providerKeeper.UpdateConsumerAllowedIcaMsgTypes(ctx, consumerId, []string{"/cosmos.gov.v1.MsgVote"})
+	require.NoError(t, err)
+	msgTypes, err := providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.gov.v1.MsgVote"}, msgTypes)
+
+	// invalid message types are rejected and the stored message types are left unchanged
+	err = providerKeeper.UpdateConsumerAllowedIcaMsgTypes(ctx, consumerId, []string{"cosmos.gov.v1.MsgVote"})
+	require.Error(t, err)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.gov.v1.MsgVote"}, msgTypes)
+
+	// an empty list removes the restriction
+	err = providerKeeper.UpdateConsumerAllowedIcaMsgTypes(ctx, consumerId, []string{})
+	require.NoError(t, err)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+}
+
+func TestValidateIcaMsgTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	msgTypes := []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgVote"}
+
+	// any message type is allowed if the message types are not restricted
+	require.NoError(t, providerKeeper.ValidateIcaMsgTypes(ctx, consumerId, msgTypes))
+
+	err := providerKeeper.SetConsumerAllowedIcaMsgTypes(ctx, consumerId, []string{"/cosmos.gov.v1.MsgVote"})
+	require.NoError(t, err)
+	require.NoError(t, providerKeeper.ValidateIcaMsgTypes(ctx, consumerId, []string{}))
+	require.NoError(t, providerKeeper.ValidateIcaMsgTypes(ctx, consumerId, msgTypes[1:]))
+	err = providerKeeper.ValidateIcaMsgTypes(ctx, consumerId, msgTypes)
+	require.ErrorIs(t, err, providertypes.ErrIcaMsgTypeNotAllowed)
+	require.ErrorContains(t, err, "/cosmos.bank.v1beta1.MsgSend")
+}
+
+func TestGetConsumerIdOfIcaControllerChannel(t *testing.T) {
+	consumerId := "0"
+	owner := "owner"
+	portId := icatypes.ControllerPortPrefix + owner
+	channelId := "channel-0"
+	clientId := "07-tendermint-0"
+
+	testCases := []struct {
+		name         string
+		portId       string
+		setup        func(ctx sdk.Context, mocks testkeeper.MockedKeepers)
+		expConsumer  bool
+		consumerOwns bool
+	}{
+		{
+			"not an interchain accounts controller port",
+			"transfer",
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {},
+			false,
+			true,
+		},
+		{
+			"channel not found",
+			portId,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, portId, channelId).
+					Return(channeltypes.Channel{}, false).Times(1)
+			},
+			false,
+			true,
+		},
+		{
+			"channel not built on top of a consumer client",
+			portId,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, portId, channelId).
+						Return(channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-0").
+						Return(conntypes.ConnectionEnd{ClientId: "07-tendermint-1"}, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "07-tendermint-1").
+						Return(&ibctmtypes.ClientState{}, true).Times(1),
+				)
+			},
+			false,
+			true,
+		},
+		{
+			"channel of a consumer chain not owned by the owner of the port",
+			portId,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, portId, channelId).
+						Return(channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-0").
+						Return(conntypes.ConnectionEnd{ClientId: clientId}, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, clientId).
+						Return(&ibctmtypes.ClientState{}, true).Times(1),
+				)
+			},
+			false,
+			false,
+		},
+		{
+			"channel registered to the consumer chain",
+			portId,
+			func(ctx sdk.Context, mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, portId, channelId).
+						Return(channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connection-0").
+						Return(conntypes.ConnectionEnd{ClientId: clientId}, true).Times(1),
+					mocks.MockClientKeeper.EXPECT().GetClientState(ctx, clientId).
+						Return(&ibctmtypes.ClientState{}, true).Times(1),
+				)
+			},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
+			if tc.consumerOwns {
+				providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, owner)
+			} else {
+				providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "other-owner")
+			}
+			tc.setup(ctx, mocks)
+
+			actualConsumerId, found := providerKeeper.GetConsumerIdOfIcaControllerChannel(ctx, tc.portId, channelId)
+			require.Equal(t, tc.expConsumer, found)
+			if tc.expConsumer {
+				require.Equal(t, consumerId, actualConsumerId)
+			}
+		})
+	}
+}
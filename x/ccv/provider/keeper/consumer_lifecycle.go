@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
@@ -12,11 +18,12 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
@@ -61,43 +68,222 @@ func (k Keeper) InitializeConsumer(ctx sdk.Context, consumerId string) (time.Tim
 	return initializationParameters.SpawnTime, true
 }
 
-// BeginBlockLaunchConsumers launches initialized consumers chains for which the spawn time has passed
+// GetConsumerLaunchChecklist returns the checks that the consumer chain with `consumerId` has to pass
+// before it can launch, i.e., the follow-up requirements of MsgCreateConsumer:
+//   - the spawn time is set and in the future;
+//   - the initialization parameters are valid;
+//   - at least one validator opted in (for Top N chains, the validators in the top N% are opted in at launch);
+//   - the power-shaping parameters are consistent, i.e., no validator is both allowlisted and denylisted;
+//   - reward denoms are allowlisted;
+//   - the Top N chains are owned by governance, i.e., the Top N was set through a governance proposal.
+//
+// Note that the checklist is only available for consumer chains that are registered or initialized.
+func (k Keeper) GetConsumerLaunchChecklist(ctx sdk.Context, consumerId string) ([]types.ConsumerLaunchCheck, error) {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != types.CONSUMER_PHASE_REGISTERED && phase != types.CONSUMER_PHASE_INITIALIZED {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot get launch checklist of consumer chain in phase %s, consumerId(%s)", phase, consumerId)
+	}
+
+	checks := []types.ConsumerLaunchCheck{}
+	addCheck := func(name string, passed bool, details string, args ...interface{}) {
+		checks = append(checks, types.ConsumerLaunchCheck{Name: name, Passed: passed, Details: fmt.Sprintf(details, args...)})
+	}
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	switch {
+	case err != nil || initializationParameters.SpawnTime.IsZero():
+		addCheck(types.LaunchCheckSpawnTime, false, "spawn time is not set")
+	case !initializationParameters.SpawnTime.After(ctx.BlockTime()):
+		addCheck(types.LaunchCheckSpawnTime, false, "spawn time %s is not in the future", initializationParameters.SpawnTime.UTC())
+	default:
+		addCheck(types.LaunchCheckSpawnTime, true, "consumer chain launches at %s", initializationParameters.SpawnTime.UTC())
+	}
+
+	if err != nil {
+		addCheck(types.LaunchCheckInitializationParameters, false, "initialization parameters are not set")
+	} else if err := types.ValidateInitializationParameters(initializationParameters); err != nil {
+		addCheck(types.LaunchCheckInitializationParameters, false, "invalid initialization parameters: %s", err.Error())
+	} else {
+		addCheck(types.LaunchCheckInitializationParameters, true, "initialization parameters are valid")
+	}
+
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+
+	numOptedIn := len(k.GetAllOptedIn(ctx, consumerId))
+	switch {
+	case powerShapingParameters.Top_N > 0:
+		addCheck(types.LaunchCheckOptedInValidators, true,
+			"%d validators opted in; the validators in the top %d%% are opted in at launch", numOptedIn, powerShapingParameters.Top_N)
+	case numOptedIn == 0:
+		addCheck(types.LaunchCheckOptedInValidators, false, "no validator opted in")
+	default:
+		addCheck(types.LaunchCheckOptedInValidators, true, "%d validators opted in", numOptedIn)
+	}
+
+	conflicts := []string{}
+	for _, addr := range k.GetAllowList(ctx, consumerId) {
+		if k.IsDenylisted(ctx, consumerId, addr) {
+			conflicts = append(conflicts, addr.String())
+		}
+	}
+	if len(conflicts) > 0 {
+		addCheck(types.LaunchCheckPowerShapingParameters, false,
+			"validators both allowlisted and denylisted: %s", strings.Join(conflicts, ", "))
+	} else {
+		addCheck(types.LaunchCheckPowerShapingParameters, true, "no validator is both allowlisted and denylisted")
+	}
+
+	rewardDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	if len(rewardDenoms) == 0 {
+		addCheck(types.LaunchCheckRewardDenoms, false, "no reward denoms are allowlisted")
+	} else {
+		addCheck(types.LaunchCheckRewardDenoms, true, "allowlisted reward denoms: %s", strings.Join(rewardDenoms, ", "))
+	}
+
+	// Top N chains cannot be created permissionlessly, i.e., the Top N is set through a governance proposal
+	// that transfers the ownership of the consumer chain to governance
+	if powerShapingParameters.Top_N == 0 {
+		addCheck(types.LaunchCheckGovernanceApproval, true, "governance approval is not required for Opt In chains")
+	} else {
+		ownerAddress, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+		if err != nil {
+			return nil, err
+		}
+		if ownerAddress == k.GetAuthority() {
+			addCheck(types.LaunchCheckGovernanceApproval, true, "Top N chain is owned by governance")
+		} else {
+			addCheck(types.LaunchCheckGovernanceApproval, false, "Top N chain is owned by %s instead of governance", ownerAddress)
+		}
+	}
+
+	return checks, nil
+}
+
+// BeginBlockLaunchConsumers launches initialized consumers chains for which the spawn time has passed,
+// i.e., is not after the block time (see TimeQueue.ConsumeUpTo). As launching a consumer chain creates
+// a consumer client, the launch of at most `MaxClientCreationsPerBlock` consumer chains is attempted in a block,
+// while the launches beyond the cap are deferred to the next block (see deferConsumerLaunches).
 func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
-	bondedValidators := []stakingtypes.Validator{}
-	activeValidators := []stakingtypes.Validator{}
-
-	consumerIds, err := k.ConsumeIdsFromTimeQueue(
-		ctx,
-		types.SpawnTimeToConsumerIdsKeyPrefix(),
-		k.GetConsumersToBeLaunched,
-		k.DeleteAllConsumersToBeLaunched,
-		k.AppendConsumerToBeLaunched,
-		200,
-	)
+	validatorSet := BondedValidatorSet{}
+
+	consumerIds, err := k.SpawnTimeQueue().ConsumeUpTo(ctx, ctx.BlockTime(), 200)
 	if err != nil {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to laumch: %s", err.Error())
 	}
+	if maxClientCreations := k.GetMaxClientCreationsPerBlock(ctx); maxClientCreations > 0 && int64(len(consumerIds)) > maxClientCreations {
+		if err := k.deferConsumerLaunches(ctx, consumerIds[maxClientCreations:]); err != nil {
+			return err
+		}
+		consumerIds = consumerIds[:maxClientCreations]
+	}
 	if len(consumerIds) > 0 {
-		// get the bonded validators from the staking module
-		bondedValidators, err = k.GetLastBondedValidators(ctx)
+		// get the bonded validators and the provider active validators from the staking module
+		// once for all the consumer chains
+		validatorSet, err = k.GetLastBondedValidatorSet(ctx)
 		if err != nil {
 			return fmt.Errorf("getting last bonded validators: %w", err)
 		}
-		// get the provider active validators
-		activeValidators, err = k.GetLastProviderConsensusActiveValidators(ctx)
-		if err != nil {
-			return fmt.Errorf("getting last provider active validators: %w", err)
-		}
 	}
 
 	for _, consumerId := range consumerIds {
 		cachedCtx, writeFn := ctx.CacheContext()
-		err = k.LaunchConsumer(cachedCtx, bondedValidators, activeValidators, consumerId)
+		err = k.LaunchConsumer(cachedCtx, validatorSet, consumerId)
+		if err != nil {
+			// the opt-ins to a consumer chain that is not launched are discarded
+			validatorSet.participations.removeConsumer(consumerId)
+		}
+		if errors.Is(err, types.ErrNoBondedValidators) || errors.Is(err, types.ErrEmptyActiveValidatorSet) {
+			// the consumer cannot launch because there are no bonded validators (e.g., after all validators
+			// unbonded) or, for a Top N chain, because the active validator set is empty (e.g., in the first
+			// block after a genesis restart); keep the consumer initialized and retry to launch it in the next block
+			ctx.Logger().Error("could not launch chain without bonded or active validators, retrying in the next block",
+				"consumerId", consumerId,
+				"error", err)
+
+			initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+			if err != nil {
+				return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+					"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+			}
+			err = k.AppendConsumerToBeLaunched(ctx, consumerId, initializationRecord.SpawnTime)
+			if err != nil {
+				return fmt.Errorf("re-queueing consumer to be launched, consumerId(%s): %w", consumerId, err)
+			}
+
+			continue
+		}
+		if errors.Is(err, types.ErrConsumerChainIdAlreadyLaunched) &&
+			k.GetConsumerLaunchConflictRetries(ctx, consumerId) < types.MaxConsumerLaunchConflictRetries {
+			// the consumer cannot launch because another consumer chain with the same chain id is launched;
+			// keep the consumer initialized (so that its owner can remove it) and retry to launch it in the next block.
+			// After `MaxConsumerLaunchConflictRetries` retries, the launch fails as any other failed launch.
+			chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+			conflictingConsumerId, _ := k.GetLaunchedConsumerIdByChainId(ctx, chainId, consumerId)
+			ctx.Logger().Error("could not launch chain since a consumer chain with the same chain id is launched",
+				"consumerId", consumerId,
+				"chainId", chainId,
+				"conflictingConsumerId", conflictingConsumerId)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConsumerLaunchConflict,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+					sdk.NewAttribute(types.AttributeConflictingConsumerId, conflictingConsumerId),
+				),
+			)
+
+			initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+			if err != nil {
+				return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+					"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+			}
+			err = k.AppendConsumerToBeLaunched(ctx, consumerId, initializationRecord.SpawnTime)
+			if err != nil {
+				return fmt.Errorf("re-queueing consumer to be launched, consumerId(%s): %w", consumerId, err)
+			}
+			k.SetConsumerLaunchConflictRetries(ctx, consumerId, k.GetConsumerLaunchConflictRetries(ctx, consumerId)+1)
+
+			continue
+		}
+		// the launch either succeeds or fails, so it is no longer retried
+		k.DeleteConsumerLaunchConflictRetries(ctx, consumerId)
 		if err != nil {
 			ctx.Logger().Error("could not launch chain",
 				"consumerId", consumerId,
 				"error", err)
 
+			// record why the launch failed, so that the owner can fix it before trying again
+			launchErr := err
+			err = k.SetConsumerRemovalRecord(ctx, consumerId, types.ConsumerRemovalRecord{
+				Reason: types.CONSUMER_REMOVAL_REASON_LAUNCH_FAILED,
+				Note:   launchErr.Error(),
+			})
+			if err != nil {
+				return fmt.Errorf("setting consumer removal record, consumerId(%s): %w", consumerId, err)
+			}
+
+			chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConsumerLaunchFailed,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+					sdk.NewAttribute(types.AttributeRemovalReason, types.CONSUMER_REMOVAL_REASON_LAUNCH_FAILED.String()),
+					sdk.NewAttribute(types.AttributeRemovalReasonValue, strconv.Itoa(int(types.CONSUMER_REMOVAL_REASON_LAUNCH_FAILED))),
+					sdk.NewAttribute(types.AttributeRemovalNote, launchErr.Error()),
+				),
+			)
+
 			// reset spawn time to zero so that owner can try again later
 			initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
 			if err != nil {
@@ -116,78 +302,47 @@ func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 		}
 
 		writeFn()
+		// the record of a previous failed launch no longer applies to a launched chain
+		k.DeleteConsumerRemovalRecord(ctx, consumerId)
 	}
 	return nil
 }
 
-// ConsumeIdsFromTimeQueue returns from a time queue the consumer ids for which the associated time passed.
-// The number of ids return is limited to 'limit'. The ids returned are removed from the time queue.
-func (k Keeper) ConsumeIdsFromTimeQueue(
-	ctx sdk.Context,
-	timeQueueKeyPrefix byte,
-	getIds func(sdk.Context, time.Time) (types.ConsumerIds, error),
-	deleteAllIds func(sdk.Context, time.Time),
-	appendId func(sdk.Context, string, time.Time) error,
-	limit int,
-) ([]string, error) {
-	store := ctx.KVStore(k.storeKey)
-
-	result := []string{}
-	nextTime := []string{}
-	timestampsToDelete := []time.Time{}
-
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{timeQueueKeyPrefix})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		if len(result) >= limit {
-			break
-		}
-		ts, err := types.ParseTime(timeQueueKeyPrefix, iterator.Key())
-		if err != nil {
-			return result, fmt.Errorf("parsing removal time: %w", err)
-		}
-		if ts.After(ctx.BlockTime()) {
-			break
-		}
-
-		consumerIds, err := getIds(ctx, ts)
+// deferConsumerLaunches puts the consumer chains with `consumerIds`, which were consumed from the spawn-time queue
+// in this order, back into the queue so that their launch is attempted in the next block. The consumer ids are
+// stored back before the consumer ids that remain in the queue under the same spawn time, i.e., the queue keeps
+// the order in which the consumer chains are launched.
+func (k Keeper) deferConsumerLaunches(ctx sdk.Context, consumerIds []string) error {
+	// the consumer ids are ordered by spawn time, i.e., the consumer ids with the same spawn time are consecutive
+	var spawnTimes []time.Time
+	var idsBySpawnTime [][]string
+	for _, consumerId := range consumerIds {
+		initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
 		if err != nil {
-			return result,
-				fmt.Errorf("getting consumers ids, ts(%s): %w", ts.String(), err)
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
 		}
-
-		timestampsToDelete = append(timestampsToDelete, ts)
-
-		availableSlots := limit - len(result)
-		if availableSlots >= len(consumerIds.Ids) {
-			// consumer all the ids
-			result = append(result, consumerIds.Ids...)
-		} else {
-			// consume only availableSlots
-			result = append(result, consumerIds.Ids[:availableSlots]...)
-			// and leave the others for next time
-			nextTime = consumerIds.Ids[availableSlots:]
-			break
+		last := len(spawnTimes) - 1
+		if last >= 0 && spawnTimes[last].Equal(initializationRecord.SpawnTime) {
+			idsBySpawnTime[last] = append(idsBySpawnTime[last], consumerId)
+			continue
 		}
+		spawnTimes = append(spawnTimes, initializationRecord.SpawnTime)
+		idsBySpawnTime = append(idsBySpawnTime, []string{consumerId})
 	}
 
-	// remove consumers to prevent handling them twice
-	for i, ts := range timestampsToDelete {
-		deleteAllIds(ctx, ts)
-		if i == len(timestampsToDelete)-1 {
-			// for the last ts consumed, store back the ids for later
-			for _, consumerId := range nextTime {
-				err := appendId(ctx, consumerId, ts)
-				if err != nil {
-					return result,
-						fmt.Errorf("failed to append consumer id, consumerId(%s), ts(%s): %w",
-							consumerId, ts.String(), err)
-				}
-			}
+	for i, spawnTime := range spawnTimes {
+		if err := k.SpawnTimeQueue().Prepend(ctx, idsBySpawnTime[i], spawnTime); err != nil {
+			return fmt.Errorf("re-queueing consumers to be launched, spawnTime(%s): %w", spawnTime, err)
 		}
 	}
 
-	return result, nil
+	k.Logger(ctx).Info("deferred consumer launches to the next block since the cap on client creations was reached",
+		"numDeferred", len(consumerIds),
+		"maxClientCreationsPerBlock", k.GetMaxClientCreationsPerBlock(ctx),
+	)
+	telemetry.IncrCounter(float32(len(consumerIds)), types.ModuleName, "deferred_consumer_launches")
+	return nil
 }
 
 // LaunchConsumer launches the chain with the provided consumer id by creating the consumer client and the respective
@@ -196,12 +351,29 @@ func (k Keeper) ConsumeIdsFromTimeQueue(
 // TODO add unit test for LaunchConsumer
 func (k Keeper) LaunchConsumer(
 	ctx sdk.Context,
-	bondedValidators []stakingtypes.Validator,
-	activeValidators []stakingtypes.Validator,
+	validatorSet BondedValidatorSet,
 	consumerId string,
 ) error {
+	// a consumer chain cannot launch if another consumer chain with the same chain id is launched,
+	// as validators could not tell which consumer id is canonical
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("getting consumer chain id, consumerId(%s): %w", consumerId, err)
+	}
+	if launchedConsumerId, found := k.GetLaunchedConsumerIdByChainId(ctx, chainId, consumerId); found {
+		return errorsmod.Wrapf(types.ErrConsumerChainIdAlreadyLaunched,
+			"cannot launch consumer, consumerId(%s): chain id %s is used by launched consumer %s", consumerId, chainId, launchedConsumerId)
+	}
+
+	if len(validatorSet.Bonded) == 0 {
+		return errorsmod.Wrapf(types.ErrNoBondedValidators, "cannot launch consumer, consumerId(%s)", consumerId)
+	}
+	if err := k.checkActiveValidatorsForTopN(ctx, consumerId, len(validatorSet.Active)); err != nil {
+		return err
+	}
+
 	// compute consumer initial validator set
-	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, []types.ConsensusValidator{})
 	if err != nil {
 		return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 	}
@@ -209,7 +381,9 @@ func (k Keeper) LaunchConsumer(
 		return fmt.Errorf("cannot launch consumer with no validator opted in, consumerId(%s)", consumerId)
 	}
 
-	// create consumer genesis
+	// create consumer genesis;
+	// note that consumer keys assigned in the same block after the consumer launched are not part of the genesis,
+	// but they are included in the first VSCPacket sent to the consumer (see QueueVSCPackets)
 	genesisState, err := k.MakeConsumerGenesis(ctx, consumerId, initialValUpdates)
 	if err != nil {
 		return fmt.Errorf("creating consumer genesis state, consumerId(%s): %w", consumerId, err)
@@ -218,6 +392,24 @@ func (k Keeper) LaunchConsumer(
 	if err != nil {
 		return fmt.Errorf("setting consumer genesis state, consumerId(%s): %w", consumerId, err)
 	}
+	if genesisState.Params.DistributionTransmissionChannel == "" {
+		// the consumer chain creates a new transfer channel to send the rewards to the provider
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributionChannelUnset,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			),
+		)
+	}
+	// store the hash of the consumer genesis so that the genesis distributed to the consumer validators
+	// can be verified against the genesis actually created at launch
+	genesisHash, err := genesisState.Hash()
+	if err != nil {
+		return fmt.Errorf("computing consumer genesis hash, consumerId(%s): %w", consumerId, err)
+	}
+	k.SetConsumerGenesisHash(ctx, consumerId, genesisHash)
 
 	// compute the hash of the consumer initial validator updates
 	updatesAsValSet, err := tmtypes.PB2TM.ValidatorUpdates(initialValUpdates)
@@ -234,6 +426,10 @@ func (k Keeper) LaunchConsumer(
 
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
 
+	if err := k.ScheduleConsumerSunset(ctx, consumerId); err != nil {
+		return fmt.Errorf("scheduling consumer sunset, consumerId(%s): %w", consumerId, err)
+	}
+
 	k.Logger(ctx).Info("consumer successfully launched",
 		"consumerId", consumerId,
 		"valset size", len(initialValUpdates),
@@ -243,6 +439,38 @@ func (k Keeper) LaunchConsumer(
 	return nil
 }
 
+// GetConsumerChainIdConflicts returns, sorted by chain id, the chain ids used by more than one
+// registered, initialized, or launched consumer chain
+func (k Keeper) GetConsumerChainIdConflicts(ctx sdk.Context) []types.ConsumerChainIdConflict {
+	conflictsByChainId := map[string]*types.ConsumerChainIdConflict{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			continue
+		}
+		conflict, found := conflictsByChainId[chainId]
+		if !found {
+			conflict = &types.ConsumerChainIdConflict{ChainId: chainId}
+			conflictsByChainId[chainId] = conflict
+		}
+		conflict.ConsumerIds = append(conflict.ConsumerIds, consumerId)
+		if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_LAUNCHED {
+			conflict.LaunchedConsumerId = consumerId
+		}
+	}
+
+	conflicts := []types.ConsumerChainIdConflict{}
+	for _, conflict := range conflictsByChainId {
+		if len(conflict.ConsumerIds) > 1 {
+			conflicts = append(conflicts, *conflict)
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].ChainId < conflicts[j].ChainId
+	})
+	return conflicts
+}
+
 // CreateConsumerClient will create the CCV client for the given consumer chain. The CCV channel must be built
 // on top of the CCV client to ensure connection with the right consumer chain.
 func (k Keeper) CreateConsumerClient(
@@ -270,33 +498,16 @@ func (k Keeper) CreateConsumerClient(
 	k.SetEquivocationEvidenceMinHeight(ctx, consumerId, initializationRecord.InitialHeight.RevisionHeight)
 
 	// Consumers start out with the unbonding period from the initialization parameters
-	consumerUnbondingPeriod := initializationRecord.UnbondingPeriod
-
-	// Create client state by getting template client from initialization parameters
-	clientState := k.GetTemplateClient(ctx)
-	clientState.ChainId = chainId
-	clientState.LatestHeight = initializationRecord.InitialHeight
-
-	trustPeriod, err := ccv.CalculateTrustPeriod(consumerUnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
-	if err != nil {
-		return err
-	}
-	clientState.TrustingPeriod = trustPeriod
-	clientState.UnbondingPeriod = consumerUnbondingPeriod
-
-	// Create consensus state
-	consensusState := ibctmtypes.NewConsensusState(
-		ctx.BlockTime(),
-		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
-		valsetHash,
-	)
-
-	clientID, err := k.clientKeeper.CreateClient(ctx, clientState, consensusState)
+	clientID, clientState, err := k.createClient(ctx, chainId, initializationRecord.InitialHeight,
+		initializationRecord.UnbondingPeriod, valsetHash)
 	if err != nil {
 		return err
 	}
 	k.SetConsumerClientId(ctx, consumerId, clientID)
 
+	// the genesis hash is only set if the consumer genesis was already created (see LaunchConsumer)
+	genesisHash, _ := k.GetConsumerGenesisHash(ctx, consumerId)
+
 	k.Logger(ctx).Info("consumer client created",
 		"consumer id", consumerId,
 		"client id", clientID,
@@ -313,12 +524,142 @@ func (k Keeper) CreateConsumerClient(
 			sdk.NewAttribute(types.AttributeTrustingPeriod, clientState.TrustingPeriod.String()),
 			sdk.NewAttribute(types.AttributeUnbondingPeriod, clientState.UnbondingPeriod.String()),
 			sdk.NewAttribute(types.AttributeValsetHash, string(valsetHash)),
+			sdk.NewAttribute(types.AttributeGenesisHash, hex.EncodeToString(genesisHash)),
 		),
 	)
 
 	return nil
 }
 
+// createClient creates a client to the consumer chain with `chainId`, whose latest height is `initialHeight`
+// and whose consensus state trusts the validator set with `valsetHash`, and returns the client id and client state
+func (k Keeper) createClient(
+	ctx sdk.Context,
+	chainId string,
+	initialHeight clienttypes.Height,
+	unbondingPeriod time.Duration,
+	valsetHash []byte,
+) (string, *ibctmtypes.ClientState, error) {
+	// Create client state by getting template client from initialization parameters
+	clientState := k.GetTemplateClient(ctx)
+	clientState.ChainId = chainId
+	clientState.LatestHeight = initialHeight
+
+	trustPeriod, err := ccv.CalculateTrustPeriod(unbondingPeriod, k.GetTrustingPeriodFraction(ctx))
+	if err != nil {
+		return "", nil, err
+	}
+	clientState.TrustingPeriod = trustPeriod
+	clientState.UnbondingPeriod = unbondingPeriod
+
+	// Create consensus state
+	consensusState := ibctmtypes.NewConsensusState(
+		ctx.BlockTime(),
+		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
+		valsetHash,
+	)
+
+	clientID, err := k.clientKeeper.CreateClient(ctx, clientState, consensusState)
+	if err != nil {
+		return "", nil, err
+	}
+	return clientID, clientState, nil
+}
+
+// HardForkConsumer updates the state of the launched consumer chain with `consumerId` after the consumer chain
+// hard forked to `newChainId`, and returns the id of the client created for the forked chain. Specifically, it
+//   - updates the chain id of the consumer chain;
+//   - creates a new client to the forked chain at `initialHeight`, whose consensus state trusts the current
+//     consumer validator set (the unbonding period of the previous client is kept);
+//   - sets the minimum height for equivocation evidence to the initial height of the forked chain;
+//   - closes the CCV channel (if any) and clears its mappings, so that a new CCV channel can be established
+//     on top of the new client.
+//
+// Note that the opt-ins, key assignments and commission rates of the consumer chain are preserved and that
+// the VSC packets are queued until the new CCV channel is established.
+func (k Keeper) HardForkConsumer(
+	ctx sdk.Context,
+	consumerId string,
+	newChainId string,
+	initialHeight clienttypes.Height,
+) (string, error) {
+	prevClientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return "", errorsmod.Wrapf(ccv.ErrClientNotFound, "cannot find client for consumer chain %s", consumerId)
+	}
+	prevClientState, found := k.clientKeeper.GetClientState(ctx, prevClientId)
+	if !found {
+		return "", errorsmod.Wrapf(clienttypes.ErrClientNotFound, "cannot find client state for client %s", prevClientId)
+	}
+	prevTmClientState, ok := prevClientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return "", errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "unexpected client state type %T", prevClientState)
+	}
+
+	// the forked consumer chain starts with the current consumer validator set
+	consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return "", fmt.Errorf("getting consumer validator set, consumerId(%s): %w", consumerId, err)
+	}
+	valUpdates := DiffValidators([]types.ConsensusValidator{}, consumerValSet)
+	if len(valUpdates) == 0 {
+		return "", fmt.Errorf("cannot hard fork consumer with an empty validator set, consumerId(%s)", consumerId)
+	}
+	updatesAsValSet, err := tmtypes.PB2TM.ValidatorUpdates(valUpdates)
+	if err != nil {
+		return "", fmt.Errorf("unable to create validator set from consumer validators: %w", err)
+	}
+	valsetHash := tmtypes.NewValidatorSet(updatesAsValSet).Hash()
+
+	clientID, _, err := k.createClient(ctx, newChainId, initialHeight, prevTmClientState.UnbondingPeriod, valsetHash)
+	if err != nil {
+		return "", err
+	}
+	k.SetConsumerClientId(ctx, consumerId, clientID)
+	k.SetConsumerChainId(ctx, consumerId, newChainId)
+
+	// evidence of the forked chain cannot be older than its initial height,
+	// while the evidence of the previous chain is not valid anymore
+	k.SetEquivocationEvidenceMinHeight(ctx, consumerId, initialHeight.RevisionHeight)
+	k.DeleteEquivocationEvidenceMinChainId(ctx, consumerId)
+
+	// close the CCV channel to the previous chain and delete its mappings,
+	// so that a new CCV channel can be established for the consumer chain
+	if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
+		channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
+		if found && channel.State != channeltypes.CLOSED {
+			if err := k.chanCloseInit(ctx, channelID); err != nil {
+				k.Logger(ctx).Error("channel to consumer chain could not be closed",
+					"consumerId", consumerId,
+					"channelID", channelID,
+					"error", err.Error(),
+				)
+			}
+		}
+		k.DeleteConsumerIdToChannelId(ctx, consumerId)
+		k.DeleteChannelIdToConsumerId(ctx, channelID)
+		// packets may still be in flight on the channel; keep track of the deleted mapping
+		// so that their acknowledgements and timeouts do not fail
+		k.SetDeletedChannelIdToConsumerId(ctx, channelID, consumerId)
+	}
+	// the provider fee pool address is communicated again during the handshake of the new CCV channel
+	k.DeleteConsumerProviderFeePoolAddr(ctx, consumerId)
+	// the scheduled provider upgrade is communicated again over the new CCV channel, provided that
+	// the consumer chain declares again during its handshake that it accepts provider upgrade notices
+	k.DeleteConsumerProviderUpgradeNotice(ctx, consumerId)
+	k.DeleteConsumerAcceptsUpgradeNotices(ctx, consumerId)
+
+	k.Logger(ctx).Info("consumer chain hard forked",
+		"consumerId", consumerId,
+		"new chainId", newChainId,
+		"client id", clientID,
+		"previous client id", prevClientId,
+		"initial height", initialHeight,
+	)
+
+	return clientID, nil
+}
+
 // MakeConsumerGenesis returns the created consumer genesis state for consumer chain `consumerId`,
 // as well as the validator hash of the initial validator set of the consumer chain
 func (k Keeper) MakeConsumerGenesis(
@@ -331,6 +672,15 @@ func (k Keeper) MakeConsumerGenesis(
 		return gen, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
 			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
 	}
+	// the initial validator set of a Top N chain is only valid if the minimum power in top N
+	// was computed from a non-empty active validator set (see ComputeConsumerNextValSet)
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err == nil && powerShapingParameters.Top_N > 0 {
+		if _, found := k.GetMinimumPowerInTopN(ctx, consumerId); !found {
+			return gen, errorsmod.Wrapf(types.ErrEmptyActiveValidatorSet,
+				"minimum power in top N not computed, consumerId(%s)", consumerId)
+		}
+	}
 	// note that providerFeePoolAddrStr is sent to the consumer during the IBC Channel handshake;
 	// see HandshakeMetadata in OnChanOpenTry on the provider-side, and OnChanOpenAck on the consumer-side
 	consumerGenesisParams := ccv.NewParams(
@@ -347,7 +697,19 @@ func (k Keeper) MakeConsumerGenesis(
 		[]string{},
 		ccv.DefaultRetryDelayPeriod,
 		consumerId,
+		initializationRecord.ConsumerDenom,
+		initializationRecord.ConsumerDenomMetadata,
+		ccv.DefaultProviderClientExpiryWarningFraction,
+		ccv.DefaultHaltOnProviderClientExpiry,
+		ccv.DefaultProviderClientExpiryHaltDelay,
+		initializationRecord.SignedBlocksWindow,
+		initializationRecord.MinSignedPerWindow,
+		initializationRecord.DenomRedistributionFractions,
+		ccv.DefaultExpectedProviderEpochDuration,
+		ccv.DefaultValsetStalenessWarningEpochs,
 	)
+	// the provider verifies the unbonding period declared by the consumer chain in the handshake metadata
+	consumerGenesisParams.ProviderAcceptsHandshakeMetadata = true
 
 	// create provider client state and consensus state for the consumer to be able
 	// to create a provider client
@@ -356,13 +718,18 @@ func (k Keeper) MakeConsumerGenesis(
 	if err != nil {
 		return gen, errorsmod.Wrapf(types.ErrNoUnbondingTime, "unbonding time not found: %s", err)
 	}
-	height := clienttypes.GetSelfHeight(ctx)
+	// the self consensus state might not be available at the current height,
+	// in which case the consensus state at a previous height is used
+	consState, height, err := k.GetSelfConsensusStateWithFallback(ctx, clienttypes.GetSelfHeight(ctx))
+	if err != nil {
+		return gen, err
+	}
 
 	clientState := k.GetTemplateClient(ctx)
 	// this is the counter party chain ID for the consumer
 	clientState.ChainId = ctx.ChainID()
 	// this is the latest height the client was updated at, i.e.,
-	// the height of the latest consensus state (see below)
+	// the height of the latest consensus state (see above)
 	clientState.LatestHeight = height
 	trustPeriod, err := ccv.CalculateTrustPeriod(providerUnbondingPeriod, k.GetTrustingPeriodFraction(ctx))
 	if err != nil {
@@ -371,33 +738,213 @@ func (k Keeper) MakeConsumerGenesis(
 	clientState.TrustingPeriod = trustPeriod
 	clientState.UnbondingPeriod = providerUnbondingPeriod
 
-	consState, err := k.clientKeeper.GetSelfConsensusState(ctx, height)
+	// the initial validator set of the consumer chain cannot contain duplicate public keys
+	initialValidatorUpdates = DeduplicateValidatorUpdates(initialValidatorUpdates)
+
+	assignedProviderKeys, err := k.GetAssignedProviderKeys(ctx, consumerId, initialValidatorUpdates)
 	if err != nil {
-		return gen, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "error %s getting self consensus state for: %s", err, height)
+		return gen, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting provider keys of validators with assigned keys, consumerId(%s): %s", consumerId, err.Error())
 	}
 
 	gen = *ccv.NewInitialConsumerGenesisState(
 		clientState,
-		consState.(*ibctmtypes.ConsensusState),
+		consState,
 		initialValidatorUpdates,
 		consumerGenesisParams,
 	)
+	gen.Provider.AssignedProviderKeys = assignedProviderKeys
 	return gen, nil
 }
 
+// checkActiveValidatorsForTopN returns an ErrEmptyActiveValidatorSet error if the consumer chain with `consumerId`
+// is a Top N chain and `numActiveValidators` is zero, as no validator could be automatically opted in.
+// The error is retryable, i.e., the launch of the consumer chain is postponed to the next block.
+func (k Keeper) checkActiveValidatorsForTopN(ctx sdk.Context, consumerId string, numActiveValidators int) error {
+	if numActiveValidators > 0 {
+		return nil
+	}
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting power shaping parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+	if powerShapingParameters.Top_N > 0 {
+		return errorsmod.Wrapf(types.ErrEmptyActiveValidatorSet,
+			"cannot opt in the top %d%% validators, consumerId(%s)", powerShapingParameters.Top_N, consumerId)
+	}
+	return nil
+}
+
+// ValidateDistributionTransmissionChannel validates that `channelId` can be the distribution transmission channel
+// of the consumer chain with `chainId`. Note that the distribution transmission channel is a transfer channel of the
+// consumer chain, i.e., the consumer chain sends the rewards through it. Thus, the channel is valid if the provider
+// has an OPEN channel on the transfer port with `channelId` (on the transfer port) as counterparty and, when it can
+// be determined from the client state of the channel's connection, the counterparty chain id is `chainId`.
+func (k Keeper) ValidateDistributionTransmissionChannel(ctx sdk.Context, chainId, channelId string) error {
+	var candidates []channeltypes.IdentifiedChannel
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		if channel.PortId == transfertypes.PortID && channel.Counterparty.ChannelId == channelId {
+			candidates = append(candidates, channel)
+		}
+	}
+	if len(candidates) == 0 {
+		return errorsmod.Wrapf(types.ErrInvalidDistributionTransmissionChannel,
+			"no channel on port %s has channel %s of chain %s as counterparty", transfertypes.PortID, channelId, chainId)
+	}
+
+	var err error
+	for _, channel := range candidates {
+		if err = k.validateDistributionTransmissionChannelCandidate(ctx, chainId, channel); err == nil {
+			return nil
+		}
+	}
+	// return the error of the last candidate
+	return err
+}
+
+// validateDistributionTransmissionChannelCandidate validates that the provider-side `channel` is the counterparty
+// of the distribution transmission channel of the consumer chain with `chainId`
+func (k Keeper) validateDistributionTransmissionChannelCandidate(
+	ctx sdk.Context,
+	chainId string,
+	channel channeltypes.IdentifiedChannel,
+) error {
+	if channel.Counterparty.PortId != transfertypes.PortID {
+		return errorsmod.Wrapf(types.ErrInvalidDistributionTransmissionChannel,
+			"channel %s belongs to port %s instead of %s", channel.Counterparty.ChannelId, channel.Counterparty.PortId, transfertypes.PortID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidDistributionTransmissionChannel,
+			"channel %s is in state %s instead of %s", channel.Counterparty.ChannelId, channel.State, channeltypes.OPEN)
+	}
+
+	// the counterparty chain id is determinable only for Tendermint clients
+	_, connection, err := k.channelKeeper.GetChannelConnection(ctx, channel.PortId, channel.ChannelId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidDistributionTransmissionChannel,
+			"cannot get the connection of channel %s: %s", channel.ChannelId, err.Error())
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, connection.GetClientID())
+	if !found {
+		return nil
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil
+	}
+	if tmClientState.ChainId != chainId {
+		return errorsmod.Wrapf(types.ErrInvalidDistributionTransmissionChannel,
+			"channel %s belongs to chain %s instead of %s", channel.Counterparty.ChannelId, tmClientState.ChainId, chainId)
+	}
+	return nil
+}
+
+// GetSelfConsensusStateWithFallback returns the self consensus state at the given height together with the height
+// of the returned consensus state. If the self consensus state is unavailable at the given height (e.g., due to the header
+// timing at the block the consumer chain launches), it falls back to the most recent of the previous
+// MaxSelfConsensusStateFallbackHeights heights with an available self consensus state.
+//
+// Note that the self consensus states are derived from the store (i.e., the historical info) and hence
+// all the nodes fall back to the same height.
+func (k Keeper) GetSelfConsensusStateWithFallback(ctx sdk.Context, height clienttypes.Height) (*ibctmtypes.ConsensusState, clienttypes.Height, error) {
+	var lastErr error
+	for i := uint64(0); i <= types.MaxSelfConsensusStateFallbackHeights; i++ {
+		if i > 0 && i >= height.RevisionHeight {
+			// there are no previous heights in the current revision
+			break
+		}
+		fallbackHeight := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight-i)
+		consState, err := k.clientKeeper.GetSelfConsensusState(ctx, fallbackHeight)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		tmConsState, ok := consState.(*ibctmtypes.ConsensusState)
+		if !ok {
+			return nil, height, errorsmod.Wrapf(clienttypes.ErrInvalidConsensus,
+				"unexpected self consensus state type %T at height %s", consState, fallbackHeight)
+		}
+		if i > 0 {
+			k.Logger(ctx).Info("self consensus state unavailable, using the consensus state at a previous height",
+				"height", height,
+				"fallback height", fallbackHeight,
+			)
+		}
+		return tmConsState, fallbackHeight, nil
+	}
+	return nil, height, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound,
+		"error %s getting self consensus state for: %s", lastErr, height)
+}
+
+// GetAssignedProviderKeys returns the provider consensus public keys of the validators in
+// `validatorUpdates` that assigned a consumer key different from their provider key.
+// The consumer uses these keys to detect nodes that sign with the provider key instead
+// of the assigned consumer key.
+func (k Keeper) GetAssignedProviderKeys(
+	ctx sdk.Context,
+	consumerId string,
+	validatorUpdates []abci.ValidatorUpdate,
+) ([]tmprotocrypto.PublicKey, error) {
+	var providerKeys []tmprotocrypto.PublicKey
+	for _, update := range validatorUpdates {
+		consAddr, err := ccv.TMCryptoPublicKeyToConsAddr(update.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		consumerAddr := types.NewConsumerConsAddress(consAddr)
+		providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+		if !found || providerAddr.ToSdkConsAddr().Equals(consumerAddr.ToSdkConsAddr()) {
+			// the validator uses its provider key on the consumer chain
+			continue
+		}
+		validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve validator by provider address (%s): %w", providerAddr.String(), err)
+		}
+		providerKey, err := validator.CmtConsPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve validator's (%s) public key: %w", providerAddr.String(), err)
+		}
+		providerKeys = append(providerKeys, providerKey)
+	}
+	return providerKeys, nil
+}
+
 // StopAndPrepareForConsumerRemoval sets the phase of the chain to stopped and prepares to get the state of the
-// chain removed after unbonding period elapses
-func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId string) error {
+// chain removed after unbonding period elapses. The `reason` why the chain is stopped, together with an optional
+// `note`, is recorded and retained after the state of the chain is removed.
+func (k Keeper) StopAndPrepareForConsumerRemoval(
+	ctx sdk.Context,
+	consumerId string,
+	reason types.ConsumerRemovalReason,
+	note string,
+) error {
+	if reason == types.CONSUMER_REMOVAL_REASON_UNSPECIFIED {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot stop consumer chain %s without a reason", consumerId)
+	}
+
 	// The phase of the chain is immediately set to stopped, albeit its state is removed later (see below).
 	// Setting the phase here helps in not considering this chain when we look at launched chains (e.g., in `QueueVSCPackets)
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
 
+	// a chain that is stopped before its maximum lifetime elapses is no longer to be stopped at its sunset time
+	if lifetime, found := k.GetConsumerLifetime(ctx, consumerId); found {
+		if err := k.RemoveConsumerToBeStopped(ctx, consumerId, lifetime.SunsetTime); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot remove the consumer from being stopped: %s", err.Error())
+		}
+		if err := k.unscheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot remove the lifetime reminder of the consumer: %s", err.Error())
+		}
+		k.DeleteConsumerLifetime(ctx, consumerId)
+	}
+
 	// state of this chain is removed once UnbondingPeriod elapses
 	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
 	if err != nil {
 		return err
 	}
-	removalTime := ctx.BlockTime().Add(unbondingPeriod)
+	removalTime := types.NormalizeTime(ctx.BlockTime().Add(unbondingPeriod))
 
 	if err := k.SetConsumerRemovalTime(ctx, consumerId, removalTime); err != nil {
 		return fmt.Errorf("cannot set removal time (%s): %s", removalTime.String(), err.Error())
@@ -406,19 +953,37 @@ func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId str
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot set consumer to be removed: %s", err.Error())
 	}
 
+	if err := k.SetConsumerRemovalRecord(ctx, consumerId, types.ConsumerRemovalRecord{Reason: reason, Note: note}); err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "cannot set removal record: %s", err.Error())
+	}
+
+	chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+	k.Logger(ctx).Info("consumer chain stopped",
+		"consumerId", consumerId,
+		"chainId", chainId,
+		"reason", reason,
+		"note", note,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStopConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeRemovalReason, reason.String()),
+			sdk.NewAttribute(types.AttributeRemovalReasonValue, strconv.Itoa(int(reason))),
+			sdk.NewAttribute(types.AttributeRemovalNote, note),
+		),
+	)
+
 	return nil
 }
 
-// BeginBlockRemoveConsumers removes stopped consumer chain for which the removal time has passed
+// BeginBlockRemoveConsumers removes stopped consumer chain for which the removal time has passed,
+// i.e., is not after the block time (see TimeQueue.ConsumeUpTo)
 func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
-	consumerIds, err := k.ConsumeIdsFromTimeQueue(
-		ctx,
-		types.RemovalTimeToConsumerIdsKeyPrefix(),
-		k.GetConsumersToBeRemoved,
-		k.DeleteAllConsumersToBeRemoved,
-		k.AppendConsumerToBeRemoved,
-		200,
-	)
+	consumerIds, err := k.RemovalTimeQueue().ConsumeUpTo(ctx, ctx.BlockTime(), 200)
 	if err != nil {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to stop: %s", err.Error())
 	}
@@ -438,6 +1003,37 @@ func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
 	return nil
 }
 
+// CancelConsumerLaunch removes the consumer chain with `consumerId` that is in its registered or initialized phase,
+// i.e., that was not launched yet. As neither a client nor a channel to the consumer chain was ever created,
+// the consumer chain is deleted immediately instead of waiting for the unbonding period to elapse
+// (see StopAndPrepareForConsumerRemoval). Specifically, the consumer chain is removed from the chains to be launched
+// and its initialization parameters are deleted. As for every deleted consumer chain, its per-validator state
+// (e.g., the opted-in validators) is removed incrementally in EndBlock.
+func (k Keeper) CancelConsumerLaunch(ctx sdk.Context, consumerId string) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != types.CONSUMER_PHASE_REGISTERED && phase != types.CONSUMER_PHASE_INITIALIZED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot cancel the launch of chain with consumer id %s in phase %s", consumerId, phase)
+	}
+
+	if phase == types.CONSUMER_PHASE_INITIALIZED {
+		initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+		if err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+		}
+		if err := k.RemoveConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"cannot remove the consumer from being launched: %s", err.Error())
+		}
+	}
+	k.DeleteConsumerInitializationParameters(ctx, consumerId)
+
+	// the chain skips the stopped phase, i.e., it is deleted in the same block
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
+	return k.DeleteConsumerChain(ctx, consumerId)
+}
+
 // DeleteConsumerChain cleans up the state of the given consumer chain
 func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err error) {
 	phase := k.GetConsumerPhase(ctx, consumerId)
@@ -448,10 +1044,10 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	// clean up states
 	k.DeleteConsumerClientId(ctx, consumerId)
 	k.DeleteConsumerGenesis(ctx, consumerId)
-	// Note: this call panics if the key assignment state is invalid
-	k.DeleteKeyAssignments(ctx, consumerId)
+	k.DeleteConsumerGenesisHash(ctx, consumerId)
 	k.DeleteMinimumPowerInTopN(ctx, consumerId)
 	k.DeleteEquivocationEvidenceMinHeight(ctx, consumerId)
+	k.DeleteEquivocationEvidenceMinChainId(ctx, consumerId)
 
 	// close channel and delete the mappings between chain ID and channel ID
 	if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
@@ -470,29 +1066,44 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 		}
 		k.DeleteConsumerIdToChannelId(ctx, consumerId)
 		k.DeleteChannelIdToConsumerId(ctx, channelID)
-	}
-
-	// delete consumer commission rate
-	provAddrs := k.GetAllCommissionRateValidators(ctx, consumerId)
-	for _, addr := range provAddrs {
-		k.DeleteConsumerCommissionRate(ctx, consumerId, addr)
+		// packets may still be in flight on the channel; keep track of the deleted mapping
+		// so that their acknowledgements and timeouts do not fail
+		k.SetDeletedChannelIdToConsumerId(ctx, channelID, consumerId)
 	}
 
 	k.DeleteInitChainHeight(ctx, consumerId)
 	k.DeleteSlashAcks(ctx, consumerId)
 	k.DeletePendingVSCPackets(ctx, consumerId)
-
-	k.DeleteAllowlist(ctx, consumerId)
-	k.DeleteDenylist(ctx, consumerId)
-	k.DeleteAllOptedIn(ctx, consumerId)
-	k.DeleteConsumerValSet(ctx, consumerId)
+	k.DeleteAllVscIdToHeights(ctx, consumerId)
+	k.DeleteAllPendingValidatorRemovals(ctx, consumerId)
+	k.DeleteConsumerRewardChannel(ctx, consumerId)
+	k.DeleteConsumerEndpointInfo(ctx, consumerId)
+	k.DeleteConsumerTimeoutPeriods(ctx, consumerId)
+	k.DeleteLastPacketReceivedTime(ctx, consumerId)
+	k.DeleteConsumerDormant(ctx, consumerId)
+	k.DeleteConsumerProviderFeePoolAddr(ctx, consumerId)
+	k.DeleteConsumerProviderUpgradeNotice(ctx, consumerId)
+	k.DeleteConsumerAcceptsUpgradeNotices(ctx, consumerId)
+	k.DeleteConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	k.DeleteConsumerLaunchConflictRetries(ctx, consumerId)
 
 	k.DeleteConsumerRemovalTime(ctx, consumerId)
+	k.DeleteConsumerUpgradeNotices(ctx, consumerId)
+	// release the held back rewards so that they are handled as the rest of the undistributed rewards
+	if err := k.UnpauseConsumerRewards(ctx, consumerId); err != nil {
+		return err
+	}
+
+	// The per-validator state (i.e., commission rates, allowlist, denylist, opted-in validators,
+	// acknowledged terms, the consumer validator set and the key assignments) can be arbitrarily large. It is removed incrementally
+	// in EndBlock to bound the number of store deletions per block.
+	k.SetConsumerToBeCleanedUp(ctx, consumerId)
 
 	// TODO (PERMISSIONLESS) add newly-added state to be deleted
 
-	// Note that we do not delete ConsumerIdToChainIdKey and ConsumerIdToPhase, as well
-	// as consumer metadata, initialization and power-shaping parameters.
+	// Note that we do not delete ConsumerIdToChainIdKey, ConsumerIdToPhase, ConsumerIdToRemovalInitiator,
+	// ConsumerIdToCreationRecord and ConsumerIdToRemovalRecord, as well as consumer metadata, initialization
+	// and power-shaping parameters.
 	// This is to enable block explorers and front ends to show information of
 	// consumer chains that were removed without needing an archive node.
 
@@ -502,6 +1113,80 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	return nil
 }
 
+// EndBlockCleanupDeletedConsumers removes the remaining per-validator state of deleted consumer chains.
+// At most `MaxConsumerCleanupDeletionsPerBlock` store entries are removed in a block. A consumer chain
+// is dropped from the cleanup queue once all its per-validator state is removed.
+func (k Keeper) EndBlockCleanupDeletedConsumers(ctx sdk.Context) {
+	budget := k.GetMaxConsumerCleanupDeletionsPerBlock(ctx)
+	for _, consumerId := range k.GetConsumersToBeCleanedUp(ctx) {
+		if budget <= 0 {
+			break
+		}
+		deleted, done, err := k.cleanUpConsumerState(ctx, consumerId, budget)
+		budget -= deleted
+		if err != nil {
+			k.Logger(ctx).Error("invalid state of deleted consumer chain",
+				"consumerId", consumerId,
+				"error", err.Error())
+		}
+		if done {
+			k.DeleteConsumerToBeCleanedUp(ctx, consumerId)
+			k.Logger(ctx).Info("consumer chain state cleaned up", "consumerId", consumerId)
+		}
+	}
+}
+
+// cleanUpConsumerState removes at most `limit` store entries of the per-validator state (and of the
+// validator set snapshots, economic security records, and record logs) of the consumer chain with `consumerId`. It returns the number of
+// removed entries and whether all the per-validator state of the consumer chain is removed. Corrupt key-assignment
+// entries are removed as well, in which case an error is returned.
+func (k Keeper) cleanUpConsumerState(ctx sdk.Context, consumerId string, limit int64) (deleted int64, done bool, err error) {
+	store := ctx.KVStore(k.storeKey)
+	prefixes := [][]byte{
+		types.StringIdWithLenKey(types.ConsumerCommissionRateKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.AllowlistKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.DenylistKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.OptedInKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.AcknowledgedConsumerTermsKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.ForcedOptInTimeKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.UnavailableValidatorKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.OptInTimeKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.OptInHeightKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.EmergencyOptOutCooldownKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.ReOptInCooldownKeyPrefix(), consumerId),
+		k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId),
+		types.StringIdWithLenKey(types.ConsumerValSetSnapshotKeyPrefix(), consumerId),
+		types.StringIdWithLenKey(types.ConsumerEconomicSecurityKeyPrefix(), consumerId),
+	}
+	prefixes = append(prefixes, k.RewardAttributionLog().KeyPrefixes(consumerId)...)
+	prefixes = append(prefixes, k.SkippedDowntimeSlashLog().KeyPrefixes(consumerId)...)
+	prefixes = append(prefixes, k.UnroutableSlashPacketLog().KeyPrefixes(consumerId)...)
+	prefixes = append(prefixes, k.PreOptInInfractionLog().KeyPrefixes(consumerId)...)
+
+	for _, prefix := range prefixes {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		var keysToDel [][]byte
+		for ; iterator.Valid() && deleted+int64(len(keysToDel)) < limit; iterator.Next() {
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		remaining := iterator.Valid()
+		iterator.Close()
+
+		for _, key := range keysToDel {
+			store.Delete(key)
+		}
+		deleted += int64(len(keysToDel))
+
+		if remaining {
+			return deleted, false, nil
+		}
+	}
+
+	// delete the key assignments with the remaining budget
+	keyAssignmentsDeleted, done, err := k.DeleteKeyAssignments(ctx, consumerId, limit-deleted)
+	return deleted + keyAssignmentsDeleted, done, err
+}
+
 //
 // Setters and Getters
 //
@@ -520,10 +1205,12 @@ func (k Keeper) GetConsumerRemovalTime(ctx sdk.Context, consumerId string) (time
 	return time, nil
 }
 
-// SetConsumerRemovalTime sets the removal time associated with this consumer id
+// SetConsumerRemovalTime sets the removal time associated with this consumer id. The removal time is normalized
+// (see types.NormalizeTime) so that it is read back as the time under which the consumer id is stored in the removal
+// time queue (see RemovalTimeToConsumerIdsKey).
 func (k Keeper) SetConsumerRemovalTime(ctx sdk.Context, consumerId string, removalTime time.Time) error {
 	store := ctx.KVStore(k.storeKey)
-	buf, err := removalTime.MarshalBinary()
+	buf, err := types.NormalizeTime(removalTime).MarshalBinary()
 	if err != nil {
 		return fmt.Errorf("failed to marshal removal time (%+v) for consumer id (%s): %w", removalTime, consumerId, err)
 	}
@@ -537,126 +1224,124 @@ func (k Keeper) DeleteConsumerRemovalTime(ctx sdk.Context, consumerId string) {
 	store.Delete(types.ConsumerIdToRemovalTimeKey(consumerId))
 }
 
-// getConsumerIdsBasedOnTime returns all the consumer ids stored under this specific `key(time)`
-func (k Keeper) getConsumerIdsBasedOnTime(ctx sdk.Context, key func(time.Time) []byte, time time.Time) (types.ConsumerIds, error) {
+// GetConsumerLaunchConflictRetries returns the number of times the launch of the consumer chain with `consumerId`
+// was retried since another consumer chain with the same chain id is launched
+func (k Keeper) GetConsumerLaunchConflictRetries(ctx sdk.Context, consumerId string) uint64 {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(key(time))
+	bz := store.Get(types.ConsumerIdToLaunchConflictRetriesKey(consumerId))
 	if bz == nil {
-		return types.ConsumerIds{}, nil
-	}
-
-	var consumerIds types.ConsumerIds
-
-	if err := consumerIds.Unmarshal(bz); err != nil {
-		return types.ConsumerIds{}, fmt.Errorf("failed to unmarshal consumer ids: %w", err)
+		return 0
 	}
-	return consumerIds, nil
+	return sdk.BigEndianToUint64(bz)
 }
 
-// appendConsumerIdOnTime appends the consumer id on all the other consumer ids under `key(time)`
-func (k Keeper) appendConsumerIdOnTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+// SetConsumerLaunchConflictRetries sets the number of times the launch of the consumer chain with `consumerId`
+// was retried since another consumer chain with the same chain id is launched
+func (k Keeper) SetConsumerLaunchConflictRetries(ctx sdk.Context, consumerId string, retries uint64) {
 	store := ctx.KVStore(k.storeKey)
-
-	consumers, err := k.getConsumerIdsBasedOnTime(ctx, key, time)
-	if err != nil {
-		return err
-	}
-
-	consumersWithAppend := types.ConsumerIds{
-		Ids: append(consumers.Ids, consumerId),
-	}
-
-	bz, err := consumersWithAppend.Marshal()
-	if err != nil {
-		return err
-	}
-
-	store.Set(key(time), bz)
-	return nil
+	store.Set(types.ConsumerIdToLaunchConflictRetriesKey(consumerId), sdk.Uint64ToBigEndian(retries))
 }
 
-// removeConsumerIdFromTime removes consumer id stored under `key(time)`
-func (k Keeper) removeConsumerIdFromTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+// DeleteConsumerLaunchConflictRetries deletes the number of launch retries of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerLaunchConflictRetries(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
-
-	consumers, err := k.getConsumerIdsBasedOnTime(ctx, key, time)
-	if err != nil {
-		return err
-	}
-
-	if len(consumers.Ids) == 0 {
-		return fmt.Errorf("no consumer ids found for this time: %s", time.String())
-	}
-
-	// find the index of the consumer we want to remove
-	index := -1
-	for i := 0; i < len(consumers.Ids); i++ {
-		if consumers.Ids[i] == consumerId {
-			index = i
-			break
-		}
-	}
-
-	if index == -1 {
-		return fmt.Errorf("failed to find consumer id (%s)", consumerId)
-	}
-
-	if len(consumers.Ids) == 1 {
-		store.Delete(key(time))
-		return nil
-	}
-
-	consumersWithRemoval := types.ConsumerIds{
-		Ids: append(consumers.Ids[:index], consumers.Ids[index+1:]...),
-	}
-
-	bz, err := consumersWithRemoval.Marshal()
-	if err != nil {
-		return err
-	}
-
-	store.Set(key(time), bz)
-	return nil
+	store.Delete(types.ConsumerIdToLaunchConflictRetriesKey(consumerId))
 }
 
 // GetConsumersToBeLaunched returns all the consumer ids of chains stored under this spawn time
 func (k Keeper) GetConsumersToBeLaunched(ctx sdk.Context, spawnTime time.Time) (types.ConsumerIds, error) {
-	return k.getConsumerIdsBasedOnTime(ctx, types.SpawnTimeToConsumerIdsKey, spawnTime)
+	return k.SpawnTimeQueue().Get(ctx, spawnTime)
 }
 
 // AppendConsumerToBeLaunched appends the provider consumer id for the given spawn time
 func (k Keeper) AppendConsumerToBeLaunched(ctx sdk.Context, consumerId string, spawnTime time.Time) error {
-	return k.appendConsumerIdOnTime(ctx, consumerId, types.SpawnTimeToConsumerIdsKey, spawnTime)
+	return k.SpawnTimeQueue().Append(ctx, consumerId, spawnTime)
 }
 
 // RemoveConsumerToBeLaunched removes consumer id from if stored for this specific spawn time
 func (k Keeper) RemoveConsumerToBeLaunched(ctx sdk.Context, consumerId string, spawnTime time.Time) error {
-	return k.removeConsumerIdFromTime(ctx, consumerId, types.SpawnTimeToConsumerIdsKey, spawnTime)
+	return k.SpawnTimeQueue().Remove(ctx, consumerId, spawnTime)
 }
 
 // DeleteAllConsumersToBeLaunched deletes all consumer to be launched at this specific spawn time
 func (k Keeper) DeleteAllConsumersToBeLaunched(ctx sdk.Context, spawnTime time.Time) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.SpawnTimeToConsumerIdsKey(spawnTime))
+	k.SpawnTimeQueue().DeleteAll(ctx, spawnTime)
 }
 
 // GetConsumersToBeRemoved returns all the consumer ids of chains stored under this removal time
 func (k Keeper) GetConsumersToBeRemoved(ctx sdk.Context, removalTime time.Time) (types.ConsumerIds, error) {
-	return k.getConsumerIdsBasedOnTime(ctx, types.RemovalTimeToConsumerIdsKey, removalTime)
+	return k.RemovalTimeQueue().Get(ctx, removalTime)
 }
 
 // AppendConsumerToBeRemoved appends the provider consumer id for the given removal time
 func (k Keeper) AppendConsumerToBeRemoved(ctx sdk.Context, consumerId string, removalTime time.Time) error {
-	return k.appendConsumerIdOnTime(ctx, consumerId, types.RemovalTimeToConsumerIdsKey, removalTime)
+	return k.RemovalTimeQueue().Append(ctx, consumerId, removalTime)
 }
 
 // RemoveConsumerToBeRemoved removes consumer id from the given removal time
 func (k Keeper) RemoveConsumerToBeRemoved(ctx sdk.Context, consumerId string, removalTime time.Time) error {
-	return k.removeConsumerIdFromTime(ctx, consumerId, types.RemovalTimeToConsumerIdsKey, removalTime)
+	return k.RemovalTimeQueue().Remove(ctx, consumerId, removalTime)
 }
 
 // DeleteAllConsumersToBeRemoved deletes all consumer to be removed at this specific removal time
 func (k Keeper) DeleteAllConsumersToBeRemoved(ctx sdk.Context, removalTime time.Time) {
+	k.RemovalTimeQueue().DeleteAll(ctx, removalTime)
+}
+
+// GetConsumersToBeStopped returns all the consumer ids of chains stored under this stop time
+func (k Keeper) GetConsumersToBeStopped(ctx sdk.Context, stopTime time.Time) (types.ConsumerIds, error) {
+	return k.StopTimeQueue().Get(ctx, stopTime)
+}
+
+// AppendConsumerToBeStopped appends the provider consumer id for the given stop time
+func (k Keeper) AppendConsumerToBeStopped(ctx sdk.Context, consumerId string, stopTime time.Time) error {
+	return k.StopTimeQueue().Append(ctx, consumerId, stopTime)
+}
+
+// RemoveConsumerToBeStopped removes consumer id from the given stop time
+func (k Keeper) RemoveConsumerToBeStopped(ctx sdk.Context, consumerId string, stopTime time.Time) error {
+	return k.StopTimeQueue().Remove(ctx, consumerId, stopTime)
+}
+
+// DeleteAllConsumersToBeStopped deletes all consumer to be stopped at this specific stop time
+func (k Keeper) DeleteAllConsumersToBeStopped(ctx sdk.Context, stopTime time.Time) {
+	k.StopTimeQueue().DeleteAll(ctx, stopTime)
+}
+
+// SetConsumerToBeCleanedUp marks the deleted consumer chain with `consumerId` as having state to be cleaned up
+func (k Keeper) SetConsumerToBeCleanedUp(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumersToBeCleanedUpKey(consumerId), []byte{})
+}
+
+// DeleteConsumerToBeCleanedUp removes the consumer chain with `consumerId` from the consumer chains to be cleaned up
+func (k Keeper) DeleteConsumerToBeCleanedUp(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.RemovalTimeToConsumerIdsKey(removalTime))
+	store.Delete(types.ConsumersToBeCleanedUpKey(consumerId))
+}
+
+// IsConsumerToBeCleanedUp returns whether the deleted consumer chain with `consumerId` has state to be cleaned up
+func (k Keeper) IsConsumerToBeCleanedUp(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumersToBeCleanedUpKey(consumerId))
+}
+
+// GetConsumersToBeCleanedUp returns the ids of all the deleted consumer chains that have state to be cleaned up
+func (k Keeper) GetConsumersToBeCleanedUp(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumersToBeCleanedUpKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized.
+			panic(fmt.Errorf("failed to parse consumer id: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
 }
@@ -1,12 +1,17 @@
 package keeper_test
 
 import (
+	"context"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	_go "github.com/cosmos/ics23/go"
@@ -17,6 +22,8 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -24,6 +31,7 @@ import (
 
 	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v6/x/ccv/consumer/types"
 	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
@@ -139,6 +147,131 @@ func TestInitializeConsumer(t *testing.T) {
 	}
 }
 
+// TestGetConsumerLaunchChecklist tests that the launch checklist of a consumer chain reports every failing condition
+func TestGetConsumerLaunchChecklist(t *testing.T) {
+	consumerId := CONSUMER_ID
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+
+	testCases := []struct {
+		name           string
+		setup          func(*providerkeeper.Keeper, sdk.Context)
+		expErr         bool
+		expFailedCheck string
+	}{
+		{
+			name:  "ready to launch",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {},
+		},
+		{
+			name: "launched consumer chain",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				pk.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+			},
+			expErr: true,
+		},
+		{
+			name: "spawn time not set",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				initializationParameters := testkeeper.GetTestInitializationParameters()
+				initializationParameters.SpawnTime = time.Time{}
+				require.NoError(t, pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+			},
+			expFailedCheck: providertypes.LaunchCheckSpawnTime,
+		},
+		{
+			name: "spawn time in the past",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				initializationParameters := testkeeper.GetTestInitializationParameters()
+				initializationParameters.SpawnTime = ctx.BlockTime().Add(-time.Hour)
+				require.NoError(t, pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+			},
+			expFailedCheck: providertypes.LaunchCheckSpawnTime,
+		},
+		{
+			name: "invalid initialization parameters",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				initializationParameters := testkeeper.GetTestInitializationParameters()
+				initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Hour)
+				initializationParameters.InitialHeight = clienttypes.Height{}
+				require.NoError(t, pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+			},
+			expFailedCheck: providertypes.LaunchCheckInitializationParameters,
+		},
+		{
+			name: "no validator opted in",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				pk.DeleteOptedIn(ctx, consumerId, providerAddr)
+			},
+			expFailedCheck: providertypes.LaunchCheckOptedInValidators,
+		},
+		{
+			name: "validator both allowlisted and denylisted",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				pk.SetAllowlist(ctx, consumerId, providerAddr)
+				pk.SetDenylist(ctx, consumerId, providerAddr)
+			},
+			expFailedCheck: providertypes.LaunchCheckPowerShapingParameters,
+		},
+		{
+			name: "no reward denoms",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				require.NoError(t, pk.SetAllowlistedRewardDenoms(ctx, consumerId, []string{}))
+			},
+			expFailedCheck: providertypes.LaunchCheckRewardDenoms,
+		},
+		{
+			name: "Top N chain not owned by governance",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+				powerShapingParameters.Top_N = 50
+				require.NoError(t, pk.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters))
+			},
+			expFailedCheck: providertypes.LaunchCheckGovernanceApproval,
+		},
+		{
+			name: "Top N chain owned by governance",
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context) {
+				powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+				powerShapingParameters.Top_N = 50
+				require.NoError(t, pk.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters))
+				pk.SetConsumerOwnerAddress(ctx, consumerId, pk.GetAuthority())
+				// validators in the top N are opted in at launch
+				pk.DeleteOptedIn(ctx, consumerId, providerAddr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer ctrl.Finish()
+
+		// set up a consumer chain that is ready to launch
+		ctx = ctx.WithBlockTime(time.Now().UTC())
+		pk.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+		pk.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+		pk.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+		initializationParameters := testkeeper.GetTestInitializationParameters()
+		initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Hour)
+		require.NoError(t, pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+		require.NoError(t, pk.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters()))
+		require.NoError(t, pk.SetAllowlistedRewardDenoms(ctx, consumerId, []string{"uatom"}))
+		pk.SetOptedIn(ctx, consumerId, providerAddr)
+
+		tc.setup(&pk, ctx)
+
+		checks, err := pk.GetConsumerLaunchChecklist(ctx, consumerId)
+		if tc.expErr {
+			require.ErrorIs(t, err, providertypes.ErrInvalidPhase, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Len(t, checks, 6, tc.name)
+		for _, check := range checks {
+			require.Equal(t, check.Name != tc.expFailedCheck, check.Passed, "%s: %s", tc.name, check.Details)
+		}
+	}
+}
+
 // TestBeginBlockInit directly tests BeginBlockLaunchConsumers against the spec using helpers defined above.
 func TestBeginBlockLaunchConsumers(t *testing.T) {
 	now := time.Now().UTC()
@@ -301,9 +434,24 @@ func TestBeginBlockLaunchConsumers(t *testing.T) {
 	// first chain was successfully launched
 	phase := providerKeeper.GetConsumerPhase(ctx, "0")
 	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, phase)
-	_, found := providerKeeper.GetConsumerGenesis(ctx, "0")
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, "0")
 	require.True(t, found)
 
+	// the hash of the genesis created at launch is stored and emitted in the consumer_client_created event
+	genesisHash, found := providerKeeper.GetConsumerGenesisHash(ctx, "0")
+	require.True(t, found)
+	require.NoError(t, ccvtypes.VerifyConsumerGenesisHash(gen, genesisHash))
+	var clientCreatedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerClientCreated {
+			clientCreatedEvents = append(clientCreatedEvents, event)
+		}
+	}
+	require.Len(t, clientCreatedEvents, 3)
+	attr, found := clientCreatedEvents[0].GetAttribute(providertypes.AttributeGenesisHash)
+	require.True(t, found)
+	require.Equal(t, hex.EncodeToString(genesisHash), attr.Value)
+
 	// second chain was successfully launched
 	phase = providerKeeper.GetConsumerPhase(ctx, "1")
 	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, phase)
@@ -315,6 +463,8 @@ func TestBeginBlockLaunchConsumers(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, phase)
 	_, found = providerKeeper.GetConsumerGenesis(ctx, "2")
 	require.False(t, found)
+	_, found = providerKeeper.GetConsumerGenesisHash(ctx, "2")
+	require.False(t, found)
 
 	// fourth chain corresponds to an Opt-In chain with one opted-in validator and hence the chain gets
 	// successfully executed
@@ -329,144 +479,434 @@ func TestBeginBlockLaunchConsumers(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
 	_, found = providerKeeper.GetConsumerGenesis(ctx, "4")
 	require.False(t, found)
+
+	// the reason why the launch failed is recorded and emitted
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, "4")
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_LAUNCH_FAILED, removalRecord.Reason)
+	require.NotEmpty(t, removalRecord.Note)
+	var launchFailedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerLaunchFailed {
+			launchFailedEvents = append(launchFailedEvents, event)
+		}
+	}
+	require.Len(t, launchFailedEvents, 1)
+	attr, found = launchFailedEvents[0].GetAttribute(providertypes.AttributeRemovalNote)
+	require.True(t, found)
+	require.Equal(t, removalRecord.Note, attr.Value)
+	// the launched chains have no removal record
+	_, found = providerKeeper.GetConsumerRemovalRecord(ctx, "0")
+	require.False(t, found)
 }
 
-func TestConsumeIdsFromTimeQueue(t *testing.T) {
-	expectedConsumerIds := []string{"1", "2", "3", "4"}
-	timestamps := []time.Time{time.Unix(10, 0), time.Unix(20, 0), time.Unix(30, 0)}
+// TestBeginBlockLaunchConsumersWithFewBondedValidators tests that Top N and Opt In consumer chains
+// do not launch without bonded validators (and are retried in the next block), but launch with one bonded validator
+func TestBeginBlockLaunchConsumersWithFewBondedValidators(t *testing.T) {
+	now := time.Now().UTC()
+	spawnTime := now.Add(-time.Hour)
 
 	testCases := []struct {
-		name       string
-		ts         time.Time
-		limit      int
-		expOutcome func(sdk.Context, []string, func(sdk.Context, time.Time) (providertypes.ConsumerIds, error))
+		name          string
+		topN          uint32
+		numValidators int
+		expLaunched   bool
 	}{
-		{
-			name:  "timestamp too early",
-			ts:    time.Unix(9, 999999999),
-			limit: 3,
-			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
-				require.Empty(t, ids)
-			},
-		},
-		{
-			name:  "first timestamp",
-			ts:    timestamps[0],
-			limit: 2,
-			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
-				require.Equal(t, expectedConsumerIds[0:2], ids)
-
-				// check that all consumers where removed
-				consumerIds, err := getIds(ctx, timestamps[0])
-				require.NoError(t, err)
-				require.Empty(t, consumerIds)
-			},
-		},
-		{
-			name:  "first timestamp, with limit",
-			ts:    timestamps[0],
-			limit: 1,
-			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
-				require.Equal(t, expectedConsumerIds[0:1], ids)
-
-				// second consumer remained
-				ret, err := getIds(ctx, timestamps[0])
-				require.NoError(t, err)
-				require.Equal(t, providertypes.ConsumerIds{
-					Ids: []string{expectedConsumerIds[1]},
-				}, ret)
-			},
-		},
-		{
-			name:  "second timestamp",
-			ts:    timestamps[1],
-			limit: 3,
-			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
-				require.Equal(t, expectedConsumerIds[0:3], ids)
-
-				// check that all consumers where removed
-				ret, err := getIds(ctx, timestamps[0])
-				require.NoError(t, err)
-				require.Empty(t, ret)
-				ret, err = getIds(ctx, timestamps[1])
-				require.NoError(t, err)
-				require.Empty(t, ret)
-			},
-		},
-		{
-			name:  "third timestamp, with limit",
-			ts:    timestamps[1],
-			limit: 3,
-			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
-				require.Equal(t, expectedConsumerIds[0:3], ids)
-
-				// 4th consumer remained
-				ret, err := getIds(ctx, timestamps[0])
-				require.NoError(t, err)
-				require.Empty(t, ret)
-				ret, err = getIds(ctx, timestamps[1])
-				require.NoError(t, err)
-				require.Empty(t, ret)
-				ret, err = getIds(ctx, timestamps[2])
-				require.NoError(t, err)
-				require.Equal(t, providertypes.ConsumerIds{
-					Ids: []string{expectedConsumerIds[3]},
-				}, ret)
-			},
-		},
+		{name: "Top N chain without bonded validators", topN: 100, numValidators: 0, expLaunched: false},
+		{name: "Opt In chain without bonded validators", topN: 0, numValidators: 0, expLaunched: false},
+		{name: "Top N chain with one bonded validator", topN: 100, numValidators: 1, expLaunched: true},
+		{name: "Opt In chain with one bonded validator", topN: 0, numValidators: 1, expLaunched: true},
 	}
 
-	// test for consumers to be launched
 	for _, tc := range testCases {
-		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
-		defer ctrl.Finish()
-
-		callCases := []struct {
-			timeQueueKeyPrefix byte
-			getIds             func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)
-			deleteAllIds       func(sdk.Context, time.Time)
-			appendId           func(sdk.Context, string, time.Time) error
-		}{
-			{
-				timeQueueKeyPrefix: providertypes.SpawnTimeToConsumerIdsKeyPrefix(),
-				getIds:             providerKeeper.GetConsumersToBeLaunched,
-				deleteAllIds:       providerKeeper.DeleteAllConsumersToBeLaunched,
-				appendId:           providerKeeper.AppendConsumerToBeLaunched,
-			},
-			{
-				timeQueueKeyPrefix: providertypes.RemovalTimeToConsumerIdsKeyPrefix(),
-				getIds:             providerKeeper.GetConsumersToBeRemoved,
-				deleteAllIds:       providerKeeper.DeleteAllConsumersToBeRemoved,
-				appendId:           providerKeeper.AppendConsumerToBeRemoved,
-			},
-		}
-		for _, cc := range callCases {
-			err := cc.appendId(ctx, expectedConsumerIds[0], timestamps[0])
-			require.NoError(t, err)
-			err = cc.appendId(ctx, expectedConsumerIds[1], timestamps[0])
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+			ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+
+			consumerId := "0"
+			providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+			err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, providertypes.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(0, 4),
+				GenesisHash:                       []byte{},
+				BinaryHash:                        []byte{},
+				SpawnTime:                         spawnTime,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+			})
 			require.NoError(t, err)
-			err = cc.appendId(ctx, expectedConsumerIds[2], timestamps[1])
+			err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: tc.topN})
 			require.NoError(t, err)
-			err = cc.appendId(ctx, expectedConsumerIds[3], timestamps[2])
+			providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+			err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, spawnTime)
 			require.NoError(t, err)
 
-			ctx = ctx.WithBlockTime(tc.ts)
+			validators := []stakingtypes.Validator{}
+			for i := 0; i < tc.numValidators; i++ {
+				validator := cryptotestutil.NewCryptoIdentityFromIntSeed(i).SDKStakingValidator()
+				valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+				require.NoError(t, err)
+				mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+				consAddr, err := validator.GetConsAddr()
+				require.NoError(t, err)
+				if tc.topN == 0 {
+					providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+				}
+				validators = append(validators, validator)
+			}
+			testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, validators, -1)
+
+			if tc.expLaunched {
+				expectedCalls := testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)
+				expectedCalls = append(expectedCalls, testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain0", clienttypes.NewHeight(0, 4))...)
+				gomock.InOrder(expectedCalls...)
+			}
+
+			err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+			require.NoError(t, err)
 
-			consumerIds, err := providerKeeper.ConsumeIdsFromTimeQueue(
-				ctx,
-				cc.timeQueueKeyPrefix,
-				cc.getIds,
-				cc.deleteAllIds,
-				cc.appendId,
-				tc.limit,
-			)
+			consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
 			require.NoError(t, err)
+			if tc.expLaunched {
+				require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+				require.Len(t, providerKeeper.GetAllOptedIn(ctx, consumerId), 1)
+				require.Empty(t, consumerIds.Ids)
+				// a warning event is emitted as the distribution transmission channel is unset
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					found = found || event.Type == providertypes.EventTypeDistributionChannelUnset
+				}
+				require.True(t, found)
+			} else {
+				// the consumer chain stays initialized and is queued to be launched in the next block
+				require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+				_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+				require.False(t, found)
+				require.Equal(t, []string{consumerId}, consumerIds.Ids)
+				initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+				require.NoError(t, err)
+				require.Equal(t, spawnTime, initializationParameters.SpawnTime)
+			}
+		})
+	}
+}
+
+// TestBeginBlockLaunchConsumersWithMaxClientCreations tests that at most `MaxClientCreationsPerBlock` consumer chains
+// are launched in a block, while the launches beyond the cap are deferred to the next blocks in the order of the queue
+func TestBeginBlockLaunchConsumersWithMaxClientCreations(t *testing.T) {
+	now := time.Now().UTC()
+	spawnTime := now.Add(-time.Hour)
+	numConsumers := 60
 
-			tc.expOutcome(ctx, consumerIds, cc.getIds)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	maxClientCreations := int(params.MaxClientCreationsPerBlock)
+	require.Equal(t, 25, maxClientCreations)
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(now)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+
+	// all the consumer chains are queued to be launched at the same spawn time
+	consumerIds := []string{}
+	for i := 0; i < numConsumers; i++ {
+		consumerId := fmt.Sprintf("%d", i)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain%d", i))
+		initializationParameters := testkeeper.GetTestInitializationParameters()
+		initializationParameters.SpawnTime = spawnTime
+		initializationParameters.InitialHeight = clienttypes.NewHeight(0, 4)
+		require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+		require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 100}))
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+		require.NoError(t, providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, spawnTime))
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	// the consumer chains are launched over ⌈60/25⌉ = 3 blocks
+	numBlocks := (numConsumers + maxClientCreations - 1) / maxClientCreations
+	require.Equal(t, 3, numBlocks)
+	for block := 0; block < numBlocks; block++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+
+		first := block * maxClientCreations
+		last := min(first+maxClientCreations, numConsumers)
+		expectedCalls := []*gomock.Call{}
+		for i := first; i < last; i++ {
+			expectedCalls = append(expectedCalls, testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+			expectedCalls = append(expectedCalls,
+				testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, fmt.Sprintf("chain%d", i), clienttypes.NewHeight(0, 4))...)
+		}
+		gomock.InOrder(expectedCalls...)
+
+		require.NoError(t, providerKeeper.BeginBlockLaunchConsumers(ctx))
+
+		// the consumer chains are launched in the order of the queue
+		for i, consumerId := range consumerIds {
+			expectedPhase := providertypes.CONSUMER_PHASE_INITIALIZED
+			if i < last {
+				expectedPhase = providertypes.CONSUMER_PHASE_LAUNCHED
+			}
+			require.Equal(t, expectedPhase, providerKeeper.GetConsumerPhase(ctx, consumerId), "block %d, consumerId(%s)", block, consumerId)
+		}
+
+		// the deferred launches are still queued under their spawn time and in the same order
+		queued, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+		require.NoError(t, err)
+		if last == numConsumers {
+			require.Empty(t, queued.Ids)
+		} else {
+			require.Equal(t, consumerIds[last:], queued.Ids)
 		}
 	}
 }
 
+// TestDeferConsumerLaunchesPreservesOrder tests that the launches deferred by the cap on client creations
+// keep their order with respect to the consumer chains that remain in the queue under the same spawn time
+func TestDeferConsumerLaunchesPreservesOrder(t *testing.T) {
+	now := time.Now().UTC()
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.MaxClientCreationsPerBlock = 1
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(now)
+
+	// consumer "0" can launch, while consumers "1" and "2" are deferred and consumer "3" is not due yet
+	spawnTimes := []time.Time{now.Add(-2 * time.Hour), now.Add(-2 * time.Hour), now.Add(-time.Hour), now.Add(time.Hour)}
+	for i, spawnTime := range spawnTimes {
+		consumerId := fmt.Sprintf("%d", i)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain%d", i))
+		initializationParameters := testkeeper.GetTestInitializationParameters()
+		initializationParameters.SpawnTime = spawnTime
+		initializationParameters.InitialHeight = clienttypes.NewHeight(0, 4)
+		require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+		require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{}))
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+		require.NoError(t, providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, spawnTime))
+	}
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	providerKeeper.SetOptedIn(ctx, "0", providertypes.NewProviderConsAddress(consAddr))
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+
+	gomock.InOrder(append(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour),
+		testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain0", clienttypes.NewHeight(0, 4))...)...)
+	require.NoError(t, providerKeeper.BeginBlockLaunchConsumers(ctx))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, "0"))
+
+	queued := []string{}
+	err = providerKeeper.SpawnTimeQueue().Iterate(ctx, func(_ time.Time, consumerIds []string) bool {
+		queued = append(queued, consumerIds...)
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, queued)
+	for i, spawnTime := range spawnTimes[1:] {
+		consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+		require.NoError(t, err)
+		require.Equal(t, []string{fmt.Sprintf("%d", i+1)}, consumerIds.Ids)
+	}
+}
+
+// TestBeginBlockLaunchConsumersAfterGenesisRestart tests that a Top N consumer chain whose spawn time passed
+// is not launched while the active validator set is empty (e.g., in the first block after the provider chain
+// is restarted from an exported genesis), but in the first block with a non-empty active validator set
+func TestBeginBlockLaunchConsumersAfterGenesisRestart(t *testing.T) {
+	now := time.Now().UTC()
+	spawnTime := now.Add(-time.Hour)
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(now)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	require.NoError(t, err)
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	// import the provider genesis; the last provider consensus validator set is rebuilt from the imported staking state
+	gomock.InOrder(
+		mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(nil, true).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1), nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(
+			[]stakingtypes.Validator{validator}, nil).Times(1),
+	)
+	providerKeeper.InitGenesis(ctx, providertypes.DefaultGenesisState())
+	consensusValSet, err := providerKeeper.GetLastProviderConsensusValSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, consensusValSet, 1)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = spawnTime
+	err = providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 100})
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, spawnTime)
+	require.NoError(t, err)
+
+	// in the first block, the validator is bonded, but the active validator set is empty
+	params := providerKeeper.GetParams(ctx)
+	maxProviderConsensusValidators := params.MaxProviderConsensusValidators
+	params.MaxProviderConsensusValidators = 0
+	providerKeeper.SetParams(ctx, params)
+	mocks.MockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(uint32(1), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(
+		[]stakingtypes.Validator{validator}, nil).Times(1)
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the consumer chain stays initialized and is queued to be launched in the next block
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, consumerId))
+	_, found = providerKeeper.GetMinimumPowerInTopN(ctx, consumerId)
+	require.False(t, found)
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, consumerIds.Ids)
+
+	// in the next block, the active validator set is not empty and the consumer chain launches
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	params.MaxProviderConsensusValidators = maxProviderConsensusValidators
+	providerKeeper.SetParams(ctx, params)
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(
+		[]stakingtypes.Validator{validator}, nil).Times(1)
+	expectedCalls := testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)
+	expectedCalls = append(expectedCalls, testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "chain0", initializationParameters.InitialHeight)...)
+	gomock.InOrder(expectedCalls...)
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr)))
+	gen, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.True(t, found)
+	require.Len(t, gen.Provider.InitialValSet, 1)
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+}
+
+// TestBeginBlockLaunchConsumersWithLaunchedChainId tests that a consumer chain is not launched
+// while another consumer chain with the same chain id is launched
+func TestBeginBlockLaunchConsumersWithLaunchedChainId(t *testing.T) {
+	now := time.Now().UTC()
+	spawnTime := now.Add(-time.Hour)
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+
+	// consumer chains "0" and "1" are launched, while "2" and "3" try to launch with the chain id of "0"
+	chains := []struct {
+		chainId string
+		phase   providertypes.ConsumerPhase
+	}{
+		{chainId: "chain0", phase: providertypes.CONSUMER_PHASE_LAUNCHED},
+		{chainId: "chain1", phase: providertypes.CONSUMER_PHASE_LAUNCHED},
+		{chainId: "chain0", phase: providertypes.CONSUMER_PHASE_INITIALIZED},
+		{chainId: "chain0", phase: providertypes.CONSUMER_PHASE_REGISTERED},
+	}
+	for _, chain := range chains {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, chain.chainId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, chain.phase)
+	}
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = spawnTime
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, "2", initializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, "2", spawnTime)
+	require.NoError(t, err)
+
+	// the conflicts are flagged before the launch
+	expectedConflicts := []providertypes.ConsumerChainIdConflict{
+		{ChainId: "chain0", ConsumerIds: []string{"0", "2", "3"}, LaunchedConsumerId: "0"},
+	}
+	require.Equal(t, expectedConflicts, providerKeeper.GetConsumerChainIdConflicts(ctx))
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, 2)
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the consumer chain stays initialized and is queued to be launched in the next block
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, "2"))
+	_, found := providerKeeper.GetConsumerGenesis(ctx, "2")
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerClientId(ctx, "2")
+	require.False(t, found)
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, consumerIds.Ids)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeConsumerLaunchConflict, events[0].Type)
+	attr, found := events[0].GetAttribute(providertypes.AttributeConsumerId)
+	require.True(t, found)
+	require.Equal(t, "2", attr.Value)
+	attr, found = events[0].GetAttribute(providertypes.AttributeConflictingConsumerId)
+	require.True(t, found)
+	require.Equal(t, "0", attr.Value)
+	require.Equal(t, uint64(1), providerKeeper.GetConsumerLaunchConflictRetries(ctx, "2"))
+
+	// the launch fails once it was retried `MaxConsumerLaunchConflictRetries` times
+	providerKeeper.SetConsumerLaunchConflictRetries(ctx, "2", providertypes.MaxConsumerLaunchConflictRetries)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, "2"))
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_LAUNCH_FAILED, removalRecord.Reason)
+	require.Zero(t, providerKeeper.GetConsumerLaunchConflictRetries(ctx, "2"))
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeConsumerLaunchFailed, events[0].Type)
+
+	// the owner can remove the consumer chain that could not launch
+	err = providerKeeper.CancelConsumerLaunch(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, "2"))
+	expectedConflicts[0].ConsumerIds = []string{"0", "3"}
+	require.Equal(t, expectedConflicts, providerKeeper.GetConsumerChainIdConflicts(ctx))
+
+	// chain ids of stopped consumer chains are not in conflict
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_STOPPED)
+	require.Empty(t, providerKeeper.GetConsumerChainIdConflicts(ctx))
+}
+
 func TestCreateConsumerClient(t *testing.T) {
 	type testCase struct {
 		description string
@@ -520,6 +960,11 @@ func TestCreateConsumerClient(t *testing.T) {
 			clientId, found := providerKeeper.GetConsumerClientId(ctx, CONSUMER_ID)
 			require.True(t, found)
 			require.Equal(t, "clientID", clientId)
+			// the consumer id can be looked up from the client id
+			res, err := providerKeeper.QueryConsumerIdFromClientId(ctx,
+				&providertypes.QueryConsumerIdFromClientIdRequest{ClientId: clientId})
+			require.NoError(t, err)
+			require.Equal(t, CONSUMER_ID, res.ConsumerId)
 		} else {
 			require.Error(t, err)
 		}
@@ -654,9 +1099,15 @@ func TestMakeConsumerGenesis(t *testing.T) {
 			"reward_denoms": [],
 			"provider_reward_denoms": [],
 			"retry_delay_period": %d,
-			"consumer_id": "%s"
+			"consumer_id": "%s",
+			"provider_client_expiry_warning_fraction": "%s",
+			"provider_client_expiry_halt_delay": %d,
+			"expected_provider_epoch_duration": %d,
+			"valset_staleness_warning_epochs": %d,
+			"provider_accepts_handshake_metadata": true
 		},
 		"new_chain": true,
+		"ccv_genesis_version": "v6",
 		"provider" : {
 			"client_state": {
 				"chain_id": "%s",
@@ -726,6 +1177,10 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		consumerUnbondingPeriod.Nanoseconds(),
 		ccvtypes.DefaultRetryDelayPeriod.Nanoseconds(),
 		CONSUMER_ID,
+		ccvtypes.DefaultProviderClientExpiryWarningFraction,
+		ccvtypes.DefaultProviderClientExpiryHaltDelay,
+		ccvtypes.DefaultExpectedProviderEpochDuration.Nanoseconds(),
+		ccvtypes.DefaultValsetStalenessWarningEpochs,
 		providerChainId,
 		trustingPeriod.Nanoseconds(),
 		providerUnbondingPeriod.Nanoseconds(),
@@ -746,6 +1201,342 @@ func TestMakeConsumerGenesis(t *testing.T) {
 	require.Equal(t, expectedGenesis, actualGenesis, "consumer chain genesis created incorrectly")
 }
 
+// TestMakeConsumerGenesisConsumerDenom tests that the consumer denom and its metadata
+// set in the initialization parameters are carried into the consumer genesis params
+func TestMakeConsumerGenesisConsumerDenom(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	metadata := banktypes.Metadata{
+		Description: "The native staking token of the consumer chain",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ucons", Exponent: 0},
+			{Denom: "cons", Exponent: 6},
+		},
+		Base:    "ucons",
+		Display: "cons",
+		Name:    "Consumer",
+		Symbol:  "CONS",
+	}
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.ConsumerDenom = "ucons"
+	initializationParameters.ConsumerDenomMetadata = &metadata
+
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters)
+	require.NoError(t, err)
+
+	gen, err := providerKeeper.MakeConsumerGenesis(ctx, CONSUMER_ID, []abci.ValidatorUpdate{})
+	require.NoError(t, err)
+	require.Equal(t, "ucons", gen.Params.ConsumerDenom)
+	require.Equal(t, &metadata, gen.Params.ConsumerDenomMetadata)
+	require.NoError(t, gen.Params.Validate())
+
+	// the denom metadata survives the JSON encoding used for the consumer genesis file
+	bz, err := json.Marshal(gen.Params)
+	require.NoError(t, err)
+	var decodedParams ccvtypes.ConsumerParams
+	require.NoError(t, json.Unmarshal(bz, &decodedParams))
+	require.Equal(t, gen.Params.ConsumerDenom, decodedParams.ConsumerDenom)
+	require.Equal(t, gen.Params.ConsumerDenomMetadata, decodedParams.ConsumerDenomMetadata)
+}
+
+// TestMakeConsumerGenesisDowntimeWindow tests the round trip of the downtime window from the
+// MsgCreateConsumer on the provider to the effective slashing params of the consumer chain
+func TestMakeConsumerGenesisDowntimeWindow(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Hour)
+	initializationParameters.SignedBlocksWindow = 10000
+	initializationParameters.MinSignedPerWindow = "0.05"
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+
+	msg, err := providertypes.NewMsgCreateConsumer("submitter", CONSUMER_CHAIN_ID, testkeeper.GetTestConsumerMetadata(),
+		&initializationParameters, &powerShapingParameters, nil)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	resp, err := msgServer.CreateConsumer(ctx, msg)
+	require.NoError(t, err)
+
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	valUpdate := abci.ValidatorUpdate{
+		PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(),
+		Power:  1,
+	}
+	gen, err := providerKeeper.MakeConsumerGenesis(ctx, resp.ConsumerId, []abci.ValidatorUpdate{valUpdate})
+	require.NoError(t, err)
+	require.Equal(t, int64(10000), gen.Params.SignedBlocksWindow)
+	require.Equal(t, "0.05", gen.Params.MinSignedPerWindow)
+	require.NoError(t, gen.Params.Validate())
+
+	// the consumer chain applies the downtime window to its slashing params on genesis
+	consumerKeeper, consumerCtx, consumerCtrl, consumerMocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer consumerCtrl.Finish()
+
+	params := gen.Params
+	params.Enabled = true
+	effectiveSlashingParams := slashingtypes.DefaultParams()
+	gomock.InOrder(
+		testkeeper.ExpectGetCapabilityMock(consumerCtx, consumerMocks, 1),
+		consumerMocks.MockClientKeeper.EXPECT().CreateClient(consumerCtx, gomock.Any(), gomock.Any()).Return("07-tendermint-0", nil).Times(1),
+		consumerMocks.MockSlashingKeeper.EXPECT().GetParams(consumerCtx).Return(slashingtypes.DefaultParams(), nil).Times(1),
+		consumerMocks.MockSlashingKeeper.EXPECT().SetParams(consumerCtx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, p slashingtypes.Params) error {
+				effectiveSlashingParams = p
+				return nil
+			}).Times(1),
+	)
+	consumerKeeper.InitGenesis(consumerCtx, consumertypes.NewInitialGenesisState(
+		gen.Provider.ClientState, gen.Provider.ConsensusState, gen.Provider.InitialValSet, params))
+
+	require.Equal(t, int64(10000), effectiveSlashingParams.SignedBlocksWindow)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), effectiveSlashingParams.MinSignedPerWindow)
+	require.Equal(t, slashingtypes.DefaultParams().DowntimeJailDuration, effectiveSlashingParams.DowntimeJailDuration)
+}
+
+// TestMakeConsumerGenesisDenomRedistributionFractions tests that the denom-specific redistribution
+// fractions of the MsgCreateConsumer are carried into the consumer genesis params
+func TestMakeConsumerGenesisDenomRedistributionFractions(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	denomFractions := []ccvtypes.DenomRedistributionFraction{
+		{Denom: "untrn", Fraction: "1"},
+		{Denom: "ustake", Fraction: "0.1"},
+	}
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Hour)
+	initializationParameters.DenomRedistributionFractions = denomFractions
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+
+	msg, err := providertypes.NewMsgCreateConsumer("submitter", CONSUMER_CHAIN_ID, testkeeper.GetTestConsumerMetadata(),
+		&initializationParameters, &powerShapingParameters, nil)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	resp, err := msgServer.CreateConsumer(ctx, msg)
+	require.NoError(t, err)
+
+	gomock.InOrder(testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour)...)
+	valUpdate := abci.ValidatorUpdate{
+		PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(),
+		Power:  1,
+	}
+	gen, err := providerKeeper.MakeConsumerGenesis(ctx, resp.ConsumerId, []abci.ValidatorUpdate{valUpdate})
+	require.NoError(t, err)
+	require.Equal(t, denomFractions, gen.Params.DenomRedistributionFractions)
+	require.Equal(t, initializationParameters.ConsumerRedistributionFraction, gen.Params.ConsumerRedistributionFraction)
+	require.NoError(t, gen.Params.Validate())
+}
+
+// TestMakeConsumerGenesisSelfConsensusStateFallback tests that the consumer genesis embeds the self consensus state
+// of a previous height if the self consensus state of the current height is unavailable
+func TestMakeConsumerGenesisSelfConsensusStateFallback(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockHeight(10)
+
+	height := clienttypes.GetSelfHeight(ctx)
+	previousHeight := clienttypes.NewHeight(height.RevisionNumber, height.RevisionHeight-1)
+	consState := &ibctmtypes.ConsensusState{Timestamp: time.Unix(1000000, 0).UTC()}
+
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), height).Return(
+			nil, fmt.Errorf("no historical info")).Times(1),
+		mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), previousHeight).Return(
+			consState, nil).Times(1),
+	)
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters())
+	require.NoError(t, err)
+
+	gen, err := providerKeeper.MakeConsumerGenesis(ctx, CONSUMER_ID, []abci.ValidatorUpdate{})
+	require.NoError(t, err)
+	// the embedded provider client is at the height of the embedded consensus state
+	require.Equal(t, previousHeight, gen.Provider.ClientState.LatestHeight)
+	require.Equal(t, consState, gen.Provider.ConsensusState)
+}
+
+// TestMakeConsumerGenesisTopNWithoutActiveValidators tests that the genesis of a Top N consumer chain
+// is not created if the minimum power in top N was not computed, i.e., if the active validator set was empty
+func TestMakeConsumerGenesisTopNWithoutActiveValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+
+	_, err = providerKeeper.MakeConsumerGenesis(ctx, CONSUMER_ID, []abci.ValidatorUpdate{})
+	require.ErrorIs(t, err, providertypes.ErrEmptyActiveValidatorSet)
+}
+
+// TestValidateDistributionTransmissionChannel tests that the distribution transmission channel of a consumer chain
+// is valid only if the provider has an OPEN transfer channel to the consumer chain with the channel as counterparty
+func TestValidateDistributionTransmissionChannel(t *testing.T) {
+	transferChannel := func(channelId, counterpartyPortId, counterpartyChannelId string, state channeltypes.State) channeltypes.IdentifiedChannel {
+		return channeltypes.IdentifiedChannel{
+			State:          state,
+			Counterparty:   channeltypes.NewCounterparty(counterpartyPortId, counterpartyChannelId),
+			ConnectionHops: []string{"connection-0"},
+			PortId:         "transfer",
+			ChannelId:      channelId,
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		channels        []channeltypes.IdentifiedChannel
+		clientState     ibcexported.ClientState
+		expConnection   bool
+		expErrorMessage string
+	}{
+		{
+			name:          "valid channel",
+			channels:      []channeltypes.IdentifiedChannel{transferChannel("channel-1", "transfer", "channel-5", channeltypes.OPEN)},
+			clientState:   &ibctmtypes.ClientState{ChainId: CONSUMER_CHAIN_ID},
+			expConnection: true,
+		},
+		{
+			name:          "valid channel with undeterminable counterparty chain id",
+			channels:      []channeltypes.IdentifiedChannel{transferChannel("channel-1", "transfer", "channel-5", channeltypes.OPEN)},
+			clientState:   nil,
+			expConnection: true,
+		},
+		{
+			name:            "channel does not exist",
+			channels:        []channeltypes.IdentifiedChannel{transferChannel("channel-1", "transfer", "channel-6", channeltypes.OPEN)},
+			expErrorMessage: "no channel on port transfer has channel channel-5",
+		},
+		{
+			name:            "channel belongs to another port",
+			channels:        []channeltypes.IdentifiedChannel{transferChannel("channel-1", "icahost", "channel-5", channeltypes.OPEN)},
+			expErrorMessage: "belongs to port icahost",
+		},
+		{
+			name:            "channel is not open",
+			channels:        []channeltypes.IdentifiedChannel{transferChannel("channel-1", "transfer", "channel-5", channeltypes.TRYOPEN)},
+			expErrorMessage: "is in state STATE_TRYOPEN",
+		},
+		{
+			name:            "channel belongs to another chain",
+			channels:        []channeltypes.IdentifiedChannel{transferChannel("channel-1", "transfer", "channel-5", channeltypes.OPEN)},
+			clientState:     &ibctmtypes.ClientState{ChainId: "other-chain"},
+			expConnection:   true,
+			expErrorMessage: "belongs to chain other-chain",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			mocks.MockChannelKeeper.EXPECT().GetAllChannelsWithPortPrefix(gomock.Any(), "transfer").Return(tc.channels).Times(1)
+			if tc.expConnection {
+				mocks.MockChannelKeeper.EXPECT().GetChannelConnection(gomock.Any(), "transfer", "channel-1").Return(
+					"connection-0", conntypes.ConnectionEnd{ClientId: "07-tendermint-1"}, nil).Times(1)
+				mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "07-tendermint-1").Return(
+					tc.clientState, tc.clientState != nil).Times(1)
+			}
+
+			err := providerKeeper.ValidateDistributionTransmissionChannel(ctx, CONSUMER_CHAIN_ID, "channel-5")
+			if tc.expErrorMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, providertypes.ErrInvalidDistributionTransmissionChannel)
+				require.ErrorContains(t, err, tc.expErrorMessage)
+			}
+		})
+	}
+}
+
+// TestGetSelfConsensusStateWithFallback tests that the self consensus state falls back to
+// at most MaxSelfConsensusStateFallbackHeights previous heights and that the fallback is deterministic
+func TestGetSelfConsensusStateWithFallback(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the self consensus states are available only up to height 7
+	mocks.MockClientKeeper.EXPECT().GetSelfConsensusState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, height ibcexported.Height) (ibcexported.ConsensusState, error) {
+			if height.GetRevisionHeight() > 7 {
+				return nil, fmt.Errorf("no historical info")
+			}
+			return &ibctmtypes.ConsensusState{Timestamp: time.Unix(int64(height.GetRevisionHeight()), 0).UTC()}, nil
+		}).AnyTimes()
+
+	// no fallback is needed
+	consState, height, err := providerKeeper.GetSelfConsensusStateWithFallback(ctx, clienttypes.NewHeight(1, 5))
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(1, 5), height)
+	require.Equal(t, time.Unix(5, 0).UTC(), consState.Timestamp)
+
+	// the fallback height is the same on every call
+	for i := 0; i < 2; i++ {
+		consState, height, err = providerKeeper.GetSelfConsensusStateWithFallback(ctx, clienttypes.NewHeight(1, 9))
+		require.NoError(t, err)
+		require.Equal(t, clienttypes.NewHeight(1, 7), height)
+		require.Equal(t, time.Unix(7, 0).UTC(), consState.Timestamp)
+	}
+
+	// the fallback is bounded
+	_, height, err = providerKeeper.GetSelfConsensusStateWithFallback(ctx,
+		clienttypes.NewHeight(1, 7+providertypes.MaxSelfConsensusStateFallbackHeights))
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(1, 7), height)
+	_, _, err = providerKeeper.GetSelfConsensusStateWithFallback(ctx,
+		clienttypes.NewHeight(1, 8+providertypes.MaxSelfConsensusStateFallbackHeights))
+	require.ErrorIs(t, err, clienttypes.ErrConsensusStateNotFound)
+}
+
+// TestGetAssignedProviderKeys tests that only the provider keys of validators
+// that assigned a different consumer key are returned
+func TestGetAssignedProviderKeys(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// validator that uses its provider key on the consumer
+	valA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	// validator that assigned a consumer key
+	valB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	valBConsumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, valBConsumerKey.ConsumerConsAddress(), valB.ProviderConsAddress())
+	// validator that assigned back its provider key
+	valC := cryptotestutil.NewCryptoIdentityFromIntSeed(4)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, valC.ConsumerConsAddress(), valC.ProviderConsAddress())
+
+	mocks.MockStakingKeeper.EXPECT().
+		GetValidatorByConsAddr(ctx, valB.SDKValConsAddress()).Return(valB.SDKStakingValidator(), nil).Times(1)
+
+	updates := []abci.ValidatorUpdate{
+		{PubKey: valA.TMProtoCryptoPublicKey(), Power: 1},
+		{PubKey: valBConsumerKey.TMProtoCryptoPublicKey(), Power: 2},
+		{PubKey: valC.TMProtoCryptoPublicKey(), Power: 3},
+	}
+	providerKeys, err := providerKeeper.GetAssignedProviderKeys(ctx, CONSUMER_ID, updates)
+	require.NoError(t, err)
+	require.Equal(t, []tmprotocrypto.PublicKey{valB.TMProtoCryptoPublicKey()}, providerKeys)
+
+	// no key assignments on a different consumer
+	providerKeys, err = providerKeeper.GetAssignedProviderKeys(ctx, "1", updates)
+	require.NoError(t, err)
+	require.Empty(t, providerKeys)
+}
+
 func TestBeginBlockStopConsumers(t *testing.T) {
 	now := time.Now().UTC()
 
@@ -886,6 +1677,12 @@ func TestStopConsumerChain(t *testing.T) {
 			require.Error(t, err, t)
 		} else {
 			require.NoError(t, err)
+			// the consumer id cannot be looked up from the client id anymore
+			_, found := providerKeeper.GetClientIdToConsumerId(ctx, "clientID")
+			require.False(t, found)
+			_, err = providerKeeper.QueryConsumerIdFromClientId(ctx,
+				&providertypes.QueryConsumerIdFromClientIdRequest{ClientId: "clientID"})
+			require.Error(t, err)
 		}
 
 		testkeeper.TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t, ctx, providerKeeper, consumerId, "channelID", tc.expErr)
@@ -894,6 +1691,163 @@ func TestStopConsumerChain(t *testing.T) {
 	}
 }
 
+// TestStopAndPrepareForConsumerRemoval tests that the reason why a consumer chain is stopped is required,
+// and that it is recorded and emitted in the stop event
+func TestStopAndPrepareForConsumerRemoval(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chainId")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	// a consumer chain cannot be stopped without a reason
+	err := providerKeeper.StopAndPrepareForConsumerRemoval(ctx, consumerId, providertypes.CONSUMER_REMOVAL_REASON_UNSPECIFIED, "")
+	require.ErrorIs(t, err, ccvtypes.ErrInvalidConsumerState)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	_, found := providerKeeper.GetConsumerRemovalRecord(ctx, consumerId)
+	require.False(t, found)
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = providerKeeper.StopAndPrepareForConsumerRemoval(ctx, consumerId, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT, "note")
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerRemovalRecord{
+		Reason: providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT,
+		Note:   "note",
+	}, removalRecord)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeStopConsumer, events[0].Type)
+	attr, found := events[0].GetAttribute(providertypes.AttributeRemovalReason)
+	require.True(t, found)
+	require.Equal(t, "CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT", attr.Value)
+	attr, found = events[0].GetAttribute(providertypes.AttributeRemovalNote)
+	require.True(t, found)
+	require.Equal(t, "note", attr.Value)
+
+	// the removal record is retained once the consumer chain is deleted
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, consumerId))
+	retainedRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, removalRecord, retainedRecord)
+}
+
+// TestEndBlockCleanupDeletedConsumers tests that the per-validator state of deleted consumer chains
+// is removed over multiple blocks, while not being visible through queries in the meantime
+func TestEndBlockCleanupDeletedConsumers(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxConsumerCleanupDeletionsPerBlock = 5
+	providerKeeper.SetParams(ctx, params)
+
+	consumerIds := []string{"0", "1"}
+	testkeeper.SetupForDeleteConsumerChain(t, ctx, &providerKeeper, mocks, consumerIds[0])
+	gomock.InOrder(testkeeper.GetMocksForDeleteConsumerChain(ctx, &mocks)...)
+
+	// the second consumer chain has already been deleted and is waiting to be cleaned up
+	providerKeeper.SetConsumerChainId(ctx, consumerIds[1], "chainID-1")
+	providerKeeper.SetConsumerPhase(ctx, consumerIds[1], providertypes.CONSUMER_PHASE_DELETED)
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerIds[1])
+
+	// 3 commission rates, 2 allowlisted, 2 denylisted, 3 opted-in and 3 consumer validators
+	// for the first consumer chain, and 2 opted-in validators for the second consumer chain
+	for i := 0; i < 3; i++ {
+		providerAddr := providertypes.NewProviderConsAddress([]byte(fmt.Sprintf("providerAddr%d", i)))
+		err := providerKeeper.SetConsumerCommissionRate(ctx, consumerIds[0], providerAddr, math.LegacyNewDecWithPrec(1, 1))
+		require.NoError(t, err)
+		if i < 2 {
+			providerKeeper.SetAllowlist(ctx, consumerIds[0], providerAddr)
+			providerKeeper.SetDenylist(ctx, consumerIds[0], providerAddr)
+			providerKeeper.SetOptedIn(ctx, consumerIds[1], providerAddr)
+		}
+		providerKeeper.SetOptedIn(ctx, consumerIds[0], providerAddr)
+		err = providerKeeper.SetConsumerValidator(ctx, consumerIds[0], providertypes.ConsensusValidator{
+			ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+			Power:            1,
+			PublicKey:        &tmprotocrypto.PublicKey{},
+		})
+		require.NoError(t, err)
+	}
+
+	err := providerKeeper.DeleteConsumerChain(ctx, consumerIds[0])
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, consumerIds[0]))
+	require.Equal(t, consumerIds, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+
+	// the deleted consumer chains must look deleted through queries while being cleaned up
+	requireCleanedUpThroughQueries := func() {
+		for _, consumerId := range consumerIds {
+			res, err := providerKeeper.QueryConsumerValidators(ctx, &providertypes.QueryConsumerValidatorsRequest{ConsumerId: consumerId})
+			require.NoError(t, err)
+			require.Empty(t, res.Validators)
+
+			_, err = providerKeeper.QueryConsumerChainOptedInValidators(ctx, &providertypes.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: consumerId})
+			require.Error(t, err)
+		}
+
+		chain, err := providerKeeper.GetConsumerChain(ctx, consumerIds[0])
+		require.NoError(t, err)
+		require.Empty(t, chain.Allowlist)
+		require.Empty(t, chain.Denylist)
+	}
+	requireCleanedUpThroughQueries()
+
+	// 1st block: 5 out of 13 entries of the first consumer chain are removed
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	requireCleanedUpThroughQueries()
+	require.Equal(t, consumerIds, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	require.Empty(t, providerKeeper.GetAllCommissionRateValidators(ctx, consumerIds[0]))
+	require.Empty(t, providerKeeper.GetAllowList(ctx, consumerIds[0]))
+	require.Len(t, providerKeeper.GetDenyList(ctx, consumerIds[0]), 2)
+
+	// 2nd block: 10 out of 13 entries of the first consumer chain are removed
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	requireCleanedUpThroughQueries()
+	require.Equal(t, consumerIds, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	require.Empty(t, providerKeeper.GetDenyList(ctx, consumerIds[0]))
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, consumerIds[0]))
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, consumerIds[0])
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 3)
+	require.Len(t, providerKeeper.GetAllOptedIn(ctx, consumerIds[1]), 2)
+
+	// 3rd block: the remaining 3 entries of the first consumer chain
+	// and the 2 entries of the second consumer chain are removed
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	requireCleanedUpThroughQueries()
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	consumerValSet, err = providerKeeper.GetConsumerValSet(ctx, consumerIds[0])
+	require.NoError(t, err)
+	require.Empty(t, consumerValSet)
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, consumerIds[1]))
+
+	// the consumer chains remain deleted
+	for _, consumerId := range consumerIds {
+		require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	}
+
+	// the key assignments of a deleted consumer chain are cleaned up, even if they are corrupt
+	consumerId := "2"
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, tmprotocrypto.PublicKey{})
+	store := ctx.KVStore(keeperParams.StoreKey)
+	store.Set(providertypes.StringIdWithLenKey(providertypes.ValidatorsByConsumerAddrKeyPrefix(), consumerId), []byte("malformed"))
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerId)
+
+	require.NotPanics(t, func() { providerKeeper.EndBlockCleanupDeletedConsumers(ctx) })
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	require.Empty(t, providerKeeper.GetAllValidatorConsumerPubKeys(ctx, &consumerId))
+	require.False(t, store.Has(providertypes.StringIdWithLenKey(providertypes.ValidatorsByConsumerAddrKeyPrefix(), consumerId)))
+}
+
 //
 // Setters and Getters
 //
@@ -910,7 +1864,23 @@ func TestConsumerRemovalTime(t *testing.T) {
 	providerKeeper.SetConsumerRemovalTime(ctx, CONSUMER_ID, expectedRemovalTime)
 	actualRemovalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	require.Equal(t, actualRemovalTime, expectedRemovalTime)
+	require.Equal(t, actualRemovalTime, expectedRemovalTime.UTC())
+
+	// the removal time is normalized, i.e., the same logical time in another location
+	// (and with a monotonic clock reading) is read back as the same time
+	removalTime := time.Now().In(time.FixedZone("UTC-5", -5*60*60))
+	providerKeeper.SetConsumerRemovalTime(ctx, CONSUMER_ID, removalTime)
+	actualRemovalTime, err = providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.NormalizeTime(removalTime), actualRemovalTime)
+	require.True(t, removalTime.Equal(actualRemovalTime))
+
+	// and the consumer id can be removed from the removal time queue with the removal time read back
+	require.NoError(t, providerKeeper.AppendConsumerToBeRemoved(ctx, CONSUMER_ID, removalTime))
+	require.NoError(t, providerKeeper.RemoveConsumerToBeRemoved(ctx, CONSUMER_ID, actualRemovalTime))
+	consumerIds, err := providerKeeper.GetConsumersToBeRemoved(ctx, removalTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
 
 	providerKeeper.DeleteConsumerRemovalTime(ctx, CONSUMER_ID)
 	_, err = providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
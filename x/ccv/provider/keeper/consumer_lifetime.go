@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// SetConsumerLifetime sets the lifetime of the launched consumer chain with `consumerId`
+func (k Keeper) SetConsumerLifetime(ctx sdk.Context, consumerId string, lifetime types.ConsumerLifetime) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := lifetime.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal lifetime (%+v) for consumer id (%s): %w", lifetime, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToLifetimeKey(consumerId), bz)
+	return nil
+}
+
+// GetConsumerLifetime returns the lifetime of the launched consumer chain with `consumerId`
+// and false if the chain is not launched or its lifetime is unlimited
+func (k Keeper) GetConsumerLifetime(ctx sdk.Context, consumerId string) (types.ConsumerLifetime, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToLifetimeKey(consumerId))
+	if bz == nil {
+		return types.ConsumerLifetime{}, false
+	}
+	var lifetime types.ConsumerLifetime
+	if err := lifetime.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the lifetime is assumed to be correctly serialized in SetConsumerLifetime.
+		panic(fmt.Errorf("failed to unmarshal lifetime for consumer id (%s): %w", consumerId, err))
+	}
+	return lifetime, true
+}
+
+// DeleteConsumerLifetime deletes the lifetime of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerLifetime(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToLifetimeKey(consumerId))
+}
+
+// GetConsumerIdsWithLifetime returns the ids of all the launched consumer chains with a maximum lifetime
+func (k Keeper) GetConsumerIdsWithLifetime(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToLifetimeKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized.
+			panic(fmt.Errorf("failed to parse consumer id: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// ScheduleConsumerSunset schedules the launched consumer chain with `consumerId` to be stopped once
+// the `MaxLifetime` of its initialization parameters elapses. A zero `MaxLifetime` means that the
+// lifetime of the chain is unlimited, in which case nothing is scheduled.
+func (k Keeper) ScheduleConsumerSunset(ctx sdk.Context, consumerId string) error {
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if initializationParameters.MaxLifetime == 0 {
+		return nil
+	}
+
+	lifetime := types.ConsumerLifetime{
+		LaunchTime: ctx.BlockTime().UTC(),
+		SunsetTime: ctx.BlockTime().Add(initializationParameters.MaxLifetime).UTC(),
+	}
+	if err := k.scheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+		return err
+	}
+	if err := k.SetConsumerLifetime(ctx, consumerId, lifetime); err != nil {
+		return err
+	}
+	return k.AppendConsumerToBeStopped(ctx, consumerId, lifetime.SunsetTime)
+}
+
+// ExtendConsumerLifetime extends the lifetime of the launched consumer chain with `consumerId` by `extension`
+// and returns its new sunset time. The lifetime can only be extended before it elapses. The lifetime reminders
+// are emitted anew for the fractions of the extended lifetime that have not elapsed yet.
+func (k Keeper) ExtendConsumerLifetime(ctx sdk.Context, consumerId string, extension time.Duration) (time.Time, error) {
+	lifetime, found := k.GetConsumerLifetime(ctx, consumerId)
+	if !found {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidLifetimeExtension,
+			"consumer chain with consumer id %s is not launched or has an unlimited lifetime", consumerId)
+	}
+	if !ctx.BlockTime().Before(lifetime.SunsetTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidLifetimeExtension,
+			"the lifetime of consumer chain with consumer id %s elapsed at %s", consumerId, lifetime.SunsetTime)
+	}
+
+	if err := k.RemoveConsumerToBeStopped(ctx, consumerId, lifetime.SunsetTime); err != nil {
+		return time.Time{}, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot remove the consumer from being stopped: %s", err.Error())
+	}
+	if err := k.unscheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+		return time.Time{}, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot remove the lifetime reminder of the consumer: %s", err.Error())
+	}
+	lifetime.SunsetTime = lifetime.SunsetTime.Add(extension).UTC()
+	lifetime.RemindersEmitted = k.countElapsedLifetimeFractions(ctx, lifetime)
+	if err := k.scheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+		return time.Time{}, err
+	}
+	if err := k.SetConsumerLifetime(ctx, consumerId, lifetime); err != nil {
+		return time.Time{}, err
+	}
+	if err := k.AppendConsumerToBeStopped(ctx, consumerId, lifetime.SunsetTime); err != nil {
+		return time.Time{}, errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot set consumer to be stopped: %s", err.Error())
+	}
+	return lifetime.SunsetTime, nil
+}
+
+// lifetimeReminderTimes returns the times at which the `LifetimeReminderFractions` of the given lifetime elapse
+func (k Keeper) lifetimeReminderTimes(ctx sdk.Context, lifetime types.ConsumerLifetime) []time.Time {
+	duration := lifetime.SunsetTime.Sub(lifetime.LaunchTime)
+	reminderTimes := []time.Time{}
+	for _, fraction := range k.GetLifetimeReminderFractions(ctx) {
+		dec, err := math.LegacyNewDecFromStr(fraction)
+		if err != nil {
+			// the fractions are validated with the params
+			break
+		}
+		reminderTimes = append(reminderTimes,
+			lifetime.LaunchTime.Add(time.Duration(dec.MulInt64(int64(duration)).TruncateInt64())))
+	}
+	return reminderTimes
+}
+
+// countElapsedLifetimeFractions returns the number of `LifetimeReminderFractions` of the given lifetime
+// that have elapsed at the current block time
+func (k Keeper) countElapsedLifetimeFractions(ctx sdk.Context, lifetime types.ConsumerLifetime) uint32 {
+	count := uint32(0)
+	for _, reminderTime := range k.lifetimeReminderTimes(ctx, lifetime) {
+		if ctx.BlockTime().Before(reminderTime) {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// scheduleLifetimeReminder schedules the reminder of the first of the `LifetimeReminderFractions` of the given lifetime
+// for which no reminder was emitted yet, if any, and records the time of the reminder in the lifetime.
+// The caller is responsible for storing the lifetime.
+func (k Keeper) scheduleLifetimeReminder(ctx sdk.Context, consumerId string, lifetime *types.ConsumerLifetime) error {
+	lifetime.NextReminderTime = time.Time{}
+	reminderTimes := k.lifetimeReminderTimes(ctx, *lifetime)
+	if int(lifetime.RemindersEmitted) >= len(reminderTimes) {
+		return nil
+	}
+	lifetime.NextReminderTime = reminderTimes[lifetime.RemindersEmitted].UTC()
+	if err := k.ReminderTimeQueue().Append(ctx, consumerId, lifetime.NextReminderTime); err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot schedule the lifetime reminder of the consumer: %s", err.Error())
+	}
+	return nil
+}
+
+// unscheduleLifetimeReminder removes the reminder scheduled for the given lifetime, if any.
+// The caller is responsible for storing or deleting the lifetime.
+func (k Keeper) unscheduleLifetimeReminder(ctx sdk.Context, consumerId string, lifetime *types.ConsumerLifetime) error {
+	if lifetime.NextReminderTime.IsZero() {
+		return nil
+	}
+	if err := k.ReminderTimeQueue().Remove(ctx, consumerId, lifetime.NextReminderTime); err != nil {
+		return err
+	}
+	lifetime.NextReminderTime = time.Time{}
+	return nil
+}
+
+// BeginBlockSunsetConsumers stops the launched consumer chains for which the maximum lifetime elapsed
+// and emits reminder events for the consumer chains for which another fraction of their lifetime elapsed
+// (see ReminderTimeQueue). A consumer chain that could not be stopped is put back into the stop-time queue,
+// so that stopping it is retried in the next block.
+func (k Keeper) BeginBlockSunsetConsumers(ctx sdk.Context) error {
+	consumerIds, err := k.StopTimeQueue().ConsumeUpTo(ctx, ctx.BlockTime(), 200)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to sunset: %s", err.Error())
+	}
+	for _, consumerId := range consumerIds {
+		// stop consumer chain in a cached context to abort the stop in case of errors
+		cachedCtx, writeFn := ctx.CacheContext()
+		chainId, err := k.sunsetConsumer(cachedCtx, consumerId)
+		if err != nil {
+			k.Logger(ctx).Error("consumer chain could not be stopped at its sunset time, retrying in the next block",
+				"consumerId", consumerId,
+				"error", err.Error())
+
+			// only a launched chain with a maximum lifetime can be stopped at its sunset time
+			lifetime, found := k.GetConsumerLifetime(ctx, consumerId)
+			if found && k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_LAUNCHED {
+				if err := k.AppendConsumerToBeStopped(ctx, consumerId, lifetime.SunsetTime); err != nil {
+					return fmt.Errorf("re-queueing consumer to be stopped, consumerId(%s): %w", consumerId, err)
+				}
+			}
+			continue
+		}
+		writeFn()
+
+		k.Logger(ctx).Info("stopped consumer since its maximum lifetime elapsed",
+			"consumerId", consumerId,
+			"chainId", chainId,
+		)
+	}
+
+	consumerIds, err = k.ReminderTimeQueue().ConsumeUpTo(ctx, ctx.BlockTime(), 200)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers to remind of their sunset: %s", err.Error())
+	}
+	for _, consumerId := range consumerIds {
+		if err := k.emitConsumerLifetimeReminder(ctx, consumerId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sunsetConsumer stops the launched consumer chain with `consumerId` for which the maximum lifetime elapsed
+func (k Keeper) sunsetConsumer(ctx sdk.Context, consumerId string) (string, error) {
+	lifetime, found := k.GetConsumerLifetime(ctx, consumerId)
+	if !found {
+		return "", fmt.Errorf("cannot find lifetime of consumer id %s", consumerId)
+	}
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return "", errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot stop chain with consumer id %s in phase %s", consumerId, phase)
+	}
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return "", err
+	}
+
+	// the chain was already consumed from the stop-time queue
+	if err := k.unscheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+		return "", errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot remove the lifetime reminder of the consumer: %s", err.Error())
+	}
+	k.DeleteConsumerLifetime(ctx, consumerId)
+	if err := k.StopAndPrepareForConsumerRemoval(ctx, consumerId, types.CONSUMER_REMOVAL_REASON_LIFETIME_EXPIRED,
+		fmt.Sprintf("maximum lifetime elapsed at %s", lifetime.SunsetTime)); err != nil {
+		return "", err
+	}
+
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerSunset,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeConsumerPhase, types.PhaseToString(phase)),
+			sdk.NewAttribute(types.AttributeConsumerPhaseValue, strconv.Itoa(int(phase))),
+			sdk.NewAttribute(types.AttributeConsumerSunsetTime, lifetime.SunsetTime.String()),
+		),
+	)
+	return chainId, nil
+}
+
+// emitConsumerLifetimeReminder emits a reminder event about the sunset of the consumer chain with `consumerId`,
+// whose reminder was consumed from the reminder-time queue, if another of the `LifetimeReminderFractions`
+// of its lifetime elapsed since the last reminder. The reminder of the next fraction is then scheduled.
+func (k Keeper) emitConsumerLifetimeReminder(ctx sdk.Context, consumerId string) error {
+	lifetime, found := k.GetConsumerLifetime(ctx, consumerId)
+	if !found {
+		return nil
+	}
+
+	elapsed := k.countElapsedLifetimeFractions(ctx, lifetime)
+	if elapsed > lifetime.RemindersEmitted {
+		// a single reminder is emitted for the latest elapsed fraction
+		lifetime.RemindersEmitted = elapsed
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerLifetimeReminder,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeLifetimeFraction, k.GetLifetimeReminderFractions(ctx)[elapsed-1]),
+				sdk.NewAttribute(types.AttributeConsumerSunsetTime, lifetime.SunsetTime.String()),
+				sdk.NewAttribute(types.AttributeRemainingLifetime, lifetime.SunsetTime.Sub(ctx.BlockTime()).String()),
+			),
+		)
+	}
+
+	// the reminder was already consumed from the reminder-time queue
+	if err := k.scheduleLifetimeReminder(ctx, consumerId, &lifetime); err != nil {
+		return err
+	}
+	return k.SetConsumerLifetime(ctx, consumerId, lifetime)
+}
@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// setupLaunchedConsumerWithLifetime sets up a launched consumer chain with the given maximum lifetime
+// and schedules its sunset at the current block time
+func setupLaunchedConsumerWithLifetime(t *testing.T, ctx sdk.Context, providerKeeper providerkeeper.Keeper, maxLifetime time.Duration) {
+	t.Helper()
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, "owner")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.MaxLifetime = maxLifetime
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerMetadata(ctx, CONSUMER_ID, testkeeper.GetTestConsumerMetadata())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, testkeeper.GetTestPowerShapingParameters())
+	require.NoError(t, err)
+	require.NoError(t, providerKeeper.ScheduleConsumerSunset(ctx, CONSUMER_ID))
+}
+
+// getLifetimeEvents returns the lifetime reminder and sunset events emitted in the given context
+func getLifetimeEvents(ctx sdk.Context) (reminders, sunsets []sdk.Event) {
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case providertypes.EventTypeConsumerLifetimeReminder:
+			reminders = append(reminders, event)
+		case providertypes.EventTypeConsumerSunset:
+			sunsets = append(sunsets, event)
+		}
+	}
+	return reminders, sunsets
+}
+
+// TestScheduleConsumerSunset tests that a sunset is only scheduled for consumer chains with a maximum lifetime
+func TestScheduleConsumerSunset(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// the lifetime of the consumer chain is unlimited
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 0)
+	_, found := providerKeeper.GetConsumerLifetime(ctx, CONSUMER_ID)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetConsumerIdsWithLifetime(ctx))
+	_, err := providerKeeper.ExtendConsumerLifetime(ctx, CONSUMER_ID, time.Hour)
+	require.ErrorIs(t, err, providertypes.ErrInvalidLifetimeExtension)
+
+	// a chain with unlimited lifetime is never stopped
+	ctx = ctx.WithBlockTime(now.Add(100 * 365 * 24 * time.Hour))
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+
+	// the consumer chain has a maximum lifetime
+	ctx = ctx.WithBlockTime(now)
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 10*time.Hour)
+	lifetime, found := providerKeeper.GetConsumerLifetime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerLifetime{LaunchTime: now, SunsetTime: now.Add(10 * time.Hour)}, lifetime)
+	require.Equal(t, []string{CONSUMER_ID}, providerKeeper.GetConsumerIdsWithLifetime(ctx))
+	consumerIds, err := providerKeeper.GetConsumersToBeStopped(ctx, now.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{CONSUMER_ID}, consumerIds.Ids)
+}
+
+// TestConsumerLifetimeExpiry tests that reminders are emitted at the lifetime reminder fractions
+// and that the consumer chain is stopped once its maximum lifetime elapsed
+func TestConsumerLifetimeExpiry(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 10*time.Hour)
+
+	// the reminder of the first fraction is scheduled
+	consumerIds, err := providerKeeper.ReminderTimeQueue().Get(ctx, now.Add(5*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{CONSUMER_ID}, consumerIds.Ids)
+
+	// beginBlockAtTime executes BeginBlockSunsetConsumers at the given time and returns the emitted events
+	beginBlockAtTime := func(blockTime time.Time) (reminders, sunsets []sdk.Event) {
+		ctx = ctx.WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+		return getLifetimeEvents(ctx)
+	}
+
+	// no fraction of the lifetime elapsed yet
+	reminders, sunsets := beginBlockAtTime(now.Add(5*time.Hour - time.Second))
+	require.Empty(t, reminders)
+	require.Empty(t, sunsets)
+
+	// half of the lifetime elapsed
+	reminders, sunsets = beginBlockAtTime(now.Add(5 * time.Hour))
+	require.Len(t, reminders, 1)
+	require.Empty(t, sunsets)
+	attr, found := reminders[0].GetAttribute(providertypes.AttributeLifetimeFraction)
+	require.True(t, found)
+	require.Equal(t, "0.5", attr.Value)
+	attr, found = reminders[0].GetAttribute(providertypes.AttributeRemainingLifetime)
+	require.True(t, found)
+	require.Equal(t, (5 * time.Hour).String(), attr.Value)
+
+	// every reminder is emitted only once
+	reminders, _ = beginBlockAtTime(now.Add(6 * time.Hour))
+	require.Empty(t, reminders)
+
+	reminders, _ = beginBlockAtTime(now.Add(9 * time.Hour))
+	require.Len(t, reminders, 1)
+	attr, _ = reminders[0].GetAttribute(providertypes.AttributeLifetimeFraction)
+	require.Equal(t, "0.9", attr.Value)
+
+	// no reminder is scheduled after the last fraction
+	entries, err := providerKeeper.ReminderTimeQueue().GetAll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// the chain is not stopped before its sunset time
+	_, sunsets = beginBlockAtTime(now.Add(10*time.Hour - time.Second))
+	require.Empty(t, sunsets)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+
+	// the chain is stopped once its lifetime elapsed
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	reminders, sunsets = beginBlockAtTime(now.Add(10 * time.Hour))
+	require.Empty(t, reminders)
+	require.Len(t, sunsets, 1)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	attr, found = sunsets[0].GetAttribute(providertypes.AttributeConsumerPhase)
+	require.True(t, found)
+	require.Equal(t, "CONSUMER_PHASE_STOPPED", attr.Value)
+	attr, found = sunsets[0].GetAttribute(providertypes.AttributeConsumerPhaseValue)
+	require.True(t, found)
+	require.Equal(t, "4", attr.Value)
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(11*time.Hour), removalTime)
+	_, found = providerKeeper.GetConsumerLifetime(ctx, CONSUMER_ID)
+	require.False(t, found)
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_LIFETIME_EXPIRED, removalRecord.Reason)
+
+	// the lifetime of a stopped chain cannot be extended
+	_, err = providerKeeper.ExtendConsumerLifetime(ctx, CONSUMER_ID, time.Hour)
+	require.ErrorIs(t, err, providertypes.ErrInvalidLifetimeExtension)
+}
+
+// TestExtendConsumerLifetime tests that the owner of a consumer chain can extend its lifetime
+// before it elapses and that the chain is stopped at its extended sunset time
+func TestExtendConsumerLifetime(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 10*time.Hour)
+
+	// the reminder for half of the lifetime is emitted
+	ctx = ctx.WithBlockTime(now.Add(6 * time.Hour))
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	reminders, _ := getLifetimeEvents(ctx)
+	require.Len(t, reminders, 1)
+
+	// only the owner can extend the lifetime
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	lifetimeExtension := 10 * time.Hour
+	_, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner: "not the owner", ConsumerId: CONSUMER_ID, LifetimeExtension: &lifetimeExtension,
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	resp, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner: "owner", ConsumerId: CONSUMER_ID, LifetimeExtension: &lifetimeExtension,
+	})
+	require.NoError(t, err)
+	require.Contains(t, resp.UpdatedFields, "lifetime_extension")
+
+	// the chain is scheduled to be stopped at the extended sunset time
+	lifetime, found := providerKeeper.GetConsumerLifetime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, now.Add(20*time.Hour), lifetime.SunsetTime)
+	consumerIds, err := providerKeeper.GetConsumersToBeStopped(ctx, now.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+	consumerIds, err = providerKeeper.GetConsumersToBeStopped(ctx, now.Add(20*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{CONSUMER_ID}, consumerIds.Ids)
+
+	// the query shows the sunset time and the remaining lifetime
+	chain, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(20*time.Hour), *chain.SunsetTime)
+	require.Equal(t, 14*time.Hour, *chain.RemainingLifetime)
+
+	// the reminder of the first fraction of the extended lifetime that has not elapsed yet is scheduled
+	require.Equal(t, now.Add(10*time.Hour), lifetime.NextReminderTime)
+	entries, err := providerKeeper.ReminderTimeQueue().GetAll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.TimeQueueEntry{{Time: now.Add(10 * time.Hour), ConsumerIds: []string{CONSUMER_ID}}}, entries)
+
+	// the chain is not stopped at its previous sunset time and
+	// the reminders are emitted anew for the extended lifetime
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	reminders, sunsets := getLifetimeEvents(ctx)
+	require.Len(t, reminders, 1)
+	require.Empty(t, sunsets)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+
+	// the chain is stopped at its extended sunset time
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	ctx = ctx.WithBlockTime(now.Add(20 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	_, sunsets = getLifetimeEvents(ctx)
+	require.Len(t, sunsets, 1)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+}
+
+// TestStopConsumerWithLifetime tests that a consumer chain that is stopped before its maximum lifetime
+// elapses is no longer to be stopped at its sunset time
+func TestStopConsumerWithLifetime(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 10*time.Hour)
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	require.NoError(t, providerKeeper.StopAndPrepareForConsumerRemoval(ctx, CONSUMER_ID,
+		providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST, ""))
+
+	_, found := providerKeeper.GetConsumerLifetime(ctx, CONSUMER_ID)
+	require.False(t, found)
+	consumerIds, err := providerKeeper.GetConsumersToBeStopped(ctx, now.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+	// no reminder is emitted for a stopped chain
+	entries, err := providerKeeper.ReminderTimeQueue().GetAll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// TestSunsetConsumerRetried tests that stopping a consumer chain at its sunset time
+// is retried in the next block if it fails
+func TestSunsetConsumerRetried(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	setupLaunchedConsumerWithLifetime(t, ctx, providerKeeper, 10*time.Hour)
+
+	// the chain cannot be stopped since the unbonding period is not found
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Duration(0), fmt.Errorf("not found")).Times(1)
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	_, sunsets := getLifetimeEvents(ctx)
+	require.Empty(t, sunsets)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	consumerIds, err := providerKeeper.GetConsumersToBeStopped(ctx, now.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []string{CONSUMER_ID}, consumerIds.Ids)
+
+	// the chain is stopped in the next block
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	ctx = ctx.WithBlockTime(now.Add(10*time.Hour + time.Second)).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.BeginBlockSunsetConsumers(ctx))
+	_, sunsets = getLifetimeEvents(ctx)
+	require.Len(t, sunsets, 1)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	consumerIds, err = providerKeeper.GetConsumersToBeStopped(ctx, now.Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// SetOptInTime sets the time at which the validator with `providerAddr` opted in
+// (either voluntarily or automatically) to the consumer chain with `consumerId`
+func (k Keeper) SetOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, optInTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OptInTimeKey(consumerId, providerAddr), sdk.FormatTimeBytes(optInTime))
+}
+
+// GetOptInTime returns the time at which the validator with `providerAddr` opted in to the consumer chain
+// with `consumerId`, and false if no opt-in time is recorded (e.g., for the validators that opted in
+// before the opt-in times were recorded)
+func (k Keeper) GetOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OptInTimeKey(consumerId, providerAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	optInTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		k.Logger(ctx).Error("failed to parse opt-in time",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"error", err.Error(),
+		)
+		return time.Time{}, false
+	}
+	return optInTime, true
+}
+
+// DeleteOptInTime deletes the time at which the validator with `providerAddr` opted in
+// to the consumer chain with `consumerId`
+func (k Keeper) DeleteOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OptInTimeKey(consumerId, providerAddr))
+}
+
+// setOptedInWithTime opts in the validator with `providerAddr` to the consumer chain with `consumerId`
+// and records the opt-in time if the validator was not opted in yet
+func (k Keeper) setOptedInWithTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+		k.SetOptInTime(ctx, consumerId, providerAddr, ctx.BlockTime())
+	}
+	k.SetOptedIn(ctx, consumerId, providerAddr)
+}
+
+// getParticipationOptInTime returns the opt-in time of the validator with `providerAddr` on the consumer chain
+// with `consumerId` used to order its participations. Validators opted in without a recorded opt-in time are
+// considered to be opted in the longest, while validators that are not opted in yet (e.g., the validators that
+// are about to be opted in to a Top N chain) are considered to opt in at the current block time.
+func (k Keeper) getParticipationOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) time.Time {
+	if optInTime, found := k.GetOptInTime(ctx, consumerId, providerAddr); found {
+		return optInTime
+	}
+	if k.IsOptedIn(ctx, consumerId, providerAddr) {
+		return time.Time{}
+	}
+	return ctx.BlockTime()
+}
+
+// consumerParticipation is a launched consumer chain to which a validator is opted in, together with the time
+// the validator opted in to it (see getParticipationOptInTime)
+type consumerParticipation struct {
+	consumerId string
+	optInTime  time.Time
+}
+
+// before returns true if the validator opted in to the consumer chain of `p` before the one of `other`, with ties
+// broken by consumer id. The consumer ids are sequence numbers, hence shorter ids are smaller.
+func (p consumerParticipation) before(other consumerParticipation) bool {
+	if !p.optInTime.Equal(other.optInTime) {
+		return p.optInTime.Before(other.optInTime)
+	}
+	if len(p.consumerId) != len(other.consumerId) {
+		return len(p.consumerId) < len(other.consumerId)
+	}
+	return p.consumerId < other.consumerId
+}
+
+// ConsumerParticipations contains the launched consumer chains to which every validator is opted in. They are
+// computed once per block (see GetConsumerParticipations) and shared across the computations of the validator sets
+// of all the consumer chains, which record the validators opted in to them in the meantime (e.g., the validators
+// that are automatically opted in to a Top N chain).
+type ConsumerParticipations struct {
+	maxConsumerParticipation int64
+	// the participations of every validator, indexed by provider consensus address
+	participations map[string][]consumerParticipation
+}
+
+// GetConsumerParticipations returns the participations of the validators in the launched consumer chains.
+// If the max consumer participation is not set, no participations are retrieved, as they are not needed.
+func (k Keeper) GetConsumerParticipations(ctx sdk.Context) *ConsumerParticipations {
+	p := &ConsumerParticipations{
+		maxConsumerParticipation: k.GetMaxConsumerParticipation(ctx),
+		participations:           map[string][]consumerParticipation{},
+	}
+	if p.maxConsumerParticipation == 0 {
+		return p
+	}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		for _, providerAddr := range k.GetAllOptedIn(ctx, consumerId) {
+			p.record(consumerId, providerAddr, k.getParticipationOptInTime(ctx, consumerId, providerAddr))
+		}
+	}
+	return p
+}
+
+// record records that the validator with `providerAddr` is opted in to the consumer chain with `consumerId` since `optInTime`
+func (p *ConsumerParticipations) record(consumerId string, providerAddr types.ProviderConsAddress, optInTime time.Time) {
+	participations := p.participations[providerAddr.String()]
+	for i := range participations {
+		if participations[i].consumerId == consumerId {
+			participations[i].optInTime = optInTime
+			return
+		}
+	}
+	p.participations[providerAddr.String()] = append(participations, consumerParticipation{consumerId: consumerId, optInTime: optInTime})
+}
+
+// removeConsumer removes the participations in the consumer chain with `consumerId`, e.g., after its launch failed
+func (p *ConsumerParticipations) removeConsumer(consumerId string) {
+	if p == nil {
+		return
+	}
+	for addr, participations := range p.participations {
+		for i := range participations {
+			if participations[i].consumerId == consumerId {
+				p.participations[addr] = append(participations[:i:i], participations[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// recordConsumerParticipation records in `participations` that the validator with `providerAddr` is opted in
+// to the consumer chain with `consumerId`, unless the max consumer participation is not set
+func (k Keeper) recordConsumerParticipation(
+	ctx sdk.Context,
+	participations *ConsumerParticipations,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	if participations.maxConsumerParticipation == 0 {
+		return
+	}
+	participations.record(consumerId, providerAddr, k.getParticipationOptInTime(ctx, consumerId, providerAddr))
+}
+
+// IsWithinMaxConsumerParticipation returns true if the validator with `providerAddr` may be included in the
+// validator set of the consumer chain with `consumerId`, i.e., if the max consumer participation is not set or
+// if `consumerId` is among the first `MaxConsumerParticipation` launched consumer chains the validator opted in to
+func (k Keeper) IsWithinMaxConsumerParticipation(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) bool {
+	return k.isWithinMaxConsumerParticipation(ctx, k.GetConsumerParticipations(ctx), consumerId, providerAddr)
+}
+
+// isWithinMaxConsumerParticipation returns true if the validator with `providerAddr` may be included in the
+// validator set of the consumer chain with `consumerId` given the `participations` of the validators
+// (see IsWithinMaxConsumerParticipation). Note that `consumerId` is always considered, as the validator
+// either opted in to it or is about to be opted in to it.
+func (k Keeper) isWithinMaxConsumerParticipation(
+	ctx sdk.Context,
+	participations *ConsumerParticipations,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) bool {
+	if participations.maxConsumerParticipation == 0 {
+		return true
+	}
+
+	participation := consumerParticipation{consumerId: consumerId, optInTime: k.getParticipationOptInTime(ctx, consumerId, providerAddr)}
+	numBefore := int64(0)
+	for _, other := range participations.participations[providerAddr.String()] {
+		if other.consumerId != consumerId && other.before(participation) {
+			numBefore++
+		}
+	}
+	return numBefore < participations.maxConsumerParticipation
+}
+
+// GetMaxConsumerParticipationWarning returns a warning if the validator with `providerAddr` is opted in to more
+// active consumer chains than the max consumer participation allows, and an empty string otherwise
+func (k Keeper) GetMaxConsumerParticipationWarning(ctx sdk.Context, providerAddr types.ProviderConsAddress) string {
+	maxConsumerParticipation := k.GetMaxConsumerParticipation(ctx)
+	if maxConsumerParticipation == 0 {
+		return ""
+	}
+
+	numOptedIn := int64(0)
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		if k.IsOptedIn(ctx, consumerId, providerAddr) {
+			numOptedIn++
+		}
+	}
+	if numOptedIn <= maxConsumerParticipation {
+		return ""
+	}
+	return fmt.Sprintf("validator %s is opted in to %d consumer chains, but it is only included in the validator sets "+
+		"of the %d consumer chains it opted in to first (max consumer participation)",
+		providerAddr.String(), numOptedIn, maxConsumerParticipation)
+}
+
+// emitMaxConsumerParticipationEvictionEvent emits an event for the validator with `providerAddr` that is excluded
+// from the validator set of the consumer chain with `consumerId` due to the max consumer participation
+func (k Keeper) emitMaxConsumerParticipationEvictionEvent(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	k.Logger(ctx).Info("excluding validator from the consumer validator set due to the max consumer participation",
+		"consumerId", consumerId,
+		"providerAddr", providerAddr.String(),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerValidatorEvicted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+			sdk.NewAttribute(types.AttributeOptInTime, k.getParticipationOptInTime(ctx, consumerId, providerAddr).String()),
+			sdk.NewAttribute(types.AttributeEvictionReason, types.EvictionReasonMaxConsumerParticipation),
+		),
+	)
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestOptInTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// the opt-in time is recorded when the validator opts in
+	optInTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(optInTime)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, ""))
+	actualOptInTime, found := providerKeeper.GetOptInTime(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, found)
+	require.Equal(t, optInTime, actualOptInTime)
+
+	// opting in again retains the opt-in time
+	ctx = ctx.WithBlockTime(optInTime.Add(time.Hour))
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, ""))
+	actualOptInTime, found = providerKeeper.GetOptInTime(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, found)
+	require.Equal(t, optInTime, actualOptInTime)
+
+	providerKeeper.DeleteOptInTime(ctx, CONSUMER_ID, providerAddr)
+	_, found = providerKeeper.GetOptInTime(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+}
+
+func TestIsWithinMaxConsumerParticipation(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	optInTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(optInTime.Add(24 * time.Hour))
+
+	// launch 11 consumer chains, such that the consumer ids are not ordered lexicographically
+	consumerIds := make([]string, 11)
+	for i := range consumerIds {
+		consumerIds[i] = providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerIds[i], providertypes.CONSUMER_PHASE_LAUNCHED)
+	}
+
+	// the validator opts in to consumer chains 10 and 2 at the same time, to consumer chain 1 before,
+	// and to consumer chain 0 before the opt-in times were recorded
+	providerKeeper.SetOptedIn(ctx, consumerIds[0], providerAddr)
+	providerKeeper.SetOptedIn(ctx, consumerIds[1], providerAddr)
+	providerKeeper.SetOptInTime(ctx, consumerIds[1], providerAddr, optInTime)
+	providerKeeper.SetOptedIn(ctx, consumerIds[10], providerAddr)
+	providerKeeper.SetOptInTime(ctx, consumerIds[10], providerAddr, optInTime.Add(time.Hour))
+	providerKeeper.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	providerKeeper.SetOptInTime(ctx, consumerIds[2], providerAddr, optInTime.Add(time.Hour))
+
+	// the validator is not opted in to a stopped consumer chain
+	providerKeeper.SetConsumerPhase(ctx, consumerIds[3], providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.SetOptedIn(ctx, consumerIds[3], providerAddr)
+	providerKeeper.SetOptInTime(ctx, consumerIds[3], providerAddr, optInTime.Add(-time.Hour))
+
+	testCases := []struct {
+		maxConsumerParticipation int64
+		// the consumer chains the validator can be included in (among the ones it is opted in to)
+		within []string
+	}{
+		{0, []string{consumerIds[0], consumerIds[1], consumerIds[2], consumerIds[10]}},
+		{1, []string{consumerIds[0]}},
+		{2, []string{consumerIds[0], consumerIds[1]}},
+		{3, []string{consumerIds[0], consumerIds[1], consumerIds[2]}},
+		{4, []string{consumerIds[0], consumerIds[1], consumerIds[2], consumerIds[10]}},
+	}
+	for _, tc := range testCases {
+		params := providerKeeper.GetParams(ctx)
+		params.MaxConsumerParticipation = tc.maxConsumerParticipation
+		providerKeeper.SetParams(ctx, params)
+
+		within := []string{}
+		for _, consumerId := range []string{consumerIds[0], consumerIds[1], consumerIds[2], consumerIds[10]} {
+			if providerKeeper.IsWithinMaxConsumerParticipation(ctx, consumerId, providerAddr) {
+				within = append(within, consumerId)
+			}
+		}
+		require.Equal(t, tc.within, within, "max consumer participation: %d", tc.maxConsumerParticipation)
+	}
+
+	// a consumer chain the validator is about to be opted in to comes last
+	params := providerKeeper.GetParams(ctx)
+	params.MaxConsumerParticipation = 4
+	providerKeeper.SetParams(ctx, params)
+	require.False(t, providerKeeper.IsWithinMaxConsumerParticipation(ctx, consumerIds[4], providerAddr))
+	params.MaxConsumerParticipation = 5
+	providerKeeper.SetParams(ctx, params)
+	require.True(t, providerKeeper.IsWithinMaxConsumerParticipation(ctx, consumerIds[4], providerAddr))
+}
+
+func TestGetMaxConsumerParticipationWarning(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	for i := 0; i < 3; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_REGISTERED)
+		providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+	}
+
+	// no warning without a max consumer participation
+	require.Empty(t, providerKeeper.GetMaxConsumerParticipationWarning(ctx, providerAddr))
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxConsumerParticipation = 3
+	providerKeeper.SetParams(ctx, params)
+	require.Empty(t, providerKeeper.GetMaxConsumerParticipationWarning(ctx, providerAddr))
+
+	params.MaxConsumerParticipation = 2
+	providerKeeper.SetParams(ctx, params)
+	require.Contains(t, providerKeeper.GetMaxConsumerParticipationWarning(ctx, providerAddr), "opted in to 3 consumer chains")
+}
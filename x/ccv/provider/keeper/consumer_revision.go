@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"strings"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// IsRevisionOfChainId returns true if `chainId` and `otherChainId` are distinct chain ids in the revision format
+// (i.e., `{chain-name}-{revision-number}`) that differ only in their revision numbers, e.g., `foo-1` and `foo-2`
+func IsRevisionOfChainId(chainId, otherChainId string) bool {
+	if chainId == otherChainId ||
+		!ibcclienttypes.IsRevisionFormat(chainId) ||
+		!ibcclienttypes.IsRevisionFormat(otherChainId) {
+		return false
+	}
+	return chainId[:strings.LastIndex(chainId, "-")] == otherChainId[:strings.LastIndex(otherChainId, "-")]
+}
+
+// SyncConsumerChainIdWithClient updates the chain id of the consumer chain with `consumerId` after the consumer
+// client was upgraded to a newer revision of the consumer chain (e.g., from `foo-1` to `foo-2`) and returns the
+// up-to-date chain id of the consumer chain.
+//
+// The chain id of a client can only change through a client upgrade, which is verified by the IBC client module
+// against the upgraded client committed by the consumer chain. Thus, the stored consumer chain id is updated
+// lazily, i.e., whenever evidence or packets from the consumer chain are handled, if the chain id of the consumer
+// client is a newer revision of the stored chain id. Any other difference is left as is.
+//
+// Note that the evidence of the previous revisions remains valid (see GetEquivocationEvidenceMinHeightOfChainId).
+func (k Keeper) SyncConsumerChainIdWithClient(ctx sdk.Context, consumerId string) (string, error) {
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return "", err
+	}
+
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return chainId, nil
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return chainId, nil
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return chainId, nil
+	}
+
+	clientChainId := tmClientState.ChainId
+	if !IsRevisionOfChainId(chainId, clientChainId) ||
+		ibcclienttypes.ParseChainID(clientChainId) <= ibcclienttypes.ParseChainID(chainId) {
+		return chainId, nil
+	}
+
+	k.SetConsumerChainId(ctx, consumerId, clientChainId)
+	// the minimum height of valid evidence applies to the earliest revision,
+	// i.e., the revision of the consumer chain at launch or at its last hard fork
+	if _, found := k.GetEquivocationEvidenceMinChainId(ctx, consumerId); !found {
+		k.SetEquivocationEvidenceMinChainId(ctx, consumerId, chainId)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerRevisionUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, clientChainId),
+			sdk.NewAttribute(types.AttributePreviousConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeConsumerClientId, clientId),
+		),
+	)
+
+	k.Logger(ctx).Info("consumer chain id updated after a client upgrade",
+		"consumerId", consumerId,
+		"chainId", clientChainId,
+		"previousChainId", chainId,
+		"clientId", clientId,
+	)
+
+	return clientChainId, nil
+}
+
+// GetEquivocationEvidenceMinHeightOfChainId returns the minimum height of valid equivocation evidence with chain id
+// `evidenceChainId` on the consumer chain with `consumerId`. The evidence of any revision of the consumer chain from the
+// earliest one (i.e., the revision at launch or at the last hard fork) up to the current one is valid. The minimum height
+// only applies to the earliest revision, as the heights of the newer revisions may restart. An error is returned if the
+// evidence is of any other chain.
+func (k Keeper) GetEquivocationEvidenceMinHeightOfChainId(ctx sdk.Context, consumerId, evidenceChainId string) (uint64, error) {
+	chainId, err := k.SyncConsumerChainIdWithClient(ctx, consumerId)
+	if err != nil {
+		return 0, err
+	}
+	minChainId, found := k.GetEquivocationEvidenceMinChainId(ctx, consumerId)
+	if !found {
+		minChainId = chainId
+	}
+
+	if evidenceChainId == minChainId {
+		return k.GetEquivocationEvidenceMinHeight(ctx, consumerId), nil
+	}
+	if IsRevisionOfChainId(minChainId, evidenceChainId) &&
+		ibcclienttypes.ParseChainID(evidenceChainId) > ibcclienttypes.ParseChainID(minChainId) &&
+		ibcclienttypes.ParseChainID(evidenceChainId) <= ibcclienttypes.ParseChainID(chainId) {
+		return 0, nil
+	}
+	return 0, errorsmod.Wrapf(ccvtypes.ErrInvalidDoubleVotingEvidence,
+		"evidence chain id %s is not a revision of consumer chain %s (consumerId: %s) between %s and %s",
+		evidenceChainId, chainId, consumerId, minChainId, chainId)
+}
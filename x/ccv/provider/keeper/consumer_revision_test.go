@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestIsRevisionOfChainId(t *testing.T) {
+	testCases := []struct {
+		chainId      string
+		otherChainId string
+		expected     bool
+	}{
+		{"foo-1", "foo-2", true},
+		{"foo-2", "foo-1", true},
+		{"foo-bar-1", "foo-bar-10", true},
+		{"foo-1", "foo-1", false},
+		{"foo-1", "bar-2", false},
+		{"foo-1", "foo-bar-2", false},
+		{"foo", "foo-1", false},
+		{"foo-0", "foo-1", false},
+		{"foo1", "foo2", false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, providerkeeper.IsRevisionOfChainId(tc.chainId, tc.otherChainId),
+			"chainId(%s), otherChainId(%s)", tc.chainId, tc.otherChainId)
+	}
+}
+
+// TestSyncConsumerChainIdWithClient tests that the chain id of a consumer chain is updated only if
+// the consumer client was upgraded to a newer revision of the consumer chain
+func TestSyncConsumerChainIdWithClient(t *testing.T) {
+	consumerId := "0"
+	clientId := "07-tendermint-0"
+
+	testCases := []struct {
+		name            string
+		clientChainId   string
+		expectedChainId string
+	}{
+		{"client not upgraded", "foo-2", "foo-2"},
+		{"client upgraded to a newer revision", "foo-3", "foo-3"},
+		{"client of an older revision", "foo-1", "foo-2"},
+		{"client of a different chain", "bar-3", "foo-2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			providerKeeper.SetConsumerChainId(ctx, consumerId, "foo-2")
+			providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
+			providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, 100)
+
+			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, clientId).
+				Return(&ibctmtypes.ClientState{ChainId: tc.clientChainId}, true).Times(1)
+
+			chainId, err := providerKeeper.SyncConsumerChainIdWithClient(ctx, consumerId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedChainId, chainId)
+
+			storedChainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedChainId, storedChainId)
+
+			// the min height of the previous revision is kept
+			upgraded := tc.expectedChainId != "foo-2"
+			require.Equal(t, uint64(100), providerKeeper.GetEquivocationEvidenceMinHeight(ctx, consumerId))
+			minChainId, found := providerKeeper.GetEquivocationEvidenceMinChainId(ctx, consumerId)
+			require.Equal(t, upgraded, found)
+			if upgraded {
+				require.Equal(t, "foo-2", minChainId)
+			}
+			found = false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeConsumerRevisionUpgrade {
+					found = true
+				}
+			}
+			require.Equal(t, upgraded, found)
+		})
+	}
+
+	// the chain id is returned as is if the consumer client does not exist
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "foo-1")
+	chainId, err := providerKeeper.SyncConsumerChainIdWithClient(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "foo-1", chainId)
+
+	// an error is returned for an unknown consumer chain
+	_, err = providerKeeper.SyncConsumerChainIdWithClient(ctx, "1")
+	require.Error(t, err)
+}
+
+// TestGetEquivocationEvidenceMinHeightOfChainId tests that the evidence of any revision of the consumer chain
+// from the earliest one up to the current one is accepted, and that the min height only applies to the earliest one
+func TestGetEquivocationEvidenceMinHeightOfChainId(t *testing.T) {
+	consumerId := "0"
+	clientId := "07-tendermint-0"
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "foo-2")
+	providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
+	providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, 100)
+
+	// the consumer client is upgraded twice, from `foo-2` to `foo-4`
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), clientId).
+		Return(&ibctmtypes.ClientState{ChainId: "foo-3"}, true).Times(1)
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), clientId).
+		Return(&ibctmtypes.ClientState{ChainId: "foo-4"}, true).AnyTimes()
+	_, err := providerKeeper.SyncConsumerChainIdWithClient(ctx, consumerId)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		evidenceChainId string
+		expMinHeight    uint64
+		expErr          bool
+	}{
+		{"foo-1", 0, true},
+		{"foo-2", 100, false},
+		{"foo-3", 0, false},
+		{"foo-4", 0, false},
+		{"foo-5", 0, true},
+		{"bar-3", 0, true},
+	}
+
+	for _, tc := range testCases {
+		minHeight, err := providerKeeper.GetEquivocationEvidenceMinHeightOfChainId(ctx, consumerId, tc.evidenceChainId)
+		if tc.expErr {
+			require.Error(t, err, "evidenceChainId(%s)", tc.evidenceChainId)
+		} else {
+			require.NoError(t, err, "evidenceChainId(%s)", tc.evidenceChainId)
+			require.Equal(t, tc.expMinHeight, minHeight, "evidenceChainId(%s)", tc.evidenceChainId)
+		}
+	}
+
+	// the evidence of the previous revisions is not valid anymore after a hard fork
+	providerKeeper.DeleteEquivocationEvidenceMinChainId(ctx, consumerId)
+	_, err = providerKeeper.GetEquivocationEvidenceMinHeightOfChainId(ctx, consumerId, "foo-3")
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// ExportConsumerState returns the complete provider-side state stored for the consumer chain with `consumerId`.
+// For deleted consumer chains, only the retained state is returned, i.e., the per-validator state
+// (e.g., the key assignments) is only returned until it is removed by EndBlockCleanupDeletedConsumers.
+func (k Keeper) ExportConsumerState(ctx sdk.Context, consumerId string) (types.ConsumerStateExport, error) {
+	chain, err := k.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: consumerId})
+	if err != nil {
+		return types.ConsumerStateExport{}, err
+	}
+
+	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+	channelId, _ := k.GetConsumerIdToChannelId(ctx, consumerId)
+
+	var removalTime *time.Time
+	if t, err := k.GetConsumerRemovalTime(ctx, consumerId); err == nil {
+		removalTime = &t
+	}
+
+	var lastPacketReceivedTime *time.Time
+	if t, found := k.GetLastPacketReceivedTime(ctx, consumerId); found {
+		lastPacketReceivedTime = &t
+	}
+
+	var timeoutPeriods *types.ConsumerTimeoutPeriods
+	if periods, found := k.GetConsumerTimeoutPeriods(ctx, consumerId); found {
+		timeoutPeriods = &periods
+	}
+
+	initChainHeight, _ := k.GetInitChainHeight(ctx, consumerId)
+	genesisHash, _ := k.GetConsumerGenesisHash(ctx, consumerId)
+	minPowerInTopN, _ := k.GetMinimumPowerInTopN(ctx, consumerId)
+	rewardChannelId, _ := k.GetConsumerRewardChannel(ctx, consumerId)
+	providerFeePoolAddr, _ := k.GetConsumerProviderFeePoolAddr(ctx, consumerId)
+	allowlistedRewardDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return types.ConsumerStateExport{}, err
+	}
+
+	consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return types.ConsumerStateExport{}, err
+	}
+	validators := []types.ConsumerStateExportValidator{}
+	for _, val := range consumerValSet {
+		providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+		validators = append(validators, types.ConsumerStateExportValidator{
+			ProviderAddress: providerAddr.String(),
+			ConsumerKey:     val.PublicKey,
+			Power:           val.Power,
+			JoinHeight:      val.JoinHeight,
+		})
+	}
+
+	keyAssignments := []types.ConsumerStateExportKeyAssignment{}
+	for _, assignment := range k.GetAllValidatorConsumerPubKeys(ctx, &consumerId) {
+		consumerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(*assignment.ConsumerKey)
+		if err != nil {
+			return types.ConsumerStateExport{}, err
+		}
+		providerAddr := types.NewProviderConsAddress(assignment.ProviderAddr)
+		keyAssignments = append(keyAssignments, types.ConsumerStateExportKeyAssignment{
+			ProviderAddress: providerAddr.String(),
+			ConsumerAddress: consumerAddr.String(),
+			ConsumerKey:     assignment.ConsumerKey,
+		})
+	}
+
+	commissionRates := []types.ConsumerStateExportCommissionRate{}
+	for _, providerAddr := range k.GetAllCommissionRateValidators(ctx, consumerId) {
+		rate, found := k.GetConsumerCommissionRate(ctx, consumerId, providerAddr)
+		if !found {
+			continue
+		}
+		commissionRates = append(commissionRates, types.ConsumerStateExportCommissionRate{
+			ProviderAddress: providerAddr.String(),
+			Rate:            rate,
+		})
+	}
+
+	return types.ConsumerStateExport{
+		Chain:                         *chain,
+		ClientId:                      clientId,
+		ChannelId:                     channelId,
+		RemovalTime:                   removalTime,
+		LastPacketReceivedTime:        lastPacketReceivedTime,
+		Dormant:                       k.IsConsumerDormant(ctx, consumerId),
+		Verified:                      k.IsConsumerVerified(ctx, consumerId),
+		EvidenceSubmissionPaused:      k.IsConsumerEvidenceSubmissionPaused(ctx, consumerId),
+		TimeoutPeriods:                timeoutPeriods,
+		InitChainHeight:               initChainHeight,
+		GenesisHash:                   genesisHash,
+		MinimumPowerInTopN:            minPowerInTopN,
+		EquivocationEvidenceMinHeight: k.GetEquivocationEvidenceMinHeight(ctx, consumerId),
+		RewardChannelId:               rewardChannelId,
+		AllowlistedRewardDenoms:       allowlistedRewardDenoms,
+		ProviderFeePoolAddress:        providerFeePoolAddr,
+		PendingVscPackets:             uint64(len(k.GetPendingVSCPackets(ctx, consumerId))),
+		SlashAcks:                     k.GetSlashAcks(ctx, consumerId),
+		Validators:                    validators,
+		OptedInValidators:             providerConsAddressesToStrings(k.GetAllOptedIn(ctx, consumerId)),
+		KeyAssignments:                keyAssignments,
+		CommissionRates:               commissionRates,
+		Allowlist:                     providerConsAddressesToStrings(k.GetAllowList(ctx, consumerId)),
+		Denylist:                      providerConsAddressesToStrings(k.GetDenyList(ctx, consumerId)),
+		CleanupPending:                k.IsConsumerToBeCleanedUp(ctx, consumerId),
+	}, nil
+}
+
+// providerConsAddressesToStrings returns the bech32 representation of the given provider consensus addresses
+func providerConsAddressesToStrings(addrs []types.ProviderConsAddress) []string {
+	strs := []string{}
+	for _, addr := range addrs {
+		strs = append(strs, addr.String())
+	}
+	return strs
+}
@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+var updateGoldenFiles = flag.Bool("update-golden", false, "update the golden files of the consumer state exports")
+
+// requireGoldenConsumerState checks that the JSON representation of the exported consumer state
+// matches the given golden file in the testdata directory
+func requireGoldenConsumerState(t *testing.T, state providertypes.ConsumerStateExport, goldenFile string) {
+	t.Helper()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(&providertypes.QueryConsumerDumpResponse{State: state})
+	require.NoError(t, err)
+	var indented bytes.Buffer
+	require.NoError(t, json.Indent(&indented, bz, "", "  "))
+	indented.WriteString("\n")
+
+	path := filepath.Join("testdata", goldenFile)
+	if *updateGoldenFiles {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, indented.Bytes(), 0o600))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), indented.String())
+}
+
+// seedConsumerState stores every piece of provider-side state of a launched consumer chain
+func seedConsumerState(t *testing.T, ctx sdk.Context, providerKeeper providerkeeper.Keeper, consumerId string, blockTime time.Time) {
+	t.Helper()
+
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "consumerchain")
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetConsumerMetadata(ctx, consumerId, testkeeper.GetTestConsumerMetadata()))
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = blockTime.Add(-time.Hour)
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	powerShapingParameters.ValidatorSetCap = 3
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters))
+	providerKeeper.SetConsumerPowerShapingAdmin(ctx, consumerId, "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s")
+	require.NoError(t, providerKeeper.SetConsumerLifetime(ctx, consumerId, providertypes.ConsumerLifetime{
+		LaunchTime: blockTime.Add(-time.Hour),
+		SunsetTime: blockTime.Add(24 * time.Hour),
+	}))
+
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "07-tendermint-0")
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channel-0")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", consumerId)
+	providerKeeper.SetInitChainHeight(ctx, consumerId, 10)
+	providerKeeper.SetConsumerGenesisHash(ctx, consumerId, []byte("consumer genesis hash"))
+	providerKeeper.SetMinimumPowerInTopN(ctx, consumerId, 50)
+	providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, 12)
+	providerKeeper.SetConsumerRewardChannel(ctx, consumerId, "channel-1")
+	require.NoError(t, providerKeeper.SetAllowlistedRewardDenoms(ctx, consumerId, []string{"untrn"}))
+	providerKeeper.SetConsumerProviderFeePoolAddr(ctx, consumerId, "cosmos1jv65s3grqf6v6jl3dp4t6c9t9rk99cd88lyufl")
+	providerKeeper.SetLastPacketReceivedTime(ctx, consumerId, blockTime.Add(-time.Minute))
+	providerKeeper.SetConsumerVerified(ctx, consumerId)
+	providerKeeper.SetConsumerEvidenceSubmissionPaused(ctx, consumerId)
+	require.NoError(t, providerKeeper.SetConsumerTimeoutPeriods(ctx, consumerId, providertypes.ConsumerTimeoutPeriods{
+		CcvTimeoutPeriod:      2 * time.Hour,
+		TransferTimeoutPeriod: time.Hour,
+	}))
+	providerKeeper.AppendPendingVSCPackets(ctx, consumerId,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1},
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2},
+	)
+
+	var consumerValSet []providertypes.ConsensusValidator
+	var slashAcks []string
+	for i := 0; i < 3; i++ {
+		identity := crypto.NewCryptoIdentityFromIntSeed(1000 + i)
+		providerAddr := identity.ProviderConsAddress()
+		// the validators assign a consumer key that differs from their provider key
+		consumerKey := crypto.NewCryptoIdentityFromIntSeed(2000 + i).TMProtoCryptoPublicKey()
+		providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerKey)
+		providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+		require.NoError(t, providerKeeper.SetConsumerCommissionRate(ctx, consumerId, providerAddr, math.LegacyNewDecWithPrec(int64(i+1), 2)))
+		consumerValSet = append(consumerValSet, providertypes.ConsensusValidator{
+			ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+			Power:            int64(100 * (i + 1)),
+			PublicKey:        &consumerKey,
+			JoinHeight:       10,
+		})
+		slashAcks = append(slashAcks, providerAddr.String())
+	}
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, consumerValSet))
+	providerKeeper.SetSlashAcks(ctx, consumerId, slashAcks[:1])
+	providerKeeper.SetAllowlist(ctx, consumerId, crypto.NewCryptoIdentityFromIntSeed(1000).ProviderConsAddress())
+	providerKeeper.SetDenylist(ctx, consumerId, crypto.NewCryptoIdentityFromIntSeed(1003).ProviderConsAddress())
+}
+
+// TestExportConsumerState tests that the exported state of a consumer chain contains everything
+// stored for the chain, both while it is launched and after it is deleted
+func TestExportConsumerState(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	blockTime := time.Date(2024, time.September, 26, 6, 55, 14, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(20)
+
+	// the chain does not exist
+	_, err := providerKeeper.ExportConsumerState(ctx, CONSUMER_ID)
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerDump(ctx, &providertypes.QueryConsumerDumpRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+
+	seedConsumerState(t, ctx, providerKeeper, CONSUMER_ID, blockTime)
+	state, err := providerKeeper.ExportConsumerState(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED.String(), state.Chain.Phase)
+	require.Len(t, state.Validators, 3)
+	require.Len(t, state.KeyAssignments, 3)
+	require.Equal(t, uint64(2), state.PendingVscPackets)
+	requireGoldenConsumerState(t, state, "consumer_dump_launched.golden.json")
+
+	res, err := providerKeeper.QueryConsumerDump(ctx, &providertypes.QueryConsumerDumpRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, state, res.State)
+
+	// delete the chain, i.e., only the metadata, the parameters and the per-validator state that is
+	// not yet cleaned up are retained
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.CLOSED}, true,
+	).Times(1)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.DeleteConsumerLifetime(ctx, CONSUMER_ID)
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, CONSUMER_ID))
+
+	state, err = providerKeeper.ExportConsumerState(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED.String(), state.Chain.Phase)
+	require.True(t, state.CleanupPending)
+	requireGoldenConsumerState(t, state, "consumer_dump_deleted.golden.json")
+
+	// once the per-validator state is cleaned up, it is no longer exported
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	state, err = providerKeeper.ExportConsumerState(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.False(t, state.CleanupPending)
+	require.Empty(t, state.Validators)
+	require.Empty(t, state.KeyAssignments)
+	require.Empty(t, state.OptedInValidators)
+	require.Empty(t, state.CommissionRates)
+	require.Empty(t, state.Allowlist)
+	require.Empty(t, state.Denylist)
+}
+
+// TestQueryConsumerDumpUnknownChain tests that dumping an unknown consumer chain fails
+func TestQueryConsumerDumpUnknownChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerDump(ctx, nil)
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerDump(ctx, &providertypes.QueryConsumerDumpRequest{ConsumerId: "1"})
+	require.Error(t, err)
+
+	// the dump of a registered chain only contains its metadata
+	providerKeeper.SetConsumerChainId(ctx, "1", "consumerchain")
+	providerKeeper.SetConsumerOwnerAddress(ctx, "1", "owner")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_REGISTERED)
+	require.NoError(t, providerKeeper.SetConsumerMetadata(ctx, "1", testkeeper.GetTestConsumerMetadata()))
+	res, err := providerKeeper.QueryConsumerDump(ctx, &providertypes.QueryConsumerDumpRequest{ConsumerId: "1"})
+	require.NoError(t, err)
+	require.Empty(t, res.State.ClientId)
+	require.Empty(t, res.State.Validators)
+	require.Zero(t, res.State.PendingVscPackets)
+	require.Equal(t, clienttypes.Height{}, res.State.Chain.InitParams.InitialHeight)
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -30,6 +33,32 @@ func (k Keeper) GetConsumerRewardsPoolAddressStr(ctx sdk.Context) string {
 		ctx, types.ConsumerRewardsPool).GetAddress().String()
 }
 
+// SetConsumerProviderFeePoolAddr sets the provider fee pool address last communicated
+// to the consumer chain with `consumerId`, i.e., either during the CCV channel handshake
+// or in a VSC packet
+func (k Keeper) SetConsumerProviderFeePoolAddr(ctx sdk.Context, consumerId, addr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToProviderFeePoolAddrKey(consumerId), []byte(addr))
+}
+
+// GetConsumerProviderFeePoolAddr returns the provider fee pool address last communicated
+// to the consumer chain with `consumerId`
+func (k Keeper) GetConsumerProviderFeePoolAddr(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToProviderFeePoolAddrKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeleteConsumerProviderFeePoolAddr deletes the provider fee pool address last communicated
+// to the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerProviderFeePoolAddr(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToProviderFeePoolAddrKey(consumerId))
+}
+
 func (k Keeper) SetConsumerRewardDenom(
 	ctx sdk.Context,
 	denom string,
@@ -137,6 +166,170 @@ func (k Keeper) DeleteConsumerRewardsAllocationByDenom(ctx sdk.Context, consumer
 	store.Delete(types.ConsumerRewardsAllocationByDenomKey(consumerId, denom))
 }
 
+// AttributeRewardsToValidators attributes the shares `amounts` of ICS rewards in `denom`, which are generated by the
+// consumer validators with consensus addresses `consumerAddrs` according to the per-validator breakdown of a reward memo,
+// to the corresponding provider validators in the consumer rewards allocation `alloc`. The consumer addresses are
+// mapped to provider validators through the key-assignment index. The shares of consumer addresses that do not map
+// to a validator of the consumer chain are not attributed, i.e., they are distributed pro rata.
+// It returns the attributed rewards.
+func (k Keeper) AttributeRewardsToValidators(
+	ctx sdk.Context,
+	consumerId string,
+	alloc *types.ConsumerRewardsAllocation,
+	denom string,
+	consumerAddrs []sdk.ConsAddress,
+	amounts []math.Int,
+) sdk.DecCoins {
+	attributed := sdk.DecCoins{}
+	for i, consumerAddr := range consumerAddrs {
+		providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, types.NewConsumerConsAddress(consumerAddr))
+		if _, found := k.GetConsumerValidator(ctx, consumerId, providerAddr); !found {
+			k.Logger(ctx).Info(
+				"ICS rewards attributed to a consumer address that is not a consumer validator are distributed pro rata",
+				"consumerId", consumerId,
+				"consumerAddr", consumerAddr.String(),
+				"denom", denom,
+				"amount", amounts[i].String(),
+			)
+			continue
+		}
+
+		rewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, math.LegacyNewDecFromInt(amounts[i])))
+		addValidatorRewards(alloc, providerAddr, rewards)
+		attributed = attributed.Add(rewards...)
+	}
+	return attributed
+}
+
+// addValidatorRewards adds `rewards` to the rewards attributed to the validator with `providerAddr` in `alloc`
+func addValidatorRewards(alloc *types.ConsumerRewardsAllocation, providerAddr types.ProviderConsAddress, rewards sdk.DecCoins) {
+	for i, validatorRewards := range alloc.ValidatorRewards {
+		if bytes.Equal(validatorRewards.ProviderConsAddr, providerAddr.ToSdkConsAddr()) {
+			alloc.ValidatorRewards[i].Rewards = validatorRewards.Rewards.Add(rewards...)
+			return
+		}
+	}
+	alloc.ValidatorRewards = append(alloc.ValidatorRewards, types.ValidatorRewardsAllocation{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Rewards:          rewards,
+	})
+}
+
+// SetConsumerRewardsPaused pauses the reward distribution of the consumer chain with `consumerId`
+func (k Keeper) SetConsumerRewardsPaused(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RewardsPausedConsumerKey(consumerId), []byte{})
+}
+
+// IsConsumerRewardsPaused returns whether the reward distribution of the consumer chain with `consumerId` is paused
+func (k Keeper) IsConsumerRewardsPaused(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.RewardsPausedConsumerKey(consumerId))
+}
+
+// DeleteConsumerRewardsPaused removes the rewards pause of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerRewardsPaused(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RewardsPausedConsumerKey(consumerId))
+}
+
+// GetConsumerHeldBackRewards returns the rewards of the consumer chain with `consumerId` that were held back
+// while its reward distribution was paused
+func (k Keeper) GetConsumerHeldBackRewards(ctx sdk.Context, consumerId string) (sdk.DecCoins, error) {
+	heldBack, err := k.getConsumerHeldBackAllocation(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	return heldBack.Rewards, nil
+}
+
+// getConsumerHeldBackAllocation returns the rewards allocation of the consumer chain with `consumerId` that was held
+// back while its reward distribution was paused, i.e., the held back rewards in all denoms together with the
+// rewards attributed to specific validators
+func (k Keeper) getConsumerHeldBackAllocation(ctx sdk.Context, consumerId string) (types.ConsumerRewardsAllocation, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToHeldBackRewardsKey(consumerId))
+	if bz == nil {
+		return types.ConsumerRewardsAllocation{}, nil
+	}
+
+	var heldBack types.ConsumerRewardsAllocation
+	if err := heldBack.Unmarshal(bz); err != nil {
+		return types.ConsumerRewardsAllocation{}, fmt.Errorf("failed to unmarshal held back rewards for consumer id (%s): %w", consumerId, err)
+	}
+	return heldBack, nil
+}
+
+// setConsumerHeldBackAllocation stores the held back rewards allocation of the consumer chain with `consumerId`
+func (k Keeper) setConsumerHeldBackAllocation(ctx sdk.Context, consumerId string, heldBack types.ConsumerRewardsAllocation) error {
+	store := ctx.KVStore(k.storeKey)
+	if heldBack.Rewards.IsZero() {
+		store.Delete(types.ConsumerIdToHeldBackRewardsKey(consumerId))
+		return nil
+	}
+	bz, err := heldBack.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal held back rewards for consumer id (%s): %w", consumerId, err)
+	}
+	store.Set(types.ConsumerIdToHeldBackRewardsKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerHeldBackRewards deletes the held back rewards of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerHeldBackRewards(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToHeldBackRewardsKey(consumerId))
+}
+
+// HoldBackConsumerRewards adds the rewards allocation `alloc` to the held back rewards of the consumer chain
+// with `consumerId`, keeping the rewards attributed to specific validators
+func (k Keeper) HoldBackConsumerRewards(ctx sdk.Context, consumerId string, alloc types.ConsumerRewardsAllocation) error {
+	heldBack, err := k.getConsumerHeldBackAllocation(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	heldBack.Rewards = heldBack.Rewards.Add(alloc.Rewards...)
+	for _, validatorRewards := range alloc.ValidatorRewards {
+		addValidatorRewards(&heldBack, types.NewProviderConsAddress(validatorRewards.ProviderConsAddr), validatorRewards.Rewards)
+	}
+	return k.setConsumerHeldBackAllocation(ctx, consumerId, heldBack)
+}
+
+// UnpauseConsumerRewards resumes the reward distribution of the consumer chain with `consumerId` and
+// releases its held back rewards, i.e., they are distributed together with the next allocation.
+// The rewards attributed to specific validators remain attributed to those validators.
+func (k Keeper) UnpauseConsumerRewards(ctx sdk.Context, consumerId string) error {
+	k.DeleteConsumerRewardsPaused(ctx, consumerId)
+
+	heldBack, err := k.getConsumerHeldBackAllocation(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	for _, reward := range heldBack.Rewards {
+		rewardsAllocation, err := k.GetConsumerRewardsAllocationByDenom(ctx, consumerId, reward.Denom)
+		if err != nil {
+			return err
+		}
+		rewardsAllocation.Rewards = rewardsAllocation.Rewards.Add(reward)
+		for _, validatorRewards := range heldBack.ValidatorRewards {
+			amount := validatorRewards.Rewards.AmountOf(reward.Denom)
+			if !amount.IsPositive() {
+				continue
+			}
+			addValidatorRewards(
+				&rewardsAllocation,
+				types.NewProviderConsAddress(validatorRewards.ProviderConsAddr),
+				sdk.NewDecCoins(sdk.NewDecCoinFromDec(reward.Denom, amount)),
+			)
+		}
+		if err := k.SetConsumerRewardsAllocationByDenom(ctx, consumerId, reward.Denom, rewardsAllocation); err != nil {
+			return err
+		}
+	}
+	k.DeleteConsumerHeldBackRewards(ctx, consumerId)
+	return nil
+}
+
 // AllocateConsumerRewards allocates the given rewards to provider consumer chain with the given consumer id
 func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, alloc types.ConsumerRewardsAllocation) (types.ConsumerRewardsAllocation, error) {
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
@@ -171,6 +364,7 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 
 		// set the consumer allocation to the remaining reward decimals
 		alloc.Rewards = rewardsChange
+		alloc.ValidatorRewards = nil
 
 		return alloc, nil
 	}
@@ -210,11 +404,34 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 		return types.ConsumerRewardsAllocation{}, err
 	}
 
-	// allocate tokens to consumer validators
+	// allocate the rewards attributed to specific validators directly to those validators
+	attributedRewards, err := k.allocateAttributedRewards(ctx, consumerId, alloc.ValidatorRewards, voteMultiplier)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"fail to allocate attributed ICS rewards to validators",
+			"consumerId", consumerId,
+			"chainId", chainId,
+			"error", err.Error(),
+		)
+		return types.ConsumerRewardsAllocation{}, err
+	}
+	proRataRewards, hasNeg := sdk.NewDecCoinsFromCoins(validatorsRewardsTrunc...).SafeSub(attributedRewards)
+	if hasNeg {
+		err := fmt.Errorf("attributed rewards (%s) exceed the validator rewards (%s)", attributedRewards, validatorsRewardsTrunc)
+		k.Logger(ctx).Error(
+			"fail to allocate ICS rewards to validators",
+			"consumerId", consumerId,
+			"chainId", chainId,
+			"error", err.Error(),
+		)
+		return types.ConsumerRewardsAllocation{}, err
+	}
+
+	// allocate the remaining tokens to consumer validators pro rata
 	if err := k.AllocateTokensToConsumerValidators(
 		ctx,
 		consumerId,
-		sdk.NewDecCoinsFromCoins(validatorsRewardsTrunc...),
+		proRataRewards,
 	); err != nil {
 		k.Logger(ctx).Error(
 			"fail to allocate ICS rewards to validators",
@@ -240,6 +457,7 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 
 	// set consumer allocations to the remaining rewards decimals
 	alloc.Rewards = validatorsRewardsChange.Add(remainingChanges...)
+	alloc.ValidatorRewards = nil
 
 	k.Logger(ctx).Info(
 		"distributed ICS rewards successfully",
@@ -247,6 +465,7 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 		"chainId", chainId,
 		"total-rewards", consumerRewards.String(),
 		"sent-to-validators", validatorsRewardsTrunc.String(),
+		"attributed-to-validators", attributedRewards.String(),
 		"sent-to-CP", remainingRewards.String(),
 	)
 
@@ -258,6 +477,7 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
 			sdk.NewAttribute(types.AttributeRewardTotal, consumerRewards.String()),
 			sdk.NewAttribute(types.AttributeRewardDistributed, validatorsRewardsTrunc.String()),
+			sdk.NewAttribute(types.AttributeRewardAttributed, attributedRewards.String()),
 			sdk.NewAttribute(types.AttributeRewardCommunityPool, remainingRewards.String()),
 		),
 	)
@@ -282,6 +502,7 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 			continue
 		}
 
+		rewardsPaused := k.IsConsumerRewardsPaused(ctx, consumerId)
 		allAllowlistedDenoms := append(allConsumerRewardDenoms, consumerAllowlistedRewardDenoms...)
 		for _, denom := range allAllowlistedDenoms {
 			// use a cached context to verify that the call to `AllocateConsumerRewards` is atomic, and hence
@@ -302,6 +523,20 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 				// when there is no (consumerId, denom) key for consumer rewards allocations
 				continue
 			}
+			if rewardsPaused {
+				// hold back the rewards until the reward distribution of the consumer chain is unpaused
+				if err := k.HoldBackConsumerRewards(cachedCtx, consumerId, consumerRewards); err != nil {
+					k.Logger(ctx).Error(
+						"fail to hold back rewards for consumer chain",
+						"consumer id", consumerId,
+						"error", err.Error(),
+					)
+					continue
+				}
+				k.DeleteConsumerRewardsAllocationByDenom(cachedCtx, consumerId, denom)
+				writeCache()
+				continue
+			}
 			remainingRewardAllocation, err := k.AllocateConsumerRewards(cachedCtx, consumerId, consumerRewards)
 			if err != nil {
 				k.Logger(ctx).Error(
@@ -397,25 +632,90 @@ func (k Keeper) AllocateTokensToConsumerValidators(
 			return err
 		}
 
-		// check if the validator set a custom commission rate for the consumer chain
-		if cr, found := k.GetConsumerCommissionRate(ctx, consumerId, types.NewProviderConsAddress(consAddr)); found {
-			// set the validator commission rate
-			val.Commission.CommissionRates.Rate = cr
+		if err := k.allocateTokensToValidator(ctx, consumerId, consAddr, val, tokensFraction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// allocateAttributedRewards allocates the rewards attributed by the consumer chain with `consumerId` to specific
+// validators (see AttributeRewardsToValidators) directly to those validators. Only the `voteMultiplier` fraction
+// of the attributed rewards (i.e., after deducting the community tax) is allocated, truncated to whole tokens.
+// The attributed rewards of validators that are no longer found or that are not yet eligible for rewards
+// (see IsEligibleForConsumerRewards) are distributed pro rata.
+// It returns the allocated rewards.
+func (k Keeper) allocateAttributedRewards(
+	ctx sdk.Context,
+	consumerId string,
+	validatorRewards []types.ValidatorRewardsAllocation,
+	voteMultiplier math.LegacyDec,
+) (sdk.DecCoins, error) {
+	allocated := sdk.DecCoins{}
+	for _, attributed := range validatorRewards {
+		tokens, _ := attributed.Rewards.MulDecTruncate(voteMultiplier).TruncateDecimal()
+		if tokens.IsZero() {
+			continue
 		}
 
-		// allocate the consumer reward tokens to the validator
-		err = k.distributionKeeper.AllocateTokensToValidator(
-			ctx,
-			val,
-			tokensFraction,
-		)
+		consAddr := sdk.ConsAddress(attributed.ProviderConsAddr)
+		consumerVal, found := k.GetConsumerValidator(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+		if !found || !k.IsEligibleForConsumerRewards(ctx, consumerVal.JoinHeight) {
+			k.Logger(ctx).Info(
+				"validator is not eligible for ICS rewards, attributed ICS rewards are distributed pro rata",
+				"consumerId", consumerId,
+				"providerAddr", consAddr.String(),
+				"rewards", tokens.String(),
+			)
+			continue
+		}
+
+		val, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 		if err != nil {
-			k.Logger(ctx).Error("fail to allocate tokens to validator :%s while allocating rewards from consumer chain: %s",
-				consAddr, consumerId)
-			return err
+			k.Logger(ctx).Info(
+				"cannot find validator by consensus address, attributed ICS rewards are distributed pro rata",
+				"consumerId", consumerId,
+				"providerAddr", consAddr.String(),
+				"rewards", tokens.String(),
+				"error", err.Error(),
+			)
+			continue
+		}
+
+		if err := k.allocateTokensToValidator(ctx, consumerId, consAddr, val, sdk.NewDecCoinsFromCoins(tokens...)); err != nil {
+			return nil, err
 		}
+		allocated = allocated.Add(sdk.NewDecCoinsFromCoins(tokens...)...)
 	}
+	return allocated, nil
+}
 
+// allocateTokensToValidator allocates the consumer reward `tokens` to the validator `val` with consensus address
+// `consAddr`, using the commission rate the validator charges on the consumer chain with `consumerId`
+func (k Keeper) allocateTokensToValidator(
+	ctx sdk.Context,
+	consumerId string,
+	consAddr sdk.ConsAddress,
+	val stakingtypes.Validator,
+	tokens sdk.DecCoins,
+) error {
+	// use the commission rate the validator charges on the consumer chain,
+	// i.e., its provider commission rate unless it set a custom commission rate for the consumer chain
+	val.Commission.CommissionRates.Rate, _ = k.GetEffectiveConsumerCommissionRate(
+		ctx, consumerId, types.NewProviderConsAddress(consAddr), val)
+
+	// allocate the consumer reward tokens to the validator
+	err := k.distributionKeeper.AllocateTokensToValidator(
+		ctx,
+		val,
+		tokens,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("fail to allocate tokens to validator :%s while allocating rewards from consumer chain: %s",
+			consAddr, consumerId)
+		return err
+	}
 	return nil
 }
 
@@ -501,6 +801,123 @@ func (k Keeper) GetSourceChainIdFromIBCPacket(ctx sdk.Context, packet channeltyp
 	return tmClient.ChainId, nil
 }
 
+// SetConsumerRewardChannel sets the transfer channel on which the ICS rewards
+// of the consumer chain with `consumerId` are received
+func (k Keeper) SetConsumerRewardChannel(ctx sdk.Context, consumerId, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToRewardChannelKey(consumerId), []byte(channelId))
+}
+
+// GetConsumerRewardChannel returns the transfer channel on which the ICS rewards
+// of the consumer chain with `consumerId` are received
+func (k Keeper) GetConsumerRewardChannel(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToRewardChannelKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeleteConsumerRewardChannel deletes the transfer channel on which the ICS rewards
+// of the consumer chain with `consumerId` are received
+func (k Keeper) DeleteConsumerRewardChannel(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToRewardChannelKey(consumerId))
+}
+
+// ValidateConsumerRewardChannel validates that `channelId` can be the reward channel of the consumer chain
+// with `consumerId`, i.e., that the provider has an OPEN channel with `channelId` on the transfer port
+// whose connection is built on the client to the consumer chain
+func (k Keeper) ValidateConsumerRewardChannel(ctx sdk.Context, consumerId, channelId string) error {
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"no client to consumer chain, consumerId(%s)", consumerId)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"channel %s not found on port %s", channelId, transfertypes.PortID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"channel %s is in state %s instead of %s", channelId, channel.State, channeltypes.OPEN)
+	}
+	if len(channel.ConnectionHops) != 1 {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"channel %s must have a direct connection to the consumer chain", channelId)
+	}
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"connection %s of channel %s not found", channel.ConnectionHops[0], channelId)
+	}
+	if connection.ClientId != clientId {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerRewardChannel,
+			"channel %s is built on client %s instead of the consumer client %s", channelId, connection.ClientId, clientId)
+	}
+	return nil
+}
+
+// RegisterConsumerRewardChannels registers the reward channel of every launched consumer chain that has none,
+// provided that the consumer chain has exactly one OPEN transfer channel to the provider, i.e., the channel
+// is unambiguous. The reward channels of the other consumer chains are registered once the rewards are received
+// on a channel that belongs to them (see CheckConsumerRewardChannel) or set by their owners.
+func (k Keeper) RegisterConsumerRewardChannels(ctx sdk.Context) {
+	// map the client ids to the OPEN transfer channels built on them
+	channelIdsByClientId := map[string][]string{}
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		if channel.PortId != transfertypes.PortID || channel.State != channeltypes.OPEN || len(channel.ConnectionHops) != 1 {
+			continue
+		}
+		connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			continue
+		}
+		channelIdsByClientId[connection.ClientId] = append(channelIdsByClientId[connection.ClientId], channel.ChannelId)
+	}
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found {
+			continue
+		}
+		if _, found := k.GetConsumerRewardChannel(ctx, consumerId); found {
+			continue
+		}
+		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+		if channelIds := channelIdsByClientId[clientId]; len(channelIds) == 1 {
+			k.SetConsumerRewardChannel(ctx, consumerId, channelIds[0])
+		}
+	}
+}
+
+// CheckConsumerRewardChannel returns true if ICS rewards of the consumer chain with `consumerId`
+// received on the transfer channel `channelId` can be allocated to the consumer chain.
+// If the consumer chain has a registered reward channel, then the rewards must be received on it.
+// Otherwise, the rewards must be received on a channel that is known to belong to the consumer
+// chain (i.e., `fromConsumer` is true), in which case the channel is registered as the reward channel.
+func (k Keeper) CheckConsumerRewardChannel(ctx sdk.Context, consumerId, channelId string, fromConsumer bool) bool {
+	if rewardChannelId, found := k.GetConsumerRewardChannel(ctx, consumerId); found {
+		return rewardChannelId == channelId
+	}
+	if !fromConsumer {
+		return false
+	}
+	k.SetConsumerRewardChannel(ctx, consumerId, channelId)
+	k.Logger(ctx).Info("registered the reward channel of consumer chain",
+		"consumerId", consumerId,
+		"channelId", channelId,
+	)
+	return true
+}
+
+// DivertRewardsToCommunityPool sends rewards from the consumer rewards pool to the community pool
+// without allocating them to any consumer chain
+func (k Keeper) DivertRewardsToCommunityPool(ctx sdk.Context, rewards sdk.Coins) error {
+	return k.distributionKeeper.FundCommunityPool(context.Context(ctx), rewards, k.accountKeeper.GetModuleAccount(ctx, types.ConsumerRewardsPool).GetAddress())
+}
+
 // HandleSetConsumerCommissionRate sets a per-consumer chain commission rate for the given provider address
 // on the condition that the given consumer chain exists.
 func (k Keeper) HandleSetConsumerCommissionRate(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, commissionRate math.LegacyDec) error {
@@ -531,6 +948,22 @@ func (k Keeper) HandleSetConsumerCommissionRate(ctx sdk.Context, consumerId stri
 	)
 }
 
+// GetEffectiveConsumerCommissionRate returns the commission rate that `validator` with `providerAddr` charges
+// on the consumer chain with `consumerId` together with its source. Unless the validator set a per-consumer
+// chain commission rate (see MsgSetConsumerCommissionRate), the rate is inherited from the current commission
+// rate of the validator on the provider chain, i.e., changes of the provider commission rate apply immediately.
+func (k Keeper) GetEffectiveConsumerCommissionRate(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	validator stakingtypes.Validator,
+) (math.LegacyDec, types.ConsumerCommissionRateSource) {
+	if rate, found := k.GetConsumerCommissionRate(ctx, consumerId, providerAddr); found {
+		return rate, types.CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT
+	}
+	return validator.Commission.Rate, types.CONSUMER_COMMISSION_RATE_SOURCE_INHERITED
+}
+
 // TODO: this method needs to be tested
 func (k Keeper) ChangeRewardDenoms(ctx sdk.Context, denomsToAdd, denomsToRemove []string) []sdk.Attribute {
 	// initialize an empty slice to store event attributes
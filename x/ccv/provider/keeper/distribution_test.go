@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -13,9 +15,12 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 )
@@ -373,6 +378,92 @@ func TestAllowlistedRewardDenoms(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestConsumerRewardChannel tests the getter, setter, and deletion of the reward channel of a consumer chain,
+// as well as that rewards can only be allocated to a consumer chain if they are received on its reward channel
+func TestConsumerRewardChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	_, found := providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.False(t, found)
+
+	// rewards received on a channel not known to belong to the consumer chain are not accepted
+	// and the channel is not registered
+	require.False(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-2", false))
+	_, found = providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.False(t, found)
+
+	// the first rewards received on a channel of the consumer chain register the reward channel
+	require.True(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-1", true))
+	channelId, found := providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "channel-1", channelId)
+
+	// once registered, only rewards received on the reward channel are accepted
+	require.True(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-1", false))
+	require.False(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-2", false))
+	require.False(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-3", true))
+
+	// the reward channel can be overwritten
+	providerKeeper.SetConsumerRewardChannel(ctx, consumerId, "channel-2")
+	require.True(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-2", false))
+	require.False(t, providerKeeper.CheckConsumerRewardChannel(ctx, consumerId, "channel-1", true))
+
+	providerKeeper.DeleteConsumerRewardChannel(ctx, consumerId)
+	_, found = providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.False(t, found)
+}
+
+// TestRegisterConsumerRewardChannels tests that the reward channels of the launched consumer chains
+// are registered only if they are unambiguous
+func TestRegisterConsumerRewardChannels(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// consumer "0" has a single transfer channel, consumer "1" has two transfer channels,
+	// consumer "2" already has a reward channel, and consumer "3" is not launched
+	for i, consumerId := range []string{"0", "1", "2", "3"} {
+		providerKeeper.SetConsumerClientId(ctx, consumerId, fmt.Sprintf("07-tendermint-%d", i))
+		if consumerId != "3" {
+			providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, fmt.Sprintf("channel-%d", 10+i))
+		}
+	}
+	providerKeeper.SetConsumerRewardChannel(ctx, "2", "channel-5")
+
+	transferChannel := func(channelId, connectionId string, state channeltypes.State) channeltypes.IdentifiedChannel {
+		return channeltypes.IdentifiedChannel{
+			PortId: "transfer", ChannelId: channelId, State: state, ConnectionHops: []string{connectionId},
+		}
+	}
+	mocks.MockChannelKeeper.EXPECT().GetAllChannelsWithPortPrefix(gomock.Any(), "transfer").Return(
+		[]channeltypes.IdentifiedChannel{
+			transferChannel("channel-0", "connection-0", channeltypes.OPEN),
+			transferChannel("channel-1", "connection-0", channeltypes.CLOSED),
+			transferChannel("channel-2", "connection-1", channeltypes.OPEN),
+			transferChannel("channel-3", "connection-1", channeltypes.OPEN),
+			transferChannel("channel-4", "connection-2", channeltypes.OPEN),
+			transferChannel("channel-6", "connection-3", channeltypes.OPEN),
+		}).Times(1)
+	for i := 0; i < 4; i++ {
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), fmt.Sprintf("connection-%d", i)).
+			Return(conntypes.ConnectionEnd{ClientId: fmt.Sprintf("07-tendermint-%d", i)}, true).AnyTimes()
+	}
+
+	providerKeeper.RegisterConsumerRewardChannels(ctx)
+
+	channelId, found := providerKeeper.GetConsumerRewardChannel(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, "channel-0", channelId)
+	_, found = providerKeeper.GetConsumerRewardChannel(ctx, "1")
+	require.False(t, found)
+	channelId, found = providerKeeper.GetConsumerRewardChannel(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "channel-5", channelId)
+	_, found = providerKeeper.GetConsumerRewardChannel(ctx, "3")
+	require.False(t, found)
+}
+
 // TestConsumerRewardsAllocationByDenom tests the `*ConsumerRewardsAllocationByDenom* methods
 func TestConsumerRewardsAllocationByDenom(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -398,3 +489,312 @@ func TestConsumerRewardsAllocationByDenom(t *testing.T) {
 	require.Empty(t, rewards.Rewards)
 	require.NoError(t, err)
 }
+
+// TestConsumerRewardsPaused tests that the rewards of a consumer chain are held back while its reward
+// distribution is paused and that they are all distributed at once after it is unpaused
+func TestConsumerRewardsPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	denom := "uatom"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-id")
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	providerKeeper.SetConsumerRewardDenom(ctx, denom)
+
+	// the consumer chain has no validators, hence the distributed rewards are sent to the community pool
+	distributed := sdk.Coins{}
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(
+		authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)).AnyTimes()
+	mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, amount sdk.Coins, _ sdk.AccAddress) error {
+			distributed = distributed.Add(amount...)
+			return nil
+		}).AnyTimes()
+
+	allocate := func(amount int64) {
+		rewardsAllocation, err := providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom)
+		require.NoError(t, err)
+		rewardsAllocation.Rewards = rewardsAllocation.Rewards.Add(sdk.NewDecCoin(denom, math.NewInt(amount)))
+		require.NoError(t, providerKeeper.SetConsumerRewardsAllocationByDenom(ctx, consumerId, denom, rewardsAllocation))
+		providerKeeper.AllocateTokens(ctx)
+	}
+
+	// pause the reward distribution and accumulate the rewards of two allocations
+	require.False(t, providerKeeper.IsConsumerRewardsPaused(ctx, consumerId))
+	providerKeeper.SetConsumerRewardsPaused(ctx, consumerId)
+	require.True(t, providerKeeper.IsConsumerRewardsPaused(ctx, consumerId))
+	allocate(100)
+	allocate(200)
+	require.Empty(t, distributed)
+	heldBack, err := providerKeeper.GetConsumerHeldBackRewards(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(300))), heldBack)
+	rewardsAllocation, err := providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom)
+	require.NoError(t, err)
+	require.Empty(t, rewardsAllocation.Rewards)
+
+	// unpause the reward distribution; the held back rewards are distributed together with the next allocation
+	require.NoError(t, providerKeeper.UnpauseConsumerRewards(ctx, consumerId))
+	require.False(t, providerKeeper.IsConsumerRewardsPaused(ctx, consumerId))
+	allocate(50)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(350))), distributed)
+	heldBack, err = providerKeeper.GetConsumerHeldBackRewards(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, heldBack)
+}
+
+// TestConsumerRewardsPausedWithAttributedRewards tests that the rewards attributed to specific validators
+// are held back while the reward distribution is paused and remain attributed to them after it is unpaused
+func TestConsumerRewardsPausedWithAttributedRewards(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	denom1 := "uatom"
+	denom2 := "ibc/denom"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-id")
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	providerKeeper.SetConsumerRewardDenom(ctx, denom1)
+	providerKeeper.SetConsumerRewardDenom(ctx, denom2)
+	providerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddr2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	allocate := func(denom string, amount int64, validatorRewards ...providertypes.ValidatorRewardsAllocation) {
+		rewardsAllocation, err := providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom)
+		require.NoError(t, err)
+		rewardsAllocation.Rewards = rewardsAllocation.Rewards.Add(sdk.NewDecCoin(denom, math.NewInt(amount)))
+		rewardsAllocation.ValidatorRewards = append(rewardsAllocation.ValidatorRewards, validatorRewards...)
+		require.NoError(t, providerKeeper.SetConsumerRewardsAllocationByDenom(ctx, consumerId, denom, rewardsAllocation))
+		providerKeeper.AllocateTokens(ctx)
+	}
+
+	providerKeeper.SetConsumerRewardsPaused(ctx, consumerId)
+	allocate(denom1, 100, providertypes.ValidatorRewardsAllocation{
+		ProviderConsAddr: providerAddr1.ToSdkConsAddr(),
+		Rewards:          sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(40))),
+	})
+	allocate(denom1, 200,
+		providertypes.ValidatorRewardsAllocation{
+			ProviderConsAddr: providerAddr1.ToSdkConsAddr(),
+			Rewards:          sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(10))),
+		},
+		providertypes.ValidatorRewardsAllocation{
+			ProviderConsAddr: providerAddr2.ToSdkConsAddr(),
+			Rewards:          sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(20))),
+		},
+	)
+	allocate(denom2, 50, providertypes.ValidatorRewardsAllocation{
+		ProviderConsAddr: providerAddr2.ToSdkConsAddr(),
+		Rewards:          sdk.NewDecCoins(sdk.NewDecCoin(denom2, math.NewInt(30))),
+	})
+	heldBack, err := providerKeeper.GetConsumerHeldBackRewards(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(300)), sdk.NewDecCoin(denom2, math.NewInt(50))), heldBack)
+
+	// after unpausing, the held back rewards are released per denom together with their attributions
+	require.NoError(t, providerKeeper.UnpauseConsumerRewards(ctx, consumerId))
+	rewardsAllocation, err := providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom1)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(300))),
+		ValidatorRewards: []providertypes.ValidatorRewardsAllocation{
+			{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(50)))},
+			{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom1, math.NewInt(20)))},
+		},
+	}, rewardsAllocation)
+	rewardsAllocation, err = providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom2)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom2, math.NewInt(50))),
+		ValidatorRewards: []providertypes.ValidatorRewardsAllocation{
+			{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom2, math.NewInt(30)))},
+		},
+	}, rewardsAllocation)
+	heldBack, err = providerKeeper.GetConsumerHeldBackRewards(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, heldBack)
+}
+
+// TestAllocateTokensToConsumerValidatorsCommissionRate tests that the consumer rewards are allocated using
+// the commission rate a validator set for the consumer chain, or otherwise using the provider commission rate
+// the validator has at the time of the distribution
+func TestAllocateTokensToConsumerValidatorsCommissionRate(t *testing.T) {
+	keeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(params.NumberOfEpochsToStartReceivingRewards * params.BlocksPerEpoch)
+
+	consumerId := "0"
+	providerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddr2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	err := keeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Power: 1},
+		{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Power: 1},
+	})
+	require.NoError(t, err)
+	addr1, addr2 := providerAddr1.ToSdkConsAddr().String(), providerAddr2.ToSdkConsAddr().String()
+
+	// the provider commission rates of the validators
+	providerRates := map[string]math.LegacyDec{
+		addr1: math.LegacyNewDecWithPrec(1, 1),
+		addr2: math.LegacyNewDecWithPrec(2, 1),
+	}
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			return stakingtypes.Validator{
+				OperatorAddress: consAddr.String(),
+				Commission: stakingtypes.Commission{
+					CommissionRates: stakingtypes.CommissionRates{Rate: providerRates[consAddr.String()]},
+				},
+			}, nil
+		}).AnyTimes()
+
+	// allocate records the commission rates with which the rewards are allocated to the validators
+	allocate := func() map[string]math.LegacyDec {
+		allocatedRates := map[string]math.LegacyDec{}
+		mocks.MockDistributionKeeper.EXPECT().AllocateTokensToValidator(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, val stakingtypes.ValidatorI, _ sdk.DecCoins) error {
+				allocatedRates[val.GetOperator()] = val.GetCommission()
+				return nil
+			}).Times(2)
+		err := keeper.AllocateTokensToConsumerValidators(ctx, consumerId, sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(100))))
+		require.NoError(t, err)
+		return allocatedRates
+	}
+
+	// both validators inherit their provider commission rates
+	require.Equal(t, map[string]math.LegacyDec{
+		addr1: math.LegacyNewDecWithPrec(1, 1),
+		addr2: math.LegacyNewDecWithPrec(2, 1),
+	}, allocate())
+
+	// the second validator sets a commission rate for the consumer chain
+	err = keeper.SetConsumerCommissionRate(ctx, consumerId, providerAddr2, math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(t, err)
+	require.Equal(t, map[string]math.LegacyDec{
+		addr1: math.LegacyNewDecWithPrec(1, 1),
+		addr2: math.LegacyNewDecWithPrec(5, 2),
+	}, allocate())
+
+	// both validators change their provider commission rates between distributions;
+	// only the validator that did not set a commission rate for the consumer chain is affected
+	providerRates[addr1] = math.LegacyNewDecWithPrec(3, 1)
+	providerRates[addr2] = math.LegacyNewDecWithPrec(4, 1)
+	require.Equal(t, map[string]math.LegacyDec{
+		addr1: math.LegacyNewDecWithPrec(3, 1),
+		addr2: math.LegacyNewDecWithPrec(5, 2),
+	}, allocate())
+
+	rate, source := keeper.GetEffectiveConsumerCommissionRate(ctx, consumerId, providerAddr1,
+		stakingtypes.Validator{Commission: stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: providerRates[addr1]}}})
+	require.Equal(t, math.LegacyNewDecWithPrec(3, 1), rate)
+	require.Equal(t, providertypes.CONSUMER_COMMISSION_RATE_SOURCE_INHERITED, source)
+	rate, source = keeper.GetEffectiveConsumerCommissionRate(ctx, consumerId, providerAddr2,
+		stakingtypes.Validator{Commission: stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: providerRates[addr2]}}})
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), rate)
+	require.Equal(t, providertypes.CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT, source)
+}
+
+// TestAttributeRewardsToValidators tests that the shares of a per-validator breakdown of ICS rewards are attributed
+// to the provider validators mapped through the key-assignment index, and that the shares of consumer addresses
+// that are not consumer validators are left out
+func TestAttributeRewardsToValidators(t *testing.T) {
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	denom := "ibc/denom"
+	providerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddr2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	err := keeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Power: 1},
+		{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Power: 1},
+	})
+	require.NoError(t, err)
+
+	// the first validator assigned a consumer key, while the second validator uses its provider key
+	consumerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(11).ConsumerConsAddress()
+	keeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr1, providerAddr1)
+	consumerAddr2 := providertypes.NewConsumerConsAddress(providerAddr2.ToSdkConsAddr())
+	// a consumer address that does not belong to a consumer validator
+	consumerAddr3 := cryptotestutil.NewCryptoIdentityFromIntSeed(3).ConsumerConsAddress()
+
+	alloc := providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(100))),
+	}
+	attributed := keeper.AttributeRewardsToValidators(ctx, consumerId, &alloc, denom,
+		[]sdk.ConsAddress{consumerAddr1.ToSdkConsAddr(), consumerAddr2.ToSdkConsAddr(), consumerAddr3.ToSdkConsAddr()},
+		[]math.Int{math.NewInt(50), math.NewInt(30), math.NewInt(20)},
+	)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(80))), attributed)
+	require.Equal(t, []providertypes.ValidatorRewardsAllocation{
+		{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(50)))},
+		{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(30)))},
+	}, alloc.ValidatorRewards)
+
+	// the shares of another transfer are added to the rewards already attributed to the validators
+	attributed = keeper.AttributeRewardsToValidators(ctx, consumerId, &alloc, denom,
+		[]sdk.ConsAddress{consumerAddr2.ToSdkConsAddr()}, []math.Int{math.NewInt(10)})
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(10))), attributed)
+	require.Equal(t, []providertypes.ValidatorRewardsAllocation{
+		{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(50)))},
+		{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(40)))},
+	}, alloc.ValidatorRewards)
+}
+
+// TestAllocateAttributedRewardsEligibility tests that the rewards attributed to validators that are not yet
+// eligible for consumer rewards are not allocated to them directly, but are distributed pro rata
+func TestAllocateAttributedRewardsEligibility(t *testing.T) {
+	keeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(params.NumberOfEpochsToStartReceivingRewards * params.BlocksPerEpoch)
+
+	consumerId := "0"
+	denom := "ibc/denom"
+	keeper.SetConsumerChainId(ctx, consumerId, "chain-id")
+	providerAddr1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddr2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	// the second validator just joined the consumer chain and hence is not eligible for rewards
+	err := keeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Power: 1, JoinHeight: 0},
+		{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Power: 1, JoinHeight: ctx.BlockHeight()},
+	})
+	require.NoError(t, err)
+
+	allocated := map[string]sdk.DecCoins{}
+	mocks.MockDistributionKeeper.EXPECT().GetCommunityTax(gomock.Any()).Return(math.LegacyZeroDec(), nil)
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(
+		authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)).AnyTimes()
+	mocks.MockDistributionKeeper.EXPECT().FundCommunityPool(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			return stakingtypes.Validator{OperatorAddress: consAddr.String()}, nil
+		}).AnyTimes()
+	mocks.MockDistributionKeeper.EXPECT().AllocateTokensToValidator(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error {
+			allocated[val.GetOperator()] = allocated[val.GetOperator()].Add(tokens...)
+			return nil
+		}).AnyTimes()
+
+	alloc := providertypes.ConsumerRewardsAllocation{
+		Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(100))),
+		ValidatorRewards: []providertypes.ValidatorRewardsAllocation{
+			{ProviderConsAddr: providerAddr1.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(40)))},
+			{ProviderConsAddr: providerAddr2.ToSdkConsAddr(), Rewards: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(40)))},
+		},
+	}
+	_, err = keeper.AllocateConsumerRewards(ctx, consumerId, alloc)
+	require.NoError(t, err)
+
+	// the share attributed to the ineligible validator is distributed pro rata among the eligible validators
+	require.Equal(t, map[string]sdk.DecCoins{
+		providerAddr1.ToSdkConsAddr().String(): sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(100))),
+	}, allocated)
+}
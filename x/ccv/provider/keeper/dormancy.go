@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// SetLastPacketReceivedTime sets the time at which the last packet (i.e., VSC ack,
+// slash packet, or reward packet) was received from the consumer chain with `consumerId`
+func (k Keeper) SetLastPacketReceivedTime(ctx sdk.Context, consumerId string, receivedTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToLastPacketReceivedTimeKey(consumerId), sdk.FormatTimeBytes(receivedTime.UTC()))
+}
+
+// GetLastPacketReceivedTime returns the time at which the last packet was received
+// from the consumer chain with `consumerId`
+func (k Keeper) GetLastPacketReceivedTime(ctx sdk.Context, consumerId string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToLastPacketReceivedTimeKey(consumerId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	receivedTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the time bytes are assumed to be correctly serialized in SetLastPacketReceivedTime.
+		panic(fmt.Errorf("failed to parse last packet received time for consumer id (%s): %w", consumerId, err))
+	}
+	return receivedTime.UTC(), true
+}
+
+// DeleteLastPacketReceivedTime deletes the time at which the last packet was received
+// from the consumer chain with `consumerId`
+func (k Keeper) DeleteLastPacketReceivedTime(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToLastPacketReceivedTimeKey(consumerId))
+}
+
+// SetConsumerDormant marks the consumer chain with `consumerId` as dormant
+func (k Keeper) SetConsumerDormant(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DormantConsumerKey(consumerId), []byte{})
+}
+
+// IsConsumerDormant returns whether the consumer chain with `consumerId` is dormant
+func (k Keeper) IsConsumerDormant(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DormantConsumerKey(consumerId))
+}
+
+// DeleteConsumerDormant removes the dormant mark of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerDormant(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DormantConsumerKey(consumerId))
+}
+
+// RecordConsumerActivity records that a packet was received from the consumer chain with `consumerId`.
+// If the consumer chain is dormant, it resumes the chain, i.e., the validator updates accumulated while
+// the chain was dormant are sent as a single VSC packet at the beginning of the next epoch.
+func (k Keeper) RecordConsumerActivity(ctx sdk.Context, consumerId string) {
+	k.SetLastPacketReceivedTime(ctx, consumerId, ctx.BlockTime())
+
+	if !k.IsConsumerDormant(ctx, consumerId) {
+		return
+	}
+
+	k.DeleteConsumerDormant(ctx, consumerId)
+	k.Logger(ctx).Info("consumer chain resumed", "consumerId", consumerId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerResumed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeLastPacketReceivedTime, ctx.BlockTime().UTC().String()),
+		),
+	)
+}
+
+// UpdateConsumerDormancy marks the consumer chain with `consumerId` as dormant if no packet
+// was received from it for at least `DormancyPeriod`, and returns whether the chain is dormant.
+// Dormancy is disabled if `DormancyPeriod` is zero.
+func (k Keeper) UpdateConsumerDormancy(ctx sdk.Context, consumerId string) bool {
+	dormancyPeriod := k.GetDormancyPeriod(ctx)
+	if dormancyPeriod == 0 {
+		return false
+	}
+
+	if k.IsConsumerDormant(ctx, consumerId) {
+		return true
+	}
+
+	lastPacketReceivedTime, found := k.GetLastPacketReceivedTime(ctx, consumerId)
+	if !found {
+		// no packet was received yet (e.g., the dormancy period was just enabled),
+		// start measuring the dormancy period from now on
+		k.SetLastPacketReceivedTime(ctx, consumerId, ctx.BlockTime())
+		return false
+	}
+
+	if ctx.BlockTime().Sub(lastPacketReceivedTime) < dormancyPeriod {
+		return false
+	}
+
+	k.SetConsumerDormant(ctx, consumerId)
+	k.Logger(ctx).Info("consumer chain is dormant",
+		"consumerId", consumerId,
+		"lastPacketReceivedTime", lastPacketReceivedTime,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerDormant,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeLastPacketReceivedTime, lastPacketReceivedTime.String()),
+		),
+	)
+
+	return true
+}
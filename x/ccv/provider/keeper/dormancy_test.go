@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+)
+
+// TestLastPacketReceivedTime tests the getter, setter, and deletion of the last packet received time
+func TestLastPacketReceivedTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	receivedTime := time.Now().UTC()
+	providerKeeper.SetLastPacketReceivedTime(ctx, CONSUMER_ID, receivedTime)
+	actualTime, found := providerKeeper.GetLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, receivedTime, actualTime)
+
+	providerKeeper.DeleteLastPacketReceivedTime(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// TestUpdateConsumerDormancy tests that a consumer chain becomes dormant only after
+// no packets were received for at least the dormancy period
+func TestUpdateConsumerDormancy(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// dormancy is disabled by default
+	providerKeeper.SetLastPacketReceivedTime(ctx, CONSUMER_ID, now.Add(-365*24*time.Hour))
+	require.False(t, providerKeeper.UpdateConsumerDormancy(ctx, CONSUMER_ID))
+	require.False(t, providerKeeper.IsConsumerDormant(ctx, CONSUMER_ID))
+
+	params := providerKeeper.GetParams(ctx)
+	params.DormancyPeriod = time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	// if no packet was received yet, the dormancy period starts now
+	providerKeeper.DeleteLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.False(t, providerKeeper.UpdateConsumerDormancy(ctx, CONSUMER_ID))
+	receivedTime, found := providerKeeper.GetLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, now, receivedTime)
+
+	// the dormancy period has not elapsed yet
+	ctx = ctx.WithBlockTime(now.Add(time.Hour - time.Second))
+	require.False(t, providerKeeper.UpdateConsumerDormancy(ctx, CONSUMER_ID))
+	require.False(t, providerKeeper.IsConsumerDormant(ctx, CONSUMER_ID))
+
+	// the dormancy period has elapsed
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	require.True(t, providerKeeper.UpdateConsumerDormancy(ctx, CONSUMER_ID))
+	require.True(t, providerKeeper.IsConsumerDormant(ctx, CONSUMER_ID))
+
+	// receiving a packet resumes the consumer chain
+	providerKeeper.RecordConsumerActivity(ctx, CONSUMER_ID)
+	require.False(t, providerKeeper.IsConsumerDormant(ctx, CONSUMER_ID))
+	receivedTime, found = providerKeeper.GetLastPacketReceivedTime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour), receivedTime)
+	require.False(t, providerKeeper.UpdateConsumerDormancy(ctx, CONSUMER_ID))
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// SetForcedOptInTime sets the time at which the validator with `providerAddr` was automatically opted in
+// to the consumer chain with `consumerId` because it belongs to the top N
+func (k Keeper) SetForcedOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, optInTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ForcedOptInTimeKey(consumerId, providerAddr), sdk.FormatTimeBytes(optInTime))
+}
+
+// GetForcedOptInTime returns the time at which the validator with `providerAddr` was automatically opted in
+// to the consumer chain with `consumerId`, and false if the validator was not automatically opted in
+func (k Keeper) GetForcedOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ForcedOptInTimeKey(consumerId, providerAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	optInTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		k.Logger(ctx).Error("failed to parse forced opt-in time",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"error", err.Error(),
+		)
+		return time.Time{}, false
+	}
+	return optInTime, true
+}
+
+// DeleteForcedOptInTime deletes the time at which the validator with `providerAddr` was automatically opted in
+// to the consumer chain with `consumerId`
+func (k Keeper) DeleteForcedOptInTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ForcedOptInTimeKey(consumerId, providerAddr))
+}
+
+// IsWithinDowntimeGracePeriod returns true if the validator with `providerAddr` was automatically opted in to the
+// consumer chain with `consumerId` less than the downtime grace period of the consumer chain ago, together with
+// the time at which the validator was automatically opted in. Validators that opted in voluntarily are never
+// within the downtime grace period.
+func (k Keeper) IsWithinDowntimeGracePeriod(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	optInTime, found := k.GetForcedOptInTime(ctx, consumerId, providerAddr)
+	if !found {
+		return time.Time{}, false
+	}
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return optInTime, false
+	}
+	gracePeriod := downtimeGracePeriod(powerShapingParameters)
+	return optInTime, gracePeriod > 0 && ctx.BlockTime().Before(optInTime.Add(gracePeriod))
+}
+
+// downtimeGracePeriod returns the downtime grace period of the given power-shaping parameters, or zero if it is not set
+func downtimeGracePeriod(powerShapingParameters types.PowerShapingParameters) time.Duration {
+	if powerShapingParameters.DowntimeGracePeriod == nil {
+		return 0
+	}
+	return *powerShapingParameters.DowntimeGracePeriod
+}
+
+// RecordSkippedDowntimeSlash emits an event for a downtime slash of the validator with `providerAddr` that is not
+// executed due to the downtime grace period of the consumer chain with `consumerId`, and appends it to the skipped
+// downtime slashes of the consumer chain.
+//
+// Note that the skipped downtime slashes are bounded to the last MaxSkippedDowntimeSlashEntries records per consumer chain,
+// and that they are removed once the state of the consumer chain is cleaned up after its deletion. They are neither exported
+// in genesis nor used by the provider logic.
+func (k Keeper) RecordSkippedDowntimeSlash(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	consumerAddr types.ConsumerConsAddress,
+	vscId uint64,
+	optInTime time.Time,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDowntimeSlashSkipped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+			sdk.NewAttribute(types.AttributeConsumerValidatorAddress, consumerAddr.String()),
+			sdk.NewAttribute(types.AttributeOptInTime, optInTime.String()),
+			sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(vscId, 10)),
+		),
+	)
+
+	log := k.SkippedDowntimeSlashLog()
+	record := types.SkippedDowntimeSlash{
+		Sequence:        log.NextSequence(ctx, consumerId),
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+		ProviderAddress: providerAddr.String(),
+		ConsumerAddress: consumerAddr.String(),
+		ValsetUpdateId:  vscId,
+		OptInTime:       optInTime,
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to store skipped downtime slash",
+			"consumerId", consumerId,
+			"sequence", record.Sequence,
+			"error", err.Error(),
+		)
+		return
+	}
+	// append the record and prune the skipped downtime slashes by count
+	log.Append(ctx, consumerId, record.Sequence, bz)
+}
+
+// SetSkippedDowntimeSlash stores the skipped downtime slash `record` of the consumer chain with `consumerId`
+func (k Keeper) SetSkippedDowntimeSlash(ctx sdk.Context, consumerId string, record types.SkippedDowntimeSlash) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal skipped downtime slash (%d) for consumer id (%s): %w", record.Sequence, consumerId, err)
+	}
+	k.SkippedDowntimeSlashLog().Set(ctx, consumerId, record.Sequence, bz)
+	return nil
+}
+
+// GetSkippedDowntimeSlashes returns the skipped downtime slashes of the consumer chain with `consumerId`
+// in ascending order of their sequence numbers, i.e., from the oldest to the newest
+func (k Keeper) GetSkippedDowntimeSlashes(ctx sdk.Context, consumerId string) ([]types.SkippedDowntimeSlash, error) {
+	records := []types.SkippedDowntimeSlash{}
+	var err error
+	k.SkippedDowntimeSlashLog().Iterate(ctx, consumerId, func(_ uint64, bz []byte) bool {
+		var record types.SkippedDowntimeSlash
+		if err = record.Unmarshal(bz); err != nil {
+			err = fmt.Errorf("failed to unmarshal skipped downtime slash for consumer id (%s): %w", consumerId, err)
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
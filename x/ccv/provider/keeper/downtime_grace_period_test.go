@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestForcedOptInTime tests that the time at which a validator is opted in is only recorded if the validator
+// is automatically opted in because it belongs to the top N, and that it is removed on a voluntary opt in
+func TestForcedOptInTime(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	providerAddrA := providertypes.NewProviderConsAddress(valAConsAddr)
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	providerAddrB := providertypes.NewProviderConsAddress(valBConsAddr)
+
+	// validator A opts in voluntarily before the validators in the top N are opted in
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrA)
+	optInTime := ctx.BlockTime()
+	err := providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB}, 0)
+	require.NoError(t, err)
+
+	_, found := providerKeeper.GetForcedOptInTime(ctx, CONSUMER_ID, providerAddrA)
+	require.False(t, found)
+	forcedOptInTime, found := providerKeeper.GetForcedOptInTime(ctx, CONSUMER_ID, providerAddrB)
+	require.True(t, found)
+	require.Equal(t, optInTime.UTC(), forcedOptInTime)
+
+	// the forced opt-in time does not change once the validator is opted in
+	ctx = ctx.WithBlockTime(optInTime.Add(time.Hour))
+	valA = createStakingValidator(ctx, mocks, 1, 1)
+	valB = createStakingValidator(ctx, mocks, 2, 2)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB}, 0)
+	require.NoError(t, err)
+	forcedOptInTime, found = providerKeeper.GetForcedOptInTime(ctx, CONSUMER_ID, providerAddrB)
+	require.True(t, found)
+	require.Equal(t, optInTime.UTC(), forcedOptInTime)
+
+	// a voluntary opt in removes the forced opt-in time
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddrB, "")
+	require.NoError(t, err)
+	_, found = providerKeeper.GetForcedOptInTime(ctx, CONSUMER_ID, providerAddrB)
+	require.False(t, found)
+}
+
+// TestHandleSlashPacketDowntimeGracePeriod tests that validators that were automatically opted in are not jailed
+// for downtime during the downtime grace period, while validators that opted in voluntarily are jailed
+func TestHandleSlashPacketDowntimeGracePeriod(t *testing.T) {
+	consumerId := "0"
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	optInTime := time.Unix(1700000000, 0).UTC()
+	gracePeriod := 24 * time.Hour
+
+	testCases := []struct {
+		name        string
+		forced      bool
+		gracePeriod *time.Duration
+		blockTime   time.Time
+		expectJail  bool
+	}{
+		{"forced opt in within the grace period", true, &gracePeriod, optInTime.Add(gracePeriod - time.Nanosecond), false},
+		{"forced opt in at the start of the grace period", true, &gracePeriod, optInTime, false},
+		{"forced opt in at the end of the grace period", true, &gracePeriod, optInTime.Add(gracePeriod), true},
+		{"forced opt in after the grace period", true, &gracePeriod, optInTime.Add(gracePeriod + time.Hour), true},
+		{"forced opt in without a grace period", true, nil, optInTime, true},
+		{"voluntary opt in within the grace period", false, &gracePeriod, optInTime, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+				ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, tc.expectJail)...)
+
+			providerKeeper.SetConsumerChainId(ctx, consumerId, "consumer-chain-id")
+			providerKeeper.SetInitChainHeight(ctx, consumerId, 5)
+			providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerConsAddr, providerConsAddr)
+			err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId,
+				providertypes.PowerShapingParameters{Top_N: 100, DowntimeGracePeriod: tc.gracePeriod})
+			require.NoError(t, err)
+			providerKeeper.SetOptedIn(ctx, consumerId, providerConsAddr)
+			if tc.forced {
+				providerKeeper.SetForcedOptInTime(ctx, consumerId, providerConsAddr, optInTime)
+			}
+
+			providerKeeper.HandleSlashPacket(ctx, consumerId, *ccv.NewSlashPacketData(
+				abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+				0,
+				stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			))
+
+			// the slash packet is acknowledged in either case
+			require.Equal(t, []string{consumerConsAddr.String()}, providerKeeper.GetSlashAcks(ctx, consumerId))
+
+			skippedSlashes, err := providerKeeper.GetSkippedDowntimeSlashes(ctx, consumerId)
+			require.NoError(t, err)
+			var skippedEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == providertypes.EventTypeDowntimeSlashSkipped {
+					skippedEvents = append(skippedEvents, event)
+				}
+			}
+			if tc.expectJail {
+				require.Empty(t, skippedSlashes)
+				require.Empty(t, skippedEvents)
+				return
+			}
+
+			require.Equal(t, []providertypes.SkippedDowntimeSlash{{
+				Sequence:        0,
+				Height:          ctx.BlockHeight(),
+				Time:            tc.blockTime,
+				ProviderAddress: providerConsAddr.String(),
+				ConsumerAddress: consumerConsAddr.String(),
+				ValsetUpdateId:  0,
+				OptInTime:       optInTime,
+			}}, skippedSlashes)
+			require.Len(t, skippedEvents, 1)
+			attr, found := skippedEvents[0].GetAttribute(providertypes.AttributeProviderValidatorAddress)
+			require.True(t, found)
+			require.Equal(t, providerConsAddr.String(), attr.Value)
+
+			res, err := providerKeeper.QuerySkippedDowntimeSlashes(ctx,
+				&providertypes.QuerySkippedDowntimeSlashesRequest{ConsumerId: consumerId})
+			require.NoError(t, err)
+			require.Equal(t, skippedSlashes, res.SkippedSlashes)
+		})
+	}
+}
+
+// TestSkippedDowntimeSlashesPruningAndCleanUp tests that only the last MaxSkippedDowntimeSlashEntries skipped
+// downtime slashes are kept per consumer chain, and that they are removed once the consumer chain is cleaned up
+func TestSkippedDowntimeSlashesPruningAndCleanUp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	consumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+
+	numRecords := providertypes.MaxSkippedDowntimeSlashEntries + 10
+	for i := 0; i < numRecords; i++ {
+		providerKeeper.RecordSkippedDowntimeSlash(ctx, consumerId, providerAddr, consumerAddr, uint64(i), time.Time{})
+	}
+
+	skippedSlashes, err := providerKeeper.GetSkippedDowntimeSlashes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, skippedSlashes, providertypes.MaxSkippedDowntimeSlashEntries)
+	for i, skippedSlash := range skippedSlashes {
+		expectedSequence := uint64(numRecords - providertypes.MaxSkippedDowntimeSlashEntries + i)
+		require.Equal(t, expectedSequence, skippedSlash.Sequence)
+		require.Equal(t, expectedSequence, skippedSlash.ValsetUpdateId)
+	}
+
+	// the skipped downtime slashes and their sequence number are removed once the consumer chain is cleaned up
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerId)
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	skippedSlashes, err = providerKeeper.GetSkippedDowntimeSlashes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, skippedSlashes)
+	require.Equal(t, uint64(0), providerKeeper.SkippedDowntimeSlashLog().NextSequence(ctx, consumerId))
+}
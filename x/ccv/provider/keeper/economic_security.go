@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// RecordConsumerEconomicSecurity stores the economic security of the consumer chain with `consumerId`
+// backed by the `validators` computed at the current block height, i.e., the total power of the validators
+// and the provider tokens equivalent to this power. It is a no-op if NumberOfEpochsToRetainEconomicSecurity is zero.
+func (k Keeper) RecordConsumerEconomicSecurity(ctx sdk.Context, consumerId string, validators []types.ConsensusValidator) error {
+	if k.GetNumberOfEpochsToRetainEconomicSecurity(ctx) <= 0 {
+		return nil
+	}
+
+	totalPower := int64(0)
+	for _, val := range validators {
+		totalPower += val.Power
+	}
+
+	return k.SetConsumerEconomicSecurity(ctx, types.ConsumerEconomicSecurity{
+		ConsumerId:     consumerId,
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		TotalPower:     totalPower,
+		TotalTokens:    sdk.TokensFromConsensusPower(totalPower, k.stakingKeeper.PowerReduction(ctx)),
+		ValidatorCount: uint32(len(validators)),
+	})
+}
+
+// SetConsumerEconomicSecurity stores the economic security `record` of a consumer chain
+func (k Keeper) SetConsumerEconomicSecurity(ctx sdk.Context, record types.ConsumerEconomicSecurity) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal economic security at height %d for consumer id (%s): %w", record.Height, record.ConsumerId, err)
+	}
+	store.Set(types.ConsumerEconomicSecurityKey(record.ConsumerId, uint64(record.Height)), bz)
+	return nil
+}
+
+// GetConsumerEconomicSecurity returns the economic security records of the consumer chain with `consumerId`
+// stored at heights between `startHeight` and `endHeight` (both inclusive), in ascending order of heights.
+// A zero `startHeight` (or `endHeight`) means that the range has no lower (or upper) bound.
+func (k Keeper) GetConsumerEconomicSecurity(ctx sdk.Context, consumerId string, startHeight, endHeight int64) []types.ConsumerEconomicSecurity {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.StringIdWithLenKey(types.ConsumerEconomicSecurityKeyPrefix(), consumerId)
+	start := types.ConsumerEconomicSecurityKey(consumerId, uint64(startHeight))
+	end := storetypes.PrefixEndBytes(prefix)
+	if endHeight > 0 {
+		end = storetypes.InclusiveEndBytes(types.ConsumerEconomicSecurityKey(consumerId, uint64(endHeight)))
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	records := []types.ConsumerEconomicSecurity{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ConsumerEconomicSecurity
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetConsumerEconomicSecurity.
+			panic(fmt.Errorf("failed to unmarshal economic security for consumer id (%s): %w", consumerId, err))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// DeleteConsumerEconomicSecurity deletes the economic security record of the consumer chain with `consumerId`
+// stored at `height`
+func (k Keeper) DeleteConsumerEconomicSecurity(ctx sdk.Context, consumerId string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerEconomicSecurityKey(consumerId, uint64(height)))
+}
+
+// PruneConsumerEconomicSecurity deletes the economic security records of the consumer chain with `consumerId`
+// that are older than the last NumberOfEpochsToRetainEconomicSecurity epochs. If the records are disabled,
+// all the records are deleted.
+func (k Keeper) PruneConsumerEconomicSecurity(ctx sdk.Context, consumerId string) {
+	windowStart := ctx.BlockHeight() - k.GetNumberOfEpochsToRetainEconomicSecurity(ctx)*k.GetBlocksPerEpoch(ctx)
+	if windowStart <= 0 {
+		// no record is older than the retention window
+		return
+	}
+
+	for _, record := range k.GetConsumerEconomicSecurity(ctx, consumerId, 0, windowStart) {
+		k.DeleteConsumerEconomicSecurity(ctx, consumerId, record.Height)
+		k.Logger(ctx).Debug("consumer economic security record was pruned",
+			"consumerId", consumerId,
+			"height", record.Height,
+		)
+	}
+}
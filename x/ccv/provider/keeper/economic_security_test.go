@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestRecordConsumerEconomicSecurity(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	now := time.Now().UTC()
+	validators := []providertypes.ConsensusValidator{
+		{ProviderConsAddr: []byte("providerAddr1"), Power: 10},
+		{ProviderConsAddr: []byte("providerAddr2"), Power: 5},
+	}
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(now)
+	err := providerKeeper.RecordConsumerEconomicSecurity(ctx, consumerId, validators)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(200).WithBlockTime(now.Add(time.Hour))
+	err = providerKeeper.RecordConsumerEconomicSecurity(ctx, consumerId, validators[:1])
+	require.NoError(t, err)
+
+	expectedRecords := []providertypes.ConsumerEconomicSecurity{
+		{
+			ConsumerId:     consumerId,
+			Height:         100,
+			Time:           now,
+			TotalPower:     15,
+			TotalTokens:    math.NewInt(15000000),
+			ValidatorCount: 2,
+		},
+		{
+			ConsumerId:     consumerId,
+			Height:         200,
+			Time:           now.Add(time.Hour),
+			TotalPower:     10,
+			TotalTokens:    math.NewInt(10000000),
+			ValidatorCount: 1,
+		},
+	}
+	require.Equal(t, expectedRecords, providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 0))
+	require.Empty(t, providerKeeper.GetConsumerEconomicSecurity(ctx, "1", 0, 0))
+
+	// the records are disabled
+	params := providertypes.DefaultParams()
+	params.NumberOfEpochsToRetainEconomicSecurity = 0
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(300)
+	err = providerKeeper.RecordConsumerEconomicSecurity(ctx, consumerId, validators)
+	require.NoError(t, err)
+	require.Equal(t, expectedRecords, providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 0))
+}
+
+func TestGetConsumerEconomicSecurityInRange(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	for _, height := range []int64{10, 20, 30} {
+		err := providerKeeper.SetConsumerEconomicSecurity(ctx, providertypes.ConsumerEconomicSecurity{
+			ConsumerId:  consumerId,
+			Height:      height,
+			TotalPower:  height,
+			TotalTokens: math.NewInt(height),
+		})
+		require.NoError(t, err)
+	}
+
+	heights := func(records []providertypes.ConsumerEconomicSecurity) []int64 {
+		heights := []int64{}
+		for _, record := range records {
+			heights = append(heights, record.Height)
+		}
+		return heights
+	}
+
+	require.Equal(t, []int64{10, 20, 30}, heights(providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 0)))
+	require.Equal(t, []int64{20, 30}, heights(providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 20, 0)))
+	require.Equal(t, []int64{10, 20}, heights(providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 20)))
+	require.Equal(t, []int64{20}, heights(providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 11, 29)))
+	require.Empty(t, providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 31, 0))
+}
+
+func TestPruneConsumerEconomicSecurity(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.NumberOfEpochsToRetainEconomicSecurity = 2
+	providerKeeper.SetParams(ctx, params)
+
+	consumerId := "0"
+	for _, id := range []string{consumerId, "1"} {
+		for _, height := range []int64{10, 20, 30, 40} {
+			err := providerKeeper.SetConsumerEconomicSecurity(ctx, providertypes.ConsumerEconomicSecurity{
+				ConsumerId:  id,
+				Height:      height,
+				TotalTokens: math.ZeroInt(),
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	// the retention window starts after height 15
+	ctx = ctx.WithBlockHeight(35)
+	providerKeeper.PruneConsumerEconomicSecurity(ctx, consumerId)
+	records := providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 0)
+	require.Len(t, records, 3)
+	require.Equal(t, int64(20), records[0].Height)
+	// the records of other consumer chains are not pruned
+	require.Len(t, providerKeeper.GetConsumerEconomicSecurity(ctx, "1", 0, 0), 4)
+
+	// all the records are pruned if the records are disabled
+	params.NumberOfEpochsToRetainEconomicSecurity = 0
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(41)
+	providerKeeper.PruneConsumerEconomicSecurity(ctx, consumerId)
+	require.Empty(t, providerKeeper.GetConsumerEconomicSecurity(ctx, consumerId, 0, 0))
+}
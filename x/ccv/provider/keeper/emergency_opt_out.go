@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// HandleEmergencyOptOut opts out validator `providerAddr` from the launched consumer chain with `consumerId` right away,
+// instead of at the end of the epoch. If the validator set of the consumer chain contains the validator, a VSC packet
+// with a zero-power update for the validator is queued and sent at the end of the block (similar to the removal
+// of tombstoned validators, see RemoveValidatorFromConsumers). In return, the validator is slashed by the
+// `EmergencyOptOutSlashFraction` and cannot opt in to the consumer chain again during the `EmergencyOptOutCooldown`.
+//
+// Note that the validators that have to validate a Top N chain can only emergency opt out if the chain allows it.
+func (k Keeper) HandleEmergencyOptOut(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase == types.CONSUMER_PHASE_UNSPECIFIED {
+		return errorsmod.Wrapf(
+			types.ErrUnknownConsumerId,
+			"emergency opting out of an unknown consumer chain, consumerId(%s)", consumerId,
+		)
+	}
+	if phase != types.CONSUMER_PHASE_LAUNCHED {
+		// A validator can only emergency opt out from a running chain
+		return errorsmod.Wrapf(
+			types.ErrInvalidPhase,
+			"emergency opting out of a consumer chain not yet launched, consumerId(%s)", consumerId,
+		)
+	}
+
+	if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotOptedIn,
+			"validator (%s) is not opted in to the consumer chain with consumer id (%s)", providerAddr.String(), consumerId)
+	}
+
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot get consumer power shaping parameters: %s", err.Error(),
+		)
+	}
+	if powerShapingParameters.Top_N > 0 && !powerShapingParameters.AllowTopNEmergencyOptOut {
+		// a validator in the Top N validators has to validate the chain, unless the chain allows emergency opt outs
+		minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId)
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrUnknownConsumerId,
+				"Could not find minimum power in top N for chain with consumer id: %s", consumerId)
+		}
+		hasToValidate, err := k.HasMinPower(ctx, providerAddr, minPowerInTopN)
+		if err != nil {
+			return err
+		}
+		if hasToValidate {
+			return errorsmod.Wrapf(
+				types.ErrCannotOptOutFromTopN,
+				"validator (%s) cannot emergency opt out from Top N chain with consumer id (%s) because all validators"+
+					" with at least %d power have to validate", providerAddr.String(), consumerId, minPowerInTopN)
+		}
+	}
+
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	removed, err := k.removeValidatorFromConsumer(ctx, consumerId, providerAddr, valUpdateID)
+	if err != nil {
+		return err
+	}
+	if removed {
+		k.IncrementValidatorSetUpdateId(ctx)
+	}
+
+	k.deleteOptedIn(ctx, consumerId, providerAddr)
+
+	slashFraction, slashedAmount, err := k.slashEmergencyOptOut(ctx, providerAddr)
+	if err != nil {
+		return err
+	}
+
+	var cooldownEndTime time.Time
+	if cooldown := k.GetEmergencyOptOutCooldown(ctx); cooldown > 0 {
+		cooldownEndTime = ctx.BlockTime().Add(cooldown)
+		k.SetEmergencyOptOutCooldownEndTime(ctx, consumerId, providerAddr, cooldownEndTime)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+		sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+		sdk.NewAttribute(types.AttributeSlashFraction, slashFraction.String()),
+		sdk.NewAttribute(types.AttributeSlashedAmount, slashedAmount.String()),
+	}
+	if removed {
+		attributes = append(attributes, sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(valUpdateID, 10)))
+	}
+	if !cooldownEndTime.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeCooldownEndTime, cooldownEndTime.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeEmergencyOptOut, attributes...))
+
+	return nil
+}
+
+// slashEmergencyOptOut slashes the validator with `providerAddr` by the `EmergencyOptOutSlashFraction` after an emergency
+// opt out and returns the slash fraction together with the amount of slashed tokens. Unbonded validators are not slashed.
+func (k Keeper) slashEmergencyOptOut(ctx sdk.Context, providerAddr types.ProviderConsAddress) (math.LegacyDec, math.Int, error) {
+	slashFraction, err := math.LegacyNewDecFromStr(k.GetEmergencyOptOutSlashFraction(ctx))
+	if err != nil {
+		return math.LegacyZeroDec(), math.ZeroInt(), fmt.Errorf("invalid emergency opt-out slash fraction: %w", err)
+	}
+	if !slashFraction.IsPositive() {
+		return slashFraction, math.ZeroInt(), nil
+	}
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return slashFraction, math.ZeroInt(), err
+	}
+	if validator.IsUnbonded() {
+		k.Logger(ctx).Info("emergency opt-out slash skipped because validator is unbonded",
+			"provider address", providerAddr.String(),
+		)
+		return slashFraction, math.ZeroInt(), nil
+	}
+
+	// the power is computed from the tokens of the validator, as inactive validators have no last validator power
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	slashedAmount, err := k.stakingKeeper.SlashWithInfractionReason(ctx, providerAddr.ToSdkConsAddr(),
+		ctx.BlockHeight(), power, slashFraction, stakingtypes.Infraction_INFRACTION_UNSPECIFIED)
+	if err != nil {
+		return slashFraction, math.ZeroInt(), err
+	}
+	return slashFraction, slashedAmount, nil
+}
+
+// SetEmergencyOptOutCooldownEndTime sets the time until which the validator with `providerAddr` cannot opt in
+// to the consumer chain with `consumerId` after an emergency opt out
+func (k Keeper) SetEmergencyOptOutCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EmergencyOptOutCooldownKey(consumerId, providerAddr), sdk.FormatTimeBytes(endTime))
+}
+
+// GetEmergencyOptOutCooldownEndTime returns the time until which the validator with `providerAddr` cannot opt in
+// to the consumer chain with `consumerId` after an emergency opt out, and false if no cooldown is recorded
+func (k Keeper) GetEmergencyOptOutCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EmergencyOptOutCooldownKey(consumerId, providerAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	endTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		k.Logger(ctx).Error("failed to parse emergency opt-out cooldown",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"error", err.Error(),
+		)
+		return time.Time{}, false
+	}
+	return endTime, true
+}
+
+// DeleteEmergencyOptOutCooldownEndTime deletes the time until which the validator with `providerAddr` cannot opt in
+// to the consumer chain with `consumerId` after an emergency opt out
+func (k Keeper) DeleteEmergencyOptOutCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EmergencyOptOutCooldownKey(consumerId, providerAddr))
+}
+
+// IsInEmergencyOptOutCooldown returns true if the validator with `providerAddr` cannot opt in to the consumer chain
+// with `consumerId`, since it emergency opted out of it less than the `EmergencyOptOutCooldown` ago, together with
+// the time at which the cooldown ends
+func (k Keeper) IsInEmergencyOptOutCooldown(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	endTime, found := k.GetEmergencyOptOutCooldownEndTime(ctx, consumerId, providerAddr)
+	if !found {
+		return time.Time{}, false
+	}
+	return endTime, ctx.BlockTime().Before(endTime)
+}
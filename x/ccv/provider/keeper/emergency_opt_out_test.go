@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestHandleEmergencyOptOut tests that an emergency opt out removes the validator from the validator set
+// of the consumer chain right away, slashes the validator, and prevents it from opting in during the cooldown
+func TestHandleEmergencyOptOut(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ctx = ctx.WithBlockHeight(100)
+	params := providertypes.DefaultParams()
+	params.EmergencyOptOutSlashFraction = "0.01"
+	params.EmergencyOptOutCooldown = 24 * time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	val := createStakingValidator(ctx, mocks, 1, 1)
+	val.Tokens = sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	valConsAddr, _ := val.GetConsAddr()
+	valPubKey, _ := val.CmtConsPublicKey()
+	providerAddr := providertypes.NewProviderConsAddress(valConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valConsAddr).Return(val, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	// unknown consumer chain
+	err := providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddr)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+
+	// consumer chain not yet launched
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddr)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	// validator not opted in
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddr)
+	require.ErrorIs(t, err, providertypes.ErrValidatorNotOptedIn)
+
+	// the validator is part of the validator set of the consumer chain
+	var sentPackets []ccv.ValidatorSetChangePacketData
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "CCVChannelID")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "CCVChannelID").Return(
+		channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(
+		&capabilitytypes.Capability{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), ccv.ProviderPortID, "CCVChannelID",
+		clienttypes.Height{}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+			var packetData ccv.ValidatorSetChangePacketData
+			require.NoError(t, ccv.ModuleCdc.UnmarshalJSON(data, &packetData))
+			sentPackets = append(sentPackets, packetData)
+			return uint64(len(sentPackets)), nil
+		}).AnyTimes()
+	moduleAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(moduleAcct).AnyTimes()
+
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr)
+	providerKeeper.SetOptInHeight(ctx, CONSUMER_ID, providerAddr, 50)
+	err = providerKeeper.SetConsumerValidator(ctx, CONSUMER_ID, providertypes.ConsensusValidator{
+		ProviderConsAddr: valConsAddr,
+		Power:            1,
+		PublicKey:        &valPubKey,
+	})
+	require.NoError(t, err)
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+
+	mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), valConsAddr, int64(100), int64(1),
+		math.LegacyMustNewDecFromStr("0.01"), stakingtypes.Infraction_INFRACTION_UNSPECIFIED).Return(math.NewInt(10_000), nil).Times(1)
+
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddr)
+	require.NoError(t, err)
+
+	// a zero-power update for the validator is queued and sent at the end of the block
+	require.Empty(t, sentPackets)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+	require.NoError(t, providerKeeper.SendValidatorRemovals(ctx))
+	require.Len(t, sentPackets, 1)
+	require.Equal(t, uint64(5), sentPackets[0].ValsetUpdateId)
+	require.Len(t, sentPackets[0].ValidatorUpdates, 1)
+	require.Equal(t, valPubKey, sentPackets[0].ValidatorUpdates[0].PubKey)
+	require.Equal(t, int64(0), sentPackets[0].ValidatorUpdates[0].Power)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+	require.Equal(t, uint64(6), providerKeeper.GetValidatorSetUpdateId(ctx))
+	vscIdToHeight, found := providerKeeper.GetVscIdToHeight(ctx, CONSUMER_ID, 5)
+	require.True(t, found)
+	require.Equal(t, uint64(101), vscIdToHeight.Height)
+
+	// the validator is opted out
+	require.False(t, providerKeeper.IsConsumerValidator(ctx, CONSUMER_ID, providerAddr))
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+	_, found = providerKeeper.GetOptInHeight(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+
+	// the emergency opt out is reported in an event
+	cooldownEndTime := ctx.BlockTime().Add(24 * time.Hour)
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeEmergencyOptOut {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 1)
+	for key, value := range map[string]string{
+		providertypes.AttributeConsumerId:               CONSUMER_ID,
+		providertypes.AttributeProviderValidatorAddress: providerAddr.String(),
+		providertypes.AttributeSlashFraction:            "0.010000000000000000",
+		providertypes.AttributeSlashedAmount:            "10000",
+		ccv.AttributeValSetUpdateID:                     "5",
+		providertypes.AttributeCooldownEndTime:          cooldownEndTime.String(),
+	} {
+		attr, found := events[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+
+	// the validator cannot opt in during the cooldown
+	endTime, inCooldown := providerKeeper.IsInEmergencyOptOutCooldown(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, inCooldown)
+	require.Equal(t, cooldownEndTime, endTime)
+	res, err := providerKeeper.QueryEmergencyOptOutCooldown(ctx, &providertypes.QueryEmergencyOptOutCooldownRequest{
+		ConsumerId:      CONSUMER_ID,
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, res.InCooldown)
+	require.Equal(t, cooldownEndTime, res.CooldownEndTime)
+	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, "")
+	require.ErrorIs(t, err, providertypes.ErrEmergencyOptOutCooldown)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+
+	// the validator can opt in again once the cooldown elapsed
+	ctx = ctx.WithBlockTime(cooldownEndTime)
+	_, inCooldown = providerKeeper.IsInEmergencyOptOutCooldown(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, inCooldown)
+	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, "")
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+	_, found = providerKeeper.GetEmergencyOptOutCooldownEndTime(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+}
+
+// TestHandleEmergencyOptOutOnTopNChain tests that the validators that have to validate a Top N chain
+// can only emergency opt out if the chain allows it, and that they are not opted in again during the cooldown
+func TestHandleEmergencyOptOutOnTopNChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no slashing with the default params
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	providerAddrA := providertypes.NewProviderConsAddress(valAConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valAConsAddr).Return(valA, nil).AnyTimes()
+	valB := createStakingValidator(ctx, mocks, 3, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	providerAddrB := providertypes.NewProviderConsAddress(valBConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valBConsAddr).Return(valB, nil).AnyTimes()
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	// only validator B has to validate the chain
+	providerKeeper.SetMinimumPowerInTopN(ctx, CONSUMER_ID, 3)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrA)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrB)
+
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddrB)
+	require.ErrorIs(t, err, providertypes.ErrCannotOptOutFromTopN)
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddrA)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrA))
+
+	// the chain allows the validators in the Top N to emergency opt out
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID,
+		providertypes.PowerShapingParameters{Top_N: 50, AllowTopNEmergencyOptOut: true})
+	require.NoError(t, err)
+	err = providerKeeper.HandleEmergencyOptOut(ctx, CONSUMER_ID, providerAddrB)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+
+	// validator B is not opted in again at the end of the epoch during the cooldown
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB}, 3)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+
+	// but once the cooldown elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(providertypes.DefaultEmergencyOptOutCooldown))
+	createStakingValidator(ctx, mocks, 1, 1)
+	createStakingValidator(ctx, mocks, 3, 2)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB}, 3)
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrA))
+}
@@ -42,6 +42,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 			// the ConsumerGenesis validated in ConsumerState.Validate().
 			panic(fmt.Errorf("consumer chain genesis could not be persisted: %w", err))
 		}
+		if len(cs.GenesisHash) > 0 {
+			k.SetConsumerGenesisHash(ctx, chainID, cs.GenesisHash)
+		}
 		// check if the CCV channel was established
 		if cs.ChannelId != "" {
 			k.SetChannelToConsumerId(ctx, cs.ChannelId, chainID)
@@ -51,7 +54,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		} else {
 			k.AppendPendingVSCPackets(ctx, chainID, cs.PendingValsetChanges...)
 		}
+		for _, vscIdToHeight := range cs.VscIdToHeights {
+			k.SetVscIdToHeight(ctx, chainID, vscIdToHeight)
+		}
 	}
+	// the legacy mapping from vscIDs to block heights is moved to the per-consumer mappings
+	k.MigrateLegacyValsetUpdateBlockHeights(ctx)
 
 	// Import key assignment state
 	for _, item := range genState.ValidatorConsumerPubkeys {
@@ -72,6 +80,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		}
 	}
 
+	for _, item := range genState.KeyAssignmentNonces {
+		providerAddr := types.NewProviderConsAddress(item.ProviderAddr)
+		k.SetKeyAssignmentNonce(ctx, item.ConsumerId, providerAddr, item.Nonce)
+	}
+
 	k.SetParams(ctx, genState.Params)
 	k.InitializeSlashMeter(ctx)
 
@@ -136,12 +149,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(fmt.Errorf("cannot find genesis for consumer chain %s with client %s", consumerId, clientId))
 		}
 
+		genesisHash, _ := k.GetConsumerGenesisHash(ctx, consumerId)
+
 		// initial consumer chain states
 		cs := types.ConsumerState{
 			ChainId:         consumerId,
 			ClientId:        clientId,
 			ConsumerGenesis: gen,
 			Phase:           k.GetConsumerPhase(ctx, consumerId),
+			GenesisHash:     genesisHash,
 		}
 
 		// try to find channel id for the current consumer chain
@@ -156,6 +172,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 
 		cs.PendingValsetChanges = k.GetPendingVSCPackets(ctx, consumerId)
+		cs.VscIdToHeights = k.GetAllVscIdToHeights(ctx, consumerId)
 		consumerStates = append(consumerStates, cs)
 	}
 
@@ -168,7 +185,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 
 	// TODO (PERMISSIONLESS)
-	return types.NewGenesisState(
+	genesis := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -177,4 +194,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	// the key assignment nonces are exported so that the key assignments
+	// broadcast before the export cannot be replayed after the import
+	genesis.KeyAssignmentNonces = k.GetAllKeyAssignmentNonces(ctx)
+
+	return genesis
 }
@@ -53,6 +53,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 				[]ccv.ValidatorSetChangePacketData{},
 				[]string{"slashedValidatorConsAddress"},
 				providertypes.CONSUMER_PHASE_LAUNCHED,
+				[]providertypes.VscIdToHeight{{VscId: vscID, Height: initHeight + 1, Timestamp: oneHourFromNow.Add(-2 * time.Hour)}},
 			),
 			providertypes.NewConsumerStates(
 				cChainIDs[1],
@@ -63,6 +64,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 				[]ccv.ValidatorSetChangePacketData{{ValsetUpdateId: vscID}},
 				nil,
 				providertypes.CONSUMER_PHASE_LAUNCHED,
+				nil,
 			),
 		},
 		params,
@@ -89,6 +91,20 @@ func TestInitAndExportGenesis(t *testing.T) {
 		},
 	)
 
+	// the first consumer chain launched after the genesis hash was recorded
+	genesisHash, err := ccv.DefaultConsumerGenesisState().Hash()
+	require.NoError(t, err)
+	provGenesis.ConsumerStates[0].GenesisHash = genesisHash
+
+	// the validator assigned consumer keys on the first consumer chain
+	provGenesis.KeyAssignmentNonces = []providertypes.KeyAssignmentNonce{
+		{
+			ConsumerId:   cChainIDs[0],
+			ProviderAddr: provAddr.ToSdkConsAddr(),
+			Nonce:        3,
+		},
+	}
+
 	// Instantiate in-mem provider keeper with mocks
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -131,9 +147,15 @@ func TestInitAndExportGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, cChainIDs[0], chainID)
 	require.Equal(t, vscID, pk.GetValidatorSetUpdateId(ctx))
-	height, found := pk.GetValsetUpdateBlockHeight(ctx, vscID)
+	// the legacy mapping is moved to the consumer chains that do not map vscID yet
+	_, found = pk.GetValsetUpdateBlockHeight(ctx, vscID)
+	require.False(t, found)
+	vscIdToHeight, found := pk.GetVscIdToHeight(ctx, cChainIDs[0], vscID)
 	require.True(t, found)
-	require.Equal(t, initHeight, height)
+	require.Equal(t, initHeight+1, vscIdToHeight.Height)
+	vscIdToHeight, found = pk.GetVscIdToHeight(ctx, cChainIDs[1], vscID)
+	require.True(t, found)
+	require.Equal(t, providertypes.VscIdToHeight{VscId: vscID, Height: initHeight, Timestamp: ctx.BlockTime()}, vscIdToHeight)
 	require.Equal(t, provGenesis.Params, pk.GetParams(ctx))
 
 	providerConsensusValSet, err := pk.GetLastProviderConsensusValSet(ctx)
@@ -160,11 +182,20 @@ func TestInitAndExportGenesis(t *testing.T) {
 	expectedAddrList := providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}}
 	require.Equal(t, expectedAddrList, addrs)
 
+	require.Equal(t, uint64(3), pk.GetKeyAssignmentNonce(ctx, cChainIDs[0], provAddr))
+	require.Zero(t, pk.GetKeyAssignmentNonce(ctx, cChainIDs[1], provAddr))
+
+	// the legacy mapping is expected to be moved to the consumer chain states
+	expGenesis := *provGenesis
+	expGenesis.ValsetUpdateIdToHeight = nil
+	expGenesis.ConsumerStates = append([]providertypes.ConsumerState{}, provGenesis.ConsumerStates...)
+	expGenesis.ConsumerStates[1].VscIdToHeights = []providertypes.VscIdToHeight{vscIdToHeight}
+
 	// check provider chain's consumer chain states
-	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
+	assertConsumerChainStates(t, ctx, pk, expGenesis.ConsumerStates...)
 
 	// check the exported genesis
-	require.Equal(t, provGenesis, pk.ExportGenesis(ctx))
+	require.Equal(t, &expGenesis, pk.ExportGenesis(ctx))
 }
 
 func assertConsumerChainStates(t *testing.T, ctx sdk.Context, pk keeper.Keeper, consumerStates ...providertypes.ConsumerState) {
@@ -196,5 +227,10 @@ func assertConsumerChainStates(t *testing.T, ctx sdk.Context, pk keeper.Keeper,
 		}
 
 		require.Equal(t, cs.SlashDowntimeAck, pk.GetSlashAcks(ctx, chainID))
+
+		genesisHash, found := pk.GetConsumerGenesisHash(ctx, chainID)
+		require.Equal(t, len(cs.GenesisHash) > 0, found)
+		require.Equal(t, cs.GenesisHash, genesisHash)
+		require.Equal(t, cs.VscIdToHeights, pk.GetAllVscIdToHeights(ctx, chainID))
 	}
 }
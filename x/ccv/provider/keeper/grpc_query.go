@@ -4,13 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,7 +49,22 @@ func (k Keeper) QueryConsumerGenesis(c context.Context, req *types.QueryConsumer
 		)
 	}
 
-	return &types.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+	genesisHash, _ := k.GetConsumerGenesisHash(ctx, consumerId)
+
+	var transformedGen string
+	if req.TargetVersion != "" {
+		bz, err := ccvtypes.TransformConsumerGenesis(gen, req.TargetVersion)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		transformedGen = string(bz)
+	}
+
+	return &types.QueryConsumerGenesisResponse{
+		GenesisState:            gen,
+		GenesisHash:             hex.EncodeToString(genesisHash),
+		TransformedGenesisState: transformedGen,
+	}, nil
 }
 
 func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsumerChainsRequest) (*types.QueryConsumerChainsResponse, error) {
@@ -51,32 +73,55 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var chains []*types.Chain
 
-	store := ctx.KVStore(k.storeKey)
-	storePrefix := types.ConsumerIdToPhaseKeyPrefix()
-	consumerPhaseStore := prefix.NewStore(store, []byte{storePrefix})
-	pageRes, err := query.Paginate(consumerPhaseStore, req.Pagination, func(key, value []byte) error {
-		consumerId, err := types.ParseStringIdWithLenKey(storePrefix, append([]byte{storePrefix}, key...))
-		if err != nil {
-			return status.Error(codes.Internal, err.Error())
+	// collect the consumer chains with the requested phase
+	type consumerChainKey struct {
+		consumerId string
+		chainId    string
+		phase      types.ConsumerPhase
+	}
+	var keys []consumerChainKey
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		phase := k.GetConsumerPhase(ctx, consumerId)
+		if phase == types.CONSUMER_PHASE_UNSPECIFIED {
+			continue
 		}
-
-		phase := types.ConsumerPhase(binary.BigEndian.Uint32(value))
 		if req.Phase != types.CONSUMER_PHASE_UNSPECIFIED && req.Phase != phase {
-			return nil
+			continue
 		}
-
-		c, err := k.GetConsumerChain(ctx, consumerId)
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
 		if err != nil {
-			return status.Error(codes.Internal, err.Error())
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-		chains = append(chains, &c)
-		return nil
+		keys = append(keys, consumerChainKey{consumerId: consumerId, chainId: chainId, phase: phase})
+	}
+
+	// sort the chains by phase and then by chain id (and consumer id for chains with the same chain id),
+	// so that the ordering of the response is deterministic and meaningful for explorers;
+	// note that the chains are sorted before paginating, so that the ordering holds across pages
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].phase != keys[j].phase {
+			return keys[i].phase < keys[j].phase
+		}
+		if keys[i].chainId != keys[j].chainId {
+			return keys[i].chainId < keys[j].chainId
+		}
+		return keys[i].consumerId < keys[j].consumerId
 	})
 
+	// the page size is not capped beyond the number of consumer chains, as with the store pagination
+	start, end, pageRes, err := paginateSlice(len(keys), req.Pagination, query.DefaultLimit, uint64(len(keys)))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var chains []*types.Chain
+	for _, key := range keys[start:end] {
+		c, err := k.GetConsumerChain(ctx, key.consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		chains = append(chains, &c)
 	}
 
 	return &types.QueryConsumerChainsResponse{Chains: chains, Pagination: pageRes}, nil
@@ -102,13 +147,21 @@ func (k Keeper) GetConsumerChain(ctx sdk.Context, consumerId string) (types.Chai
 		minPowerInTopN = -1
 	}
 
-	allowlist := k.GetAllowList(ctx, consumerId)
+	phase := k.GetConsumerPhase(ctx, consumerId)
+
+	// the allowlist and denylist of deleted consumer chains might be partially removed,
+	// see EndBlockCleanupDeletedConsumers
+	var allowlist, denylist []types.ProviderConsAddress
+	if phase != types.CONSUMER_PHASE_DELETED {
+		allowlist = k.GetAllowList(ctx, consumerId)
+		denylist = k.GetDenyList(ctx, consumerId)
+	}
+
 	strAllowlist := make([]string, len(allowlist))
 	for i, addr := range allowlist {
 		strAllowlist[i] = addr.String()
 	}
 
-	denylist := k.GetDenyList(ctx, consumerId)
 	strDenylist := make([]string, len(denylist))
 	for i, addr := range denylist {
 		strDenylist[i] = addr.String()
@@ -124,21 +177,43 @@ func (k Keeper) GetConsumerChain(ctx sdk.Context, consumerId string) (types.Chai
 		return types.Chain{}, fmt.Errorf("cannot find allowlisted reward denoms (%s): %s", consumerId, err.Error())
 	}
 
+	var lastPacketReceivedTime *time.Time
+	if receivedTime, found := k.GetLastPacketReceivedTime(ctx, consumerId); found {
+		lastPacketReceivedTime = &receivedTime
+	}
+
+	var creationRecord *types.ConsumerCreationRecord
+	if record, found := k.GetConsumerCreationRecord(ctx, consumerId); found {
+		creationRecord = &record
+	}
+
+	var removalRecord *types.ConsumerRemovalRecord
+	if record, found := k.GetConsumerRemovalRecord(ctx, consumerId); found {
+		removalRecord = &record
+	}
+
 	return types.Chain{
-		ChainId:                 chainID,
-		ClientId:                clientID,
-		Top_N:                   powerShapingParameters.Top_N,
-		MinPowerInTop_N:         minPowerInTopN,
-		ValidatorSetCap:         powerShapingParameters.ValidatorSetCap,
-		ValidatorsPowerCap:      powerShapingParameters.ValidatorsPowerCap,
-		Allowlist:               strAllowlist,
-		Denylist:                strDenylist,
-		Phase:                   k.GetConsumerPhase(ctx, consumerId).String(),
-		Metadata:                metadata,
-		AllowInactiveVals:       powerShapingParameters.AllowInactiveVals,
-		MinStake:                powerShapingParameters.MinStake,
-		ConsumerId:              consumerId,
-		AllowlistedRewardDenoms: &types.AllowlistedRewardDenoms{Denoms: allowlistedRewardDenoms},
+		ChainId:                  chainID,
+		ClientId:                 clientID,
+		Top_N:                    powerShapingParameters.Top_N,
+		MinPowerInTop_N:          minPowerInTopN,
+		ValidatorSetCap:          powerShapingParameters.ValidatorSetCap,
+		ValidatorsPowerCap:       powerShapingParameters.ValidatorsPowerCap,
+		Allowlist:                strAllowlist,
+		Denylist:                 strDenylist,
+		Phase:                    types.PhaseToString(phase),
+		Metadata:                 metadata,
+		AllowInactiveVals:        powerShapingParameters.AllowInactiveVals,
+		MinStake:                 powerShapingParameters.MinStake,
+		MaxProviderRank:          powerShapingParameters.MaxProviderRank,
+		ConsumerId:               consumerId,
+		AllowlistedRewardDenoms:  &types.AllowlistedRewardDenoms{Denoms: allowlistedRewardDenoms},
+		Dormant:                  k.IsConsumerDormant(ctx, consumerId),
+		LastPacketReceivedTime:   lastPacketReceivedTime,
+		Verified:                 k.IsConsumerVerified(ctx, consumerId),
+		EvidenceSubmissionPaused: k.IsConsumerEvidenceSubmissionPaused(ctx, consumerId),
+		CreationRecord:           creationRecord,
+		RemovalRecord:            removalRecord,
 	}, nil
 }
 
@@ -159,10 +234,11 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 		return nil, err
 	}
 	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+	nonce := k.GetKeyAssignmentNonce(ctx, consumerId, providerAddr)
 
 	consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
 	if !found {
-		return &types.QueryValidatorConsumerAddrResponse{}, nil
+		return &types.QueryValidatorConsumerAddrResponse{KeyAssignmentNonce: nonce}, nil
 	}
 
 	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
@@ -171,7 +247,8 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 	}
 
 	return &types.QueryValidatorConsumerAddrResponse{
-		ConsumerAddress: consumerAddr.String(),
+		ConsumerAddress:    consumerAddr.String(),
+		KeyAssignmentNonce: nonce,
 	}, nil
 }
 
@@ -295,12 +372,26 @@ func (k Keeper) QueryConsumerChainOptedInValidators(goCtx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
 	}
 
+	validators := []*types.QueryConsumerChainOptedInValidatorsValidator{}
 	for _, v := range k.GetAllOptedIn(ctx, consumerId) {
 		optedInVals = append(optedInVals, v.ToSdkConsAddr().String())
+
+		providerVal, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, v.ToSdkConsAddr())
+		if err != nil {
+			k.Logger(ctx).Error("cannot find validator for provider address", "address", v.String())
+			continue
+		}
+		consumerRate, source := k.GetEffectiveConsumerCommissionRate(ctx, consumerId, v, providerVal)
+		validators = append(validators, &types.QueryConsumerChainOptedInValidatorsValidator{
+			ProviderAddress:              v.ToSdkConsAddr().String(),
+			ConsumerCommissionRate:       consumerRate,
+			ConsumerCommissionRateSource: source,
+		})
 	}
 
 	return &types.QueryConsumerChainOptedInValidatorsResponse{
 		ValidatorsProviderAddresses: optedInVals,
+		Validators:                  validators,
 	}, nil
 }
 
@@ -334,8 +425,8 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		//  if the consumer hasn't been launched or stopped, compute the consumer validator set
-	} else if phase != types.CONSUMER_PHASE_STOPPED {
+		//  if the consumer hasn't been launched, stopped or deleted, compute the consumer validator set
+	} else if phase != types.CONSUMER_PHASE_STOPPED && phase != types.CONSUMER_PHASE_DELETED {
 		bondedValidators, err := k.GetLastBondedValidators(ctx)
 		if err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get last validators: %s", err))
@@ -358,7 +449,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			}
 		}
 
-		consumerValSet, err = k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+		consumerValSet, _, err = k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
 		if err != nil {
 			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to compute the next validators for chain %s: %s", consumerId, err))
 		}
@@ -390,10 +481,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			continue
 		}
 
-		consumerRate, found := k.GetConsumerCommissionRate(ctx, consumerId, types.NewProviderConsAddress(consAddr))
-		if !found {
-			consumerRate = providerVal.Commission.Rate
-		}
+		consumerRate, _ := k.GetEffectiveConsumerCommissionRate(ctx, consumerId, provAddr, providerVal)
 
 		validators = append(validators, &types.QueryConsumerValidatorsValidator{
 			ProviderAddress:         sdk.ConsAddress(consumerVal.ProviderConsAddr).String(),
@@ -494,7 +582,7 @@ func (k Keeper) hasToValidate(
 	if err != nil {
 		return false, err
 	}
-	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, lastVals, powerShapingParameters, minPowerToOptIn)
+	nextValidators, _, err := k.ComputeNextValidators(ctx, consumerId, lastVals, powerShapingParameters, minPowerToOptIn)
 	if err != nil {
 		return false, err
 	}
@@ -549,6 +637,95 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 	return res, nil
 }
 
+// QueryValidatorConsumerAvailability returns whether the validator is available to validate the consumer chain
+func (k Keeper) QueryValidatorConsumerAvailability(goCtx context.Context, req *types.QueryValidatorConsumerAvailabilityRequest) (*types.QueryValidatorConsumerAvailabilityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
+	}
+
+	return &types.QueryValidatorConsumerAvailabilityResponse{
+		Available: !k.IsValidatorUnavailable(ctx, consumerId, types.NewProviderConsAddress(consAddr)),
+	}, nil
+}
+
+// QueryEmergencyOptOutCooldown returns whether the validator cannot opt in to the consumer chain
+// after an emergency opt out, together with the end of the cooldown
+func (k Keeper) QueryEmergencyOptOutCooldown(goCtx context.Context, req *types.QueryEmergencyOptOutCooldownRequest) (*types.QueryEmergencyOptOutCooldownResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
+	}
+
+	res := &types.QueryEmergencyOptOutCooldownResponse{}
+	if endTime, inCooldown := k.IsInEmergencyOptOutCooldown(ctx, consumerId, types.NewProviderConsAddress(consAddr)); inCooldown {
+		res.InCooldown = true
+		res.CooldownEndTime = endTime
+	}
+	return res, nil
+}
+
+// QueryReOptInCooldown returns whether the validator cannot validate the consumer chain after being jailed
+// for an infraction committed on it, together with the end of the cooldown
+func (k Keeper) QueryReOptInCooldown(goCtx context.Context, req *types.QueryReOptInCooldownRequest) (*types.QueryReOptInCooldownResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
+	}
+
+	res := &types.QueryReOptInCooldownResponse{}
+	if endTime, inCooldown := k.IsInReOptInCooldown(ctx, consumerId, types.NewProviderConsAddress(consAddr)); inCooldown {
+		res.InCooldown = true
+		res.CooldownEndTime = endTime
+	}
+	return res, nil
+}
+
 // QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
 func (k Keeper) QueryBlocksUntilNextEpoch(goCtx context.Context, req *types.QueryBlocksUntilNextEpochRequest) (*types.QueryBlocksUntilNextEpochResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -559,6 +736,40 @@ func (k Keeper) QueryBlocksUntilNextEpoch(goCtx context.Context, req *types.Quer
 	return &types.QueryBlocksUntilNextEpochResponse{BlocksUntilNextEpoch: uint64(blocksUntilNextEpoch)}, nil
 }
 
+// QueryNextEpoch returns the current epoch and the height (and estimated time) at which the next epoch starts
+func (k Keeper) QueryNextEpoch(goCtx context.Context, req *types.QueryNextEpochRequest) (*types.QueryNextEpochResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochInfo := k.GetCurrentEpochInfo(ctx)
+	nextEpochStartHeight := k.NextEpochStartHeight(ctx)
+	blocksUntilNextEpoch := nextEpochStartHeight - ctx.BlockHeight()
+
+	// use the average block time of the current epoch or, if no block of the current epoch
+	// elapsed yet (or the start of the epoch was not materialized), of the previous epoch
+	averageBlockTime := epochInfo.AverageBlockTime
+	if _, found := k.GetEpochInfo(ctx); found && ctx.BlockHeight() > epochInfo.StartHeight {
+		averageBlockTime = ctx.BlockTime().Sub(epochInfo.StartTime) / time.Duration(ctx.BlockHeight()-epochInfo.StartHeight)
+	}
+
+	var estimatedNextEpochStartTime *time.Time
+	if averageBlockTime > 0 {
+		estimatedTime := ctx.BlockTime().Add(averageBlockTime * time.Duration(blocksUntilNextEpoch))
+		estimatedNextEpochStartTime = &estimatedTime
+	}
+
+	return &types.QueryNextEpochResponse{
+		CurrentEpoch:                epochInfo.Index,
+		CurrentEpochStartHeight:     epochInfo.StartHeight,
+		CurrentEpochEndHeight:       nextEpochStartHeight - 1,
+		NextEpochStartHeight:        nextEpochStartHeight,
+		BlocksUntilNextEpoch:        uint64(blocksUntilNextEpoch),
+		EstimatedNextEpochStartTime: estimatedNextEpochStartTime,
+	}, nil
+}
+
 // QueryConsumerIdFromClientId returns the consumer id of the chain associated with this client id
 func (k Keeper) QueryConsumerIdFromClientId(goCtx context.Context, req *types.QueryConsumerIdFromClientIdRequest) (*types.QueryConsumerIdFromClientIdResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -607,13 +818,1136 @@ func (k Keeper) QueryConsumerChain(goCtx context.Context, req *types.QueryConsum
 	initParams, _ := k.GetConsumerInitializationParameters(ctx, consumerId)
 	powerParams, _ := k.GetConsumerPowerShapingParameters(ctx, consumerId)
 
+	// the endpoints are optional as well
+	var endpointInfo *types.EndpointInfo
+	if info, err := k.GetConsumerEndpointInfo(ctx, consumerId); err == nil {
+		endpointInfo = &info
+	}
+
+	powerShapingAdmin, _ := k.GetConsumerPowerShapingAdmin(ctx, consumerId)
+
+	upgradeNotices, err := k.GetConsumerUpgradeNotices(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot retrieve upgrade notices for consumer id: %s", consumerId)
+	}
+
+	heldBackRewards, err := k.GetConsumerHeldBackRewards(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot retrieve held back rewards for consumer id: %s", consumerId)
+	}
+
+	var sunsetTime *time.Time
+	var remainingLifetime *time.Duration
+	if lifetime, found := k.GetConsumerLifetime(ctx, consumerId); found {
+		remaining := max(lifetime.SunsetTime.Sub(ctx.BlockTime()), 0)
+		sunsetTime = &lifetime.SunsetTime
+		remainingLifetime = &remaining
+	}
+
+	var creationRecord *types.ConsumerCreationRecord
+	if record, found := k.GetConsumerCreationRecord(ctx, consumerId); found {
+		creationRecord = &record
+	}
+
+	var removalRecord *types.ConsumerRemovalRecord
+	if record, found := k.GetConsumerRemovalRecord(ctx, consumerId); found {
+		removalRecord = &record
+	}
+
 	return &types.QueryConsumerChainResponse{
 		ChainId:            chainId,
 		ConsumerId:         consumerId,
 		OwnerAddress:       ownerAddress,
-		Phase:              phase.String(),
+		Phase:              types.PhaseToString(phase),
 		Metadata:           metadata,
 		InitParams:         &initParams,
 		PowerShapingParams: &powerParams,
+		EndpointInfo:       endpointInfo,
+		PowerShapingAdmin:  powerShapingAdmin,
+		SunsetTime:         sunsetTime,
+		RemainingLifetime:  remainingLifetime,
+		RemovalInitiator:   k.GetConsumerRemovalInitiator(ctx, consumerId),
+		UpgradeNotices:     upgradeNotices,
+		RewardsPaused:      k.IsConsumerRewardsPaused(ctx, consumerId),
+		HeldBackRewards:    heldBackRewards,
+		CreationRecord:     creationRecord,
+		RemovalRecord:      removalRecord,
+	}, nil
+}
+
+// QueryValidatorProviderExposure returns the exposure of a given validator to the launched consumer chains,
+// i.e., all the consumer chains the validator is either a consumer validator of or opted in to
+func (k Keeper) QueryValidatorProviderExposure(goCtx context.Context, req *types.QueryValidatorProviderExposureRequest) (*types.QueryValidatorProviderExposureResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ProviderOperatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider operator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown validator: %s", req.ProviderOperatorAddress))
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	provAddr := types.NewProviderConsAddress(consAddr)
+
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	topNPower, err := k.GetTopNPower(ctx, valAddr, power)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	consumers := []types.ValidatorConsumerExposure{}
+	// only launched consumer chains have a consumer validator set
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		consumerVal, inValset := k.GetConsumerValidator(ctx, consumerId, provAddr)
+		optedIn := k.IsOptedIn(ctx, consumerId, provAddr)
+		if !inValset && !optedIn {
+			continue
+		}
+
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// the validator uses its provider key on the consumer chain unless it assigned a consumer key
+		consumerAddr := sdk.ConsAddress(consAddr)
+		if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, provAddr); found {
+			consumerAddr, err = ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		// a validator is forced to validate a Top N chain if its power is at least the minimum power in the Top N
+		forcedByTopN := false
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if powerShapingParameters.Top_N > 0 {
+			if minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId); found {
+				forcedByTopN = topNPower >= minPowerInTopN
+			}
+		}
+
+		consumerRate, _ := k.GetEffectiveConsumerCommissionRate(ctx, consumerId, provAddr, validator)
+
+		upgradeNotices, err := k.GetConsumerUpgradeNotices(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		consumers = append(consumers, types.ValidatorConsumerExposure{
+			ConsumerId:             consumerId,
+			ChainId:                chainId,
+			ConsumerAddress:        consumerAddr.String(),
+			InValidatorSet:         inValset,
+			ConsumerPower:          consumerVal.Power,
+			OptedIn:                optedIn,
+			ForcedByTopN:           forcedByTopN,
+			PendingOptOut:          inValset && !optedIn,
+			ConsumerCommissionRate: consumerRate,
+			UpgradeNotices:         upgradeNotices,
+		})
+	}
+
+	return &types.QueryValidatorProviderExposureResponse{
+		ProviderAddress: provAddr.String(),
+		Consumers:       consumers,
+	}, nil
+}
+
+// QueryConsumerTopology returns the provider-side IBC client, connection and channels of every launched consumer chain
+func (k Keeper) QueryConsumerTopology(goCtx context.Context, req *types.QueryConsumerTopologyRequest) (*types.QueryConsumerTopologyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	topologies := []types.ConsumerTopology{}
+
+	store := ctx.KVStore(k.storeKey)
+	storePrefix := types.ConsumerIdToPhaseKeyPrefix()
+	consumerPhaseStore := prefix.NewStore(store, []byte{storePrefix})
+	pageRes, err := query.Paginate(consumerPhaseStore, req.Pagination, func(key, value []byte) error {
+		consumerId, err := types.ParseStringIdWithLenKey(storePrefix, append([]byte{storePrefix}, key...))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		phase := types.ConsumerPhase(binary.BigEndian.Uint32(value))
+		if phase != types.CONSUMER_PHASE_LAUNCHED {
+			return nil
+		}
+
+		topology, err := k.GetConsumerTopology(ctx, consumerId)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		topologies = append(topologies, topology)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerTopologyResponse{Topologies: topologies, Pagination: pageRes}, nil
+}
+
+// GetConsumerTopology returns the provider-side IBC client, connection and channels of the consumer chain with `consumerId`.
+// The channel related fields are empty if the CCV channel is not yet established.
+func (k Keeper) GetConsumerTopology(ctx sdk.Context, consumerId string) (types.ConsumerTopology, error) {
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return types.ConsumerTopology{}, fmt.Errorf("cannot find chainID for consumer (%s)", consumerId)
+	}
+
+	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+
+	// the initialization parameters are not mandatory for consumers
+	initParams, _ := k.GetConsumerInitializationParameters(ctx, consumerId)
+
+	topology := types.ConsumerTopology{
+		ConsumerId:                      consumerId,
+		ChainId:                         chainId,
+		ClientId:                        clientId,
+		DistributionTransmissionChannel: initParams.DistributionTransmissionChannel,
+	}
+
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return topology, nil
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, ccvtypes.ProviderPortID, channelId)
+	if !found {
+		return topology, nil
+	}
+
+	topology.ChannelId = channelId
+	topology.ChannelState = channel.State.String()
+	topology.CounterpartyChannelId = channel.Counterparty.ChannelId
+	if len(channel.ConnectionHops) > 0 {
+		topology.ConnectionId = channel.ConnectionHops[0]
+	}
+	topology.Established = channel.State == channeltypes.OPEN
+
+	return topology, nil
+}
+
+// QueryVscIdToHeight returns the provider block height (and time) to which
+// a valset update id sent to a consumer chain is mapped
+func (k Keeper) QueryVscIdToHeight(goCtx context.Context, req *types.QueryVscIdToHeightRequest) (*types.QueryVscIdToHeightResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	vscIdToHeight, found := k.GetVscIdToHeight(ctx, consumerId, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no height mapped to vscID %d for consumer id: %s", req.VscId, consumerId)
+	}
+
+	return &types.QueryVscIdToHeightResponse{
+		Height:    vscIdToHeight.Height,
+		Timestamp: vscIdToHeight.Timestamp,
+	}, nil
+}
+
+// QueryConsumerRewardChannel returns the transfer channel on the provider
+// registered as the source of the ICS rewards of a consumer chain
+func (k Keeper) QueryConsumerRewardChannel(goCtx context.Context, req *types.QueryConsumerRewardChannelRequest) (*types.QueryConsumerRewardChannelResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	channelId, found := k.GetConsumerRewardChannel(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no reward channel registered for consumer id: %s", consumerId)
+	}
+
+	return &types.QueryConsumerRewardChannelResponse{ChannelId: channelId}, nil
+}
+
+// QueryConsumerEndpoints returns the endpoints (e.g., peers, RPC and genesis URLs) advertised for a consumer chain
+func (k Keeper) QueryConsumerEndpoints(goCtx context.Context, req *types.QueryConsumerEndpointsRequest) (*types.QueryConsumerEndpointsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	endpointInfo, err := k.GetConsumerEndpointInfo(ctx, consumerId)
+	if err != nil {
+		if errors.Is(err, ccvtypes.ErrStoreKeyNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no endpoints advertised for consumer id: %s", consumerId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerEndpointsResponse{EndpointInfo: endpointInfo}, nil
+}
+
+// QuerySecurityOverview returns the security overview of every launched or initialized consumer chain,
+// as well as the number of consumer chains every bonded validator would secure
+func (k Keeper) QuerySecurityOverview(goCtx context.Context, req *types.QuerySecurityOverviewRequest) (*types.QuerySecurityOverviewResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	overview, err := k.ComputeSecurityOverview(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return overview, nil
+}
+
+// QueryConsumersByOwner returns the consumer chains owned by the given owner address
+func (k Keeper) QueryConsumersByOwner(goCtx context.Context, req *types.QueryConsumersByOwnerRequest) (*types.QueryConsumersByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.OwnerAddress); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address %s: %s", req.OwnerAddress, err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var chains []*types.Chain
+	store := ctx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, types.OwnerAddressToConsumerIdsKeyPrefix(req.OwnerAddress))
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key, value []byte) error {
+		c, err := k.GetConsumerChain(ctx, string(key))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		chains = append(chains, &c)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumersByOwnerResponse{Chains: chains, Pagination: pageRes}, nil
+}
+
+// QueryConsumerChainIdConflicts returns the chain ids used by more than one registered, initialized, or launched consumer chain
+func (k Keeper) QueryConsumerChainIdConflicts(goCtx context.Context, req *types.QueryConsumerChainIdConflictsRequest) (*types.QueryConsumerChainIdConflictsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryConsumerChainIdConflictsResponse{Conflicts: k.GetConsumerChainIdConflicts(ctx)}, nil
+}
+
+// ComputeSecurityOverview computes, for every launched or initialized consumer chain, the validators
+// that would be selected if the consumer validator set was computed now and the fraction of the
+// total bonded power on the provider they represent. The computation is done on a cached context
+// that is discarded, and the bonded validators are retrieved only once for all the consumer chains.
+func (k Keeper) ComputeSecurityOverview(ctx sdk.Context) (*types.QuerySecurityOverviewResponse, error) {
+	cachedCtx, _ := ctx.CacheContext()
+
+	validatorSet, err := k.GetLastBondedValidatorSet(cachedCtx)
+	if err != nil {
+		return nil, fmt.Errorf("getting bonded validators: %w", err)
+	}
+
+	totalPower := int64(0)
+	validators := make([]types.ValidatorSecurityOverview, 0, len(validatorSet.Bonded))
+	validatorIndexes := map[string]int{}
+	for _, val := range validatorSet.Bonded {
+		validatorIndexes[val.ProviderAddr.String()] = len(validators)
+		validators = append(validators, types.ValidatorSecurityOverview{
+			ProviderAddress: val.ProviderAddr.String(),
+			Power:           val.Power,
+		})
+		totalPower += val.Power
+	}
+
+	consumers := []types.ConsumerSecurityOverview{}
+	for _, consumerId := range k.GetAllConsumerIds(cachedCtx) {
+		phase := k.GetConsumerPhase(cachedCtx, consumerId)
+		if phase != types.CONSUMER_PHASE_LAUNCHED && phase != types.CONSUMER_PHASE_INITIALIZED {
+			continue
+		}
+
+		chainId, err := k.GetConsumerChainId(cachedCtx, consumerId)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find chain id for consumer (%s): %w", consumerId, err)
+		}
+
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(cachedCtx, consumerId)
+		if err != nil {
+			return nil, fmt.Errorf("getting power shaping params for consumer (%s): %w", consumerId, err)
+		}
+
+		minPower := int64(0)
+		if powerShapingParameters.Top_N > 0 {
+			minPower, err = computeMinPowerInTopN(validatorSet.Active, powerShapingParameters.Top_N)
+			if err != nil {
+				return nil, fmt.Errorf("computing min power to opt in for consumer (%s): %w", consumerId, err)
+			}
+		}
+
+		nextValidators, evictedValidators, err := k.computeNextValidators(cachedCtx, consumerId, validatorSet, powerShapingParameters, minPower)
+		if err != nil {
+			return nil, fmt.Errorf("computing next validators for consumer (%s): %w", consumerId, err)
+		}
+
+		optedInValidators := []string{}
+		for _, providerAddr := range k.GetAllOptedIn(cachedCtx, consumerId) {
+			optedInValidators = append(optedInValidators, providerAddr.String())
+		}
+
+		nextValidatorAddrs := []string{}
+		selectedPower := int64(0)
+		for _, val := range nextValidators {
+			providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+			nextValidatorAddrs = append(nextValidatorAddrs, providerAddr.String())
+			if i, found := validatorIndexes[providerAddr.String()]; found {
+				selectedPower += validators[i].Power
+				validators[i].NumSecuredConsumers++
+			}
+		}
+
+		// the validators of the current consumer validator set that would be evicted due to the validator-set cap
+		currentValidators, err := k.GetConsumerValSet(cachedCtx, consumerId)
+		if err != nil {
+			return nil, fmt.Errorf("getting current validators for consumer (%s): %w", consumerId, err)
+		}
+		evictedValidatorAddrs := []string{}
+		for _, val := range FilterEvictedValidators(currentValidators, evictedValidators) {
+			providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+			evictedValidatorAddrs = append(evictedValidatorAddrs, providerAddr.String())
+		}
+
+		securityFraction := math.LegacyZeroDec()
+		if totalPower > 0 {
+			securityFraction = math.LegacyNewDec(selectedPower).QuoInt64(totalPower)
+		}
+
+		consumers = append(consumers, types.ConsumerSecurityOverview{
+			ConsumerId:        consumerId,
+			ChainId:           chainId,
+			Phase:             types.PhaseToString(phase),
+			OptedInValidators: optedInValidators,
+			NextValidators:    nextValidatorAddrs,
+			SelectedPower:     selectedPower,
+			SecurityFraction:  securityFraction,
+			EvictedValidators: evictedValidatorAddrs,
+		})
+	}
+
+	return &types.QuerySecurityOverviewResponse{
+		TotalBondedPower: totalPower,
+		Consumers:        consumers,
+		Validators:       validators,
+	}, nil
+}
+
+// QueryConsumerChainsCarryingValidator returns the consumer chains that still carry the given validator
+func (k Keeper) QueryConsumerChainsCarryingValidator(goCtx context.Context, req *types.QueryConsumerChainsCarryingValidatorRequest) (*types.QueryConsumerChainsCarryingValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ProviderAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty provider address")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryConsumerChainsCarryingValidatorResponse{
+		ConsumerIds: k.GetConsumerChainsCarryingValidator(ctx, types.NewProviderConsAddress(consAddr)),
+	}, nil
+}
+
+// QueryConsumerLaunchChecklist returns the checks that a consumer chain has to pass before it can launch
+func (k Keeper) QueryConsumerLaunchChecklist(goCtx context.Context, req *types.QueryConsumerLaunchChecklistRequest) (*types.QueryConsumerLaunchChecklistResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	checks, err := k.GetConsumerLaunchChecklist(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ready := true
+	for _, check := range checks {
+		ready = ready && check.Passed
+	}
+
+	return &types.QueryConsumerLaunchChecklistResponse{
+		Checks: checks,
+		Ready:  ready,
+	}, nil
+}
+
+// QueryConsumerChainsFullDump returns all the stored parameters of the consumer chains, ordered by consumer id.
+// The consumer chains in the deleted phase are only returned if requested.
+func (k Keeper) QueryConsumerChainsFullDump(goCtx context.Context, req *types.QueryConsumerChainsFullDumpRequest) (*types.QueryConsumerChainsFullDumpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var dumps []types.ConsumerChainDump
+	store := ctx.KVStore(k.storeKey)
+	storePrefix := types.ConsumerIdToPhaseKeyPrefix()
+	consumerPhaseStore := prefix.NewStore(store, []byte{storePrefix})
+	pageRes, err := query.FilteredPaginate(consumerPhaseStore, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		phase := types.ConsumerPhase(binary.BigEndian.Uint32(value))
+		if phase == types.CONSUMER_PHASE_DELETED && !req.IncludeDeleted {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		consumerId, err := types.ParseStringIdWithLenKey(storePrefix, append([]byte{storePrefix}, key...))
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		dump, err := k.GetConsumerChainDump(ctx, consumerId)
+		if err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+		dumps = append(dumps, dump)
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerChainsFullDumpResponse{ConsumerChains: dumps, Pagination: pageRes}, nil
+}
+
+// GetConsumerChainDump returns all the stored parameters of the given consumer chain
+func (k Keeper) GetConsumerChainDump(ctx sdk.Context, consumerId string) (types.ConsumerChainDump, error) {
+	chain, err := k.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: consumerId})
+	if err != nil {
+		return types.ConsumerChainDump{}, err
+	}
+
+	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+	channelId, _ := k.GetConsumerIdToChannelId(ctx, consumerId)
+
+	var removalTime *time.Time
+	if t, err := k.GetConsumerRemovalTime(ctx, consumerId); err == nil {
+		removalTime = &t
+	}
+
+	// the allowlist and denylist of deleted consumer chains might be partially removed,
+	// see EndBlockCleanupDeletedConsumers
+	var allowlist, denylist []string
+	if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_DELETED {
+		for _, addr := range k.GetAllowList(ctx, consumerId) {
+			allowlist = append(allowlist, addr.String())
+		}
+		for _, addr := range k.GetDenyList(ctx, consumerId) {
+			denylist = append(denylist, addr.String())
+		}
+	}
+
+	return types.ConsumerChainDump{
+		Chain:       *chain,
+		ClientId:    clientId,
+		ChannelId:   channelId,
+		RemovalTime: removalTime,
+		Allowlist:   allowlist,
+		Denylist:    denylist,
+	}, nil
+}
+
+// QueryConsumerValidatorSetAtHeight returns the validator set of a consumer chain
+// that was in effect on the provider at a given height
+func (k Keeper) QueryConsumerValidatorSetAtHeight(goCtx context.Context, req *types.QueryConsumerValidatorSetAtHeightRequest) (*types.QueryConsumerValidatorSetAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %d", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Height > ctx.BlockHeight() {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d is greater than the current height %d", req.Height, ctx.BlockHeight())
+	}
+
+	snapshot, snapshotHeight, found := k.GetConsumerValSetSnapshotAtHeight(ctx, consumerId, req.Height)
+	if !found {
+		return nil, status.Errorf(codes.NotFound,
+			"no validator set is retained for consumer %s at height %d", consumerId, req.Height)
+	}
+
+	validators := []types.QueryConsumerValidatorSetAtHeightValidator{}
+	for _, val := range snapshot.Validators {
+		providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+		validators = append(validators, types.QueryConsumerValidatorSetAtHeightValidator{
+			ProviderAddress: providerAddr.String(),
+			ConsumerKey:     val.PublicKey,
+			Power:           val.Power,
+		})
+	}
+
+	return &types.QueryConsumerValidatorSetAtHeightResponse{
+		SnapshotHeight: snapshotHeight,
+		Validators:     validators,
+	}, nil
+}
+
+// QueryConsumerDump returns the complete provider-side state stored for a given consumer chain
+func (k Keeper) QueryConsumerDump(goCtx context.Context, req *types.QueryConsumerDumpRequest) (*types.QueryConsumerDumpResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	state, err := k.ExportConsumerState(ctx, consumerId)
+	if err != nil {
+		// the errors of QueryConsumerChain are already gRPC status errors
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerDumpResponse{State: state}, nil
+}
+
+// QueryRewardAttributionLog returns the last reward attribution records of a given consumer chain
+func (k Keeper) QueryRewardAttributionLog(goCtx context.Context, req *types.QueryRewardAttributionLogRequest) (*types.QueryRewardAttributionLogResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	records, err := k.GetRewardAttributionLog(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardAttributionLogResponse{Records: records}, nil
+}
+
+// QueryConsumerClientStatus returns, for every launched consumer chain, the status and the remaining trusting period of its client
+func (k Keeper) QueryConsumerClientStatus(goCtx context.Context, req *types.QueryConsumerClientStatusRequest) (*types.QueryConsumerClientStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clients, err := k.GetAllConsumerClientExpiries(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerClientStatusResponse{Clients: clients}, nil
+}
+
+// QueryTimeQueue returns the entries of the time queue stored under the given key prefix
+func (k Keeper) QueryTimeQueue(goCtx context.Context, req *types.QueryTimeQueueRequest) (*types.QueryTimeQueueResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	prefix := byte(req.Prefix)
+	if uint32(prefix) != req.Prefix || !types.IsTimeQueueKeyPrefix(prefix) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time queue key prefix: %d", req.Prefix)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entries, err := k.NewTimeQueue(prefix).GetAll(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTimeQueueResponse{Entries: entries}, nil
+}
+
+// QueryConsumerGenesisBatch returns the genesis states of at most MaxConsumerGenesisBatchSize consumer chains.
+// The consumer ids without a genesis state (e.g., unknown or not yet launched) do not fail the query,
+// but their entries contain the reason why the genesis state is not available.
+func (k Keeper) QueryConsumerGenesisBatch(goCtx context.Context, req *types.QueryConsumerGenesisBatchRequest) (*types.QueryConsumerGenesisBatchResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if len(req.ConsumerIds) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no consumer ids")
+	}
+	if len(req.ConsumerIds) > types.MaxConsumerGenesisBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many consumer ids: %d > %d",
+			len(req.ConsumerIds), types.MaxConsumerGenesisBatchSize)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entries := []types.ConsumerGenesisEntry{}
+	seen := map[string]bool{}
+	for _, consumerId := range req.ConsumerIds {
+		if seen[consumerId] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate consumer id: %s", consumerId)
+		}
+		seen[consumerId] = true
+
+		entry := types.ConsumerGenesisEntry{ConsumerId: consumerId}
+		if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+			entry.Error = err.Error()
+			entries = append(entries, entry)
+			continue
+		}
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			entry.Error = errorsmod.Wrap(types.ErrUnknownConsumerId, consumerId).Error()
+			entries = append(entries, entry)
+			continue
+		}
+		entry.ChainId = chainId
+		entry.Phase = k.GetConsumerPhase(ctx, consumerId)
+
+		gen, found := k.GetConsumerGenesis(ctx, consumerId)
+		if !found {
+			entry.Error = fmt.Sprintf("no genesis state for consumer chain with consumer id %s in phase %s", consumerId, entry.Phase)
+			entries = append(entries, entry)
+			continue
+		}
+		entry.GenesisState = &gen
+		if genesisHash, found := k.GetConsumerGenesisHash(ctx, consumerId); found {
+			entry.GenesisHash = hex.EncodeToString(genesisHash)
+		}
+		entries = append(entries, entry)
+	}
+
+	return &types.QueryConsumerGenesisBatchResponse{Entries: entries}, nil
+}
+
+// QuerySkippedDowntimeSlashes returns the downtime slashes of a given consumer chain that were not executed
+// due to the downtime grace period of the consumer chain
+func (k Keeper) QuerySkippedDowntimeSlashes(goCtx context.Context, req *types.QuerySkippedDowntimeSlashesRequest) (*types.QuerySkippedDowntimeSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	skippedSlashes, err := k.GetSkippedDowntimeSlashes(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySkippedDowntimeSlashesResponse{SkippedSlashes: skippedSlashes}, nil
+}
+
+// QueryUnroutableSlashPackets returns the last slash packets of a given consumer chain that could not be routed to a provider validator
+func (k Keeper) QueryUnroutableSlashPackets(goCtx context.Context, req *types.QueryUnroutableSlashPacketsRequest) (*types.QueryUnroutableSlashPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	slashPackets, err := k.GetUnroutableSlashPackets(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnroutableSlashPacketsResponse{SlashPackets: slashPackets}, nil
+}
+
+// QueryPreOptInInfractions returns the downtime infractions of a given consumer chain that were dropped,
+// since they were committed before the validators opted in
+func (k Keeper) QueryPreOptInInfractions(goCtx context.Context, req *types.QueryPreOptInInfractionsRequest) (*types.QueryPreOptInInfractionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	infractions, err := k.GetPreOptInInfractions(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPreOptInInfractionsResponse{Infractions: infractions}, nil
+}
+
+// QueryTopNThreshold returns the minimum power (and bonded tokens) an active validator currently needs to be
+// opted in automatically to a given Top N consumer chain, together with the rank cutoff and the active validators
+// at the boundary. The threshold is computed on a cached context, i.e., without mutating the opt-in state.
+func (k Keeper) QueryTopNThreshold(goCtx context.Context, req *types.QueryTopNThresholdRequest) (*types.QueryTopNThresholdResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	notApplicable := func(reason string) *types.QueryTopNThresholdResponse {
+		return &types.QueryTopNThresholdResponse{NotApplicableReason: reason, MinTokens: math.ZeroInt()}
+	}
+
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase == types.CONSUMER_PHASE_STOPPED || phase == types.CONSUMER_PHASE_DELETED {
+		return notApplicable(fmt.Sprintf("consumer chain is in phase %s, i.e., no validator is opted in automatically", phase)), nil
+	}
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if powerShapingParameters.Top_N == 0 {
+		return notApplicable("consumer chain is not a Top N chain, i.e., no validator is opted in automatically"), nil
+	}
+
+	cachedCtx, _ := ctx.CacheContext()
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(cachedCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	minPower, err := k.ComputeMinPowerInTopN(cachedCtx, activeValidators, powerShapingParameters.Top_N)
+	if err != nil {
+		if errors.Is(err, types.ErrEmptyActiveValidatorSet) {
+			return notApplicable("the provider chain has no active validators"), nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// rank the active validators by power, i.e., the validators with at least `minPower` are opted in automatically
+	validators, err := k.toBondedValidators(cachedCtx, activeValidators)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].Power > validators[j].Power
+	})
+
+	res := &types.QueryTopNThresholdResponse{
+		Applicable:          true,
+		TopN:                powerShapingParameters.Top_N,
+		MinPower:            minPower,
+		MinTokens:           sdk.TokensFromConsensusPower(minPower, k.stakingKeeper.PowerReduction(cachedCtx)),
+		NumActiveValidators: uint32(len(validators)),
+		BoundaryValidators:  []types.TopNBoundaryValidator{},
+	}
+	for i, val := range validators {
+		if val.Power < minPower {
+			break
+		}
+		res.RankCutoff = uint32(i + 1)
+		if val.Power == minPower {
+			res.BoundaryValidators = append(res.BoundaryValidators, types.TopNBoundaryValidator{
+				ProviderAddress: val.ProviderAddr.String(),
+				OperatorAddress: val.Validator.GetOperator(),
+				Rank:            uint32(i + 1),
+				Power:           val.Power,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// QueryConsumerEconomicSecurity returns the economic security records of a consumer chain
+// within an optional range of provider heights
+func (k Keeper) QueryConsumerEconomicSecurity(goCtx context.Context, req *types.QueryConsumerEconomicSecurityRequest) (*types.QueryConsumerEconomicSecurityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range: [%d, %d]", req.StartHeight, req.EndHeight)
+	}
+	if req.EndHeight > 0 && req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"start height %d is greater than the end height %d", req.StartHeight, req.EndHeight)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	return &types.QueryConsumerEconomicSecurityResponse{
+		Records: k.GetConsumerEconomicSecurity(ctx, consumerId, req.StartHeight, req.EndHeight),
+	}, nil
+}
+
+// QueryNextConsumerId returns the consumer id that is assigned to the next created consumer chain
+func (k Keeper) QueryNextConsumerId(goCtx context.Context, req *types.QueryNextConsumerIdRequest) (*types.QueryNextConsumerIdResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerId, _ := k.GetConsumerId(ctx)
+	return &types.QueryNextConsumerIdResponse{
+		NextConsumerId: strconv.FormatUint(consumerId, 10),
+	}, nil
+}
+
+// SimulateCreateConsumer runs the MsgCreateConsumer handler on a cached context that is discarded afterwards
+// and returns the consumer id the consumer chain would get, the normalized parameters it would be created with,
+// and the failed launch checks as warnings. Nothing is persisted.
+//
+// Note that the returned consumer id is only a prediction: if other consumer chains are created first,
+// the consumer chain gets a different id. The response hence also contains the value of the consumer id
+// counter the prediction is based on.
+func (k Keeper) SimulateCreateConsumer(goCtx context.Context, req *types.QuerySimulateCreateConsumerRequest) (*types.QuerySimulateCreateConsumerResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if !req.DryRun {
+		return nil, status.Errorf(codes.InvalidArgument, "consumer creations can only be simulated as dry runs")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerIdCounter, _ := k.GetConsumerId(ctx)
+
+	// the cached context is never written, so that the simulation does not persist anything
+	cachedCtx, _ := ctx.CacheContext()
+	createResp, err := NewMsgServerImpl(&k).CreateConsumer(cachedCtx, req.Msg)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	consumerId := createResp.ConsumerId
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(cachedCtx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(cachedCtx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	allowlistedRewardDenoms, err := k.GetAllowlistedRewardDenoms(cachedCtx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	warnings := []string{}
+	phase := k.GetConsumerPhase(cachedCtx, consumerId)
+	if phase == types.CONSUMER_PHASE_REGISTERED || phase == types.CONSUMER_PHASE_INITIALIZED {
+		checks, err := k.GetConsumerLaunchChecklist(cachedCtx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, check := range checks {
+			if !check.Passed {
+				warnings = append(warnings, fmt.Sprintf("%s: %s", check.Name, check.Details))
+			}
+		}
+	}
+
+	return &types.QuerySimulateCreateConsumerResponse{
+		ConsumerId:               consumerId,
+		ConsumerIdCounter:        consumerIdCounter,
+		Phase:                    phase,
+		InitializationParameters: initializationParameters,
+		PowerShapingParameters:   powerShapingParameters,
+		AllowlistedRewardDenoms:  allowlistedRewardDenoms,
+		Warnings:                 warnings,
+	}, nil
+}
+
+// QueryPendingVSCPackets returns the VSC packets queued on the provider to be sent to the given consumer chain,
+// in the order in which they are sent. As the pending VSC packets are stored as a single list, the pagination
+// is done over the list, i.e., the page key is the big-endian encoded index of the next packet.
+func (k Keeper) QueryPendingVSCPackets(goCtx context.Context, req *types.QueryPendingVSCPacketsRequest) (*types.QueryPendingVSCPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find consumer chain with consumer id: %s", consumerId)
+	}
+
+	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
+	start, end, pageRes, err := paginateSlice(len(pendingPackets), req.Pagination, types.MaxPendingVSCPacketsPerPage, types.MaxPendingVSCPacketsPerPage)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	packets := []types.PendingVSCPacket{}
+	for _, data := range pendingPackets[start:end] {
+		packet, err := k.toPendingVSCPacket(ctx, consumerId, data)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		packets = append(packets, packet)
+	}
+
+	return &types.QueryPendingVSCPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// paginateSlice returns the range [start, end) of a sorted slice of `numItems` items requested by `pageReq`,
+// together with the page response. The page key is the big-endian encoded index of the first item of the page.
+// The limit of the page defaults to `defaultLimit` and is capped at `maxLimit`.
+func paginateSlice(numItems int, pageReq *query.PageRequest, defaultLimit, maxLimit uint64) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return 0, 0, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	if len(pageReq.Key) != 0 {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, fmt.Errorf("invalid page key: %X", pageReq.Key)
+		}
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, maxLimit)
+
+	start = int(min(offset, uint64(numItems)))
+	end = int(min(uint64(start)+limit, uint64(numItems)))
+
+	pageRes = &query.PageResponse{}
+	if end < numItems {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, uint64(end))
+	}
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(numItems)
+	}
+	return start, end, pageRes, nil
+}
+
+// toPendingVSCPacket converts the given VSC packet `data` queued for the consumer chain with `consumerId`
+// into a pending VSC packet, i.e., with the validator updates as consumer consensus addresses and powers
+func (k Keeper) toPendingVSCPacket(ctx sdk.Context, consumerId string, data ccvtypes.ValidatorSetChangePacketData) (types.PendingVSCPacket, error) {
+	validatorUpdates := []types.PendingValidatorUpdate{}
+	for _, update := range data.ValidatorUpdates {
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(update.PubKey)
+		if err != nil {
+			return types.PendingVSCPacket{}, fmt.Errorf("invalid public key in VSC packet with vscID %d: %w", data.ValsetUpdateId, err)
+		}
+		validatorUpdates = append(validatorUpdates, types.PendingValidatorUpdate{
+			ConsumerAddress: consumerAddr.String(),
+			Power:           update.Power,
+		})
+	}
+
+	// the vscID is mapped to the height of the block following the one in which the VSC packet was queued
+	creationHeight := int64(0)
+	if vscIdToHeight, found := k.GetVscIdToHeight(ctx, consumerId, data.ValsetUpdateId); found && vscIdToHeight.Height > 0 {
+		creationHeight = int64(vscIdToHeight.Height) - 1
+	}
+
+	return types.PendingVSCPacket{
+		VscId:                 data.ValsetUpdateId,
+		ValidatorUpdates:      validatorUpdates,
+		SlashAcks:             data.SlashAcks,
+		CreationHeight:        creationHeight,
+		ProviderFeePoolAddr:   data.ProviderFeePoolAddr,
+		ProviderUpgradeNotice: data.ProviderUpgradeNotice,
 	}, nil
 }
@@ -2,19 +2,25 @@ package keeper_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
@@ -72,7 +78,7 @@ func TestQueryAllPairsValConsAddrByConsumer(t *testing.T) {
 }
 
 func TestQueryConsumerChainOptedInValidators(t *testing.T) {
-	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
 
 	consumerId := "0"
@@ -90,8 +96,34 @@ func TestQueryConsumerChainOptedInValidators(t *testing.T) {
 
 	providerAddr1 := types.NewProviderConsAddress([]byte("providerAddr1"))
 	providerAddr2 := types.NewProviderConsAddress([]byte("providerAddr2"))
+
+	// the first validator inherits its provider commission rate,
+	// while the second validator set a commission rate for the consumer chain
+	val1 := stakingtypes.Validator{Commission: stakingtypes.Commission{
+		CommissionRates: stakingtypes.CommissionRates{Rate: math.LegacyNewDecWithPrec(1, 1)},
+	}}
+	val2 := stakingtypes.Validator{Commission: stakingtypes.Commission{
+		CommissionRates: stakingtypes.CommissionRates{Rate: math.LegacyNewDecWithPrec(2, 1)},
+	}}
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), providerAddr1.ToSdkConsAddr()).Return(val1, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), providerAddr2.ToSdkConsAddr()).Return(val2, nil).AnyTimes()
+	err = pk.SetConsumerCommissionRate(ctx, consumerId, providerAddr2, math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(t, err)
+
 	expectedResponse := types.QueryConsumerChainOptedInValidatorsResponse{
 		ValidatorsProviderAddresses: []string{providerAddr1.String(), providerAddr2.String()},
+		Validators: []*types.QueryConsumerChainOptedInValidatorsValidator{
+			{
+				ProviderAddress:              providerAddr1.String(),
+				ConsumerCommissionRate:       math.LegacyNewDecWithPrec(1, 1),
+				ConsumerCommissionRateSource: types.CONSUMER_COMMISSION_RATE_SOURCE_INHERITED,
+			},
+			{
+				ProviderAddress:              providerAddr2.String(),
+				ConsumerCommissionRate:       math.LegacyNewDecWithPrec(5, 2),
+				ConsumerCommissionRateSource: types.CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT,
+			},
+		},
 	}
 
 	pk.SetOptedIn(ctx, consumerId, providerAddr1)
@@ -401,6 +433,123 @@ func TestQueryValidatorConsumerCommissionRate(t *testing.T) {
 	require.Equal(t, expectedCommissionRate, res.Rate)
 }
 
+// TestQueryValidatorProviderExposure tests that the exposure of a validator is correctly computed
+// for a validator that is part of three consumer chains in different situations
+func TestQueryValidatorProviderExposure(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	val := createStakingValidator(ctx, mocks, 100, 1)
+	val.Commission.Rate = math.LegacyMustNewDecFromStr("0.05")
+	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	require.NoError(t, err)
+	valConsAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	providerAddr := types.NewProviderConsAddress(valConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, valAddr).Return(val, nil).AnyTimes()
+
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	// set up launched consumer chains where
+	// - consumer 0 is a Top N chain the validator is forced to validate, with a custom commission rate,
+	// - consumer 1 is an Opt In chain the validator opted out from, using an assigned consumer key,
+	// - consumer 2 is an Opt In chain the validator opted in to, but is not yet a consumer validator of,
+	// - consumer 3 is an Opt In chain the validator is not part of,
+	// - consumer 4 is a stopped chain the validator was a consumer validator of.
+	consumerIds := []string{"0", "1", "2", "3", "4"}
+	for i, consumerId := range consumerIds {
+		pk.SetConsumerChainId(ctx, consumerId, "consumer-"+consumerId)
+		pk.SetConsumerClientId(ctx, consumerId, "client-"+strconv.Itoa(i))
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(t, err)
+	}
+
+	err = pk.SetConsumerPowerShapingParameters(ctx, consumerIds[0], types.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	pk.SetMinimumPowerInTopN(ctx, consumerIds[0], 50)
+	pk.SetOptedIn(ctx, consumerIds[0], providerAddr)
+	err = pk.SetConsumerValidator(ctx, consumerIds[0], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            100,
+		PublicKey:        &crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{1}}},
+	})
+	require.NoError(t, err)
+	pk.SetConsumerCommissionRate(ctx, consumerIds[0], providerAddr, math.LegacyMustNewDecFromStr("0.1"))
+
+	pk.SetValidatorConsumerPubKey(ctx, consumerIds[1], providerAddr, consumerKey.TMProtoCryptoPublicKey())
+	err = pk.SetConsumerValidator(ctx, consumerIds[1], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            40,
+		PublicKey:        &crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{2}}},
+	})
+	require.NoError(t, err)
+
+	pk.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	upgradeNotice := types.ConsumerUpgradeNotice{
+		Name: "v2", Height: 1000, BinaryHash: "binary_hash", Deadline: ctx.BlockTime().Add(time.Hour).UTC(),
+	}
+	require.NoError(t, pk.PublishConsumerUpgradeNotice(ctx, consumerIds[2], upgradeNotice))
+
+	err = pk.SetConsumerValidator(ctx, consumerIds[4], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            100,
+		PublicKey:        &crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{3}}},
+	})
+	require.NoError(t, err)
+	pk.SetConsumerPhase(ctx, consumerIds[4], types.CONSUMER_PHASE_STOPPED)
+
+	expectedConsumers := []types.ValidatorConsumerExposure{
+		{
+			ConsumerId:             consumerIds[0],
+			ChainId:                "consumer-0",
+			ConsumerAddress:        providerAddr.String(),
+			InValidatorSet:         true,
+			ConsumerPower:          100,
+			OptedIn:                true,
+			ForcedByTopN:           true,
+			PendingOptOut:          false,
+			ConsumerCommissionRate: math.LegacyMustNewDecFromStr("0.1"),
+		},
+		{
+			ConsumerId:             consumerIds[1],
+			ChainId:                "consumer-1",
+			ConsumerAddress:        consumerKey.SDKValConsAddress().String(),
+			InValidatorSet:         true,
+			ConsumerPower:          40,
+			OptedIn:                false,
+			ForcedByTopN:           false,
+			PendingOptOut:          true,
+			ConsumerCommissionRate: val.Commission.Rate,
+		},
+		{
+			ConsumerId:             consumerIds[2],
+			ChainId:                "consumer-2",
+			ConsumerAddress:        providerAddr.String(),
+			InValidatorSet:         false,
+			ConsumerPower:          0,
+			OptedIn:                true,
+			ForcedByTopN:           false,
+			PendingOptOut:          false,
+			ConsumerCommissionRate: val.Commission.Rate,
+			UpgradeNotices:         []types.ConsumerUpgradeNotice{upgradeNotice},
+		},
+	}
+
+	res, err := pk.QueryValidatorProviderExposure(ctx, &types.QueryValidatorProviderExposureRequest{
+		ProviderOperatorAddress: val.GetOperator(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, providerAddr.String(), res.ProviderAddress)
+	require.Equal(t, expectedConsumers, res.Consumers)
+
+	// invalid operator address
+	_, err = pk.QueryValidatorProviderExposure(ctx, &types.QueryValidatorProviderExposureRequest{
+		ProviderOperatorAddress: "invalid",
+	})
+	require.Error(t, err)
+}
+
 // TestGetConsumerChain tests GetConsumerChain behaviour correctness
 func TestGetConsumerChain(t *testing.T) {
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -606,6 +755,119 @@ func TestQueryConsumerChain(t *testing.T) {
 	res, err = providerKeeper.QueryConsumerChain(ctx, &req)
 	require.NoError(t, err)
 	require.Equal(t, &expRes, res)
+
+	endpointInfo := types.EndpointInfo{GenesisUrl: "https://consumer.io/genesis.json"}
+	err = providerKeeper.SetConsumerEndpointInfo(ctx, consumerId, endpointInfo)
+	require.NoError(t, err)
+	expRes.EndpointInfo = &endpointInfo
+
+	res, err = providerKeeper.QueryConsumerChain(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, &expRes, res)
+}
+
+func TestQueryConsumerGenesis(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerGenesis(ctx, &types.QueryConsumerGenesisRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryConsumerGenesis(ctx, &types.QueryConsumerGenesisRequest{ConsumerId: CONSUMER_ID})
+	require.Error(t, err)
+
+	gen := *ccvtypes.DefaultConsumerGenesisState()
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, CONSUMER_ID, gen))
+
+	// the genesis hash is empty if it was not recorded at launch
+	res, err := providerKeeper.QueryConsumerGenesis(ctx, &types.QueryConsumerGenesisRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, gen, res.GenesisState)
+	require.Empty(t, res.GenesisHash)
+
+	genesisHash, err := gen.Hash()
+	require.NoError(t, err)
+	providerKeeper.SetConsumerGenesisHash(ctx, CONSUMER_ID, genesisHash)
+	res, err = providerKeeper.QueryConsumerGenesis(ctx, &types.QueryConsumerGenesisRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(genesisHash), res.GenesisHash)
+
+	// the returned genesis can be verified against the returned hash
+	hashBz, err := hex.DecodeString(res.GenesisHash)
+	require.NoError(t, err)
+	require.NoError(t, ccvtypes.VerifyConsumerGenesisHash(res.GenesisState, hashBz))
+	require.Empty(t, res.TransformedGenesisState)
+
+	// the genesis is transformed to the format of the requested target version
+	res, err = providerKeeper.QueryConsumerGenesis(ctx,
+		&types.QueryConsumerGenesisRequest{ConsumerId: CONSUMER_ID, TargetVersion: ccvtypes.ConsumerGenesisVersionV5})
+	require.NoError(t, err)
+	expectedGen, err := ccvtypes.TransformConsumerGenesis(gen, ccvtypes.ConsumerGenesisVersionV5)
+	require.NoError(t, err)
+	require.Equal(t, string(expectedGen), res.TransformedGenesisState)
+	require.Equal(t, gen, res.GenesisState)
+
+	_, err = providerKeeper.QueryConsumerGenesis(ctx,
+		&types.QueryConsumerGenesisRequest{ConsumerId: CONSUMER_ID, TargetVersion: "v3"})
+	require.Error(t, err)
+}
+
+// TestQueryConsumerGenesisBatch tests that the genesis states of launched consumer chains are returned,
+// while the entries of initialized consumer chains and unknown consumer ids contain an error
+func TestQueryConsumerGenesisBatch(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// consumer chain "0" is launched and consumer chain "1" is initialized
+	gen := *ccvtypes.DefaultConsumerGenesisState()
+	genesisHash, err := gen.Hash()
+	require.NoError(t, err)
+	for _, phase := range []types.ConsumerPhase{types.CONSUMER_PHASE_LAUNCHED, types.CONSUMER_PHASE_INITIALIZED} {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, phase)
+	}
+	require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, "0", gen))
+	providerKeeper.SetConsumerGenesisHash(ctx, "0", genesisHash)
+
+	res, err := providerKeeper.QueryConsumerGenesisBatch(ctx, &types.QueryConsumerGenesisBatchRequest{
+		ConsumerIds: []string{"1", "0", "2", "invalid"},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 4)
+
+	require.Equal(t, "1", res.Entries[0].ConsumerId)
+	require.Equal(t, "chain-1", res.Entries[0].ChainId)
+	require.Equal(t, types.CONSUMER_PHASE_INITIALIZED, res.Entries[0].Phase)
+	require.Nil(t, res.Entries[0].GenesisState)
+	require.Contains(t, res.Entries[0].Error, "no genesis state")
+
+	require.Equal(t, types.ConsumerGenesisEntry{
+		ConsumerId:   "0",
+		ChainId:      "chain-0",
+		Phase:        types.CONSUMER_PHASE_LAUNCHED,
+		GenesisState: &gen,
+		GenesisHash:  hex.EncodeToString(genesisHash),
+	}, res.Entries[1])
+
+	for _, entry := range res.Entries[2:] {
+		require.Empty(t, entry.ChainId)
+		require.Equal(t, types.CONSUMER_PHASE_UNSPECIFIED, entry.Phase)
+		require.Nil(t, entry.GenesisState)
+		require.NotEmpty(t, entry.Error)
+	}
+
+	// the number of consumer ids is bounded and the consumer ids must be distinct
+	_, err = providerKeeper.QueryConsumerGenesisBatch(ctx, &types.QueryConsumerGenesisBatchRequest{})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerGenesisBatch(ctx, &types.QueryConsumerGenesisBatchRequest{
+		ConsumerIds: make([]string, types.MaxConsumerGenesisBatchSize+1),
+	})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerGenesisBatch(ctx, &types.QueryConsumerGenesisBatchRequest{
+		ConsumerIds: []string{"0", "0"},
+	})
+	require.Error(t, err)
 }
 
 func TestQueryConsumerIdFromClientId(t *testing.T) {
@@ -624,6 +886,134 @@ func TestQueryConsumerIdFromClientId(t *testing.T) {
 	require.Equal(t, expectedConsumerId, res.ConsumerId)
 }
 
+func TestQueryVscIdToHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryVscIdToHeight(ctx, &types.QueryVscIdToHeightRequest{ConsumerId: "invalid", VscId: 1})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryVscIdToHeight(ctx, &types.QueryVscIdToHeightRequest{ConsumerId: CONSUMER_ID, VscId: 1})
+	require.ErrorContains(t, err, "no height mapped to vscID")
+
+	timestamp := time.Now().UTC()
+	providerKeeper.SetVscIdToHeight(ctx, CONSUMER_ID, types.VscIdToHeight{VscId: 1, Height: 10, Timestamp: timestamp})
+
+	res, err := providerKeeper.QueryVscIdToHeight(ctx, &types.QueryVscIdToHeightRequest{ConsumerId: CONSUMER_ID, VscId: 1})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryVscIdToHeightResponse{Height: 10, Timestamp: timestamp}, res)
+
+	// the vscID is not mapped for another consumer chain
+	_, err = providerKeeper.QueryVscIdToHeight(ctx, &types.QueryVscIdToHeightRequest{ConsumerId: "1", VscId: 1})
+	require.Error(t, err)
+}
+
+func TestQueryConsumerRewardChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerRewardChannel(ctx, &types.QueryConsumerRewardChannelRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryConsumerRewardChannel(ctx, &types.QueryConsumerRewardChannelRequest{ConsumerId: CONSUMER_ID})
+	require.ErrorContains(t, err, "no reward channel registered")
+
+	providerKeeper.SetConsumerRewardChannel(ctx, CONSUMER_ID, "channel-1")
+	res, err := providerKeeper.QueryConsumerRewardChannel(ctx, &types.QueryConsumerRewardChannelRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, "channel-1", res.ChannelId)
+}
+
+func TestQueryConsumerEndpoints(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerEndpoints(ctx, &types.QueryConsumerEndpointsRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryConsumerEndpoints(ctx, &types.QueryConsumerEndpointsRequest{ConsumerId: CONSUMER_ID})
+	require.ErrorContains(t, err, "no endpoints advertised")
+
+	endpointInfo := types.EndpointInfo{
+		PersistentPeers: []string{"e5f9ab0a1bc2a0f4e4e5e9b1c7c8d1a2b3c4d5e6@peer.consumer.io:26656"},
+		RpcUrls:         []string{"https://rpc.consumer.io:443"},
+		GenesisUrl:      "https://consumer.io/genesis.json",
+	}
+	err = providerKeeper.SetConsumerEndpointInfo(ctx, CONSUMER_ID, endpointInfo)
+	require.NoError(t, err)
+	res, err := providerKeeper.QueryConsumerEndpoints(ctx, &types.QueryConsumerEndpointsRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, endpointInfo, res.EndpointInfo)
+}
+
+func TestQueryNextEpoch(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	// without a materialized epoch, the start of the current epoch is derived from BlocksPerEpoch
+	startTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(startTime)
+	res, err := providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryNextEpochResponse{
+		CurrentEpoch:            0,
+		CurrentEpochStartHeight: 0,
+		CurrentEpochEndHeight:   9,
+		NextEpochStartHeight:    10,
+		BlocksUntilNextEpoch:    5,
+	}, res)
+
+	// start a new epoch at height 10 and advance 5 blocks of 6 seconds each
+	ctx = ctx.WithBlockHeight(10)
+	providerKeeper.StartNewEpoch(ctx, 0)
+	ctx = ctx.WithBlockHeight(15).WithBlockTime(startTime.Add(30 * time.Second))
+	res, err = providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	estimatedTime := startTime.Add(60 * time.Second)
+	require.Equal(t, &types.QueryNextEpochResponse{
+		CurrentEpoch:                1,
+		CurrentEpochStartHeight:     10,
+		CurrentEpochEndHeight:       19,
+		NextEpochStartHeight:        20,
+		BlocksUntilNextEpoch:        5,
+		EstimatedNextEpochStartTime: &estimatedTime,
+	}, res)
+
+	// changing BlocksPerEpoch in the middle of the epoch moves the start of the next epoch
+	params.BlocksPerEpoch = 7
+	providerKeeper.SetParams(ctx, params)
+	res, err = providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	estimatedTime = startTime.Add(66 * time.Second)
+	require.Equal(t, &types.QueryNextEpochResponse{
+		CurrentEpoch:                1,
+		CurrentEpochStartHeight:     10,
+		CurrentEpochEndHeight:       20,
+		NextEpochStartHeight:        21,
+		BlocksUntilNextEpoch:        6,
+		EstimatedNextEpochStartTime: &estimatedTime,
+	}, res)
+
+	// at the start of the next epoch, the average block time of the previous epoch is used
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(startTime.Add(66 * time.Second))
+	providerKeeper.StartNewEpoch(ctx, 0)
+	res, err = providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	estimatedTime = startTime.Add(108 * time.Second)
+	require.Equal(t, &types.QueryNextEpochResponse{
+		CurrentEpoch:                2,
+		CurrentEpochStartHeight:     21,
+		CurrentEpochEndHeight:       27,
+		NextEpochStartHeight:        28,
+		BlocksUntilNextEpoch:        7,
+		EstimatedNextEpochStartTime: &estimatedTime,
+	}, res)
+}
+
 func TestQueryConsumerChains(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -674,6 +1064,7 @@ func TestQueryConsumerChains(t *testing.T) {
 			Metadata:                metadata,
 			ConsumerId:              consumerId,
 			AllowlistedRewardDenoms: &types.AllowlistedRewardDenoms{Denoms: []string{}},
+			CreationRecord:          &types.ConsumerCreationRecord{},
 		}
 		consumerIds[i] = consumerId
 		consumers[i] = &c
@@ -756,3 +1147,1051 @@ func TestQueryConsumerChains(t *testing.T) {
 		})
 	}
 }
+
+// TestQueryConsumerChainsOrdering tests that the consumer chains are sorted by phase and then by chain id
+func TestQueryConsumerChainsOrdering(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+
+	chains := []struct {
+		chainId string
+		phase   types.ConsumerPhase
+	}{
+		{chainId: "b-1", phase: types.CONSUMER_PHASE_LAUNCHED},
+		{chainId: "z-1", phase: types.CONSUMER_PHASE_REGISTERED},
+		{chainId: "a-1", phase: types.CONSUMER_PHASE_LAUNCHED},
+		{chainId: "c-1", phase: types.CONSUMER_PHASE_REGISTERED},
+		{chainId: "a-1", phase: types.CONSUMER_PHASE_STOPPED},
+	}
+	consumerIds := make([]string, len(chains))
+	for i, chain := range chains {
+		initializationParameters := types.DefaultConsumerInitializationParameters()
+		resp, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			ChainId:                  chain.chainId,
+			Metadata:                 types.ConsumerMetadata{Name: chain.chainId},
+			InitializationParameters: &initializationParameters,
+		})
+		require.NoError(t, err)
+		pk.SetConsumerPhase(ctx, resp.ConsumerId, chain.phase)
+		consumerIds[i] = resp.ConsumerId
+	}
+
+	// mark a consumer chain as verified
+	pk.SetConsumerVerified(ctx, consumerIds[2])
+
+	res, err := pk.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{})
+	require.NoError(t, err)
+
+	actualConsumerIds := []string{}
+	for _, chain := range res.Chains {
+		actualConsumerIds = append(actualConsumerIds, chain.ConsumerId)
+		require.Equal(t, chain.ConsumerId == consumerIds[2], chain.Verified)
+	}
+	require.Equal(t, []string{consumerIds[3], consumerIds[1], consumerIds[2], consumerIds[0], consumerIds[4]}, actualConsumerIds)
+
+	// the ordering holds across pages
+	pagedConsumerIds := []string{}
+	pageReq := &sdkquery.PageRequest{Limit: 2, CountTotal: true}
+	for {
+		res, err := pk.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{Pagination: pageReq})
+		require.NoError(t, err)
+		require.Equal(t, uint64(len(chains)), res.Pagination.Total)
+		for _, chain := range res.Chains {
+			pagedConsumerIds = append(pagedConsumerIds, chain.ConsumerId)
+		}
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2, CountTotal: true}
+	}
+	require.Equal(t, actualConsumerIds, pagedConsumerIds)
+}
+
+// TestQueryConsumersByOwner tests that the consumer chains are listed by their owner address,
+// including after their ownership is transferred with MsgUpdateConsumer
+func TestQueryConsumersByOwner(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	msgServer := keeper.NewMsgServerImpl(&pk)
+
+	oldOwner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	newOwner := "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s"
+
+	consumerIds := []string{}
+	for i := 0; i < 3; i++ {
+		resp, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			Submitter: oldOwner,
+			ChainId:   fmt.Sprintf("chain%d-1", i),
+			Metadata:  types.ConsumerMetadata{Name: "name"},
+		})
+		require.NoError(t, err)
+		consumerIds = append(consumerIds, resp.ConsumerId)
+	}
+
+	queryConsumerIds := func(owner string, pagination *sdkquery.PageRequest) []string {
+		res, err := pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{
+			OwnerAddress: owner,
+			Pagination:   pagination,
+		})
+		require.NoError(t, err)
+		ids := []string{}
+		for _, chain := range res.Chains {
+			ids = append(ids, chain.ConsumerId)
+		}
+		return ids
+	}
+
+	require.Equal(t, consumerIds, queryConsumerIds(oldOwner, nil))
+	require.Empty(t, queryConsumerIds(newOwner, nil))
+	require.Equal(t, consumerIds[1:2], queryConsumerIds(oldOwner, &sdkquery.PageRequest{Offset: 1, Limit: 1}))
+
+	// transfer the ownership of a consumer chain
+	_, err := msgServer.UpdateConsumer(ctx, &types.MsgUpdateConsumer{
+		Owner:           oldOwner,
+		ConsumerId:      consumerIds[1],
+		NewOwnerAddress: newOwner,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{consumerIds[0], consumerIds[2]}, queryConsumerIds(oldOwner, nil))
+	require.Equal(t, []string{consumerIds[1]}, queryConsumerIds(newOwner, nil))
+
+	// transfer the ownership back
+	_, err = msgServer.UpdateConsumer(ctx, &types.MsgUpdateConsumer{
+		Owner:           newOwner,
+		ConsumerId:      consumerIds[1],
+		NewOwnerAddress: oldOwner,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, consumerIds, queryConsumerIds(oldOwner, nil))
+	require.Empty(t, queryConsumerIds(newOwner, nil))
+
+	// an invalid owner address is rejected
+	_, err = pk.QueryConsumersByOwner(ctx, &types.QueryConsumersByOwnerRequest{OwnerAddress: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryConsumerTopology(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// consumer "0" is launched and its CCV channel is established,
+	// consumer "1" is launched but its CCV channel is not yet established,
+	// and consumer "2" is not launched
+	phases := []types.ConsumerPhase{
+		types.CONSUMER_PHASE_LAUNCHED,
+		types.CONSUMER_PHASE_LAUNCHED,
+		types.CONSUMER_PHASE_INITIALIZED,
+	}
+	for i, phase := range phases {
+		consumerId := strconv.Itoa(i)
+		pk.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain%d", i))
+		pk.SetConsumerPhase(ctx, consumerId, phase)
+		pk.SetConsumerClientId(ctx, consumerId, fmt.Sprintf("07-tendermint-%d", i))
+		initParams := testkeeper.GetTestInitializationParameters()
+		initParams.DistributionTransmissionChannel = fmt.Sprintf("channel-%d", 10+i)
+		err := pk.SetConsumerInitializationParameters(ctx, consumerId, initParams)
+		require.NoError(t, err)
+	}
+	pk.SetConsumerIdToChannelId(ctx, "0", "channel-2")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-2").Return(
+		channeltypes.Channel{
+			State:          channeltypes.OPEN,
+			Ordering:       channeltypes.ORDERED,
+			Counterparty:   channeltypes.NewCounterparty(ccvtypes.ConsumerPortID, "channel-3"),
+			ConnectionHops: []string{"connection-4"},
+		}, true,
+	).AnyTimes()
+
+	expectedTopologies := []types.ConsumerTopology{
+		{
+			ConsumerId:                      "0",
+			ChainId:                         "chain0",
+			ClientId:                        "07-tendermint-0",
+			ChannelId:                       "channel-2",
+			ChannelState:                    channeltypes.OPEN.String(),
+			CounterpartyChannelId:           "channel-3",
+			ConnectionId:                    "connection-4",
+			DistributionTransmissionChannel: "channel-10",
+			Established:                     true,
+		},
+		{
+			ConsumerId:                      "1",
+			ChainId:                         "chain1",
+			ClientId:                        "07-tendermint-1",
+			DistributionTransmissionChannel: "channel-11",
+			Established:                     false,
+		},
+	}
+
+	res, err := pk.QueryConsumerTopology(ctx, &types.QueryConsumerTopologyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expectedTopologies, res.Topologies)
+
+	// query the consumer topologies one page at a time
+	res, err = pk.QueryConsumerTopology(ctx, &types.QueryConsumerTopologyRequest{
+		Pagination: &sdkquery.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedTopologies[:1], res.Topologies)
+
+	res, err = pk.QueryConsumerTopology(ctx, &types.QueryConsumerTopologyRequest{
+		Pagination: &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedTopologies[1:], res.Topologies)
+
+	_, err = pk.QueryConsumerTopology(ctx, nil)
+	require.Error(t, err)
+}
+
+// createBondedValidatorsAndMocks creates bonded validators with the given powers and sets up
+// the staking keeper mocks for any context, as the validator sets might be computed on cached contexts
+func createBondedValidatorsAndMocks(mocks testkeeper.MockedKeepers, powers ...int64) ([]stakingtypes.Validator, []types.ProviderConsAddress) {
+	var validators []stakingtypes.Validator
+	var providerAddrs []types.ProviderConsAddress
+	powersByValAddr := map[string]int64{}
+	validatorsByConsAddr := map[string]stakingtypes.Validator{}
+	for i, power := range powers {
+		identity := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		val := identity.SDKStakingValidator()
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		val.Status = stakingtypes.Bonded
+
+		powersByValAddr[identity.SDKValOpAddress().String()] = power
+		validatorsByConsAddr[identity.SDKValConsAddress().String()] = val
+		validators = append(validators, val)
+		providerAddrs = append(providerAddrs, identity.ProviderConsAddress())
+	}
+
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (int64, error) {
+			return powersByValAddr[valAddr.String()], nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			val, found := validatorsByConsAddr[consAddr.String()]
+			if !found {
+				return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+			}
+			return val, nil
+		}).AnyTimes()
+
+	return validators, providerAddrs
+}
+
+// TestQueryConsumerLaunchChecklist tests that the consumer chain is ready to launch only if it passes all the launch checks
+func TestQueryConsumerLaunchChecklist(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+
+	_, err := pk.QueryConsumerLaunchChecklist(ctx, &types.QueryConsumerLaunchChecklistRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerLaunchChecklist(ctx, &types.QueryConsumerLaunchChecklistRequest{ConsumerId: "0"})
+	require.Error(t, err)
+
+	consumerId := "0"
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+	pk.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+	require.NoError(t, pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{}))
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Hour)
+	require.NoError(t, pk.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+	require.NoError(t, pk.SetAllowlistedRewardDenoms(ctx, consumerId, []string{"uatom"}))
+
+	// no validator opted in
+	res, err := pk.QueryConsumerLaunchChecklist(ctx, &types.QueryConsumerLaunchChecklistRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.False(t, res.Ready)
+	require.Len(t, res.Checks, 6)
+
+	pk.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress([]byte("providerAddr")))
+	res, err = pk.QueryConsumerLaunchChecklist(ctx, &types.QueryConsumerLaunchChecklistRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.True(t, res.Ready)
+}
+
+// TestQueryConsumerChainsFullDump tests that the full dump of the consumer chains matches the individual queries
+func TestQueryConsumerChainsFullDump(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	msgServer := keeper.NewMsgServerImpl(&pk)
+
+	consumerIds := []string{}
+	for i := 0; i < 4; i++ {
+		initializationParameters := testkeeper.GetTestInitializationParameters()
+		powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+		resp, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			Submitter:                "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la",
+			ChainId:                  fmt.Sprintf("chain%d", i),
+			Metadata:                 types.ConsumerMetadata{Name: fmt.Sprintf("name%d", i), Description: "description"},
+			InitializationParameters: &initializationParameters,
+			PowerShapingParameters:   &powerShapingParameters,
+		})
+		require.NoError(t, err)
+		consumerIds = append(consumerIds, resp.ConsumerId)
+	}
+
+	// the first chain is launched, the second chain is stopped, and the third chain is deleted
+	pk.SetConsumerPhase(ctx, consumerIds[0], types.CONSUMER_PHASE_LAUNCHED)
+	pk.SetConsumerClientId(ctx, consumerIds[0], "07-tendermint-0")
+	pk.SetConsumerIdToChannelId(ctx, consumerIds[0], "channel-0")
+	allowlistedAddr := types.NewProviderConsAddress([]byte("allowlistedAddr"))
+	denylistedAddr := types.NewProviderConsAddress([]byte("denylistedAddr"))
+	pk.SetAllowlist(ctx, consumerIds[0], allowlistedAddr)
+	pk.SetDenylist(ctx, consumerIds[0], denylistedAddr)
+	pk.SetConsumerPhase(ctx, consumerIds[1], types.CONSUMER_PHASE_STOPPED)
+	removalTime := time.Unix(1000000, 0).UTC()
+	require.NoError(t, pk.SetConsumerRemovalTime(ctx, consumerIds[1], removalTime))
+	pk.SetConsumerPhase(ctx, consumerIds[2], types.CONSUMER_PHASE_DELETED)
+
+	queryDump := func(includeDeleted bool, pagination *sdkquery.PageRequest) []types.ConsumerChainDump {
+		res, err := pk.QueryConsumerChainsFullDump(ctx, &types.QueryConsumerChainsFullDumpRequest{
+			IncludeDeleted: includeDeleted,
+			Pagination:     pagination,
+		})
+		require.NoError(t, err)
+		return res.ConsumerChains
+	}
+
+	// the deleted chain is only returned if requested
+	dumps := queryDump(false, nil)
+	require.Len(t, dumps, 3)
+	require.Equal(t, []string{consumerIds[0], consumerIds[1], consumerIds[3]},
+		[]string{dumps[0].Chain.ConsumerId, dumps[1].Chain.ConsumerId, dumps[2].Chain.ConsumerId})
+	dumps = queryDump(true, nil)
+	require.Len(t, dumps, 4)
+
+	// the dump matches the individual queries
+	for i, consumerId := range consumerIds {
+		chain, err := pk.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: consumerId})
+		require.NoError(t, err)
+		require.Equal(t, *chain, dumps[i].Chain)
+	}
+	require.Equal(t, "07-tendermint-0", dumps[0].ClientId)
+	require.Equal(t, "channel-0", dumps[0].ChannelId)
+	require.Equal(t, []string{allowlistedAddr.String()}, dumps[0].Allowlist)
+	require.Equal(t, []string{denylistedAddr.String()}, dumps[0].Denylist)
+	require.Nil(t, dumps[0].RemovalTime)
+	require.Equal(t, removalTime, *dumps[1].RemovalTime)
+	require.Empty(t, dumps[3].ClientId)
+	require.Empty(t, dumps[3].ChannelId)
+
+	// the pagination skips the deleted chain
+	require.Equal(t, []types.ConsumerChainDump{dumps[1], dumps[3]}, queryDump(false, &sdkquery.PageRequest{Offset: 1, Limit: 2}))
+	require.Equal(t, dumps[1:3], queryDump(true, &sdkquery.PageRequest{Offset: 1, Limit: 2}))
+}
+
+func TestQuerySecurityOverview(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := pk.QuerySecurityOverview(ctx, nil)
+	require.Error(t, err)
+
+	// validators sorted by power, i.e., with powers 3, 2, and 1
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, 3, 2, 1)
+
+	// the bonded validators are retrieved once for all the consumer chains
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 3, vals, 1)
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	// consumer chain "0" is a launched Top 50% chain, with an opted-in validator outside the top 50%
+	// consumer chain "1" is an initialized Opt In chain, with two opted-in validators out of which one is denylisted
+	// consumer chains "2" and "3" are registered and stopped, respectively
+	phases := []types.ConsumerPhase{
+		types.CONSUMER_PHASE_LAUNCHED,
+		types.CONSUMER_PHASE_INITIALIZED,
+		types.CONSUMER_PHASE_REGISTERED,
+		types.CONSUMER_PHASE_STOPPED,
+	}
+	for i, phase := range phases {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain-%d", i))
+		pk.SetConsumerPhase(ctx, consumerId, phase)
+		err = pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(t, err)
+		pk.SetOptedIn(ctx, consumerId, providerAddrs[2])
+	}
+	err = pk.SetConsumerPowerShapingParameters(ctx, "0", types.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	pk.SetOptedIn(ctx, "1", providerAddrs[1])
+	pk.SetDenylist(ctx, "1", providerAddrs[1])
+
+	res, err := pk.QuerySecurityOverview(ctx, &types.QuerySecurityOverviewRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(6), res.TotalBondedPower)
+
+	require.Len(t, res.Consumers, 2)
+	require.Equal(t, "0", res.Consumers[0].ConsumerId)
+	require.Equal(t, "chain-0", res.Consumers[0].ChainId)
+	require.Equal(t, types.CONSUMER_PHASE_LAUNCHED.String(), res.Consumers[0].Phase)
+	require.Equal(t, []string{providerAddrs[2].String()}, res.Consumers[0].OptedInValidators)
+	require.Equal(t, []string{providerAddrs[0].String(), providerAddrs[2].String()}, res.Consumers[0].NextValidators)
+	require.Equal(t, int64(4), res.Consumers[0].SelectedPower)
+	require.Equal(t, math.LegacyNewDec(4).QuoInt64(6), res.Consumers[0].SecurityFraction)
+
+	require.Equal(t, "1", res.Consumers[1].ConsumerId)
+	require.Equal(t, types.CONSUMER_PHASE_INITIALIZED.String(), res.Consumers[1].Phase)
+	require.ElementsMatch(t, []string{providerAddrs[1].String(), providerAddrs[2].String()}, res.Consumers[1].OptedInValidators)
+	require.Equal(t, []string{providerAddrs[2].String()}, res.Consumers[1].NextValidators)
+	require.Equal(t, int64(1), res.Consumers[1].SelectedPower)
+	require.Equal(t, math.LegacyNewDec(1).QuoInt64(6), res.Consumers[1].SecurityFraction)
+
+	require.Equal(t, []types.ValidatorSecurityOverview{
+		{ProviderAddress: providerAddrs[0].String(), Power: 3, NumSecuredConsumers: 1},
+		{ProviderAddress: providerAddrs[1].String(), Power: 2, NumSecuredConsumers: 0},
+		{ProviderAddress: providerAddrs[2].String(), Power: 1, NumSecuredConsumers: 2},
+	}, res.Validators)
+
+	// the computation does not modify the state
+	_, found := pk.GetMinimumPowerInTopN(ctx, "0")
+	require.False(t, found)
+	require.Len(t, pk.GetAllOptedIn(ctx, "0"), 1)
+}
+
+// TestQuerySecurityOverviewEvictedValidators checks that the security overview returns the validators
+// of the current consumer validator set that would be evicted due to the validator-set cap
+func TestQuerySecurityOverviewEvictedValidators(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// validators sorted by power, i.e., with powers 3, 2, and 1
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, 3, 2, 1)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 3, vals, 1)
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	// consumer chain "0" is a launched Opt In chain that is validated by all the validators
+	// and whose validator-set cap shrinks to 1
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{ValidatorSetCap: 1})
+	require.NoError(t, err)
+	currentValSet := []types.ConsensusValidator{}
+	for _, providerAddr := range providerAddrs {
+		pk.SetOptedIn(ctx, consumerId, providerAddr)
+		currentValSet = append(currentValSet, types.ConsensusValidator{ProviderConsAddr: providerAddr.ToSdkConsAddr()})
+	}
+	err = pk.SetConsumerValSet(ctx, consumerId, currentValSet)
+	require.NoError(t, err)
+
+	res, err := pk.QuerySecurityOverview(ctx, &types.QuerySecurityOverviewRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Consumers, 1)
+	require.Equal(t, []string{providerAddrs[0].String()}, res.Consumers[0].NextValidators)
+	require.Equal(t, []string{providerAddrs[1].String(), providerAddrs[2].String()}, res.Consumers[0].EvictedValidators)
+}
+
+func TestQuerySecurityOverviewWithoutValidators(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 3, []stakingtypes.Validator{}, 1)
+
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
+	err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	res, err := pk.QuerySecurityOverview(ctx, &types.QuerySecurityOverviewRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.TotalBondedPower)
+	require.Len(t, res.Consumers, 1)
+	require.Empty(t, res.Consumers[0].NextValidators)
+	require.True(t, res.Consumers[0].SecurityFraction.IsZero())
+	require.Empty(t, res.Validators)
+}
+
+// BenchmarkComputeSecurityOverview benchmarks the security overview of 20 consumer chains
+// (half of them Top N chains) secured by up to 100 bonded validators
+func BenchmarkComputeSecurityOverview(b *testing.B) {
+	keeperParams := testkeeper.NewInMemKeeperParams(b)
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	pk := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+
+	numValidators := 100
+	powers := make([]int64, numValidators)
+	for i := range powers {
+		powers[i] = int64(numValidators - i)
+	}
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, powers...)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, uint32(numValidators), vals, -1)
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = int64(numValidators)
+	pk.SetParams(ctx, params)
+
+	for i := 0; i < 20; i++ {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain-%d", i))
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		powerShapingParameters := types.PowerShapingParameters{}
+		if i%2 == 0 {
+			powerShapingParameters.Top_N = 80
+		}
+		if err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters); err != nil {
+			b.Fatal(err)
+		}
+		for j := i % 3; j < numValidators; j += 3 {
+			pk.SetOptedIn(ctx, consumerId, providerAddrs[j])
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := pk.ComputeSecurityOverview(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// TestQueryConsumerValidatorSetAtHeight tests the query of the validator set of a consumer chain
+// at past provider heights across three epochs, with a validator joining in the second epoch
+func TestQueryConsumerValidatorSetAtHeight(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.BlocksPerEpoch = 10
+	pk.SetParams(ctx, params)
+
+	consensusValidator := func(identity *cryptotestutil.CryptoIdentity, power int64) types.ConsensusValidator {
+		consumerKey := identity.TMProtoCryptoPublicKey()
+		return types.ConsensusValidator{
+			ProviderConsAddr: identity.SDKValConsAddress(),
+			Power:            power,
+			PublicKey:        &consumerKey,
+		}
+	}
+	queryValidator := func(identity *cryptotestutil.CryptoIdentity, power int64) types.QueryConsumerValidatorSetAtHeightValidator {
+		consumerKey := identity.TMProtoCryptoPublicKey()
+		return types.QueryConsumerValidatorSetAtHeightValidator{
+			ProviderAddress: identity.SDKValConsAddress().String(),
+			ConsumerKey:     &consumerKey,
+			Power:           power,
+		}
+	}
+	val1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	val2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	// the validator sets computed at the epoch boundaries 10, 20, and 30;
+	// the second validator joins at height 20
+	err := pk.SetConsumerValSet(ctx.WithBlockHeight(10), CONSUMER_ID, []types.ConsensusValidator{
+		consensusValidator(val1, 10),
+	})
+	require.NoError(t, err)
+	err = pk.SetConsumerValSet(ctx.WithBlockHeight(20), CONSUMER_ID, []types.ConsensusValidator{
+		consensusValidator(val1, 10), consensusValidator(val2, 5),
+	})
+	require.NoError(t, err)
+	err = pk.SetConsumerValSet(ctx.WithBlockHeight(30), CONSUMER_ID, []types.ConsensusValidator{
+		consensusValidator(val1, 8), consensusValidator(val2, 5),
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(35)
+	testCases := []struct {
+		height      int64
+		expResponse *types.QueryConsumerValidatorSetAtHeightResponse
+	}{
+		{
+			height: 15,
+			expResponse: &types.QueryConsumerValidatorSetAtHeightResponse{
+				SnapshotHeight: 10,
+				Validators:     []types.QueryConsumerValidatorSetAtHeightValidator{queryValidator(val1, 10)},
+			},
+		},
+		{
+			height: 20,
+			expResponse: &types.QueryConsumerValidatorSetAtHeightResponse{
+				SnapshotHeight: 20,
+				Validators:     []types.QueryConsumerValidatorSetAtHeightValidator{queryValidator(val1, 10), queryValidator(val2, 5)},
+			},
+		},
+		{
+			height: 35,
+			expResponse: &types.QueryConsumerValidatorSetAtHeightResponse{
+				SnapshotHeight: 30,
+				Validators:     []types.QueryConsumerValidatorSetAtHeightValidator{queryValidator(val1, 8), queryValidator(val2, 5)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		res, err := pk.QueryConsumerValidatorSetAtHeight(ctx, &types.QueryConsumerValidatorSetAtHeightRequest{
+			ConsumerId: CONSUMER_ID,
+			Height:     tc.height,
+		})
+		require.NoError(t, err)
+		require.Equal(t, tc.expResponse, res, "height %d", tc.height)
+	}
+
+	// no validator set is retained before the first epoch boundary
+	_, err = pk.QueryConsumerValidatorSetAtHeight(ctx, &types.QueryConsumerValidatorSetAtHeightRequest{ConsumerId: CONSUMER_ID, Height: 5})
+	require.Error(t, err)
+	// invalid heights
+	_, err = pk.QueryConsumerValidatorSetAtHeight(ctx, &types.QueryConsumerValidatorSetAtHeightRequest{ConsumerId: CONSUMER_ID, Height: 0})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerValidatorSetAtHeight(ctx, &types.QueryConsumerValidatorSetAtHeightRequest{ConsumerId: CONSUMER_ID, Height: 36})
+	require.Error(t, err)
+	// invalid consumer id
+	_, err = pk.QueryConsumerValidatorSetAtHeight(ctx, &types.QueryConsumerValidatorSetAtHeightRequest{ConsumerId: "invalid", Height: 20})
+	require.Error(t, err)
+}
+
+func TestQueryRewardAttributionLog(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// unknown consumer chain
+	_, err := pk.QueryRewardAttributionLog(ctx, &types.QueryRewardAttributionLogRequest{ConsumerId: CONSUMER_ID})
+	require.Error(t, err)
+
+	pk.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+
+	// no records
+	res, err := pk.QueryRewardAttributionLog(ctx, &types.QueryRewardAttributionLogRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	pk.RecordRewardAttribution(ctx, CONSUMER_ID, "channel-1", "sender", "uatom", "10",
+		types.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+	pk.RecordRewardAttribution(ctx, CONSUMER_ID, "channel-2", "sender", "uatom", "20",
+		types.REWARD_ATTRIBUTION_DECISION_DIVERTED, "received on channel channel-2 instead of reward channel channel-1")
+
+	res, err = pk.QueryRewardAttributionLog(ctx, &types.QueryRewardAttributionLogRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 2)
+	require.Equal(t, types.REWARD_ATTRIBUTION_DECISION_CREDITED, res.Records[0].Decision)
+	require.Equal(t, types.REWARD_ATTRIBUTION_DECISION_DIVERTED, res.Records[1].Decision)
+
+	// invalid consumer id
+	_, err = pk.QueryRewardAttributionLog(ctx, &types.QueryRewardAttributionLogRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryTimeQueue(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	spawnTime := time.Unix(10, 0).UTC()
+	require.NoError(t, pk.AppendConsumerToBeLaunched(ctx, "0", spawnTime))
+	require.NoError(t, pk.AppendConsumerToBeLaunched(ctx, "1", spawnTime))
+
+	res, err := pk.QueryTimeQueue(ctx, &types.QueryTimeQueueRequest{Prefix: uint32(types.SpawnTimeToConsumerIdsKeyPrefix())})
+	require.NoError(t, err)
+	require.Equal(t, []types.TimeQueueEntry{{Time: spawnTime, ConsumerIds: []string{"0", "1"}}}, res.Entries)
+
+	res, err = pk.QueryTimeQueue(ctx, &types.QueryTimeQueueRequest{Prefix: uint32(types.RemovalTimeToConsumerIdsKeyPrefix())})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	// only the prefixes of time queues are accepted
+	_, err = pk.QueryTimeQueue(ctx, &types.QueryTimeQueueRequest{Prefix: uint32(types.ConsumerIdToPhaseKeyPrefix())})
+	require.Error(t, err)
+	_, err = pk.QueryTimeQueue(ctx, &types.QueryTimeQueueRequest{Prefix: 256 + uint32(types.SpawnTimeToConsumerIdsKeyPrefix())})
+	require.Error(t, err)
+}
+
+// TestQueryTopNThreshold tests the query of the minimum power needed to be opted in automatically to a Top N
+// consumer chain, with validators of equal powers at the boundary
+func TestQueryTopNThreshold(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// the total power is 60
+	vals, providerAddrs := createBondedValidatorsAndMocks(mocks, 10, 10, 9, 7, 7, 7, 4, 3, 2, 1)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, uint32(len(vals)), vals, -1)
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+	boundaryValidator := func(i int) types.TopNBoundaryValidator {
+		return types.TopNBoundaryValidator{
+			ProviderAddress: providerAddrs[i].String(),
+			OperatorAddress: vals[i].GetOperator(),
+			Rank:            uint32(i + 1),
+			Power:           vals[i].ConsensusPower(sdk.DefaultPowerReduction),
+		}
+	}
+
+	testCases := []struct {
+		name                  string
+		topN                  uint32
+		maxActiveValidators   int64
+		expMinPower           int64
+		expRankCutoff         uint32
+		expBoundaryValidators []types.TopNBoundaryValidator
+	}{
+		{
+			"validators with equal powers share the boundary",
+			50, 100,
+			7, 6,
+			[]types.TopNBoundaryValidator{boundaryValidator(3), boundaryValidator(4), boundaryValidator(5)},
+		},
+		{
+			"validators with equal powers at the top",
+			33, 100,
+			10, 2,
+			[]types.TopNBoundaryValidator{boundaryValidator(0), boundaryValidator(1)},
+		},
+		{
+			"single validator at the boundary",
+			34, 100,
+			9, 3,
+			[]types.TopNBoundaryValidator{boundaryValidator(2)},
+		},
+		{
+			"all the validators are opted in automatically",
+			100, 100,
+			1, 10,
+			[]types.TopNBoundaryValidator{boundaryValidator(9)},
+		},
+		{
+			// the active validators have a total power of 43
+			"only the active validators are considered",
+			50, 5,
+			9, 3,
+			[]types.TopNBoundaryValidator{boundaryValidator(2)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := pk.GetParams(ctx)
+			params.MaxProviderConsensusValidators = tc.maxActiveValidators
+			pk.SetParams(ctx, params)
+			err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: tc.topN})
+			require.NoError(t, err)
+
+			res, err := pk.QueryTopNThreshold(ctx, &types.QueryTopNThresholdRequest{ConsumerId: consumerId})
+			require.NoError(t, err)
+			require.True(t, res.Applicable)
+			require.Empty(t, res.NotApplicableReason)
+			require.Equal(t, tc.topN, res.TopN)
+			require.Equal(t, tc.expMinPower, res.MinPower)
+			require.Equal(t, sdk.TokensFromConsensusPower(tc.expMinPower, sdk.DefaultPowerReduction), res.MinTokens)
+			require.Equal(t, tc.expRankCutoff, res.RankCutoff)
+			require.Equal(t, uint32(min(int64(len(vals)), tc.maxActiveValidators)), res.NumActiveValidators)
+			require.Equal(t, tc.expBoundaryValidators, res.BoundaryValidators)
+
+			// the min power is the one computed when the validator sets are computed
+			activeValidators, err := pk.GetLastProviderConsensusActiveValidators(ctx)
+			require.NoError(t, err)
+			minPower, err := pk.ComputeMinPowerInTopN(ctx, activeValidators, tc.topN)
+			require.NoError(t, err)
+			require.Equal(t, minPower, res.MinPower)
+
+			// the query does not mutate the opt-in state
+			require.Empty(t, pk.GetAllOptedIn(ctx, consumerId))
+			_, found := pk.GetMinimumPowerInTopN(ctx, consumerId)
+			require.False(t, found)
+		})
+	}
+
+	// the threshold does not apply to consumer chains that are not Top N chains
+	err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+	require.NoError(t, err)
+	res, err := pk.QueryTopNThreshold(ctx, &types.QueryTopNThresholdRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.False(t, res.Applicable)
+	require.Contains(t, res.NotApplicableReason, "not a Top N chain")
+	require.Zero(t, res.MinPower)
+	require.Empty(t, res.BoundaryValidators)
+
+	// nor to stopped consumer chains
+	err = pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
+	res, err = pk.QueryTopNThreshold(ctx, &types.QueryTopNThresholdRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.False(t, res.Applicable)
+	require.Contains(t, res.NotApplicableReason, types.CONSUMER_PHASE_STOPPED.String())
+
+	// unknown and invalid consumer ids
+	_, err = pk.QueryTopNThreshold(ctx, &types.QueryTopNThresholdRequest{ConsumerId: "42"})
+	require.ErrorContains(t, err, "cannot find consumer chain")
+	_, err = pk.QueryTopNThreshold(ctx, &types.QueryTopNThresholdRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryTopNThreshold(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerEconomicSecurity(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+
+	records := []types.ConsumerEconomicSecurity{}
+	for _, height := range []int64{10, 20, 30} {
+		record := types.ConsumerEconomicSecurity{
+			ConsumerId:     consumerId,
+			Height:         height,
+			Time:           time.Unix(height, 0).UTC(),
+			TotalPower:     height,
+			TotalTokens:    sdk.TokensFromConsensusPower(height, sdk.DefaultPowerReduction),
+			ValidatorCount: 1,
+		}
+		require.NoError(t, pk.SetConsumerEconomicSecurity(ctx, record))
+		records = append(records, record)
+	}
+
+	res, err := pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, records, res.Records)
+
+	res, err = pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{
+		ConsumerId:  consumerId,
+		StartHeight: 15,
+		EndHeight:   30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, records[1:], res.Records)
+
+	// invalid height ranges
+	_, err = pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{ConsumerId: consumerId, StartHeight: -1})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{
+		ConsumerId:  consumerId,
+		StartHeight: 30,
+		EndHeight:   20,
+	})
+	require.ErrorContains(t, err, "greater than the end height")
+
+	// unknown and invalid consumer ids
+	_, err = pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{ConsumerId: "42"})
+	require.ErrorContains(t, err, "cannot find consumer chain")
+	_, err = pk.QueryConsumerEconomicSecurity(ctx, &types.QueryConsumerEconomicSecurityRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerEconomicSecurity(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryNextConsumerId(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	res, err := pk.QueryNextConsumerId(ctx, &types.QueryNextConsumerIdRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.NextConsumerId)
+
+	pk.FetchAndIncrementConsumerId(ctx)
+	pk.FetchAndIncrementConsumerId(ctx)
+	res, err = pk.QueryNextConsumerId(ctx, &types.QueryNextConsumerIdRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "2", res.NextConsumerId)
+
+	_, err = pk.QueryNextConsumerId(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestSimulateCreateConsumer(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+	msg := &types.MsgCreateConsumer{
+		Submitter: "submitter",
+		ChainId:   "chain-1",
+		Metadata:  types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "{}"},
+		PowerShapingParameters: &types.PowerShapingParameters{
+			ValidatorsPowerCap: 10,
+		},
+		AllowlistedRewardDenoms: &types.AllowlistedRewardDenoms{Denoms: []string{"uatom"}},
+	}
+
+	// the simulation predicts the consumer id and returns the normalized parameters without persisting anything
+	res, err := pk.SimulateCreateConsumer(ctx, &types.QuerySimulateCreateConsumerRequest{Msg: msg, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.ConsumerId)
+	require.Equal(t, uint64(0), res.ConsumerIdCounter)
+	require.Equal(t, types.CONSUMER_PHASE_REGISTERED, res.Phase)
+	// the initialization parameters are set to their defaults
+	defaultInitializationParameters := types.DefaultConsumerInitializationParameters()
+	require.Equal(t, defaultInitializationParameters.InitialHeight, res.InitializationParameters.InitialHeight)
+	require.Equal(t, defaultInitializationParameters.UnbondingPeriod, res.InitializationParameters.UnbondingPeriod)
+	require.Equal(t, defaultInitializationParameters.CcvTimeoutPeriod, res.InitializationParameters.CcvTimeoutPeriod)
+	require.Equal(t, defaultInitializationParameters.HistoricalEntries, res.InitializationParameters.HistoricalEntries)
+	require.Equal(t, types.PowerShapingParameters{ValidatorsPowerCap: 10}, res.PowerShapingParameters)
+	require.Equal(t, []string{"uatom"}, res.AllowlistedRewardDenoms)
+	require.Equal(t, []string{
+		types.LaunchCheckSpawnTime + ": spawn time is not set",
+		types.LaunchCheckOptedInValidators + ": no validator opted in",
+	}, res.Warnings)
+	nextConsumerId, _ := pk.GetConsumerId(ctx)
+	require.Equal(t, uint64(0), nextConsumerId)
+	require.Equal(t, types.CONSUMER_PHASE_UNSPECIFIED, pk.GetConsumerPhase(ctx, "0"))
+	_, err = pk.GetConsumerChainId(ctx, "0")
+	require.Error(t, err)
+
+	// another consumer chain is created first, which invalidates the prediction
+	createRes, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+		Submitter: "other-submitter",
+		ChainId:   "other-1",
+		Metadata:  types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "{}"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.ConsumerId, createRes.ConsumerId)
+	nextConsumerId, _ = pk.GetConsumerId(ctx)
+	require.NotEqual(t, res.ConsumerIdCounter, nextConsumerId)
+
+	// simulating again returns the next consumer id and the new counter value
+	res, err = pk.SimulateCreateConsumer(ctx, &types.QuerySimulateCreateConsumerRequest{Msg: msg, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, "1", res.ConsumerId)
+	require.Equal(t, uint64(1), res.ConsumerIdCounter)
+
+	// the creation of the simulated consumer chain gets the predicted consumer id if nothing else is created first
+	createRes, err = msgServer.CreateConsumer(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, res.ConsumerId, createRes.ConsumerId)
+
+	// invalid messages short-circuit the simulation without changing the state
+	nextConsumerId, _ = pk.GetConsumerId(ctx)
+	invalidMsgs := map[string]*types.MsgCreateConsumer{
+		"empty chain id": {Submitter: "submitter", Metadata: msg.Metadata},
+		"Top N chain": {
+			Submitter: "submitter", ChainId: "chain-1", Metadata: msg.Metadata,
+			PowerShapingParameters: &types.PowerShapingParameters{Top_N: 50},
+		},
+		"proposal id from non-authority": {
+			Submitter: "submitter", ChainId: "chain-1", Metadata: msg.Metadata, ProposalId: 1,
+		},
+	}
+	for name, invalidMsg := range invalidMsgs {
+		_, err = pk.SimulateCreateConsumer(ctx, &types.QuerySimulateCreateConsumerRequest{Msg: invalidMsg, DryRun: true})
+		require.Error(t, err, name)
+		counter, _ := pk.GetConsumerId(ctx)
+		require.Equal(t, nextConsumerId, counter, name)
+		require.Equal(t, types.CONSUMER_PHASE_UNSPECIFIED,
+			pk.GetConsumerPhase(ctx, strconv.FormatUint(nextConsumerId, 10)), name)
+	}
+
+	// the simulation must be a dry run
+	_, err = pk.SimulateCreateConsumer(ctx, &types.QuerySimulateCreateConsumerRequest{Msg: msg})
+	require.ErrorContains(t, err, "dry runs")
+	_, err = pk.SimulateCreateConsumer(ctx, &types.QuerySimulateCreateConsumerRequest{DryRun: true})
+	require.Error(t, err)
+	_, err = pk.SimulateCreateConsumer(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryPendingVSCPackets(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+
+	// queue 5 VSC packets, each updating the power of a validator
+	expectedPackets := []types.PendingVSCPacket{}
+	for vscId := uint64(1); vscId <= 5; vscId++ {
+		consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(int(vscId)).TMProtoCryptoPublicKey()
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+		require.NoError(t, err)
+
+		var slashAcks []string
+		if vscId == 3 {
+			slashAcks = []string{"cosmosvalcons1wpex7anfv3jhystyv3eq20r35a"}
+		}
+		pk.AppendPendingVSCPackets(ctx, consumerId, ccvtypes.NewValidatorSetChangePacketData(
+			[]abci.ValidatorUpdate{{PubKey: consumerKey, Power: int64(vscId) * 10}}, vscId, slashAcks))
+
+		// the last packet is not mapped to a provider height
+		creationHeight := int64(0)
+		if vscId < 5 {
+			creationHeight = int64(vscId) * 10
+			pk.SetVscIdToHeight(ctx, consumerId, types.VscIdToHeight{VscId: vscId, Height: uint64(creationHeight) + 1})
+		}
+		expectedPackets = append(expectedPackets, types.PendingVSCPacket{
+			VscId:            vscId,
+			ValidatorUpdates: []types.PendingValidatorUpdate{{ConsumerAddress: consumerAddr.String(), Power: int64(vscId) * 10}},
+			SlashAcks:        slashAcks,
+			CreationHeight:   creationHeight,
+		})
+	}
+
+	// all the pending VSC packets are returned in order
+	res, err := pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, expectedPackets, res.Packets)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// paginate with keys
+	packets := []types.PendingVSCPacket{}
+	pageReq := &sdkquery.PageRequest{Limit: 2, CountTotal: true}
+	for {
+		res, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{ConsumerId: consumerId, Pagination: pageReq})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Packets), 2)
+		require.Equal(t, uint64(5), res.Pagination.Total)
+		packets = append(packets, res.Packets...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 2, CountTotal: true}
+	}
+	require.Equal(t, expectedPackets, packets)
+
+	// paginate with an offset
+	res, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{
+		ConsumerId: consumerId,
+		Pagination: &sdkquery.PageRequest{Offset: 3, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedPackets[3:4], res.Packets)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{
+		ConsumerId: consumerId,
+		Pagination: &sdkquery.PageRequest{Offset: 10},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Packets)
+
+	// either an offset or a key is expected
+	_, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{
+		ConsumerId: consumerId,
+		Pagination: &sdkquery.PageRequest{Offset: 1, Key: []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+	})
+	require.Error(t, err)
+
+	// the number of returned packets is capped
+	for vscId := uint64(6); vscId <= 5+types.MaxPendingVSCPacketsPerPage; vscId++ {
+		pk.AppendPendingVSCPackets(ctx, consumerId, ccvtypes.NewValidatorSetChangePacketData(nil, vscId, nil))
+	}
+	res, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{
+		ConsumerId: consumerId,
+		Pagination: &sdkquery.PageRequest{Limit: 2 * types.MaxPendingVSCPacketsPerPage},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, types.MaxPendingVSCPacketsPerPage)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// unknown consumer chain
+	_, err = pk.QueryPendingVSCPackets(ctx, &types.QueryPendingVSCPacketsRequest{ConsumerId: "1"})
+	require.Error(t, err)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -49,9 +50,12 @@ type Keeper struct {
 	slashingKeeper     ccv.SlashingKeeper
 	distributionKeeper ccv.DistributionKeeper
 	bankKeeper         ccv.BankKeeper
+	upgradeKeeper      ccv.UpgradeKeeper
 	govKeeper          govkeeper.Keeper
 	feeCollectorName   string
 
+	tokenizedSharesKeeper ccv.TokenizedSharesKeeper
+
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
 }
@@ -64,6 +68,7 @@ func NewKeeper(
 	stakingKeeper ccv.StakingKeeper, slashingKeeper ccv.SlashingKeeper,
 	accountKeeper ccv.AccountKeeper,
 	distributionKeeper ccv.DistributionKeeper, bankKeeper ccv.BankKeeper,
+	upgradeKeeper ccv.UpgradeKeeper,
 	govKeeper govkeeper.Keeper,
 	authority string,
 	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
@@ -83,10 +88,12 @@ func NewKeeper(
 		accountKeeper:         accountKeeper,
 		distributionKeeper:    distributionKeeper,
 		bankKeeper:            bankKeeper,
+		upgradeKeeper:         upgradeKeeper,
 		feeCollectorName:      feeCollectorName,
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
 		govKeeper:             govKeeper,
+		tokenizedSharesKeeper: NoOpTokenizedSharesKeeper{},
 	}
 
 	k.mustValidateFields()
@@ -112,8 +119,8 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 17 {
-		panic(fmt.Sprintf("number of fields in provider keeper is not 18 - have %d", reflect.ValueOf(k).NumField()))
+	if reflect.ValueOf(k).NumField() != 19 {
+		panic(fmt.Sprintf("number of fields in provider keeper is not 19 - have %d", reflect.ValueOf(k).NumField()))
 	}
 
 	if k.validatorAddressCodec == nil || k.consensusAddressCodec == nil {
@@ -136,15 +143,35 @@ func (k Keeper) mustValidateFields() {
 	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
 	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
 	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.upgradeKeeper, "upgradeKeeper")                 // 17
 
 	// this can be nil in tests
-	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 17
+	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 18
+
+	// this defaults to NoOpTokenizedSharesKeeper and is an empty struct
+	// ccv.PanicIfZeroOrNil(k.tokenizedSharesKeeper, "tokenizedSharesKeeper") // 19
 }
 
 func (k *Keeper) SetGovKeeper(govKeeper govkeeper.Keeper) {
 	k.govKeeper = govKeeper
 }
 
+// SetTokenizedSharesKeeper sets the keeper used to retrieve the tokenized shares of the validators,
+// which must be set by apps whose staking module supports liquid staking (e.g., LSM).
+// Otherwise, the NoOpTokenizedSharesKeeper is used, i.e., no validator has tokenized shares.
+func (k *Keeper) SetTokenizedSharesKeeper(tokenizedSharesKeeper ccv.TokenizedSharesKeeper) {
+	k.tokenizedSharesKeeper = tokenizedSharesKeeper
+}
+
+// NoOpTokenizedSharesKeeper is the default TokenizedSharesKeeper of the provider keeper,
+// for staking modules that do not support the tokenization of shares
+type NoOpTokenizedSharesKeeper struct{}
+
+// GetValidatorTokenizedShareTokens always returns zero
+func (NoOpTokenizedSharesKeeper) GetValidatorTokenizedShareTokens(context.Context, sdk.ValAddress) (math.Int, error) {
+	return math.ZeroInt(), nil
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -248,6 +275,55 @@ func (k Keeper) DeleteChannelIdToConsumerId(ctx sdk.Context, channelId string) {
 	store.Delete(types.ChannelToConsumerIdKey(channelId))
 }
 
+// SetDeletedChannelIdToConsumerId records that the mapping from the given CCV channel id to the consumer id was deleted,
+// so that acknowledgements and timeouts of packets that were still in flight on the channel can be handled gracefully.
+// The record is indexed by the current block time, so that it can be pruned once the unbonding period elapses
+// (see PruneDeletedChannelIds).
+func (k Keeper) SetDeletedChannelIdToConsumerId(ctx sdk.Context, channelId, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DeletedChannelIdToConsumerIdKey(channelId), []byte(consumerId))
+	store.Set(types.ChannelDeletionTimeKey(ctx.BlockTime(), channelId), []byte{})
+}
+
+// GetDeletedChannelIdToConsumerId returns the consumer id of a CCV channel whose mapping to the consumer id was deleted
+func (k Keeper) GetDeletedChannelIdToConsumerId(ctx sdk.Context, channelId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DeletedChannelIdToConsumerIdKey(channelId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// PruneDeletedChannelIds deletes the records of the CCV channels whose mappings were deleted
+// at least `unbondingPeriod` ago. After the unbonding period, the client of the consumer chain is expired
+// and hence no acknowledgement or timeout of a packet sent on the channel can be received anymore.
+func (k Keeper) PruneDeletedChannelIds(ctx sdk.Context, unbondingPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ChannelDeletionTimeKeyPrefix()})
+	defer iterator.Close()
+
+	// the records are iterated in ascending order of deletion times,
+	// i.e., the iteration stops at the first record that cannot be pruned
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		deletionTime, channelId, err := types.ParseChannelDeletionTimeKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetDeletedChannelIdToConsumerId.
+			panic(fmt.Errorf("failed to parse channel deletion time key: %w", err))
+		}
+		if ctx.BlockTime().Before(deletionTime.Add(unbondingPeriod)) {
+			break
+		}
+		keysToDel = append(keysToDel, iterator.Key(), types.DeletedChannelIdToConsumerIdKey(channelId))
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
 // GetAllChannelToConsumers gets all channel to chain mappings. If a mapping exists,
 // then the CCV channel to that consumer chain is established.
 //
@@ -313,6 +389,28 @@ func (k Keeper) DeleteConsumerGenesis(ctx sdk.Context, consumerId string) {
 	store.Delete(types.ConsumerGenesisKey(consumerId))
 }
 
+// SetConsumerGenesisHash sets the hash of the genesis created at launch for the given consumer chain
+func (k Keeper) SetConsumerGenesisHash(ctx sdk.Context, consumerId string, hash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToGenesisHashKey(consumerId), hash)
+}
+
+// GetConsumerGenesisHash returns the hash of the genesis created at launch for the given consumer chain
+func (k Keeper) GetConsumerGenesisHash(ctx sdk.Context, consumerId string) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	hash := store.Get(types.ConsumerIdToGenesisHashKey(consumerId))
+	if hash == nil {
+		return nil, false
+	}
+	return hash, true
+}
+
+// DeleteConsumerGenesisHash deletes the hash of the genesis created at launch for the given consumer chain
+func (k Keeper) DeleteConsumerGenesisHash(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToGenesisHashKey(consumerId))
+}
+
 // VerifyConsumerChain verifies that the chain trying to connect on the channel handshake
 // is the expected consumer chain.
 func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) error {
@@ -344,6 +442,64 @@ func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectio
 	return nil
 }
 
+// VerifyConsumerUnbondingPeriod verifies that the unbonding period `consumerUnbondingPeriod` declared by the consumer chain
+// on the other end of the CCV channel with `connectionHops` during the channel handshake matches the unbonding period
+// in the initialization parameters of the consumer chain, up to `MaxConsumerUnbondingPeriodDeviation`.
+//
+// VerifyConsumerUnbondingPeriod is called by OnChanOpenTry, after VerifyConsumerChain.
+func (k Keeper) VerifyConsumerUnbondingPeriod(ctx sdk.Context, connectionHops []string, consumerUnbondingPeriod time.Duration) error {
+	if len(connectionHops) != 1 {
+		return errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
+	}
+	consumerId, err := k.getConsumerIdByConnection(ctx, connectionHops[0])
+	if err != nil {
+		return err
+	}
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"cannot get initialization parameters of consumer chain %s: %s", consumerId, err.Error())
+	}
+
+	deviation := consumerUnbondingPeriod - initializationParameters.UnbondingPeriod
+	if deviation < 0 {
+		deviation = -deviation
+	}
+	if deviation > types.MaxConsumerUnbondingPeriodDeviation {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerUnbondingPeriod,
+			"consumer chain %s declared an unbonding period of %s, while its initialization parameters have an unbonding period of %s (tolerated deviation: %s)",
+			consumerId, consumerUnbondingPeriod, initializationParameters.UnbondingPeriod, types.MaxConsumerUnbondingPeriodDeviation)
+	}
+	return nil
+}
+
+// RecordConsumerAcceptsUpgradeNotices marks the consumer chain whose client underlies the connection in `connectionHops`
+// as accepting provider upgrade notices, as declared by the consumer chain during the CCV channel handshake
+func (k Keeper) RecordConsumerAcceptsUpgradeNotices(ctx sdk.Context, connectionHops []string) error {
+	if len(connectionHops) != 1 {
+		return errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
+	}
+	consumerId, err := k.getConsumerIdByConnection(ctx, connectionHops[0])
+	if err != nil {
+		return err
+	}
+	k.SetConsumerAcceptsUpgradeNotices(ctx, consumerId)
+	return nil
+}
+
+// getConsumerIdByConnection returns the id of the consumer chain whose client underlies the connection with `connectionID`
+func (k Keeper) getConsumerIdByConnection(ctx sdk.Context, connectionID string) (string, error) {
+	clientId, _, err := k.getUnderlyingClient(ctx, connectionID)
+	if err != nil {
+		return "", err
+	}
+	consumerId, found := k.GetClientIdToConsumerId(ctx, clientId)
+	if !found {
+		return "", errorsmod.Wrapf(ccv.ErrConsumerChainNotFound, "cannot find consumer id associated with client id: %s", clientId)
+	}
+	return consumerId, nil
+}
+
 // SetConsumerChain ensures that the consumer chain has not already been
 // set by a different channel, and then sets the consumer chain mappings
 // in keeper, and set the channel status to validating.
@@ -380,6 +536,9 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 	k.SetChannelToConsumerId(ctx, channelID, consumerId)
 	// - set current block height for the consumer chain initialization
 	k.SetInitChainHeight(ctx, consumerId, uint64(ctx.BlockHeight()))
+	// - set the current block time as the time of the last received packet,
+	//   i.e., the dormancy period is measured from the channel establishment
+	k.SetLastPacketReceivedTime(ctx, consumerId, ctx.BlockTime())
 
 	// emit event on successful addition
 	ctx.EventManager().EmitEvent(
@@ -501,6 +660,126 @@ func (k Keeper) DeleteValsetUpdateBlockHeight(ctx sdk.Context, valsetUpdateId ui
 	store.Delete(types.ValsetUpdateBlockHeightKey(valsetUpdateId))
 }
 
+// MigrateLegacyValsetUpdateBlockHeights moves the legacy mapping from vscIDs to block heights,
+// which is shared by all consumer chains, to the per-consumer mappings of the consumer chains
+// with IBC clients and deletes the legacy mapping. As the legacy mapping does not record when
+// a vscID was sent, the moved mappings are timestamped with the current block time.
+func (k Keeper) MigrateLegacyValsetUpdateBlockHeights(ctx sdk.Context) {
+	legacyHeights := k.GetAllValsetUpdateBlockHeights(ctx)
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		for _, v2h := range legacyHeights {
+			if _, found := k.GetVscIdToHeight(ctx, consumerId, v2h.ValsetUpdateId); found {
+				continue
+			}
+			k.SetVscIdToHeight(ctx, consumerId, types.VscIdToHeight{
+				VscId:     v2h.ValsetUpdateId,
+				Height:    v2h.Height,
+				Timestamp: ctx.BlockTime(),
+			})
+		}
+	}
+	for _, v2h := range legacyHeights {
+		k.DeleteValsetUpdateBlockHeight(ctx, v2h.ValsetUpdateId)
+	}
+}
+
+// SetVscIdToHeight sets the provider block height (and time) to which
+// a valset update id sent to the consumer chain with `consumerId` is mapped
+func (k Keeper) SetVscIdToHeight(ctx sdk.Context, consumerId string, vscIdToHeight types.VscIdToHeight) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := vscIdToHeight.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// vscIdToHeight is instantiated in this module.
+		panic(fmt.Errorf("failed to marshal VscIdToHeight: %w", err))
+	}
+	store.Set(types.ConsumerIdAndVscIdToHeightKey(consumerId, vscIdToHeight.VscId), bz)
+}
+
+// GetVscIdToHeight returns the provider block height (and time) to which
+// `vscId` sent to the consumer chain with `consumerId` is mapped
+func (k Keeper) GetVscIdToHeight(ctx sdk.Context, consumerId string, vscId uint64) (types.VscIdToHeight, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdAndVscIdToHeightKey(consumerId, vscId))
+	if bz == nil {
+		return types.VscIdToHeight{}, false
+	}
+	var vscIdToHeight types.VscIdToHeight
+	if err := vscIdToHeight.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// vscIdToHeight is assumed to be correctly serialized in SetVscIdToHeight.
+		panic(fmt.Errorf("failed to unmarshal VscIdToHeight: %w", err))
+	}
+	return vscIdToHeight, true
+}
+
+// GetAllVscIdToHeights returns the provider block heights (and times) to which
+// the valset update ids sent to the consumer chain with `consumerId` are mapped
+//
+// Note that the mapping is stored under keys with the following format:
+// ConsumerIdAndVscIdToHeightKeyPrefix | len(consumerId) | consumerId | vscID
+// Thus, the returned array is in ascending order of vscIDs.
+func (k Keeper) GetAllVscIdToHeights(ctx sdk.Context, consumerId string) (vscIdToHeights []types.VscIdToHeight) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsumerIdAndVscIdToHeightKeyPrefix(consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vscIdToHeight types.VscIdToHeight
+		if err := vscIdToHeight.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// vscIdToHeight is assumed to be correctly serialized in SetVscIdToHeight.
+			panic(fmt.Errorf("failed to unmarshal VscIdToHeight: %w", err))
+		}
+		vscIdToHeights = append(vscIdToHeights, vscIdToHeight)
+	}
+
+	return vscIdToHeights
+}
+
+// DeleteVscIdToHeight deletes the provider block height to which
+// `vscId` sent to the consumer chain with `consumerId` is mapped
+func (k Keeper) DeleteVscIdToHeight(ctx sdk.Context, consumerId string, vscId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdAndVscIdToHeightKey(consumerId, vscId))
+}
+
+// DeleteAllVscIdToHeights deletes the mapping from all the valset update ids
+// sent to the consumer chain with `consumerId` to provider block heights
+func (k Keeper) DeleteAllVscIdToHeights(ctx sdk.Context, consumerId string) {
+	for _, vscIdToHeight := range k.GetAllVscIdToHeights(ctx, consumerId) {
+		k.DeleteVscIdToHeight(ctx, consumerId, vscIdToHeight.VscId)
+	}
+}
+
+// SetEpochInfo sets the information about the current epoch
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epochInfo types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := epochInfo.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// epochInfo is instantiated in this module.
+		panic(fmt.Errorf("failed to marshal EpochInfo: %w", err))
+	}
+	store.Set(types.EpochInfoKey(), bz)
+}
+
+// GetEpochInfo returns the information about the current epoch
+func (k Keeper) GetEpochInfo(ctx sdk.Context) (types.EpochInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochInfoKey())
+	if bz == nil {
+		return types.EpochInfo{}, false
+	}
+	var epochInfo types.EpochInfo
+	if err := epochInfo.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// epochInfo is assumed to be correctly serialized in SetEpochInfo.
+		panic(fmt.Errorf("failed to unmarshal EpochInfo: %w", err))
+	}
+	return epochInfo, true
+}
+
 // SetSlashAcks sets the slash acks under the given chain ID
 //
 // TODO: SlashAcks should be persisted as a list of ConsumerConsAddr types, not strings.
@@ -815,3 +1094,17 @@ func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
 func (k Keeper) UnbondingTime(ctx sdk.Context) (time.Duration, error) {
 	return k.stakingKeeper.UnbondingTime(ctx)
 }
+
+// DumpStoreKeys returns the entries of the provider store whose key starts with `prefix`, e.g., for debugging
+// the store. The entries can be formatted human-readable with simulation.FormatStoreEntry.
+func (k Keeper) DumpStoreKeys(ctx sdk.Context, prefix []byte) []kv.Pair {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	entries := []kv.Pair{}
+	for ; iterator.Valid(); iterator.Next() {
+		entries = append(entries, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+	}
+	return entries
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"sort"
 	"testing"
+	"time"
 
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 
@@ -17,6 +19,7 @@ import (
 
 	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/simulation"
 	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
@@ -93,6 +96,45 @@ func TestGetAllValsetUpdateBlockHeights(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestVscIdToHeights tests the getter, setter, iteration, and deletion methods for the
+// per-consumer mapping of valset update ids to block heights
+func TestVscIdToHeights(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+
+	_, found := pk.GetVscIdToHeight(ctx, "0", 1)
+	require.False(t, found)
+
+	expected := []providertypes.VscIdToHeight{
+		{VscId: 1, Height: 11, Timestamp: now},
+		{VscId: 2, Height: 22, Timestamp: now.Add(time.Hour)},
+		{VscId: 256, Height: 33, Timestamp: now.Add(2 * time.Hour)},
+	}
+	// set the mappings in reverse order
+	for i := len(expected) - 1; i >= 0; i-- {
+		pk.SetVscIdToHeight(ctx, "0", expected[i])
+	}
+	pk.SetVscIdToHeight(ctx, "1", providertypes.VscIdToHeight{VscId: 1, Height: 44, Timestamp: now})
+
+	vscIdToHeight, found := pk.GetVscIdToHeight(ctx, "0", 2)
+	require.True(t, found)
+	require.Equal(t, expected[1], vscIdToHeight)
+
+	// the mappings are returned in ascending order of vscIDs
+	require.Equal(t, expected, pk.GetAllVscIdToHeights(ctx, "0"))
+
+	pk.DeleteVscIdToHeight(ctx, "0", 2)
+	_, found = pk.GetVscIdToHeight(ctx, "0", 2)
+	require.False(t, found)
+	require.Equal(t, []providertypes.VscIdToHeight{expected[0], expected[2]}, pk.GetAllVscIdToHeights(ctx, "0"))
+
+	pk.DeleteAllVscIdToHeights(ctx, "0")
+	require.Empty(t, pk.GetAllVscIdToHeights(ctx, "0"))
+	require.Len(t, pk.GetAllVscIdToHeights(ctx, "1"), 1)
+}
+
 // TestSlashAcks tests the getter, setter, iteration, and deletion methods for stored slash acknowledgements
 func TestSlashAcks(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -280,6 +322,39 @@ func TestGetAllChannelToChains(t *testing.T) {
 }
 
 // TestSetSlashLog tests slash log getter and setter methods
+// TestPruneDeletedChannelIds tests that the records of deleted CCV channels are pruned once the unbonding period elapses
+func TestPruneDeletedChannelIds(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 10 * time.Hour
+	now := time.Now().UTC()
+
+	providerKeeper.SetDeletedChannelIdToConsumerId(ctx.WithBlockTime(now), "channel-1", "1")
+	providerKeeper.SetDeletedChannelIdToConsumerId(ctx.WithBlockTime(now.Add(time.Hour)), "channel-0", "0")
+
+	// the unbonding period did not elapse since any channel was deleted
+	ctx = ctx.WithBlockTime(now.Add(unbondingPeriod - time.Second))
+	providerKeeper.PruneDeletedChannelIds(ctx, unbondingPeriod)
+	_, found := providerKeeper.GetDeletedChannelIdToConsumerId(ctx, "channel-1")
+	require.True(t, found)
+
+	// the unbonding period elapsed since "channel-1" was deleted
+	ctx = ctx.WithBlockTime(now.Add(unbondingPeriod))
+	providerKeeper.PruneDeletedChannelIds(ctx, unbondingPeriod)
+	_, found = providerKeeper.GetDeletedChannelIdToConsumerId(ctx, "channel-1")
+	require.False(t, found)
+	consumerId, found := providerKeeper.GetDeletedChannelIdToConsumerId(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, "0", consumerId)
+
+	// the unbonding period elapsed since "channel-0" was deleted
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + unbondingPeriod))
+	providerKeeper.PruneDeletedChannelIds(ctx, unbondingPeriod)
+	_, found = providerKeeper.GetDeletedChannelIdToConsumerId(ctx, "channel-0")
+	require.False(t, found)
+}
+
 func TestSetSlashLog(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -372,3 +447,63 @@ func TestConsumerClientId(t *testing.T) {
 	_, found = providerKeeper.GetClientIdToConsumerId(ctx, clientIds[1])
 	require.False(t, found)
 }
+
+// TestDumpStoreKeys tests that the entries of the provider store stored under a key prefix are dumped human-readable
+func TestDumpStoreKeys(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.SetConsumerChainId(ctx, "0", CONSUMER_CHAIN_ID)
+
+	dumpStoreKeys := func(prefix []byte) []string {
+		entries := []string{}
+		for _, pair := range providerKeeper.DumpStoreKeys(ctx, prefix) {
+			entries = append(entries, simulation.FormatStoreEntry(pair))
+		}
+		return entries
+	}
+
+	require.Equal(t, []string{
+		"ConsumerIdToPhaseKey(consumerId: 0): CONSUMER_PHASE_LAUNCHED",
+		"ConsumerIdToPhaseKey(consumerId: 1): CONSUMER_PHASE_STOPPED",
+	}, dumpStoreKeys([]byte{providertypes.ConsumerIdToPhaseKeyPrefix()}))
+
+	require.Equal(t, []string{
+		fmt.Sprintf("ConsumerIdToChainIdKey(consumerId: 0): %s", CONSUMER_CHAIN_ID),
+	}, dumpStoreKeys(providertypes.ConsumerIdToChainIdKey("0")))
+
+	require.Empty(t, providerKeeper.DumpStoreKeys(ctx, providertypes.ConsumerIdToChainIdKey("1")))
+}
+
+// TestStoreEntriesSubspaceQuery tests that the response of a subspace query of the provider store
+// (as used by the dump-store-keys query command) is unmarshaled as StoreEntries
+func TestStoreEntriesSubspaceQuery(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.SetConsumerChainId(ctx, "0", CONSUMER_CHAIN_ID)
+
+	cms, ok := ctx.MultiStore().(storetypes.CommitMultiStore)
+	require.True(t, ok)
+	cms.Commit()
+	prefix := []byte{providertypes.ConsumerIdToPhaseKeyPrefix()}
+	res, err := cms.(storetypes.Queryable).Query(&storetypes.RequestQuery{
+		Path: fmt.Sprintf("/%s/subspace", keeperParams.StoreKey.Name()),
+		Data: prefix,
+	})
+	require.NoError(t, err)
+
+	var entries providertypes.StoreEntries
+	require.NoError(t, entries.Unmarshal(res.Value))
+	expectedEntries := []providertypes.StoreEntry{}
+	for _, pair := range providerKeeper.DumpStoreKeys(ctx, prefix) {
+		expectedEntries = append(expectedEntries, providertypes.StoreEntry{Key: pair.Key, Value: pair.Value})
+	}
+	require.Len(t, expectedEntries, 2)
+	require.Equal(t, expectedEntries, entries.Entries)
+}
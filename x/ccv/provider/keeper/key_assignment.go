@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"time"
@@ -394,6 +395,63 @@ func (k Keeper) DeleteConsumerAddrsToPrune(ctx sdk.Context, consumerId string, p
 	store.Delete(types.ConsumerAddrsToPruneV2Key(consumerId, pruneTs))
 }
 
+// GetKeyAssignmentNonce returns the key assignment nonce of the validator with `providerAddr`
+// on the consumer chain with `consumerId`, i.e., the nonce expected in its next MsgAssignConsumerKey
+func (k Keeper) GetKeyAssignmentNonce(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAssignmentNonceKey(consumerId, providerAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetKeyAssignmentNonce sets the key assignment nonce of the validator with `providerAddr`
+// on the consumer chain with `consumerId`
+func (k Keeper) SetKeyAssignmentNonce(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyAssignmentNonceKey(consumerId, providerAddr), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetAllKeyAssignmentNonces returns the key assignment nonces of all the validators on all the consumer chains
+func (k Keeper) GetAllKeyAssignmentNonces(ctx sdk.Context) (nonces []types.KeyAssignmentNonce) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyAssignmentNonceKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, providerAddr, err := types.ParseStringIdAndConsAddrKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetKeyAssignmentNonce.
+			panic(err)
+		}
+		nonces = append(nonces, types.KeyAssignmentNonce{
+			ConsumerId:   consumerId,
+			ProviderAddr: providerAddr,
+			Nonce:        sdk.BigEndianToUint64(iterator.Value()),
+		})
+	}
+	return nonces
+}
+
+// ValidateKeyAssignmentNonce validates the `nonce` of a MsgAssignConsumerKey of the validator with `providerAddr`
+// on the consumer chain with `consumerId`. The nonce has to be equal to the key assignment nonce of the validator.
+// A zero nonce (see the AllowZeroKeyAssignmentNonce param) is only accepted as long as the key assignment nonce
+// of the validator is zero, i.e., a zero nonce cannot be used to replay a key assignment.
+// This prevents stale or replayed key assignments, e.g., after the provider state is exported and imported.
+func (k Keeper) ValidateKeyAssignmentNonce(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, nonce uint64) error {
+	expectedNonce := k.GetKeyAssignmentNonce(ctx, consumerId, providerAddr)
+	if nonce == 0 && expectedNonce == 0 && k.GetAllowZeroKeyAssignmentNonce(ctx) {
+		return nil
+	}
+	if nonce != expectedNonce {
+		return errorsmod.Wrapf(types.ErrInvalidKeyAssignmentNonce,
+			"expected nonce %d, got %d (validator %s, consumer id %s)", expectedNonce, nonce, providerAddr.String(), consumerId)
+	}
+	return nil
+}
+
 // AssignConsumerKey assigns the consumerKey to the validator with providerAddr
 // on the consumer chain with the given `consumerId`, if it is either registered or currently
 // voted on in a ConsumerAddition governance proposal
@@ -450,6 +508,28 @@ func (k Keeper) AssignConsumerKey(
 		)
 	}
 
+	// The consumer key cannot be used by a different validator of the consumer validator set. This is the case,
+	// for example, if the consumer key is the provider key of a validator that was removed from the staking module,
+	// but still validates the consumer chain until the next validator set update.
+	consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	for _, val := range consumerValSet {
+		if providerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(val.ProviderConsAddr)) {
+			continue
+		}
+		valConsumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*val.PublicKey)
+		if err != nil {
+			return err
+		}
+		if valConsumerAddr.Equals(consumerAddr.ToSdkConsAddr()) {
+			return errorsmod.Wrapf(
+				types.ErrConsumerKeyInUse, "a different validator of the consumer validator set uses the consumer key",
+			)
+		}
+	}
+
 	// get the previous key assigned for this validator on this consumer chain
 	if oldConsumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found {
 		oldConsumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(oldConsumerKey)
@@ -493,6 +573,83 @@ func (k Keeper) AssignConsumerKey(
 	return nil
 }
 
+// ExcludeConsumerKeyCollisions returns the consumer `validators` without the validators whose consumer key is also
+// used by another validator in `validators`. Among the validators that use the same consumer key, the validator
+// to which the key was assigned last (i.e., the validator the consumer address is mapped to) is retained. If the
+// consumer address is not mapped to any of them, the validator with the smallest provider address is retained.
+// An event is emitted for every excluded validator.
+func (k Keeper) ExcludeConsumerKeyCollisions(
+	ctx sdk.Context,
+	consumerId string,
+	validators []types.ConsensusValidator,
+) ([]types.ConsensusValidator, error) {
+	consumerAddrs := make([]sdk.ConsAddress, len(validators))
+	validatorsByConsumerAddr := map[string][]types.ConsensusValidator{}
+	for i, val := range validators {
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*val.PublicKey)
+		if err != nil {
+			return validators, err
+		}
+		consumerAddrs[i] = consumerAddr
+		validatorsByConsumerAddr[consumerAddr.String()] = append(validatorsByConsumerAddr[consumerAddr.String()], val)
+	}
+
+	// retainedValidators maps every consumer address to the provider address of the validator retained for it
+	retainedValidators := map[string][]byte{}
+	for consumerAddrStr, vals := range validatorsByConsumerAddr {
+		if len(vals) == 1 {
+			retainedValidators[consumerAddrStr] = vals[0].ProviderConsAddr
+			continue
+		}
+
+		retained := vals[0].ProviderConsAddr
+		for _, val := range vals[1:] {
+			if bytes.Compare(val.ProviderConsAddr, retained) < 0 {
+				retained = val.ProviderConsAddr
+			}
+		}
+		consumerAddr, err := sdk.ConsAddressFromBech32(consumerAddrStr)
+		if err != nil {
+			return validators, err
+		}
+		if providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, types.NewConsumerConsAddress(consumerAddr)); found {
+			for _, val := range vals {
+				if providerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(val.ProviderConsAddr)) {
+					retained = val.ProviderConsAddr
+					break
+				}
+			}
+		}
+		retainedValidators[consumerAddrStr] = retained
+	}
+
+	filtered := []types.ConsensusValidator{}
+	for i, val := range validators {
+		if bytes.Equal(retainedValidators[consumerAddrs[i].String()], val.ProviderConsAddr) {
+			filtered = append(filtered, val)
+			continue
+		}
+
+		providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+		k.Logger(ctx).Error("excluding validator from the consumer validator set due to a consumer key collision",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"consumerAddr", consumerAddrs[i].String(),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerValidatorEvicted,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+				sdk.NewAttribute(types.AttributeConsumerValidatorAddress, consumerAddrs[i].String()),
+				sdk.NewAttribute(types.AttributeEvictionReason, types.EvictionReasonConsumerKeyCollision),
+			),
+		)
+	}
+	return filtered, nil
+}
+
 // GetProviderAddrFromConsumerAddr returns the consensus address of a validator with
 // consAddr set as the consensus address on a consumer chain
 func (k Keeper) GetProviderAddrFromConsumerAddr(
@@ -525,24 +682,116 @@ func (k Keeper) PruneKeyAssignments(ctx sdk.Context, consumerId string) {
 	}
 }
 
-// DeleteKeyAssignments deletes all the state needed for key assignments on a consumer chain
-func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string) {
-	// delete ValidatorConsumerPubKey
-	for _, validatorConsumerAddr := range k.GetAllValidatorConsumerPubKeys(ctx, &consumerId) {
-		providerAddr := types.NewProviderConsAddress(validatorConsumerAddr.ProviderAddr)
-		k.DeleteValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+// DeleteKeyAssignments deletes at most `limit` store entries of the state needed for key assignments
+// on a consumer chain. It returns the number of deleted entries and whether all the key-assignment
+// state of the consumer chain is deleted.
+//
+// Note that this method does not panic if the key-assignment state is invalid. Entries that cannot be
+// decoded are logged and deleted without being decoded. In this case, an error describing how many
+// entries were skipped is returned once the deletions are done.
+func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string, limit int64) (deleted int64, done bool, err error) {
+	store := ctx.KVStore(k.storeKey)
+	prefixes := []struct {
+		name     string
+		prefix   byte
+		validate func(key, value []byte) error
+	}{
+		{
+			// ValidatorConsumerPubKey
+			name:   types.ConsumerValidatorsKeyName,
+			prefix: types.ConsumerValidatorsKeyPrefix(),
+			validate: func(key, value []byte) error {
+				if err := validateConsAddrKey(types.ConsumerValidatorsKeyPrefix(), key); err != nil {
+					return err
+				}
+				var consumerKey tmprotocrypto.PublicKey
+				return consumerKey.Unmarshal(value)
+			},
+		},
+		{
+			// ValidatorsByConsumerAddr
+			name:   types.ValidatorsByConsumerAddrKeyName,
+			prefix: types.ValidatorsByConsumerAddrKeyPrefix(),
+			validate: func(key, value []byte) error {
+				if err := validateConsAddrKey(types.ValidatorsByConsumerAddrKeyPrefix(), key); err != nil {
+					return err
+				}
+				return sdk.VerifyAddressFormat(value)
+			},
+		},
+		{
+			// ConsumerAddrsToPrune
+			name:   types.ConsumerAddrsToPruneV2KeyName,
+			prefix: types.ConsumerAddrsToPruneV2KeyPrefix(),
+			validate: func(key, value []byte) error {
+				if _, _, err := types.ParseStringIdAndTsKey(types.ConsumerAddrsToPruneV2KeyPrefix(), key); err != nil {
+					return err
+				}
+				var addrs types.AddressList
+				return addrs.Unmarshal(value)
+			},
+		},
+		{
+			// KeyAssignmentNonce
+			name:   types.KeyAssignmentNonceKeyName,
+			prefix: types.KeyAssignmentNonceKeyPrefix(),
+			validate: func(key, value []byte) error {
+				if err := validateConsAddrKey(types.KeyAssignmentNonceKeyPrefix(), key); err != nil {
+					return err
+				}
+				if len(value) != 8 {
+					return fmt.Errorf("invalid key assignment nonce length: %d", len(value))
+				}
+				return nil
+			},
+		},
 	}
 
-	// delete ValidatorsByConsumerAddr
-	for _, validatorConsumerAddr := range k.GetAllValidatorsByConsumerAddr(ctx, &consumerId) {
-		consumerAddr := types.NewConsumerConsAddress(validatorConsumerAddr.ConsumerAddr)
-		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	skipped := 0
+	done = true
+	for _, p := range prefixes {
+		iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(p.prefix, consumerId))
+		var keysToDel [][]byte
+		for ; iterator.Valid() && deleted+int64(len(keysToDel)) < limit; iterator.Next() {
+			if err := p.validate(iterator.Key(), iterator.Value()); err != nil {
+				skipped++
+				k.Logger(ctx).Error("skipping corrupt key assignment entry",
+					"consumerId", consumerId,
+					"state", p.name,
+					"key", fmt.Sprintf("%X", iterator.Key()),
+					"error", err.Error(),
+				)
+			}
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		remaining := iterator.Valid()
+		iterator.Close()
+
+		for _, key := range keysToDel {
+			store.Delete(key)
+		}
+		deleted += int64(len(keysToDel))
+
+		if remaining {
+			done = false
+			break
+		}
 	}
 
-	// delete ValidatorConsumerPubKey
-	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
-		k.DeleteConsumerAddrsToPrune(ctx, consumerId, consumerAddrsToPrune.PruneTs)
+	if skipped > 0 {
+		err = errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"skipped %d corrupt key assignment entries of consumer chain %s", skipped, consumerId)
+	}
+	return deleted, done, err
+}
+
+// validateConsAddrKey validates that `key` is a StringIdAndConsAddr key with the given `prefix`
+func validateConsAddrKey(prefix byte, key []byte) error {
+	_, addr, err := types.ParseStringIdAndConsAddrKey(prefix, key)
+	if err != nil {
+		return err
 	}
+	return sdk.VerifyAddressFormat(addr)
 }
 
 // ValidatorConsensusKeyInUse checks if the given consensus key is already
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"math/rand"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -295,6 +298,60 @@ func TestGetAllConsumerAddrsToPrune(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestDeleteKeyAssignments tests that the key-assignment state of a consumer chain is deleted
+// within the given limit and that corrupt entries are logged and deleted without panicking
+func TestDeleteKeyAssignments(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	var logs bytes.Buffer
+	ctx = ctx.WithLogger(log.NewLogger(&logs))
+
+	consumerIds := []string{"0", "1"}
+	for _, consumerId := range consumerIds {
+		for i := 0; i < 2; i++ {
+			identity := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+			pk.SetValidatorConsumerPubKey(ctx, consumerId, identity.ProviderConsAddress(), identity.TMProtoCryptoPublicKey())
+			pk.SetValidatorByConsumerAddr(ctx, consumerId, identity.ConsumerConsAddress(), identity.ProviderConsAddress())
+		}
+		pk.AppendConsumerAddrsToPrune(ctx, consumerId, time.Now().UTC(),
+			cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress())
+	}
+
+	// plant malformed entries under the key-assignment prefixes of the first consumer chain
+	store := ctx.KVStore(keeperParams.StoreKey)
+	store.Set(types.StringIdWithLenKey(types.ConsumerValidatorsKeyPrefix(), consumerIds[0]), []byte("malformed"))
+	store.Set(types.ValidatorsByConsumerAddrKey(consumerIds[0], types.NewConsumerConsAddress([]byte("consumerAddr"))), []byte{})
+	store.Set(append(types.StringIdWithLenKey(types.ConsumerAddrsToPruneV2KeyPrefix(), consumerIds[0]), []byte("malformed")...), []byte("malformed"))
+
+	// the 8 entries of the first consumer chain are deleted in 3 calls,
+	// each of them skipping one of the corrupt entries
+	for _, expDeleted := range []int64{3, 3, 2} {
+		deleted, done, err := pk.DeleteKeyAssignments(ctx, consumerIds[0], 3)
+		require.Equal(t, expDeleted, deleted)
+		require.Equal(t, expDeleted < 3, done)
+		require.ErrorContains(t, err, "skipped 1 corrupt key assignment entries")
+	}
+	require.Equal(t, 3, strings.Count(logs.String(), "skipping corrupt key assignment entry"))
+
+	// the key-assignment state of the first consumer chain is deleted
+	require.Empty(t, pk.GetAllValidatorConsumerPubKeys(ctx, &consumerIds[0]))
+	require.Empty(t, pk.GetAllValidatorsByConsumerAddr(ctx, &consumerIds[0]))
+	require.Empty(t, pk.GetAllConsumerAddrsToPrune(ctx, consumerIds[0]))
+
+	// the key-assignment state of the second consumer chain is not affected
+	require.Len(t, pk.GetAllValidatorConsumerPubKeys(ctx, &consumerIds[1]), 2)
+	require.Len(t, pk.GetAllValidatorsByConsumerAddr(ctx, &consumerIds[1]), 2)
+	require.Len(t, pk.GetAllConsumerAddrsToPrune(ctx, consumerIds[1]), 1)
+
+	// deleting the key-assignment state of the second consumer chain does not return an error
+	deleted, done, err := pk.DeleteKeyAssignments(ctx, consumerIds[1], 10)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, int64(5), deleted)
+}
+
 // checkCorrectPruningProperty checks that the pruning property is correct for a given
 // consumer chain. See AppendConsumerAddrsToPrune for a formulation of the property.
 func checkCorrectPruningProperty(ctx sdk.Context, k providerkeeper.Keeper, chainID string) bool {
@@ -627,6 +684,155 @@ func TestCannotReassignDefaultKeyAssignment(t *testing.T) {
 	require.Equal(t, "a validator cannot assign the default key assignment unless its key on that consumer has already been assigned: cannot re-assign default key assignment", err.Error())
 }
 
+// TestCannotAssignKeyOfConsumerValidator checks that a validator cannot assign a consumer key that is used by
+// a different validator of the consumer validator set, e.g., by a validator that was removed from the staking module
+func TestCannotAssignKeyOfConsumerValidator(t *testing.T) {
+	removedValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	otherKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	removedValidatorKey := removedValidator.TMProtoCryptoPublicKey()
+	err := providerKeeper.SetConsumerValSet(ctx, CONSUMER_ID, []types.ConsensusValidator{{
+		ProviderConsAddr: removedValidator.SDKValConsAddress(),
+		Power:            1,
+		PublicKey:        &removedValidatorKey,
+	}})
+	require.NoError(t, err)
+
+	// the removed validator is not found in the staking module anymore
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+			removedValidator.SDKValConsAddress(),
+		).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound),
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx,
+			otherKey.SDKValConsAddress(),
+		).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound),
+	)
+
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID, validator.SDKStakingValidator(), removedValidatorKey)
+	require.ErrorIs(t, err, types.ErrConsumerKeyInUse)
+	_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, removedValidator.ConsumerConsAddress())
+	require.False(t, found)
+
+	// any other key can still be assigned
+	err = providerKeeper.AssignConsumerKey(ctx, CONSUMER_ID, validator.SDKStakingValidator(), otherKey.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+}
+
+// TestExcludeConsumerKeyCollisions tests that only one of the validators using the same consumer key is retained
+func TestExcludeConsumerKeyCollisions(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerIdentities := []*cryptotestutil.CryptoIdentity{
+		cryptotestutil.NewCryptoIdentityFromIntSeed(0),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(1),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(2),
+	}
+	collidingKey := cryptotestutil.NewCryptoIdentityFromIntSeed(3).TMProtoCryptoPublicKey()
+	collidingAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(3).ConsumerConsAddress()
+	otherKey := cryptotestutil.NewCryptoIdentityFromIntSeed(4).TMProtoCryptoPublicKey()
+
+	validators := []types.ConsensusValidator{
+		{ProviderConsAddr: providerIdentities[0].SDKValConsAddress(), Power: 1, PublicKey: &collidingKey},
+		{ProviderConsAddr: providerIdentities[1].SDKValConsAddress(), Power: 2, PublicKey: &otherKey},
+		{ProviderConsAddr: providerIdentities[2].SDKValConsAddress(), Power: 3, PublicKey: &collidingKey},
+	}
+
+	// validators without a collision are retained
+	retained, err := providerKeeper.ExcludeConsumerKeyCollisions(ctx, CONSUMER_ID, validators[0:2])
+	require.NoError(t, err)
+	require.Equal(t, validators[0:2], retained)
+
+	// without a key assignment, the validator with the smallest provider address is retained
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	retained, err = providerKeeper.ExcludeConsumerKeyCollisions(ctx, CONSUMER_ID, validators)
+	require.NoError(t, err)
+	if bytes.Compare(validators[0].ProviderConsAddr, validators[2].ProviderConsAddr) < 0 {
+		require.Equal(t, []types.ConsensusValidator{validators[0], validators[1]}, retained)
+	} else {
+		require.Equal(t, []types.ConsensusValidator{validators[1], validators[2]}, retained)
+	}
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// the validator to which the key was assigned last is retained
+	for _, i := range []int{0, 2} {
+		providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, collidingAddr, providerIdentities[i].ProviderConsAddress())
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		retained, err = providerKeeper.ExcludeConsumerKeyCollisions(ctx, CONSUMER_ID, validators)
+		require.NoError(t, err)
+		excluded := 2 - i
+		require.Equal(t, []types.ConsensusValidator{validators[min(i, 1)], validators[max(i, 1)]}, retained)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, types.EventTypeConsumerValidatorEvicted, events[0].Type)
+		attributes := map[string]string{}
+		for _, attr := range events[0].Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		excludedAddr := providerIdentities[excluded].ProviderConsAddress()
+		require.Equal(t, excludedAddr.String(), attributes[types.AttributeProviderValidatorAddress])
+		require.Equal(t, collidingAddr.String(), attributes[types.AttributeConsumerValidatorAddress])
+		require.Equal(t, types.EvictionReasonConsumerKeyCollision, attributes[types.AttributeEvictionReason])
+	}
+}
+
+// TestPruneKeyAssignmentsWithoutChannel tests that the consumer addresses replaced on a launched consumer chain
+// are pruned once the unbonding period elapsed since the key assignment, even if the CCV channel to the
+// consumer chain was never established and hence no VSC packet was ever acknowledged
+func TestPruneKeyAssignmentsWithoutChannel(t *testing.T) {
+	k, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).Return(
+		stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	// the consumer chain launched, i.e., it has a client, but there is no CCV channel
+	k.SetConsumerPhase(ctx, CONSUMER_ID, types.CONSUMER_PHASE_LAUNCHED)
+	k.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	_, found := k.GetConsumerIdToChannelId(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	providerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	oldConsumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	newConsumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	ctx = ctx.WithBlockTime(time.Unix(1000000, 0).UTC())
+	err := k.AssignConsumerKey(ctx, CONSUMER_ID, providerIdentity.SDKStakingValidator(), oldConsumerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+	err = k.AssignConsumerKey(ctx, CONSUMER_ID, providerIdentity.SDKStakingValidator(), newConsumerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+
+	// the old consumer address is not pruned before the unbonding period elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingPeriod - time.Second))
+	k.EndBlockCIS(ctx)
+	_, found = k.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, oldConsumerIdentity.ConsumerConsAddress())
+	require.True(t, found)
+
+	// the old consumer address is pruned once the unbonding period elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	k.EndBlockCIS(ctx)
+	_, found = k.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, oldConsumerIdentity.ConsumerConsAddress())
+	require.False(t, found)
+	require.Empty(t, k.GetAllConsumerAddrsToPrune(ctx, CONSUMER_ID))
+	providerAddr, found := k.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, newConsumerIdentity.ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t, providerIdentity.ProviderConsAddress(), providerAddr)
+
+	// pruning is idempotent
+	k.EndBlockCIS(ctx)
+	_, found = k.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, newConsumerIdentity.ConsumerConsAddress())
+	require.True(t, found)
+	require.True(t, checkCorrectPruningProperty(ctx, k, CONSUMER_ID))
+}
+
 // Represents the validator set of a chain
 type ValSet struct {
 	identities []*cryptotestutil.CryptoIdentity
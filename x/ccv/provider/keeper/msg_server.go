@@ -3,10 +3,14 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -65,6 +69,17 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	// reject stale or replayed key assignments
+	if err := k.ValidateKeyAssignmentNonce(ctx, msg.ConsumerId, providerAddr, msg.Nonce); err != nil {
+		return nil, err
+	}
+
 	consumerTMPublicKey, err := k.ParseConsumerKey(msg.ConsumerKey)
 	if err != nil {
 		return nil, err
@@ -73,6 +88,8 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 	if err := k.Keeper.AssignConsumerKey(ctx, msg.ConsumerId, validator, consumerTMPublicKey); err != nil {
 		return nil, err
 	}
+	nonce := k.GetKeyAssignmentNonce(ctx, msg.ConsumerId, providerAddr) + 1
+	k.SetKeyAssignmentNonce(ctx, msg.ConsumerId, providerAddr, nonce)
 
 	chainId, err := k.GetConsumerChainId(ctx, msg.ConsumerId)
 	if err != nil {
@@ -94,6 +111,7 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
 			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
 			sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
+			sdk.NewAttribute(types.AttributeKeyAssignmentNonce, strconv.FormatUint(nonce, 10)),
 			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
 		),
 	)
@@ -121,6 +139,69 @@ func (k msgServer) ChangeRewardDenoms(goCtx context.Context, msg *types.MsgChang
 	return &types.MsgChangeRewardDenomsResponse{}, nil
 }
 
+// SetConsumerVerified defines a rpc handler method for MsgSetConsumerVerified
+func (k msgServer) SetConsumerVerified(goCtx context.Context, msg *types.MsgSetConsumerVerified) (*types.MsgSetConsumerVerifiedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if k.GetConsumerPhase(ctx, msg.ConsumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(types.ErrUnknownConsumerId, "consumer id: %s", msg.ConsumerId)
+	}
+
+	// the verified flag does not depend on the phase of the consumer chain,
+	// i.e., it can only be cleared by governance
+	if msg.Verified {
+		k.Keeper.SetConsumerVerified(ctx, msg.ConsumerId)
+	} else {
+		k.Keeper.DeleteConsumerVerified(ctx, msg.ConsumerId)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConsumerVerified,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeConsumerVerified, strconv.FormatBool(msg.Verified)),
+		),
+	)
+
+	return &types.MsgSetConsumerVerifiedResponse{}, nil
+}
+
+// SetConsumerEvidenceSubmissionPaused defines a rpc handler method for MsgSetConsumerEvidenceSubmissionPaused
+func (k msgServer) SetConsumerEvidenceSubmissionPaused(goCtx context.Context, msg *types.MsgSetConsumerEvidenceSubmissionPaused) (*types.MsgSetConsumerEvidenceSubmissionPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if k.GetConsumerPhase(ctx, msg.ConsumerId) == types.CONSUMER_PHASE_UNSPECIFIED {
+		return nil, errorsmod.Wrapf(types.ErrUnknownConsumerId, "consumer id: %s", msg.ConsumerId)
+	}
+
+	eventType := types.EventTypePauseEvidenceSubmission
+	if msg.Paused {
+		k.Keeper.SetConsumerEvidenceSubmissionPaused(ctx, msg.ConsumerId)
+	} else {
+		k.Keeper.DeleteConsumerEvidenceSubmissionPaused(ctx, msg.ConsumerId)
+		eventType = types.EventTypeResumeEvidenceSubmission
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+		),
+	)
+
+	return &types.MsgSetConsumerEvidenceSubmissionPausedResponse{}, nil
+}
+
 func (k msgServer) SubmitConsumerMisbehaviour(goCtx context.Context, msg *types.MsgSubmitConsumerMisbehaviour) (*types.MsgSubmitConsumerMisbehaviourResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.Keeper.HandleConsumerMisbehaviour(ctx, msg.ConsumerId, *msg.Misbehaviour); err != nil {
@@ -177,7 +258,7 @@ func (k msgServer) SubmitConsumerDoubleVoting(goCtx context.Context, msg *types.
 
 	// handle the double voting evidence using the malicious validator's public key
 	consumerId := msg.ConsumerId
-	if err := k.Keeper.HandleConsumerDoubleVoting(ctx, consumerId, evidence, pubkey); err != nil {
+	if err := k.Keeper.HandleConsumerDoubleVoting(ctx, consumerId, msg.InfractionBlockHeader.Header.ChainID, evidence, pubkey); err != nil {
 		return nil, err
 	}
 
@@ -198,6 +279,11 @@ func (k msgServer) SubmitConsumerDoubleVoting(goCtx context.Context, msg *types.
 func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.MsgOptInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// check the phase before any state is written (e.g., the acknowledged terms)
+	if err := k.Keeper.ValidateOptInPhase(ctx, msg.ConsumerId); err != nil {
+		return nil, err
+	}
+
 	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
@@ -215,6 +301,11 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 	}
 	providerConsAddr := types.NewProviderConsAddress(consAddrTmp)
 
+	err = k.Keeper.HandleConsumerTermsAcknowledgment(ctx, msg.ConsumerId, providerConsAddr, msg.AcknowledgedTermsHash)
+	if err != nil {
+		return nil, err
+	}
+
 	err = k.Keeper.HandleOptIn(ctx, msg.ConsumerId, providerConsAddr, msg.ConsumerKey)
 	if err != nil {
 		return nil, err
@@ -244,12 +335,26 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 		),
 	)
 
-	return &types.MsgOptInResponse{}, nil
+	// the opt-in is recorded even if the validator exceeds the max consumer participation
+	warning := k.GetMaxConsumerParticipationWarning(ctx, providerConsAddr)
+	if warning != "" {
+		k.Logger(ctx).Info("validator opted in beyond the max consumer participation",
+			"consumerId", msg.ConsumerId,
+			"validator operator addr", msg.ProviderAddr,
+			"warning", warning,
+		)
+	}
+
+	return &types.MsgOptInResponse{Warning: warning}, nil
 }
 
 func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.MsgOptOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := k.Keeper.ValidateOptOutPhase(ctx, msg.ConsumerId); err != nil {
+		return nil, err
+	}
+
 	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
@@ -297,6 +402,40 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 	return &types.MsgOptOutResponse{}, nil
 }
 
+// EmergencyOptOut defines a rpc handler method for MsgEmergencyOptOut
+func (k msgServer) EmergencyOptOut(goCtx context.Context, msg *types.MsgEmergencyOptOut) (*types.MsgEmergencyOptOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// validator must already be registered
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	consAddrTmp, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	providerConsAddr := types.NewProviderConsAddress(consAddrTmp)
+
+	if err := k.Keeper.HandleEmergencyOptOut(ctx, msg.ConsumerId, providerConsAddr); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("validator emergency opted out",
+		"consumerId", msg.ConsumerId,
+		"validator operator addr", msg.ProviderAddr,
+		"submitter", msg.Signer,
+	)
+
+	return &types.MsgEmergencyOptOutResponse{}, nil
+}
+
 func (k msgServer) SetConsumerCommissionRate(goCtx context.Context, msg *types.MsgSetConsumerCommissionRate) (*types.MsgSetConsumerCommissionRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -347,11 +486,80 @@ func (k msgServer) SetConsumerCommissionRate(goCtx context.Context, msg *types.M
 	return &types.MsgSetConsumerCommissionRateResponse{}, nil
 }
 
+// SetConsumerAvailability defines a rpc handler method for MsgSetConsumerAvailability
+func (k msgServer) SetConsumerAvailability(goCtx context.Context, msg *types.MsgSetConsumerAvailability) (*types.MsgSetConsumerAvailabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// validator must already be registered
+	validator, err := k.stakingKeeper.GetValidator(ctx, providerValidatorAddr)
+	if err != nil {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.HandleSetConsumerAvailability(ctx, msg.ConsumerId, types.NewProviderConsAddress(consAddr), msg.Available); err != nil {
+		return nil, err
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, msg.ConsumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	k.Logger(ctx).Info("validator set availability on consumer",
+		"consumerId", msg.ConsumerId,
+		"chainId", chainId,
+		"validator operator addr", msg.ProviderAddr,
+		"available", msg.Available,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConsumerAvailability,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			sdk.NewAttribute(types.AttributeConsumerAvailable, strconv.FormatBool(msg.Available)),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
+		),
+	)
+
+	return &types.MsgSetConsumerAvailabilityResponse{}, nil
+}
+
 // CreateConsumer creates a consumer chain
 func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateConsumer) (*types.MsgCreateConsumerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	resp := types.MsgCreateConsumerResponse{}
 
+	// charge gas proportional to the size of the metadata and the lists before anything is written
+	ctx.GasMeter().ConsumeGas(consumerMsgSizeGas(&msg.Metadata, msg.PowerShapingParameters, msg.AllowlistedRewardDenoms),
+		"create consumer")
+
+	// record whether the consumer chain is created by the governance authority and, if provided,
+	// through which governance proposal
+	creationRecord := types.ConsumerCreationRecord{CreatedByGovernance: msg.Submitter == k.GetAuthority()}
+	if msg.ProposalId != 0 {
+		if !creationRecord.CreatedByGovernance {
+			return &resp, errorsmod.Wrapf(types.ErrUnauthorized,
+				"only the governance authority can provide a proposal id, expected %s, got %s", k.GetAuthority(), msg.Submitter)
+		}
+		if err := k.Keeper.ValidateProposalInExecution(ctx, msg.ProposalId, msg); err != nil {
+			return &resp, err
+		}
+		creationRecord.ProposalId = msg.ProposalId
+	}
+
 	// initialize an empty slice to store event attributes
 	eventAttributes := []sdk.Attribute{}
 
@@ -365,6 +573,9 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerMetadata,
 			"cannot set consumer metadata: %s", err.Error())
 	}
+	if err := k.Keeper.SetConsumerCreationRecord(ctx, consumerId, creationRecord); err != nil {
+		return &resp, err
+	}
 
 	// add event attributes
 	eventAttributes = append(eventAttributes, []sdk.Attribute{
@@ -374,7 +585,12 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		sdk.NewAttribute(types.AttributeConsumerName, msg.Metadata.Name),
 		sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter),
 		sdk.NewAttribute(types.AttributeConsumerOwner, msg.Submitter),
+		sdk.NewAttribute(types.AttributeCreatedByGovernance, strconv.FormatBool(creationRecord.CreatedByGovernance)),
 	}...)
+	if creationRecord.ProposalId != 0 {
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributeProposalId, strconv.FormatUint(creationRecord.ProposalId, 10)))
+	}
 
 	// initialization parameters are optional and hence could be nil;
 	// in that case, set the default
@@ -382,6 +598,12 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 	if msg.InitializationParameters != nil {
 		initializationParameters = *msg.InitializationParameters
 	}
+	if initializationParameters.DistributionTransmissionChannel != "" {
+		if err := k.Keeper.ValidateDistributionTransmissionChannel(ctx, msg.ChainId,
+			initializationParameters.DistributionTransmissionChannel); err != nil {
+			return &resp, err
+		}
+	}
 	if err := k.Keeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters); err != nil {
 		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 			"cannot set consumer initialization parameters: %s", err.Error())
@@ -422,9 +644,12 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		}
 	}
 
-	// add Phase event attribute
+	// add Phase event attributes
 	phase := k.GetConsumerPhase(ctx, consumerId)
-	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()))
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeConsumerPhase, types.PhaseToString(phase)),
+		sdk.NewAttribute(types.AttributeConsumerPhaseValue, strconv.Itoa(int(phase))),
+	)
 
 	k.Logger(ctx).Info("created consumer",
 		"consumerId", consumerId,
@@ -432,6 +657,8 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		"owner", msg.Submitter,
 		"phase", phase,
 		"spawn time", initializationParameters.SpawnTime,
+		"created by governance", creationRecord.CreatedByGovernance,
+		"proposal id", creationRecord.ProposalId,
 	)
 
 	ctx.EventManager().EmitEvent(
@@ -442,12 +669,92 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 	)
 
 	resp.ConsumerId = consumerId
+	resp.Phase = phase
+	if phase == types.CONSUMER_PHASE_INITIALIZED {
+		spawnTime := initializationParameters.SpawnTime.UTC()
+		resp.SpawnTime = &spawnTime
+	}
 	return &resp, nil
 }
 
-// UpdateConsumer updates the metadata, power-shaping or initialization parameters of a consumer chain
+// UpdateConsumer updates the metadata, power-shaping or initialization parameters of a consumer chain.
+// The update is atomic, i.e., either all the provided fields are applied or, if any of them is invalid,
+// none of them is persisted.
 func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateConsumer) (*types.MsgUpdateConsumerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// charge gas proportional to the size of the metadata and the lists before anything is written
+	ctx.GasMeter().ConsumeGas(consumerMsgSizeGas(msg.Metadata, msg.PowerShapingParameters, msg.AllowlistedRewardDenoms),
+		"update consumer")
+
+	// apply the update on a cached context and only write it (and the emitted events) if all the fields are valid
+	cachedCtx, writeFn := ctx.CacheContext()
+	resp, err := k.updateConsumer(cachedCtx, msg)
+	if err != nil {
+		return resp, err
+	}
+	writeFn()
+
+	return resp, nil
+}
+
+// consumerMsgSizeGas returns the gas charged for a MsgCreateConsumer or MsgUpdateConsumer message with the given
+// metadata, power-shaping parameters and allowlisted reward denoms, i.e., a constant per byte of the serialized metadata
+// plus a constant per byte of the serialized allowlist, denylist and reward denoms. Otherwise, the gas consumed by large
+// messages barely differs from the one consumed by small messages, as most of the gas is consumed by the store writes.
+func consumerMsgSizeGas(
+	metadata *types.ConsumerMetadata,
+	powerShapingParameters *types.PowerShapingParameters,
+	allowlistedRewardDenoms *types.AllowlistedRewardDenoms,
+) storetypes.Gas {
+	gas := storetypes.Gas(0)
+	if metadata != nil {
+		gas += uint64(metadata.Size()) * types.ConsumerMetadataGasPerByte
+	}
+	if powerShapingParameters != nil {
+		lists := types.PowerShapingParameters{
+			Allowlist: powerShapingParameters.Allowlist,
+			Denylist:  powerShapingParameters.Denylist,
+		}
+		gas += uint64(lists.Size()) * types.ConsumerListGasPerByte
+	}
+	if allowlistedRewardDenoms != nil {
+		gas += uint64(allowlistedRewardDenoms.Size()) * types.ConsumerListGasPerByte
+	}
+	return gas
+}
+
+// powerShapingAdminUpdate returns the given MsgUpdateConsumer message sent by the power-shaping admin of a consumer chain
+// with its allowlist and denylist applied to the current power-shaping parameters of the consumer chain.
+// It returns an error if the message contains anything else than the allowlist and denylist.
+func (k msgServer) powerShapingAdminUpdate(ctx sdk.Context, msg *types.MsgUpdateConsumer) (*types.MsgUpdateConsumer, error) {
+	params := msg.PowerShapingParameters
+	onlyLists := params != nil && params.Top_N == 0 && params.ValidatorsPowerCap == 0 && params.ValidatorSetCap == 0 &&
+		params.MinStake == 0 && !params.AllowInactiveVals && params.MaxProviderRank == 0 && params.DowntimeGracePeriod == nil &&
+		!params.AllowTopNEmergencyOptOut && params.ReOptInCooldownAfterSlash == nil
+	if !onlyLists || msg.NewOwnerAddress != "" || msg.Metadata != nil || msg.InitializationParameters != nil ||
+		msg.AllowlistedRewardDenoms != nil || msg.RewardChannelId != "" || msg.EndpointInfo != nil ||
+		msg.TimeoutPeriods != nil || msg.PowerShapingAdmin != nil || msg.RetainPowerShapingAdmin ||
+		msg.LifetimeExtension != nil || msg.UpgradeNotice != nil || msg.RewardsPaused != nil || msg.AllowedIcaMsgTypes != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized,
+			"power-shaping admin %s can only update the allowlist and denylist", msg.Owner)
+	}
+
+	currentParams, err := k.Keeper.GetConsumerPowerShapingParameters(ctx, msg.ConsumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot get consumer previous power shaping parameters: %s", err.Error())
+	}
+	currentParams.Allowlist = params.Allowlist
+	currentParams.Denylist = params.Denylist
+
+	adminMsg := *msg
+	adminMsg.PowerShapingParameters = &currentParams
+	return &adminMsg, nil
+}
+
+// updateConsumer applies the fields of a MsgUpdateConsumer message and returns the resulting state of the consumer chain
+func (k msgServer) updateConsumer(ctx sdk.Context, msg *types.MsgUpdateConsumer) (*types.MsgUpdateConsumerResponse, error) {
 	resp := types.MsgUpdateConsumerResponse{}
 
 	// initialize an empty slice to store event attributes
@@ -466,7 +773,14 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 	}
 
 	if msg.Owner != ownerAddress {
-		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+		// the power-shaping admin is permitted to update only the allowlist and denylist
+		admin, found := k.Keeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+		if !found || msg.Owner != admin {
+			return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+		}
+		if msg, err = k.powerShapingAdminUpdate(ctx, msg); err != nil {
+			return &resp, err
+		}
 	}
 
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
@@ -490,6 +804,28 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 
 		k.Keeper.SetConsumerOwnerAddress(ctx, consumerId, msg.NewOwnerAddress)
+		resp.UpdatedFields = append(resp.UpdatedFields, "new_owner_address")
+
+		// the power-shaping admin is removed on ownership transfer unless explicitly retained
+		if msg.NewOwnerAddress != ownerAddress && !msg.RetainPowerShapingAdmin {
+			k.Keeper.DeleteConsumerPowerShapingAdmin(ctx, consumerId)
+		}
+	}
+
+	if msg.PowerShapingAdmin != nil {
+		if msg.PowerShapingAdmin.Address == "" {
+			k.Keeper.DeleteConsumerPowerShapingAdmin(ctx, consumerId)
+		} else {
+			if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.PowerShapingAdmin.Address); err != nil {
+				return &resp, errorsmod.Wrapf(types.ErrInvalidPowerShapingAdmin,
+					"invalid power-shaping admin address %s", msg.PowerShapingAdmin.Address)
+			}
+			k.Keeper.SetConsumerPowerShapingAdmin(ctx, consumerId, msg.PowerShapingAdmin.Address)
+		}
+
+		// add PowerShapingAdmin event attribute
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributePowerShapingAdmin, msg.PowerShapingAdmin.Address))
+		resp.UpdatedFields = append(resp.UpdatedFields, "power_shaping_admin")
 	}
 
 	if msg.Metadata != nil {
@@ -500,6 +836,7 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 
 		// add Name event attribute
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerName, msg.Metadata.Name))
+		resp.UpdatedFields = append(resp.UpdatedFields, "metadata")
 	}
 
 	// get the previous spawn time so that we can remove its previously planned spawn time if a new spawn time is provided
@@ -529,8 +866,16 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 						"cannot remove the consumer from being launched: %s", err.Error())
 				}
 				k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+				k.DeleteConsumerLaunchConflictRetries(ctx, consumerId)
 			}
 		}
+		if msg.InitializationParameters.DistributionTransmissionChannel != "" {
+			if err := k.Keeper.ValidateDistributionTransmissionChannel(ctx, chainId,
+				msg.InitializationParameters.DistributionTransmissionChannel); err != nil {
+				return &resp, err
+			}
+		}
+
 		// add SpawnTime event attribute
 		eventAttributes = append(eventAttributes,
 			sdk.NewAttribute(types.AttributeConsumerSpawnTime, msg.InitializationParameters.SpawnTime.String()))
@@ -539,6 +884,7 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 				"cannot set consumer initialization parameters: %s", err.Error())
 		}
+		resp.UpdatedFields = append(resp.UpdatedFields, "initialization_parameters")
 	}
 
 	if msg.PowerShapingParameters != nil {
@@ -557,6 +903,13 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 		oldTopN := oldPowerShapingParameters.Top_N
 
+		// the downtime grace period exempts validators from downtime and hence it can only be changed by the gov module
+		if downtimeGracePeriod(*msg.PowerShapingParameters) != downtimeGracePeriod(oldPowerShapingParameters) &&
+			ownerAddress != k.GetAuthority() {
+			return &resp, errorsmod.Wrapf(types.ErrUnauthorized,
+				"the downtime grace period can only be updated if the owner of the chain is the gov module")
+		}
+
 		if err = k.Keeper.SetConsumerPowerShapingParameters(ctx, consumerId, *msg.PowerShapingParameters); err != nil {
 			return &resp, errorsmod.Wrapf(types.ErrInvalidPowerShapingParameters,
 				"cannot set power shaping parameters")
@@ -570,6 +923,7 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		// add TopN event attribute
 		eventAttributes = append(eventAttributes,
 			sdk.NewAttribute(types.AttributeConsumerTopN, fmt.Sprintf("%v", msg.PowerShapingParameters.Top_N)))
+		resp.UpdatedFields = append(resp.UpdatedFields, "power_shaping_parameters")
 	}
 
 	// A Top N cannot change its owner address to something different from the gov module if the chain
@@ -589,6 +943,12 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			"a move to a new owner address that is not the gov module can only be done if `Top N` is set to 0")
 	}
 
+	// The validators of a Top N chain are opted in automatically and hence cannot acknowledge its terms.
+	if currentPowerShapingParameters.Top_N != 0 && k.Keeper.GetConsumerTermsHash(ctx, consumerId) != "" {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerTerms,
+			"a Top N chain cannot have terms; either set `Top N` to 0 or remove the terms hash from the metadata")
+	}
+
 	if spawnTime, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
 		if err := k.Keeper.PrepareConsumerForLaunch(ctx, consumerId, previousSpawnTime, spawnTime); err != nil {
 			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
@@ -602,14 +962,137 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			return &resp, errorsmod.Wrapf(types.ErrInvalidAllowlistedRewardDenoms,
 				"cannot update allowlisted reward denoms: %s", err.Error())
 		}
+		resp.UpdatedFields = append(resp.UpdatedFields, "allowlisted_reward_denoms")
+	}
+
+	if msg.RewardChannelId != "" {
+		if err := k.ValidateConsumerRewardChannel(ctx, consumerId, msg.RewardChannelId); err != nil {
+			return &resp, err
+		}
+		k.SetConsumerRewardChannel(ctx, consumerId, msg.RewardChannelId)
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeRewardChannelId, msg.RewardChannelId))
+		resp.UpdatedFields = append(resp.UpdatedFields, "reward_channel_id")
+	}
+
+	if msg.EndpointInfo != nil {
+		updated, err := k.UpdateConsumerEndpointInfo(ctx, consumerId, *msg.EndpointInfo)
+		if err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidEndpointInfo,
+				"cannot update consumer endpoint info: %s", err.Error())
+		}
+		if updated {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeUpdateConsumerEndpoints,
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+					sdk.NewAttribute(types.AttributePersistentPeers, strings.Join(msg.EndpointInfo.PersistentPeers, ",")),
+					sdk.NewAttribute(types.AttributeSeeds, strings.Join(msg.EndpointInfo.Seeds, ",")),
+					sdk.NewAttribute(types.AttributeRpcUrls, strings.Join(msg.EndpointInfo.RpcUrls, ",")),
+					sdk.NewAttribute(types.AttributeGenesisUrl, msg.EndpointInfo.GenesisUrl),
+				),
+			)
+		}
+		resp.UpdatedFields = append(resp.UpdatedFields, "endpoint_info")
+	}
+
+	if msg.TimeoutPeriods != nil {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			return &resp, errorsmod.Wrap(types.ErrInvalidMsgUpdateConsumer,
+				"cannot update the timeout periods of a chain that is not launched; "+
+					"update the timeout periods of the initialization parameters instead")
+		}
+		if err := k.Keeper.ValidateConsumerTimeoutPeriods(ctx, *msg.TimeoutPeriods); err != nil {
+			return &resp, err
+		}
+		if err := k.Keeper.SetConsumerTimeoutPeriods(ctx, consumerId, *msg.TimeoutPeriods); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerTimeoutPeriods,
+				"cannot set consumer timeout periods: %s", err.Error())
+		}
+
+		// the consumer chain does not learn about the updated timeout periods from the provider,
+		// hence the event enables off-chain tooling to keep the consumer params in sync
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateConsumerTimeouts,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeCcvTimeoutPeriod, msg.TimeoutPeriods.CcvTimeoutPeriod.String()),
+				sdk.NewAttribute(types.AttributeTransferTimeoutPeriod, msg.TimeoutPeriods.TransferTimeoutPeriod.String()),
+			),
+		)
+		resp.UpdatedFields = append(resp.UpdatedFields, "timeout_periods")
+	}
+
+	// the lifetime of a Top N chain can only be extended by governance, since it is owned by the gov module
+	if msg.LifetimeExtension != nil {
+		sunsetTime, err := k.Keeper.ExtendConsumerLifetime(ctx, consumerId, *msg.LifetimeExtension)
+		if err != nil {
+			return &resp, err
+		}
+
+		// add SunsetTime event attribute
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerSunsetTime, sunsetTime.String()))
+		resp.UpdatedFields = append(resp.UpdatedFields, "lifetime_extension")
+	}
+
+	if msg.UpgradeNotice != nil {
+		if err := k.Keeper.PublishConsumerUpgradeNotice(ctx, consumerId, *msg.UpgradeNotice); err != nil {
+			return &resp, err
+		}
+
+		// the event enables validator tooling to alert the validators of the consumer chain
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerUpgradeNotice,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeUpgradeName, msg.UpgradeNotice.Name),
+				sdk.NewAttribute(types.AttributeUpgradeHeight, strconv.FormatUint(msg.UpgradeNotice.Height, 10)),
+				sdk.NewAttribute(types.AttributeBinaryHash, msg.UpgradeNotice.BinaryHash),
+				sdk.NewAttribute(types.AttributeUpgradeDeadline, msg.UpgradeNotice.Deadline.String()),
+			),
+		)
+		resp.UpdatedFields = append(resp.UpdatedFields, "upgrade_notice")
+	}
+
+	if msg.RewardsPaused != nil {
+		if msg.RewardsPaused.Paused {
+			k.Keeper.SetConsumerRewardsPaused(ctx, consumerId)
+		} else if err := k.Keeper.UnpauseConsumerRewards(ctx, consumerId); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"cannot release the held back rewards: %s", err.Error())
+		}
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributeRewardsPaused, strconv.FormatBool(msg.RewardsPaused.Paused)))
+		resp.UpdatedFields = append(resp.UpdatedFields, "rewards_paused")
+	}
+
+	if msg.AllowedIcaMsgTypes != nil {
+		// the allowed message types protect the consumer chain from the interchain accounts controlled by its owner
+		// (e.g., from a compromised governance account) and hence they can only be updated by the gov module
+		if msg.Owner != k.GetAuthority() {
+			return &resp, errorsmod.Wrapf(types.ErrUnauthorized,
+				"the allowed interchain account message types can only be updated if the owner of the chain is the gov module")
+		}
+		if err := k.Keeper.UpdateConsumerAllowedIcaMsgTypes(ctx, consumerId, msg.AllowedIcaMsgTypes.MsgTypeUrls); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidAllowedIcaMsgTypes,
+				"cannot update allowed interchain account message types: %s", err.Error())
+		}
+		resp.UpdatedFields = append(resp.UpdatedFields, "allowed_ica_msg_types")
 	}
 
 	// add Owner event attribute
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerOwner, currentOwnerAddress))
 
-	// add Phase event attribute
+	// add Phase event attributes
 	phase := k.GetConsumerPhase(ctx, consumerId)
-	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()))
+	eventAttributes = append(eventAttributes,
+		sdk.NewAttribute(types.AttributeConsumerPhase, types.PhaseToString(phase)),
+		sdk.NewAttribute(types.AttributeConsumerPhaseValue, strconv.Itoa(int(phase))),
+	)
 
 	k.Logger(ctx).Info("updated consumer",
 		"consumerId", consumerId,
@@ -626,6 +1109,26 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		),
 	)
 
+	// echo the resulting state of the consumer chain (e.g., the spawn time after preparing the chain for launch)
+	resp.ConsumerId = consumerId
+	resp.OwnerAddress = currentOwnerAddress
+	resp.Phase = phase
+	resp.PowerShapingParameters = currentPowerShapingParameters
+	resp.PowerShapingAdmin, _ = k.Keeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+	if resp.Metadata, err = k.Keeper.GetConsumerMetadata(ctx, consumerId); err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve metadata: %s", err.Error())
+	}
+	if resp.InitializationParameters, err = k.Keeper.GetConsumerInitializationParameters(ctx, consumerId); err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve initialization parameters: %s", err.Error())
+	}
+	if phase == types.CONSUMER_PHASE_INITIALIZED {
+		spawnTime := resp.InitializationParameters.SpawnTime.UTC()
+		resp.SpawnTime = &spawnTime
+	}
+	if resp.TimeoutPeriods, err = k.Keeper.GetEffectiveConsumerTimeoutPeriods(ctx, consumerId); err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve timeout periods: %s", err.Error())
+	}
+
 	return &resp, nil
 }
 
@@ -646,33 +1149,150 @@ func (k msgServer) RemoveConsumer(goCtx context.Context, msg *types.MsgRemoveCon
 		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
 	}
 
-	if msg.Owner != ownerAddress {
+	// the governance authority can remove any consumer chain regardless of its owner,
+	// e.g., a permissionless chain that violates the provider policy
+	var initiator types.ConsumerRemovalInitiator
+	var reason types.ConsumerRemovalReason
+	switch msg.Owner {
+	case k.GetAuthority():
+		initiator = types.CONSUMER_REMOVAL_INITIATOR_GOVERNANCE
+		reason = types.CONSUMER_REMOVAL_REASON_GOVERNANCE
+	case ownerAddress:
+		initiator = types.CONSUMER_REMOVAL_INITIATOR_OWNER
+		reason = types.CONSUMER_REMOVAL_REASON_OWNER_REQUEST
+	default:
 		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
 	}
 
 	phase := k.Keeper.GetConsumerPhase(ctx, consumerId)
+	if phase == types.CONSUMER_PHASE_REGISTERED || phase == types.CONSUMER_PHASE_INITIALIZED {
+		// the chain was not launched yet and hence it is deleted immediately
+		if err := k.Keeper.CancelConsumerLaunch(ctx, consumerId); err != nil {
+			return &resp, err
+		}
+		k.Keeper.SetConsumerRemovalInitiator(ctx, consumerId, initiator)
+		if err := k.Keeper.SetConsumerRemovalRecord(ctx, consumerId, types.ConsumerRemovalRecord{Reason: reason}); err != nil {
+			return &resp, err
+		}
+
+		k.Logger(ctx).Info("cancelled consumer launch",
+			"consumerId", consumerId,
+			"chainId", chainId,
+			"phase", phase,
+			"initiator", initiator,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelConsumerLaunch,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeConsumerPhase, types.PhaseToString(phase)),
+				sdk.NewAttribute(types.AttributeConsumerPhaseValue, strconv.Itoa(int(phase))),
+				sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+				sdk.NewAttribute(types.AttributeRemovalInitiator, initiator.String()),
+				sdk.NewAttribute(types.AttributeRemovalInitiatorValue, strconv.Itoa(int(initiator))),
+				sdk.NewAttribute(types.AttributeRemovalReason, reason.String()),
+				sdk.NewAttribute(types.AttributeRemovalReasonValue, strconv.Itoa(int(reason))),
+			),
+		)
+
+		return &resp, nil
+	}
+
 	if phase != types.CONSUMER_PHASE_LAUNCHED {
 		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
-			"chain with consumer id: %s has to be in its launched phase", consumerId)
+			"chain with consumer id: %s has to be in its registered, initialized, or launched phase", consumerId)
 	}
 
-	err = k.Keeper.StopAndPrepareForConsumerRemoval(ctx, consumerId)
+	err = k.Keeper.StopAndPrepareForConsumerRemoval(ctx, consumerId, reason, "")
+	k.Keeper.SetConsumerRemovalInitiator(ctx, consumerId, initiator)
 
 	k.Logger(ctx).Info("stopped consumer",
 		"consumerId", consumerId,
 		"chainId", chainId,
 		"phase", phase,
+		"initiator", initiator,
 	)
 
+	phase = k.GetConsumerPhase(ctx, consumerId)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRemoveConsumer,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeConsumerPhase, types.PhaseToString(phase)),
+			sdk.NewAttribute(types.AttributeConsumerPhaseValue, strconv.Itoa(int(phase))),
 			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+			sdk.NewAttribute(types.AttributeRemovalInitiator, initiator.String()),
+			sdk.NewAttribute(types.AttributeRemovalInitiatorValue, strconv.Itoa(int(initiator))),
+			sdk.NewAttribute(types.AttributeRemovalReason, reason.String()),
+			sdk.NewAttribute(types.AttributeRemovalReasonValue, strconv.Itoa(int(reason))),
 		),
 	)
 
 	return &resp, err
 }
+
+// ConsumerHardFork defines an RPC handler method for MsgConsumerHardFork
+func (k msgServer) ConsumerHardFork(goCtx context.Context, msg *types.MsgConsumerHardFork) (*types.MsgConsumerHardForkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgConsumerHardForkResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	// note that the owner of a Top N chain is the gov module, and hence the hard fork must be approved by governance
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	phase := k.Keeper.GetConsumerPhase(ctx, consumerId)
+	if phase != types.CONSUMER_PHASE_LAUNCHED {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"chain with consumer id: %s has to be in its launched phase", consumerId)
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+	if msg.NewChainId == chainId {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidMsgConsumerHardFork,
+			"the chain id of the forked chain must differ from the current chain id %s", chainId)
+	}
+	if otherConsumerId, found := k.GetLaunchedConsumerIdByChainId(ctx, msg.NewChainId, consumerId); found {
+		return &resp, errorsmod.Wrapf(types.ErrConsumerChainIdAlreadyLaunched,
+			"chain id %s is already used by the launched consumer chain with consumer id %s", msg.NewChainId, otherConsumerId)
+	}
+
+	prevClientId, _ := k.GetConsumerClientId(ctx, consumerId)
+	clientId, err := k.Keeper.HardForkConsumer(ctx, consumerId, msg.NewChainId, msg.InitialHeight)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot hard fork consumer chain, consumerId(%s): %s", consumerId, err.Error())
+	}
+	resp.ClientId = clientId
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerHardFork,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, msg.NewChainId),
+			sdk.NewAttribute(types.AttributePreviousConsumerChainId, chainId),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientId),
+			sdk.NewAttribute(types.AttributePreviousClientId, prevClientId),
+			sdk.NewAttribute(types.AttributeInitialHeight, msg.InitialHeight.String()),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
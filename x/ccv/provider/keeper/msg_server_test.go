@@ -1,15 +1,29 @@
 package keeper_test
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
@@ -63,6 +77,206 @@ func TestCreateConsumer(t *testing.T) {
 	require.Equal(t, "submitter2", ownerAddress)
 	phase = providerKeeper.GetConsumerPhase(ctx, "1")
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
+	// assert that the response reports the phase and no spawn time
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, response.Phase)
+	require.Nil(t, response.SpawnTime)
+
+	// assert that the response reports the spawn time (in UTC) of a chain that is scheduled to launch
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = time.Date(2030, 1, 1, 12, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60))
+	response, err = msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: consumerMetadata,
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	require.Equal(t, "2", response.ConsumerId)
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, response.Phase)
+	require.Equal(t, time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC), *response.SpawnTime)
+}
+
+// TestCreateConsumerCreationRecord tests that it is recorded whether a consumer chain is created permissionlessly
+// or by the governance authority, that the record is returned by the chain queries and emitted in the creation event,
+// and that only the governance authority can provide a proposal id
+func TestCreateConsumerCreationRecord(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	consumerMetadata := providertypes.ConsumerMetadata{Name: "chain name", Description: "description"}
+
+	testCases := []struct {
+		name           string
+		submitter      string
+		expectedRecord providertypes.ConsumerCreationRecord
+	}{
+		{"permissionless", "submitter", providertypes.ConsumerCreationRecord{CreatedByGovernance: false}},
+		{"governance", providerKeeper.GetAuthority(), providertypes.ConsumerCreationRecord{CreatedByGovernance: true}},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		response, err := msgServer.CreateConsumer(ctx,
+			&providertypes.MsgCreateConsumer{
+				Submitter: tc.submitter, ChainId: "chainId", Metadata: consumerMetadata,
+				InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			})
+		require.NoError(t, err, tc.name)
+
+		record, found := providerKeeper.GetConsumerCreationRecord(ctx, response.ConsumerId)
+		require.True(t, found, tc.name)
+		require.Equal(t, tc.expectedRecord, record, tc.name)
+
+		chain, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: response.ConsumerId})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, &tc.expectedRecord, chain.CreationRecord, tc.name)
+		chains, err := providerKeeper.GetConsumerChain(ctx, response.ConsumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, &tc.expectedRecord, chains.CreationRecord, tc.name)
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1, tc.name)
+		require.Equal(t, providertypes.EventTypeCreateConsumer, events[0].Type, tc.name)
+		attr, found := events[0].GetAttribute(providertypes.AttributeCreatedByGovernance)
+		require.True(t, found, tc.name)
+		require.Equal(t, fmt.Sprintf("%t", tc.expectedRecord.CreatedByGovernance), attr.Value, tc.name)
+		_, found = events[0].GetAttribute(providertypes.AttributeProposalId)
+		require.False(t, found, tc.name)
+	}
+
+	// only the governance authority can provide a proposal id
+	_, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{Submitter: "submitter", ChainId: "chainId", Metadata: consumerMetadata, ProposalId: 1})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// a consumer chain without record (e.g., created before the record was introduced) is returned without it
+	providerKeeper.SetConsumerChainId(ctx, "5", "chainId")
+	providerKeeper.SetConsumerPhase(ctx, "5", providertypes.CONSUMER_PHASE_REGISTERED)
+	providerKeeper.SetConsumerOwnerAddress(ctx, "5", "submitter")
+	err = providerKeeper.SetConsumerMetadata(ctx, "5", consumerMetadata)
+	require.NoError(t, err)
+	_, found := providerKeeper.GetConsumerCreationRecord(ctx, "5")
+	require.False(t, found)
+	chain, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: "5"})
+	require.NoError(t, err)
+	require.Nil(t, chain.CreationRecord)
+}
+
+// TestCreateAndUpdateConsumerGas tests that the gas consumed by MsgCreateConsumer and MsgUpdateConsumer
+// grows with the sizes of the metadata and the lists in the messages
+func TestCreateAndUpdateConsumerGas(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	owner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+
+	// list returns a list of `n` provider consensus addresses
+	list := func(n int) []string {
+		addrs := []string{}
+		for i := 0; i < n; i++ {
+			providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(i).ProviderConsAddress()
+			addrs = append(addrs, providerAddr.String())
+		}
+		return addrs
+	}
+	// denoms returns a list of `n` reward denoms
+	denoms := func(n int) []string {
+		denoms := []string{}
+		for i := 0; i < n; i++ {
+			denoms = append(denoms, fmt.Sprintf("ibc/%064d", i))
+		}
+		return denoms
+	}
+
+	createConsumerGas := func(listSize int, description string) (string, storetypes.Gas) {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		metadata := testkeeper.GetTestConsumerMetadata()
+		metadata.Description = description
+		resp, err := msgServer.CreateConsumer(gasCtx,
+			&providertypes.MsgCreateConsumer{
+				Submitter: owner, ChainId: "chainId-1", Metadata: metadata,
+				PowerShapingParameters:  &providertypes.PowerShapingParameters{Allowlist: list(listSize)},
+				AllowlistedRewardDenoms: &providertypes.AllowlistedRewardDenoms{Denoms: denoms(min(listSize, 3))},
+			})
+		require.NoError(t, err)
+		return resp.ConsumerId, gasCtx.GasMeter().GasConsumed()
+	}
+	updateConsumerGas := func(consumerId string, listSize int) storetypes.Gas {
+		gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := msgServer.UpdateConsumer(gasCtx,
+			&providertypes.MsgUpdateConsumer{
+				Owner: owner, ConsumerId: consumerId,
+				PowerShapingParameters: &providertypes.PowerShapingParameters{Denylist: list(listSize)},
+			})
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	// the gas consumed grows with the size of the lists, by at least the gas charged per byte of the lists
+	var consumerIds []string
+	prevGas := storetypes.Gas(0)
+	prevListSize := 0
+	for _, listSize := range []int{0, 1, 10, 100} {
+		consumerId, gas := createConsumerGas(listSize, "description")
+		consumerIds = append(consumerIds, consumerId)
+		if listSize > 0 {
+			listsSizeDiff := (&providertypes.PowerShapingParameters{Allowlist: list(listSize)}).Size() -
+				(&providertypes.PowerShapingParameters{Allowlist: list(prevListSize)}).Size()
+			require.Greater(t, gas, prevGas)
+			require.GreaterOrEqual(t, gas-prevGas, uint64(listsSizeDiff*providertypes.ConsumerListGasPerByte))
+		}
+		prevGas, prevListSize = gas, listSize
+	}
+
+	// the gas consumed grows with the size of the metadata
+	_, gas := createConsumerGas(0, "description")
+	_, gasWithLongDescription := createConsumerGas(0, strings.Repeat("description", 100))
+	require.GreaterOrEqual(t, gasWithLongDescription-gas, uint64(11*99*providertypes.ConsumerMetadataGasPerByte))
+
+	prevGas = 0
+	for i, listSize := range []int{0, 1, 10, 100} {
+		gas := updateConsumerGas(consumerIds[i], listSize)
+		require.Greater(t, gas, prevGas)
+		prevGas = gas
+	}
+}
+
+// TestCreateAndUpdateConsumerDistributionTransmissionChannel tests that a consumer chain cannot be created
+// or updated with a distribution transmission channel for which the provider has no transfer channel
+func TestCreateAndUpdateConsumerDistributionTransmissionChannel(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	mocks.MockChannelKeeper.EXPECT().GetAllChannelsWithPortPrefix(gomock.Any(), "transfer").Return(nil).Times(2)
+
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.DistributionTransmissionChannel = "channel-5"
+	msgCreateConsumer := &providertypes.MsgCreateConsumer{
+		Submitter: "submitter", ChainId: "chainId",
+		Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+		InitializationParameters: &initializationParameters,
+	}
+	_, err := msgServer.CreateConsumer(ctx, msgCreateConsumer)
+	require.ErrorIs(t, err, providertypes.ErrInvalidDistributionTransmissionChannel)
+
+	// the consumer chain can be created without a distribution transmission channel
+	initializationParameters.DistributionTransmissionChannel = ""
+	response, err := msgServer.CreateConsumer(ctx, msgCreateConsumer)
+	require.NoError(t, err)
+
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	updatedInitializationParameters := initializationParameters
+	updatedInitializationParameters.DistributionTransmissionChannel = "channel-5"
+	_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner: "submitter", ConsumerId: response.ConsumerId, InitializationParameters: &updatedInitializationParameters,
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidDistributionTransmissionChannel)
+	actualInitializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, response.ConsumerId)
+	require.NoError(t, err)
+	require.Empty(t, actualInitializationParameters.DistributionTransmissionChannel)
 }
 
 func TestUpdateConsumer(t *testing.T) {
@@ -117,7 +331,7 @@ func TestUpdateConsumer(t *testing.T) {
 	expectedPowerShapingParameters := testkeeper.GetTestPowerShapingParameters()
 
 	expectedOwnerAddress := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
-	_, err = msgServer.UpdateConsumer(ctx,
+	updateConsumerResponse, err := msgServer.UpdateConsumer(ctx,
 		&providertypes.MsgUpdateConsumer{
 			Owner: "submitter", ConsumerId: consumerId, NewOwnerAddress: expectedOwnerAddress,
 			Metadata:                 &expectedConsumerMetadata,
@@ -126,6 +340,25 @@ func TestUpdateConsumer(t *testing.T) {
 		})
 	require.NoError(t, err)
 
+	// assert that the response enumerates the updated fields and echoes the resulting state
+	expectedSpawnTime := expectedInitializationParameters.SpawnTime
+	require.Equal(t, &providertypes.MsgUpdateConsumerResponse{
+		UpdatedFields:            []string{"new_owner_address", "metadata", "initialization_parameters", "power_shaping_parameters"},
+		ConsumerId:               consumerId,
+		SpawnTime:                &expectedSpawnTime,
+		OwnerAddress:             expectedOwnerAddress,
+		Phase:                    providertypes.CONSUMER_PHASE_INITIALIZED,
+		Metadata:                 expectedConsumerMetadata,
+		InitializationParameters: expectedInitializationParameters,
+		PowerShapingParameters:   expectedPowerShapingParameters,
+		// until they are updated, the timeout periods are the CcvTimeoutPeriod param, which is used for the VSC packets,
+		// and the transfer timeout period of the initialization parameters
+		TimeoutPeriods: providertypes.ConsumerTimeoutPeriods{
+			CcvTimeoutPeriod:      providerKeeper.GetCCVTimeoutPeriod(ctx),
+			TransferTimeoutPeriod: expectedInitializationParameters.TransferTimeoutPeriod,
+		},
+	}, updateConsumerResponse)
+
 	// assert that owner address was updated
 	ownerAddress, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
 	require.NoError(t, err)
@@ -237,7 +470,7 @@ func TestUpdateConsumer(t *testing.T) {
 	// also update an arbitrary field of the initialization parameters
 	// to verify that the parameters of the chain get updated
 	expectedInitializationParameters.InitialHeight = types.NewHeight(1, 123456)
-	_, err = msgServer.UpdateConsumer(ctx,
+	updateConsumerResponse, err = msgServer.UpdateConsumer(ctx,
 		&providertypes.MsgUpdateConsumer{
 			Owner: expectedOwnerAddress, ConsumerId: consumerId,
 			Metadata:                 nil,
@@ -245,6 +478,9 @@ func TestUpdateConsumer(t *testing.T) {
 			PowerShapingParameters:   nil,
 		})
 	require.NoError(t, err)
+	// assert that the response reports the phase change and no spawn time
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, updateConsumerResponse.Phase)
+	require.Nil(t, updateConsumerResponse.SpawnTime)
 	// assert the chain is not scheduled to launch
 	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, previousSpawnTime)
 	require.NoError(t, err)
@@ -260,4 +496,1275 @@ func TestUpdateConsumer(t *testing.T) {
 	actualInitializationParameters, err = providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
 	require.NoError(t, err)
 	require.Equal(t, expectedInitializationParameters, actualInitializationParameters)
+
+	// update the reward channel of the chain, which must be built on the consumer client
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "07-tendermint-1")
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), "transfer", "channel-6").
+			Return(channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}}, true).Times(1),
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), "connection-0").
+			Return(conntypes.ConnectionEnd{ClientId: "07-tendermint-0"}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), "transfer", "channel-7").
+			Return(channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-1"}}, true).Times(1),
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), "connection-1").
+			Return(conntypes.ConnectionEnd{ClientId: "07-tendermint-1"}, true).Times(1),
+	)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			RewardChannelId: "channel-6",
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerRewardChannel)
+	_, found := providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.False(t, found)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			RewardChannelId: "channel-7",
+		})
+	require.NoError(t, err)
+	rewardChannelId, found := providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "channel-7", rewardChannelId)
+
+	// update the endpoints of the chain
+	expectedEndpointInfo := providertypes.EndpointInfo{
+		Seeds:      []string{"e5f9ab0a1bc2a0f4e4e5e9b1c7c8d1a2b3c4d5e6@seed.consumer.io:26656"},
+		GenesisUrl: "https://consumer.io/genesis.json",
+	}
+	countEndpointEvents := func(events sdk.Events) int {
+		count := 0
+		for _, event := range events {
+			if event.Type == providertypes.EventTypeUpdateConsumerEndpoints {
+				count++
+			}
+		}
+		return count
+	}
+	for i := 0; i < 2; i++ {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.UpdateConsumer(ctx,
+			&providertypes.MsgUpdateConsumer{
+				Owner: expectedOwnerAddress, ConsumerId: consumerId,
+				EndpointInfo: &expectedEndpointInfo,
+			})
+		require.NoError(t, err)
+		// the event is only emitted when the endpoints change
+		require.Equal(t, 1-i, countEndpointEvents(ctx.EventManager().Events()))
+	}
+	actualEndpointInfo, err := providerKeeper.GetConsumerEndpointInfo(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, expectedEndpointInfo, actualEndpointInfo)
+
+	// the timeout periods cannot be updated before the chain launches
+	expectedTimeoutPeriods := providertypes.ConsumerTimeoutPeriods{
+		CcvTimeoutPeriod:      2 * time.Hour,
+		TransferTimeoutPeriod: 30 * time.Minute,
+	}
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			TimeoutPeriods: &expectedTimeoutPeriods,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgUpdateConsumer)
+	_, found = providerKeeper.GetConsumerTimeoutPeriods(ctx, consumerId)
+	require.False(t, found)
+
+	// the timeout periods cannot be updated while the expected epoch duration is not known
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			TimeoutPeriods: &expectedTimeoutPeriods,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerTimeoutPeriods)
+
+	// the CCV timeout period must exceed the expected epoch duration
+	providerKeeper.SetEpochInfo(ctx, providertypes.EpochInfo{AverageBlockTime: 6 * time.Second})
+	expectedEpochDuration, found := providerKeeper.GetExpectedEpochDuration(ctx)
+	require.True(t, found)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			TimeoutPeriods: &providertypes.ConsumerTimeoutPeriods{
+				CcvTimeoutPeriod:      expectedEpochDuration,
+				TransferTimeoutPeriod: 30 * time.Minute,
+			},
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerTimeoutPeriods)
+
+	// update the timeout periods of the launched chain
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	updateConsumerResponse, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			TimeoutPeriods: &expectedTimeoutPeriods,
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"timeout_periods"}, updateConsumerResponse.UpdatedFields)
+	require.Equal(t, expectedTimeoutPeriods, updateConsumerResponse.TimeoutPeriods)
+	actualTimeoutPeriods, found := providerKeeper.GetConsumerTimeoutPeriods(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, expectedTimeoutPeriods, actualTimeoutPeriods)
+	require.Equal(t, expectedTimeoutPeriods.CcvTimeoutPeriod, providerKeeper.GetConsumerCCVTimeoutPeriod(ctx, consumerId))
+
+	var timeoutPeriodsEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeUpdateConsumerTimeouts {
+			timeoutPeriodsEvent = event
+		}
+	}
+	require.Equal(t, providertypes.EventTypeUpdateConsumerTimeouts, timeoutPeriodsEvent.Type)
+	attr, found := timeoutPeriodsEvent.GetAttribute(providertypes.AttributeCcvTimeoutPeriod)
+	require.True(t, found)
+	require.Equal(t, expectedTimeoutPeriods.CcvTimeoutPeriod.String(), attr.Value)
+	attr, found = timeoutPeriodsEvent.GetAttribute(providertypes.AttributeTransferTimeoutPeriod)
+	require.True(t, found)
+	require.Equal(t, expectedTimeoutPeriods.TransferTimeoutPeriod.String(), attr.Value)
+}
+
+// TestUpdateConsumerIsAtomic tests that none of the fields of a MsgUpdateConsumer message
+// is persisted if any of them is invalid
+func TestUpdateConsumerIsAtomic(t *testing.T) {
+	validMetadata := providertypes.ConsumerMetadata{
+		Name:        "updated name",
+		Description: "updated description",
+		Metadata:    "updated metadata",
+	}
+	validInitializationParameters := testkeeper.GetTestInitializationParameters()
+	validInitializationParameters.InitialHeight.RevisionNumber = 1
+	validPowerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	validPowerShapingParameters.ValidatorsPowerCap = 30
+
+	// the initial height does not match the revision number of the chain id
+	invalidInitializationParameters := testkeeper.GetTestInitializationParameters()
+	// only the gov module can own Top N chains
+	invalidPowerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	invalidPowerShapingParameters.Top_N = 50
+
+	testCases := []struct {
+		name                     string
+		initializationParameters providertypes.ConsumerInitializationParameters
+		powerShapingParameters   providertypes.PowerShapingParameters
+		expErr                   string
+	}{
+		{
+			name:                     "invalid initialization parameters",
+			initializationParameters: invalidInitializationParameters,
+			powerShapingParameters:   validPowerShapingParameters,
+			expErr:                   "invalid initial height",
+		},
+		{
+			name:                     "invalid power-shaping parameters",
+			initializationParameters: validInitializationParameters,
+			powerShapingParameters:   invalidPowerShapingParameters,
+			expErr:                   "an update to a Top N chain can only be done if chain is owner is the gov module",
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+		createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+			&providertypes.MsgCreateConsumer{
+				Submitter: "submitter", ChainId: "chainId-1",
+				Metadata: testkeeper.GetTestConsumerMetadata(),
+			})
+		require.NoError(t, err, tc.name)
+		consumerId := createConsumerResponse.ConsumerId
+		expectedInitializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		expectedPowerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.UpdateConsumer(ctx,
+			&providertypes.MsgUpdateConsumer{
+				Owner: "submitter", ConsumerId: consumerId,
+				Metadata:                 &validMetadata,
+				InitializationParameters: &tc.initializationParameters,
+				PowerShapingParameters:   &tc.powerShapingParameters,
+				RewardChannelId:          "channel-1",
+			})
+		require.ErrorContains(t, err, tc.expErr, tc.name)
+
+		// assert that nothing was persisted
+		metadata, err := providerKeeper.GetConsumerMetadata(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, testkeeper.GetTestConsumerMetadata(), metadata, tc.name)
+		initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, expectedInitializationParameters, initializationParameters, tc.name)
+		powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, expectedPowerShapingParameters, powerShapingParameters, tc.name)
+		_, found := providerKeeper.GetConsumerRewardChannel(ctx, consumerId)
+		require.False(t, found, tc.name)
+		require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId), tc.name)
+		require.Empty(t, ctx.EventManager().Events(), tc.name)
+
+		ctrl.Finish()
+	}
+}
+
+// TestUpdateConsumerTermsWithTopN tests that a consumer chain cannot have terms and be a Top N chain at the same time
+func TestUpdateConsumerTermsWithTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, _ := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, vals, -1)
+	// the consumer is updated on a cached context
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).Return(int64(20), nil).AnyTimes()
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	providerKeeper.SetParams(ctx, params)
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	metadataWithTerms := testkeeper.GetTestConsumerMetadata()
+	metadataWithTerms.TermsHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata: metadataWithTerms,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, providerKeeper.GetAuthority())
+
+	topNPowerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	topNPowerShapingParameters.Top_N = 50
+
+	// a chain with terms cannot become a Top N chain
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			PowerShapingParameters: &topNPowerShapingParameters,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerTerms)
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Zero(t, powerShapingParameters.Top_N)
+
+	// the chain becomes a Top N chain once its terms are removed
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			Metadata:               &providertypes.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+			PowerShapingParameters: &topNPowerShapingParameters,
+		})
+	require.NoError(t, err)
+
+	// a Top N chain cannot get terms
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			Metadata: &metadataWithTerms,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerTerms)
+	require.Empty(t, providerKeeper.GetConsumerTermsHash(ctx, consumerId))
+}
+
+// TestUpdateConsumerDowntimeGracePeriod tests that the downtime grace period can be set on creation,
+// but that it can only be changed if the owner of the consumer chain is the gov module
+func TestUpdateConsumerDowntimeGracePeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	gracePeriod := 24 * time.Hour
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata:               testkeeper.GetTestConsumerMetadata(),
+			PowerShapingParameters: &providertypes.PowerShapingParameters{DowntimeGracePeriod: &gracePeriod},
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, &gracePeriod, powerShapingParameters.DowntimeGracePeriod)
+
+	// the owner can update the other power-shaping parameters, but not the downtime grace period
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			PowerShapingParameters: &providertypes.PowerShapingParameters{ValidatorSetCap: 10, DowntimeGracePeriod: &gracePeriod},
+		})
+	require.NoError(t, err)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			PowerShapingParameters: &providertypes.PowerShapingParameters{ValidatorSetCap: 10},
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the gov module can update the downtime grace period
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, providerKeeper.GetAuthority())
+	newGracePeriod := time.Hour
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			PowerShapingParameters: &providertypes.PowerShapingParameters{DowntimeGracePeriod: &newGracePeriod},
+		})
+	require.NoError(t, err)
+	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, &newGracePeriod, powerShapingParameters.DowntimeGracePeriod)
+}
+
+// TestUpdateConsumerPowerShapingAdmin tests that the power-shaping admin of a consumer chain can only update
+// its allowlist and denylist, and that it is removed on ownership transfer unless explicitly retained
+func TestUpdateConsumerPowerShapingAdmin(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	owner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	admin := "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+	newOwner := sdk.AccAddress([]byte("new-owner-address")).String()
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: owner, ChainId: "chainId-1",
+			Metadata: testkeeper.GetTestConsumerMetadata(),
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	powerShapingParameters.ValidatorsPowerCap = 30
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			PowerShapingParameters: &powerShapingParameters,
+		})
+	require.NoError(t, err)
+
+	// only valid addresses can become power-shaping admin
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			PowerShapingAdmin: &providertypes.PowerShapingAdmin{Address: "invalid address"},
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPowerShapingAdmin)
+
+	// the power-shaping admin cannot update the consumer chain before it is set by the owner
+	listsOnly := providertypes.PowerShapingParameters{
+		Allowlist: []string{"cosmosvalcons1wpex7anfv3jhystyv3eq20r35a"},
+		Denylist:  []string{"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"},
+	}
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: admin, ConsumerId: consumerId,
+			PowerShapingParameters: &listsOnly,
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	resp, err := msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			PowerShapingAdmin: &providertypes.PowerShapingAdmin{Address: admin},
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"power_shaping_admin"}, resp.UpdatedFields)
+	require.Equal(t, admin, resp.PowerShapingAdmin)
+	queryResp, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, admin, queryResp.PowerShapingAdmin)
+
+	withPowerCap := listsOnly
+	withPowerCap.ValidatorsPowerCap = 20
+	metadata := testkeeper.GetTestConsumerMetadata()
+	testCases := []struct {
+		name   string
+		signer string
+		msg    providertypes.MsgUpdateConsumer
+		expErr error
+	}{
+		{
+			name:   "admin without power-shaping parameters",
+			signer: admin,
+			msg:    providertypes.MsgUpdateConsumer{},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "admin updating other power-shaping parameters",
+			signer: admin,
+			msg:    providertypes.MsgUpdateConsumer{PowerShapingParameters: &withPowerCap},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "admin updating the metadata",
+			signer: admin,
+			msg:    providertypes.MsgUpdateConsumer{PowerShapingParameters: &listsOnly, Metadata: &metadata},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "admin updating the owner",
+			signer: admin,
+			msg:    providertypes.MsgUpdateConsumer{PowerShapingParameters: &listsOnly, NewOwnerAddress: admin},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "admin updating the power-shaping admin",
+			signer: admin,
+			msg: providertypes.MsgUpdateConsumer{
+				PowerShapingParameters: &listsOnly,
+				PowerShapingAdmin:      &providertypes.PowerShapingAdmin{Address: owner},
+			},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "neither owner nor admin",
+			signer: newOwner,
+			msg:    providertypes.MsgUpdateConsumer{PowerShapingParameters: &listsOnly},
+			expErr: providertypes.ErrUnauthorized,
+		},
+		{
+			name:   "admin updating the allowlist and denylist",
+			signer: admin,
+			msg:    providertypes.MsgUpdateConsumer{PowerShapingParameters: &listsOnly},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.msg.Owner = tc.signer
+		tc.msg.ConsumerId = consumerId
+		_, err = msgServer.UpdateConsumer(ctx, &tc.msg)
+
+		expectedPowerShapingParameters := powerShapingParameters
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			// only the allowlist and denylist are updated
+			expectedPowerShapingParameters.Allowlist = listsOnly.Allowlist
+			expectedPowerShapingParameters.Denylist = listsOnly.Denylist
+		}
+		actualPowerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, expectedPowerShapingParameters, actualPowerShapingParameters, tc.name)
+		actualOwner, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, owner, actualOwner, tc.name)
+		actualAdmin, found := providerKeeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+		require.True(t, found, tc.name)
+		require.Equal(t, admin, actualAdmin, tc.name)
+	}
+
+	// the power-shaping admin is retained on ownership transfer if explicitly requested
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			NewOwnerAddress:         newOwner,
+			RetainPowerShapingAdmin: true,
+		})
+	require.NoError(t, err)
+	actualAdmin, found := providerKeeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, admin, actualAdmin)
+
+	// the power-shaping admin is removed on ownership transfer
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: newOwner, ConsumerId: consumerId,
+			NewOwnerAddress: owner,
+		})
+	require.NoError(t, err)
+	_, found = providerKeeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+	require.False(t, found)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: admin, ConsumerId: consumerId,
+			PowerShapingParameters: &listsOnly,
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the new owner can set a new power-shaping admin in the ownership transfer and remove it afterwards
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			NewOwnerAddress:   newOwner,
+			PowerShapingAdmin: &providertypes.PowerShapingAdmin{Address: admin},
+		})
+	require.NoError(t, err)
+	actualAdmin, found = providerKeeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, admin, actualAdmin)
+
+	resp, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: newOwner, ConsumerId: consumerId,
+			PowerShapingAdmin: &providertypes.PowerShapingAdmin{},
+		})
+	require.NoError(t, err)
+	require.Empty(t, resp.PowerShapingAdmin)
+	_, found = providerKeeper.GetConsumerPowerShapingAdmin(ctx, consumerId)
+	require.False(t, found)
+}
+
+// TestUpdateConsumerRewardsPaused tests that the owner of a consumer chain can pause its reward distribution
+// and that unpausing it releases the held back rewards
+func TestUpdateConsumerRewardsPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	owner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: owner, ChainId: "chainId-1",
+			Metadata: testkeeper.GetTestConsumerMetadata(),
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	resp, err := msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			RewardsPaused: &providertypes.RewardsPaused{Paused: true},
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"rewards_paused"}, resp.UpdatedFields)
+	require.True(t, providerKeeper.IsConsumerRewardsPaused(ctx, consumerId))
+
+	heldBack := sdk.NewDecCoins(sdk.NewDecCoin("uatom", math.NewInt(100)))
+	require.NoError(t, providerKeeper.HoldBackConsumerRewards(ctx, consumerId, providertypes.ConsumerRewardsAllocation{Rewards: heldBack}))
+	queryResp, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.True(t, queryResp.RewardsPaused)
+	require.Equal(t, heldBack, queryResp.HeldBackRewards)
+
+	// only the owner can pause or unpause the reward distribution
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: sdk.AccAddress([]byte("other-address")).String(), ConsumerId: consumerId,
+			RewardsPaused: &providertypes.RewardsPaused{Paused: false},
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.True(t, providerKeeper.IsConsumerRewardsPaused(ctx, consumerId))
+
+	// unpausing releases the held back rewards into the rewards allocation of the consumer chain
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			RewardsPaused: &providertypes.RewardsPaused{Paused: false},
+		})
+	require.NoError(t, err)
+	queryResp, err = providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.False(t, queryResp.RewardsPaused)
+	require.Empty(t, queryResp.HeldBackRewards)
+	rewardsAllocation, err := providerKeeper.GetConsumerRewardsAllocationByDenom(ctx, consumerId, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, heldBack, rewardsAllocation.Rewards)
+}
+
+// TestUpdateConsumerAllowedIcaMsgTypes tests that the allowed interchain account message types
+// of a consumer chain can only be updated by the gov module
+func TestUpdateConsumerAllowedIcaMsgTypes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	owner := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: owner, ChainId: "chainId-1",
+			Metadata: testkeeper.GetTestConsumerMetadata(),
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	allowedIcaMsgTypes := &providertypes.AllowedIcaMsgTypes{MsgTypeUrls: []string{"/cosmos.gov.v1.MsgVote"}}
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: owner, ConsumerId: consumerId,
+			AllowedIcaMsgTypes: allowedIcaMsgTypes,
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	msgTypes, err := providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, providerKeeper.GetAuthority())
+	resp, err := msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			AllowedIcaMsgTypes: allowedIcaMsgTypes,
+		})
+	require.NoError(t, err)
+	require.Equal(t, []string{"allowed_ica_msg_types"}, resp.UpdatedFields)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, allowedIcaMsgTypes.MsgTypeUrls, msgTypes)
+
+	// an empty list removes the restriction
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			AllowedIcaMsgTypes: &providertypes.AllowedIcaMsgTypes{},
+		})
+	require.NoError(t, err)
+	msgTypes, err = providerKeeper.GetConsumerAllowedIcaMsgTypes(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, msgTypes)
+}
+
+func TestSetConsumerVerified(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	authority := providerKeeper.GetAuthority()
+
+	// try to verify a non-existing consumer chain
+	_, err := msgServer.SetConsumerVerified(ctx,
+		&providertypes.MsgSetConsumerVerified{Authority: authority, ConsumerId: "0", Verified: true})
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+			},
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	// the owner cannot verify the consumer chain
+	_, err = msgServer.SetConsumerVerified(ctx,
+		&providertypes.MsgSetConsumerVerified{Authority: "submitter", ConsumerId: consumerId, Verified: true})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.False(t, providerKeeper.IsConsumerVerified(ctx, consumerId))
+
+	// governance verifies the consumer chain
+	_, err = msgServer.SetConsumerVerified(ctx,
+		&providertypes.MsgSetConsumerVerified{Authority: authority, ConsumerId: consumerId, Verified: true})
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsConsumerVerified(ctx, consumerId))
+
+	// the verified flag survives phase transitions
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_STOPPED)
+	require.True(t, providerKeeper.IsConsumerVerified(ctx, consumerId))
+
+	// the owner cannot clear the verified flag
+	_, err = msgServer.SetConsumerVerified(ctx,
+		&providertypes.MsgSetConsumerVerified{Authority: "submitter", ConsumerId: consumerId, Verified: false})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.True(t, providerKeeper.IsConsumerVerified(ctx, consumerId))
+
+	// governance clears the verified flag
+	_, err = msgServer.SetConsumerVerified(ctx,
+		&providertypes.MsgSetConsumerVerified{Authority: authority, ConsumerId: consumerId, Verified: false})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsConsumerVerified(ctx, consumerId))
+}
+
+// TestSetConsumerEvidenceSubmissionPaused tests that only governance can pause and resume
+// the submission of equivocation evidence for a consumer chain and that the expected events are emitted
+func TestSetConsumerEvidenceSubmissionPaused(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	authority := providerKeeper.GetAuthority()
+
+	// try to pause the evidence submission for a non-existing consumer chain
+	_, err := msgServer.SetConsumerEvidenceSubmissionPaused(ctx,
+		&providertypes.MsgSetConsumerEvidenceSubmissionPaused{Authority: authority, ConsumerId: "0", Paused: true})
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+			},
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	// only governance can pause the evidence submission
+	_, err = msgServer.SetConsumerEvidenceSubmissionPaused(ctx,
+		&providertypes.MsgSetConsumerEvidenceSubmissionPaused{Authority: "submitter", ConsumerId: consumerId, Paused: true})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.False(t, providerKeeper.IsConsumerEvidenceSubmissionPaused(ctx, consumerId))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SetConsumerEvidenceSubmissionPaused(ctx,
+		&providertypes.MsgSetConsumerEvidenceSubmissionPaused{Authority: authority, ConsumerId: consumerId, Paused: true})
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsConsumerEvidenceSubmissionPaused(ctx, consumerId))
+	require.Equal(t, providertypes.EventTypePauseEvidenceSubmission, ctx.EventManager().Events()[0].Type)
+	chain, err := providerKeeper.GetConsumerChain(ctx, consumerId)
+	require.NoError(t, err)
+	require.True(t, chain.EvidenceSubmissionPaused)
+
+	// the evidence submission is rejected while paused
+	err = providerKeeper.HandleConsumerDoubleVoting(ctx, consumerId, "chainId", nil, nil)
+	require.ErrorIs(t, err, providertypes.ErrEvidenceSubmissionPaused)
+	err = providerKeeper.HandleConsumerMisbehaviour(ctx, consumerId, ibctmtypes.Misbehaviour{})
+	require.ErrorIs(t, err, providertypes.ErrEvidenceSubmissionPaused)
+
+	// governance resumes the evidence submission
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SetConsumerEvidenceSubmissionPaused(ctx,
+		&providertypes.MsgSetConsumerEvidenceSubmissionPaused{Authority: authority, ConsumerId: consumerId, Paused: false})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.IsConsumerEvidenceSubmissionPaused(ctx, consumerId))
+	require.Equal(t, providertypes.EventTypeResumeEvidenceSubmission, ctx.EventManager().Events()[0].Type)
+	chain, err = providerKeeper.GetConsumerChain(ctx, consumerId)
+	require.NoError(t, err)
+	require.False(t, chain.EvidenceSubmissionPaused)
+
+	err = providerKeeper.HandleConsumerDoubleVoting(ctx, consumerId, "chainId", nil, nil)
+	require.NotErrorIs(t, err, providertypes.ErrEvidenceSubmissionPaused)
+}
+
+// TestConsumerHardFork tests that the owner of a launched consumer chain can hard fork it
+// and that opt-ins, key assignments, and commission rates are preserved
+func TestConsumerHardFork(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "foo-1")
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientID-0")
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelID")
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", consumerId)
+	providerKeeper.SetEquivocationEvidenceMinChainId(ctx, consumerId, "foo-0")
+	providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, 10)
+
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	providerAddr := identity.ProviderConsAddress()
+	consumerKey := identity.TMProtoCryptoPublicKey()
+	err := providerKeeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: providerAddr.ToSdkConsAddr(), Power: 10, PublicKey: &consumerKey},
+	})
+	require.NoError(t, err)
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerKey)
+	err = providerKeeper.SetConsumerCommissionRate(ctx, consumerId, providerAddr, math.LegacyNewDecWithPrec(5, 2))
+	require.NoError(t, err)
+
+	initialHeight := types.NewHeight(2, 1)
+
+	// the consumer chain is not launched
+	_, err = msgServer.ConsumerHardFork(ctx,
+		&providertypes.MsgConsumerHardFork{Owner: "owner", ConsumerId: consumerId, NewChainId: "foo-2", InitialHeight: initialHeight})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	// only the owner can hard fork the consumer chain
+	_, err = msgServer.ConsumerHardFork(ctx,
+		&providertypes.MsgConsumerHardFork{Owner: "submitter", ConsumerId: consumerId, NewChainId: "foo-2", InitialHeight: initialHeight})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the chain id of the forked chain must differ
+	_, err = msgServer.ConsumerHardFork(ctx,
+		&providertypes.MsgConsumerHardFork{Owner: "owner", ConsumerId: consumerId, NewChainId: "foo-1", InitialHeight: initialHeight})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgConsumerHardFork)
+
+	// the chain id of the forked chain cannot be used by another launched consumer chain
+	providerKeeper.FetchAndIncrementConsumerId(ctx)
+	otherConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerChainId(ctx, otherConsumerId, "bar-1")
+	providerKeeper.SetConsumerPhase(ctx, otherConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.ConsumerHardFork(ctx,
+		&providertypes.MsgConsumerHardFork{Owner: "owner", ConsumerId: consumerId, NewChainId: "bar-1", InitialHeight: initialHeight})
+	require.ErrorIs(t, err, providertypes.ErrConsumerChainIdAlreadyLaunched)
+
+	gomock.InOrder(
+		append([]*gomock.Call{
+			mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientID-0").Return(
+				&ibctmtypes.ClientState{UnbondingPeriod: 2 * 7 * 24 * time.Hour}, true,
+			).Times(1),
+		},
+			append(testkeeper.GetMocksForCreateConsumerClient(ctx, &mocks, "foo-2", initialHeight),
+				testkeeper.GetMocksForDeleteConsumerChain(ctx, &mocks)...)...)...,
+	)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.ConsumerHardFork(ctx,
+		&providertypes.MsgConsumerHardFork{Owner: "owner", ConsumerId: consumerId, NewChainId: "foo-2", InitialHeight: initialHeight})
+	require.NoError(t, err)
+	require.Equal(t, "clientID", resp.ClientId)
+
+	// the consumer chain now refers to the forked chain
+	chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "foo-2", chainId)
+	clientId, found := providerKeeper.GetConsumerClientId(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "clientID", clientId)
+	actualConsumerId, found := providerKeeper.GetClientIdToConsumerId(ctx, "clientID")
+	require.True(t, found)
+	require.Equal(t, consumerId, actualConsumerId)
+	_, found = providerKeeper.GetClientIdToConsumerId(ctx, "clientID-0")
+	require.False(t, found)
+	require.Equal(t, uint64(1), providerKeeper.GetEquivocationEvidenceMinHeight(ctx, consumerId))
+	_, found = providerKeeper.GetEquivocationEvidenceMinChainId(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the channel to the previous chain is cleared
+	_, found = providerKeeper.GetConsumerIdToChannelId(ctx, consumerId)
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelIdToConsumerId(ctx, "channelID")
+	require.False(t, found)
+
+	// opt-ins, key assignments, and commission rates are preserved
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+	actualConsumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	require.True(t, found)
+	require.Equal(t, consumerKey, actualConsumerKey)
+	commissionRate, found := providerKeeper.GetConsumerCommissionRate(ctx, consumerId, providerAddr)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), commissionRate)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeConsumerHardFork, events[0].Type)
+	for key, value := range map[string]string{
+		providertypes.AttributeConsumerChainId:         "foo-2",
+		providertypes.AttributePreviousConsumerChainId: "foo-1",
+		providertypes.AttributePreviousClientId:        "clientID-0",
+		types.AttributeKeyClientID:                     "clientID",
+	} {
+		attr, found := events[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+}
+
+// TestRemoveConsumer tests that removing a consumer chain that is not launched yet cancels its launch,
+// i.e., the chain is deleted immediately, while removing a launched consumer chain stops it
+func TestRemoveConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	unbondingTime := 21 * 24 * time.Hour
+	spawnTime := ctx.BlockTime().Add(time.Hour)
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+
+	// createConsumer creates a consumer chain in the registered phase or, if `initialize` is true,
+	// in the initialized phase, and opts in a validator
+	createConsumer := func(initialize bool) string {
+		initializationParameters := providertypes.ConsumerInitializationParameters{}
+		if initialize {
+			initializationParameters = testkeeper.GetTestInitializationParameters()
+			initializationParameters.SpawnTime = spawnTime
+		}
+		resp, err := msgServer.CreateConsumer(ctx,
+			&providertypes.MsgCreateConsumer{
+				Submitter: "owner", ChainId: "chainId",
+				Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+				InitializationParameters: &initializationParameters,
+				PowerShapingParameters:   &providertypes.PowerShapingParameters{},
+			})
+		require.NoError(t, err)
+		providerKeeper.SetOptedIn(ctx, resp.ConsumerId, providerAddr)
+		return resp.ConsumerId
+	}
+
+	// requireCancelled checks that the launch of the consumer chain was cancelled
+	requireCancelled := func(consumerId string, previousPhase providertypes.ConsumerPhase) {
+		require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+		// the consumer chain is not scheduled to be launched or removed
+		toBeLaunched, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+		require.NoError(t, err)
+		require.NotContains(t, toBeLaunched.Ids, consumerId)
+		_, err = providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+		require.Error(t, err)
+		toBeRemoved, err := providerKeeper.GetConsumersToBeRemoved(ctx, ctx.BlockTime().Add(unbondingTime))
+		require.NoError(t, err)
+		require.Empty(t, toBeRemoved.Ids)
+		_, err = providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+		require.Error(t, err)
+
+		// the metadata are kept
+		_, err = providerKeeper.GetConsumerMetadata(ctx, consumerId)
+		require.NoError(t, err)
+
+		// the opt-ins are removed in EndBlock
+		require.Contains(t, providerKeeper.GetConsumersToBeCleanedUp(ctx), consumerId)
+		providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+		require.False(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, providertypes.EventTypeCancelConsumerLaunch, events[0].Type)
+		attr, found := events[0].GetAttribute(providertypes.AttributeConsumerPhase)
+		require.True(t, found)
+		require.Equal(t, previousPhase.String(), attr.Value)
+		attr, found = events[0].GetAttribute(providertypes.AttributeRemovalReason)
+		require.True(t, found)
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST.String(), attr.Value)
+
+		// the removal reason is retained
+		removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, consumerId)
+		require.True(t, found)
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST, removalRecord.Reason)
+	}
+
+	// remove a registered consumer chain
+	consumerId := createConsumer(false)
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	_, err := msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: "submitter"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: "owner"})
+	require.NoError(t, err)
+	requireCancelled(consumerId, providertypes.CONSUMER_PHASE_REGISTERED)
+
+	// a deleted consumer chain cannot be removed
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: "owner"})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	// remove an initialized consumer chain
+	consumerId = createConsumer(true)
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	toBeLaunched, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, toBeLaunched.Ids)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: "owner"})
+	require.NoError(t, err)
+	requireCancelled(consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	// remove a launched consumer chain, which is stopped and removed once the unbonding period elapses
+	consumerId = createConsumer(false)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).Times(1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: consumerId, Owner: "owner"})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), removalTime)
+	toBeRemoved, err := providerKeeper.GetConsumersToBeRemoved(ctx, removalTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, toBeRemoved.Ids)
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, providertypes.EventTypeStopConsumer, events[0].Type)
+	attr, found := events[0].GetAttribute(providertypes.AttributeRemovalReason)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST.String(), attr.Value)
+	require.Equal(t, providertypes.EventTypeRemoveConsumer, events[1].Type)
+	attr, found = events[1].GetAttribute(providertypes.AttributeRemovalInitiator)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_OWNER.String(), attr.Value)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_OWNER, providerKeeper.GetConsumerRemovalInitiator(ctx, consumerId))
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerRemovalRecord{Reason: providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST}, removalRecord)
+	// the phase and the removal initiator and reason are emitted as their proto enum names alongside their numeric values
+	attr, found = events[1].GetAttribute(providertypes.AttributeConsumerPhase)
+	require.True(t, found)
+	require.Equal(t, "CONSUMER_PHASE_STOPPED", attr.Value)
+	attr, found = events[1].GetAttribute(providertypes.AttributeConsumerPhaseValue)
+	require.True(t, found)
+	require.Equal(t, "4", attr.Value)
+	attr, found = events[1].GetAttribute(providertypes.AttributeRemovalInitiatorValue)
+	require.True(t, found)
+	require.Equal(t, strconv.Itoa(int(providertypes.CONSUMER_REMOVAL_INITIATOR_OWNER)), attr.Value)
+	attr, found = events[1].GetAttribute(providertypes.AttributeRemovalReasonValue)
+	require.True(t, found)
+	require.Equal(t, strconv.Itoa(int(providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST)), attr.Value)
+	attr, found = events[0].GetAttribute(providertypes.AttributeRemovalReasonValue)
+	require.True(t, found)
+	require.Equal(t, strconv.Itoa(int(providertypes.CONSUMER_REMOVAL_REASON_OWNER_REQUEST)), attr.Value)
+}
+
+// TestRemoveConsumerByGovernance tests that the governance authority can remove any consumer chain
+// regardless of its owner, while the owner of an opt-in chain cannot remove a Top N chain
+func TestRemoveConsumerByGovernance(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	authority := providerKeeper.GetAuthority()
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).Times(2)
+
+	// requireRemovedByGovernance checks that the launched consumer chain was stopped by the governance authority
+	requireRemovedByGovernance := func(consumerId string) {
+		require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_GOVERNANCE, providerKeeper.GetConsumerRemovalInitiator(ctx, consumerId))
+
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 2)
+		require.Equal(t, providertypes.EventTypeStopConsumer, events[0].Type)
+		require.Equal(t, providertypes.EventTypeRemoveConsumer, events[1].Type)
+		attr, found := events[1].GetAttribute(providertypes.AttributeRemovalInitiator)
+		require.True(t, found)
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_GOVERNANCE.String(), attr.Value)
+		attr, found = events[1].GetAttribute(providertypes.AttributeRemovalReason)
+		require.True(t, found)
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_GOVERNANCE.String(), attr.Value)
+		attr, found = events[1].GetAttribute(providertypes.AttributeSubmitterAddress)
+		require.True(t, found)
+		require.Equal(t, authority, attr.Value)
+
+		// the removal initiator and reason are retained once the consumer chain is deleted
+		require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, consumerId))
+		res, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: consumerId})
+		require.NoError(t, err)
+		require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_GOVERNANCE, res.RemovalInitiator)
+		require.Equal(t, &providertypes.ConsumerRemovalRecord{Reason: providertypes.CONSUMER_REMOVAL_REASON_GOVERNANCE}, res.RemovalRecord)
+	}
+
+	// the governance authority removes a launched opt-in chain against the wishes of its owner
+	resp, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "owner", ChainId: "chainId",
+			Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			PowerShapingParameters:   &providertypes.PowerShapingParameters{},
+		})
+	require.NoError(t, err)
+	optInConsumerId := resp.ConsumerId
+	providerKeeper.SetConsumerPhase(ctx, optInConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: optInConsumerId, Owner: authority})
+	require.NoError(t, err)
+	ownerAddress, err := providerKeeper.GetConsumerOwnerAddress(ctx, optInConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, "owner", ownerAddress)
+	requireRemovedByGovernance(optInConsumerId)
+
+	// the owner of an opt-in chain cannot remove a Top N chain, which is owned by the governance authority
+	topNConsumerId := "1"
+	providerKeeper.SetConsumerChainId(ctx, topNConsumerId, "topNChainId")
+	providerKeeper.SetConsumerOwnerAddress(ctx, topNConsumerId, authority)
+	err = providerKeeper.SetConsumerMetadata(ctx, topNConsumerId, providertypes.ConsumerMetadata{Name: "name"})
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, topNConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, topNConsumerId, providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: topNConsumerId, Owner: "owner"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, topNConsumerId))
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED, providerKeeper.GetConsumerRemovalInitiator(ctx, topNConsumerId))
+
+	// the governance authority removes the Top N chain
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RemoveConsumer(ctx, &providertypes.MsgRemoveConsumer{ConsumerId: topNConsumerId, Owner: authority})
+	require.NoError(t, err)
+	requireRemovedByGovernance(topNConsumerId)
+}
+
+// TestAssignConsumerKeyNonce tests that stale or replayed key assignments are rejected
+// and that a zero nonce is accepted during the transition window
+func TestAssignConsumerKeyNonce(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-0")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, validator.SDKValOpAddress()).
+		Return(validator.SDKStakingValidator(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(time.Hour, nil).AnyTimes()
+
+	// returns a MsgAssignConsumerKey of the validator with the consumer key of the identity with `seed`
+	assignKeyMsg := func(seed int, nonce uint64) *providertypes.MsgAssignConsumerKey {
+		consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(seed).ConsensusSDKPubKey()
+		return &providertypes.MsgAssignConsumerKey{
+			ConsumerId:   consumerId,
+			ProviderAddr: validator.SDKValOpAddressString(),
+			ConsumerKey: fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`,
+				base64.StdEncoding.EncodeToString(consumerKey.Bytes())),
+			Signer: sdk.AccAddress(validator.SDKValOpAddress()).String(),
+			Nonce:  nonce,
+		}
+	}
+	providerAddr := validator.ProviderConsAddress()
+
+	// the first key assignment with a zero nonce
+	_, err := msgServer.AssignConsumerKey(ctx, assignKeyMsg(1, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), providerKeeper.GetKeyAssignmentNonce(ctx, consumerId, providerAddr))
+
+	// a key assignment with the expected nonce
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(2, 1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), providerKeeper.GetKeyAssignmentNonce(ctx, consumerId, providerAddr))
+
+	// stale and future nonces are rejected
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(3, 1))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyAssignmentNonce)
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(3, 3))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyAssignmentNonce)
+	require.Equal(t, uint64(2), providerKeeper.GetKeyAssignmentNonce(ctx, consumerId, providerAddr))
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	require.True(t, found)
+	require.Equal(t, cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey(), consumerKey)
+
+	// even during the transition window, a zero nonce is rejected once the nonce of the validator is not zero,
+	// i.e., the first key assignment with a zero nonce cannot be replayed
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(1, 0))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyAssignmentNonce)
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(3, 2))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), providerKeeper.GetKeyAssignmentNonce(ctx, consumerId, providerAddr))
+
+	// once the transition window is over, key assignments with a zero nonce are still rejected
+	params := providertypes.DefaultParams()
+	params.AllowZeroKeyAssignmentNonce = false
+	providerKeeper.SetParams(ctx, params)
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(4, 0))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyAssignmentNonce)
+	_, err = msgServer.AssignConsumerKey(ctx, assignKeyMsg(4, 3))
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), providerKeeper.GetKeyAssignmentNonce(ctx, consumerId, providerAddr))
+
+	// the nonce is exposed by the validator consumer key query
+	res, err := providerKeeper.QueryValidatorConsumerAddr(ctx, &providertypes.QueryValidatorConsumerAddrRequest{
+		ProviderAddress: providerAddr.String(),
+		ConsumerId:      consumerId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.KeyAssignmentNonce)
+
+	// the nonces of other validators are not affected
+	otherValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(5)
+	res, err = providerKeeper.QueryValidatorConsumerAddr(ctx, &providertypes.QueryValidatorConsumerAddrRequest{
+		ProviderAddress: otherValidator.SDKValConsAddress().String(),
+		ConsumerId:      consumerId,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.ConsumerAddress)
+	require.Zero(t, res.KeyAssignmentNonce)
+}
+
+// TestOptInOptOutPhases tests that validators can only opt in to registered, initialized, or launched consumer chains
+// and only opt out of launched consumer chains, and that the errors name the current phase of the chain
+func TestOptInOptOutPhases(t *testing.T) {
+	testCases := []struct {
+		phase         providertypes.ConsumerPhase
+		optInAllowed  bool
+		optOutAllowed bool
+	}{
+		{providertypes.CONSUMER_PHASE_UNSPECIFIED, false, false},
+		{providertypes.CONSUMER_PHASE_REGISTERED, true, false},
+		{providertypes.CONSUMER_PHASE_INITIALIZED, true, false},
+		{providertypes.CONSUMER_PHASE_LAUNCHED, true, true},
+		{providertypes.CONSUMER_PHASE_STOPPED, false, false},
+		{providertypes.CONSUMER_PHASE_DELETED, false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.phase.String(), func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+			validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+			valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+			require.NoError(t, err)
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(t, err)
+			providerAddr := providertypes.NewProviderConsAddress(consAddr)
+
+			if tc.phase != providertypes.CONSUMER_PHASE_UNSPECIFIED {
+				providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, tc.phase)
+				providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chain-1")
+				require.NoError(t, providerKeeper.SetConsumerMetadata(ctx, CONSUMER_ID,
+					providertypes.ConsumerMetadata{Name: "name", TermsHash: "terms"}))
+				require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{}))
+			}
+			// the validator is only looked up if the phase allows the message
+			mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, nil).AnyTimes()
+
+			_, err = msgServer.OptIn(ctx, &providertypes.MsgOptIn{
+				ProviderAddr:          valAddr.String(),
+				ConsumerId:            CONSUMER_ID,
+				AcknowledgedTermsHash: "terms",
+			})
+			if tc.optInAllowed {
+				require.NoError(t, err)
+				require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+			} else {
+				require.Error(t, err)
+				if tc.phase == providertypes.CONSUMER_PHASE_UNSPECIFIED {
+					require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+				} else {
+					require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+					require.ErrorContains(t, err, tc.phase.String())
+				}
+				// no opt-in state is created
+				require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+				_, found := providerKeeper.GetAcknowledgedConsumerTerms(ctx, CONSUMER_ID, providerAddr)
+				require.False(t, found)
+				_, found = providerKeeper.GetOptInTime(ctx, CONSUMER_ID, providerAddr)
+				require.False(t, found)
+			}
+
+			// the validator is opted in to check whether it can opt out
+			providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr)
+			_, err = msgServer.OptOut(ctx, &providertypes.MsgOptOut{
+				ProviderAddr: valAddr.String(),
+				ConsumerId:   CONSUMER_ID,
+			})
+			if tc.optOutAllowed {
+				require.NoError(t, err)
+				require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+			} else {
+				require.Error(t, err)
+				if tc.phase == providertypes.CONSUMER_PHASE_UNSPECIFIED {
+					require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+				} else {
+					require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+					require.ErrorContains(t, err, tc.phase.String())
+				}
+				require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
+			}
+		})
+	}
 }
@@ -73,6 +73,104 @@ func (k Keeper) GetMaxProviderConsensusValidators(ctx sdk.Context) int64 {
 	return params.MaxProviderConsensusValidators
 }
 
+// GetMaxConsumerCleanupDeletionsPerBlock returns the maximum number of store entries
+// of deleted consumer chains that are removed in a single block
+func (k Keeper) GetMaxConsumerCleanupDeletionsPerBlock(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxConsumerCleanupDeletionsPerBlock
+}
+
+// GetDormancyPeriod returns the period after which a consumer chain from which
+// no packets were received is considered dormant
+func (k Keeper) GetDormancyPeriod(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.DormancyPeriod
+}
+
+// GetNumberOfEpochsToRetainConsumerValsets returns the number of epochs for which
+// the validator sets of the consumer chains are retained
+func (k Keeper) GetNumberOfEpochsToRetainConsumerValsets(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.NumberOfEpochsToRetainConsumerValsets
+}
+
+// GetLifetimeReminderFractions returns the fractions of the lifetime of a consumer chain
+// with a maximum lifetime after which reminder events about its sunset are emitted
+func (k Keeper) GetLifetimeReminderFractions(ctx sdk.Context) []string {
+	params := k.GetParams(ctx)
+	return params.LifetimeReminderFractions
+}
+
+// GetUpgradeQuietPeriod returns the number of blocks before the height of a scheduled
+// upgrade plan during which consumer lifecycle messages are rejected
+func (k Keeper) GetUpgradeQuietPeriod(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.UpgradeQuietPeriod
+}
+
+// GetConsumerClientExpiryWarningFraction returns the fraction of the trusting period
+// of the consumer clients below which warning events are emitted
+func (k Keeper) GetConsumerClientExpiryWarningFraction(ctx sdk.Context) string {
+	params := k.GetParams(ctx)
+	return params.ConsumerClientExpiryWarningFraction
+}
+
+// GetMaxConsumerParticipation returns the maximal number of consumer chains whose validator sets
+// a single provider validator may be included in simultaneously
+func (k Keeper) GetMaxConsumerParticipation(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxConsumerParticipation
+}
+
+// GetEmergencyOptOutSlashFraction returns the fraction of the stake of a validator
+// that is slashed when the validator emergency opts out of a consumer chain
+func (k Keeper) GetEmergencyOptOutSlashFraction(ctx sdk.Context) string {
+	params := k.GetParams(ctx)
+	return params.EmergencyOptOutSlashFraction
+}
+
+// GetEmergencyOptOutCooldown returns the period after an emergency opt out during which
+// the validator cannot opt in to the consumer chain again
+func (k Keeper) GetEmergencyOptOutCooldown(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.EmergencyOptOutCooldown
+}
+
+// GetSendUpgradeNotices returns whether the upgrades of the provider chain
+// are communicated to the consumer chains in VSC packets
+func (k Keeper) GetSendUpgradeNotices(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.SendUpgradeNotices
+}
+
+// GetMaxClientCreationsPerBlock returns the maximal number of consumer clients
+// created in a single block
+func (k Keeper) GetMaxClientCreationsPerBlock(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxClientCreationsPerBlock
+}
+
+// GetNumberOfEpochsToRetainEconomicSecurity returns the number of epochs for which
+// the economic security records of the consumer chains are retained
+func (k Keeper) GetNumberOfEpochsToRetainEconomicSecurity(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.NumberOfEpochsToRetainEconomicSecurity
+}
+
+// GetAllowZeroKeyAssignmentNonce returns whether a MsgAssignConsumerKey with a zero nonce
+// is accepted as long as the key assignment nonce of the validator is zero
+func (k Keeper) GetAllowZeroKeyAssignmentNonce(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.AllowZeroKeyAssignmentNonce
+}
+
+// GetExcludeTokenizedSharesFromTopN returns whether the tokenized shares of the validators
+// are excluded from the power used to compute the Top N validators
+func (k Keeper) GetExcludeTokenizedSharesFromTopN(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.ExcludeTokenizedSharesFromTopN
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
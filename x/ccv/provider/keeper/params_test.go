@@ -51,6 +51,20 @@ func TestParams(t *testing.T) {
 		600,
 		24,
 		10,
+		500,
+		48*time.Hour,
+		100,
+		[]string{"0.25", "0.75"},
+		50,
+		"0.25",
+		3,
+		"0.01",
+		24*time.Hour,
+		true,
+		10,
+		100,
+		false,
+		true,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
@@ -16,13 +16,45 @@ import (
 // HandleOptIn prepares validator `providerAddr` to opt in to `consumerId` with an optional `consumerKey` consumer public key.
 // Note that the validator only opts in at the end of an epoch.
 func (k Keeper) HandleOptIn(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, consumerKey string) error {
-	if !k.IsConsumerActive(ctx, consumerId) {
+	if err := k.ValidateOptInPhase(ctx, consumerId); err != nil {
+		return err
+	}
+
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot get consumer power shaping parameters: %s", err.Error(),
+		)
+	}
+	if cooldownEndTime, inCooldown := k.IsInEmergencyOptOutCooldown(ctx, consumerId, providerAddr); inCooldown {
 		return errorsmod.Wrapf(
-			types.ErrInvalidPhase,
-			"cannot opt in to a consumer chain that is not in the registered, initialized, or launched phase: %s", consumerId)
+			types.ErrEmergencyOptOutCooldown,
+			"validator %s cannot opt in to consumer chain %s until %s",
+			providerAddr.String(), consumerId, cooldownEndTime)
+	}
+	// the cooldown of a validator that emergency opted out before (if any) elapsed
+	k.DeleteEmergencyOptOutCooldownEndTime(ctx, consumerId, providerAddr)
+	if cooldownEndTime, inCooldown := k.IsInReOptInCooldown(ctx, consumerId, providerAddr); inCooldown {
+		return errorsmod.Wrapf(
+			types.ErrReOptInCooldown,
+			"validator %s cannot opt in to consumer chain %s until %s",
+			providerAddr.String(), consumerId, cooldownEndTime)
 	}
 
-	k.SetOptedIn(ctx, consumerId, providerAddr)
+	fulfillsMaxProviderRank, err := k.FulfillsMaxProviderRank(ctx, powerShapingParameters.MaxProviderRank, providerAddr)
+	if err != nil {
+		return err
+	}
+	if !fulfillsMaxProviderRank {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotWithinMaxProviderRank,
+			"validator %s is not ranked within the top %d provider validators required by consumer chain %s",
+			providerAddr.String(), powerShapingParameters.MaxProviderRank, consumerId)
+	}
+
+	k.setOptedInWithTime(ctx, consumerId, providerAddr)
+	// a validator that opts in voluntarily is not exempt from downtime during the downtime grace period
+	k.DeleteForcedOptInTime(ctx, consumerId, providerAddr)
 
 	if consumerKey != "" {
 		consumerTMPublicKey, err := k.ParseConsumerKey(consumerKey)
@@ -44,22 +76,96 @@ func (k Keeper) HandleOptIn(ctx sdk.Context, consumerId string, providerAddr typ
 	return nil
 }
 
+// ValidateOptInPhase returns an error naming the current phase of the consumer chain with `consumerId`
+// unless validators can opt in to it, i.e., unless the chain is registered, initialized, or launched.
+// Note that no opt-in state is hence created once a chain is stopped, while its existing opt-in state
+// is removed when the chain is deleted (see DeleteConsumerChain).
+func (k Keeper) ValidateOptInPhase(ctx sdk.Context, consumerId string) error {
+	switch phase := k.GetConsumerPhase(ctx, consumerId); phase {
+	case types.CONSUMER_PHASE_REGISTERED, types.CONSUMER_PHASE_INITIALIZED, types.CONSUMER_PHASE_LAUNCHED:
+		return nil
+	case types.CONSUMER_PHASE_UNSPECIFIED:
+		return errorsmod.Wrapf(types.ErrUnknownConsumerId,
+			"cannot opt in to an unknown consumer chain, consumerId(%s)", consumerId)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot opt in to consumer chain in phase %s, consumerId(%s): "+
+				"opting in is only allowed in the registered, initialized, or launched phase", phase, consumerId)
+	}
+}
+
+// ValidateOptOutPhase returns an error naming the current phase of the consumer chain with `consumerId`
+// unless validators can opt out of it, i.e., unless the chain is launched
+func (k Keeper) ValidateOptOutPhase(ctx sdk.Context, consumerId string) error {
+	switch phase := k.GetConsumerPhase(ctx, consumerId); phase {
+	case types.CONSUMER_PHASE_LAUNCHED:
+		return nil
+	case types.CONSUMER_PHASE_UNSPECIFIED:
+		return errorsmod.Wrapf(types.ErrUnknownConsumerId,
+			"cannot opt out of an unknown consumer chain, consumerId(%s)", consumerId)
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot opt out of consumer chain in phase %s, consumerId(%s): "+
+				"opting out is only allowed in the launched phase", phase, consumerId)
+	}
+}
+
+// HandleConsumerTermsAcknowledgment records that validator `providerAddr` acknowledged the terms of `consumerId`
+// with hash `acknowledgedTermsHash` when opting in. If the consumer chain has terms, opting in requires
+// acknowledging the current terms of the chain.
+func (k Keeper) HandleConsumerTermsAcknowledgment(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	acknowledgedTermsHash string,
+) error {
+	termsHash := k.GetConsumerTermsHash(ctx, consumerId)
+	if termsHash == "" {
+		// the consumer chain has no terms to acknowledge
+		return nil
+	}
+	if acknowledgedTermsHash != termsHash {
+		return errorsmod.Wrapf(
+			types.ErrConsumerTermsNotAcknowledged,
+			"validator %s must acknowledge the terms with hash %s of consumer chain %s to opt in, acknowledged terms hash: %q",
+			providerAddr.String(), termsHash, consumerId, acknowledgedTermsHash)
+	}
+
+	k.SetAcknowledgedConsumerTerms(ctx, consumerId, providerAddr, acknowledgedTermsHash)
+	return nil
+}
+
+// GetConsumerTermsHash returns the hash of the terms that validators must acknowledge before opting in to `consumerId`,
+// or an empty string if the consumer chain has no terms
+func (k Keeper) GetConsumerTermsHash(ctx sdk.Context, consumerId string) string {
+	metadata, err := k.GetConsumerMetadata(ctx, consumerId)
+	if err != nil {
+		// a consumer chain without metadata has no terms
+		return ""
+	}
+	return metadata.TermsHash
+}
+
+// HasAcknowledgedConsumerTerms returns true if the validator `providerAddr` acknowledged the terms with `termsHash`
+// of chain `consumerId`, or if the consumer chain has no terms (i.e., `termsHash` is empty)
+func (k Keeper) HasAcknowledgedConsumerTerms(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	termsHash string,
+) bool {
+	if termsHash == "" {
+		return true
+	}
+	acknowledgedTermsHash, found := k.GetAcknowledgedConsumerTerms(ctx, consumerId, providerAddr)
+	return found && acknowledgedTermsHash == termsHash
+}
+
 // HandleOptOut prepares validator `providerAddr` to opt out from running `consumerId`.
 // Note that the validator only opts out at the end of an epoch.
 func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) error {
-	phase := k.GetConsumerPhase(ctx, consumerId)
-	if phase == types.CONSUMER_PHASE_UNSPECIFIED {
-		return errorsmod.Wrapf(
-			types.ErrUnknownConsumerId,
-			"opting out of an unknown consumer chain, consumerId(%s)", consumerId,
-		)
-	}
-	if phase != types.CONSUMER_PHASE_LAUNCHED {
-		// A validator can only opt out from a running chain
-		return errorsmod.Wrapf(
-			types.ErrInvalidPhase,
-			"opting out of a consumer chain not yet launched, consumerId(%s)", consumerId,
-		)
+	if err := k.ValidateOptOutPhase(ctx, consumerId); err != nil {
+		return err
 	}
 
 	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
@@ -82,6 +188,10 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		if err != nil {
 			return err
 		}
+		power, err = k.GetTopNPower(ctx, valAddr, power)
+		if err != nil {
+			return err
+		}
 		minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId)
 		if !found {
 			return errorsmod.Wrapf(
@@ -97,11 +207,99 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		}
 	}
 
+	k.deleteOptedIn(ctx, consumerId, providerAddr)
+
+	return nil
+}
+
+// deleteOptedIn opts out validator `providerAddr` from `consumerId` and deletes the state
+// that is only relevant while the validator is opted in
+func (k Keeper) deleteOptedIn(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
 	k.DeleteOptedIn(ctx, consumerId, providerAddr)
+	k.DeleteAcknowledgedConsumerTerms(ctx, consumerId, providerAddr)
+	k.DeleteForcedOptInTime(ctx, consumerId, providerAddr)
+	k.DeleteOptInTime(ctx, consumerId, providerAddr)
+	k.DeleteOptInHeight(ctx, consumerId, providerAddr)
+	k.DeleteValidatorUnavailable(ctx, consumerId, providerAddr)
+}
+
+// HandleSetConsumerAvailability marks the opted-in validator `providerAddr` as (un)available to validate `consumerId`.
+// Unavailable validators remain opted in, but are excluded from the validator set of the consumer chain
+// from the end of the epoch on, until they are marked as available again.
+// Note that the validators that are required to validate a Top N chain cannot be marked as unavailable.
+func (k Keeper) HandleSetConsumerAvailability(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, available bool) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase == types.CONSUMER_PHASE_UNSPECIFIED {
+		return errorsmod.Wrapf(
+			types.ErrUnknownConsumerId,
+			"setting the availability on an unknown consumer chain, consumerId(%s)", consumerId,
+		)
+	}
+	if phase != types.CONSUMER_PHASE_LAUNCHED {
+		// A validator can only step out of the validator set of a running chain
+		return errorsmod.Wrapf(
+			types.ErrInvalidPhase,
+			"setting the availability on a consumer chain not yet launched, consumerId(%s)", consumerId,
+		)
+	}
 
+	if available {
+		k.DeleteValidatorUnavailable(ctx, consumerId, providerAddr)
+		return nil
+	}
+
+	if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotOptedIn,
+			"validator (%s) is not opted in to the consumer chain with consumer id (%s)", providerAddr.String(), consumerId)
+	}
+
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot get consumer power shaping parameters: %s", err.Error(),
+		)
+	}
+	if powerShapingParameters.Top_N > 0 {
+		// a validator in the Top N validators has to validate the chain and hence cannot evade its liability
+		minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId)
+		if !found {
+			return errorsmod.Wrapf(
+				types.ErrUnknownConsumerId,
+				"Could not find minimum power in top N for chain with consumer id: %s", consumerId)
+		}
+		hasToValidate, err := k.HasMinPower(ctx, providerAddr, minPowerInTopN)
+		if err != nil {
+			return err
+		}
+		if hasToValidate {
+			return errorsmod.Wrapf(
+				types.ErrCannotBeUnavailableOnTopN,
+				"validator (%s) cannot be unavailable on Top N chain with consumer id (%s) because all validators"+
+					" with at least %d power have to validate", providerAddr.String(), consumerId, minPowerInTopN)
+		}
+	}
+
+	k.SetValidatorUnavailable(ctx, consumerId, providerAddr)
 	return nil
 }
 
+// isAvailableToValidate returns false if validator `providerAddr` with `power` is marked as unavailable to validate
+// `consumerId`, unless the validator has at least `minPowerToOptIn` power on a Top N chain and hence has to validate
+func (k Keeper) isAvailableToValidate(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	power int64,
+	topN uint32,
+	minPowerToOptIn int64,
+) bool {
+	if !k.IsValidatorUnavailable(ctx, consumerId, providerAddr) {
+		return true
+	}
+	return topN > 0 && power >= minPowerToOptIn
+}
+
 // OptInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power
 func (k Keeper) OptInTopNValidators(
 	ctx sdk.Context,
@@ -109,37 +307,56 @@ func (k Keeper) OptInTopNValidators(
 	bondedValidators []stakingtypes.Validator,
 	minPowerToOptIn int64,
 ) error {
+	validators, err := k.toBondedValidators(ctx, bondedValidators)
+	if err != nil {
+		return fmt.Errorf("converting bonded validators, consumerId(%s): %w", consumerId, err)
+	}
+	k.optInTopNValidators(ctx, consumerId, validators, minPowerToOptIn)
+	return nil
+}
+
+// optInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power
+func (k Keeper) optInTopNValidators(
+	ctx sdk.Context,
+	consumerId string,
+	bondedValidators []BondedValidator,
+	minPowerToOptIn int64,
+) {
 	for _, val := range bondedValidators {
 		// log the validator
 		k.Logger(ctx).Debug("Checking whether to opt in validator because of top N",
 			"consumerId", consumerId,
-			"validator", val.GetOperator(),
+			"validator", val.Validator.GetOperator(),
 		)
 
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
-		if err != nil {
-			return fmt.Errorf("converting operator address to validator address, consumerId(%s), validator(%s): %w",
-				consumerId, val.GetOperator(), err)
-		}
-		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
-		if err != nil {
-			return fmt.Errorf("getting validator power, consumerId(%s), validator(%s): %w",
-				consumerId, val.GetOperator(), err)
-		}
-		if power >= minPowerToOptIn {
-			consAddr, err := val.GetConsAddr()
-			if err != nil {
-				return fmt.Errorf("getting validator consensus address, consumerId(%s), validator(%s): %w",
-					consumerId, val.GetOperator(), err)
+		if val.TopNPower >= minPowerToOptIn {
+			k.Logger(ctx).Debug("Opting in validator", "consumerId", consumerId, "validator", val.Validator.GetOperator())
+
+			// validators in the cooldown after an emergency opt out are not opted in again
+			providerAddr := val.ProviderAddr
+			if _, inCooldown := k.IsInEmergencyOptOutCooldown(ctx, consumerId, providerAddr); inCooldown {
+				k.Logger(ctx).Debug("Not opting in validator in emergency opt-out cooldown",
+					"consumerId", consumerId, "validator", val.Validator.GetOperator())
+				continue
+			}
+			// validators in the cooldown after being jailed for an infraction committed on the consumer chain
+			// are not forced to validate it
+			if _, inCooldown := k.IsInReOptInCooldown(ctx, consumerId, providerAddr); inCooldown {
+				k.Logger(ctx).Debug("Not opting in validator in re-opt-in cooldown",
+					"consumerId", consumerId, "validator", val.Validator.GetOperator())
+				continue
 			}
 
-			k.Logger(ctx).Debug("Opting in validator", "consumerId", consumerId, "validator", val.GetOperator())
+			// record the time at which the validator is automatically opted in,
+			// which is the start of its downtime grace period on the consumer chain
+			if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+				k.SetForcedOptInTime(ctx, consumerId, providerAddr, ctx.BlockTime())
+			}
 
-			// if validator is already opted in, it gets overwritten
-			k.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+			// if validator is already opted in, it gets overwritten (but its opt-in time is retained)
+			k.setOptedInWithTime(ctx, consumerId, providerAddr)
 		} // else validators that do not belong to the top N validators but were opted in, remain opted in
 	}
-	return nil
 }
 
 //
@@ -190,6 +407,71 @@ func (k Keeper) GetAllOptedIn(
 	return providerConsAddresses
 }
 
+// SetAcknowledgedConsumerTerms sets the hash of the terms of chain `consumerId` acknowledged by validator `providerAddr`
+func (k Keeper) SetAcknowledgedConsumerTerms(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	termsHash string,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AcknowledgedConsumerTermsKey(consumerId, providerAddr), []byte(termsHash))
+}
+
+// GetAcknowledgedConsumerTerms returns the hash of the terms of chain `consumerId` acknowledged by validator `providerAddr`
+func (k Keeper) GetAcknowledgedConsumerTerms(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AcknowledgedConsumerTermsKey(consumerId, providerAddr))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeleteAcknowledgedConsumerTerms deletes the hash of the terms of chain `consumerId` acknowledged by validator `providerAddr`
+func (k Keeper) DeleteAcknowledgedConsumerTerms(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AcknowledgedConsumerTermsKey(consumerId, providerAddr))
+}
+
+// SetValidatorUnavailable marks validator `providerAddr` as unavailable to validate chain `consumerId`
+func (k Keeper) SetValidatorUnavailable(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnavailableValidatorKey(consumerId, providerAddr), []byte{})
+}
+
+// IsValidatorUnavailable returns true if validator `providerAddr` is marked as unavailable to validate chain `consumerId`
+func (k Keeper) IsValidatorUnavailable(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.UnavailableValidatorKey(consumerId, providerAddr)) != nil
+}
+
+// DeleteValidatorUnavailable marks validator `providerAddr` as available again to validate chain `consumerId`
+func (k Keeper) DeleteValidatorUnavailable(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UnavailableValidatorKey(consumerId, providerAddr))
+}
+
 // DeleteAllOptedIn deletes all the opted-in validators for chain with `consumerId`
 func (k Keeper) DeleteAllOptedIn(
 	ctx sdk.Context,
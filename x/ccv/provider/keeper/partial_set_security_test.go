@@ -33,12 +33,38 @@ func TestHandleOptIn(t *testing.T) {
 
 	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
 	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chainId")
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
 	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
-	err := providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, "")
+	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, "")
 	require.NoError(t, err)
 	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddr))
 }
 
+func TestHandleOptInWithMaxProviderRank(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, vals, -1)
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chainId")
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{MaxProviderRank: 2})
+	require.NoError(t, err)
+
+	// the validators ranked within the top 2 can opt in
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, CONSUMER_ID, consAddrs[0], ""))
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, CONSUMER_ID, consAddrs[1], ""))
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[0]))
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[1]))
+
+	// the validator ranked third cannot opt in
+	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, consAddrs[2], "")
+	require.ErrorIs(t, err, providertypes.ErrValidatorNotWithinMaxProviderRank)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[2]))
+}
+
 func TestHandleOptInWithConsumerKey(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -74,6 +100,8 @@ func TestHandleOptInWithConsumerKey(t *testing.T) {
 
 	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
 	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
 	err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, consumerKey)
 	require.NoError(t, err)
 
@@ -89,6 +117,76 @@ func TestHandleOptInWithConsumerKey(t *testing.T) {
 	require.Equal(t, providerAddr, actualProviderConsAddr)
 }
 
+// TestHandleConsumerTermsAcknowledgment tests that validators must acknowledge the terms of a consumer chain
+// to opt in, and that they must re-acknowledge the terms once they change to remain in the validator set
+func TestHandleConsumerTermsAcknowledgment(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20)
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chainId")
+	powerShapingParameters := providertypes.PowerShapingParameters{AllowInactiveVals: true}
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters))
+
+	termsHashV1 := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	termsHashV2 := "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752"
+	setTerms := func(termsHash string) {
+		require.NoError(t, providerKeeper.SetConsumerMetadata(ctx, CONSUMER_ID,
+			providertypes.ConsumerMetadata{Name: "name", TermsHash: termsHash}))
+	}
+	optIn := func(providerAddr providertypes.ProviderConsAddress, acknowledgedTermsHash string) error {
+		if err := providerKeeper.HandleConsumerTermsAcknowledgment(ctx, CONSUMER_ID, providerAddr, acknowledgedTermsHash); err != nil {
+			return err
+		}
+		return providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddr, "")
+	}
+	nextValsAddrs := func() []providertypes.ProviderConsAddress {
+		nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+		require.NoError(t, err)
+		addrs := []providertypes.ProviderConsAddress{}
+		for _, val := range nextVals {
+			addrs = append(addrs, providertypes.NewProviderConsAddress(val.ProviderConsAddr))
+		}
+		return addrs
+	}
+
+	setTerms(termsHashV1)
+
+	// validators cannot opt in without acknowledging the current terms
+	require.ErrorIs(t, optIn(consAddrs[0], ""), providertypes.ErrConsumerTermsNotAcknowledged)
+	require.ErrorIs(t, optIn(consAddrs[0], termsHashV2), providertypes.ErrConsumerTermsNotAcknowledged)
+	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[0]))
+
+	// both validators acknowledge the terms and opt in
+	require.NoError(t, optIn(consAddrs[0], termsHashV1))
+	require.NoError(t, optIn(consAddrs[1], termsHashV1))
+	acknowledgedTermsHash, found := providerKeeper.GetAcknowledgedConsumerTerms(ctx, CONSUMER_ID, consAddrs[0])
+	require.True(t, found)
+	require.Equal(t, termsHashV1, acknowledgedTermsHash)
+	require.ElementsMatch(t, consAddrs, nextValsAddrs())
+
+	// once the terms change, the validators that did not re-acknowledge them are dropped at the next epoch
+	setTerms(termsHashV2)
+	require.Empty(t, nextValsAddrs())
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[0]))
+
+	// the first validator re-acknowledges the terms by opting in again
+	require.ErrorIs(t, optIn(consAddrs[0], termsHashV1), providertypes.ErrConsumerTermsNotAcknowledged)
+	require.NoError(t, optIn(consAddrs[0], termsHashV2))
+	require.Equal(t, []providertypes.ProviderConsAddress{consAddrs[0]}, nextValsAddrs())
+
+	// once the terms are removed, all opted-in validators are in the validator set
+	setTerms("")
+	require.ElementsMatch(t, consAddrs, nextValsAddrs())
+
+	// the acknowledgment is removed when the validator opts out
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, CONSUMER_ID, consAddrs[0]))
+	_, found = providerKeeper.GetAcknowledgedConsumerTerms(ctx, CONSUMER_ID, consAddrs[0])
+	require.False(t, found)
+}
+
 func TestHandleOptOut(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -107,10 +205,13 @@ func TestHandleOptOut(t *testing.T) {
 
 	// if validator (`providerAddr`) is already opted in, then an opt-out would remove this validator
 	providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+	providerKeeper.SetOptInTime(ctx, consumerId, providerAddr, ctx.BlockTime())
 	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
 	err = providerKeeper.HandleOptOut(ctx, consumerId, providerAddr)
 	require.NoError(t, err)
 	require.False(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+	_, found := providerKeeper.GetOptInTime(ctx, consumerId, providerAddr)
+	require.False(t, found)
 }
 
 func TestHandleOptOutFromTopNChain(t *testing.T) {
@@ -172,6 +273,73 @@ func TestHandleOptOutFromTopNChain(t *testing.T) {
 	require.Error(t, providerKeeper.HandleOptOut(ctx, consumerId, providertypes.NewProviderConsAddress(notFoundValidatorConsAddr)))
 }
 
+func TestHandleSetConsumerAvailability(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := CONSUMER_ID
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+
+	// setting the availability on an unknown or not yet launched chain returns an error
+	err := providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, false)
+	require.ErrorIs(t, err, providertypes.ErrUnknownConsumerId)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, false)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// a validator that is not opted in cannot be unavailable
+	err = providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, false)
+	require.ErrorIs(t, err, providertypes.ErrValidatorNotOptedIn)
+
+	// an opted-in validator can be unavailable and available again, while remaining opted in
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+	require.NoError(t, providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, false))
+	require.True(t, providerKeeper.IsValidatorUnavailable(ctx, consumerId, providerAddr))
+	require.NoError(t, providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, true))
+	require.False(t, providerKeeper.IsValidatorUnavailable(ctx, consumerId, providerAddr))
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+
+	// opting out removes the unavailability of the validator
+	require.NoError(t, providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, providerAddr, false))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, consumerId, providerAddr))
+	require.False(t, providerKeeper.IsValidatorUnavailable(ctx, consumerId, providerAddr))
+}
+
+func TestHandleSetConsumerAvailabilityOnTopNChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := CONSUMER_ID
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{
+		Top_N: 50,
+	})
+	require.NoError(t, err)
+
+	// validator A has 25% and validator B has 75% of the total voting power
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1, 3)
+	minPowerInTopN, err := providerKeeper.ComputeMinPowerInTopN(ctx, vals, 50)
+	require.NoError(t, err)
+	providerKeeper.SetMinimumPowerInTopN(ctx, consumerId, minPowerInTopN)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, consumerId, consAddr)
+	}
+
+	// validator A does not belong to the top N and hence can be unavailable
+	require.NoError(t, providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, consAddrs[0], false))
+	require.True(t, providerKeeper.IsValidatorUnavailable(ctx, consumerId, consAddrs[0]))
+
+	// validator B belongs to the top N and hence cannot be unavailable
+	err = providerKeeper.HandleSetConsumerAvailability(ctx, consumerId, consAddrs[1], false)
+	require.ErrorIs(t, err, providertypes.ErrCannotBeUnavailableOnTopN)
+	require.False(t, providerKeeper.IsValidatorUnavailable(ctx, consumerId, consAddrs[1]))
+}
+
 func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
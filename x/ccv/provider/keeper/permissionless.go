@@ -1,13 +1,20 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 // setConsumerId sets the provided consumerId
@@ -50,6 +57,13 @@ func (k Keeper) GetConsumerChainId(ctx sdk.Context, consumerId string) (string,
 
 // SetConsumerChainId sets the chain id associated with this consumer id
 func (k Keeper) SetConsumerChainId(ctx sdk.Context, consumerId, chainId string) {
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_LAUNCHED {
+		// the chain id of a launched chain changes (e.g., on a hard fork), so move it in the index of launched chain ids
+		if prevChainId, err := k.GetConsumerChainId(ctx, consumerId); err == nil {
+			k.deleteLaunchedChainId(ctx, prevChainId, consumerId)
+		}
+		k.setLaunchedChainId(ctx, chainId, consumerId)
+	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.ConsumerIdToChainIdKey(consumerId), []byte(chainId))
 }
@@ -71,17 +85,63 @@ func (k Keeper) GetConsumerOwnerAddress(ctx sdk.Context, consumerId string) (str
 }
 
 // SetConsumerOwnerAddress sets the owner address associated with this consumer id
+// and updates the index of the consumer ids owned by every owner address accordingly
 func (k Keeper) SetConsumerOwnerAddress(ctx sdk.Context, consumerId, owner string) {
 	store := ctx.KVStore(k.storeKey)
+	if previousOwner, err := k.GetConsumerOwnerAddress(ctx, consumerId); err == nil {
+		store.Delete(types.OwnerAddressToConsumerIdKey(previousOwner, consumerId))
+	}
 	store.Set(types.ConsumerIdToOwnerAddressKey(consumerId), []byte(owner))
+	store.Set(types.OwnerAddressToConsumerIdKey(owner, consumerId), []byte{})
 }
 
 // DeleteConsumerOwnerAddress deletes the owner address associated with this consumer id
+// and removes the consumer id from the consumer ids owned by this owner address
 func (k Keeper) DeleteConsumerOwnerAddress(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
+	if owner, err := k.GetConsumerOwnerAddress(ctx, consumerId); err == nil {
+		store.Delete(types.OwnerAddressToConsumerIdKey(owner, consumerId))
+	}
 	store.Delete(types.ConsumerIdToOwnerAddressKey(consumerId))
 }
 
+// GetConsumerPowerShapingAdmin returns the power-shaping admin associated with this consumer id, i.e.,
+// the address permitted to update the allowlist and denylist of the consumer chain on behalf of its owner
+func (k Keeper) GetConsumerPowerShapingAdmin(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPowerShapingAdminKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetConsumerPowerShapingAdmin sets the power-shaping admin associated with this consumer id
+func (k Keeper) SetConsumerPowerShapingAdmin(ctx sdk.Context, consumerId, admin string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToPowerShapingAdminKey(consumerId), []byte(admin))
+}
+
+// DeleteConsumerPowerShapingAdmin deletes the power-shaping admin associated with this consumer id
+func (k Keeper) DeleteConsumerPowerShapingAdmin(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToPowerShapingAdminKey(consumerId))
+}
+
+// GetConsumerIdsByOwnerAddress returns the consumer ids of all the consumer chains owned by the given owner address
+func (k Keeper) GetConsumerIdsByOwnerAddress(ctx sdk.Context, owner string) []string {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.OwnerAddressToConsumerIdsKeyPrefix(owner)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerIds = append(consumerIds, string(iterator.Key()[len(keyPrefix):]))
+	}
+	return consumerIds
+}
+
 // GetConsumerMetadata returns the registration record associated with this consumer id
 func (k Keeper) GetConsumerMetadata(ctx sdk.Context, consumerId string) (types.ConsumerMetadata, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -113,6 +173,139 @@ func (k Keeper) DeleteConsumerMetadata(ctx sdk.Context, consumerId string) {
 	store.Delete(types.ConsumerIdToMetadataKey(consumerId))
 }
 
+// GetConsumerEndpointInfo returns the endpoints (e.g., peers, RPC and genesis URLs) advertised for this consumer id
+func (k Keeper) GetConsumerEndpointInfo(ctx sdk.Context, consumerId string) (types.EndpointInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToEndpointInfoKey(consumerId))
+	if bz == nil {
+		return types.EndpointInfo{}, errorsmod.Wrapf(ccvtypes.ErrStoreKeyNotFound,
+			"GetConsumerEndpointInfo, consumerId(%s)", consumerId)
+	}
+	var endpointInfo types.EndpointInfo
+	if err := endpointInfo.Unmarshal(bz); err != nil {
+		return types.EndpointInfo{}, errorsmod.Wrapf(ccvtypes.ErrStoreUnmarshal,
+			"GetConsumerEndpointInfo, consumerId(%s): %s", consumerId, err.Error())
+	}
+	return endpointInfo, nil
+}
+
+// SetConsumerEndpointInfo sets the endpoints advertised for this consumer id
+func (k Keeper) SetConsumerEndpointInfo(ctx sdk.Context, consumerId string, endpointInfo types.EndpointInfo) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := endpointInfo.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal endpoint info (%+v) for consumer id (%s): %w", endpointInfo, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToEndpointInfoKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerEndpointInfo deletes the endpoints advertised for this consumer id
+func (k Keeper) DeleteConsumerEndpointInfo(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToEndpointInfoKey(consumerId))
+}
+
+// UpdateConsumerEndpointInfo overwrites the endpoints advertised for this consumer id and returns
+// true if they changed. Providing an empty `endpointInfo` removes the advertised endpoints.
+func (k Keeper) UpdateConsumerEndpointInfo(ctx sdk.Context, consumerId string, endpointInfo types.EndpointInfo) (bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	oldBz := store.Get(types.ConsumerIdToEndpointInfoKey(consumerId))
+	newBz, err := endpointInfo.Marshal()
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal endpoint info (%+v) for consumer id (%s): %w", endpointInfo, consumerId, err)
+	}
+	if bytes.Equal(oldBz, newBz) {
+		return false, nil
+	}
+
+	if len(newBz) == 0 {
+		k.DeleteConsumerEndpointInfo(ctx, consumerId)
+	} else {
+		store.Set(types.ConsumerIdToEndpointInfoKey(consumerId), newBz)
+	}
+	return true, nil
+}
+
+// GetConsumerTimeoutPeriods returns the timeout periods of this consumer id that were updated after the chain launched
+func (k Keeper) GetConsumerTimeoutPeriods(ctx sdk.Context, consumerId string) (types.ConsumerTimeoutPeriods, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToTimeoutPeriodsKey(consumerId))
+	if bz == nil {
+		return types.ConsumerTimeoutPeriods{}, false
+	}
+	var timeoutPeriods types.ConsumerTimeoutPeriods
+	if err := timeoutPeriods.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the timeout periods are assumed to be correctly serialized in SetConsumerTimeoutPeriods.
+		panic(fmt.Errorf("failed to unmarshal timeout periods for consumer id (%s): %w", consumerId, err))
+	}
+	return timeoutPeriods, true
+}
+
+// SetConsumerTimeoutPeriods sets the timeout periods of this consumer id
+func (k Keeper) SetConsumerTimeoutPeriods(ctx sdk.Context, consumerId string, timeoutPeriods types.ConsumerTimeoutPeriods) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := timeoutPeriods.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal timeout periods (%+v) for consumer id (%s): %w", timeoutPeriods, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToTimeoutPeriodsKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerTimeoutPeriods deletes the timeout periods of this consumer id
+func (k Keeper) DeleteConsumerTimeoutPeriods(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToTimeoutPeriodsKey(consumerId))
+}
+
+// GetEffectiveConsumerTimeoutPeriods returns the timeout periods of this consumer id, i.e., the timeout periods
+// updated after the chain launched or, if they were never updated, the CCV timeout period used for the VSC packets
+// (see GetConsumerCCVTimeoutPeriod) and the transfer timeout period of the initialization parameters
+func (k Keeper) GetEffectiveConsumerTimeoutPeriods(ctx sdk.Context, consumerId string) (types.ConsumerTimeoutPeriods, error) {
+	if timeoutPeriods, found := k.GetConsumerTimeoutPeriods(ctx, consumerId); found {
+		return timeoutPeriods, nil
+	}
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return types.ConsumerTimeoutPeriods{}, err
+	}
+	return types.ConsumerTimeoutPeriods{
+		CcvTimeoutPeriod:      k.GetConsumerCCVTimeoutPeriod(ctx, consumerId),
+		TransferTimeoutPeriod: initializationParameters.TransferTimeoutPeriod,
+	}, nil
+}
+
+// GetConsumerCCVTimeoutPeriod returns the timeout period for the VSC packets sent to this consumer id, i.e.,
+// the CCV timeout period updated after the chain launched or, if it was never updated, the CcvTimeoutPeriod param
+func (k Keeper) GetConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string) time.Duration {
+	if timeoutPeriods, found := k.GetConsumerTimeoutPeriods(ctx, consumerId); found {
+		return timeoutPeriods.CcvTimeoutPeriod
+	}
+	return k.GetCCVTimeoutPeriod(ctx)
+}
+
+// ValidateConsumerTimeoutPeriods validates the timeout periods of a consumer chain, i.e., it checks that
+// both timeout periods are positive and that the CCV timeout period exceeds the expected duration of an epoch,
+// as VSC packets are sent once per epoch. The expected duration of an epoch is not known before the first epoch
+// ends, in which case the timeout periods cannot be updated.
+func (k Keeper) ValidateConsumerTimeoutPeriods(ctx sdk.Context, timeoutPeriods types.ConsumerTimeoutPeriods) error {
+	if err := types.ValidateConsumerTimeoutPeriods(timeoutPeriods); err != nil {
+		return err
+	}
+	expectedEpochDuration, found := k.GetExpectedEpochDuration(ctx)
+	if !found {
+		return errorsmod.Wrap(types.ErrInvalidConsumerTimeoutPeriods,
+			"the expected epoch duration is not known yet; retry once the current epoch ends")
+	}
+	if timeoutPeriods.CcvTimeoutPeriod <= expectedEpochDuration {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerTimeoutPeriods,
+			"CcvTimeoutPeriod (%s) must exceed the expected epoch duration (%s)", timeoutPeriods.CcvTimeoutPeriod, expectedEpochDuration)
+	}
+	return nil
+}
+
 // GetConsumerInitializationParameters returns the initialization parameters associated with this consumer id
 func (k Keeper) GetConsumerInitializationParameters(ctx sdk.Context, consumerId string) (types.ConsumerInitializationParameters, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -165,6 +358,7 @@ func (k Keeper) GetConsumerPhase(ctx sdk.Context, consumerId string) types.Consu
 
 // SetConsumerPhase sets the phase associated with this consumer id
 func (k Keeper) SetConsumerPhase(ctx sdk.Context, consumerId string, phase types.ConsumerPhase) {
+	k.updateLaunchedChainId(ctx, consumerId, k.GetConsumerPhase(ctx, consumerId), phase)
 	store := ctx.KVStore(k.storeKey)
 	phaseBytes := make([]byte, 8)
 	binary.BigEndian.PutUint32(phaseBytes, uint32(phase))
@@ -173,10 +367,183 @@ func (k Keeper) SetConsumerPhase(ctx sdk.Context, consumerId string, phase types
 
 // DeleteConsumerPhase deletes the phase associated with this consumer id
 func (k Keeper) DeleteConsumerPhase(ctx sdk.Context, consumerId string) {
+	k.updateLaunchedChainId(ctx, consumerId, k.GetConsumerPhase(ctx, consumerId), types.CONSUMER_PHASE_UNSPECIFIED)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerIdToPhaseKey(consumerId))
 }
 
+// updateLaunchedChainId updates the index of launched chain ids when the phase of the consumer chain
+// with `consumerId` changes from `prevPhase` to `phase`
+func (k Keeper) updateLaunchedChainId(ctx sdk.Context, consumerId string, prevPhase, phase types.ConsumerPhase) {
+	if prevPhase == phase || (prevPhase != types.CONSUMER_PHASE_LAUNCHED && phase != types.CONSUMER_PHASE_LAUNCHED) {
+		return
+	}
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		// the chain id is indexed once it is set
+		return
+	}
+	if phase == types.CONSUMER_PHASE_LAUNCHED {
+		k.setLaunchedChainId(ctx, chainId, consumerId)
+	} else {
+		k.deleteLaunchedChainId(ctx, chainId, consumerId)
+	}
+}
+
+// GetLaunchedConsumerIdByChainId returns the consumer id of a launched consumer chain with the given chain id,
+// other than the consumer chain with `excludedConsumerId`
+func (k Keeper) GetLaunchedConsumerIdByChainId(ctx sdk.Context, chainId, excludedConsumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LaunchedChainIdToConsumerIdKey(chainId))
+	if bz == nil || string(bz) == excludedConsumerId {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// setLaunchedChainId indexes the launched consumer chain with `consumerId` by its `chainId`,
+// unless another launched consumer chain is already indexed under `chainId`
+func (k Keeper) setLaunchedChainId(ctx sdk.Context, chainId, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.LaunchedChainIdToConsumerIdKey(chainId)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, []byte(consumerId))
+}
+
+// deleteLaunchedChainId removes the consumer chain with `consumerId` from the index of launched chain ids,
+// if it is indexed under `chainId`
+func (k Keeper) deleteLaunchedChainId(ctx sdk.Context, chainId, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.LaunchedChainIdToConsumerIdKey(chainId)
+	if string(store.Get(key)) == consumerId {
+		store.Delete(key)
+	}
+}
+
+// GetConsumerRemovalInitiator returns who removed the consumer chain with this consumer id,
+// or CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED if the chain was not removed with a MsgRemoveConsumer
+func (k Keeper) GetConsumerRemovalInitiator(ctx sdk.Context, consumerId string) types.ConsumerRemovalInitiator {
+	store := ctx.KVStore(k.storeKey)
+	buf := store.Get(types.ConsumerIdToRemovalInitiatorKey(consumerId))
+	if buf == nil {
+		return types.CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED
+	}
+	return types.ConsumerRemovalInitiator(binary.BigEndian.Uint32(buf))
+}
+
+// SetConsumerRemovalInitiator sets who removed the consumer chain with this consumer id
+func (k Keeper) SetConsumerRemovalInitiator(ctx sdk.Context, consumerId string, initiator types.ConsumerRemovalInitiator) {
+	store := ctx.KVStore(k.storeKey)
+	initiatorBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(initiatorBytes, uint32(initiator))
+	store.Set(types.ConsumerIdToRemovalInitiatorKey(consumerId), initiatorBytes)
+}
+
+// GetConsumerCreationRecord returns the record of how the consumer chain with this consumer id was created,
+// and false if no record is stored (e.g., if the chain was created before the record was introduced)
+func (k Keeper) GetConsumerCreationRecord(ctx sdk.Context, consumerId string) (types.ConsumerCreationRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToCreationRecordKey(consumerId))
+	if bz == nil {
+		return types.ConsumerCreationRecord{}, false
+	}
+	var record types.ConsumerCreationRecord
+	if err := record.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetConsumerCreationRecord.
+		panic(fmt.Errorf("failed to unmarshal creation record for consumer id (%s): %w", consumerId, err))
+	}
+	return record, true
+}
+
+// SetConsumerCreationRecord sets the record of how the consumer chain with this consumer id was created
+func (k Keeper) SetConsumerCreationRecord(ctx sdk.Context, consumerId string, record types.ConsumerCreationRecord) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal creation record (%+v) for consumer id (%s): %w", record, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToCreationRecordKey(consumerId), bz)
+	return nil
+}
+
+// GetConsumerRemovalRecord returns the record of why the consumer chain with this consumer id was stopped
+// (or why its launch failed), and false if no record is stored
+func (k Keeper) GetConsumerRemovalRecord(ctx sdk.Context, consumerId string) (types.ConsumerRemovalRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToRemovalRecordKey(consumerId))
+	if bz == nil {
+		return types.ConsumerRemovalRecord{}, false
+	}
+	var record types.ConsumerRemovalRecord
+	if err := record.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetConsumerRemovalRecord.
+		panic(fmt.Errorf("failed to unmarshal removal record for consumer id (%s): %w", consumerId, err))
+	}
+	return record, true
+}
+
+// SetConsumerRemovalRecord sets the record of why the consumer chain with this consumer id was stopped
+func (k Keeper) SetConsumerRemovalRecord(ctx sdk.Context, consumerId string, record types.ConsumerRemovalRecord) error {
+	if record.Reason == types.CONSUMER_REMOVAL_REASON_UNSPECIFIED {
+		return fmt.Errorf("cannot set removal record for consumer id (%s) without a reason", consumerId)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal removal record (%+v) for consumer id (%s): %w", record, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToRemovalRecordKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerRemovalRecord deletes the record of why the consumer chain with this consumer id was stopped
+func (k Keeper) DeleteConsumerRemovalRecord(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToRemovalRecordKey(consumerId))
+}
+
+// ValidateProposalInExecution returns an error if the governance proposal with `proposalId` is not the proposal
+// whose messages are being executed or if it does not contain `msg`. Note that the gov module executes the messages
+// of a passed proposal before it updates the status of the proposal, i.e., while the proposal is still in its
+// voting period, which already ended.
+func (k Keeper) ValidateProposalInExecution(ctx sdk.Context, proposalId uint64, msg *types.MsgCreateConsumer) error {
+	proposal, err := k.govKeeper.Proposals.Get(ctx, proposalId)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidProposalId, "cannot retrieve proposal %d: %s", proposalId, err.Error())
+	}
+	if proposal.Status != govv1.StatusVotingPeriod || proposal.VotingEndTime == nil ||
+		proposal.VotingEndTime.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidProposalId, "proposal %d is not being executed", proposalId)
+	}
+
+	msgBz, err := msg.Marshal()
+	if err != nil {
+		return err
+	}
+	proposalMsgs, err := proposal.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidProposalId, "cannot retrieve messages of proposal %d: %s", proposalId, err.Error())
+	}
+	for _, proposalMsg := range proposalMsgs {
+		createConsumerMsg, ok := proposalMsg.(*types.MsgCreateConsumer)
+		if !ok {
+			continue
+		}
+		bz, err := createConsumerMsg.Marshal()
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(bz, msgBz) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrInvalidProposalId, "proposal %d does not contain the message", proposalId)
+}
+
 // IsConsumerActive checks if a consumer chain is either registered, initialized, or launched.
 func (k Keeper) IsConsumerActive(ctx sdk.Context, consumerId string) bool {
 	phase := k.GetConsumerPhase(ctx, consumerId)
@@ -184,3 +551,21 @@ func (k Keeper) IsConsumerActive(ctx sdk.Context, consumerId string) bool {
 		phase == types.CONSUMER_PHASE_INITIALIZED ||
 		phase == types.CONSUMER_PHASE_LAUNCHED
 }
+
+// SetConsumerVerified marks the consumer chain with this consumer id as verified by governance
+func (k Keeper) SetConsumerVerified(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VerifiedConsumerKey(consumerId), []byte{})
+}
+
+// IsConsumerVerified checks if the consumer chain with this consumer id is verified by governance
+func (k Keeper) IsConsumerVerified(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.VerifiedConsumerKey(consumerId))
+}
+
+// DeleteConsumerVerified removes the verified mark of the consumer chain with this consumer id
+func (k Keeper) DeleteConsumerVerified(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VerifiedConsumerKey(consumerId))
+}
@@ -87,9 +87,17 @@ func TestConsumerOwnerAddress(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "owner address2", ownerAddress)
 
+	// assert that the consumer ids are indexed by their current owner address
+	require.Equal(t, []string{"consumerId2"}, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner address"))
+	require.Equal(t, []string{CONSUMER_ID}, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner address2"))
+	// the owner address is not a prefix match
+	require.Empty(t, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner"))
+
 	providerKeeper.DeleteConsumerOwnerAddress(ctx, CONSUMER_ID)
 	_, err = providerKeeper.GetConsumerChainId(ctx, CONSUMER_ID)
 	require.Error(t, err, "failed to retrieve owner address")
+	require.Empty(t, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner address2"))
+	require.Equal(t, []string{"consumerId2"}, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner address"))
 }
 
 // TestConsumerMetadata tests the getter, setter, and deletion of the consumer id to consumer metadata methods
@@ -129,6 +137,53 @@ func TestConsumerMetadata(t *testing.T) {
 	require.Equal(t, providertypes.ConsumerMetadata{}, actualMetadata)
 }
 
+// TestConsumerEndpointInfo tests the getter, setter, update, and deletion of the consumer id to endpoint info methods
+func TestConsumerEndpointInfo(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.GetConsumerEndpointInfo(ctx, CONSUMER_ID)
+	require.Error(t, err)
+
+	expectedEndpointInfo := providertypes.EndpointInfo{
+		PersistentPeers: []string{"e5f9ab0a1bc2a0f4e4e5e9b1c7c8d1a2b3c4d5e6@peer.consumer.io:26656"},
+		RpcUrls:         []string{"https://rpc.consumer.io:443"},
+	}
+	err = providerKeeper.SetConsumerEndpointInfo(ctx, CONSUMER_ID, expectedEndpointInfo)
+	require.NoError(t, err)
+	actualEndpointInfo, err := providerKeeper.GetConsumerEndpointInfo(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, expectedEndpointInfo, actualEndpointInfo)
+
+	// updating with the same endpoints is a no-op
+	updated, err := providerKeeper.UpdateConsumerEndpointInfo(ctx, CONSUMER_ID, expectedEndpointInfo)
+	require.NoError(t, err)
+	require.False(t, updated)
+
+	// updating with different endpoints overwrites the endpoints
+	expectedEndpointInfo = providertypes.EndpointInfo{GenesisUrl: "https://consumer.io/genesis.json"}
+	updated, err = providerKeeper.UpdateConsumerEndpointInfo(ctx, CONSUMER_ID, expectedEndpointInfo)
+	require.NoError(t, err)
+	require.True(t, updated)
+	actualEndpointInfo, err = providerKeeper.GetConsumerEndpointInfo(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, expectedEndpointInfo, actualEndpointInfo)
+
+	// updating with empty endpoints removes the endpoints
+	updated, err = providerKeeper.UpdateConsumerEndpointInfo(ctx, CONSUMER_ID, providertypes.EndpointInfo{})
+	require.NoError(t, err)
+	require.True(t, updated)
+	_, err = providerKeeper.GetConsumerEndpointInfo(ctx, CONSUMER_ID)
+	require.Error(t, err)
+
+	err = providerKeeper.SetConsumerEndpointInfo(ctx, CONSUMER_ID, expectedEndpointInfo)
+	require.NoError(t, err)
+	providerKeeper.DeleteConsumerEndpointInfo(ctx, CONSUMER_ID)
+	actualEndpointInfo, err = providerKeeper.GetConsumerEndpointInfo(ctx, CONSUMER_ID)
+	require.Error(t, err)
+	require.Equal(t, providertypes.EndpointInfo{}, actualEndpointInfo)
+}
+
 // TestConsumerInitializationParameters tests the getter, setter, and deletion of the consumer id to initialization parameters methods
 func TestConsumerInitializationParameters(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -198,3 +253,46 @@ func TestConsumerPhase(t *testing.T) {
 	phase = providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID)
 	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, phase)
 }
+
+// TestGetLaunchedConsumerIdByChainId tests that the launched consumer chains are indexed by their chain id
+func TestGetLaunchedConsumerIdByChainId(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain0")
+	providerKeeper.SetConsumerChainId(ctx, "1", "chain0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_INITIALIZED)
+	_, found := providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.False(t, found)
+
+	// the chain id is indexed once the consumer chain is launched
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	consumerId, found := providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "1")
+	require.True(t, found)
+	require.Equal(t, "0", consumerId)
+	_, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "0")
+	require.False(t, found)
+
+	// another launched consumer chain with the same chain id does not replace the indexed one
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_LAUNCHED)
+	consumerId, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.True(t, found)
+	require.Equal(t, "0", consumerId)
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_STOPPED)
+	consumerId, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.True(t, found)
+	require.Equal(t, "0", consumerId)
+
+	// the index follows the chain id of a launched consumer chain
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain0-1")
+	_, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.False(t, found)
+	consumerId, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0-1", "")
+	require.True(t, found)
+	require.Equal(t, "0", consumerId)
+
+	// the chain id is no longer indexed once the consumer chain is stopped
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_STOPPED)
+	_, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0-1", "")
+	require.False(t, found)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -19,7 +20,18 @@ import (
 
 // ComputeMinPowerInTopN returns the minimum power needed for a validator (from the bonded validators)
 // to belong to the `topN`% of validators for a Top N chain.
+// It returns an ErrEmptyActiveValidatorSet error if the bonded validators have no power (e.g., there are no bonded validators).
 func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakingtypes.Validator, topN uint32) (int64, error) {
+	validators, err := k.toBondedValidators(ctx, bondedValidators)
+	if err != nil {
+		return 0, err
+	}
+	return computeMinPowerInTopN(validators, topN)
+}
+
+// computeMinPowerInTopN returns the minimum power needed for a validator (from the bonded validators)
+// to belong to the `topN`% of validators for a Top N chain (see ComputeMinPowerInTopN)
+func computeMinPowerInTopN(bondedValidators []BondedValidator, topN uint32) (int64, error) {
 	if topN == 0 || topN > 100 {
 		// Note that Top N chains have a lower limit on `topN`, namely that topN cannot be less than 50.
 		// However, we can envision that this method could be used for other (future) reasons where this might not
@@ -29,19 +41,16 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 	}
 
 	totalPower := math.LegacyZeroDec()
-	var powers []int64
+	powers := make([]int64, 0, len(bondedValidators))
 
 	for _, val := range bondedValidators {
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
-		if err != nil {
-			return 0, err
-		}
-		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
-		if err != nil {
-			return 0, err
-		}
-		powers = append(powers, power)
-		totalPower = totalPower.Add(math.LegacyNewDec(power))
+		powers = append(powers, val.TopNPower)
+		totalPower = totalPower.Add(math.LegacyNewDec(val.TopNPower))
+	}
+
+	if totalPower.IsZero() {
+		return 0, errorsmod.Wrapf(types.ErrEmptyActiveValidatorSet,
+			"cannot compute minimum power for topN (%d) with %d validators", topN, len(bondedValidators))
 	}
 
 	// sort by powers descending
@@ -88,29 +97,56 @@ func (k Keeper) UpdateMinimumPowerInTopN(ctx sdk.Context, consumerId string, old
 }
 
 // CapValidatorSet caps the provided `validators` if chain with `consumerId` is an Opt In chain with a validator-set cap.
-// If cap is `k`, `CapValidatorSet` returns the first `k` validators from `validators` with the highest power.
+// If cap is `k`, `CapValidatorSet` returns the first `k` validators from `validators` with the highest power, as well as
+// the validators that are left out due to the cap. The eviction rule is deterministic: the validators are sorted by
+// power in descending order with ties broken by their provider consensus addresses in ascending byte order, i.e.,
+// the validators with the lowest power (and the largest addresses among validators with equal power) are left out first.
 func (k Keeper) CapValidatorSet(
 	ctx sdk.Context,
 	powerShapingParameters types.PowerShapingParameters,
 	validators []types.ConsensusValidator,
-) []types.ConsensusValidator {
+) (cappedValidators, evictedValidators []types.ConsensusValidator) {
 	if powerShapingParameters.Top_N > 0 {
 		// is a no-op if the chain is a Top N chain
-		return validators
+		return validators, []types.ConsensusValidator{}
 	}
 
 	validatorSetCap := powerShapingParameters.ValidatorSetCap
 	if validatorSetCap != 0 && int(validatorSetCap) < len(validators) {
 		sort.Slice(validators, func(i, j int) bool {
-			return validators[i].Power > validators[j].Power
+			if validators[i].Power != validators[j].Power {
+				return validators[i].Power > validators[j].Power
+			}
+			return bytes.Compare(validators[i].ProviderConsAddr, validators[j].ProviderConsAddr) < 0
 		})
 
-		return validators[:int(validatorSetCap)]
+		return validators[:int(validatorSetCap)], validators[int(validatorSetCap):]
 	} else {
-		return validators
+		return validators, []types.ConsensusValidator{}
 	}
 }
 
+// FilterEvictedValidators returns the validators from `evictedValidators` (i.e., left out due to the validator-set cap)
+// that belong to `currentValidators`, i.e., the validators that are removed from the current consumer validator set
+// due to the validator-set cap
+func FilterEvictedValidators(
+	currentValidators []types.ConsensusValidator,
+	evictedValidators []types.ConsensusValidator,
+) []types.ConsensusValidator {
+	isCurrentValidator := map[string]bool{}
+	for _, val := range currentValidators {
+		isCurrentValidator[string(val.ProviderConsAddr)] = true
+	}
+
+	filtered := []types.ConsensusValidator{}
+	for _, val := range evictedValidators {
+		if isCurrentValidator[string(val.ProviderConsAddr)] {
+			filtered = append(filtered, val)
+		}
+	}
+	return filtered
+}
+
 // CapValidatorsPower caps the power of the validators on chain with `consumerId` and returns an updated slice of validators
 // with their new powers. Works on a best-basis effort because there are cases where we cannot guarantee that all validators
 // on the consumer chain have less power than the set validators-power cap. For example, if we have 10 validators and
@@ -250,13 +286,18 @@ func (k Keeper) CanValidateChain(
 	}
 
 	// only consider opted-in validators
-	return optedIn &&
-		// if an allowlist is declared, only consider allowlisted validators
-		(k.IsAllowlistEmpty(ctx, consumerId) ||
-			k.IsAllowlisted(ctx, consumerId, providerAddr)) &&
+	return optedIn && k.isAllowedByLists(ctx, consumerId, providerAddr), nil
+}
+
+// isAllowedByLists returns true if the allowlist and denylist of the chain with `consumerId`
+// do not prevent the validator `providerAddr` from validating the chain
+func (k Keeper) isAllowedByLists(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) bool {
+	// if an allowlist is declared, only consider allowlisted validators
+	return (k.IsAllowlistEmpty(ctx, consumerId) ||
+		k.IsAllowlisted(ctx, consumerId, providerAddr)) &&
 		// if a denylist is declared, only consider denylisted validators
 		(k.IsDenylistEmpty(ctx, consumerId) ||
-			!k.IsDenylisted(ctx, consumerId, providerAddr)), nil
+			!k.IsDenylisted(ctx, consumerId, providerAddr))
 }
 
 // FulfillsMinStake returns true if the validator `providerAddr` has enough stake to validate chain with `consumerId`
@@ -275,11 +316,85 @@ func (k Keeper) FulfillsMinStake(
 		return false, err
 	}
 
+	return fulfillsMinStake(minStake, validator), nil
+}
+
+// fulfillsMinStake returns true if `validator` has at least `minStake` staked tokens
+func fulfillsMinStake(minStake uint64, validator stakingtypes.Validator) bool {
 	// validator has enough stake to validate the chain
-	return validator.GetBondedTokens().GTE(math.NewIntFromUint64(minStake)), nil
+	return minStake == 0 || validator.GetBondedTokens().GTE(math.NewIntFromUint64(minStake))
 }
 
-// HasMinPower returns true if the `providerAddr` voting power is GTE than the given minimum power
+// FulfillsMaxProviderRank returns true if the validator `providerAddr` is ranked within the top `maxProviderRank`
+// validators of the last bonded validators on the provider chain.
+func (k Keeper) FulfillsMaxProviderRank(
+	ctx sdk.Context,
+	maxProviderRank uint32,
+	providerAddr types.ProviderConsAddress,
+) (bool, error) {
+	if maxProviderRank == 0 {
+		return true, nil
+	}
+
+	bondedValidators, err := k.GetLastBondedValidators(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	validatorsWithinRank, err := ComputeValidatorsWithinMaxProviderRank(bondedValidators, maxProviderRank)
+	if err != nil {
+		return false, err
+	}
+
+	return validatorsWithinRank[providerAddr.String()], nil
+}
+
+// ComputeValidatorsWithinMaxProviderRank returns the provider consensus addresses of the validators
+// ranked within the top `maxProviderRank` of `bondedValidators` by bonded tokens.
+// Ties between validators with the same bonded tokens are broken by consensus address, so that
+// the result is deterministic. If `maxProviderRank` is 0, all the `bondedValidators` are returned.
+func ComputeValidatorsWithinMaxProviderRank(
+	bondedValidators []stakingtypes.Validator,
+	maxProviderRank uint32,
+) (map[string]bool, error) {
+	type rankedValidator struct {
+		providerAddr types.ProviderConsAddress
+		tokens       math.Int
+	}
+
+	rankedValidators := make([]rankedValidator, 0, len(bondedValidators))
+	for _, val := range bondedValidators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		rankedValidators = append(rankedValidators, rankedValidator{
+			providerAddr: types.NewProviderConsAddress(consAddr),
+			tokens:       val.GetBondedTokens(),
+		})
+	}
+
+	sort.Slice(rankedValidators, func(i, j int) bool {
+		if !rankedValidators[i].tokens.Equal(rankedValidators[j].tokens) {
+			return rankedValidators[i].tokens.GT(rankedValidators[j].tokens)
+		}
+		return bytes.Compare(rankedValidators[i].providerAddr.ToSdkConsAddr(), rankedValidators[j].providerAddr.ToSdkConsAddr()) < 0
+	})
+
+	if maxProviderRank != 0 && int(maxProviderRank) < len(rankedValidators) {
+		rankedValidators = rankedValidators[:maxProviderRank]
+	}
+
+	validatorsWithinRank := make(map[string]bool, len(rankedValidators))
+	for _, val := range rankedValidators {
+		validatorsWithinRank[val.providerAddr.String()] = true
+	}
+
+	return validatorsWithinRank, nil
+}
+
+// HasMinPower returns true if the `providerAddr` voting power that counts towards the Top N of the consumer chains
+// (see GetTopNPower) is GTE than the given minimum power
 func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddress, minPower int64) (bool, error) {
 	val, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.Address)
 	if err != nil {
@@ -295,6 +410,10 @@ func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddr
 	if err != nil {
 		return false, err
 	}
+	power, err = k.GetTopNPower(ctx, valAddr, power)
+	if err != nil {
+		return false, err
+	}
 
 	return power >= minPower, nil
 }
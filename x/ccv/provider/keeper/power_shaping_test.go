@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	gomath "math"
+	"slices"
 	"sort"
 	"testing"
 
@@ -172,6 +173,57 @@ func TestComputeMinPowerInTopN(t *testing.T) {
 
 	_, err = providerKeeper.ComputeMinPowerInTopN(ctx, bondedValidators, 101)
 	require.Error(t, err)
+
+	// no bonded validators or bonded validators without power
+	_, err = providerKeeper.ComputeMinPowerInTopN(ctx, []stakingtypes.Validator{}, 50)
+	require.ErrorIs(t, err, providertypes.ErrEmptyActiveValidatorSet)
+
+	_, err = providerKeeper.ComputeMinPowerInTopN(ctx, []stakingtypes.Validator{createStakingValidator(ctx, mocks, 0, 6)}, 50)
+	require.ErrorIs(t, err, providertypes.ErrEmptyActiveValidatorSet)
+}
+
+// TestComputeMinPowerInTopNWithTokenizedShares checks that the power of the tokenized shares of the validators
+// is excluded from the computation of the minimum power in the Top N if ExcludeTokenizedSharesFromTopN is enabled
+func TestComputeMinPowerInTopNWithTokenizedShares(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// create 5 validators with powers 10, 6, 5, 3, 1 with total power of 25
+	bondedValidators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 10, 6, 5, 3, 1)
+
+	// the tokenized shares are not retrieved if ExcludeTokenizedSharesFromTopN is disabled,
+	// i.e., 10 => 40% and 6 => 64% of the total power
+	m, err := providerKeeper.ComputeMinPowerInTopN(ctx, bondedValidators, 50)
+	require.NoError(t, err)
+	require.Equal(t, int64(6), m)
+
+	// 8 of the 10 power of the first validator and 2 of the 1 power of the last validator are tokenized
+	tokenizedPowers := []int64{8, 0, 0, 0, 2}
+	for i, val := range bondedValidators {
+		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		require.NoError(t, err)
+		mocks.MockTokenizedSharesKeeper.EXPECT().GetValidatorTokenizedShareTokens(ctx, valAddr).
+			Return(sdk.TokensFromConsensusPower(tokenizedPowers[i], sdk.DefaultPowerReduction), nil).AnyTimes()
+	}
+
+	params := providerKeeper.GetParams(ctx)
+	params.ExcludeTokenizedSharesFromTopN = true
+	providerKeeper.SetParams(ctx, params)
+
+	// the validators have Top N powers 2, 6, 5, 3, 0 with total power of 16,
+	// i.e., 6 => 37.5%, 5 => 68.75%, 3 => 87.5%, and 2 => 100%
+	m, err = providerKeeper.ComputeMinPowerInTopN(ctx, bondedValidators, 50)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), m)
+
+	m, err = providerKeeper.ComputeMinPowerInTopN(ctx, bondedValidators, 95)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), m)
+
+	// the validators with tokenized shares have to validate based on their Top N power
+	hasMinPower, err := providerKeeper.HasMinPower(ctx, consAddrs[0], 5)
+	require.NoError(t, err)
+	require.False(t, hasMinPower)
 }
 
 // TestCanValidateChain returns true if `validator` is opted in, in `consumerId.
@@ -271,8 +323,9 @@ func TestCapValidatorSet(t *testing.T) {
 
 	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.Error(t, err)
-	consumerValidators := providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators := providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, validators, consumerValidators)
+	require.Empty(t, evictedValidators)
 
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
 		ValidatorSetCap: 0,
@@ -280,8 +333,9 @@ func TestCapValidatorSet(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, validators, consumerValidators)
+	require.Empty(t, evictedValidators)
 
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
 		ValidatorSetCap: 100,
@@ -289,8 +343,9 @@ func TestCapValidatorSet(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, validators, consumerValidators)
+	require.Empty(t, evictedValidators)
 
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
 		ValidatorSetCap: 1,
@@ -298,8 +353,9 @@ func TestCapValidatorSet(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, []providertypes.ConsensusValidator{validatorC}, consumerValidators)
+	require.Equal(t, []providertypes.ConsensusValidator{validatorB, validatorA}, evictedValidators)
 
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
 		ValidatorSetCap: 2,
@@ -307,8 +363,9 @@ func TestCapValidatorSet(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, []providertypes.ConsensusValidator{validatorC, validatorB}, consumerValidators)
+	require.Equal(t, []providertypes.ConsensusValidator{validatorA}, evictedValidators)
 
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
 		ValidatorSetCap: 3,
@@ -316,8 +373,79 @@ func TestCapValidatorSet(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
 	require.NoError(t, err)
-	consumerValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
 	require.Equal(t, []providertypes.ConsensusValidator{validatorC, validatorB, validatorA}, consumerValidators)
+	require.Empty(t, evictedValidators)
+
+	// validators with equal power are evicted by their provider consensus addresses in descending byte order
+	validatorD := providertypes.ConsensusValidator{
+		ProviderConsAddr: []byte("providerConsAddrD"),
+		Power:            2,
+		PublicKey:        &crypto.PublicKey{},
+	}
+	validators = []providertypes.ConsensusValidator{validatorD, validatorA, validatorB, validatorC}
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{
+		ValidatorSetCap: 2,
+	})
+	require.NoError(t, err)
+	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	consumerValidators, evictedValidators = providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+	require.Equal(t, []providertypes.ConsensusValidator{validatorC, validatorB}, consumerValidators)
+	require.Equal(t, []providertypes.ConsensusValidator{validatorD, validatorA}, evictedValidators)
+}
+
+// TestCapValidatorSetProps checks that the eviction due to the validator-set cap is deterministic and that
+// no validator with strictly higher power than any validator that is kept is ever evicted
+func TestCapValidatorSetProps(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	rapid.Check(t, func(r *rapid.T) {
+		powers := rapid.SliceOfN(rapid.Int64Range(1, 10), 1, 50).Draw(r, "powers")
+		validatorSetCap := rapid.Uint32Range(0, 60).Draw(r, "validatorSetCap")
+		powerShapingParameters := providertypes.PowerShapingParameters{ValidatorSetCap: validatorSetCap}
+
+		validators := []providertypes.ConsensusValidator{}
+		for i, power := range powers {
+			validators = append(validators, providertypes.ConsensusValidator{
+				ProviderConsAddr: []byte(fmt.Sprintf("providerConsAddr%d", i)),
+				Power:            power,
+				PublicKey:        &crypto.PublicKey{},
+			})
+		}
+		// shuffle the validators to check that the result does not depend on the input order
+		shuffled := slices.Clone(validators)
+		for i := len(shuffled) - 1; i > 0; i-- {
+			j := rapid.IntRange(0, i).Draw(r, "j")
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		}
+
+		keptValidators, evictedValidators := providerKeeper.CapValidatorSet(ctx, powerShapingParameters, validators)
+		shuffledKeptValidators, shuffledEvictedValidators := providerKeeper.CapValidatorSet(ctx, powerShapingParameters, shuffled)
+
+		require.Equal(r, len(powers), len(keptValidators)+len(evictedValidators))
+		if validatorSetCap == 0 || int(validatorSetCap) >= len(powers) {
+			require.Empty(r, evictedValidators)
+		} else {
+			require.Len(r, keptValidators, int(validatorSetCap))
+		}
+
+		// the eviction is deterministic
+		require.ElementsMatch(r, keptValidators, shuffledKeptValidators)
+		require.ElementsMatch(r, evictedValidators, shuffledEvictedValidators)
+
+		for _, evicted := range evictedValidators {
+			for _, kept := range keptValidators {
+				// no evicted validator has strictly higher power than any kept validator
+				require.LessOrEqual(r, evicted.Power, kept.Power)
+				// ties are broken by the provider consensus addresses
+				if evicted.Power == kept.Power {
+					require.Equal(r, 1, bytes.Compare(evicted.ProviderConsAddr, kept.ProviderConsAddr))
+				}
+			}
+		}
+	})
 }
 
 func TestCapValidatorsPower(t *testing.T) {
@@ -601,6 +729,7 @@ func createStakingValidatorsAndMocks(ctx sdk.Context, mocks testkeeper.MockedKee
 	for index, val := range validators {
 		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consAddrs[index].Address).Return(val, nil).AnyTimes()
 	}
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
 
 	return validators, consAddrs
 }
@@ -647,6 +776,194 @@ func TestFulfillsMinStake(t *testing.T) {
 	}
 }
 
+// TestComputeValidatorsWithinMaxProviderRank checks that ComputeValidatorsWithinMaxProviderRank returns
+// the validators ranked within the max provider rank and that ties are broken by consensus address
+func TestComputeValidatorsWithinMaxProviderRank(t *testing.T) {
+	_, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// create four validators, where the last two have the same bonded tokens
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 40, 30, 20, 20)
+
+	// find which of the two validators with the same bonded tokens wins the tie
+	tieWinner, tieLoser := consAddrs[2], consAddrs[3]
+	if bytes.Compare(tieWinner.ToSdkConsAddr(), tieLoser.ToSdkConsAddr()) > 0 {
+		tieWinner, tieLoser = tieLoser, tieWinner
+	}
+
+	testCases := []struct {
+		name            string
+		maxProviderRank uint32
+		expected        []providertypes.ProviderConsAddress
+	}{
+		{
+			name:            "no max provider rank",
+			maxProviderRank: 0,
+			expected:        consAddrs,
+		},
+		{
+			name:            "max provider rank of 1",
+			maxProviderRank: 1,
+			expected:        []providertypes.ProviderConsAddress{consAddrs[0]},
+		},
+		{
+			name:            "max provider rank of 3 breaks the tie by consensus address",
+			maxProviderRank: 3,
+			expected:        []providertypes.ProviderConsAddress{consAddrs[0], consAddrs[1], tieWinner},
+		},
+		{
+			name:            "max provider rank larger than the number of validators",
+			maxProviderRank: 10,
+			expected:        consAddrs,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the result does not depend on the order of the bonded validators
+			reversedVals := []stakingtypes.Validator{vals[3], vals[2], vals[1], vals[0]}
+			for _, bondedVals := range [][]stakingtypes.Validator{vals, reversedVals} {
+				validatorsWithinRank, err := keeper.ComputeValidatorsWithinMaxProviderRank(bondedVals, tc.maxProviderRank)
+				require.NoError(t, err)
+				require.Len(t, validatorsWithinRank, len(tc.expected))
+				for _, consAddr := range tc.expected {
+					require.True(t, validatorsWithinRank[consAddr.String()])
+				}
+				if tc.maxProviderRank == 3 {
+					require.False(t, validatorsWithinRank[tieLoser.String()])
+				}
+			}
+		})
+	}
+}
+
+// TestComputeNextValidatorsWithMaxProviderRank checks that opted-in validators whose rank
+// falls below the max provider rank between epochs are dropped from the next validator set
+func TestComputeNextValidatorsWithMaxProviderRank(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+
+	powerShapingParameters := providertypes.PowerShapingParameters{
+		MaxProviderRank:   2,
+		AllowInactiveVals: true,
+	}
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters))
+
+	nextValsAddrs := func(bondedVals []stakingtypes.Validator) []providertypes.ProviderConsAddress {
+		nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, bondedVals, powerShapingParameters, 0)
+		require.NoError(t, err)
+		addrs := []providertypes.ProviderConsAddress{}
+		for _, val := range nextVals {
+			addrs = append(addrs, providertypes.NewProviderConsAddress(val.ProviderConsAddr))
+		}
+		return addrs
+	}
+
+	// first epoch: only the two validators with the most bonded tokens are in the next validator set
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{consAddrs[0], consAddrs[1]}, nextValsAddrs(vals))
+
+	// second epoch: the third validator receives more delegations and the second validator
+	// falls below the max provider rank
+	vals[2].Tokens = math.NewInt(40)
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{consAddrs[0], consAddrs[2]}, nextValsAddrs(vals))
+
+	// without a max provider rank, all the opted-in validators are in the next validator set
+	powerShapingParameters.MaxProviderRank = 0
+	require.ElementsMatch(t, consAddrs, nextValsAddrs(vals))
+}
+
+// TestComputeNextValidatorsWithUnavailableValidators checks that opted-in validators that marked themselves
+// as unavailable are dropped from the next validator set until they are available again, unless they have to
+// validate a Top N chain
+func TestComputeNextValidatorsWithUnavailableValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+
+	powerShapingParameters := providertypes.PowerShapingParameters{AllowInactiveVals: true}
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters))
+
+	nextValsAddrs := func(minPowerToOptIn int64) []providertypes.ProviderConsAddress {
+		nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, minPowerToOptIn)
+		require.NoError(t, err)
+		addrs := []providertypes.ProviderConsAddress{}
+		for _, val := range nextVals {
+			addrs = append(addrs, providertypes.NewProviderConsAddress(val.ProviderConsAddr))
+		}
+		return addrs
+	}
+
+	// first epoch: all the opted-in validators are in the next validator set
+	require.ElementsMatch(t, consAddrs, nextValsAddrs(0))
+
+	// second epoch: the third validator is unavailable and hence not in the next validator set, while remaining opted in
+	providerKeeper.SetValidatorUnavailable(ctx, CONSUMER_ID, consAddrs[2])
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{consAddrs[0], consAddrs[1]}, nextValsAddrs(0))
+	require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, consAddrs[2]))
+
+	// third epoch: the third validator is available again
+	providerKeeper.DeleteValidatorUnavailable(ctx, CONSUMER_ID, consAddrs[2])
+	require.ElementsMatch(t, consAddrs, nextValsAddrs(0))
+
+	// on a Top N chain, unavailable validators that have to validate remain in the next validator set
+	powerShapingParameters.Top_N = 50
+	providerKeeper.SetValidatorUnavailable(ctx, CONSUMER_ID, consAddrs[0])
+	providerKeeper.SetValidatorUnavailable(ctx, CONSUMER_ID, consAddrs[2])
+	require.ElementsMatch(t, []providertypes.ProviderConsAddress{consAddrs[0], consAddrs[1]}, nextValsAddrs(30))
+}
+
+// TestComputeNextValidatorsWithTokenizedShares checks that the validators are automatically opted in to a Top N chain
+// based on their power without the power of their tokenized shares if ExcludeTokenizedSharesFromTopN is enabled,
+// while their power on the consumer chain is unchanged
+func TestComputeNextValidatorsWithTokenizedShares(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 30, 20, 10)
+	powerShapingParameters := providertypes.PowerShapingParameters{Top_N: 50, AllowInactiveVals: true}
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters))
+
+	nextVals := func() []providertypes.ConsensusValidator {
+		minPowerToOptIn, err := providerKeeper.ComputeMinPowerInTopN(ctx, vals, powerShapingParameters.Top_N)
+		require.NoError(t, err)
+		nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, minPowerToOptIn)
+		require.NoError(t, err)
+		return nextVals
+	}
+
+	// without excluding the tokenized shares, only the first validator has to validate
+	validators := nextVals()
+	require.Len(t, validators, 1)
+	require.Equal(t, consAddrs[0].ToSdkConsAddr().Bytes(), validators[0].ProviderConsAddr)
+
+	// 25 of the 30 power of the first validator are tokenized
+	for i, tokenizedPower := range []int64{25, 0, 0} {
+		valAddr, err := sdk.ValAddressFromBech32(vals[i].GetOperator())
+		require.NoError(t, err)
+		mocks.MockTokenizedSharesKeeper.EXPECT().GetValidatorTokenizedShareTokens(ctx, valAddr).
+			Return(sdk.TokensFromConsensusPower(tokenizedPower, sdk.DefaultPowerReduction), nil).AnyTimes()
+	}
+	params := providerKeeper.GetParams(ctx)
+	params.ExcludeTokenizedSharesFromTopN = true
+	providerKeeper.SetParams(ctx, params)
+
+	// the validators have Top N powers 5, 20, and 10, and hence only the second validator has to validate,
+	// with its full power on the consumer chain
+	validators = nextVals()
+	require.Len(t, validators, 1)
+	require.Equal(t, consAddrs[1].ToSdkConsAddr().Bytes(), validators[0].ProviderConsAddr)
+	require.Equal(t, int64(20), validators[0].Power)
+}
+
 // TestIfInactiveValsDisallowedProperty checks that the number of validators in the next validator set is at most
 // the MaxProviderConsensusValidators parameter if the consumer chain does not allow inactive validators to validate.
 func TestIfInactiveValsDisallowedProperty(t *testing.T) {
@@ -683,7 +1000,7 @@ func TestIfInactiveValsDisallowedProperty(t *testing.T) {
 		require.NoError(t, err)
 
 		// Compute the next validators
-		nextVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+		nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
 		require.NoError(t, err)
 
 		// Check that the length of nextVals is at most maxProviderConsensusVals
@@ -745,6 +1062,7 @@ func TestConsumerPowerShapingParameters(t *testing.T) {
 		Denylist:           []string{consAddrs[2], consAddrs[3]},
 		MinStake:           234,
 		AllowInactiveVals:  true,
+		MaxProviderRank:    5,
 	}
 	expectedAllowlist := []providertypes.ProviderConsAddress{providerConsAddr[0], providerConsAddr[1]}
 	sortProviderConsAddr(expectedAllowlist)
@@ -767,6 +1085,7 @@ func TestConsumerPowerShapingParameters(t *testing.T) {
 		Denylist:           []string{consAddrs[2], consAddrs[3]},
 		MinStake:           567,
 		AllowInactiveVals:  false,
+		MaxProviderRank:    0,
 	}
 	expectedAllowlist = []providertypes.ProviderConsAddress{providerConsAddr[4], providerConsAddr[5]}
 	sortProviderConsAddr(expectedAllowlist)
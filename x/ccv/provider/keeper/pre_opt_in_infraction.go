@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// SetOptInHeight sets the provider block height at which the opt-in of the validator with `providerAddr`
+// to the consumer chain with `consumerId` became effective, i.e., the first provider block height in which
+// the validator is part of the validator set of the consumer chain
+func (k Keeper) SetOptInHeight(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OptInHeightKey(consumerId, providerAddr), sdk.Uint64ToBigEndian(height))
+}
+
+// GetOptInHeight returns the provider block height at which the opt-in of the validator with `providerAddr`
+// to the consumer chain with `consumerId` became effective, and false if no opt-in height is recorded
+// (e.g., for the validators that joined the consumer validator set before the opt-in heights were recorded)
+func (k Keeper) GetOptInHeight(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OptInHeightKey(consumerId, providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteOptInHeight deletes the provider block height at which the opt-in of the validator with `providerAddr`
+// to the consumer chain with `consumerId` became effective
+func (k Keeper) DeleteOptInHeight(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OptInHeightKey(consumerId, providerAddr))
+}
+
+// recordOptInHeights records the opt-in heights of the validators that join the validator set of the consumer chain
+// with `consumerId`, i.e., that are part of `nextValidators`, but not of `currentValidators`. The opt-in becomes effective
+// at the next block height, as this is the provider height the valset update id of the next VSC packet is mapped to.
+// The opt-in heights of validators that already have one recorded (e.g., validators that stayed opted in while being
+// temporarily excluded from the consumer validator set) are not updated.
+func (k Keeper) recordOptInHeights(
+	ctx sdk.Context,
+	consumerId string,
+	currentValidators []types.ConsensusValidator,
+	nextValidators []types.ConsensusValidator,
+) {
+	isCurrentValidator := make(map[string]bool, len(currentValidators))
+	for _, val := range currentValidators {
+		isCurrentValidator[string(val.ProviderConsAddr)] = true
+	}
+
+	for _, val := range nextValidators {
+		if isCurrentValidator[string(val.ProviderConsAddr)] {
+			continue
+		}
+		providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+		if _, found := k.GetOptInHeight(ctx, consumerId, providerAddr); !found {
+			k.SetOptInHeight(ctx, consumerId, providerAddr, uint64(ctx.BlockHeight())+1)
+		}
+	}
+}
+
+// IsPreOptInInfraction returns true if the infraction at provider height `infractionHeight` was committed before the
+// opt-in of the validator with `providerAddr` to the consumer chain with `consumerId` became effective, together with
+// the opt-in height of the validator. Validators without a recorded opt-in height never committed pre-opt-in infractions.
+//
+// Note that `providerAddr` must be mapped from the consumer address of the infraction, i.e., the consumer key of the validator
+// at the valset update id of the infraction. As the assigned consumer keys are only pruned once the VSC packets sent after
+// they were replaced matured, this address is still mapped to the validator, even if it assigned a new consumer key since.
+func (k Keeper) IsPreOptInInfraction(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	infractionHeight uint64,
+) (uint64, bool) {
+	optInHeight, found := k.GetOptInHeight(ctx, consumerId, providerAddr)
+	if !found {
+		return 0, false
+	}
+	return optInHeight, infractionHeight < optInHeight
+}
+
+// RecordPreOptInInfraction emits an event for a downtime infraction of the validator with `providerAddr` that is dropped,
+// since it was committed before the opt-in of the validator to the consumer chain with `consumerId` became effective,
+// and appends it to the pre-opt-in infractions of the consumer chain.
+//
+// Note that the pre-opt-in infractions are bounded to the last MaxPreOptInInfractionEntries records per consumer chain,
+// and that they are removed once the state of the consumer chain is cleaned up after its deletion. They are neither exported
+// in genesis nor used by the provider logic.
+func (k Keeper) RecordPreOptInInfraction(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	consumerAddr types.ConsumerConsAddress,
+	vscId uint64,
+	infractionHeight uint64,
+	optInHeight uint64,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePreOptInInfractionDropped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+			sdk.NewAttribute(types.AttributeConsumerValidatorAddress, consumerAddr.String()),
+			sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(vscId, 10)),
+			sdk.NewAttribute(types.AttributeInfractionHeight, strconv.FormatUint(infractionHeight, 10)),
+			sdk.NewAttribute(types.AttributeOptInHeight, strconv.FormatUint(optInHeight, 10)),
+		),
+	)
+
+	log := k.PreOptInInfractionLog()
+	record := types.PreOptInInfraction{
+		Sequence:         log.NextSequence(ctx, consumerId),
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		ProviderAddress:  providerAddr.String(),
+		ConsumerAddress:  consumerAddr.String(),
+		ValsetUpdateId:   vscId,
+		InfractionHeight: infractionHeight,
+		OptInHeight:      optInHeight,
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to store pre-opt-in infraction",
+			"consumerId", consumerId,
+			"sequence", record.Sequence,
+			"error", err.Error(),
+		)
+		return
+	}
+	// append the record and prune the pre-opt-in infractions by count
+	log.Append(ctx, consumerId, record.Sequence, bz)
+}
+
+// SetPreOptInInfraction stores the pre-opt-in infraction `record` of the consumer chain with `consumerId`
+func (k Keeper) SetPreOptInInfraction(ctx sdk.Context, consumerId string, record types.PreOptInInfraction) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal pre-opt-in infraction (%d) for consumer id (%s): %w", record.Sequence, consumerId, err)
+	}
+	k.PreOptInInfractionLog().Set(ctx, consumerId, record.Sequence, bz)
+	return nil
+}
+
+// GetPreOptInInfractions returns the pre-opt-in infractions of the consumer chain with `consumerId`
+// in ascending order of their sequence numbers, i.e., from the oldest to the newest
+func (k Keeper) GetPreOptInInfractions(ctx sdk.Context, consumerId string) ([]types.PreOptInInfraction, error) {
+	records := []types.PreOptInInfraction{}
+	var err error
+	k.PreOptInInfractionLog().Iterate(ctx, consumerId, func(_ uint64, bz []byte) bool {
+		var record types.PreOptInInfraction
+		if err = record.Unmarshal(bz); err != nil {
+			err = fmt.Errorf("failed to unmarshal pre-opt-in infraction for consumer id (%s): %w", consumerId, err)
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
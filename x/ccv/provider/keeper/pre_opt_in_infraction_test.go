@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestOptInHeight tests that the opt-in height is recorded when a validator joins the consumer validator set,
+// that it is not updated afterwards, and that it is removed on opt out
+func TestOptInHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ctx = ctx.WithBlockHeight(100)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	valAPubKey, _ := valA.CmtConsPublicKey()
+	providerAddrA := providertypes.NewProviderConsAddress(valAConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valAConsAddr).Return(valA, nil).AnyTimes()
+	valB := createStakingValidator(ctx, mocks, 2, 2)
+	valBConsAddr, _ := valB.GetConsAddr()
+	providerAddrB := providertypes.NewProviderConsAddress(valBConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valBConsAddr).Return(valB, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, []stakingtypes.Validator{valA, valB}, -1)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// validator A is a consumer validator that joined before the opt-in heights were recorded
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrA)
+	err = providerKeeper.SetConsumerValidator(ctx, CONSUMER_ID, providertypes.ConsensusValidator{
+		ProviderConsAddr: valAConsAddr,
+		Power:            1,
+		PublicKey:        &valAPubKey,
+	})
+	require.NoError(t, err)
+	// validator B opts in and joins the consumer validator set at the end of the epoch
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrB)
+
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	_, found := providerKeeper.GetOptInHeight(ctx, CONSUMER_ID, providerAddrA)
+	require.False(t, found)
+	// the opt-in of validator B becomes effective at the height the vscID of the VSC packet is mapped to
+	optInHeight, found := providerKeeper.GetOptInHeight(ctx, CONSUMER_ID, providerAddrB)
+	require.True(t, found)
+	require.Equal(t, uint64(101), optInHeight)
+	vscIdToHeight, found := providerKeeper.GetVscIdToHeight(ctx, CONSUMER_ID, providerKeeper.GetValidatorSetUpdateId(ctx)-1)
+	require.True(t, found)
+	require.Equal(t, vscIdToHeight.Height, optInHeight)
+
+	// the opt-in height does not change in the following epochs
+	ctx = ctx.WithBlockHeight(200)
+	createStakingValidator(ctx, mocks, 1, 1)
+	createStakingValidator(ctx, mocks, 2, 2)
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	optInHeight, found = providerKeeper.GetOptInHeight(ctx, CONSUMER_ID, providerAddrB)
+	require.True(t, found)
+	require.Equal(t, uint64(101), optInHeight)
+
+	// the opt-in height is removed on opt out
+	err = providerKeeper.HandleOptOut(ctx, CONSUMER_ID, providerAddrB)
+	require.NoError(t, err)
+	_, found = providerKeeper.GetOptInHeight(ctx, CONSUMER_ID, providerAddrB)
+	require.False(t, found)
+}
+
+// TestHandleSlashPacketPreOptInInfraction tests that validators are not jailed for downtime infractions
+// committed before their opt-in became effective, and that these infractions are recorded instead
+func TestHandleSlashPacketPreOptInInfraction(t *testing.T) {
+	consumerId := "0"
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	// the consumer key assigned when the validator opted in, and the one assigned afterwards
+	oldConsumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	newConsumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987635).ConsumerConsAddress()
+
+	// the vscIDs sent before the opt-in (i.e., 0 and 3), at the opt-in (i.e., 5), and after the opt-in (i.e., 6)
+	initChainHeight := uint64(5)
+	vscIdToHeights := []providertypes.VscIdToHeight{
+		{VscId: 3, Height: 10},
+		{VscId: 5, Height: 20},
+		{VscId: 6, Height: 30},
+	}
+
+	testCases := []struct {
+		name           string
+		optInHeight    uint64 // zero if no opt-in height is recorded
+		consumerAddr   providertypes.ConsumerConsAddress
+		vscId          uint64
+		expectJail     bool
+		expectedHeight uint64
+	}{
+		{"infraction at the init chain height before the opt in", 20, oldConsumerConsAddr, 0, false, 5},
+		{"infraction before the opt in", 20, oldConsumerConsAddr, 3, false, 10},
+		{"infraction at the opt in", 20, oldConsumerConsAddr, 5, true, 20},
+		{"infraction after the opt in", 20, oldConsumerConsAddr, 6, true, 30},
+		{"infraction after the opt in with a new consumer key", 20, newConsumerConsAddr, 6, true, 30},
+		{"infraction before the opt in without a recorded opt-in height", 0, oldConsumerConsAddr, 3, true, 10},
+		{"infraction at the init chain height with an opt in at the launch", 4, oldConsumerConsAddr, 0, true, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ctx = ctx.WithBlockHeight(42)
+
+			gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+				ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, tc.expectJail)...)
+
+			providerKeeper.SetConsumerChainId(ctx, consumerId, "consumer-chain-id")
+			providerKeeper.SetInitChainHeight(ctx, consumerId, initChainHeight)
+			for _, vscIdToHeight := range vscIdToHeights {
+				providerKeeper.SetVscIdToHeight(ctx, consumerId, vscIdToHeight)
+			}
+			// both consumer keys are mapped to the validator, as the old one is only pruned
+			// once the VSC packet sent after the key assignment matured
+			providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, oldConsumerConsAddr, providerConsAddr)
+			providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, newConsumerConsAddr, providerConsAddr)
+			providerKeeper.SetOptedIn(ctx, consumerId, providerConsAddr)
+			if tc.optInHeight != 0 {
+				providerKeeper.SetOptInHeight(ctx, consumerId, providerConsAddr, tc.optInHeight)
+			}
+
+			providerKeeper.HandleSlashPacket(ctx, consumerId, *ccv.NewSlashPacketData(
+				abci.Validator{Address: tc.consumerAddr.ToSdkConsAddr()},
+				tc.vscId,
+				stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			))
+
+			// the slash packet is acknowledged in either case
+			require.Equal(t, []string{tc.consumerAddr.String()}, providerKeeper.GetSlashAcks(ctx, consumerId))
+
+			infractions, err := providerKeeper.GetPreOptInInfractions(ctx, consumerId)
+			require.NoError(t, err)
+			var droppedEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == providertypes.EventTypePreOptInInfractionDropped {
+					droppedEvents = append(droppedEvents, event)
+				}
+			}
+			if tc.expectJail {
+				require.Empty(t, infractions)
+				require.Empty(t, droppedEvents)
+				return
+			}
+
+			require.Equal(t, []providertypes.PreOptInInfraction{{
+				Sequence:         0,
+				Height:           42,
+				Time:             ctx.BlockTime(),
+				ProviderAddress:  providerConsAddr.String(),
+				ConsumerAddress:  tc.consumerAddr.String(),
+				ValsetUpdateId:   tc.vscId,
+				InfractionHeight: tc.expectedHeight,
+				OptInHeight:      tc.optInHeight,
+			}}, infractions)
+			require.Len(t, droppedEvents, 1)
+			for key, value := range map[string]string{
+				providertypes.AttributeProviderValidatorAddress: providerConsAddr.String(),
+				providertypes.AttributeConsumerValidatorAddress: tc.consumerAddr.String(),
+				providertypes.AttributeOptInHeight:              "20",
+			} {
+				attr, found := droppedEvents[0].GetAttribute(key)
+				require.True(t, found, key)
+				require.Equal(t, value, attr.Value, key)
+			}
+
+			res, err := providerKeeper.QueryPreOptInInfractions(ctx,
+				&providertypes.QueryPreOptInInfractionsRequest{ConsumerId: consumerId})
+			require.NoError(t, err)
+			require.Equal(t, infractions, res.Infractions)
+		})
+	}
+}
+
+// TestPreOptInInfractionsPruningAndCleanUp tests that only the last MaxPreOptInInfractionEntries pre-opt-in infractions
+// are kept per consumer chain, and that they are removed together with their sequence number once the consumer chain is cleaned up
+func TestPreOptInInfractionsPruningAndCleanUp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	consumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+
+	numRecords := providertypes.MaxPreOptInInfractionEntries + 10
+	for i := 0; i < numRecords; i++ {
+		providerKeeper.RecordPreOptInInfraction(ctx, consumerId, providerAddr, consumerAddr, uint64(i), 10, 20)
+	}
+
+	infractions, err := providerKeeper.GetPreOptInInfractions(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, infractions, providertypes.MaxPreOptInInfractionEntries)
+	for i, infraction := range infractions {
+		expectedSequence := uint64(numRecords - providertypes.MaxPreOptInInfractionEntries + i)
+		require.Equal(t, expectedSequence, infraction.Sequence)
+		require.Equal(t, expectedSequence, infraction.ValsetUpdateId)
+	}
+
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerId)
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	infractions, err = providerKeeper.GetPreOptInInfractions(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, infractions)
+	require.Equal(t, uint64(0), providerKeeper.PreOptInInfractionLog().NextSequence(ctx, consumerId))
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// SetConsumerProviderUpgradeNotice sets the provider upgrade notice last communicated
+// to the consumer chain with `consumerId`
+func (k Keeper) SetConsumerProviderUpgradeNotice(ctx sdk.Context, consumerId string, notice ccv.ProviderUpgradeNotice) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := notice.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal provider upgrade notice (%+v) for consumer id (%s): %w", notice, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToProviderUpgradeNoticeKey(consumerId), bz)
+	return nil
+}
+
+// GetConsumerProviderUpgradeNotice returns the provider upgrade notice last communicated
+// to the consumer chain with `consumerId`, and false if no scheduled upgrade was communicated
+func (k Keeper) GetConsumerProviderUpgradeNotice(ctx sdk.Context, consumerId string) (ccv.ProviderUpgradeNotice, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToProviderUpgradeNoticeKey(consumerId))
+	if bz == nil {
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+	var notice ccv.ProviderUpgradeNotice
+	if err := notice.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the notice is assumed to be correctly serialized in SetConsumerProviderUpgradeNotice.
+		panic(fmt.Errorf("failed to unmarshal provider upgrade notice for consumer id (%s): %w", consumerId, err))
+	}
+	return notice, true
+}
+
+// DeleteConsumerProviderUpgradeNotice deletes the provider upgrade notice last communicated
+// to the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerProviderUpgradeNotice(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToProviderUpgradeNoticeKey(consumerId))
+}
+
+// SetConsumerAcceptsUpgradeNotices marks the consumer chain with `consumerId` as accepting provider upgrade notices,
+// i.e., as having declared during the CCV channel handshake that it accepts them
+func (k Keeper) SetConsumerAcceptsUpgradeNotices(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerAcceptsUpgradeNoticesKey(consumerId), []byte{})
+}
+
+// ConsumerAcceptsUpgradeNotices returns whether the consumer chain with `consumerId` accepts provider upgrade notices
+func (k Keeper) ConsumerAcceptsUpgradeNotices(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerAcceptsUpgradeNoticesKey(consumerId))
+}
+
+// DeleteConsumerAcceptsUpgradeNotices removes the mark of the consumer chain with `consumerId` as accepting provider upgrade notices
+func (k Keeper) DeleteConsumerAcceptsUpgradeNotices(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerAcceptsUpgradeNoticesKey(consumerId))
+}
+
+// GetScheduledProviderUpgradeNotice returns a notice for the upgrade plan scheduled on the provider chain,
+// and false if no upgrade plan is scheduled. The time at which the upgrade is applied is estimated from
+// the average block time of the previous epoch; it is left zero if the average block time is not known yet.
+func (k Keeper) GetScheduledProviderUpgradeNotice(ctx sdk.Context) (ccv.ProviderUpgradeNotice, bool) {
+	plan, err := k.upgradeKeeper.GetUpgradePlan(ctx)
+	if err != nil {
+		if !errors.Is(err, upgradetypes.ErrNoUpgradePlanFound) {
+			k.Logger(ctx).Error("failed to get the upgrade plan", "error", err.Error())
+		}
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+
+	notice := ccv.ProviderUpgradeNotice{
+		Name:   plan.Name,
+		Height: plan.Height,
+	}
+	if averageBlockTime := k.GetCurrentEpochInfo(ctx).AverageBlockTime; averageBlockTime > 0 && plan.Height > ctx.BlockHeight() {
+		notice.EstimatedTime = ctx.BlockTime().Add(time.Duration(plan.Height-ctx.BlockHeight()) * averageBlockTime)
+	}
+	return notice, true
+}
+
+// GetProviderUpgradeNoticeUpdate returns the provider upgrade notice that must be communicated to the consumer
+// chain with `consumerId` in the next VSC packet, and false if there is no update, i.e., if the `SendUpgradeNotices`
+// param is disabled, if the consumer chain does not accept provider upgrade notices (e.g., as it runs a previous ICS
+// version that rejects VSC packets carrying them), or if the scheduled upgrade was already communicated. If the upgrade
+// last communicated is no longer scheduled (i.e., it was either applied or cancelled), the returned notice clears it
+// on the consumer chain.
+//
+// Note that the update is recorded as communicated, i.e., the caller must queue a VSC packet carrying it.
+func (k Keeper) GetProviderUpgradeNoticeUpdate(ctx sdk.Context, consumerId string) (ccv.ProviderUpgradeNotice, bool) {
+	if !k.GetSendUpgradeNotices(ctx) || !k.ConsumerAcceptsUpgradeNotices(ctx, consumerId) {
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+
+	lastNotice, communicated := k.GetConsumerProviderUpgradeNotice(ctx, consumerId)
+	notice, scheduled := k.GetScheduledProviderUpgradeNotice(ctx)
+	if !scheduled {
+		if !communicated {
+			return ccv.ProviderUpgradeNotice{}, false
+		}
+		k.DeleteConsumerProviderUpgradeNotice(ctx, consumerId)
+		return ccv.ProviderUpgradeNotice{}, true
+	}
+
+	// the estimated time changes from block to block, hence it is not taken into account
+	if communicated && lastNotice.Name == notice.Name && lastNotice.Height == notice.Height {
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+	if err := k.SetConsumerProviderUpgradeNotice(ctx, consumerId, notice); err != nil {
+		k.Logger(ctx).Error("failed to record provider upgrade notice",
+			"consumerId", consumerId,
+			"error", err.Error(),
+		)
+		return ccv.ProviderUpgradeNotice{}, false
+	}
+	return notice, true
+}
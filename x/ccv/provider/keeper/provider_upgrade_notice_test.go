@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestGetProviderUpgradeNoticeUpdate tests that the scheduled provider upgrade is communicated
+// to a consumer chain once, and cleared once it is no longer scheduled
+func TestGetProviderUpgradeNoticeUpdate(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	params := providertypes.DefaultParams()
+	providerKeeper.SetParams(ctx, params)
+	plan := upgradetypes.Plan{Name: "v7", Height: 1000}
+
+	// no update is returned if the param is disabled, even if an upgrade is scheduled
+	_, found := providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	params.SendUpgradeNotices = true
+	providerKeeper.SetParams(ctx, params)
+
+	// no update is returned if the consumer chain does not accept provider upgrade notices
+	_, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	providerKeeper.SetConsumerAcceptsUpgradeNotices(ctx, CONSUMER_ID)
+
+	// no update is returned if no upgrade is scheduled
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(upgradetypes.Plan{}, upgradetypes.ErrNoUpgradePlanFound).Times(1)
+	_, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// the scheduled upgrade is returned, without an estimated time if the average block time is not known
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(plan, nil).Times(1)
+	notice, found := providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, ccv.ProviderUpgradeNotice{Name: "v7", Height: 1000}, notice)
+	lastNotice, found := providerKeeper.GetConsumerProviderUpgradeNotice(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, notice, lastNotice)
+
+	// the scheduled upgrade is not returned again
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(plan, nil).Times(1)
+	_, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// a rescheduled upgrade is returned, with the estimated time computed from the average block time
+	providerKeeper.SetEpochInfo(ctx, providertypes.EpochInfo{AverageBlockTime: 6 * time.Second})
+	plan.Height = 2000
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(plan, nil).Times(1)
+	notice, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, ccv.ProviderUpgradeNotice{
+		Name:          "v7",
+		Height:        2000,
+		EstimatedTime: ctx.BlockTime().Add(1900 * 6 * time.Second),
+	}, notice)
+
+	// a notice clearing the upgrade is returned once it is no longer scheduled
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(upgradetypes.Plan{}, upgradetypes.ErrNoUpgradePlanFound).Times(1)
+	notice, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.True(t, notice.IsCleared())
+	_, found = providerKeeper.GetConsumerProviderUpgradeNotice(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// the upgrade is only cleared once
+	mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(upgradetypes.Plan{}, upgradetypes.ErrNoUpgradePlanFound).Times(1)
+	_, found = providerKeeper.GetProviderUpgradeNoticeUpdate(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// TestQueueVSCPacketsWithProviderUpgradeNotice tests that VSC packets carrying provider upgrade notices
+// are queued even if the validator set of the consumer chain does not change
+func TestQueueVSCPacketsWithProviderUpgradeNotice(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.SendUpgradeNotices = true
+	providerKeeper.SetParams(ctx, params)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 0, []stakingtypes.Validator{}, -1)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerAcceptsUpgradeNotices(ctx, CONSUMER_ID)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	plan := upgradetypes.Plan{Name: "v7", Height: 1000}
+	gomock.InOrder(
+		mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(plan, nil).Times(2),
+		mocks.MockUpgradeKeeper.EXPECT().GetUpgradePlan(gomock.Any()).Return(upgradetypes.Plan{}, upgradetypes.ErrNoUpgradePlanFound).Times(1),
+	)
+
+	// a packet with the scheduled upgrade is queued
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 1)
+	require.Empty(t, pending[0].ValidatorUpdates)
+	require.Equal(t, &ccv.ProviderUpgradeNotice{Name: "v7", Height: 1000}, pending[0].ProviderUpgradeNotice)
+
+	// no packet is queued if the scheduled upgrade was already communicated
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+
+	// a packet clearing the upgrade is queued once it is no longer scheduled
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	pending = providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	require.Equal(t, &ccv.ProviderUpgradeNotice{}, pending[1].ProviderUpgradeNotice)
+}
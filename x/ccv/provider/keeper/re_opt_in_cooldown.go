@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// RecordReOptInCooldown records that the validator with `providerAddr` cannot validate the consumer chain with `consumerId`
+// during the `ReOptInCooldownAfterSlash` of the chain, since it was jailed for an infraction committed on it. Nothing is
+// recorded if the consumer chain does not set a cooldown. A cooldown that ends later than the new one is retained.
+func (k Keeper) RecordReOptInCooldown(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get power shaping parameters",
+			"consumerId", consumerId,
+			"error", err.Error(),
+		)
+		return
+	}
+	cooldown := powerShapingParameters.ReOptInCooldownAfterSlash
+	if cooldown == nil || *cooldown <= 0 {
+		return
+	}
+
+	endTime := ctx.BlockTime().Add(*cooldown)
+	if currentEndTime, found := k.GetReOptInCooldownEndTime(ctx, consumerId, providerAddr); found && !currentEndTime.Before(endTime) {
+		return
+	}
+	k.SetReOptInCooldownEndTime(ctx, consumerId, providerAddr, endTime)
+
+	k.Logger(ctx).Info("validator cannot validate consumer chain until the end of the re-opt-in cooldown",
+		"consumerId", consumerId,
+		"provider addr", providerAddr.String(),
+		"cooldown end time", endTime,
+	)
+}
+
+// SetReOptInCooldownEndTime sets the time until which the validator with `providerAddr` cannot validate
+// the consumer chain with `consumerId` after being jailed for an infraction committed on it
+func (k Keeper) SetReOptInCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, endTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ReOptInCooldownKey(consumerId, providerAddr), sdk.FormatTimeBytes(endTime))
+}
+
+// GetReOptInCooldownEndTime returns the time until which the validator with `providerAddr` cannot validate
+// the consumer chain with `consumerId` after being jailed for an infraction committed on it, and false if
+// no cooldown is recorded
+func (k Keeper) GetReOptInCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReOptInCooldownKey(consumerId, providerAddr))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	endTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		k.Logger(ctx).Error("failed to parse re-opt-in cooldown",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"error", err.Error(),
+		)
+		return time.Time{}, false
+	}
+	return endTime, true
+}
+
+// DeleteReOptInCooldownEndTime deletes the time until which the validator with `providerAddr` cannot validate
+// the consumer chain with `consumerId` after being jailed for an infraction committed on it
+func (k Keeper) DeleteReOptInCooldownEndTime(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReOptInCooldownKey(consumerId, providerAddr))
+}
+
+// IsInReOptInCooldown returns true if the validator with `providerAddr` cannot validate the consumer chain with
+// `consumerId`, since it was jailed for an infraction committed on it less than the `ReOptInCooldownAfterSlash`
+// of the chain ago, together with the time at which the cooldown ends
+func (k Keeper) IsInReOptInCooldown(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (time.Time, bool) {
+	endTime, found := k.GetReOptInCooldownEndTime(ctx, consumerId, providerAddr)
+	if !found {
+		return time.Time{}, false
+	}
+	return endTime, ctx.BlockTime().Before(endTime)
+}
+
+// PruneReOptInCooldowns deletes the re-opt-in cooldowns of the consumer chain with `consumerId` that ended,
+// as well as the ones that cannot be parsed
+func (k Keeper) PruneReOptInCooldowns(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ReOptInCooldownKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		endTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err == nil && ctx.BlockTime().Before(endTime) {
+			continue
+		}
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
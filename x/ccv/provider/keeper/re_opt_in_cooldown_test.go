@@ -0,0 +1,230 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestRecordReOptInCooldown tests that the re-opt-in cooldown is only recorded if the consumer chain sets it,
+// that a cooldown ending later is retained, and that the cooldowns are removed once the consumer chain is cleaned up
+func TestRecordReOptInCooldown(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+
+	// no cooldown is recorded if the consumer chain does not set it
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	providerKeeper.RecordReOptInCooldown(ctx, CONSUMER_ID, providerAddr)
+	_, found := providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+
+	cooldown := 24 * time.Hour
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID,
+		providertypes.PowerShapingParameters{ReOptInCooldownAfterSlash: &cooldown})
+	require.NoError(t, err)
+	providerKeeper.RecordReOptInCooldown(ctx, CONSUMER_ID, providerAddr)
+	endTime, inCooldown := providerKeeper.IsInReOptInCooldown(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, inCooldown)
+	require.Equal(t, ctx.BlockTime().Add(cooldown), endTime)
+
+	// a shorter cooldown recorded later does not shorten the current one
+	shorterCooldown := time.Hour
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID,
+		providertypes.PowerShapingParameters{ReOptInCooldownAfterSlash: &shorterCooldown})
+	require.NoError(t, err)
+	providerKeeper.RecordReOptInCooldown(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), CONSUMER_ID, providerAddr)
+	endTime, found = providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, providerAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(cooldown), endTime)
+
+	// the cooldown elapses
+	_, inCooldown = providerKeeper.IsInReOptInCooldown(ctx.WithBlockTime(endTime), CONSUMER_ID, providerAddr)
+	require.False(t, inCooldown)
+
+	// the cooldowns are removed once the deleted consumer chain is cleaned up
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_DELETED)
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, CONSUMER_ID)
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	_, found = providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+}
+
+// TestHandleSlashPacketRecordsReOptInCooldown tests that a validator jailed for downtime on a consumer chain
+// is in the re-opt-in cooldown of the chain, and that the cooldown is returned by the query
+func TestHandleSlashPacketRecordsReOptInCooldown(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, providerConsAddr, stakingtypes.Validator{Jailed: false}, true)...)
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "consumer-chain-id")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetInitChainHeight(ctx, CONSUMER_ID, 5)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerConsAddr, providerConsAddr)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerConsAddr)
+	cooldown := 24 * time.Hour
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID,
+		providertypes.PowerShapingParameters{ReOptInCooldownAfterSlash: &cooldown})
+	require.NoError(t, err)
+
+	providerKeeper.HandleSlashPacket(ctx, CONSUMER_ID, *ccv.NewSlashPacketData(
+		abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+		0,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	))
+
+	endTime, inCooldown := providerKeeper.IsInReOptInCooldown(ctx, CONSUMER_ID, providerConsAddr)
+	require.True(t, inCooldown)
+	require.Equal(t, ctx.BlockTime().Add(cooldown), endTime)
+
+	res, err := providerKeeper.QueryReOptInCooldown(ctx, &providertypes.QueryReOptInCooldownRequest{
+		ConsumerId:      CONSUMER_ID,
+		ProviderAddress: providerConsAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &providertypes.QueryReOptInCooldownResponse{InCooldown: true, CooldownEndTime: endTime}, res)
+
+	// the cooldown is also recorded for a validator that is already jailed, e.g., for an infraction on another chain
+	jailedProviderConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842335).ProviderConsAddress()
+	jailedConsumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987635).ConsumerConsAddress()
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, jailedConsumerConsAddr, jailedProviderConsAddr)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, jailedProviderConsAddr)
+	gomock.InOrder(testkeeper.GetMocksForHandleSlashPacket(
+		ctx, mocks, jailedProviderConsAddr, stakingtypes.Validator{Jailed: true}, false)...)
+
+	providerKeeper.HandleSlashPacket(ctx, CONSUMER_ID, *ccv.NewSlashPacketData(
+		abci.Validator{Address: jailedConsumerConsAddr.ToSdkConsAddr()},
+		0,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	))
+
+	endTime, inCooldown = providerKeeper.IsInReOptInCooldown(ctx, CONSUMER_ID, jailedProviderConsAddr)
+	require.True(t, inCooldown)
+	require.Equal(t, ctx.BlockTime().Add(cooldown), endTime)
+}
+
+// TestPruneReOptInCooldowns tests that only the re-opt-in cooldowns that ended are pruned
+func TestPruneReOptInCooldowns(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	endedAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	endingAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+	ongoingAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(3).ProviderConsAddress()
+	providerKeeper.SetReOptInCooldownEndTime(ctx, CONSUMER_ID, endedAddr, now.Add(-time.Hour))
+	providerKeeper.SetReOptInCooldownEndTime(ctx, CONSUMER_ID, endingAddr, now)
+	providerKeeper.SetReOptInCooldownEndTime(ctx, CONSUMER_ID, ongoingAddr, now.Add(time.Hour))
+	// the cooldowns of other consumer chains are not pruned
+	providerKeeper.SetReOptInCooldownEndTime(ctx, "1", endedAddr, now.Add(-time.Hour))
+
+	providerKeeper.PruneReOptInCooldowns(ctx, CONSUMER_ID)
+
+	_, found := providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, endedAddr)
+	require.False(t, found)
+	_, found = providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, endingAddr)
+	require.False(t, found)
+	endTime, found := providerKeeper.GetReOptInCooldownEndTime(ctx, CONSUMER_ID, ongoingAddr)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Hour), endTime)
+	_, found = providerKeeper.GetReOptInCooldownEndTime(ctx, "1", endedAddr)
+	require.True(t, found)
+}
+
+// TestReOptInCooldownAfterUnjail tests that a validator that is unjailed before the end of the re-opt-in cooldown
+// of a consumer chain can neither opt in to the chain nor validate it (even if it has to validate a Top N chain),
+// while a validator that is unjailed after the end of the cooldown can
+func TestReOptInCooldownAfterUnjail(t *testing.T) {
+	cooldown := 24 * time.Hour
+
+	testCases := []struct {
+		name          string
+		unjailedAfter time.Duration
+		expectInSet   bool
+	}{
+		{"unjailed before the end of the cooldown", time.Hour, false},
+		{"unjailed after the end of the cooldown", cooldown, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+			providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+			powerShapingParameters := providertypes.PowerShapingParameters{Top_N: 100, ReOptInCooldownAfterSlash: &cooldown}
+			err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, powerShapingParameters)
+			require.NoError(t, err)
+
+			valA := createStakingValidator(ctx, mocks, 1, 1)
+			valAConsAddr, _ := valA.GetConsAddr()
+			providerAddrA := providertypes.NewProviderConsAddress(valAConsAddr)
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valAConsAddr).Return(valA, nil).AnyTimes()
+			valB := createStakingValidator(ctx, mocks, 2, 2)
+			valBConsAddr, _ := valB.GetConsAddr()
+			providerAddrB := providertypes.NewProviderConsAddress(valBConsAddr)
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valBConsAddr).Return(valB, nil).AnyTimes()
+
+			// validator B is jailed for an infraction committed on the consumer chain, while remaining opted in
+			providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrA)
+			providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddrB)
+			providerKeeper.RecordReOptInCooldown(ctx, CONSUMER_ID, providerAddrB)
+
+			// validator B is unjailed, i.e., it is bonded again
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tc.unjailedAfter))
+			createStakingValidator(ctx, mocks, 1, 1)
+			createStakingValidator(ctx, mocks, 2, 2)
+			bondedValidators := []stakingtypes.Validator{valA, valB}
+
+			nextVals, _, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, bondedValidators, powerShapingParameters, 1)
+			require.NoError(t, err)
+			nextValsAddrs := []sdk.ConsAddress{}
+			for _, val := range nextVals {
+				nextValsAddrs = append(nextValsAddrs, val.ProviderConsAddr)
+			}
+			if tc.expectInSet {
+				require.ElementsMatch(t, []sdk.ConsAddress{valAConsAddr, valBConsAddr}, nextValsAddrs)
+			} else {
+				require.Equal(t, []sdk.ConsAddress{valAConsAddr}, nextValsAddrs)
+			}
+
+			// validator B opts out and opts in again, and is automatically opted in as part of the top N
+			providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providerAddrB)
+			err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, bondedValidators, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectInSet, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+
+			providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providerAddrB)
+			err = providerKeeper.HandleOptIn(ctx, CONSUMER_ID, providerAddrB, "")
+			if tc.expectInSet {
+				require.NoError(t, err)
+				require.True(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+			} else {
+				require.ErrorIs(t, err, providertypes.ErrReOptInCooldown)
+				require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, providerAddrB))
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// RecordLog is a log of records that is bounded per consumer chain. For every consumer chain, the records are
+// stored (in the order in which they were appended) under types.StringIdAndUintIdKey(prefix, consumerId, sequence),
+// while the sequence number of the next record is stored under the sequence key of the consumer chain.
+// Only the last `maxEntries` records of every consumer chain are kept.
+type RecordLog struct {
+	storeKey    storetypes.StoreKey
+	prefix      byte
+	sequenceKey func(consumerId string) []byte
+	maxEntries  uint64
+}
+
+// NewRecordLog returns the record log whose records are stored under `prefix` and whose sequence numbers
+// are stored under `sequenceKey`, and that keeps at most `maxEntries` records per consumer chain
+func (k Keeper) NewRecordLog(prefix byte, sequenceKey func(consumerId string) []byte, maxEntries uint64) RecordLog {
+	return RecordLog{storeKey: k.storeKey, prefix: prefix, sequenceKey: sequenceKey, maxEntries: maxEntries}
+}
+
+// RewardAttributionLog returns the record log of the reward attribution decisions
+func (k Keeper) RewardAttributionLog() RecordLog {
+	return k.NewRecordLog(types.RewardAttributionLogKeyPrefix(), types.RewardAttributionLogSequenceKey,
+		types.MaxRewardAttributionLogEntries)
+}
+
+// SkippedDowntimeSlashLog returns the record log of the downtime slashes skipped due to the downtime grace period
+func (k Keeper) SkippedDowntimeSlashLog() RecordLog {
+	return k.NewRecordLog(types.SkippedDowntimeSlashKeyPrefix(), types.SkippedDowntimeSlashSequenceKey,
+		types.MaxSkippedDowntimeSlashEntries)
+}
+
+// UnroutableSlashPacketLog returns the record log of the slash packets that could not be mapped to a provider validator
+func (k Keeper) UnroutableSlashPacketLog() RecordLog {
+	return k.NewRecordLog(types.UnroutableSlashPacketKeyPrefix(), types.UnroutableSlashPacketSequenceKey,
+		types.MaxUnroutableSlashPacketEntries)
+}
+
+// PreOptInInfractionLog returns the record log of the downtime infractions dropped since they were committed
+// before the opt-in of the validators became effective
+func (k Keeper) PreOptInInfractionLog() RecordLog {
+	return k.NewRecordLog(types.PreOptInInfractionKeyPrefix(), types.PreOptInInfractionSequenceKey,
+		types.MaxPreOptInInfractionEntries)
+}
+
+// key returns the key under which the record with `sequence` of the consumer chain with `consumerId` is stored
+func (l RecordLog) key(consumerId string, sequence uint64) []byte {
+	return types.StringIdAndUintIdKey(l.prefix, consumerId, sequence)
+}
+
+// NextSequence returns the sequence number of the next record of the consumer chain with `consumerId` and increments it
+func (l RecordLog) NextSequence(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(l.storeKey)
+	sequence := uint64(0)
+	if bz := store.Get(l.sequenceKey(consumerId)); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(l.sequenceKey(consumerId), sdk.Uint64ToBigEndian(sequence+1))
+	return sequence
+}
+
+// Set stores the marshaled record `bz` with `sequence` of the consumer chain with `consumerId`
+func (l RecordLog) Set(ctx sdk.Context, consumerId string, sequence uint64, bz []byte) {
+	ctx.KVStore(l.storeKey).Set(l.key(consumerId, sequence), bz)
+}
+
+// Append stores the marshaled record `bz` with `sequence` (as returned by NextSequence) of the consumer chain
+// with `consumerId` and prunes the oldest record of the consumer chain if the log holds more than `maxEntries` records
+func (l RecordLog) Append(ctx sdk.Context, consumerId string, sequence uint64, bz []byte) {
+	l.Set(ctx, consumerId, sequence, bz)
+	if sequence >= l.maxEntries {
+		l.Delete(ctx, consumerId, sequence-l.maxEntries)
+	}
+}
+
+// Delete deletes the record with `sequence` of the consumer chain with `consumerId`
+func (l RecordLog) Delete(ctx sdk.Context, consumerId string, sequence uint64) {
+	ctx.KVStore(l.storeKey).Delete(l.key(consumerId, sequence))
+}
+
+// Iterate calls `cb` with the sequence numbers and the marshaled records of the consumer chain with `consumerId`
+// in ascending order of their sequence numbers, i.e., from the oldest to the newest, until `cb` returns true
+func (l RecordLog) Iterate(ctx sdk.Context, consumerId string, cb func(sequence uint64, bz []byte) (stop bool)) {
+	store := ctx.KVStore(l.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(l.prefix, consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, sequence, err := types.ParseStringIdAndUintIdKey(l.prefix, iterator.Key())
+		if err != nil {
+			// the key is built by key(), hence this cannot happen
+			continue
+		}
+		if cb(sequence, iterator.Value()) {
+			return
+		}
+	}
+}
+
+// KeyPrefixes returns the key prefixes under which the records and the sequence number of the consumer chain
+// with `consumerId` are stored, so that they can be removed once the consumer chain is deleted
+func (l RecordLog) KeyPrefixes(consumerId string) [][]byte {
+	return [][]byte{
+		types.StringIdWithLenKey(l.prefix, consumerId),
+		l.sequenceKey(consumerId),
+	}
+}
@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -30,18 +32,43 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			"error", err,
 		)
 		if consumerId, ok := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
-			return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
+			return k.StopAndPrepareForConsumerRemoval(ctx, consumerId, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_ERROR,
+				fmt.Sprintf("error acknowledgement on channel %s: %s", packet.SourceChannel, err))
+		}
+		if consumerId, ok := k.GetDeletedChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+			// the consumer chain was already removed
+			k.Logger(ctx).Info("recv ErrorAcknowledgement on channel of removed consumer chain",
+				"channelID", packet.SourceChannel,
+				"consumerId", consumerId,
+			)
+			return nil
 		}
 		return errorsmod.Wrapf(providertypes.ErrUnknownConsumerChannelId, "recv ErrorAcknowledgement on unknown channel %s", packet.SourceChannel)
 	}
+	if consumerId, ok := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+		k.RecordConsumerActivity(ctx, consumerId)
+		// the validator removals sent up to the acknowledged VSC packet were applied by the consumer
+		var data ccv.ValidatorSetChangePacketData
+		if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+			k.DeleteAcknowledgedValidatorRemovals(ctx, consumerId, data.ValsetUpdateId)
+		}
+	}
 	return nil
 }
 
 // OnTimeoutPacket aborts the transaction if no chain exists for the destination channel,
-// otherwise it stops the chain
+// otherwise it stops the chain. Timeouts of packets sent on the channel of an already removed
+// consumer chain are ignored.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel)
 	if !found {
+		if consumerId, ok := k.GetDeletedChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+			k.Logger(ctx).Info("packet timeout on channel of removed consumer chain",
+				"channelID", packet.SourceChannel,
+				"consumerId", consumerId,
+			)
+			return nil
+		}
 		k.Logger(ctx).Error("packet timeout, unknown channel:", "channelID", packet.SourceChannel)
 		// abort transaction
 		return errorsmod.Wrap(
@@ -50,7 +77,8 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 		)
 	}
 	k.Logger(ctx).Info("packet timeout, deleting the consumer:", "consumerId", consumerId)
-	return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
+	return k.StopAndPrepareForConsumerRemoval(ctx, consumerId, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT,
+		fmt.Sprintf("packet %d timed out on channel %s", packet.Sequence, packet.SourceChannel))
 }
 
 // EndBlockVSU contains the EndBlock logic needed for
@@ -66,7 +94,8 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 		// only queue and send VSCPackets at the boundaries of an epoch
 
 		// collect validator updates
-		if err := k.QueueVSCPackets(ctx); err != nil {
+		numConsumersWithPackets, err := k.QueueVSCPackets(ctx)
+		if err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("queueing consumer validator updates: %w", err)
 		}
 
@@ -76,6 +105,13 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 		if err := k.SendVSCPackets(ctx); err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer validator updates: %w", err)
 		}
+
+		k.StartNewEpoch(ctx, numConsumersWithPackets)
+	}
+
+	// send the VSC packets removing validators (e.g., tombstoned validators) right away instead of at the end of the epoch
+	if err := k.SendValidatorRemovals(ctx); err != nil {
+		return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer validator removals: %w", err)
 	}
 
 	return valUpdates, nil
@@ -138,6 +174,76 @@ func (k Keeper) BlocksUntilNextEpoch(ctx sdk.Context) int64 {
 	}
 }
 
+// GetCurrentEpochInfo returns the information about the current epoch. If the epoch info was not yet
+// materialized in the store (i.e., no epoch started since), then the current epoch has index zero and
+// started at the last height that is a multiple of BlocksPerEpoch (or at genesis).
+func (k Keeper) GetCurrentEpochInfo(ctx sdk.Context) providertypes.EpochInfo {
+	if epochInfo, found := k.GetEpochInfo(ctx); found {
+		return epochInfo
+	}
+	return providertypes.EpochInfo{
+		Index:       0,
+		StartHeight: ctx.BlockHeight() - ctx.BlockHeight()%k.GetBlocksPerEpoch(ctx),
+	}
+}
+
+// StartNewEpoch materializes the start of a new epoch in the current block (i.e., the block in which
+// VSC packets are queued) and emits an event for the end of the previous epoch containing the number of
+// consumer chains for which VSC packets were queued.
+//
+// The average block time of the previous epoch is computed from the time elapsed since it started and
+// used to estimate the start time of the next epoch until blocks of the new epoch are available.
+func (k Keeper) StartNewEpoch(ctx sdk.Context, numConsumersWithPackets int) {
+	prevEpochInfo, found := k.GetEpochInfo(ctx)
+	if !found {
+		// the start of the previous epoch was not materialized
+		prevEpochInfo.StartHeight = max(0, ctx.BlockHeight()-k.GetBlocksPerEpoch(ctx))
+	}
+
+	averageBlockTime := prevEpochInfo.AverageBlockTime
+	if found && ctx.BlockHeight() > prevEpochInfo.StartHeight {
+		averageBlockTime = ctx.BlockTime().Sub(prevEpochInfo.StartTime) / time.Duration(ctx.BlockHeight()-prevEpochInfo.StartHeight)
+	}
+
+	k.SetEpochInfo(ctx, providertypes.EpochInfo{
+		Index:            prevEpochInfo.Index + 1,
+		StartHeight:      ctx.BlockHeight(),
+		StartTime:        ctx.BlockTime(),
+		AverageBlockTime: averageBlockTime,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeEpochEnd,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeEpochIndex, strconv.FormatUint(prevEpochInfo.Index, 10)),
+			sdk.NewAttribute(providertypes.AttributeEpochStartHeight, strconv.FormatInt(prevEpochInfo.StartHeight, 10)),
+			sdk.NewAttribute(providertypes.AttributeNumConsumersWithPackets, strconv.Itoa(numConsumersWithPackets)),
+		),
+	)
+}
+
+// NextEpochStartHeight returns the height of the first block of the next epoch, i.e., the next block
+// (strictly after the current one) in which VSC packets are queued. Note that the height depends on the
+// current value of BlocksPerEpoch, i.e., changing BlocksPerEpoch in the middle of an epoch moves the start
+// of the next epoch to the next height that is a multiple of the new value.
+func (k Keeper) NextEpochStartHeight(ctx sdk.Context) int64 {
+	if blocksUntilNextEpoch := k.BlocksUntilNextEpoch(ctx); blocksUntilNextEpoch > 0 {
+		return ctx.BlockHeight() + blocksUntilNextEpoch
+	}
+	return ctx.BlockHeight() + k.GetBlocksPerEpoch(ctx)
+}
+
+// GetExpectedEpochDuration returns the expected duration of an epoch, i.e., BlocksPerEpoch times the average
+// block time of the previous epoch, and whether the average block time is known, i.e., false before the first epoch ends
+func (k Keeper) GetExpectedEpochDuration(ctx sdk.Context) (time.Duration, bool) {
+	epochInfo, found := k.GetEpochInfo(ctx)
+	if !found || epochInfo.AverageBlockTime <= 0 {
+		return 0, false
+	}
+	return time.Duration(k.GetBlocksPerEpoch(ctx)) * epochInfo.AverageBlockTime, true
+}
+
 // SendVSCPackets iterates over all consumers chains with created IBC clients
 // and sends pending VSC packets to the chains with established CCV channels.
 // If the CCV channel is not established for a consumer chain,
@@ -164,6 +270,22 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 // SendVSCPacketsToChain sends all queued VSC packets to the specified chain
 func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId string) error {
 	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
+	if len(pendingPackets) == 0 {
+		return nil
+	}
+
+	// the VSC packets carry the provider fee pool address only if it differs from the address
+	// last communicated to the consumer chain; note that if no address is recorded (e.g., for
+	// CCV channels established before the address was recorded), the address communicated
+	// during the CCV channel handshake is assumed to be the current one
+	feePoolAddr := k.GetConsumerRewardsPoolAddressStr(ctx)
+	lastFeePoolAddr, found := k.GetConsumerProviderFeePoolAddr(ctx, consumerId)
+	if !found {
+		k.SetConsumerProviderFeePoolAddr(ctx, consumerId, feePoolAddr)
+	} else if lastFeePoolAddr != feePoolAddr {
+		pendingPackets[0].ProviderFeePoolAddr = feePoolAddr
+	}
+
 	for _, data := range pendingPackets {
 		// send packet over IBC
 		err := ccv.SendIBCPacket(
@@ -173,7 +295,7 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			channelId,          // source channel id
 			ccv.ProviderPortID, // source port id
 			data.GetBytes(),
-			k.GetCCVTimeoutPeriod(ctx),
+			k.GetConsumerCCVTimeoutPeriod(ctx, consumerId),
 		)
 		if err != nil {
 			if errors.Is(err, clienttypes.ErrClientNotActive) {
@@ -189,13 +311,22 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			// Not able to send packet over IBC!
 			k.Logger(ctx).Error("cannot send VSC, removing consumer:", "consumerId", consumerId, "vscid", data.ValsetUpdateId, "err", err.Error())
 
-			err := k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
+			err := k.StopAndPrepareForConsumerRemoval(ctx, consumerId, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_ERROR,
+				fmt.Sprintf("cannot send VSC packet %d: %s", data.ValsetUpdateId, err))
 			if err != nil {
 				k.Logger(ctx).Info("consumer chain failed to stop:", "consumerId", consumerId, "error", err.Error())
 				// return fmt.Errorf("stopping consumer, consumerId(%s): %w", consumerId, err)
 			}
 			return nil
 		}
+		if data.ProviderFeePoolAddr != "" {
+			k.SetConsumerProviderFeePoolAddr(ctx, consumerId, data.ProviderFeePoolAddr)
+			k.Logger(ctx).Info("provider fee pool address sent to consumer chain",
+				"consumerId", consumerId,
+				"vscid", data.ValsetUpdateId,
+				"address", data.ProviderFeePoolAddr,
+			)
+		}
 	}
 	k.DeletePendingVSCPackets(ctx, consumerId)
 
@@ -203,57 +334,75 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 }
 
 // QueueVSCPackets queues latest validator updates for every consumer chain
-// with the IBC client created.
+// with the IBC client created. It returns the number of consumer chains
+// for which a VSC packet was queued.
 //
 // TODO (mpoke): iterate only over consumers with established channel -- GetAllChannelToConsumers
-func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
+func (k Keeper) QueueVSCPackets(ctx sdk.Context) (int, error) {
 	valUpdateID := k.GetValidatorSetUpdateId(ctx) // current valset update ID
 
-	// get the bonded validators from the staking module
-	bondedValidators, err := k.GetLastBondedValidators(ctx)
+	// get the bonded validators and the provider active validators from the staking module
+	// once for all the consumer chains
+	validatorSet, err := k.GetLastBondedValidatorSet(ctx)
 	if err != nil {
-		return fmt.Errorf("getting bonded validators: %w", err)
-	}
-
-	// get the provider active validators
-	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
-	if err != nil {
-		return fmt.Errorf("getting provider active validators: %w", err)
+		return 0, fmt.Errorf("getting bonded validators: %w", err)
 	}
 
+	numConsumersWithPackets := 0
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
 		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
 			// only queue VSCPackets to launched chains
 			continue
 		}
 
+		if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); found && k.UpdateConsumerDormancy(ctx, consumerId) {
+			// do not queue VSCPackets to dormant chains; the validator updates accumulate
+			// (as the consumer validator set is not updated) and are sent once the chain resumes
+			continue
+		}
+
 		currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
 		if err != nil {
-			return fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
+			return 0, fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
 		}
 
 		// compute consumer next validator set
-		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet)
+		valUpdates, err := k.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, currentValSet)
 		if err != nil {
-			return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+			return 0, fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 		}
 
+		// a VSC packet is also queued to communicate an update of the provider upgrade notice
+		upgradeNotice, hasUpgradeNotice := k.GetProviderUpgradeNoticeUpdate(ctx, consumerId)
+
 		// check whether there are changes in the validator set
-		if len(valUpdates) != 0 {
+		if len(valUpdates) != 0 || hasUpgradeNotice {
 			// construct validator set change packet data
 			packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
+			if hasUpgradeNotice {
+				packet.ProviderUpgradeNotice = &upgradeNotice
+			}
 			k.AppendPendingVSCPackets(ctx, consumerId, packet)
+			numConsumersWithPackets++
+			// map the vscID to the height of the next block, i.e., the first block
+			// in which the new validator set is in effect on the provider
+			k.SetVscIdToHeight(ctx, consumerId, providertypes.VscIdToHeight{
+				VscId:     valUpdateID,
+				Height:    uint64(ctx.BlockHeight()) + 1,
+				Timestamp: ctx.BlockTime(),
+			})
 			k.Logger(ctx).Info("VSCPacket enqueued:",
 				"consumerId", consumerId,
 				"vscID", valUpdateID,
 				"len updates", len(valUpdates),
+				"upgrade notice", hasUpgradeNotice,
 			)
 		}
 	}
 
 	k.IncrementValidatorSetUpdateId(ctx)
 
-	return nil
+	return numConsumersWithPackets, nil
 }
 
 // BeginBlockCIS contains the BeginBlock logic needed for the Consumer Initiated Slashing sub-protocol.
@@ -274,15 +423,71 @@ func (k Keeper) BeginBlockCIS(ctx sdk.Context) {
 // EndBlockCIS contains the EndBlock logic needed for
 // the Consumer Initiated Slashing sub-protocol
 func (k Keeper) EndBlockCIS(ctx sdk.Context) {
-	// set the ValsetUpdateBlockHeight
-	blockHeight := uint64(ctx.BlockHeight()) + 1
-	valUpdateID := k.GetValidatorSetUpdateId(ctx)
-	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
-	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the unbonding period is a staking module parameter.
+		panic(fmt.Errorf("failed to get the unbonding period: %w", err))
+	}
 
-	// prune previous consumer validator addresses that are no longer needed
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		// prune previous consumer validator addresses that are no longer needed
 		k.PruneKeyAssignments(ctx, consumerId)
+		// prune the vscID to height mappings that are no longer needed
+		k.PruneVscIdToHeights(ctx, consumerId, unbondingPeriod)
+		// prune the consumer validator set snapshots that are no longer retained
+		k.PruneConsumerValSetSnapshots(ctx, consumerId)
+		// prune the consumer economic security records that are no longer retained
+		k.PruneConsumerEconomicSecurity(ctx, consumerId)
+		// prune the reward attribution records that are out of the retention window
+		k.PruneRewardAttributionLog(ctx, consumerId)
+		// prune the re-opt-in cooldowns that ended
+		k.PruneReOptInCooldowns(ctx, consumerId)
+	}
+
+	// prune the records of the deleted CCV channels on which no packet can be acknowledged or timed out anymore
+	k.PruneDeletedChannelIds(ctx, unbondingPeriod)
+}
+
+// PruneVscIdToHeights deletes the mappings from the valset update ids sent to the consumer chain
+// with `consumerId` to provider block heights that can no longer be used as infraction heights.
+//
+// The validator set sent with a vscID is in effect on the consumer chain until the validator set
+// sent with the next vscID is applied. As evidence of an infraction is only valid for the unbonding period,
+// a vscID can be pruned once the unbonding period elapsed since the next vscID was sent.
+// Note that the latest vscID is never pruned.
+func (k Keeper) PruneVscIdToHeights(ctx sdk.Context, consumerId string, unbondingPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, providertypes.ConsumerIdAndVscIdToHeightKeyPrefix(consumerId))
+	defer iterator.Close()
+
+	// the mappings are iterated in ascending order of vscIDs, and thus of timestamps,
+	// i.e., the iteration stops at the first mapping that cannot be pruned
+	var prunable []providertypes.VscIdToHeight
+	var prev *providertypes.VscIdToHeight
+	for ; iterator.Valid(); iterator.Next() {
+		var vscIdToHeight providertypes.VscIdToHeight
+		if err := vscIdToHeight.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// vscIdToHeight is assumed to be correctly serialized in SetVscIdToHeight.
+			panic(fmt.Errorf("failed to unmarshal VscIdToHeight: %w", err))
+		}
+		if prev != nil {
+			if ctx.BlockTime().Before(vscIdToHeight.Timestamp.Add(unbondingPeriod)) {
+				break
+			}
+			prunable = append(prunable, *prev)
+		}
+		prev = &vscIdToHeight
+	}
+
+	for _, vscIdToHeight := range prunable {
+		k.DeleteVscIdToHeight(ctx, consumerId, vscIdToHeight.VscId)
+		k.Logger(ctx).Debug("vscID to height mapping was pruned",
+			"consumerId", consumerId,
+			"vscID", vscIdToHeight.VscId,
+			"height", vscIdToHeight.Height,
+		)
 	}
 }
 
@@ -309,6 +514,16 @@ func (k Keeper) OnRecvSlashPacket(
 		return nil, errorsmod.Wrapf(err, "error validating SlashPacket data")
 	}
 
+	k.RecordConsumerActivity(ctx, consumerId)
+
+	// update the chain id of the consumer chain if the consumer client was upgraded to a newer revision
+	if _, err := k.SyncConsumerChainIdWithClient(ctx, consumerId); err != nil {
+		k.Logger(ctx).Error("cannot sync consumer chain id with the consumer client",
+			"consumerId", consumerId,
+			"error", err.Error(),
+		)
+	}
+
 	if err := k.ValidateSlashPacket(ctx, consumerId, packet, data); err != nil {
 		k.Logger(ctx).Error("invalid slash packet",
 			"error", err.Error(),
@@ -437,6 +652,8 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr())
 	if err != nil {
 		k.Logger(ctx).Error("validator not found", "validator", providerConsAddr.String(), "error", err)
+		// persist the routing failure, e.g., for debugging key assignments that were pruned too early
+		k.RecordUnroutableSlashPacket(ctx, consumerId, consumerConsAddr, providerConsAddr, data.ValsetUpdateId)
 		return
 	}
 
@@ -478,6 +695,29 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	// TODO: consumer cons address should be accepted here
 	k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
 
+	// validators are not jailed for downtime infractions committed before their opt-in to the consumer chain became
+	// effective, as they were not part of the consumer validator set at the infraction height
+	if optInHeight, preOptIn := k.IsPreOptInInfraction(ctx, consumerId, providerConsAddr, infractionHeight); preOptIn {
+		k.Logger(ctx).Info("HandleSlashPacket - validator not jailed due to an infraction committed before its opt-in",
+			"provider cons addr", providerConsAddr.String(),
+			"infractionHeight", infractionHeight,
+			"optInHeight", optInHeight,
+		)
+		k.RecordPreOptInInfraction(ctx, consumerId, providerConsAddr, consumerConsAddr, data.ValsetUpdateId, infractionHeight, optInHeight)
+		return
+	}
+
+	// validators that were automatically opted in because they belong to the top N are not jailed
+	// for downtime during the downtime grace period of the consumer chain
+	if optInTime, withinGracePeriod := k.IsWithinDowntimeGracePeriod(ctx, consumerId, providerConsAddr); withinGracePeriod {
+		k.Logger(ctx).Info("HandleSlashPacket - validator not jailed due to the downtime grace period",
+			"provider cons addr", providerConsAddr.String(),
+			"opt-in time", optInTime,
+		)
+		k.RecordSkippedDowntimeSlash(ctx, consumerId, providerConsAddr, consumerConsAddr, data.ValsetUpdateId, optInTime)
+		return
+	}
+
 	// jail validator
 	if !validator.IsJailed() {
 		err := k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr())
@@ -497,14 +737,24 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 			k.Logger(ctx).Error("failed to set jail duration", "err", err.Error())
 			return
 		}
+
+		// slash packets carry only the vscID, i.e., the consumer infraction height is unknown
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+		k.emitConsumerValidatorJailedEvent(ctx, consumerId, chainId, data.Infraction,
+			0, infractionHeight, providerConsAddr, consumerConsAddr)
 	}
 
+	// the cooldown is also recorded if the validator is already jailed (e.g., for an infraction on another chain),
+	// as it committed an infraction on this consumer chain regardless
+	k.RecordReOptInCooldown(ctx, consumerId, providerConsAddr)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			providertypes.EventTypeExecuteConsumerChainSlash,
 			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
 			sdk.NewAttribute(ccv.AttributeValidatorAddress, providerConsAddr.String()),
 			sdk.NewAttribute(ccv.AttributeInfractionType, data.Infraction.String()),
+			sdk.NewAttribute(ccv.AttributeInfractionTypeValue, strconv.Itoa(int(data.Infraction))),
 			sdk.NewAttribute(providertypes.AttributeInfractionHeight, strconv.Itoa(int(infractionHeight))),
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
 		),
@@ -517,7 +767,38 @@ func (k Keeper) getMappedInfractionHeight(ctx sdk.Context,
 ) (height uint64, found bool) {
 	if valsetUpdateID == 0 {
 		return k.GetInitChainHeight(ctx, consumerId)
-	} else {
-		return k.GetValsetUpdateBlockHeight(ctx, valsetUpdateID)
 	}
+	vscIdToHeight, found := k.GetVscIdToHeight(ctx, consumerId, valsetUpdateID)
+	return vscIdToHeight.Height, found
+}
+
+// emitConsumerValidatorJailedEvent emits an event for a validator that was jailed on the provider for an
+// infraction committed on the consumer chain with the given consumer id and chain id. The consumer infraction
+// height is omitted if it is unknown (i.e., zero), which is the case for downtime infractions.
+func (k Keeper) emitConsumerValidatorJailedEvent(
+	ctx sdk.Context,
+	consumerId, chainId string,
+	infraction stakingtypes.Infraction,
+	consumerInfractionHeight, providerInfractionHeight uint64,
+	providerAddr providertypes.ProviderConsAddress,
+	consumerAddr providertypes.ConsumerConsAddress,
+) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+		sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+		sdk.NewAttribute(providertypes.AttributeConsumerChainId, chainId),
+		sdk.NewAttribute(ccv.AttributeInfractionType, infraction.String()),
+		sdk.NewAttribute(ccv.AttributeInfractionTypeValue, strconv.Itoa(int(infraction))),
+	}
+	if consumerInfractionHeight != 0 {
+		attributes = append(attributes,
+			sdk.NewAttribute(providertypes.AttributeConsumerInfractionHeight, strconv.FormatUint(consumerInfractionHeight, 10)))
+	}
+	attributes = append(attributes,
+		sdk.NewAttribute(providertypes.AttributeProviderInfractionHeight, strconv.FormatUint(providerInfractionHeight, 10)),
+		sdk.NewAttribute(providertypes.AttributeProviderValidatorAddress, providerAddr.String()),
+		sdk.NewAttribute(providertypes.AttributeConsumerValidatorAddress, consumerAddr.String()),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(providertypes.EventTypeConsumerValidatorJailed, attributes...))
 }
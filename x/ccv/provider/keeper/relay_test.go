@@ -17,6 +17,7 @@ import (
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -77,7 +78,7 @@ func TestQueueVSCPackets(t *testing.T) {
 		// no-op if tc.packets is empty
 		pk.AppendPendingVSCPackets(ctx, chainID, tc.packets...)
 
-		err := pk.QueueVSCPackets(ctx)
+		_, err := pk.QueueVSCPackets(ctx)
 		require.NoError(t, err)
 		pending := pk.GetPendingVSCPackets(ctx, chainID)
 		require.Len(t, pending, tc.expectedQueueSize, "pending vsc queue mismatch (%v != %v) in case: '%s'", tc.expectedQueueSize, len(pending), tc.name)
@@ -89,6 +90,45 @@ func TestQueueVSCPackets(t *testing.T) {
 	}
 }
 
+// TestPruneVscIdToHeights tests that a vscID to height mapping is pruned only
+// once the unbonding period elapsed since the next vscID was sent
+func TestPruneVscIdToHeights(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 10 * time.Hour
+	now := time.Now().UTC()
+
+	vscIdToHeights := []providertypes.VscIdToHeight{
+		{VscId: 1, Height: 10, Timestamp: now},
+		{VscId: 3, Height: 20, Timestamp: now.Add(time.Hour)},
+		{VscId: 4, Height: 30, Timestamp: now.Add(2 * time.Hour)},
+	}
+	for _, vscIdToHeight := range vscIdToHeights {
+		providerKeeper.SetVscIdToHeight(ctx, CONSUMER_ID, vscIdToHeight)
+	}
+	// a mapping of another consumer chain that should not be pruned
+	providerKeeper.SetVscIdToHeight(ctx, "1", providertypes.VscIdToHeight{VscId: 2, Height: 15, Timestamp: now})
+
+	// the unbonding period did not elapse since vscID 3 was sent
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + unbondingPeriod - time.Second))
+	providerKeeper.PruneVscIdToHeights(ctx, CONSUMER_ID, unbondingPeriod)
+	require.Equal(t, vscIdToHeights, providerKeeper.GetAllVscIdToHeights(ctx, CONSUMER_ID))
+
+	// the unbonding period elapsed since vscID 3 was sent
+	ctx = ctx.WithBlockTime(now.Add(time.Hour + unbondingPeriod))
+	providerKeeper.PruneVscIdToHeights(ctx, CONSUMER_ID, unbondingPeriod)
+	require.Equal(t, vscIdToHeights[1:], providerKeeper.GetAllVscIdToHeights(ctx, CONSUMER_ID))
+
+	// the latest vscID is never pruned
+	ctx = ctx.WithBlockTime(now.Add(100 * unbondingPeriod))
+	providerKeeper.PruneVscIdToHeights(ctx, CONSUMER_ID, unbondingPeriod)
+	require.Equal(t, vscIdToHeights[2:], providerKeeper.GetAllVscIdToHeights(ctx, CONSUMER_ID))
+
+	_, found := providerKeeper.GetVscIdToHeight(ctx, "1", 2)
+	require.True(t, found)
+}
+
 // TestQueueVSCPacketsDoesNotResetConsumerValidatorsHeights checks that the heights of consumer validators are not
 // getting incorrectly updated
 func TestQueueVSCPacketsDoesNotResetConsumerValidatorsHeights(t *testing.T) {
@@ -132,8 +172,10 @@ func TestQueueVSCPacketsDoesNotResetConsumerValidatorsHeights(t *testing.T) {
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
 	require.NoError(t, err)
 
-	err = providerKeeper.QueueVSCPackets(ctx)
+	numConsumersWithPackets, err := providerKeeper.QueueVSCPackets(ctx)
 	require.NoError(t, err)
+	// validator B joins the consumer validator set
+	require.Equal(t, 1, numConsumersWithPackets)
 
 	// the height of consumer validator A should not be modified because A was already a consumer validator
 	cv, _ := providerKeeper.GetConsumerValidator(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valAConsAddr))
@@ -166,7 +208,9 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOWNTIME
 
 	// Set a block height for the valset update id in the generated packet data
-	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+	for _, consumerId := range []string{consumerId0, consumerId1} {
+		providerKeeper.SetVscIdToHeight(ctx, consumerId, providertypes.VscIdToHeight{VscId: packetData.ValsetUpdateId, Height: 15})
+	}
 
 	// Set consumer validator
 	err := providerKeeper.SetConsumerValidator(ctx, consumerId0, providertypes.ConsensusValidator{
@@ -242,7 +286,7 @@ func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
 
 	// Set a block height for the valset update id in the generated packet data
-	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+	providerKeeper.SetVscIdToHeight(ctx, "chain-1", providertypes.VscIdToHeight{VscId: packetData.ValsetUpdateId, Height: 15})
 
 	// Receive the double-sign slash packet for chain-1 and confirm the expected acknowledgement
 	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", 1, packetData)
@@ -275,6 +319,8 @@ func executeOnRecvSlashPacket(t *testing.T, providerKeeper *keeper.Keeper, ctx s
 // TestValidateSlashPacket tests ValidateSlashPacket.
 func TestValidateSlashPacket(t *testing.T) {
 	validVscID := uint64(98)
+	otherConsumerVscID := uint64(62)
+	legacyVscID := uint64(50)
 
 	testCases := []struct {
 		name       string
@@ -286,6 +332,16 @@ func TestValidateSlashPacket(t *testing.T) {
 			ccv.SlashPacketData{ValsetUpdateId: 61},
 			true,
 		},
+		{
+			"block height found for given vscID only for another consumer chain",
+			ccv.SlashPacketData{ValsetUpdateId: otherConsumerVscID, Infraction: stakingtypes.Infraction_INFRACTION_DOWNTIME},
+			true,
+		},
+		{
+			"block height found for given vscID only in the legacy mapping",
+			ccv.SlashPacketData{ValsetUpdateId: legacyVscID, Infraction: stakingtypes.Infraction_INFRACTION_DOWNTIME},
+			true,
+		},
 		{
 			"valid double sign packet with non-zero vscID",
 			ccv.SlashPacketData{ValsetUpdateId: validVscID, Infraction: stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN},
@@ -322,7 +378,9 @@ func TestValidateSlashPacket(t *testing.T) {
 		providerKeeper.SetInitChainHeight(ctx, "consumer-chain-id", uint64(89))
 
 		// Setup valset update ID to block height mapping using var instantiated above.
-		providerKeeper.SetValsetUpdateBlockHeight(ctx, validVscID, uint64(100))
+		providerKeeper.SetVscIdToHeight(ctx, "consumer-chain-id", providertypes.VscIdToHeight{VscId: validVscID, Height: 100})
+		providerKeeper.SetVscIdToHeight(ctx, "other-consumer-chain-id", providertypes.VscIdToHeight{VscId: otherConsumerVscID, Height: 70})
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, legacyVscID, uint64(60))
 
 		// Test error behavior as specified in tc.
 		err := providerKeeper.ValidateSlashPacket(ctx, "consumer-chain-id", packet, tc.packetData)
@@ -338,6 +396,7 @@ func TestValidateSlashPacket(t *testing.T) {
 // Note that only downtime slash packets are processed by HandleSlashPacket.
 func TestHandleSlashPacket(t *testing.T) {
 	chainId := "consumer-id"
+	consumerChainId := "consumer-chain-id"
 	validVscID := uint64(234)
 
 	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
@@ -350,6 +409,7 @@ func TestHandleSlashPacket(t *testing.T) {
 		expectedCalls                       func(sdk.Context, testkeeper.MockedKeepers, ccv.SlashPacketData) []*gomock.Call
 		expectedSlashAcksLen                int
 		expectedSlashAckConsumerConsAddress providertypes.ConsumerConsAddress
+		expectedJailedEvent                 bool
 	}{
 		{
 			"unfound validator",
@@ -372,6 +432,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"found, but tombstoned validator",
@@ -395,6 +456,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"drop packet when infraction height not found",
@@ -419,6 +481,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"full downtime packet handling, uses init chain height and non-jailed validator",
@@ -437,6 +500,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			1,
 			consumerConsAddr,
+			true,
 		},
 		{
 			"full downtime packet handling, uses valid vscID and jailed validator",
@@ -455,6 +519,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			1,
 			consumerConsAddr,
+			false,
 		},
 		// Note: double-sign slash packet handling should not occur, see OnRecvSlashPacket.
 	}
@@ -467,9 +532,11 @@ func TestHandleSlashPacket(t *testing.T) {
 			// Setup expected mock calls
 			gomock.InOrder(tc.expectedCalls(ctx, mocks, tc.packetData)...)
 
+			providerKeeper.SetConsumerChainId(ctx, chainId, consumerChainId)
+
 			// Setup init chain height and a single valid valset update ID to block height mapping.
 			providerKeeper.SetInitChainHeight(ctx, chainId, 5)
-			providerKeeper.SetValsetUpdateBlockHeight(ctx, validVscID, 99)
+			providerKeeper.SetVscIdToHeight(ctx, chainId, providertypes.VscIdToHeight{VscId: validVscID, Height: 99})
 
 			// Setup consumer address to provider address mapping.
 			require.NotEmpty(t, tc.packetData.Validator.Address)
@@ -489,6 +556,36 @@ func TestHandleSlashPacket(t *testing.T) {
 				require.NotEqual(t, providerConsAddr.String(), consumerConsAddr.String())
 			}
 
+			// a consumer_validator_jailed event is emitted only if the validator is jailed
+			var jailedEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == providertypes.EventTypeConsumerValidatorJailed {
+					jailedEvents = append(jailedEvents, event)
+				}
+			}
+			if tc.expectedJailedEvent {
+				require.Len(t, jailedEvents, 1)
+				expectedAttributes := map[string]string{
+					providertypes.AttributeConsumerId:               chainId,
+					providertypes.AttributeConsumerChainId:          consumerChainId,
+					ccv.AttributeInfractionType:                     stakingtypes.Infraction_INFRACTION_DOWNTIME.String(),
+					ccv.AttributeInfractionTypeValue:                "2",
+					providertypes.AttributeProviderInfractionHeight: "5", // the init chain height
+					providertypes.AttributeProviderValidatorAddress: providerConsAddr.String(),
+					providertypes.AttributeConsumerValidatorAddress: consumerConsAddr.String(),
+				}
+				for key, value := range expectedAttributes {
+					attr, found := jailedEvents[0].GetAttribute(key)
+					require.True(t, found, key)
+					require.Equal(t, value, attr.Value, key)
+				}
+				// the consumer infraction height is unknown for downtime infractions
+				_, found := jailedEvents[0].GetAttribute(providertypes.AttributeConsumerInfractionHeight)
+				require.False(t, found)
+			} else {
+				require.Empty(t, jailedEvents)
+			}
+
 			ctrl.Finish()
 		})
 	}
@@ -526,6 +623,8 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 
 	unbondingTime := 123 * time.Second
 	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(
+		authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)).AnyTimes()
 
 	// No error should occur, DeleteConsumerChain should be called
 	err = providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID")
@@ -535,6 +634,9 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
 	require.NoError(t, err)
 	require.Equal(t, ctx.BlockTime().Add(unbondingTime), removalTime)
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_ERROR, removalRecord.Reason)
 
 	// Increase the block time by `unbondingTime` so the chain actually gets deleted
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
@@ -544,9 +646,110 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	// Pending VSC packets should be deleted in DeleteConsumerChain
 	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
 	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	// the removal reason is retained
+	_, found = providerKeeper.GetConsumerRemovalRecord(ctx, CONSUMER_ID)
+	require.True(t, found)
+}
+
+// TestSendVSCPacketsToChainWithUpdatedTimeout tests that VSC packets sent after the CCV timeout period
+// of a consumer chain is updated carry the new timeout, while packets sent before keep the previous one
+func TestSendVSCPacketsToChainWithUpdatedTimeout(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	var sentTimeouts []uint64
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "CCVChannelID").Return(
+		channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(
+		&capabilitytypes.Capability{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), ccv.ProviderPortID, "CCVChannelID",
+		clienttypes.Height{}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, timeoutTimestamp uint64, _ []byte) (uint64, error) {
+			sentTimeouts = append(sentTimeouts, timeoutTimestamp)
+			return uint64(len(sentTimeouts)), nil
+		}).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(
+		authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)).AnyTimes()
+
+	// the packet sent before the update uses the CcvTimeoutPeriod param
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID"))
+	require.Equal(t, []uint64{uint64(ctx.BlockTime().Add(providerKeeper.GetCCVTimeoutPeriod(ctx)).UnixNano())}, sentTimeouts)
+
+	// update the CCV timeout period of the consumer chain
+	newCcvTimeoutPeriod := 3 * time.Hour
+	err := providerKeeper.SetConsumerTimeoutPeriods(ctx, CONSUMER_ID, providertypes.ConsumerTimeoutPeriods{
+		CcvTimeoutPeriod:      newCcvTimeoutPeriod,
+		TransferTimeoutPeriod: time.Hour,
+	})
+	require.NoError(t, err)
+
+	// the newly queued packets carry the new timeout, while the in-flight packet is unaffected
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2}, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 3})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID"))
+	require.Len(t, sentTimeouts, 3)
+	require.Equal(t, uint64(ctx.BlockTime().Add(-time.Minute).Add(providerKeeper.GetCCVTimeoutPeriod(ctx)).UnixNano()), sentTimeouts[0])
+	for _, timeout := range sentTimeouts[1:] {
+		require.Equal(t, uint64(ctx.BlockTime().Add(newCcvTimeoutPeriod).UnixNano()), timeout)
+	}
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
 }
 
 // TestOnTimeoutPacketWithNoChainFound tests the `OnTimeoutPacket` method fails when no chain is found
+// TestSendVSCPacketsToChainWithUpdatedProviderFeePoolAddr tests that the provider fee pool address is sent
+// to a consumer chain only if it differs from the address last communicated to the consumer chain
+func TestSendVSCPacketsToChainWithUpdatedProviderFeePoolAddr(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	var sentPackets []ccv.ValidatorSetChangePacketData
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "CCVChannelID").Return(
+		channeltypes.Channel{}, true).AnyTimes()
+	mocks.MockScopedKeeper.EXPECT().GetCapability(gomock.Any(), gomock.Any()).Return(
+		&capabilitytypes.Capability{}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), gomock.Any(), ccv.ProviderPortID, "CCVChannelID",
+		clienttypes.Height{}, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, data []byte) (uint64, error) {
+			var packetData ccv.ValidatorSetChangePacketData
+			require.NoError(t, ccv.ModuleCdc.UnmarshalJSON(data, &packetData))
+			sentPackets = append(sentPackets, packetData)
+			return uint64(len(sentPackets)), nil
+		}).AnyTimes()
+	moduleAcct := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), providertypes.ConsumerRewardsPool).Return(moduleAcct).AnyTimes()
+
+	// without a recorded address, the current address is assumed to be the one communicated during the handshake
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID"))
+	require.Len(t, sentPackets, 1)
+	require.Empty(t, sentPackets[0].ProviderFeePoolAddr)
+	feePoolAddr, found := providerKeeper.GetConsumerProviderFeePoolAddr(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, moduleAcct.GetAddress().String(), feePoolAddr)
+
+	// the address is sent once, in the first VSC packet sent after it changed
+	newFeePoolAddr := sdk.AccAddress([]byte("new-fee-pool-address")).String()
+	moduleAcct.Address = newFeePoolAddr
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2}, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 3})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID"))
+	require.Len(t, sentPackets, 3)
+	require.Equal(t, newFeePoolAddr, sentPackets[1].ProviderFeePoolAddr)
+	require.Empty(t, sentPackets[2].ProviderFeePoolAddr)
+	feePoolAddr, found = providerKeeper.GetConsumerProviderFeePoolAddr(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, newFeePoolAddr, feePoolAddr)
+
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 4})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID"))
+	require.Len(t, sentPackets, 4)
+	require.Empty(t, sentPackets[3].ProviderFeePoolAddr)
+}
+
 func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	// Keeper setup
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -587,6 +790,9 @@ func TestOnTimeoutPacketStopsChain(t *testing.T) {
 	}
 	err := providerKeeper.OnTimeoutPacket(ctx, packet)
 	require.NoError(t, err)
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT, removalRecord.Reason)
 
 	// increase the block time by `unbondingTime` so the chain actually gets deleted
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
@@ -594,6 +800,14 @@ func TestOnTimeoutPacketStopsChain(t *testing.T) {
 	require.NoError(t, err)
 
 	testkeeper.TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t, ctx, providerKeeper, CONSUMER_ID, "channelID", false)
+
+	// timeouts of packets that were still in flight on the channel of the deleted chain are ignored
+	consumerId, found := providerKeeper.GetDeletedChannelIdToConsumerId(ctx, "channelID")
+	require.True(t, found)
+	require.Equal(t, CONSUMER_ID, consumerId)
+	err = providerKeeper.OnTimeoutPacket(ctx, packet)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
 }
 
 // TestOnAcknowledgementPacketWithNoAckError tests `OnAcknowledgementPacket` when the underlying ack contains no error
@@ -640,6 +854,10 @@ func TestOnAcknowledgementPacketWithAckError(t *testing.T) {
 
 	err = providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError)
 	require.NoError(t, err)
+	removalRecord, found := providerKeeper.GetConsumerRemovalRecord(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.CONSUMER_REMOVAL_REASON_CHANNEL_ERROR, removalRecord.Reason)
+	require.Contains(t, removalRecord.Note, "some error")
 
 	// increase the block time by `unbondingTime` so the chain actually gets deleted
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
@@ -647,6 +865,11 @@ func TestOnAcknowledgementPacketWithAckError(t *testing.T) {
 	require.NoError(t, err)
 
 	testkeeper.TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t, ctx, providerKeeper, CONSUMER_ID, "channelID", false)
+
+	// error acknowledgements of packets that were still in flight on the channel of the deleted chain are ignored
+	err = providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
 }
 
 // TestEndBlockVSU tests that during `EndBlockVSU`, we only queue VSC packets at the boundaries of an epoch
@@ -702,11 +925,30 @@ func TestEndBlockVSU(t *testing.T) {
 	require.Equal(t, 0, len(providerKeeper.GetPendingVSCPackets(ctx, consumerId)))
 
 	// with block height of 10 we expect the queueing of one VSC packet
-	ctx = ctx.WithBlockHeight(10)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
 	_, err = providerKeeper.EndBlockVSU(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(providerKeeper.GetPendingVSCPackets(ctx, consumerId)))
 
+	// the end of the epoch is emitted and a new epoch starts
+	epochEndEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeEpochEnd {
+			epochEndEvents++
+			attr, found := event.GetAttribute(providertypes.AttributeNumConsumersWithPackets)
+			require.True(t, found)
+			require.Equal(t, "1", attr.Value)
+			attr, found = event.GetAttribute(providertypes.AttributeEpochIndex)
+			require.True(t, found)
+			require.Equal(t, "0", attr.Value)
+		}
+	}
+	require.Equal(t, 1, epochEndEvents)
+	epochInfo, found := providerKeeper.GetEpochInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(1), epochInfo.Index)
+	require.Equal(t, int64(10), epochInfo.StartHeight)
+
 	// With block height of 15 we expect no additional queueing of a VSC packet.
 	// Note that the pending VSC packet is still there because `SendVSCPackets` does not send the packet. We
 	// need to mock channels, etc. for this to work, and it's out of scope for this test.
@@ -839,7 +1081,7 @@ func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 	params.MaxProviderConsensusValidators = 180
 	providerKeeper.SetParams(ctx, params)
 
-	err = providerKeeper.QueueVSCPackets(ctx)
+	_, err = providerKeeper.QueueVSCPackets(ctx)
 	require.NoError(t, err)
 
 	actualQueuedVSCPackets := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
@@ -872,6 +1114,163 @@ func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 	require.Equal(t, expectedQueuedVSCPackets, actualQueuedVSCPackets)
 }
 
+// TestQueueVSCPacketsWithMaxConsumerParticipation tests that a validator opted in to more consumer chains
+// than the max consumer participation allows is only included in the consumer chains it opted in to first
+func TestQueueVSCPacketsWithMaxConsumerParticipation(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
+
+	validators, providerAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1, 2)
+	valA, valB := validators[0], validators[1]
+	valAPubKey, _ := valA.CmtConsPublicKey()
+	valBPubKey, _ := valB.CmtConsPublicKey()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 5, validators, -1)
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 180
+	params.MaxConsumerParticipation = 2
+	providerKeeper.SetParams(ctx, params)
+
+	// launch three opt-in consumer chains
+	numConsumers := int(params.MaxConsumerParticipation) + 1
+	consumerIds := make([]string, numConsumers)
+	for i := range consumerIds {
+		consumerIds[i] = providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerClientId(ctx, consumerIds[i], "clientId")
+		providerKeeper.SetConsumerPhase(ctx, consumerIds[i], providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerIds[i], providertypes.PowerShapingParameters{})
+		require.NoError(t, err)
+	}
+
+	// validator A opts in to all the consumer chains, the second one last,
+	// while validator B opts in only to the second consumer chain
+	optInTime := ctx.BlockTime()
+	optInOrder := []string{consumerIds[2], consumerIds[0], consumerIds[1]}
+	for i, consumerId := range optInOrder {
+		providerKeeper.SetOptedIn(ctx, consumerId, providerAddrs[0])
+		providerKeeper.SetOptInTime(ctx, consumerId, providerAddrs[0], optInTime.Add(time.Duration(i)*time.Hour))
+	}
+	providerKeeper.SetOptedIn(ctx, consumerIds[1], providerAddrs[1])
+	providerKeeper.SetOptInTime(ctx, consumerIds[1], providerAddrs[1], optInTime)
+
+	_, err := providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// validator A is excluded from the consumer chain it opted in to last
+	require.Equal(t, []ccv.ValidatorSetChangePacketData{
+		ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: valAPubKey, Power: 1}}, 1, nil),
+	}, providerKeeper.GetPendingVSCPackets(ctx, consumerIds[0]))
+	require.Equal(t, []ccv.ValidatorSetChangePacketData{
+		ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: valBPubKey, Power: 2}}, 1, nil),
+	}, providerKeeper.GetPendingVSCPackets(ctx, consumerIds[1]))
+	require.Equal(t, []ccv.ValidatorSetChangePacketData{
+		ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: valAPubKey, Power: 1}}, 1, nil),
+	}, providerKeeper.GetPendingVSCPackets(ctx, consumerIds[2]))
+
+	// no eviction event is emitted, as validator A was never included in the consumer chain it is excluded from
+	require.Empty(t, getEvictedEvents(ctx))
+
+	// once validator A opts out from one of the consumer chains it is included in,
+	// it is included in the consumer chain it was excluded from in the next epoch
+	providerKeeper.DeleteOptedIn(ctx, consumerIds[2], providerAddrs[0])
+	providerKeeper.DeleteOptInTime(ctx, consumerIds[2], providerAddrs[0])
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerIds[1])
+	require.NoError(t, err)
+	require.Len(t, valSet, 2)
+	valSet, err = providerKeeper.GetConsumerValSet(ctx, consumerIds[2])
+	require.NoError(t, err)
+	require.Empty(t, valSet)
+
+	// once the max consumer participation is lowered, validator A is evicted from the consumer chain
+	// it opted in to last and an eviction event is emitted for it
+	params.MaxConsumerParticipation = 1
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	valSet, err = providerKeeper.GetConsumerValSet(ctx, consumerIds[1])
+	require.NoError(t, err)
+	require.Len(t, valSet, 1)
+	evictedEvents := getEvictedEvents(ctx)
+	require.Len(t, evictedEvents, 1)
+	expectedAttributes := map[string]string{
+		providertypes.AttributeConsumerId:               consumerIds[1],
+		providertypes.AttributeProviderValidatorAddress: providerAddrs[0].String(),
+		providertypes.AttributeEvictionReason:           providertypes.EvictionReasonMaxConsumerParticipation,
+	}
+	for key, value := range expectedAttributes {
+		attr, found := evictedEvents[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+
+	// the eviction event is not emitted again in the next epoch
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, getEvictedEvents(ctx))
+}
+
+// TestQueueVSCPacketsWithMaxConsumerParticipationTopN tests that the validators that are automatically opted in
+// to a Top N consumer chain count towards the max consumer participation of the consumer chains whose validator sets
+// are computed afterwards in the same epoch
+func TestQueueVSCPacketsWithMaxConsumerParticipationTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
+
+	validators, providerAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 5, validators, -1)
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 180
+	params.MaxConsumerParticipation = 1
+	providerKeeper.SetParams(ctx, params)
+
+	// launch two Top N consumer chains
+	consumerIds := make([]string, 2)
+	for i := range consumerIds {
+		consumerIds[i] = providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerClientId(ctx, consumerIds[i], "clientId")
+		providerKeeper.SetConsumerPhase(ctx, consumerIds[i], providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerIds[i], providertypes.PowerShapingParameters{Top_N: 100})
+		require.NoError(t, err)
+	}
+
+	_, err := providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	// the validator is opted in to both consumer chains, but only included in the first one
+	for _, consumerId := range consumerIds {
+		require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddrs[0]))
+	}
+	valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerIds[0])
+	require.NoError(t, err)
+	require.Len(t, valSet, 1)
+	valSet, err = providerKeeper.GetConsumerValSet(ctx, consumerIds[1])
+	require.NoError(t, err)
+	require.Empty(t, valSet)
+}
+
+// getEvictedEvents returns the consumer_validator_evicted events emitted in `ctx`
+func getEvictedEvents(ctx sdk.Context) []sdk.Event {
+	var evictedEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerValidatorEvicted {
+			evictedEvents = append(evictedEvents, event)
+		}
+	}
+	return evictedEvents
+}
+
 // TestBlocksUntilNextEpoch tests the `BlocksUntilNextEpoch` method
 func TestBlocksUntilNextEpoch(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
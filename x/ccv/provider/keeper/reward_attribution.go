@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// IsAllowlistedRewardDenom returns true if `denom` is allowlisted as a reward denom for the consumer chain
+// with `consumerId`, either through governance (for all consumer chains) or by the owner of the consumer chain
+func (k Keeper) IsAllowlistedRewardDenom(ctx sdk.Context, consumerId, denom string) bool {
+	if k.ConsumerRewardDenomExists(ctx, denom) {
+		return true
+	}
+	allowlistedDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(allowlistedDenoms, denom)
+}
+
+// RecordRewardAttribution emits a reward attribution event with the decision of the provider on an incoming
+// transfer to the consumer rewards pool. If the transfer is attributed to a known consumer chain, it also appends
+// the decision to the reward attribution log of the consumer chain.
+//
+// Note that the log is bounded to the last MaxRewardAttributionLogEntries records per consumer chain, and that
+// the records older than RewardAttributionLogRetentionPeriod are pruned (see PruneRewardAttributionLog). The records
+// are removed once the state of the consumer chain is cleaned up after its deletion. The log is deterministic, but it is neither exported in genesis nor used by the provider logic.
+func (k Keeper) RecordRewardAttribution(
+	ctx sdk.Context,
+	consumerId string,
+	channelId string,
+	sender string,
+	denom string,
+	amount string,
+	decision types.RewardAttributionDecision,
+	reason string,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardAttribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeReceivedChannelId, channelId),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeRewardDenom, denom),
+			sdk.NewAttribute(types.AttributeRewardAmount, amount),
+			sdk.NewAttribute(types.AttributeRewardAttributionDecision, decision.String()),
+			sdk.NewAttribute(types.AttributeRewardAttributionReason, reason),
+		),
+	)
+
+	// do not store records of unknown consumer chains, as anyone can send a transfer with an arbitrary memo,
+	// nor of deleted consumer chains, whose state may already be cleaned up
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return
+	}
+	if k.GetConsumerPhase(ctx, consumerId) == types.CONSUMER_PHASE_DELETED {
+		return
+	}
+
+	log := k.RewardAttributionLog()
+	record := types.RewardAttributionRecord{
+		Sequence:  log.NextSequence(ctx, consumerId),
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		ChannelId: channelId,
+		Sender:    sender,
+		Denom:     denom,
+		Amount:    amount,
+		Decision:  decision,
+		Reason:    reason,
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to store reward attribution record",
+			"consumerId", consumerId,
+			"sequence", record.Sequence,
+			"error", err.Error(),
+		)
+		return
+	}
+	// append the record and prune the log by count
+	log.Append(ctx, consumerId, record.Sequence, bz)
+}
+
+// SetRewardAttributionRecord stores the reward attribution `record` of the consumer chain with `consumerId`
+func (k Keeper) SetRewardAttributionRecord(ctx sdk.Context, consumerId string, record types.RewardAttributionRecord) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal reward attribution record (%d) for consumer id (%s): %w", record.Sequence, consumerId, err)
+	}
+	k.RewardAttributionLog().Set(ctx, consumerId, record.Sequence, bz)
+	return nil
+}
+
+// DeleteRewardAttributionRecord deletes the reward attribution record with `sequence` of the consumer chain with `consumerId`
+func (k Keeper) DeleteRewardAttributionRecord(ctx sdk.Context, consumerId string, sequence uint64) {
+	k.RewardAttributionLog().Delete(ctx, consumerId, sequence)
+}
+
+// GetRewardAttributionLog returns the reward attribution records of the consumer chain with `consumerId`
+// in ascending order of their sequence numbers, i.e., from the oldest to the newest
+func (k Keeper) GetRewardAttributionLog(ctx sdk.Context, consumerId string) ([]types.RewardAttributionRecord, error) {
+	records := []types.RewardAttributionRecord{}
+	var err error
+	k.RewardAttributionLog().Iterate(ctx, consumerId, func(_ uint64, bz []byte) bool {
+		var record types.RewardAttributionRecord
+		if err = record.Unmarshal(bz); err != nil {
+			err = fmt.Errorf("failed to unmarshal reward attribution record for consumer id (%s): %w", consumerId, err)
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// PruneRewardAttributionLog deletes the reward attribution records of the consumer chain with `consumerId`
+// that are older than RewardAttributionLogRetentionPeriod. As the records are appended in chronological order,
+// the pruning stops at the first record that is still within the retention window.
+func (k Keeper) PruneRewardAttributionLog(ctx sdk.Context, consumerId string) {
+	log := k.RewardAttributionLog()
+	var toDelete []uint64
+	log.Iterate(ctx, consumerId, func(sequence uint64, bz []byte) bool {
+		var record types.RewardAttributionRecord
+		if err := record.Unmarshal(bz); err == nil &&
+			!record.Time.Add(types.RewardAttributionLogRetentionPeriod).Before(ctx.BlockTime()) {
+			return true
+		}
+		// records that cannot be unmarshaled are pruned as well
+		toDelete = append(toDelete, sequence)
+		return false
+	})
+	for _, sequence := range toDelete {
+		log.Delete(ctx, consumerId, sequence)
+	}
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestIsAllowlistedRewardDenom(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerRewardDenom(ctx, "uatom")
+	err := providerKeeper.SetAllowlistedRewardDenoms(ctx, "0", []string{"ibc/denom0"})
+	require.NoError(t, err)
+
+	require.True(t, providerKeeper.IsAllowlistedRewardDenom(ctx, "0", "uatom"))
+	require.True(t, providerKeeper.IsAllowlistedRewardDenom(ctx, "1", "uatom"))
+	require.True(t, providerKeeper.IsAllowlistedRewardDenom(ctx, "0", "ibc/denom0"))
+	require.False(t, providerKeeper.IsAllowlistedRewardDenom(ctx, "1", "ibc/denom0"))
+	require.False(t, providerKeeper.IsAllowlistedRewardDenom(ctx, "0", "ibc/denom1"))
+}
+
+func TestRecordRewardAttribution(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(42)
+	providerKeeper.RecordRewardAttribution(ctx, consumerId, "channel-1", "sender", "ibc/denom", "100",
+		providertypes.REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED, "denom ibc/denom is not allowlisted")
+
+	// verify the event
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeRewardAttribution, events[0].Type)
+	attributes := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, consumerId, attributes[providertypes.AttributeConsumerId])
+	require.Equal(t, "channel-1", attributes[providertypes.AttributeReceivedChannelId])
+	require.Equal(t, "ibc/denom", attributes[providertypes.AttributeRewardDenom])
+	require.Equal(t, "100", attributes[providertypes.AttributeRewardAmount])
+	require.Equal(t, providertypes.REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED.String(),
+		attributes[providertypes.AttributeRewardAttributionDecision])
+
+	// verify the record
+	records, err := providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.RewardAttributionRecord{
+		{
+			Sequence:  0,
+			Height:    42,
+			Time:      ctx.BlockTime(),
+			ChannelId: "channel-1",
+			Sender:    "sender",
+			Denom:     "ibc/denom",
+			Amount:    "100",
+			Decision:  providertypes.REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED,
+			Reason:    "denom ibc/denom is not allowlisted",
+		},
+	}, records)
+
+	// verify that the decisions on transfers attributed to unknown consumer chains are only emitted as events
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.RecordRewardAttribution(ctx, "1", "channel-2", "sender", "ibc/denom", "100",
+		providertypes.REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER, "unknown consumer")
+	require.Len(t, ctx.EventManager().Events(), 1)
+	records, err = providerKeeper.GetRewardAttributionLog(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, records)
+}
+
+func TestRewardAttributionLogPruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	otherConsumerId := "1"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+	providerKeeper.SetConsumerChainId(ctx, otherConsumerId, "other-chain-id")
+
+	numRecords := providertypes.MaxRewardAttributionLogEntries + 10
+	for i := 0; i < numRecords; i++ {
+		providerKeeper.RecordRewardAttribution(ctx, consumerId, "channel-1", "sender", "uatom", fmt.Sprint(i),
+			providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+	}
+	providerKeeper.RecordRewardAttribution(ctx, otherConsumerId, "channel-2", "sender", "uatom", "1",
+		providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+
+	// verify that only the last MaxRewardAttributionLogEntries records are kept, from the oldest to the newest
+	records, err := providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, records, providertypes.MaxRewardAttributionLogEntries)
+	for i, record := range records {
+		expectedSequence := uint64(numRecords - providertypes.MaxRewardAttributionLogEntries + i)
+		require.Equal(t, expectedSequence, record.Sequence)
+		require.Equal(t, fmt.Sprint(expectedSequence), record.Amount)
+	}
+
+	// verify that the records of other consumer chains are not affected
+	records, err = providerKeeper.GetRewardAttributionLog(ctx, otherConsumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(0), records[0].Sequence)
+}
+
+func TestPruneRewardAttributionLog(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+
+	now := time.Now().UTC()
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(now.Add(time.Duration(i) * time.Hour))
+		providerKeeper.RecordRewardAttribution(ctx, consumerId, "channel-1", "sender", "uatom", fmt.Sprint(i),
+			providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+	}
+
+	// no record is out of the retention window yet
+	ctx = ctx.WithBlockTime(now.Add(providertypes.RewardAttributionLogRetentionPeriod))
+	providerKeeper.PruneRewardAttributionLog(ctx, consumerId)
+	records, err := providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	// the first two records are out of the retention window
+	ctx = ctx.WithBlockTime(now.Add(providertypes.RewardAttributionLogRetentionPeriod + time.Hour + time.Second))
+	providerKeeper.PruneRewardAttributionLog(ctx, consumerId)
+	records, err = providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(2), records[0].Sequence)
+}
+
+func TestRewardAttributionLogCleanUp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+	for i := 0; i < 3; i++ {
+		providerKeeper.RecordRewardAttribution(ctx, consumerId, "channel-1", "sender", "uatom", fmt.Sprint(i),
+			providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+	}
+
+	// the records of deleted consumer chains are not stored
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_DELETED)
+	providerKeeper.RecordRewardAttribution(ctx, consumerId, "channel-1", "sender", "uatom", "3",
+		providertypes.REWARD_ATTRIBUTION_DECISION_CREDITED, "")
+	records, err := providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+
+	// the records and the sequence number are removed once the consumer chain is cleaned up
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerId)
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	records, err = providerKeeper.GetRewardAttributionLog(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	require.Equal(t, uint64(0), providerKeeper.RewardAttributionLog().NextSequence(ctx, consumerId))
+}
@@ -45,28 +45,56 @@ func (k Keeper) GetEffectiveValPower(ctx sdktypes.Context,
 func (k Keeper) InitializeSlashMeter(ctx sdktypes.Context) {
 	k.SetSlashMeter(ctx, k.GetSlashMeterAllowance(ctx))
 	k.SetSlashMeterReplenishTimeCandidate(ctx)
+	k.SetSlashMeterAccruedReplenishment(ctx, math.ZeroInt())
 }
 
 // CheckForSlashMeterReplenishment checks if the slash meter should be replenished, and if so, replenishes it.
+//
+// The slash meter is replenished continuously: at a block time t within the current replenish period, i.e.,
+// the period ending at the replenish time candidate, the meter has been replenished by a total of
+// allowance * (t - period start) / SlashMeterReplenishPeriod (rounded down). Once the replenish time candidate
+// is reached, the rest of the allowance is added and a new replenish period starts. Thus, the total replenishment
+// over every replenish period is the same as replenishing the whole allowance at the end of the period,
+// but slash packets can be handled as soon as enough allowance has accrued.
+//
 // Note: initial slash meter replenish time candidate is set in InitGenesis.
 func (k Keeper) CheckForSlashMeterReplenishment(ctx sdktypes.Context) {
-	// Replenish slash meter if current time is equal to or after the current replenish candidate time.
-	if !ctx.BlockTime().UTC().Before(k.GetSlashMeterReplenishTimeCandidate(ctx)) {
-		k.ReplenishSlashMeter(ctx)
+	allowance := k.GetSlashMeterAllowance(ctx)
+	candidate := k.GetSlashMeterReplenishTimeCandidate(ctx)
+	accrued := k.GetSlashMeterAccruedReplenishment(ctx)
+
+	if !ctx.BlockTime().UTC().Before(candidate) {
+		// The replenish period elapsed, replenish the part of the allowance that did not accrue yet.
+		if allowance.GT(accrued) {
+			k.replenishSlashMeter(ctx, allowance.Sub(accrued))
+		}
 		// Set replenish time candidate to one replenish period from now, since we just replenished.
 		k.SetSlashMeterReplenishTimeCandidate(ctx)
+		k.SetSlashMeterAccruedReplenishment(ctx, math.ZeroInt())
+	} else {
+		// Replenish the part of the allowance that accrued since the start of the replenish period.
+		period := k.GetSlashMeterReplenishPeriod(ctx)
+		elapsed := ctx.BlockTime().UTC().Sub(candidate.Add(-period))
+		if elapsed > 0 {
+			// Note that elapsed < period, hence the accrued replenishment is less than the allowance
+			totalAccrued := allowance.MulRaw(int64(elapsed)).QuoRaw(int64(period))
+			if totalAccrued.GT(accrued) {
+				k.replenishSlashMeter(ctx, totalAccrued.Sub(accrued))
+				k.SetSlashMeterAccruedReplenishment(ctx, totalAccrued)
+			}
+		}
 	}
 
 	// The following logic exists to ensure the slash meter is not greater than the allowance for this block,
 	// in the event that the total voting power of the provider chain has decreased since previous blocks.
 
 	// If slash meter is full, or more than full considering updated allowance/total power,
-	allowance := k.GetSlashMeterAllowance(ctx)
 	if k.GetSlashMeter(ctx).GTE(allowance) {
 
 		// Update/set replenish time candidate to one replenish period from now.
 		// This time candidate will be updated in every future block until the slash meter becomes NOT full.
 		k.SetSlashMeterReplenishTimeCandidate(ctx)
+		k.SetSlashMeterAccruedReplenishment(ctx, math.ZeroInt())
 
 		// Ensure the slash meter is not greater than allowance this block,
 		// considering current total voting power.
@@ -74,7 +102,13 @@ func (k Keeper) CheckForSlashMeterReplenishment(ctx sdktypes.Context) {
 	}
 }
 
+// ReplenishSlashMeter replenishes the slash meter by its whole allowance for this block.
 func (k Keeper) ReplenishSlashMeter(ctx sdktypes.Context) {
+	k.replenishSlashMeter(ctx, k.GetSlashMeterAllowance(ctx))
+}
+
+// replenishSlashMeter adds the given amount of voting power to the slash meter.
+func (k Keeper) replenishSlashMeter(ctx sdktypes.Context, amount math.Int) {
 	meter := k.GetSlashMeter(ctx)
 	oldMeter := meter
 	allowance := k.GetSlashMeterAllowance(ctx)
@@ -83,7 +117,7 @@ func (k Keeper) ReplenishSlashMeter(ctx sdktypes.Context) {
 	// before being replenished, it'll become more positive in value. However, if the meter
 	// was 0 or positive in value, it'll be replenished only up to it's allowance
 	// for the current block.
-	meter = meter.Add(allowance)
+	meter = meter.Add(amount)
 	if meter.GT(allowance) {
 		meter = allowance
 	}
@@ -206,3 +240,33 @@ func (k Keeper) SetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) {
 	timeToStore := ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx))
 	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(timeToStore))
 }
+
+// GetSlashMeterAccruedReplenishment returns the amount by which the slash meter was replenished
+// since the start of the current replenish period, i.e., one replenish period before the replenish time candidate.
+func (k Keeper) GetSlashMeterAccruedReplenishment(ctx sdktypes.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(providertypes.SlashMeterAccruedReplenishmentKey())
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	value := math.ZeroInt()
+	err := value.Unmarshal(bz)
+	if err != nil {
+		// We should have obtained value bytes that were serialized in SetSlashMeterAccruedReplenishment,
+		// so an error here would indicate something is very wrong.
+		panic(fmt.Sprintf("failed to unmarshal slash meter accrued replenishment: %v", err))
+	}
+	return value
+}
+
+// SetSlashMeterAccruedReplenishment sets the amount by which the slash meter was replenished
+// since the start of the current replenish period
+func (k Keeper) SetSlashMeterAccruedReplenishment(ctx sdktypes.Context, value math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := value.Marshal()
+	if err != nil {
+		// A returned error for marshaling an int would indicate something is very wrong.
+		panic(fmt.Sprintf("failed to marshal slash meter accrued replenishment: %v", err))
+	}
+	store.Set(providertypes.SlashMeterAccruedReplenishmentKey(), bz)
+}
@@ -76,9 +76,9 @@ func TestSlashMeterReplenishment(t *testing.T) {
 		initialReplenishCandidate := providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx)
 		require.Equal(t, now.Add(tc.replenishPeriod), initialReplenishCandidate)
 
-		// Decrement slash meter
-		providerKeeper.SetSlashMeter(ctx, providerKeeper.GetSlashMeter(ctx).Sub(math.NewInt(3)))
-		require.Equal(t, tc.expectedAllowance.Sub(math.NewInt(3)), providerKeeper.GetSlashMeter(ctx))
+		// Decrement slash meter to zero
+		providerKeeper.SetSlashMeter(ctx, providerKeeper.GetSlashMeter(ctx).Sub(tc.expectedAllowance))
+		require.True(t, providerKeeper.GetSlashMeter(ctx).IsZero())
 
 		// Check for replenishment, confirm meter is not replenished (since no time has passed since init)
 		meterBefore := providerKeeper.GetSlashMeter(ctx)
@@ -93,10 +93,10 @@ func TestSlashMeterReplenishment(t *testing.T) {
 		// Increment block time by half replenish period
 		ctx = ctx.WithBlockTime(now.Add(tc.replenishPeriod / 2).Local())
 
-		// Confirm meter is not still not replenished
-		meterBefore = providerKeeper.GetSlashMeter(ctx)
+		// Confirm meter is replenished by half the allowance
 		providerKeeper.CheckForSlashMeterReplenishment(ctx)
-		require.Equal(t, meterBefore, providerKeeper.GetSlashMeter(ctx))
+		require.Equal(t, tc.expectedAllowance.QuoRaw(2), providerKeeper.GetSlashMeter(ctx))
+		require.Equal(t, tc.expectedAllowance.QuoRaw(2), providerKeeper.GetSlashMeterAccruedReplenishment(ctx))
 
 		// Confirm replenishment time candidate is not updated
 		require.Equal(t, initialReplenishCandidate, providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx))
@@ -199,6 +199,72 @@ func TestConsecutiveReplenishments(t *testing.T) {
 	require.Equal(t, now.Add(6*time.Hour).Add(5*time.Second), providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx))
 }
 
+// TestContinuousReplenishment tests that the slash meter is replenished continuously, i.e., a little every block,
+// and that the cumulative replenishment matches the one of replenishing the whole allowance once per replenish period.
+func TestContinuousReplenishment(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+		t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	replenishPeriod := time.Hour
+	params := providertypes.DefaultParams()
+	params.SlashMeterReplenishPeriod = replenishPeriod
+	params.SlashMeterReplenishFraction = "0.05"
+	providerKeeper.SetParams(ctx, params)
+
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+
+	providerKeeper.InitializeSlashMeter(ctx)
+	allowance := providerKeeper.GetSlashMeterAllowance(ctx)
+	require.Equal(t, int64(50), allowance.Int64())
+
+	// Drain the slash meter such that it does not become full during the simulation
+	initialMeter := math.NewInt(-1000)
+	providerKeeper.SetSlashMeter(ctx, initialMeter)
+
+	// The discrete model replenishes the whole allowance once the replenish time candidate is reached
+	discreteCandidate := now.Add(replenishPeriod)
+	discreteReplenishment := math.ZeroInt()
+
+	// Simulate 10 replenish periods with a block time that does not divide the replenish period
+	blockTime := 7 * time.Second
+	// Maximum replenishment per block, i.e., the allowance accrued during one block rounded up
+	maxBlockReplenishment := allowance.MulRaw(int64(blockTime)).QuoRaw(int64(replenishPeriod)).AddRaw(1)
+	previousReplenishment := math.ZeroInt()
+	for ctx.BlockTime().Before(now.Add(10 * replenishPeriod)) {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(blockTime))
+
+		replenishedDiscretely := false
+		if !ctx.BlockTime().Before(discreteCandidate) {
+			discreteReplenishment = discreteReplenishment.Add(allowance)
+			discreteCandidate = ctx.BlockTime().Add(replenishPeriod)
+			replenishedDiscretely = true
+		}
+
+		providerKeeper.CheckForSlashMeterReplenishment(ctx)
+		continuousReplenishment := providerKeeper.GetSlashMeter(ctx).Sub(initialMeter)
+
+		// Both models share the same replenish periods
+		require.Equal(t, discreteCandidate, providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx))
+
+		// The continuous replenishment is ahead of the discrete one by less than one allowance,
+		// and both are equal at the end of every replenish period
+		require.True(t, continuousReplenishment.GTE(discreteReplenishment))
+		require.True(t, continuousReplenishment.Sub(discreteReplenishment).LT(allowance))
+		if replenishedDiscretely {
+			require.Equal(t, discreteReplenishment, continuousReplenishment)
+		}
+
+		// The slash meter is replenished smoothly, i.e., only by the allowance accrued during the last block
+		require.True(t, continuousReplenishment.Sub(previousReplenishment).LTE(maxBlockReplenishment))
+		previousReplenishment = continuousReplenishment
+	}
+	require.Equal(t, allowance.MulRaw(9), discreteReplenishment)
+}
+
 // TestSlashMeterAllowanceChanges tests the behavior of a full slash meter
 // when total voting power becomes higher and lower.
 func TestTotalVotingPowerChanges(t *testing.T) {
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TimeQueue is a queue of consumer ids ordered by time. For every time, the consumer ids are stored
+// (in the order in which they were appended) as types.ConsumerIds under types.TimeKey(prefix, time).
+// As the time keys are sorted bytewise in chronological order, iterating over the prefix of the queue
+// returns the consumer ids ordered by time.
+type TimeQueue struct {
+	storeKey storetypes.StoreKey
+	prefix   byte
+}
+
+// NewTimeQueue returns the time queue stored under `prefix`
+func (k Keeper) NewTimeQueue(prefix byte) TimeQueue {
+	return TimeQueue{storeKey: k.storeKey, prefix: prefix}
+}
+
+// SpawnTimeQueue returns the time queue of the initialized consumer chains that are to be launched
+func (k Keeper) SpawnTimeQueue() TimeQueue {
+	return k.NewTimeQueue(types.SpawnTimeToConsumerIdsKeyPrefix())
+}
+
+// RemovalTimeQueue returns the time queue of the stopped consumer chains that are to be removed
+func (k Keeper) RemovalTimeQueue() TimeQueue {
+	return k.NewTimeQueue(types.RemovalTimeToConsumerIdsKeyPrefix())
+}
+
+// StopTimeQueue returns the time queue of the launched consumer chains that are to be stopped
+func (k Keeper) StopTimeQueue() TimeQueue {
+	return k.NewTimeQueue(types.StopTimeToConsumerIdsKeyPrefix())
+}
+
+// ReminderTimeQueue returns the time queue of the launched consumer chains for which a lifetime reminder is to be emitted
+func (k Keeper) ReminderTimeQueue() TimeQueue {
+	return k.NewTimeQueue(types.ReminderTimeToConsumerIdsKeyPrefix())
+}
+
+// Prefix returns the key prefix of the time queue
+func (q TimeQueue) Prefix() byte {
+	return q.prefix
+}
+
+// key returns the key under which the consumer ids of time `t` are stored
+func (q TimeQueue) key(t time.Time) []byte {
+	return types.TimeKey(q.prefix, t)
+}
+
+// Get returns all the consumer ids stored under time `t`
+func (q TimeQueue) Get(ctx sdk.Context, t time.Time) (types.ConsumerIds, error) {
+	store := ctx.KVStore(q.storeKey)
+	bz := store.Get(q.key(t))
+	if bz == nil {
+		return types.ConsumerIds{}, nil
+	}
+
+	var consumerIds types.ConsumerIds
+	if err := consumerIds.Unmarshal(bz); err != nil {
+		return types.ConsumerIds{}, fmt.Errorf("failed to unmarshal consumer ids: %w", err)
+	}
+	return consumerIds, nil
+}
+
+// set stores the consumer ids under time `t`
+func (q TimeQueue) set(ctx sdk.Context, t time.Time, consumerIds types.ConsumerIds) error {
+	bz, err := consumerIds.Marshal()
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(q.storeKey).Set(q.key(t), bz)
+	return nil
+}
+
+// Append appends the consumer id to all the other consumer ids stored under time `t`
+func (q TimeQueue) Append(ctx sdk.Context, consumerId string, t time.Time) error {
+	consumers, err := q.Get(ctx, t)
+	if err != nil {
+		return err
+	}
+	return q.set(ctx, t, types.ConsumerIds{Ids: append(consumers.Ids, consumerId)})
+}
+
+// Prepend stores the consumer ids (in the given order) before all the other consumer ids stored under time `t`
+func (q TimeQueue) Prepend(ctx sdk.Context, consumerIds []string, t time.Time) error {
+	consumers, err := q.Get(ctx, t)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, 0, len(consumerIds)+len(consumers.Ids))
+	ids = append(ids, consumerIds...)
+	return q.set(ctx, t, types.ConsumerIds{Ids: append(ids, consumers.Ids...)})
+}
+
+// Remove removes the consumer id stored under time `t`. It returns an error if the consumer id is not found.
+func (q TimeQueue) Remove(ctx sdk.Context, consumerId string, t time.Time) error {
+	consumers, err := q.Get(ctx, t)
+	if err != nil {
+		return err
+	}
+
+	if len(consumers.Ids) == 0 {
+		return fmt.Errorf("no consumer ids found for this time: %s", t.String())
+	}
+
+	// find the index of the consumer we want to remove
+	index := -1
+	for i := 0; i < len(consumers.Ids); i++ {
+		if consumers.Ids[i] == consumerId {
+			index = i
+			break
+		}
+	}
+
+	if index == -1 {
+		return fmt.Errorf("failed to find consumer id (%s)", consumerId)
+	}
+
+	if len(consumers.Ids) == 1 {
+		q.DeleteAll(ctx, t)
+		return nil
+	}
+
+	return q.set(ctx, t, types.ConsumerIds{Ids: append(consumers.Ids[:index], consumers.Ids[index+1:]...)})
+}
+
+// DeleteAll deletes all the consumer ids stored under time `t`
+func (q TimeQueue) DeleteAll(ctx sdk.Context, t time.Time) {
+	ctx.KVStore(q.storeKey).Delete(q.key(t))
+}
+
+// Iterate iterates over the time queue in chronological order and calls `cb` with every time and the
+// consumer ids stored under it. The iteration stops once `cb` returns true.
+func (q TimeQueue) Iterate(ctx sdk.Context, cb func(t time.Time, consumerIds []string) (stop bool)) error {
+	store := ctx.KVStore(q.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{q.prefix})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ts, err := types.ParseTime(q.prefix, iterator.Key())
+		if err != nil {
+			return fmt.Errorf("parsing time: %w", err)
+		}
+		var consumerIds types.ConsumerIds
+		if err := consumerIds.Unmarshal(iterator.Value()); err != nil {
+			return fmt.Errorf("failed to unmarshal consumer ids, ts(%s): %w", ts.String(), err)
+		}
+		if cb(ts, consumerIds.Ids) {
+			break
+		}
+	}
+	return nil
+}
+
+// isDue returns true if the consumer ids stored under time `ts` are due at time `upTo`. The maturity is inclusive,
+// i.e., the consumer ids stored under exactly `upTo` are due. As a result, when used with the block time, the
+// consumer ids are due in the first block whose time is not before `ts`, and the consumer ids appended under a time
+// equal to the block time are due in the next block, even if consecutive blocks have identical block times.
+// The times are compared as logical times (see types.NormalizeTime), i.e., independently of their location.
+func isDue(ts, upTo time.Time) bool {
+	return !types.NormalizeTime(ts).After(types.NormalizeTime(upTo))
+}
+
+// ConsumeUpTo returns from the time queue the consumer ids that are due at `upTo` (see isDue), i.e., for which
+// the associated time is not after `upTo` (in chronological order and, for the same time, in the order in which
+// they were appended). The number of ids returned is limited to `limit`. The ids returned are removed from the time queue.
+func (q TimeQueue) ConsumeUpTo(ctx sdk.Context, upTo time.Time, limit int) ([]string, error) {
+	result := []string{}
+	nextTime := []string{}
+	timestampsToDelete := []time.Time{}
+
+	err := q.Iterate(ctx, func(ts time.Time, consumerIds []string) bool {
+		if len(result) >= limit || !isDue(ts, upTo) {
+			return true
+		}
+
+		timestampsToDelete = append(timestampsToDelete, ts)
+
+		availableSlots := limit - len(result)
+		if availableSlots >= len(consumerIds) {
+			// consume all the ids
+			result = append(result, consumerIds...)
+			return false
+		}
+		// consume only availableSlots
+		result = append(result, consumerIds[:availableSlots]...)
+		// and leave the others for next time
+		nextTime = consumerIds[availableSlots:]
+		return true
+	})
+	if err != nil {
+		return []string{}, err
+	}
+
+	// remove consumers to prevent handling them twice
+	for i, ts := range timestampsToDelete {
+		q.DeleteAll(ctx, ts)
+		if i == len(timestampsToDelete)-1 {
+			// for the last ts consumed, store back the ids for later
+			for _, consumerId := range nextTime {
+				err := q.Append(ctx, consumerId, ts)
+				if err != nil {
+					return result,
+						fmt.Errorf("failed to append consumer id, consumerId(%s), ts(%s): %w",
+							consumerId, ts.String(), err)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetAll returns all the entries of the time queue in chronological order
+func (q TimeQueue) GetAll(ctx sdk.Context) ([]types.TimeQueueEntry, error) {
+	entries := []types.TimeQueueEntry{}
+	err := q.Iterate(ctx, func(ts time.Time, consumerIds []string) bool {
+		entries = append(entries, types.TimeQueueEntry{Time: ts, ConsumerIds: consumerIds})
+		return false
+	})
+	return entries, err
+}
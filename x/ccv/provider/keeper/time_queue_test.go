@@ -0,0 +1,429 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestTimeQueueConsumeUpTo(t *testing.T) {
+	expectedConsumerIds := []string{"1", "2", "3", "4"}
+	timestamps := []time.Time{time.Unix(10, 0), time.Unix(20, 0), time.Unix(30, 0)}
+
+	testCases := []struct {
+		name       string
+		ts         time.Time
+		limit      int
+		expOutcome func(sdk.Context, []string, func(sdk.Context, time.Time) (providertypes.ConsumerIds, error))
+	}{
+		{
+			name:  "timestamp too early",
+			ts:    time.Unix(9, 999999999),
+			limit: 3,
+			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
+				require.Empty(t, ids)
+			},
+		},
+		{
+			name:  "first timestamp",
+			ts:    timestamps[0],
+			limit: 2,
+			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
+				require.Equal(t, expectedConsumerIds[0:2], ids)
+
+				// check that all consumers where removed
+				consumerIds, err := getIds(ctx, timestamps[0])
+				require.NoError(t, err)
+				require.Empty(t, consumerIds)
+			},
+		},
+		{
+			name:  "first timestamp, with limit",
+			ts:    timestamps[0],
+			limit: 1,
+			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
+				require.Equal(t, expectedConsumerIds[0:1], ids)
+
+				// second consumer remained
+				ret, err := getIds(ctx, timestamps[0])
+				require.NoError(t, err)
+				require.Equal(t, providertypes.ConsumerIds{
+					Ids: []string{expectedConsumerIds[1]},
+				}, ret)
+			},
+		},
+		{
+			name:  "second timestamp",
+			ts:    timestamps[1],
+			limit: 3,
+			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
+				require.Equal(t, expectedConsumerIds[0:3], ids)
+
+				// check that all consumers where removed
+				ret, err := getIds(ctx, timestamps[0])
+				require.NoError(t, err)
+				require.Empty(t, ret)
+				ret, err = getIds(ctx, timestamps[1])
+				require.NoError(t, err)
+				require.Empty(t, ret)
+			},
+		},
+		{
+			name:  "third timestamp, with limit",
+			ts:    timestamps[1],
+			limit: 3,
+			expOutcome: func(ctx sdk.Context, ids []string, getIds func(sdk.Context, time.Time) (providertypes.ConsumerIds, error)) {
+				require.Equal(t, expectedConsumerIds[0:3], ids)
+
+				// 4th consumer remained
+				ret, err := getIds(ctx, timestamps[0])
+				require.NoError(t, err)
+				require.Empty(t, ret)
+				ret, err = getIds(ctx, timestamps[1])
+				require.NoError(t, err)
+				require.Empty(t, ret)
+				ret, err = getIds(ctx, timestamps[2])
+				require.NoError(t, err)
+				require.Equal(t, providertypes.ConsumerIds{
+					Ids: []string{expectedConsumerIds[3]},
+				}, ret)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer ctrl.Finish()
+
+		for _, queue := range []providerkeeper.TimeQueue{
+			providerKeeper.SpawnTimeQueue(),
+			providerKeeper.RemovalTimeQueue(),
+			providerKeeper.StopTimeQueue(),
+		} {
+			err := queue.Append(ctx, expectedConsumerIds[0], timestamps[0])
+			require.NoError(t, err)
+			err = queue.Append(ctx, expectedConsumerIds[1], timestamps[0])
+			require.NoError(t, err)
+			err = queue.Append(ctx, expectedConsumerIds[2], timestamps[1])
+			require.NoError(t, err)
+			err = queue.Append(ctx, expectedConsumerIds[3], timestamps[2])
+			require.NoError(t, err)
+
+			ctx = ctx.WithBlockTime(tc.ts)
+
+			consumerIds, err := queue.ConsumeUpTo(ctx, ctx.BlockTime(), tc.limit)
+			require.NoError(t, err)
+
+			tc.expOutcome(ctx, consumerIds, queue.Get)
+		}
+	}
+}
+
+// TestTimeQueueConsumeUpToBoundary tests that the consumer ids stored under a time are due at exactly that time
+// (i.e., the maturity is inclusive) for all the time queues, independently of the location and of the monotonic
+// clock reading of the times, and that consumer ids appended in a block are due in the next block if both blocks
+// have identical block times
+func TestTimeQueueConsumeUpToBoundary(t *testing.T) {
+	ts := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	otherLocation := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Now()
+
+	testCases := []struct {
+		name      string
+		queuedAt  time.Time
+		blockTime time.Time
+		expDue    bool
+	}{
+		{"block time equal to the queued time", ts, ts, true},
+		{"block time 1ns before the queued time", ts, ts.Add(-time.Nanosecond), false},
+		{"block time 1ns after the queued time", ts, ts.Add(time.Nanosecond), true},
+		{"queued time in another location", ts.In(otherLocation), ts, true},
+		{"block time in another location", ts, ts.In(otherLocation), true},
+		{"block time in another location and 1ns before", ts, ts.Add(-time.Nanosecond).In(otherLocation), false},
+		{"queued time with monotonic clock reading", now, now.Round(0), true},
+		{"queued time with monotonic clock reading and block time 1ns before", now, now.Add(-time.Nanosecond), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			for _, queue := range []providerkeeper.TimeQueue{
+				providerKeeper.SpawnTimeQueue(),
+				providerKeeper.RemovalTimeQueue(),
+				providerKeeper.StopTimeQueue(),
+			} {
+				require.NoError(t, queue.Append(ctx, "0", tc.queuedAt))
+
+				consumerIds, err := queue.ConsumeUpTo(ctx, ctx.BlockTime(), 10)
+				require.NoError(t, err)
+				remaining, err := queue.Get(ctx, tc.queuedAt)
+				require.NoError(t, err)
+				if tc.expDue {
+					require.Equal(t, []string{"0"}, consumerIds)
+					require.Empty(t, remaining.Ids)
+				} else {
+					require.Empty(t, consumerIds)
+					require.Equal(t, []string{"0"}, remaining.Ids)
+				}
+			}
+		})
+	}
+
+	t.Run("identical consecutive block times", func(t *testing.T) {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer ctrl.Finish()
+		ctx = ctx.WithBlockTime(ts)
+		queue := providerKeeper.SpawnTimeQueue()
+
+		require.NoError(t, queue.Append(ctx, "0", ts.Add(-time.Nanosecond)))
+		require.NoError(t, queue.Append(ctx, "1", ts))
+		require.NoError(t, queue.Append(ctx, "2", ts.Add(time.Nanosecond)))
+
+		// first block
+		consumerIds, err := queue.ConsumeUpTo(ctx, ctx.BlockTime(), 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"0", "1"}, consumerIds)
+
+		// a consumer id appended under the block time (e.g., a consumer chain that could not launch
+		// and is retried) is due in the next block with the same block time
+		require.NoError(t, queue.Append(ctx, "1", ctx.BlockTime()))
+
+		// second block with the same block time
+		consumerIds, err = queue.ConsumeUpTo(ctx, ctx.BlockTime(), 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"1"}, consumerIds)
+
+		// third block with the same block time
+		consumerIds, err = queue.ConsumeUpTo(ctx, ctx.BlockTime(), 10)
+		require.NoError(t, err)
+		require.Empty(t, consumerIds)
+
+		// fourth block, 1ns later
+		ctx = ctx.WithBlockTime(ts.Add(time.Nanosecond))
+		consumerIds, err = queue.ConsumeUpTo(ctx, ctx.BlockTime(), 10)
+		require.NoError(t, err)
+		require.Equal(t, []string{"2"}, consumerIds)
+	})
+}
+
+func TestTimeQueueIterate(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	queue := providerKeeper.SpawnTimeQueue()
+	timestamps := []time.Time{time.Unix(30, 0).UTC(), time.Unix(10, 0).UTC(), time.Unix(20, 0).UTC()}
+	for i, ts := range timestamps {
+		require.NoError(t, queue.Append(ctx, fmt.Sprint(i), ts))
+	}
+	require.NoError(t, queue.Append(ctx, "3", timestamps[1]))
+
+	// the entries are returned in chronological order
+	entries, err := queue.GetAll(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.TimeQueueEntry{
+		{Time: timestamps[1], ConsumerIds: []string{"1", "3"}},
+		{Time: timestamps[2], ConsumerIds: []string{"2"}},
+		{Time: timestamps[0], ConsumerIds: []string{"0"}},
+	}, entries)
+
+	// the iteration stops once the callback returns true
+	visited := []time.Time{}
+	err = queue.Iterate(ctx, func(ts time.Time, _ []string) bool {
+		visited = append(visited, ts)
+		return len(visited) == 2
+	})
+	require.NoError(t, err)
+	require.Equal(t, []time.Time{timestamps[1], timestamps[2]}, visited)
+
+	// other time queues are not affected
+	entries, err = providerKeeper.RemovalTimeQueue().GetAll(ctx)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// The functions below are the implementation of the time queues before the introduction of TimeQueue.
+// They are used to check that TimeQueue is compatible with the existing storage layout.
+
+func legacyGetConsumerIdsBasedOnTime(store storetypes.KVStore, prefix byte, ts time.Time) (providertypes.ConsumerIds, error) {
+	bz := store.Get(providertypes.TimeKey(prefix, ts))
+	if bz == nil {
+		return providertypes.ConsumerIds{}, nil
+	}
+
+	var consumerIds providertypes.ConsumerIds
+	if err := consumerIds.Unmarshal(bz); err != nil {
+		return providertypes.ConsumerIds{}, fmt.Errorf("failed to unmarshal consumer ids: %w", err)
+	}
+	return consumerIds, nil
+}
+
+func legacyAppendConsumerIdOnTime(store storetypes.KVStore, consumerId string, prefix byte, ts time.Time) error {
+	consumers, err := legacyGetConsumerIdsBasedOnTime(store, prefix, ts)
+	if err != nil {
+		return err
+	}
+	consumersWithAppend := providertypes.ConsumerIds{Ids: append(consumers.Ids, consumerId)}
+	bz, err := consumersWithAppend.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(providertypes.TimeKey(prefix, ts), bz)
+	return nil
+}
+
+func legacyRemoveConsumerIdFromTime(store storetypes.KVStore, consumerId string, prefix byte, ts time.Time) error {
+	consumers, err := legacyGetConsumerIdsBasedOnTime(store, prefix, ts)
+	if err != nil {
+		return err
+	}
+	if len(consumers.Ids) == 0 {
+		return fmt.Errorf("no consumer ids found for this time: %s", ts.String())
+	}
+	index := -1
+	for i := 0; i < len(consumers.Ids); i++ {
+		if consumers.Ids[i] == consumerId {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		return fmt.Errorf("failed to find consumer id (%s)", consumerId)
+	}
+	if len(consumers.Ids) == 1 {
+		store.Delete(providertypes.TimeKey(prefix, ts))
+		return nil
+	}
+	consumersWithRemoval := providertypes.ConsumerIds{Ids: append(consumers.Ids[:index], consumers.Ids[index+1:]...)}
+	bz, err := consumersWithRemoval.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(providertypes.TimeKey(prefix, ts), bz)
+	return nil
+}
+
+func legacyConsumeIdsFromTimeQueue(store storetypes.KVStore, prefix byte, upTo time.Time, limit int) ([]string, error) {
+	result := []string{}
+	nextTime := []string{}
+	timestampsToDelete := []time.Time{}
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if len(result) >= limit {
+			break
+		}
+		ts, err := providertypes.ParseTime(prefix, iterator.Key())
+		if err != nil {
+			return result, fmt.Errorf("parsing removal time: %w", err)
+		}
+		if ts.After(upTo) {
+			break
+		}
+		consumerIds, err := legacyGetConsumerIdsBasedOnTime(store, prefix, ts)
+		if err != nil {
+			return result, err
+		}
+		timestampsToDelete = append(timestampsToDelete, ts)
+		availableSlots := limit - len(result)
+		if availableSlots >= len(consumerIds.Ids) {
+			result = append(result, consumerIds.Ids...)
+		} else {
+			result = append(result, consumerIds.Ids[:availableSlots]...)
+			nextTime = consumerIds.Ids[availableSlots:]
+			break
+		}
+	}
+
+	for i, ts := range timestampsToDelete {
+		store.Delete(providertypes.TimeKey(prefix, ts))
+		if i == len(timestampsToDelete)-1 {
+			for _, consumerId := range nextTime {
+				if err := legacyAppendConsumerIdOnTime(store, consumerId, prefix, ts); err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// requireEqualStores checks that the two stores contain the same key-value pairs
+func requireEqualStores(t require.TestingT, store1, store2 storetypes.KVStore) {
+	getAll := func(store storetypes.KVStore) map[string][]byte {
+		all := map[string][]byte{}
+		iterator := store.Iterator(nil, nil)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			all[string(iterator.Key())] = iterator.Value()
+		}
+		return all
+	}
+	require.Equal(t, getAll(store1), getAll(store2))
+}
+
+// TestTimeQueueCompatibility checks that applying the same operations on identical stores with TimeQueue
+// and with the previous implementation of the time queues returns the same outputs and results in the same stores
+func TestTimeQueueCompatibility(t *testing.T) {
+	timestamps := []time.Time{time.Unix(10, 0), time.Unix(20, 0), time.Unix(20, 1), time.Unix(30, 0)}
+	prefixes := []byte{
+		providertypes.SpawnTimeToConsumerIdsKeyPrefix(),
+		providertypes.RemovalTimeToConsumerIdsKeyPrefix(),
+		providertypes.StopTimeToConsumerIdsKeyPrefix(),
+	}
+
+	rapid.Check(t, func(r *rapid.T) {
+		legacyParams := testkeeper.NewInMemKeeperParams(t)
+		legacyStore := legacyParams.Ctx.KVStore(legacyParams.StoreKey)
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+		defer ctrl.Finish()
+		store := ctx.KVStore(keeperParams.StoreKey)
+
+		numOps := rapid.IntRange(1, 50).Draw(r, "numOps")
+		for i := 0; i < numOps; i++ {
+			prefix := rapid.SampledFrom(prefixes).Draw(r, "prefix")
+			queue := providerKeeper.NewTimeQueue(prefix)
+			ts := rapid.SampledFrom(timestamps).Draw(r, "ts")
+			consumerId := fmt.Sprint(rapid.IntRange(0, 4).Draw(r, "consumerId"))
+
+			switch rapid.IntRange(0, 3).Draw(r, "op") {
+			case 0:
+				require.NoError(r, legacyAppendConsumerIdOnTime(legacyStore, consumerId, prefix, ts))
+				require.NoError(r, queue.Append(ctx, consumerId, ts))
+			case 1:
+				legacyErr := legacyRemoveConsumerIdFromTime(legacyStore, consumerId, prefix, ts)
+				err := queue.Remove(ctx, consumerId, ts)
+				require.Equal(r, legacyErr, err)
+			case 2:
+				limit := rapid.IntRange(0, 5).Draw(r, "limit")
+				legacyIds, legacyErr := legacyConsumeIdsFromTimeQueue(legacyStore, prefix, ts, limit)
+				require.NoError(r, legacyErr)
+				ids, err := queue.ConsumeUpTo(ctx, ts, limit)
+				require.NoError(r, err)
+				require.Equal(r, legacyIds, ids)
+			case 3:
+				legacyIds, legacyErr := legacyGetConsumerIdsBasedOnTime(legacyStore, prefix, ts)
+				require.NoError(r, legacyErr)
+				ids, err := queue.Get(ctx, ts)
+				require.NoError(r, err)
+				require.Equal(r, legacyIds, ids)
+			}
+			requireEqualStores(r, legacyStore, store)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// RecordUnroutableSlashPacket emits an event for a slash packet of the consumer chain with `consumerId` whose
+// consumer consensus address `consumerAddr` could not be mapped to a provider validator (e.g., because the
+// key assignment was pruned too early), increments the unroutable slash packets counter, and appends the
+// slash packet to the unroutable slash packets of the consumer chain.
+//
+// Note that the records are bounded to the last MaxUnroutableSlashPacketEntries records per consumer chain,
+// and that they are removed once the state of the consumer chain is cleaned up after its deletion.
+// They are deterministic, but they are neither exported in genesis nor used by the provider logic.
+func (k Keeper) RecordUnroutableSlashPacket(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+	providerAddr types.ProviderConsAddress,
+	vscId uint64,
+) {
+	// the infraction height is only used for debugging, hence it is zero if it is not found
+	infractionHeight, _ := k.getMappedInfractionHeight(ctx, consumerId, vscId)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnroutableSlashPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerValidatorAddress, consumerAddr.String()),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+			sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(vscId, 10)),
+			sdk.NewAttribute(types.AttributeInfractionHeight, strconv.FormatUint(infractionHeight, 10)),
+		),
+	)
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "unroutable_slash_packets"},
+		1,
+		[]metrics.Label{telemetry.NewLabel(types.AttributeConsumerId, consumerId)},
+	)
+
+	log := k.UnroutableSlashPacketLog()
+	record := types.UnroutableSlashPacket{
+		Sequence:         log.NextSequence(ctx, consumerId),
+		ReceivedHeight:   ctx.BlockHeight(),
+		ReceivedTime:     ctx.BlockTime(),
+		ConsumerAddress:  consumerAddr.String(),
+		ProviderAddress:  providerAddr.String(),
+		ValsetUpdateId:   vscId,
+		InfractionHeight: infractionHeight,
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		k.Logger(ctx).Error("failed to store unroutable slash packet",
+			"consumerId", consumerId,
+			"sequence", record.Sequence,
+			"error", err.Error(),
+		)
+		return
+	}
+	// append the record and prune the unroutable slash packets by count
+	log.Append(ctx, consumerId, record.Sequence, bz)
+}
+
+// SetUnroutableSlashPacket stores the unroutable slash packet `record` of the consumer chain with `consumerId`
+func (k Keeper) SetUnroutableSlashPacket(ctx sdk.Context, consumerId string, record types.UnroutableSlashPacket) error {
+	bz, err := record.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal unroutable slash packet (%d) for consumer id (%s): %w", record.Sequence, consumerId, err)
+	}
+	k.UnroutableSlashPacketLog().Set(ctx, consumerId, record.Sequence, bz)
+	return nil
+}
+
+// GetUnroutableSlashPackets returns the unroutable slash packet records of the consumer chain with `consumerId`
+// in ascending order of their sequence numbers, i.e., from the oldest to the newest
+func (k Keeper) GetUnroutableSlashPackets(ctx sdk.Context, consumerId string) ([]types.UnroutableSlashPacket, error) {
+	records := []types.UnroutableSlashPacket{}
+	var err error
+	k.UnroutableSlashPacketLog().Iterate(ctx, consumerId, func(_ uint64, bz []byte) bool {
+		var record types.UnroutableSlashPacket
+		if err = record.Unmarshal(bz); err != nil {
+			err = fmt.Errorf("failed to unmarshal unroutable slash packet for consumer id (%s): %w", consumerId, err)
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestHandleUnroutableSlashPacket tests that a slash packet for a consumer address
+// that cannot be mapped to a provider validator is recorded and emitted as an event
+func TestHandleUnroutableSlashPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	vscId := uint64(234)
+	providerKeeper.SetConsumerChainId(ctx, consumerId, CONSUMER_CHAIN_ID)
+	providerKeeper.SetVscIdToHeight(ctx, consumerId, providertypes.VscIdToHeight{VscId: vscId, Height: 17})
+
+	// the consumer address has no assigned key, hence it is mapped to the provider address with the same bytes
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	providerConsAddr := providertypes.NewProviderConsAddress(consumerConsAddr.ToSdkConsAddr())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockHeight(42)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).Return(
+		stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound,
+	).Times(1)
+
+	providerKeeper.HandleSlashPacket(ctx, consumerId, ccv.SlashPacketData{
+		Validator:      abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()},
+		ValsetUpdateId: vscId,
+		Infraction:     stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	})
+
+	// the packet is not acknowledged
+	require.Empty(t, providerKeeper.GetSlashAcks(ctx, consumerId))
+
+	// verify the event
+	var unroutableEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeUnroutableSlashPacket {
+			unroutableEvents = append(unroutableEvents, event)
+		}
+	}
+	require.Len(t, unroutableEvents, 1)
+	expectedAttributes := map[string]string{
+		providertypes.AttributeConsumerId:               consumerId,
+		providertypes.AttributeConsumerValidatorAddress: consumerConsAddr.String(),
+		providertypes.AttributeProviderValidatorAddress: providerConsAddr.String(),
+		ccv.AttributeValSetUpdateID:                     "234",
+		providertypes.AttributeInfractionHeight:         "17",
+	}
+	for key, value := range expectedAttributes {
+		attr, found := unroutableEvents[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+
+	// verify the record
+	expectedRecords := []providertypes.UnroutableSlashPacket{
+		{
+			Sequence:         0,
+			ReceivedHeight:   42,
+			ReceivedTime:     ctx.BlockTime(),
+			ConsumerAddress:  consumerConsAddr.String(),
+			ProviderAddress:  providerConsAddr.String(),
+			ValsetUpdateId:   vscId,
+			InfractionHeight: 17,
+		},
+	}
+	records, err := providerKeeper.GetUnroutableSlashPackets(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, expectedRecords, records)
+
+	// verify the query
+	res, err := providerKeeper.QueryUnroutableSlashPackets(ctx,
+		&providertypes.QueryUnroutableSlashPacketsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, expectedRecords, res.SlashPackets)
+
+	_, err = providerKeeper.QueryUnroutableSlashPackets(ctx,
+		&providertypes.QueryUnroutableSlashPacketsRequest{ConsumerId: "1"})
+	require.Error(t, err)
+}
+
+func TestUnroutableSlashPacketPruningAndCleanUp(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := "0"
+	otherConsumerId := "1"
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+
+	numRecords := providertypes.MaxUnroutableSlashPacketEntries + 10
+	for i := 0; i < numRecords; i++ {
+		providerKeeper.RecordUnroutableSlashPacket(ctx, consumerId, consumerConsAddr, providerConsAddr, uint64(i))
+	}
+	providerKeeper.RecordUnroutableSlashPacket(ctx, otherConsumerId, consumerConsAddr, providerConsAddr, 1)
+
+	// verify that only the last MaxUnroutableSlashPacketEntries records are kept, from the oldest to the newest
+	records, err := providerKeeper.GetUnroutableSlashPackets(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, records, providertypes.MaxUnroutableSlashPacketEntries)
+	for i, record := range records {
+		expectedSequence := uint64(numRecords - providertypes.MaxUnroutableSlashPacketEntries + i)
+		require.Equal(t, expectedSequence, record.Sequence)
+		require.Equal(t, expectedSequence, record.ValsetUpdateId)
+	}
+
+	// verify that the records of other consumer chains are not affected
+	records, err = providerKeeper.GetUnroutableSlashPackets(ctx, otherConsumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(0), records[0].Sequence)
+
+	// the records and their sequence number are removed once the consumer chain is cleaned up,
+	// while the records of other consumer chains are not affected
+	providerKeeper.SetConsumerToBeCleanedUp(ctx, consumerId)
+	providerKeeper.EndBlockCleanupDeletedConsumers(ctx)
+	require.Empty(t, providerKeeper.GetConsumersToBeCleanedUp(ctx))
+	records, err = providerKeeper.GetUnroutableSlashPackets(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	require.Equal(t, uint64(0), providerKeeper.UnroutableSlashPacketLog().NextSequence(ctx, consumerId))
+	records, err = providerKeeper.GetUnroutableSlashPackets(ctx, otherConsumerId)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// getAllConsumerUpgradeNotices returns all the stored upgrade notices of the consumer chain with `consumerId`,
+// including the expired ones
+func (k Keeper) getAllConsumerUpgradeNotices(ctx sdk.Context, consumerId string) ([]types.ConsumerUpgradeNotice, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToUpgradeNoticesKey(consumerId))
+	if bz == nil {
+		return nil, nil
+	}
+	var notices types.ConsumerUpgradeNotices
+	if err := notices.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal upgrade notices for consumer id (%s): %w", consumerId, err)
+	}
+	return notices.Notices, nil
+}
+
+// setConsumerUpgradeNotices stores the upgrade notices of the consumer chain with `consumerId`
+func (k Keeper) setConsumerUpgradeNotices(ctx sdk.Context, consumerId string, notices []types.ConsumerUpgradeNotice) error {
+	store := ctx.KVStore(k.storeKey)
+	if len(notices) == 0 {
+		store.Delete(types.ConsumerIdToUpgradeNoticesKey(consumerId))
+		return nil
+	}
+	upgradeNotices := types.ConsumerUpgradeNotices{Notices: notices}
+	bz, err := upgradeNotices.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal upgrade notices for consumer id (%s): %w", consumerId, err)
+	}
+	store.Set(types.ConsumerIdToUpgradeNoticesKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerUpgradeNotices deletes the upgrade notices of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerUpgradeNotices(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToUpgradeNoticesKey(consumerId))
+}
+
+// filterActiveUpgradeNotices returns the upgrade notices whose deadline is not before `now`
+// (or nil if there are no such notices)
+func filterActiveUpgradeNotices(notices []types.ConsumerUpgradeNotice, now time.Time) []types.ConsumerUpgradeNotice {
+	var active []types.ConsumerUpgradeNotice
+	for _, notice := range notices {
+		if !notice.Deadline.Before(now) {
+			active = append(active, notice)
+		}
+	}
+	return active
+}
+
+// GetConsumerUpgradeNotices returns the active upgrade notices of the consumer chain with `consumerId`
+// (in the order in which they were first published), i.e., the notices expire once their deadline passed
+func (k Keeper) GetConsumerUpgradeNotices(ctx sdk.Context, consumerId string) ([]types.ConsumerUpgradeNotice, error) {
+	notices, err := k.getAllConsumerUpgradeNotices(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	return filterActiveUpgradeNotices(notices, ctx.BlockTime()), nil
+}
+
+// PublishConsumerUpgradeNotice publishes an upgrade notice for the launched consumer chain with `consumerId`.
+// The notice overwrites the active notice with the same name. The expired notices are pruned, and
+// a consumer chain can have at most MaxConsumerUpgradeNotices active notices.
+func (k Keeper) PublishConsumerUpgradeNotice(ctx sdk.Context, consumerId string, notice types.ConsumerUpgradeNotice) error {
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot publish an upgrade notice for chain with consumer id %s in phase %s", consumerId, phase)
+	}
+	if !notice.Deadline.After(ctx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerUpgradeNotice,
+			"deadline (%s) must be after the block time (%s)", notice.Deadline, ctx.BlockTime())
+	}
+
+	notices, err := k.GetConsumerUpgradeNotices(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+
+	overwritten := false
+	for i := range notices {
+		if notices[i].Name == notice.Name {
+			notices[i] = notice
+			overwritten = true
+			break
+		}
+	}
+	if !overwritten {
+		if len(notices) >= types.MaxConsumerUpgradeNotices {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerUpgradeNotice,
+				"consumer chain with consumer id %s already has %d active upgrade notices", consumerId, len(notices))
+		}
+		notices = append(notices, notice)
+	}
+
+	return k.setConsumerUpgradeNotices(ctx, consumerId, notices)
+}
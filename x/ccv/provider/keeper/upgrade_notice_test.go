@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// TestPublishConsumerUpgradeNotice tests that the upgrade notices of a launched consumer chain are published,
+// overwritten by name, limited to MaxConsumerUpgradeNotices, and expire once their deadline passed
+func TestPublishConsumerUpgradeNotice(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	newNotice := func(name string, height uint64, deadline time.Duration) providertypes.ConsumerUpgradeNotice {
+		return providertypes.ConsumerUpgradeNotice{
+			Name: name, Height: height, BinaryHash: "7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13", Deadline: now.Add(deadline),
+		}
+	}
+
+	// notices can only be published for launched chains
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err := providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, newNotice("v2", 100, time.Hour))
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	// the deadline must be in the future
+	err = providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, newNotice("v2", 100, 0))
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpgradeNotice)
+
+	// publish a notice
+	v2 := newNotice("v2", 100, time.Hour)
+	require.NoError(t, providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, v2))
+	notices, err := providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerUpgradeNotice{v2}, notices)
+
+	// a notice with the same name overwrites the previous one
+	v2 = newNotice("v2", 200, 2*time.Hour)
+	require.NoError(t, providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, v2))
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerUpgradeNotice{v2}, notices)
+
+	// at most MaxConsumerUpgradeNotices notices are active
+	for i := 1; i < providertypes.MaxConsumerUpgradeNotices; i++ {
+		notice := newNotice(fmt.Sprintf("v2.%d", i), 300, 3*time.Hour)
+		require.NoError(t, providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, notice))
+	}
+	err = providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, newNotice("v3", 400, 4*time.Hour))
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerUpgradeNotice)
+	// but notices can still be overwritten
+	require.NoError(t, providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, newNotice("v2", 200, 4*time.Hour)))
+
+	// the notices of other consumer chains are not affected
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, notices)
+
+	// the notices expire after their deadline, which frees slots for new notices
+	ctx = ctx.WithBlockTime(now.Add(3*time.Hour + time.Second))
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerUpgradeNotice{newNotice("v2", 200, 4*time.Hour)}, notices)
+	v3 := newNotice("v3", 400, 5*time.Hour)
+	require.NoError(t, providerKeeper.PublishConsumerUpgradeNotice(ctx, CONSUMER_ID, v3))
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerUpgradeNotice{newNotice("v2", 200, 4*time.Hour), v3}, notices)
+
+	ctx = ctx.WithBlockTime(now.Add(6 * time.Hour))
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+
+	// the notices are deleted with the consumer chain
+	ctx = ctx.WithBlockTime(now)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_STOPPED)
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, CONSUMER_ID))
+	notices, err = providerKeeper.GetConsumerUpgradeNotices(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Empty(t, notices)
+}
+
+// TestUpdateConsumerUpgradeNotice tests that the owner of a launched consumer chain can publish upgrade notices
+// with MsgUpdateConsumer and that the notices are returned from the queries
+func TestUpdateConsumerUpgradeNotice(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, "owner")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerMetadata(ctx, CONSUMER_ID, testkeeper.GetTestConsumerMetadata())
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, testkeeper.GetTestPowerShapingParameters())
+	require.NoError(t, err)
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	notice := providertypes.ConsumerUpgradeNotice{
+		Name: "v2", Height: 1000, BinaryHash: "7f3ab9a54b168e3f3e0bd8ca3e3bbbc44dbd7e13", Deadline: now.Add(time.Hour),
+	}
+
+	// only the owner can publish upgrade notices
+	_, err = msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner: "not the owner", ConsumerId: CONSUMER_ID, UpgradeNotice: &notice,
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.UpdateConsumer(ctx, &providertypes.MsgUpdateConsumer{
+		Owner: "owner", ConsumerId: CONSUMER_ID, UpgradeNotice: &notice,
+	})
+	require.NoError(t, err)
+	require.Contains(t, resp.UpdatedFields, "upgrade_notice")
+
+	// the notice is emitted as an event
+	var noticeEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerUpgradeNotice {
+			noticeEvents = append(noticeEvents, event)
+		}
+	}
+	require.Len(t, noticeEvents, 1)
+	attributes := map[string]string{}
+	for _, attr := range noticeEvents[0].Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, CONSUMER_ID, attributes[providertypes.AttributeConsumerId])
+	require.Equal(t, "v2", attributes[providertypes.AttributeUpgradeName])
+	require.Equal(t, "1000", attributes[providertypes.AttributeUpgradeHeight])
+	require.Equal(t, notice.BinaryHash, attributes[providertypes.AttributeBinaryHash])
+	require.Equal(t, notice.Deadline.String(), attributes[providertypes.AttributeUpgradeDeadline])
+
+	// the notice is returned from the chain query until it expires
+	chain, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerUpgradeNotice{notice}, chain.UpgradeNotices)
+
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Hour))
+	chain, err = providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Empty(t, chain.UpgradeNotices)
+}
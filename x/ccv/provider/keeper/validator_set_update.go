@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
-	"sort"
+	"math"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -30,8 +32,107 @@ func (k Keeper) SetConsumerValidator(
 
 // SetConsumerValSet resets the current consumer validators with the `nextValidators` computed by
 // `FilterValidators` and hence this method should only be called after `FilterValidators` has completed.
+//
+// If NumberOfEpochsToRetainConsumerValsets is positive, a snapshot of `nextValidators` is also stored
+// under the current block height (see GetConsumerValSetSnapshotAtHeight).
 func (k Keeper) SetConsumerValSet(ctx sdk.Context, consumerId string, nextValidators []types.ConsensusValidator) error {
-	return k.setValSet(ctx, k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId), nextValidators)
+	if err := k.setValSet(ctx, k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId), nextValidators); err != nil {
+		return err
+	}
+	if k.GetNumberOfEpochsToRetainConsumerValsets(ctx) > 0 {
+		return k.SetConsumerValSetSnapshot(ctx, consumerId, ctx.BlockHeight(), nextValidators)
+	}
+	return nil
+}
+
+// SetConsumerValSetSnapshot stores the validator set of the consumer chain with `consumerId` computed at `height`
+func (k Keeper) SetConsumerValSetSnapshot(ctx sdk.Context, consumerId string, height int64, validators []types.ConsensusValidator) error {
+	store := ctx.KVStore(k.storeKey)
+	snapshot := types.ConsumerValSetSnapshot{Validators: validators}
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal validator set snapshot at height %d for consumer id (%s): %w", height, consumerId, err)
+	}
+	store.Set(types.ConsumerValSetSnapshotKey(consumerId, uint64(height)), bz)
+	return nil
+}
+
+// GetConsumerValSetSnapshotAtHeight returns the validator set of the consumer chain with `consumerId` that was
+// in effect at `height`, i.e., the latest validator set snapshot stored at or before `height`, together with
+// the height of the snapshot. It returns false if no such snapshot is retained.
+func (k Keeper) GetConsumerValSetSnapshotAtHeight(ctx sdk.Context, consumerId string, height int64) (types.ConsumerValSetSnapshot, int64, bool) {
+	if height < 0 {
+		return types.ConsumerValSetSnapshot{}, 0, false
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(
+		types.StringIdWithLenKey(types.ConsumerValSetSnapshotKeyPrefix(), consumerId),
+		storetypes.InclusiveEndBytes(types.ConsumerValSetSnapshotKey(consumerId, uint64(height))),
+	)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.ConsumerValSetSnapshot{}, 0, false
+	}
+
+	_, snapshotHeight, err := types.ParseStringIdAndUintIdKey(types.ConsumerValSetSnapshotKeyPrefix(), iterator.Key())
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// store keys are assumed to be correctly serialized in SetConsumerValSetSnapshot.
+		panic(fmt.Errorf("failed to parse validator set snapshot key: %w", err))
+	}
+	var snapshot types.ConsumerValSetSnapshot
+	if err := snapshot.Unmarshal(iterator.Value()); err != nil {
+		// An error here would indicate something is very wrong,
+		// the snapshot is assumed to be correctly serialized in SetConsumerValSetSnapshot.
+		panic(fmt.Errorf("failed to unmarshal validator set snapshot for consumer id (%s): %w", consumerId, err))
+	}
+	return snapshot, int64(snapshotHeight), true
+}
+
+// DeleteConsumerValSetSnapshot deletes the validator set of the consumer chain with `consumerId` computed at `height`
+func (k Keeper) DeleteConsumerValSetSnapshot(ctx sdk.Context, consumerId string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerValSetSnapshotKey(consumerId, uint64(height)))
+}
+
+// PruneConsumerValSetSnapshots deletes the validator set snapshots of the consumer chain with `consumerId`
+// that are no longer in effect at any of the heights of the last NumberOfEpochsToRetainConsumerValsets epochs.
+//
+// Note that the latest snapshot at or before the start of the retention window is kept, as it is still
+// in effect at the start of the window. If the retention is disabled, all the snapshots are deleted.
+func (k Keeper) PruneConsumerValSetSnapshots(ctx sdk.Context, consumerId string) {
+	retainedEpochs := k.GetNumberOfEpochsToRetainConsumerValsets(ctx)
+	windowStart := ctx.BlockHeight() - retainedEpochs*k.GetBlocksPerEpoch(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerValSetSnapshotKeyPrefix(), consumerId))
+	var heights []int64
+	for ; iterator.Valid(); iterator.Next() {
+		_, height, err := types.ParseStringIdAndUintIdKey(types.ConsumerValSetSnapshotKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in SetConsumerValSetSnapshot.
+			panic(fmt.Errorf("failed to parse validator set snapshot key: %w", err))
+		}
+		if int64(height) > windowStart {
+			// the snapshots are in ascending order of heights
+			break
+		}
+		heights = append(heights, int64(height))
+	}
+	iterator.Close()
+
+	if retainedEpochs > 0 && len(heights) > 0 {
+		// keep the snapshot in effect at the start of the retention window
+		heights = heights[:len(heights)-1]
+	}
+	for _, height := range heights {
+		k.DeleteConsumerValSetSnapshot(ctx, consumerId, height)
+		k.Logger(ctx).Debug("consumer validator set snapshot was pruned",
+			"consumerId", consumerId,
+			"height", height,
+		)
+	}
 }
 
 // DeleteConsumerValidator removes consumer validator with `providerAddr` address
@@ -120,44 +221,58 @@ func DiffValidators(
 		}
 	}
 
-	return updates
+	return DeduplicateValidatorUpdates(updates)
+}
+
+// DeduplicateValidatorUpdates returns the given validator `updates` with at most one update per public key,
+// where a later update overrides an earlier update with the same public key. The order of the first occurrence
+// of every public key is preserved. Note that CometBFT rejects validator updates with duplicate public keys.
+func DeduplicateValidatorUpdates(updates []abci.ValidatorUpdate) []abci.ValidatorUpdate {
+	index := make(map[string]int, len(updates))
+	var deduplicated []abci.ValidatorUpdate
+	for _, update := range updates {
+		key := update.PubKey.String()
+		if i, found := index[key]; found {
+			deduplicated[i] = update
+			continue
+		}
+		index[key] = len(deduplicated)
+		deduplicated = append(deduplicated, update)
+	}
+	return deduplicated
 }
 
 // CreateConsumerValidator creates a consumer validator for `consumerId` from the given staking `validator`
 func (k Keeper) CreateConsumerValidator(ctx sdk.Context, consumerId string, validator stakingtypes.Validator) (types.ConsensusValidator, error) {
-	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	// the Top N power is not needed to create the consumer validator
+	bondedValidator, err := k.toBondedValidator(ctx, validator, false)
 	if err != nil {
 		return types.ConsensusValidator{}, err
 	}
-	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
-	if err != nil {
-		return types.ConsensusValidator{}, fmt.Errorf("could not retrieve validator's (%+v) power: %w",
-			validator, err)
-	}
-	consAddr, err := validator.GetConsAddr()
-	if err != nil {
-		return types.ConsensusValidator{}, fmt.Errorf("could not retrieve validator's (%+v) consensus address: %w",
-			validator, err)
-	}
+	return k.createConsumerValidator(ctx, consumerId, bondedValidator)
+}
 
-	consumerPublicKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+// createConsumerValidator creates a consumer validator for `consumerId` from the given bonded `validator`
+func (k Keeper) createConsumerValidator(ctx sdk.Context, consumerId string, validator BondedValidator) (types.ConsensusValidator, error) {
+	consumerPublicKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, validator.ProviderAddr)
 	if !found {
-		consumerPublicKey, err = validator.CmtConsPublicKey()
+		var err error
+		consumerPublicKey, err = validator.Validator.CmtConsPublicKey()
 		if err != nil {
-			return types.ConsensusValidator{}, fmt.Errorf("could not retrieve validator's (%+v) public key: %w", validator, err)
+			return types.ConsensusValidator{}, fmt.Errorf("could not retrieve validator's (%+v) public key: %w", validator.Validator, err)
 		}
 	}
 
 	height := ctx.BlockHeight()
-	if v, found := k.GetConsumerValidator(ctx, consumerId, types.ProviderConsAddress{Address: consAddr}); found {
+	if v, found := k.GetConsumerValidator(ctx, consumerId, validator.ProviderAddr); found {
 		// if validator was already a consumer validator, then do not update the height set the first time
 		// the validator became a consumer validator
 		height = v.JoinHeight
 	}
 
 	return types.ConsensusValidator{
-		ProviderConsAddr: consAddr,
-		Power:            power,
+		ProviderConsAddr: validator.ProviderAddr.ToSdkConsAddr(),
+		Power:            validator.Power,
 		PublicKey:        &consumerPublicKey,
 		JoinHeight:       height,
 	}, nil
@@ -195,17 +310,33 @@ func (k Keeper) FilterValidators(
 }
 
 // ComputeNextValidators computes the validators for the upcoming epoch based on the currently `bondedValidators`.
+// It also returns the validators that are eligible to validate the consumer chain, but are evicted due to
+// the validator-set cap (see CapValidatorSet for the eviction rule).
 func (k Keeper) ComputeNextValidators(
 	ctx sdk.Context,
 	consumerId string,
 	bondedValidators []stakingtypes.Validator,
 	powerShapingParameters types.PowerShapingParameters,
 	minPowerToOptIn int64,
-) ([]types.ConsensusValidator, error) {
-	// sort the bonded validators by number of staked tokens in descending order
-	sort.Slice(bondedValidators, func(i, j int) bool {
-		return bondedValidators[i].GetBondedTokens().GT(bondedValidators[j].GetBondedTokens())
-	})
+) (nextValidators, evictedValidators []types.ConsensusValidator, err error) {
+	validatorSet, err := k.NewBondedValidatorSet(ctx, bondedValidators, nil)
+	if err != nil {
+		return []types.ConsensusValidator{}, []types.ConsensusValidator{}, err
+	}
+	return k.computeNextValidators(ctx, consumerId, validatorSet, powerShapingParameters, minPowerToOptIn)
+}
+
+// computeNextValidators computes the validators for the upcoming epoch based on the bonded validators of
+// `validatorSet`, together with the validators that are evicted due to the validator-set cap (see ComputeNextValidators)
+func (k Keeper) computeNextValidators(
+	ctx sdk.Context,
+	consumerId string,
+	validatorSet BondedValidatorSet,
+	powerShapingParameters types.PowerShapingParameters,
+	minPowerToOptIn int64,
+) (nextValidators, evictedValidators []types.ConsensusValidator, err error) {
+	// the bonded validators are sorted by number of staked tokens in descending order
+	bondedValidators := validatorSet.Bonded
 
 	// if inactive validators are not allowed, only consider the first `MaxProviderConsensusValidators` validators
 	// since those are the ones that participate in consensus
@@ -217,26 +348,87 @@ func (k Keeper) ComputeNextValidators(
 		}
 	}
 
-	nextValidators, err := k.FilterValidators(ctx, consumerId, bondedValidators,
-		func(providerAddr types.ProviderConsAddress) (bool, error) {
-			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, powerShapingParameters.Top_N, minPowerToOptIn)
-			if err != nil {
-				return false, err
+	termsHash := k.GetConsumerTermsHash(ctx, consumerId)
+	topN := powerShapingParameters.Top_N
+	// the emptiness of the allowlist and denylist is checked once, since it requires iterating over the store
+	allowlistEmpty := k.IsAllowlistEmpty(ctx, consumerId)
+	denylistEmpty := k.IsDenylistEmpty(ctx, consumerId)
+	// the participations of the validators in the launched consumer chains are shared across the consumer chains
+	participations := validatorSet.participations
+	if participations == nil {
+		participations = k.GetConsumerParticipations(ctx)
+	}
+
+	nextValidators, err = k.filterBondedValidators(ctx, consumerId, bondedValidators,
+		func(val BondedValidator) bool {
+			providerAddr := val.ProviderAddr
+			// validators that are opted in, or that are automatically opted in for a Top N chain,
+			// and that are not prevented by the allowlist and denylist
+			optedIn := k.IsOptedIn(ctx, consumerId, providerAddr)
+			if optedIn {
+				// the validator may have been opted in since the participations were retrieved
+				k.recordConsumerParticipation(ctx, participations, consumerId, providerAddr)
 			}
-			fulfillsMinStake, err := k.FulfillsMinStake(ctx, powerShapingParameters.MinStake, providerAddr)
-			if err != nil {
-				return false, err
+			optedIn = optedIn || (topN > 0 && val.TopNPower >= minPowerToOptIn)
+			canValidateChain := optedIn &&
+				(allowlistEmpty || k.IsAllowlisted(ctx, consumerId, providerAddr)) &&
+				(denylistEmpty || !k.IsDenylisted(ctx, consumerId, providerAddr))
+			// validators whose stake is below `MinStake` are dropped
+			fulfillsMinStake := fulfillsMinStake(powerShapingParameters.MinStake, val.Validator)
+			// validators whose rank has fallen below `MaxProviderRank` are dropped
+			fulfillsMaxProviderRank := validatorSet.isWithinMaxProviderRank(providerAddr, powerShapingParameters.MaxProviderRank)
+			// validators that did not acknowledge the current terms of the chain are dropped
+			acknowledgedTerms := k.HasAcknowledgedConsumerTerms(ctx, consumerId, providerAddr, termsHash)
+			// validators that marked themselves as unavailable are dropped, unless they have to validate a Top N chain
+			available := k.isAvailableToValidate(ctx, consumerId, providerAddr, val.TopNPower, topN, minPowerToOptIn)
+			// validators jailed for an infraction committed on the consumer chain are dropped during the
+			// re-opt-in cooldown of the chain, even if they have to validate a Top N chain
+			_, inReOptInCooldown := k.IsInReOptInCooldown(ctx, consumerId, providerAddr)
+			if !canValidateChain || !fulfillsMinStake || !fulfillsMaxProviderRank || !acknowledgedTerms || !available ||
+				inReOptInCooldown {
+				return false
 			}
-			return canValidateChain && fulfillsMinStake, nil
+			// validators that are opted in to more consumer chains than the max consumer participation allows
+			// are dropped from the consumer chains they opted in to last; an event is only emitted for the
+			// validators that are removed from the consumer validator set, i.e., once per eviction
+			if !k.isWithinMaxConsumerParticipation(ctx, participations, consumerId, providerAddr) {
+				if k.IsConsumerValidator(ctx, consumerId, providerAddr) {
+					k.emitMaxConsumerParticipationEvictionEvent(ctx, consumerId, providerAddr)
+				}
+				return false
+			}
+			return true
 		})
 	if err != nil {
-		return []types.ConsensusValidator{}, err
+		return []types.ConsensusValidator{}, []types.ConsensusValidator{}, err
 	}
 
-	nextValidators = k.CapValidatorSet(ctx, powerShapingParameters, nextValidators)
+	nextValidators, evictedValidators = k.CapValidatorSet(ctx, powerShapingParameters, nextValidators)
 
 	nextValidators = k.CapValidatorsPower(ctx, powerShapingParameters.ValidatorsPowerCap, nextValidators)
 
+	return nextValidators, evictedValidators, nil
+}
+
+// filterBondedValidators filters the provided `bondedValidators` according to `predicate` and returns
+// the filtered set (see FilterValidators)
+func (k Keeper) filterBondedValidators(
+	ctx sdk.Context,
+	consumerId string,
+	bondedValidators []BondedValidator,
+	predicate func(val BondedValidator) bool,
+) ([]types.ConsensusValidator, error) {
+	var nextValidators []types.ConsensusValidator
+	for _, val := range bondedValidators {
+		if predicate(val) {
+			nextValidator, err := k.createConsumerValidator(ctx, consumerId, val)
+			if err != nil {
+				return nextValidators, err
+			}
+			nextValidators = append(nextValidators, nextValidator)
+		}
+	}
+
 	return nextValidators, nil
 }
 
@@ -261,12 +453,12 @@ func (k Keeper) GetLastProviderConsensusActiveValidators(ctx sdk.Context) ([]sta
 // the validator updates to be sent to the consumer chain.
 // For TopN consumer chains, it automatically opts in all validators that
 // belong to the top N of the active validators.
+// Note that `validatorSet` is shared across all the consumer chains (see GetLastBondedValidatorSet).
 //
 // TODO add unit test for ComputeConsumerNextValSet
 func (k Keeper) ComputeConsumerNextValSet(
 	ctx sdk.Context,
-	bondedValidators []stakingtypes.Validator,
-	activeValidators []stakingtypes.Validator,
+	validatorSet BondedValidatorSet,
 	consumerId string,
 	currentConsumerValSet []types.ConsensusValidator,
 ) ([]abci.ValidatorUpdate, error) {
@@ -278,37 +470,77 @@ func (k Keeper) ComputeConsumerNextValSet(
 
 	minPower := int64(0)
 	if powerShapingParameters.Top_N > 0 {
-		minPower, err = k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
-		if err != nil {
+		minPower, err = computeMinPowerInTopN(validatorSet.Active, powerShapingParameters.Top_N)
+		switch {
+		case errors.Is(err, types.ErrEmptyActiveValidatorSet):
+			// no validator can be automatically opted in if the active validator set is empty (e.g., after all validators unbonded);
+			// keep the previous minimum power in top N (if any) and consider only the validators that are already opted in
+			k.Logger(ctx).Warn("skipping the opt in of top N validators because the active validator set is empty",
+				"consumerId", consumerId,
+				"topN", powerShapingParameters.Top_N,
+			)
+			var found bool
+			minPower, found = k.GetMinimumPowerInTopN(ctx, consumerId)
+			if !found {
+				minPower = math.MaxInt64
+			}
+		case err != nil:
 			return []abci.ValidatorUpdate{},
 				fmt.Errorf("computing min power to opt in, consumerId(%s): %w", consumerId, err)
-		}
-
-		// set the minimal power of validators in the top N in the store
-		k.SetMinimumPowerInTopN(ctx, consumerId, minPower)
+		default:
+			// set the minimal power of validators in the top N in the store
+			k.SetMinimumPowerInTopN(ctx, consumerId, minPower)
 
-		// in a Top-N chain, we automatically opt in all validators that belong to the top N
-		// of the active validators
-		err = k.OptInTopNValidators(ctx, consumerId, activeValidators, minPower)
-		if err != nil {
-			return []abci.ValidatorUpdate{},
-				fmt.Errorf("opting in topN validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
+			// in a Top-N chain, we automatically opt in all validators that belong to the top N
+			// of the active validators
+			k.optInTopNValidators(ctx, consumerId, validatorSet.Active, minPower)
 		}
 	}
 
-	// need to use the bondedValidators, not activeValidators, here since the chain might be opt-in and allow inactive vals
-	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+	// need to use the bonded validators, not the active validators, here since the chain might be opt-in and allow inactive vals
+	nextValidators, evictedValidators, err := k.computeNextValidators(ctx, consumerId, validatorSet, powerShapingParameters, minPower)
 	if err != nil {
 		return []abci.ValidatorUpdate{},
 			fmt.Errorf("computing next validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
 	}
 
+	// emit an event for every validator that is removed from the consumer validator set due to the validator-set cap
+	for _, val := range FilterEvictedValidators(currentConsumerValSet, evictedValidators) {
+		providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerValidatorEvicted,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeProviderValidatorAddress, providerAddr.String()),
+				sdk.NewAttribute(types.AttributeEvictionReason, types.EvictionReasonValidatorSetCap),
+			),
+		)
+	}
+
+	// exclude the validators whose consumer key is also used by another validator, as CometBFT
+	// rejects validator updates with duplicate public keys
+	nextValidators, err = k.ExcludeConsumerKeyCollisions(ctx, consumerId, nextValidators)
+	if err != nil {
+		return []abci.ValidatorUpdate{},
+			fmt.Errorf("excluding consumer key collisions, consumerId(%s): %w", consumerId, err)
+	}
+
 	err = k.SetConsumerValSet(ctx, consumerId, nextValidators)
 	if err != nil {
 		return []abci.ValidatorUpdate{},
 			fmt.Errorf("setting consumer validator set, consumerId(%s): %w", consumerId, err)
 	}
 
+	// record the heights at which the opt-ins of the validators that join the consumer validator set become effective
+	k.recordOptInHeights(ctx, consumerId, currentConsumerValSet, nextValidators)
+
+	// record the economic security backing the next consumer validator set
+	if err := k.RecordConsumerEconomicSecurity(ctx, consumerId, nextValidators); err != nil {
+		return []abci.ValidatorUpdate{},
+			fmt.Errorf("recording economic security, consumerId(%s): %w", consumerId, err)
+	}
+
 	// get the initial updates with the latest set consumer public keys
 	valUpdates := DiffValidators(currentConsumerValSet, nextValidators)
 
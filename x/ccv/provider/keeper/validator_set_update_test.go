@@ -252,6 +252,36 @@ func TestDiffEdgeCases(t *testing.T) {
 	require.Equal(t, expectedUpdates, actualUpdates)
 }
 
+// TestDiffValidatorsDuplicatePublicKeys checks that `DiffValidators` returns at most one update per public key,
+// even if different validators use the same consumer public key
+func TestDiffValidatorsDuplicatePublicKeys(t *testing.T) {
+	valA, publicKey := createConsumerValidator(1, 1, 1)
+	valB, _ := createConsumerValidator(2, 2, 1)
+	valC, publicKeyC := createConsumerValidator(3, 3, 3)
+
+	// the later update wins
+	require.Equal(t,
+		[]abci.ValidatorUpdate{{PubKey: publicKey, Power: 2}, {PubKey: publicKeyC, Power: 3}},
+		keeper.DiffValidators([]types.ConsensusValidator{}, []types.ConsensusValidator{valA, valB, valC}),
+	)
+	require.Equal(t,
+		[]abci.ValidatorUpdate{{PubKey: publicKey, Power: 0}},
+		keeper.DiffValidators([]types.ConsensusValidator{valA, valB}, []types.ConsensusValidator{}),
+	)
+
+	// the update that removes a validator is overridden by the update that adds a validator with the same public key
+	updates := []abci.ValidatorUpdate{
+		{PubKey: publicKey, Power: 0},
+		{PubKey: publicKeyC, Power: 3},
+		{PubKey: publicKey, Power: 2},
+	}
+	require.Equal(t,
+		[]abci.ValidatorUpdate{{PubKey: publicKey, Power: 2}, {PubKey: publicKeyC, Power: 3}},
+		keeper.DeduplicateValidatorUpdates(updates),
+	)
+	require.Empty(t, keeper.DeduplicateValidatorUpdates(nil))
+}
+
 func TestSetConsumerValSet(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -339,6 +369,76 @@ func TestSetConsumerValSet(t *testing.T) {
 	require.Equal(t, nextValidators, nextCurrentValidators)
 }
 
+// TestConsumerValSetSnapshots tests that snapshots of the consumer validator sets are stored
+// while the retention is enabled and that they are pruned once they are no longer retained
+func TestConsumerValSetSnapshots(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.NumberOfEpochsToRetainConsumerValsets = 2
+	providerKeeper.SetParams(ctx, params)
+
+	validator := func(power int64) types.ConsensusValidator {
+		return types.ConsensusValidator{
+			ProviderConsAddr: []byte("providerConsAddr"),
+			Power:            power,
+			PublicKey:        &crypto.PublicKey{Sum: &crypto.PublicKey_Ed25519{Ed25519: []byte{1}}},
+		}
+	}
+
+	// store the validator sets at the epoch boundaries 10, 20, 30, and 40
+	for height := int64(10); height <= 40; height += 10 {
+		err := providerKeeper.SetConsumerValSet(ctx.WithBlockHeight(height), CONSUMER_ID, []types.ConsensusValidator{validator(height)})
+		require.NoError(t, err)
+	}
+
+	// the validator set at a height is the one of the latest snapshot at or before the height
+	_, _, found := providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 9)
+	require.False(t, found)
+	snapshot, snapshotHeight, found := providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 10)
+	require.True(t, found)
+	require.Equal(t, int64(10), snapshotHeight)
+	require.Equal(t, []types.ConsensusValidator{validator(10)}, snapshot.Validators)
+	_, snapshotHeight, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 29)
+	require.True(t, found)
+	require.Equal(t, int64(20), snapshotHeight)
+	_, _, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, "1", 29)
+	require.False(t, found)
+
+	// at height 50, the retention window starts at height 30, and hence the snapshot
+	// at height 30 is kept, while the snapshots at heights 10 and 20 are pruned
+	providerKeeper.PruneConsumerValSetSnapshots(ctx.WithBlockHeight(50), CONSUMER_ID)
+	_, _, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 29)
+	require.False(t, found)
+	_, snapshotHeight, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 35)
+	require.True(t, found)
+	require.Equal(t, int64(30), snapshotHeight)
+	snapshot, snapshotHeight, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 50)
+	require.True(t, found)
+	require.Equal(t, int64(40), snapshotHeight)
+	require.Equal(t, []types.ConsensusValidator{validator(40)}, snapshot.Validators)
+
+	// pruning is idempotent
+	providerKeeper.PruneConsumerValSetSnapshots(ctx.WithBlockHeight(50), CONSUMER_ID)
+	_, snapshotHeight, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 35)
+	require.True(t, found)
+	require.Equal(t, int64(30), snapshotHeight)
+
+	// once the retention is disabled, no snapshots are stored and all the snapshots are pruned
+	params.NumberOfEpochsToRetainConsumerValsets = 0
+	providerKeeper.SetParams(ctx, params)
+	err := providerKeeper.SetConsumerValSet(ctx.WithBlockHeight(60), CONSUMER_ID, []types.ConsensusValidator{validator(60)})
+	require.NoError(t, err)
+	_, snapshotHeight, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 60)
+	require.True(t, found)
+	require.Equal(t, int64(40), snapshotHeight)
+	providerKeeper.PruneConsumerValSetSnapshots(ctx.WithBlockHeight(60), CONSUMER_ID)
+	_, _, found = providerKeeper.GetConsumerValSetSnapshotAtHeight(ctx, CONSUMER_ID, 60)
+	require.False(t, found)
+}
+
 func TestFilterValidatorsConsiderAll(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -496,3 +596,148 @@ func TestCreateConsumerValidator(t *testing.T) {
 	require.Equal(t, expectedConsumerValidatorB, actualConsumerValidatorB)
 	require.NoError(t, err)
 }
+
+// TestComputeConsumerNextValSetWithEmptyActiveSet tests that the next validator set of a Top N chain
+// can be computed even if the active validator set is empty, in which case no validator is opted in automatically
+func TestComputeConsumerNextValSetWithEmptyActiveSet(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	consumerId := "0"
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: 100})
+	require.NoError(t, err)
+
+	// no bonded and no active validators
+	validatorSet, err := providerKeeper.NewBondedValidatorSet(ctx, []stakingtypes.Validator{}, []stakingtypes.Validator{})
+	require.NoError(t, err)
+	valUpdates, err := providerKeeper.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, []types.ConsensusValidator{})
+	require.NoError(t, err)
+	require.Empty(t, valUpdates)
+	_, found := providerKeeper.GetMinimumPowerInTopN(ctx, consumerId)
+	require.False(t, found)
+
+	// one bonded validator that is not opted in is not automatically opted in without active validators
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1)
+	validatorSet, err = providerKeeper.NewBondedValidatorSet(ctx, validators, []stakingtypes.Validator{})
+	require.NoError(t, err)
+	valUpdates, err = providerKeeper.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, []types.ConsensusValidator{})
+	require.NoError(t, err)
+	require.Empty(t, valUpdates)
+	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, consumerId))
+
+	// one bonded validator that is opted in remains in the validator set
+	providerKeeper.SetOptedIn(ctx, consumerId, consAddrs[0])
+	valUpdates, err = providerKeeper.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, []types.ConsensusValidator{})
+	require.NoError(t, err)
+	require.Len(t, valUpdates, 1)
+}
+
+// TestComputeConsumerNextValSetEvictionEvents checks that an event is emitted for every validator
+// that is removed from the consumer validator set due to the validator-set cap
+func TestComputeConsumerNextValSetEvictionEvents(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	consumerId := "0"
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 3, 2, 1)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, consumerId, consAddr)
+	}
+
+	computeNextValSet := func(validatorSetCap uint32) []sdk.Event {
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{ValidatorSetCap: validatorSetCap})
+		require.NoError(t, err)
+		currentValSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+		require.NoError(t, err)
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		validatorSet, err := providerKeeper.NewBondedValidatorSet(ctx, validators, validators)
+		require.NoError(t, err)
+		_, err = providerKeeper.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, currentValSet)
+		require.NoError(t, err)
+
+		evictionEvents := []sdk.Event{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeConsumerValidatorEvicted {
+				evictionEvents = append(evictionEvents, event)
+			}
+		}
+		return evictionEvents
+	}
+
+	// no validator is evicted if the cap is not reached
+	require.Empty(t, computeNextValSet(3))
+
+	// the two validators with the lowest power are evicted when the cap shrinks
+	evictionEvents := computeNextValSet(1)
+	require.Len(t, evictionEvents, 2)
+	for i, event := range evictionEvents {
+		attributes := map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		require.Equal(t, types.ModuleName, attributes[sdk.AttributeKeyModule])
+		require.Equal(t, consumerId, attributes[types.AttributeConsumerId])
+		require.Equal(t, consAddrs[i+1].String(), attributes[types.AttributeProviderValidatorAddress])
+		require.Equal(t, types.EvictionReasonValidatorSetCap, attributes[types.AttributeEvictionReason])
+	}
+
+	// validators that are not in the current consumer validator set are not evicted again
+	require.Empty(t, computeNextValSet(1))
+}
+
+// TestComputeConsumerNextValSetConsumerKeyCollision reproduces the case where two validators of the next consumer
+// validator set use the same consumer key (e.g., due to imported state) and checks that only the validator to
+// which the key was assigned last is part of the consumer validator set and of the validator updates
+func TestComputeConsumerNextValSetConsumerKeyCollision(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	consumerId := "0"
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 3, 2)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, consumerId, consAddr)
+	}
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	// the first validator uses the provider key of the second validator as its consumer key
+	collidingKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	collidingAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, consAddrs[0], collidingKey)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, collidingAddr, consAddrs[0])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	validatorSet, err := providerKeeper.NewBondedValidatorSet(ctx, validators, validators)
+	require.NoError(t, err)
+	updates, err := providerKeeper.ComputeConsumerNextValSet(ctx, validatorSet, consumerId, nil)
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: collidingKey, Power: 3}}, updates)
+
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 1)
+	require.Equal(t, consAddrs[0].ToSdkConsAddr().Bytes(), consumerValSet[0].ProviderConsAddr)
+
+	// an event is emitted for the excluded validator
+	var evictionEvents []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeConsumerValidatorEvicted {
+			evictionEvents = append(evictionEvents, event)
+		}
+	}
+	require.Len(t, evictionEvents, 1)
+	attributes := map[string]string{}
+	for _, attr := range evictionEvents[0].Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, consumerId, attributes[types.AttributeConsumerId])
+	require.Equal(t, consAddrs[1].String(), attributes[types.AttributeProviderValidatorAddress])
+	require.Equal(t, collidingAddr.String(), attributes[types.AttributeConsumerValidatorAddress])
+	require.Equal(t, types.EvictionReasonConsumerKeyCollision, attributes[types.AttributeEvictionReason])
+}
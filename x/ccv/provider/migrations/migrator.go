@@ -11,6 +11,7 @@ import (
 	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
 	v7 "github.com/cosmos/interchain-security/v6/x/ccv/provider/migrations/v7"
 	v8 "github.com/cosmos/interchain-security/v6/x/ccv/provider/migrations/v8"
+	v9 "github.com/cosmos/interchain-security/v6/x/ccv/provider/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -96,3 +97,35 @@ func (m Migrator) Migrate7to8(ctx sdktypes.Context) error {
 
 	return nil
 }
+
+// Migrate8to9 migrates x/ccvprovider state from consensus version 8 to 9.
+// The migration consists of the following actions:
+// - initialize the `MaxConsumerCleanupDeletionsPerBlock` param
+// - initialize the `NumberOfEpochsToRetainConsumerValsets` param
+// - initialize the `LifetimeReminderFractions` param
+// - initialize the `UpgradeQuietPeriod` param
+// - initialize the `ConsumerClientExpiryWarningFraction` param
+// - initialize the `EmergencyOptOutSlashFraction` and `EmergencyOptOutCooldown` params
+// - initialize the `MaxClientCreationsPerBlock` param
+// - initialize the `NumberOfEpochsToRetainEconomicSecurity` param
+// - initialize the `AllowZeroKeyAssignmentNonce` param
+// - index the existing consumer chains by their owner address
+// - move the legacy mapping from vscIDs to block heights to the per-consumer mappings
+// - register the reward channels of the launched consumer chains
+// - index the launched consumer chains by their chain id
+func (m Migrator) Migrate8to9(ctx sdktypes.Context) error {
+	v9.InitializeMaxConsumerCleanupDeletionsPerBlock(ctx, m.providerKeeper)
+	v9.InitializeNumberOfEpochsToRetainConsumerValsets(ctx, m.providerKeeper)
+	v9.InitializeLifetimeReminderFractions(ctx, m.providerKeeper)
+	v9.InitializeUpgradeQuietPeriod(ctx, m.providerKeeper)
+	v9.InitializeConsumerClientExpiryWarningFraction(ctx, m.providerKeeper)
+	v9.InitializeEmergencyOptOutParams(ctx, m.providerKeeper)
+	v9.InitializeMaxClientCreationsPerBlock(ctx, m.providerKeeper)
+	v9.InitializeNumberOfEpochsToRetainEconomicSecurity(ctx, m.providerKeeper)
+	v9.InitializeAllowZeroKeyAssignmentNonce(ctx, m.providerKeeper)
+	v9.IndexConsumersByOwnerAddress(ctx, m.providerKeeper)
+	v9.MigrateValsetUpdateBlockHeights(ctx, m.providerKeeper)
+	v9.RegisterConsumerRewardChannels(ctx, m.providerKeeper)
+	v9.IndexLaunchedConsumersByChainId(ctx, m.providerKeeper)
+	return nil
+}
@@ -82,5 +82,19 @@ func GetParamsLegacy(ctx sdk.Context, paramspace ccvtypes.LegacyParamSubspace) t
 		getNumberOfEpochsToStartReceivingRewards(ctx, paramspace),
 		// this parameter is new so it doesn't need to be migrated, just initialized
 		types.DefaultMaxProviderConsensusValidators,
+		types.DefaultMaxConsumerCleanupDeletionsPerBlock,
+		types.DefaultDormancyPeriod,
+		types.DefaultNumberOfEpochsToRetainConsumerValsets,
+		types.DefaultLifetimeReminderFractions,
+		types.DefaultUpgradeQuietPeriod,
+		types.DefaultConsumerClientExpiryWarningFraction,
+		types.DefaultMaxConsumerParticipation,
+		types.DefaultEmergencyOptOutSlashFraction,
+		types.DefaultEmergencyOptOutCooldown,
+		types.DefaultSendUpgradeNotices,
+		types.DefaultMaxClientCreationsPerBlock,
+		types.DefaultNumberOfEpochsToRetainEconomicSecurity,
+		types.DefaultAllowZeroKeyAssignmentNonce,
+		types.DefaultExcludeTokenizedSharesFromTopN,
 	)
 }
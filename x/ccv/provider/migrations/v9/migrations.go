@@ -0,0 +1,112 @@
+package v9
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v6/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+// InitializeMaxConsumerCleanupDeletionsPerBlock initializes the MaxConsumerCleanupDeletionsPerBlock param
+func InitializeMaxConsumerCleanupDeletionsPerBlock(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.MaxConsumerCleanupDeletionsPerBlock = providertypes.DefaultMaxConsumerCleanupDeletionsPerBlock
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeNumberOfEpochsToRetainConsumerValsets initializes the NumberOfEpochsToRetainConsumerValsets param
+func InitializeNumberOfEpochsToRetainConsumerValsets(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.NumberOfEpochsToRetainConsumerValsets = providertypes.DefaultNumberOfEpochsToRetainConsumerValsets
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeLifetimeReminderFractions initializes the LifetimeReminderFractions param
+func InitializeLifetimeReminderFractions(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.LifetimeReminderFractions = providertypes.DefaultLifetimeReminderFractions
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeUpgradeQuietPeriod initializes the UpgradeQuietPeriod param
+func InitializeUpgradeQuietPeriod(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.UpgradeQuietPeriod = providertypes.DefaultUpgradeQuietPeriod
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeConsumerClientExpiryWarningFraction initializes the ConsumerClientExpiryWarningFraction param
+func InitializeConsumerClientExpiryWarningFraction(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.ConsumerClientExpiryWarningFraction = providertypes.DefaultConsumerClientExpiryWarningFraction
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeEmergencyOptOutParams initializes the EmergencyOptOutSlashFraction and EmergencyOptOutCooldown params
+func InitializeEmergencyOptOutParams(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.EmergencyOptOutSlashFraction = providertypes.DefaultEmergencyOptOutSlashFraction
+	params.EmergencyOptOutCooldown = providertypes.DefaultEmergencyOptOutCooldown
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeMaxClientCreationsPerBlock initializes the MaxClientCreationsPerBlock param
+func InitializeMaxClientCreationsPerBlock(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.MaxClientCreationsPerBlock = providertypes.DefaultMaxClientCreationsPerBlock
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeNumberOfEpochsToRetainEconomicSecurity initializes the NumberOfEpochsToRetainEconomicSecurity param
+func InitializeNumberOfEpochsToRetainEconomicSecurity(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.NumberOfEpochsToRetainEconomicSecurity = providertypes.DefaultNumberOfEpochsToRetainEconomicSecurity
+	providerKeeper.SetParams(ctx, params)
+}
+
+// InitializeAllowZeroKeyAssignmentNonce initializes the AllowZeroKeyAssignmentNonce param, so that the transition
+// window for key assignments without nonce is open on upgraded chains
+func InitializeAllowZeroKeyAssignmentNonce(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	params := providerKeeper.GetParams(ctx)
+	params.AllowZeroKeyAssignmentNonce = providertypes.DefaultAllowZeroKeyAssignmentNonce
+	providerKeeper.SetParams(ctx, params)
+}
+
+// IndexConsumersByOwnerAddress indexes the consumer ids of all the existing consumer chains by their owner address
+func IndexConsumersByOwnerAddress(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	for _, consumerId := range providerKeeper.GetAllConsumerIds(ctx) {
+		ownerAddress, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+		if err != nil {
+			continue
+		}
+		// setting the owner address again also sets its index entry
+		providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, ownerAddress)
+	}
+}
+
+// MigrateValsetUpdateBlockHeights moves the legacy mapping from vscIDs to block heights,
+// which is no longer updated, to the per-consumer mappings that are pruned
+func MigrateValsetUpdateBlockHeights(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	providerKeeper.MigrateLegacyValsetUpdateBlockHeights(ctx)
+}
+
+// RegisterConsumerRewardChannels registers the reward channels of the launched consumer chains,
+// so that the rewards of these consumer chains are only accepted on their transfer channels
+func RegisterConsumerRewardChannels(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	providerKeeper.RegisterConsumerRewardChannels(ctx)
+}
+
+// IndexLaunchedConsumersByChainId indexes the consumer ids of the launched consumer chains by their chain id
+func IndexLaunchedConsumersByChainId(ctx sdk.Context, providerKeeper providerkeeper.Keeper) {
+	for _, consumerId := range providerKeeper.GetAllConsumerIds(ctx) {
+		if providerKeeper.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			continue
+		}
+		// setting the chain id of a launched consumer chain again also sets its index entry
+		providerKeeper.SetConsumerChainId(ctx, consumerId, chainId)
+	}
+}
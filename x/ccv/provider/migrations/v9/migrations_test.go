@@ -0,0 +1,258 @@
+package v9
+
+import (
+	"encoding/binary"
+	"testing"
+
+	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v6/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestInitializeMaxConsumerCleanupDeletionsPerBlock(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.MaxConsumerCleanupDeletionsPerBlock = 0
+	providerKeeper.SetParams(ctx, params)
+	require.Error(t, providerKeeper.GetParams(ctx).Validate())
+
+	InitializeMaxConsumerCleanupDeletionsPerBlock(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultMaxConsumerCleanupDeletionsPerBlock, migratedParams.MaxConsumerCleanupDeletionsPerBlock)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeNumberOfEpochsToRetainConsumerValsets(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.NumberOfEpochsToRetainConsumerValsets = 0
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeNumberOfEpochsToRetainConsumerValsets(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultNumberOfEpochsToRetainConsumerValsets, migratedParams.NumberOfEpochsToRetainConsumerValsets)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeLifetimeReminderFractions(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.LifetimeReminderFractions = nil
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeLifetimeReminderFractions(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultLifetimeReminderFractions, migratedParams.LifetimeReminderFractions)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeUpgradeQuietPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.UpgradeQuietPeriod = 0
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeUpgradeQuietPeriod(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultUpgradeQuietPeriod, migratedParams.UpgradeQuietPeriod)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeConsumerClientExpiryWarningFraction(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.ConsumerClientExpiryWarningFraction = ""
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeConsumerClientExpiryWarningFraction(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultConsumerClientExpiryWarningFraction, migratedParams.ConsumerClientExpiryWarningFraction)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeEmergencyOptOutParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new params
+	params := providertypes.DefaultParams()
+	params.EmergencyOptOutSlashFraction = ""
+	params.EmergencyOptOutCooldown = 0
+	providerKeeper.SetParams(ctx, params)
+	require.Error(t, providerKeeper.GetParams(ctx).Validate())
+
+	InitializeEmergencyOptOutParams(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultEmergencyOptOutSlashFraction, migratedParams.EmergencyOptOutSlashFraction)
+	require.Equal(t, providertypes.DefaultEmergencyOptOutCooldown, migratedParams.EmergencyOptOutCooldown)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeMaxClientCreationsPerBlock(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.MaxClientCreationsPerBlock = 0
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeMaxClientCreationsPerBlock(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultMaxClientCreationsPerBlock, migratedParams.MaxClientCreationsPerBlock)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeNumberOfEpochsToRetainEconomicSecurity(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.NumberOfEpochsToRetainEconomicSecurity = 0
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeNumberOfEpochsToRetainEconomicSecurity(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultNumberOfEpochsToRetainEconomicSecurity, migratedParams.NumberOfEpochsToRetainEconomicSecurity)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestInitializeAllowZeroKeyAssignmentNonce(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the params as they were before the migration, i.e., without the new param
+	params := providertypes.DefaultParams()
+	params.AllowZeroKeyAssignmentNonce = false
+	providerKeeper.SetParams(ctx, params)
+
+	InitializeAllowZeroKeyAssignmentNonce(ctx, providerKeeper)
+
+	migratedParams := providerKeeper.GetParams(ctx)
+	require.True(t, migratedParams.AllowZeroKeyAssignmentNonce)
+	require.NoError(t, migratedParams.Validate())
+}
+
+func TestIndexConsumersByOwnerAddress(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// set the owner addresses as they were before the migration, i.e., without the index
+	store := ctx.KVStore(inMemParams.StoreKey)
+	owners := []string{"owner1", "owner2", "owner1"}
+	for _, owner := range owners {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		store.Set(providertypes.ConsumerIdToOwnerAddressKey(consumerId), []byte(owner))
+	}
+	require.Empty(t, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner1"))
+
+	IndexConsumersByOwnerAddress(ctx, providerKeeper)
+
+	require.Equal(t, []string{"0", "2"}, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner1"))
+	require.Equal(t, []string{"1"}, providerKeeper.GetConsumerIdsByOwnerAddress(ctx, "owner2"))
+}
+
+func TestMigrateValsetUpdateBlockHeights(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// set the legacy mapping as it was before the migration
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 1, 10)
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 2, 20)
+	// consumer "0" has an IBC client and already maps vscID 2, while consumer "1" has no IBC client
+	providerKeeper.SetConsumerClientId(ctx, "0", "clientId")
+	providerKeeper.SetVscIdToHeight(ctx, "0", providertypes.VscIdToHeight{VscId: 2, Height: 21})
+
+	MigrateValsetUpdateBlockHeights(ctx, providerKeeper)
+
+	require.Empty(t, providerKeeper.GetAllValsetUpdateBlockHeights(ctx))
+	require.Equal(t, []providertypes.VscIdToHeight{
+		{VscId: 1, Height: 10, Timestamp: ctx.BlockTime()},
+		{VscId: 2, Height: 21},
+	}, providerKeeper.GetAllVscIdToHeights(ctx, "0"))
+	require.Empty(t, providerKeeper.GetAllVscIdToHeights(ctx, "1"))
+}
+
+func TestRegisterConsumerRewardChannels(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// a launched consumer chain without a reward channel, as it was before the migration
+	providerKeeper.SetConsumerClientId(ctx, "0", "07-tendermint-0")
+	providerKeeper.SetConsumerIdToChannelId(ctx, "0", "channel-1")
+	mocks.MockChannelKeeper.EXPECT().GetAllChannelsWithPortPrefix(gomock.Any(), "transfer").Return(
+		[]channeltypes.IdentifiedChannel{{
+			PortId: "transfer", ChannelId: "channel-2", State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"},
+		}}).Times(1)
+	mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), "connection-0").
+		Return(conntypes.ConnectionEnd{ClientId: "07-tendermint-0"}, true).Times(1)
+
+	RegisterConsumerRewardChannels(ctx, providerKeeper)
+
+	channelId, found := providerKeeper.GetConsumerRewardChannel(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, "channel-2", channelId)
+}
+
+func TestIndexLaunchedConsumersByChainId(t *testing.T) {
+	inMemParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// set the chain ids and phases as they were before the migration, i.e., without the index
+	store := ctx.KVStore(inMemParams.StoreKey)
+	chains := []struct {
+		chainId string
+		phase   providertypes.ConsumerPhase
+	}{
+		{chainId: "chain0", phase: providertypes.CONSUMER_PHASE_INITIALIZED},
+		{chainId: "chain0", phase: providertypes.CONSUMER_PHASE_LAUNCHED},
+		{chainId: "chain1", phase: providertypes.CONSUMER_PHASE_STOPPED},
+	}
+	for _, chain := range chains {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		store.Set(providertypes.ConsumerIdToChainIdKey(consumerId), []byte(chain.chainId))
+		phaseBytes := make([]byte, 8)
+		binary.BigEndian.PutUint32(phaseBytes, uint32(chain.phase))
+		store.Set(providertypes.ConsumerIdToPhaseKey(consumerId), phaseBytes)
+	}
+	_, found := providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.False(t, found)
+
+	IndexLaunchedConsumersByChainId(ctx, providerKeeper)
+
+	consumerId, found := providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain0", "")
+	require.True(t, found)
+	require.Equal(t, "1", consumerId)
+	_, found = providerKeeper.GetLaunchedConsumerIdByChainId(ctx, "chain1", "")
+	require.False(t, found)
+}
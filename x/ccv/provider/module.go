@@ -147,6 +147,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(providertypes.ModuleName, 7, migrator.Migrate7to8); err != nil {
 		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 7 -> 8", providertypes.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 8, migrator.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 8 -> 9", providertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the provider module. It returns validator updates
@@ -168,7 +171,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 8 }
+func (AppModule) ConsensusVersion() uint64 { return 9 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -178,6 +181,10 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	if err := am.keeper.BeginBlockLaunchConsumers(sdkCtx); err != nil {
 		return err
 	}
+	// Stop any consumer chains for which the maximum lifetime elapsed
+	if err := am.keeper.BeginBlockSunsetConsumers(sdkCtx); err != nil {
+		return err
+	}
 	// Stop and remove state for any consumer chains that are due to be stopped
 	if err := am.keeper.BeginBlockRemoveConsumers(sdkCtx); err != nil {
 		return err
@@ -194,9 +201,13 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// remove the remaining state of deleted consumer chains
+	am.keeper.EndBlockCleanupDeletedConsumers(sdkCtx)
 	// EndBlock logic needed for the Consumer Initiated Slashing sub-protocol.
 	// Important: EndBlockCIS must be called before EndBlockVSU
 	am.keeper.EndBlockCIS(sdkCtx)
+	// check the expiry of the consumer clients at the boundaries of an epoch
+	am.keeper.EndBlockCheckConsumerClientsExpiry(sdkCtx)
 	// EndBlock logic needed for the Validator Set Update sub-protocol
 	return am.keeper.EndBlockVSU(sdkCtx)
 }
@@ -210,6 +221,7 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 
 // RegisterStoreDecoder registers a decoder for provider module's types
 func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[providertypes.StoreKey] = simulation.NewDecodeStore()
 }
 
 // WeightedOperations returns the all the provider module operations with their respective weights.
@@ -0,0 +1,505 @@
+package simulation
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// keyField decodes a field of a key of the provider store, i.e., it returns the human-readable
+// value of the field at the start of `bz` and the remaining bytes of the key
+type keyField struct {
+	name   string
+	decode func(bz []byte) (value string, rest []byte, err error)
+}
+
+// valueDecoder returns the human-readable value of an entry of the provider store
+type valueDecoder func(bz []byte) (string, error)
+
+// storeEntryDecoder decodes the entries of the provider store stored under a key prefix, i.e.,
+// the key after the prefix consists of `keyFields` and the value is decoded by `value`
+type storeEntryDecoder struct {
+	keyFields []keyField
+	value     valueDecoder
+}
+
+// getStoreEntryDecoders returns the decoders of the entries of the provider store for all the key names
+// (see types.GetAllKeyNames). The entries stored under deprecated key prefixes are decoded as raw bytes.
+func getStoreEntryDecoders() map[string]storeEntryDecoder {
+	consumerId := lenStringField("consumerId")
+	providerAddr := consAddrField("providerAddr")
+	deprecated := storeEntryDecoder{[]keyField{hexField("key")}, hexValue}
+
+	return map[string]storeEntryDecoder{
+		types.ParametersKeyName:                       {nil, protoValue(func() proto.Message { return &types.Params{} })},
+		types.PortKeyName:                             {nil, stringValue},
+		types.DeprecatedMaturedUnbondingOpsKeyName:    deprecated,
+		types.ValidatorSetUpdateIdKeyName:             {nil, uint64Value},
+		types.SlashMeterKeyName:                       {nil, intValue},
+		types.SlashMeterReplenishTimeCandidateKeyName: {nil, timeBytesValue},
+		types.SlashMeterAccruedReplenishmentKeyName:   {nil, intValue},
+		types.AcknowledgedConsumerTermsKeyName:        {[]keyField{consumerId, providerAddr}, stringValue},
+		types.DeletedChannelIdToConsumerIdKeyName:     {[]keyField{stringField("channelId")}, stringValue},
+		types.ConsumerValSetSnapshotKeyName: {
+			[]keyField{consumerId, uint64Field("height")},
+			protoValue(func() proto.Message { return &types.ConsumerValSetSnapshot{} }),
+		},
+		types.ConsumerIdToProviderFeePoolAddrKeyName: {[]keyField{stringField("consumerId")}, stringValue},
+		types.ConsumerIdToPowerShapingAdminKeyName:   {[]keyField{consumerId}, stringValue},
+		types.StopTimeToConsumerIdsKeyName: {
+			[]keyField{timeField("stopTime")},
+			protoValue(func() proto.Message { return &types.ConsumerIds{} }),
+		},
+		types.ConsumerIdToLifetimeKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerLifetime{} }),
+		},
+		types.RewardAttributionLogKeyName: {
+			[]keyField{consumerId, uint64Field("sequence")},
+			protoValue(func() proto.Message { return &types.RewardAttributionRecord{} }),
+		},
+		types.RewardAttributionLogSequenceKeyName: {[]keyField{consumerId}, uint64Value},
+		types.ConsumerIdToRemovalInitiatorKeyName: {[]keyField{consumerId}, removalInitiatorValue},
+		types.ConsumerIdToUpgradeNoticesKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerUpgradeNotices{} }),
+		},
+		types.ConsumerIdToChannelIdKeyName:          {[]keyField{stringField("consumerId")}, stringValue},
+		types.ChannelIdToConsumerIdKeyName:          {[]keyField{stringField("channelId")}, stringValue},
+		types.ConsumerIdToClientIdKeyName:           {[]keyField{stringField("consumerId")}, stringValue},
+		types.DeprecatedInitTimeoutTimestampKeyName: deprecated,
+		types.DeprecatedPendingCAPKeyName:           deprecated,
+		types.DeprecatedPendingCRPKeyName:           deprecated,
+		types.DeprecatedUnbondingOpKeyName:          deprecated,
+		types.DeprecatedUnbondingOpIndexKeyName:     deprecated,
+		types.ValsetUpdateBlockHeightKeyName:        {[]keyField{uint64Field("vscId")}, uint64Value},
+		types.ConsumerGenesisKeyName: {
+			[]keyField{stringField("consumerId")},
+			protoValue(func() proto.Message { return &ccvtypes.ConsumerGenesisState{} }),
+		},
+		types.SlashAcksKeyName: {
+			[]keyField{stringField("consumerId")},
+			protoValue(func() proto.Message { return &types.SlashAcks{} }),
+		},
+		types.InitChainHeightKeyName: {[]keyField{stringField("consumerId")}, uint64Value},
+		types.PendingVSCsKeyName: {
+			[]keyField{stringField("consumerId")},
+			protoValue(func() proto.Message { return &types.ValidatorSetChangePackets{} }),
+		},
+		types.DeprecatedVscSendTimestampKeyName:        deprecated,
+		types.DeprecatedThrottledPacketDataSizeKeyName: deprecated,
+		types.DeprecatedThrottledPacketDataKeyName:     deprecated,
+		types.DeprecatedGlobalSlashEntryKeyName:        deprecated,
+		types.ConsumerValidatorsKeyName: {
+			[]keyField{consumerId, providerAddr},
+			protoValue(func() proto.Message { return &tmprotocrypto.PublicKey{} }),
+		},
+		types.ValidatorsByConsumerAddrKeyName:             {[]keyField{consumerId, consAddrField("consumerAddr")}, consAddrValue},
+		types.DeprecatedKeyAssignmentReplacementsKeyName:  deprecated,
+		types.DeprecatedConsumerAddrsToPruneKeyName:       deprecated,
+		types.SlashLogKeyName:                             {[]keyField{providerAddr}, emptyValue},
+		types.ConsumerRewardDenomsKeyName:                 {[]keyField{stringField("denom")}, emptyValue},
+		types.DeprecatedVSCMaturedHandledThisBlockKeyName: deprecated,
+		types.EquivocationEvidenceMinHeightKeyName:        {[]keyField{stringField("consumerId")}, uint64Value},
+		types.DeprecatedProposedConsumerChainKeyName:      deprecated,
+		types.ConsumerValidatorKeyName: {
+			[]keyField{consumerId, providerAddr},
+			protoValue(func() proto.Message { return &types.ConsensusValidator{} }),
+		},
+		types.OptedInKeyName:                             {[]keyField{consumerId, providerAddr}, emptyValue},
+		types.DeprecatedTopNKeyName:                      deprecated,
+		types.DeprecatedValidatorsPowerCapKeyName:        deprecated,
+		types.DeprecatedValidatorSetCapKeyName:           deprecated,
+		types.AllowlistKeyName:                           {[]keyField{consumerId, providerAddr}, emptyValue},
+		types.DenylistKeyName:                            {[]keyField{consumerId, providerAddr}, emptyValue},
+		types.DeprecatedConsumerRewardsAllocationKeyName: deprecated,
+		types.ConsumerCommissionRateKeyName:              {[]keyField{consumerId, providerAddr}, decValue},
+		types.MinimumPowerInTopNKeyName:                  {[]keyField{consumerId}, int64Value},
+		types.LastProviderConsensusValsKeyName: {
+			[]keyField{providerAddr},
+			protoValue(func() proto.Message { return &types.ConsensusValidator{} }),
+		},
+		types.ConsumerAddrsToPruneV2KeyName: {
+			[]keyField{consumerId, timeField("pruneTs")},
+			protoValue(func() proto.Message { return &types.AddressList{} }),
+		},
+		types.ConsumerIdKeyName:               {nil, uint64Value},
+		types.ConsumerIdToChainIdKeyName:      {[]keyField{consumerId}, stringValue},
+		types.ConsumerIdToOwnerAddressKeyName: {[]keyField{consumerId}, stringValue},
+		types.ConsumerIdToConsumerMetadataKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerMetadata{} }),
+		},
+		types.ConsumerIdToInitializationParametersKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerInitializationParameters{} }),
+		},
+		types.ConsumerIdToPowerShapingParameters: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.PowerShapingParameters{} }),
+		},
+		types.ConsumerIdToPhaseKeyName:       {[]keyField{consumerId}, phaseValue},
+		types.ConsumerIdToRemovalTimeKeyName: {[]keyField{consumerId}, binaryTimeValue},
+		types.SpawnTimeToConsumerIdsKeyName: {
+			[]keyField{timeField("spawnTime")},
+			protoValue(func() proto.Message { return &types.ConsumerIds{} }),
+		},
+		types.RemovalTimeToConsumerIdsKeyName: {
+			[]keyField{timeField("removalTime")},
+			protoValue(func() proto.Message { return &types.ConsumerIds{} }),
+		},
+		types.ClientIdToConsumerIdKeyName: {[]keyField{lenStringField("clientId")}, stringValue},
+		types.ConsumerIdToAllowlistedRewardDenomKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.AllowlistedRewardDenoms{} }),
+		},
+		types.ConsumerRewardsAllocationByDenomKeyName: {
+			[]keyField{consumerId, stringField("denom")},
+			protoValue(func() proto.Message { return &types.ConsumerRewardsAllocation{} }),
+		},
+		types.ConsumersToBeCleanedUpKeyName:             {[]keyField{consumerId}, emptyValue},
+		types.ConsumerIdToLastPacketReceivedTimeKeyName: {[]keyField{consumerId}, timeBytesValue},
+		types.DormantConsumerKeyName:                    {[]keyField{consumerId}, emptyValue},
+		types.VerifiedConsumerKeyName:                   {[]keyField{consumerId}, emptyValue},
+		types.EvidenceSubmissionPausedConsumerKeyName:   {[]keyField{consumerId}, emptyValue},
+		types.ConsumerIdAndVscIdToHeightKeyName: {
+			[]keyField{consumerId, uint64Field("vscId")},
+			protoValue(func() proto.Message { return &types.VscIdToHeight{} }),
+		},
+		types.ConsumerIdToRewardChannelKeyName: {[]keyField{consumerId}, stringValue},
+		types.ConsumerIdToEndpointInfoKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.EndpointInfo{} }),
+		},
+		types.EpochInfoKeyName:               {nil, protoValue(func() proto.Message { return &types.EpochInfo{} })},
+		types.ConsumerIdToGenesisHashKeyName: {[]keyField{consumerId}, hexValue},
+		types.OwnerAddressToConsumerIdsKeyName: {
+			[]keyField{lenStringField("owner"), stringField("consumerId")},
+			emptyValue,
+		},
+		types.ConsumerIdToTimeoutPeriodsKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerTimeoutPeriods{} }),
+		},
+		types.ConsumerIdToPendingValidatorRemovalsKeyName: {[]keyField{consumerId, providerAddr}, uint64Value},
+		types.RewardsPausedConsumerKeyName:                {[]keyField{consumerId}, emptyValue},
+		types.ConsumerIdToHeldBackRewardsKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerRewardsAllocation{} }),
+		},
+		types.ForcedOptInTimeKeyName: {[]keyField{consumerId, providerAddr}, timeBytesValue},
+		types.SkippedDowntimeSlashKeyName: {
+			[]keyField{consumerId, uint64Field("sequence")},
+			protoValue(func() proto.Message { return &types.SkippedDowntimeSlash{} }),
+		},
+		types.SkippedDowntimeSlashSequenceKeyName: {[]keyField{consumerId}, uint64Value},
+		types.UnavailableValidatorKeyName:         {[]keyField{consumerId, providerAddr}, emptyValue},
+		types.UnroutableSlashPacketKeyName: {
+			[]keyField{consumerId, uint64Field("sequence")},
+			protoValue(func() proto.Message { return &types.UnroutableSlashPacket{} }),
+		},
+		types.UnroutableSlashPacketSequenceKeyName: {[]keyField{consumerId}, uint64Value},
+		types.OptInTimeKeyName:                     {[]keyField{consumerId, providerAddr}, timeBytesValue},
+		types.OptInHeightKeyName:                   {[]keyField{consumerId, providerAddr}, uint64Value},
+		types.PreOptInInfractionKeyName: {
+			[]keyField{consumerId, uint64Field("sequence")},
+			protoValue(func() proto.Message { return &types.PreOptInInfraction{} }),
+		},
+		types.PreOptInInfractionSequenceKeyName: {[]keyField{consumerId}, uint64Value},
+		types.EmergencyOptOutCooldownKeyName:    {[]keyField{consumerId, providerAddr}, timeBytesValue},
+		types.ConsumerIdToProviderUpgradeNoticeKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &ccvtypes.ProviderUpgradeNotice{} }),
+		},
+		types.ReOptInCooldownKeyName: {[]keyField{consumerId, providerAddr}, timeBytesValue},
+		types.ConsumerIdToCreationRecordKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerCreationRecord{} }),
+		},
+		types.ConsumerIdToRemovalRecordKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.ConsumerRemovalRecord{} }),
+		},
+		types.ConsumerIdToAllowedIcaMsgTypesKeyName: {
+			[]keyField{consumerId},
+			protoValue(func() proto.Message { return &types.AllowedIcaMsgTypes{} }),
+		},
+		types.ConsumerEconomicSecurityKeyName: {
+			[]keyField{consumerId, uint64Field("height")},
+			protoValue(func() proto.Message { return &types.ConsumerEconomicSecurity{} }),
+		},
+		types.KeyAssignmentNonceKeyName:                   {[]keyField{consumerId, providerAddr}, uint64Value},
+		types.LaunchedChainIdToConsumerIdKeyName:          {[]keyField{stringField("chainId")}, stringValue},
+		types.ConsumerIdToLaunchConflictRetriesKeyName:    {[]keyField{consumerId}, uint64Value},
+		types.ConsumersWithValidatorRemovalsToSendKeyName: {[]keyField{consumerId}, emptyValue},
+		types.ChannelDeletionTimeKeyName: {
+			[]keyField{fixedTimeField("deletionTime"), stringField("channelId")},
+			emptyValue,
+		},
+		types.ReminderTimeToConsumerIdsKeyName: {
+			[]keyField{timeField("reminderTime")},
+			protoValue(func() proto.Message { return &types.ConsumerIds{} }),
+		},
+		types.ConsumerAcceptsUpgradeNoticesKeyName:  {[]keyField{consumerId}, emptyValue},
+		types.EquivocationEvidenceMinChainIdKeyName: {[]keyField{consumerId}, stringValue},
+	}
+}
+
+// namedStoreEntryDecoder is the decoder of the entries stored under the key prefix with name `name`
+type namedStoreEntryDecoder struct {
+	name string
+	storeEntryDecoder
+}
+
+// storeEntryDecodersByPrefix are the decoders of the entries of the provider store indexed by the key prefixes
+var storeEntryDecodersByPrefix = getStoreEntryDecodersByPrefix()
+
+// getStoreEntryDecodersByPrefix returns the decoders of the entries of the provider store
+// together with the key names, indexed by the key prefixes
+func getStoreEntryDecodersByPrefix() map[byte]namedStoreEntryDecoder {
+	decoders := map[byte]namedStoreEntryDecoder{}
+	for name, decoder := range getStoreEntryDecoders() {
+		prefix, found := types.GetKeyPrefix(name)
+		if !found {
+			panic(fmt.Sprintf("could not find key prefix for index %s", name))
+		}
+		decoders[prefix] = namedStoreEntryDecoder{name, decoder}
+	}
+	return decoders
+}
+
+// DecodeStoreEntry returns the human-readable key and value of an entry of the provider store,
+// e.g., `ConsumerIdToPhaseKey(consumerId: 0)` and `CONSUMER_PHASE_LAUNCHED`
+func DecodeStoreEntry(pair kv.Pair) (key, value string, err error) {
+	if len(pair.Key) == 0 {
+		return "", "", fmt.Errorf("empty key")
+	}
+	entry, found := storeEntryDecodersByPrefix[pair.Key[0]]
+	if !found {
+		return "", "", fmt.Errorf("invalid provider key prefix %X", pair.Key[:1])
+	}
+
+	fields := make([]string, 0, len(entry.keyFields))
+	rest := pair.Key[1:]
+	for _, field := range entry.keyFields {
+		var fieldValue string
+		fieldValue, rest, err = field.decode(rest)
+		if err != nil {
+			return "", "", fmt.Errorf("decoding field %s of %s: %w", field.name, entry.name, err)
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s", field.name, fieldValue))
+	}
+	if len(rest) != 0 {
+		return "", "", fmt.Errorf("decoding %s: %d unexpected trailing bytes", entry.name, len(rest))
+	}
+
+	value, err = entry.value(pair.Value)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding value of %s: %w", entry.name, err)
+	}
+	return fmt.Sprintf("%s(%s)", entry.name, strings.Join(fields, ", ")), value, nil
+}
+
+// FormatStoreEntry returns a human-readable line for an entry of the provider store.
+// The entries that cannot be decoded are formatted as raw bytes, together with the decoding error.
+func FormatStoreEntry(pair kv.Pair) string {
+	key, value, err := DecodeStoreEntry(pair)
+	if err != nil {
+		return fmt.Sprintf("%X: %X (%s)", pair.Key, pair.Value, err)
+	}
+	return fmt.Sprintf("%s: %s", key, value)
+}
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding provider type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		_, valueA, err := DecodeStoreEntry(kvA)
+		if err != nil {
+			panic(err)
+		}
+		_, valueB, err := DecodeStoreEntry(kvB)
+		if err != nil {
+			panic(err)
+		}
+		return fmt.Sprintf("%v\n%v", valueA, valueB)
+	}
+}
+
+// lenStringField returns the field for a string that is prefixed by its length (see types.StringIdWithLenKey)
+func lenStringField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		if len(bz) < 8 {
+			return "", nil, fmt.Errorf("key too short; expected at least 8 bytes, got: %d", len(bz))
+		}
+		length := sdk.BigEndianToUint64(bz[:8])
+		if length > uint64(len(bz)-8) {
+			return "", nil, fmt.Errorf("key too short; expected string of %d bytes, got: %d", length, len(bz)-8)
+		}
+		return string(bz[8 : 8+length]), bz[8+length:], nil
+	}}
+}
+
+// stringField returns the field for a string that spans the rest of the key
+func stringField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		return string(bz), nil, nil
+	}}
+}
+
+// uint64Field returns the field for a big-endian uint64
+func uint64Field(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		if len(bz) < 8 {
+			return "", nil, fmt.Errorf("key too short; expected at least 8 bytes, got: %d", len(bz))
+		}
+		return fmt.Sprint(sdk.BigEndianToUint64(bz[:8])), bz[8:], nil
+	}}
+}
+
+// consAddrField returns the field for a consensus address that spans the rest of the key
+func consAddrField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		return sdk.ConsAddress(bz).String(), nil, nil
+	}}
+}
+
+// timeField returns the field for a time (see sdk.FormatTimeBytes) that spans the rest of the key
+func timeField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		ts, err := sdk.ParseTimeBytes(bz)
+		if err != nil {
+			return "", nil, err
+		}
+		return ts.String(), nil, nil
+	}}
+}
+
+// fixedTimeField returns the field for a time (see sdk.FormatTimeBytes) that is followed by other fields
+func fixedTimeField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		timeL := len(sdk.FormatTimeBytes(time.Time{}))
+		if len(bz) < timeL {
+			return "", nil, fmt.Errorf("key too short; expected at least %d bytes, got: %d", timeL, len(bz))
+		}
+		ts, err := sdk.ParseTimeBytes(bz[:timeL])
+		if err != nil {
+			return "", nil, err
+		}
+		return ts.String(), bz[timeL:], nil
+	}}
+}
+
+// hexField returns the field for raw bytes that span the rest of the key
+func hexField(name string) keyField {
+	return keyField{name, func(bz []byte) (string, []byte, error) {
+		return hex.EncodeToString(bz), nil, nil
+	}}
+}
+
+// protoValue returns the decoder of values that are protobuf messages of the type returned by `newMsg`
+func protoValue(newMsg func() proto.Message) valueDecoder {
+	return func(bz []byte) (string, error) {
+		msg := newMsg()
+		if err := proto.Unmarshal(bz, msg); err != nil {
+			return "", err
+		}
+		return msg.String(), nil
+	}
+}
+
+func stringValue(bz []byte) (string, error) {
+	return string(bz), nil
+}
+
+func hexValue(bz []byte) (string, error) {
+	return hex.EncodeToString(bz), nil
+}
+
+func emptyValue(bz []byte) (string, error) {
+	if len(bz) != 0 {
+		return "", fmt.Errorf("expected empty value, got %d bytes", len(bz))
+	}
+	return "", nil
+}
+
+func uint64Value(bz []byte) (string, error) {
+	if len(bz) != 8 {
+		return "", fmt.Errorf("expected 8 bytes, got %d", len(bz))
+	}
+	return fmt.Sprint(binary.BigEndian.Uint64(bz)), nil
+}
+
+func int64Value(bz []byte) (string, error) {
+	if len(bz) != 8 {
+		return "", fmt.Errorf("expected 8 bytes, got %d", len(bz))
+	}
+	return fmt.Sprint(int64(binary.BigEndian.Uint64(bz))), nil
+}
+
+func intValue(bz []byte) (string, error) {
+	var value math.Int
+	if err := value.Unmarshal(bz); err != nil {
+		return "", err
+	}
+	return value.String(), nil
+}
+
+func decValue(bz []byte) (string, error) {
+	var value math.LegacyDec
+	if err := value.Unmarshal(bz); err != nil {
+		return "", err
+	}
+	return value.String(), nil
+}
+
+func consAddrValue(bz []byte) (string, error) {
+	return sdk.ConsAddress(bz).String(), nil
+}
+
+// timeBytesValue decodes times stored with sdk.FormatTimeBytes
+func timeBytesValue(bz []byte) (string, error) {
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return "", err
+	}
+	return ts.String(), nil
+}
+
+// binaryTimeValue decodes times stored with time.Time.MarshalBinary
+func binaryTimeValue(bz []byte) (string, error) {
+	var ts time.Time
+	if err := ts.UnmarshalBinary(bz); err != nil {
+		return "", err
+	}
+	return ts.UTC().String(), nil
+}
+
+// phaseValue decodes consumer phases, which are stored as big-endian uint32 in 8 bytes
+func phaseValue(bz []byte) (string, error) {
+	if len(bz) != 8 {
+		return "", fmt.Errorf("expected 8 bytes, got %d", len(bz))
+	}
+	return types.ConsumerPhase(binary.BigEndian.Uint32(bz)).String(), nil
+}
+
+func removalInitiatorValue(bz []byte) (string, error) {
+	if len(bz) != 4 {
+		return "", fmt.Errorf("expected 4 bytes, got %d", len(bz))
+	}
+	return types.ConsumerRemovalInitiator(binary.BigEndian.Uint32(bz)).String(), nil
+}
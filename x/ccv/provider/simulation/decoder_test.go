@@ -0,0 +1,307 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/simulation"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+func TestDecodeProviderStore(t *testing.T) {
+	consumerId := "13"
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddr := types.NewConsumerConsAddress([]byte("consumerAddr"))
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tsString := ts.String()
+
+	uint64Bytes := func(i uint64) []byte {
+		return sdk.Uint64ToBigEndian(i)
+	}
+	mustMarshal := func(msg interface{ Marshal() ([]byte, error) }) []byte {
+		bz, err := msg.Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	params := types.DefaultParams()
+	consumerIds := types.ConsumerIds{Ids: []string{"1", "2"}}
+	validator := types.ConsensusValidator{ProviderConsAddr: providerAddr.ToSdkConsAddr(), Power: 10}
+	snapshot := types.ConsumerValSetSnapshot{Validators: []types.ConsensusValidator{validator}}
+	consumerKey := tmprotocrypto.PublicKey{Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: []byte("consumerKey")}}
+	genesis := ccvtypes.ConsumerGenesisState{NewChain: true}
+	metadata := types.ConsumerMetadata{Name: "name", Description: "description"}
+	powerShapingParams := types.PowerShapingParameters{Top_N: 50}
+	vscIdToHeight := types.VscIdToHeight{VscId: 2, Height: 20, Timestamp: ts}
+	phaseBytes := make([]byte, 8)
+	binary.BigEndian.PutUint32(phaseBytes, uint32(types.CONSUMER_PHASE_LAUNCHED))
+	initiatorBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(initiatorBytes, uint32(types.CONSUMER_REMOVAL_INITIATOR_OWNER))
+	removalTime, err := ts.MarshalBinary()
+	require.NoError(t, err)
+	slashMeter, err := math.NewInt(-100).Marshal()
+	require.NoError(t, err)
+	commissionRate, err := math.LegacyNewDecWithPrec(5, 2).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		pair          kv.Pair
+		expectedKey   string
+		expectedValue string
+	}{
+		{
+			"params",
+			kv.Pair{Key: types.ParametersKey(), Value: mustMarshal(&params)},
+			"ParametersKey()", params.String(),
+		},
+		{
+			"port",
+			kv.Pair{Key: types.PortKey(), Value: []byte("provider")},
+			"PortKey()", "provider",
+		},
+		{
+			"valset update id",
+			kv.Pair{Key: types.ValidatorSetUpdateIdKey(), Value: uint64Bytes(7)},
+			"ValidatorSetUpdateIdKey()", "7",
+		},
+		{
+			"slash meter",
+			kv.Pair{Key: types.SlashMeterKey(), Value: slashMeter},
+			"SlashMeterKey()", "-100",
+		},
+		{
+			"slash meter replenish time candidate",
+			kv.Pair{Key: types.SlashMeterReplenishTimeCandidateKey(), Value: sdk.FormatTimeBytes(ts)},
+			"SlashMeterReplenishTimeCandidateKey()", tsString,
+		},
+		{
+			"acknowledged consumer terms",
+			kv.Pair{Key: types.AcknowledgedConsumerTermsKey(consumerId, providerAddr), Value: []byte("hash")},
+			fmt.Sprintf("AcknowledgedConsumerTermsKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()), "hash",
+		},
+		{
+			"valset snapshot",
+			kv.Pair{Key: types.ConsumerValSetSnapshotKey(consumerId, 5), Value: mustMarshal(&snapshot)},
+			"ConsumerValSetSnapshotKey(consumerId: 13, height: 5)", snapshot.String(),
+		},
+		{
+			"stop time queue",
+			kv.Pair{Key: types.StopTimeToConsumerIdsKey(ts), Value: mustMarshal(&consumerIds)},
+			fmt.Sprintf("StopTimeToConsumerIdsKeyName(stopTime: %s)", tsString), consumerIds.String(),
+		},
+		{
+			"removal initiator",
+			kv.Pair{Key: types.ConsumerIdToRemovalInitiatorKey(consumerId), Value: initiatorBytes},
+			"ConsumerIdToRemovalInitiatorKey(consumerId: 13)", types.CONSUMER_REMOVAL_INITIATOR_OWNER.String(),
+		},
+		{
+			"channel to consumer id",
+			kv.Pair{Key: types.ChannelToConsumerIdKey("channel-0"), Value: []byte(consumerId)},
+			"ChannelToConsumerIdKey(channelId: channel-0)", consumerId,
+		},
+		{
+			"valset update block height",
+			kv.Pair{Key: types.ValsetUpdateBlockHeightKey(3), Value: uint64Bytes(30)},
+			"ValsetUpdateBlockHeightKey(vscId: 3)", "30",
+		},
+		{
+			"consumer genesis",
+			kv.Pair{Key: types.ConsumerGenesisKey(consumerId), Value: mustMarshal(&genesis)},
+			"ConsumerGenesisKey(consumerId: 13)", genesis.String(),
+		},
+		{
+			"consumer key",
+			kv.Pair{Key: types.ConsumerValidatorsKey(consumerId, providerAddr), Value: mustMarshal(&consumerKey)},
+			fmt.Sprintf("ConsumerValidatorsKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()), consumerKey.String(),
+		},
+		{
+			"validator by consumer address",
+			kv.Pair{Key: types.ValidatorsByConsumerAddrKey(consumerId, consumerAddr), Value: providerAddr.ToSdkConsAddr()},
+			fmt.Sprintf("ValidatorsByConsumerAddrKey(consumerId: 13, consumerAddr: %s)", consumerAddr.ToSdkConsAddr()),
+			providerAddr.ToSdkConsAddr().String(),
+		},
+		{
+			"opted in",
+			kv.Pair{Key: types.OptedInKey(consumerId, providerAddr), Value: []byte{}},
+			fmt.Sprintf("OptedInKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()), "",
+		},
+		{
+			"consumer validator",
+			kv.Pair{Key: types.ConsumerValidatorKey(consumerId, providerAddr.ToSdkConsAddr()), Value: mustMarshal(&validator)},
+			fmt.Sprintf("ConsumerValidatorKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()), validator.String(),
+		},
+		{
+			"commission rate",
+			kv.Pair{Key: types.ConsumerCommissionRateKey(consumerId, providerAddr), Value: commissionRate},
+			fmt.Sprintf("ConsumerCommissionRateKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()),
+			math.LegacyNewDecWithPrec(5, 2).String(),
+		},
+		{
+			"minimum power in top N",
+			kv.Pair{Key: types.MinimumPowerInTopNKey(consumerId), Value: uint64Bytes(100)},
+			"MinimumPowerInTopNKey(consumerId: 13)", "100",
+		},
+		{
+			"consumer addresses to prune",
+			kv.Pair{
+				Key:   types.ConsumerAddrsToPruneV2Key(consumerId, ts),
+				Value: mustMarshal(&types.AddressList{Addresses: [][]byte{consumerAddr.ToSdkConsAddr()}}),
+			},
+			fmt.Sprintf("ConsumerAddrsToPruneV2Key(consumerId: 13, pruneTs: %s)", tsString),
+			(&types.AddressList{Addresses: [][]byte{consumerAddr.ToSdkConsAddr()}}).String(),
+		},
+		{
+			"last provider consensus validator",
+			kv.Pair{Key: append(types.LastProviderConsensusValsPrefix(), providerAddr.ToSdkConsAddr()...), Value: mustMarshal(&validator)},
+			fmt.Sprintf("LastProviderConsensusValsKey(providerAddr: %s)", providerAddr.ToSdkConsAddr()), validator.String(),
+		},
+		{
+			"chain id",
+			kv.Pair{Key: types.ConsumerIdToChainIdKey(consumerId), Value: []byte("chain-1")},
+			"ConsumerIdToChainIdKey(consumerId: 13)", "chain-1",
+		},
+		{
+			"metadata",
+			kv.Pair{Key: types.ConsumerIdToMetadataKey(consumerId), Value: mustMarshal(&metadata)},
+			"ConsumerIdToMetadataKey(consumerId: 13)", metadata.String(),
+		},
+		{
+			"power-shaping parameters",
+			kv.Pair{Key: types.ConsumerIdToPowerShapingParametersKey(consumerId), Value: mustMarshal(&powerShapingParams)},
+			"ConsumerIdToPowerShapingParametersKey(consumerId: 13)", powerShapingParams.String(),
+		},
+		{
+			"phase",
+			kv.Pair{Key: types.ConsumerIdToPhaseKey(consumerId), Value: phaseBytes},
+			"ConsumerIdToPhaseKey(consumerId: 13)", types.CONSUMER_PHASE_LAUNCHED.String(),
+		},
+		{
+			"removal time",
+			kv.Pair{Key: types.ConsumerIdToRemovalTimeKey(consumerId), Value: removalTime},
+			"ConsumerIdToRemovalTimeKey(consumerId: 13)", tsString,
+		},
+		{
+			"spawn time queue",
+			kv.Pair{Key: types.SpawnTimeToConsumerIdsKey(ts), Value: mustMarshal(&consumerIds)},
+			fmt.Sprintf("SpawnTimeToConsumerIdsKeyName(spawnTime: %s)", tsString), consumerIds.String(),
+		},
+		{
+			"client id to consumer id",
+			kv.Pair{Key: types.ClientIdToConsumerIdKey("07-tendermint-0"), Value: []byte(consumerId)},
+			"ClientIdToConsumerIdKey(clientId: 07-tendermint-0)", consumerId,
+		},
+		{
+			"rewards allocation by denom",
+			kv.Pair{Key: types.ConsumerRewardsAllocationByDenomKey(consumerId, "uatom"), Value: mustMarshal(&types.ConsumerRewardsAllocation{})},
+			"ConsumerRewardsAllocationByDenomKey(consumerId: 13, denom: uatom)", (&types.ConsumerRewardsAllocation{}).String(),
+		},
+		{
+			"last packet received time",
+			kv.Pair{Key: types.ConsumerIdToLastPacketReceivedTimeKey(consumerId), Value: sdk.FormatTimeBytes(ts)},
+			"ConsumerIdToLastPacketReceivedTimeKey(consumerId: 13)", tsString,
+		},
+		{
+			"vsc id to height",
+			kv.Pair{Key: types.ConsumerIdAndVscIdToHeightKey(consumerId, 2), Value: mustMarshal(&vscIdToHeight)},
+			"ConsumerIdAndVscIdToHeightKey(consumerId: 13, vscId: 2)", vscIdToHeight.String(),
+		},
+		{
+			"genesis hash",
+			kv.Pair{Key: types.ConsumerIdToGenesisHashKey(consumerId), Value: []byte{0xab, 0xcd}},
+			"ConsumerIdToGenesisHashKey(consumerId: 13)", "abcd",
+		},
+		{
+			"owner address to consumer id",
+			kv.Pair{Key: types.OwnerAddressToConsumerIdKey("owner", consumerId), Value: []byte{}},
+			"OwnerAddressToConsumerIdsKey(owner: owner, consumerId: 13)", "",
+		},
+		{
+			"pending validator removal",
+			kv.Pair{Key: types.ConsumerIdToPendingValidatorRemovalKey(consumerId, providerAddr), Value: uint64Bytes(4)},
+			fmt.Sprintf("ConsumerIdToPendingValidatorRemovalsKey(consumerId: 13, providerAddr: %s)", providerAddr.ToSdkConsAddr()), "4",
+		},
+		{
+			"launched chain id to consumer id",
+			kv.Pair{Key: types.LaunchedChainIdToConsumerIdKey("chain-1"), Value: []byte(consumerId)},
+			"LaunchedChainIdToConsumerIdKey(chainId: chain-1)", consumerId,
+		},
+		{
+			"channel deletion time",
+			kv.Pair{Key: types.ChannelDeletionTimeKey(ts, "channel-0"), Value: []byte{}},
+			fmt.Sprintf("ChannelDeletionTimeKey(deletionTime: %s, channelId: channel-0)", tsString), "",
+		},
+		{
+			"reminder time queue",
+			kv.Pair{Key: types.ReminderTimeToConsumerIdsKey(ts), Value: mustMarshal(&consumerIds)},
+			fmt.Sprintf("ReminderTimeToConsumerIdsKey(reminderTime: %s)", tsString), consumerIds.String(),
+		},
+		{
+			"consumer accepts upgrade notices",
+			kv.Pair{Key: types.ConsumerAcceptsUpgradeNoticesKey(consumerId), Value: []byte{}},
+			fmt.Sprintf("ConsumerAcceptsUpgradeNoticesKey(consumerId: %s)", consumerId), "",
+		},
+		{
+			"equivocation evidence min chain id",
+			kv.Pair{Key: types.EquivocationEvidenceMinChainIdKey(consumerId), Value: []byte("chain-1")},
+			fmt.Sprintf("EquivocationEvidenceMinChainIdKey(consumerId: %s)", consumerId), "chain-1",
+		},
+		{
+			"deprecated prefix",
+			kv.Pair{Key: []byte{mustGetKeyPrefix(t, types.DeprecatedPendingCAPKeyName), 0x01}, Value: []byte{0x02}},
+			"DeprecatedPendingCAPKey(key: 01)", "02",
+		},
+	}
+
+	dec := simulation.NewDecodeStore()
+	for _, tc := range testCases {
+		key, value, err := simulation.DecodeStoreEntry(tc.pair)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expectedKey, key, tc.name)
+		require.Equal(t, tc.expectedValue, value, tc.name)
+		require.Equal(t, fmt.Sprintf("%s: %s", tc.expectedKey, tc.expectedValue), simulation.FormatStoreEntry(tc.pair), tc.name)
+		require.Equal(t, fmt.Sprintf("%v\n%v", tc.expectedValue, tc.expectedValue), dec(tc.pair, tc.pair), tc.name)
+	}
+
+	// the entries that cannot be decoded are formatted as raw bytes
+	pair := kv.Pair{Key: types.ConsumerIdToPhaseKey(consumerId)[:5], Value: phaseBytes}
+	_, _, err = simulation.DecodeStoreEntry(pair)
+	require.Error(t, err)
+	require.Contains(t, simulation.FormatStoreEntry(pair), fmt.Sprintf("%X: %X", pair.Key, pair.Value))
+
+	// unknown prefixes cannot be decoded
+	pair = kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}}
+	_, _, err = simulation.DecodeStoreEntry(pair)
+	require.Error(t, err)
+	require.Panics(t, func() { dec(pair, pair) })
+}
+
+// TestDecodeProviderStoreAllPrefixes tests that there is a decoder for every key prefix of the provider store
+func TestDecodeProviderStoreAllPrefixes(t *testing.T) {
+	for _, name := range types.GetAllKeyNames() {
+		prefix := mustGetKeyPrefix(t, name)
+		_, _, err := simulation.DecodeStoreEntry(kv.Pair{Key: []byte{prefix}})
+		if err != nil {
+			require.NotContains(t, err.Error(), "invalid provider key prefix", name)
+		}
+	}
+}
+
+func mustGetKeyPrefix(t *testing.T, name string) byte {
+	t.Helper()
+	prefix, found := types.GetKeyPrefix(name)
+	require.True(t, found, name)
+	return prefix
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+var (
+	_ authz.Authorization = &OptAuthorization{}
+	_ authz.Authorization = &AssignKeyAuthorization{}
+)
+
+// NewOptAuthorization creates a new OptAuthorization object
+func NewOptAuthorization(consumerIds []string, authzType OptAuthorizationType) *OptAuthorization {
+	return &OptAuthorization{
+		ConsumerIds:       consumerIds,
+		AuthorizationType: authzType,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a OptAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN:
+		return sdk.MsgTypeURL(&MsgOptIn{})
+	case OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT:
+		return sdk.MsgTypeURL(&MsgOptOut{})
+	default:
+		return ""
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a OptAuthorization) ValidateBasic() error {
+	if a.AuthorizationType != OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN &&
+		a.AuthorizationType != OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT {
+		return authz.ErrUnknownAuthorizationType
+	}
+	return validateAuthorizedConsumerIds(a.ConsumerIds)
+}
+
+// Accept implements Authorization.Accept. It accepts MsgOptIn (or MsgOptOut) messages
+// for the consumer chains in the authorized list of consumer ids.
+//
+// Note that a MsgOptIn message can also assign a consumer key, i.e., an OptAuthorization
+// for opting in does not restrict the type of the assigned consumer key.
+func (a OptAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	var consumerId string
+	switch m := msg.(type) {
+	case *MsgOptIn:
+		if a.AuthorizationType != OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+		}
+		consumerId = m.ConsumerId
+	case *MsgOptOut:
+		if a.AuthorizationType != OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+		}
+		consumerId = m.ConsumerId
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+	}
+
+	if !slices.Contains(a.ConsumerIds, consumerId) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot opt in or out on consumer chain with id %s", consumerId)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// NewAssignKeyAuthorization creates a new AssignKeyAuthorization object
+func NewAssignKeyAuthorization(consumerIds, keyTypes []string) *AssignKeyAuthorization {
+	return &AssignKeyAuthorization{
+		ConsumerIds: consumerIds,
+		KeyTypes:    keyTypes,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL
+func (a AssignKeyAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgAssignConsumerKey{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic
+func (a AssignKeyAuthorization) ValidateBasic() error {
+	if err := validateAuthorizedConsumerIds(a.ConsumerIds); err != nil {
+		return err
+	}
+	for _, keyType := range a.KeyTypes {
+		if keyType == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "key type cannot be empty")
+		}
+	}
+	return nil
+}
+
+// Accept implements Authorization.Accept. It accepts MsgAssignConsumerKey messages
+// for the consumer chains in the authorized list of consumer ids and, if the list
+// of key types is not empty, only for consumer keys of the authorized types.
+func (a AssignKeyAuthorization) Accept(_ context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	assignKeyMsg, ok := msg.(*MsgAssignConsumerKey)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %s, got %s", a.MsgTypeURL(), sdk.MsgTypeURL(msg))
+	}
+
+	if !slices.Contains(a.ConsumerIds, assignKeyMsg.ConsumerId) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot assign key on consumer chain with id %s", assignKeyMsg.ConsumerId)
+	}
+
+	if len(a.KeyTypes) > 0 {
+		keyType, _, err := ParseConsumerKeyFromJson(assignKeyMsg.ConsumerKey)
+		if err != nil {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ErrInvalidMsgAssignConsumerKey, "ConsumerKey: %s", err.Error())
+		}
+		if !slices.Contains(a.KeyTypes, keyType) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot assign key of type %s", keyType)
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// validateAuthorizedConsumerIds validates a non-empty list of consumer ids without duplicates
+func validateAuthorizedConsumerIds(consumerIds []string) error {
+	if len(consumerIds) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "consumer ids cannot be empty")
+	}
+	seen := map[string]bool{}
+	for _, consumerId := range consumerIds {
+		if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid consumer id: %s", err.Error())
+		}
+		if seen[consumerId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate consumer id: %s", consumerId)
+		}
+		seen[consumerId] = true
+	}
+	return nil
+}
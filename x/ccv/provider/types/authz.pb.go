@@ -0,0 +1,646 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchain_security/ccv/provider/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OptAuthorizationType defines the type of an OptAuthorization
+type OptAuthorizationType int32
+
+const (
+	// OPT_AUTHORIZATION_TYPE_UNSPECIFIED specifies an unknown authorization type
+	OptAuthorizationType_OPT_AUTHORIZATION_TYPE_UNSPECIFIED OptAuthorizationType = 0
+	// OPT_AUTHORIZATION_TYPE_OPT_IN defines an authorization type for MsgOptIn
+	OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN OptAuthorizationType = 1
+	// OPT_AUTHORIZATION_TYPE_OPT_OUT defines an authorization type for MsgOptOut
+	OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT OptAuthorizationType = 2
+)
+
+var OptAuthorizationType_name = map[int32]string{
+	0: "OPT_AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "OPT_AUTHORIZATION_TYPE_OPT_IN",
+	2: "OPT_AUTHORIZATION_TYPE_OPT_OUT",
+}
+
+var OptAuthorizationType_value = map[string]int32{
+	"OPT_AUTHORIZATION_TYPE_UNSPECIFIED": 0,
+	"OPT_AUTHORIZATION_TYPE_OPT_IN":      1,
+	"OPT_AUTHORIZATION_TYPE_OPT_OUT":     2,
+}
+
+func (x OptAuthorizationType) String() string {
+	return proto.EnumName(OptAuthorizationType_name, int32(x))
+}
+
+func (OptAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2a8ce08439aceac2, []int{0}
+}
+
+// OptAuthorization defines an authorization for MsgOptIn or MsgOptOut
+// restricted to a list of consumer chains.
+type OptAuthorization struct {
+	// the consumer ids of the consumer chains the grantee can opt in (or out)
+	// on behalf of the granter
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+	// the type of the authorization, i.e., opt in or opt out
+	AuthorizationType OptAuthorizationType `protobuf:"varint,2,opt,name=authorization_type,json=authorizationType,proto3,enum=interchain_security.ccv.provider.v1.OptAuthorizationType" json:"authorization_type,omitempty"`
+}
+
+func (m *OptAuthorization) Reset()         { *m = OptAuthorization{} }
+func (m *OptAuthorization) String() string { return proto.CompactTextString(m) }
+func (*OptAuthorization) ProtoMessage()    {}
+func (*OptAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a8ce08439aceac2, []int{0}
+}
+func (m *OptAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OptAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OptAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OptAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OptAuthorization.Merge(m, src)
+}
+func (m *OptAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *OptAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_OptAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OptAuthorization proto.InternalMessageInfo
+
+func (m *OptAuthorization) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+func (m *OptAuthorization) GetAuthorizationType() OptAuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return OptAuthorizationType_OPT_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+// AssignKeyAuthorization defines an authorization for MsgAssignConsumerKey
+// restricted to a list of consumer chains and, optionally, to a list of key types.
+type AssignKeyAuthorization struct {
+	// the consumer ids of the consumer chains for which the grantee can assign
+	// consumer keys on behalf of the granter
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+	// the types of the consumer keys that can be assigned (e.g., "/cosmos.crypto.ed25519.PubKey");
+	// if empty, consumer keys of any type can be assigned
+	KeyTypes []string `protobuf:"bytes,2,rep,name=key_types,json=keyTypes,proto3" json:"key_types,omitempty"`
+}
+
+func (m *AssignKeyAuthorization) Reset()         { *m = AssignKeyAuthorization{} }
+func (m *AssignKeyAuthorization) String() string { return proto.CompactTextString(m) }
+func (*AssignKeyAuthorization) ProtoMessage()    {}
+func (*AssignKeyAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a8ce08439aceac2, []int{1}
+}
+func (m *AssignKeyAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssignKeyAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssignKeyAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssignKeyAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssignKeyAuthorization.Merge(m, src)
+}
+func (m *AssignKeyAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssignKeyAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssignKeyAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssignKeyAuthorization proto.InternalMessageInfo
+
+func (m *AssignKeyAuthorization) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+func (m *AssignKeyAuthorization) GetKeyTypes() []string {
+	if m != nil {
+		return m.KeyTypes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.OptAuthorizationType", OptAuthorizationType_name, OptAuthorizationType_value)
+	proto.RegisterType((*OptAuthorization)(nil), "interchain_security.ccv.provider.v1.OptAuthorization")
+	proto.RegisterType((*AssignKeyAuthorization)(nil), "interchain_security.ccv.provider.v1.AssignKeyAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/authz.proto", fileDescriptor_2a8ce08439aceac2)
+}
+
+var fileDescriptor_2a8ce08439aceac2 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xcc, 0x2b, 0x49,
+	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x2f, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0xd4,
+	0x4f, 0x4e, 0x2e, 0xd3, 0x2f, 0x28, 0xca, 0x2f, 0xcb, 0x4c, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4,
+	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0xc6, 0xa2, 0x41,
+	0x2f, 0x39, 0xb9, 0x4c, 0x0f, 0xa6, 0x41, 0xaf, 0xcc, 0x50, 0x4a, 0x32, 0x39, 0xbf, 0x38, 0x37,
+	0xbf, 0x38, 0x1e, 0xac, 0x45, 0x1f, 0xc2, 0x81, 0xe8, 0x97, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
+	0xd7, 0x07, 0x93, 0x10, 0x21, 0xa5, 0xa7, 0x8c, 0x5c, 0x02, 0xfe, 0x05, 0x25, 0x8e, 0xa5, 0x25,
+	0x19, 0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x42, 0x8a, 0x5c, 0x3c, 0xc9, 0xf9,
+	0x79, 0xc5, 0xa5, 0xb9, 0xa9, 0x45, 0xf1, 0x99, 0x29, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0x9c,
+	0x41, 0xdc, 0x30, 0x31, 0xcf, 0x94, 0x62, 0xa1, 0x0c, 0x2e, 0xa1, 0x44, 0x64, 0x3d, 0xf1, 0x25,
+	0x95, 0x05, 0xa9, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0x96, 0x7a, 0x44, 0xb8, 0x53, 0x0f,
+	0xdd, 0xd6, 0x90, 0xca, 0x82, 0xd4, 0x20, 0xc1, 0x44, 0x74, 0x21, 0x2b, 0x97, 0x53, 0x5b, 0x74,
+	0x95, 0xa0, 0xde, 0x80, 0x04, 0x46, 0x99, 0x61, 0x52, 0x6a, 0x49, 0xa2, 0xa1, 0x1e, 0x8a, 0xf6,
+	0xae, 0xe7, 0x1b, 0xb4, 0x24, 0xe1, 0x01, 0x87, 0x6e, 0xb8, 0xd2, 0x12, 0x46, 0x2e, 0x31, 0xc7,
+	0xe2, 0xe2, 0xcc, 0xf4, 0x3c, 0xef, 0xd4, 0x4a, 0x92, 0x7d, 0x2b, 0xcd, 0xc5, 0x99, 0x9d, 0x5a,
+	0x09, 0xf6, 0x63, 0xb1, 0x04, 0x13, 0x58, 0x9e, 0x23, 0x3b, 0xb5, 0x12, 0xe4, 0xbe, 0x62, 0x2b,
+	0x2f, 0xe2, 0x1d, 0x28, 0x0f, 0x77, 0x20, 0x76, 0xb7, 0x68, 0xb5, 0x32, 0x72, 0x89, 0x60, 0x0b,
+	0x18, 0x21, 0x35, 0x2e, 0x25, 0xff, 0x80, 0x90, 0x78, 0xc7, 0xd0, 0x10, 0x0f, 0xff, 0x20, 0xcf,
+	0x28, 0xc7, 0x10, 0x4f, 0x7f, 0xbf, 0xf8, 0x90, 0xc8, 0x00, 0xd7, 0xf8, 0x50, 0xbf, 0xe0, 0x00,
+	0x57, 0x67, 0x4f, 0x37, 0x4f, 0x57, 0x17, 0x01, 0x06, 0x21, 0x45, 0x2e, 0x59, 0x1c, 0xea, 0x40,
+	0xc2, 0x9e, 0x7e, 0x02, 0x8c, 0x42, 0x4a, 0x5c, 0x72, 0x78, 0x94, 0xf8, 0x87, 0x86, 0x08, 0x30,
+	0x39, 0x85, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
+	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6d, 0x7a, 0x66,
+	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x34, 0x71, 0x21, 0x25, 0x63, 0x5d, 0x78, 0x32,
+	0x2e, 0x33, 0xd3, 0xaf, 0x40, 0x4d, 0xcb, 0xe0, 0xc0, 0x4b, 0x62, 0x03, 0x27, 0x3b, 0x63, 0x40,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x18, 0x32, 0xd8, 0xfc, 0x02, 0x00, 0x00,
+}
+
+func (m *OptAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OptAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OptAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssignKeyAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignKeyAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssignKeyAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyTypes) > 0 {
+		for iNdEx := len(m.KeyTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KeyTypes[iNdEx])
+			copy(dAtA[i:], m.KeyTypes[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.KeyTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	return n
+}
+
+func (m *AssignKeyAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.KeyTypes) > 0 {
+		for _, s := range m.KeyTypes {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= OptAuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssignKeyAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssignKeyAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssignKeyAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyTypes = append(m.KeyTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
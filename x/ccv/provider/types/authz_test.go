@@ -0,0 +1,262 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestOptAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization *types.OptAuthorization
+		valid         bool
+	}{
+		{
+			name:          "valid opt in",
+			authorization: types.NewOptAuthorization([]string{"0", "1"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN),
+			valid:         true,
+		},
+		{
+			name:          "valid opt out",
+			authorization: types.NewOptAuthorization([]string{"0"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT),
+			valid:         true,
+		},
+		{
+			name:          "invalid - unspecified type",
+			authorization: types.NewOptAuthorization([]string{"0"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_UNSPECIFIED),
+			valid:         false,
+		},
+		{
+			name:          "invalid - empty consumer ids",
+			authorization: types.NewOptAuthorization([]string{}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN),
+			valid:         false,
+		},
+		{
+			name:          "invalid - invalid consumer id",
+			authorization: types.NewOptAuthorization([]string{"chain-1"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN),
+			valid:         false,
+		},
+		{
+			name:          "invalid - duplicate consumer ids",
+			authorization: types.NewOptAuthorization([]string{"1", "1"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN),
+			valid:         false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestOptAuthorizationAccept(t *testing.T) {
+	optIn := types.NewOptAuthorization([]string{"0", "1"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN)
+	optOut := types.NewOptAuthorization([]string{"0"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_OUT)
+
+	require.Equal(t, "/interchain_security.ccv.provider.v1.MsgOptIn", optIn.MsgTypeURL())
+	require.Equal(t, "/interchain_security.ccv.provider.v1.MsgOptOut", optOut.MsgTypeURL())
+
+	testCases := []struct {
+		name          string
+		authorization *types.OptAuthorization
+		msg           sdk.Msg
+		accept        bool
+	}{
+		{
+			name:          "opt in on authorized consumer",
+			authorization: optIn,
+			msg:           &types.MsgOptIn{ConsumerId: "1"},
+			accept:        true,
+		},
+		{
+			name:          "opt in on unauthorized consumer",
+			authorization: optIn,
+			msg:           &types.MsgOptIn{ConsumerId: "2"},
+			accept:        false,
+		},
+		{
+			name:          "opt out with an opt in authorization",
+			authorization: optIn,
+			msg:           &types.MsgOptOut{ConsumerId: "1"},
+			accept:        false,
+		},
+		{
+			name:          "opt out on authorized consumer",
+			authorization: optOut,
+			msg:           &types.MsgOptOut{ConsumerId: "0"},
+			accept:        true,
+		},
+		{
+			name:          "opt out on unauthorized consumer",
+			authorization: optOut,
+			msg:           &types.MsgOptOut{ConsumerId: "1"},
+			accept:        false,
+		},
+		{
+			name:          "opt in with an opt out authorization",
+			authorization: optOut,
+			msg:           &types.MsgOptIn{ConsumerId: "0"},
+			accept:        false,
+		},
+		{
+			name:          "different message type",
+			authorization: optIn,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "0"},
+			accept:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		resp, err := tc.authorization.Accept(context.Background(), tc.msg)
+		if tc.accept {
+			require.NoError(t, err, tc.name)
+			require.True(t, resp.Accept, tc.name)
+			require.False(t, resp.Delete, tc.name)
+			require.Nil(t, resp.Updated, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.False(t, resp.Accept, tc.name)
+		}
+	}
+}
+
+func TestAssignKeyAuthorizationValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization *types.AssignKeyAuthorization
+		valid         bool
+	}{
+		{
+			name:          "valid without key types",
+			authorization: types.NewAssignKeyAuthorization([]string{"0", "1"}, nil),
+			valid:         true,
+		},
+		{
+			name:          "valid with key types",
+			authorization: types.NewAssignKeyAuthorization([]string{"0"}, []string{"/cosmos.crypto.ed25519.PubKey"}),
+			valid:         true,
+		},
+		{
+			name:          "invalid - empty consumer ids",
+			authorization: types.NewAssignKeyAuthorization(nil, nil),
+			valid:         false,
+		},
+		{
+			name:          "invalid - duplicate consumer ids",
+			authorization: types.NewAssignKeyAuthorization([]string{"0", "0"}, nil),
+			valid:         false,
+		},
+		{
+			name:          "invalid - empty key type",
+			authorization: types.NewAssignKeyAuthorization([]string{"0"}, []string{""}),
+			valid:         false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.authorization.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAssignKeyAuthorizationAccept(t *testing.T) {
+	ed25519Key := `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk="}`
+	secp256k1Key := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8x5hDQdwTnmjqUe8RKAz0GgV/iTEfsfir+8lLf9Z5Y2"}`
+
+	anyKeyType := types.NewAssignKeyAuthorization([]string{"0", "1"}, nil)
+	ed25519Only := types.NewAssignKeyAuthorization([]string{"0"}, []string{"/cosmos.crypto.ed25519.PubKey"})
+
+	require.Equal(t, "/interchain_security.ccv.provider.v1.MsgAssignConsumerKey", anyKeyType.MsgTypeURL())
+
+	testCases := []struct {
+		name          string
+		authorization *types.AssignKeyAuthorization
+		msg           sdk.Msg
+		accept        bool
+	}{
+		{
+			name:          "assign key on authorized consumer",
+			authorization: anyKeyType,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "1", ConsumerKey: secp256k1Key},
+			accept:        true,
+		},
+		{
+			name:          "assign key on unauthorized consumer",
+			authorization: anyKeyType,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "2", ConsumerKey: ed25519Key},
+			accept:        false,
+		},
+		{
+			name:          "assign key of authorized type",
+			authorization: ed25519Only,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "0", ConsumerKey: ed25519Key},
+			accept:        true,
+		},
+		{
+			name:          "assign key of unauthorized type",
+			authorization: ed25519Only,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "0", ConsumerKey: secp256k1Key},
+			accept:        false,
+		},
+		{
+			name:          "assign invalid key with pinned key types",
+			authorization: ed25519Only,
+			msg:           &types.MsgAssignConsumerKey{ConsumerId: "0", ConsumerKey: "invalid"},
+			accept:        false,
+		},
+		{
+			name:          "different message type",
+			authorization: anyKeyType,
+			msg:           &types.MsgOptIn{ConsumerId: "0", ConsumerKey: ed25519Key},
+			accept:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		resp, err := tc.authorization.Accept(context.Background(), tc.msg)
+		if tc.accept {
+			require.NoError(t, err, tc.name)
+			require.True(t, resp.Accept, tc.name)
+			require.False(t, resp.Delete, tc.name)
+			require.Nil(t, resp.Updated, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.False(t, resp.Accept, tc.name)
+		}
+	}
+}
+
+// TestAuthorizationsRegistered tests that the authorizations can be packed and unpacked as authz.Authorization
+func TestAuthorizationsRegistered(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	for _, authorization := range []authz.Authorization{
+		types.NewOptAuthorization([]string{"0"}, types.OptAuthorizationType_OPT_AUTHORIZATION_TYPE_OPT_IN),
+		types.NewAssignKeyAuthorization([]string{"0"}, []string{"/cosmos.crypto.ed25519.PubKey"}),
+	} {
+		anyAuthorization, err := codectypes.NewAnyWithValue(authorization)
+		require.NoError(t, err)
+
+		var unpacked authz.Authorization
+		require.NoError(t, registry.UnpackAny(anyAuthorization, &unpacked))
+		require.Equal(t, authorization, unpacked)
+	}
+}
@@ -5,13 +5,40 @@ import (
 	tendermint "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
+// RegisterLegacyAminoCodec registers the provider messages and authorizations on the provided LegacyAmino codec,
+// using the same names as their `amino.name` proto options. These names are used in the SIGN_MODE_LEGACY_AMINO_JSON
+// sign bytes, e.g., when signing with a Ledger device. Note that legacy amino names must not exceed 39 characters.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgAssignConsumerKey{}, "provider/MsgAssignConsumerKey")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitConsumerMisbehaviour{}, "provider/MsgSubmitConsumerMisbehaviour")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitConsumerDoubleVoting{}, "provider/MsgSubmitConsumerDoubleVoting")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "provider/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgConsumerAddition{}, "provider/MsgConsumerAddition")
+	legacy.RegisterAminoMsg(cdc, &MsgConsumerRemoval{}, "provider/MsgConsumerRemoval")
+	legacy.RegisterAminoMsg(cdc, &MsgConsumerModification{}, "provider/MsgConsumerModification")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateConsumer{}, "provider/MsgCreateConsumer")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateConsumer{}, "provider/MsgUpdateConsumer")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveConsumer{}, "provider/MsgRemoveConsumer")
+	legacy.RegisterAminoMsg(cdc, &MsgConsumerHardFork{}, "provider/MsgConsumerHardFork")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeRewardDenoms{}, "provider/MsgChangeRewardDenoms")
+	legacy.RegisterAminoMsg(cdc, &MsgSetConsumerVerified{}, "provider/MsgSetConsumerVerified")
+	legacy.RegisterAminoMsg(cdc, &MsgSetConsumerEvidenceSubmissionPaused{}, "provider/MsgSetEvidenceSubmissionPaused")
+	legacy.RegisterAminoMsg(cdc, &MsgOptIn{}, "provider/MsgOptIn")
+	legacy.RegisterAminoMsg(cdc, &MsgOptOut{}, "provider/MsgOptOut")
+	legacy.RegisterAminoMsg(cdc, &MsgEmergencyOptOut{}, "provider/MsgEmergencyOptOut")
+	legacy.RegisterAminoMsg(cdc, &MsgSetConsumerCommissionRate{}, "provider/MsgSetConsumerCommissionRate")
+	legacy.RegisterAminoMsg(cdc, &MsgSetConsumerAvailability{}, "provider/MsgSetConsumerAvailability")
+
+	cdc.RegisterConcrete(&OptAuthorization{}, "provider/OptAuthorization", nil)
+	cdc.RegisterConcrete(&AssignKeyAuthorization{}, "provider/AssignKeyAuthorization", nil)
 }
 
 // RegisterInterfaces registers the provider proposal structs to the interface registry
@@ -33,6 +60,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpdateConsumer{},
 		&MsgRemoveConsumer{},
 		&MsgChangeRewardDenoms{},
+		&MsgSetConsumerVerified{},
+		&MsgSetConsumerEvidenceSubmissionPaused{},
+		&MsgConsumerHardFork{},
 		&MsgUpdateParams{},
 	)
 	// keep so existing proposals can be correctly deserialized
@@ -56,6 +86,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgOptOut{},
 	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgEmergencyOptOut{},
+	)
 	registry.RegisterImplementations(
 		(*exported.ClientMessage)(nil),
 		&tendermint.Misbehaviour{},
@@ -64,6 +98,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgSetConsumerCommissionRate{},
 	)
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetConsumerAvailability{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&OptAuthorization{},
+		&AssignKeyAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -0,0 +1,273 @@
+package types_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	appencoding "github.com/cosmos/interchain-security/v6/app/encoding"
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+var updateGoldenFiles = flag.Bool("update-golden", false, "update the golden files of the sign bytes")
+
+const consumerKey = `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`
+
+// signBytes are the sign bytes of a transaction with a single message in the
+// SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL (hex-encoded) sign modes
+type signBytes struct {
+	AminoJSON json.RawMessage `json:"amino_json"`
+	Textual   string          `json:"textual"`
+}
+
+// newSignModeTestTxConfig returns a TxConfig with the SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL
+// sign modes enabled, where SIGN_MODE_TEXTUAL uses the value renderers of the provider module
+func newSignModeTestTxConfig(t *testing.T) client.TxConfig {
+	t.Helper()
+
+	encodingConfig := appencoding.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	cryptocodec.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	textualHandler, err := types.NewTextualSignModeHandler(textual.SignModeOptions{
+		CoinMetadataQuerier: func(context.Context, string) (*bankv1beta1.Metadata, error) { return nil, nil },
+		FileResolver:        encodingConfig.InterfaceRegistry,
+	})
+	require.NoError(t, err)
+	txConfig, err := authtx.NewTxConfigWithOptions(encodingConfig.Codec, authtx.ConfigOptions{
+		EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		CustomSignModes:  []txsigning.SignModeHandler{textualHandler},
+	})
+	require.NoError(t, err)
+	return txConfig
+}
+
+// getTestProviderMsgs returns an instance of every provider message, indexed by the message name
+func getTestProviderMsgs(signer sdk.AccAddress) map[string]sdk.Msg {
+	addr := signer.String()
+	valAddr := sdk.ValAddress(signer).String()
+	spawnTime := time.Date(2024, 9, 26, 8, 32, 12, 0, time.UTC)
+
+	initializationParameters := &types.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(0, 5),
+		GenesisHash:                       []byte("gen_hash"),
+		BinaryHash:                        []byte("bin_hash"),
+		SpawnTime:                         spawnTime,
+		UnbondingPeriod:                   21 * 24 * time.Hour,
+		CcvTimeoutPeriod:                  time.Hour,
+		TransferTimeoutPeriod:             time.Minute,
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10,
+		HistoricalEntries:                 100,
+		DistributionTransmissionChannel:   "",
+	}
+	powerShapingParameters := &types.PowerShapingParameters{
+		Top_N:     50,
+		Allowlist: []string{"cosmosvalcons1pz93k7t96h2zuq3r3ck85r4pc6usgdau639e4x"},
+	}
+	metadata := types.ConsumerMetadata{Name: "pion", Description: "description", Metadata: "metadata"}
+
+	return map[string]sdk.Msg{
+		"MsgAssignConsumerKey": &types.MsgAssignConsumerKey{
+			ProviderAddr: valAddr, ConsumerKey: consumerKey, Signer: addr, ConsumerId: "0",
+		},
+		"MsgSubmitConsumerMisbehaviour": &types.MsgSubmitConsumerMisbehaviour{
+			Submitter: addr, ConsumerId: "0",
+		},
+		"MsgSubmitConsumerDoubleVoting": &types.MsgSubmitConsumerDoubleVoting{
+			Submitter: addr, ConsumerId: "0",
+		},
+		"MsgUpdateParams": &types.MsgUpdateParams{
+			Authority: addr, Params: types.DefaultParams(),
+		},
+		"MsgConsumerAddition": &types.MsgConsumerAddition{
+			ChainId: "pion-1", InitialHeight: clienttypes.NewHeight(1, 5), SpawnTime: spawnTime,
+			ConsumerRedistributionFraction: "0.75", Authority: addr,
+		},
+		"MsgConsumerRemoval": &types.MsgConsumerRemoval{
+			ChainId: "pion-1", StopTime: spawnTime, Authority: addr,
+		},
+		"MsgConsumerModification": &types.MsgConsumerModification{
+			Title: "title", ChainId: "pion-1", Top_N: 50, Authority: addr,
+		},
+		"MsgCreateConsumer": &types.MsgCreateConsumer{
+			Submitter: addr, ChainId: "pion-1", Metadata: metadata,
+			InitializationParameters: initializationParameters, PowerShapingParameters: powerShapingParameters,
+			AllowlistedRewardDenoms: &types.AllowlistedRewardDenoms{Denoms: []string{"ibc/denom"}},
+		},
+		"MsgUpdateConsumer": &types.MsgUpdateConsumer{
+			Owner: addr, ConsumerId: "0", NewOwnerAddress: addr, Metadata: &metadata,
+			InitializationParameters: initializationParameters, PowerShapingParameters: powerShapingParameters,
+		},
+		"MsgRemoveConsumer": &types.MsgRemoveConsumer{
+			ConsumerId: "0", Owner: addr,
+		},
+		"MsgConsumerHardFork": &types.MsgConsumerHardFork{
+			ConsumerId: "0", Owner: addr, NewChainId: "pion-2", InitialHeight: clienttypes.NewHeight(2, 1),
+		},
+		"MsgChangeRewardDenoms": &types.MsgChangeRewardDenoms{
+			DenomsToAdd: []string{"ibc/denom"}, Authority: addr,
+		},
+		"MsgSetConsumerVerified": &types.MsgSetConsumerVerified{
+			ConsumerId: "0", Verified: true, Authority: addr,
+		},
+		"MsgSetConsumerEvidenceSubmissionPaused": &types.MsgSetConsumerEvidenceSubmissionPaused{
+			ConsumerId: "0", Paused: true, Authority: addr,
+		},
+		"MsgOptIn": &types.MsgOptIn{
+			ProviderAddr: valAddr, ConsumerKey: consumerKey, Signer: addr, ConsumerId: "0",
+		},
+		"MsgOptOut": &types.MsgOptOut{
+			ProviderAddr: valAddr, Signer: addr, ConsumerId: "0",
+		},
+		"MsgEmergencyOptOut": &types.MsgEmergencyOptOut{
+			ConsumerId: "0", ProviderAddr: valAddr, Signer: addr,
+		},
+		"MsgSetConsumerCommissionRate": &types.MsgSetConsumerCommissionRate{
+			ProviderAddr: valAddr, Rate: math.LegacyNewDecWithPrec(5, 2), Signer: addr, ConsumerId: "0",
+		},
+		"MsgSetConsumerAvailability": &types.MsgSetConsumerAvailability{
+			ConsumerId: "0", ProviderAddr: valAddr, Available: false, Signer: addr,
+		},
+	}
+}
+
+// TestProviderMsgsLegacyAminoNames checks that all the provider messages are registered on the legacy amino codec
+// with the names used in the SIGN_MODE_LEGACY_AMINO_JSON sign bytes, i.e., the `amino.name` proto options
+func TestProviderMsgsLegacyAminoNames(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	types.RegisterLegacyAminoCodec(cdc)
+
+	txConfig := newSignModeTestTxConfig(t)
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("provider sign mode test"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+
+	msgs := getTestProviderMsgs(signer)
+	// all the provider messages are covered
+	registry := appencoding.MakeTestEncodingConfig().InterfaceRegistry
+	types.RegisterInterfaces(registry)
+	for _, typeURL := range registry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		name, found := strings.CutPrefix(typeURL, "/interchain_security.ccv.provider.v1.")
+		if !found {
+			continue
+		}
+		_, found = msgs[name]
+		require.True(t, found, "missing sign bytes test for %s", typeURL)
+	}
+
+	for name, msg := range msgs {
+		bz, err := cdc.MarshalJSON(msg)
+		require.NoError(t, err, name)
+		var legacyMsg struct {
+			Type string `json:"type"`
+		}
+		require.NoError(t, json.Unmarshal(bz, &legacyMsg), name)
+		require.LessOrEqual(t, len(legacyMsg.Type), 39, name)
+
+		var signDoc struct {
+			Msgs []struct {
+				Type string `json:"type"`
+			} `json:"msgs"`
+		}
+		bz = getSignBytes(t, txConfig, privKey, msg, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		require.NoError(t, json.Unmarshal(bz, &signDoc), name)
+		require.Len(t, signDoc.Msgs, 1, name)
+		require.Equal(t, legacyMsg.Type, signDoc.Msgs[0].Type, name)
+	}
+}
+
+// TestProviderMsgsSignBytes checks that the SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_TEXTUAL sign bytes
+// of the provider messages are stable, i.e., they match the golden file, also after the transactions
+// are encoded and decoded, as done by hardware wallets and the ante handler
+func TestProviderMsgsSignBytes(t *testing.T) {
+	txConfig := newSignModeTestTxConfig(t)
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("provider sign mode test"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+
+	actual := map[string]signBytes{}
+	for name, msg := range getTestProviderMsgs(signer) {
+		aminoJSON := getSignBytes(t, txConfig, privKey, msg, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+		textualBytes := getSignBytes(t, txConfig, privKey, msg, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		actual[name] = signBytes{AminoJSON: aminoJSON, Textual: hex.EncodeToString(textualBytes)}
+	}
+
+	// the consumer keys are rendered as the key type and the base64-encoded key
+	pkType, key, err := types.ParseConsumerKeyFromJson(consumerKey)
+	require.NoError(t, err)
+	for _, name := range []string{"MsgAssignConsumerKey", "MsgOptIn"} {
+		textualBytes, err := hex.DecodeString(actual[name].Textual)
+		require.NoError(t, err)
+		require.Contains(t, string(textualBytes), pkType, name)
+		require.Contains(t, string(textualBytes), key, name)
+		require.NotContains(t, string(textualBytes), consumerKey, name)
+	}
+
+	bz, err := json.MarshalIndent(actual, "", "  ")
+	require.NoError(t, err)
+	bz = append(bz, '\n')
+
+	path := filepath.Join("testdata", "sign_bytes.golden.json")
+	if *updateGoldenFiles {
+		require.NoError(t, os.MkdirAll("testdata", 0o755))
+		require.NoError(t, os.WriteFile(path, bz, 0o600))
+	}
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(bz))
+}
+
+// getSignBytes returns the sign bytes in `signMode` of a transaction with `msg` signed by `privKey`,
+// after encoding and decoding the transaction
+func getSignBytes(t *testing.T, txConfig client.TxConfig, privKey *secp256k1.PrivKey, msg sdk.Msg, signMode signingtypes.SignMode) []byte {
+	t.Helper()
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	txBuilder.SetGasLimit(200000)
+	txBuilder.SetMemo("memo")
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   privKey.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signMode},
+		Sequence: 3,
+	}))
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(privKey.PubKey().Address()).String(),
+		ChainID:       "provider",
+		AccountNumber: 7,
+		Sequence:      3,
+		PubKey:        privKey.PubKey(),
+	}
+	bz, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(), signMode, signerData, tx)
+	require.NoError(t, err)
+	return bz
+}
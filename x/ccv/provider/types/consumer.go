@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	ccv "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
@@ -13,6 +16,7 @@ func NewConsumerStates(
 	pendingValsetChanges []ccv.ValidatorSetChangePacketData,
 	slashDowntimeAck []string,
 	phase ConsumerPhase,
+	vscIdToHeights []VscIdToHeight,
 ) ConsumerState {
 	return ConsumerState{
 		ChainId:              chainID,
@@ -23,5 +27,53 @@ func NewConsumerStates(
 		ConsumerGenesis:      genesis,
 		SlashDowntimeAck:     slashDowntimeAck,
 		Phase:                phase,
+		VscIdToHeights:       vscIdToHeights,
 	}
 }
+
+// consumerPhasePrefix is the prefix of the proto enum names of the consumer phases
+const consumerPhasePrefix = "CONSUMER_PHASE_"
+
+// consumerPhaseUnknown is the name of the consumer phases unknown to this version (e.g., added by a later ICS version)
+const consumerPhaseUnknown = consumerPhasePrefix + "UNKNOWN"
+
+// PhaseToString returns the proto enum name of the given consumer phase (e.g., CONSUMER_PHASE_LAUNCHED),
+// as used in the events and query responses, so that they do not depend on the numeric values of the phases.
+// Unlike ConsumerPhase.String, it returns CONSUMER_PHASE_UNKNOWN instead of the numeric value for unknown phases,
+// as the events carry the numeric value of the phase in a separate attribute.
+func PhaseToString(phase ConsumerPhase) string {
+	if name, found := ConsumerPhase_name[int32(phase)]; found {
+		return name
+	}
+	return consumerPhaseUnknown
+}
+
+// StringToPhase returns the consumer phase with the given proto enum name. The name is case insensitive
+// and can be provided without the CONSUMER_PHASE_ prefix (e.g., both CONSUMER_PHASE_LAUNCHED and launched
+// are parsed as CONSUMER_PHASE_LAUNCHED).
+func StringToPhase(s string) (ConsumerPhase, error) {
+	name := strings.ToUpper(strings.TrimSpace(s))
+	if !strings.HasPrefix(name, consumerPhasePrefix) {
+		name = consumerPhasePrefix + name
+	}
+	phase, found := ConsumerPhase_value[name]
+	if !found {
+		return CONSUMER_PHASE_UNSPECIFIED, fmt.Errorf("unknown consumer phase: %s", s)
+	}
+	return ConsumerPhase(phase), nil
+}
+
+// MaxSelfConsensusStateFallbackHeights is the maximum number of heights before the launch height
+// whose self consensus state can be embedded in the consumer genesis, if the self consensus state
+// at the launch height is unavailable
+const MaxSelfConsensusStateFallbackHeights = 3
+
+// Names of the checks returned by the launch checklist of a consumer chain
+const (
+	LaunchCheckSpawnTime                = "spawn_time"
+	LaunchCheckInitializationParameters = "initialization_parameters"
+	LaunchCheckOptedInValidators        = "opted_in_validators"
+	LaunchCheckPowerShapingParameters   = "power_shaping_parameters"
+	LaunchCheckRewardDenoms             = "reward_denoms"
+	LaunchCheckGovernanceApproval       = "governance_approval"
+)
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestPhaseToString(t *testing.T) {
+	require.Equal(t, "CONSUMER_PHASE_UNSPECIFIED", types.PhaseToString(types.CONSUMER_PHASE_UNSPECIFIED))
+	require.Equal(t, "CONSUMER_PHASE_REGISTERED", types.PhaseToString(types.CONSUMER_PHASE_REGISTERED))
+	require.Equal(t, "CONSUMER_PHASE_INITIALIZED", types.PhaseToString(types.CONSUMER_PHASE_INITIALIZED))
+	require.Equal(t, "CONSUMER_PHASE_LAUNCHED", types.PhaseToString(types.CONSUMER_PHASE_LAUNCHED))
+	require.Equal(t, "CONSUMER_PHASE_STOPPED", types.PhaseToString(types.CONSUMER_PHASE_STOPPED))
+	require.Equal(t, "CONSUMER_PHASE_DELETED", types.PhaseToString(types.CONSUMER_PHASE_DELETED))
+
+	// unknown phases are not returned as their numeric value
+	require.Equal(t, "CONSUMER_PHASE_UNKNOWN", types.PhaseToString(types.ConsumerPhase(42)))
+	_, err := types.StringToPhase(types.PhaseToString(types.ConsumerPhase(42)))
+	require.Error(t, err)
+
+	// every phase is parsed back from its name
+	for phase := range types.ConsumerPhase_name {
+		parsed, err := types.StringToPhase(types.PhaseToString(types.ConsumerPhase(phase)))
+		require.NoError(t, err)
+		require.Equal(t, types.ConsumerPhase(phase), parsed)
+	}
+}
+
+func TestStringToPhase(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedPhase types.ConsumerPhase
+		expectError   bool
+	}{
+		{"proto enum name", "CONSUMER_PHASE_LAUNCHED", types.CONSUMER_PHASE_LAUNCHED, false},
+		{"lower case proto enum name", "consumer_phase_stopped", types.CONSUMER_PHASE_STOPPED, false},
+		{"name without prefix", "INITIALIZED", types.CONSUMER_PHASE_INITIALIZED, false},
+		{"lower case name without prefix", "registered", types.CONSUMER_PHASE_REGISTERED, false},
+		{"name with surrounding spaces", " deleted ", types.CONSUMER_PHASE_DELETED, false},
+		{"unknown name", "CONSUMER_PHASE_PAUSED", types.CONSUMER_PHASE_UNSPECIFIED, true},
+		{"numeric value", "3", types.CONSUMER_PHASE_UNSPECIFIED, true},
+		{"empty string", "", types.CONSUMER_PHASE_UNSPECIFIED, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			phase, err := types.StringToPhase(tc.input)
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedPhase, phase)
+		})
+	}
+}
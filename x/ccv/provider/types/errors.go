@@ -40,4 +40,33 @@ var (
 	ErrInvalidMsgSetConsumerCommissionRate     = errorsmod.Register(ModuleName, 51, "invalid set consumer commission rate message")
 	ErrInvalidMsgChangeRewardDenoms            = errorsmod.Register(ModuleName, 52, "invalid change reward denoms message")
 	ErrInvalidAllowlistedRewardDenoms          = errorsmod.Register(ModuleName, 53, "invalid allowlisted reward denoms")
+	ErrValidatorNotWithinMaxProviderRank       = errorsmod.Register(ModuleName, 54, "validator is not ranked within the max provider rank of the consumer chain")
+	ErrInvalidMsgSetConsumerVerified           = errorsmod.Register(ModuleName, 55, "invalid set consumer verified message")
+	ErrInvalidEndpointInfo                     = errorsmod.Register(ModuleName, 56, "invalid consumer endpoint info")
+	ErrEmptyActiveValidatorSet                 = errorsmod.Register(ModuleName, 57, "active validator set is empty or has no voting power")
+	ErrNoBondedValidators                      = errorsmod.Register(ModuleName, 58, "no bonded validators")
+	ErrInvalidMsgConsumerHardFork              = errorsmod.Register(ModuleName, 59, "invalid consumer hard fork message")
+	ErrConsumerChainIdAlreadyLaunched          = errorsmod.Register(ModuleName, 60, "a consumer chain with the same chain id is already launched")
+	ErrInvalidConsumerTimeoutPeriods           = errorsmod.Register(ModuleName, 61, "invalid consumer timeout periods")
+	ErrEvidenceSubmissionPaused                = errorsmod.Register(ModuleName, 62, "submission of equivocation evidence is paused for the consumer chain")
+	ErrInvalidMsgSetConsumerEvidencePaused     = errorsmod.Register(ModuleName, 63, "invalid set consumer evidence submission paused message")
+	ErrInvalidConsumerTerms                    = errorsmod.Register(ModuleName, 64, "invalid consumer terms")
+	ErrConsumerTermsNotAcknowledged            = errorsmod.Register(ModuleName, 65, "consumer terms are not acknowledged by the validator")
+	ErrInvalidPowerShapingAdmin                = errorsmod.Register(ModuleName, 66, "invalid power-shaping admin")
+	ErrInvalidLifetimeExtension                = errorsmod.Register(ModuleName, 67, "invalid consumer lifetime extension")
+	ErrUpgradeQuietPeriod                      = errorsmod.Register(ModuleName, 68, "consumer lifecycle messages are rejected during the quiet period before an upgrade")
+	ErrInvalidConsumerUpgradeNotice            = errorsmod.Register(ModuleName, 69, "invalid consumer upgrade notice")
+	ErrInvalidDistributionTransmissionChannel  = errorsmod.Register(ModuleName, 70, "invalid distribution transmission channel")
+	ErrInvalidMsgSetConsumerAvailability       = errorsmod.Register(ModuleName, 71, "invalid set consumer availability message")
+	ErrCannotBeUnavailableOnTopN               = errorsmod.Register(ModuleName, 72, "cannot be unavailable on a Top N chain")
+	ErrValidatorNotOptedIn                     = errorsmod.Register(ModuleName, 73, "validator is not opted in to the consumer chain")
+	ErrInvalidConsumerUnbondingPeriod          = errorsmod.Register(ModuleName, 74, "consumer unbonding period does not match the initialization parameters")
+	ErrInvalidMsgEmergencyOptOut               = errorsmod.Register(ModuleName, 75, "invalid emergency opt out message")
+	ErrEmergencyOptOutCooldown                 = errorsmod.Register(ModuleName, 76, "validator cannot opt in during the cooldown after an emergency opt out")
+	ErrReOptInCooldown                         = errorsmod.Register(ModuleName, 77, "validator cannot opt in during the cooldown after being jailed for a consumer infraction")
+	ErrInvalidProposalId                       = errorsmod.Register(ModuleName, 78, "invalid governance proposal id")
+	ErrInvalidAllowedIcaMsgTypes               = errorsmod.Register(ModuleName, 79, "invalid allowed interchain account message types")
+	ErrIcaMsgTypeNotAllowed                    = errorsmod.Register(ModuleName, 80, "interchain account message type is not allowed on the consumer chain")
+	ErrInvalidKeyAssignmentNonce               = errorsmod.Register(ModuleName, 81, "invalid key assignment nonce")
+	ErrInvalidConsumerRewardChannel            = errorsmod.Register(ModuleName, 82, "invalid consumer reward channel")
 )
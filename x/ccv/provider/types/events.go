@@ -12,14 +12,47 @@ const (
 	EventTypeCreateConsumer            = "create_consumer"
 	EventTypeUpdateConsumer            = "update_consumer"
 	EventTypeRemoveConsumer            = "remove_consumer"
+	EventTypeCancelConsumerLaunch      = "cancel_consumer_launch"
 	EventTypeReceivedRewards           = "received_ics_rewards"
 	EventTypeDistributedRewards        = "distributed_ics_rewards"
+	EventTypeConsumerDormant           = "consumer_dormant"
+	EventTypeConsumerResumed           = "consumer_resumed"
+	EventTypeSetConsumerVerified       = "set_consumer_verified"
+	EventTypeDivertedRewards           = "diverted_ics_rewards"
+	EventTypeUpdateConsumerEndpoints   = "update_consumer_endpoints"
+	EventTypeEpochEnd                  = "epoch_end"
+	EventTypeConsumerHardFork          = "consumer_hard_fork"
+	EventTypeConsumerLaunchConflict    = "consumer_launch_conflict"
+	EventTypeUpdateConsumerTimeouts    = "update_consumer_timeout_periods"
+	EventTypePauseEvidenceSubmission   = "pause_evidence_submission"
+	EventTypeResumeEvidenceSubmission  = "resume_evidence_submission"
+	EventTypeConsumerValidatorJailed   = "consumer_validator_jailed"
+	EventTypeConsumerLifetimeReminder  = "consumer_lifetime_reminder"
+	EventTypeConsumerSunset            = "consumer_sunset"
+	EventTypeRewardAttribution         = "reward_attribution"
+	EventTypeConsumerValidatorEvicted  = "consumer_validator_evicted"
+	EventTypeConsumerClientExpiring    = "consumer_client_expiring"
+	EventTypeConsumerClientExpired     = "consumer_client_expired"
+	EventTypeConsumerUpgradeNotice     = "consumer_upgrade_notice"
+	EventTypeDistributionChannelUnset  = "distribution_transmission_channel_unset"
+	EventTypeDowntimeSlashSkipped      = "downtime_slash_skipped"
+	EventTypeSetConsumerAvailability   = "set_consumer_availability"
+	EventTypeUnroutableSlashPacket     = "unroutable_slash_packet"
+	EventTypePreOptInInfractionDropped = "pre_opt_in_infraction_dropped"
+	EventTypeEmergencyOptOut           = "emergency_opt_out"
+	EventTypeStopConsumer              = "stop_consumer"
+	EventTypeConsumerLaunchFailed      = "consumer_launch_failed"
+	EventTypeConsumerRevisionUpgrade   = "consumer_revision_upgrade"
 
 	AttributeInfractionHeight          = "infraction_height"
+	AttributeConsumerInfractionHeight  = "consumer_infraction_height"
+	AttributeProviderInfractionHeight  = "provider_infraction_height"
+	AttributeConsumerValidatorAddress  = "consumer_validator_address"
 	AttributeInitialHeight             = "initial_height"
 	AttributeTrustingPeriod            = "trusting_period"
 	AttributeUnbondingPeriod           = "unbonding_period"
 	AttributeValsetHash                = "valset_hash"
+	AttributeGenesisHash               = "genesis_hash"
 	AttributeProviderValidatorAddress  = "provider_validator_address"
 	AttributeConsumerConsensusPubKey   = "consumer_consensus_pub_key"
 	AttributeAddConsumerRewardDenom    = "add_consumer_reward_denom"
@@ -30,13 +63,72 @@ const (
 	AttributeConsumerChainId           = "consumer_chain_id"
 	AttributeConsumerName              = "consumer_name"
 	AttributeConsumerOwner             = "consumer_owner"
+	AttributePowerShapingAdmin         = "power_shaping_admin"
 	AttributeConsumerSpawnTime         = "consumer_spawn_time"
 	AttributeConsumerPhase             = "consumer_phase"
+	AttributeConsumerPhaseValue        = "consumer_phase_value"
+	AttributeConsumerSunsetTime        = "consumer_sunset_time"
+	AttributeRemainingLifetime         = "remaining_lifetime"
+	AttributeLifetimeFraction          = "lifetime_fraction"
 	AttributeConsumerTopN              = "consumer_topn"
 	AttributeRewardDenom               = "reward_denom"
 	AttributeRewardAmount              = "reward_amount"
 	AttributeRewardDistribution        = "reward_distribution"
 	AttributeRewardTotal               = "total_rewards"
 	AttributeRewardDistributed         = "distributed_rewards"
+	AttributeRewardAttributed          = "attributed_rewards"
 	AttributeRewardCommunityPool       = "community_pool_rewards"
+	AttributeLastPacketReceivedTime    = "last_packet_received_time"
+	AttributeConsumerVerified          = "consumer_verified"
+	AttributeRewardChannelId           = "reward_channel_id"
+	AttributeReceivedChannelId         = "received_channel_id"
+	AttributePersistentPeers           = "persistent_peers"
+	AttributeSeeds                     = "seeds"
+	AttributeRpcUrls                   = "rpc_urls"
+	AttributeGenesisUrl                = "genesis_url"
+	AttributeEpochIndex                = "epoch_index"
+	AttributeEpochStartHeight          = "epoch_start_height"
+	AttributeNumConsumersWithPackets   = "num_consumers_with_queued_packets"
+	AttributePreviousConsumerChainId   = "previous_consumer_chain_id"
+	AttributePreviousClientId          = "previous_client_id"
+	AttributeConflictingConsumerId     = "conflicting_consumer_id"
+	AttributeCcvTimeoutPeriod          = "ccv_timeout_period"
+	AttributeTransferTimeoutPeriod     = "transfer_timeout_period"
+	AttributeRewardAttributionDecision = "reward_attribution_decision"
+	AttributeRewardAttributionReason   = "reward_attribution_reason"
+	AttributeEvictionReason            = "eviction_reason"
+	AttributeConsumerClientId          = "consumer_client_id"
+	AttributeConsumerClientExpiryTime  = "expiry_time"
+	AttributeTimeUntilExpiry           = "time_until_expiry"
+	AttributeRemovalInitiator          = "removal_initiator"
+	AttributeRemovalInitiatorValue     = "removal_initiator_value"
+	AttributeUpgradeName               = "upgrade_name"
+	AttributeUpgradeHeight             = "upgrade_height"
+	AttributeBinaryHash                = "binary_hash"
+	AttributeUpgradeDeadline           = "upgrade_deadline"
+	AttributeRewardsPaused             = "rewards_paused"
+	AttributeOptInTime                 = "opt_in_time"
+	AttributeOptInHeight               = "opt_in_height"
+	AttributeConsumerAvailable         = "consumer_available"
+	AttributeSlashFraction             = "slash_fraction"
+	AttributeSlashedAmount             = "slashed_amount"
+	AttributeCooldownEndTime           = "cooldown_end_time"
+	AttributeCreatedByGovernance       = "created_by_governance"
+	AttributeProposalId                = "proposal_id"
+	AttributeRemovalReason             = "removal_reason"
+	AttributeRemovalReasonValue        = "removal_reason_value"
+	AttributeRemovalNote               = "removal_note"
+	AttributeKeyAssignmentNonce        = "key_assignment_nonce"
+)
+
+// Reasons for evicting validators from consumer validator sets
+const (
+	// EvictionReasonValidatorSetCap is the reason for evicting validators due to the validator-set cap
+	EvictionReasonValidatorSetCap = "cap"
+	// EvictionReasonConsumerKeyCollision is the reason for evicting validators whose consumer key is also used
+	// by another validator of the same consumer validator set
+	EvictionReasonConsumerKeyCollision = "consumer_key_collision"
+	// EvictionReasonMaxConsumerParticipation is the reason for evicting validators that are opted in
+	// to more consumer chains than the max consumer participation allows
+	EvictionReasonMaxConsumerParticipation = "max_consumer_participation"
 )
@@ -69,6 +69,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := KeyAssignmentNoncesValidateBasic(gs.KeyAssignmentNonces); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -92,6 +96,12 @@ func (cs ConsumerState) Validate() error {
 
 	// validate optional fields
 
+	if len(cs.GenesisHash) > 0 {
+		if err := ccv.VerifyConsumerGenesisHash(cs.ConsumerGenesis, cs.GenesisHash); err != nil {
+			return err
+		}
+	}
+
 	if err := validateSlashAcksAddress(cs.SlashDowntimeAck); err != nil {
 		return err
 	}
@@ -105,6 +115,12 @@ func (cs ConsumerState) Validate() error {
 		}
 	}
 
+	for _, vscIdToHeight := range cs.VscIdToHeights {
+		if vscIdToHeight.VscId == 0 {
+			return fmt.Errorf("valset update ID cannot be equal to zero")
+		}
+	}
+
 	return nil
 }
 
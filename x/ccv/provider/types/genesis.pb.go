@@ -39,6 +39,8 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPruneV2 []ConsumerAddrsToPruneV2 `protobuf:"bytes,14,rep,name=consumer_addrs_to_prune_v2,json=consumerAddrsToPruneV2,proto3" json:"consumer_addrs_to_prune_v2"`
+	// empty for a new chain
+	KeyAssignmentNonces []KeyAssignmentNonce `protobuf:"bytes,15,rep,name=key_assignment_nonces,json=keyAssignmentNonces,proto3" json:"key_assignment_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +125,13 @@ func (m *GenesisState) GetConsumerAddrsToPruneV2() []ConsumerAddrsToPruneV2 {
 	return nil
 }
 
+func (m *GenesisState) GetKeyAssignmentNonces() []KeyAssignmentNonce {
+	if m != nil {
+		return m.KeyAssignmentNonces
+	}
+	return nil
+}
+
 // The provider CCV module's knowledge of consumer state.
 //
 // Note this type is only used internally to the provider CCV module.
@@ -143,6 +152,11 @@ type ConsumerState struct {
 	SlashDowntimeAck     []string                             `protobuf:"bytes,7,rep,name=slash_downtime_ack,json=slashDowntimeAck,proto3" json:"slash_downtime_ack,omitempty"`
 	// the phase of the consumer chain
 	Phase ConsumerPhase `protobuf:"varint,9,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the mapping from the valset update ids sent to the consumer chain
+	// to provider block heights
+	VscIdToHeights []VscIdToHeight `protobuf:"bytes,10,rep,name=vsc_id_to_heights,json=vscIdToHeights,proto3" json:"vsc_id_to_heights"`
+	// the hash of the consumer genesis created at launch
+	GenesisHash []byte `protobuf:"bytes,11,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
 }
 
 func (m *ConsumerState) Reset()         { *m = ConsumerState{} }
@@ -234,6 +248,20 @@ func (m *ConsumerState) GetPhase() ConsumerPhase {
 	return CONSUMER_PHASE_UNSPECIFIED
 }
 
+func (m *ConsumerState) GetVscIdToHeights() []VscIdToHeight {
+	if m != nil {
+		return m.VscIdToHeights
+	}
+	return nil
+}
+
+func (m *ConsumerState) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
 // ValsetUpdateIdToHeight defines the genesis information for the mapping
 // of each valset update id to a block height
 type ValsetUpdateIdToHeight struct {
@@ -299,54 +327,60 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdd, 0x6e, 0xda, 0x48,
-	0x14, 0xc6, 0xc1, 0x80, 0x99, 0x04, 0xd6, 0xb2, 0x22, 0xe4, 0x4d, 0xb4, 0x04, 0xb1, 0x8a, 0x84,
-	0xb4, 0xbb, 0x38, 0xb0, 0x52, 0x55, 0xf5, 0xe7, 0x22, 0x24, 0x52, 0x83, 0x7b, 0x83, 0x48, 0x9a,
-	0x4a, 0xb9, 0xb1, 0x86, 0x99, 0x11, 0x1e, 0x01, 0xb6, 0xe5, 0x19, 0x9c, 0xa2, 0xaa, 0x52, 0xfb,
-	0x06, 0x7d, 0x93, 0xbe, 0x46, 0x2e, 0x73, 0xd9, 0xab, 0xa8, 0x4a, 0xfa, 0x04, 0x7d, 0x82, 0xca,
-	0xe3, 0x81, 0x40, 0x4a, 0x22, 0xd2, 0x3b, 0xfb, 0x7c, 0xf3, 0x9d, 0xf3, 0x9d, 0x5f, 0xd0, 0xa0,
-	0x1e, 0x27, 0x21, 0x72, 0x21, 0xf5, 0x1c, 0x46, 0xd0, 0x38, 0xa4, 0x7c, 0x62, 0x21, 0x14, 0x59,
-	0x41, 0xe8, 0x47, 0x14, 0x93, 0xd0, 0x8a, 0x1a, 0x56, 0x9f, 0x78, 0x84, 0x51, 0x56, 0x0f, 0x42,
-	0x9f, 0xfb, 0xc6, 0xdf, 0x4b, 0x28, 0x75, 0x84, 0xa2, 0xfa, 0x94, 0x52, 0x8f, 0x1a, 0x5b, 0x9b,
-	0x7d, 0xbf, 0xef, 0x8b, 0xf7, 0x56, 0xfc, 0x95, 0x50, 0xb7, 0xf6, 0xee, 0x8b, 0x16, 0x35, 0x2c,
-	0xe6, 0xc2, 0x90, 0x60, 0x07, 0xf9, 0x1e, 0x1b, 0x8f, 0x48, 0x28, 0x19, 0xbb, 0x0f, 0x30, 0xce,
-	0x69, 0x48, 0xe4, 0xb3, 0xe6, 0x2a, 0x69, 0xcc, 0xf4, 0x09, 0x4e, 0xf5, 0x4b, 0x16, 0x6c, 0xbc,
-	0x4a, 0x32, 0x3b, 0xe6, 0x90, 0x13, 0xa3, 0x06, 0xf4, 0x08, 0x0e, 0x19, 0xe1, 0xce, 0x38, 0xc0,
-	0x90, 0x13, 0x87, 0x62, 0x53, 0xa9, 0x28, 0x35, 0xb5, 0x5b, 0x4c, 0xec, 0x6f, 0x84, 0xb9, 0x8d,
-	0x8d, 0xf7, 0xe0, 0x8f, 0xa9, 0x4e, 0x87, 0xc5, 0x5c, 0x66, 0xae, 0x55, 0xd2, 0xb5, 0xf5, 0x66,
-	0xb3, 0xbe, 0x42, 0x71, 0xea, 0x07, 0x92, 0x2b, 0xc2, 0xb6, 0xca, 0x17, 0x57, 0x3b, 0xa9, 0x1f,
-	0x57, 0x3b, 0xa5, 0x09, 0x1c, 0x0d, 0x9f, 0x55, 0xef, 0x38, 0xae, 0x76, 0x8b, 0x68, 0xfe, 0x39,
-	0x33, 0x3e, 0x80, 0xad, 0xbb, 0x32, 0x1d, 0xee, 0x3b, 0x2e, 0xa1, 0x7d, 0x97, 0x9b, 0x19, 0xa1,
-	0xe3, 0xf9, 0x4a, 0x3a, 0x4e, 0x17, 0xb2, 0x3a, 0xf1, 0x8f, 0x84, 0x8b, 0x96, 0x1a, 0x0b, 0xea,
-	0x96, 0xa2, 0xa5, 0xa8, 0xd1, 0x06, 0xd9, 0x00, 0x86, 0x70, 0xc4, 0x4c, 0xad, 0xa2, 0xd4, 0xd6,
-	0x9b, 0xff, 0xac, 0x14, 0xaa, 0x23, 0x28, 0xd2, 0xb5, 0x74, 0x60, 0x7c, 0x54, 0x44, 0x2a, 0x14,
-	0x43, 0xee, 0x87, 0xb3, 0xce, 0x3b, 0xc1, 0xb8, 0x37, 0x20, 0x13, 0x66, 0xe6, 0x45, 0x2a, 0x2f,
-	0x56, 0x4d, 0x25, 0x71, 0x33, 0xad, 0x6d, 0x67, 0xdc, 0x7b, 0x4d, 0x26, 0x32, 0xa0, 0x19, 0x2d,
-	0x81, 0xe3, 0x18, 0xc6, 0x27, 0x05, 0x6c, 0xcf, 0x40, 0xe6, 0xf4, 0x26, 0xb7, 0x32, 0x20, 0xc6,
-	0xa1, 0x09, 0x7e, 0x47, 0x43, 0x6b, 0x32, 0x0d, 0xb3, 0x8f, 0x71, 0xf8, 0x8b, 0x06, 0xb6, 0x88,
-	0xc7, 0x0d, 0x5d, 0x08, 0xca, 0xe2, 0x76, 0x06, 0xe1, 0xd8, 0x23, 0x4e, 0xd4, 0x34, 0x8b, 0x8f,
-	0x68, 0xe8, 0xbc, 0x5b, 0x76, 0xe2, 0x77, 0x62, 0x1f, 0xa7, 0xcd, 0x69, 0x43, 0xd1, 0x52, 0xd4,
-	0x56, 0xb5, 0xb4, 0xae, 0xda, 0xaa, 0xa6, 0xea, 0x19, 0x5b, 0xd5, 0xb2, 0x7a, 0xce, 0x56, 0xb5,
-	0x9c, 0xae, 0xd9, 0xaa, 0xb6, 0xae, 0x6f, 0xd8, 0xaa, 0xb6, 0xa1, 0x17, 0x6c, 0x55, 0x2b, 0xe8,
-	0xc5, 0xea, 0xf7, 0x34, 0x28, 0x2c, 0xcc, 0xae, 0xf1, 0x27, 0xd0, 0x12, 0x49, 0x72, 0x55, 0xf2,
-	0xdd, 0x9c, 0xf8, 0x6f, 0x63, 0xe3, 0x2f, 0x00, 0x90, 0x0b, 0x3d, 0x8f, 0x0c, 0x63, 0x70, 0x4d,
-	0x80, 0x79, 0x69, 0x69, 0x63, 0x63, 0x1b, 0xe4, 0xd1, 0x90, 0x12, 0x8f, 0xc7, 0x68, 0x5a, 0xa0,
-	0x5a, 0x62, 0x68, 0x63, 0x63, 0x17, 0x14, 0xa9, 0x47, 0x39, 0x85, 0xc3, 0xe9, 0x58, 0xab, 0x62,
-	0x0f, 0x0b, 0xd2, 0x2a, 0x47, 0x11, 0x02, 0x7d, 0x56, 0x38, 0x79, 0xa3, 0xcc, 0x8c, 0x18, 0xca,
-	0xbd, 0x7b, 0xcb, 0x35, 0x57, 0xa5, 0xf9, 0xe5, 0x97, 0x35, 0x9a, 0xad, 0xb5, 0xc4, 0x0c, 0x0e,
-	0x4a, 0x01, 0xf1, 0x30, 0xf5, 0xfa, 0x8e, 0x5c, 0xba, 0x38, 0x85, 0x3e, 0x61, 0x66, 0x56, 0xf4,
-	0xe5, 0xe9, 0x43, 0x81, 0x66, 0x03, 0x71, 0x4c, 0xf8, 0x81, 0xa0, 0x75, 0x20, 0x1a, 0x10, 0x7e,
-	0x08, 0x39, 0x94, 0x01, 0x37, 0xa5, 0xf7, 0x64, 0x15, 0x93, 0x47, 0xcc, 0xf8, 0x17, 0x18, 0x6c,
-	0x08, 0x99, 0xeb, 0x60, 0xff, 0xdc, 0xe3, 0x74, 0x44, 0x1c, 0x88, 0x06, 0x66, 0xae, 0x92, 0xae,
-	0xe5, 0xbb, 0xba, 0x40, 0x0e, 0x25, 0xb0, 0x8f, 0x06, 0xc6, 0x11, 0xc8, 0x04, 0x2e, 0x64, 0xc4,
-	0xcc, 0x57, 0x94, 0x5a, 0xf1, 0x91, 0x37, 0xa8, 0x13, 0x33, 0xbb, 0x89, 0x03, 0x5b, 0xd5, 0x34,
-	0x3d, 0x5f, 0x3d, 0x03, 0xa5, 0xe5, 0x97, 0xe1, 0x11, 0x17, 0xb2, 0x04, 0xb2, 0xb2, 0x73, 0x6b,
-	0x02, 0x97, 0x7f, 0xad, 0xb7, 0x17, 0xd7, 0x65, 0xe5, 0xf2, 0xba, 0xac, 0x7c, 0xbb, 0x2e, 0x2b,
-	0x9f, 0x6f, 0xca, 0xa9, 0xcb, 0x9b, 0x72, 0xea, 0xeb, 0x4d, 0x39, 0x75, 0xf6, 0xb2, 0x4f, 0xb9,
-	0x3b, 0xee, 0xd5, 0x91, 0x3f, 0xb2, 0x90, 0xcf, 0x46, 0x3e, 0xb3, 0x6e, 0xf3, 0xf8, 0x6f, 0x76,
-	0xd4, 0xa3, 0x27, 0xd6, 0xbb, 0xc5, 0xcb, 0xce, 0x27, 0x01, 0x61, 0xbd, 0xac, 0x38, 0xea, 0xff,
-	0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x06, 0x3a, 0xf4, 0x7c, 0xd1, 0x06, 0x00, 0x00,
+	// 837 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0x5e, 0xef, 0x7a, 0x13, 0x67, 0x92, 0xcd, 0x9a, 0xa1, 0x44, 0x66, 0x2b, 0xd2, 0x10, 0x54,
+	0x29, 0x12, 0x10, 0x77, 0x83, 0x04, 0x88, 0x9f, 0x8b, 0x4d, 0x2b, 0xb1, 0x49, 0x25, 0x14, 0xa5,
+	0x65, 0x91, 0x7a, 0x63, 0x4d, 0x66, 0x46, 0xf6, 0x28, 0x89, 0x6d, 0x7c, 0x26, 0x2e, 0x16, 0x42,
+	0x82, 0x37, 0xe0, 0x19, 0x78, 0x9a, 0x5e, 0xf6, 0x92, 0xab, 0x0a, 0xed, 0xde, 0x72, 0xc5, 0x13,
+	0x20, 0x8f, 0x27, 0x69, 0xd2, 0x4d, 0x57, 0x59, 0xee, 0x92, 0xf3, 0xcd, 0xf7, 0x7d, 0xe7, 0xcc,
+	0xf1, 0x39, 0x83, 0x4e, 0x45, 0x28, 0x79, 0x42, 0x03, 0x22, 0x42, 0x0f, 0x38, 0x5d, 0x24, 0x42,
+	0x66, 0x2e, 0xa5, 0xa9, 0x1b, 0x27, 0x51, 0x2a, 0x18, 0x4f, 0xdc, 0xf4, 0xd4, 0xf5, 0x79, 0xc8,
+	0x41, 0x40, 0x37, 0x4e, 0x22, 0x19, 0xe1, 0x8f, 0xb6, 0x50, 0xba, 0x94, 0xa6, 0xdd, 0x25, 0xa5,
+	0x9b, 0x9e, 0x9e, 0xdc, 0xf1, 0x23, 0x3f, 0x52, 0xe7, 0xdd, 0xfc, 0x57, 0x41, 0x3d, 0x79, 0xf0,
+	0x36, 0xb7, 0xf4, 0xd4, 0x85, 0x80, 0x24, 0x9c, 0x79, 0x34, 0x0a, 0x61, 0x31, 0xe7, 0x89, 0x66,
+	0xdc, 0xbf, 0x81, 0xf1, 0x5c, 0x24, 0x5c, 0x1f, 0xeb, 0xed, 0x52, 0xc6, 0x2a, 0x3f, 0xc5, 0x69,
+	0xff, 0x59, 0x46, 0xb5, 0xef, 0x8a, 0xca, 0x9e, 0x48, 0x22, 0x39, 0xee, 0x20, 0x3b, 0x25, 0x33,
+	0xe0, 0xd2, 0x5b, 0xc4, 0x8c, 0x48, 0xee, 0x09, 0xe6, 0x18, 0x2d, 0xa3, 0x63, 0x8e, 0xeb, 0x45,
+	0xfc, 0x07, 0x15, 0x1e, 0x30, 0xfc, 0x0b, 0x3a, 0x5e, 0xe6, 0xe9, 0x41, 0xce, 0x05, 0x67, 0xbf,
+	0x75, 0xd0, 0xa9, 0xf6, 0x7a, 0xdd, 0x1d, 0x2e, 0xa7, 0xfb, 0x50, 0x73, 0x95, 0x6d, 0xbf, 0xf9,
+	0xe2, 0xd5, 0xbd, 0xbd, 0x7f, 0x5f, 0xdd, 0x6b, 0x64, 0x64, 0x3e, 0xfb, 0xaa, 0xfd, 0x86, 0x70,
+	0x7b, 0x5c, 0xa7, 0xeb, 0xc7, 0x01, 0xff, 0x8a, 0x4e, 0xde, 0x4c, 0xd3, 0x93, 0x91, 0x17, 0x70,
+	0xe1, 0x07, 0xd2, 0x39, 0x54, 0x79, 0x7c, 0xbd, 0x53, 0x1e, 0x17, 0x1b, 0x55, 0x3d, 0x8d, 0xce,
+	0x95, 0x44, 0xdf, 0xcc, 0x13, 0x1a, 0x37, 0xd2, 0xad, 0x28, 0x1e, 0xa0, 0x52, 0x4c, 0x12, 0x32,
+	0x07, 0xc7, 0x6a, 0x19, 0x9d, 0x6a, 0xef, 0xe3, 0x9d, 0xac, 0x46, 0x8a, 0xa2, 0xa5, 0xb5, 0x00,
+	0xfe, 0xcd, 0x50, 0xa5, 0x08, 0x46, 0x64, 0x94, 0xac, 0x3a, 0xef, 0xc5, 0x8b, 0xc9, 0x94, 0x67,
+	0xe0, 0x54, 0x54, 0x29, 0xdf, 0xec, 0x5a, 0x4a, 0x21, 0xb3, 0xbc, 0xdb, 0xd1, 0x62, 0xf2, 0x98,
+	0x67, 0xda, 0xd0, 0x49, 0xb7, 0xc0, 0xb9, 0x07, 0xfe, 0xdd, 0x40, 0x77, 0x57, 0x20, 0x78, 0x93,
+	0xec, 0x75, 0x1a, 0x84, 0xb1, 0xc4, 0x41, 0xff, 0x27, 0x87, 0x7e, 0xb6, 0xb4, 0x39, 0x63, 0x2c,
+	0xb9, 0x96, 0x03, 0x6c, 0xe2, 0x79, 0x43, 0x37, 0x4c, 0x21, 0x6f, 0x67, 0x9c, 0x2c, 0x42, 0xee,
+	0xa5, 0x3d, 0xa7, 0x7e, 0x8b, 0x86, 0xae, 0xcb, 0xc2, 0xd3, 0x68, 0x94, 0x6b, 0x5c, 0xf4, 0x96,
+	0x0d, 0xa5, 0x5b, 0x51, 0xfc, 0x13, 0x7a, 0x6f, 0xca, 0x33, 0x8f, 0x00, 0x08, 0x3f, 0x9c, 0xf3,
+	0x50, 0x7a, 0x61, 0x14, 0x52, 0x0e, 0xce, 0xb1, 0x72, 0xfe, 0x62, 0x27, 0xe7, 0xc7, 0x3c, 0x3b,
+	0x5b, 0x09, 0x7c, 0x9f, 0xf3, 0xb5, 0xeb, 0xbb, 0xd3, 0x6b, 0x08, 0x0c, 0x4d, 0xeb, 0xc0, 0x36,
+	0x87, 0xa6, 0x65, 0xda, 0x87, 0x43, 0xd3, 0x2a, 0xd9, 0xe5, 0xa1, 0x69, 0x95, 0x6d, 0x6b, 0x68,
+	0x5a, 0x55, 0xbb, 0x36, 0x34, 0xad, 0x9a, 0x7d, 0x34, 0x34, 0xad, 0x23, 0xbb, 0xde, 0xfe, 0xc7,
+	0x44, 0x47, 0x1b, 0xe3, 0x82, 0xdf, 0x47, 0x56, 0x91, 0x8b, 0x9e, 0xce, 0xca, 0xb8, 0xac, 0xfe,
+	0x0f, 0x18, 0xfe, 0x00, 0x21, 0x1a, 0x90, 0x30, 0xe4, 0xb3, 0x1c, 0xdc, 0x57, 0x60, 0x45, 0x47,
+	0x06, 0x0c, 0xdf, 0x45, 0x15, 0x3a, 0x13, 0x79, 0x81, 0x82, 0x39, 0x07, 0x0a, 0xb5, 0x8a, 0xc0,
+	0x80, 0xe1, 0xfb, 0xa8, 0x2e, 0x42, 0x21, 0x05, 0x99, 0x2d, 0x27, 0xc9, 0x54, 0xa3, 0x7f, 0xa4,
+	0xa3, 0xfa, 0xeb, 0x27, 0xc8, 0x5e, 0xf5, 0x4a, 0xaf, 0x45, 0xe7, 0x50, 0xcd, 0xc1, 0x83, 0xb7,
+	0xde, 0xd3, 0x5a, 0x63, 0xd6, 0xf7, 0x8d, 0xbe, 0xa0, 0x63, 0xba, 0x89, 0x61, 0x89, 0x1a, 0x31,
+	0x0f, 0x99, 0x08, 0x7d, 0x4f, 0xcf, 0x79, 0x5e, 0x82, 0xcf, 0xc1, 0x29, 0xa9, 0x86, 0x7c, 0x79,
+	0x93, 0xd1, 0xea, 0x1b, 0x7c, 0xc2, 0xe5, 0x43, 0x45, 0x1b, 0x11, 0x3a, 0xe5, 0xf2, 0x11, 0x91,
+	0x44, 0x1b, 0xde, 0xd1, 0xea, 0xc5, 0xf4, 0x17, 0x87, 0x00, 0x7f, 0x82, 0x30, 0xcc, 0x08, 0x04,
+	0x1e, 0x8b, 0x9e, 0x87, 0x52, 0xcc, 0xb9, 0x47, 0xe8, 0xd4, 0x29, 0xb7, 0x0e, 0x3a, 0x95, 0xb1,
+	0xad, 0x90, 0x47, 0x1a, 0x38, 0xa3, 0x53, 0x7c, 0x8e, 0x0e, 0xe3, 0x80, 0x00, 0x77, 0x2a, 0x2d,
+	0xa3, 0x53, 0xbf, 0xe5, 0xda, 0x1b, 0xe5, 0xcc, 0x71, 0x21, 0x80, 0x29, 0x7a, 0x27, 0x05, 0xba,
+	0xb1, 0xc3, 0x40, 0x4f, 0xdd, 0x6e, 0xaa, 0x17, 0x40, 0xaf, 0xed, 0xae, 0x7a, 0xba, 0x1e, 0x04,
+	0xfc, 0x21, 0xaa, 0xe9, 0x66, 0x79, 0x01, 0x81, 0xc0, 0xa9, 0xb6, 0x8c, 0x4e, 0x6d, 0x5c, 0xd5,
+	0xb1, 0x73, 0x02, 0xc1, 0xd0, 0xb4, 0x2c, 0xbb, 0xd2, 0x7e, 0x86, 0x1a, 0xdb, 0x97, 0xe2, 0x2d,
+	0x1e, 0x87, 0x06, 0x2a, 0xe9, 0x2f, 0x68, 0x5f, 0xe1, 0xfa, 0x5f, 0xff, 0xc7, 0x17, 0x97, 0x4d,
+	0xe3, 0xe5, 0x65, 0xd3, 0xf8, 0xfb, 0xb2, 0x69, 0xfc, 0x71, 0xd5, 0xdc, 0x7b, 0x79, 0xd5, 0xdc,
+	0xfb, 0xeb, 0xaa, 0xb9, 0xf7, 0xec, 0x5b, 0x5f, 0xc8, 0x60, 0x31, 0xe9, 0xd2, 0x68, 0xee, 0xd2,
+	0x08, 0xe6, 0x11, 0xb8, 0xaf, 0x2b, 0xff, 0x74, 0xf5, 0x9e, 0xa5, 0x9f, 0xbb, 0x3f, 0x6f, 0x3e,
+	0x6a, 0x32, 0x8b, 0x39, 0x4c, 0x4a, 0xea, 0x3d, 0xfb, 0xec, 0xbf, 0x01, 0x00, 0x8f, 0x4c, 0x98,
+	0x04, 0xcc, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +403,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.KeyAssignmentNonces) > 0 {
+		for iNdEx := len(m.KeyAssignmentNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignmentNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.ConsumerAddrsToPruneV2) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPruneV2) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -477,6 +525,27 @@ func (m *ConsumerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.VscIdToHeights) > 0 {
+		for iNdEx := len(m.VscIdToHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VscIdToHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.Phase != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Phase))
 		i--
@@ -629,6 +698,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.KeyAssignmentNonces) > 0 {
+		for _, e := range m.KeyAssignmentNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -670,6 +745,16 @@ func (m *ConsumerState) Size() (n int) {
 	if m.Phase != 0 {
 		n += 1 + sovGenesis(uint64(m.Phase))
 	}
+	if len(m.VscIdToHeights) > 0 {
+		for _, e := range m.VscIdToHeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -945,6 +1030,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignmentNonces = append(m.KeyAssignmentNonces, KeyAssignmentNonce{})
+			if err := m.KeyAssignmentNonces[len(m.KeyAssignmentNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1228,6 +1347,74 @@ func (m *ConsumerState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscIdToHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VscIdToHeights = append(m.VscIdToHeights, VscIdToHeight{})
+			if err := m.VscIdToHeights[len(m.VscIdToHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,6 +23,12 @@ import (
 
 // Tests validation of consumer states and params within a provider genesis state
 func TestValidateGenesisState(t *testing.T) {
+	consumerGenesis := getInitialConsumerGenesis(t, "chainid")
+	consumerGenesisHash, err := consumerGenesis.Hash()
+	require.NoError(t, err)
+	otherConsumerGenesisHash, err := getInitialConsumerGenesis(t, "chainid-1").Hash()
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -67,7 +73,7 @@ func TestValidateGenesisState(t *testing.T) {
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -82,7 +88,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -97,7 +103,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -116,7 +122,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -135,7 +141,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -154,7 +160,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 ccv timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -173,7 +179,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -192,7 +198,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -322,6 +328,40 @@ func TestValidateGenesisState(t *testing.T) {
 			),
 			false,
 		},
+		{
+			"valid consumer state with genesis hash",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: consumerGenesis,
+					GenesisHash:     consumerGenesisHash,
+				}},
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			true,
+		},
+		{
+			"invalid consumer state genesis hash",
+			types.NewGenesisState(
+				types.DefaultValsetUpdateID,
+				nil,
+				[]types.ConsumerState{{
+					ChainId: "chainid", ChannelId: "channel-0", ClientId: "client-id",
+					ConsumerGenesis: consumerGenesis,
+					GenesisHash:     otherConsumerGenesisHash,
+				}},
+				types.DefaultParams(),
+				nil,
+				nil,
+				nil,
+			),
+			false,
+		},
 		{
 			"invalid params- invalid consumer registration fee denom",
 			types.NewGenesisState(
@@ -330,7 +370,7 @@ func TestValidateGenesisState(t *testing.T) {
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -345,7 +385,7 @@ func TestValidateGenesisState(t *testing.T) {
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1")}},
 				types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 					time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false),
 				nil,
 				nil,
 				nil,
@@ -367,6 +407,45 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+func TestValidateGenesisKeyAssignmentNonces(t *testing.T) {
+	providerAddr := crypto.NewCryptoIdentityFromIntSeed(239668).SDKValConsAddress()
+
+	testCases := []struct {
+		name    string
+		nonces  []types.KeyAssignmentNonce
+		expPass bool
+	}{
+		{
+			"valid key assignment nonces",
+			[]types.KeyAssignmentNonce{{ConsumerId: "0", ProviderAddr: providerAddr, Nonce: 1}},
+			true,
+		},
+		{
+			"blank consumer id",
+			[]types.KeyAssignmentNonce{{ConsumerId: " ", ProviderAddr: providerAddr, Nonce: 1}},
+			false,
+		},
+		{
+			"invalid provider address",
+			[]types.KeyAssignmentNonce{{ConsumerId: "0", ProviderAddr: nil, Nonce: 1}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.KeyAssignmentNonces = tc.nonces
+			err := genState.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string) ccv.ConsumerGenesisState {
 	t.Helper()
 	// generate validator public key
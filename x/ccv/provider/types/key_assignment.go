@@ -103,3 +103,16 @@ func KeyAssignmentValidateBasic(
 	}
 	return nil
 }
+
+// KeyAssignmentNoncesValidateBasic validates the key assignment nonces of a provider genesis state
+func KeyAssignmentNoncesValidateBasic(nonces []KeyAssignmentNonce) error {
+	for _, e := range nonces {
+		if strings.TrimSpace(e.ConsumerId) == "" {
+			return errorsmod.Wrap(ccvtypes.ErrInvalidGenesis, "consumer id must not be blank")
+		}
+		if err := sdk.VerifyAddressFormat(e.ProviderAddr); err != nil {
+			return errorsmod.Wrap(ccvtypes.ErrInvalidGenesis, fmt.Sprintf("invalid provider address: %s", e.ProviderAddr))
+		}
+	}
+	return nil
+}
@@ -37,6 +37,50 @@ const (
 	// a consumer chain can allowlist
 	MaxAllowlistedRewardDenomsPerChain = 3
 
+	// MaxRewardAttributionLogEntries corresponds to the maximum number of reward attribution
+	// records kept per consumer chain
+	MaxRewardAttributionLogEntries = 100
+
+	// RewardAttributionLogRetentionPeriod corresponds to the period after which the reward attribution
+	// records of a consumer chain are pruned
+	RewardAttributionLogRetentionPeriod = 30 * 24 * time.Hour
+
+	// MaxSkippedDowntimeSlashEntries corresponds to the maximum number of skipped downtime slash
+	// records kept per consumer chain
+	MaxSkippedDowntimeSlashEntries = 100
+
+	// MaxPreOptInInfractionEntries corresponds to the maximum number of pre-opt-in infraction
+	// records kept per consumer chain
+	MaxPreOptInInfractionEntries = 100
+
+	// MaxUnroutableSlashPacketEntries corresponds to the maximum number of unroutable slash packet
+	// records kept per consumer chain
+	MaxUnroutableSlashPacketEntries = 100
+
+	// MaxConsumerUpgradeNotices corresponds to the maximum number of active upgrade notices
+	// a consumer chain can have
+	MaxConsumerUpgradeNotices = 5
+
+	// MaxConsumerGenesisBatchSize corresponds to the maximum number of consumer chains
+	// whose genesis states can be queried at once
+	MaxConsumerGenesisBatchSize = 20
+
+	// MaxPendingVSCPacketsPerPage corresponds to the maximum number of pending VSC packets
+	// returned per page by the pending VSC packets query
+	MaxPendingVSCPacketsPerPage = 100
+
+	// MaxConsumerLaunchConflictRetries corresponds to the maximum number of blocks in which the launch
+	// of a consumer chain is retried while another consumer chain with the same chain id is launched
+	MaxConsumerLaunchConflictRetries = 100
+
+	// MaxTimeKeyYear is the maximum year of a time encoded by TimeKey for which
+	// the encoding is fixed-width, and hence, the keys are chronologically ordered
+	MaxTimeKeyYear = 9999
+
+	// MaxConsumerUnbondingPeriodDeviation corresponds to the maximum deviation tolerated between the unbonding period
+	// declared by a consumer chain during the CCV channel handshake and the one in its initialization parameters
+	MaxConsumerUnbondingPeriodDeviation = time.Hour
+
 	// Names for the store keys.
 	// Used for storing the byte prefixes in the constant map.
 	// See getKeyPrefixes().
@@ -53,6 +97,84 @@ const (
 
 	SlashMeterReplenishTimeCandidateKeyName = "SlashMeterReplenishTimeCandidateKey"
 
+	SlashMeterAccruedReplenishmentKeyName = "SlashMeterAccruedReplenishmentKey"
+
+	AcknowledgedConsumerTermsKeyName = "AcknowledgedConsumerTermsKey"
+
+	DeletedChannelIdToConsumerIdKeyName = "DeletedChannelIdToConsumerIdKey"
+
+	ConsumerValSetSnapshotKeyName = "ConsumerValSetSnapshotKey"
+
+	ConsumerIdToProviderFeePoolAddrKeyName = "ConsumerIdToProviderFeePoolAddrKey"
+
+	ConsumerIdToPowerShapingAdminKeyName = "ConsumerIdToPowerShapingAdminKey"
+
+	StopTimeToConsumerIdsKeyName = "StopTimeToConsumerIdsKeyName"
+
+	ConsumerIdToLifetimeKeyName = "ConsumerIdToLifetimeKey"
+
+	RewardAttributionLogKeyName = "RewardAttributionLogKey"
+
+	RewardAttributionLogSequenceKeyName = "RewardAttributionLogSequenceKey"
+
+	ConsumerIdToRemovalInitiatorKeyName = "ConsumerIdToRemovalInitiatorKey"
+
+	ConsumerIdToUpgradeNoticesKeyName = "ConsumerIdToUpgradeNoticesKey"
+
+	RewardsPausedConsumerKeyName = "RewardsPausedConsumerKey"
+
+	ConsumerIdToHeldBackRewardsKeyName = "ConsumerIdToHeldBackRewardsKey"
+
+	ForcedOptInTimeKeyName = "ForcedOptInTimeKey"
+
+	SkippedDowntimeSlashKeyName = "SkippedDowntimeSlashKey"
+
+	SkippedDowntimeSlashSequenceKeyName = "SkippedDowntimeSlashSequenceKey"
+
+	UnavailableValidatorKeyName = "UnavailableValidatorKey"
+
+	UnroutableSlashPacketKeyName = "UnroutableSlashPacketKey"
+
+	UnroutableSlashPacketSequenceKeyName = "UnroutableSlashPacketSequenceKey"
+
+	OptInTimeKeyName = "OptInTimeKey"
+
+	OptInHeightKeyName = "OptInHeightKey"
+
+	PreOptInInfractionKeyName = "PreOptInInfractionKey"
+
+	PreOptInInfractionSequenceKeyName = "PreOptInInfractionSequenceKey"
+
+	EmergencyOptOutCooldownKeyName = "EmergencyOptOutCooldownKey"
+
+	ConsumerIdToProviderUpgradeNoticeKeyName = "ConsumerIdToProviderUpgradeNoticeKey"
+
+	ReOptInCooldownKeyName = "ReOptInCooldownKey"
+
+	ConsumerIdToCreationRecordKeyName = "ConsumerIdToCreationRecordKey"
+
+	ConsumerIdToRemovalRecordKeyName = "ConsumerIdToRemovalRecordKey"
+
+	ConsumerIdToAllowedIcaMsgTypesKeyName = "ConsumerIdToAllowedIcaMsgTypesKey"
+
+	ConsumerEconomicSecurityKeyName = "ConsumerEconomicSecurityKey"
+
+	KeyAssignmentNonceKeyName = "KeyAssignmentNonceKey"
+
+	LaunchedChainIdToConsumerIdKeyName = "LaunchedChainIdToConsumerIdKey"
+
+	ConsumerIdToLaunchConflictRetriesKeyName = "ConsumerIdToLaunchConflictRetriesKey"
+
+	ConsumersWithValidatorRemovalsToSendKeyName = "ConsumersWithValidatorRemovalsToSendKey"
+
+	ChannelDeletionTimeKeyName = "ChannelDeletionTimeKey"
+
+	ReminderTimeToConsumerIdsKeyName = "ReminderTimeToConsumerIdsKey"
+
+	ConsumerAcceptsUpgradeNoticesKeyName = "ConsumerAcceptsUpgradeNoticesKey"
+
+	EquivocationEvidenceMinChainIdKeyName = "EquivocationEvidenceMinChainIdKey"
+
 	ConsumerIdToChannelIdKeyName = "ConsumerIdToChannelIdKey"
 
 	ChannelIdToConsumerIdKeyName = "ChannelToConsumerIdKey"
@@ -154,6 +276,32 @@ const (
 	ConsumerIdToAllowlistedRewardDenomKeyName = "ConsumerIdToAllowlistedRewardDenomKey"
 
 	ConsumerRewardsAllocationByDenomKeyName = "ConsumerRewardsAllocationByDenomKey"
+
+	ConsumersToBeCleanedUpKeyName = "ConsumersToBeCleanedUpKey"
+
+	ConsumerIdToLastPacketReceivedTimeKeyName = "ConsumerIdToLastPacketReceivedTimeKey"
+
+	DormantConsumerKeyName = "DormantConsumerKey"
+
+	VerifiedConsumerKeyName = "VerifiedConsumerKey"
+
+	EvidenceSubmissionPausedConsumerKeyName = "EvidenceSubmissionPausedConsumerKey"
+
+	ConsumerIdAndVscIdToHeightKeyName = "ConsumerIdAndVscIdToHeightKey"
+
+	ConsumerIdToRewardChannelKeyName = "ConsumerIdToRewardChannelKey"
+
+	ConsumerIdToEndpointInfoKeyName = "ConsumerIdToEndpointInfoKey"
+
+	EpochInfoKeyName = "EpochInfoKey"
+
+	ConsumerIdToGenesisHashKeyName = "ConsumerIdToGenesisHashKey"
+
+	OwnerAddressToConsumerIdsKeyName = "OwnerAddressToConsumerIdsKey"
+
+	ConsumerIdToTimeoutPeriodsKeyName = "ConsumerIdToTimeoutPeriodsKey"
+
+	ConsumerIdToPendingValidatorRemovalsKeyName = "ConsumerIdToPendingValidatorRemovalsKey"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -391,6 +539,208 @@ func getKeyPrefixes() map[string]byte {
 		// ConsumerRewardsAllocationByDenomKeyName is the key for storing the consumer rewards for a specific consumer chain and denom
 		ConsumerRewardsAllocationByDenomKeyName: 55,
 
+		// ConsumersToBeCleanedUpKeyName is the key for storing the consumer ids of deleted consumer chains
+		// whose remaining state is removed incrementally in EndBlock
+		ConsumersToBeCleanedUpKeyName: 56,
+
+		// ConsumerIdToLastPacketReceivedTimeKeyName is the key for storing the time at which
+		// the last packet (i.e., VSC ack, slash packet, or reward packet) was received from a consumer chain
+		ConsumerIdToLastPacketReceivedTimeKeyName: 57,
+
+		// DormantConsumerKeyName is the key for storing the consumer ids of dormant consumer chains
+		DormantConsumerKeyName: 58,
+
+		// VerifiedConsumerKeyName is the key for storing the consumer ids of consumer chains verified by governance
+		VerifiedConsumerKeyName: 59,
+
+		// ConsumerIdAndVscIdToHeightKeyName is the key for storing the mapping from the valset update ids
+		// sent to a consumer chain to provider block heights
+		ConsumerIdAndVscIdToHeightKeyName: 60,
+
+		// ConsumerIdToRewardChannelKeyName is the key for storing the transfer channel on which
+		// the ICS rewards of a consumer chain are received
+		ConsumerIdToRewardChannelKeyName: 61,
+
+		// ConsumerIdToEndpointInfoKeyName is the key for storing the endpoints (e.g., peers, RPC and genesis URLs)
+		// advertised for a consumer chain
+		ConsumerIdToEndpointInfoKeyName: 62,
+
+		// EpochInfoKeyName is the key for storing the index, start height and time of the current epoch
+		EpochInfoKeyName: 63,
+
+		// ConsumerIdToGenesisHashKeyName is the key for storing the hash of the consumer genesis created at launch
+		ConsumerIdToGenesisHashKeyName: 64,
+
+		// OwnerAddressToConsumerIdsKeyName is the key for storing the consumer ids of the consumer chains
+		// owned by a given owner address, i.e., the secondary index of ConsumerIdToOwnerAddressKeyName
+		OwnerAddressToConsumerIdsKeyName: 65,
+
+		// ConsumerIdToTimeoutPeriodsKeyName is the key for storing the timeout periods of a consumer chain
+		// that were updated after the chain launched
+		ConsumerIdToTimeoutPeriodsKeyName: 66,
+
+		// ConsumerIdToPendingValidatorRemovalsKeyName is the key for storing the validators that were removed
+		// from a consumer chain after being tombstoned, until the consumer chain acknowledges their removal
+		ConsumerIdToPendingValidatorRemovalsKeyName: 67,
+
+		// EvidenceSubmissionPausedConsumerKeyName is the key for storing the consumer ids of consumer chains
+		// for which the submission of equivocation evidence was paused by governance
+		EvidenceSubmissionPausedConsumerKeyName: 68,
+
+		// SlashMeterAccruedReplenishmentKeyName is the key for storing the amount by which the slash meter
+		// was replenished since the start of the current replenish period
+		SlashMeterAccruedReplenishmentKeyName: 69,
+
+		// AcknowledgedConsumerTermsKeyName is the key for storing the hash of the terms of a consumer chain
+		// acknowledged by a validator when opting in
+		AcknowledgedConsumerTermsKeyName: 70,
+
+		// DeletedChannelIdToConsumerIdKeyName is the key for storing the mapping from the ids of the CCV channels
+		// whose mappings were deleted (e.g., when the consumer chain was deleted) to the consumer ids
+		DeletedChannelIdToConsumerIdKeyName: 71,
+
+		// ConsumerValSetSnapshotKeyName is the key for storing the validator sets of consumer chains
+		// computed at past provider heights
+		ConsumerValSetSnapshotKeyName: 72,
+
+		// ConsumerIdToProviderFeePoolAddrKeyName is the key for storing the provider fee pool address
+		// last communicated to a consumer chain
+		ConsumerIdToProviderFeePoolAddrKeyName: 73,
+
+		// ConsumerIdToPowerShapingAdminKeyName is the key for storing the address permitted to update
+		// the allowlist and denylist of a consumer chain on behalf of its owner
+		ConsumerIdToPowerShapingAdminKeyName: 74,
+
+		// StopTimeToConsumerIdsKeyName is the key for storing launched consumers that are to be stopped
+		// because their maximum lifetime elapses
+		StopTimeToConsumerIdsKeyName: 75,
+
+		// ConsumerIdToLifetimeKeyName is the key for storing the lifetime of a launched consumer chain
+		// with a maximum lifetime
+		ConsumerIdToLifetimeKeyName: 76,
+
+		// RewardAttributionLogKeyName is the key for storing the last reward attribution records
+		// of a consumer chain
+		RewardAttributionLogKeyName: 77,
+
+		// RewardAttributionLogSequenceKeyName is the key for storing the sequence number
+		// of the next reward attribution record of a consumer chain
+		RewardAttributionLogSequenceKeyName: 78,
+
+		// ConsumerIdToRemovalInitiatorKeyName is the key for storing who removed a consumer chain,
+		// i.e., its owner or the governance authority
+		ConsumerIdToRemovalInitiatorKeyName: 79,
+
+		// ConsumerIdToUpgradeNoticesKeyName is the key for storing the upgrade notices published by the owner of a consumer chain
+		ConsumerIdToUpgradeNoticesKeyName: 80,
+
+		// RewardsPausedConsumerKeyName is the key for storing the consumer ids of consumer chains whose reward distribution is paused
+		RewardsPausedConsumerKeyName: 81,
+
+		// ConsumerIdToHeldBackRewardsKeyName is the key for storing the rewards held back while the reward distribution
+		// of a consumer chain is paused
+		ConsumerIdToHeldBackRewardsKeyName: 82,
+
+		// ForcedOptInTimeKeyName is the key for storing the time at which a validator was automatically opted in
+		// to a consumer chain because it belongs to the top N
+		ForcedOptInTimeKeyName: 83,
+
+		// SkippedDowntimeSlashKeyName is the key for storing the downtime slashes that were not executed
+		// due to the downtime grace period of a consumer chain
+		SkippedDowntimeSlashKeyName: 84,
+
+		// SkippedDowntimeSlashSequenceKeyName is the key for storing the sequence number
+		// of the next skipped downtime slash of a consumer chain
+		SkippedDowntimeSlashSequenceKeyName: 85,
+
+		// UnavailableValidatorKeyName is the key for storing the validators that marked themselves
+		// as unavailable to validate a consumer chain
+		UnavailableValidatorKeyName: 86,
+
+		// UnroutableSlashPacketKeyName is the key for storing the last slash packets of a consumer chain
+		// that could not be routed to a provider validator
+		UnroutableSlashPacketKeyName: 87,
+
+		// UnroutableSlashPacketSequenceKeyName is the key for storing the sequence number
+		// of the next unroutable slash packet record of a consumer chain
+		UnroutableSlashPacketSequenceKeyName: 88,
+
+		// OptInTimeKeyName is the key for storing the time at which a validator opted in to a consumer chain
+		// (either voluntarily or automatically)
+		OptInTimeKeyName: 89,
+
+		// OptInHeightKeyName is the key for storing the provider block height at which the opt-in
+		// of a validator to a consumer chain became effective
+		OptInHeightKeyName: 90,
+
+		// PreOptInInfractionKeyName is the key for storing the downtime infractions of a consumer chain
+		// that were dropped, since they were committed before the validators opted in
+		PreOptInInfractionKeyName: 91,
+
+		// PreOptInInfractionSequenceKeyName is the key for storing the sequence number
+		// of the next pre-opt-in infraction record of a consumer chain
+		PreOptInInfractionSequenceKeyName: 92,
+
+		// EmergencyOptOutCooldownKeyName is the key for storing the time until which a validator
+		// cannot opt in to a consumer chain after an emergency opt out
+		EmergencyOptOutCooldownKeyName: 93,
+
+		// ConsumerIdToProviderUpgradeNoticeKeyName is the key for storing the provider upgrade notice
+		// last communicated to a consumer chain
+		ConsumerIdToProviderUpgradeNoticeKeyName: 94,
+
+		// ReOptInCooldownKeyName is the key for storing the time until which a validator cannot
+		// validate a consumer chain after being jailed for an infraction committed on it
+		ReOptInCooldownKeyName: 95,
+
+		// ConsumerIdToCreationRecordKeyName is the key for storing how a consumer chain was created,
+		// i.e., by the governance authority (and through which proposal) or permissionlessly
+		ConsumerIdToCreationRecordKeyName: 96,
+
+		// ConsumerIdToRemovalRecordKeyName is the key for storing why a consumer chain was stopped
+		// or why its launch failed
+		ConsumerIdToRemovalRecordKeyName: 97,
+
+		// ConsumerIdToAllowedIcaMsgTypesKeyName is the key for storing the message types that the interchain
+		// accounts controlled by the owner of a consumer chain are allowed to execute on the consumer chain
+		ConsumerIdToAllowedIcaMsgTypesKeyName: 98,
+
+		// ConsumerEconomicSecurityKeyName is the key for storing the economic security records
+		// of consumer chains computed at past provider heights
+		ConsumerEconomicSecurityKeyName: 99,
+
+		// KeyAssignmentNonceKeyName is the key for storing the number of consumer keys assigned by validators
+		// on consumer chains with MsgAssignConsumerKey, i.e., the nonce expected in their next MsgAssignConsumerKey
+		KeyAssignmentNonceKeyName: 100,
+
+		// LaunchedChainIdToConsumerIdKeyName is the key for storing the consumer id of the launched consumer chain
+		// with a given chain id
+		LaunchedChainIdToConsumerIdKeyName: 101,
+
+		// ConsumerIdToLaunchConflictRetriesKeyName is the key for storing the number of times the launch of a consumer
+		// chain was retried since another consumer chain with the same chain id is launched
+		ConsumerIdToLaunchConflictRetriesKeyName: 102,
+
+		// ConsumersWithValidatorRemovalsToSendKeyName is the key for storing the consumer ids of the consumer chains
+		// with VSC packets removing validators that are to be sent at the end of the block
+		ConsumersWithValidatorRemovalsToSendKeyName: 103,
+
+		// ChannelDeletionTimeKeyName is the key for storing the ids of the CCV channels whose mappings
+		// were deleted, indexed by the time of the deletion
+		ChannelDeletionTimeKeyName: 104,
+
+		// ReminderTimeToConsumerIdsKeyName is the key for storing launched consumers with a maximum lifetime
+		// for which a lifetime reminder is to be emitted
+		ReminderTimeToConsumerIdsKeyName: 105,
+
+		// ConsumerAcceptsUpgradeNoticesKeyName is the key for storing the consumer ids of the consumer chains
+		// that declared during the CCV channel handshake that they accept provider upgrade notices
+		ConsumerAcceptsUpgradeNoticesKeyName: 106,
+
+		// EquivocationEvidenceMinChainIdKeyName is the key for storing the chain id of the earliest revision
+		// of a consumer chain whose equivocation evidence is valid, i.e., to which EquivocationEvidenceMinHeight applies
+		EquivocationEvidenceMinChainIdKeyName: 107,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -406,6 +756,12 @@ func mustGetKeyPrefix(key string) byte {
 	}
 }
 
+// GetKeyPrefix returns the key prefix for a given key, or false if there is no byte prefix for the index
+func GetKeyPrefix(key string) (byte, bool) {
+	prefix, found := getKeyPrefixes()[key]
+	return prefix, found
+}
+
 // GetAllKeyPrefixes returns all the key prefixes.
 // Only used for testing
 func GetAllKeyPrefixes() []byte {
@@ -463,6 +819,12 @@ func SlashMeterReplenishTimeCandidateKey() []byte {
 	return []byte{mustGetKeyPrefix(SlashMeterReplenishTimeCandidateKeyName)}
 }
 
+// SlashMeterAccruedReplenishmentKey returns the key storing the amount by which the slash meter
+// was replenished since the start of the current replenish period
+func SlashMeterAccruedReplenishmentKey() []byte {
+	return []byte{mustGetKeyPrefix(SlashMeterAccruedReplenishmentKeyName)}
+}
+
 // ConsumerIdToChannelIdKey returns the key under which the CCV channel ID will be stored for the given consumer chain.
 func ConsumerIdToChannelIdKey(consumerId string) []byte {
 	return append([]byte{mustGetKeyPrefix(ConsumerIdToChannelIdKeyName)}, []byte(consumerId)...)
@@ -478,6 +840,30 @@ func ChannelToConsumerIdKey(channelId string) []byte {
 	return append(ChannelIdToConsumerIdKeyPrefix(), []byte(channelId)...)
 }
 
+// DeletedChannelIdToConsumerIdKey returns the key under which the consumer id is stored for the given channelId
+// once the mapping from the channelId to the consumer id was deleted
+func DeletedChannelIdToConsumerIdKey(channelId string) []byte {
+	return append([]byte{mustGetKeyPrefix(DeletedChannelIdToConsumerIdKeyName)}, []byte(channelId)...)
+}
+
+// ConsumerValSetSnapshotKeyPrefix returns the key prefix for storing the validator sets of consumer chains
+// computed at past provider heights
+func ConsumerValSetSnapshotKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerValSetSnapshotKeyName)
+}
+
+// ConsumerValSetSnapshotKey returns the key under which the validator set of the consumer chain
+// with `consumerId` computed at the provider `height` is stored
+func ConsumerValSetSnapshotKey(consumerId string, height uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerValSetSnapshotKeyPrefix(), consumerId, height)
+}
+
+// ConsumerIdToProviderFeePoolAddrKey returns the key under which the provider fee pool address
+// last communicated to the consumer chain with `consumerId` is stored
+func ConsumerIdToProviderFeePoolAddrKey(consumerId string) []byte {
+	return append([]byte{mustGetKeyPrefix(ConsumerIdToProviderFeePoolAddrKeyName)}, []byte(consumerId)...)
+}
+
 // ConsumerIdToClientIdKeyPrefix returns the key prefix for storing the clientId for the given consumerId.
 func ConsumerIdToClientIdKeyPrefix() []byte {
 	return []byte{mustGetKeyPrefix(ConsumerIdToClientIdKeyName)}
@@ -607,6 +993,18 @@ func OptedInKey(consumerId string, providerAddr ProviderConsAddress) []byte {
 	return StringIdAndConsAddrKey(OptedInKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
 }
 
+// AcknowledgedConsumerTermsKeyPrefix returns the key prefix for storing the hashes of the terms of consumer chains
+// acknowledged by validators
+func AcknowledgedConsumerTermsKeyPrefix() byte {
+	return mustGetKeyPrefix(AcknowledgedConsumerTermsKeyName)
+}
+
+// AcknowledgedConsumerTermsKey returns the key used to store the hash of the terms of the consumer chain
+// with `consumerId` acknowledged by the validator with `providerAddr`
+func AcknowledgedConsumerTermsKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(AcknowledgedConsumerTermsKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
 // ConsumerCommissionRateKeyPrefix returns the key prefix for storing the commission rate per validator per consumer chain.
 func ConsumerCommissionRateKeyPrefix() byte {
 	return mustGetKeyPrefix(ConsumerCommissionRateKeyName)
@@ -659,6 +1057,279 @@ func ConsumerIdToOwnerAddressKey(consumerId string) []byte {
 	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToOwnerAddressKeyName), consumerId)
 }
 
+// ConsumerIdToPowerShapingAdminKey returns the key under which the power-shaping admin of this consumer id is stored
+func ConsumerIdToPowerShapingAdminKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToPowerShapingAdminKeyName), consumerId)
+}
+
+// ConsumerIdToLifetimeKeyPrefix returns the key prefix for storing the lifetimes of consumer chains
+func ConsumerIdToLifetimeKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToLifetimeKeyName)
+}
+
+// ConsumerIdToLifetimeKey returns the key under which the lifetime of this consumer id is stored
+func ConsumerIdToLifetimeKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToLifetimeKeyPrefix(), consumerId)
+}
+
+// RewardAttributionLogKeyPrefix returns the key prefix for storing the reward attribution records
+func RewardAttributionLogKeyPrefix() byte {
+	return mustGetKeyPrefix(RewardAttributionLogKeyName)
+}
+
+// RewardAttributionLogKey returns the key under which the reward attribution record
+// with sequence number `sequence` of this consumer id is stored
+func RewardAttributionLogKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(RewardAttributionLogKeyPrefix(), consumerId, sequence)
+}
+
+// RewardAttributionLogSequenceKey returns the key under which the sequence number
+// of the next reward attribution record of this consumer id is stored
+func RewardAttributionLogSequenceKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(RewardAttributionLogSequenceKeyName), consumerId)
+}
+
+// ConsumerIdToRemovalInitiatorKey returns the key under which the initiator of the removal of this consumer id is stored
+func ConsumerIdToRemovalInitiatorKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToRemovalInitiatorKeyName), consumerId)
+}
+
+// ConsumerIdToUpgradeNoticesKey returns the key under which the upgrade notices of this consumer id are stored
+func ConsumerIdToUpgradeNoticesKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToUpgradeNoticesKeyName), consumerId)
+}
+
+// RewardsPausedConsumerKey returns the key used to mark the reward distribution of the consumer chain with `consumerId` as paused
+func RewardsPausedConsumerKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(RewardsPausedConsumerKeyName), consumerId)
+}
+
+// ConsumerIdToHeldBackRewardsKey returns the key under which the rewards held back for this consumer id are stored
+func ConsumerIdToHeldBackRewardsKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToHeldBackRewardsKeyName), consumerId)
+}
+
+// ForcedOptInTimeKeyPrefix returns the key prefix for storing the times at which validators were
+// automatically opted in to consumer chains
+func ForcedOptInTimeKeyPrefix() byte {
+	return mustGetKeyPrefix(ForcedOptInTimeKeyName)
+}
+
+// ForcedOptInTimeKey returns the key under which the time at which the validator with `providerAddr`
+// was automatically opted in to the consumer chain with `consumerId` is stored
+func ForcedOptInTimeKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(ForcedOptInTimeKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// SkippedDowntimeSlashKeyPrefix returns the key prefix for storing the skipped downtime slashes
+func SkippedDowntimeSlashKeyPrefix() byte {
+	return mustGetKeyPrefix(SkippedDowntimeSlashKeyName)
+}
+
+// SkippedDowntimeSlashKey returns the key under which the skipped downtime slash
+// with `sequence` of the consumer chain with `consumerId` is stored
+func SkippedDowntimeSlashKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(SkippedDowntimeSlashKeyPrefix(), consumerId, sequence)
+}
+
+// SkippedDowntimeSlashSequenceKey returns the key under which the sequence number
+// of the next skipped downtime slash of the consumer chain with `consumerId` is stored
+func SkippedDowntimeSlashSequenceKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(SkippedDowntimeSlashSequenceKeyName), consumerId)
+}
+
+// UnavailableValidatorKeyPrefix returns the key prefix for storing the validators that are unavailable
+// to validate consumer chains
+func UnavailableValidatorKeyPrefix() byte {
+	return mustGetKeyPrefix(UnavailableValidatorKeyName)
+}
+
+// UnavailableValidatorKey returns the key used to mark the validator with `providerAddr`
+// as unavailable to validate the consumer chain with `consumerId`
+func UnavailableValidatorKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(UnavailableValidatorKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// UnroutableSlashPacketKeyPrefix returns the key prefix for storing the unroutable slash packet records
+func UnroutableSlashPacketKeyPrefix() byte {
+	return mustGetKeyPrefix(UnroutableSlashPacketKeyName)
+}
+
+// UnroutableSlashPacketKey returns the key under which the unroutable slash packet record
+// with `sequence` of the consumer chain with `consumerId` is stored
+func UnroutableSlashPacketKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(UnroutableSlashPacketKeyPrefix(), consumerId, sequence)
+}
+
+// UnroutableSlashPacketSequenceKey returns the key under which the sequence number
+// of the next unroutable slash packet record of the consumer chain with `consumerId` is stored
+func UnroutableSlashPacketSequenceKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(UnroutableSlashPacketSequenceKeyName), consumerId)
+}
+
+// OptInTimeKeyPrefix returns the key prefix for storing the times at which validators opted in to consumer chains
+func OptInTimeKeyPrefix() byte {
+	return mustGetKeyPrefix(OptInTimeKeyName)
+}
+
+// OptInTimeKey returns the key under which the time at which the validator with `providerAddr`
+// opted in to the consumer chain with `consumerId` is stored
+func OptInTimeKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(OptInTimeKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// OptInHeightKeyPrefix returns the key prefix for storing the provider block heights at which
+// the opt-ins of validators to consumer chains became effective
+func OptInHeightKeyPrefix() byte {
+	return mustGetKeyPrefix(OptInHeightKeyName)
+}
+
+// OptInHeightKey returns the key under which the provider block height at which the opt-in of the
+// validator with `providerAddr` to the consumer chain with `consumerId` became effective is stored
+func OptInHeightKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(OptInHeightKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// PreOptInInfractionKeyPrefix returns the key prefix for storing the pre-opt-in infraction records
+func PreOptInInfractionKeyPrefix() byte {
+	return mustGetKeyPrefix(PreOptInInfractionKeyName)
+}
+
+// PreOptInInfractionKey returns the key under which the pre-opt-in infraction record
+// with `sequence` of the consumer chain with `consumerId` is stored
+func PreOptInInfractionKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(PreOptInInfractionKeyPrefix(), consumerId, sequence)
+}
+
+// PreOptInInfractionSequenceKey returns the key under which the sequence number
+// of the next pre-opt-in infraction record of the consumer chain with `consumerId` is stored
+func PreOptInInfractionSequenceKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(PreOptInInfractionSequenceKeyName), consumerId)
+}
+
+// EmergencyOptOutCooldownKeyPrefix returns the key prefix for storing the times until which
+// validators cannot opt in to consumer chains after an emergency opt out
+func EmergencyOptOutCooldownKeyPrefix() byte {
+	return mustGetKeyPrefix(EmergencyOptOutCooldownKeyName)
+}
+
+// EmergencyOptOutCooldownKey returns the key under which the time until which the validator with `providerAddr`
+// cannot opt in to the consumer chain with `consumerId` after an emergency opt out is stored
+func EmergencyOptOutCooldownKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(EmergencyOptOutCooldownKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerIdToProviderUpgradeNoticeKey returns the key under which the provider upgrade notice
+// last communicated to the consumer chain with `consumerId` is stored
+func ConsumerIdToProviderUpgradeNoticeKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToProviderUpgradeNoticeKeyName), consumerId)
+}
+
+// ReOptInCooldownKeyPrefix returns the key prefix for storing the times until which validators
+// cannot validate consumer chains after being jailed for infractions committed on them
+func ReOptInCooldownKeyPrefix() byte {
+	return mustGetKeyPrefix(ReOptInCooldownKeyName)
+}
+
+// ReOptInCooldownKey returns the key under which the time until which the validator with `providerAddr`
+// cannot validate the consumer chain with `consumerId` after being jailed for an infraction committed on it is stored
+func ReOptInCooldownKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(ReOptInCooldownKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerIdToCreationRecordKey returns the key under which the record of how
+// the consumer chain with `consumerId` was created is stored
+func ConsumerIdToCreationRecordKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToCreationRecordKeyName), consumerId)
+}
+
+// ConsumerIdToRemovalRecordKey returns the key under which the record of why
+// the consumer chain with `consumerId` was stopped is stored
+func ConsumerIdToRemovalRecordKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToRemovalRecordKeyName), consumerId)
+}
+
+// ConsumerIdToAllowedIcaMsgTypesKey returns the key under which the message types that the interchain accounts
+// controlled by the owner of the consumer chain with `consumerId` are allowed to execute are stored
+func ConsumerIdToAllowedIcaMsgTypesKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToAllowedIcaMsgTypesKeyName), consumerId)
+}
+
+// ConsumerEconomicSecurityKeyPrefix returns the key prefix for storing the economic security records of consumer chains
+func ConsumerEconomicSecurityKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerEconomicSecurityKeyName)
+}
+
+// ConsumerEconomicSecurityKey returns the key under which the economic security record of the consumer chain
+// with `consumerId` computed at the provider `height` is stored
+func ConsumerEconomicSecurityKey(consumerId string, height uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerEconomicSecurityKeyPrefix(), consumerId, height)
+}
+
+// KeyAssignmentNonceKeyPrefix returns the key prefix for storing the key assignment nonces of validators
+func KeyAssignmentNonceKeyPrefix() byte {
+	return mustGetKeyPrefix(KeyAssignmentNonceKeyName)
+}
+
+// KeyAssignmentNonceKey returns the key under which the key assignment nonce of the validator
+// with `providerAddr` on the consumer chain with `consumerId` is stored
+func KeyAssignmentNonceKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(KeyAssignmentNonceKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// LaunchedChainIdToConsumerIdKey returns the key under which the consumer id of the launched consumer chain
+// with `chainId` is stored
+func LaunchedChainIdToConsumerIdKey(chainId string) []byte {
+	return append([]byte{mustGetKeyPrefix(LaunchedChainIdToConsumerIdKeyName)}, []byte(chainId)...)
+}
+
+// ConsumerIdToLaunchConflictRetriesKey returns the key under which the number of launch retries of the consumer chain
+// with `consumerId` due to a chain id conflict is stored
+func ConsumerIdToLaunchConflictRetriesKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToLaunchConflictRetriesKeyName), consumerId)
+}
+
+// ConsumersWithValidatorRemovalsToSendKeyPrefix returns the key prefix for storing the consumer ids of the consumer chains
+// with VSC packets removing validators that are to be sent at the end of the block
+func ConsumersWithValidatorRemovalsToSendKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumersWithValidatorRemovalsToSendKeyName)
+}
+
+// ConsumersWithValidatorRemovalsToSendKey returns the key used to mark the consumer chain with `consumerId`
+// as having VSC packets removing validators that are to be sent at the end of the block
+func ConsumersWithValidatorRemovalsToSendKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumersWithValidatorRemovalsToSendKeyPrefix(), consumerId)
+}
+
+// ChannelDeletionTimeKeyPrefix returns the key prefix for storing the ids of the CCV channels whose mappings
+// were deleted, indexed by the time of the deletion
+func ChannelDeletionTimeKeyPrefix() byte {
+	return mustGetKeyPrefix(ChannelDeletionTimeKeyName)
+}
+
+// ChannelDeletionTimeKey returns the key with the following format:
+// bytePrefix | deletionTime | channelId
+// Note that the time is encoded with a fixed width (see TimeKey) and hence the keys are sorted by deletion time.
+func ChannelDeletionTimeKey(deletionTime time.Time, channelId string) []byte {
+	return ccvtypes.AppendMany(
+		TimeKey(ChannelDeletionTimeKeyPrefix(), deletionTime),
+		[]byte(channelId),
+	)
+}
+
+// ParseChannelDeletionTimeKey returns the deletion time and the channel id for a ChannelDeletionTime key
+func ParseChannelDeletionTimeKey(bz []byte) (time.Time, string, error) {
+	timeL := len(sdk.FormatTimeBytes(time.Time{}))
+	if len(bz) < 1+timeL {
+		return time.Time{}, "", fmt.Errorf("key too short; expected at least %d bytes, got: %d", 1+timeL, len(bz))
+	}
+	deletionTime, err := ParseTime(ChannelDeletionTimeKeyPrefix(), bz[:1+timeL])
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return deletionTime, string(bz[1+timeL:]), nil
+}
+
 // ConsumerIdToMetadataKeyPrefix returns the key prefix for storing consumer metadata
 func ConsumerIdToMetadataKeyPrefix() byte {
 	return mustGetKeyPrefix(ConsumerIdToConsumerMetadataKeyName)
@@ -705,6 +1376,41 @@ func ConsumerIdToRemovalTimeKey(consumerId string) []byte {
 	return StringIdWithLenKey(ConsumerIdToRemovalTimeKeyPrefix(), consumerId)
 }
 
+// StopTimeToConsumerIdsKeyPrefix returns the key prefix for storing launched chains that are to be stopped
+func StopTimeToConsumerIdsKeyPrefix() byte {
+	return mustGetKeyPrefix(StopTimeToConsumerIdsKeyName)
+}
+
+// StopTimeToConsumerIdsKey returns the key prefix for storing the stop times of consumer chains
+// whose maximum lifetime elapses
+func StopTimeToConsumerIdsKey(stopTime time.Time) []byte {
+	return TimeKey(StopTimeToConsumerIdsKeyPrefix(), stopTime)
+}
+
+// ReminderTimeToConsumerIdsKeyPrefix returns the key prefix for storing launched chains for which
+// a lifetime reminder is to be emitted
+func ReminderTimeToConsumerIdsKeyPrefix() byte {
+	return mustGetKeyPrefix(ReminderTimeToConsumerIdsKeyName)
+}
+
+// ReminderTimeToConsumerIdsKey returns the key prefix for storing the times at which
+// the next lifetime reminders of consumer chains are emitted
+func ReminderTimeToConsumerIdsKey(reminderTime time.Time) []byte {
+	return TimeKey(ReminderTimeToConsumerIdsKeyPrefix(), reminderTime)
+}
+
+// ConsumerAcceptsUpgradeNoticesKey returns the key used to mark the consumer chain with `consumerId`
+// as accepting provider upgrade notices
+func ConsumerAcceptsUpgradeNoticesKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerAcceptsUpgradeNoticesKeyName), consumerId)
+}
+
+// EquivocationEvidenceMinChainIdKey returns the key storing the chain id of the earliest revision
+// of the consumer chain with `consumerId` whose equivocation evidence is valid
+func EquivocationEvidenceMinChainIdKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(EquivocationEvidenceMinChainIdKeyName), consumerId)
+}
+
 // SpawnTimeToConsumerIdsKeyPrefix returns the key prefix for storing pending chains that are to be launched
 func SpawnTimeToConsumerIdsKeyPrefix() byte {
 	return mustGetKeyPrefix(SpawnTimeToConsumerIdsKeyName)
@@ -713,12 +1419,7 @@ func SpawnTimeToConsumerIdsKeyPrefix() byte {
 // SpawnTimeToConsumerIdsKey returns the key prefix for storing the spawn times of consumer chains
 // that are about to be launched
 func SpawnTimeToConsumerIdsKey(spawnTime time.Time) []byte {
-	return ccvtypes.AppendMany(
-		// append the prefix
-		[]byte{SpawnTimeToConsumerIdsKeyPrefix()},
-		// append the time
-		sdk.FormatTimeBytes(spawnTime),
-	)
+	return TimeKey(SpawnTimeToConsumerIdsKeyPrefix(), spawnTime)
 }
 
 // RemovalTimeToConsumerIdsKeyPrefix returns the key prefix for storing pending chains that are to be removed
@@ -729,18 +1430,60 @@ func RemovalTimeToConsumerIdsKeyPrefix() byte {
 // RemovalTimeToConsumerIdsKey returns the key prefix for storing the removal times of consumer chains
 // that are about to be removed
 func RemovalTimeToConsumerIdsKey(removalTime time.Time) []byte {
+	return TimeKey(RemovalTimeToConsumerIdsKeyPrefix(), removalTime)
+}
+
+// TimeQueueKeyPrefixes returns the key prefixes of the time queues by name
+func TimeQueueKeyPrefixes() map[string]byte {
+	return map[string]byte{
+		"spawn":    SpawnTimeToConsumerIdsKeyPrefix(),
+		"removal":  RemovalTimeToConsumerIdsKeyPrefix(),
+		"stop":     StopTimeToConsumerIdsKeyPrefix(),
+		"reminder": ReminderTimeToConsumerIdsKeyPrefix(),
+	}
+}
+
+// IsTimeQueueKeyPrefix returns whether `prefix` is the key prefix of a time queue
+func IsTimeQueueKeyPrefix(prefix byte) bool {
+	for _, timeQueuePrefix := range TimeQueueKeyPrefixes() {
+		if prefix == timeQueuePrefix {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeTime returns the logical time of `t` as written to the store, i.e., `t` in UTC and without
+// its monotonic clock reading. Both sdk.FormatTimeBytes (used in the keys, see TimeKey) and
+// time.Time.MarshalBinary (used in some values, e.g., the removal times) keep nanosecond precision,
+// so no truncation is needed; normalizing only ensures that a time that is written both in a key and
+// in a value is read back as the same time.Time, regardless of the location of the original time.
+func NormalizeTime(t time.Time) time.Time {
+	return t.UTC().Round(0)
+}
+
+// TimeKey returns the key with the provided prefix and time, where the time is normalized
+// (see NormalizeTime) and encoded using sdk.FormatTimeBytes, i.e., as a fixed-width byte slice
+// of the UTC time with nanosecond precision. As a result, the same logical time always results
+// in the same key and, for times with years in [0, MaxTimeKeyYear], the keys with the same prefix
+// are sorted bytewise in chronological order, which is required by the time queues
+// (see TimeQueue).
+func TimeKey(prefix byte, t time.Time) []byte {
 	return ccvtypes.AppendMany(
 		// append the prefix
-		[]byte{RemovalTimeToConsumerIdsKeyPrefix()},
+		[]byte{prefix},
 		// append the time
-		sdk.FormatTimeBytes(removalTime),
+		sdk.FormatTimeBytes(NormalizeTime(t)),
 	)
 }
 
-// ParseTime returns the marshalled time
+// ParseTime returns the time marshalled by TimeKey
 func ParseTime(prefix byte, bz []byte) (time.Time, error) {
 	expectedPrefix := []byte{prefix}
 	prefixL := len(expectedPrefix)
+	if len(bz) < prefixL {
+		return time.Time{}, fmt.Errorf("key too short; expected at least %d bytes, got: %d", prefixL, len(bz))
+	}
 	if prefix := bz[:prefixL]; !bytes.Equal(prefix, expectedPrefix) {
 		return time.Time{}, fmt.Errorf("invalid prefix; expected: %X, got: %X", expectedPrefix, prefix)
 	}
@@ -784,6 +1527,101 @@ func ConsumerRewardsAllocationByDenomKey(consumerId string, denom string) []byte
 	return append(StringIdWithLenKey(ConsumerRewardsAllocationByDenomKeyPrefix(), consumerId), []byte(denom)...)
 }
 
+// ConsumersToBeCleanedUpKeyPrefix returns the key prefix for storing the consumer ids of deleted consumer chains
+// whose state still needs to be cleaned up
+func ConsumersToBeCleanedUpKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumersToBeCleanedUpKeyName)
+}
+
+// ConsumersToBeCleanedUpKey returns the key used to mark the consumer chain with `consumerId` as pending cleanup
+func ConsumersToBeCleanedUpKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumersToBeCleanedUpKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToLastPacketReceivedTimeKey returns the key used to store the time at which
+// the last packet was received from the consumer chain with `consumerId`
+func ConsumerIdToLastPacketReceivedTimeKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToLastPacketReceivedTimeKeyName), consumerId)
+}
+
+// DormantConsumerKey returns the key used to mark the consumer chain with `consumerId` as dormant
+func DormantConsumerKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(DormantConsumerKeyName), consumerId)
+}
+
+// VerifiedConsumerKey returns the key used to mark the consumer chain with `consumerId` as verified
+func VerifiedConsumerKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(VerifiedConsumerKeyName), consumerId)
+}
+
+// EvidenceSubmissionPausedConsumerKey returns the key used to mark the submission of equivocation evidence
+// for the consumer chain with `consumerId` as paused
+func EvidenceSubmissionPausedConsumerKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(EvidenceSubmissionPausedConsumerKeyName), consumerId)
+}
+
+// ConsumerIdAndVscIdToHeightKeyPrefix returns the key prefix for storing the mapping from the valset update ids
+// sent to the consumer chain with `consumerId` to provider block heights
+func ConsumerIdAndVscIdToHeightKeyPrefix(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdAndVscIdToHeightKeyName), consumerId)
+}
+
+// ConsumerIdAndVscIdToHeightKey returns the key for storing the provider block height
+// to which `vscId` sent to the consumer chain with `consumerId` is mapped
+func ConsumerIdAndVscIdToHeightKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(mustGetKeyPrefix(ConsumerIdAndVscIdToHeightKeyName), consumerId, vscId)
+}
+
+// ConsumerIdToRewardChannelKey returns the key used to store the transfer channel on which
+// the ICS rewards of the consumer chain with `consumerId` are received
+func ConsumerIdToRewardChannelKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToRewardChannelKeyName), consumerId)
+}
+
+// ConsumerIdToEndpointInfoKey returns the key used to store the endpoints advertised
+// for the consumer chain with `consumerId`
+func ConsumerIdToEndpointInfoKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToEndpointInfoKeyName), consumerId)
+}
+
+// EpochInfoKey returns the key used to store the information about the current epoch
+func EpochInfoKey() []byte {
+	return []byte{mustGetKeyPrefix(EpochInfoKeyName)}
+}
+
+// ConsumerIdToGenesisHashKey returns the key used to store the hash of the genesis
+// created at launch for the consumer chain with `consumerId`
+func ConsumerIdToGenesisHashKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToGenesisHashKeyName), consumerId)
+}
+
+// OwnerAddressToConsumerIdsKeyPrefix returns the key prefix for storing the consumer ids owned by the given owner address
+func OwnerAddressToConsumerIdsKeyPrefix(owner string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(OwnerAddressToConsumerIdsKeyName), owner)
+}
+
+// OwnerAddressToConsumerIdKey returns the key used to store that the given owner address owns the given consumer id
+func OwnerAddressToConsumerIdKey(owner, consumerId string) []byte {
+	return append(OwnerAddressToConsumerIdsKeyPrefix(owner), []byte(consumerId)...)
+}
+
+// ConsumerIdToTimeoutPeriodsKey returns the key used to store the updated timeout periods of this consumer id
+func ConsumerIdToTimeoutPeriodsKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToTimeoutPeriodsKeyName), consumerId)
+}
+
+// ConsumerIdToPendingValidatorRemovalsKeyPrefix returns the key prefix used to store the validators
+// removed from this consumer id whose removal was not yet acknowledged
+func ConsumerIdToPendingValidatorRemovalsKeyPrefix(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToPendingValidatorRemovalsKeyName), consumerId)
+}
+
+// ConsumerIdToPendingValidatorRemovalKey returns the key used to store the valset update id of the VSC packet
+// removing the validator with the given provider address from this consumer id
+func ConsumerIdToPendingValidatorRemovalKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(mustGetKeyPrefix(ConsumerIdToPendingValidatorRemovalsKeyName), consumerId, providerAddr.ToSdkConsAddr())
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
@@ -1,11 +1,13 @@
 package types_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -147,6 +149,110 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(55), providertypes.ConsumerRewardsAllocationByDenomKey("13", "denom")[0])
 	i++
+	require.Equal(t, byte(56), providertypes.ConsumersToBeCleanedUpKey("13")[0])
+	i++
+	require.Equal(t, byte(57), providertypes.ConsumerIdToLastPacketReceivedTimeKey("13")[0])
+	i++
+	require.Equal(t, byte(58), providertypes.DormantConsumerKey("13")[0])
+	i++
+	require.Equal(t, byte(59), providertypes.VerifiedConsumerKey("13")[0])
+	i++
+	require.Equal(t, byte(60), providertypes.ConsumerIdAndVscIdToHeightKey("13", 7)[0])
+	i++
+	require.Equal(t, byte(61), providertypes.ConsumerIdToRewardChannelKey("13")[0])
+	i++
+	require.Equal(t, byte(62), providertypes.ConsumerIdToEndpointInfoKey("13")[0])
+	i++
+	require.Equal(t, byte(63), providertypes.EpochInfoKey()[0])
+	i++
+	require.Equal(t, byte(64), providertypes.ConsumerIdToGenesisHashKey("13")[0])
+	i++
+	require.Equal(t, byte(65), providertypes.OwnerAddressToConsumerIdKey("owner", "13")[0])
+	i++
+	require.Equal(t, byte(66), providertypes.ConsumerIdToTimeoutPeriodsKey("13")[0])
+	i++
+	require.Equal(t, byte(67), providertypes.ConsumerIdToPendingValidatorRemovalsKeyPrefix("13")[0])
+	i++
+	require.Equal(t, byte(68), providertypes.EvidenceSubmissionPausedConsumerKey("13")[0])
+	i++
+	require.Equal(t, byte(69), providertypes.SlashMeterAccruedReplenishmentKey()[0])
+	i++
+	require.Equal(t, byte(70), providertypes.AcknowledgedConsumerTermsKeyPrefix())
+	i++
+	require.Equal(t, byte(71), providertypes.DeletedChannelIdToConsumerIdKey("channel-0")[0])
+	i++
+	require.Equal(t, byte(72), providertypes.ConsumerValSetSnapshotKeyPrefix())
+	i++
+	require.Equal(t, byte(73), providertypes.ConsumerIdToProviderFeePoolAddrKey("13")[0])
+	i++
+	require.Equal(t, byte(74), providertypes.ConsumerIdToPowerShapingAdminKey("13")[0])
+	i++
+	require.Equal(t, byte(75), providertypes.StopTimeToConsumerIdsKeyPrefix())
+	i++
+	require.Equal(t, byte(76), providertypes.ConsumerIdToLifetimeKeyPrefix())
+	i++
+	require.Equal(t, byte(77), providertypes.RewardAttributionLogKeyPrefix())
+	i++
+	require.Equal(t, byte(78), providertypes.RewardAttributionLogSequenceKey("13")[0])
+	i++
+	require.Equal(t, byte(79), providertypes.ConsumerIdToRemovalInitiatorKey("13")[0])
+	i++
+	require.Equal(t, byte(80), providertypes.ConsumerIdToUpgradeNoticesKey("13")[0])
+	i++
+	require.Equal(t, byte(81), providertypes.RewardsPausedConsumerKey("13")[0])
+	i++
+	require.Equal(t, byte(82), providertypes.ConsumerIdToHeldBackRewardsKey("13")[0])
+	i++
+	require.Equal(t, byte(83), providertypes.ForcedOptInTimeKeyPrefix())
+	i++
+	require.Equal(t, byte(84), providertypes.SkippedDowntimeSlashKeyPrefix())
+	i++
+	require.Equal(t, byte(85), providertypes.SkippedDowntimeSlashSequenceKey("13")[0])
+	i++
+	require.Equal(t, byte(86), providertypes.UnavailableValidatorKeyPrefix())
+	i++
+	require.Equal(t, byte(87), providertypes.UnroutableSlashPacketKeyPrefix())
+	i++
+	require.Equal(t, byte(88), providertypes.UnroutableSlashPacketSequenceKey("13")[0])
+	i++
+	require.Equal(t, byte(89), providertypes.OptInTimeKeyPrefix())
+	i++
+	require.Equal(t, byte(90), providertypes.OptInHeightKeyPrefix())
+	i++
+	require.Equal(t, byte(91), providertypes.PreOptInInfractionKeyPrefix())
+	i++
+	require.Equal(t, byte(92), providertypes.PreOptInInfractionSequenceKey("13")[0])
+	i++
+	require.Equal(t, byte(93), providertypes.EmergencyOptOutCooldownKeyPrefix())
+	i++
+	require.Equal(t, byte(94), providertypes.ConsumerIdToProviderUpgradeNoticeKey("13")[0])
+	i++
+	require.Equal(t, byte(95), providertypes.ReOptInCooldownKeyPrefix())
+	i++
+	require.Equal(t, byte(96), providertypes.ConsumerIdToCreationRecordKey("13")[0])
+	i++
+	require.Equal(t, byte(97), providertypes.ConsumerIdToRemovalRecordKey("13")[0])
+	i++
+	require.Equal(t, byte(98), providertypes.ConsumerIdToAllowedIcaMsgTypesKey("13")[0])
+	i++
+	require.Equal(t, byte(99), providertypes.ConsumerEconomicSecurityKeyPrefix())
+	i++
+	require.Equal(t, byte(100), providertypes.KeyAssignmentNonceKeyPrefix())
+	i++
+	require.Equal(t, byte(101), providertypes.LaunchedChainIdToConsumerIdKey("chainId")[0])
+	i++
+	require.Equal(t, byte(102), providertypes.ConsumerIdToLaunchConflictRetriesKey("13")[0])
+	i++
+	require.Equal(t, byte(103), providertypes.ConsumersWithValidatorRemovalsToSendKeyPrefix())
+	i++
+	require.Equal(t, byte(104), providertypes.ChannelDeletionTimeKeyPrefix())
+	i++
+	require.Equal(t, byte(105), providertypes.ReminderTimeToConsumerIdsKeyPrefix())
+	i++
+	require.Equal(t, byte(106), providertypes.ConsumerAcceptsUpgradeNoticesKey("13")[0])
+	i++
+	require.Equal(t, byte(107), providertypes.EquivocationEvidenceMinChainIdKey("13")[0])
+	i++
 
 	prefixes := providertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -216,6 +322,58 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.ClientIdToConsumerIdKey("clientId"),
 		providertypes.ConsumerIdToAllowlistedRewardDenomKey("13"),
 		providertypes.ConsumerRewardsAllocationByDenomKey("13", "denom"),
+		providertypes.ConsumersToBeCleanedUpKey("13"),
+		providertypes.ConsumerIdToLastPacketReceivedTimeKey("13"),
+		providertypes.DormantConsumerKey("13"),
+		providertypes.VerifiedConsumerKey("13"),
+		providertypes.ConsumerIdAndVscIdToHeightKey("13", 7),
+		providertypes.ConsumerIdToRewardChannelKey("13"),
+		providertypes.ConsumerIdToEndpointInfoKey("13"),
+		providertypes.EpochInfoKey(),
+		providertypes.ConsumerIdToGenesisHashKey("13"),
+		providertypes.OwnerAddressToConsumerIdKey("owner", "13"),
+		providertypes.ConsumerIdToTimeoutPeriodsKey("13"),
+		providertypes.ConsumerIdToPendingValidatorRemovalKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.EvidenceSubmissionPausedConsumerKey("13"),
+		providertypes.SlashMeterAccruedReplenishmentKey(),
+		providertypes.AcknowledgedConsumerTermsKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.DeletedChannelIdToConsumerIdKey("channel-0"),
+		providertypes.ConsumerValSetSnapshotKey("13", 42),
+		providertypes.ConsumerIdToProviderFeePoolAddrKey("13"),
+		providertypes.ConsumerIdToPowerShapingAdminKey("13"),
+		providertypes.StopTimeToConsumerIdsKey(time.Time{}),
+		providertypes.ConsumerIdToLifetimeKey("13"),
+		providertypes.RewardAttributionLogKey("13", 42),
+		providertypes.RewardAttributionLogSequenceKey("13"),
+		providertypes.ConsumerIdToRemovalInitiatorKey("13"),
+		providertypes.ConsumerIdToUpgradeNoticesKey("13"),
+		providertypes.RewardsPausedConsumerKey("13"),
+		providertypes.ConsumerIdToHeldBackRewardsKey("13"),
+		providertypes.ForcedOptInTimeKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.SkippedDowntimeSlashKey("13", 42),
+		providertypes.SkippedDowntimeSlashSequenceKey("13"),
+		providertypes.UnavailableValidatorKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.UnroutableSlashPacketKey("13", 42),
+		providertypes.UnroutableSlashPacketSequenceKey("13"),
+		providertypes.OptInTimeKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.OptInHeightKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.PreOptInInfractionKey("13", 42),
+		providertypes.PreOptInInfractionSequenceKey("13"),
+		providertypes.EmergencyOptOutCooldownKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToProviderUpgradeNoticeKey("13"),
+		providertypes.ReOptInCooldownKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToCreationRecordKey("13"),
+		providertypes.ConsumerIdToRemovalRecordKey("13"),
+		providertypes.ConsumerIdToAllowedIcaMsgTypesKey("13"),
+		providertypes.ConsumerEconomicSecurityKey("13", 42),
+		providertypes.KeyAssignmentNonceKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.LaunchedChainIdToConsumerIdKey("chainId"),
+		providertypes.ConsumerIdToLaunchConflictRetriesKey("13"),
+		providertypes.ConsumersWithValidatorRemovalsToSendKey("13"),
+		providertypes.ChannelDeletionTimeKey(time.Time{}, "channel-0"),
+		providertypes.ReminderTimeToConsumerIdsKey(time.Time{}),
+		providertypes.ConsumerAcceptsUpgradeNoticesKey("13"),
+		providertypes.EquivocationEvidenceMinChainIdKey("13"),
 	}
 }
 
@@ -245,6 +403,105 @@ func TestStringIdAndTsKeyAndParse(t *testing.T) {
 	}
 }
 
+// Tests the construction and parsing of channel deletion time keys
+func TestChannelDeletionTimeKeyAndParse(t *testing.T) {
+	deletionTime := time.Date(2003, 11, 17, 20, 34, 58, 651387237, time.UTC)
+	key := providertypes.ChannelDeletionTimeKey(deletionTime, "channel-13")
+	parsedTime, parsedChannelId, err := providertypes.ParseChannelDeletionTimeKey(key)
+	require.NoError(t, err)
+	require.Equal(t, deletionTime, parsedTime)
+	require.Equal(t, "channel-13", parsedChannelId)
+
+	// the keys are sorted by deletion time, independently of the channel ids
+	require.Negative(t, bytes.Compare(
+		providertypes.ChannelDeletionTimeKey(deletionTime, "channel-9"),
+		providertypes.ChannelDeletionTimeKey(deletionTime.Add(time.Nanosecond), "channel-10"),
+	))
+
+	_, _, err = providertypes.ParseChannelDeletionTimeKey([]byte{providertypes.ChannelDeletionTimeKeyPrefix()})
+	require.Error(t, err)
+}
+
+// Tests the construction and parsing of time keys
+func TestTimeKeyAndParse(t *testing.T) {
+	tests := []struct {
+		prefix    byte
+		timestamp time.Time
+	}{
+		{prefix: 0x01, timestamp: time.Time{}},
+		{prefix: 0x02, timestamp: time.Date(2003, 11, 17, 20, 34, 58, 651387237, time.UTC)},
+		{prefix: 0x03, timestamp: time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+
+	for _, test := range tests {
+		key := providertypes.TimeKey(test.prefix, test.timestamp)
+		require.Equal(t, 1+len(sdk.FormatTimeBytes(time.Time{})), len(key))
+		parsedTime, err := providertypes.ParseTime(test.prefix, key)
+		require.NoError(t, err)
+		require.Equal(t, test.timestamp.UTC(), parsedTime)
+	}
+
+	// the same logical time results in the same key, independently of its location and monotonic clock reading
+	now := time.Now()
+	for _, equivalent := range []time.Time{now.UTC(), now.Round(0), now.In(time.FixedZone("UTC+9", 9*60*60))} {
+		require.Equal(t, providertypes.TimeKey(0x01, now), providertypes.TimeKey(0x01, equivalent))
+		require.Equal(t, providertypes.NormalizeTime(now), providertypes.NormalizeTime(equivalent))
+	}
+	// the keys keep nanosecond precision
+	require.NotEqual(t, providertypes.TimeKey(0x01, now), providertypes.TimeKey(0x01, now.Add(time.Nanosecond)))
+
+	_, err := providertypes.ParseTime(0x01, []byte{})
+	require.Error(t, err)
+	_, err = providertypes.ParseTime(0x02, providertypes.TimeKey(0x01, time.Now()))
+	require.Error(t, err)
+
+	// the time queues use the time keys
+	require.Equal(t, providertypes.TimeKey(providertypes.SpawnTimeToConsumerIdsKeyPrefix(), now),
+		providertypes.SpawnTimeToConsumerIdsKey(now))
+	require.Equal(t, providertypes.TimeKey(providertypes.RemovalTimeToConsumerIdsKeyPrefix(), now),
+		providertypes.RemovalTimeToConsumerIdsKey(now))
+	require.Equal(t, providertypes.TimeKey(providertypes.ReminderTimeToConsumerIdsKeyPrefix(), now),
+		providertypes.ReminderTimeToConsumerIdsKey(now))
+}
+
+// Tests that time keys round-trip and that they are sorted bytewise in chronological order
+func TestTimeKeyOrdering(t *testing.T) {
+	// the range of times with years in [0, MaxTimeKeyYear]
+	minSeconds := time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxSeconds := time.Date(providertypes.MaxTimeKeyYear, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+
+	drawTime := func(t *rapid.T, label string) time.Time {
+		seconds := rapid.Int64Range(minSeconds, maxSeconds).Draw(t, label+"Seconds")
+		nanos := rapid.Int64Range(0, int64(time.Second)-1).Draw(t, label+"Nanos")
+		zoneOffset := rapid.IntRange(-12, 14).Draw(t, label+"ZoneOffset")
+		return time.Unix(seconds, nanos).In(time.FixedZone("", zoneOffset*60*60))
+	}
+
+	rapid.Check(t, func(t *rapid.T) {
+		prefix := rapid.Byte().Draw(t, "prefix")
+		t1 := drawTime(t, "t1")
+		// draw the second time either independently or close to the first one
+		t2 := drawTime(t, "t2")
+		if rapid.Bool().Draw(t, "close") {
+			t2 = t1.Add(time.Duration(rapid.Int64Range(-int64(time.Second), int64(time.Second)).Draw(t, "delta")))
+		}
+		if t2.UTC().Year() < 0 || t2.UTC().Year() > providertypes.MaxTimeKeyYear {
+			t.Skip("time outside the supported range")
+		}
+
+		key1 := providertypes.TimeKey(prefix, t1)
+		key2 := providertypes.TimeKey(prefix, t2)
+
+		// round-trip
+		parsedTime, err := providertypes.ParseTime(prefix, key1)
+		require.NoError(t, err)
+		require.True(t, t1.Equal(parsedTime))
+
+		// ordering
+		require.Equal(t, t1.Compare(t2), bytes.Compare(key1, key2))
+	})
+}
+
 // Tests the construction and parsing of StringIdAndUintId keys
 func TestStringIdAndUintIdAndParse(t *testing.T) {
 	tests := []struct {
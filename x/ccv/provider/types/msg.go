@@ -1,13 +1,21 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,6 +23,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -32,6 +41,28 @@ const (
 	MaxHashLength = 64
 	// MaxValidatorCount defines the maximum number of validators
 	MaxValidatorCount = 1000
+	// MaxEndpointCount defines the maximum number of peers, seeds or RPC URLs of a consumer
+	MaxEndpointCount = 10
+	// MaxEndpointLength defines the maximum length of a consumer peer, seed or URL
+	MaxEndpointLength = 255
+	// MaxAllowedIcaMsgTypeCount defines the maximum number of message types that the interchain accounts
+	// controlled by the owner of a consumer chain can be allowed to execute
+	MaxAllowedIcaMsgTypeCount = 50
+	// MaxMsgTypeUrlLength defines the maximum length of a message type URL
+	MaxMsgTypeUrlLength = 255
+	// MisbehaviourVerificationBaseGas defines the gas consumed by a MsgSubmitConsumerMisbehaviour
+	// before the headers of the misbehaviour are verified
+	MisbehaviourVerificationBaseGas = 10000
+	// MisbehaviourVerificationGasPerSignature defines the gas consumed by a MsgSubmitConsumerMisbehaviour
+	// per validator in the validator sets of the headers of the misbehaviour, i.e., per signature that may be verified
+	MisbehaviourVerificationGasPerSignature = 1000
+	// ConsumerMetadataGasPerByte defines the gas consumed by a MsgCreateConsumer or MsgUpdateConsumer
+	// per byte of the serialized consumer metadata, on top of the gas consumed by the store writes
+	ConsumerMetadataGasPerByte = 30
+	// ConsumerListGasPerByte defines the gas consumed by a MsgCreateConsumer or MsgUpdateConsumer
+	// per byte of the serialized allowlist, denylist and allowlisted reward denoms, on top of the gas
+	// consumed by the store writes, as these lists are iterated over in the provider logic (e.g., every epoch)
+	ConsumerListGasPerByte = 100
 )
 
 var (
@@ -44,7 +75,12 @@ var (
 	_ sdk.Msg = (*MsgRemoveConsumer)(nil)
 	_ sdk.Msg = (*MsgOptIn)(nil)
 	_ sdk.Msg = (*MsgOptOut)(nil)
+	_ sdk.Msg = (*MsgEmergencyOptOut)(nil)
 	_ sdk.Msg = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.Msg = (*MsgSetConsumerAvailability)(nil)
+	_ sdk.Msg = (*MsgSetConsumerVerified)(nil)
+	_ sdk.Msg = (*MsgSetConsumerEvidenceSubmissionPaused)(nil)
+	_ sdk.Msg = (*MsgConsumerHardFork)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgAssignConsumerKey)(nil)
 	_ sdk.HasValidateBasic = (*MsgChangeRewardDenoms)(nil)
@@ -55,19 +91,25 @@ var (
 	_ sdk.HasValidateBasic = (*MsgRemoveConsumer)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptIn)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptOut)(nil)
+	_ sdk.HasValidateBasic = (*MsgEmergencyOptOut)(nil)
 	_ sdk.HasValidateBasic = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerAvailability)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerVerified)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerEvidenceSubmissionPaused)(nil)
+	_ sdk.HasValidateBasic = (*MsgConsumerHardFork)(nil)
 )
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
 // Delegator address and validator address are the same.
 func NewMsgAssignConsumerKey(consumerId string, providerValidatorAddress sdk.ValAddress,
-	consumerConsensusPubKey, signer string,
+	consumerConsensusPubKey, signer string, nonce uint64,
 ) (*MsgAssignConsumerKey, error) {
 	return &MsgAssignConsumerKey{
 		ConsumerId:   consumerId,
 		ProviderAddr: providerValidatorAddress.String(),
 		ConsumerKey:  consumerConsensusPubKey,
 		Signer:       signer,
+		Nonce:        nonce,
 	}, nil
 }
 
@@ -127,6 +169,24 @@ func (msg *MsgChangeRewardDenoms) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgSetConsumerVerified) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerVerified, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg *MsgSetConsumerEvidenceSubmissionPaused) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerEvidencePaused, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
 func NewMsgSubmitConsumerMisbehaviour(
 	consumerId string,
 	submitter sdk.AccAddress,
@@ -187,12 +247,13 @@ func (msg MsgSubmitConsumerDoubleVoting) ValidateBasic() error {
 }
 
 // NewMsgOptIn creates a new NewMsgOptIn instance.
-func NewMsgOptIn(consumerId string, providerValidatorAddress sdk.ValAddress, consumerConsensusPubKey, signer string) (*MsgOptIn, error) {
+func NewMsgOptIn(consumerId string, providerValidatorAddress sdk.ValAddress, consumerConsensusPubKey, signer, acknowledgedTermsHash string) (*MsgOptIn, error) {
 	return &MsgOptIn{
-		ConsumerId:   consumerId,
-		ProviderAddr: providerValidatorAddress.String(),
-		ConsumerKey:  consumerConsensusPubKey,
-		Signer:       signer,
+		ConsumerId:            consumerId,
+		ProviderAddr:          providerValidatorAddress.String(),
+		ConsumerKey:           consumerConsensusPubKey,
+		Signer:                signer,
+		AcknowledgedTermsHash: acknowledgedTermsHash,
 	}, nil
 }
 
@@ -215,6 +276,12 @@ func (msg MsgOptIn) ValidateBasic() error {
 			return errorsmod.Wrapf(ErrInvalidMsgOptIn, "ConsumerKey: %s", err.Error())
 		}
 	}
+
+	if msg.AcknowledgedTermsHash != "" {
+		if err := ValidateTermsHash(msg.AcknowledgedTermsHash); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgOptIn, "AcknowledgedTermsHash: %s", err.Error())
+		}
+	}
 	return nil
 }
 
@@ -244,6 +311,28 @@ func (msg MsgOptOut) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgEmergencyOptOut creates a new MsgEmergencyOptOut instance.
+func NewMsgEmergencyOptOut(consumerId string, providerValidatorAddress sdk.ValAddress, signer string) *MsgEmergencyOptOut {
+	return &MsgEmergencyOptOut{
+		ConsumerId:   consumerId,
+		ProviderAddr: providerValidatorAddress.String(),
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgEmergencyOptOut) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgEmergencyOptOut, "ConsumerId: %s", err.Error())
+	}
+
+	if err := validateProviderAddress(msg.ProviderAddr, msg.Signer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgEmergencyOptOut, "ProviderAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
 // NewMsgSetConsumerCommissionRate creates a new MsgSetConsumerCommissionRate msg instance.
 func NewMsgSetConsumerCommissionRate(
 	consumerId string,
@@ -280,6 +369,34 @@ func (msg MsgSetConsumerCommissionRate) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetConsumerAvailability creates a new MsgSetConsumerAvailability msg instance.
+func NewMsgSetConsumerAvailability(
+	consumerId string,
+	available bool,
+	providerValidatorAddress sdk.ValAddress,
+	signer string,
+) *MsgSetConsumerAvailability {
+	return &MsgSetConsumerAvailability{
+		ConsumerId:   consumerId,
+		Available:    available,
+		ProviderAddr: providerValidatorAddress.String(),
+		Signer:       signer,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgSetConsumerAvailability) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerAvailability, "ConsumerId: %s", err.Error())
+	}
+
+	if err := validateProviderAddress(msg.ProviderAddr, msg.Signer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerAvailability, "ProviderAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
 // NewMsgCreateConsumer creates a new MsgCreateConsumer instance
 func NewMsgCreateConsumer(submitter, chainId string, metadata ConsumerMetadata,
 	initializationParameters *ConsumerInitializationParameters, powerShapingParameters *PowerShapingParameters,
@@ -319,6 +436,11 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		if err := ValidateInitializationParameters(*msg.InitializationParameters); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "InitializationParameters: %s", err.Error())
 		}
+		// the chain id is known at this point, so the initial height can be validated
+		// without waiting for the message to be handled
+		if err := ValidateInitialHeight(msg.InitializationParameters.InitialHeight, msg.ChainId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "InitializationParameters: %s", err.Error())
+		}
 	}
 
 	if msg.PowerShapingParameters != nil {
@@ -343,7 +465,10 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 // NewMsgUpdateConsumer creates a new MsgUpdateConsumer instance
 func NewMsgUpdateConsumer(owner, consumerId, ownerAddress string, metadata *ConsumerMetadata,
 	initializationParameters *ConsumerInitializationParameters, powerShapingParameters *PowerShapingParameters,
-	allowlistedRewardDenoms *AllowlistedRewardDenoms,
+	allowlistedRewardDenoms *AllowlistedRewardDenoms, rewardChannelId string, endpointInfo *EndpointInfo,
+	timeoutPeriods *ConsumerTimeoutPeriods, powerShapingAdmin *PowerShapingAdmin, retainPowerShapingAdmin bool,
+	lifetimeExtension *time.Duration, upgradeNotice *ConsumerUpgradeNotice, rewardsPaused *RewardsPaused,
+	allowedIcaMsgTypes *AllowedIcaMsgTypes,
 ) (*MsgUpdateConsumer, error) {
 	return &MsgUpdateConsumer{
 		Owner:                    owner,
@@ -353,6 +478,15 @@ func NewMsgUpdateConsumer(owner, consumerId, ownerAddress string, metadata *Cons
 		InitializationParameters: initializationParameters,
 		PowerShapingParameters:   powerShapingParameters,
 		AllowlistedRewardDenoms:  allowlistedRewardDenoms,
+		RewardChannelId:          rewardChannelId,
+		EndpointInfo:             endpointInfo,
+		TimeoutPeriods:           timeoutPeriods,
+		PowerShapingAdmin:        powerShapingAdmin,
+		RetainPowerShapingAdmin:  retainPowerShapingAdmin,
+		LifetimeExtension:        lifetimeExtension,
+		UpgradeNotice:            upgradeNotice,
+		RewardsPaused:            rewardsPaused,
+		AllowedIcaMsgTypes:       allowedIcaMsgTypes,
 	}, nil
 }
 
@@ -382,12 +516,54 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.Metadata != nil && msg.Metadata.TermsHash != "" &&
+		msg.PowerShapingParameters != nil && msg.PowerShapingParameters.Top_N > 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer,
+			"Metadata: a Top N chain cannot have terms, since its validators are opted in automatically")
+	}
+
 	if msg.AllowlistedRewardDenoms != nil {
 		if err := ValidateAllowlistedRewardDenoms(*msg.AllowlistedRewardDenoms); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "AllowlistedRewardDenoms: %s", err.Error())
 		}
 	}
 
+	if msg.RewardChannelId != "" {
+		if err := host.ChannelIdentifierValidator(msg.RewardChannelId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "RewardChannelId: %s", err.Error())
+		}
+	}
+
+	if msg.EndpointInfo != nil {
+		if err := ValidateEndpointInfo(*msg.EndpointInfo); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "EndpointInfo: %s", err.Error())
+		}
+	}
+
+	if msg.TimeoutPeriods != nil {
+		if err := ValidateConsumerTimeoutPeriods(*msg.TimeoutPeriods); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "TimeoutPeriods: %s", err.Error())
+		}
+	}
+
+	if msg.LifetimeExtension != nil {
+		if err := ccvtypes.ValidateDuration(*msg.LifetimeExtension); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "LifetimeExtension: %s", err.Error())
+		}
+	}
+
+	if msg.UpgradeNotice != nil {
+		if err := ValidateConsumerUpgradeNotice(*msg.UpgradeNotice); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "UpgradeNotice: %s", err.Error())
+		}
+	}
+
+	if msg.AllowedIcaMsgTypes != nil {
+		if err := ValidateAllowedIcaMsgTypes(*msg.AllowedIcaMsgTypes); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "AllowedIcaMsgTypes: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -407,6 +583,36 @@ func (msg MsgRemoveConsumer) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgConsumerHardFork creates a new MsgConsumerHardFork instance
+func NewMsgConsumerHardFork(owner, consumerId, newChainId string, initialHeight clienttypes.Height) (*MsgConsumerHardFork, error) {
+	return &MsgConsumerHardFork{
+		Owner:         owner,
+		ConsumerId:    consumerId,
+		NewChainId:    newChainId,
+		InitialHeight: initialHeight,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgConsumerHardFork) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgConsumerHardFork, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateStringField("NewChainId", msg.NewChainId, cmttypes.MaxChainIDLen); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgConsumerHardFork, "NewChainId: %s", err.Error())
+	}
+
+	if msg.InitialHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidMsgConsumerHardFork, "InitialHeight cannot be zero")
+	}
+	if err := ValidateInitialHeight(msg.InitialHeight, msg.NewChainId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgConsumerHardFork, "InitialHeight: %s", err.Error())
+	}
+
+	return nil
+}
+
 //
 // Validation methods
 //
@@ -494,6 +700,145 @@ func ValidateConsumerMetadata(metadata ConsumerMetadata) error {
 		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "Metadata: %s", err.Error())
 	}
 
+	if metadata.TermsHash != "" {
+		if err := ValidateTermsHash(metadata.TermsHash); err != nil {
+			return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "TermsHash: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateTermsHash validates that the hash of the terms of a consumer chain is a hex-encoded SHA-256 hash
+func ValidateTermsHash(termsHash string) error {
+	if hash, err := hex.DecodeString(termsHash); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("terms hash must be %d hex-encoded bytes", sha256.Size)
+	}
+	return nil
+}
+
+// ValidateConsumerTimeoutPeriods validates that the timeout periods of a consumer chain are positive.
+// Note that the CCV timeout period is also validated against the expected duration of an epoch
+// when handling MsgUpdateConsumer (see ValidateConsumerTimeoutPeriods in the provider keeper).
+func ValidateConsumerTimeoutPeriods(timeoutPeriods ConsumerTimeoutPeriods) error {
+	if err := ccvtypes.ValidateDuration(timeoutPeriods.CcvTimeoutPeriod); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerTimeoutPeriods, "CcvTimeoutPeriod: %s", err.Error())
+	}
+	if err := ccvtypes.ValidateDuration(timeoutPeriods.TransferTimeoutPeriod); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerTimeoutPeriods, "TransferTimeoutPeriod: %s", err.Error())
+	}
+	return nil
+}
+
+// ValidateConsumerUpgradeNotice validates that an upgrade notice of a consumer chain has a name,
+// a positive upgrade height, a binary hash, and a deadline.
+// Note that the deadline is validated against the block time when handling MsgUpdateConsumer.
+func ValidateConsumerUpgradeNotice(notice ConsumerUpgradeNotice) error {
+	if err := ValidateStringField("name", notice.Name, MaxNameLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerUpgradeNotice, "Name: %s", err.Error())
+	}
+	if notice.Height == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerUpgradeNotice, "Height: upgrade height cannot be zero")
+	}
+	if err := ValidateStringField("binary hash", notice.BinaryHash, MaxHashLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerUpgradeNotice, "BinaryHash: %s", err.Error())
+	}
+	if notice.Deadline.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerUpgradeNotice, "Deadline: deadline cannot be zero")
+	}
+	return nil
+}
+
+// ValidateEndpointInfo validates that the endpoints advertised for a consumer chain are well-formed, i.e.,
+//   - there are at most `MaxEndpointCount` persistent peers, seeds, and RPC URLs, respectively, without duplicates
+//   - every endpoint has at most `MaxEndpointLength` characters
+//   - persistent peers and seeds are of the form `node_id@host:port`
+//   - RPC URLs are absolute http(s), ws(s), or tcp URLs and the genesis URL (if provided) is an absolute http(s) URL
+func ValidateEndpointInfo(endpointInfo EndpointInfo) error {
+	if err := validateEndpointList("persistent peers", endpointInfo.PersistentPeers, validatePeerAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEndpointInfo, "PersistentPeers: %s", err.Error())
+	}
+
+	if err := validateEndpointList("seeds", endpointInfo.Seeds, validatePeerAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEndpointInfo, "Seeds: %s", err.Error())
+	}
+
+	validateRpcUrl := func(rpcUrl string) error {
+		return validateEndpointUrl(rpcUrl, "http", "https", "ws", "wss", "tcp")
+	}
+	if err := validateEndpointList("RPC URLs", endpointInfo.RpcUrls, validateRpcUrl); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEndpointInfo, "RpcUrls: %s", err.Error())
+	}
+
+	if endpointInfo.GenesisUrl != "" {
+		if err := ValidateStringField("genesis URL", endpointInfo.GenesisUrl, MaxEndpointLength); err != nil {
+			return errorsmod.Wrapf(ErrInvalidEndpointInfo, "GenesisUrl: %s", err.Error())
+		}
+		if err := validateEndpointUrl(endpointInfo.GenesisUrl, "http", "https"); err != nil {
+			return errorsmod.Wrapf(ErrInvalidEndpointInfo, "GenesisUrl: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// validateEndpointList validates a list of at most `MaxEndpointCount` distinct endpoints
+// of at most `MaxEndpointLength` characters each using the `validate` function
+func validateEndpointList(nameOfTheList string, endpoints []string, validate func(string) error) error {
+	if len(endpoints) > MaxEndpointCount {
+		return fmt.Errorf("%s list too long; got: %d, max: %d", nameOfTheList, len(endpoints), MaxEndpointCount)
+	}
+	seen := map[string]bool{}
+	for _, endpoint := range endpoints {
+		if err := ValidateStringField("endpoint", endpoint, MaxEndpointLength); err != nil {
+			return err
+		}
+		if seen[endpoint] {
+			return fmt.Errorf("duplicate endpoint: %s", endpoint)
+		}
+		seen[endpoint] = true
+		if err := validate(endpoint); err != nil {
+			return fmt.Errorf("invalid endpoint %s: %w", endpoint, err)
+		}
+	}
+	return nil
+}
+
+// validatePeerAddress validates that `peer` is of the form `node_id@host:port`, where
+// `node_id` is the hex-encoded ID of a CometBFT node
+func validatePeerAddress(peer string) error {
+	nodeId, hostPort, found := strings.Cut(peer, "@")
+	if !found {
+		return fmt.Errorf("expected node_id@host:port")
+	}
+	if id, err := hex.DecodeString(nodeId); err != nil || len(id) != cmtcrypto.AddressSize {
+		return fmt.Errorf("node id must be %d hex-encoded bytes", cmtcrypto.AddressSize)
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return err
+	}
+	if host == "" {
+		return fmt.Errorf("host cannot be empty")
+	}
+	if portNumber, err := strconv.ParseUint(port, 10, 16); err != nil || portNumber == 0 {
+		return fmt.Errorf("invalid port: %s", port)
+	}
+	return nil
+}
+
+// validateEndpointUrl validates that `endpointUrl` is an absolute URL with one of the given schemes
+func validateEndpointUrl(endpointUrl string, schemes ...string) error {
+	parsedUrl, err := url.ParseRequestURI(endpointUrl)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(schemes, parsedUrl.Scheme) {
+		return fmt.Errorf("unsupported scheme %q, expected one of %v", parsedUrl.Scheme, schemes)
+	}
+	if parsedUrl.Hostname() == "" {
+		return fmt.Errorf("host cannot be empty")
+	}
 	return nil
 }
 
@@ -523,6 +868,14 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "ValidatorsPowerCap has to be in the range [0, 100]")
 	}
 
+	if powerShapingParameters.DowntimeGracePeriod != nil && *powerShapingParameters.DowntimeGracePeriod < 0 {
+		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "DowntimeGracePeriod cannot be negative")
+	}
+
+	if powerShapingParameters.ReOptInCooldownAfterSlash != nil && *powerShapingParameters.ReOptInCooldownAfterSlash < 0 {
+		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "ReOptInCooldownAfterSlash cannot be negative")
+	}
+
 	if err := ValidateConsAddressList(powerShapingParameters.Allowlist, MaxValidatorCount); err != nil {
 		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Allowlist: %s", err.Error())
 	}
@@ -547,12 +900,39 @@ func ValidateAllowlistedRewardDenoms(allowlistedRewardDenoms AllowlistedRewardDe
 	return nil
 }
 
+// ValidateAllowedIcaMsgTypes validates that the provided message types are distinct type URLs
+// (e.g., "/cosmos.bank.v1beta1.MsgSend") and that there are at most MaxAllowedIcaMsgTypeCount of them
+func ValidateAllowedIcaMsgTypes(allowedIcaMsgTypes AllowedIcaMsgTypes) error {
+	if len(allowedIcaMsgTypes.MsgTypeUrls) > MaxAllowedIcaMsgTypeCount {
+		return errorsmod.Wrapf(ErrInvalidAllowedIcaMsgTypes, "more than %d message types", MaxAllowedIcaMsgTypeCount)
+	}
+
+	seen := map[string]bool{}
+	for _, msgTypeUrl := range allowedIcaMsgTypes.MsgTypeUrls {
+		if err := ValidateStringField("message type URL", msgTypeUrl, MaxMsgTypeUrlLength); err != nil {
+			return errorsmod.Wrap(ErrInvalidAllowedIcaMsgTypes, err.Error())
+		}
+		if !strings.HasPrefix(msgTypeUrl, "/") || strings.ContainsAny(msgTypeUrl, " \t\n") {
+			return errorsmod.Wrapf(ErrInvalidAllowedIcaMsgTypes, "invalid message type URL (%s)", msgTypeUrl)
+		}
+		if seen[msgTypeUrl] {
+			return errorsmod.Wrapf(ErrInvalidAllowedIcaMsgTypes, "duplicate message type URL (%s)", msgTypeUrl)
+		}
+		seen[msgTypeUrl] = true
+	}
+	return nil
+}
+
 // ValidateInitializationParameters validates that all the provided parameters are in the expected range
 func ValidateInitializationParameters(initializationParameters ConsumerInitializationParameters) error {
 	if initializationParameters.InitialHeight.IsZero() {
 		return errorsmod.Wrap(ErrInvalidConsumerInitializationParameters, "InitialHeight cannot be zero")
 	}
 
+	if initializationParameters.SpawnTime.UTC().Year() > MaxTimeKeyYear {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "SpawnTime cannot be after year %d", MaxTimeKeyYear)
+	}
+
 	if err := ValidateByteSlice(initializationParameters.GenesisHash, MaxHashLength); err != nil {
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "GenesisHash: %s", err.Error())
 	}
@@ -589,6 +969,48 @@ func ValidateInitializationParameters(initializationParameters ConsumerInitializ
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "UnbondingPeriod: %s", err.Error())
 	}
 
+	if err := ccvtypes.ValidateConsumerDenom(initializationParameters.ConsumerDenom, initializationParameters.ConsumerDenomMetadata); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "ConsumerDenom: %s", err.Error())
+	}
+
+	if err := ccvtypes.ValidateDowntimeWindow(initializationParameters.SignedBlocksWindow, initializationParameters.MinSignedPerWindow); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "DowntimeWindow: %s", err.Error())
+	}
+
+	if err := ccvtypes.ValidateDenomRedistributionFractions(initializationParameters.DenomRedistributionFractions); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "DenomRedistributionFractions: %s", err.Error())
+	}
+
+	if err := ccvtypes.ValidateNonNegativeDuration(initializationParameters.MaxLifetime); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "MaxLifetime: %s", err.Error())
+	}
+
+	if err := validatePreCCVEvidenceParameters(initializationParameters); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "PreCcvEvidenceMinHeight: %s", err.Error())
+	}
+
+	return nil
+}
+
+// validatePreCCVEvidenceParameters validates the optional parameters that enable
+// the handling of double voting evidence from the pre-CCV history of a consumer chain
+func validatePreCCVEvidenceParameters(initializationParameters ConsumerInitializationParameters) error {
+	if initializationParameters.PreCcvEvidenceMinHeight == 0 {
+		if initializationParameters.PreCcvClientId != "" {
+			return fmt.Errorf("pre-CCV client id (%s) cannot be set without a pre-CCV evidence min height", initializationParameters.PreCcvClientId)
+		}
+		return nil
+	}
+
+	if initializationParameters.PreCcvEvidenceMinHeight >= initializationParameters.InitialHeight.RevisionHeight {
+		return fmt.Errorf("pre-CCV evidence min height (%d) must be smaller than the initial height (%d)",
+			initializationParameters.PreCcvEvidenceMinHeight, initializationParameters.InitialHeight.RevisionHeight)
+	}
+
+	if err := host.ClientIdentifierValidator(initializationParameters.PreCcvClientId); err != nil {
+		return fmt.Errorf("invalid pre-CCV client id: %w", err)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -8,9 +10,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	cryptoutil "github.com/cosmos/interchain-security/v6/testutil/crypto"
 	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v6/x/ccv/types"
 )
 
 func TestValidateStringField(t *testing.T) {
@@ -130,6 +134,26 @@ func TestValidateConsumerMetadata(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid terms hash",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				TermsHash:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid terms hash",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				TermsHash:   "9f86d081884c7d659a2feaa0c55ad015",
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -142,10 +166,126 @@ func TestValidateConsumerMetadata(t *testing.T) {
 	}
 }
 
+func TestValidateEndpointInfo(t *testing.T) {
+	nodeId := "e5f9ab0a1bc2a0f4e4e5e9b1c7c8d1a2b3c4d5e6"
+	peer := nodeId + "@peer.consumer.io:26656"
+
+	tooManyPeers := []string{}
+	for i := 0; i <= types.MaxEndpointCount; i++ {
+		tooManyPeers = append(tooManyPeers, fmt.Sprintf("%s@peer-%d.consumer.io:26656", nodeId, i))
+	}
+
+	testCases := []struct {
+		name         string
+		endpointInfo types.EndpointInfo
+		valid        bool
+	}{
+		{
+			name:         "valid empty endpoints",
+			endpointInfo: types.EndpointInfo{},
+			valid:        true,
+		},
+		{
+			name: "valid",
+			endpointInfo: types.EndpointInfo{
+				PersistentPeers: []string{peer, nodeId + "@10.0.0.1:26656"},
+				Seeds:           []string{nodeId + "@[::1]:26656"},
+				RpcUrls:         []string{"https://rpc.consumer.io:443", "tcp://10.0.0.1:26657"},
+				GenesisUrl:      "https://consumer.io/genesis.json",
+			},
+			valid: true,
+		},
+		{
+			name:         "valid with max number of peers",
+			endpointInfo: types.EndpointInfo{PersistentPeers: tooManyPeers[:types.MaxEndpointCount]},
+			valid:        true,
+		},
+		{
+			name:         "invalid - too many peers",
+			endpointInfo: types.EndpointInfo{PersistentPeers: tooManyPeers},
+			valid:        false,
+		},
+		{
+			name:         "invalid - duplicate seeds",
+			endpointInfo: types.EndpointInfo{Seeds: []string{peer, peer}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - empty peer",
+			endpointInfo: types.EndpointInfo{PersistentPeers: []string{" "}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - peer without node id",
+			endpointInfo: types.EndpointInfo{PersistentPeers: []string{"peer.consumer.io:26656"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - peer with short node id",
+			endpointInfo: types.EndpointInfo{PersistentPeers: []string{"e5f9ab0a@peer.consumer.io:26656"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - seed without port",
+			endpointInfo: types.EndpointInfo{Seeds: []string{nodeId + "@peer.consumer.io"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - seed with invalid port",
+			endpointInfo: types.EndpointInfo{Seeds: []string{nodeId + "@peer.consumer.io:70000"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - relative RPC URL",
+			endpointInfo: types.EndpointInfo{RpcUrls: []string{"rpc.consumer.io:443"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - RPC URL with unsupported scheme",
+			endpointInfo: types.EndpointInfo{RpcUrls: []string{"ftp://rpc.consumer.io"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - too long RPC URL",
+			endpointInfo: types.EndpointInfo{RpcUrls: []string{"https://" + strings.Repeat("a", types.MaxEndpointLength) + ".io"}},
+			valid:        false,
+		},
+		{
+			name:         "invalid - genesis URL with websocket scheme",
+			endpointInfo: types.EndpointInfo{GenesisUrl: "wss://consumer.io/genesis.json"},
+			valid:        false,
+		},
+		{
+			name:         "invalid - genesis URL without host",
+			endpointInfo: types.EndpointInfo{GenesisUrl: "https:///genesis.json"},
+			valid:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateEndpointInfo(tc.endpointInfo)
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestValidateInitializationParameters(t *testing.T) {
 	now := time.Now().UTC()
 	coolStr := "Cosmos Hub is the best place to launch a chain. Interchain Security is awesome."
 	tooLongHash := []byte(coolStr)
+	consumerDenomMetadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "ucons", Exponent: 0},
+			{Denom: "cons", Exponent: 6},
+		},
+		Base:    "ucons",
+		Display: "cons",
+		Name:    "Consumer",
+		Symbol:  "CONS",
+	}
 
 	testCases := []struct {
 		name   string
@@ -220,6 +360,23 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			name: "invalid - spawn time after year 9999",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC),
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+			},
+			valid: false,
+		},
 		{
 			name: "invalid - zero duration",
 			params: types.ConsumerInitializationParameters{
@@ -322,6 +479,302 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid - consumer denom without metadata",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConsumerDenom:                     "ucons",
+			},
+			valid: true,
+		},
+		{
+			name: "valid - consumer denom with metadata",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConsumerDenom:                     "ucons",
+				ConsumerDenomMetadata:             &consumerDenomMetadata,
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - consumer denom does not follow SDK denom rules",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConsumerDenom:                     "u",
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - consumer denom metadata without denom",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConsumerDenomMetadata:             &consumerDenomMetadata,
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - consumer denom metadata for a different denom",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConsumerDenom:                     "uother",
+				ConsumerDenomMetadata:             &consumerDenomMetadata,
+			},
+			valid: false,
+		},
+		{
+			name: "valid - downtime window",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				SignedBlocksWindow:                10000,
+				MinSignedPerWindow:                "0.05",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - negative signed blocks window",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				SignedBlocksWindow:                -1,
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - min signed per window over 1",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				MinSignedPerWindow:                "1.5",
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - bad min signed per window",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				MinSignedPerWindow:                "notFrac",
+			},
+			valid: false,
+		},
+		{
+			name: "valid - denom redistribution fractions",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				DenomRedistributionFractions:      []ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}},
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - duplicate denom redistribution fraction",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				DenomRedistributionFractions: []ccvtypes.DenomRedistributionFraction{
+					{Denom: "untrn", Fraction: "1"},
+					{Denom: "untrn", Fraction: "0.5"},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - denom redistribution fraction over 1",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				DenomRedistributionFractions:      []ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1.5"}},
+			},
+			valid: false,
+		},
+		{
+			name: "valid - pre-CCV evidence enabled",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				PreCcvEvidenceMinHeight:           2,
+				PreCcvClientId:                    "07-tendermint-0",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - pre-CCV client id without pre-CCV evidence min height",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				PreCcvClientId:                    "07-tendermint-0",
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - pre-CCV evidence min height without pre-CCV client id",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				PreCcvEvidenceMinHeight:           2,
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - pre-CCV evidence min height not smaller than initial height",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				PreCcvEvidenceMinHeight:           4,
+				PreCcvClientId:                    "07-tendermint-0",
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -334,6 +787,118 @@ func TestValidateInitializationParameters(t *testing.T) {
 	}
 }
 
+func TestValidatePowerShapingParameters(t *testing.T) {
+	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
+	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
+	invalidConsAddr := "cosmosvalcons1nx7n5uh0ztxsynn4sje6ey"
+
+	tooLongList := make([]string, types.MaxValidatorCount+1)
+	for i := range tooLongList {
+		tooLongList[i] = consAddr1
+	}
+	gracePeriod := 24 * time.Hour
+	negativeGracePeriod := -time.Second
+
+	testCases := []struct {
+		name   string
+		params types.PowerShapingParameters
+		valid  bool
+	}{
+		{
+			name:   "valid - empty parameters",
+			params: types.PowerShapingParameters{},
+			valid:  true,
+		},
+		{
+			name: "valid - all parameters set",
+			params: types.PowerShapingParameters{
+				Top_N:              50,
+				ValidatorsPowerCap: 30,
+				ValidatorSetCap:    10,
+				Allowlist:          []string{consAddr1},
+				Denylist:           []string{consAddr2},
+				MinStake:           1000,
+				AllowInactiveVals:  true,
+			},
+			valid: true,
+		},
+		{
+			name:   "valid - Top N of 100",
+			params: types.PowerShapingParameters{Top_N: 100},
+			valid:  true,
+		},
+		{
+			name:   "invalid - Top N smaller than 50",
+			params: types.PowerShapingParameters{Top_N: 49},
+			valid:  false,
+		},
+		{
+			name:   "invalid - Top N larger than 100",
+			params: types.PowerShapingParameters{Top_N: 101},
+			valid:  false,
+		},
+		{
+			name:   "valid - validators power cap of 100",
+			params: types.PowerShapingParameters{ValidatorsPowerCap: 100},
+			valid:  true,
+		},
+		{
+			name:   "invalid - validators power cap larger than 100",
+			params: types.PowerShapingParameters{ValidatorsPowerCap: 101},
+			valid:  false,
+		},
+		{
+			name:   "valid - downtime grace period",
+			params: types.PowerShapingParameters{DowntimeGracePeriod: &gracePeriod},
+			valid:  true,
+		},
+		{
+			name:   "invalid - negative downtime grace period",
+			params: types.PowerShapingParameters{DowntimeGracePeriod: &negativeGracePeriod},
+			valid:  false,
+		},
+		{
+			name:   "valid - re-opt-in cooldown after slash",
+			params: types.PowerShapingParameters{ReOptInCooldownAfterSlash: &gracePeriod},
+			valid:  true,
+		},
+		{
+			name:   "invalid - negative re-opt-in cooldown after slash",
+			params: types.PowerShapingParameters{ReOptInCooldownAfterSlash: &negativeGracePeriod},
+			valid:  false,
+		},
+		{
+			name:   "invalid - malformed address in allowlist",
+			params: types.PowerShapingParameters{Allowlist: []string{consAddr1, invalidConsAddr}},
+			valid:  false,
+		},
+		{
+			name:   "invalid - malformed address in denylist",
+			params: types.PowerShapingParameters{Denylist: []string{invalidConsAddr}},
+			valid:  false,
+		},
+		{
+			name:   "invalid - allowlist too long",
+			params: types.PowerShapingParameters{Allowlist: tooLongList},
+			valid:  false,
+		},
+		{
+			name:   "invalid - denylist too long",
+			params: types.PowerShapingParameters{Denylist: tooLongList},
+			valid:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidatePowerShapingParameters(tc.params)
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestValidateConsAddressList(t *testing.T) {
 	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
 	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
@@ -424,54 +989,157 @@ func TestValidateByteSlice(t *testing.T) {
 	}
 }
 
+func TestValidateAllowedIcaMsgTypes(t *testing.T) {
+	tooMany := []string{}
+	for i := 0; i <= types.MaxAllowedIcaMsgTypeCount; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("/cosmos.test.v1.Msg%d", i))
+	}
+
+	testCases := []struct {
+		name        string
+		msgTypeUrls []string
+		valid       bool
+	}{
+		{
+			name:        "valid: empty",
+			msgTypeUrls: []string{},
+			valid:       true,
+		},
+		{
+			name:        "valid",
+			msgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.gov.v1.MsgVote"},
+			valid:       true,
+		},
+		{
+			name:        "invalid: too many message types",
+			msgTypeUrls: tooMany,
+			valid:       false,
+		},
+		{
+			name:        "invalid: empty message type",
+			msgTypeUrls: []string{""},
+			valid:       false,
+		},
+		{
+			name:        "invalid: missing leading slash",
+			msgTypeUrls: []string{"cosmos.gov.v1.MsgVote"},
+			valid:       false,
+		},
+		{
+			name:        "invalid: whitespace",
+			msgTypeUrls: []string{"/cosmos.gov.v1 MsgVote"},
+			valid:       false,
+		},
+		{
+			name:        "invalid: duplicate message type",
+			msgTypeUrls: []string{"/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1.MsgVote"},
+			valid:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateAllowedIcaMsgTypes(types.AllowedIcaMsgTypes{MsgTypeUrls: tc.msgTypeUrls})
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidAllowedIcaMsgTypes, tc.name)
+		}
+	}
+}
+
 func TestMsgCreateConsumerValidateBasic(t *testing.T) {
+	validInitializationParameters := types.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(1, 4),
+		GenesisHash:                       []byte{0x01},
+		BinaryHash:                        []byte{0x01},
+		SpawnTime:                         time.Now().UTC(),
+		UnbondingPeriod:                   time.Duration(100000000000),
+		CcvTimeoutPeriod:                  time.Duration(100000000000),
+		TransferTimeoutPeriod:             time.Duration(100000000000),
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10,
+		HistoricalEntries:                 10000,
+	}
+	zeroUnbondingPeriod := validInitializationParameters
+	zeroUnbondingPeriod.UnbondingPeriod = 0
+	wrongRevision := validInitializationParameters
+	wrongRevision.InitialHeight = clienttypes.NewHeight(0, 4)
+
 	testCases := []struct {
-		name                   string
-		chainId                string
-		powerShapingParameters *types.PowerShapingParameters
-		expPass                bool
+		name                     string
+		chainId                  string
+		initializationParameters *types.ConsumerInitializationParameters
+		powerShapingParameters   *types.PowerShapingParameters
+		expPass                  bool
 	}{
 		{
 			"empty chain id",
 			"",
+			nil, // no initialization parameters
 			nil, // no power-shaping parameters
 			false,
 		},
 		{
 			"empty chain id after trimming",
 			"   	",
+			nil, // no initialization parameters
 			nil, // no power-shaping parameters
 			false,
 		},
 		{
 			"neutron chain id that cannot be reused",
 			"neutron-1",
+			nil, // no initialization parameters
 			nil, // no power-shaping parameters
 			false,
 		},
 		{
 			"stride chain id that cannot be reused",
 			"stride-1",
+			nil, // no initialization parameters
 			nil, // no power-shaping parameters
 			false,
 		},
 		{
 			"valid chain id",
 			"somechain-1",
+			nil, // no initialization parameters
 			nil, // no power-shaping parameters
 			true,
 		},
 		{
 			"valid chain id and invalid power-shaping parameters",
 			"somechain-1",
+			nil,                                      // no initialization parameters
 			&types.PowerShapingParameters{Top_N: 51}, // TopN cannot be > 0 in MsgCreateConsumer
 			false,
 		},
+		{
+			"valid chain id and valid initialization parameters",
+			"somechain-1",
+			&validInitializationParameters,
+			nil, // no power-shaping parameters
+			true,
+		},
+		{
+			"valid chain id and invalid initialization parameters",
+			"somechain-1",
+			&zeroUnbondingPeriod,
+			nil, // no power-shaping parameters
+			false,
+		},
+		{
+			"initial height that does not match the revision number of the chain id",
+			"somechain-1",
+			&wrongRevision,
+			nil, // no power-shaping parameters
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
 		validConsumerMetadata := types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
-		msg, err := types.NewMsgCreateConsumer("submitter", tc.chainId, validConsumerMetadata, nil, tc.powerShapingParameters, nil)
+		msg, err := types.NewMsgCreateConsumer("submitter", tc.chainId, validConsumerMetadata, tc.initializationParameters, tc.powerShapingParameters, nil)
 		require.NoError(t, err)
 		err = msg.ValidateBasic()
 		if tc.expPass {
@@ -546,7 +1214,7 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 
 	for _, tc := range testCases {
 		// TODO (PERMISSIONLESS) add more tests
-		msg, _ := types.NewMsgUpdateConsumer("", "0", "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s", nil, nil, &tc.powerShapingParameters, nil)
+		msg, _ := types.NewMsgUpdateConsumer("", "0", "cosmos1p3ucd3ptpw902fluyjzhq3ffgq4ntddac9sa3s", nil, nil, &tc.powerShapingParameters, nil, "", nil, nil, nil, false, nil, nil, nil, nil)
 		err := msg.ValidateBasic()
 		if tc.expPass {
 			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
@@ -554,6 +1222,66 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
 		}
 	}
+
+	// the reward channel id must be a valid channel identifier, if provided
+	msg, _ := types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "channel-1", nil, nil, nil, false, nil, nil, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "invalid/channel", nil, nil, nil, false, nil, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	// the endpoint info must be valid, if provided
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", &types.EndpointInfo{}, nil, nil, false, nil, nil, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", &types.EndpointInfo{GenesisUrl: "genesis.json"}, nil, nil, false, nil, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	// the timeout periods must be positive, if provided
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil,
+		&types.ConsumerTimeoutPeriods{CcvTimeoutPeriod: time.Hour, TransferTimeoutPeriod: time.Minute}, nil, false, nil, nil, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil,
+		&types.ConsumerTimeoutPeriods{CcvTimeoutPeriod: time.Hour}, nil, false, nil, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil,
+		&types.ConsumerTimeoutPeriods{TransferTimeoutPeriod: time.Minute}, nil, false, nil, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	// the lifetime extension must be positive, if provided
+	lifetimeExtension := 24 * time.Hour
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil, nil, nil, false, &lifetimeExtension, nil, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	lifetimeExtension = 0
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil, nil, nil, false, &lifetimeExtension, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	// the upgrade notice must have a name, a height, a binary hash and a deadline, if provided
+	upgradeNotice := types.ConsumerUpgradeNotice{Name: "v2", Height: 1000, BinaryHash: "binary_hash", Deadline: time.Now()}
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil, nil, nil, false, nil, &upgradeNotice, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	for _, invalidNotice := range []types.ConsumerUpgradeNotice{
+		{Height: 1000, BinaryHash: "binary_hash", Deadline: time.Now()},
+		{Name: "v2", BinaryHash: "binary_hash", Deadline: time.Now()},
+		{Name: "v2", Height: 1000, Deadline: time.Now()},
+		{Name: "v2", Height: 1000, BinaryHash: strings.Repeat("a", types.MaxHashLength+1), Deadline: time.Now()},
+		{Name: "v2", Height: 1000, BinaryHash: "binary_hash"},
+	} {
+		msg, _ = types.NewMsgUpdateConsumer("", "0", "", nil, nil, nil, nil, "", nil, nil, nil, false, nil, &invalidNotice, nil, nil)
+		require.Error(t, msg.ValidateBasic())
+	}
+
+	// a Top N chain cannot have terms
+	metadataWithTerms := types.ConsumerMetadata{
+		Name:        "name",
+		Description: "description",
+		Metadata:    "metadata",
+		TermsHash:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", &metadataWithTerms, nil,
+		&types.PowerShapingParameters{Top_N: 0}, nil, "", nil, nil, nil, false, nil, nil, nil, nil)
+	require.NoError(t, msg.ValidateBasic())
+	msg, _ = types.NewMsgUpdateConsumer("", "0", "", &metadataWithTerms, nil,
+		&types.PowerShapingParameters{Top_N: 50}, nil, "", nil, nil, nil, false, nil, nil, nil, nil)
+	require.Error(t, msg.ValidateBasic())
 }
 
 func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
@@ -710,3 +1438,67 @@ func TestValidateInitialHeight(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgConsumerHardForkValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		consumerId    string
+		newChainId    string
+		initialHeight clienttypes.Height
+		expPass       bool
+	}{
+		{
+			name:          "valid",
+			consumerId:    "0",
+			newChainId:    "foo-2",
+			initialHeight: clienttypes.NewHeight(2, 1),
+			expPass:       true,
+		},
+		{
+			name:          "invalid: consumer id",
+			consumerId:    "foo",
+			newChainId:    "foo-2",
+			initialHeight: clienttypes.NewHeight(2, 1),
+			expPass:       false,
+		},
+		{
+			name:          "invalid: empty chain id",
+			consumerId:    "0",
+			newChainId:    "",
+			initialHeight: clienttypes.NewHeight(2, 1),
+			expPass:       false,
+		},
+		{
+			name:          "invalid: too long chain id",
+			consumerId:    "0",
+			newChainId:    strings.Repeat("a", 51),
+			initialHeight: clienttypes.NewHeight(0, 1),
+			expPass:       false,
+		},
+		{
+			name:          "invalid: zero initial height",
+			consumerId:    "0",
+			newChainId:    "foo-2",
+			initialHeight: clienttypes.NewHeight(0, 0),
+			expPass:       false,
+		},
+		{
+			name:          "invalid: initial height does not match the revision of the new chain id",
+			consumerId:    "0",
+			newChainId:    "foo-2",
+			initialHeight: clienttypes.NewHeight(1, 100),
+			expPass:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgConsumerHardFork("owner", tc.consumerId, tc.newChainId, tc.initialHeight)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
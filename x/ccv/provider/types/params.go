@@ -52,21 +52,97 @@ const (
 	// DefaultMaxProviderConsensusValidators is the default maximum number of validators that will
 	// be passed on from the staking module to the consensus engine on the provider.
 	DefaultMaxProviderConsensusValidators = 180
+
+	// DefaultMaxConsumerCleanupDeletionsPerBlock is the default maximum number of store entries
+	// of deleted consumer chains that are removed in a single block.
+	DefaultMaxConsumerCleanupDeletionsPerBlock = int64(1000)
+
+	// DefaultDormancyPeriod is the default period after which a consumer chain from which
+	// no packets have been received is marked as dormant. By default, dormancy is disabled.
+	DefaultDormancyPeriod = time.Duration(0)
+
+	// DefaultNumberOfEpochsToRetainConsumerValsets is the default number of epochs for which
+	// the validator sets of the consumer chains are retained, i.e., about three weeks of epochs
+	// with the default number of blocks per epoch.
+	DefaultNumberOfEpochsToRetainConsumerValsets = int64(504)
+
+	// DefaultUpgradeQuietPeriod is the default number of blocks before the height of a scheduled
+	// upgrade plan during which consumer lifecycle messages are rejected, i.e., about 10 minutes
+	// with 6-second blocks.
+	DefaultUpgradeQuietPeriod = int64(100)
+
+	// DefaultConsumerClientExpiryWarningFraction is the default fraction of the trusting period
+	// of a consumer client below which warning events are emitted, i.e., once less than a third
+	// of the trusting period remains.
+	DefaultConsumerClientExpiryWarningFraction = "0.33"
+
+	// DefaultMaxConsumerParticipation is the default maximal number of consumer chains whose validator sets
+	// a single provider validator may be included in simultaneously. By default, there is no cap.
+	DefaultMaxConsumerParticipation = int64(0)
+
+	// DefaultEmergencyOptOutSlashFraction is the default fraction of the stake of a validator
+	// that is slashed on an emergency opt out. By default, the validators are not slashed.
+	DefaultEmergencyOptOutSlashFraction = "0"
+
+	// DefaultEmergencyOptOutCooldown is the default period after an emergency opt out during which
+	// the validator cannot opt in to the consumer chain again, i.e., one week.
+	DefaultEmergencyOptOutCooldown = 7 * 24 * time.Hour
+
+	// DefaultSendUpgradeNotices is the default value of whether the upgrades of the provider chain
+	// are communicated to the consumer chains. By default, no provider upgrade notices are sent,
+	// since consumer chains that do not support them reject the VSC packets carrying them.
+	DefaultSendUpgradeNotices = false
+
+	// DefaultMaxClientCreationsPerBlock is the default maximal number of consumer clients
+	// created in a single block, i.e., of consumer chains whose launch is attempted in a block.
+	DefaultMaxClientCreationsPerBlock = int64(25)
+
+	// DefaultNumberOfEpochsToRetainEconomicSecurity is the default number of epochs for which
+	// the economic security records of the consumer chains are retained, i.e., about a month
+	// of epochs with the default number of blocks per epoch.
+	DefaultNumberOfEpochsToRetainEconomicSecurity = int64(720)
+
+	// DefaultAllowZeroKeyAssignmentNonce is the default value of whether a MsgAssignConsumerKey
+	// with a zero nonce is accepted as long as the key assignment nonce of the validator is zero.
+	// By default, the transition window is open, so that tooling that does not set the nonce keeps working.
+	DefaultAllowZeroKeyAssignmentNonce = true
+
+	// DefaultExcludeTokenizedSharesFromTopN is the default value of whether the tokenized shares
+	// of the validators are excluded from the power used to compute the Top N validators
+	DefaultExcludeTokenizedSharesFromTopN = false
 )
 
+// DefaultLifetimeReminderFractions are the default fractions of the lifetime of a consumer chain
+// with a maximum lifetime after which reminder events about its sunset are emitted
+var DefaultLifetimeReminderFractions = []string{"0.5", "0.9"}
+
 // Reflection based keys for params subspace
 // Legacy: usage of x/params for parameters is deprecated.
 // Use x/ccv/provider/keeper/params instead
 // [DEPRECATED]
 var (
-	KeyTemplateClient                        = []byte("TemplateClient")
-	KeyTrustingPeriodFraction                = []byte("TrustingPeriodFraction")
-	KeySlashMeterReplenishPeriod             = []byte("SlashMeterReplenishPeriod")
-	KeySlashMeterReplenishFraction           = []byte("SlashMeterReplenishFraction")
-	KeyConsumerRewardDenomRegistrationFee    = []byte("ConsumerRewardDenomRegistrationFee")
-	KeyBlocksPerEpoch                        = []byte("BlocksPerEpoch")
-	KeyNumberOfEpochsToStartReceivingRewards = []byte("NumberOfEpochsToStartReceivingRewards")
-	KeyMaxProviderConsensusValidators        = []byte("MaxProviderConsensusValidators")
+	KeyTemplateClient                         = []byte("TemplateClient")
+	KeyTrustingPeriodFraction                 = []byte("TrustingPeriodFraction")
+	KeySlashMeterReplenishPeriod              = []byte("SlashMeterReplenishPeriod")
+	KeySlashMeterReplenishFraction            = []byte("SlashMeterReplenishFraction")
+	KeyConsumerRewardDenomRegistrationFee     = []byte("ConsumerRewardDenomRegistrationFee")
+	KeyBlocksPerEpoch                         = []byte("BlocksPerEpoch")
+	KeyNumberOfEpochsToStartReceivingRewards  = []byte("NumberOfEpochsToStartReceivingRewards")
+	KeyMaxProviderConsensusValidators         = []byte("MaxProviderConsensusValidators")
+	KeyMaxConsumerCleanupDeletionsPerBlock    = []byte("MaxConsumerCleanupDeletionsPerBlock")
+	KeyDormancyPeriod                         = []byte("DormancyPeriod")
+	KeyNumberOfEpochsToRetainConsumerValsets  = []byte("NumberOfEpochsToRetainConsumerValsets")
+	KeyLifetimeReminderFractions              = []byte("LifetimeReminderFractions")
+	KeyUpgradeQuietPeriod                     = []byte("UpgradeQuietPeriod")
+	KeyConsumerClientExpiryWarningFraction    = []byte("ConsumerClientExpiryWarningFraction")
+	KeyMaxConsumerParticipation               = []byte("MaxConsumerParticipation")
+	KeyEmergencyOptOutSlashFraction           = []byte("EmergencyOptOutSlashFraction")
+	KeyEmergencyOptOutCooldown                = []byte("EmergencyOptOutCooldown")
+	KeySendUpgradeNotices                     = []byte("SendUpgradeNotices")
+	KeyMaxClientCreationsPerBlock             = []byte("MaxClientCreationsPerBlock")
+	KeyNumberOfEpochsToRetainEconomicSecurity = []byte("NumberOfEpochsToRetainEconomicSecurity")
+	KeyAllowZeroKeyAssignmentNonce            = []byte("AllowZeroKeyAssignmentNonce")
+	KeyExcludeTokenizedSharesFromTopN         = []byte("ExcludeTokenizedSharesFromTopN")
 )
 
 // ParamKeyTable returns a key table with the necessary registered provider params
@@ -85,17 +161,45 @@ func NewParams(
 	blocksPerEpoch int64,
 	numberOfEpochsToStartReceivingRewards int64,
 	maxProviderConsensusValidators int64,
+	maxConsumerCleanupDeletionsPerBlock int64,
+	dormancyPeriod time.Duration,
+	numberOfEpochsToRetainConsumerValsets int64,
+	lifetimeReminderFractions []string,
+	upgradeQuietPeriod int64,
+	consumerClientExpiryWarningFraction string,
+	maxConsumerParticipation int64,
+	emergencyOptOutSlashFraction string,
+	emergencyOptOutCooldown time.Duration,
+	sendUpgradeNotices bool,
+	maxClientCreationsPerBlock int64,
+	numberOfEpochsToRetainEconomicSecurity int64,
+	allowZeroKeyAssignmentNonce bool,
+	excludeTokenizedSharesFromTopN bool,
 ) Params {
 	return Params{
-		TemplateClient:                        cs,
-		TrustingPeriodFraction:                trustingPeriodFraction,
-		CcvTimeoutPeriod:                      ccvTimeoutPeriod,
-		SlashMeterReplenishPeriod:             slashMeterReplenishPeriod,
-		SlashMeterReplenishFraction:           slashMeterReplenishFraction,
-		ConsumerRewardDenomRegistrationFee:    consumerRewardDenomRegistrationFee,
-		BlocksPerEpoch:                        blocksPerEpoch,
-		NumberOfEpochsToStartReceivingRewards: numberOfEpochsToStartReceivingRewards,
-		MaxProviderConsensusValidators:        maxProviderConsensusValidators,
+		TemplateClient:                         cs,
+		TrustingPeriodFraction:                 trustingPeriodFraction,
+		CcvTimeoutPeriod:                       ccvTimeoutPeriod,
+		SlashMeterReplenishPeriod:              slashMeterReplenishPeriod,
+		SlashMeterReplenishFraction:            slashMeterReplenishFraction,
+		ConsumerRewardDenomRegistrationFee:     consumerRewardDenomRegistrationFee,
+		BlocksPerEpoch:                         blocksPerEpoch,
+		NumberOfEpochsToStartReceivingRewards:  numberOfEpochsToStartReceivingRewards,
+		MaxProviderConsensusValidators:         maxProviderConsensusValidators,
+		MaxConsumerCleanupDeletionsPerBlock:    maxConsumerCleanupDeletionsPerBlock,
+		DormancyPeriod:                         dormancyPeriod,
+		NumberOfEpochsToRetainConsumerValsets:  numberOfEpochsToRetainConsumerValsets,
+		LifetimeReminderFractions:              lifetimeReminderFractions,
+		UpgradeQuietPeriod:                     upgradeQuietPeriod,
+		ConsumerClientExpiryWarningFraction:    consumerClientExpiryWarningFraction,
+		MaxConsumerParticipation:               maxConsumerParticipation,
+		EmergencyOptOutSlashFraction:           emergencyOptOutSlashFraction,
+		EmergencyOptOutCooldown:                emergencyOptOutCooldown,
+		SendUpgradeNotices:                     sendUpgradeNotices,
+		MaxClientCreationsPerBlock:             maxClientCreationsPerBlock,
+		NumberOfEpochsToRetainEconomicSecurity: numberOfEpochsToRetainEconomicSecurity,
+		AllowZeroKeyAssignmentNonce:            allowZeroKeyAssignmentNonce,
+		ExcludeTokenizedSharesFromTopN:         excludeTokenizedSharesFromTopN,
 	}
 }
 
@@ -127,6 +231,20 @@ func DefaultParams() Params {
 		DefaultBlocksPerEpoch,
 		DefaultNumberOfEpochsToStartReceivingRewards,
 		DefaultMaxProviderConsensusValidators,
+		DefaultMaxConsumerCleanupDeletionsPerBlock,
+		DefaultDormancyPeriod,
+		DefaultNumberOfEpochsToRetainConsumerValsets,
+		DefaultLifetimeReminderFractions,
+		DefaultUpgradeQuietPeriod,
+		DefaultConsumerClientExpiryWarningFraction,
+		DefaultMaxConsumerParticipation,
+		DefaultEmergencyOptOutSlashFraction,
+		DefaultEmergencyOptOutCooldown,
+		DefaultSendUpgradeNotices,
+		DefaultMaxClientCreationsPerBlock,
+		DefaultNumberOfEpochsToRetainEconomicSecurity,
+		DefaultAllowZeroKeyAssignmentNonce,
+		DefaultExcludeTokenizedSharesFromTopN,
 	)
 }
 
@@ -163,6 +281,39 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxProviderConsensusValidators); err != nil {
 		return fmt.Errorf("max provider consensus validators is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidatePositiveInt64(p.MaxConsumerCleanupDeletionsPerBlock); err != nil {
+		return fmt.Errorf("max consumer cleanup deletions per block is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.DormancyPeriod); err != nil {
+		return fmt.Errorf("dormancy period is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.NumberOfEpochsToRetainConsumerValsets); err != nil {
+		return fmt.Errorf("number of epochs to retain consumer validator sets is invalid: %s", err)
+	}
+	if err := ValidateLifetimeReminderFractions(p.LifetimeReminderFractions); err != nil {
+		return fmt.Errorf("lifetime reminder fractions are invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.UpgradeQuietPeriod); err != nil {
+		return fmt.Errorf("upgrade quiet period is invalid: %s", err)
+	}
+	if err := ValidateConsumerClientExpiryWarningFraction(p.ConsumerClientExpiryWarningFraction); err != nil {
+		return fmt.Errorf("consumer client expiry warning fraction is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxConsumerParticipation); err != nil {
+		return fmt.Errorf("max consumer participation is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.EmergencyOptOutSlashFraction); err != nil {
+		return fmt.Errorf("emergency opt-out slash fraction is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.EmergencyOptOutCooldown); err != nil {
+		return fmt.Errorf("emergency opt-out cooldown is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxClientCreationsPerBlock); err != nil {
+		return fmt.Errorf("max client creations per block is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.NumberOfEpochsToRetainEconomicSecurity); err != nil {
+		return fmt.Errorf("number of epochs to retain economic security is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -178,9 +329,54 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBlocksPerEpoch, p.BlocksPerEpoch, ccvtypes.ValidatePositiveInt64),
 		paramtypes.NewParamSetPair(KeyNumberOfEpochsToStartReceivingRewards, p.NumberOfEpochsToStartReceivingRewards, ccvtypes.ValidatePositiveInt64),
 		paramtypes.NewParamSetPair(KeyMaxProviderConsensusValidators, p.MaxProviderConsensusValidators, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyMaxConsumerCleanupDeletionsPerBlock, p.MaxConsumerCleanupDeletionsPerBlock, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyDormancyPeriod, p.DormancyPeriod, ccvtypes.ValidateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyNumberOfEpochsToRetainConsumerValsets, p.NumberOfEpochsToRetainConsumerValsets, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyLifetimeReminderFractions, p.LifetimeReminderFractions, ValidateLifetimeReminderFractions),
+		paramtypes.NewParamSetPair(KeyUpgradeQuietPeriod, p.UpgradeQuietPeriod, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyConsumerClientExpiryWarningFraction, p.ConsumerClientExpiryWarningFraction, ValidateConsumerClientExpiryWarningFraction),
+		paramtypes.NewParamSetPair(KeyMaxConsumerParticipation, p.MaxConsumerParticipation, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyEmergencyOptOutSlashFraction, p.EmergencyOptOutSlashFraction, ccvtypes.ValidateStringFraction),
+		paramtypes.NewParamSetPair(KeyEmergencyOptOutCooldown, p.EmergencyOptOutCooldown, ccvtypes.ValidateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeySendUpgradeNotices, p.SendUpgradeNotices, ccvtypes.ValidateBool),
+		paramtypes.NewParamSetPair(KeyMaxClientCreationsPerBlock, p.MaxClientCreationsPerBlock, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyNumberOfEpochsToRetainEconomicSecurity, p.NumberOfEpochsToRetainEconomicSecurity, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyAllowZeroKeyAssignmentNonce, p.AllowZeroKeyAssignmentNonce, ccvtypes.ValidateBool),
+		paramtypes.NewParamSetPair(KeyExcludeTokenizedSharesFromTopN, p.ExcludeTokenizedSharesFromTopN, ccvtypes.ValidateBool),
 	}
 }
 
+// ValidateConsumerClientExpiryWarningFraction validates the fraction of the trusting period
+// of the consumer clients below which warning events are emitted. Accept empty string as valid,
+// since it disables the warnings.
+func ValidateConsumerClientExpiryWarningFraction(i interface{}) error {
+	if i == "" {
+		return nil
+	}
+	return ccvtypes.ValidateStringFraction(i)
+}
+
+// ValidateLifetimeReminderFractions validates that the lifetime reminder fractions
+// are strictly increasing fractions in (0, 1)
+func ValidateLifetimeReminderFractions(i interface{}) error {
+	fractions, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T, expected: %T", i, []string{})
+	}
+	previous := math.LegacyZeroDec()
+	for _, fraction := range fractions {
+		dec, err := math.LegacyNewDecFromStr(fraction)
+		if err != nil {
+			return fmt.Errorf("invalid fraction %s: %s", fraction, err)
+		}
+		if !dec.GT(previous) || !dec.LT(math.LegacyOneDec()) {
+			return fmt.Errorf("fraction %s must be in (%s, 1)", fraction, previous)
+		}
+		previous = dec
+	}
+	return nil
+}
+
 func ValidateTemplateClient(i interface{}) error {
 	cs, ok := i.(ibctmtypes.ClientState)
 	if !ok {
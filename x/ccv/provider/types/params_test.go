@@ -26,37 +26,125 @@ func TestValidateParams(t *testing.T) {
 		{"custom valid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), true},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 24*time.Hour, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), true},
+		{"custom valid params with provider upgrade notices", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 24*time.Hour, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, true, 25, 720, true, false), true},
 		{"custom invalid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				0, clienttypes.Height{}, nil, []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"blank client", types.NewParams(&ibctmtypes.ClientState{},
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
-		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		// Check if "0.00" is valid or if a zero dec TrustFraction needs to return an error
 		{"0 trusting period fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), true},
+			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), true},
 		{"0 ccv timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"0 slash meter replenish period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"slash meter replenish fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"invalid consumer reward denom registration fee denom", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"invalid consumer reward denom registration fee amount", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
 		{"invalid number of epochs to start receiving rewards", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180, 1000, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"invalid max consumer cleanup deletions per block", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 0, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"invalid dormancy period", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, -time.Hour, 504, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"invalid number of epochs to retain consumer valsets", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, -1, []string{"0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"non-increasing lifetime reminder fractions", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"0.5", "0.5"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"lifetime reminder fraction of 1", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, []string{"1"}, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"no lifetime reminder fractions", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, 25, 720, true, false), true},
+		{"negative upgrade quiet period", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, -1, "0.33", 0, "0", 0, false, 25, 720, true, false), false},
+		{"disabled upgrade quiet period", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 0, "0.33", 0, "0", 0, false, 25, 720, true, false), true},
+		{"consumer client expiry warning fraction over 1", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "1.5", 0, "0", 0, false, 25, 720, true, false), false},
+		{"disabled consumer client expiry warnings", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "", 0, "0", 0, false, 25, 720, true, false), true},
+		{"negative max consumer participation", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", -1, "0", 0, false, 25, 720, true, false), false},
+		{"capped max consumer participation", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 3, "0", 0, false, 25, 720, true, false), true},
+		{"emergency opt-out slash fraction over 1", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "1.5", 0, false, 25, 720, true, false), false},
+		{"invalid emergency opt-out slash fraction", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "", 0, false, 25, 720, true, false), false},
+		{"negative emergency opt-out cooldown", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", -time.Hour, false, 25, 720, true, false), false},
+		{"emergency opt-out penalty", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0.01", 7*24*time.Hour, false, 25, 720, true, false), true},
+		{"negative max client creations per block", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, -1, 720, true, false), false},
+		{"disabled max client creations per block", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, 0, 720, true, false), true},
+		{"negative number of epochs to retain economic security", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, 25, -1, true, false), false},
+		{"disabled economic security records", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, 25, 0, true, false), true},
+		{"key assignment nonces always required", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 1000, 0, 504, nil, 100, "0.33", 0, "0", 0, false, 25, 720, false, false), true},
 	}
 
 	for _, tc := range testCases {
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	types1 "cosmossdk.io/x/evidence/types"
 	fmt "fmt"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -11,6 +12,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types4 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -84,6 +86,219 @@ func (ConsumerPhase) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{0}
 }
 
+// ConsumerCommissionRateSource defines the source of the commission rate that
+// a validator charges on a consumer chain
+type ConsumerCommissionRateSource int32
+
+const (
+	// UNSPECIFIED defines an empty source.
+	CONSUMER_COMMISSION_RATE_SOURCE_UNSPECIFIED ConsumerCommissionRateSource = 0
+	// INHERITED defines that the validator did not set a commission rate for the consumer chain
+	// and hence the commission rate of the validator on the provider chain is used.
+	CONSUMER_COMMISSION_RATE_SOURCE_INHERITED ConsumerCommissionRateSource = 1
+	// EXPLICIT defines that the validator set a commission rate for the consumer chain
+	// through MsgSetConsumerCommissionRate.
+	CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT ConsumerCommissionRateSource = 2
+)
+
+var ConsumerCommissionRateSource_name = map[int32]string{
+	0: "CONSUMER_COMMISSION_RATE_SOURCE_UNSPECIFIED",
+	1: "CONSUMER_COMMISSION_RATE_SOURCE_INHERITED",
+	2: "CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT",
+}
+
+var ConsumerCommissionRateSource_value = map[string]int32{
+	"CONSUMER_COMMISSION_RATE_SOURCE_UNSPECIFIED": 0,
+	"CONSUMER_COMMISSION_RATE_SOURCE_INHERITED":   1,
+	"CONSUMER_COMMISSION_RATE_SOURCE_EXPLICIT":    2,
+}
+
+func (x ConsumerCommissionRateSource) String() string {
+	return proto.EnumName(ConsumerCommissionRateSource_name, int32(x))
+}
+
+func (ConsumerCommissionRateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{1}
+}
+
+// RewardAttributionDecision defines the decision of the provider on an incoming
+// transfer to the consumer rewards pool
+type RewardAttributionDecision int32
+
+const (
+	// UNSPECIFIED defines an empty decision.
+	REWARD_ATTRIBUTION_DECISION_UNSPECIFIED RewardAttributionDecision = 0
+	// CREDITED defines that the rewards were credited to the consumer chain,
+	// i.e., they are distributed to the validators of the consumer chain.
+	REWARD_ATTRIBUTION_DECISION_CREDITED RewardAttributionDecision = 1
+	// MALFORMED_MEMO defines that the memo of the transfer could not be parsed and
+	// the channel of the transfer does not belong to a consumer chain.
+	REWARD_ATTRIBUTION_DECISION_MALFORMED_MEMO RewardAttributionDecision = 2
+	// UNKNOWN_CONSUMER defines that the transfer could not be attributed to a known consumer chain.
+	REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER RewardAttributionDecision = 3
+	// DENOM_NOT_ALLOWLISTED defines that the rewards were recorded for the consumer chain,
+	// but their denom is not allowlisted, i.e., they are not distributed unless the denom is allowlisted.
+	REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED RewardAttributionDecision = 4
+	// DIVERTED defines that the rewards were diverted to the community pool, since
+	// they were not received on the reward channel of the consumer chain.
+	REWARD_ATTRIBUTION_DECISION_DIVERTED RewardAttributionDecision = 5
+	// FAILED defines that the rewards could not be recorded for the consumer chain.
+	REWARD_ATTRIBUTION_DECISION_FAILED RewardAttributionDecision = 6
+)
+
+var RewardAttributionDecision_name = map[int32]string{
+	0: "REWARD_ATTRIBUTION_DECISION_UNSPECIFIED",
+	1: "REWARD_ATTRIBUTION_DECISION_CREDITED",
+	2: "REWARD_ATTRIBUTION_DECISION_MALFORMED_MEMO",
+	3: "REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER",
+	4: "REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED",
+	5: "REWARD_ATTRIBUTION_DECISION_DIVERTED",
+	6: "REWARD_ATTRIBUTION_DECISION_FAILED",
+}
+
+var RewardAttributionDecision_value = map[string]int32{
+	"REWARD_ATTRIBUTION_DECISION_UNSPECIFIED":           0,
+	"REWARD_ATTRIBUTION_DECISION_CREDITED":              1,
+	"REWARD_ATTRIBUTION_DECISION_MALFORMED_MEMO":        2,
+	"REWARD_ATTRIBUTION_DECISION_UNKNOWN_CONSUMER":      3,
+	"REWARD_ATTRIBUTION_DECISION_DENOM_NOT_ALLOWLISTED": 4,
+	"REWARD_ATTRIBUTION_DECISION_DIVERTED":              5,
+	"REWARD_ATTRIBUTION_DECISION_FAILED":                6,
+}
+
+func (x RewardAttributionDecision) String() string {
+	return proto.EnumName(RewardAttributionDecision_name, int32(x))
+}
+
+func (RewardAttributionDecision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{2}
+}
+
+// ConsumerClientStatus defines the status of the client to a consumer chain
+// with respect to its trusting period
+type ConsumerClientStatus int32
+
+const (
+	// UNSPECIFIED defines an empty status.
+	CONSUMER_CLIENT_STATUS_UNSPECIFIED ConsumerClientStatus = 0
+	// ACTIVE defines that the client is not about to expire.
+	CONSUMER_CLIENT_STATUS_ACTIVE ConsumerClientStatus = 1
+	// EXPIRING defines that less than `consumer_client_expiry_warning_fraction`
+	// of the trusting period of the client remains.
+	CONSUMER_CLIENT_STATUS_EXPIRING ConsumerClientStatus = 2
+	// EXPIRED defines that the trusting period of the client elapsed
+	// since the timestamp of its latest consensus state.
+	CONSUMER_CLIENT_STATUS_EXPIRED ConsumerClientStatus = 3
+)
+
+var ConsumerClientStatus_name = map[int32]string{
+	0: "CONSUMER_CLIENT_STATUS_UNSPECIFIED",
+	1: "CONSUMER_CLIENT_STATUS_ACTIVE",
+	2: "CONSUMER_CLIENT_STATUS_EXPIRING",
+	3: "CONSUMER_CLIENT_STATUS_EXPIRED",
+}
+
+var ConsumerClientStatus_value = map[string]int32{
+	"CONSUMER_CLIENT_STATUS_UNSPECIFIED": 0,
+	"CONSUMER_CLIENT_STATUS_ACTIVE":      1,
+	"CONSUMER_CLIENT_STATUS_EXPIRING":    2,
+	"CONSUMER_CLIENT_STATUS_EXPIRED":     3,
+}
+
+func (x ConsumerClientStatus) String() string {
+	return proto.EnumName(ConsumerClientStatus_name, int32(x))
+}
+
+func (ConsumerClientStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{3}
+}
+
+// ConsumerRemovalInitiator defines who removed a consumer chain
+type ConsumerRemovalInitiator int32
+
+const (
+	// UNSPECIFIED defines that the consumer chain was not removed with a MsgRemoveConsumer
+	// (e.g., it was stopped since its maximum lifetime elapsed).
+	CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED ConsumerRemovalInitiator = 0
+	// OWNER defines that the consumer chain was removed by its owner.
+	CONSUMER_REMOVAL_INITIATOR_OWNER ConsumerRemovalInitiator = 1
+	// GOVERNANCE defines that the consumer chain was removed by the governance authority,
+	// either as the owner of the chain (e.g., of a Top N chain) or regardless of its owner
+	// (e.g., of a permissionless chain that violates the provider policy).
+	CONSUMER_REMOVAL_INITIATOR_GOVERNANCE ConsumerRemovalInitiator = 2
+)
+
+var ConsumerRemovalInitiator_name = map[int32]string{
+	0: "CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED",
+	1: "CONSUMER_REMOVAL_INITIATOR_OWNER",
+	2: "CONSUMER_REMOVAL_INITIATOR_GOVERNANCE",
+}
+
+var ConsumerRemovalInitiator_value = map[string]int32{
+	"CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED": 0,
+	"CONSUMER_REMOVAL_INITIATOR_OWNER":       1,
+	"CONSUMER_REMOVAL_INITIATOR_GOVERNANCE":  2,
+}
+
+func (x ConsumerRemovalInitiator) String() string {
+	return proto.EnumName(ConsumerRemovalInitiator_name, int32(x))
+}
+
+func (ConsumerRemovalInitiator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{4}
+}
+
+// ConsumerRemovalReason defines why a consumer chain was stopped or, if it was not launched yet,
+// removed or returned to the registered phase
+type ConsumerRemovalReason int32
+
+const (
+	// UNSPECIFIED defines that no reason was recorded (e.g., the consumer chain was not stopped).
+	CONSUMER_REMOVAL_REASON_UNSPECIFIED ConsumerRemovalReason = 0
+	// OWNER_REQUEST defines that the consumer chain was removed by its owner with a MsgRemoveConsumer.
+	CONSUMER_REMOVAL_REASON_OWNER_REQUEST ConsumerRemovalReason = 1
+	// GOVERNANCE defines that the consumer chain was removed by the governance authority with a MsgRemoveConsumer.
+	CONSUMER_REMOVAL_REASON_GOVERNANCE ConsumerRemovalReason = 2
+	// CHANNEL_TIMEOUT defines that the consumer chain was stopped since a packet sent on its CCV channel timed out.
+	CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT ConsumerRemovalReason = 3
+	// LIFETIME_EXPIRED defines that the consumer chain was stopped since its maximum lifetime elapsed.
+	CONSUMER_REMOVAL_REASON_LIFETIME_EXPIRED ConsumerRemovalReason = 4
+	// LAUNCH_FAILED defines that the consumer chain could not launch at its spawn time
+	// and was returned to the registered phase.
+	CONSUMER_REMOVAL_REASON_LAUNCH_FAILED ConsumerRemovalReason = 5
+	// CHANNEL_ERROR defines that the consumer chain was stopped since a packet could not be sent on its CCV channel
+	// or since the consumer chain acknowledged a packet with an error.
+	CONSUMER_REMOVAL_REASON_CHANNEL_ERROR ConsumerRemovalReason = 6
+)
+
+var ConsumerRemovalReason_name = map[int32]string{
+	0: "CONSUMER_REMOVAL_REASON_UNSPECIFIED",
+	1: "CONSUMER_REMOVAL_REASON_OWNER_REQUEST",
+	2: "CONSUMER_REMOVAL_REASON_GOVERNANCE",
+	3: "CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT",
+	4: "CONSUMER_REMOVAL_REASON_LIFETIME_EXPIRED",
+	5: "CONSUMER_REMOVAL_REASON_LAUNCH_FAILED",
+	6: "CONSUMER_REMOVAL_REASON_CHANNEL_ERROR",
+}
+
+var ConsumerRemovalReason_value = map[string]int32{
+	"CONSUMER_REMOVAL_REASON_UNSPECIFIED":      0,
+	"CONSUMER_REMOVAL_REASON_OWNER_REQUEST":    1,
+	"CONSUMER_REMOVAL_REASON_GOVERNANCE":       2,
+	"CONSUMER_REMOVAL_REASON_CHANNEL_TIMEOUT":  3,
+	"CONSUMER_REMOVAL_REASON_LIFETIME_EXPIRED": 4,
+	"CONSUMER_REMOVAL_REASON_LAUNCH_FAILED":    5,
+	"CONSUMER_REMOVAL_REASON_CHANNEL_ERROR":    6,
+}
+
+func (x ConsumerRemovalReason) String() string {
+	return proto.EnumName(ConsumerRemovalReason_name, int32(x))
+}
+
+func (ConsumerRemovalReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{5}
+}
+
 // WARNING: This message is deprecated in favor of `MsgCreateConsumer`.
 // ConsumerAdditionProposal is a governance proposal on the provider chain to
 // spawn a new consumer chain. If it passes, then all validators on the provider
@@ -672,6 +887,62 @@ type Params struct {
 	// The maximal number of validators that will be passed
 	// to the consensus engine on the provider.
 	MaxProviderConsensusValidators int64 `protobuf:"varint,12,opt,name=max_provider_consensus_validators,json=maxProviderConsensusValidators,proto3" json:"max_provider_consensus_validators,omitempty"`
+	// The maximal number of store entries of deleted consumer chains
+	// that are removed in a single block.
+	MaxConsumerCleanupDeletionsPerBlock int64 `protobuf:"varint,13,opt,name=max_consumer_cleanup_deletions_per_block,json=maxConsumerCleanupDeletionsPerBlock,proto3" json:"max_consumer_cleanup_deletions_per_block,omitempty"`
+	// The period after which a launched consumer chain from which no packets
+	// (i.e., VSC acknowledgements, slash packets, or reward transfers) have been
+	// received is marked as dormant. Setting it to zero disables dormancy.
+	DormancyPeriod time.Duration `protobuf:"bytes,14,opt,name=dormancy_period,json=dormancyPeriod,proto3,stdduration" json:"dormancy_period"`
+	// The number of epochs for which the validator sets of the consumer chains are retained,
+	// e.g., to investigate infractions. Setting it to zero disables the retention.
+	NumberOfEpochsToRetainConsumerValsets int64 `protobuf:"varint,15,opt,name=number_of_epochs_to_retain_consumer_valsets,json=numberOfEpochsToRetainConsumerValsets,proto3" json:"number_of_epochs_to_retain_consumer_valsets,omitempty"`
+	// The fractions of the lifetime of a consumer chain with a maximum lifetime after which
+	// reminder events about its sunset are emitted, in increasing order (e.g., ["0.5", "0.9"]).
+	LifetimeReminderFractions []string `protobuf:"bytes,16,rep,name=lifetime_reminder_fractions,json=lifetimeReminderFractions,proto3" json:"lifetime_reminder_fractions,omitempty"`
+	// The number of blocks before the height of a scheduled upgrade plan during which
+	// consumer lifecycle messages (e.g., MsgCreateConsumer) are rejected.
+	// Setting it to zero disables the quiet period.
+	UpgradeQuietPeriod int64 `protobuf:"varint,17,opt,name=upgrade_quiet_period,json=upgradeQuietPeriod,proto3" json:"upgrade_quiet_period,omitempty"`
+	// The fraction of the trusting period of a consumer client below which (i.e., once less
+	// than this fraction of the trusting period remains) warning events are emitted.
+	// Setting it to an empty string disables the warnings.
+	ConsumerClientExpiryWarningFraction string `protobuf:"bytes,18,opt,name=consumer_client_expiry_warning_fraction,json=consumerClientExpiryWarningFraction,proto3" json:"consumer_client_expiry_warning_fraction,omitempty"`
+	// The maximal number of consumer chains whose validator sets a single provider validator
+	// may be included in simultaneously. A validator opted in to more consumer chains is only
+	// included in the consumer chains it opted in to first. Setting it to zero disables the cap.
+	MaxConsumerParticipation int64 `protobuf:"varint,19,opt,name=max_consumer_participation,json=maxConsumerParticipation,proto3" json:"max_consumer_participation,omitempty"`
+	// The fraction of the stake of a validator that is slashed when the validator
+	// opts out of a consumer chain through an emergency opt out (see MsgEmergencyOptOut).
+	// Setting it to zero disables the slashing.
+	EmergencyOptOutSlashFraction string `protobuf:"bytes,20,opt,name=emergency_opt_out_slash_fraction,json=emergencyOptOutSlashFraction,proto3" json:"emergency_opt_out_slash_fraction,omitempty"`
+	// The period after an emergency opt out (see MsgEmergencyOptOut) during which the
+	// validator cannot opt in to the consumer chain again, neither voluntarily nor
+	// automatically. Setting it to zero disables the cooldown.
+	EmergencyOptOutCooldown time.Duration `protobuf:"bytes,21,opt,name=emergency_opt_out_cooldown,json=emergencyOptOutCooldown,proto3,stdduration" json:"emergency_opt_out_cooldown"`
+	// Whether the upgrades of the provider chain scheduled through the x/upgrade module
+	// are communicated to the consumer chains in VSC packets (see ProviderUpgradeNotice).
+	// Note that consumer chains that do not support provider upgrade notices reject
+	// such VSC packets, i.e., it should only be enabled once all consumer chains do.
+	SendUpgradeNotices bool `protobuf:"varint,22,opt,name=send_upgrade_notices,json=sendUpgradeNotices,proto3" json:"send_upgrade_notices,omitempty"`
+	// The maximal number of consumer clients created in a single block, i.e., the maximal
+	// number of consumer chains whose launch is attempted in a block. The launches beyond
+	// the cap are deferred to the next block. Setting it to zero disables the cap.
+	MaxClientCreationsPerBlock int64 `protobuf:"varint,23,opt,name=max_client_creations_per_block,json=maxClientCreationsPerBlock,proto3" json:"max_client_creations_per_block,omitempty"`
+	// The number of epochs for which the economic security records of the consumer chains
+	// (i.e., the total power and tokens backing their validator sets at every epoch) are retained.
+	// Setting it to zero disables the records.
+	NumberOfEpochsToRetainEconomicSecurity int64 `protobuf:"varint,24,opt,name=number_of_epochs_to_retain_economic_security,json=numberOfEpochsToRetainEconomicSecurity,proto3" json:"number_of_epochs_to_retain_economic_security,omitempty"`
+	// Whether a MsgAssignConsumerKey with a zero nonce is accepted as long as the
+	// key assignment nonce of the validator on the consumer chain is zero. It enables a
+	// transition window during which tooling that does not set the nonce keeps working.
+	AllowZeroKeyAssignmentNonce bool `protobuf:"varint,25,opt,name=allow_zero_key_assignment_nonce,json=allowZeroKeyAssignmentNonce,proto3" json:"allow_zero_key_assignment_nonce,omitempty"`
+	// Whether the tokenized shares of the validators (e.g., the shares tokenized through
+	// a liquid staking module) are excluded from the power used to compute the Top N
+	// validators of the consumer chains, so that the Top N chains are not secured
+	// predominantly by liquid-staked power. It requires the app to wire a staking keeper
+	// that supports tokenized shares; otherwise, no shares are excluded.
+	ExcludeTokenizedSharesFromTopN bool `protobuf:"varint,26,opt,name=exclude_tokenized_shares_from_top_n,json=excludeTokenizedSharesFromTopN,proto3" json:"exclude_tokenized_shares_from_top_n,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -770,6 +1041,104 @@ func (m *Params) GetMaxProviderConsensusValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetMaxConsumerCleanupDeletionsPerBlock() int64 {
+	if m != nil {
+		return m.MaxConsumerCleanupDeletionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDormancyPeriod() time.Duration {
+	if m != nil {
+		return m.DormancyPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetNumberOfEpochsToRetainConsumerValsets() int64 {
+	if m != nil {
+		return m.NumberOfEpochsToRetainConsumerValsets
+	}
+	return 0
+}
+
+func (m *Params) GetLifetimeReminderFractions() []string {
+	if m != nil {
+		return m.LifetimeReminderFractions
+	}
+	return nil
+}
+
+func (m *Params) GetUpgradeQuietPeriod() int64 {
+	if m != nil {
+		return m.UpgradeQuietPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetConsumerClientExpiryWarningFraction() string {
+	if m != nil {
+		return m.ConsumerClientExpiryWarningFraction
+	}
+	return ""
+}
+
+func (m *Params) GetMaxConsumerParticipation() int64 {
+	if m != nil {
+		return m.MaxConsumerParticipation
+	}
+	return 0
+}
+
+func (m *Params) GetEmergencyOptOutSlashFraction() string {
+	if m != nil {
+		return m.EmergencyOptOutSlashFraction
+	}
+	return ""
+}
+
+func (m *Params) GetEmergencyOptOutCooldown() time.Duration {
+	if m != nil {
+		return m.EmergencyOptOutCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetSendUpgradeNotices() bool {
+	if m != nil {
+		return m.SendUpgradeNotices
+	}
+	return false
+}
+
+func (m *Params) GetMaxClientCreationsPerBlock() int64 {
+	if m != nil {
+		return m.MaxClientCreationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetNumberOfEpochsToRetainEconomicSecurity() int64 {
+	if m != nil {
+		return m.NumberOfEpochsToRetainEconomicSecurity
+	}
+	return 0
+}
+
+func (m *Params) GetAllowZeroKeyAssignmentNonce() bool {
+	if m != nil {
+		return m.AllowZeroKeyAssignmentNonce
+	}
+	return false
+}
+
+func (m *Params) GetExcludeTokenizedSharesFromTopN() bool {
+	if m != nil {
+		return m.ExcludeTokenizedSharesFromTopN
+	}
+	return false
+}
+
 // SlashAcks contains cons addresses of consumer chain validators
 // successfully slashed on the provider chain.
 type SlashAcks struct {
@@ -1304,6 +1673,68 @@ func (m *ConsumerAddrsToPruneV2) GetConsumerAddrs() *AddressList {
 	return nil
 }
 
+// Used to serialize the KeyAssignmentNonce index from key assignment
+// KeyAssignmentNonce: (consumerId, providerAddr consAddr) -> nonce
+type KeyAssignmentNonce struct {
+	ConsumerId   string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ProviderAddr []byte `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *KeyAssignmentNonce) Reset()         { *m = KeyAssignmentNonce{} }
+func (m *KeyAssignmentNonce) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentNonce) ProtoMessage()    {}
+func (*KeyAssignmentNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{17}
+}
+func (m *KeyAssignmentNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAssignmentNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAssignmentNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyAssignmentNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentNonce.Merge(m, src)
+}
+func (m *KeyAssignmentNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAssignmentNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAssignmentNonce proto.InternalMessageInfo
+
+func (m *KeyAssignmentNonce) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *KeyAssignmentNonce) GetProviderAddr() []byte {
+	if m != nil {
+		return m.ProviderAddr
+	}
+	return nil
+}
+
+func (m *KeyAssignmentNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // ConsensusValidator is used to express a validator that
 // should be validating on a chain.
 // It contains relevant info for
@@ -1327,7 +1758,7 @@ func (m *ConsensusValidator) Reset()         { *m = ConsensusValidator{} }
 func (m *ConsensusValidator) String() string { return proto.CompactTextString(m) }
 func (*ConsensusValidator) ProtoMessage()    {}
 func (*ConsensusValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{17}
+	return fileDescriptor_f22ec409a72b7b72, []int{18}
 }
 func (m *ConsensusValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,13 +1820,17 @@ func (m *ConsensusValidator) GetJoinHeight() int64 {
 // opted-in validators and the community pool during BeginBlock.
 type ConsumerRewardsAllocation struct {
 	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+	// the part of `rewards` attributed by the consumer chain to specific validators
+	// through the per-validator breakdown of the reward memos, which is allocated
+	// directly to those validators instead of pro rata
+	ValidatorRewards []ValidatorRewardsAllocation `protobuf:"bytes,2,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
 }
 
 func (m *ConsumerRewardsAllocation) Reset()         { *m = ConsumerRewardsAllocation{} }
 func (m *ConsumerRewardsAllocation) String() string { return proto.CompactTextString(m) }
 func (*ConsumerRewardsAllocation) ProtoMessage()    {}
 func (*ConsumerRewardsAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{18}
+	return fileDescriptor_f22ec409a72b7b72, []int{19}
 }
 func (m *ConsumerRewardsAllocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1431,6 +1866,68 @@ func (m *ConsumerRewardsAllocation) GetRewards() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *ConsumerRewardsAllocation) GetValidatorRewards() []ValidatorRewardsAllocation {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+// ValidatorRewardsAllocation stores the rewards attributed by a consumer chain
+// to a specific provider validator
+type ValidatorRewardsAllocation struct {
+	// the consensus address of the validator on the provider chain
+	ProviderConsAddr []byte                                      `protobuf:"bytes,1,opt,name=provider_cons_addr,json=providerConsAddr,proto3" json:"provider_cons_addr,omitempty"`
+	Rewards          github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *ValidatorRewardsAllocation) Reset()         { *m = ValidatorRewardsAllocation{} }
+func (m *ValidatorRewardsAllocation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewardsAllocation) ProtoMessage()    {}
+func (*ValidatorRewardsAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{20}
+}
+func (m *ValidatorRewardsAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewardsAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewardsAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewardsAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewardsAllocation.Merge(m, src)
+}
+func (m *ValidatorRewardsAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewardsAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewardsAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorRewardsAllocation proto.InternalMessageInfo
+
+func (m *ValidatorRewardsAllocation) GetProviderConsAddr() []byte {
+	if m != nil {
+		return m.ProviderConsAddr
+	}
+	return nil
+}
+
+func (m *ValidatorRewardsAllocation) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // ConsumerMetadata contains general information about the registered chain
 type ConsumerMetadata struct {
 	// the name of the chain
@@ -1439,13 +1936,17 @@ type ConsumerMetadata struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// the metadata (e.g., GitHub repository URL) of the chain
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// (optional) the hex-encoded SHA-256 hash of the terms (e.g., jurisdictional restrictions,
+	// hardware requirements) that validators must acknowledge before opting in to the chain;
+	// it cannot be set for Top N chains
+	TermsHash string `protobuf:"bytes,4,opt,name=terms_hash,json=termsHash,proto3" json:"terms_hash,omitempty"`
 }
 
 func (m *ConsumerMetadata) Reset()         { *m = ConsumerMetadata{} }
 func (m *ConsumerMetadata) String() string { return proto.CompactTextString(m) }
 func (*ConsumerMetadata) ProtoMessage()    {}
 func (*ConsumerMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{19}
+	return fileDescriptor_f22ec409a72b7b72, []int{21}
 }
 func (m *ConsumerMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,44 +1996,181 @@ func (m *ConsumerMetadata) GetMetadata() string {
 	return ""
 }
 
-// ConsumerInitializationParameters are the parameters needed to launch a chain
-type ConsumerInitializationParameters struct {
-	// the proposed initial height of new consumer chain.
-	// For a completely new chain, this will be {0,1}. However, it may be
-	// different if this is a chain that is converting to a consumer chain.
-	InitialHeight types.Height `protobuf:"bytes,1,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height"`
-	// The hash of the consumer chain genesis state without the consumer CCV
-	// module genesis params. It is used for off-chain confirmation of
-	// genesis.json validity by validators and other parties.
-	GenesisHash []byte `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
-	// The hash of the consumer chain binary that should be run by validators on
-	// chain initialization. It is used for off-chain confirmation of binary
-	// validity by validators and other parties.
-	BinaryHash []byte `protobuf:"bytes,3,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
-	// spawn time is the time on the provider chain at which the consumer chain
-	// genesis is finalized and all validators will be responsible for starting
-	// their consumer chain validator node.
-	SpawnTime time.Time `protobuf:"bytes,4,opt,name=spawn_time,json=spawnTime,proto3,stdtime" json:"spawn_time"`
-	// Unbonding period for the consumer,
-	// which should be smaller than that of the provider in general.
-	UnbondingPeriod time.Duration `protobuf:"bytes,5,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
-	// Sent CCV related IBC packets will timeout after this duration
-	CcvTimeoutPeriod time.Duration `protobuf:"bytes,6,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
-	// Sent transfer related IBC packets will timeout after this duration
-	TransferTimeoutPeriod time.Duration `protobuf:"bytes,7,opt,name=transfer_timeout_period,json=transferTimeoutPeriod,proto3,stdduration" json:"transfer_timeout_period"`
-	// The fraction of tokens allocated to the consumer redistribution address
-	// during distribution events. The fraction is a string representing a
-	// decimal number. For example "0.75" would represent 75%.
-	ConsumerRedistributionFraction string `protobuf:"bytes,8,opt,name=consumer_redistribution_fraction,json=consumerRedistributionFraction,proto3" json:"consumer_redistribution_fraction,omitempty"`
-	// BlocksPerDistributionTransmission is the number of blocks between
-	// ibc-token-transfers from the consumer chain to the provider chain. On
-	// sending transmission event, `consumer_redistribution_fraction` of the
-	// accumulated tokens are sent to the consumer redistribution address.
-	BlocksPerDistributionTransmission int64 `protobuf:"varint,9,opt,name=blocks_per_distribution_transmission,json=blocksPerDistributionTransmission,proto3" json:"blocks_per_distribution_transmission,omitempty"`
-	// The number of historical info entries to persist in store.
-	// This param is a part of the cosmos sdk staking module. In the case of
-	// a ccv enabled consumer chain, the ccv module acts as the staking module.
-	HistoricalEntries int64 `protobuf:"varint,10,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
+func (m *ConsumerMetadata) GetTermsHash() string {
+	if m != nil {
+		return m.TermsHash
+	}
+	return ""
+}
+
+// EndpointInfo contains the information needed by validators to bootstrap
+// the nodes of a consumer chain
+type EndpointInfo struct {
+	// the persistent peers of the chain (i.e., node_id@host:port)
+	PersistentPeers []string `protobuf:"bytes,1,rep,name=persistent_peers,json=persistentPeers,proto3" json:"persistent_peers,omitempty"`
+	// the seed nodes of the chain (i.e., node_id@host:port)
+	Seeds []string `protobuf:"bytes,2,rep,name=seeds,proto3" json:"seeds,omitempty"`
+	// the public RPC endpoints of the chain (e.g., https://rpc.consumer.io:443)
+	RpcUrls []string `protobuf:"bytes,3,rep,name=rpc_urls,json=rpcUrls,proto3" json:"rpc_urls,omitempty"`
+	// the URL from which the genesis file of the chain can be downloaded
+	GenesisUrl string `protobuf:"bytes,4,opt,name=genesis_url,json=genesisUrl,proto3" json:"genesis_url,omitempty"`
+}
+
+func (m *EndpointInfo) Reset()         { *m = EndpointInfo{} }
+func (m *EndpointInfo) String() string { return proto.CompactTextString(m) }
+func (*EndpointInfo) ProtoMessage()    {}
+func (*EndpointInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{22}
+}
+func (m *EndpointInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndpointInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndpointInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndpointInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointInfo.Merge(m, src)
+}
+func (m *EndpointInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndpointInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndpointInfo proto.InternalMessageInfo
+
+func (m *EndpointInfo) GetPersistentPeers() []string {
+	if m != nil {
+		return m.PersistentPeers
+	}
+	return nil
+}
+
+func (m *EndpointInfo) GetSeeds() []string {
+	if m != nil {
+		return m.Seeds
+	}
+	return nil
+}
+
+func (m *EndpointInfo) GetRpcUrls() []string {
+	if m != nil {
+		return m.RpcUrls
+	}
+	return nil
+}
+
+func (m *EndpointInfo) GetGenesisUrl() string {
+	if m != nil {
+		return m.GenesisUrl
+	}
+	return ""
+}
+
+// ConsumerTimeoutPeriods are the timeout periods of a launched consumer chain
+// that can be updated after the chain launched
+type ConsumerTimeoutPeriods struct {
+	// CCV related IBC packets sent to the consumer chain (i.e., VSC packets) will timeout after this duration
+	CcvTimeoutPeriod time.Duration `protobuf:"bytes,1,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
+	// Transfer related IBC packets sent by the consumer chain will timeout after this duration
+	TransferTimeoutPeriod time.Duration `protobuf:"bytes,2,opt,name=transfer_timeout_period,json=transferTimeoutPeriod,proto3,stdduration" json:"transfer_timeout_period"`
+}
+
+func (m *ConsumerTimeoutPeriods) Reset()         { *m = ConsumerTimeoutPeriods{} }
+func (m *ConsumerTimeoutPeriods) String() string { return proto.CompactTextString(m) }
+func (*ConsumerTimeoutPeriods) ProtoMessage()    {}
+func (*ConsumerTimeoutPeriods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{23}
+}
+func (m *ConsumerTimeoutPeriods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerTimeoutPeriods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerTimeoutPeriods.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerTimeoutPeriods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerTimeoutPeriods.Merge(m, src)
+}
+func (m *ConsumerTimeoutPeriods) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerTimeoutPeriods) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerTimeoutPeriods.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerTimeoutPeriods proto.InternalMessageInfo
+
+func (m *ConsumerTimeoutPeriods) GetCcvTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.CcvTimeoutPeriod
+	}
+	return 0
+}
+
+func (m *ConsumerTimeoutPeriods) GetTransferTimeoutPeriod() time.Duration {
+	if m != nil {
+		return m.TransferTimeoutPeriod
+	}
+	return 0
+}
+
+// ConsumerInitializationParameters are the parameters needed to launch a chain
+type ConsumerInitializationParameters struct {
+	// the proposed initial height of new consumer chain.
+	// For a completely new chain, this will be {0,1}. However, it may be
+	// different if this is a chain that is converting to a consumer chain.
+	InitialHeight types.Height `protobuf:"bytes,1,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height"`
+	// The hash of the consumer chain genesis state without the consumer CCV
+	// module genesis params. It is used for off-chain confirmation of
+	// genesis.json validity by validators and other parties.
+	GenesisHash []byte `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// The hash of the consumer chain binary that should be run by validators on
+	// chain initialization. It is used for off-chain confirmation of binary
+	// validity by validators and other parties.
+	BinaryHash []byte `protobuf:"bytes,3,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+	// spawn time is the time on the provider chain at which the consumer chain
+	// genesis is finalized and all validators will be responsible for starting
+	// their consumer chain validator node.
+	SpawnTime time.Time `protobuf:"bytes,4,opt,name=spawn_time,json=spawnTime,proto3,stdtime" json:"spawn_time"`
+	// Unbonding period for the consumer,
+	// which should be smaller than that of the provider in general.
+	UnbondingPeriod time.Duration `protobuf:"bytes,5,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
+	// Sent CCV related IBC packets will timeout after this duration
+	CcvTimeoutPeriod time.Duration `protobuf:"bytes,6,opt,name=ccv_timeout_period,json=ccvTimeoutPeriod,proto3,stdduration" json:"ccv_timeout_period"`
+	// Sent transfer related IBC packets will timeout after this duration
+	TransferTimeoutPeriod time.Duration `protobuf:"bytes,7,opt,name=transfer_timeout_period,json=transferTimeoutPeriod,proto3,stdduration" json:"transfer_timeout_period"`
+	// The fraction of tokens allocated to the consumer redistribution address
+	// during distribution events. The fraction is a string representing a
+	// decimal number. For example "0.75" would represent 75%.
+	ConsumerRedistributionFraction string `protobuf:"bytes,8,opt,name=consumer_redistribution_fraction,json=consumerRedistributionFraction,proto3" json:"consumer_redistribution_fraction,omitempty"`
+	// BlocksPerDistributionTransmission is the number of blocks between
+	// ibc-token-transfers from the consumer chain to the provider chain. On
+	// sending transmission event, `consumer_redistribution_fraction` of the
+	// accumulated tokens are sent to the consumer redistribution address.
+	BlocksPerDistributionTransmission int64 `protobuf:"varint,9,opt,name=blocks_per_distribution_transmission,json=blocksPerDistributionTransmission,proto3" json:"blocks_per_distribution_transmission,omitempty"`
+	// The number of historical info entries to persist in store.
+	// This param is a part of the cosmos sdk staking module. In the case of
+	// a ccv enabled consumer chain, the ccv module acts as the staking module.
+	HistoricalEntries int64 `protobuf:"varint,10,opt,name=historical_entries,json=historicalEntries,proto3" json:"historical_entries,omitempty"`
 	// The ID of a token transfer channel used for the Reward Distribution
 	// sub-protocol. If DistributionTransmissionChannel == "", a new transfer
 	// channel is created on top of the same connection as the CCV channel.
@@ -1540,13 +2178,45 @@ type ConsumerInitializationParameters struct {
 	// chain. it is most relevant for chains performing a sovereign to consumer
 	// changeover in order to maintain the existing ibc transfer channel
 	DistributionTransmissionChannel string `protobuf:"bytes,11,opt,name=distribution_transmission_channel,json=distributionTransmissionChannel,proto3" json:"distribution_transmission_channel,omitempty"`
+	// The staking/mint denom of the consumer chain. It is optional and it is only used to
+	// populate the consumer genesis, i.e., it has no effect on the provider.
+	ConsumerDenom string `protobuf:"bytes,12,opt,name=consumer_denom,json=consumerDenom,proto3" json:"consumer_denom,omitempty"`
+	// The bank metadata of `consumer_denom`. It is optional and, if set, the consumer chain
+	// registers it with the bank module on InitGenesis unless metadata for the denom already exists.
+	ConsumerDenomMetadata *types4.Metadata `protobuf:"bytes,13,opt,name=consumer_denom_metadata,json=consumerDenomMetadata,proto3" json:"consumer_denom_metadata,omitempty"`
+	// The minimum height of double voting evidence from the pre-CCV (i.e., standalone) history
+	// of a changeover chain that can be submitted to the provider. It is optional and, if set, it
+	// must be smaller than `initial_height`. Evidence with heights in [pre_ccv_evidence_min_height, initial_height)
+	// is verified against `pre_ccv_client_id` and is only punished if the offending validator
+	// assigned its standalone key as its consumer key. If zero, pre-CCV evidence is rejected.
+	PreCcvEvidenceMinHeight uint64 `protobuf:"varint,14,opt,name=pre_ccv_evidence_min_height,json=preCcvEvidenceMinHeight,proto3" json:"pre_ccv_evidence_min_height,omitempty"`
+	// The ID of a client on the provider that tracks the standalone chain. It is required
+	// if `pre_ccv_evidence_min_height` is set. The chain id of the client must be the chain id
+	// of the consumer chain or a revision of it.
+	PreCcvClientId string `protobuf:"bytes,15,opt,name=pre_ccv_client_id,json=preCcvClientId,proto3" json:"pre_ccv_client_id,omitempty"`
+	// The maximum lifetime of the consumer chain. If set, the consumer chain is stopped once
+	// `max_lifetime` elapsed after its launch, unless its owner extends its lifetime before.
+	// Zero means that the lifetime of the consumer chain is unlimited.
+	MaxLifetime time.Duration `protobuf:"bytes,16,opt,name=max_lifetime,json=maxLifetime,proto3,stdduration" json:"max_lifetime"`
+	// The number of blocks of the downtime window of the consumer chain, i.e., the
+	// `SignedBlocksWindow` of its slashing module. Optional, zero means that the consumer
+	// chain keeps the value of its genesis file.
+	SignedBlocksWindow int64 `protobuf:"varint,17,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// The minimum fraction of blocks a validator must have signed in the downtime window of the
+	// consumer chain, i.e., the `MinSignedPerWindow` of its slashing module. The fraction is a
+	// string representing a decimal number. Optional, empty means that the consumer chain keeps
+	// the value of its genesis file.
+	MinSignedPerWindow string `protobuf:"bytes,18,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	// Denom-specific overrides of `consumer_redistribution_fraction`. Optional, the tokens
+	// of the denoms without an override are split according to `consumer_redistribution_fraction`.
+	DenomRedistributionFractions []types3.DenomRedistributionFraction `protobuf:"bytes,19,rep,name=denom_redistribution_fractions,json=denomRedistributionFractions,proto3" json:"denom_redistribution_fractions"`
 }
 
 func (m *ConsumerInitializationParameters) Reset()         { *m = ConsumerInitializationParameters{} }
 func (m *ConsumerInitializationParameters) String() string { return proto.CompactTextString(m) }
 func (*ConsumerInitializationParameters) ProtoMessage()    {}
 func (*ConsumerInitializationParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{20}
+	return fileDescriptor_f22ec409a72b7b72, []int{24}
 }
 func (m *ConsumerInitializationParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,6 +2322,62 @@ func (m *ConsumerInitializationParameters) GetDistributionTransmissionChannel()
 	return ""
 }
 
+func (m *ConsumerInitializationParameters) GetConsumerDenom() string {
+	if m != nil {
+		return m.ConsumerDenom
+	}
+	return ""
+}
+
+func (m *ConsumerInitializationParameters) GetConsumerDenomMetadata() *types4.Metadata {
+	if m != nil {
+		return m.ConsumerDenomMetadata
+	}
+	return nil
+}
+
+func (m *ConsumerInitializationParameters) GetPreCcvEvidenceMinHeight() uint64 {
+	if m != nil {
+		return m.PreCcvEvidenceMinHeight
+	}
+	return 0
+}
+
+func (m *ConsumerInitializationParameters) GetPreCcvClientId() string {
+	if m != nil {
+		return m.PreCcvClientId
+	}
+	return ""
+}
+
+func (m *ConsumerInitializationParameters) GetMaxLifetime() time.Duration {
+	if m != nil {
+		return m.MaxLifetime
+	}
+	return 0
+}
+
+func (m *ConsumerInitializationParameters) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *ConsumerInitializationParameters) GetMinSignedPerWindow() string {
+	if m != nil {
+		return m.MinSignedPerWindow
+	}
+	return ""
+}
+
+func (m *ConsumerInitializationParameters) GetDenomRedistributionFractions() []types3.DenomRedistributionFraction {
+	if m != nil {
+		return m.DenomRedistributionFractions
+	}
+	return nil
+}
+
 // PowerShapingParameters contains parameters that shape the validator set that we send to the consumer chain
 type PowerShapingParameters struct {
 	// Corresponds to the percentage of validators that have to validate the chain under the Top N case.
@@ -1699,13 +2425,33 @@ type PowerShapingParameters struct {
 	MinStake uint64 `protobuf:"varint,6,opt,name=min_stake,json=minStake,proto3" json:"min_stake,omitempty"`
 	// Corresponds to whether inactive validators are allowed to validate the consumer chain.
 	AllowInactiveVals bool `protobuf:"varint,7,opt,name=allow_inactive_vals,json=allowInactiveVals,proto3" json:"allow_inactive_vals,omitempty"`
+	// Corresponds to the maximum rank (by bonded tokens on the provider chain) a validator can have
+	// to be eligible to validate the consumer chain, i.e., only the top `max_provider_rank` provider
+	// validators can opt in and validate the consumer chain. Setting `max_provider_rank` to 0 disables it.
+	// Ties between validators with the same bonded tokens are broken by provider consensus address.
+	MaxProviderRank uint32 `protobuf:"varint,8,opt,name=max_provider_rank,json=maxProviderRank,proto3" json:"max_provider_rank,omitempty"`
+	// Corresponds to the period after their opt in during which the validators that were automatically opted in
+	// because they belong to the top N are not jailed for downtime on the consumer chain. The downtime slashes
+	// of these validators are acknowledged, but not executed, and they are recorded instead (see `SkippedDowntimeSlash`).
+	// Validators that opted in voluntarily are not exempt. Not setting `downtime_grace_period` (or setting it to 0) disables it.
+	DowntimeGracePeriod *time.Duration `protobuf:"bytes,9,opt,name=downtime_grace_period,json=downtimeGracePeriod,proto3,stdduration" json:"downtime_grace_period,omitempty"`
+	// Corresponds to whether the validators that have to validate the consumer chain because they belong
+	// to the top N can opt out of it through an emergency opt out (see `MsgEmergencyOptOut`).
+	// Only applicable to Top N chains, as any validator can emergency opt out of an Opt In chain.
+	AllowTopNEmergencyOptOut bool `protobuf:"varint,10,opt,name=allow_top_n_emergency_opt_out,json=allowTopNEmergencyOptOut,proto3" json:"allow_top_n_emergency_opt_out,omitempty"`
+	// Corresponds to the period after being jailed for an infraction committed on the consumer chain during which
+	// a validator cannot validate the consumer chain, even if it is unjailed on the provider chain in the meantime.
+	// During this period, the validator can neither opt in, nor be part of the consumer validator set, nor be
+	// automatically opted in because it belongs to the top N. Not setting `re_opt_in_cooldown_after_slash`
+	// (or setting it to 0) disables it.
+	ReOptInCooldownAfterSlash *time.Duration `protobuf:"bytes,11,opt,name=re_opt_in_cooldown_after_slash,json=reOptInCooldownAfterSlash,proto3,stdduration" json:"re_opt_in_cooldown_after_slash,omitempty"`
 }
 
 func (m *PowerShapingParameters) Reset()         { *m = PowerShapingParameters{} }
 func (m *PowerShapingParameters) String() string { return proto.CompactTextString(m) }
 func (*PowerShapingParameters) ProtoMessage()    {}
 func (*PowerShapingParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{21}
+	return fileDescriptor_f22ec409a72b7b72, []int{25}
 }
 func (m *PowerShapingParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1783,6 +2529,34 @@ func (m *PowerShapingParameters) GetAllowInactiveVals() bool {
 	return false
 }
 
+func (m *PowerShapingParameters) GetMaxProviderRank() uint32 {
+	if m != nil {
+		return m.MaxProviderRank
+	}
+	return 0
+}
+
+func (m *PowerShapingParameters) GetDowntimeGracePeriod() *time.Duration {
+	if m != nil {
+		return m.DowntimeGracePeriod
+	}
+	return nil
+}
+
+func (m *PowerShapingParameters) GetAllowTopNEmergencyOptOut() bool {
+	if m != nil {
+		return m.AllowTopNEmergencyOptOut
+	}
+	return false
+}
+
+func (m *PowerShapingParameters) GetReOptInCooldownAfterSlash() *time.Duration {
+	if m != nil {
+		return m.ReOptInCooldownAfterSlash
+	}
+	return nil
+}
+
 // ConsumerIds contains consumer ids of chains
 // Used so we can easily (de)serialize slices of strings
 type ConsumerIds struct {
@@ -1793,7 +2567,7 @@ func (m *ConsumerIds) Reset()         { *m = ConsumerIds{} }
 func (m *ConsumerIds) String() string { return proto.CompactTextString(m) }
 func (*ConsumerIds) ProtoMessage()    {}
 func (*ConsumerIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{22}
+	return fileDescriptor_f22ec409a72b7b72, []int{26}
 }
 func (m *ConsumerIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1829,23 +2603,30 @@ func (m *ConsumerIds) GetIds() []string {
 	return nil
 }
 
-// AllowlistedRewardDenoms corresponds to the denoms allowlisted by a specific consumer id
-type AllowlistedRewardDenoms struct {
-	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+// ConsumerLifetime contains the lifetime of a launched consumer chain with a maximum lifetime
+type ConsumerLifetime struct {
+	// the time at which the consumer chain launched
+	LaunchTime time.Time `protobuf:"bytes,1,opt,name=launch_time,json=launchTime,proto3,stdtime" json:"launch_time"`
+	// the time at which the consumer chain is stopped
+	SunsetTime time.Time `protobuf:"bytes,2,opt,name=sunset_time,json=sunsetTime,proto3,stdtime" json:"sunset_time"`
+	// the number of `lifetime_reminder_fractions` for which a reminder event was emitted
+	RemindersEmitted uint32 `protobuf:"varint,3,opt,name=reminders_emitted,json=remindersEmitted,proto3" json:"reminders_emitted,omitempty"`
+	// the time at which the next reminder event is scheduled, or the zero time if no reminder is scheduled
+	NextReminderTime time.Time `protobuf:"bytes,4,opt,name=next_reminder_time,json=nextReminderTime,proto3,stdtime" json:"next_reminder_time"`
 }
 
-func (m *AllowlistedRewardDenoms) Reset()         { *m = AllowlistedRewardDenoms{} }
-func (m *AllowlistedRewardDenoms) String() string { return proto.CompactTextString(m) }
-func (*AllowlistedRewardDenoms) ProtoMessage()    {}
-func (*AllowlistedRewardDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{23}
+func (m *ConsumerLifetime) Reset()         { *m = ConsumerLifetime{} }
+func (m *ConsumerLifetime) String() string { return proto.CompactTextString(m) }
+func (*ConsumerLifetime) ProtoMessage()    {}
+func (*ConsumerLifetime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{27}
 }
-func (m *AllowlistedRewardDenoms) XXX_Unmarshal(b []byte) error {
+func (m *ConsumerLifetime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllowlistedRewardDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ConsumerLifetime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllowlistedRewardDenoms.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ConsumerLifetime.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1855,2168 +2636,9712 @@ func (m *AllowlistedRewardDenoms) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AllowlistedRewardDenoms) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowlistedRewardDenoms.Merge(m, src)
+func (m *ConsumerLifetime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerLifetime.Merge(m, src)
 }
-func (m *AllowlistedRewardDenoms) XXX_Size() int {
+func (m *ConsumerLifetime) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllowlistedRewardDenoms) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowlistedRewardDenoms.DiscardUnknown(m)
+func (m *ConsumerLifetime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerLifetime.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllowlistedRewardDenoms proto.InternalMessageInfo
+var xxx_messageInfo_ConsumerLifetime proto.InternalMessageInfo
 
-func (m *AllowlistedRewardDenoms) GetDenoms() []string {
+func (m *ConsumerLifetime) GetLaunchTime() time.Time {
 	if m != nil {
-		return m.Denoms
+		return m.LaunchTime
 	}
-	return nil
-}
-
-func init() {
-	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
-	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
-	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
-	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
-	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
-	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
-	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
-	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
-	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
-	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
-	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
-	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
-	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
-	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
-	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
-	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
-	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
-	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
-	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
-	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
-	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
-	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
-	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
-	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
-	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+func (m *ConsumerLifetime) GetSunsetTime() time.Time {
+	if m != nil {
+		return m.SunsetTime
+	}
+	return time.Time{}
 }
 
-var fileDescriptor_f22ec409a72b7b72 = []byte{
-	// 2267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x1b, 0xc9,
-	0xd1, 0xd7, 0x88, 0x94, 0x44, 0x16, 0xf5, 0xa0, 0xda, 0x5e, 0x99, 0x92, 0xb5, 0x24, 0xcd, 0xfd,
-	0xbc, 0xe0, 0x67, 0xc7, 0xe4, 0x4a, 0x0b, 0x04, 0x86, 0x93, 0x85, 0x41, 0x93, 0xb4, 0x4d, 0x3f,
-	0x64, 0x66, 0x48, 0x6b, 0x01, 0xe7, 0x30, 0x68, 0xce, 0xb4, 0xc8, 0x8e, 0xe6, 0xe5, 0xe9, 0x26,
-	0x6d, 0xe6, 0x90, 0xf3, 0x22, 0x40, 0x80, 0x4d, 0x4e, 0x8b, 0x5c, 0xb2, 0x40, 0x2e, 0x41, 0x4e,
-	0x39, 0x04, 0xf9, 0x03, 0x72, 0xda, 0x04, 0x08, 0xb0, 0xb9, 0xe5, 0xb4, 0x1b, 0xd8, 0x87, 0x1c,
-	0x02, 0x24, 0xe7, 0xdc, 0x82, 0xee, 0x79, 0x70, 0xa8, 0x97, 0x69, 0xd8, 0xce, 0x45, 0x9a, 0xae,
-	0xfa, 0x55, 0x75, 0x75, 0x77, 0xbd, 0xba, 0x09, 0xbb, 0xd4, 0xe6, 0xc4, 0xd3, 0x07, 0x98, 0xda,
-	0x1a, 0x23, 0xfa, 0xd0, 0xa3, 0x7c, 0x5c, 0xd5, 0xf5, 0x51, 0xd5, 0xf5, 0x9c, 0x11, 0x35, 0x88,
-	0x57, 0x1d, 0xed, 0x44, 0xdf, 0x15, 0xd7, 0x73, 0xb8, 0x83, 0x3e, 0x38, 0x41, 0xa6, 0xa2, 0xeb,
-	0xa3, 0x4a, 0x84, 0x1b, 0xed, 0x6c, 0x5d, 0x3e, 0x4d, 0xf1, 0x68, 0xa7, 0xfa, 0x8c, 0x7a, 0xc4,
-	0xd7, 0xb5, 0x75, 0xbe, 0xef, 0xf4, 0x1d, 0xf9, 0x59, 0x15, 0x5f, 0x01, 0xb5, 0xd0, 0x77, 0x9c,
-	0xbe, 0x49, 0xaa, 0x72, 0xd4, 0x1b, 0x1e, 0x54, 0x39, 0xb5, 0x08, 0xe3, 0xd8, 0x72, 0x03, 0x40,
-	0xfe, 0x28, 0xc0, 0x18, 0x7a, 0x98, 0x53, 0xc7, 0x0e, 0x15, 0xd0, 0x9e, 0x5e, 0xd5, 0x1d, 0x8f,
-	0x54, 0x75, 0x93, 0x12, 0x9b, 0x8b, 0x59, 0xfd, 0xaf, 0x00, 0x50, 0x15, 0x00, 0x93, 0xf6, 0x07,
-	0xdc, 0x27, 0xb3, 0x2a, 0x27, 0xb6, 0x41, 0x3c, 0x8b, 0xfa, 0xe0, 0xc9, 0x28, 0x10, 0xd8, 0x8e,
-	0xf1, 0x75, 0x6f, 0xec, 0x72, 0xa7, 0x7a, 0x48, 0xc6, 0x2c, 0xe0, 0x7e, 0xa8, 0x3b, 0xcc, 0x72,
-	0x58, 0x95, 0x88, 0xf5, 0xdb, 0x3a, 0xa9, 0x8e, 0x76, 0x7a, 0x84, 0xe3, 0x9d, 0x88, 0x10, 0xda,
-	0x1d, 0xe0, 0x7a, 0x98, 0x4d, 0x30, 0xba, 0x43, 0x43, 0xbb, 0x37, 0x7d, 0xbe, 0xe6, 0xef, 0x88,
-	0x3f, 0x08, 0x58, 0xeb, 0xd8, 0xa2, 0xb6, 0x53, 0x95, 0x7f, 0x7d, 0x52, 0xe9, 0x3f, 0x29, 0xc8,
-	0xd5, 0x1d, 0x9b, 0x0d, 0x2d, 0xe2, 0xd5, 0x0c, 0x83, 0x8a, 0x0d, 0x68, 0x7b, 0x8e, 0xeb, 0x30,
-	0x6c, 0xa2, 0xf3, 0xb0, 0xc0, 0x29, 0x37, 0x49, 0x4e, 0x29, 0x2a, 0xe5, 0xb4, 0xea, 0x0f, 0x50,
-	0x11, 0x32, 0x06, 0x61, 0xba, 0x47, 0x5d, 0x01, 0xce, 0xcd, 0x4b, 0x5e, 0x9c, 0x84, 0x36, 0x21,
-	0xe5, 0x9f, 0x1a, 0x35, 0x72, 0x09, 0xc9, 0x5e, 0x92, 0xe3, 0x96, 0x81, 0xee, 0xc0, 0x2a, 0xb5,
-	0x29, 0xa7, 0xd8, 0xd4, 0x06, 0x44, 0xec, 0x5d, 0x2e, 0x59, 0x54, 0xca, 0x99, 0xdd, 0xad, 0x0a,
-	0xed, 0xe9, 0x15, 0xb1, 0xdd, 0x95, 0x60, 0x93, 0x47, 0x3b, 0x95, 0xbb, 0x12, 0x71, 0x2b, 0xf9,
-	0xd5, 0x37, 0x85, 0x39, 0x75, 0x25, 0x90, 0xf3, 0x89, 0xe8, 0x12, 0x2c, 0xf7, 0x89, 0x4d, 0x18,
-	0x65, 0xda, 0x00, 0xb3, 0x41, 0x6e, 0xa1, 0xa8, 0x94, 0x97, 0xd5, 0x4c, 0x40, 0xbb, 0x8b, 0xd9,
-	0x00, 0x15, 0x20, 0xd3, 0xa3, 0x36, 0xf6, 0xc6, 0x3e, 0x62, 0x51, 0x22, 0xc0, 0x27, 0x49, 0x40,
-	0x1d, 0x80, 0xb9, 0xf8, 0x99, 0xad, 0x09, 0xdf, 0xc8, 0x2d, 0x05, 0x86, 0xf8, 0x7e, 0x51, 0x09,
-	0xfd, 0xa2, 0xd2, 0x0d, 0x1d, 0xe7, 0x56, 0x4a, 0x18, 0xf2, 0xf9, 0xb7, 0x05, 0x45, 0x4d, 0x4b,
-	0x39, 0xc1, 0x41, 0x7b, 0x90, 0x1d, 0xda, 0x3d, 0xc7, 0x36, 0xa8, 0xdd, 0xd7, 0x5c, 0xe2, 0x51,
-	0xc7, 0xc8, 0xa5, 0xa4, 0xaa, 0xcd, 0x63, 0xaa, 0x1a, 0x81, 0x8b, 0xf9, 0x9a, 0xbe, 0x10, 0x9a,
-	0xd6, 0x22, 0xe1, 0xb6, 0x94, 0x45, 0x3f, 0x00, 0xa4, 0xeb, 0x23, 0x69, 0x92, 0x33, 0xe4, 0xa1,
-	0xc6, 0xf4, 0xec, 0x1a, 0xb3, 0xba, 0x3e, 0xea, 0xfa, 0xd2, 0x81, 0xca, 0x1f, 0xc2, 0x05, 0xee,
-	0x61, 0x9b, 0x1d, 0x10, 0xef, 0xa8, 0x5e, 0x98, 0x5d, 0xef, 0x7b, 0xa1, 0x8e, 0x69, 0xe5, 0x77,
-	0xa1, 0xa8, 0x07, 0x0e, 0xa4, 0x79, 0xc4, 0xa0, 0x8c, 0x7b, 0xb4, 0x37, 0x14, 0xb2, 0xda, 0x81,
-	0x87, 0x75, 0xe9, 0x23, 0x19, 0xe9, 0x04, 0xf9, 0x10, 0xa7, 0x4e, 0xc1, 0x6e, 0x07, 0x28, 0xf4,
-	0x08, 0xfe, 0xaf, 0x67, 0x3a, 0xfa, 0x21, 0x13, 0xc6, 0x69, 0x53, 0x9a, 0xe4, 0xd4, 0x16, 0x65,
-	0x4c, 0x68, 0x5b, 0x2e, 0x2a, 0xe5, 0x84, 0x7a, 0xc9, 0xc7, 0xb6, 0x89, 0xd7, 0x88, 0x21, 0xbb,
-	0x31, 0x20, 0xba, 0x06, 0x68, 0x40, 0x19, 0x77, 0x3c, 0xaa, 0x63, 0x53, 0x23, 0x36, 0xf7, 0x28,
-	0x61, 0xb9, 0x15, 0x29, 0xbe, 0x3e, 0xe1, 0x34, 0x7d, 0x06, 0xba, 0x07, 0x97, 0x4e, 0x9d, 0x54,
-	0xd3, 0x07, 0xd8, 0xb6, 0x89, 0x99, 0x5b, 0x95, 0x4b, 0x29, 0x18, 0xa7, 0xcc, 0x59, 0xf7, 0x61,
-	0xe8, 0x1c, 0x2c, 0x70, 0xc7, 0xd5, 0xf6, 0x72, 0x6b, 0x45, 0xa5, 0xbc, 0xa2, 0x26, 0xb9, 0xe3,
-	0xee, 0xa1, 0x8f, 0xe0, 0xfc, 0x08, 0x9b, 0xd4, 0xc0, 0xdc, 0xf1, 0x98, 0xe6, 0x3a, 0xcf, 0x88,
-	0xa7, 0xe9, 0xd8, 0xcd, 0x65, 0x25, 0x06, 0x4d, 0x78, 0x6d, 0xc1, 0xaa, 0x63, 0x17, 0x5d, 0x81,
-	0xf5, 0x88, 0xaa, 0x31, 0xc2, 0x25, 0x7c, 0x5d, 0xc2, 0xd7, 0x22, 0x46, 0x87, 0x70, 0x81, 0xdd,
-	0x86, 0x34, 0x36, 0x4d, 0xe7, 0x99, 0x49, 0x19, 0xcf, 0xa1, 0x62, 0xa2, 0x9c, 0x56, 0x27, 0x04,
-	0xb4, 0x05, 0x29, 0x83, 0xd8, 0x63, 0xc9, 0x3c, 0x27, 0x99, 0xd1, 0x18, 0x5d, 0x84, 0xb4, 0x25,
-	0x72, 0x2c, 0xc7, 0x87, 0x24, 0x77, 0xbe, 0xa8, 0x94, 0x93, 0x6a, 0xca, 0xa2, 0x76, 0x47, 0x8c,
-	0x51, 0x05, 0xce, 0x49, 0x2d, 0x1a, 0xb5, 0xc5, 0x39, 0x8d, 0x88, 0x36, 0xc2, 0x26, 0xcb, 0xbd,
-	0x57, 0x54, 0xca, 0x29, 0x75, 0x5d, 0xb2, 0x5a, 0x01, 0x67, 0x1f, 0x9b, 0xec, 0x46, 0xf9, 0xb3,
-	0x2f, 0x0b, 0x73, 0x5f, 0x7c, 0x59, 0x98, 0xfb, 0xf3, 0xef, 0xaf, 0x6d, 0x05, 0xe9, 0xa7, 0xef,
-	0x8c, 0x2a, 0x41, 0xaa, 0xaa, 0xd4, 0x1d, 0x9b, 0x13, 0x9b, 0xe7, 0x94, 0xd2, 0x5f, 0x15, 0xb8,
-	0x50, 0x8f, 0x5c, 0xc2, 0x72, 0x46, 0xd8, 0x7c, 0x97, 0xa9, 0xa7, 0x06, 0x69, 0x26, 0xce, 0x44,
-	0x06, 0x7b, 0xf2, 0x35, 0x82, 0x3d, 0x25, 0xc4, 0x04, 0xe3, 0x46, 0xf1, 0x95, 0x6b, 0xfa, 0xf7,
-	0x3c, 0x6c, 0x87, 0x6b, 0x7a, 0xe8, 0x18, 0xf4, 0x80, 0xea, 0xf8, 0x5d, 0xe7, 0xd4, 0xc8, 0xd7,
-	0x92, 0x33, 0xf8, 0xda, 0xc2, 0xeb, 0xf9, 0xda, 0xe2, 0x0c, 0xbe, 0xb6, 0x74, 0x96, 0xaf, 0xa5,
-	0xce, 0xf2, 0xb5, 0xf4, 0x6c, 0xbe, 0x06, 0xa7, 0xf9, 0xda, 0x7c, 0x4e, 0x29, 0xfd, 0x4a, 0x81,
-	0xf3, 0xcd, 0xa7, 0x43, 0x3a, 0x72, 0xde, 0xd2, 0x4e, 0xdf, 0x87, 0x15, 0x12, 0xd3, 0xc7, 0x72,
-	0x89, 0x62, 0xa2, 0x9c, 0xd9, 0xbd, 0x5c, 0x09, 0x0e, 0x3e, 0xaa, 0xc7, 0xe1, 0xe9, 0xc7, 0x67,
-	0x57, 0xa7, 0x65, 0xa5, 0x85, 0x7f, 0x54, 0x60, 0x4b, 0xe4, 0x85, 0x3e, 0x51, 0xc9, 0x33, 0xec,
-	0x19, 0x0d, 0x62, 0x3b, 0x16, 0x7b, 0x63, 0x3b, 0x4b, 0xb0, 0x62, 0x48, 0x4d, 0x1a, 0x77, 0x34,
-	0x6c, 0x18, 0xd2, 0x4e, 0x89, 0x11, 0xc4, 0xae, 0x53, 0x33, 0x0c, 0x54, 0x86, 0xec, 0x04, 0xe3,
-	0x89, 0x18, 0x13, 0xae, 0x2f, 0x60, 0xab, 0x21, 0x4c, 0x46, 0x1e, 0xb9, 0x91, 0x3f, 0xdb, 0xb5,
-	0x4b, 0xff, 0x54, 0x20, 0x7b, 0xc7, 0x74, 0x7a, 0xd8, 0xec, 0x98, 0x98, 0x0d, 0x44, 0xce, 0x1c,
-	0x8b, 0x90, 0xf2, 0x48, 0x50, 0xac, 0xa4, 0xf9, 0x33, 0x87, 0x94, 0x10, 0x93, 0xe5, 0xf3, 0x26,
-	0xac, 0x47, 0xe5, 0x23, 0x72, 0x70, 0xb9, 0xda, 0x5b, 0xe7, 0x5e, 0x7c, 0x53, 0x58, 0x0b, 0x83,
-	0xa9, 0x2e, 0x9d, 0xbd, 0xa1, 0xae, 0xe9, 0x53, 0x04, 0x03, 0xe5, 0x21, 0x43, 0x7b, 0xba, 0xc6,
-	0xc8, 0x53, 0xcd, 0x1e, 0x5a, 0x32, 0x36, 0x92, 0x6a, 0x9a, 0xf6, 0xf4, 0x0e, 0x79, 0xba, 0x37,
-	0xb4, 0xd0, 0xc7, 0xb0, 0x11, 0x36, 0x95, 0xc2, 0x9b, 0x34, 0x21, 0x2f, 0xb6, 0xcb, 0x93, 0xe1,
-	0xb2, 0xac, 0x9e, 0x0b, 0xb9, 0xfb, 0xd8, 0x14, 0x93, 0xd5, 0x0c, 0xc3, 0x2b, 0xfd, 0x6b, 0x01,
-	0x16, 0xdb, 0xd8, 0xc3, 0x16, 0x43, 0x5d, 0x58, 0xe3, 0xc4, 0x72, 0x4d, 0xcc, 0x89, 0xe6, 0xb7,
-	0x26, 0xc1, 0x4a, 0xaf, 0xca, 0x96, 0x25, 0xde, 0x00, 0x56, 0x62, 0x2d, 0xdf, 0x68, 0xa7, 0x52,
-	0x97, 0xd4, 0x0e, 0xc7, 0x9c, 0xa8, 0xab, 0xa1, 0x0e, 0x9f, 0x88, 0xae, 0x43, 0x8e, 0x7b, 0x43,
-	0xc6, 0x27, 0x4d, 0xc3, 0xa4, 0x5a, 0xfa, 0x67, 0xbd, 0x11, 0xf2, 0xfd, 0x3a, 0x1b, 0x55, 0xc9,
-	0x93, 0xfb, 0x83, 0xc4, 0x9b, 0xf4, 0x07, 0x06, 0x6c, 0x33, 0x71, 0xa8, 0x9a, 0x45, 0xb8, 0xac,
-	0xe2, 0xae, 0x49, 0x6c, 0xca, 0x06, 0xa1, 0xf2, 0xc5, 0xd9, 0x95, 0x6f, 0x4a, 0x45, 0x0f, 0x85,
-	0x1e, 0x35, 0x54, 0x13, 0xcc, 0x52, 0x87, 0xfc, 0xc9, 0xb3, 0x44, 0x0b, 0x5f, 0x92, 0x0b, 0xbf,
-	0x78, 0x82, 0x8a, 0x68, 0xf5, 0x0c, 0x3e, 0x8c, 0x75, 0x1b, 0x22, 0x9a, 0x34, 0xe9, 0xc8, 0x9a,
-	0x47, 0xfa, 0xa2, 0x24, 0x63, 0xbf, 0xf1, 0x20, 0x24, 0xea, 0x98, 0x02, 0x9f, 0x16, 0xed, 0x72,
-	0xcc, 0xa9, 0xa9, 0x1d, 0xb4, 0x95, 0xa5, 0x49, 0x53, 0x12, 0xc5, 0xa6, 0x1a, 0xd3, 0x75, 0x9b,
-	0x10, 0x11, 0x45, 0xb1, 0xc6, 0x84, 0xb8, 0x8e, 0x3e, 0x90, 0x39, 0x29, 0xa1, 0xae, 0x46, 0x4d,
-	0x48, 0x53, 0x50, 0xd1, 0x13, 0xb8, 0x6a, 0x0f, 0xad, 0x1e, 0xf1, 0x34, 0xe7, 0xc0, 0x07, 0xca,
-	0xc8, 0x63, 0x1c, 0x7b, 0x5c, 0xf3, 0x88, 0x4e, 0xe8, 0x48, 0x9c, 0xb8, 0x6f, 0x39, 0x93, 0x7d,
-	0x51, 0x42, 0xbd, 0xec, 0x8b, 0x3c, 0x3a, 0x90, 0x3a, 0x58, 0xd7, 0xe9, 0x08, 0xb8, 0x1a, 0xa2,
-	0x7d, 0xc3, 0x18, 0x6a, 0xc1, 0x25, 0x0b, 0x3f, 0xd7, 0x22, 0x67, 0x16, 0x86, 0x13, 0x9b, 0x0d,
-	0x99, 0x36, 0x49, 0xe6, 0x41, 0x6f, 0x94, 0xb7, 0xf0, 0xf3, 0x76, 0x80, 0xab, 0x87, 0xb0, 0xfd,
-	0x08, 0x75, 0x2f, 0x99, 0x4a, 0x66, 0x17, 0xee, 0x25, 0x53, 0x0b, 0xd9, 0xc5, 0x7b, 0xc9, 0x54,
-	0x2a, 0x9b, 0x2e, 0xfd, 0x3f, 0xa4, 0x65, 0x5c, 0xd7, 0xf4, 0x43, 0x26, 0xb3, 0xbb, 0x61, 0x78,
-	0x84, 0x31, 0xc2, 0x72, 0x4a, 0x90, 0xdd, 0x43, 0x42, 0x89, 0xc3, 0xe6, 0x69, 0x37, 0x06, 0x86,
-	0x3e, 0x85, 0x25, 0x97, 0xc8, 0x76, 0x56, 0x0a, 0x66, 0x76, 0x3f, 0xa9, 0xcc, 0x70, 0xd5, 0xab,
-	0x9c, 0xa6, 0x50, 0x0d, 0xb5, 0x95, 0xbc, 0xc9, 0x3d, 0xe5, 0x48, 0xaf, 0xc0, 0xd0, 0xfe, 0xd1,
-	0x49, 0xbf, 0xff, 0x5a, 0x93, 0x1e, 0xd1, 0x37, 0x99, 0xf3, 0x2a, 0x64, 0x6a, 0xfe, 0xb2, 0x1f,
-	0x88, 0xd2, 0x75, 0x6c, 0x5b, 0x96, 0xe3, 0xdb, 0xb2, 0x07, 0xab, 0x41, 0xf3, 0xd7, 0x75, 0x64,
-	0x6e, 0x42, 0xef, 0x03, 0x04, 0x5d, 0xa3, 0xc8, 0x69, 0x7e, 0x76, 0x4f, 0x07, 0x94, 0x96, 0x31,
-	0x55, 0xd1, 0xe7, 0xa7, 0x2a, 0xba, 0xac, 0x1a, 0x0e, 0x6c, 0xee, 0xc7, 0xab, 0xae, 0x2c, 0x20,
-	0x6d, 0xac, 0x1f, 0x12, 0xce, 0x90, 0x0a, 0x49, 0x59, 0x5d, 0xfd, 0xe5, 0x5e, 0x3f, 0x75, 0xb9,
-	0xa3, 0x9d, 0xca, 0x69, 0x4a, 0x1a, 0x98, 0xe3, 0x20, 0x06, 0xa4, 0xae, 0xd2, 0xcf, 0x15, 0xc8,
-	0xdd, 0x27, 0xe3, 0x1a, 0x63, 0xb4, 0x6f, 0x5b, 0xc4, 0xe6, 0x22, 0xfa, 0xb0, 0x4e, 0xc4, 0x27,
-	0xfa, 0x00, 0x56, 0x22, 0xc7, 0x93, 0xc9, 0x53, 0x91, 0xc9, 0x73, 0x39, 0x24, 0x8a, 0x7d, 0x42,
-	0x37, 0x00, 0x5c, 0x8f, 0x8c, 0x34, 0x5d, 0x3b, 0x24, 0x63, 0xb9, 0xa6, 0xcc, 0xee, 0x76, 0x3c,
-	0x29, 0xfa, 0xb7, 0xde, 0x4a, 0x7b, 0xd8, 0x33, 0xa9, 0x7e, 0x9f, 0x8c, 0xd5, 0x94, 0xc0, 0xd7,
-	0xef, 0x93, 0xb1, 0xa8, 0x82, 0xb2, 0x49, 0x91, 0x99, 0x2c, 0xa1, 0xfa, 0x83, 0xd2, 0x2f, 0x15,
-	0xb8, 0x10, 0x2d, 0x20, 0x3c, 0xaf, 0xf6, 0xb0, 0x27, 0x24, 0xe2, 0xfb, 0xa7, 0x4c, 0x77, 0x44,
-	0xc7, 0xac, 0x9d, 0x3f, 0xc1, 0xda, 0x9b, 0xb0, 0x1c, 0xa5, 0x12, 0x61, 0x6f, 0x62, 0x06, 0x7b,
-	0x33, 0xa1, 0xc4, 0x7d, 0x32, 0x2e, 0xfd, 0x24, 0x66, 0xdb, 0xad, 0x71, 0xcc, 0x85, 0xbd, 0x57,
-	0xd8, 0x16, 0x4d, 0x1b, 0xb7, 0x4d, 0x8f, 0xcb, 0x1f, 0x5b, 0x40, 0xe2, 0xf8, 0x02, 0x4a, 0x7f,
-	0x51, 0x60, 0x23, 0x3e, 0x2b, 0xeb, 0x3a, 0x6d, 0x6f, 0x68, 0x93, 0xfd, 0xdd, 0xb3, 0xe6, 0xbf,
-	0x09, 0x29, 0x57, 0xa0, 0x34, 0xce, 0x82, 0x23, 0x9a, 0xad, 0x64, 0x2f, 0x49, 0xa9, 0xae, 0x08,
-	0xf1, 0xd5, 0xa9, 0x05, 0xb0, 0x60, 0xe7, 0x3e, 0x9a, 0x29, 0xe8, 0x62, 0x01, 0xa5, 0xae, 0xc4,
-	0xd7, 0xcc, 0x4a, 0x7f, 0x50, 0x00, 0x1d, 0xcf, 0x56, 0xe8, 0x3b, 0x80, 0xa6, 0x72, 0x5e, 0xdc,
-	0xff, 0xb2, 0x6e, 0x2c, 0xcb, 0xc9, 0x9d, 0x8b, 0xfc, 0x68, 0x3e, 0xe6, 0x47, 0xe8, 0x7b, 0x00,
-	0xae, 0x3c, 0xc4, 0x99, 0x4f, 0x3a, 0xed, 0x86, 0x9f, 0xa8, 0x00, 0x99, 0x1f, 0x39, 0xd4, 0x8e,
-	0x3f, 0x58, 0x24, 0x54, 0x10, 0x24, 0xff, 0x2d, 0xa2, 0xf4, 0x33, 0x65, 0x92, 0x12, 0x83, 0x6c,
-	0x5d, 0x33, 0xcd, 0xa0, 0x07, 0x44, 0x2e, 0x2c, 0x85, 0xf9, 0xde, 0x0f, 0xd7, 0xed, 0x13, 0x6b,
-	0x52, 0x83, 0xe8, 0xb2, 0x2c, 0x5d, 0x17, 0x3b, 0xfe, 0xdb, 0x6f, 0x0b, 0x57, 0xfb, 0x94, 0x0f,
-	0x86, 0xbd, 0x8a, 0xee, 0x58, 0xc1, 0x2b, 0x4e, 0xf0, 0xef, 0x1a, 0x33, 0x0e, 0xab, 0x7c, 0xec,
-	0x12, 0x16, 0xca, 0xb0, 0xdf, 0xfc, 0xe3, 0x77, 0x57, 0x14, 0x35, 0x9c, 0xa6, 0x64, 0x40, 0x36,
-	0xba, 0x83, 0x10, 0x8e, 0x0d, 0xcc, 0x31, 0x42, 0x90, 0xb4, 0xb1, 0x15, 0x36, 0x99, 0xf2, 0x7b,
-	0x86, 0x1e, 0x73, 0x0b, 0x52, 0x56, 0xa0, 0x21, 0xb8, 0x75, 0x44, 0xe3, 0xd2, 0x4f, 0x17, 0xa1,
-	0x18, 0x4e, 0xd3, 0xf2, 0xdf, 0x66, 0xe8, 0x8f, 0xfd, 0x16, 0x5c, 0x74, 0x4e, 0xa2, 0x7e, 0xb3,
-	0x13, 0xde, 0x7b, 0x94, 0xb7, 0xf3, 0xde, 0x33, 0xff, 0xca, 0xf7, 0x9e, 0xc4, 0x2b, 0xde, 0x7b,
-	0x92, 0x6f, 0xef, 0xbd, 0x67, 0xe1, 0xad, 0xbf, 0xf7, 0x2c, 0xbe, 0xa3, 0xf7, 0x9e, 0xa5, 0xff,
-	0xc9, 0x7b, 0x4f, 0xea, 0xad, 0xbe, 0xf7, 0xa4, 0xdf, 0xec, 0xbd, 0x07, 0xde, 0xe8, 0xbd, 0x27,
-	0x33, 0xd3, 0x7b, 0x4f, 0xe9, 0x17, 0xf3, 0xb0, 0x21, 0x6f, 0xd2, 0x9d, 0x01, 0x76, 0xc5, 0xe1,
-	0x4e, 0x42, 0x20, 0xba, 0x9e, 0x2b, 0x33, 0x5c, 0xcf, 0xe7, 0x5f, 0xef, 0x7a, 0x9e, 0x98, 0xe1,
-	0x7a, 0x9e, 0x3c, 0xeb, 0x7a, 0xbe, 0x70, 0xd6, 0xf5, 0x7c, 0x71, 0xb6, 0xeb, 0xf9, 0xd2, 0x29,
-	0xd7, 0xf3, 0x52, 0x01, 0x32, 0x51, 0x82, 0x30, 0x18, 0xca, 0x42, 0x82, 0x1a, 0x61, 0x43, 0x29,
-	0x3e, 0x4b, 0x3b, 0x70, 0xa1, 0x16, 0x9a, 0x45, 0x8c, 0xf8, 0xed, 0x18, 0x6d, 0xc0, 0xa2, 0x7f,
-	0x43, 0x0d, 0xf0, 0xc1, 0xe8, 0xca, 0x9f, 0x14, 0x58, 0x89, 0x1a, 0x81, 0x01, 0x66, 0x04, 0xe5,
-	0x61, 0xab, 0xfe, 0x68, 0xaf, 0xf3, 0xf8, 0x61, 0x53, 0xd5, 0xda, 0x77, 0x6b, 0x9d, 0xa6, 0xf6,
-	0x78, 0xaf, 0xd3, 0x6e, 0xd6, 0x5b, 0xb7, 0x5b, 0xcd, 0x46, 0x76, 0x0e, 0xbd, 0x0f, 0x9b, 0x47,
-	0xf8, 0x6a, 0xf3, 0x4e, 0xab, 0xd3, 0x6d, 0xaa, 0xcd, 0x46, 0x56, 0x39, 0x41, 0xbc, 0xb5, 0xd7,
-	0xea, 0xb6, 0x6a, 0x0f, 0x5a, 0x4f, 0x9a, 0x8d, 0xec, 0x3c, 0xba, 0x08, 0x17, 0x8e, 0xf0, 0x1f,
-	0xd4, 0x1e, 0xef, 0xd5, 0xef, 0x36, 0x1b, 0xd9, 0x04, 0xda, 0x82, 0x8d, 0x23, 0xcc, 0x4e, 0xf7,
-	0x51, 0xbb, 0xdd, 0x6c, 0x64, 0x93, 0x27, 0xf0, 0x1a, 0xcd, 0x07, 0xcd, 0x6e, 0xb3, 0x91, 0x5d,
-	0xd8, 0x4a, 0x7e, 0xf6, 0xeb, 0xfc, 0xdc, 0xad, 0x4f, 0xbf, 0x7a, 0x91, 0x57, 0xbe, 0x7e, 0x91,
-	0x57, 0xfe, 0xfe, 0x22, 0xaf, 0x7c, 0xfe, 0x32, 0x3f, 0xf7, 0xf5, 0xcb, 0xfc, 0xdc, 0xdf, 0x5e,
-	0xe6, 0xe7, 0x9e, 0x7c, 0x72, 0x3c, 0xf9, 0x4f, 0x8a, 0xeb, 0xb5, 0xe8, 0xc7, 0x90, 0xd1, 0x77,
-	0xab, 0xcf, 0xa7, 0x7f, 0x6a, 0x91, 0x75, 0xa1, 0xb7, 0x28, 0xe3, 0xfa, 0xe3, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0x21, 0xa7, 0x53, 0x56, 0x9b, 0x19, 0x00, 0x00,
+func (m *ConsumerLifetime) GetRemindersEmitted() uint32 {
+	if m != nil {
+		return m.RemindersEmitted
+	}
+	return 0
 }
 
-func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerLifetime) GetNextReminderTime() time.Time {
+	if m != nil {
+		return m.NextReminderTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerValSetSnapshot contains the validator set of a consumer chain
+// computed by the provider at a given height
+type ConsumerValSetSnapshot struct {
+	Validators []ConsensusValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.MinStake != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
+func (m *ConsumerValSetSnapshot) Reset()         { *m = ConsumerValSetSnapshot{} }
+func (m *ConsumerValSetSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ConsumerValSetSnapshot) ProtoMessage()    {}
+func (*ConsumerValSetSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{28}
+}
+func (m *ConsumerValSetSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerValSetSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerValSetSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.DistributionTransmissionChannel) > 0 {
-		i -= len(m.DistributionTransmissionChannel)
-		copy(dAtA[i:], m.DistributionTransmissionChannel)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintProvider(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintProvider(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x4a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintProvider(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintProvider(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *ConsumerValSetSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerValSetSnapshot.Merge(m, src)
+}
+func (m *ConsumerValSetSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerValSetSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerValSetSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerValSetSnapshot proto.InternalMessageInfo
+
+func (m *ConsumerValSetSnapshot) GetValidators() []ConsensusValidator {
+	if m != nil {
+		return m.Validators
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// ConsumerEconomicSecurity records the economic security of a consumer chain, i.e.,
+// the provider stake backing the validator set computed for the consumer chain at a given height
+type ConsumerEconomicSecurity struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the provider height at which the validator set was computed
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at `height`
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the total power of the validator set
+	TotalPower int64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// the provider tokens equivalent to `total_power`, i.e., `total_power` multiplied by the power reduction
+	TotalTokens cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=total_tokens,json=totalTokens,proto3,customtype=cosmossdk.io/math.Int" json:"total_tokens"`
+	// the number of validators in the validator set
+	ValidatorCount uint32 `protobuf:"varint,6,opt,name=validator_count,json=validatorCount,proto3" json:"validator_count,omitempty"`
+}
+
+func (m *ConsumerEconomicSecurity) Reset()         { *m = ConsumerEconomicSecurity{} }
+func (m *ConsumerEconomicSecurity) String() string { return proto.CompactTextString(m) }
+func (*ConsumerEconomicSecurity) ProtoMessage()    {}
+func (*ConsumerEconomicSecurity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{29}
+}
+func (m *ConsumerEconomicSecurity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerEconomicSecurity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerEconomicSecurity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerEconomicSecurity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerEconomicSecurity.Merge(m, src)
+}
+func (m *ConsumerEconomicSecurity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerEconomicSecurity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerEconomicSecurity.DiscardUnknown(m)
 }
 
-func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerEconomicSecurity proto.InternalMessageInfo
+
+func (m *ConsumerEconomicSecurity) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerEconomicSecurity) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintProvider(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *ConsumerEconomicSecurity) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *ConsumerEconomicSecurity) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerEconomicSecurity) GetValidatorCount() uint32 {
+	if m != nil {
+		return m.ValidatorCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AllowlistedRewardDenoms corresponds to the denoms allowlisted by a specific consumer id
+type AllowlistedRewardDenoms struct {
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.MinStake != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+func (m *AllowlistedRewardDenoms) Reset()         { *m = AllowlistedRewardDenoms{} }
+func (m *AllowlistedRewardDenoms) String() string { return proto.CompactTextString(m) }
+func (*AllowlistedRewardDenoms) ProtoMessage()    {}
+func (*AllowlistedRewardDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{30}
+}
+func (m *AllowlistedRewardDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowlistedRewardDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowlistedRewardDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *AllowlistedRewardDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowlistedRewardDenoms.Merge(m, src)
+}
+func (m *AllowlistedRewardDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowlistedRewardDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowlistedRewardDenoms.DiscardUnknown(m)
 }
 
-func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllowlistedRewardDenoms proto.InternalMessageInfo
+
+func (m *AllowlistedRewardDenoms) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AllowedIcaMsgTypes is the list of message type URLs (e.g., "/cosmos.bank.v1beta1.MsgSend") that the interchain
+// accounts controlled by the owner of a consumer chain are allowed to execute on the consumer chain
+type AllowedIcaMsgTypes struct {
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
 }
 
-func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Equivocations) > 0 {
-		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *AllowedIcaMsgTypes) Reset()         { *m = AllowedIcaMsgTypes{} }
+func (m *AllowedIcaMsgTypes) String() string { return proto.CompactTextString(m) }
+func (*AllowedIcaMsgTypes) ProtoMessage()    {}
+func (*AllowedIcaMsgTypes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{31}
+}
+func (m *AllowedIcaMsgTypes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedIcaMsgTypes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedIcaMsgTypes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *AllowedIcaMsgTypes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedIcaMsgTypes.Merge(m, src)
+}
+func (m *AllowedIcaMsgTypes) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedIcaMsgTypes) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedIcaMsgTypes.DiscardUnknown(m)
 }
 
-func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AllowedIcaMsgTypes proto.InternalMessageInfo
+
+func (m *AllowedIcaMsgTypes) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PowerShapingAdmin is the address permitted to update the allowlist and denylist of a consumer chain
+// on behalf of its owner
+type PowerShapingAdmin struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomsToRemove) > 0 {
-		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToRemove[iNdEx])
-			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.DenomsToAdd) > 0 {
-		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToAdd[iNdEx])
-			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *PowerShapingAdmin) Reset()         { *m = PowerShapingAdmin{} }
+func (m *PowerShapingAdmin) String() string { return proto.CompactTextString(m) }
+func (*PowerShapingAdmin) ProtoMessage()    {}
+func (*PowerShapingAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{32}
+}
+func (m *PowerShapingAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerShapingAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerShapingAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *PowerShapingAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerShapingAdmin.Merge(m, src)
+}
+func (m *PowerShapingAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerShapingAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerShapingAdmin.DiscardUnknown(m)
 }
 
-func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PowerShapingAdmin proto.InternalMessageInfo
+
+func (m *PowerShapingAdmin) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardsPaused is whether the reward distribution of a consumer chain is paused, i.e., whether
+// the ICS rewards received from the consumer chain are held back instead of distributed
+type RewardsPaused struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderValConsAddr) > 0 {
-		i -= len(m.ProviderValConsAddr)
-		copy(dAtA[i:], m.ProviderValConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IbcSeqNum != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ConsumerChainID) > 0 {
-		i -= len(m.ConsumerChainID)
-		copy(dAtA[i:], m.ConsumerChainID)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
-	if err7 != nil {
-		return 0, err7
+func (m *RewardsPaused) Reset()         { *m = RewardsPaused{} }
+func (m *RewardsPaused) String() string { return proto.CompactTextString(m) }
+func (*RewardsPaused) ProtoMessage()    {}
+func (*RewardsPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{33}
+}
+func (m *RewardsPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n7
-	i = encodeVarintProvider(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *RewardsPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsPaused.Merge(m, src)
+}
+func (m *RewardsPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsPaused.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RewardsPaused proto.InternalMessageInfo
+
+func (m *RewardsPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VscIdToHeight maps a valset update id sent to a consumer chain
+// to the provider block height and time at which the VSC packet was queued
+type VscIdToHeight struct {
+	VscId uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// the provider block height used as infraction height for
+	// slash packets with this valset update id
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at which the VSC packet was queued
+	Timestamp time.Time `protobuf:"bytes,3,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxProviderConsensusValidators != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderConsensusValidators))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.BlocksPerEpoch != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *VscIdToHeight) Reset()         { *m = VscIdToHeight{} }
+func (m *VscIdToHeight) String() string { return proto.CompactTextString(m) }
+func (*VscIdToHeight) ProtoMessage()    {}
+func (*VscIdToHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{34}
+}
+func (m *VscIdToHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VscIdToHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VscIdToHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.SlashMeterReplenishFraction) > 0 {
-		i -= len(m.SlashMeterReplenishFraction)
-		copy(dAtA[i:], m.SlashMeterReplenishFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintProvider(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err10 != nil {
-		return 0, err10
+		return b[:n], nil
 	}
-	i -= n10
-	i = encodeVarintProvider(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TrustingPeriodFraction) > 0 {
-		i -= len(m.TrustingPeriodFraction)
-		copy(dAtA[i:], m.TrustingPeriodFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *VscIdToHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VscIdToHeight.Merge(m, src)
+}
+func (m *VscIdToHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *VscIdToHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_VscIdToHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VscIdToHeight proto.InternalMessageInfo
+
+func (m *VscIdToHeight) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	if m.TemplateClient != nil {
-		{
-			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *VscIdToHeight) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VscIdToHeight) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EpochInfo describes the current epoch on the provider. An epoch starts
+// with the block in which VSC packets are queued for the consumer chains.
+type EpochInfo struct {
+	// the index of the current epoch, i.e., the number of epochs that started
+	// since the epoch info was first materialized in the store
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// the height of the first block of the current epoch
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the time of the first block of the current epoch
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// the average block time during the previous epoch
+	AverageBlockTime time.Duration `protobuf:"bytes,4,opt,name=average_block_time,json=averageBlockTime,proto3,stdduration" json:"average_block_time"`
 }
 
-func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
+func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
+func (*EpochInfo) ProtoMessage()    {}
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{35}
+}
+func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfo.Merge(m, src)
+}
+func (m *EpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
 }
 
-func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EpochInfo proto.InternalMessageInfo
+
+func (m *EpochInfo) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EpochInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *EpochInfo) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EpochInfo) GetAverageBlockTime() time.Duration {
+	if m != nil {
+		return m.AverageBlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardAttributionRecord records the decision of the provider on an incoming
+// transfer to the consumer rewards pool attributed to a consumer chain
+type RewardAttributionRecord struct {
+	// the sequence number of the record among the records of the consumer chain
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the provider block height at which the transfer was received
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at which the transfer was received
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the provider channel on which the transfer was received
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sender of the transfer on the source chain
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the denom of the transferred tokens on the provider
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the amount of transferred tokens
+	Amount string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// the decision of the provider on the transfer
+	Decision RewardAttributionDecision `protobuf:"varint,8,opt,name=decision,proto3,enum=interchain_security.ccv.provider.v1.RewardAttributionDecision" json:"decision,omitempty"`
+	// the reason of the decision, if the rewards were not credited
+	Reason string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RewardAttributionRecord) Reset()         { *m = RewardAttributionRecord{} }
+func (m *RewardAttributionRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardAttributionRecord) ProtoMessage()    {}
+func (*RewardAttributionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{36}
+}
+func (m *RewardAttributionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RewardAttributionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardAttributionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *AddressList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RewardAttributionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardAttributionRecord.Merge(m, src)
+}
+func (m *RewardAttributionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardAttributionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardAttributionRecord.DiscardUnknown(m)
 }
 
-func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_RewardAttributionRecord proto.InternalMessageInfo
 
-func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *RewardAttributionRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardAttributionRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardAttributionRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
 }
 
-func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *RewardAttributionRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardAttributionRecord) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardAttributionRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *RewardAttributionRecord) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardAttributionRecord) GetDecision() RewardAttributionDecision {
+	if m != nil {
+		return m.Decision
 	}
-	return dAtA[:n], nil
+	return REWARD_ATTRIBUTION_DECISION_UNSPECIFIED
 }
 
-func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardAttributionRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PrevCKey != nil {
-		{
-			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+// SkippedDowntimeSlash records a downtime slash of a consumer chain that was not executed, since the
+// validator was opted in because it belongs to the top N and it is within the downtime grace period
+type SkippedDowntimeSlash struct {
+	// the sequence number of the record among the records of the consumer chain
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the provider block height at which the slash packet was handled
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at which the slash packet was handled
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,4,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,5,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the valset update id of the slash packet
+	ValsetUpdateId uint64 `protobuf:"varint,6,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the time at which the validator was automatically opted in
+	OptInTime time.Time `protobuf:"bytes,7,opt,name=opt_in_time,json=optInTime,proto3,stdtime" json:"opt_in_time"`
+}
+
+func (m *SkippedDowntimeSlash) Reset()         { *m = SkippedDowntimeSlash{} }
+func (m *SkippedDowntimeSlash) String() string { return proto.CompactTextString(m) }
+func (*SkippedDowntimeSlash) ProtoMessage()    {}
+func (*SkippedDowntimeSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{37}
+}
+func (m *SkippedDowntimeSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SkippedDowntimeSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SkippedDowntimeSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SkippedDowntimeSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedDowntimeSlash.Merge(m, src)
+}
+func (m *SkippedDowntimeSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *SkippedDowntimeSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedDowntimeSlash.DiscardUnknown(m)
 }
 
-func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SkippedDowntimeSlash proto.InternalMessageInfo
+
+func (m *SkippedDowntimeSlash) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SkippedDowntimeSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *SkippedDowntimeSlash) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SkippedDowntimeSlash) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SkippedDowntimeSlash) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
+	}
+	return ""
 }
 
-func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConsumerAddr) > 0 {
-		i -= len(m.ConsumerAddr)
-		copy(dAtA[i:], m.ConsumerAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *SkippedDowntimeSlash) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SkippedDowntimeSlash) GetOptInTime() time.Time {
+	if m != nil {
+		return m.OptInTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnroutableSlashPacket records a slash packet of a consumer chain that could not be routed,
+// since its consumer consensus address could not be mapped to a provider validator
+type UnroutableSlashPacket struct {
+	// the sequence number of the record among the records of the consumer chain
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the provider block height at which the slash packet was received
+	ReceivedHeight int64 `protobuf:"varint,2,opt,name=received_height,json=receivedHeight,proto3" json:"received_height,omitempty"`
+	// the provider block time at which the slash packet was received
+	ReceivedTime time.Time `protobuf:"bytes,3,opt,name=received_time,json=receivedTime,proto3,stdtime" json:"received_time"`
+	// the consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,4,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the provider consensus address the consumer consensus address was mapped to
+	// (i.e., the consumer consensus address if no key was assigned)
+	ProviderAddress string `protobuf:"bytes,5,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the valset update id of the slash packet
+	ValsetUpdateId uint64 `protobuf:"varint,6,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the provider block height mapped to the valset update id of the slash packet,
+	// i.e., the height of the infraction on the provider (zero if it is not found)
+	InfractionHeight uint64 `protobuf:"varint,7,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+}
+
+func (m *UnroutableSlashPacket) Reset()         { *m = UnroutableSlashPacket{} }
+func (m *UnroutableSlashPacket) String() string { return proto.CompactTextString(m) }
+func (*UnroutableSlashPacket) ProtoMessage()    {}
+func (*UnroutableSlashPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{38}
+}
+func (m *UnroutableSlashPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerAddrs != nil {
-		{
-			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *UnroutableSlashPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnroutableSlashPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
-	if err15 != nil {
-		return 0, err15
+}
+func (m *UnroutableSlashPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnroutableSlashPacket.Merge(m, src)
+}
+func (m *UnroutableSlashPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnroutableSlashPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnroutableSlashPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnroutableSlashPacket proto.InternalMessageInfo
+
+func (m *UnroutableSlashPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	i -= n15
-	i = encodeVarintProvider(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *UnroutableSlashPacket) GetReceivedHeight() int64 {
+	if m != nil {
+		return m.ReceivedHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ConsensusValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UnroutableSlashPacket) GetReceivedTime() time.Time {
+	if m != nil {
+		return m.ReceivedTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsensusValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UnroutableSlashPacket) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
+	}
+	return ""
 }
 
-func (m *ConsensusValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.JoinHeight != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
-		i--
-		dAtA[i] = 0x20
+func (m *UnroutableSlashPacket) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	if m.PublicKey != nil {
-		{
-			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *UnroutableSlashPacket) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *UnroutableSlashPacket) GetInfractionHeight() uint64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
+}
+
+// PreOptInInfraction records a downtime infraction of a consumer chain that was dropped, since it was committed
+// before the opt-in of the validator to the consumer chain became effective
+type PreOptInInfraction struct {
+	// the sequence number of the record among the records of the consumer chain
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the provider block height at which the slash packet was handled
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at which the slash packet was handled
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,4,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,5,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the valset update id of the slash packet
+	ValsetUpdateId uint64 `protobuf:"varint,6,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the provider block height mapped to the valset update id of the slash packet,
+	// i.e., the height of the infraction on the provider
+	InfractionHeight uint64 `protobuf:"varint,7,opt,name=infraction_height,json=infractionHeight,proto3" json:"infraction_height,omitempty"`
+	// the provider block height at which the opt-in of the validator became effective
+	OptInHeight uint64 `protobuf:"varint,8,opt,name=opt_in_height,json=optInHeight,proto3" json:"opt_in_height,omitempty"`
+}
+
+func (m *PreOptInInfraction) Reset()         { *m = PreOptInInfraction{} }
+func (m *PreOptInInfraction) String() string { return proto.CompactTextString(m) }
+func (*PreOptInInfraction) ProtoMessage()    {}
+func (*PreOptInInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{39}
+}
+func (m *PreOptInInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreOptInInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreOptInInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *PreOptInInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreOptInInfraction.Merge(m, src)
+}
+func (m *PreOptInInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreOptInInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreOptInInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreOptInInfraction proto.InternalMessageInfo
+
+func (m *PreOptInInfraction) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if len(m.ProviderConsAddr) > 0 {
-		i -= len(m.ProviderConsAddr)
-		copy(dAtA[i:], m.ProviderConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *PreOptInInfraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PreOptInInfraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PreOptInInfraction) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *PreOptInInfraction) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PreOptInInfraction) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PreOptInInfraction) GetInfractionHeight() uint64 {
+	if m != nil {
+		return m.InfractionHeight
+	}
+	return 0
 }
 
-func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
+func (m *PreOptInInfraction) GetOptInHeight() uint64 {
+	if m != nil {
+		return m.OptInHeight
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// ConsumerClientExpiry describes the remaining trusting period of the client to a consumer chain
+type ConsumerClientExpiry struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the id of the client to the consumer chain
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the status of the client
+	Status ConsumerClientStatus `protobuf:"varint,4,opt,name=status,proto3,enum=interchain_security.ccv.provider.v1.ConsumerClientStatus" json:"status,omitempty"`
+	// the trusting period of the client
+	TrustingPeriod time.Duration `protobuf:"bytes,5,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	// the time at which the client expires, i.e., the timestamp of
+	// its latest consensus state plus its trusting period
+	ExpiryTime time.Time `protobuf:"bytes,6,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// the remaining time until the client expires; zero if the client expired
+	RemainingTime time.Duration `protobuf:"bytes,7,opt,name=remaining_time,json=remainingTime,proto3,stdduration" json:"remaining_time"`
+}
+
+func (m *ConsumerClientExpiry) Reset()         { *m = ConsumerClientExpiry{} }
+func (m *ConsumerClientExpiry) String() string { return proto.CompactTextString(m) }
+func (*ConsumerClientExpiry) ProtoMessage()    {}
+func (*ConsumerClientExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{40}
+}
+func (m *ConsumerClientExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerClientExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerClientExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ConsumerClientExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerClientExpiry.Merge(m, src)
+}
+func (m *ConsumerClientExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerClientExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerClientExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerClientExpiry proto.InternalMessageInfo
+
+func (m *ConsumerClientExpiry) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerInitializationParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerClientExpiry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsumerInitializationParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerClientExpiry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DistributionTransmissionChannel) > 0 {
-		i -= len(m.DistributionTransmissionChannel)
-		copy(dAtA[i:], m.DistributionTransmissionChannel)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
-		i--
-		dAtA[i] = 0x5a
+func (m *ConsumerClientExpiry) GetStatus() ConsumerClientStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x50
+	return CONSUMER_CLIENT_STATUS_UNSPECIFIED
+}
+
+func (m *ConsumerClientExpiry) GetTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err17 != nil {
-		return 0, err17
-	}
-	i -= n17
-	i = encodeVarintProvider(dAtA, i, uint64(n17))
-	i--
-	dAtA[i] = 0x3a
-	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err18 != nil {
-		return 0, err18
-	}
-	i -= n18
-	i = encodeVarintProvider(dAtA, i, uint64(n18))
-	i--
-	dAtA[i] = 0x32
-	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err19 != nil {
-		return 0, err19
-	}
-	i -= n19
-	i = encodeVarintProvider(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x2a
-	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err20 != nil {
-		return 0, err20
-	}
-	i -= n20
-	i = encodeVarintProvider(dAtA, i, uint64(n20))
-	i--
-	dAtA[i] = 0x22
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ConsumerClientExpiry) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x12
+	return time.Time{}
+}
+
+func (m *ConsumerClientExpiry) GetRemainingTime() time.Duration {
+	if m != nil {
+		return m.RemainingTime
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// ConsumerRemovalRecord records why a consumer chain was stopped
+type ConsumerRemovalRecord struct {
+	// the reason why the consumer chain was stopped
+	Reason ConsumerRemovalReason `protobuf:"varint,1,opt,name=reason,proto3,enum=interchain_security.ccv.provider.v1.ConsumerRemovalReason" json:"reason,omitempty"`
+	// an optional note with details about the reason (e.g., the error that caused a launch to fail)
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *ConsumerRemovalRecord) Reset()         { *m = ConsumerRemovalRecord{} }
+func (m *ConsumerRemovalRecord) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRemovalRecord) ProtoMessage()    {}
+func (*ConsumerRemovalRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{41}
+}
+func (m *ConsumerRemovalRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRemovalRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRemovalRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerRemovalRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRemovalRecord.Merge(m, src)
+}
+func (m *ConsumerRemovalRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRemovalRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRemovalRecord.DiscardUnknown(m)
 }
 
-func (m *PowerShapingParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerRemovalRecord proto.InternalMessageInfo
+
+func (m *ConsumerRemovalRecord) GetReason() ConsumerRemovalReason {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return CONSUMER_REMOVAL_REASON_UNSPECIFIED
 }
 
-func (m *PowerShapingParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerRemovalRecord) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
 }
 
-func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// ConsumerCreationRecord records how a consumer chain was created
+type ConsumerCreationRecord struct {
+	// whether the consumer chain was created by the governance authority
+	// (i.e., through a governance proposal) or permissionlessly
+	CreatedByGovernance bool `protobuf:"varint,1,opt,name=created_by_governance,json=createdByGovernance,proto3" json:"created_by_governance,omitempty"`
+	// the id of the governance proposal that created the consumer chain
+	// (zero if the chain was created permissionlessly or the proposal id was not provided)
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *ConsumerCreationRecord) Reset()         { *m = ConsumerCreationRecord{} }
+func (m *ConsumerCreationRecord) String() string { return proto.CompactTextString(m) }
+func (*ConsumerCreationRecord) ProtoMessage()    {}
+func (*ConsumerCreationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{42}
+}
+func (m *ConsumerCreationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerCreationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerCreationRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
+		return b[:n], nil
 	}
-	if m.MinStake != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x30
+}
+func (m *ConsumerCreationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerCreationRecord.Merge(m, src)
+}
+func (m *ConsumerCreationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerCreationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerCreationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerCreationRecord proto.InternalMessageInfo
+
+func (m *ConsumerCreationRecord) GetCreatedByGovernance() bool {
+	if m != nil {
+		return m.CreatedByGovernance
 	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
+	return false
+}
+
+func (m *ConsumerCreationRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	return 0
+}
+
+// ConsumerUpgradeNotice is a notice published by the owner of a consumer chain
+// to inform the validators of the chain about an upcoming upgrade of the consumer binary
+type ConsumerUpgradeNotice struct {
+	// the name of the upgrade (a notice with the same name overwrites the previous one)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the consumer height at which the validators must upgrade
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the hash of the consumer binary or the commit hash of the release
+	BinaryHash string `protobuf:"bytes,3,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+	// the time after which the notice expires
+	Deadline time.Time `protobuf:"bytes,4,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *ConsumerUpgradeNotice) Reset()         { *m = ConsumerUpgradeNotice{} }
+func (m *ConsumerUpgradeNotice) String() string { return proto.CompactTextString(m) }
+func (*ConsumerUpgradeNotice) ProtoMessage()    {}
+func (*ConsumerUpgradeNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{43}
+}
+func (m *ConsumerUpgradeNotice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerUpgradeNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerUpgradeNotice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *ConsumerUpgradeNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerUpgradeNotice.Merge(m, src)
+}
+func (m *ConsumerUpgradeNotice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerUpgradeNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerUpgradeNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerUpgradeNotice proto.InternalMessageInfo
+
+func (m *ConsumerUpgradeNotice) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ConsumerUpgradeNotice) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ConsumerUpgradeNotice) GetBinaryHash() string {
+	if m != nil {
+		return m.BinaryHash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerIds) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerUpgradeNotice) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsumerIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerUpgradeNotices is a list of upgrade notices of a consumer chain
+// (used to store the upgrade notices of a consumer chain)
+type ConsumerUpgradeNotices struct {
+	Notices []ConsumerUpgradeNotice `protobuf:"bytes,1,rep,name=notices,proto3" json:"notices"`
 }
 
-func (m *ConsumerIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerUpgradeNotices) Reset()         { *m = ConsumerUpgradeNotices{} }
+func (m *ConsumerUpgradeNotices) String() string { return proto.CompactTextString(m) }
+func (*ConsumerUpgradeNotices) ProtoMessage()    {}
+func (*ConsumerUpgradeNotices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{44}
+}
+func (m *ConsumerUpgradeNotices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerUpgradeNotices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerUpgradeNotices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerUpgradeNotices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerUpgradeNotices.Merge(m, src)
+}
+func (m *ConsumerUpgradeNotices) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerUpgradeNotices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerUpgradeNotices.DiscardUnknown(m)
 }
 
-func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerUpgradeNotices proto.InternalMessageInfo
+
+func (m *ConsumerUpgradeNotices) GetNotices() []ConsumerUpgradeNotice {
+	if m != nil {
+		return m.Notices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllowlistedRewardDenoms) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StoreEntries is a list of entries of the provider store, as returned by a subspace query of the store
+// (i.e., it is wire compatible with the key/value pairs of the store, whose type is internal to the store package)
+type StoreEntries struct {
+	Entries []StoreEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *AllowlistedRewardDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *StoreEntries) Reset()         { *m = StoreEntries{} }
+func (m *StoreEntries) String() string { return proto.CompactTextString(m) }
+func (*StoreEntries) ProtoMessage()    {}
+func (*StoreEntries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{45}
+}
+func (m *StoreEntries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreEntries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreEntries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StoreEntries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreEntries.Merge(m, src)
+}
+func (m *StoreEntries) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreEntries) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreEntries.DiscardUnknown(m)
 }
 
-func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProvider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_StoreEntries proto.InternalMessageInfo
+
+func (m *StoreEntries) GetEntries() []StoreEntry {
+	if m != nil {
+		return m.Entries
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ConsumerAdditionProposal) Size() (n int) {
-	if m == nil {
-		return 0
+
+// StoreEntry is an entry of the provider store
+type StoreEntry struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *StoreEntry) Reset()         { *m = StoreEntry{} }
+func (m *StoreEntry) String() string { return proto.CompactTextString(m) }
+func (*StoreEntry) ProtoMessage()    {}
+func (*StoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{46}
+}
+func (m *StoreEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+}
+func (m *StoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreEntry.Merge(m, src)
+}
+func (m *StoreEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreEntry proto.InternalMessageInfo
+
+func (m *StoreEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return nil
+}
+
+func (m *StoreEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerCommissionRateSource", ConsumerCommissionRateSource_name, ConsumerCommissionRateSource_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.RewardAttributionDecision", RewardAttributionDecision_name, RewardAttributionDecision_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerClientStatus", ConsumerClientStatus_name, ConsumerClientStatus_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerRemovalInitiator", ConsumerRemovalInitiator_name, ConsumerRemovalInitiator_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerRemovalReason", ConsumerRemovalReason_name, ConsumerRemovalReason_value)
+	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
+	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
+	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
+	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
+	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
+	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
+	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
+	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
+	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
+	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
+	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
+	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
+	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
+	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
+	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
+	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
+	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
+	proto.RegisterType((*KeyAssignmentNonce)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentNonce")
+	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
+	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+	proto.RegisterType((*ValidatorRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ValidatorRewardsAllocation")
+	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
+	proto.RegisterType((*EndpointInfo)(nil), "interchain_security.ccv.provider.v1.EndpointInfo")
+	proto.RegisterType((*ConsumerTimeoutPeriods)(nil), "interchain_security.ccv.provider.v1.ConsumerTimeoutPeriods")
+	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
+	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
+	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
+	proto.RegisterType((*ConsumerLifetime)(nil), "interchain_security.ccv.provider.v1.ConsumerLifetime")
+	proto.RegisterType((*ConsumerValSetSnapshot)(nil), "interchain_security.ccv.provider.v1.ConsumerValSetSnapshot")
+	proto.RegisterType((*ConsumerEconomicSecurity)(nil), "interchain_security.ccv.provider.v1.ConsumerEconomicSecurity")
+	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
+	proto.RegisterType((*AllowedIcaMsgTypes)(nil), "interchain_security.ccv.provider.v1.AllowedIcaMsgTypes")
+	proto.RegisterType((*PowerShapingAdmin)(nil), "interchain_security.ccv.provider.v1.PowerShapingAdmin")
+	proto.RegisterType((*RewardsPaused)(nil), "interchain_security.ccv.provider.v1.RewardsPaused")
+	proto.RegisterType((*VscIdToHeight)(nil), "interchain_security.ccv.provider.v1.VscIdToHeight")
+	proto.RegisterType((*EpochInfo)(nil), "interchain_security.ccv.provider.v1.EpochInfo")
+	proto.RegisterType((*RewardAttributionRecord)(nil), "interchain_security.ccv.provider.v1.RewardAttributionRecord")
+	proto.RegisterType((*SkippedDowntimeSlash)(nil), "interchain_security.ccv.provider.v1.SkippedDowntimeSlash")
+	proto.RegisterType((*UnroutableSlashPacket)(nil), "interchain_security.ccv.provider.v1.UnroutableSlashPacket")
+	proto.RegisterType((*PreOptInInfraction)(nil), "interchain_security.ccv.provider.v1.PreOptInInfraction")
+	proto.RegisterType((*ConsumerClientExpiry)(nil), "interchain_security.ccv.provider.v1.ConsumerClientExpiry")
+	proto.RegisterType((*ConsumerRemovalRecord)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalRecord")
+	proto.RegisterType((*ConsumerCreationRecord)(nil), "interchain_security.ccv.provider.v1.ConsumerCreationRecord")
+	proto.RegisterType((*ConsumerUpgradeNotice)(nil), "interchain_security.ccv.provider.v1.ConsumerUpgradeNotice")
+	proto.RegisterType((*ConsumerUpgradeNotices)(nil), "interchain_security.ccv.provider.v1.ConsumerUpgradeNotices")
+	proto.RegisterType((*StoreEntries)(nil), "interchain_security.ccv.provider.v1.StoreEntries")
+	proto.RegisterType((*StoreEntry)(nil), "interchain_security.ccv.provider.v1.StoreEntry")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+}
+
+var fileDescriptor_f22ec409a72b7b72 = []byte{
+	// 4578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x4d, 0x6c, 0x23, 0x47,
+	0x76, 0x9e, 0xa6, 0xa8, 0x1f, 0x3e, 0xea, 0x87, 0xaa, 0x91, 0x66, 0x28, 0xcd, 0x58, 0x92, 0x39,
+	0x6b, 0x8f, 0x3c, 0xe3, 0xa1, 0x2c, 0x6d, 0x7e, 0x1c, 0xc7, 0xbb, 0x0e, 0x45, 0xf6, 0xcc, 0xd0,
+	0x23, 0x91, 0x74, 0x93, 0xd2, 0x2c, 0x26, 0x09, 0x3a, 0xad, 0xee, 0x1a, 0xa9, 0x23, 0xf6, 0x8f,
+	0xab, 0x9a, 0x9c, 0x91, 0x0f, 0x09, 0x92, 0x05, 0x82, 0x05, 0x72, 0xd9, 0xdc, 0x16, 0x01, 0x82,
+	0x6c, 0xb0, 0x41, 0x12, 0xe4, 0xb4, 0x08, 0x8c, 0x20, 0x87, 0x9c, 0x72, 0x72, 0x0c, 0x04, 0xd8,
+	0x6c, 0x72, 0x08, 0x82, 0xc0, 0xbb, 0xb0, 0x0f, 0x39, 0xe4, 0x90, 0x73, 0x6e, 0x41, 0xfd, 0x35,
+	0x9b, 0x14, 0xa5, 0x21, 0x63, 0x8f, 0x2f, 0xb9, 0xcc, 0x74, 0xd5, 0xfb, 0xde, 0xab, 0xaa, 0x57,
+	0xaf, 0x5e, 0xbd, 0x7a, 0x8f, 0x82, 0x1d, 0xd7, 0x8f, 0x30, 0xb1, 0x4f, 0x2c, 0xd7, 0x37, 0x29,
+	0xb6, 0x3b, 0xc4, 0x8d, 0xce, 0xb6, 0x6c, 0xbb, 0xbb, 0x15, 0x92, 0xa0, 0xeb, 0x3a, 0x98, 0x6c,
+	0x75, 0xb7, 0xe3, 0xef, 0x62, 0x48, 0x82, 0x28, 0x40, 0xb7, 0x86, 0xf0, 0x14, 0x6d, 0xbb, 0x5b,
+	0x8c, 0x71, 0xdd, 0xed, 0xd5, 0xb7, 0x2e, 0x12, 0xdc, 0xdd, 0xde, 0xa2, 0x27, 0x16, 0xc1, 0x8e,
+	0x69, 0x07, 0x3e, 0xed, 0x78, 0x4a, 0xec, 0xea, 0x6b, 0x97, 0x70, 0x3c, 0x73, 0x09, 0x96, 0xb0,
+	0xa5, 0xe3, 0xe0, 0x38, 0xe0, 0x9f, 0x5b, 0xec, 0x4b, 0xf6, 0xae, 0x1f, 0x07, 0xc1, 0x71, 0x1b,
+	0x6f, 0xf1, 0xd6, 0x51, 0xe7, 0xe9, 0x56, 0xe4, 0x7a, 0x98, 0x46, 0x96, 0x17, 0x4a, 0xc0, 0xda,
+	0x20, 0xc0, 0xe9, 0x10, 0x2b, 0x72, 0x03, 0x5f, 0x09, 0x70, 0x8f, 0xec, 0x2d, 0x3b, 0x20, 0x78,
+	0xcb, 0x6e, 0xbb, 0xd8, 0x8f, 0xd8, 0xa8, 0xe2, 0x4b, 0x02, 0xb6, 0x18, 0xa0, 0xed, 0x1e, 0x9f,
+	0x44, 0xa2, 0x9b, 0x6e, 0x45, 0xd8, 0x77, 0x30, 0xf1, 0x5c, 0x01, 0xee, 0xb5, 0x24, 0xc3, 0xcd,
+	0x04, 0xdd, 0x26, 0x67, 0x61, 0x14, 0x6c, 0x9d, 0xe2, 0x33, 0x2a, 0xa9, 0xaf, 0xdb, 0x01, 0xf5,
+	0x02, 0xba, 0x85, 0x99, 0xc6, 0x7c, 0x1b, 0x6f, 0x75, 0xb7, 0x8f, 0x70, 0x64, 0x6d, 0xc7, 0x1d,
+	0x6a, 0xde, 0x12, 0x77, 0x64, 0xd1, 0x1e, 0xc6, 0x0e, 0x5c, 0xff, 0x1c, 0xdd, 0x3f, 0x8d, 0xe9,
+	0xac, 0x21, 0xe9, 0x2b, 0x82, 0x6e, 0x0a, 0x8d, 0x89, 0x86, 0x24, 0x2d, 0x5a, 0x9e, 0xeb, 0x07,
+	0x5b, 0xfc, 0x5f, 0xd1, 0x55, 0xf8, 0x9f, 0x19, 0xc8, 0x97, 0xe5, 0xb6, 0x94, 0x1c, 0xc7, 0x65,
+	0x0a, 0x6a, 0x90, 0x20, 0x0c, 0xa8, 0xd5, 0x46, 0x4b, 0x30, 0x19, 0xb9, 0x51, 0x1b, 0xe7, 0xb5,
+	0x0d, 0x6d, 0x33, 0x63, 0x88, 0x06, 0xda, 0x80, 0xac, 0x83, 0xa9, 0x4d, 0xdc, 0x90, 0x81, 0xf3,
+	0x29, 0x4e, 0x4b, 0x76, 0xa1, 0x15, 0x98, 0x11, 0xbb, 0xea, 0x3a, 0xf9, 0x09, 0x4e, 0x9e, 0xe6,
+	0xed, 0xaa, 0x83, 0x1e, 0xc0, 0xbc, 0xeb, 0xbb, 0x91, 0x6b, 0xb5, 0xcd, 0x13, 0xcc, 0x74, 0x9b,
+	0x4f, 0x6f, 0x68, 0x9b, 0xd9, 0x9d, 0xd5, 0xa2, 0x7b, 0x64, 0x17, 0xd9, 0x76, 0x14, 0xe5, 0x26,
+	0x74, 0xb7, 0x8b, 0x0f, 0x39, 0x62, 0x37, 0xfd, 0xc9, 0x67, 0xeb, 0x57, 0x8c, 0x39, 0xc9, 0x27,
+	0x3a, 0xd1, 0xab, 0x30, 0x7b, 0x8c, 0x7d, 0x4c, 0x5d, 0x6a, 0x9e, 0x58, 0xf4, 0x24, 0x3f, 0xb9,
+	0xa1, 0x6d, 0xce, 0x1a, 0x59, 0xd9, 0xf7, 0xd0, 0xa2, 0x27, 0x68, 0x1d, 0xb2, 0x47, 0xae, 0x6f,
+	0x91, 0x33, 0x81, 0x98, 0xe2, 0x08, 0x10, 0x5d, 0x1c, 0x50, 0x06, 0xa0, 0xa1, 0xf5, 0xcc, 0x37,
+	0x99, 0xed, 0xe4, 0xa7, 0xe5, 0x44, 0x84, 0xdd, 0x14, 0x95, 0xdd, 0x14, 0x5b, 0xca, 0xb0, 0x76,
+	0x67, 0xd8, 0x44, 0xbe, 0xff, 0xb3, 0x75, 0xcd, 0xc8, 0x70, 0x3e, 0x46, 0x41, 0x35, 0xc8, 0x75,
+	0xfc, 0xa3, 0xc0, 0x77, 0x5c, 0xff, 0xd8, 0x0c, 0x31, 0x71, 0x03, 0x27, 0x3f, 0xc3, 0x45, 0xad,
+	0x9c, 0x13, 0x55, 0x91, 0x26, 0x28, 0x24, 0xfd, 0x80, 0x49, 0x5a, 0x88, 0x99, 0x1b, 0x9c, 0x17,
+	0x7d, 0x00, 0xc8, 0xb6, 0xbb, 0x7c, 0x4a, 0x41, 0x27, 0x52, 0x12, 0x33, 0xa3, 0x4b, 0xcc, 0xd9,
+	0x76, 0xb7, 0x25, 0xb8, 0xa5, 0xc8, 0x5f, 0x87, 0xeb, 0x11, 0xb1, 0x7c, 0xfa, 0x14, 0x93, 0x41,
+	0xb9, 0x30, 0xba, 0xdc, 0x65, 0x25, 0xa3, 0x5f, 0xf8, 0x43, 0xd8, 0x50, 0xe7, 0xda, 0x24, 0xd8,
+	0x71, 0x69, 0x44, 0xdc, 0xa3, 0x0e, 0xe3, 0x35, 0x9f, 0x12, 0xcb, 0x66, 0x1f, 0xf9, 0x2c, 0x37,
+	0x82, 0x35, 0x85, 0x33, 0xfa, 0x60, 0xf7, 0x25, 0x0a, 0xd5, 0xe1, 0x1b, 0x47, 0xed, 0xc0, 0x3e,
+	0xa5, 0x6c, 0x72, 0x66, 0x9f, 0x24, 0x3e, 0xb4, 0xe7, 0x52, 0xca, 0xa4, 0xcd, 0x6e, 0x68, 0x9b,
+	0x13, 0xc6, 0xab, 0x02, 0xdb, 0xc0, 0xa4, 0x92, 0x40, 0xb6, 0x12, 0x40, 0x74, 0x0f, 0xd0, 0x89,
+	0x4b, 0xa3, 0x80, 0xb8, 0xb6, 0xd5, 0x36, 0xb1, 0x1f, 0x11, 0x17, 0xd3, 0xfc, 0x1c, 0x67, 0x5f,
+	0xec, 0x51, 0x74, 0x41, 0x40, 0xef, 0xc3, 0xab, 0x17, 0x0e, 0x6a, 0xda, 0x27, 0x96, 0xef, 0xe3,
+	0x76, 0x7e, 0x9e, 0x2f, 0x65, 0xdd, 0xb9, 0x60, 0xcc, 0xb2, 0x80, 0xa1, 0xab, 0x30, 0x19, 0x05,
+	0xa1, 0x59, 0xcb, 0x2f, 0x6c, 0x68, 0x9b, 0x73, 0x46, 0x3a, 0x0a, 0xc2, 0x1a, 0x7a, 0x0b, 0x96,
+	0xba, 0x56, 0xdb, 0x75, 0xac, 0x28, 0x20, 0xd4, 0x0c, 0x83, 0x67, 0x98, 0x98, 0xb6, 0x15, 0xe6,
+	0x73, 0x1c, 0x83, 0x7a, 0xb4, 0x06, 0x23, 0x95, 0xad, 0x10, 0xdd, 0x81, 0xc5, 0xb8, 0xd7, 0xa4,
+	0x38, 0xe2, 0xf0, 0x45, 0x0e, 0x5f, 0x88, 0x09, 0x4d, 0x1c, 0x31, 0xec, 0x4d, 0xc8, 0x58, 0xed,
+	0x76, 0xf0, 0xac, 0xed, 0xd2, 0x28, 0x8f, 0x36, 0x26, 0x36, 0x33, 0x46, 0xaf, 0x03, 0xad, 0xc2,
+	0x8c, 0x83, 0xfd, 0x33, 0x4e, 0xbc, 0xca, 0x89, 0x71, 0x1b, 0xdd, 0x80, 0x8c, 0xc7, 0x7c, 0x70,
+	0x64, 0x9d, 0xe2, 0xfc, 0xd2, 0x86, 0xb6, 0x99, 0x36, 0x66, 0x3c, 0xd7, 0x6f, 0xb2, 0x36, 0x2a,
+	0xc2, 0x55, 0x2e, 0xc5, 0x74, 0x7d, 0xb6, 0x4f, 0x5d, 0x6c, 0x76, 0xad, 0x36, 0xcd, 0x2f, 0x6f,
+	0x68, 0x9b, 0x33, 0xc6, 0x22, 0x27, 0x55, 0x25, 0xe5, 0xd0, 0x6a, 0xd3, 0x77, 0x36, 0xbf, 0xf7,
+	0xc3, 0xf5, 0x2b, 0x3f, 0xf8, 0xe1, 0xfa, 0x95, 0x4f, 0x3f, 0xbe, 0xb7, 0x2a, 0xdd, 0xcf, 0x71,
+	0xd0, 0x2d, 0x4a, 0x57, 0x55, 0x2c, 0x07, 0x7e, 0x84, 0xfd, 0x28, 0xaf, 0x15, 0xfe, 0x59, 0x83,
+	0xeb, 0xe5, 0xd8, 0x24, 0xbc, 0xa0, 0x6b, 0xb5, 0x5f, 0xa6, 0xeb, 0x29, 0x41, 0x86, 0xb2, 0x3d,
+	0xe1, 0x87, 0x3d, 0x3d, 0xc6, 0x61, 0x9f, 0x61, 0x6c, 0x8c, 0xf0, 0xce, 0xc6, 0x0b, 0xd7, 0xf4,
+	0xdf, 0x29, 0xb8, 0xa9, 0xd6, 0xb4, 0x1f, 0x38, 0xee, 0x53, 0xd7, 0xb6, 0x5e, 0xb6, 0x4f, 0x8d,
+	0x6d, 0x2d, 0x3d, 0x82, 0xad, 0x4d, 0x8e, 0x67, 0x6b, 0x53, 0x23, 0xd8, 0xda, 0xf4, 0x65, 0xb6,
+	0x36, 0x73, 0x99, 0xad, 0x65, 0x46, 0xb3, 0x35, 0xb8, 0xc8, 0xd6, 0x52, 0x79, 0xad, 0xf0, 0xa7,
+	0x1a, 0x2c, 0xe9, 0x1f, 0x76, 0xdc, 0x6e, 0xf0, 0x15, 0x69, 0xfa, 0x11, 0xcc, 0xe1, 0x84, 0x3c,
+	0x9a, 0x9f, 0xd8, 0x98, 0xd8, 0xcc, 0xee, 0xbc, 0x56, 0x94, 0x1b, 0x1f, 0xdf, 0xd7, 0x6a, 0xf7,
+	0x93, 0xa3, 0x1b, 0xfd, 0xbc, 0x7c, 0x86, 0xff, 0xa0, 0xc1, 0x2a, 0xf3, 0x0b, 0xc7, 0xd8, 0xc0,
+	0xcf, 0x2c, 0xe2, 0x54, 0xb0, 0x1f, 0x78, 0xf4, 0x4b, 0xcf, 0xb3, 0x00, 0x73, 0x0e, 0x97, 0x64,
+	0x46, 0x81, 0x69, 0x39, 0x0e, 0x9f, 0x27, 0xc7, 0xb0, 0xce, 0x56, 0x50, 0x72, 0x1c, 0xb4, 0x09,
+	0xb9, 0x1e, 0x86, 0xb0, 0x33, 0xc6, 0x4c, 0x9f, 0xc1, 0xe6, 0x15, 0x8c, 0x9f, 0x3c, 0xfc, 0xce,
+	0xda, 0xe5, 0xa6, 0x5d, 0xf8, 0x2f, 0x0d, 0x72, 0x0f, 0xda, 0xc1, 0x91, 0xd5, 0x6e, 0xb6, 0x2d,
+	0x7a, 0xc2, 0x7c, 0xe6, 0x19, 0x3b, 0x52, 0x04, 0xcb, 0xcb, 0x2a, 0xaf, 0x8d, 0x73, 0xa4, 0x18,
+	0x1b, 0x23, 0xa0, 0xf7, 0x60, 0x31, 0xbe, 0x3e, 0x62, 0x03, 0xe7, 0xab, 0xdd, 0xbd, 0xfa, 0xf9,
+	0x67, 0xeb, 0x0b, 0xea, 0x30, 0x95, 0xb9, 0xb1, 0x57, 0x8c, 0x05, 0xbb, 0xaf, 0xc3, 0x41, 0x6b,
+	0x90, 0x75, 0x8f, 0x6c, 0x93, 0xe2, 0x0f, 0x4d, 0xbf, 0xe3, 0xf1, 0xb3, 0x91, 0x36, 0x32, 0xee,
+	0x91, 0xdd, 0xc4, 0x1f, 0xd6, 0x3a, 0x1e, 0xfa, 0x26, 0x5c, 0x53, 0x61, 0x2a, 0xb3, 0x26, 0x1e,
+	0x84, 0x32, 0x75, 0x11, 0x7e, 0x5c, 0x66, 0x8d, 0xab, 0x8a, 0x7a, 0x68, 0xb5, 0xd9, 0x60, 0x25,
+	0xc7, 0x21, 0x85, 0x3f, 0x98, 0x87, 0xa9, 0x86, 0x45, 0x2c, 0x8f, 0xa2, 0x16, 0x2c, 0x44, 0xd8,
+	0x0b, 0xdb, 0x56, 0x84, 0x4d, 0x11, 0x9a, 0xc8, 0x95, 0xde, 0xe5, 0x21, 0x4b, 0x32, 0x40, 0x2c,
+	0x26, 0x42, 0xc2, 0xee, 0x76, 0xb1, 0xcc, 0x7b, 0x9b, 0x91, 0x15, 0x61, 0x63, 0x5e, 0xc9, 0x10,
+	0x9d, 0xe8, 0x6d, 0xc8, 0x47, 0xa4, 0x43, 0xa3, 0x5e, 0xd0, 0xd0, 0xbb, 0x2d, 0xc5, 0x5e, 0x5f,
+	0x53, 0x74, 0x71, 0xcf, 0xc6, 0xb7, 0xe4, 0xf0, 0xf8, 0x60, 0xe2, 0xcb, 0xc4, 0x07, 0x0e, 0xdc,
+	0xa4, 0x6c, 0x53, 0x4d, 0x0f, 0x47, 0xfc, 0x16, 0x0f, 0xdb, 0xd8, 0x77, 0xe9, 0x89, 0x12, 0x3e,
+	0x35, 0xba, 0xf0, 0x15, 0x2e, 0x68, 0x9f, 0xc9, 0x31, 0x94, 0x18, 0x39, 0x4a, 0x19, 0xd6, 0x86,
+	0x8f, 0x12, 0x2f, 0x7c, 0x9a, 0x2f, 0xfc, 0xc6, 0x10, 0x11, 0xf1, 0xea, 0x29, 0xbc, 0x9e, 0x88,
+	0x36, 0xd8, 0x69, 0x32, 0xb9, 0x21, 0x9b, 0x04, 0x1f, 0xb3, 0x2b, 0xd9, 0x12, 0x81, 0x07, 0xc6,
+	0x71, 0xc4, 0x24, 0x6d, 0x9a, 0x85, 0xd3, 0x09, 0xa3, 0x76, 0x7d, 0x19, 0x56, 0x16, 0x7a, 0x41,
+	0x49, 0x7c, 0x36, 0x8d, 0x84, 0xac, 0xfb, 0x18, 0xb3, 0x53, 0x94, 0x08, 0x4c, 0x70, 0x18, 0xd8,
+	0x27, 0xdc, 0x27, 0x4d, 0x18, 0xf3, 0x71, 0x10, 0xa2, 0xb3, 0x5e, 0xf4, 0x04, 0xee, 0xfa, 0x1d,
+	0xef, 0x08, 0x13, 0x33, 0x78, 0x2a, 0x80, 0xfc, 0xe4, 0xd1, 0xc8, 0x22, 0x91, 0x49, 0xb0, 0x8d,
+	0xdd, 0x2e, 0xdb, 0x71, 0x31, 0x73, 0xca, 0xe3, 0xa2, 0x09, 0xe3, 0x35, 0xc1, 0x52, 0x7f, 0xca,
+	0x65, 0xd0, 0x56, 0xd0, 0x64, 0x70, 0x43, 0xa1, 0xc5, 0xc4, 0x28, 0xaa, 0xc2, 0xab, 0x9e, 0xf5,
+	0xdc, 0x8c, 0x8d, 0x99, 0x4d, 0x1c, 0xfb, 0xb4, 0x43, 0xcd, 0x9e, 0x33, 0x97, 0xb1, 0xd1, 0x9a,
+	0x67, 0x3d, 0x6f, 0x48, 0x5c, 0x59, 0xc1, 0x0e, 0x63, 0x14, 0x3a, 0x80, 0x4d, 0x26, 0xaa, 0x77,
+	0xf0, 0xda, 0xd8, 0xf2, 0x3b, 0xa1, 0xe9, 0xe0, 0x36, 0xe6, 0x7e, 0x8b, 0x2f, 0x94, 0xaf, 0x4d,
+	0x86, 0x4b, 0xb7, 0x3c, 0xeb, 0x79, 0x7c, 0x14, 0x05, 0xba, 0xa2, 0xc0, 0x0d, 0x4c, 0x76, 0x19,
+	0x14, 0xed, 0xc1, 0x82, 0x13, 0x10, 0xcf, 0xf2, 0xed, 0x33, 0x65, 0x3a, 0xf3, 0xa3, 0x9b, 0xce,
+	0xbc, 0xe2, 0x95, 0xf6, 0x72, 0x81, 0x2e, 0x09, 0x8e, 0x98, 0x97, 0x88, 0xe7, 0xce, 0x6e, 0x08,
+	0x1c, 0xd1, 0xfc, 0xc2, 0x70, 0x5d, 0x1a, 0x1c, 0xae, 0xa6, 0x7e, 0x28, 0xc0, 0xe8, 0xdb, 0x70,
+	0xa3, 0xed, 0x3e, 0xc5, 0xec, 0x10, 0x31, 0xb7, 0xe8, 0xb2, 0x63, 0x1b, 0xdb, 0x21, 0xcd, 0xe7,
+	0xb8, 0x8b, 0x5c, 0x51, 0x10, 0x43, 0x22, 0x94, 0x15, 0x52, 0x76, 0xbb, 0x76, 0xc2, 0x63, 0x62,
+	0x39, 0xd8, 0xfc, 0xb0, 0xe3, 0xe2, 0xf8, 0x18, 0x2e, 0xf2, 0x49, 0x20, 0x49, 0xfb, 0x80, 0x91,
+	0xe4, 0x6a, 0x5a, 0x70, 0x3b, 0xa1, 0x6e, 0xe6, 0x03, 0x4c, 0xfc, 0x3c, 0x74, 0xc9, 0x99, 0xf9,
+	0xcc, 0x22, 0x3e, 0x33, 0x8a, 0xf8, 0x18, 0x20, 0x7e, 0x0c, 0x6e, 0xc5, 0x8e, 0x8e, 0xa3, 0x75,
+	0x0e, 0x7e, 0x2c, 0xb0, 0xf1, 0x71, 0x78, 0x17, 0x56, 0xfb, 0x36, 0x32, 0xb4, 0x48, 0xe4, 0xda,
+	0x6e, 0xc8, 0x75, 0x9b, 0xbf, 0xca, 0x67, 0x93, 0x4f, 0x6c, 0x5d, 0x23, 0x49, 0x47, 0xf7, 0x61,
+	0x03, 0x7b, 0x98, 0x1c, 0x63, 0xb6, 0x61, 0x41, 0x18, 0x99, 0xcc, 0xa1, 0x88, 0x33, 0x1a, 0x4f,
+	0x66, 0x89, 0x4f, 0xe6, 0x66, 0x8c, 0xab, 0x87, 0x51, 0xbd, 0x13, 0xf1, 0x3b, 0x20, 0x9e, 0xc5,
+	0x6f, 0xc1, 0xea, 0x79, 0x39, 0x76, 0x10, 0xb4, 0x9d, 0xe0, 0x99, 0x9f, 0x5f, 0x1e, 0xdd, 0x04,
+	0xae, 0x0f, 0x0c, 0x53, 0x96, 0x32, 0x98, 0xbe, 0x29, 0xf6, 0x1d, 0x53, 0x29, 0xdd, 0x0f, 0x22,
+	0xd7, 0xc6, 0x34, 0x7f, 0x8d, 0x47, 0x06, 0x88, 0xd1, 0x0e, 0x04, 0xa9, 0x26, 0x28, 0x68, 0x17,
+	0xd6, 0xb8, 0x66, 0x84, 0xaa, 0x6d, 0x82, 0xad, 0x41, 0xc3, 0xbe, 0xce, 0xb5, 0xc3, 0xf4, 0x27,
+	0x34, 0x5c, 0x56, 0x98, 0xd8, 0x9e, 0x7f, 0x03, 0xde, 0xbc, 0xc4, 0x02, 0xb1, 0x1d, 0xf8, 0x81,
+	0xe7, 0xda, 0x71, 0xee, 0x22, 0x9f, 0xe7, 0x12, 0x5f, 0x1f, 0x6e, 0x82, 0xba, 0x84, 0x37, 0x25,
+	0x1a, 0x55, 0x60, 0x5d, 0x04, 0x3b, 0x1f, 0x61, 0x12, 0x98, 0xa7, 0xf8, 0xcc, 0xb4, 0x28, 0x75,
+	0x8f, 0x7d, 0x8f, 0x4d, 0xd8, 0x0f, 0x7c, 0x1b, 0xe7, 0x57, 0xf8, 0xf2, 0x6e, 0x70, 0xd8, 0x13,
+	0x4c, 0x82, 0x47, 0xf8, 0xac, 0x14, 0x63, 0x6a, 0x0c, 0x82, 0x1e, 0xc1, 0x2d, 0xfc, 0xdc, 0x6e,
+	0x77, 0x1c, 0x6c, 0x46, 0xc1, 0x29, 0xf6, 0xdd, 0x8f, 0xb0, 0x63, 0xf2, 0x7c, 0x0b, 0x35, 0x9f,
+	0x92, 0xc0, 0x33, 0x59, 0x68, 0xe8, 0xe7, 0x57, 0xb9, 0xa4, 0x35, 0x09, 0x6d, 0x29, 0x64, 0x93,
+	0x03, 0xef, 0x93, 0xc0, 0x6b, 0x05, 0x61, 0xed, 0xfd, 0xf4, 0x4c, 0x3a, 0x37, 0xf9, 0x7e, 0x7a,
+	0x66, 0x32, 0x37, 0xf5, 0x7e, 0x7a, 0x66, 0x26, 0x97, 0x29, 0xbc, 0x01, 0x19, 0xbe, 0xd7, 0x25,
+	0xfb, 0x94, 0xf2, 0xa8, 0xcf, 0x71, 0x08, 0xa6, 0x14, 0xd3, 0xbc, 0x26, 0xa3, 0x3e, 0xd5, 0x51,
+	0x88, 0x60, 0xe5, 0xa2, 0x4c, 0x02, 0x45, 0x8f, 0x61, 0x3a, 0xc4, 0xfc, 0x99, 0xcb, 0x19, 0xb3,
+	0x3b, 0xdf, 0x2a, 0x8e, 0x90, 0x54, 0x2a, 0x5e, 0x24, 0xd0, 0x50, 0xd2, 0x0a, 0xa4, 0x97, 0xbf,
+	0x18, 0x78, 0x43, 0x50, 0x74, 0x38, 0x38, 0xe8, 0xbb, 0x63, 0x0d, 0x3a, 0x20, 0xaf, 0x37, 0xe6,
+	0x5d, 0xc8, 0x96, 0xc4, 0xb2, 0xf7, 0x58, 0x48, 0x7b, 0x4e, 0x2d, 0xb3, 0x49, 0xb5, 0xd4, 0x60,
+	0x5e, 0x3e, 0x0a, 0x5b, 0x01, 0x8f, 0x59, 0xd0, 0x2b, 0x00, 0xf2, 0x35, 0xc9, 0x62, 0x1d, 0x11,
+	0xf5, 0x65, 0x64, 0x4f, 0xd5, 0xe9, 0x8b, 0xf4, 0x53, 0x7d, 0x91, 0x3e, 0x8f, 0x26, 0x03, 0x58,
+	0x39, 0x4c, 0x46, 0xe3, 0x3c, 0xb0, 0x6c, 0x58, 0xf6, 0x29, 0xf3, 0x6b, 0x06, 0xa4, 0x79, 0xd4,
+	0x2d, 0x96, 0xfb, 0xf6, 0x85, 0xcb, 0xed, 0x6e, 0x17, 0x2f, 0x12, 0x52, 0xb1, 0x22, 0x4b, 0xde,
+	0x8d, 0x5c, 0x56, 0xe1, 0x8f, 0x34, 0xc8, 0xf7, 0x19, 0x1e, 0xbb, 0x95, 0x2d, 0x1b, 0xb3, 0x4f,
+	0x74, 0x0b, 0xe6, 0xe2, 0x0b, 0x89, 0x07, 0x55, 0x1a, 0x0f, 0xaa, 0x66, 0x55, 0x27, 0xd3, 0x13,
+	0x7a, 0x07, 0x20, 0x24, 0xb8, 0x6b, 0xda, 0xcc, 0xca, 0xf9, 0x9a, 0xb2, 0x3b, 0x37, 0x93, 0xc1,
+	0x92, 0xc8, 0x96, 0x15, 0x1b, 0x9d, 0xa3, 0xb6, 0x6b, 0x3f, 0xc2, 0x67, 0xc6, 0x0c, 0xc3, 0x97,
+	0x1f, 0xe1, 0x33, 0x16, 0x1d, 0xf3, 0xc7, 0x0b, 0x8f, 0x70, 0x26, 0x0c, 0xd1, 0x28, 0xfc, 0xb1,
+	0x06, 0xd7, 0xe3, 0x05, 0xc4, 0xce, 0xad, 0x73, 0xc4, 0x38, 0x92, 0xfa, 0xd3, 0xfa, 0x5f, 0x4a,
+	0xe7, 0x66, 0x9b, 0x1a, 0x32, 0xdb, 0xf7, 0x60, 0x36, 0xf6, 0xa7, 0x6c, 0xbe, 0x13, 0x23, 0xcc,
+	0x37, 0xab, 0x38, 0x1e, 0xe1, 0xb3, 0xc2, 0xef, 0x24, 0xe6, 0xb6, 0x7b, 0x96, 0x30, 0x61, 0xf2,
+	0x82, 0xb9, 0xc5, 0xc3, 0x26, 0xe7, 0x66, 0x27, 0xf9, 0xcf, 0x2d, 0x60, 0xe2, 0xfc, 0x02, 0x0a,
+	0xff, 0xa4, 0xc1, 0xb5, 0xe4, 0xa8, 0xb4, 0x15, 0x34, 0x48, 0xc7, 0xc7, 0x87, 0x3b, 0x97, 0x8d,
+	0xff, 0x1e, 0xcc, 0x84, 0x0c, 0x65, 0x46, 0x34, 0x9f, 0x1a, 0x23, 0x94, 0x9f, 0xe6, 0x5c, 0x2d,
+	0x76, 0xc4, 0xe7, 0xfb, 0x16, 0x40, 0xa5, 0xe6, 0xde, 0x1a, 0xe9, 0xd0, 0x25, 0x0e, 0x94, 0x31,
+	0x97, 0x5c, 0x33, 0x2d, 0x84, 0x80, 0x86, 0x38, 0xbe, 0x75, 0x88, 0x95, 0xde, 0x5b, 0x0d, 0xa8,
+	0xae, 0x51, 0x37, 0x7b, 0x09, 0x26, 0x85, 0xab, 0x15, 0xef, 0x06, 0xd1, 0x28, 0xfc, 0xad, 0x06,
+	0xe8, 0x7c, 0xdc, 0x84, 0xde, 0x04, 0xd4, 0x17, 0x7d, 0x25, 0x2d, 0x3e, 0x17, 0x26, 0xe2, 0x2d,
+	0x25, 0x5a, 0x58, 0x6e, 0x2a, 0x61, 0xb9, 0xe8, 0x57, 0x01, 0x42, 0x6e, 0x36, 0x23, 0xdb, 0x56,
+	0x26, 0x54, 0x9f, 0x6c, 0xcd, 0xbf, 0x1d, 0xb8, 0x7e, 0x32, 0x75, 0x3a, 0x61, 0x00, 0xeb, 0x12,
+	0x59, 0xd1, 0xc2, 0xef, 0xa5, 0x7a, 0x4e, 0x58, 0xc6, 0x8d, 0xa5, 0x76, 0x5b, 0xbe, 0x46, 0x51,
+	0x08, 0xd3, 0x2a, 0xf2, 0x14, 0x0e, 0xe2, 0xe6, 0xd0, 0xe8, 0xb8, 0x82, 0x6d, 0x1e, 0x20, 0xbf,
+	0xcd, 0xf6, 0xf8, 0xaf, 0x7f, 0xb6, 0x7e, 0xf7, 0xd8, 0x8d, 0x4e, 0x3a, 0x47, 0x45, 0x3b, 0xf0,
+	0x64, 0x3e, 0x59, 0xfe, 0x77, 0x8f, 0x3a, 0xa7, 0x5b, 0xd1, 0x59, 0x88, 0xa9, 0xe2, 0xa1, 0x7f,
+	0xf5, 0x9f, 0x3f, 0xbe, 0xa3, 0x19, 0x6a, 0x18, 0x44, 0x92, 0x39, 0x05, 0x35, 0x76, 0x8a, 0x8f,
+	0xfd, 0xde, 0x48, 0x66, 0x11, 0x2b, 0xff, 0xdc, 0x6a, 0xa4, 0x8f, 0xca, 0x75, 0x07, 0x10, 0x85,
+	0xbf, 0xd7, 0x60, 0xf5, 0x62, 0xb6, 0x31, 0x37, 0x31, 0xa1, 0xb2, 0xd4, 0xd7, 0xa2, 0xb2, 0xc2,
+	0x77, 0x35, 0xc8, 0xc5, 0x19, 0x24, 0x1c, 0x59, 0x8e, 0x15, 0x59, 0x08, 0x41, 0xda, 0xb7, 0x3c,
+	0x95, 0x22, 0xe0, 0xdf, 0x23, 0x64, 0x08, 0x56, 0x61, 0xc6, 0x93, 0x12, 0x64, 0xce, 0x28, 0x6e,
+	0xb3, 0x4b, 0x28, 0xc2, 0xc4, 0x93, 0xd9, 0xf3, 0xb4, 0xb8, 0x84, 0x78, 0x0f, 0x4b, 0x8d, 0x17,
+	0xfe, 0x50, 0x83, 0x59, 0xdd, 0x77, 0xc2, 0xc0, 0xf5, 0xa3, 0xaa, 0xff, 0x34, 0x40, 0x6f, 0x40,
+	0x2e, 0xc4, 0x84, 0xba, 0x34, 0x62, 0xe1, 0x49, 0x88, 0x31, 0x51, 0x21, 0xc0, 0x42, 0xaf, 0xbf,
+	0xc1, 0xba, 0x99, 0xe1, 0x53, 0x8c, 0xa5, 0xc6, 0x32, 0x86, 0x68, 0x30, 0xd7, 0x43, 0x42, 0xdb,
+	0xec, 0x90, 0x36, 0x95, 0x99, 0x8a, 0x69, 0x12, 0xda, 0x07, 0xa4, 0x4d, 0x99, 0x59, 0xab, 0x5c,
+	0x7e, 0x87, 0xb4, 0xe5, 0x64, 0x40, 0x76, 0x1d, 0x90, 0x76, 0xe1, 0x93, 0x84, 0x47, 0xeb, 0x7b,
+	0xba, 0xd2, 0x0b, 0x9e, 0xc3, 0xda, 0x4b, 0x4a, 0x97, 0xa7, 0xbe, 0x6c, 0xba, 0xbc, 0xf0, 0xe7,
+	0x00, 0x1b, 0x6a, 0x29, 0x55, 0x51, 0xd1, 0x70, 0x3f, 0x12, 0x89, 0x2b, 0x96, 0x6f, 0xc0, 0x11,
+	0xd3, 0xe0, 0xf9, 0x2a, 0x89, 0xf6, 0xd5, 0x54, 0x49, 0x52, 0x2f, 0xac, 0x92, 0x4c, 0xbc, 0xa0,
+	0x4a, 0x92, 0xfe, 0xea, 0xaa, 0x24, 0x93, 0x5f, 0x79, 0x95, 0x64, 0xea, 0x25, 0x6d, 0xfb, 0xf4,
+	0xd7, 0x52, 0x25, 0x99, 0xf9, 0x4a, 0xab, 0x24, 0x99, 0x2f, 0x57, 0x25, 0x81, 0x2f, 0x55, 0x25,
+	0xc9, 0x8e, 0x56, 0x25, 0x79, 0x2d, 0x11, 0x32, 0xf0, 0x34, 0x0e, 0xcf, 0x5f, 0x64, 0x7a, 0x01,
+	0x00, 0x4f, 0xc7, 0xa0, 0x03, 0xb8, 0xde, 0x0f, 0x33, 0x63, 0xb7, 0x36, 0xc7, 0x77, 0xe6, 0x95,
+	0x9e, 0x53, 0xf6, 0x4f, 0x63, 0xa7, 0xac, 0xbc, 0xa7, 0xb1, 0xdc, 0x27, 0x4e, 0x75, 0xa3, 0x77,
+	0xe1, 0x46, 0x48, 0xb0, 0xc9, 0xec, 0x48, 0xe5, 0x74, 0x4d, 0xaf, 0x77, 0xbb, 0xce, 0xf3, 0x88,
+	0xe0, 0x7a, 0x48, 0x70, 0xd9, 0xee, 0xea, 0x12, 0xb0, 0xaf, 0xae, 0x5a, 0xf4, 0x06, 0x2c, 0x2a,
+	0x6e, 0xf9, 0xc8, 0x74, 0x1d, 0x9e, 0x84, 0xc8, 0x18, 0xf3, 0x82, 0x47, 0x3c, 0x2b, 0xab, 0x0e,
+	0xba, 0x0f, 0xb3, 0xec, 0x2d, 0xaa, 0xd2, 0x09, 0xf9, 0xdc, 0xe8, 0xe6, 0x94, 0xf5, 0xac, 0xe7,
+	0x7b, 0x92, 0x8f, 0xbf, 0x82, 0xdd, 0x63, 0x1f, 0x3b, 0xa6, 0xb4, 0x80, 0x67, 0xae, 0xef, 0x04,
+	0xcf, 0x54, 0xd6, 0x41, 0xd0, 0xf8, 0xd3, 0x95, 0x3e, 0xe6, 0x14, 0xb4, 0x0d, 0xcb, 0x6c, 0x45,
+	0x92, 0x8b, 0x19, 0x8c, 0x64, 0x11, 0x39, 0x06, 0xc4, 0x32, 0xef, 0x9c, 0xd6, 0xc0, 0x44, 0xb2,
+	0x7c, 0x57, 0x83, 0x35, 0x95, 0x52, 0x1b, 0x6a, 0xa7, 0x94, 0xd7, 0x8f, 0xb2, 0x3b, 0xbf, 0x7c,
+	0xd9, 0xeb, 0x42, 0xe6, 0xd1, 0x86, 0x59, 0xb0, 0xf4, 0x54, 0x37, 0x9d, 0x8b, 0x21, 0xb4, 0xf0,
+	0x77, 0x69, 0xb8, 0xc6, 0x2b, 0x13, 0xcd, 0x13, 0x2b, 0x64, 0xc7, 0xbe, 0xe7, 0x1c, 0xe3, 0x72,
+	0x87, 0x36, 0x42, 0xb9, 0x23, 0x35, 0x5e, 0xb9, 0x63, 0x62, 0x84, 0x72, 0x47, 0xfa, 0xb2, 0x72,
+	0xc7, 0xe4, 0x65, 0xe5, 0x8e, 0xa9, 0xd1, 0xca, 0x1d, 0xd3, 0x17, 0x94, 0x3b, 0xd8, 0x94, 0xfb,
+	0x32, 0x80, 0xc4, 0xf2, 0x4f, 0xb9, 0xd7, 0x98, 0x33, 0x16, 0x12, 0x19, 0x3f, 0xc3, 0xf2, 0x4f,
+	0x51, 0x13, 0x96, 0x59, 0xe6, 0x84, 0x67, 0xb8, 0x8e, 0x89, 0x65, 0xe3, 0x91, 0x2b, 0xc9, 0x69,
+	0x6e, 0x78, 0x57, 0x15, 0xf7, 0x03, 0xc6, 0x2c, 0xbd, 0xd8, 0x7b, 0xf0, 0x8a, 0x98, 0x30, 0x4f,
+	0x2a, 0x98, 0xe7, 0x92, 0x3e, 0xb2, 0x52, 0x93, 0xe7, 0x20, 0x96, 0x51, 0xd0, 0xfb, 0xf3, 0x39,
+	0xe8, 0x08, 0xd6, 0x08, 0xe6, 0x68, 0x9e, 0xc2, 0x13, 0xd9, 0x1d, 0xd3, 0x7a, 0x1a, 0x61, 0x22,
+	0x12, 0x4f, 0xf9, 0xec, 0x68, 0xd3, 0x5b, 0x21, 0xb8, 0x1e, 0x46, 0x55, 0x5f, 0x65, 0x88, 0x4a,
+	0x4c, 0x04, 0xcf, 0x54, 0x14, 0xd6, 0x21, 0x1b, 0x5f, 0xb0, 0x0e, 0x45, 0x39, 0x98, 0x70, 0x1d,
+	0x15, 0xab, 0xb0, 0xcf, 0xc2, 0x9f, 0xa5, 0x7a, 0x11, 0x56, 0x7c, 0xb6, 0x74, 0xc8, 0xb6, 0xad,
+	0x8e, 0x6f, 0x9f, 0x8c, 0x5f, 0xcc, 0x00, 0xc1, 0xd8, 0x92, 0x62, 0x68, 0xc7, 0x67, 0xe6, 0xc4,
+	0xc5, 0x8c, 0xf3, 0x90, 0x02, 0xc1, 0xc8, 0xc5, 0xdc, 0x85, 0x45, 0x95, 0x96, 0xa4, 0x26, 0xf6,
+	0xdc, 0x28, 0xc2, 0x8e, 0x34, 0xce, 0x5c, 0x4c, 0xd0, 0x45, 0x3f, 0x32, 0x00, 0xf9, 0xf8, 0x79,
+	0xd4, 0x4b, 0x64, 0x8e, 0x7d, 0x51, 0xe7, 0x18, 0xbf, 0xca, 0x72, 0x32, 0x40, 0xe1, 0x59, 0x2f,
+	0xe0, 0x3a, 0xb4, 0xda, 0x4d, 0x1c, 0x35, 0x7d, 0x2b, 0xa4, 0x27, 0x41, 0x84, 0x7e, 0x13, 0x20,
+	0x91, 0x6f, 0xd6, 0x5e, 0xe0, 0x0a, 0x06, 0xf3, 0x2a, 0xfd, 0x2f, 0x2a, 0xe9, 0x0a, 0x12, 0x02,
+	0x0b, 0x7f, 0x99, 0xea, 0x25, 0x74, 0xce, 0xa5, 0xcc, 0x5e, 0xf8, 0xe6, 0xbb, 0x06, 0x53, 0xd2,
+	0x7b, 0x8b, 0x47, 0x97, 0x6c, 0xa1, 0xb7, 0x21, 0xcd, 0x95, 0x32, 0x31, 0x86, 0x52, 0x38, 0x07,
+	0x1b, 0x32, 0x0a, 0x22, 0xab, 0x2d, 0x7c, 0x8a, 0x7a, 0x72, 0xf1, 0x2e, 0xee, 0x4a, 0x50, 0x0d,
+	0x66, 0x05, 0x80, 0xa7, 0xdf, 0x28, 0x8f, 0x6a, 0x32, 0xbb, 0x77, 0x99, 0x98, 0x7f, 0xff, 0x6c,
+	0x7d, 0x59, 0x5c, 0x4c, 0xd4, 0x39, 0x2d, 0xba, 0xc1, 0x96, 0x67, 0x45, 0x27, 0xc5, 0xaa, 0x1f,
+	0xfd, 0xf4, 0xe3, 0x7b, 0x20, 0x08, 0xac, 0x65, 0x88, 0x11, 0x78, 0x52, 0x8e, 0xa2, 0xdb, 0xd0,
+	0x73, 0x3f, 0xa6, 0x1d, 0x74, 0xfc, 0x48, 0x16, 0x61, 0xe7, 0xbb, 0xbd, 0x84, 0x47, 0xc7, 0x8f,
+	0x0a, 0xdb, 0x70, 0xbd, 0xa4, 0x7c, 0x10, 0x76, 0x92, 0xa5, 0x45, 0xa6, 0x06, 0x51, 0xde, 0x93,
+	0x66, 0x2f, 0x5b, 0x85, 0xb7, 0x01, 0x71, 0x16, 0xec, 0x54, 0x6d, 0x6b, 0x9f, 0x1e, 0xb7, 0xd8,
+	0x43, 0x84, 0x15, 0x12, 0x3d, 0x7a, 0x6c, 0xb2, 0x57, 0x89, 0x08, 0xcf, 0x05, 0x53, 0xd6, 0x13,
+	0x00, 0x16, 0xa2, 0x17, 0x1e, 0xc0, 0x62, 0xd2, 0x1d, 0x97, 0x1c, 0xcf, 0xf5, 0xd1, 0x0e, 0x4c,
+	0xcb, 0x3c, 0x97, 0xd8, 0x8a, 0xdd, 0xfc, 0x4f, 0x3f, 0xbe, 0xb7, 0x24, 0x17, 0x26, 0x1f, 0xf4,
+	0xcd, 0x88, 0xb0, 0x1a, 0x86, 0x02, 0x16, 0x6e, 0xc3, 0x9c, 0x7c, 0x93, 0x35, 0xac, 0x0e, 0xc5,
+	0x7c, 0xcb, 0x42, 0xfe, 0xc5, 0x65, 0xcc, 0x18, 0xb2, 0x55, 0xf8, 0x7d, 0x0d, 0xe6, 0x0e, 0xa9,
+	0x5d, 0x75, 0x5a, 0x81, 0xbc, 0x71, 0x97, 0x61, 0xaa, 0x4b, 0x6d, 0xb5, 0xf1, 0x69, 0x63, 0xb2,
+	0xcb, 0xc8, 0x03, 0x7b, 0x9e, 0x8e, 0xf7, 0x7c, 0x17, 0x32, 0xf1, 0x6f, 0xc2, 0xc6, 0xda, 0xf8,
+	0x1e, 0x5b, 0xe1, 0x3f, 0x34, 0xc8, 0xf0, 0x34, 0x2e, 0x7f, 0x03, 0x2d, 0xc1, 0x24, 0x3b, 0x21,
+	0xcf, 0xd5, 0xf8, 0xbc, 0xc1, 0x62, 0x6c, 0x51, 0xdf, 0xe9, 0xb3, 0xbc, 0x2c, 0xef, 0x93, 0x33,
+	0x67, 0x21, 0x34, 0x87, 0x8c, 0x6d, 0x84, 0x19, 0xce, 0xc7, 0x28, 0x2c, 0xe4, 0xb5, 0xba, 0x98,
+	0x58, 0xc7, 0x58, 0x5c, 0xff, 0xc9, 0x63, 0x3e, 0x5a, 0xc8, 0x2b, 0xd9, 0x79, 0x84, 0xc0, 0x4f,
+	0xf9, 0xcf, 0x53, 0x70, 0x5d, 0xec, 0x46, 0x29, 0x8a, 0x2f, 0x61, 0x03, 0xdb, 0x01, 0x71, 0xd8,
+	0xad, 0x46, 0xf1, 0x87, 0x1d, 0x16, 0xf4, 0xc8, 0xf5, 0xc6, 0xed, 0x97, 0x70, 0xcc, 0xfa, 0x73,
+	0xa2, 0xe9, 0xc1, 0x9c, 0xe8, 0x35, 0x98, 0xa2, 0x3c, 0x45, 0x22, 0x8e, 0x97, 0x21, 0x5b, 0x6c,
+	0x47, 0x44, 0xdc, 0x38, 0xc5, 0xbb, 0x45, 0x83, 0xa1, 0x2d, 0x8f, 0x9f, 0x1c, 0x51, 0x51, 0x94,
+	0x2d, 0xf4, 0x84, 0x5d, 0xd4, 0xb6, 0x4b, 0x55, 0xb0, 0x3d, 0xbf, 0xf3, 0xed, 0x91, 0x1c, 0xd7,
+	0x39, 0x15, 0x55, 0xa4, 0x14, 0x23, 0x96, 0xc7, 0xc6, 0x24, 0xd8, 0xa2, 0x32, 0xf0, 0xce, 0x18,
+	0xb2, 0x55, 0xf8, 0x34, 0x05, 0x4b, 0xcd, 0x53, 0x37, 0x0c, 0xb1, 0x53, 0x91, 0x37, 0x2a, 0xbf,
+	0xa6, 0xbe, 0x66, 0xfd, 0xb2, 0xe7, 0x7b, 0x32, 0x19, 0xc6, 0xce, 0xac, 0xd0, 0xf2, 0x42, 0x32,
+	0x1f, 0x86, 0x29, 0x65, 0xd0, 0xbe, 0x3c, 0x1e, 0x83, 0x0a, 0xad, 0x2f, 0x24, 0xf3, 0x72, 0x0c,
+	0xba, 0x09, 0x39, 0x51, 0x7e, 0x33, 0x3b, 0xa1, 0x63, 0x45, 0x98, 0xed, 0x9d, 0x08, 0x72, 0xe6,
+	0x45, 0xff, 0x01, 0xef, 0xae, 0x3a, 0xa8, 0x02, 0x59, 0x79, 0xeb, 0x8f, 0xff, 0x5b, 0xbb, 0x80,
+	0x5d, 0xf4, 0xdc, 0x5e, 0xff, 0x35, 0x05, 0xcb, 0x07, 0x3e, 0x09, 0x3a, 0x91, 0x75, 0xd4, 0x16,
+	0x7a, 0x14, 0x49, 0xeb, 0x4b, 0xb5, 0x79, 0x1b, 0x16, 0x44, 0xe9, 0x15, 0x3b, 0xfd, 0x67, 0x74,
+	0x5e, 0x75, 0xcb, 0x63, 0x5a, 0x85, 0xb9, 0x18, 0x38, 0xb6, 0x9e, 0x67, 0x15, 0x6b, 0x4b, 0xea,
+	0xfb, 0x9c, 0x12, 0xd3, 0xc3, 0x95, 0x38, 0x6c, 0x6b, 0x26, 0x87, 0x6f, 0xcd, 0xe8, 0xfa, 0xbe,
+	0x0b, 0x8b, 0xae, 0xaf, 0x22, 0x76, 0xb5, 0xea, 0x69, 0x0e, 0xcd, 0xf5, 0x08, 0x32, 0x6b, 0xf8,
+	0x2f, 0x29, 0x40, 0x0d, 0x19, 0x50, 0x55, 0x63, 0xe2, 0xff, 0x33, 0x0b, 0x1d, 0x47, 0x63, 0xec,
+	0xca, 0x94, 0xe6, 0x2c, 0x81, 0x33, 0x1c, 0x98, 0xe5, 0xa6, 0x2a, 0xb5, 0xfa, 0xa3, 0x09, 0x58,
+	0x2a, 0x0f, 0xa9, 0xe1, 0xbe, 0x38, 0x8a, 0xb9, 0xb8, 0x02, 0xc4, 0xde, 0x13, 0xbd, 0xd7, 0xa6,
+	0xcc, 0xe9, 0xd9, 0xea, 0x9d, 0xf9, 0x01, 0x4c, 0xd1, 0xc8, 0x8a, 0x3a, 0x42, 0x71, 0xf3, 0x3b,
+	0xbf, 0x32, 0x56, 0xb9, 0xab, 0xf7, 0x73, 0x95, 0x0e, 0x35, 0xa4, 0x20, 0x56, 0xd2, 0x1f, 0xf8,
+	0x9d, 0xca, 0x38, 0x69, 0x9b, 0xf9, 0xfe, 0xdf, 0xb0, 0xb0, 0xe8, 0x58, 0x16, 0xbd, 0xb9, 0x91,
+	0x4c, 0x8d, 0x13, 0x1d, 0x0b, 0x46, 0x7e, 0xb8, 0xde, 0x87, 0x79, 0x82, 0x3d, 0xcb, 0xe5, 0x65,
+	0xf3, 0x84, 0x3f, 0x19, 0x69, 0x4e, 0x73, 0x31, 0x2b, 0x77, 0x29, 0xbf, 0x0b, 0xcb, 0x03, 0xf5,
+	0x3e, 0x79, 0xff, 0x19, 0xb1, 0x43, 0xd7, 0xb8, 0x32, 0xdf, 0xf9, 0xbf, 0xd4, 0x0e, 0x0d, 0x2e,
+	0x41, 0x5d, 0x06, 0x3c, 0x8d, 0x1b, 0x44, 0x58, 0x6e, 0x2a, 0xff, 0x2e, 0x78, 0xbd, 0x48, 0x5b,
+	0x55, 0xa0, 0xe5, 0x0c, 0x76, 0x60, 0x99, 0xd7, 0xad, 0xd9, 0x7b, 0xff, 0xcc, 0x3c, 0x0e, 0xba,
+	0x98, 0xf8, 0x96, 0x3a, 0x8c, 0x33, 0xc6, 0x55, 0x49, 0xdc, 0x3d, 0x7b, 0x10, 0x93, 0x98, 0x6d,
+	0x85, 0xb2, 0x5e, 0xa9, 0xac, 0x27, 0x6d, 0x80, 0xea, 0xaa, 0x3a, 0x85, 0xbf, 0xd0, 0x7a, 0x0b,
+	0xee, 0x2b, 0x99, 0x0f, 0xcd, 0x31, 0x5f, 0x14, 0x5b, 0x0d, 0x49, 0x1a, 0x66, 0xfa, 0x92, 0x86,
+	0xbf, 0xc6, 0xae, 0x5a, 0xcb, 0x69, 0xbb, 0xfe, 0x98, 0xbf, 0xb5, 0x54, 0x5c, 0x85, 0x08, 0xae,
+	0x0d, 0x9d, 0x27, 0x45, 0x4f, 0x60, 0x5a, 0xd5, 0xff, 0xc5, 0xf3, 0x63, 0xbc, 0xad, 0xe9, 0x93,
+	0x26, 0x5f, 0x20, 0x4a, 0x60, 0xc1, 0x84, 0xd9, 0x66, 0x14, 0x10, 0xac, 0xb2, 0x5d, 0x75, 0x98,
+	0x56, 0x19, 0x31, 0x31, 0xd6, 0xd6, 0x48, 0x63, 0xc5, 0x32, 0xce, 0xd4, 0x00, 0x52, 0x4a, 0xe1,
+	0x17, 0x00, 0x7a, 0x44, 0xf6, 0x38, 0x65, 0x65, 0x20, 0x51, 0x7d, 0x60, 0x9f, 0x2c, 0xa2, 0xe9,
+	0x5a, 0xed, 0x0e, 0x96, 0xa9, 0x5a, 0xd1, 0xb8, 0xf3, 0x8f, 0x1a, 0xcc, 0xc5, 0x65, 0xce, 0x13,
+	0x8b, 0x62, 0xb4, 0x06, 0xab, 0xe5, 0x7a, 0xad, 0x79, 0xb0, 0xaf, 0x1b, 0x66, 0xe3, 0x61, 0xa9,
+	0xa9, 0x9b, 0x07, 0xb5, 0x66, 0x43, 0x2f, 0x57, 0xef, 0x57, 0xf5, 0x4a, 0xee, 0x0a, 0x7a, 0x05,
+	0x56, 0x06, 0xe8, 0x86, 0xfe, 0xa0, 0xda, 0x6c, 0xe9, 0x86, 0x5e, 0xc9, 0x69, 0x43, 0xd8, 0xab,
+	0xb5, 0x6a, 0xab, 0x5a, 0xda, 0xab, 0x3e, 0xd1, 0x2b, 0xb9, 0x14, 0xba, 0x01, 0xd7, 0x07, 0xe8,
+	0x7b, 0xa5, 0x83, 0x5a, 0xf9, 0xa1, 0x5e, 0xc9, 0x4d, 0xa0, 0x55, 0xb8, 0x36, 0x40, 0x6c, 0xb6,
+	0xea, 0x8d, 0x86, 0x5e, 0xc9, 0xa5, 0x87, 0xd0, 0x2a, 0xfa, 0x9e, 0xde, 0xd2, 0x2b, 0xb9, 0xc9,
+	0xd5, 0xf4, 0xf7, 0x7e, 0xb4, 0x76, 0xe5, 0xce, 0xdf, 0x68, 0xbd, 0x9f, 0xc8, 0x96, 0x03, 0x4f,
+	0xa6, 0x04, 0x0d, 0x2b, 0xc2, 0xcd, 0xa0, 0x43, 0x6c, 0x8c, 0xb6, 0xe0, 0x6e, 0x2c, 0xa2, 0x5c,
+	0xdf, 0xdf, 0xaf, 0x36, 0x9b, 0xd5, 0x7a, 0xcd, 0x34, 0x4a, 0x2d, 0xdd, 0x6c, 0xd6, 0x0f, 0x8c,
+	0xf2, 0xe0, 0x5a, 0xef, 0xc1, 0x1b, 0x2f, 0x62, 0xa8, 0xd6, 0x1e, 0xea, 0x46, 0xb5, 0xc5, 0xd7,
+	0xfe, 0x26, 0x6c, 0xbe, 0x08, 0xae, 0x7f, 0xa7, 0xb1, 0x57, 0x2d, 0x57, 0x5b, 0xb9, 0x94, 0x9c,
+	0xf4, 0x17, 0x29, 0x58, 0xb9, 0x30, 0x0c, 0x44, 0x77, 0xe1, 0xb6, 0xa1, 0x3f, 0x2e, 0x19, 0x15,
+	0xb3, 0xd4, 0x6a, 0x19, 0xd5, 0xdd, 0x83, 0x16, 0x13, 0x58, 0xd1, 0xcb, 0x55, 0x2e, 0xb9, 0x7f,
+	0xb6, 0x9b, 0xf0, 0x8d, 0xcb, 0xc0, 0x65, 0x43, 0xaf, 0xc8, 0x89, 0x16, 0xe1, 0xce, 0x65, 0xc8,
+	0xfd, 0xd2, 0xde, 0xfd, 0xba, 0xb1, 0xaf, 0x57, 0xcc, 0x7d, 0x7d, 0xbf, 0x9e, 0x4b, 0xa1, 0xb7,
+	0xe0, 0xcd, 0xcb, 0xa7, 0xf1, 0xa8, 0x56, 0x7f, 0x5c, 0x33, 0xd5, 0xe2, 0x73, 0x13, 0xe8, 0x17,
+	0x61, 0xfb, 0x32, 0x8e, 0x8a, 0x5e, 0xab, 0xef, 0x9b, 0xb5, 0x7a, 0xcb, 0x2c, 0xed, 0xed, 0xd5,
+	0x1f, 0xef, 0x31, 0xfb, 0x61, 0x9b, 0xfc, 0x82, 0x25, 0x54, 0xaa, 0x87, 0xba, 0xc1, 0xb7, 0x1c,
+	0xbd, 0x0e, 0x85, 0xcb, 0x90, 0xf7, 0x4b, 0xd5, 0x3d, 0xbd, 0x92, 0x9b, 0x92, 0x5a, 0xfe, 0xb1,
+	0x06, 0x4b, 0xc3, 0xae, 0x23, 0x26, 0xa6, 0xb7, 0x65, 0x7b, 0x55, 0xbd, 0xd6, 0x32, 0x9b, 0xad,
+	0x52, 0xeb, 0xa0, 0x39, 0xa0, 0xdb, 0x57, 0xe1, 0x95, 0x0b, 0x70, 0xa5, 0x72, 0xab, 0x7a, 0xa8,
+	0xe7, 0x34, 0x74, 0x0b, 0xd6, 0x2f, 0x80, 0xe8, 0xdf, 0x69, 0x54, 0x8d, 0x6a, 0xed, 0x41, 0x2e,
+	0x85, 0x0a, 0xb0, 0x76, 0x19, 0x88, 0x9d, 0x02, 0x39, 0xe5, 0x3f, 0xd1, 0xce, 0xfd, 0x00, 0x45,
+	0x94, 0x75, 0xa2, 0x80, 0xa0, 0x3b, 0xf0, 0x7a, 0x2c, 0xc6, 0xd0, 0xf7, 0xeb, 0x87, 0xa5, 0x3d,
+	0x79, 0xce, 0x5a, 0x75, 0x63, 0x60, 0xea, 0xdf, 0x80, 0x8d, 0x4b, 0xb0, 0xf5, 0xc7, 0x35, 0xdd,
+	0xc8, 0x69, 0xe8, 0x0d, 0x78, 0xed, 0x12, 0xd4, 0x83, 0xfa, 0xa1, 0x6e, 0xd4, 0x4a, 0xb5, 0xb2,
+	0x1e, 0x1b, 0xee, 0xa7, 0xa9, 0x21, 0x17, 0x1c, 0xbf, 0x8c, 0x6e, 0xc3, 0xad, 0x73, 0xa2, 0x0c,
+	0xbd, 0xd4, 0x3c, 0x67, 0xb0, 0xc3, 0xc6, 0x94, 0x40, 0x3e, 0x2d, 0xd3, 0xd0, 0x3f, 0x38, 0xd0,
+	0x9b, 0xad, 0x9c, 0xd6, 0xb7, 0x4f, 0x03, 0xd0, 0xe4, 0xdc, 0xd8, 0x81, 0xb9, 0x08, 0x57, 0x7e,
+	0x58, 0xaa, 0xd5, 0xf4, 0x3d, 0xb3, 0x55, 0xdd, 0xd7, 0xeb, 0x07, 0xad, 0xdc, 0x44, 0xdf, 0x79,
+	0x1d, 0x00, 0xef, 0x55, 0xef, 0xeb, 0x0c, 0x18, 0x6f, 0x4b, 0xfa, 0xb2, 0xd9, 0x0a, 0x17, 0xa6,
+	0x8c, 0x6e, 0xf2, 0x32, 0xa8, 0x9a, 0x85, 0x6e, 0x18, 0x75, 0x43, 0xd9, 0xe7, 0xee, 0xe3, 0x4f,
+	0x3e, 0x5f, 0xd3, 0x7e, 0xf2, 0xf9, 0x9a, 0xf6, 0xf3, 0xcf, 0xd7, 0xb4, 0xef, 0x7f, 0xb1, 0x76,
+	0xe5, 0x27, 0x5f, 0xac, 0x5d, 0xf9, 0xb7, 0x2f, 0xd6, 0xae, 0x3c, 0xf9, 0xd6, 0xf9, 0x82, 0x6f,
+	0xef, 0x9e, 0xb8, 0x17, 0xff, 0x75, 0x5b, 0xf7, 0x97, 0xb6, 0x9e, 0xf7, 0xff, 0xb5, 0x1d, 0xaf,
+	0x05, 0x1f, 0x4d, 0xf1, 0x4b, 0xf1, 0x9b, 0xff, 0x3b, 0x00, 0x1c, 0x73, 0x2f, 0x0c, 0x9e, 0x37,
+	0x00, 0x00,
+}
+
+func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.MinStake != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
 	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x72
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
-		}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
-		}
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.MinStake != 0 {
-		n += 2 + sovProvider(uint64(m.MinStake))
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.AllowInactiveVals {
-		n += 3
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err1 != nil {
+		return 0, err1
 	}
-	return n
-}
-
-func (m *ConsumerRemovalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
-	n += 1 + l + sovProvider(uint64(l))
-	return n
-}
-
-func (m *ConsumerModificationProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n1
+	i = encodeVarintProvider(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n2
+	i = encodeVarintProvider(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
+	i -= n3
+	i = encodeVarintProvider(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	i -= n4
+	i = encodeVarintProvider(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MinStake != 0 {
-		n += 1 + sovProvider(uint64(m.MinStake))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowInactiveVals {
-		n += 2
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EquivocationProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n6
+	i = encodeVarintProvider(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Equivocations) > 0 {
-		for _, e := range m.Equivocations {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeRewardDenomsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.MinStake != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.DenomsToAdd) > 0 {
-		for _, s := range m.DenomsToAdd {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.DenomsToRemove) > 0 {
-		for _, s := range m.DenomsToRemove {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	return n
-}
-
-func (m *GlobalSlashEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerChainID)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.IbcSeqNum != 0 {
-		n += 1 + sovProvider(uint64(m.IbcSeqNum))
-	}
-	l = len(m.ProviderValConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TemplateClient != nil {
-		l = m.TemplateClient.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.TrustingPeriodFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.SlashMeterReplenishFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.ConsumerRewardDenomRegistrationFee.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	if m.BlocksPerEpoch != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.MaxProviderConsensusValidators != 0 {
-		n += 1 + sovProvider(uint64(m.MaxProviderConsensusValidators))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SlashAcks) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConsumerAdditionProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
-	}
-	return n
+func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerRemovalProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Equivocations) > 0 {
+		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AddressList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.DenomsToRemove) > 0 {
+		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToRemove[iNdEx])
+			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *ChannelToChain) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.DenomsToAdd) > 0 {
+		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToAdd[iNdEx])
+			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSetChangePackets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for _, e := range m.List {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *KeyAssignmentReplacement) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ProviderValConsAddr) > 0 {
+		i -= len(m.ProviderValConsAddr)
+		copy(dAtA[i:], m.ProviderValConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.PrevCKey != nil {
-		l = m.PrevCKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.IbcSeqNum != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	if len(m.ConsumerChainID) > 0 {
+		i -= len(m.ConsumerChainID)
+		copy(dAtA[i:], m.ConsumerChainID)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintProvider(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorConsumerPubKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ExcludeTokenizedSharesFromTopN {
+		i--
+		if m.ExcludeTokenizedSharesFromTopN {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.AllowZeroKeyAssignmentNonce {
+		i--
+		if m.AllowZeroKeyAssignmentNonce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
 	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.NumberOfEpochsToRetainEconomicSecurity != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToRetainEconomicSecurity))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	return n
-}
-
-func (m *ValidatorByConsumerAddr) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxClientCreationsPerBlock != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxClientCreationsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.SendUpgradeNotices {
+		i--
+		if m.SendUpgradeNotices {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	l = len(m.ConsumerAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EmergencyOptOutCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyOptOutCooldown):])
+	if err8 != nil {
+		return 0, err8
 	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n8
+	i = encodeVarintProvider(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.EmergencyOptOutSlashFraction) > 0 {
+		i -= len(m.EmergencyOptOutSlashFraction)
+		copy(dAtA[i:], m.EmergencyOptOutSlashFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.EmergencyOptOutSlashFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return n
-}
-
-func (m *ConsumerAddrsToPruneV2) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxConsumerParticipation != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxConsumerParticipation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ConsumerClientExpiryWarningFraction) > 0 {
+		i -= len(m.ConsumerClientExpiryWarningFraction)
+		copy(dAtA[i:], m.ConsumerClientExpiryWarningFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerClientExpiryWarningFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
-	n += 1 + l + sovProvider(uint64(l))
-	if m.ConsumerAddrs != nil {
-		l = m.ConsumerAddrs.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if m.UpgradeQuietPeriod != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.UpgradeQuietPeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	return n
-}
-
-func (m *ConsensusValidator) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LifetimeReminderFractions) > 0 {
+		for iNdEx := len(m.LifetimeReminderFractions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LifetimeReminderFractions[iNdEx])
+			copy(dAtA[i:], m.LifetimeReminderFractions[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.LifetimeReminderFractions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ProviderConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.NumberOfEpochsToRetainConsumerValsets != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToRetainConsumerValsets))
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DormancyPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DormancyPeriod):])
+	if err9 != nil {
+		return 0, err9
 	}
-	if m.PublicKey != nil {
-		l = m.PublicKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n9
+	i = encodeVarintProvider(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x72
+	if m.MaxConsumerCleanupDeletionsPerBlock != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxConsumerCleanupDeletionsPerBlock))
+		i--
+		dAtA[i] = 0x68
 	}
-	if m.JoinHeight != 0 {
-		n += 1 + sovProvider(uint64(m.JoinHeight))
+	if m.MaxProviderConsensusValidators != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderConsensusValidators))
+		i--
+		dAtA[i] = 0x60
 	}
-	return n
-}
-
-func (m *ConsumerRewardsAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
+		i--
+		dAtA[i] = 0x58
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ConsumerMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err11 != nil {
+		return 0, err11
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n11
+	i = encodeVarintProvider(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err12 != nil {
+		return 0, err12
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n12
+	i = encodeVarintProvider(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TrustingPeriodFraction) > 0 {
+		i -= len(m.TrustingPeriodFraction)
+		copy(dAtA[i:], m.TrustingPeriodFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.TemplateClient != nil {
+		{
+			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerInitializationParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PowerShapingParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddressList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MinStake != 0 {
-		n += 1 + sovProvider(uint64(m.MinStake))
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.AllowInactiveVals {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerIds) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PrevCKey != nil {
+		{
+			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddr) > 0 {
+		i -= len(m.ConsumerAddr)
+		copy(dAtA[i:], m.ConsumerAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerAddrs != nil {
+		{
+			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintProvider(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsensusValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderConsAddr) > 0 {
+		i -= len(m.ProviderConsAddr)
+		copy(dAtA[i:], m.ProviderConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorRewards) > 0 {
+		for iNdEx := len(m.ValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewardsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProviderConsAddr) > 0 {
+		i -= len(m.ProviderConsAddr)
+		copy(dAtA[i:], m.ProviderConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TermsHash) > 0 {
+		i -= len(m.TermsHash)
+		copy(dAtA[i:], m.TermsHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.TermsHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EndpointInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndpointInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisUrl) > 0 {
+		i -= len(m.GenesisUrl)
+		copy(dAtA[i:], m.GenesisUrl)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RpcUrls) > 0 {
+		for iNdEx := len(m.RpcUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RpcUrls[iNdEx])
+			copy(dAtA[i:], m.RpcUrls[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.RpcUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seeds) > 0 {
+		for iNdEx := len(m.Seeds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Seeds[iNdEx])
+			copy(dAtA[i:], m.Seeds[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Seeds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PersistentPeers) > 0 {
+		for iNdEx := len(m.PersistentPeers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PersistentPeers[iNdEx])
+			copy(dAtA[i:], m.PersistentPeers[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.PersistentPeers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerTimeoutPeriods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerTimeoutPeriods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerTimeoutPeriods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintProvider(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintProvider(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerInitializationParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerInitializationParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomRedistributionFractions) > 0 {
+		for iNdEx := len(m.DenomRedistributionFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRedistributionFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.MinSignedPerWindow) > 0 {
+		i -= len(m.MinSignedPerWindow)
+		copy(dAtA[i:], m.MinSignedPerWindow)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.MinSignedPerWindow)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxLifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLifetime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintProvider(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.PreCcvClientId) > 0 {
+		i -= len(m.PreCcvClientId)
+		copy(dAtA[i:], m.PreCcvClientId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.PreCcvClientId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.PreCcvEvidenceMinHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.PreCcvEvidenceMinHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ConsumerDenomMetadata != nil {
+		{
+			size, err := m.ConsumerDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ConsumerDenom) > 0 {
+		i -= len(m.ConsumerDenom)
+		copy(dAtA[i:], m.ConsumerDenom)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerDenom)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintProvider(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x3a
+	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintProvider(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x32
+	n25, err25 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintProvider(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x2a
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintProvider(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerShapingParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerShapingParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReOptInCooldownAfterSlash != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ReOptInCooldownAfterSlash, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReOptInCooldownAfterSlash):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintProvider(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AllowTopNEmergencyOptOut {
+		i--
+		if m.AllowTopNEmergencyOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DowntimeGracePeriod != nil {
+		n29, err29 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DowntimeGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DowntimeGracePeriod):])
+		if err29 != nil {
+			return 0, err29
+		}
+		i -= n29
+		i = encodeVarintProvider(dAtA, i, uint64(n29))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxProviderRank != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderRank))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerLifetime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerLifetime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerLifetime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReminderTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReminderTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintProvider(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	if m.RemindersEmitted != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.RemindersEmitted))
+		i--
+		dAtA[i] = 0x18
+	}
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SunsetTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SunsetTime):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintProvider(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x12
+	n32, err32 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LaunchTime):])
+	if err32 != nil {
+		return 0, err32
+	}
+	i -= n32
+	i = encodeVarintProvider(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerValSetSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerValSetSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerValSetSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerEconomicSecurity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerEconomicSecurity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerEconomicSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatorCount != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TotalTokens.Size()
+		i -= size
+		if _, err := m.TotalTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TotalPower != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	n33, err33 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintProvider(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowlistedRewardDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistedRewardDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedIcaMsgTypes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedIcaMsgTypes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedIcaMsgTypes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerShapingAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerShapingAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerShapingAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VscIdToHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VscIdToHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VscIdToHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintProvider(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VscId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n35, err35 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AverageBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageBlockTime):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintProvider(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x22
+	n36, err36 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err36 != nil {
+		return 0, err36
+	}
+	i -= n36
+	i = encodeVarintProvider(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x1a
+	if m.StartHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardAttributionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardAttributionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardAttributionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Decision != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n37, err37 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err37 != nil {
+		return 0, err37
+	}
+	i -= n37
+	i = encodeVarintProvider(dAtA, i, uint64(n37))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SkippedDowntimeSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SkippedDowntimeSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SkippedDowntimeSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n38, err38 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OptInTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OptInTime):])
+	if err38 != nil {
+		return 0, err38
+	}
+	i -= n38
+	i = encodeVarintProvider(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x3a
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n39, err39 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err39 != nil {
+		return 0, err39
+	}
+	i -= n39
+	i = encodeVarintProvider(dAtA, i, uint64(n39))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnroutableSlashPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnroutableSlashPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnroutableSlashPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InfractionHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n40, err40 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime):])
+	if err40 != nil {
+		return 0, err40
+	}
+	i -= n40
+	i = encodeVarintProvider(dAtA, i, uint64(n40))
+	i--
+	dAtA[i] = 0x1a
+	if m.ReceivedHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ReceivedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreOptInInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreOptInInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreOptInInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptInHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.OptInHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InfractionHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.InfractionHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n41, err41 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err41 != nil {
+		return 0, err41
+	}
+	i -= n41
+	i = encodeVarintProvider(dAtA, i, uint64(n41))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerClientExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerClientExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerClientExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n42, err42 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RemainingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintProvider(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x3a
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintProvider(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x32
+	n44, err44 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err44 != nil {
+		return 0, err44
+	}
+	i -= n44
+	i = encodeVarintProvider(dAtA, i, uint64(n44))
+	i--
+	dAtA[i] = 0x2a
+	if m.Status != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRemovalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Reason != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerCreationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerCreationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerCreationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedByGovernance {
+		i--
+		if m.CreatedByGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerUpgradeNotice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUpgradeNotice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerUpgradeNotice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n45, err45 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err45 != nil {
+		return 0, err45
+	}
+	i -= n45
+	i = encodeVarintProvider(dAtA, i, uint64(n45))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerUpgradeNotices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUpgradeNotices) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerUpgradeNotices) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Notices) > 0 {
+		for iNdEx := len(m.Notices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreEntries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreEntries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreEntries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumerAdditionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 2 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerModificationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	return n
+}
+
+func (m *EquivocationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Equivocations) > 0 {
+		for _, e := range m.Equivocations {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChangeRewardDenomsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for _, s := range m.DenomsToAdd {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.DenomsToRemove) > 0 {
+		for _, s := range m.DenomsToRemove {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GlobalSlashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerChainID)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.IbcSeqNum != 0 {
+		n += 1 + sovProvider(uint64(m.IbcSeqNum))
+	}
+	l = len(m.ProviderValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemplateClient != nil {
+		l = m.TemplateClient.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.TrustingPeriodFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.ConsumerRewardDenomRegistrationFee.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	}
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
+	}
+	if m.MaxProviderConsensusValidators != 0 {
+		n += 1 + sovProvider(uint64(m.MaxProviderConsensusValidators))
+	}
+	if m.MaxConsumerCleanupDeletionsPerBlock != 0 {
+		n += 1 + sovProvider(uint64(m.MaxConsumerCleanupDeletionsPerBlock))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DormancyPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.NumberOfEpochsToRetainConsumerValsets != 0 {
+		n += 1 + sovProvider(uint64(m.NumberOfEpochsToRetainConsumerValsets))
+	}
+	if len(m.LifetimeReminderFractions) > 0 {
+		for _, s := range m.LifetimeReminderFractions {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.UpgradeQuietPeriod != 0 {
+		n += 2 + sovProvider(uint64(m.UpgradeQuietPeriod))
+	}
+	l = len(m.ConsumerClientExpiryWarningFraction)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if m.MaxConsumerParticipation != 0 {
+		n += 2 + sovProvider(uint64(m.MaxConsumerParticipation))
+	}
+	l = len(m.EmergencyOptOutSlashFraction)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EmergencyOptOutCooldown)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.SendUpgradeNotices {
+		n += 3
+	}
+	if m.MaxClientCreationsPerBlock != 0 {
+		n += 2 + sovProvider(uint64(m.MaxClientCreationsPerBlock))
+	}
+	if m.NumberOfEpochsToRetainEconomicSecurity != 0 {
+		n += 2 + sovProvider(uint64(m.NumberOfEpochsToRetainEconomicSecurity))
+	}
+	if m.AllowZeroKeyAssignmentNonce {
+		n += 3
+	}
+	if m.ExcludeTokenizedSharesFromTopN {
+		n += 3
+	}
+	return n
+}
+
+func (m *SlashAcks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerAdditionProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelToChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChangePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyAssignmentReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PrevCKey != nil {
+		l = m.PrevCKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorConsumerPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorByConsumerAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAddrsToPruneV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.ConsumerAddrs != nil {
+		l = m.ConsumerAddrs.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyAssignmentNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProvider(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ConsensusValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.JoinHeight != 0 {
+		n += 1 + sovProvider(uint64(m.JoinHeight))
+	}
+	return n
+}
+
+func (m *ConsumerRewardsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewardsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.TermsHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *EndpointInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PersistentPeers) > 0 {
+		for _, s := range m.PersistentPeers {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Seeds) > 0 {
+		for _, s := range m.Seeds {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.RpcUrls) > 0 {
+		for _, s := range m.RpcUrls {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	l = len(m.GenesisUrl)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerTimeoutPeriods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerInitializationParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerDenom)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerDenomMetadata != nil {
+		l = m.ConsumerDenomMetadata.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PreCcvEvidenceMinHeight != 0 {
+		n += 1 + sovProvider(uint64(m.PreCcvEvidenceMinHeight))
+	}
+	l = len(m.PreCcvClientId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxLifetime)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.SignedBlocksWindow != 0 {
+		n += 2 + sovProvider(uint64(m.SignedBlocksWindow))
+	}
+	l = len(m.MinSignedPerWindow)
+	if l > 0 {
+		n += 2 + l + sovProvider(uint64(l))
+	}
+	if len(m.DenomRedistributionFractions) > 0 {
+		for _, e := range m.DenomRedistributionFractions {
+			l = e.Size()
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PowerShapingParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	if m.MaxProviderRank != 0 {
+		n += 1 + sovProvider(uint64(m.MaxProviderRank))
+	}
+	if m.DowntimeGracePeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DowntimeGracePeriod)
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.AllowTopNEmergencyOptOut {
+		n += 2
+	}
+	if m.ReOptInCooldownAfterSlash != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ReOptInCooldownAfterSlash)
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
 		for _, s := range m.Ids {
 			l = len(s)
 			n += 1 + l + sovProvider(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ConsumerLifetime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LaunchTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SunsetTime)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.RemindersEmitted != 0 {
+		n += 1 + sovProvider(uint64(m.RemindersEmitted))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReminderTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerValSetSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerEconomicSecurity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.TotalPower != 0 {
+		n += 1 + sovProvider(uint64(m.TotalPower))
+	}
+	l = m.TotalTokens.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.ValidatorCount != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorCount))
+	}
+	return n
+}
+
+func (m *AllowlistedRewardDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowedIcaMsgTypes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PowerShapingAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardsPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *VscIdToHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovProvider(uint64(m.VscId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *EpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovProvider(uint64(m.Index))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovProvider(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AverageBlockTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *RewardAttributionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovProvider(uint64(m.Decision))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *SkippedDowntimeSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovProvider(uint64(m.ValsetUpdateId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OptInTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *UnroutableSlashPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
+	if m.ReceivedHeight != 0 {
+		n += 1 + sovProvider(uint64(m.ReceivedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReceivedTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovProvider(uint64(m.ValsetUpdateId))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovProvider(uint64(m.InfractionHeight))
+	}
+	return n
+}
+
+func (m *PreOptInInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovProvider(uint64(m.ValsetUpdateId))
+	}
+	if m.InfractionHeight != 0 {
+		n += 1 + sovProvider(uint64(m.InfractionHeight))
+	}
+	if m.OptInHeight != 0 {
+		n += 1 + sovProvider(uint64(m.OptInHeight))
+	}
+	return n
+}
+
+func (m *ConsumerClientExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovProvider(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerRemovalRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovProvider(uint64(m.Reason))
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerCreationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedByGovernance {
+		n += 2
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovProvider(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *ConsumerUpgradeNotice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerUpgradeNotices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Notices) > 0 {
+		for _, e := range m.Notices {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreEntries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			}
+			m.BlocksPerDistributionTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
+			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+			}
+			m.IbcSeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderValConsAddr == nil {
+				m.ProviderValConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateClient == nil {
+				m.TemplateClient = &_07_tendermint.ClientState{}
+			}
+			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
+			}
+			m.BlocksPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
+			}
+			m.NumberOfEpochsToStartReceivingRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderConsensusValidators", wireType)
+			}
+			m.MaxProviderConsensusValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProviderConsensusValidators |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsumerCleanupDeletionsPerBlock", wireType)
+			}
+			m.MaxConsumerCleanupDeletionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsumerCleanupDeletionsPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DormancyPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DormancyPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToRetainConsumerValsets", wireType)
+			}
+			m.NumberOfEpochsToRetainConsumerValsets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfEpochsToRetainConsumerValsets |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeReminderFractions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeReminderFractions = append(m.LifetimeReminderFractions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeQuietPeriod", wireType)
+			}
+			m.UpgradeQuietPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradeQuietPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerClientExpiryWarningFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerClientExpiryWarningFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConsumerParticipation", wireType)
+			}
+			m.MaxConsumerParticipation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxConsumerParticipation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyOptOutSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyOptOutSlashFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyOptOutCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EmergencyOptOutCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendUpgradeNotices", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SendUpgradeNotices = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClientCreationsPerBlock", wireType)
+			}
+			m.MaxClientCreationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClientCreationsPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToRetainEconomicSecurity", wireType)
+			}
+			m.NumberOfEpochsToRetainEconomicSecurity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfEpochsToRetainEconomicSecurity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowZeroKeyAssignmentNonce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowZeroKeyAssignmentNonce = bool(v != 0)
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeTokenizedSharesFromTopN", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeTokenizedSharesFromTopN = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCKey == nil {
+				m.PrevCKey = &crypto.PublicKey{}
+			}
+			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsumerAddr == nil {
+				m.ConsumerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerAddrs == nil {
+				m.ConsumerAddrs = &AddressList{}
+			}
+			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderConsAddr == nil {
+				m.ProviderConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &crypto.PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
+			}
+			m.JoinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types2.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorRewardsAllocation{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewardsAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewardsAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderConsAddr == nil {
+				m.ProviderConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types2.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TermsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *AllowlistedRewardDenoms) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProvider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProvider(x uint64) (n int) {
-	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+func (m *EndpointInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4039,15 +12364,15 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndpointInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndpointInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentPeers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4075,11 +12400,75 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.PersistentPeers = append(m.PersistentPeers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seeds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seeds = append(m.Seeds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpcUrls = append(m.RpcUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4096,24 +12485,107 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerTimeoutPeriods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerTimeoutPeriods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerTimeoutPeriods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4123,25 +12595,76 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
 			}
@@ -4174,7 +12697,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
@@ -4208,7 +12731,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				m.GenesisHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
 			}
@@ -4242,7 +12765,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				m.BinaryHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
 			}
@@ -4275,7 +12798,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
@@ -4308,7 +12831,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
 			}
@@ -4341,7 +12864,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
 			}
@@ -4374,7 +12897,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
 			}
@@ -4406,7 +12929,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 9:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
 			}
@@ -4425,7 +12948,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 13:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
@@ -4444,7 +12967,7 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 14:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
 			}
@@ -4476,66 +12999,9 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
-			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
-			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 18:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4563,13 +13029,13 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDenomMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4579,48 +13045,33 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			if m.ConsumerDenomMetadata == nil {
+				m.ConsumerDenomMetadata = &types4.Metadata{}
 			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.ConsumerDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 21:
+			iNdEx = postIndex
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreCcvEvidenceMinHeight", wireType)
 			}
-			var v int
+			m.PreCcvEvidenceMinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4628,67 +13079,16 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowInactiveVals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreCcvEvidenceMinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreCcvClientId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4716,13 +13116,13 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.PreCcvClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLifetime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4732,27 +13132,47 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4780,11 +13200,11 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.MinSignedPerWindow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRedistributionFractions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4811,7 +13231,8 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomRedistributionFractions = append(m.DenomRedistributionFractions, types3.DenomRedistributionFraction{})
+			if err := m.DenomRedistributionFractions[len(m.DenomRedistributionFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4836,7 +13257,7 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
+func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4859,109 +13280,13 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
 			}
@@ -4980,7 +13305,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
 			}
@@ -4999,7 +13324,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
 			}
@@ -5018,7 +13343,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
@@ -5050,7 +13375,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
 			}
@@ -5082,7 +13407,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
 			}
@@ -5101,7 +13426,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
 			}
@@ -5121,61 +13446,11 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowInactiveVals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderRank", wireType)
 			}
-			var stringLen uint64
+			m.MaxProviderRank = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5185,29 +13460,16 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxProviderRank |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeGracePeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5217,27 +13479,51 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			if m.DowntimeGracePeriod == nil {
+				m.DowntimeGracePeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DowntimeGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTopNEmergencyOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowTopNEmergencyOptOut = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReOptInCooldownAfterSlash", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5264,8 +13550,10 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
-			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReOptInCooldownAfterSlash == nil {
+				m.ReOptInCooldownAfterSlash = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ReOptInCooldownAfterSlash, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5290,7 +13578,7 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5313,111 +13601,15 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5445,7 +13637,7 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5468,7 +13660,7 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+func (m *ConsumerLifetime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5491,15 +13683,15 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerLifetime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerLifetime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5526,15 +13718,15 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LaunchTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5544,29 +13736,30 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SunsetTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemindersEmitted", wireType)
 			}
-			m.IbcSeqNum = 0
+			m.RemindersEmitted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5576,16 +13769,16 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				m.RemindersEmitted |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReminderTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5595,24 +13788,23 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderValConsAddr == nil {
-				m.ProviderValConsAddr = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReminderTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5636,7 +13828,7 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ConsumerValSetSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,15 +13851,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerValSetSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerValSetSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5694,83 +13886,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateClient == nil {
-				m.TemplateClient = &_07_tendermint.ClientState{}
-			}
-			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ConsensusValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerEconomicSecurity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerEconomicSecurity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerEconomicSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5780,30 +13955,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5813,27 +13987,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5860,15 +14021,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			m.BlocksPerEpoch = 0
+			m.TotalPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5878,16 +14039,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerEpoch |= int64(b&0x7F) << shift
+				m.TotalPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokens", wireType)
 			}
-			m.NumberOfEpochsToStartReceivingRewards = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5897,16 +14058,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderConsensusValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCount", wireType)
 			}
-			m.MaxProviderConsensusValidators = 0
+			m.ValidatorCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5916,7 +14092,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxProviderConsensusValidators |= int64(b&0x7F) << shift
+				m.ValidatorCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5942,7 +14118,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5965,15 +14141,15 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6001,7 +14177,7 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6024,7 +14200,7 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+func (m *AllowedIcaMsgTypes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6047,17 +14223,17 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowedIcaMsgTypes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowedIcaMsgTypes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6067,25 +14243,23 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6108,7 +14282,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+func (m *PowerShapingAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6131,17 +14305,17 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerShapingAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerShapingAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6151,25 +14325,23 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6192,7 +14364,7 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressList) Unmarshal(dAtA []byte) error {
+func (m *RewardsPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6215,17 +14387,17 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6235,24 +14407,12 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -6274,7 +14434,7 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+func (m *VscIdToHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6297,17 +14457,17 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: VscIdToHeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VscIdToHeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
 			}
-			var stringLen uint64
+			m.VscId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6317,29 +14477,35 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VscId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6349,23 +14515,24 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6388,7 +14555,7 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6411,15 +14578,86 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6446,8 +14684,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AverageBlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6472,7 +14709,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+func (m *RewardAttributionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6495,17 +14732,17 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardAttributionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardAttributionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6515,29 +14752,33 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6564,18 +14805,15 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PrevCKey == nil {
-				m.PrevCKey = &crypto.PublicKey{}
-			}
-			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6585,64 +14823,59 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6670,13 +14903,13 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6686,31 +14919,48 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= RewardAttributionDecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6720,27 +14970,23 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6763,7 +15009,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+func (m *SkippedDowntimeSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6786,17 +15032,17 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: SkippedDowntimeSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SkippedDowntimeSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var stringLen uint64
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6806,29 +15052,16 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6838,31 +15071,16 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsumerAddr == nil {
-				m.ConsumerAddr = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6872,79 +15090,28 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6972,13 +15139,13 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6988,28 +15155,46 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7036,10 +15221,7 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerAddrs == nil {
-				m.ConsumerAddrs = &AddressList{}
-			}
-			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OptInTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7064,7 +15246,7 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
+func (m *UnroutableSlashPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7087,17 +15269,17 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnroutableSlashPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnroutableSlashPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			var byteLen int
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7107,31 +15289,16 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderConsAddr == nil {
-				m.ProviderConsAddr = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedHeight", wireType)
 			}
-			m.Power = 0
+			m.ReceivedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7141,14 +15308,14 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.ReceivedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7175,18 +15342,15 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PublicKey == nil {
-				m.PublicKey = &crypto.PublicKey{}
-			}
-			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
-			m.JoinHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7196,66 +15360,29 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.JoinHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7265,26 +15392,62 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types2.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7306,7 +15469,7 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+func (m *PreOptInInfraction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7325,21 +15488,59 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreOptInInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreOptInInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7349,27 +15550,28 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7397,11 +15599,11 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7429,8 +15631,65 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionHeight", wireType)
+			}
+			m.InfractionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptInHeight", wireType)
+			}
+			m.OptInHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptInHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7452,7 +15711,7 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
+func (m *ConsumerClientExpiry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7475,17 +15734,17 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerClientExpiry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerClientExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7495,30 +15754,29 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7528,31 +15786,29 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7562,31 +15818,29 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7596,28 +15850,14 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= ConsumerClientStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7644,13 +15884,13 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7677,13 +15917,13 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7710,66 +15950,65 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RemainingTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7779,14 +16018,14 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
+				m.Reason |= ConsumerRemovalReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7814,7 +16053,7 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			m.Note = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7837,7 +16076,7 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
+func (m *ConsumerCreationRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7860,17 +16099,17 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerCreationRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerCreationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedByGovernance", wireType)
 			}
-			m.Top_N = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7880,16 +16119,17 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.CreatedByGovernance = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.ValidatorsPowerCap = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7899,33 +16139,64 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerUpgradeNotice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerUpgradeNotice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerUpgradeNotice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7953,11 +16224,30 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7985,13 +16275,13 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.BinaryHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.MinStake = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8001,16 +16291,80 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerUpgradeNotices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerUpgradeNotices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerUpgradeNotices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notices", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8020,12 +16374,26 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notices = append(m.Notices, ConsumerUpgradeNotice{})
+			if err := m.Notices[len(m.Notices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -8047,7 +16415,7 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
+func (m *StoreEntries) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8070,17 +16438,17 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreEntries: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreEntries: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8090,23 +16458,25 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.Entries = append(m.Entries, StoreEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8129,7 +16499,7 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
+func (m *StoreEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8152,17 +16522,17 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8172,23 +16542,59 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
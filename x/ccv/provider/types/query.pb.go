@@ -9,6 +9,8 @@ import (
 	fmt "fmt"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -20,6 +22,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -41,6 +44,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryConsumerGenesisRequest struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the consumer genesis version (e.g., "v5") to which the genesis state is transformed (optional)
+	TargetVersion string `protobuf:"bytes,2,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
 }
 
 func (m *QueryConsumerGenesisRequest) Reset()         { *m = QueryConsumerGenesisRequest{} }
@@ -83,8 +88,21 @@ func (m *QueryConsumerGenesisRequest) GetConsumerId() string {
 	return ""
 }
 
+func (m *QueryConsumerGenesisRequest) GetTargetVersion() string {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return ""
+}
+
 type QueryConsumerGenesisResponse struct {
 	GenesisState types.ConsumerGenesisState `protobuf:"bytes,1,opt,name=genesis_state,json=genesisState,proto3" json:"genesis_state"`
+	// the hex-encoded SHA-256 hash of the consumer genesis created at launch
+	// (empty if the consumer chain has not yet launched)
+	GenesisHash string `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the JSON-encoded genesis state in the format of the requested target version
+	// (empty if no target version was requested)
+	TransformedGenesisState string `protobuf:"bytes,3,opt,name=transformed_genesis_state,json=transformedGenesisState,proto3" json:"transformed_genesis_state,omitempty"`
 }
 
 func (m *QueryConsumerGenesisResponse) Reset()         { *m = QueryConsumerGenesisResponse{} }
@@ -127,6 +145,20 @@ func (m *QueryConsumerGenesisResponse) GetGenesisState() types.ConsumerGenesisSt
 	return types.ConsumerGenesisState{}
 }
 
+func (m *QueryConsumerGenesisResponse) GetGenesisHash() string {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return ""
+}
+
+func (m *QueryConsumerGenesisResponse) GetTransformedGenesisState() string {
+	if m != nil {
+		return m.TransformedGenesisState
+	}
+	return ""
+}
+
 type QueryConsumerChainsRequest struct {
 	// The phase of the consumer chains returned (optional)
 	// Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5
@@ -182,6 +214,7 @@ func (m *QueryConsumerChainsRequest) GetPagination() *query.PageRequest {
 }
 
 type QueryConsumerChainsResponse struct {
+	// the consumer chains in the requested page, sorted by phase and then by chain id
 	Chains     []*Chain            `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -261,6 +294,25 @@ type Chain struct {
 	ConsumerId        string `protobuf:"bytes,13,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 	// the reward denoms allowlisted by this consumer chain
 	AllowlistedRewardDenoms *AllowlistedRewardDenoms `protobuf:"bytes,14,opt,name=allowlisted_reward_denoms,json=allowlistedRewardDenoms,proto3" json:"allowlisted_reward_denoms,omitempty"`
+	// Corresponds to the maximum rank (by bonded tokens on the provider chain) a validator can have
+	// to be eligible to validate the consumer chain.
+	MaxProviderRank uint32 `protobuf:"varint,15,opt,name=max_provider_rank,json=maxProviderRank,proto3" json:"max_provider_rank,omitempty"`
+	// Corresponds to whether the consumer chain is dormant, i.e., no packets were received
+	// from the consumer chain for at least the dormancy period
+	Dormant bool `protobuf:"varint,16,opt,name=dormant,proto3" json:"dormant,omitempty"`
+	// The time at which the last packet (i.e., VSC ack, slash packet, or reward packet)
+	// was received from the consumer chain
+	LastPacketReceivedTime *time.Time `protobuf:"bytes,17,opt,name=last_packet_received_time,json=lastPacketReceivedTime,proto3,stdtime" json:"last_packet_received_time,omitempty"`
+	// Corresponds to whether the consumer chain was verified by governance
+	Verified bool `protobuf:"varint,18,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Corresponds to whether the submission of equivocation evidence for the consumer chain
+	// was paused by governance
+	EvidenceSubmissionPaused bool `protobuf:"varint,19,opt,name=evidence_submission_paused,json=evidenceSubmissionPaused,proto3" json:"evidence_submission_paused,omitempty"`
+	// Corresponds to how the consumer chain was created
+	// (not set if the chain was created before this record was introduced)
+	CreationRecord *ConsumerCreationRecord `protobuf:"bytes,20,opt,name=creation_record,json=creationRecord,proto3" json:"creation_record,omitempty"`
+	// Corresponds to why the consumer chain was stopped (not set if the chain was not stopped)
+	RemovalRecord *ConsumerRemovalRecord `protobuf:"bytes,21,opt,name=removal_record,json=removalRecord,proto3" json:"removal_record,omitempty"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -394,6 +446,55 @@ func (m *Chain) GetAllowlistedRewardDenoms() *AllowlistedRewardDenoms {
 	return nil
 }
 
+func (m *Chain) GetMaxProviderRank() uint32 {
+	if m != nil {
+		return m.MaxProviderRank
+	}
+	return 0
+}
+
+func (m *Chain) GetDormant() bool {
+	if m != nil {
+		return m.Dormant
+	}
+	return false
+}
+
+func (m *Chain) GetLastPacketReceivedTime() *time.Time {
+	if m != nil {
+		return m.LastPacketReceivedTime
+	}
+	return nil
+}
+
+func (m *Chain) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Chain) GetEvidenceSubmissionPaused() bool {
+	if m != nil {
+		return m.EvidenceSubmissionPaused
+	}
+	return false
+}
+
+func (m *Chain) GetCreationRecord() *ConsumerCreationRecord {
+	if m != nil {
+		return m.CreationRecord
+	}
+	return nil
+}
+
+func (m *Chain) GetRemovalRecord() *ConsumerRemovalRecord {
+	if m != nil {
+		return m.RemovalRecord
+	}
+	return nil
+}
+
 type QueryValidatorConsumerAddrRequest struct {
 	// The consensus address of the validator on the provider chain
 	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
@@ -437,6 +538,8 @@ var xxx_messageInfo_QueryValidatorConsumerAddrRequest proto.InternalMessageInfo
 type QueryValidatorConsumerAddrResponse struct {
 	// The address of the validator on the consumer chain
 	ConsumerAddress string `protobuf:"bytes,1,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// The nonce to set in the next MsgAssignConsumerKey of the validator on the consumer chain
+	KeyAssignmentNonce uint64 `protobuf:"varint,2,opt,name=key_assignment_nonce,json=keyAssignmentNonce,proto3" json:"key_assignment_nonce,omitempty"`
 }
 
 func (m *QueryValidatorConsumerAddrResponse) Reset()         { *m = QueryValidatorConsumerAddrResponse{} }
@@ -479,6 +582,13 @@ func (m *QueryValidatorConsumerAddrResponse) GetConsumerAddress() string {
 	return ""
 }
 
+func (m *QueryValidatorConsumerAddrResponse) GetKeyAssignmentNonce() uint64 {
+	if m != nil {
+		return m.KeyAssignmentNonce
+	}
+	return 0
+}
+
 type QueryValidatorProviderAddrRequest struct {
 	// The consensus address of the validator on the consumer chain
 	ConsumerAddress string `protobuf:"bytes,1,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty" yaml:"address"`
@@ -1043,6 +1153,8 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) GetConsumerId() string {
 type QueryConsumerChainOptedInValidatorsResponse struct {
 	// The consensus addresses of the validators on the provider chain
 	ValidatorsProviderAddresses []string `protobuf:"bytes,1,rep,name=validators_provider_addresses,json=validatorsProviderAddresses,proto3" json:"validators_provider_addresses,omitempty"`
+	// The opted-in validators together with the commission rates they charge on the consumer chain
+	Validators []*QueryConsumerChainOptedInValidatorsValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
 }
 
 func (m *QueryConsumerChainOptedInValidatorsResponse) Reset() {
@@ -1089,6 +1201,74 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) GetValidatorsProviderAddre
 	return nil
 }
 
+func (m *QueryConsumerChainOptedInValidatorsResponse) GetValidators() []*QueryConsumerChainOptedInValidatorsValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type QueryConsumerChainOptedInValidatorsValidator struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// The rate to charge delegators on the consumer chain, as a fraction
+	ConsumerCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=consumer_commission_rate,json=consumerCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"consumer_commission_rate"`
+	// The source of the consumer commission rate, i.e., whether the rate was set explicitly
+	// for the consumer chain or whether it is inherited from the validator's provider commission rate
+	ConsumerCommissionRateSource ConsumerCommissionRateSource `protobuf:"varint,3,opt,name=consumer_commission_rate_source,json=consumerCommissionRateSource,proto3,enum=interchain_security.ccv.provider.v1.ConsumerCommissionRateSource" json:"consumer_commission_rate_source,omitempty"`
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) Reset() {
+	*m = QueryConsumerChainOptedInValidatorsValidator{}
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerChainOptedInValidatorsValidator) ProtoMessage() {}
+func (*QueryConsumerChainOptedInValidatorsValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{20}
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainOptedInValidatorsValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainOptedInValidatorsValidator.Merge(m, src)
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainOptedInValidatorsValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerChainOptedInValidatorsValidator proto.InternalMessageInfo
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) GetConsumerCommissionRateSource() ConsumerCommissionRateSource {
+	if m != nil {
+		return m.ConsumerCommissionRateSource
+	}
+	return CONSUMER_COMMISSION_RATE_SOURCE_UNSPECIFIED
+}
+
 type QueryConsumerValidatorsRequest struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
@@ -1097,7 +1277,7 @@ func (m *QueryConsumerValidatorsRequest) Reset()         { *m = QueryConsumerVal
 func (m *QueryConsumerValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConsumerValidatorsRequest) ProtoMessage()    {}
 func (*QueryConsumerValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{20}
+	return fileDescriptor_422512d7b7586cd7, []int{21}
 }
 func (m *QueryConsumerValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1348,7 @@ func (m *QueryConsumerValidatorsValidator) Reset()         { *m = QueryConsumerV
 func (m *QueryConsumerValidatorsValidator) String() string { return proto.CompactTextString(m) }
 func (*QueryConsumerValidatorsValidator) ProtoMessage()    {}
 func (*QueryConsumerValidatorsValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{21}
+	return fileDescriptor_422512d7b7586cd7, []int{22}
 }
 func (m *QueryConsumerValidatorsValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1456,7 @@ func (m *QueryConsumerValidatorsResponse) Reset()         { *m = QueryConsumerVa
 func (m *QueryConsumerValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConsumerValidatorsResponse) ProtoMessage()    {}
 func (*QueryConsumerValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{22}
+	return fileDescriptor_422512d7b7586cd7, []int{23}
 }
 func (m *QueryConsumerValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,7 +1505,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) String() string {
 }
 func (*QueryConsumerChainsValidatorHasToValidateRequest) ProtoMessage() {}
 func (*QueryConsumerChainsValidatorHasToValidateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{23}
+	return fileDescriptor_422512d7b7586cd7, []int{24}
 }
 func (m *QueryConsumerChainsValidatorHasToValidateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1373,7 +1553,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) String() string {
 }
 func (*QueryConsumerChainsValidatorHasToValidateResponse) ProtoMessage() {}
 func (*QueryConsumerChainsValidatorHasToValidateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{24}
+	return fileDescriptor_422512d7b7586cd7, []int{25}
 }
 func (m *QueryConsumerChainsValidatorHasToValidateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1603,7 @@ func (m *QueryValidatorConsumerCommissionRateRequest) String() string {
 }
 func (*QueryValidatorConsumerCommissionRateRequest) ProtoMessage() {}
 func (*QueryValidatorConsumerCommissionRateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{25}
+	return fileDescriptor_422512d7b7586cd7, []int{26}
 }
 func (m *QueryValidatorConsumerCommissionRateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1479,7 +1659,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) String() string {
 }
 func (*QueryValidatorConsumerCommissionRateResponse) ProtoMessage() {}
 func (*QueryValidatorConsumerCommissionRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{26}
+	return fileDescriptor_422512d7b7586cd7, []int{27}
 }
 func (m *QueryValidatorConsumerCommissionRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1508,21 +1688,28 @@ func (m *QueryValidatorConsumerCommissionRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryValidatorConsumerCommissionRateResponse proto.InternalMessageInfo
 
-type QueryBlocksUntilNextEpochRequest struct {
+type QueryValidatorConsumerAvailabilityRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Reset()         { *m = QueryBlocksUntilNextEpochRequest{} }
-func (m *QueryBlocksUntilNextEpochRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlocksUntilNextEpochRequest) ProtoMessage()    {}
-func (*QueryBlocksUntilNextEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{27}
+func (m *QueryValidatorConsumerAvailabilityRequest) Reset() {
+	*m = QueryValidatorConsumerAvailabilityRequest{}
 }
-func (m *QueryBlocksUntilNextEpochRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidatorConsumerAvailabilityRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorConsumerAvailabilityRequest) ProtoMessage() {}
+func (*QueryValidatorConsumerAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{28}
+}
+func (m *QueryValidatorConsumerAvailabilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlocksUntilNextEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorConsumerAvailabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlocksUntilNextEpochRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorConsumerAvailabilityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1532,35 +1719,54 @@ func (m *QueryBlocksUntilNextEpochRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryBlocksUntilNextEpochRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlocksUntilNextEpochRequest.Merge(m, src)
+func (m *QueryValidatorConsumerAvailabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorConsumerAvailabilityRequest.Merge(m, src)
 }
-func (m *QueryBlocksUntilNextEpochRequest) XXX_Size() int {
+func (m *QueryValidatorConsumerAvailabilityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlocksUntilNextEpochRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlocksUntilNextEpochRequest.DiscardUnknown(m)
+func (m *QueryValidatorConsumerAvailabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorConsumerAvailabilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlocksUntilNextEpochRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorConsumerAvailabilityRequest proto.InternalMessageInfo
 
-type QueryBlocksUntilNextEpochResponse struct {
-	// The number of blocks until the next epoch starts
-	BlocksUntilNextEpoch uint64 `protobuf:"varint,1,opt,name=blocks_until_next_epoch,json=blocksUntilNextEpoch,proto3" json:"blocks_until_next_epoch,omitempty"`
+func (m *QueryValidatorConsumerAvailabilityRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) Reset()         { *m = QueryBlocksUntilNextEpochResponse{} }
-func (m *QueryBlocksUntilNextEpochResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlocksUntilNextEpochResponse) ProtoMessage()    {}
-func (*QueryBlocksUntilNextEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{28}
+func (m *QueryValidatorConsumerAvailabilityRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
-func (m *QueryBlocksUntilNextEpochResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryValidatorConsumerAvailabilityResponse struct {
+	// whether the validator is available to validate the consumer chain
+	// (see MsgSetConsumerAvailability)
+	Available bool `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (m *QueryValidatorConsumerAvailabilityResponse) Reset() {
+	*m = QueryValidatorConsumerAvailabilityResponse{}
+}
+func (m *QueryValidatorConsumerAvailabilityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryValidatorConsumerAvailabilityResponse) ProtoMessage() {}
+func (*QueryValidatorConsumerAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{29}
+}
+func (m *QueryValidatorConsumerAvailabilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlocksUntilNextEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidatorConsumerAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlocksUntilNextEpochResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidatorConsumerAvailabilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1570,43 +1776,43 @@ func (m *QueryBlocksUntilNextEpochResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryBlocksUntilNextEpochResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlocksUntilNextEpochResponse.Merge(m, src)
+func (m *QueryValidatorConsumerAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorConsumerAvailabilityResponse.Merge(m, src)
 }
-func (m *QueryBlocksUntilNextEpochResponse) XXX_Size() int {
+func (m *QueryValidatorConsumerAvailabilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlocksUntilNextEpochResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlocksUntilNextEpochResponse.DiscardUnknown(m)
+func (m *QueryValidatorConsumerAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorConsumerAvailabilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlocksUntilNextEpochResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidatorConsumerAvailabilityResponse proto.InternalMessageInfo
 
-func (m *QueryBlocksUntilNextEpochResponse) GetBlocksUntilNextEpoch() uint64 {
+func (m *QueryValidatorConsumerAvailabilityResponse) GetAvailable() bool {
 	if m != nil {
-		return m.BlocksUntilNextEpoch
+		return m.Available
 	}
-	return 0
+	return false
 }
 
-type QueryConsumerIdFromClientIdRequest struct {
-	// the client id (on the provider) that is tracking the consumer chain
-	// the client id can be found from the consumer chain by querying (i.e., `query ccvconsumer provider-info`)
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+type QueryEmergencyOptOutCooldownRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Reset()         { *m = QueryConsumerIdFromClientIdRequest{} }
-func (m *QueryConsumerIdFromClientIdRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryConsumerIdFromClientIdRequest) ProtoMessage()    {}
-func (*QueryConsumerIdFromClientIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{29}
+func (m *QueryEmergencyOptOutCooldownRequest) Reset()         { *m = QueryEmergencyOptOutCooldownRequest{} }
+func (m *QueryEmergencyOptOutCooldownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyOptOutCooldownRequest) ProtoMessage()    {}
+func (*QueryEmergencyOptOutCooldownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{30}
 }
-func (m *QueryConsumerIdFromClientIdRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEmergencyOptOutCooldownRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConsumerIdFromClientIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmergencyOptOutCooldownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConsumerIdFromClientIdRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmergencyOptOutCooldownRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1616,42 +1822,52 @@ func (m *QueryConsumerIdFromClientIdRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryConsumerIdFromClientIdRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConsumerIdFromClientIdRequest.Merge(m, src)
+func (m *QueryEmergencyOptOutCooldownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyOptOutCooldownRequest.Merge(m, src)
 }
-func (m *QueryConsumerIdFromClientIdRequest) XXX_Size() int {
+func (m *QueryEmergencyOptOutCooldownRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConsumerIdFromClientIdRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConsumerIdFromClientIdRequest.DiscardUnknown(m)
+func (m *QueryEmergencyOptOutCooldownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyOptOutCooldownRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConsumerIdFromClientIdRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmergencyOptOutCooldownRequest proto.InternalMessageInfo
 
-func (m *QueryConsumerIdFromClientIdRequest) GetClientId() string {
+func (m *QueryEmergencyOptOutCooldownRequest) GetConsumerId() string {
 	if m != nil {
-		return m.ClientId
+		return m.ConsumerId
 	}
 	return ""
 }
 
-type QueryConsumerIdFromClientIdResponse struct {
-	// the consumer id of the chain associated with this client id
-	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+func (m *QueryEmergencyOptOutCooldownRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Reset()         { *m = QueryConsumerIdFromClientIdResponse{} }
-func (m *QueryConsumerIdFromClientIdResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryConsumerIdFromClientIdResponse) ProtoMessage()    {}
-func (*QueryConsumerIdFromClientIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{30}
+type QueryEmergencyOptOutCooldownResponse struct {
+	// whether the validator cannot opt in to the consumer chain, since it
+	// emergency opted out of it less than the cooldown ago (see MsgEmergencyOptOut)
+	InCooldown bool `protobuf:"varint,1,opt,name=in_cooldown,json=inCooldown,proto3" json:"in_cooldown,omitempty"`
+	// the time at which the cooldown ends, if the validator is in cooldown
+	CooldownEndTime time.Time `protobuf:"bytes,2,opt,name=cooldown_end_time,json=cooldownEndTime,proto3,stdtime" json:"cooldown_end_time"`
 }
-func (m *QueryConsumerIdFromClientIdResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryEmergencyOptOutCooldownResponse) Reset()         { *m = QueryEmergencyOptOutCooldownResponse{} }
+func (m *QueryEmergencyOptOutCooldownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmergencyOptOutCooldownResponse) ProtoMessage()    {}
+func (*QueryEmergencyOptOutCooldownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{31}
+}
+func (m *QueryEmergencyOptOutCooldownResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConsumerIdFromClientIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEmergencyOptOutCooldownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConsumerIdFromClientIdResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEmergencyOptOutCooldownResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1661,41 +1877,50 @@ func (m *QueryConsumerIdFromClientIdResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryConsumerIdFromClientIdResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConsumerIdFromClientIdResponse.Merge(m, src)
+func (m *QueryEmergencyOptOutCooldownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmergencyOptOutCooldownResponse.Merge(m, src)
 }
-func (m *QueryConsumerIdFromClientIdResponse) XXX_Size() int {
+func (m *QueryEmergencyOptOutCooldownResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConsumerIdFromClientIdResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConsumerIdFromClientIdResponse.DiscardUnknown(m)
+func (m *QueryEmergencyOptOutCooldownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmergencyOptOutCooldownResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConsumerIdFromClientIdResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEmergencyOptOutCooldownResponse proto.InternalMessageInfo
 
-func (m *QueryConsumerIdFromClientIdResponse) GetConsumerId() string {
+func (m *QueryEmergencyOptOutCooldownResponse) GetInCooldown() bool {
 	if m != nil {
-		return m.ConsumerId
+		return m.InCooldown
 	}
-	return ""
+	return false
 }
 
-type QueryConsumerChainRequest struct {
+func (m *QueryEmergencyOptOutCooldownResponse) GetCooldownEndTime() time.Time {
+	if m != nil {
+		return m.CooldownEndTime
+	}
+	return time.Time{}
+}
+
+type QueryReOptInCooldownRequest struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
 }
 
-func (m *QueryConsumerChainRequest) Reset()         { *m = QueryConsumerChainRequest{} }
-func (m *QueryConsumerChainRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryConsumerChainRequest) ProtoMessage()    {}
-func (*QueryConsumerChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{31}
+func (m *QueryReOptInCooldownRequest) Reset()         { *m = QueryReOptInCooldownRequest{} }
+func (m *QueryReOptInCooldownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReOptInCooldownRequest) ProtoMessage()    {}
+func (*QueryReOptInCooldownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{32}
 }
-func (m *QueryConsumerChainRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReOptInCooldownRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConsumerChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReOptInCooldownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConsumerChainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReOptInCooldownRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1705,47 +1930,52 @@ func (m *QueryConsumerChainRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryConsumerChainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConsumerChainRequest.Merge(m, src)
+func (m *QueryReOptInCooldownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReOptInCooldownRequest.Merge(m, src)
 }
-func (m *QueryConsumerChainRequest) XXX_Size() int {
+func (m *QueryReOptInCooldownRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConsumerChainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConsumerChainRequest.DiscardUnknown(m)
+func (m *QueryReOptInCooldownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReOptInCooldownRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConsumerChainRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryReOptInCooldownRequest proto.InternalMessageInfo
 
-func (m *QueryConsumerChainRequest) GetConsumerId() string {
+func (m *QueryReOptInCooldownRequest) GetConsumerId() string {
 	if m != nil {
 		return m.ConsumerId
 	}
 	return ""
 }
 
-type QueryConsumerChainResponse struct {
-	ConsumerId         string                            `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
-	ChainId            string                            `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	OwnerAddress       string                            `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
-	Phase              string                            `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
-	Metadata           ConsumerMetadata                  `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
-	InitParams         *ConsumerInitializationParameters `protobuf:"bytes,6,opt,name=init_params,json=initParams,proto3" json:"init_params,omitempty"`
-	PowerShapingParams *PowerShapingParameters           `protobuf:"bytes,7,opt,name=power_shaping_params,json=powerShapingParams,proto3" json:"power_shaping_params,omitempty"`
+func (m *QueryReOptInCooldownRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *QueryConsumerChainResponse) Reset()         { *m = QueryConsumerChainResponse{} }
-func (m *QueryConsumerChainResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryConsumerChainResponse) ProtoMessage()    {}
-func (*QueryConsumerChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_422512d7b7586cd7, []int{32}
+type QueryReOptInCooldownResponse struct {
+	// whether the validator cannot validate the consumer chain, since it was jailed for an infraction
+	// committed on it less than the `re_opt_in_cooldown_after_slash` of the chain ago
+	InCooldown bool `protobuf:"varint,1,opt,name=in_cooldown,json=inCooldown,proto3" json:"in_cooldown,omitempty"`
+	// the time at which the cooldown ends, if the validator is in cooldown
+	CooldownEndTime time.Time `protobuf:"bytes,2,opt,name=cooldown_end_time,json=cooldownEndTime,proto3,stdtime" json:"cooldown_end_time"`
 }
-func (m *QueryConsumerChainResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryReOptInCooldownResponse) Reset()         { *m = QueryReOptInCooldownResponse{} }
+func (m *QueryReOptInCooldownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReOptInCooldownResponse) ProtoMessage()    {}
+func (*QueryReOptInCooldownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{33}
+}
+func (m *QueryReOptInCooldownResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConsumerChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReOptInCooldownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConsumerChainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReOptInCooldownResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1755,2844 +1985,23820 @@ func (m *QueryConsumerChainResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryConsumerChainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConsumerChainResponse.Merge(m, src)
+func (m *QueryReOptInCooldownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReOptInCooldownResponse.Merge(m, src)
 }
-func (m *QueryConsumerChainResponse) XXX_Size() int {
+func (m *QueryReOptInCooldownResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConsumerChainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConsumerChainResponse.DiscardUnknown(m)
+func (m *QueryReOptInCooldownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReOptInCooldownResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConsumerChainResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReOptInCooldownResponse proto.InternalMessageInfo
 
-func (m *QueryConsumerChainResponse) GetConsumerId() string {
+func (m *QueryReOptInCooldownResponse) GetInCooldown() bool {
 	if m != nil {
-		return m.ConsumerId
+		return m.InCooldown
 	}
-	return ""
+	return false
 }
 
-func (m *QueryConsumerChainResponse) GetChainId() string {
+func (m *QueryReOptInCooldownResponse) GetCooldownEndTime() time.Time {
 	if m != nil {
-		return m.ChainId
+		return m.CooldownEndTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *QueryConsumerChainResponse) GetOwnerAddress() string {
+type QueryBlocksUntilNextEpochRequest struct {
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Reset()         { *m = QueryBlocksUntilNextEpochRequest{} }
+func (m *QueryBlocksUntilNextEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksUntilNextEpochRequest) ProtoMessage()    {}
+func (*QueryBlocksUntilNextEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{34}
+}
+func (m *QueryBlocksUntilNextEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksUntilNextEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksUntilNextEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlocksUntilNextEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksUntilNextEpochRequest.Merge(m, src)
+}
+func (m *QueryBlocksUntilNextEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksUntilNextEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksUntilNextEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlocksUntilNextEpochRequest proto.InternalMessageInfo
+
+type QueryBlocksUntilNextEpochResponse struct {
+	// The number of blocks until the next epoch starts
+	BlocksUntilNextEpoch uint64 `protobuf:"varint,1,opt,name=blocks_until_next_epoch,json=blocksUntilNextEpoch,proto3" json:"blocks_until_next_epoch,omitempty"`
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Reset()         { *m = QueryBlocksUntilNextEpochResponse{} }
+func (m *QueryBlocksUntilNextEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksUntilNextEpochResponse) ProtoMessage()    {}
+func (*QueryBlocksUntilNextEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{35}
+}
+func (m *QueryBlocksUntilNextEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksUntilNextEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksUntilNextEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlocksUntilNextEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksUntilNextEpochResponse.Merge(m, src)
+}
+func (m *QueryBlocksUntilNextEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksUntilNextEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksUntilNextEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlocksUntilNextEpochResponse proto.InternalMessageInfo
+
+func (m *QueryBlocksUntilNextEpochResponse) GetBlocksUntilNextEpoch() uint64 {
 	if m != nil {
-		return m.OwnerAddress
+		return m.BlocksUntilNextEpoch
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) GetPhase() string {
+type QueryNextEpochRequest struct {
+}
+
+func (m *QueryNextEpochRequest) Reset()         { *m = QueryNextEpochRequest{} }
+func (m *QueryNextEpochRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochRequest) ProtoMessage()    {}
+func (*QueryNextEpochRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{36}
+}
+func (m *QueryNextEpochRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochRequest.Merge(m, src)
+}
+func (m *QueryNextEpochRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochRequest proto.InternalMessageInfo
+
+type QueryNextEpochResponse struct {
+	// the index of the current epoch
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// the height of the first block of the current epoch
+	CurrentEpochStartHeight int64 `protobuf:"varint,2,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// the height of the last block of the current epoch
+	CurrentEpochEndHeight int64 `protobuf:"varint,3,opt,name=current_epoch_end_height,json=currentEpochEndHeight,proto3" json:"current_epoch_end_height,omitempty"`
+	// the height of the first block of the next epoch, i.e., the block in which
+	// the next VSC packets are queued for the consumer chains
+	NextEpochStartHeight int64 `protobuf:"varint,4,opt,name=next_epoch_start_height,json=nextEpochStartHeight,proto3" json:"next_epoch_start_height,omitempty"`
+	// the number of blocks until the next epoch starts
+	BlocksUntilNextEpoch uint64 `protobuf:"varint,5,opt,name=blocks_until_next_epoch,json=blocksUntilNextEpoch,proto3" json:"blocks_until_next_epoch,omitempty"`
+	// the estimated time at which the next epoch starts based on the average
+	// block time of the current (or, if empty, the previous) epoch;
+	// not set if there is no block time average yet
+	EstimatedNextEpochStartTime *time.Time `protobuf:"bytes,6,opt,name=estimated_next_epoch_start_time,json=estimatedNextEpochStartTime,proto3,stdtime" json:"estimated_next_epoch_start_time,omitempty"`
+}
+
+func (m *QueryNextEpochResponse) Reset()         { *m = QueryNextEpochResponse{} }
+func (m *QueryNextEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextEpochResponse) ProtoMessage()    {}
+func (*QueryNextEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{37}
+}
+func (m *QueryNextEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextEpochResponse.Merge(m, src)
+}
+func (m *QueryNextEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextEpochResponse proto.InternalMessageInfo
+
+func (m *QueryNextEpochResponse) GetCurrentEpoch() uint64 {
 	if m != nil {
-		return m.Phase
+		return m.CurrentEpoch
 	}
-	return ""
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) GetMetadata() ConsumerMetadata {
+func (m *QueryNextEpochResponse) GetCurrentEpochStartHeight() int64 {
 	if m != nil {
-		return m.Metadata
+		return m.CurrentEpochStartHeight
 	}
-	return ConsumerMetadata{}
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) GetInitParams() *ConsumerInitializationParameters {
+func (m *QueryNextEpochResponse) GetCurrentEpochEndHeight() int64 {
 	if m != nil {
-		return m.InitParams
+		return m.CurrentEpochEndHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) GetPowerShapingParams() *PowerShapingParameters {
+func (m *QueryNextEpochResponse) GetNextEpochStartHeight() int64 {
 	if m != nil {
-		return m.PowerShapingParams
+		return m.NextEpochStartHeight
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
-	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
-	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
-	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
-	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
-	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
-	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
-	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
-	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
-	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
-	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
-	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
-	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
-	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
-	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
-	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
-	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
-	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
-	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
+func (m *QueryNextEpochResponse) GetBlocksUntilNextEpoch() uint64 {
+	if m != nil {
+		return m.BlocksUntilNextEpoch
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+func (m *QueryNextEpochResponse) GetEstimatedNextEpochStartTime() *time.Time {
+	if m != nil {
+		return m.EstimatedNextEpochStartTime
+	}
+	return nil
 }
 
-var fileDescriptor_422512d7b7586cd7 = []byte{
-	// 2450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-	0x15, 0x17, 0x57, 0x1f, 0x5e, 0x8d, 0x2c, 0x39, 0x1e, 0xcb, 0xd6, 0x7a, 0x65, 0x6b, 0x65, 0x3a,
-	0x6e, 0x15, 0x39, 0x5e, 0x4a, 0x2a, 0xf2, 0xe5, 0xc4, 0x1f, 0x5a, 0x59, 0xb2, 0x05, 0xc7, 0xb6,
-	0x42, 0x29, 0x0e, 0xe0, 0xd4, 0x65, 0x47, 0xe4, 0x64, 0x35, 0x15, 0x97, 0xa4, 0x39, 0xa3, 0xb5,
-	0xb7, 0x86, 0x2f, 0x3d, 0xe5, 0xd0, 0x02, 0x09, 0x8a, 0x9e, 0x9b, 0x73, 0x0f, 0x45, 0x51, 0x04,
-	0xfd, 0x07, 0x7a, 0xc9, 0xad, 0x69, 0x7a, 0x29, 0x5a, 0xd4, 0x6d, 0xed, 0x16, 0xe8, 0xa5, 0x28,
-	0x9a, 0xf6, 0x0f, 0x08, 0x66, 0x38, 0xe4, 0x2e, 0x69, 0x4a, 0x4b, 0x6a, 0x75, 0x13, 0x67, 0xde,
-	0xfb, 0xbd, 0x8f, 0x79, 0xf3, 0xe6, 0xbd, 0xb7, 0x02, 0x1a, 0x71, 0x18, 0xf6, 0xcd, 0x2d, 0x44,
-	0x1c, 0x83, 0x62, 0x73, 0xc7, 0x27, 0xac, 0xa5, 0x99, 0x66, 0x53, 0xf3, 0x7c, 0xb7, 0x49, 0x2c,
-	0xec, 0x6b, 0xcd, 0x79, 0xed, 0xc1, 0x0e, 0xf6, 0x5b, 0x55, 0xcf, 0x77, 0x99, 0x0b, 0xcf, 0xa6,
-	0x30, 0x54, 0x4d, 0xb3, 0x59, 0x0d, 0x19, 0xaa, 0xcd, 0xf9, 0xf2, 0xa9, 0xba, 0xeb, 0xd6, 0x6d,
-	0xac, 0x21, 0x8f, 0x68, 0xc8, 0x71, 0x5c, 0x86, 0x18, 0x71, 0x1d, 0x1a, 0x40, 0x94, 0xc7, 0xeb,
-	0x6e, 0xdd, 0x15, 0x7f, 0x6a, 0xfc, 0x2f, 0xb9, 0x5a, 0x91, 0x3c, 0xe2, 0x6b, 0x73, 0xe7, 0x23,
-	0x8d, 0x91, 0x06, 0xa6, 0x0c, 0x35, 0x3c, 0x49, 0xb0, 0x90, 0x45, 0xd5, 0x48, 0x8b, 0x80, 0x67,
-	0x6e, 0x37, 0x9e, 0xe6, 0xbc, 0x46, 0xb7, 0x90, 0x8f, 0x2d, 0xc3, 0x74, 0x1d, 0xba, 0xd3, 0x88,
-	0x38, 0xce, 0xed, 0xc1, 0xf1, 0x90, 0xf8, 0x58, 0x92, 0x9d, 0x62, 0xd8, 0xb1, 0xb0, 0xdf, 0x20,
-	0x0e, 0xd3, 0x4c, 0xbf, 0xe5, 0x31, 0x57, 0xdb, 0xc6, 0xad, 0xd0, 0xc2, 0x93, 0xa6, 0x4b, 0x1b,
-	0x2e, 0x35, 0x02, 0x23, 0x83, 0x0f, 0xb9, 0xf5, 0x72, 0xf0, 0xa5, 0x51, 0x86, 0xb6, 0x89, 0x53,
-	0xd7, 0x9a, 0xf3, 0x9b, 0x98, 0xa1, 0xf9, 0xf0, 0x5b, 0x52, 0xcd, 0x4a, 0xaa, 0x4d, 0x44, 0x71,
-	0xe0, 0xfe, 0x88, 0xd0, 0x43, 0x75, 0xe2, 0x08, 0x7f, 0x06, 0xb4, 0xea, 0x65, 0x30, 0xf9, 0x1e,
-	0xa7, 0x58, 0x92, 0x86, 0x5c, 0xc7, 0x0e, 0xa6, 0x84, 0xea, 0xf8, 0xc1, 0x0e, 0xa6, 0x0c, 0x56,
-	0xc0, 0x48, 0x68, 0xa2, 0x41, 0xac, 0x92, 0x32, 0xad, 0xcc, 0x0c, 0xeb, 0x20, 0x5c, 0x5a, 0xb5,
-	0xd4, 0xc7, 0xe0, 0x54, 0x3a, 0x3f, 0xf5, 0x5c, 0x87, 0x62, 0xf8, 0x21, 0x18, 0xad, 0x07, 0x4b,
-	0x06, 0x65, 0x88, 0x61, 0x01, 0x31, 0xb2, 0x30, 0x57, 0xdd, 0x2d, 0x12, 0x9a, 0xf3, 0xd5, 0x04,
-	0xd6, 0x3a, 0xe7, 0xab, 0x0d, 0x7c, 0xf1, 0xb4, 0xd2, 0xa7, 0x1f, 0xae, 0x77, 0xac, 0xa9, 0xbf,
-	0x54, 0x40, 0x39, 0x26, 0x7d, 0x89, 0xe3, 0x45, 0xca, 0xdf, 0x00, 0x83, 0xde, 0x16, 0xa2, 0x81,
-	0xcc, 0xb1, 0x85, 0x85, 0x6a, 0x86, 0xe8, 0x8b, 0x84, 0xaf, 0x71, 0x4e, 0x3d, 0x00, 0x80, 0x2b,
-	0x00, 0xb4, 0x3d, 0x57, 0x2a, 0x08, 0x13, 0xbe, 0x55, 0x95, 0x47, 0xc3, 0xdd, 0x5c, 0x0d, 0xa2,
-	0x5c, 0xba, 0xb9, 0xba, 0x86, 0xea, 0x58, 0x6a, 0xa1, 0x77, 0x70, 0xaa, 0xbf, 0x50, 0x12, 0xee,
-	0x0e, 0x15, 0x96, 0xde, 0xaa, 0x81, 0x21, 0xa1, 0x1e, 0x2d, 0x29, 0xd3, 0xfd, 0x33, 0x23, 0x0b,
-	0xb3, 0xd9, 0x54, 0xe6, 0xdb, 0xba, 0xe4, 0x84, 0xd7, 0x53, 0x74, 0xfd, 0x76, 0x57, 0x5d, 0x03,
-	0x05, 0x62, 0xca, 0xfe, 0x67, 0x00, 0x0c, 0x0a, 0x68, 0x78, 0x12, 0x14, 0x03, 0x15, 0xa2, 0x10,
-	0x38, 0x24, 0xbe, 0x57, 0x2d, 0x38, 0x09, 0x86, 0x4d, 0x9b, 0x60, 0x87, 0xf1, 0xbd, 0x82, 0xd8,
-	0x2b, 0x06, 0x0b, 0xab, 0x16, 0x3c, 0x06, 0x06, 0x99, 0xeb, 0x19, 0xb7, 0x4b, 0xfd, 0xd3, 0xca,
-	0xcc, 0xa8, 0x3e, 0xc0, 0x5c, 0xef, 0x36, 0x9c, 0x05, 0xb0, 0x41, 0x1c, 0xc3, 0x73, 0x1f, 0xf2,
-	0x98, 0x72, 0x8c, 0x80, 0x62, 0x60, 0x5a, 0x99, 0xe9, 0xd7, 0xc7, 0x1a, 0xc4, 0x59, 0xe3, 0x1b,
-	0xab, 0xce, 0x06, 0xa7, 0x9d, 0x03, 0xe3, 0x4d, 0x64, 0x13, 0x0b, 0x31, 0xd7, 0xa7, 0x92, 0xc5,
-	0x44, 0x5e, 0x69, 0x50, 0xe0, 0xc1, 0xf6, 0x9e, 0x60, 0x5a, 0x42, 0x1e, 0x9c, 0x05, 0x47, 0xa3,
-	0x55, 0x83, 0x62, 0x26, 0xc8, 0x87, 0x04, 0xf9, 0x91, 0x68, 0x63, 0x1d, 0x33, 0x4e, 0x7b, 0x0a,
-	0x0c, 0x23, 0xdb, 0x76, 0x1f, 0xda, 0x84, 0xb2, 0xd2, 0xa1, 0xe9, 0xfe, 0x99, 0x61, 0xbd, 0xbd,
-	0x00, 0xcb, 0xa0, 0x68, 0x61, 0xa7, 0x25, 0x36, 0x8b, 0x62, 0x33, 0xfa, 0x86, 0xe3, 0x61, 0x64,
-	0x0d, 0x0b, 0x8b, 0x65, 0x94, 0x7c, 0x00, 0x8a, 0x0d, 0xcc, 0x90, 0x85, 0x18, 0x2a, 0x01, 0xe1,
-	0xf7, 0xd7, 0x72, 0x85, 0xdc, 0x2d, 0xc9, 0x2c, 0x63, 0x3d, 0x02, 0xe3, 0x4e, 0xe6, 0x2e, 0xe3,
-	0xb7, 0x1c, 0x97, 0x46, 0xa6, 0x95, 0x99, 0x01, 0xbd, 0xd8, 0x20, 0xce, 0x3a, 0xff, 0x86, 0x55,
-	0x70, 0x4c, 0x28, 0x6d, 0x10, 0x07, 0x99, 0x8c, 0x34, 0xb1, 0xd1, 0x44, 0x36, 0x2d, 0x1d, 0x9e,
-	0x56, 0x66, 0x8a, 0xfa, 0x51, 0xb1, 0xb5, 0x2a, 0x77, 0xee, 0x22, 0x9b, 0x26, 0xaf, 0xf4, 0x68,
-	0xf2, 0x4a, 0xc3, 0x47, 0xe0, 0x64, 0xe4, 0x05, 0x6c, 0x19, 0x3e, 0x7e, 0x88, 0x7c, 0xcb, 0xb0,
-	0xb0, 0xe3, 0x36, 0x68, 0x69, 0x4c, 0xd8, 0xf5, 0x4e, 0x26, 0xbb, 0x16, 0xdb, 0x28, 0xba, 0x00,
-	0xb9, 0x26, 0x30, 0xf4, 0x09, 0x94, 0xbe, 0xa1, 0xfe, 0x44, 0x01, 0x67, 0xc4, 0xf5, 0xb8, 0x1b,
-	0x9e, 0x54, 0xe8, 0x9a, 0x45, 0xcb, 0xf2, 0xc3, 0x6b, 0x7d, 0x09, 0xbc, 0x14, 0x4a, 0x31, 0x90,
-	0x65, 0xf9, 0x98, 0xd2, 0x20, 0x2a, 0x6b, 0xf0, 0xeb, 0xa7, 0x95, 0xb1, 0x16, 0x6a, 0xd8, 0x17,
-	0x55, 0xb9, 0xa1, 0xea, 0x47, 0x42, 0xda, 0xc5, 0x60, 0x25, 0x69, 0x7f, 0x21, 0x69, 0xff, 0xc5,
-	0xe2, 0xc7, 0x9f, 0x55, 0xfa, 0xfe, 0xf5, 0x59, 0xa5, 0x4f, 0xbd, 0x03, 0xd4, 0xbd, 0xd4, 0x91,
-	0x97, 0xf6, 0x15, 0xf0, 0x52, 0x04, 0x18, 0xd3, 0x47, 0x3f, 0x62, 0x76, 0xd0, 0x73, 0x6d, 0x5e,
-	0x34, 0x70, 0xad, 0x43, 0xbb, 0x0e, 0x03, 0xd3, 0x01, 0xd3, 0x0d, 0x4c, 0x08, 0xe9, 0xc9, 0xc0,
-	0xb8, 0x3a, 0x6d, 0x03, 0xd3, 0x1d, 0xfe, 0x82, 0x73, 0xd5, 0x49, 0x70, 0x52, 0x00, 0x6e, 0x6c,
-	0xf9, 0x2e, 0x63, 0x36, 0x16, 0x79, 0x5a, 0xda, 0xa5, 0xfe, 0x3e, 0x4c, 0xd7, 0x89, 0x5d, 0x29,
-	0xa6, 0x02, 0x46, 0xa8, 0x8d, 0xe8, 0x96, 0xd1, 0xc0, 0x0c, 0xfb, 0x42, 0x42, 0xbf, 0x0e, 0xc4,
-	0xd2, 0x2d, 0xbe, 0x02, 0x17, 0xc0, 0xf1, 0x0e, 0x02, 0x43, 0x44, 0x11, 0x72, 0x4c, 0x2c, 0x4c,
-	0xec, 0xd7, 0x8f, 0xb5, 0x49, 0x17, 0xc3, 0x2d, 0xf8, 0x3d, 0x50, 0x72, 0xf0, 0x23, 0x66, 0xf8,
-	0xd8, 0xb3, 0xb1, 0x43, 0xe8, 0x96, 0x61, 0x22, 0xc7, 0xe2, 0xc6, 0x62, 0x91, 0x95, 0x46, 0x16,
-	0xca, 0xd5, 0xa0, 0x76, 0xa8, 0x86, 0xb5, 0x43, 0x75, 0x23, 0xac, 0x1d, 0x6a, 0x45, 0x7e, 0x11,
-	0x3f, 0xf9, 0x6b, 0x45, 0xd1, 0x4f, 0x70, 0x14, 0x3d, 0x04, 0x59, 0x0a, 0x31, 0xd4, 0x57, 0xc1,
-	0xac, 0x30, 0x49, 0xc7, 0x75, 0x1e, 0xcf, 0x3e, 0xb6, 0xc2, 0x18, 0x89, 0x85, 0xbc, 0xf4, 0xc0,
-	0x32, 0x38, 0x9f, 0x89, 0x5a, 0x7a, 0xe4, 0x04, 0x18, 0x92, 0xd7, 0x4e, 0x11, 0x09, 0x48, 0x7e,
-	0xa9, 0xef, 0x82, 0x57, 0x04, 0xcc, 0xa2, 0x6d, 0xaf, 0x21, 0xe2, 0xd3, 0xbb, 0xc8, 0xe6, 0x38,
-	0xfc, 0x10, 0x6a, 0xad, 0x36, 0x62, 0xc6, 0x27, 0xfc, 0xe7, 0x8a, 0xb4, 0xa1, 0x0b, 0x9c, 0x54,
-	0xea, 0x01, 0x38, 0xea, 0x21, 0xe2, 0xf3, 0x2c, 0xc3, 0xcb, 0x1f, 0x11, 0x11, 0xf2, 0xb9, 0x5a,
-	0xc9, 0x94, 0x16, 0xb8, 0x8c, 0x40, 0x04, 0x97, 0x10, 0x45, 0x9c, 0xd3, 0xf6, 0xc5, 0x98, 0x17,
-	0x23, 0x51, 0xff, 0xaf, 0x80, 0x33, 0x5d, 0xb9, 0xe0, 0xca, 0xae, 0x79, 0x61, 0xf2, 0xeb, 0xa7,
-	0x95, 0x89, 0xe0, 0xda, 0x24, 0x29, 0x52, 0x12, 0xc4, 0x4a, 0xca, 0xf5, 0x2b, 0x24, 0x71, 0x92,
-	0x14, 0x29, 0xf7, 0xf0, 0x0a, 0x38, 0x1c, 0x51, 0x6d, 0xe3, 0x96, 0x0c, 0xb7, 0x53, 0xd5, 0x76,
-	0xf1, 0x57, 0x0d, 0x8a, 0xbf, 0xea, 0xda, 0xce, 0xa6, 0x4d, 0xcc, 0x9b, 0xb8, 0xa5, 0x47, 0x47,
-	0x75, 0x13, 0xb7, 0xd4, 0x71, 0x00, 0xc5, 0xb9, 0xac, 0x21, 0x1f, 0xb5, 0x63, 0xe8, 0xfb, 0xe0,
-	0x58, 0x6c, 0x55, 0x1e, 0xcb, 0x2a, 0x18, 0xf2, 0xc4, 0x8a, 0xac, 0xb0, 0xce, 0x67, 0x3c, 0x0b,
-	0xce, 0x22, 0x1f, 0x1c, 0x09, 0xa0, 0xde, 0x92, 0xf1, 0x10, 0x2b, 0x52, 0xee, 0x78, 0x0c, 0x5b,
-	0xab, 0x4e, 0x94, 0x29, 0xb2, 0x97, 0x88, 0x0f, 0x64, 0xd0, 0x77, 0x83, 0x8b, 0x6a, 0xa0, 0xd3,
-	0x9d, 0x6f, 0x7e, 0xe2, 0xbc, 0x70, 0x78, 0x17, 0x26, 0x3b, 0x1e, 0xff, 0xf8, 0x01, 0x62, 0xaa,
-	0x2e, 0x82, 0xa9, 0x98, 0xc8, 0x7d, 0x68, 0xfd, 0xe9, 0x21, 0x30, 0xbd, 0x0b, 0x46, 0xf4, 0x57,
-	0xaf, 0x4f, 0x51, 0x32, 0x42, 0x0a, 0x39, 0x23, 0x04, 0x96, 0xc0, 0xa0, 0x28, 0x8a, 0x44, 0x6c,
-	0xf5, 0xd7, 0x0a, 0x25, 0x45, 0x0f, 0x16, 0xe0, 0x5b, 0x60, 0xc0, 0xe7, 0x39, 0x6e, 0x40, 0x68,
-	0x73, 0x8e, 0x9f, 0xef, 0x9f, 0x9e, 0x56, 0x26, 0x83, 0x32, 0x90, 0x5a, 0xdb, 0x55, 0xe2, 0x6a,
-	0x0d, 0xc4, 0xb6, 0xaa, 0xef, 0xe2, 0x3a, 0x32, 0x5b, 0xd7, 0xb0, 0x59, 0x52, 0x74, 0xc1, 0x02,
-	0xcf, 0x81, 0xb1, 0x48, 0xab, 0x00, 0x7d, 0x50, 0xe4, 0xd7, 0xd1, 0x70, 0x55, 0x14, 0x5b, 0xf0,
-	0x3e, 0x28, 0x45, 0x64, 0xa6, 0xdb, 0x68, 0x10, 0x4a, 0x89, 0xeb, 0x18, 0x42, 0xea, 0x90, 0x90,
-	0x7a, 0x36, 0x83, 0x54, 0xfd, 0x44, 0x08, 0xb2, 0x14, 0x61, 0xe8, 0x5c, 0x8b, 0xfb, 0xa0, 0x14,
-	0xb9, 0x36, 0x09, 0x7f, 0x28, 0x07, 0x7c, 0x08, 0x92, 0x80, 0xbf, 0x09, 0x46, 0x2c, 0x4c, 0x4d,
-	0x9f, 0x78, 0xa2, 0x4c, 0x2e, 0x0a, 0xcf, 0x9f, 0x0d, 0xcb, 0xe4, 0xb0, 0x9f, 0x0a, 0x6b, 0xe4,
-	0x6b, 0x6d, 0x52, 0x79, 0x57, 0x3a, 0xb9, 0xe1, 0x7d, 0x70, 0x32, 0xd2, 0xd5, 0xf5, 0xb0, 0x2f,
-	0x8a, 0xcf, 0x30, 0x1e, 0x44, 0x89, 0x58, 0x3b, 0xf3, 0xd5, 0xe7, 0x17, 0x4e, 0x4b, 0xf4, 0x28,
-	0x7e, 0x64, 0x1c, 0xac, 0x33, 0x9f, 0x38, 0x75, 0x7d, 0x22, 0xc4, 0xb8, 0x23, 0x21, 0xc2, 0x30,
-	0x39, 0x01, 0x86, 0x7e, 0x80, 0x88, 0x8d, 0x2d, 0x51, 0x55, 0x16, 0x75, 0xf9, 0x05, 0x2f, 0x82,
-	0x21, 0xde, 0x53, 0xed, 0x50, 0x51, 0x13, 0x8e, 0x2d, 0xa8, 0xbb, 0xa9, 0x5f, 0x73, 0x1d, 0x6b,
-	0x5d, 0x50, 0xea, 0x92, 0x03, 0x6e, 0x80, 0x28, 0x1a, 0x0d, 0xe6, 0x6e, 0x63, 0x27, 0xa8, 0x18,
-	0x87, 0x6b, 0xe7, 0xa5, 0x57, 0x8f, 0xbf, 0xe8, 0xd5, 0x55, 0x87, 0x7d, 0xf5, 0xf9, 0x05, 0x20,
-	0x85, 0xac, 0x3a, 0x4c, 0x1f, 0x0b, 0x31, 0x36, 0x04, 0x04, 0x0f, 0x9d, 0x08, 0x35, 0x08, 0x9d,
-	0xd1, 0x20, 0x74, 0xc2, 0xd5, 0x20, 0x74, 0x5e, 0x07, 0x13, 0xf2, 0xf6, 0x62, 0x6a, 0x98, 0x3b,
-	0xbe, 0xcf, 0xfb, 0x07, 0xec, 0xb9, 0xe6, 0x96, 0xa8, 0x2f, 0x8b, 0xfa, 0xf1, 0x68, 0x7b, 0x29,
-	0xd8, 0x5d, 0xe6, 0x9b, 0xea, 0xc7, 0x0a, 0xa8, 0xec, 0x7a, 0xaf, 0x65, 0xfa, 0xc0, 0x00, 0xb4,
-	0x33, 0x83, 0x7c, 0x97, 0x96, 0x33, 0xe5, 0xc2, 0x6e, 0xb7, 0x5d, 0xef, 0x00, 0x56, 0x1f, 0x80,
-	0xb9, 0x94, 0x46, 0x2e, 0xa2, 0xbd, 0x81, 0xe8, 0x86, 0x2b, 0xbf, 0xf0, 0xc1, 0x14, 0xae, 0xea,
-	0x5d, 0x30, 0x9f, 0x43, 0xa4, 0x74, 0xc7, 0x99, 0x8e, 0x14, 0x43, 0xac, 0x30, 0x79, 0x8e, 0xb4,
-	0x13, 0x9d, 0x28, 0x4a, 0xcf, 0xa7, 0x97, 0xb9, 0xf1, 0x3b, 0x93, 0x35, 0x75, 0xa6, 0xda, 0x59,
-	0xc8, 0x6e, 0x67, 0x1d, 0xbc, 0x9a, 0x4d, 0x1d, 0x69, 0xe2, 0x1b, 0x32, 0xd5, 0x29, 0xd9, 0xb3,
-	0x82, 0x60, 0x50, 0x55, 0x99, 0xe1, 0x6b, 0xb6, 0x6b, 0x6e, 0xd3, 0xf7, 0x1d, 0x46, 0xec, 0xdb,
-	0xf8, 0x51, 0x10, 0x6b, 0xe1, 0x6b, 0x7b, 0x4f, 0x16, 0xec, 0xe9, 0x34, 0x52, 0x83, 0xd7, 0xc0,
-	0xc4, 0xa6, 0xd8, 0x37, 0x76, 0x38, 0x81, 0x21, 0x2a, 0xce, 0x20, 0x9e, 0x15, 0xd1, 0xad, 0x8d,
-	0x6f, 0xa6, 0xb0, 0xab, 0x8b, 0xb2, 0xfa, 0x5e, 0x8a, 0x5c, 0xb7, 0xe2, 0xbb, 0x8d, 0x25, 0xd9,
-	0x3d, 0x87, 0xee, 0x8e, 0x75, 0xd8, 0x4a, 0xbc, 0xc3, 0x56, 0x57, 0xc0, 0xd9, 0x3d, 0x21, 0xda,
-	0xa5, 0xf5, 0xde, 0xaf, 0xdd, 0x3b, 0xb2, 0x6e, 0x8f, 0xc5, 0x56, 0xe6, 0xb7, 0xf2, 0xb7, 0xfd,
-	0x69, 0x73, 0x98, 0xcc, 0xd2, 0x63, 0xf3, 0x85, 0x42, 0x7c, 0xbe, 0x70, 0x16, 0x8c, 0xba, 0x0f,
-	0x9d, 0x8e, 0x40, 0xea, 0x17, 0xfb, 0x87, 0xc5, 0x62, 0x98, 0x20, 0xa3, 0x76, 0x7c, 0x60, 0xb7,
-	0x76, 0x7c, 0xf0, 0x20, 0xdb, 0xf1, 0x8f, 0xc0, 0x08, 0x71, 0x08, 0x33, 0x64, 0xbd, 0x35, 0x24,
-	0xb0, 0x97, 0x73, 0x61, 0xaf, 0x3a, 0x84, 0x11, 0x64, 0x93, 0x1f, 0x8a, 0x51, 0x8b, 0xa8, 0xc2,
-	0x78, 0xdf, 0x42, 0x75, 0xc0, 0x91, 0x83, 0xaa, 0x0c, 0x36, 0xc0, 0x78, 0x30, 0xf2, 0xa0, 0x5b,
-	0xc8, 0x23, 0x4e, 0x3d, 0x14, 0x78, 0x48, 0x08, 0x7c, 0x3b, 0x5b, 0x81, 0xc7, 0x01, 0xd6, 0x03,
-	0xfe, 0x0e, 0x31, 0xd0, 0x4b, 0xae, 0xd3, 0x85, 0xbf, 0x9f, 0x06, 0x83, 0xe2, 0x14, 0xe1, 0x3f,
-	0x15, 0x30, 0x9e, 0x36, 0xd5, 0x83, 0x57, 0xf3, 0x27, 0xd2, 0xf8, 0x40, 0xb1, 0xbc, 0xd8, 0x03,
-	0x42, 0x10, 0x4e, 0xea, 0x8d, 0x1f, 0xfd, 0xe1, 0x1f, 0x3f, 0x2d, 0xd4, 0xe0, 0xd5, 0xee, 0xe3,
-	0xe7, 0x28, 0xec, 0xe4, 0xd8, 0x50, 0x7b, 0xdc, 0x11, 0x88, 0x4f, 0xe0, 0x9f, 0x15, 0x59, 0x4b,
-	0xc7, 0x53, 0x2a, 0xbc, 0x92, 0x5f, 0xc9, 0xd8, 0xe4, 0xb1, 0x7c, 0x75, 0xff, 0x00, 0xd2, 0xc8,
-	0x45, 0x61, 0xe4, 0xdb, 0xf0, 0xad, 0x1c, 0x46, 0x06, 0x03, 0x40, 0xed, 0xb1, 0x08, 0xff, 0x27,
-	0xf0, 0xd3, 0x82, 0xbc, 0x95, 0xa9, 0xe3, 0x0b, 0xb8, 0x92, 0x5d, 0xc7, 0xbd, 0xc6, 0x31, 0xe5,
-	0xeb, 0x3d, 0xe3, 0x48, 0x93, 0x37, 0x85, 0xc9, 0xdf, 0x85, 0xf7, 0x32, 0xfc, 0xac, 0x10, 0x8d,
-	0xf8, 0x62, 0x7d, 0x58, 0xfc, 0x78, 0xb5, 0xc7, 0xc9, 0x57, 0x28, 0xcd, 0x27, 0x9d, 0xcd, 0xc3,
-	0xbe, 0x7c, 0x92, 0x32, 0xc1, 0xd9, 0x97, 0x4f, 0xd2, 0x46, 0x2f, 0xfb, 0xf3, 0x49, 0xcc, 0xec,
-	0xa4, 0x4f, 0x92, 0x8d, 0xeb, 0x13, 0xf8, 0x3b, 0x45, 0xf6, 0x99, 0xb1, 0xb1, 0x0c, 0xbc, 0x9c,
-	0xdd, 0x86, 0xb4, 0x69, 0x4f, 0xf9, 0xca, 0xbe, 0xf9, 0xa5, 0xed, 0x6f, 0x0a, 0xdb, 0x17, 0xe0,
-	0x5c, 0x77, 0xdb, 0x99, 0x04, 0x08, 0x7e, 0x63, 0x80, 0x3f, 0x2b, 0xc8, 0x67, 0x71, 0xef, 0x39,
-	0x0b, 0xbc, 0x93, 0x5d, 0xc5, 0x4c, 0xf3, 0x9d, 0xf2, 0xda, 0xc1, 0x01, 0x4a, 0x27, 0xdc, 0x14,
-	0x4e, 0x58, 0x86, 0x4b, 0xdd, 0x9d, 0xe0, 0x47, 0x88, 0xed, 0x5b, 0x11, 0x1b, 0xde, 0xc2, 0x1f,
-	0x17, 0x64, 0xc5, 0xb1, 0xe7, 0xa4, 0x07, 0xde, 0xce, 0x6e, 0x45, 0x96, 0x09, 0x54, 0xf9, 0xce,
-	0x81, 0xe1, 0x49, 0xa7, 0x2c, 0x0b, 0xa7, 0x5c, 0x81, 0x97, 0xba, 0x3b, 0x45, 0x46, 0xb9, 0xe1,
-	0x71, 0xd4, 0x44, 0xfa, 0xff, 0xb5, 0x02, 0x46, 0x3a, 0x46, 0x29, 0xf0, 0x8d, 0xec, 0x7a, 0xc6,
-	0x46, 0x32, 0xe5, 0x37, 0xf3, 0x33, 0x4a, 0x4b, 0xe6, 0x84, 0x25, 0xb3, 0x70, 0xa6, 0xbb, 0x25,
-	0xc1, 0xe3, 0xdf, 0x8e, 0xed, 0xbd, 0xc7, 0x29, 0x79, 0x62, 0x3b, 0xd3, 0x9c, 0x27, 0x4f, 0x6c,
-	0x67, 0x9b, 0xf4, 0xe4, 0x89, 0x6d, 0x97, 0x83, 0x18, 0xc4, 0x31, 0xda, 0x2d, 0x58, 0xe2, 0x30,
-	0x7f, 0x53, 0x90, 0x43, 0xd1, 0x2c, 0xed, 0x11, 0x7c, 0x7f, 0xbf, 0x0f, 0xf4, 0x9e, 0x1d, 0x5e,
-	0xf9, 0xee, 0x41, 0xc3, 0x4a, 0x4f, 0xdd, 0x13, 0x9e, 0xda, 0x80, 0x7a, 0xee, 0x6a, 0xc0, 0xf0,
-	0xb0, 0xdf, 0x76, 0x5a, 0xda, 0x93, 0xf8, 0xab, 0x02, 0x78, 0x39, 0x4b, 0xbf, 0x05, 0xd7, 0x7a,
-	0x78, 0xe8, 0x53, 0x3b, 0xc9, 0xf2, 0x7b, 0x07, 0x88, 0x28, 0x3d, 0x65, 0x0a, 0x4f, 0xdd, 0x87,
-	0x1f, 0xe6, 0xf1, 0x54, 0x7c, 0xbc, 0xd4, 0xbd, 0x8a, 0xf8, 0xaf, 0x02, 0x26, 0x76, 0x99, 0x16,
-	0xc0, 0xa5, 0x5e, 0x66, 0x0d, 0xa1, 0x63, 0xae, 0xf5, 0x06, 0x92, 0xff, 0x7e, 0x45, 0x16, 0xef,
-	0x7a, 0xbf, 0xfe, 0xad, 0xc8, 0x16, 0x31, 0xad, 0x13, 0x86, 0x39, 0x26, 0x2c, 0x7b, 0x74, 0xdb,
-	0xe5, 0x95, 0x5e, 0x61, 0xf2, 0x57, 0xcf, 0xbb, 0x34, 0xee, 0xf0, 0x7f, 0xc9, 0x9f, 0xea, 0xe3,
-	0xad, 0x35, 0xbc, 0x9e, 0xff, 0x88, 0x52, 0xfb, 0xfb, 0xf2, 0x8d, 0xde, 0x81, 0x7a, 0xe8, 0x19,
-	0x88, 0xa5, 0x3d, 0x8e, 0xc6, 0x0b, 0x4f, 0xe0, 0x5f, 0xc2, 0x5a, 0x30, 0x96, 0x9e, 0xf2, 0xd4,
-	0x82, 0x69, 0x13, 0x84, 0xf2, 0x95, 0x7d, 0xf3, 0x4b, 0xd3, 0x56, 0x84, 0x69, 0x57, 0xe1, 0xe5,
-	0xbc, 0x09, 0x30, 0x1e, 0xc5, 0xb5, 0x0f, 0xbe, 0x78, 0x36, 0xa5, 0x7c, 0xf9, 0x6c, 0x4a, 0xf9,
-	0xdb, 0xb3, 0x29, 0xe5, 0x93, 0xe7, 0x53, 0x7d, 0x5f, 0x3e, 0x9f, 0xea, 0xfb, 0xe3, 0xf3, 0xa9,
-	0xbe, 0x7b, 0x97, 0xea, 0x84, 0x6d, 0xed, 0x6c, 0x56, 0x4d, 0xb7, 0x21, 0xff, 0xe7, 0xa6, 0x43,
-	0xd4, 0x85, 0x48, 0x54, 0xf3, 0x75, 0xed, 0x51, 0xa2, 0xf6, 0x6c, 0x79, 0x98, 0x6e, 0x0e, 0x89,
-	0x5f, 0x0f, 0xbf, 0xf3, 0x4d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0xe8, 0xa5, 0x6b, 0x13, 0x25,
-	0x00, 0x00,
+type QueryConsumerIdFromClientIdRequest struct {
+	// the client id (on the provider) that is tracking the consumer chain
+	// the client id can be found from the consumer chain by querying (i.e., `query ccvconsumer provider-info`)
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryConsumerIdFromClientIdRequest) Reset()         { *m = QueryConsumerIdFromClientIdRequest{} }
+func (m *QueryConsumerIdFromClientIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerIdFromClientIdRequest) ProtoMessage()    {}
+func (*QueryConsumerIdFromClientIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{38}
+}
+func (m *QueryConsumerIdFromClientIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerIdFromClientIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerIdFromClientIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerIdFromClientIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerIdFromClientIdRequest.Merge(m, src)
+}
+func (m *QueryConsumerIdFromClientIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerIdFromClientIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerIdFromClientIdRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryConsumerIdFromClientIdRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
+func (m *QueryConsumerIdFromClientIdRequest) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryConsumerIdFromClientIdResponse struct {
+	// the consumer id of the chain associated with this client id
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryConsumerIdFromClientIdResponse) Reset()         { *m = QueryConsumerIdFromClientIdResponse{} }
+func (m *QueryConsumerIdFromClientIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerIdFromClientIdResponse) ProtoMessage()    {}
+func (*QueryConsumerIdFromClientIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{39}
 }
-
-func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
-	out := new(QueryConsumerGenesisResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryConsumerIdFromClientIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
-	out := new(QueryConsumerChainsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerIdFromClientIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerIdFromClientIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryConsumerIdFromClientIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerIdFromClientIdResponse.Merge(m, src)
+}
+func (m *QueryConsumerIdFromClientIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerIdFromClientIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerIdFromClientIdResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
-	out := new(QueryValidatorConsumerAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerIdFromClientIdResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerIdFromClientIdResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
-	out := new(QueryValidatorProviderAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryConsumerChainRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainRequest) Reset()         { *m = QueryConsumerChainRequest{} }
+func (m *QueryConsumerChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainRequest) ProtoMessage()    {}
+func (*QueryConsumerChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{40}
+}
+func (m *QueryConsumerChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryConsumerChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainRequest.Merge(m, src)
+}
+func (m *QueryConsumerChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	out := new(QueryRegisteredConsumerRewardDenomsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerChainRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	out := new(QueryAllPairsValConsAddrByConsumerResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryConsumerChainResponse struct {
+	ConsumerId         string                            `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId            string                            `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	OwnerAddress       string                            `protobuf:"bytes,3,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Phase              string                            `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Metadata           ConsumerMetadata                  `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata"`
+	InitParams         *ConsumerInitializationParameters `protobuf:"bytes,6,opt,name=init_params,json=initParams,proto3" json:"init_params,omitempty"`
+	PowerShapingParams *PowerShapingParameters           `protobuf:"bytes,7,opt,name=power_shaping_params,json=powerShapingParams,proto3" json:"power_shaping_params,omitempty"`
+	EndpointInfo       *EndpointInfo                     `protobuf:"bytes,8,opt,name=endpoint_info,json=endpointInfo,proto3" json:"endpoint_info,omitempty"`
+	// the address permitted to update the allowlist and denylist of the consumer chain
+	// on behalf of the owner (empty if not set)
+	PowerShapingAdmin string `protobuf:"bytes,9,opt,name=power_shaping_admin,json=powerShapingAdmin,proto3" json:"power_shaping_admin,omitempty"`
+	// the time at which the consumer chain is stopped because its maximum lifetime elapses
+	// (not set if the chain is not launched or its lifetime is unlimited)
+	SunsetTime *time.Time `protobuf:"bytes,10,opt,name=sunset_time,json=sunsetTime,proto3,stdtime" json:"sunset_time,omitempty"`
+	// the remaining lifetime of the consumer chain (not set if the chain is not launched or its lifetime is unlimited)
+	RemainingLifetime *time.Duration `protobuf:"bytes,11,opt,name=remaining_lifetime,json=remainingLifetime,proto3,stdduration" json:"remaining_lifetime,omitempty"`
+	// who removed the consumer chain (unspecified if the chain was not removed with a MsgRemoveConsumer)
+	RemovalInitiator ConsumerRemovalInitiator `protobuf:"varint,12,opt,name=removal_initiator,json=removalInitiator,proto3,enum=interchain_security.ccv.provider.v1.ConsumerRemovalInitiator" json:"removal_initiator,omitempty"`
+	// the active upgrade notices published by the owner of the consumer chain
+	UpgradeNotices []ConsumerUpgradeNotice `protobuf:"bytes,13,rep,name=upgrade_notices,json=upgradeNotices,proto3" json:"upgrade_notices"`
+	// whether the reward distribution of the consumer chain is paused by its owner
+	RewardsPaused bool `protobuf:"varint,14,opt,name=rewards_paused,json=rewardsPaused,proto3" json:"rewards_paused,omitempty"`
+	// the rewards held back while the reward distribution of the consumer chain is paused
+	HeldBackRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,15,rep,name=held_back_rewards,json=heldBackRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"held_back_rewards"`
+	// how the consumer chain was created (not set if the chain was created before this record was introduced)
+	CreationRecord *ConsumerCreationRecord `protobuf:"bytes,16,opt,name=creation_record,json=creationRecord,proto3" json:"creation_record,omitempty"`
+	// why the consumer chain was stopped (not set if the chain was not stopped)
+	RemovalRecord *ConsumerRemovalRecord `protobuf:"bytes,17,opt,name=removal_record,json=removalRecord,proto3" json:"removal_record,omitempty"`
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) Reset()         { *m = QueryConsumerChainResponse{} }
+func (m *QueryConsumerChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainResponse) ProtoMessage()    {}
+func (*QueryConsumerChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{41}
+}
+func (m *QueryConsumerChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryConsumerChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainResponse.Merge(m, src)
+}
+func (m *QueryConsumerChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	out := new(QueryConsumerChainOptedInValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerChainResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	out := new(QueryValidatorConsumerCommissionRateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
-	out := new(QueryConsumerValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetPhase() string {
+	if m != nil {
+		return m.Phase
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
-	out := new(QueryBlocksUntilNextEpochResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetMetadata() ConsumerMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return ConsumerMetadata{}
 }
 
-func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
-	out := new(QueryConsumerIdFromClientIdResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetInitParams() *ConsumerInitializationParameters {
+	if m != nil {
+		return m.InitParams
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
-	out := new(QueryConsumerChainResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainResponse) GetPowerShapingParams() *PowerShapingParameters {
+	if m != nil {
+		return m.PowerShapingParams
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
+func (m *QueryConsumerChainResponse) GetEndpointInfo() *EndpointInfo {
+	if m != nil {
+		return m.EndpointInfo
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryConsumerChainResponse) GetPowerShapingAdmin() string {
+	if m != nil {
+		return m.PowerShapingAdmin
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+func (m *QueryConsumerChainResponse) GetSunsetTime() *time.Time {
+	if m != nil {
+		return m.SunsetTime
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+
+func (m *QueryConsumerChainResponse) GetRemainingLifetime() *time.Duration {
+	if m != nil {
+		return m.RemainingLifetime
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+
+func (m *QueryConsumerChainResponse) GetRemovalInitiator() ConsumerRemovalInitiator {
+	if m != nil {
+		return m.RemovalInitiator
+	}
+	return CONSUMER_REMOVAL_INITIATOR_UNSPECIFIED
 }
-func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+
+func (m *QueryConsumerChainResponse) GetUpgradeNotices() []ConsumerUpgradeNotice {
+	if m != nil {
+		return m.UpgradeNotices
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+
+func (m *QueryConsumerChainResponse) GetRewardsPaused() bool {
+	if m != nil {
+		return m.RewardsPaused
+	}
+	return false
 }
-func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+
+func (m *QueryConsumerChainResponse) GetHeldBackRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.HeldBackRewards
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+
+func (m *QueryConsumerChainResponse) GetCreationRecord() *ConsumerCreationRecord {
+	if m != nil {
+		return m.CreationRecord
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+
+func (m *QueryConsumerChainResponse) GetRemovalRecord() *ConsumerRemovalRecord {
+	if m != nil {
+		return m.RemovalRecord
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+
+type QueryValidatorProviderExposureRequest struct {
+	// The operator address of the validator on the provider chain
+	ProviderOperatorAddress string `protobuf:"bytes,1,opt,name=provider_operator_address,json=providerOperatorAddress,proto3" json:"provider_operator_address,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+
+func (m *QueryValidatorProviderExposureRequest) Reset()         { *m = QueryValidatorProviderExposureRequest{} }
+func (m *QueryValidatorProviderExposureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorProviderExposureRequest) ProtoMessage()    {}
+func (*QueryValidatorProviderExposureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{42}
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+func (m *QueryValidatorProviderExposureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+func (m *QueryValidatorProviderExposureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorProviderExposureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+func (m *QueryValidatorProviderExposureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorProviderExposureRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+func (m *QueryValidatorProviderExposureRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+func (m *QueryValidatorProviderExposureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorProviderExposureRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryValidatorProviderExposureRequest proto.InternalMessageInfo
 
-func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerGenesisRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+func (m *QueryValidatorProviderExposureRequest) GetProviderOperatorAddress() string {
+	if m != nil {
+		return m.ProviderOperatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryValidatorProviderExposureResponse struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+	// The consumer chains the validator is exposed to
+	Consumers []ValidatorConsumerExposure `protobuf:"bytes,2,rep,name=consumers,proto3" json:"consumers"`
 }
 
-func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
+func (m *QueryValidatorProviderExposureResponse) Reset() {
+	*m = QueryValidatorProviderExposureResponse{}
+}
+func (m *QueryValidatorProviderExposureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorProviderExposureResponse) ProtoMessage()    {}
+func (*QueryValidatorProviderExposureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{43}
+}
+func (m *QueryValidatorProviderExposureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorProviderExposureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorProviderExposureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidatorProviderExposureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorProviderExposureResponse.Merge(m, src)
+}
+func (m *QueryValidatorProviderExposureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorProviderExposureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorProviderExposureResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorProviderAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+var xxx_messageInfo_QueryValidatorProviderExposureResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorProviderExposureResponse) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+func (m *QueryValidatorProviderExposureResponse) GetConsumers() []ValidatorConsumerExposure {
+	if m != nil {
+		return m.Consumers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredConsumerRewardDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ValidatorConsumerExposure describes the exposure of a validator to a single consumer chain
+type ValidatorConsumerExposure struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,3,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// Whether the validator is part of the latest consumer validator set
+	InValidatorSet bool `protobuf:"varint,4,opt,name=in_validator_set,json=inValidatorSet,proto3" json:"in_validator_set,omitempty"`
+	// The power of the validator in the latest consumer validator set (zero if not part of it)
+	ConsumerPower int64 `protobuf:"varint,5,opt,name=consumer_power,json=consumerPower,proto3" json:"consumer_power,omitempty"`
+	// Whether the validator is opted in
+	OptedIn bool `protobuf:"varint,6,opt,name=opted_in,json=optedIn,proto3" json:"opted_in,omitempty"`
+	// Whether the validator is forced to validate the chain because it belongs to the Top N validators
+	ForcedByTopN bool `protobuf:"varint,7,opt,name=forced_by_top_n,json=forcedByTopN,proto3" json:"forced_by_top_n,omitempty"`
+	// Whether the validator opted out but is still part of the consumer validator set until the end of the epoch
+	PendingOptOut bool `protobuf:"varint,8,opt,name=pending_opt_out,json=pendingOptOut,proto3" json:"pending_opt_out,omitempty"`
+	// The rate to charge delegators on the consumer chain, as a fraction
+	ConsumerCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=consumer_commission_rate,json=consumerCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"consumer_commission_rate"`
+	// The active upgrade notices published by the owner of the consumer chain
+	UpgradeNotices []ConsumerUpgradeNotice `protobuf:"bytes,10,rep,name=upgrade_notices,json=upgradeNotices,proto3" json:"upgrade_notices"`
 }
 
-func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPairsValConsAddrByConsumerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+func (m *ValidatorConsumerExposure) Reset()         { *m = ValidatorConsumerExposure{} }
+func (m *ValidatorConsumerExposure) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsumerExposure) ProtoMessage()    {}
+func (*ValidatorConsumerExposure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{44}
+}
+func (m *ValidatorConsumerExposure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsumerExposure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsumerExposure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ValidatorConsumerExposure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsumerExposure.Merge(m, src)
+}
+func (m *ValidatorConsumerExposure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsumerExposure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsumerExposure.DiscardUnknown(m)
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
+var xxx_messageInfo_ValidatorConsumerExposure proto.InternalMessageInfo
+
+func (m *ValidatorConsumerExposure) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
+	return ""
+}
+
+func (m *ValidatorConsumerExposure) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	return ""
+}
+
+func (m *ValidatorConsumerExposure) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainOptedInValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ValidatorConsumerExposure) GetInValidatorSet() bool {
+	if m != nil {
+		return m.InValidatorSet
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
+	return false
+}
+
+func (m *ValidatorConsumerExposure) GetConsumerPower() int64 {
+	if m != nil {
+		return m.ConsumerPower
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
+	return 0
+}
+
+func (m *ValidatorConsumerExposure) GetOptedIn() bool {
+	if m != nil {
+		return m.OptedIn
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+	return false
+}
+
+func (m *ValidatorConsumerExposure) GetForcedByTopN() bool {
+	if m != nil {
+		return m.ForcedByTopN
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ValidatorConsumerExposure) GetPendingOptOut() bool {
+	if m != nil {
+		return m.PendingOptOut
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
+	return false
+}
+
+func (m *ValidatorConsumerExposure) GetUpgradeNotices() []ConsumerUpgradeNotice {
+	if m != nil {
+		return m.UpgradeNotices
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+	return nil
+}
+
+type QueryConsumerTopologyRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerTopologyRequest) Reset()         { *m = QueryConsumerTopologyRequest{} }
+func (m *QueryConsumerTopologyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerTopologyRequest) ProtoMessage()    {}
+func (*QueryConsumerTopologyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{45}
+}
+func (m *QueryConsumerTopologyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerTopologyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerTopologyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+}
+func (m *QueryConsumerTopologyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerTopologyRequest.Merge(m, src)
+}
+func (m *QueryConsumerTopologyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerTopologyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerTopologyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerTopologyRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerTopologyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerCommissionRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryConsumerTopologyResponse struct {
+	Topologies []ConsumerTopology  `protobuf:"bytes,1,rep,name=topologies,proto3" json:"topologies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumerTopologyResponse) Reset()         { *m = QueryConsumerTopologyResponse{} }
+func (m *QueryConsumerTopologyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerTopologyResponse) ProtoMessage()    {}
+func (*QueryConsumerTopologyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{46}
+}
+func (m *QueryConsumerTopologyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerTopologyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerTopologyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
+}
+func (m *QueryConsumerTopologyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerTopologyResponse.Merge(m, src)
+}
+func (m *QueryConsumerTopologyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerTopologyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerTopologyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerTopologyResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerTopologyResponse) GetTopologies() []ConsumerTopology {
+	if m != nil {
+		return m.Topologies
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
+	return nil
+}
+
+func (m *QueryConsumerTopologyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
+	return nil
+}
+
+// ConsumerTopology describes the IBC path between the provider and a consumer chain
+type ConsumerTopology struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The client id of the consumer chain on the provider
+	ClientId string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The id of the CCV channel on the provider (empty if the channel is not established)
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// The state of the CCV channel on the provider (empty if the channel is not established)
+	ChannelState string `protobuf:"bytes,5,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	// The id of the CCV channel on the consumer (empty if the channel is not established)
+	CounterpartyChannelId string `protobuf:"bytes,6,opt,name=counterparty_channel_id,json=counterpartyChannelId,proto3" json:"counterparty_channel_id,omitempty"`
+	// The id of the connection underlying the CCV channel (empty if the channel is not established)
+	ConnectionId string `protobuf:"bytes,7,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The transfer channel on the consumer used for sending rewards to the provider,
+	// as set in the initialization parameters of the consumer chain
+	DistributionTransmissionChannel string `protobuf:"bytes,8,opt,name=distribution_transmission_channel,json=distributionTransmissionChannel,proto3" json:"distribution_transmission_channel,omitempty"`
+	// Whether the CCV channel is established
+	Established bool `protobuf:"varint,9,opt,name=established,proto3" json:"established,omitempty"`
+}
+
+func (m *ConsumerTopology) Reset()         { *m = ConsumerTopology{} }
+func (m *ConsumerTopology) String() string { return proto.CompactTextString(m) }
+func (*ConsumerTopology) ProtoMessage()    {}
+func (*ConsumerTopology) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{47}
+}
+func (m *ConsumerTopology) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerTopology) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerTopology.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ConsumerTopology) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerTopology.Merge(m, src)
+}
+func (m *ConsumerTopology) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerTopology) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerTopology.DiscardUnknown(m)
 }
 
-func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerTopology proto.InternalMessageInfo
+
+func (m *ConsumerTopology) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
+	return ""
+}
+
+func (m *ConsumerTopology) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
+	return ""
+}
+
+func (m *ConsumerTopology) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
+	return ""
+}
+
+func (m *ConsumerTopology) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlocksUntilNextEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ConsumerTopology) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
+	return ""
+}
+
+func (m *ConsumerTopology) GetCounterpartyChannelId() string {
+	if m != nil {
+		return m.CounterpartyChannelId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
+	return ""
+}
+
+func (m *ConsumerTopology) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
+	return ""
+}
+
+func (m *ConsumerTopology) GetDistributionTransmissionChannel() string {
+	if m != nil {
+		return m.DistributionTransmissionChannel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerIdFromClientIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
+func (m *ConsumerTopology) GetEstablished() bool {
+	if m != nil {
+		return m.Established
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryVscIdToHeightRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	VscId      uint64 `protobuf:"varint,2,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.provider.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryConsumerGenesis",
-			Handler:    _Query_QueryConsumerGenesis_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChains",
-			Handler:    _Query_QueryConsumerChains_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerAddr",
-			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
-		},
-		{
-			MethodName: "QueryValidatorProviderAddr",
-			Handler:    _Query_QueryValidatorProviderAddr_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-		{
-			MethodName: "QueryRegisteredConsumerRewardDenoms",
-			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
-		},
-		{
-			MethodName: "QueryAllPairsValConsAddrByConsumer",
-			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainOptedInValidators",
-			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainsValidatorHasToValidate",
-			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerCommissionRate",
-			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
-		},
-		{
-			MethodName: "QueryConsumerValidators",
-			Handler:    _Query_QueryConsumerValidators_Handler,
-		},
-		{
-			MethodName: "QueryBlocksUntilNextEpoch",
-			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
-		},
-		{
-			MethodName: "QueryConsumerIdFromClientId",
-			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChain",
-			Handler:    _Query_QueryConsumerChain_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+func (m *QueryVscIdToHeightRequest) Reset()         { *m = QueryVscIdToHeightRequest{} }
+func (m *QueryVscIdToHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVscIdToHeightRequest) ProtoMessage()    {}
+func (*QueryVscIdToHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{48}
 }
-
-func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVscIdToHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVscIdToHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVscIdToHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVscIdToHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVscIdToHeightRequest.Merge(m, src)
+}
+func (m *QueryVscIdToHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVscIdToHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVscIdToHeightRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryVscIdToHeightRequest proto.InternalMessageInfo
+
+func (m *QueryVscIdToHeightRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryVscIdToHeightRequest) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryVscIdToHeightResponse struct {
+	// the provider block height to which the valset update id is mapped
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the provider block time at which the VSC packet was queued
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
 }
 
-func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryVscIdToHeightResponse) Reset()         { *m = QueryVscIdToHeightResponse{} }
+func (m *QueryVscIdToHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVscIdToHeightResponse) ProtoMessage()    {}
+func (*QueryVscIdToHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{49}
+}
+func (m *QueryVscIdToHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVscIdToHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVscIdToHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryVscIdToHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVscIdToHeightResponse.Merge(m, src)
+}
+func (m *QueryVscIdToHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVscIdToHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVscIdToHeightResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVscIdToHeightResponse proto.InternalMessageInfo
+
+func (m *QueryVscIdToHeightResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryVscIdToHeightResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
 }
 
-func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryConsumerRewardChannelRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerRewardChannelRequest) Reset()         { *m = QueryConsumerRewardChannelRequest{} }
+func (m *QueryConsumerRewardChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardChannelRequest) ProtoMessage()    {}
+func (*QueryConsumerRewardChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{50}
+}
+func (m *QueryConsumerRewardChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Phase != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerRewardChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardChannelRequest.Merge(m, src)
+}
+func (m *QueryConsumerRewardChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardChannelRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerRewardChannelRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardChannelRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerRewardChannelResponse struct {
+	// the transfer channel on the provider on which the ICS rewards
+	// of the consumer chain are received
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsumerRewardChannelResponse) Reset()         { *m = QueryConsumerRewardChannelResponse{} }
+func (m *QueryConsumerRewardChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardChannelResponse) ProtoMessage()    {}
+func (*QueryConsumerRewardChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{51}
+}
+func (m *QueryConsumerRewardChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerRewardChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardChannelResponse.Merge(m, src)
+}
+func (m *QueryConsumerRewardChannelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardChannelResponse.DiscardUnknown(m)
 }
 
-func (m *Chain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerRewardChannelResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardChannelResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerEndpointsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowlistedRewardDenoms != nil {
-		{
-			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.MinStake != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerEndpointsRequest) Reset()         { *m = QueryConsumerEndpointsRequest{} }
+func (m *QueryConsumerEndpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerEndpointsRequest) ProtoMessage()    {}
+func (*QueryConsumerEndpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{52}
+}
+func (m *QueryConsumerEndpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerEndpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerEndpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MinPowerInTop_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Top_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerEndpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerEndpointsRequest.Merge(m, src)
 }
-
-func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerEndpointsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryConsumerEndpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerEndpointsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerEndpointsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerEndpointsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerEndpointsResponse struct {
+	EndpointInfo EndpointInfo `protobuf:"bytes,1,opt,name=endpoint_info,json=endpointInfo,proto3" json:"endpoint_info"`
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerEndpointsResponse) Reset()         { *m = QueryConsumerEndpointsResponse{} }
+func (m *QueryConsumerEndpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerEndpointsResponse) ProtoMessage()    {}
+func (*QueryConsumerEndpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{53}
+}
+func (m *QueryConsumerEndpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerEndpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerEndpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerEndpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerEndpointsResponse.Merge(m, src)
+}
+func (m *QueryConsumerEndpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerEndpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerEndpointsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerEndpointsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerEndpointsResponse) GetEndpointInfo() EndpointInfo {
+	if m != nil {
+		return m.EndpointInfo
 	}
-	return dAtA[:n], nil
+	return EndpointInfo{}
 }
 
-func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySecurityOverviewRequest struct {
 }
 
-func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySecurityOverviewRequest) Reset()         { *m = QuerySecurityOverviewRequest{} }
+func (m *QuerySecurityOverviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySecurityOverviewRequest) ProtoMessage()    {}
+func (*QuerySecurityOverviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{54}
 }
-
-func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySecurityOverviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecurityOverviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecurityOverviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySecurityOverviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecurityOverviewRequest.Merge(m, src)
+}
+func (m *QuerySecurityOverviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecurityOverviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecurityOverviewRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySecurityOverviewRequest proto.InternalMessageInfo
+
+type QuerySecurityOverviewResponse struct {
+	// the total power of the bonded validators on the provider
+	TotalBondedPower int64 `protobuf:"varint,1,opt,name=total_bonded_power,json=totalBondedPower,proto3" json:"total_bonded_power,omitempty"`
+	// the security overview of every launched or initialized consumer chain
+	Consumers []ConsumerSecurityOverview `protobuf:"bytes,2,rep,name=consumers,proto3" json:"consumers"`
+	// the number of consumer chains every bonded validator would secure
+	Validators []ValidatorSecurityOverview `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators"`
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySecurityOverviewResponse) Reset()         { *m = QuerySecurityOverviewResponse{} }
+func (m *QuerySecurityOverviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySecurityOverviewResponse) ProtoMessage()    {}
+func (*QuerySecurityOverviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{55}
+}
+func (m *QuerySecurityOverviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecurityOverviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecurityOverviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySecurityOverviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecurityOverviewResponse.Merge(m, src)
+}
+func (m *QuerySecurityOverviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecurityOverviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecurityOverviewResponse.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySecurityOverviewResponse proto.InternalMessageInfo
+
+func (m *QuerySecurityOverviewResponse) GetTotalBondedPower() int64 {
+	if m != nil {
+		return m.TotalBondedPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySecurityOverviewResponse) GetConsumers() []ConsumerSecurityOverview {
+	if m != nil {
+		return m.Consumers
+	}
+	return nil
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySecurityOverviewResponse) GetValidators() []ValidatorSecurityOverview {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type ConsumerSecurityOverview struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// The phase of the consumer chain
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// The provider consensus addresses of the opted-in validators
+	OptedInValidators []string `protobuf:"bytes,4,rep,name=opted_in_validators,json=optedInValidators,proto3" json:"opted_in_validators,omitempty"`
+	// The provider consensus addresses of the validators that would be selected
+	// if the consumer validator set was computed now
+	NextValidators []string `protobuf:"bytes,5,rep,name=next_validators,json=nextValidators,proto3" json:"next_validators,omitempty"`
+	// The total provider power of the validators in `next_validators`
+	SelectedPower int64 `protobuf:"varint,6,opt,name=selected_power,json=selectedPower,proto3" json:"selected_power,omitempty"`
+	// The fraction of the total bonded power on the provider securing the consumer chain,
+	// i.e., `selected_power` divided by the total bonded power
+	SecurityFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=security_fraction,json=securityFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"security_fraction"`
+	// The provider consensus addresses of the validators of the current consumer validator set
+	// that would be evicted due to the validator-set cap if the consumer validator set was computed now
+	EvictedValidators []string `protobuf:"bytes,8,rep,name=evicted_validators,json=evictedValidators,proto3" json:"evicted_validators,omitempty"`
+}
+
+func (m *ConsumerSecurityOverview) Reset()         { *m = ConsumerSecurityOverview{} }
+func (m *ConsumerSecurityOverview) String() string { return proto.CompactTextString(m) }
+func (*ConsumerSecurityOverview) ProtoMessage()    {}
+func (*ConsumerSecurityOverview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{56}
+}
+func (m *ConsumerSecurityOverview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerSecurityOverview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerSecurityOverview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerSecurityOverview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerSecurityOverview.Merge(m, src)
+}
+func (m *ConsumerSecurityOverview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerSecurityOverview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerSecurityOverview.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
-	if err6 != nil {
-		return 0, err6
+var xxx_messageInfo_ConsumerSecurityOverview proto.InternalMessageInfo
+
+func (m *ConsumerSecurityOverview) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	i -= n6
-	i = encodeVarintQuery(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x1a
-	if m.SlashMeterAllowance != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ConsumerSecurityOverview) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.SlashMeter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ConsumerSecurityOverview) GetPhase() string {
+	if m != nil {
+		return m.Phase
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerSecurityOverview) GetOptedInValidators() []string {
+	if m != nil {
+		return m.OptedInValidators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerSecurityOverview) GetNextValidators() []string {
+	if m != nil {
+		return m.NextValidators
+	}
+	return nil
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ConsumerSecurityOverview) GetSelectedPower() int64 {
+	if m != nil {
+		return m.SelectedPower
+	}
+	return 0
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerSecurityOverview) GetEvictedValidators() []string {
+	if m != nil {
+		return m.EvictedValidators
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorSecurityOverview struct {
+	// The provider consensus address of the validator
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// The power of the validator on the provider
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// The number of consumer chains the validator would secure
+	NumSecuredConsumers uint32 `protobuf:"varint,3,opt,name=num_secured_consumers,json=numSecuredConsumers,proto3" json:"num_secured_consumers,omitempty"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorSecurityOverview) Reset()         { *m = ValidatorSecurityOverview{} }
+func (m *ValidatorSecurityOverview) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSecurityOverview) ProtoMessage()    {}
+func (*ValidatorSecurityOverview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{57}
+}
+func (m *ValidatorSecurityOverview) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSecurityOverview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSecurityOverview.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorSecurityOverview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSecurityOverview.Merge(m, src)
+}
+func (m *ValidatorSecurityOverview) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSecurityOverview) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSecurityOverview.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorSecurityOverview proto.InternalMessageInfo
+
+func (m *ValidatorSecurityOverview) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorSecurityOverview) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorSecurityOverview) GetNumSecuredConsumers() uint32 {
+	if m != nil {
+		return m.NumSecuredConsumers
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryConsumersByOwnerRequest struct {
+	// The address of the owner of the consumer chains
+	OwnerAddress string             `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumersByOwnerRequest) Reset()         { *m = QueryConsumersByOwnerRequest{} }
+func (m *QueryConsumersByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByOwnerRequest) ProtoMessage()    {}
+func (*QueryConsumersByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{58}
 }
-
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsumersByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByOwnerRequest.Merge(m, src)
+}
+func (m *QueryConsumersByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByOwnerRequest.DiscardUnknown(m)
 }
 
-func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumersByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryConsumersByOwnerRequest) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumersByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryConsumersByOwnerResponse struct {
+	// the consumer chains owned by the owner address in the requested page
+	Chains     []*Chain            `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryConsumersByOwnerResponse) Reset()         { *m = QueryConsumersByOwnerResponse{} }
+func (m *QueryConsumersByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumersByOwnerResponse) ProtoMessage()    {}
+func (*QueryConsumersByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{59}
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumersByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumersByOwnerResponse.Merge(m, src)
+}
+func (m *QueryConsumersByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumersByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumersByOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumersByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryConsumersByOwnerResponse) GetChains() []*Chain {
+	if m != nil {
+		return m.Chains
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumersByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryConsumerChainIdConflictsRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainIdConflictsRequest) Reset()         { *m = QueryConsumerChainIdConflictsRequest{} }
+func (m *QueryConsumerChainIdConflictsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainIdConflictsRequest) ProtoMessage()    {}
+func (*QueryConsumerChainIdConflictsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{60}
+}
+func (m *QueryConsumerChainIdConflictsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainIdConflictsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainIdConflictsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryConsumerChainIdConflictsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainIdConflictsRequest.Merge(m, src)
+}
+func (m *QueryConsumerChainIdConflictsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainIdConflictsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainIdConflictsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryConsumerChainIdConflictsRequest proto.InternalMessageInfo
+
+type QueryConsumerChainIdConflictsResponse struct {
+	// the conflicts sorted by chain id
+	Conflicts []ConsumerChainIdConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerChainIdConflictsResponse) Reset()         { *m = QueryConsumerChainIdConflictsResponse{} }
+func (m *QueryConsumerChainIdConflictsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainIdConflictsResponse) ProtoMessage()    {}
+func (*QueryConsumerChainIdConflictsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{61}
+}
+func (m *QueryConsumerChainIdConflictsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainIdConflictsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainIdConflictsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerChainIdConflictsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainIdConflictsResponse.Merge(m, src)
+}
+func (m *QueryConsumerChainIdConflictsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainIdConflictsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainIdConflictsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainIdConflictsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerChainIdConflictsResponse) GetConflicts() []ConsumerChainIdConflict {
+	if m != nil {
+		return m.Conflicts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConsumerChainIdConflict struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the consumer ids of the registered, initialized, or launched consumer chains with this chain id
+	ConsumerIds []string `protobuf:"bytes,2,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+	// the consumer id of the launched consumer chain with this chain id (empty if there is none)
+	LaunchedConsumerId string `protobuf:"bytes,3,opt,name=launched_consumer_id,json=launchedConsumerId,proto3" json:"launched_consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ConsumerChainIdConflict) Reset()         { *m = ConsumerChainIdConflict{} }
+func (m *ConsumerChainIdConflict) String() string { return proto.CompactTextString(m) }
+func (*ConsumerChainIdConflict) ProtoMessage()    {}
+func (*ConsumerChainIdConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{62}
 }
-
-func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerChainIdConflict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerChainIdConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerChainIdConflict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerChainIdConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerChainIdConflict.Merge(m, src)
+}
+func (m *ConsumerChainIdConflict) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerChainIdConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerChainIdConflict.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorsProviderAddresses[iNdEx])
-			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_ConsumerChainIdConflict proto.InternalMessageInfo
+
+func (m *ConsumerChainIdConflict) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerChainIdConflict) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerChainIdConflict) GetLaunchedConsumerId() string {
+	if m != nil {
+		return m.LaunchedConsumerId
+	}
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+type QueryConsumerChainsCarryingValidatorRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+}
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) Reset() {
+	*m = QueryConsumerChainsCarryingValidatorRequest{}
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerChainsCarryingValidatorRequest) ProtoMessage() {}
+func (*QueryConsumerChainsCarryingValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{63}
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainsCarryingValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainsCarryingValidatorRequest.Merge(m, src)
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainsCarryingValidatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainsCarryingValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerChainsCarryingValidatorResponse struct {
+	// the consumer ids of the consumer chains that still carry the validator
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatesCurrentEpoch {
-		i--
-		if m.ValidatesCurrentEpoch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.ProviderPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.ProviderTokens.Size()
-		i -= size
-		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.ProviderOperatorAddress) > 0 {
-		i -= len(m.ProviderOperatorAddress)
-		copy(dAtA[i:], m.ProviderOperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerChainsCarryingValidatorResponse) Reset() {
+	*m = QueryConsumerChainsCarryingValidatorResponse{}
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerChainsCarryingValidatorResponse) ProtoMessage() {}
+func (*QueryConsumerChainsCarryingValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{64}
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainsCarryingValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ProviderCommissionRate.Size()
-		i -= size
-		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ConsumerCommissionRate.Size()
-		i -= size
-		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.ConsumerPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Power != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainsCarryingValidatorResponse.Merge(m, src)
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainsCarryingValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainsCarryingValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerChainsCarryingValidatorResponse) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerLaunchChecklistRequest struct {
+	// the consumer id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsumerLaunchChecklistRequest) Reset()         { *m = QueryConsumerLaunchChecklistRequest{} }
+func (m *QueryConsumerLaunchChecklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchChecklistRequest) ProtoMessage()    {}
+func (*QueryConsumerLaunchChecklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{65}
+}
+func (m *QueryConsumerLaunchChecklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerLaunchChecklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchChecklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerLaunchChecklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchChecklistRequest.Merge(m, src)
+}
+func (m *QueryConsumerLaunchChecklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerLaunchChecklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchChecklistRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerLaunchChecklistRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerLaunchChecklistRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerLaunchCheck is a requirement that a consumer chain has to meet before it can launch
+type ConsumerLaunchCheck struct {
+	// the name of the check, e.g., `spawn_time`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// whether the consumer chain passes the check
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// human-readable details on the outcome of the check
+	Details string `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ConsumerLaunchCheck) Reset()         { *m = ConsumerLaunchCheck{} }
+func (m *ConsumerLaunchCheck) String() string { return proto.CompactTextString(m) }
+func (*ConsumerLaunchCheck) ProtoMessage()    {}
+func (*ConsumerLaunchCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{66}
 }
-
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerLaunchCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerLaunchCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerLaunchCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ConsumerLaunchCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerLaunchCheck.Merge(m, src)
+}
+func (m *ConsumerLaunchCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerLaunchCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerLaunchCheck.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConsumerLaunchCheck proto.InternalMessageInfo
+
+func (m *ConsumerLaunchCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConsumerIds[iNdEx])
-			copy(dAtA[i:], m.ConsumerIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ConsumerLaunchCheck) GetPassed() bool {
+	if m != nil {
+		return m.Passed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerLaunchCheck) GetDetails() string {
+	if m != nil {
+		return m.Details
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerLaunchChecklistResponse struct {
+	// the launch checks of the consumer chain
+	Checks []ConsumerLaunchCheck `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks"`
+	// whether the consumer chain passes all the launch checks
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryConsumerLaunchChecklistResponse) Reset()         { *m = QueryConsumerLaunchChecklistResponse{} }
+func (m *QueryConsumerLaunchChecklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchChecklistResponse) ProtoMessage()    {}
+func (*QueryConsumerLaunchChecklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{67}
+}
+func (m *QueryConsumerLaunchChecklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerLaunchChecklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchChecklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryConsumerLaunchChecklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchChecklistResponse.Merge(m, src)
+}
+func (m *QueryConsumerLaunchChecklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerLaunchChecklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchChecklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerLaunchChecklistResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerLaunchChecklistResponse) GetChecks() []ConsumerLaunchCheck {
+	if m != nil {
+		return m.Checks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerLaunchChecklistResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerChainsFullDumpRequest struct {
+	// whether to include the consumer chains in the deleted phase
+	IncludeDeleted bool               `protobuf:"varint,1,opt,name=include_deleted,json=includeDeleted,proto3" json:"include_deleted,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryConsumerChainsFullDumpRequest) Reset()         { *m = QueryConsumerChainsFullDumpRequest{} }
+func (m *QueryConsumerChainsFullDumpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainsFullDumpRequest) ProtoMessage()    {}
+func (*QueryConsumerChainsFullDumpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{68}
+}
+func (m *QueryConsumerChainsFullDumpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainsFullDumpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainsFullDumpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerChainsFullDumpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainsFullDumpRequest.Merge(m, src)
+}
+func (m *QueryConsumerChainsFullDumpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainsFullDumpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainsFullDumpRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerChainsFullDumpRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerChainsFullDumpRequest) GetIncludeDeleted() bool {
+	if m != nil {
+		return m.IncludeDeleted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerChainsFullDumpRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// ConsumerChainDump contains all the stored parameters of a consumer chain
+type ConsumerChainDump struct {
+	// the consumer chain as returned by the `QueryConsumerChain` query, i.e., its metadata,
+	// initialization parameters (including the spawn time), power shaping parameters, phase, and owner
+	Chain QueryConsumerChainResponse `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	// the client id (on the provider) of the consumer chain (empty if not launched)
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the CCV channel id (on the provider) of the consumer chain (empty if not established)
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the time at which the consumer chain is deleted (not set if the chain is not stopped)
+	RemovalTime *time.Time `protobuf:"bytes,4,opt,name=removal_time,json=removalTime,proto3,stdtime" json:"removal_time,omitempty"`
+	// the provider consensus addresses of the allowlisted validators
+	Allowlist []string `protobuf:"bytes,5,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// the provider consensus addresses of the denylisted validators
+	Denylist []string `protobuf:"bytes,6,rep,name=denylist,proto3" json:"denylist,omitempty"`
+}
+
+func (m *ConsumerChainDump) Reset()         { *m = ConsumerChainDump{} }
+func (m *ConsumerChainDump) String() string { return proto.CompactTextString(m) }
+func (*ConsumerChainDump) ProtoMessage()    {}
+func (*ConsumerChainDump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{69}
+}
+func (m *ConsumerChainDump) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerChainDump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerChainDump.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ConsumerChainDump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerChainDump.Merge(m, src)
+}
+func (m *ConsumerChainDump) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerChainDump) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerChainDump.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ConsumerChainDump proto.InternalMessageInfo
+
+func (m *ConsumerChainDump) GetChain() QueryConsumerChainResponse {
+	if m != nil {
+		return m.Chain
+	}
+	return QueryConsumerChainResponse{}
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
-		i--
-		dAtA[i] = 0x8
+func (m *ConsumerChainDump) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerChainDump) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerChainDump) GetRemovalTime() *time.Time {
+	if m != nil {
+		return m.RemovalTime
+	}
+	return nil
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerChainDump) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerChainDump) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerChainsFullDumpResponse struct {
+	// the dumps of the consumer chains, ordered by consumer id
+	ConsumerChains []ConsumerChainDump `protobuf:"bytes,1,rep,name=consumer_chains,json=consumerChains,proto3" json:"consumer_chains"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryConsumerChainsFullDumpResponse) Reset()         { *m = QueryConsumerChainsFullDumpResponse{} }
+func (m *QueryConsumerChainsFullDumpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerChainsFullDumpResponse) ProtoMessage()    {}
+func (*QueryConsumerChainsFullDumpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{70}
 }
-
-func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainsFullDumpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerChainsFullDumpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerChainsFullDumpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerChainsFullDumpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerChainsFullDumpResponse.Merge(m, src)
+}
+func (m *QueryConsumerChainsFullDumpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerChainsFullDumpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerChainsFullDumpResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryConsumerChainsFullDumpResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerChainsFullDumpResponse) GetConsumerChains() []ConsumerChainDump {
+	if m != nil {
+		return m.ConsumerChains
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerChainsFullDumpResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerValidatorSetAtHeightRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the provider height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PowerShapingParams != nil {
-		{
-			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.InitParams != nil {
-		{
-			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerValidatorSetAtHeightRequest) Reset() {
+	*m = QueryConsumerValidatorSetAtHeightRequest{}
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerValidatorSetAtHeightRequest) ProtoMessage()    {}
+func (*QueryConsumerValidatorSetAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{71}
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightRequest.Merge(m, src)
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryConsumerValidatorSetAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryConsumerGenesisRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *QueryConsumerGenesisResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.GenesisState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type QueryConsumerValidatorSetAtHeightValidator struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+	// The consumer public key of the validator used on the consumer chain
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// The power of the validator used on the consumer chain
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *QueryConsumerChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerValidatorSetAtHeightValidator) Reset() {
+	*m = QueryConsumerValidatorSetAtHeightValidator{}
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerValidatorSetAtHeightValidator) ProtoMessage() {}
+func (*QueryConsumerValidatorSetAtHeightValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{72}
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetAtHeightValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Phase != 0 {
-		n += 1 + sovQuery(uint64(m.Phase))
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightValidator.Merge(m, src)
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerValidatorSetAtHeightValidator proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return n
+	return nil
 }
 
-func (m *QueryConsumerChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerValidatorSetAtHeightValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type QueryConsumerValidatorSetAtHeightResponse struct {
+	// the provider height at which the validator set was computed
+	SnapshotHeight int64                                        `protobuf:"varint,1,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	Validators     []QueryConsumerValidatorSetAtHeightValidator `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) Reset() {
+	*m = QueryConsumerValidatorSetAtHeightResponse{}
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerValidatorSetAtHeightResponse) ProtoMessage() {}
+func (*QueryConsumerValidatorSetAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{73}
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorSetAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
 }
-
-func (m *Chain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Top_N != 0 {
-		n += 1 + sovQuery(uint64(m.Top_N))
-	}
-	if m.MinPowerInTop_N != 0 {
-		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
+func (m *QueryConsumerValidatorSetAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightResponse.Merge(m, src)
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorSetAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerValidatorSetAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
+	return 0
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) GetValidators() []QueryConsumerValidatorSetAtHeightValidator {
+	if m != nil {
+		return m.Validators
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryConsumerDumpRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerDumpRequest) Reset()         { *m = QueryConsumerDumpRequest{} }
+func (m *QueryConsumerDumpRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerDumpRequest) ProtoMessage()    {}
+func (*QueryConsumerDumpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{74}
+}
+func (m *QueryConsumerDumpRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerDumpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerDumpRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerDumpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerDumpRequest.Merge(m, src)
+}
+func (m *QueryConsumerDumpRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerDumpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerDumpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerDumpRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerDumpRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryConsumerDumpResponse struct {
+	State ConsumerStateExport `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+}
+
+func (m *QueryConsumerDumpResponse) Reset()         { *m = QueryConsumerDumpResponse{} }
+func (m *QueryConsumerDumpResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerDumpResponse) ProtoMessage()    {}
+func (*QueryConsumerDumpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{75}
+}
+func (m *QueryConsumerDumpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerDumpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerDumpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerDumpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerDumpResponse.Merge(m, src)
+}
+func (m *QueryConsumerDumpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerDumpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerDumpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerDumpResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerDumpResponse) GetState() ConsumerStateExport {
+	if m != nil {
+		return m.State
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.MinStake != 0 {
-		n += 1 + sovQuery(uint64(m.MinStake))
+	return ConsumerStateExport{}
+}
+
+// ConsumerStateExport contains the complete provider-side state stored for a consumer chain
+type ConsumerStateExport struct {
+	// the consumer id, chain id, owner, phase, metadata, and initialization and power-shaping parameters
+	Chain QueryConsumerChainResponse `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain"`
+	// the client id (on the provider) of the consumer chain (empty if not launched)
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the CCV channel id (on the provider) of the consumer chain (empty if not established)
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the time at which the consumer chain is deleted (not set if the chain is not stopped)
+	RemovalTime *time.Time `protobuf:"bytes,4,opt,name=removal_time,json=removalTime,proto3,stdtime" json:"removal_time,omitempty"`
+	// the time at which the last packet was received from the consumer chain (not set if none was received)
+	LastPacketReceivedTime *time.Time `protobuf:"bytes,5,opt,name=last_packet_received_time,json=lastPacketReceivedTime,proto3,stdtime" json:"last_packet_received_time,omitempty"`
+	// whether the consumer chain is dormant
+	Dormant bool `protobuf:"varint,6,opt,name=dormant,proto3" json:"dormant,omitempty"`
+	// whether the consumer chain is verified
+	Verified bool `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
+	// whether the submission of equivocation evidence is paused for the consumer chain
+	EvidenceSubmissionPaused bool `protobuf:"varint,8,opt,name=evidence_submission_paused,json=evidenceSubmissionPaused,proto3" json:"evidence_submission_paused,omitempty"`
+	// the timeout periods set after the consumer chain launched (not set if none were set)
+	TimeoutPeriods *ConsumerTimeoutPeriods `protobuf:"bytes,9,opt,name=timeout_periods,json=timeoutPeriods,proto3" json:"timeout_periods,omitempty"`
+	// the provider height at which the consumer chain was launched
+	InitChainHeight uint64 `protobuf:"varint,10,opt,name=init_chain_height,json=initChainHeight,proto3" json:"init_chain_height,omitempty"`
+	// the hash of the consumer genesis created at launch
+	GenesisHash []byte `protobuf:"bytes,11,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the minimum power required to be in the Top N of the consumer chain
+	MinimumPowerInTopN int64 `protobuf:"varint,12,opt,name=minimum_power_in_top_n,json=minimumPowerInTopN,proto3" json:"minimum_power_in_top_n,omitempty"`
+	// the minimum height of the equivocation evidence accepted for the consumer chain
+	EquivocationEvidenceMinHeight uint64 `protobuf:"varint,13,opt,name=equivocation_evidence_min_height,json=equivocationEvidenceMinHeight,proto3" json:"equivocation_evidence_min_height,omitempty"`
+	// the transfer channel on the provider on which the consumer chain sends ICS rewards
+	RewardChannelId string `protobuf:"bytes,14,opt,name=reward_channel_id,json=rewardChannelId,proto3" json:"reward_channel_id,omitempty"`
+	// the reward denoms allowlisted for the consumer chain
+	AllowlistedRewardDenoms []string `protobuf:"bytes,15,rep,name=allowlisted_reward_denoms,json=allowlistedRewardDenoms,proto3" json:"allowlisted_reward_denoms,omitempty"`
+	// the address of the provider fee pool sent to the consumer chain
+	ProviderFeePoolAddress string `protobuf:"bytes,16,opt,name=provider_fee_pool_address,json=providerFeePoolAddress,proto3" json:"provider_fee_pool_address,omitempty"`
+	// the number of VSC packets pending to be sent to the consumer chain
+	PendingVscPackets uint64 `protobuf:"varint,17,opt,name=pending_vsc_packets,json=pendingVscPackets,proto3" json:"pending_vsc_packets,omitempty"`
+	// the provider consensus addresses of the validators whose downtime slashes are pending to be acknowledged
+	SlashAcks []string `protobuf:"bytes,18,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+	// the consumer validator set, ordered by provider consensus address
+	Validators []ConsumerStateExportValidator `protobuf:"bytes,19,rep,name=validators,proto3" json:"validators"`
+	// the provider consensus addresses of the opted-in validators
+	OptedInValidators []string `protobuf:"bytes,20,rep,name=opted_in_validators,json=optedInValidators,proto3" json:"opted_in_validators,omitempty"`
+	// the key assignments of the validators, ordered by provider consensus address
+	KeyAssignments []ConsumerStateExportKeyAssignment `protobuf:"bytes,21,rep,name=key_assignments,json=keyAssignments,proto3" json:"key_assignments"`
+	// the per-consumer commission rates of the validators, ordered by provider consensus address
+	CommissionRates []ConsumerStateExportCommissionRate `protobuf:"bytes,22,rep,name=commission_rates,json=commissionRates,proto3" json:"commission_rates"`
+	// the provider consensus addresses of the allowlisted validators
+	Allowlist []string `protobuf:"bytes,23,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// the provider consensus addresses of the denylisted validators
+	Denylist []string `protobuf:"bytes,24,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	// whether the per-validator state of the deleted consumer chain is still being removed
+	CleanupPending bool `protobuf:"varint,25,opt,name=cleanup_pending,json=cleanupPending,proto3" json:"cleanup_pending,omitempty"`
+}
+
+func (m *ConsumerStateExport) Reset()         { *m = ConsumerStateExport{} }
+func (m *ConsumerStateExport) String() string { return proto.CompactTextString(m) }
+func (*ConsumerStateExport) ProtoMessage()    {}
+func (*ConsumerStateExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{76}
+}
+func (m *ConsumerStateExport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerStateExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerStateExport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.AllowInactiveVals {
-		n += 2
+}
+func (m *ConsumerStateExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerStateExport.Merge(m, src)
+}
+func (m *ConsumerStateExport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerStateExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerStateExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerStateExport proto.InternalMessageInfo
+
+func (m *ConsumerStateExport) GetChain() QueryConsumerChainResponse {
+	if m != nil {
+		return m.Chain
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return QueryConsumerChainResponse{}
+}
+
+func (m *ConsumerStateExport) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	if m.AllowlistedRewardDenoms != nil {
-		l = m.AllowlistedRewardDenoms.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ConsumerStateExport) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetRemovalTime() *time.Time {
+	if m != nil {
+		return m.RemovalTime
 	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerStateExport) GetLastPacketReceivedTime() *time.Time {
+	if m != nil {
+		return m.LastPacketReceivedTime
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerStateExport) GetDormant() bool {
+	if m != nil {
+		return m.Dormant
 	}
-	return n
+	return false
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *ConsumerStateExport) GetEvidenceSubmissionPaused() bool {
+	if m != nil {
+		return m.EvidenceSubmissionPaused
 	}
-	return n
+	return false
 }
 
-func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetTimeoutPeriods() *ConsumerTimeoutPeriods {
+	if m != nil {
+		return m.TimeoutPeriods
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerStateExport) GetInitChainHeight() uint64 {
+	if m != nil {
+		return m.InitChainHeight
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *ConsumerStateExport) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetMinimumPowerInTopN() int64 {
+	if m != nil {
+		return m.MinimumPowerInTopN
 	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *ConsumerStateExport) GetEquivocationEvidenceMinHeight() uint64 {
+	if m != nil {
+		return m.EquivocationEvidenceMinHeight
 	}
-	return n
+	return 0
 }
 
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetRewardChannelId() string {
+	if m != nil {
+		return m.RewardChannelId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetAllowlistedRewardDenoms() []string {
+	if m != nil {
+		return m.AllowlistedRewardDenoms
 	}
-	var l int
-	_ = l
-	if m.SlashMeter != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeter))
+	return nil
+}
+
+func (m *ConsumerStateExport) GetProviderFeePoolAddress() string {
+	if m != nil {
+		return m.ProviderFeePoolAddress
 	}
-	if m.SlashMeterAllowance != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	return ""
+}
+
+func (m *ConsumerStateExport) GetPendingVscPackets() uint64 {
+	if m != nil {
+		return m.PendingVscPackets
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetSlashAcks() []string {
+	if m != nil {
+		return m.SlashAcks
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetValidators() []ConsumerStateExportValidator {
+	if m != nil {
+		return m.Validators
 	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return nil
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConsumerStateExport) GetOptedInValidators() []string {
+	if m != nil {
+		return m.OptedInValidators
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for _, e := range m.PairValConAddr {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *ConsumerStateExport) GetKeyAssignments() []ConsumerStateExportKeyAssignment {
+	if m != nil {
+		return m.KeyAssignments
 	}
-	return n
+	return nil
 }
 
-func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConsumerStateExport) GetCommissionRates() []ConsumerStateExportCommissionRate {
+	if m != nil {
+		return m.CommissionRates
 	}
-	return n
+	return nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExport) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConsumerStateExport) GetCleanupPending() bool {
+	if m != nil {
+		return m.CleanupPending
 	}
-	return n
+	return false
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for _, s := range m.ValidatorsProviderAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+type ConsumerStateExportValidator struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consumer public key of the validator used on the consumer chain
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// the power of the validator used on the consumer chain
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// the height at which the validator became a consumer validator
+	JoinHeight int64 `protobuf:"varint,4,opt,name=join_height,json=joinHeight,proto3" json:"join_height,omitempty"`
+}
+
+func (m *ConsumerStateExportValidator) Reset()         { *m = ConsumerStateExportValidator{} }
+func (m *ConsumerStateExportValidator) String() string { return proto.CompactTextString(m) }
+func (*ConsumerStateExportValidator) ProtoMessage()    {}
+func (*ConsumerStateExportValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{77}
+}
+func (m *ConsumerStateExportValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerStateExportValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerStateExportValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerStateExportValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerStateExportValidator.Merge(m, src)
+}
+func (m *ConsumerStateExportValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerStateExportValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerStateExportValidator.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ConsumerStateExportValidator proto.InternalMessageInfo
+
+func (m *ConsumerStateExportValidator) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerValidatorsValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovQuery(uint64(m.Power))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ConsumerPower != 0 {
-		n += 1 + sovQuery(uint64(m.ConsumerPower))
-	}
-	l = m.ConsumerCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ProviderCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProviderOperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = m.ProviderTokens.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ProviderPower != 0 {
-		n += 1 + sovQuery(uint64(m.ProviderPower))
-	}
-	if m.ValidatesCurrentEpoch {
-		n += 2
+func (m *ConsumerStateExportValidator) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return n
+	return nil
 }
 
-func (m *QueryConsumerValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *ConsumerStateExportValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return n
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ConsumerStateExportValidator) GetJoinHeight() int64 {
+	if m != nil {
+		return m.JoinHeight
 	}
-	return n
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for _, s := range m.ConsumerIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+type ConsumerStateExportKeyAssignment struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consensus address of the validator on the consumer chain
+	ConsumerAddress string `protobuf:"bytes,2,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the assigned consumer public key of the validator
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+}
+
+func (m *ConsumerStateExportKeyAssignment) Reset()         { *m = ConsumerStateExportKeyAssignment{} }
+func (m *ConsumerStateExportKeyAssignment) String() string { return proto.CompactTextString(m) }
+func (*ConsumerStateExportKeyAssignment) ProtoMessage()    {}
+func (*ConsumerStateExportKeyAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{78}
+}
+func (m *ConsumerStateExportKeyAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerStateExportKeyAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerStateExportKeyAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerStateExportKeyAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerStateExportKeyAssignment.Merge(m, src)
+}
+func (m *ConsumerStateExportKeyAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerStateExportKeyAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerStateExportKeyAssignment.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ConsumerStateExportKeyAssignment proto.InternalMessageInfo
+
+func (m *ConsumerStateExportKeyAssignment) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ConsumerStateExportKeyAssignment) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerStateExportKeyAssignment) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type ConsumerStateExportCommissionRate struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the rate to charge delegators on the consumer chain, as a fraction
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+func (m *ConsumerStateExportCommissionRate) Reset()         { *m = ConsumerStateExportCommissionRate{} }
+func (m *ConsumerStateExportCommissionRate) String() string { return proto.CompactTextString(m) }
+func (*ConsumerStateExportCommissionRate) ProtoMessage()    {}
+func (*ConsumerStateExportCommissionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{79}
+}
+func (m *ConsumerStateExportCommissionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerStateExportCommissionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerStateExportCommissionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ConsumerStateExportCommissionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerStateExportCommissionRate.Merge(m, src)
+}
+func (m *ConsumerStateExportCommissionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerStateExportCommissionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerStateExportCommissionRate.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ConsumerStateExportCommissionRate proto.InternalMessageInfo
+
+func (m *ConsumerStateExportCommissionRate) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryRewardAttributionLogRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryRewardAttributionLogRequest) Reset()         { *m = QueryRewardAttributionLogRequest{} }
+func (m *QueryRewardAttributionLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardAttributionLogRequest) ProtoMessage()    {}
+func (*QueryRewardAttributionLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{80}
+}
+func (m *QueryRewardAttributionLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardAttributionLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardAttributionLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRewardAttributionLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardAttributionLogRequest.Merge(m, src)
+}
+func (m *QueryRewardAttributionLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardAttributionLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardAttributionLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardAttributionLogRequest proto.InternalMessageInfo
+
+func (m *QueryRewardAttributionLogRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryRewardAttributionLogResponse struct {
+	// the last reward attribution records of the consumer chain, from the oldest to the newest
+	Records []RewardAttributionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryRewardAttributionLogResponse) Reset()         { *m = QueryRewardAttributionLogResponse{} }
+func (m *QueryRewardAttributionLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardAttributionLogResponse) ProtoMessage()    {}
+func (*QueryRewardAttributionLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{81}
+}
+func (m *QueryRewardAttributionLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardAttributionLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardAttributionLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryRewardAttributionLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardAttributionLogResponse.Merge(m, src)
+}
+func (m *QueryRewardAttributionLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardAttributionLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardAttributionLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardAttributionLogResponse proto.InternalMessageInfo
+
+func (m *QueryRewardAttributionLogResponse) GetRecords() []RewardAttributionRecord {
+	if m != nil {
+		return m.Records
 	}
-	return n
+	return nil
 }
 
-func (m *QueryConsumerChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryConsumerClientStatusRequest struct {
+}
+
+func (m *QueryConsumerClientStatusRequest) Reset()         { *m = QueryConsumerClientStatusRequest{} }
+func (m *QueryConsumerClientStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientStatusRequest) ProtoMessage()    {}
+func (*QueryConsumerClientStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{82}
+}
+func (m *QueryConsumerClientStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerClientStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientStatusRequest.Merge(m, src)
+}
+func (m *QueryConsumerClientStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerClientStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerClientStatusRequest proto.InternalMessageInfo
+
+type QueryConsumerClientStatusResponse struct {
+	// the status of the clients to the launched consumer chains
+	Clients []ConsumerClientExpiry `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients"`
+}
+
+func (m *QueryConsumerClientStatusResponse) Reset()         { *m = QueryConsumerClientStatusResponse{} }
+func (m *QueryConsumerClientStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientStatusResponse) ProtoMessage()    {}
+func (*QueryConsumerClientStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{83}
+}
+func (m *QueryConsumerClientStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerClientStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientStatusResponse.Merge(m, src)
+}
+func (m *QueryConsumerClientStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerClientStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerClientStatusResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerClientStatusResponse) GetClients() []ConsumerClientExpiry {
+	if m != nil {
+		return m.Clients
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryTimeQueueRequest struct {
+	// the key prefix of the time queue
+	Prefix uint32 `protobuf:"varint,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *QueryTimeQueueRequest) Reset()         { *m = QueryTimeQueueRequest{} }
+func (m *QueryTimeQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeQueueRequest) ProtoMessage()    {}
+func (*QueryTimeQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{84}
+}
+func (m *QueryTimeQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTimeQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeQueueRequest.Merge(m, src)
+}
+func (m *QueryTimeQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeQueueRequest proto.InternalMessageInfo
+
+func (m *QueryTimeQueueRequest) GetPrefix() uint32 {
+	if m != nil {
+		return m.Prefix
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.InitParams != nil {
-		l = m.InitParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type QueryTimeQueueResponse struct {
+	// the entries of the time queue in chronological order
+	Entries []TimeQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryTimeQueueResponse) Reset()         { *m = QueryTimeQueueResponse{} }
+func (m *QueryTimeQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeQueueResponse) ProtoMessage()    {}
+func (*QueryTimeQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{85}
+}
+func (m *QueryTimeQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.PowerShapingParams != nil {
-		l = m.PowerShapingParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTimeQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeQueueResponse.Merge(m, src)
+}
+func (m *QueryTimeQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTimeQueueResponse proto.InternalMessageInfo
+
+func (m *QueryTimeQueueResponse) GetEntries() []TimeQueueEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// TimeQueueEntry contains the consumer ids stored under a time of a time queue
+type TimeQueueEntry struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// the consumer ids in the order in which they were appended
+	ConsumerIds []string `protobuf:"bytes,2,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *TimeQueueEntry) Reset()         { *m = TimeQueueEntry{} }
+func (m *TimeQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*TimeQueueEntry) ProtoMessage()    {}
+func (*TimeQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{86}
 }
-func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+func (m *TimeQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeQueueEntry.Merge(m, src)
+}
+func (m *TimeQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeQueueEntry proto.InternalMessageInfo
+
+func (m *TimeQueueEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TimeQueueEntry) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+type QueryConsumerGenesisBatchRequest struct {
+	// the consumer ids of the consumer chains (at most 20)
+	ConsumerIds []string `protobuf:"bytes,1,rep,name=consumer_ids,json=consumerIds,proto3" json:"consumer_ids,omitempty"`
+}
+
+func (m *QueryConsumerGenesisBatchRequest) Reset()         { *m = QueryConsumerGenesisBatchRequest{} }
+func (m *QueryConsumerGenesisBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerGenesisBatchRequest) ProtoMessage()    {}
+func (*QueryConsumerGenesisBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{87}
+}
+func (m *QueryConsumerGenesisBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerGenesisBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerGenesisBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerGenesisBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerGenesisBatchRequest.Merge(m, src)
+}
+func (m *QueryConsumerGenesisBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerGenesisBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerGenesisBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerGenesisBatchRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerGenesisBatchRequest) GetConsumerIds() []string {
+	if m != nil {
+		return m.ConsumerIds
+	}
+	return nil
+}
+
+type QueryConsumerGenesisBatchResponse struct {
+	// the entries in the order of the requested consumer ids
+	Entries []ConsumerGenesisEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryConsumerGenesisBatchResponse) Reset()         { *m = QueryConsumerGenesisBatchResponse{} }
+func (m *QueryConsumerGenesisBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerGenesisBatchResponse) ProtoMessage()    {}
+func (*QueryConsumerGenesisBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{88}
+}
+func (m *QueryConsumerGenesisBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerGenesisBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerGenesisBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerGenesisBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerGenesisBatchResponse.Merge(m, src)
+}
+func (m *QueryConsumerGenesisBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerGenesisBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerGenesisBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerGenesisBatchResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerGenesisBatchResponse) GetEntries() []ConsumerGenesisEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// ConsumerGenesisEntry contains the genesis state of a consumer chain
+// (or the reason why the genesis state is not available)
+type ConsumerGenesisEntry struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// empty if the consumer id is unknown
+	ChainId string        `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Phase   ConsumerPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the genesis state created at launch (nil if the consumer chain has no genesis state yet)
+	GenesisState *types.ConsumerGenesisState `protobuf:"bytes,4,opt,name=genesis_state,json=genesisState,proto3" json:"genesis_state,omitempty"`
+	// the hex-encoded SHA-256 hash of the consumer genesis created at launch
+	GenesisHash string `protobuf:"bytes,5,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the reason why the genesis state is not available (empty if it is available)
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ConsumerGenesisEntry) Reset()         { *m = ConsumerGenesisEntry{} }
+func (m *ConsumerGenesisEntry) String() string { return proto.CompactTextString(m) }
+func (*ConsumerGenesisEntry) ProtoMessage()    {}
+func (*ConsumerGenesisEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{89}
+}
+func (m *ConsumerGenesisEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerGenesisEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerGenesisEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerGenesisEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerGenesisEntry.Merge(m, src)
+}
+func (m *ConsumerGenesisEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerGenesisEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerGenesisEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerGenesisEntry proto.InternalMessageInfo
+
+func (m *ConsumerGenesisEntry) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerGenesisEntry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsumerGenesisEntry) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+func (m *ConsumerGenesisEntry) GetGenesisState() *types.ConsumerGenesisState {
+	if m != nil {
+		return m.GenesisState
+	}
+	return nil
+}
+
+func (m *ConsumerGenesisEntry) GetGenesisHash() string {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return ""
+}
+
+func (m *ConsumerGenesisEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type QuerySkippedDowntimeSlashesRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QuerySkippedDowntimeSlashesRequest) Reset()         { *m = QuerySkippedDowntimeSlashesRequest{} }
+func (m *QuerySkippedDowntimeSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedDowntimeSlashesRequest) ProtoMessage()    {}
+func (*QuerySkippedDowntimeSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{90}
+}
+func (m *QuerySkippedDowntimeSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedDowntimeSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedDowntimeSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkippedDowntimeSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedDowntimeSlashesRequest.Merge(m, src)
+}
+func (m *QuerySkippedDowntimeSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedDowntimeSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedDowntimeSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedDowntimeSlashesRequest proto.InternalMessageInfo
+
+func (m *QuerySkippedDowntimeSlashesRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QuerySkippedDowntimeSlashesResponse struct {
+	// the skipped downtime slashes of the consumer chain, from the oldest to the newest
+	SkippedSlashes []SkippedDowntimeSlash `protobuf:"bytes,1,rep,name=skipped_slashes,json=skippedSlashes,proto3" json:"skipped_slashes"`
+}
+
+func (m *QuerySkippedDowntimeSlashesResponse) Reset()         { *m = QuerySkippedDowntimeSlashesResponse{} }
+func (m *QuerySkippedDowntimeSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedDowntimeSlashesResponse) ProtoMessage()    {}
+func (*QuerySkippedDowntimeSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{91}
+}
+func (m *QuerySkippedDowntimeSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedDowntimeSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedDowntimeSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkippedDowntimeSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedDowntimeSlashesResponse.Merge(m, src)
+}
+func (m *QuerySkippedDowntimeSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedDowntimeSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedDowntimeSlashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedDowntimeSlashesResponse proto.InternalMessageInfo
+
+func (m *QuerySkippedDowntimeSlashesResponse) GetSkippedSlashes() []SkippedDowntimeSlash {
+	if m != nil {
+		return m.SkippedSlashes
+	}
+	return nil
+}
+
+type QueryUnroutableSlashPacketsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryUnroutableSlashPacketsRequest) Reset()         { *m = QueryUnroutableSlashPacketsRequest{} }
+func (m *QueryUnroutableSlashPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnroutableSlashPacketsRequest) ProtoMessage()    {}
+func (*QueryUnroutableSlashPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{92}
+}
+func (m *QueryUnroutableSlashPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnroutableSlashPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnroutableSlashPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnroutableSlashPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnroutableSlashPacketsRequest.Merge(m, src)
+}
+func (m *QueryUnroutableSlashPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnroutableSlashPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnroutableSlashPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnroutableSlashPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryUnroutableSlashPacketsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryUnroutableSlashPacketsResponse struct {
+	// the last unroutable slash packets of the consumer chain, from the oldest to the newest
+	SlashPackets []UnroutableSlashPacket `protobuf:"bytes,1,rep,name=slash_packets,json=slashPackets,proto3" json:"slash_packets"`
+}
+
+func (m *QueryUnroutableSlashPacketsResponse) Reset()         { *m = QueryUnroutableSlashPacketsResponse{} }
+func (m *QueryUnroutableSlashPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnroutableSlashPacketsResponse) ProtoMessage()    {}
+func (*QueryUnroutableSlashPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{93}
+}
+func (m *QueryUnroutableSlashPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnroutableSlashPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnroutableSlashPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnroutableSlashPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnroutableSlashPacketsResponse.Merge(m, src)
+}
+func (m *QueryUnroutableSlashPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnroutableSlashPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnroutableSlashPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnroutableSlashPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryUnroutableSlashPacketsResponse) GetSlashPackets() []UnroutableSlashPacket {
+	if m != nil {
+		return m.SlashPackets
+	}
+	return nil
+}
+
+type QueryPreOptInInfractionsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryPreOptInInfractionsRequest) Reset()         { *m = QueryPreOptInInfractionsRequest{} }
+func (m *QueryPreOptInInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPreOptInInfractionsRequest) ProtoMessage()    {}
+func (*QueryPreOptInInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{94}
+}
+func (m *QueryPreOptInInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreOptInInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreOptInInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreOptInInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreOptInInfractionsRequest.Merge(m, src)
+}
+func (m *QueryPreOptInInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreOptInInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreOptInInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreOptInInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryPreOptInInfractionsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryPreOptInInfractionsResponse struct {
+	// the dropped pre-opt-in infractions of the consumer chain, from the oldest to the newest
+	Infractions []PreOptInInfraction `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+}
+
+func (m *QueryPreOptInInfractionsResponse) Reset()         { *m = QueryPreOptInInfractionsResponse{} }
+func (m *QueryPreOptInInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPreOptInInfractionsResponse) ProtoMessage()    {}
+func (*QueryPreOptInInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{95}
+}
+func (m *QueryPreOptInInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPreOptInInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPreOptInInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPreOptInInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPreOptInInfractionsResponse.Merge(m, src)
+}
+func (m *QueryPreOptInInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPreOptInInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPreOptInInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPreOptInInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryPreOptInInfractionsResponse) GetInfractions() []PreOptInInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+type QueryTopNThresholdRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryTopNThresholdRequest) Reset()         { *m = QueryTopNThresholdRequest{} }
+func (m *QueryTopNThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTopNThresholdRequest) ProtoMessage()    {}
+func (*QueryTopNThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{96}
+}
+func (m *QueryTopNThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopNThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopNThresholdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopNThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopNThresholdRequest.Merge(m, src)
+}
+func (m *QueryTopNThresholdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopNThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopNThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopNThresholdRequest proto.InternalMessageInfo
+
+func (m *QueryTopNThresholdRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+type QueryTopNThresholdResponse struct {
+	// whether the threshold applies to the consumer chain, i.e., whether it is a Top N chain that is not stopped;
+	// if false, the other fields (except `not_applicable_reason`) are empty
+	Applicable bool `protobuf:"varint,1,opt,name=applicable,proto3" json:"applicable,omitempty"`
+	// the reason why the threshold does not apply to the consumer chain (empty if it applies)
+	NotApplicableReason string `protobuf:"bytes,2,opt,name=not_applicable_reason,json=notApplicableReason,proto3" json:"not_applicable_reason,omitempty"`
+	// the Top N of the consumer chain
+	TopN uint32 `protobuf:"varint,3,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// the minimum power an active validator needs to be opted in automatically to the consumer chain
+	MinPower int64 `protobuf:"varint,4,opt,name=min_power,json=minPower,proto3" json:"min_power,omitempty"`
+	// the bonded tokens equivalent to `min_power`, i.e., `min_power` multiplied by the power reduction
+	MinTokens cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_tokens,json=minTokens,proto3,customtype=cosmossdk.io/math.Int" json:"min_tokens"`
+	// the number of active validators with at least `min_power`, i.e., the active validators
+	// with a rank (starting from 1) up to `rank_cutoff` are opted in automatically
+	RankCutoff uint32 `protobuf:"varint,6,opt,name=rank_cutoff,json=rankCutoff,proto3" json:"rank_cutoff,omitempty"`
+	// the number of active validators
+	NumActiveValidators uint32 `protobuf:"varint,7,opt,name=num_active_validators,json=numActiveValidators,proto3" json:"num_active_validators,omitempty"`
+	// the active validators at the boundary, i.e., with exactly `min_power`; note that
+	// validators with equal powers share the boundary and are all opted in automatically
+	BoundaryValidators []TopNBoundaryValidator `protobuf:"bytes,8,rep,name=boundary_validators,json=boundaryValidators,proto3" json:"boundary_validators"`
+}
+
+func (m *QueryTopNThresholdResponse) Reset()         { *m = QueryTopNThresholdResponse{} }
+func (m *QueryTopNThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTopNThresholdResponse) ProtoMessage()    {}
+func (*QueryTopNThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{97}
+}
+func (m *QueryTopNThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTopNThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTopNThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTopNThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTopNThresholdResponse.Merge(m, src)
+}
+func (m *QueryTopNThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTopNThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTopNThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTopNThresholdResponse proto.InternalMessageInfo
+
+func (m *QueryTopNThresholdResponse) GetApplicable() bool {
+	if m != nil {
+		return m.Applicable
+	}
+	return false
+}
+
+func (m *QueryTopNThresholdResponse) GetNotApplicableReason() string {
+	if m != nil {
+		return m.NotApplicableReason
+	}
+	return ""
+}
+
+func (m *QueryTopNThresholdResponse) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+func (m *QueryTopNThresholdResponse) GetMinPower() int64 {
+	if m != nil {
+		return m.MinPower
+	}
+	return 0
+}
+
+func (m *QueryTopNThresholdResponse) GetRankCutoff() uint32 {
+	if m != nil {
+		return m.RankCutoff
+	}
+	return 0
+}
+
+func (m *QueryTopNThresholdResponse) GetNumActiveValidators() uint32 {
+	if m != nil {
+		return m.NumActiveValidators
+	}
+	return 0
+}
+
+func (m *QueryTopNThresholdResponse) GetBoundaryValidators() []TopNBoundaryValidator {
+	if m != nil {
+		return m.BoundaryValidators
+	}
+	return nil
+}
+
+// TopNBoundaryValidator is an active validator at the boundary of the Top N of a consumer chain
+type TopNBoundaryValidator struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// the rank (starting from 1) of the validator among the active validators
+	Rank  uint32 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Power int64  `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *TopNBoundaryValidator) Reset()         { *m = TopNBoundaryValidator{} }
+func (m *TopNBoundaryValidator) String() string { return proto.CompactTextString(m) }
+func (*TopNBoundaryValidator) ProtoMessage()    {}
+func (*TopNBoundaryValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{98}
+}
+func (m *TopNBoundaryValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopNBoundaryValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopNBoundaryValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopNBoundaryValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopNBoundaryValidator.Merge(m, src)
+}
+func (m *TopNBoundaryValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopNBoundaryValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopNBoundaryValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopNBoundaryValidator proto.InternalMessageInfo
+
+func (m *TopNBoundaryValidator) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
+}
+
+func (m *TopNBoundaryValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *TopNBoundaryValidator) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *TopNBoundaryValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type QueryConsumerEconomicSecurityRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the first provider height of the returned records (optional, zero means no lower bound)
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the last provider height of the returned records (optional, zero means no upper bound)
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) Reset()         { *m = QueryConsumerEconomicSecurityRequest{} }
+func (m *QueryConsumerEconomicSecurityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerEconomicSecurityRequest) ProtoMessage()    {}
+func (*QueryConsumerEconomicSecurityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{99}
+}
+func (m *QueryConsumerEconomicSecurityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerEconomicSecurityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerEconomicSecurityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerEconomicSecurityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerEconomicSecurityRequest.Merge(m, src)
+}
+func (m *QueryConsumerEconomicSecurityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerEconomicSecurityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerEconomicSecurityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerEconomicSecurityRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerEconomicSecurityRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+type QueryConsumerEconomicSecurityResponse struct {
+	// the economic security records of the consumer chain within the height range, from the oldest to the newest
+	Records []ConsumerEconomicSecurity `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryConsumerEconomicSecurityResponse) Reset()         { *m = QueryConsumerEconomicSecurityResponse{} }
+func (m *QueryConsumerEconomicSecurityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerEconomicSecurityResponse) ProtoMessage()    {}
+func (*QueryConsumerEconomicSecurityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{100}
+}
+func (m *QueryConsumerEconomicSecurityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerEconomicSecurityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerEconomicSecurityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerEconomicSecurityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerEconomicSecurityResponse.Merge(m, src)
+}
+func (m *QueryConsumerEconomicSecurityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerEconomicSecurityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerEconomicSecurityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerEconomicSecurityResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerEconomicSecurityResponse) GetRecords() []ConsumerEconomicSecurity {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type QueryNextConsumerIdRequest struct {
+}
+
+func (m *QueryNextConsumerIdRequest) Reset()         { *m = QueryNextConsumerIdRequest{} }
+func (m *QueryNextConsumerIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextConsumerIdRequest) ProtoMessage()    {}
+func (*QueryNextConsumerIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{101}
+}
+func (m *QueryNextConsumerIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextConsumerIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextConsumerIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextConsumerIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextConsumerIdRequest.Merge(m, src)
+}
+func (m *QueryNextConsumerIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextConsumerIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextConsumerIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextConsumerIdRequest proto.InternalMessageInfo
+
+type QueryNextConsumerIdResponse struct {
+	// the consumer id assigned to the next created consumer chain, i.e., the current value of the consumer id counter
+	NextConsumerId string `protobuf:"bytes,1,opt,name=next_consumer_id,json=nextConsumerId,proto3" json:"next_consumer_id,omitempty"`
+}
+
+func (m *QueryNextConsumerIdResponse) Reset()         { *m = QueryNextConsumerIdResponse{} }
+func (m *QueryNextConsumerIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextConsumerIdResponse) ProtoMessage()    {}
+func (*QueryNextConsumerIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{102}
+}
+func (m *QueryNextConsumerIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextConsumerIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextConsumerIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextConsumerIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextConsumerIdResponse.Merge(m, src)
+}
+func (m *QueryNextConsumerIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextConsumerIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextConsumerIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextConsumerIdResponse proto.InternalMessageInfo
+
+func (m *QueryNextConsumerIdResponse) GetNextConsumerId() string {
+	if m != nil {
+		return m.NextConsumerId
+	}
+	return ""
+}
+
+type QuerySimulateCreateConsumerRequest struct {
+	// the message to simulate
+	Msg *MsgCreateConsumer `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// must be set, as a reminder that the simulation never creates the consumer chain
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *QuerySimulateCreateConsumerRequest) Reset()         { *m = QuerySimulateCreateConsumerRequest{} }
+func (m *QuerySimulateCreateConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCreateConsumerRequest) ProtoMessage()    {}
+func (*QuerySimulateCreateConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{103}
+}
+func (m *QuerySimulateCreateConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCreateConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCreateConsumerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCreateConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCreateConsumerRequest.Merge(m, src)
+}
+func (m *QuerySimulateCreateConsumerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCreateConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCreateConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCreateConsumerRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateCreateConsumerRequest) GetMsg() *MsgCreateConsumer {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QuerySimulateCreateConsumerRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type QuerySimulateCreateConsumerResponse struct {
+	// the consumer id that would be assigned to the consumer chain; note that this is only a prediction,
+	// i.e., it is assigned to another consumer chain if another creation lands first
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the value of the consumer id counter at the time of the simulation; the prediction only holds
+	// as long as the counter (see QueryNextConsumerId) still has this value
+	ConsumerIdCounter uint64 `protobuf:"varint,2,opt,name=consumer_id_counter,json=consumerIdCounter,proto3" json:"consumer_id_counter,omitempty"`
+	// the phase of the consumer chain after its creation, i.e., registered or initialized
+	Phase ConsumerPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the initialization parameters of the consumer chain, with the defaults set for the ones not provided
+	InitializationParameters ConsumerInitializationParameters `protobuf:"bytes,4,opt,name=initialization_parameters,json=initializationParameters,proto3" json:"initialization_parameters"`
+	// the power-shaping parameters of the consumer chain
+	PowerShapingParameters PowerShapingParameters `protobuf:"bytes,5,opt,name=power_shaping_parameters,json=powerShapingParameters,proto3" json:"power_shaping_parameters"`
+	// the allowlisted reward denoms of the consumer chain
+	AllowlistedRewardDenoms []string `protobuf:"bytes,6,rep,name=allowlisted_reward_denoms,json=allowlistedRewardDenoms,proto3" json:"allowlisted_reward_denoms,omitempty"`
+	// the requirements the consumer chain would not meet yet to launch (see QueryConsumerLaunchChecklist)
+	Warnings []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (m *QuerySimulateCreateConsumerResponse) Reset()         { *m = QuerySimulateCreateConsumerResponse{} }
+func (m *QuerySimulateCreateConsumerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateCreateConsumerResponse) ProtoMessage()    {}
+func (*QuerySimulateCreateConsumerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{104}
+}
+func (m *QuerySimulateCreateConsumerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateCreateConsumerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateCreateConsumerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateCreateConsumerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateCreateConsumerResponse.Merge(m, src)
+}
+func (m *QuerySimulateCreateConsumerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateCreateConsumerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateCreateConsumerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateCreateConsumerResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateCreateConsumerResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetConsumerIdCounter() uint64 {
+	if m != nil {
+		return m.ConsumerIdCounter
+	}
+	return 0
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetInitializationParameters() ConsumerInitializationParameters {
+	if m != nil {
+		return m.InitializationParameters
+	}
+	return ConsumerInitializationParameters{}
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetPowerShapingParameters() PowerShapingParameters {
+	if m != nil {
+		return m.PowerShapingParameters
+	}
+	return PowerShapingParameters{}
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetAllowlistedRewardDenoms() []string {
+	if m != nil {
+		return m.AllowlistedRewardDenoms
+	}
+	return nil
+}
+
+func (m *QuerySimulateCreateConsumerResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type QueryPendingVSCPacketsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the pagination of the pending VSC packets; at most 100 packets are returned per page
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingVSCPacketsRequest) Reset()         { *m = QueryPendingVSCPacketsRequest{} }
+func (m *QueryPendingVSCPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVSCPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingVSCPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{105}
+}
+func (m *QueryPendingVSCPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVSCPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVSCPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingVSCPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVSCPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingVSCPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVSCPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVSCPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingVSCPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingVSCPacketsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *QueryPendingVSCPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingVSCPacket is a VSC packet queued on the provider to be sent to a consumer chain,
+// with its validator updates in a readable form
+type PendingVSCPacket struct {
+	// the valset update id of the VSC packet
+	VscId uint64 `protobuf:"varint,1,opt,name=vsc_id,json=vscId,proto3" json:"vsc_id,omitempty"`
+	// the validator updates of the VSC packet
+	ValidatorUpdates []PendingValidatorUpdate `protobuf:"bytes,2,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	// the consumer consensus addresses of the validators whose slashing is acknowledged
+	SlashAcks []string `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+	// the provider height at which the VSC packet was queued (zero if not known)
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// the provider fee pool address carried by the VSC packet (empty if not updated)
+	ProviderFeePoolAddr string `protobuf:"bytes,5,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
+	// the provider upgrade notice carried by the VSC packet (not set if not updated)
+	ProviderUpgradeNotice *types.ProviderUpgradeNotice `protobuf:"bytes,6,opt,name=provider_upgrade_notice,json=providerUpgradeNotice,proto3" json:"provider_upgrade_notice,omitempty"`
+}
+
+func (m *PendingVSCPacket) Reset()         { *m = PendingVSCPacket{} }
+func (m *PendingVSCPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingVSCPacket) ProtoMessage()    {}
+func (*PendingVSCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{106}
+}
+func (m *PendingVSCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingVSCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingVSCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingVSCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVSCPacket.Merge(m, src)
+}
+func (m *PendingVSCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingVSCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVSCPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVSCPacket proto.InternalMessageInfo
+
+func (m *PendingVSCPacket) GetVscId() uint64 {
+	if m != nil {
+		return m.VscId
+	}
+	return 0
+}
+
+func (m *PendingVSCPacket) GetValidatorUpdates() []PendingValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *PendingVSCPacket) GetSlashAcks() []string {
+	if m != nil {
+		return m.SlashAcks
+	}
+	return nil
+}
+
+func (m *PendingVSCPacket) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *PendingVSCPacket) GetProviderFeePoolAddr() string {
+	if m != nil {
+		return m.ProviderFeePoolAddr
+	}
+	return ""
+}
+
+func (m *PendingVSCPacket) GetProviderUpgradeNotice() *types.ProviderUpgradeNotice {
+	if m != nil {
+		return m.ProviderUpgradeNotice
+	}
+	return nil
+}
+
+// PendingValidatorUpdate is a validator update of a pending VSC packet
+type PendingValidatorUpdate struct {
+	// the consumer consensus address of the validator
+	ConsumerAddress string `protobuf:"bytes,1,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the power of the validator on the consumer chain; zero if the validator is removed
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *PendingValidatorUpdate) Reset()         { *m = PendingValidatorUpdate{} }
+func (m *PendingValidatorUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingValidatorUpdate) ProtoMessage()    {}
+func (*PendingValidatorUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{107}
+}
+func (m *PendingValidatorUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidatorUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingValidatorUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingValidatorUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidatorUpdate.Merge(m, src)
+}
+func (m *PendingValidatorUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidatorUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidatorUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidatorUpdate proto.InternalMessageInfo
+
+func (m *PendingValidatorUpdate) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
+	}
+	return ""
+}
+
+func (m *PendingValidatorUpdate) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type QueryPendingVSCPacketsResponse struct {
+	// the pending VSC packets of the consumer chain, in the order in which they are sent
+	Packets    []PendingVSCPacket  `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingVSCPacketsResponse) Reset()         { *m = QueryPendingVSCPacketsResponse{} }
+func (m *QueryPendingVSCPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVSCPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingVSCPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{108}
+}
+func (m *QueryPendingVSCPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVSCPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVSCPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingVSCPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVSCPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingVSCPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVSCPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVSCPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingVSCPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingVSCPacketsResponse) GetPackets() []PendingVSCPacket {
+	if m != nil {
+		return m.Packets
+	}
+	return nil
+}
+
+func (m *QueryPendingVSCPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
+	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
+	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
+	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
+	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
+	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
+	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
+	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
+	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
+	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsValidator")
+	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
+	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
+	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
+	proto.RegisterType((*QueryValidatorConsumerAvailabilityRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAvailabilityRequest")
+	proto.RegisterType((*QueryValidatorConsumerAvailabilityResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAvailabilityResponse")
+	proto.RegisterType((*QueryEmergencyOptOutCooldownRequest)(nil), "interchain_security.ccv.provider.v1.QueryEmergencyOptOutCooldownRequest")
+	proto.RegisterType((*QueryEmergencyOptOutCooldownResponse)(nil), "interchain_security.ccv.provider.v1.QueryEmergencyOptOutCooldownResponse")
+	proto.RegisterType((*QueryReOptInCooldownRequest)(nil), "interchain_security.ccv.provider.v1.QueryReOptInCooldownRequest")
+	proto.RegisterType((*QueryReOptInCooldownResponse)(nil), "interchain_security.ccv.provider.v1.QueryReOptInCooldownResponse")
+	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
+	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
+	proto.RegisterType((*QueryNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryNextEpochRequest")
+	proto.RegisterType((*QueryNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryNextEpochResponse")
+	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
+	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
+	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
+	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
+	proto.RegisterType((*QueryValidatorProviderExposureRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderExposureRequest")
+	proto.RegisterType((*QueryValidatorProviderExposureResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderExposureResponse")
+	proto.RegisterType((*ValidatorConsumerExposure)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerExposure")
+	proto.RegisterType((*QueryConsumerTopologyRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerTopologyRequest")
+	proto.RegisterType((*QueryConsumerTopologyResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerTopologyResponse")
+	proto.RegisterType((*ConsumerTopology)(nil), "interchain_security.ccv.provider.v1.ConsumerTopology")
+	proto.RegisterType((*QueryVscIdToHeightRequest)(nil), "interchain_security.ccv.provider.v1.QueryVscIdToHeightRequest")
+	proto.RegisterType((*QueryVscIdToHeightResponse)(nil), "interchain_security.ccv.provider.v1.QueryVscIdToHeightResponse")
+	proto.RegisterType((*QueryConsumerRewardChannelRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardChannelRequest")
+	proto.RegisterType((*QueryConsumerRewardChannelResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardChannelResponse")
+	proto.RegisterType((*QueryConsumerEndpointsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerEndpointsRequest")
+	proto.RegisterType((*QueryConsumerEndpointsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerEndpointsResponse")
+	proto.RegisterType((*QuerySecurityOverviewRequest)(nil), "interchain_security.ccv.provider.v1.QuerySecurityOverviewRequest")
+	proto.RegisterType((*QuerySecurityOverviewResponse)(nil), "interchain_security.ccv.provider.v1.QuerySecurityOverviewResponse")
+	proto.RegisterType((*ConsumerSecurityOverview)(nil), "interchain_security.ccv.provider.v1.ConsumerSecurityOverview")
+	proto.RegisterType((*ValidatorSecurityOverview)(nil), "interchain_security.ccv.provider.v1.ValidatorSecurityOverview")
+	proto.RegisterType((*QueryConsumersByOwnerRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByOwnerRequest")
+	proto.RegisterType((*QueryConsumersByOwnerResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumersByOwnerResponse")
+	proto.RegisterType((*QueryConsumerChainIdConflictsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainIdConflictsRequest")
+	proto.RegisterType((*QueryConsumerChainIdConflictsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainIdConflictsResponse")
+	proto.RegisterType((*ConsumerChainIdConflict)(nil), "interchain_security.ccv.provider.v1.ConsumerChainIdConflict")
+	proto.RegisterType((*QueryConsumerChainsCarryingValidatorRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsCarryingValidatorRequest")
+	proto.RegisterType((*QueryConsumerChainsCarryingValidatorResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsCarryingValidatorResponse")
+	proto.RegisterType((*QueryConsumerLaunchChecklistRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchChecklistRequest")
+	proto.RegisterType((*ConsumerLaunchCheck)(nil), "interchain_security.ccv.provider.v1.ConsumerLaunchCheck")
+	proto.RegisterType((*QueryConsumerLaunchChecklistResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchChecklistResponse")
+	proto.RegisterType((*QueryConsumerChainsFullDumpRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsFullDumpRequest")
+	proto.RegisterType((*ConsumerChainDump)(nil), "interchain_security.ccv.provider.v1.ConsumerChainDump")
+	proto.RegisterType((*QueryConsumerChainsFullDumpResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsFullDumpResponse")
+	proto.RegisterType((*QueryConsumerValidatorSetAtHeightRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetAtHeightRequest")
+	proto.RegisterType((*QueryConsumerValidatorSetAtHeightValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetAtHeightValidator")
+	proto.RegisterType((*QueryConsumerValidatorSetAtHeightResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorSetAtHeightResponse")
+	proto.RegisterType((*QueryConsumerDumpRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerDumpRequest")
+	proto.RegisterType((*QueryConsumerDumpResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerDumpResponse")
+	proto.RegisterType((*ConsumerStateExport)(nil), "interchain_security.ccv.provider.v1.ConsumerStateExport")
+	proto.RegisterType((*ConsumerStateExportValidator)(nil), "interchain_security.ccv.provider.v1.ConsumerStateExportValidator")
+	proto.RegisterType((*ConsumerStateExportKeyAssignment)(nil), "interchain_security.ccv.provider.v1.ConsumerStateExportKeyAssignment")
+	proto.RegisterType((*ConsumerStateExportCommissionRate)(nil), "interchain_security.ccv.provider.v1.ConsumerStateExportCommissionRate")
+	proto.RegisterType((*QueryRewardAttributionLogRequest)(nil), "interchain_security.ccv.provider.v1.QueryRewardAttributionLogRequest")
+	proto.RegisterType((*QueryRewardAttributionLogResponse)(nil), "interchain_security.ccv.provider.v1.QueryRewardAttributionLogResponse")
+	proto.RegisterType((*QueryConsumerClientStatusRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientStatusRequest")
+	proto.RegisterType((*QueryConsumerClientStatusResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientStatusResponse")
+	proto.RegisterType((*QueryTimeQueueRequest)(nil), "interchain_security.ccv.provider.v1.QueryTimeQueueRequest")
+	proto.RegisterType((*QueryTimeQueueResponse)(nil), "interchain_security.ccv.provider.v1.QueryTimeQueueResponse")
+	proto.RegisterType((*TimeQueueEntry)(nil), "interchain_security.ccv.provider.v1.TimeQueueEntry")
+	proto.RegisterType((*QueryConsumerGenesisBatchRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisBatchRequest")
+	proto.RegisterType((*QueryConsumerGenesisBatchResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisBatchResponse")
+	proto.RegisterType((*ConsumerGenesisEntry)(nil), "interchain_security.ccv.provider.v1.ConsumerGenesisEntry")
+	proto.RegisterType((*QuerySkippedDowntimeSlashesRequest)(nil), "interchain_security.ccv.provider.v1.QuerySkippedDowntimeSlashesRequest")
+	proto.RegisterType((*QuerySkippedDowntimeSlashesResponse)(nil), "interchain_security.ccv.provider.v1.QuerySkippedDowntimeSlashesResponse")
+	proto.RegisterType((*QueryUnroutableSlashPacketsRequest)(nil), "interchain_security.ccv.provider.v1.QueryUnroutableSlashPacketsRequest")
+	proto.RegisterType((*QueryUnroutableSlashPacketsResponse)(nil), "interchain_security.ccv.provider.v1.QueryUnroutableSlashPacketsResponse")
+	proto.RegisterType((*QueryPreOptInInfractionsRequest)(nil), "interchain_security.ccv.provider.v1.QueryPreOptInInfractionsRequest")
+	proto.RegisterType((*QueryPreOptInInfractionsResponse)(nil), "interchain_security.ccv.provider.v1.QueryPreOptInInfractionsResponse")
+	proto.RegisterType((*QueryTopNThresholdRequest)(nil), "interchain_security.ccv.provider.v1.QueryTopNThresholdRequest")
+	proto.RegisterType((*QueryTopNThresholdResponse)(nil), "interchain_security.ccv.provider.v1.QueryTopNThresholdResponse")
+	proto.RegisterType((*TopNBoundaryValidator)(nil), "interchain_security.ccv.provider.v1.TopNBoundaryValidator")
+	proto.RegisterType((*QueryConsumerEconomicSecurityRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerEconomicSecurityRequest")
+	proto.RegisterType((*QueryConsumerEconomicSecurityResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerEconomicSecurityResponse")
+	proto.RegisterType((*QueryNextConsumerIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryNextConsumerIdRequest")
+	proto.RegisterType((*QueryNextConsumerIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryNextConsumerIdResponse")
+	proto.RegisterType((*QuerySimulateCreateConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QuerySimulateCreateConsumerRequest")
+	proto.RegisterType((*QuerySimulateCreateConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QuerySimulateCreateConsumerResponse")
+	proto.RegisterType((*QueryPendingVSCPacketsRequest)(nil), "interchain_security.ccv.provider.v1.QueryPendingVSCPacketsRequest")
+	proto.RegisterType((*PendingVSCPacket)(nil), "interchain_security.ccv.provider.v1.PendingVSCPacket")
+	proto.RegisterType((*PendingValidatorUpdate)(nil), "interchain_security.ccv.provider.v1.PendingValidatorUpdate")
+	proto.RegisterType((*QueryPendingVSCPacketsResponse)(nil), "interchain_security.ccv.provider.v1.QueryPendingVSCPacketsResponse")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+}
+
+var fileDescriptor_422512d7b7586cd7 = []byte{
+	// 6781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7d, 0x5b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xf6, 0xf6, 0xf0, 0xaa, 0xa2, 0x78, 0x2b, 0x52, 0xe2, 0x70, 0x74, 0xa1, 0xd4, 0xda, 0x8b,
+	0x56, 0xab, 0x25, 0x25, 0xae, 0xf7, 0x26, 0xad, 0x56, 0xe2, 0x8c, 0x48, 0x91, 0x92, 0x56, 0xa2,
+	0x9a, 0x92, 0xd6, 0xbb, 0x6b, 0xfd, 0xed, 0x66, 0x77, 0x71, 0xd8, 0xcb, 0x99, 0xee, 0x56, 0x77,
+	0x0f, 0x25, 0xfe, 0x82, 0x0c, 0xd8, 0x3f, 0x7e, 0x7b, 0x9d, 0xd8, 0x88, 0x0d, 0xc7, 0x41, 0x92,
+	0x97, 0xf8, 0x21, 0x41, 0x00, 0xc7, 0x08, 0x82, 0xc0, 0x31, 0x90, 0xbc, 0xe4, 0x86, 0x00, 0x06,
+	0xfc, 0x10, 0xc7, 0x4e, 0x80, 0xc4, 0x41, 0xd6, 0x81, 0xed, 0x00, 0x7e, 0xb0, 0x03, 0xc4, 0x49,
+	0x10, 0xc0, 0x40, 0x82, 0xa0, 0xaa, 0x4e, 0xf5, 0x74, 0xf7, 0xf4, 0xcc, 0x74, 0xcf, 0x8c, 0x37,
+	0x46, 0x9e, 0x38, 0x5d, 0x97, 0xaf, 0xea, 0x9c, 0xba, 0x9d, 0x3a, 0x97, 0x22, 0x5a, 0x30, 0x2d,
+	0x9f, 0xb8, 0xfa, 0xb6, 0x66, 0x5a, 0xaa, 0x47, 0xf4, 0x9a, 0x6b, 0xfa, 0x7b, 0x0b, 0xba, 0xbe,
+	0xbb, 0xe0, 0xb8, 0xf6, 0xae, 0x69, 0x10, 0x77, 0x61, 0xf7, 0xec, 0xc2, 0xfd, 0x1a, 0x71, 0xf7,
+	0xe6, 0x1d, 0xd7, 0xf6, 0x6d, 0x7c, 0x22, 0xa1, 0xc2, 0xbc, 0xae, 0xef, 0xce, 0x8b, 0x0a, 0xf3,
+	0xbb, 0x67, 0x0b, 0x87, 0xcb, 0xb6, 0x5d, 0xae, 0x90, 0x05, 0xcd, 0x31, 0x17, 0x34, 0xcb, 0xb2,
+	0x7d, 0xcd, 0x37, 0x6d, 0xcb, 0xe3, 0x10, 0x85, 0xe9, 0xb2, 0x5d, 0xb6, 0xd9, 0xcf, 0x05, 0xfa,
+	0x0b, 0x52, 0xe7, 0xa0, 0x0e, 0xfb, 0xda, 0xac, 0x6d, 0x2d, 0xf8, 0x66, 0x95, 0x78, 0xbe, 0x56,
+	0x75, 0xa0, 0xc0, 0xd1, 0x78, 0x01, 0xa3, 0xe6, 0x32, 0x5c, 0xc8, 0x5f, 0x4c, 0x43, 0x4a, 0xd0,
+	0x4b, 0x5e, 0xe7, 0x74, 0x9a, 0x3a, 0xfe, 0x43, 0x28, 0x7d, 0xa6, 0x59, 0xe9, 0xdd, 0xb3, 0x0b,
+	0xde, 0xb6, 0xe6, 0x12, 0x43, 0xd5, 0x6d, 0xcb, 0xab, 0x55, 0x03, 0xfc, 0xa7, 0x5a, 0xd4, 0x78,
+	0x60, 0xba, 0x04, 0x8a, 0x1d, 0xf6, 0x89, 0x65, 0x10, 0xb7, 0x6a, 0x5a, 0xfe, 0x82, 0xee, 0xee,
+	0x39, 0xbe, 0xbd, 0xb0, 0x43, 0xf6, 0x04, 0xbf, 0x66, 0x75, 0xdb, 0xab, 0xda, 0x9e, 0xca, 0x59,
+	0xc6, 0x3f, 0x20, 0xeb, 0x49, 0xfe, 0xb5, 0xe0, 0xf9, 0xda, 0x8e, 0x69, 0x95, 0x17, 0x76, 0xcf,
+	0x6e, 0x12, 0x5f, 0x3b, 0x2b, 0xbe, 0xa1, 0xd4, 0x29, 0x28, 0xb5, 0xa9, 0x79, 0x84, 0x0f, 0x66,
+	0x50, 0xd0, 0xd1, 0xca, 0xa6, 0x15, 0xe6, 0xe2, 0xd1, 0x70, 0x59, 0x51, 0x4a, 0xb7, 0x4d, 0xc8,
+	0x97, 0x09, 0x3a, 0x74, 0x8b, 0x22, 0x94, 0x80, 0xd0, 0x2b, 0xc4, 0x22, 0x9e, 0xe9, 0x29, 0xe4,
+	0x7e, 0x8d, 0x78, 0x3e, 0x9e, 0x43, 0x23, 0x82, 0x05, 0xaa, 0x69, 0xe4, 0xa5, 0x63, 0xd2, 0xc9,
+	0x7d, 0x0a, 0x12, 0x49, 0x6b, 0x06, 0x7e, 0x0a, 0x8d, 0xf9, 0x9a, 0x5b, 0x26, 0xbe, 0xba, 0x4b,
+	0x5c, 0xcf, 0xb4, 0xad, 0x7c, 0x8e, 0x95, 0x19, 0xe5, 0xa9, 0x77, 0x79, 0xa2, 0xfc, 0x1d, 0x09,
+	0x1d, 0x4e, 0x6e, 0xc7, 0x73, 0x6c, 0xcb, 0x23, 0xf8, 0x1d, 0x34, 0x5a, 0xe6, 0x49, 0xaa, 0xe7,
+	0x6b, 0x3e, 0x61, 0x4d, 0x8d, 0x2c, 0x9e, 0x99, 0x6f, 0x36, 0x3f, 0x77, 0xcf, 0xce, 0xc7, 0xb0,
+	0x36, 0x68, 0xbd, 0x62, 0xff, 0xd7, 0xdf, 0x9f, 0x7b, 0x42, 0xd9, 0x5f, 0x0e, 0xa5, 0xe1, 0xe3,
+	0x48, 0x7c, 0xab, 0xdb, 0x9a, 0xb7, 0x0d, 0x5d, 0x1c, 0x81, 0xb4, 0x55, 0xcd, 0xdb, 0xc6, 0xe7,
+	0xd0, 0xac, 0xef, 0x6a, 0x96, 0xb7, 0x65, 0xbb, 0x55, 0x62, 0xa8, 0xd1, 0xbe, 0xf4, 0xb1, 0xf2,
+	0x33, 0xa1, 0x02, 0xe1, 0x26, 0xe5, 0xdf, 0x95, 0x50, 0x21, 0x42, 0x5c, 0x89, 0x76, 0x37, 0xe0,
+	0xe1, 0x2a, 0x1a, 0x70, 0xb6, 0x35, 0x8f, 0x93, 0x34, 0xb6, 0xb8, 0x38, 0x9f, 0x62, 0xc9, 0x05,
+	0xb4, 0xad, 0xd3, 0x9a, 0x0a, 0x07, 0xc0, 0x2b, 0x08, 0xd5, 0x07, 0x98, 0x51, 0x31, 0xb2, 0xf8,
+	0xf4, 0x3c, 0xcc, 0x20, 0x3a, 0xc2, 0xf3, 0x7c, 0x69, 0xc3, 0x38, 0xcf, 0xaf, 0x6b, 0x65, 0x02,
+	0xbd, 0x50, 0x42, 0x35, 0xe5, 0x2f, 0x4b, 0xe8, 0x50, 0x62, 0x87, 0x61, 0x30, 0x8a, 0x68, 0x90,
+	0x75, 0xcf, 0xcb, 0x4b, 0xc7, 0xfa, 0x4e, 0x8e, 0x2c, 0x9e, 0x4a, 0xd7, 0x65, 0x9a, 0xad, 0x40,
+	0x4d, 0x7c, 0x25, 0xa1, 0xaf, 0xcf, 0xb4, 0xed, 0x2b, 0xef, 0x40, 0xa4, 0xb3, 0x7f, 0x30, 0x8c,
+	0x06, 0x18, 0x34, 0x9e, 0x45, 0xc3, 0xbc, 0x0b, 0xc1, 0x4c, 0x1c, 0x62, 0xdf, 0x6b, 0x06, 0x3e,
+	0x84, 0xf6, 0xe9, 0x15, 0x93, 0x58, 0x3e, 0xcd, 0xe3, 0xc3, 0x3b, 0xcc, 0x13, 0xd6, 0x0c, 0x3c,
+	0x85, 0x06, 0x7c, 0xdb, 0x51, 0x6f, 0xb0, 0x71, 0x1c, 0x55, 0xfa, 0x7d, 0xdb, 0xb9, 0x81, 0x4f,
+	0x21, 0x5c, 0x35, 0x2d, 0xd5, 0xb1, 0x1f, 0xd0, 0xa9, 0x6d, 0xa9, 0xbc, 0x44, 0xff, 0x31, 0xe9,
+	0x64, 0x9f, 0x32, 0x56, 0x35, 0xad, 0x75, 0x9a, 0xb1, 0x66, 0xdd, 0xa6, 0x65, 0xcf, 0xa0, 0xe9,
+	0x5d, 0xad, 0x62, 0x1a, 0x9a, 0x6f, 0xbb, 0x1e, 0x54, 0xd1, 0x35, 0x27, 0x3f, 0xc0, 0xf0, 0x70,
+	0x3d, 0x8f, 0x55, 0x2a, 0x69, 0x0e, 0x3e, 0x85, 0x26, 0x83, 0x54, 0xd5, 0x23, 0x3e, 0x2b, 0x3e,
+	0xc8, 0x8a, 0x8f, 0x07, 0x19, 0x1b, 0xc4, 0xa7, 0x65, 0x0f, 0xa3, 0x7d, 0x5a, 0xa5, 0x62, 0x3f,
+	0xa8, 0x98, 0x9e, 0x9f, 0x1f, 0x3a, 0xd6, 0x77, 0x72, 0x9f, 0x52, 0x4f, 0xc0, 0x05, 0x34, 0x6c,
+	0x10, 0x6b, 0x8f, 0x65, 0x0e, 0xb3, 0xcc, 0xe0, 0x1b, 0x4f, 0x8b, 0x99, 0xb5, 0x8f, 0x51, 0xcc,
+	0x3f, 0xf0, 0x9b, 0x68, 0xb8, 0x4a, 0x7c, 0xcd, 0xd0, 0x7c, 0x2d, 0x8f, 0x18, 0xdf, 0x5f, 0xcc,
+	0x34, 0xe5, 0xde, 0x80, 0xca, 0xb0, 0x94, 0x02, 0x30, 0xca, 0x64, 0xca, 0x32, 0xba, 0x19, 0x91,
+	0xfc, 0xc8, 0x31, 0xe9, 0x64, 0xbf, 0x32, 0x5c, 0x35, 0xad, 0x0d, 0xfa, 0x8d, 0xe7, 0xd1, 0x14,
+	0xeb, 0xb4, 0x6a, 0x5a, 0x9a, 0xee, 0x9b, 0xbb, 0x44, 0xdd, 0xd5, 0x2a, 0x5e, 0x7e, 0xff, 0x31,
+	0xe9, 0xe4, 0xb0, 0x32, 0xc9, 0xb2, 0xd6, 0x20, 0xe7, 0xae, 0x56, 0xf1, 0xe2, 0x3b, 0xcb, 0x68,
+	0xc3, 0xce, 0xf2, 0x10, 0xcd, 0x06, 0x5c, 0x20, 0x86, 0xea, 0x92, 0x07, 0x9a, 0x6b, 0xa8, 0x06,
+	0xb1, 0xec, 0xaa, 0x97, 0x1f, 0x63, 0x74, 0xbd, 0x96, 0x8a, 0xae, 0xa5, 0x3a, 0x8a, 0xc2, 0x40,
+	0x2e, 0x33, 0x0c, 0x65, 0x46, 0x4b, 0xce, 0xa0, 0x83, 0x57, 0xd5, 0x1e, 0xaa, 0x02, 0x43, 0x75,
+	0x35, 0x6b, 0x27, 0x3f, 0xce, 0x07, 0xaf, 0xaa, 0x3d, 0x5c, 0x87, 0x74, 0x45, 0xb3, 0x76, 0x70,
+	0x1e, 0x0d, 0x19, 0xb6, 0x5b, 0xd5, 0x2c, 0x3f, 0x3f, 0xc1, 0x48, 0x15, 0x9f, 0xf8, 0x1d, 0x34,
+	0x5b, 0xd1, 0x3c, 0x5f, 0x75, 0x34, 0x7d, 0x87, 0xf8, 0xaa, 0x4b, 0x74, 0x62, 0xee, 0x12, 0x43,
+	0xa5, 0xe7, 0x60, 0x7e, 0x92, 0xf5, 0xbf, 0x30, 0xcf, 0xcf, 0xc0, 0x79, 0x71, 0x06, 0xce, 0xdf,
+	0x16, 0x87, 0x64, 0xb1, 0xff, 0x73, 0xdf, 0x9d, 0x93, 0x94, 0x83, 0x14, 0x62, 0x9d, 0x21, 0x28,
+	0x00, 0x40, 0x8b, 0xd0, 0x59, 0xb1, 0x4b, 0x5c, 0x73, 0xcb, 0x24, 0x46, 0x1e, 0xb3, 0x76, 0x83,
+	0x6f, 0xfc, 0x1a, 0x2a, 0x10, 0xda, 0x41, 0x4b, 0x27, 0xaa, 0x57, 0xdb, 0xac, 0x9a, 0x9e, 0x67,
+	0xda, 0x96, 0xea, 0x68, 0x35, 0x8f, 0x18, 0xf9, 0x29, 0x56, 0x3a, 0x2f, 0x4a, 0x6c, 0x04, 0x05,
+	0xd6, 0x59, 0x3e, 0x36, 0xd0, 0xb8, 0xee, 0x12, 0xb6, 0xf4, 0x68, 0x9f, 0x6d, 0xd7, 0xc8, 0x4f,
+	0xb3, 0xce, 0x9e, 0xcf, 0x34, 0x89, 0x4a, 0x80, 0xa1, 0x30, 0x08, 0x65, 0x4c, 0x8f, 0x7c, 0x63,
+	0x0d, 0x8d, 0xb9, 0xa4, 0x6a, 0xef, 0x6a, 0x15, 0xd1, 0xc8, 0x01, 0xd6, 0xc8, 0xb9, 0x4c, 0x8d,
+	0x28, 0x1c, 0x02, 0xda, 0x18, 0x75, 0xc3, 0x9f, 0xf2, 0x67, 0x25, 0x74, 0x9c, 0x6d, 0x72, 0x77,
+	0xc5, 0x7a, 0x13, 0xd5, 0x96, 0x0c, 0xc3, 0x15, 0x9b, 0xf3, 0x05, 0x34, 0x11, 0x8c, 0xb3, 0x66,
+	0x18, 0x2e, 0xf1, 0x3c, 0xbe, 0xb7, 0x14, 0xf1, 0x4f, 0xde, 0x9f, 0x1b, 0xdb, 0xd3, 0xaa, 0x95,
+	0x73, 0x32, 0x64, 0xc8, 0xca, 0xb8, 0x28, 0xbb, 0xc4, 0x53, 0xe2, 0xb3, 0x38, 0x17, 0x9f, 0xc5,
+	0xe7, 0x86, 0xdf, 0xfb, 0xd2, 0xdc, 0x13, 0x3f, 0xfc, 0xd2, 0xdc, 0x13, 0xf2, 0xc7, 0x25, 0x24,
+	0xb7, 0xea, 0x0f, 0xec, 0xbd, 0xcf, 0xa2, 0x89, 0x00, 0x31, 0xd2, 0x21, 0x65, 0x5c, 0x0f, 0x95,
+	0xa7, 0x8d, 0x9f, 0x41, 0xd3, 0x3b, 0x64, 0x4f, 0xd5, 0x3c, 0xcf, 0x2c, 0x5b, 0x55, 0xba, 0xf9,
+	0x59, 0xb6, 0xa5, 0x13, 0xd6, 0x8b, 0x7e, 0x05, 0xef, 0x90, 0xbd, 0xa5, 0x20, 0xeb, 0x06, 0xcd,
+	0x49, 0xe0, 0xc9, 0x7a, 0x88, 0xa0, 0x10, 0x4f, 0x92, 0xbb, 0x90, 0xcc, 0x93, 0x78, 0xb7, 0x32,
+	0xf0, 0xe4, 0x26, 0x92, 0x5b, 0x75, 0xa7, 0xce, 0x92, 0xe4, 0x31, 0x6a, 0x18, 0x0f, 0xf9, 0x10,
+	0x9a, 0x65, 0x80, 0xb7, 0xb7, 0x5d, 0xdb, 0xf7, 0x2b, 0x84, 0x1d, 0xd0, 0x40, 0x97, 0xfc, 0x57,
+	0xe2, 0x9c, 0x8e, 0xe5, 0x42, 0x33, 0x73, 0x68, 0xc4, 0xab, 0x68, 0xde, 0xb6, 0x5a, 0x25, 0x3e,
+	0x71, 0x59, 0x0b, 0x7d, 0x0a, 0x62, 0x49, 0x6f, 0xd0, 0x14, 0xbc, 0x88, 0x0e, 0x84, 0x0a, 0xa8,
+	0x6c, 0xfb, 0xd0, 0x04, 0xc3, 0xfb, 0x94, 0xa9, 0x7a, 0xd1, 0x25, 0x91, 0x85, 0xff, 0x0f, 0xca,
+	0x5b, 0xe4, 0x21, 0x5d, 0xfe, 0x4e, 0x85, 0x58, 0xa6, 0xb7, 0xad, 0xea, 0x9a, 0x65, 0x98, 0x86,
+	0x10, 0x2b, 0x5a, 0x6f, 0x02, 0xc3, 0x74, 0x07, 0xe6, 0x1b, 0x01, 0x45, 0x51, 0x04, 0x48, 0x49,
+	0x60, 0xc8, 0xa7, 0xd1, 0x29, 0x46, 0x92, 0x42, 0xca, 0xa6, 0xe7, 0x13, 0x97, 0x18, 0xf5, 0xc5,
+	0x11, 0xda, 0xeb, 0x80, 0x03, 0xcb, 0xe8, 0xb9, 0x54, 0xa5, 0x81, 0x23, 0x07, 0xd1, 0x20, 0xec,
+	0xb7, 0x12, 0x3b, 0x79, 0xe0, 0x4b, 0xbe, 0x8e, 0x9e, 0x65, 0x30, 0x4b, 0x95, 0xca, 0xba, 0x66,
+	0xba, 0xde, 0x5d, 0xad, 0x42, 0x71, 0xe8, 0x20, 0x14, 0xf7, 0xea, 0x88, 0xe9, 0x44, 0x48, 0xf9,
+	0x37, 0x24, 0x74, 0x2a, 0x0d, 0x1c, 0x74, 0xea, 0x3e, 0x9a, 0x74, 0x34, 0xd3, 0xa5, 0xc7, 0x0b,
+	0x15, 0xcf, 0xd9, 0x8c, 0x00, 0x39, 0x65, 0x25, 0xd5, 0xee, 0x41, 0xdb, 0xe0, 0x4d, 0xd0, 0x16,
+	0x82, 0x19, 0x67, 0xd5, 0x79, 0x31, 0xe6, 0x44, 0x8a, 0xc8, 0xff, 0x26, 0xa1, 0xe3, 0x6d, 0x6b,
+	0xe1, 0x95, 0xa6, 0x5b, 0xc9, 0xa1, 0x9f, 0xbc, 0x3f, 0x37, 0xc3, 0x97, 0x4d, 0xbc, 0x44, 0xc2,
+	0x9e, 0xb2, 0x92, 0xb0, 0xfc, 0x72, 0x71, 0x9c, 0x78, 0x89, 0x84, 0x75, 0x78, 0x11, 0xed, 0x0f,
+	0x4a, 0xed, 0x90, 0x3d, 0x98, 0x6e, 0x87, 0xe7, 0xeb, 0x97, 0x93, 0x79, 0x7e, 0x39, 0x99, 0x5f,
+	0xaf, 0x6d, 0x56, 0x4c, 0xfd, 0x1a, 0xd9, 0x53, 0x82, 0xa1, 0xba, 0x46, 0xf6, 0xe4, 0x69, 0x84,
+	0xd9, 0xb8, 0xac, 0x6b, 0xae, 0x56, 0x9f, 0x43, 0x1f, 0x45, 0x53, 0x91, 0x54, 0x18, 0x96, 0x35,
+	0x34, 0xe8, 0xb0, 0x14, 0x90, 0xdc, 0x9f, 0x4b, 0x39, 0x16, 0xb4, 0x0a, 0x48, 0x1a, 0x00, 0x20,
+	0xbf, 0x01, 0xf3, 0x21, 0x22, 0x9d, 0xde, 0x74, 0x7c, 0x62, 0xac, 0x59, 0xc1, 0x4e, 0x91, 0xfa,
+	0x8a, 0x22, 0xff, 0x40, 0x42, 0xcf, 0xa5, 0xc2, 0x0b, 0xa4, 0xdf, 0x23, 0x61, 0x69, 0x2f, 0x36,
+	0x60, 0x44, 0x2c, 0x86, 0x43, 0x21, 0xb1, 0x2f, 0x3a, 0x82, 0xc4, 0xc3, 0xf7, 0x11, 0xaa, 0x67,
+	0xe7, 0x73, 0x6c, 0x76, 0xde, 0x4a, 0xc5, 0x91, 0x14, 0x3d, 0x0d, 0x7e, 0x29, 0xa1, 0x46, 0xe4,
+	0x3f, 0xcf, 0xa1, 0xd3, 0x59, 0x2a, 0x67, 0xd8, 0x56, 0xf1, 0x3d, 0x94, 0x0f, 0x78, 0xac, 0xdb,
+	0x55, 0x21, 0x52, 0xb8, 0x74, 0x17, 0xe3, 0x53, 0xf3, 0x04, 0x1d, 0xc1, 0xef, 0xbc, 0x3f, 0x77,
+	0x88, 0x4b, 0xf8, 0x9e, 0xb1, 0x33, 0x6f, 0xda, 0x0b, 0x55, 0xcd, 0xdf, 0x9e, 0xbf, 0x4e, 0xca,
+	0x9a, 0xbe, 0x77, 0x99, 0xe8, 0xca, 0x41, 0x01, 0x52, 0x0a, 0x30, 0x14, 0x7a, 0x3f, 0x7b, 0x4f,
+	0x42, 0x73, 0xcd, 0xf0, 0x55, 0xcf, 0xae, 0xb9, 0x3a, 0xdf, 0x2c, 0xc7, 0x16, 0x97, 0xb2, 0x09,
+	0x21, 0x91, 0x66, 0x36, 0x18, 0x90, 0x72, 0x58, 0x6f, 0x91, 0x2b, 0x2f, 0xa1, 0xa3, 0x11, 0x26,
+	0x76, 0x30, 0xdf, 0x3e, 0x3f, 0x84, 0x8e, 0x35, 0xc1, 0xa8, 0x33, 0xbf, 0x4b, 0xb9, 0x23, 0xbe,
+	0xb6, 0x73, 0x19, 0xd7, 0x36, 0xce, 0xa3, 0x01, 0x76, 0x8f, 0x61, 0x7c, 0xed, 0x2b, 0xe6, 0xf2,
+	0x92, 0xc2, 0x13, 0xf0, 0xab, 0xa8, 0x9f, 0x8d, 0x6b, 0x3f, 0xeb, 0xcd, 0x53, 0x29, 0xc6, 0x35,
+	0x2f, 0x29, 0xac, 0x0a, 0x55, 0x06, 0x04, 0xbd, 0xe2, 0xe8, 0x03, 0xec, 0x64, 0x1c, 0x15, 0xa9,
+	0xec, 0x7e, 0xd4, 0x72, 0x36, 0x0d, 0x76, 0x3f, 0x9b, 0xee, 0xa1, 0x7c, 0xc0, 0xda, 0x38, 0xfc,
+	0x50, 0x06, 0x78, 0x01, 0x12, 0x83, 0xbf, 0x86, 0x46, 0x0c, 0xe2, 0xe9, 0xae, 0xe9, 0xb0, 0x9b,
+	0xed, 0x30, 0xe3, 0xfc, 0x09, 0x71, 0xb3, 0x15, 0x9a, 0x1a, 0x71, 0xad, 0xbd, 0x5c, 0x2f, 0x0a,
+	0xbb, 0x5c, 0xb8, 0x36, 0xbe, 0x87, 0x66, 0x83, 0xbe, 0xda, 0x0e, 0x71, 0xd9, 0x7d, 0x51, 0xcc,
+	0x07, 0x76, 0xab, 0x2b, 0x1e, 0xff, 0xd6, 0x57, 0x9f, 0x3f, 0x02, 0xe8, 0xc1, 0xfc, 0x81, 0x79,
+	0xb0, 0xe1, 0xbb, 0xa6, 0x55, 0x56, 0x66, 0x04, 0xc6, 0x4d, 0x80, 0x10, 0xd3, 0xe4, 0x20, 0x1a,
+	0x7c, 0x57, 0x33, 0x2b, 0xc4, 0x60, 0x17, 0xc1, 0x61, 0x05, 0xbe, 0xf0, 0x39, 0x34, 0xe8, 0xf9,
+	0x9a, 0x5f, 0xf3, 0xd8, 0x35, 0x6e, 0x6c, 0x51, 0x6e, 0xd6, 0xfd, 0xa2, 0x6d, 0x19, 0x1b, 0xac,
+	0xa4, 0x02, 0x35, 0xf0, 0x6d, 0x14, 0xcc, 0x46, 0xd5, 0xb7, 0x77, 0x88, 0xc5, 0x2f, 0x79, 0xfb,
+	0x8a, 0xcf, 0x01, 0x57, 0x0f, 0x34, 0x72, 0x75, 0xcd, 0xf2, 0xbf, 0xf5, 0xd5, 0xe7, 0x11, 0x34,
+	0xb2, 0x66, 0xf9, 0xca, 0x98, 0xc0, 0xb8, 0xcd, 0x20, 0xe8, 0xd4, 0x09, 0x50, 0xf9, 0xd4, 0x19,
+	0xe5, 0x53, 0x47, 0xa4, 0xf2, 0xa9, 0xf3, 0x12, 0x9a, 0x81, 0x2d, 0x8f, 0x78, 0xaa, 0x5e, 0x73,
+	0x5d, 0x2a, 0xf5, 0x12, 0xc7, 0xd6, 0xb7, 0xd9, 0x95, 0x70, 0x58, 0x39, 0x10, 0x64, 0x97, 0x78,
+	0xee, 0x32, 0xcd, 0x94, 0xe9, 0x0e, 0xd3, 0x74, 0x5d, 0xc3, 0xbe, 0x4f, 0x22, 0x7b, 0x36, 0x97,
+	0x28, 0x96, 0xb3, 0xef, 0xd9, 0xed, 0xf6, 0xe9, 0xfb, 0xe8, 0x4c, 0x82, 0xee, 0x25, 0x28, 0xbb,
+	0xaa, 0x79, 0xb7, 0x6d, 0xf8, 0x22, 0xbd, 0xb9, 0xa5, 0xc8, 0x77, 0xd1, 0xd9, 0x0c, 0x4d, 0x02,
+	0x3b, 0x8e, 0x87, 0xb6, 0x18, 0xd3, 0x10, 0xa7, 0xde, 0x48, 0x7d, 0xa3, 0xf3, 0xe4, 0xcf, 0x8a,
+	0x93, 0xb5, 0xe1, 0x4a, 0x13, 0x5d, 0x33, 0xa9, 0xb5, 0x89, 0x49, 0x74, 0xe6, 0xd2, 0xd3, 0x59,
+	0x46, 0xa7, 0xd3, 0x75, 0x07, 0x48, 0x7c, 0x19, 0xb6, 0x3a, 0x29, 0xfd, 0xae, 0xc0, 0x2a, 0xc8,
+	0xbf, 0x28, 0xa1, 0x67, 0x93, 0x5b, 0x5a, 0xda, 0xd5, 0xcc, 0x8a, 0xb6, 0x69, 0x56, 0x4c, 0x7f,
+	0xef, 0x83, 0x22, 0xfb, 0x2a, 0x3a, 0x95, 0xa6, 0x33, 0x40, 0x34, 0x55, 0x37, 0xf1, 0xf4, 0x0a,
+	0xa7, 0x7c, 0x58, 0xa9, 0x27, 0xc8, 0xff, 0x5f, 0x42, 0x27, 0x18, 0xd8, 0x72, 0x95, 0xb8, 0x65,
+	0x62, 0xe9, 0x7b, 0x37, 0x1d, 0xff, 0x66, 0xcd, 0x2f, 0xd9, 0x76, 0xc5, 0xb0, 0x1f, 0x58, 0x1f,
+	0x14, 0x4d, 0xbf, 0x26, 0xa1, 0x27, 0x5b, 0xf7, 0xa3, 0x7e, 0x6b, 0x33, 0x2d, 0x55, 0x87, 0x64,
+	0x20, 0x08, 0x99, 0x96, 0x28, 0x88, 0xd7, 0xd1, 0xa4, 0xc8, 0x55, 0x89, 0x05, 0xfa, 0x97, 0x5c,
+	0x86, 0xab, 0xd7, 0xb8, 0xa8, 0xbe, 0x6c, 0x31, 0xe5, 0x8b, 0xfc, 0x18, 0xb4, 0xa7, 0x0a, 0xb9,
+	0xe9, 0xf8, 0x6b, 0xd6, 0x07, 0xcd, 0x9a, 0xcf, 0x0b, 0x5d, 0x7a, 0x43, 0xfb, 0xff, 0x73, 0x2c,
+	0x91, 0x41, 0xe4, 0x29, 0x56, 0x6c, 0x7d, 0xc7, 0xbb, 0x63, 0xf9, 0x66, 0xe5, 0x06, 0x79, 0xc8,
+	0x37, 0x5f, 0x71, 0x71, 0x78, 0x1b, 0x1d, 0x6f, 0x51, 0x06, 0xfa, 0xfe, 0x22, 0x9a, 0xd9, 0x64,
+	0xf9, 0x6a, 0x8d, 0x16, 0x50, 0xd9, 0xe5, 0x99, 0x6f, 0xf0, 0x12, 0x53, 0x6b, 0x4c, 0x6f, 0x26,
+	0x54, 0x97, 0x67, 0xd0, 0x01, 0x86, 0xdd, 0xd0, 0xe8, 0xa7, 0xfb, 0xd0, 0xc1, 0x78, 0x0e, 0x34,
+	0x75, 0x02, 0x8d, 0x46, 0x4f, 0x10, 0xde, 0xc0, 0x7e, 0x3d, 0x74, 0x70, 0xe0, 0xf3, 0xa8, 0x10,
+	0x29, 0x44, 0xb5, 0x9f, 0xae, 0xaf, 0x6e, 0x13, 0xb3, 0xbc, 0xed, 0xc3, 0xc5, 0x7f, 0x26, 0x5c,
+	0x63, 0x83, 0xe6, 0xaf, 0xb2, 0x6c, 0xfc, 0x32, 0xca, 0x47, 0x2b, 0x53, 0x66, 0x43, 0x55, 0x26,
+	0x77, 0x29, 0x07, 0xc2, 0x55, 0x97, 0x2d, 0x03, 0x2a, 0xbe, 0x88, 0x66, 0xea, 0x84, 0x47, 0x9b,
+	0xe4, 0x1a, 0xea, 0x69, 0x8b, 0x3c, 0x6c, 0x6c, 0xaf, 0x05, 0xf3, 0x06, 0x9a, 0x33, 0x0f, 0x6f,
+	0xa1, 0x39, 0xe2, 0xf9, 0x66, 0x55, 0xa3, 0x7a, 0xd6, 0x86, 0x76, 0xd9, 0xe4, 0x18, 0x4c, 0xa9,
+	0xaf, 0x3c, 0x14, 0x00, 0xdd, 0x88, 0x74, 0x90, 0x4d, 0x92, 0x25, 0xd0, 0xf6, 0x94, 0x82, 0xa5,
+	0xb0, 0xe2, 0xda, 0xd5, 0x12, 0xa8, 0xe9, 0xc5, 0xf2, 0x89, 0xa8, 0xf2, 0xa5, 0xa8, 0x2a, 0x5f,
+	0x5e, 0x41, 0x27, 0x5a, 0x42, 0xd4, 0x57, 0x40, 0x6b, 0x19, 0xfd, 0x35, 0x34, 0x1b, 0xc1, 0xe1,
+	0xb6, 0x8b, 0xb4, 0x12, 0xfe, 0x6f, 0xa2, 0x24, 0x83, 0x4f, 0xea, 0xd6, 0x23, 0x86, 0x8c, 0x5c,
+	0xd4, 0x90, 0x71, 0x02, 0x8d, 0xda, 0x0f, 0xac, 0xd0, 0xc6, 0xc0, 0x6d, 0x4f, 0xfb, 0x59, 0xa2,
+	0x10, 0xeb, 0x02, 0xbd, 0x7f, 0x7f, 0x33, 0xbd, 0xff, 0x40, 0x2f, 0xf5, 0xfe, 0x5b, 0x74, 0x3f,
+	0x31, 0x7d, 0x95, 0x5f, 0xcf, 0x61, 0x2e, 0x2c, 0x67, 0xc2, 0x5e, 0xb3, 0x4c, 0xdf, 0xd4, 0x2a,
+	0xe6, 0xff, 0x65, 0x4a, 0x60, 0x76, 0xeb, 0x27, 0x3e, 0x71, 0x3d, 0xba, 0x2d, 0x99, 0x3e, 0xfb,
+	0xf6, 0x70, 0x15, 0x4d, 0x73, 0xdb, 0x8a, 0xb7, 0xad, 0x39, 0xa6, 0x55, 0x16, 0x0d, 0x0e, 0x65,
+	0xd0, 0x3f, 0x33, 0x31, 0x71, 0x83, 0xd7, 0x0f, 0x35, 0x83, 0x9d, 0x78, 0xba, 0x87, 0xef, 0xa2,
+	0x51, 0x62, 0x19, 0x8e, 0x6d, 0xd2, 0xa9, 0x66, 0x6d, 0xd9, 0x20, 0xca, 0x9f, 0x4d, 0xd5, 0xce,
+	0x32, 0xd4, 0x5c, 0xb3, 0xb6, 0x6c, 0x65, 0x3f, 0x09, 0x7d, 0x51, 0x4b, 0x48, 0x94, 0x0c, 0xcd,
+	0xa8, 0x9a, 0x16, 0xd8, 0x68, 0x26, 0xc3, 0x1d, 0x59, 0xa2, 0x19, 0x78, 0x09, 0x8d, 0x78, 0x35,
+	0xcb, 0x23, 0xb0, 0xd4, 0x50, 0xca, 0xa5, 0x86, 0x78, 0x25, 0x9a, 0x8c, 0x6f, 0x20, 0xec, 0x92,
+	0xaa, 0x66, 0x5a, 0xb4, 0xb9, 0x8a, 0xb9, 0x45, 0x18, 0xd2, 0x08, 0x43, 0x9a, 0x6d, 0x40, 0xba,
+	0x0c, 0x86, 0xf6, 0x62, 0xff, 0xaf, 0x52, 0xa0, 0xc9, 0xa0, 0xea, 0x75, 0xa8, 0x89, 0xdf, 0x45,
+	0x93, 0x42, 0x3d, 0x6f, 0xb2, 0x91, 0xf3, 0x6d, 0x97, 0x49, 0xf9, 0x63, 0x8b, 0x17, 0x3a, 0xd1,
+	0xd0, 0xaf, 0x09, 0x10, 0x65, 0xc2, 0x8d, 0xa5, 0x60, 0x13, 0x8d, 0xd7, 0x9c, 0xb2, 0xab, 0x19,
+	0x44, 0xb5, 0x6c, 0xdf, 0xd4, 0x89, 0x97, 0x1f, 0x3d, 0xd6, 0x97, 0xd9, 0x16, 0x70, 0x87, 0x63,
+	0xdc, 0x60, 0x10, 0x30, 0x85, 0xc7, 0x6a, 0xe1, 0x44, 0x76, 0xc9, 0xe0, 0x66, 0x24, 0x4f, 0x58,
+	0x43, 0xf8, 0xa5, 0x61, 0x14, 0x52, 0xc1, 0x04, 0xf2, 0x18, 0x4d, 0x6e, 0x93, 0x8a, 0xa1, 0x6e,
+	0x6a, 0xfa, 0x0e, 0xd8, 0x9d, 0xbc, 0xfc, 0x38, 0xeb, 0xd3, 0xe1, 0x88, 0x05, 0xb3, 0x7e, 0xc9,
+	0xd3, 0x4b, 0xb6, 0x69, 0x15, 0x5f, 0xa0, 0xad, 0x7e, 0xf9, 0xbb, 0x73, 0xcf, 0x95, 0x4d, 0x7f,
+	0xbb, 0xb6, 0x39, 0xaf, 0xdb, 0x55, 0xb0, 0xef, 0xc3, 0x9f, 0xe7, 0x3d, 0x63, 0x67, 0xc1, 0xdf,
+	0x73, 0x88, 0x27, 0xea, 0x78, 0xca, 0x38, 0x6d, 0xab, 0xa8, 0xe9, 0x3b, 0x5c, 0x05, 0xeb, 0x25,
+	0x59, 0x60, 0x26, 0x3e, 0x08, 0x0b, 0xcc, 0x64, 0xaf, 0x2d, 0x30, 0x9f, 0x94, 0xd0, 0x53, 0xc9,
+	0xea, 0xfd, 0xe5, 0x87, 0x8e, 0xed, 0xd5, 0xdc, 0xe0, 0x62, 0xd0, 0xf2, 0x1a, 0x2c, 0x75, 0x7b,
+	0x0d, 0x96, 0xbf, 0x21, 0xa1, 0xa7, 0xdb, 0x75, 0x04, 0xf6, 0xee, 0x2e, 0xf5, 0x32, 0x9b, 0x68,
+	0x9f, 0xd8, 0xe7, 0x85, 0xda, 0xef, 0xf5, 0x54, 0x0c, 0x6d, 0x90, 0xdd, 0x45, 0xcf, 0x60, 0x2a,
+	0xd7, 0x61, 0xe5, 0x4f, 0xf5, 0xa3, 0xd9, 0xa6, 0xc5, 0xbb, 0x3a, 0x7c, 0x92, 0x6c, 0x4f, 0x7d,
+	0xc9, 0xb6, 0xa7, 0x93, 0x68, 0xc2, 0xb4, 0xd4, 0x88, 0x8d, 0x9b, 0x9d, 0x46, 0xc3, 0xca, 0x98,
+	0x59, 0xd7, 0x36, 0x6e, 0x10, 0x3f, 0xad, 0x52, 0x68, 0x16, 0x0d, 0xdb, 0x54, 0x57, 0xa9, 0x9a,
+	0x16, 0x3b, 0x61, 0x86, 0x95, 0x21, 0x9b, 0xeb, 0x2e, 0xf1, 0x53, 0x68, 0x7c, 0xcb, 0x76, 0x75,
+	0x62, 0xa8, 0x9b, 0x7b, 0xcc, 0x4e, 0x6f, 0xb1, 0x23, 0x61, 0x58, 0xd9, 0xcf, 0x93, 0x8b, 0x7b,
+	0xcc, 0x4a, 0xff, 0x34, 0x1a, 0x77, 0x88, 0x65, 0xd0, 0x2d, 0xd0, 0x76, 0x7c, 0xd5, 0xae, 0xf9,
+	0x6c, 0x47, 0x1f, 0x56, 0x46, 0x21, 0x99, 0xdf, 0x20, 0x5a, 0xaa, 0x9f, 0xf6, 0x75, 0xaf, 0x7e,
+	0x4a, 0xd8, 0xcf, 0xd0, 0xcf, 0x66, 0x3f, 0x93, 0xb7, 0x62, 0x4e, 0x35, 0xb7, 0x6d, 0xc7, 0xae,
+	0xd8, 0xe5, 0xe0, 0xe2, 0x19, 0xf5, 0x17, 0x91, 0x3a, 0xf6, 0x17, 0xf9, 0x0b, 0x09, 0x1d, 0x69,
+	0xd2, 0x50, 0xe0, 0xbe, 0x83, 0x7c, 0x9e, 0x66, 0x12, 0xa1, 0x3b, 0xc9, 0x26, 0x7d, 0x08, 0x48,
+	0x20, 0x35, 0x04, 0xd7, 0x3b, 0x57, 0x92, 0x9f, 0xe6, 0xd0, 0x44, 0xbc, 0xbd, 0xae, 0x16, 0x4c,
+	0x44, 0x56, 0xed, 0x8b, 0xb9, 0x9d, 0x1c, 0x41, 0x48, 0xdf, 0xd6, 0x2c, 0x8b, 0x54, 0x68, 0x2e,
+	0x17, 0xd5, 0xf6, 0x41, 0x0a, 0x97, 0xf4, 0x44, 0x36, 0xf7, 0x32, 0x1a, 0xe0, 0x92, 0x1e, 0x24,
+	0x72, 0xcf, 0xa5, 0x97, 0xd0, 0x8c, 0x6e, 0xd7, 0x28, 0x1b, 0x1d, 0xcd, 0xf5, 0xf7, 0xd4, 0x10,
+	0x20, 0xd3, 0x94, 0x2a, 0x07, 0xc2, 0xd9, 0xa5, 0x08, 0xb8, 0x6d, 0x59, 0x44, 0x67, 0xa7, 0x88,
+	0x69, 0x70, 0xc5, 0xa7, 0xb2, 0xbf, 0x9e, 0xb8, 0x66, 0xe0, 0xab, 0xe8, 0xb8, 0x61, 0x7a, 0xbe,
+	0x6b, 0x6e, 0xd6, 0x58, 0x31, 0xe6, 0xdf, 0x24, 0x96, 0x03, 0xb4, 0xc4, 0x96, 0xd0, 0x3e, 0x65,
+	0x2e, 0x5c, 0xf0, 0x76, 0xa8, 0x1c, 0x34, 0x89, 0x8f, 0xa1, 0x11, 0xe2, 0xf9, 0xda, 0x66, 0xc5,
+	0xf4, 0xb6, 0x89, 0xc1, 0xd6, 0xd1, 0xb0, 0x12, 0x4e, 0x92, 0x37, 0x40, 0xe4, 0xbe, 0xeb, 0xe9,
+	0x6b, 0xc6, 0x6d, 0x9b, 0x5f, 0x59, 0x52, 0xdf, 0x99, 0x0f, 0xa0, 0xc1, 0x5d, 0x4f, 0x17, 0x43,
+	0xd0, 0xaf, 0x0c, 0xec, 0x52, 0x18, 0xf9, 0x21, 0x2a, 0x24, 0x81, 0xd6, 0xed, 0x97, 0x70, 0x6b,
+	0xe2, 0x57, 0x3b, 0xf8, 0xc2, 0x45, 0xb4, 0x2f, 0xf0, 0x46, 0xcc, 0x74, 0xef, 0xad, 0x57, 0x93,
+	0x2f, 0xc3, 0x6d, 0x36, 0x6a, 0x40, 0x05, 0x76, 0xa4, 0xbe, 0x49, 0x94, 0x90, 0xdc, 0x0a, 0x05,
+	0xe8, 0x88, 0xce, 0x24, 0x29, 0x36, 0x93, 0xe4, 0x4b, 0xb1, 0xd5, 0x29, 0x64, 0xd3, 0xf4, 0x26,
+	0x8b, 0x8f, 0xa1, 0xa3, 0xcd, 0x10, 0xa0, 0x0b, 0x1f, 0x89, 0x0b, 0xcb, 0x52, 0x87, 0xc2, 0xb2,
+	0x70, 0xd0, 0x0b, 0x8b, 0xcc, 0xf2, 0x51, 0xd8, 0xc8, 0x36, 0x00, 0xe1, 0xe6, 0x2e, 0x71, 0x77,
+	0x4d, 0xf2, 0x40, 0xdc, 0xe2, 0x7f, 0x25, 0x87, 0x8e, 0x34, 0x29, 0x00, 0xfd, 0x3b, 0x8d, 0xb0,
+	0x6f, 0xfb, 0x5a, 0x45, 0xdd, 0xb4, 0x2d, 0x83, 0x18, 0x70, 0xd2, 0x70, 0x1b, 0xfe, 0x04, 0xcb,
+	0x29, 0xb2, 0x0c, 0x7e, 0xd8, 0x68, 0x8d, 0xc7, 0x74, 0x36, 0xb9, 0x36, 0xde, 0x8f, 0x86, 0x53,
+	0x1a, 0x1b, 0x11, 0x6d, 0x72, 0x5f, 0x27, 0xa2, 0x40, 0x93, 0x46, 0xc2, 0xca, 0xe4, 0x1f, 0xe7,
+	0x50, 0xbe, 0x59, 0x9f, 0xba, 0xda, 0xd9, 0x82, 0x2b, 0x66, 0x5f, 0xf8, 0x8a, 0x39, 0x8f, 0xa6,
+	0xc4, 0x21, 0xad, 0x86, 0xa8, 0xeb, 0x67, 0xaa, 0xe1, 0x49, 0x3b, 0x6e, 0x6b, 0xc4, 0xcf, 0xa0,
+	0x71, 0xa6, 0x4f, 0x08, 0x95, 0x1d, 0x60, 0x65, 0xc7, 0x68, 0x72, 0xa8, 0xe0, 0x53, 0x68, 0xcc,
+	0x23, 0x15, 0xa2, 0xfb, 0xc1, 0xd0, 0x0d, 0x72, 0x21, 0x41, 0xa4, 0xf2, 0x71, 0x5b, 0x47, 0x93,
+	0x82, 0x6d, 0xea, 0x96, 0xab, 0xb1, 0x8d, 0x2c, 0x8b, 0x4d, 0x67, 0x42, 0xd4, 0x5e, 0x81, 0xca,
+	0xf8, 0x79, 0x84, 0xc9, 0xae, 0xc9, 0xda, 0x0d, 0x75, 0x92, 0x3b, 0xda, 0x4d, 0x42, 0x4e, 0xbd,
+	0x9f, 0xf2, 0xe7, 0xa4, 0x90, 0xec, 0xd5, 0xc0, 0xf0, 0x0c, 0x16, 0xd5, 0x69, 0x61, 0x7f, 0xe3,
+	0x2a, 0x24, 0xfe, 0x41, 0x3d, 0x4c, 0xac, 0x5a, 0x95, 0x4f, 0x8d, 0x90, 0xf7, 0xb1, 0x07, 0x9e,
+	0x8b, 0x53, 0x56, 0xad, 0xba, 0xc1, 0xf3, 0xc4, 0xa0, 0x7b, 0x54, 0x17, 0x1d, 0x95, 0x02, 0xbc,
+	0xe2, 0xde, 0x4d, 0xaa, 0x2d, 0x10, 0xab, 0xbf, 0x41, 0xa5, 0x20, 0x25, 0xa8, 0x14, 0x7a, 0xe5,
+	0x5a, 0xfa, 0x95, 0xb8, 0xa8, 0x50, 0xef, 0xcd, 0xcf, 0xa3, 0x73, 0xe9, 0xd3, 0xe8, 0xc9, 0x48,
+	0x6f, 0x4b, 0x7c, 0xfa, 0x97, 0x6c, 0x6b, 0xab, 0x62, 0xea, 0xc1, 0x0e, 0x2a, 0x7f, 0x5a, 0x5c,
+	0x65, 0x9a, 0x17, 0x04, 0xf2, 0x3e, 0xca, 0xb6, 0x16, 0x9e, 0x08, 0x14, 0xbe, 0x96, 0xed, 0xde,
+	0x16, 0x45, 0x0e, 0xed, 0x2c, 0x1c, 0x94, 0xf6, 0x65, 0xa6, 0x49, 0xe1, 0x56, 0x2e, 0xb2, 0x71,
+	0x7b, 0x4e, 0xae, 0xc1, 0x9e, 0x43, 0x1d, 0xca, 0x2a, 0x5a, 0xcd, 0xd2, 0xb7, 0x43, 0x73, 0xaf,
+	0x2e, 0xd9, 0x60, 0x91, 0x57, 0x57, 0xbe, 0xc9, 0x95, 0x24, 0xd7, 0x0a, 0xaf, 0xa4, 0xb9, 0xee,
+	0x9e, 0x69, 0x95, 0xeb, 0x06, 0xb0, 0xde, 0xd8, 0xb1, 0x6e, 0xa1, 0xd3, 0xe9, 0x5a, 0x4b, 0x6f,
+	0xc2, 0x8a, 0x2b, 0x14, 0xaf, 0x33, 0x1a, 0x4b, 0xdb, 0x44, 0xdf, 0xa9, 0x98, 0x5e, 0x6a, 0xf9,
+	0x44, 0x7e, 0x07, 0x4d, 0x25, 0x40, 0x60, 0x8c, 0xfa, 0x2d, 0xad, 0x0a, 0x16, 0x26, 0x85, 0xfd,
+	0xa6, 0x52, 0x89, 0xa3, 0x79, 0x54, 0xfb, 0x90, 0xe3, 0x46, 0x59, 0xfe, 0xc5, 0x5c, 0x49, 0x89,
+	0xaf, 0x99, 0x15, 0x71, 0xe9, 0x12, 0x9f, 0xf2, 0x2f, 0x4b, 0xb1, 0x69, 0xda, 0xd0, 0x4b, 0x20,
+	0xf8, 0x2e, 0x5d, 0x5b, 0x44, 0xdf, 0x11, 0x33, 0xef, 0x95, 0x4c, 0x33, 0x2f, 0x84, 0x2a, 0x3c,
+	0x72, 0x38, 0x1a, 0xdd, 0xad, 0x5c, 0xa2, 0x19, 0x7b, 0xd0, 0x63, 0xfe, 0x21, 0x7f, 0x51, 0x42,
+	0x72, 0xc2, 0x78, 0xac, 0xd4, 0x2a, 0x95, 0xcb, 0xb5, 0xaa, 0x23, 0x78, 0xf7, 0x0c, 0x1a, 0x37,
+	0x2d, 0xbd, 0x52, 0x33, 0x88, 0x6a, 0x90, 0x0a, 0xf1, 0x89, 0x01, 0x26, 0x89, 0x31, 0x48, 0xbe,
+	0xcc, 0x53, 0x7b, 0xb6, 0x07, 0xfd, 0x4e, 0x0e, 0x4d, 0x46, 0xba, 0x44, 0x7b, 0x83, 0xdf, 0x41,
+	0x03, 0x8c, 0x0f, 0x20, 0xb9, 0x5c, 0xec, 0xd0, 0x1b, 0x47, 0xf0, 0x1a, 0x38, 0xc4, 0x31, 0x5b,
+	0xfb, 0x9f, 0x47, 0xc5, 0xb7, 0xbe, 0xf8, 0x45, 0xa0, 0x84, 0xf6, 0x0b, 0x4d, 0x0c, 0x53, 0xdb,
+	0xf5, 0xa7, 0x54, 0x00, 0x8e, 0x40, 0x2d, 0x9a, 0x1e, 0x75, 0x22, 0x1f, 0x68, 0xe5, 0x44, 0x3e,
+	0x18, 0x75, 0x22, 0x97, 0xff, 0x46, 0x42, 0x27, 0x1a, 0xc9, 0x0c, 0x8d, 0x62, 0x60, 0x1e, 0x1f,
+	0xaf, 0x5f, 0x9b, 0xc3, 0x1b, 0xf8, 0x4b, 0xd9, 0xb7, 0x37, 0x0a, 0x2c, 0xee, 0xb4, 0x7a, 0xa4,
+	0xd9, 0xde, 0x6d, 0xed, 0x3a, 0x3a, 0x99, 0x6c, 0x97, 0xdf, 0x20, 0xfe, 0x92, 0x9f, 0xf1, 0xfa,
+	0x51, 0xbf, 0x49, 0xf0, 0xf3, 0x1a, 0xbe, 0xe4, 0x3f, 0x93, 0xd0, 0xa9, 0xb6, 0xad, 0xfc, 0xfc,
+	0x78, 0xfd, 0x4c, 0x47, 0xbc, 0x7e, 0x40, 0xea, 0x90, 0xbf, 0x21, 0xac, 0xd9, 0xad, 0x59, 0x05,
+	0xf3, 0xe0, 0x19, 0x34, 0xee, 0x59, 0x9a, 0xe3, 0x6d, 0xdb, 0x81, 0x4d, 0x8a, 0x8b, 0xd9, 0x63,
+	0x22, 0x99, 0x57, 0xc0, 0xb5, 0x04, 0x1f, 0xb8, 0x9b, 0x5d, 0xf8, 0x53, 0x24, 0x71, 0x34, 0x41,
+	0x24, 0x3e, 0x8f, 0xf2, 0x91, 0xfa, 0xe1, 0xad, 0xa8, 0xed, 0x36, 0x7e, 0x1f, 0xcd, 0x26, 0x54,
+	0x06, 0xca, 0x6f, 0xa3, 0x81, 0x70, 0x6c, 0x52, 0xb6, 0xcd, 0x95, 0xdd, 0xe7, 0xa9, 0x96, 0xce,
+	0x15, 0x47, 0x3a, 0x07, 0x93, 0xbf, 0x3d, 0x82, 0xa6, 0x12, 0x0a, 0xfd, 0x2f, 0xdf, 0xaf, 0x5a,
+	0x46, 0x47, 0x0c, 0x74, 0x19, 0x1d, 0x11, 0x0a, 0xca, 0x18, 0x8c, 0x06, 0x65, 0x84, 0xe3, 0x26,
+	0x86, 0x32, 0xc5, 0x4d, 0x0c, 0xb7, 0x8f, 0x9b, 0xa0, 0x7d, 0xb7, 0x6b, 0xbe, 0xea, 0x10, 0xd7,
+	0xb4, 0x0d, 0xee, 0xbf, 0x95, 0x55, 0x6b, 0x7f, 0x9b, 0x63, 0xac, 0x73, 0x08, 0x65, 0xcc, 0x8f,
+	0x7c, 0xd3, 0xd0, 0x14, 0x66, 0x8a, 0xe3, 0x68, 0xb0, 0xfc, 0x10, 0x53, 0x6e, 0x8c, 0xd3, 0x0c,
+	0x36, 0xe4, 0xb0, 0xfe, 0xe2, 0x51, 0x6f, 0xd4, 0x1c, 0xb4, 0x3f, 0x1a, 0xf5, 0xb6, 0x88, 0x0e,
+	0x56, 0x4d, 0xcb, 0xac, 0xd6, 0xaa, 0xd1, 0x40, 0x28, 0x8b, 0x19, 0x7b, 0xfa, 0x14, 0x0c, 0xb9,
+	0xe1, 0x60, 0xa8, 0x2b, 0xe8, 0x18, 0xb9, 0x5f, 0x33, 0x77, 0x6d, 0x9d, 0x9b, 0x28, 0x02, 0x9e,
+	0x55, 0xeb, 0x3d, 0x1a, 0x65, 0x3d, 0x3a, 0x12, 0x2e, 0xb7, 0x0c, 0xc5, 0xde, 0x08, 0xfa, 0x77,
+	0x8a, 0x1a, 0x99, 0x58, 0x50, 0x4f, 0x68, 0xb6, 0x8d, 0xf1, 0xeb, 0x92, 0x1b, 0xd6, 0x83, 0xac,
+	0x51, 0x87, 0xb5, 0x16, 0xc1, 0x40, 0xe3, 0xec, 0x44, 0x6b, 0x1a, 0xce, 0xf3, 0x6a, 0xc8, 0xb8,
+	0xb0, 0x45, 0x88, 0xea, 0xd8, 0x76, 0x25, 0xd8, 0x7d, 0x27, 0x58, 0x7b, 0x81, 0xaf, 0xdf, 0x0a,
+	0x21, 0xeb, 0xb6, 0x5d, 0x11, 0x1b, 0x2e, 0x35, 0xe5, 0x81, 0x4a, 0x99, 0x6a, 0x9f, 0xf8, 0x64,
+	0xf5, 0x98, 0xa5, 0xa4, 0x5f, 0x99, 0x84, 0xac, 0xbb, 0x9e, 0xce, 0xe7, 0xa0, 0x47, 0x57, 0x0e,
+	0x8f, 0x10, 0xd0, 0xa8, 0x0c, 0x86, 0xf9, 0x31, 0xcc, 0x52, 0x96, 0xa8, 0x18, 0x55, 0x8e, 0xec,
+	0x88, 0x53, 0x6c, 0x47, 0x5c, 0xea, 0x74, 0x17, 0x69, 0xb1, 0x07, 0x36, 0xbb, 0xa7, 0x4f, 0x37,
+	0xbb, 0xa7, 0xfb, 0x68, 0x3c, 0x1a, 0x49, 0xe2, 0xe5, 0x0f, 0x64, 0xf0, 0x7f, 0x4b, 0xe8, 0xdd,
+	0xb5, 0x70, 0xf0, 0x89, 0x38, 0xea, 0x23, 0x11, 0x29, 0x1e, 0x7e, 0x40, 0xcd, 0x0d, 0x11, 0xfd,
+	0xbb, 0x97, 0x3f, 0x98, 0xc1, 0x91, 0x3f, 0xa1, 0xd9, 0xa8, 0x2e, 0x1e, 0xda, 0x1d, 0xd7, 0x23,
+	0xa9, 0x5e, 0x54, 0x58, 0x9a, 0x69, 0x25, 0x2c, 0xe5, 0x63, 0x11, 0x77, 0xcf, 0xa0, 0x71, 0xbd,
+	0x42, 0x34, 0xab, 0xe6, 0xa8, 0x30, 0xfa, 0xf9, 0x59, 0x2e, 0xcb, 0x42, 0xf2, 0x3a, 0x4f, 0x95,
+	0xff, 0x54, 0x42, 0x87, 0x5b, 0x0d, 0x5a, 0x16, 0x5d, 0xc1, 0xcf, 0xe6, 0xd8, 0xa7, 0x87, 0xe1,
+	0xbb, 0x76, 0x7d, 0xcd, 0x72, 0xc7, 0x12, 0xf4, 0xae, 0x2d, 0x16, 0xa8, 0xfc, 0x87, 0x12, 0x3a,
+	0xd6, 0x6e, 0x68, 0xb3, 0xd0, 0xf1, 0x6c, 0xb3, 0xc0, 0x86, 0x9f, 0x41, 0xec, 0xc2, 0xa7, 0x24,
+	0x74, 0xbc, 0xed, 0xfc, 0xc8, 0xd2, 0x79, 0xe1, 0x2b, 0x98, 0xcb, 0xea, 0x2b, 0x58, 0x02, 0xd7,
+	0x28, 0xbe, 0x27, 0x2d, 0xf9, 0x81, 0x1a, 0xfd, 0xba, 0x5d, 0x4e, 0x2d, 0x97, 0x7c, 0x5c, 0x04,
+	0x6e, 0x25, 0xa3, 0x04, 0x4a, 0xda, 0x21, 0x6e, 0xcb, 0xcd, 0xa6, 0x79, 0x68, 0xc0, 0xe4, 0xf6,
+	0x5b, 0x58, 0x3d, 0x02, 0x32, 0xf0, 0xf1, 0x0a, 0xc4, 0x0b, 0x26, 0x2f, 0x80, 0x77, 0x30, 0xe8,
+	0x49, 0x3e, 0x86, 0x8e, 0xb7, 0x28, 0x03, 0xdd, 0x7c, 0x0b, 0x0d, 0x71, 0x59, 0x43, 0x74, 0xf3,
+	0xd5, 0x6c, 0x37, 0x08, 0x56, 0x77, 0xf9, 0xa1, 0x63, 0xba, 0xc2, 0x5a, 0x24, 0xf0, 0xe4, 0x05,
+	0xf0, 0x03, 0xa3, 0xc7, 0xe8, 0xad, 0x1a, 0xa9, 0x05, 0x16, 0x66, 0x7a, 0xe9, 0x76, 0xc9, 0x96,
+	0xf9, 0x90, 0x31, 0x77, 0x54, 0x81, 0x2f, 0xb9, 0x8a, 0x0e, 0xc6, 0x2b, 0x40, 0x2f, 0x37, 0xd0,
+	0x10, 0xb1, 0x7c, 0xb7, 0x6e, 0xcf, 0x7a, 0x21, 0x55, 0x2f, 0x03, 0xa0, 0x65, 0xcb, 0xaf, 0xf7,
+	0x0f, 0x90, 0xe4, 0x2a, 0x1a, 0x8b, 0x16, 0xc0, 0xaf, 0xa0, 0x7e, 0x26, 0xf3, 0x48, 0x19, 0xcc,
+	0x10, 0xac, 0x46, 0x0a, 0x85, 0x8e, 0xbc, 0x1c, 0x1b, 0x32, 0x08, 0x5b, 0x2f, 0x6a, 0x7e, 0xe0,
+	0x21, 0x97, 0x46, 0x49, 0x12, 0x1f, 0xd5, 0x28, 0x4c, 0x7d, 0x54, 0xa3, 0xfc, 0xca, 0x36, 0xaa,
+	0x80, 0x99, 0xc8, 0xb5, 0xaf, 0xe4, 0xd0, 0x74, 0x52, 0xb9, 0xae, 0x34, 0xdc, 0xab, 0x61, 0x0d,
+	0x77, 0x57, 0x61, 0xf9, 0x77, 0xe2, 0x6f, 0x17, 0xf4, 0x77, 0xf6, 0x76, 0x41, 0x9b, 0x57, 0x0b,
+	0x06, 0x1a, 0x5f, 0x2d, 0x98, 0x46, 0x03, 0xc4, 0x75, 0x6d, 0x17, 0x8c, 0x81, 0xfc, 0x43, 0x5e,
+	0x06, 0xb5, 0xcc, 0xc6, 0x8e, 0xe9, 0x38, 0xc4, 0xb8, 0x6c, 0x3f, 0xb0, 0xe8, 0x84, 0xd9, 0xa0,
+	0x72, 0x08, 0x49, 0x6f, 0x14, 0xfa, 0x25, 0xa1, 0x18, 0x68, 0x86, 0x03, 0x03, 0xbf, 0x8d, 0xc6,
+	0x3d, 0x5e, 0x42, 0xf5, 0x78, 0x56, 0xa6, 0x09, 0x90, 0x84, 0x2e, 0x04, 0x06, 0xc0, 0x85, 0x16,
+	0x03, 0xc2, 0xee, 0x58, 0xae, 0x5d, 0xa3, 0x96, 0x45, 0x5e, 0x1a, 0xa4, 0xaf, 0xd4, 0x84, 0x7d,
+	0x46, 0x10, 0xd6, 0x0c, 0x27, 0xd0, 0x78, 0x8c, 0x72, 0x69, 0x4e, 0xc8, 0x7d, 0x52, 0x06, 0x3b,
+	0x7e, 0x22, 0xb6, 0x30, 0x7e, 0x79, 0xa1, 0xe6, 0xe4, 0x22, 0x84, 0x26, 0xac, 0xbb, 0xdc, 0x9f,
+	0x77, 0xcd, 0x12, 0xa6, 0x8d, 0xf4, 0x24, 0xfd, 0x3f, 0x09, 0x1d, 0x6b, 0x0e, 0x02, 0xf4, 0xa8,
+	0xd4, 0x8f, 0x2f, 0x48, 0x06, 0x6a, 0x5e, 0x4e, 0xe7, 0x56, 0xd7, 0x00, 0x2b, 0xa2, 0x59, 0x42,
+	0x88, 0x81, 0x57, 0x25, 0xbd, 0x27, 0xdc, 0xde, 0x76, 0x89, 0xb7, 0x6d, 0x57, 0x8c, 0xd4, 0x34,
+	0x7c, 0xad, 0x0f, 0x15, 0x92, 0xaa, 0x43, 0xef, 0x8f, 0x22, 0xa4, 0x39, 0x4e, 0xc5, 0xd4, 0x43,
+	0x8e, 0xeb, 0xa1, 0x14, 0x66, 0x3b, 0xb1, 0x7d, 0xb5, 0x9e, 0xa2, 0xba, 0x44, 0xf3, 0x82, 0x07,
+	0x49, 0xa6, 0x2c, 0xdb, 0x5f, 0x0a, 0xf2, 0x14, 0x96, 0x25, 0x5e, 0x86, 0xb0, 0x22, 0x2f, 0x43,
+	0xc0, 0x33, 0x07, 0x5c, 0x62, 0xe2, 0x52, 0xd1, 0xb0, 0x78, 0x10, 0x02, 0x5f, 0x45, 0xa8, 0xca,
+	0x2e, 0x49, 0x2c, 0xf0, 0x65, 0x20, 0x7b, 0xe0, 0x0b, 0xc5, 0x86, 0x98, 0x97, 0x39, 0x34, 0x42,
+	0x9f, 0x16, 0x50, 0xf5, 0x9a, 0x6f, 0x6f, 0x6d, 0xc1, 0xf3, 0x10, 0x88, 0x26, 0x95, 0x58, 0x8a,
+	0x30, 0x07, 0xd5, 0xdf, 0x53, 0x10, 0x82, 0xfc, 0x50, 0x60, 0x0e, 0x5a, 0x12, 0x2f, 0x2a, 0x40,
+	0x16, 0xbe, 0x8f, 0xa6, 0x36, 0xed, 0x9a, 0x65, 0x68, 0xee, 0x5e, 0xdc, 0xa2, 0x95, 0x76, 0xea,
+	0x52, 0xfe, 0x17, 0x01, 0x23, 0x7e, 0xcb, 0xc0, 0x9b, 0xf1, 0x0c, 0x4f, 0xfe, 0x23, 0x09, 0x1d,
+	0x48, 0xac, 0x93, 0x45, 0xbe, 0xba, 0x8e, 0x26, 0x1a, 0x3c, 0xbf, 0x72, 0x69, 0x3d, 0xbf, 0xc6,
+	0xed, 0x58, 0xe0, 0x13, 0xa6, 0xd2, 0x9a, 0xb5, 0x23, 0xc6, 0x95, 0xfe, 0xae, 0x4b, 0xc1, 0xfd,
+	0x61, 0xe5, 0xd7, 0xa7, 0xe3, 0xba, 0xf5, 0x65, 0xdd, 0xb6, 0xec, 0xaa, 0xa9, 0x0b, 0xeb, 0x5e,
+	0x6a, 0x1d, 0xe1, 0x71, 0xb4, 0x3f, 0xc1, 0x39, 0x7c, 0xc4, 0x0b, 0x39, 0x68, 0x1f, 0x41, 0xa8,
+	0xc1, 0x05, 0x7c, 0x1f, 0x11, 0x6e, 0xdf, 0x75, 0x87, 0xb9, 0xe6, 0x7d, 0x81, 0xc5, 0x70, 0x2f,
+	0x2e, 0xe9, 0x65, 0x33, 0x5f, 0xc7, 0x71, 0xe3, 0xa2, 0xde, 0x61, 0x58, 0x89, 0xd4, 0x89, 0xbb,
+	0x6e, 0xed, 0x11, 0x42, 0xde, 0x15, 0x74, 0x28, 0x31, 0x17, 0xfa, 0x76, 0x12, 0x4d, 0x30, 0xa3,
+	0x6f, 0x23, 0xb7, 0xc6, 0xac, 0x48, 0x0d, 0x2a, 0xa4, 0xc3, 0x49, 0x65, 0x56, 0x6b, 0x15, 0xcd,
+	0x27, 0xcc, 0x67, 0x91, 0xc4, 0x23, 0xc8, 0x57, 0x51, 0x5f, 0xd5, 0x2b, 0x83, 0x84, 0x94, 0x4e,
+	0xdb, 0xfc, 0x86, 0x57, 0x8e, 0x61, 0x51, 0x08, 0x3c, 0x83, 0x86, 0x0c, 0x77, 0x4f, 0x75, 0x6b,
+	0x96, 0xb0, 0xbd, 0x18, 0xee, 0x9e, 0x52, 0xb3, 0xe4, 0x2f, 0xf5, 0xa3, 0x13, 0x2d, 0x7b, 0x92,
+	0xd6, 0xb5, 0x7b, 0x1e, 0x4d, 0x85, 0x0a, 0xa8, 0xe0, 0x9d, 0x03, 0x4e, 0x2b, 0x93, 0xf5, 0x82,
+	0x25, 0x9e, 0xd1, 0x43, 0x29, 0xe4, 0x3d, 0x09, 0xcd, 0x9a, 0x11, 0x37, 0x6b, 0xd5, 0x09, 0x1c,
+	0xa0, 0x41, 0x24, 0xe9, 0x8d, 0xd3, 0x36, 0xcc, 0x96, 0xbc, 0xd9, 0x24, 0x1f, 0x3f, 0x42, 0xf9,
+	0x04, 0x47, 0x6e, 0xde, 0x91, 0x81, 0xae, 0x9d, 0xb9, 0xa1, 0xf9, 0x83, 0x4e, 0x62, 0x6e, 0x6b,
+	0x55, 0xd1, 0x60, 0x6b, 0x55, 0x51, 0x01, 0x0d, 0x3f, 0xd0, 0x5c, 0xcb, 0xb4, 0xca, 0x1e, 0xbc,
+	0xc4, 0x13, 0x7c, 0xcb, 0xef, 0x09, 0xcb, 0x36, 0x5c, 0xf1, 0xef, 0x6e, 0x94, 0x32, 0x0a, 0x1e,
+	0x3d, 0x33, 0x70, 0x7d, 0xbc, 0x0f, 0x4d, 0xc4, 0x7b, 0x11, 0xf2, 0x90, 0x92, 0x42, 0x1e, 0x52,
+	0xd8, 0x0a, 0xbf, 0x44, 0x54, 0x73, 0x58, 0x68, 0x24, 0x28, 0xe3, 0x53, 0x0e, 0x02, 0x34, 0x24,
+	0x40, 0xee, 0x30, 0x0c, 0x18, 0x84, 0x89, 0xdd, 0x68, 0x72, 0x5c, 0x05, 0xd6, 0x17, 0x57, 0x81,
+	0x3d, 0x13, 0x72, 0x6e, 0x8e, 0x28, 0x1e, 0x02, 0xff, 0x64, 0xd8, 0x2a, 0x5f, 0x40, 0x07, 0x93,
+	0xb5, 0x76, 0x20, 0x07, 0x4f, 0x25, 0xa8, 0xec, 0xb0, 0x89, 0x02, 0x1f, 0x60, 0x35, 0xea, 0x84,
+	0x99, 0x1f, 0x6c, 0xe3, 0xaf, 0x44, 0x29, 0x85, 0xaa, 0x11, 0xd7, 0x4b, 0xe5, 0x80, 0x93, 0x94,
+	0x2c, 0xbf, 0x85, 0x0e, 0x26, 0x73, 0x26, 0xcb, 0x0b, 0x2e, 0x89, 0x5e, 0x20, 0xf2, 0x9f, 0x48,
+	0xe0, 0x8e, 0x95, 0x30, 0xd3, 0x60, 0x1f, 0xba, 0x83, 0x86, 0xa2, 0x42, 0xe9, 0x8b, 0x99, 0xc6,
+	0x52, 0x00, 0x8a, 0x7d, 0x1f, 0xb0, 0x7a, 0x66, 0x7c, 0x5b, 0xfc, 0xec, 0x5d, 0x34, 0xc0, 0x48,
+	0xc0, 0xff, 0x24, 0xa1, 0xe9, 0xa4, 0xcb, 0x23, 0xbe, 0x94, 0xdd, 0xa0, 0x11, 0x7d, 0x9c, 0xae,
+	0xb0, 0xd4, 0x05, 0x02, 0xef, 0xb3, 0xbc, 0xfa, 0x89, 0x6f, 0xff, 0xe0, 0x0b, 0xb9, 0x22, 0xbe,
+	0xd4, 0xfe, 0xe1, 0xc4, 0x60, 0x4c, 0xe1, 0x02, 0xb6, 0xf0, 0x28, 0xb4, 0xd8, 0x1f, 0xe3, 0xbf,
+	0x97, 0xd0, 0x54, 0xa4, 0x29, 0xb0, 0x67, 0x76, 0x6a, 0xb7, 0x09, 0xa8, 0xbc, 0xd4, 0x39, 0x00,
+	0x10, 0xb9, 0xc4, 0x88, 0x3c, 0x8f, 0x5f, 0xcd, 0x40, 0x24, 0x2b, 0xe4, 0x2d, 0x3c, 0x62, 0x67,
+	0xcb, 0x63, 0xfc, 0xf9, 0x9c, 0x70, 0xb4, 0x4c, 0x7a, 0xbc, 0x08, 0xaf, 0xa4, 0xef, 0x63, 0xab,
+	0xd7, 0x98, 0x0a, 0x57, 0xba, 0xc6, 0x01, 0x92, 0x37, 0x19, 0xc9, 0x1f, 0xc1, 0x6f, 0xb7, 0x27,
+	0xb9, 0xbe, 0x3b, 0x46, 0x56, 0x6d, 0x74, 0x78, 0x17, 0x1e, 0xc5, 0xe5, 0xd8, 0x24, 0x9e, 0x84,
+	0xdf, 0x01, 0xe9, 0x88, 0x27, 0x09, 0xaf, 0x31, 0x15, 0xae, 0x74, 0x8d, 0xd3, 0x0d, 0x4f, 0x22,
+	0x64, 0xc7, 0x79, 0x12, 0xdf, 0xe6, 0x1e, 0xe3, 0xbf, 0x94, 0x10, 0x6e, 0x7c, 0x62, 0x09, 0xbf,
+	0x9e, 0x9e, 0x86, 0xa4, 0x97, 0x9b, 0x0a, 0x17, 0x3b, 0xae, 0x0f, 0xb4, 0xbf, 0xc2, 0x68, 0x5f,
+	0xc4, 0x67, 0xda, 0xd3, 0xee, 0x03, 0x00, 0xd7, 0xe5, 0xe0, 0x2f, 0xe6, 0xd0, 0x89, 0x14, 0x6f,
+	0x26, 0xe1, 0x0c, 0x96, 0xed, 0x54, 0x6f, 0x35, 0x15, 0xd6, 0x7b, 0x07, 0x08, 0x4c, 0xb8, 0xc6,
+	0x98, 0xb0, 0x8c, 0x4b, 0xed, 0x99, 0xe0, 0x06, 0x88, 0xf5, 0x55, 0x11, 0x91, 0xa4, 0xf0, 0x67,
+	0x72, 0x48, 0x6e, 0xff, 0x6a, 0x13, 0xbe, 0x91, 0x9e, 0x8a, 0x34, 0xaf, 0x49, 0x15, 0x6e, 0xf6,
+	0x0c, 0x0f, 0x98, 0xb2, 0xcc, 0x98, 0x72, 0x11, 0x5f, 0x68, 0xcf, 0x14, 0x98, 0xe5, 0xaa, 0x43,
+	0x51, 0x63, 0xdb, 0xff, 0xef, 0x4b, 0x68, 0x24, 0xf4, 0x2c, 0x12, 0x7e, 0x39, 0x7d, 0x3f, 0x23,
+	0xcf, 0x2b, 0x15, 0x5e, 0xc9, 0x5e, 0x11, 0x28, 0x39, 0xc3, 0x28, 0x39, 0x85, 0x4f, 0xb6, 0xa7,
+	0x84, 0x07, 0x56, 0xd6, 0xe7, 0x76, 0xeb, 0x27, 0x83, 0xf0, 0xcd, 0x5e, 0xbd, 0x5c, 0xd4, 0xc1,
+	0xdc, 0x4e, 0xf7, 0x68, 0x53, 0x96, 0xb9, 0x9d, 0x60, 0x19, 0x8d, 0x0d, 0xe6, 0xd7, 0x72, 0xe8,
+	0xd9, 0xc6, 0xc6, 0x9b, 0x3c, 0x98, 0x81, 0xef, 0x74, 0x7a, 0x40, 0xb7, 0x7c, 0xf3, 0xa3, 0x70,
+	0xb7, 0xd7, 0xb0, 0xc0, 0xa9, 0xb7, 0x19, 0xa7, 0x6e, 0x63, 0x25, 0xb3, 0x34, 0x40, 0x1d, 0x1b,
+	0xea, 0x4c, 0x4b, 0x3a, 0x12, 0x7f, 0x2f, 0x07, 0xca, 0x94, 0x36, 0x2f, 0x70, 0xe0, 0xf5, 0x2e,
+	0x0e, 0xfa, 0xc4, 0xb7, 0x45, 0x0a, 0xb7, 0x7a, 0x88, 0x08, 0x9c, 0xd2, 0x19, 0xa7, 0xee, 0xe1,
+	0x77, 0xb2, 0x70, 0x2a, 0x6a, 0xd0, 0x6e, 0x2f, 0x45, 0xfc, 0x76, 0xae, 0xe9, 0xb3, 0x90, 0xa1,
+	0xd7, 0x3b, 0xb2, 0xec, 0xa3, 0x69, 0xde, 0x24, 0x29, 0xdc, 0xec, 0x19, 0x1e, 0x30, 0xeb, 0xa3,
+	0x8c, 0x59, 0x6f, 0xe3, 0x0f, 0x67, 0x60, 0x96, 0x16, 0x02, 0x6a, 0xcf, 0xa9, 0x5f, 0xcf, 0xa1,
+	0xc3, 0xad, 0x9e, 0x04, 0xc1, 0xab, 0xe9, 0x69, 0x6a, 0xfd, 0xba, 0x49, 0x61, 0xad, 0x07, 0x48,
+	0xc0, 0x17, 0xc2, 0xf8, 0xa2, 0xe2, 0x7b, 0xed, 0xf9, 0x42, 0x04, 0x94, 0x88, 0x5f, 0x0c, 0xde,
+	0xf0, 0x68, 0xcf, 0x9c, 0x9f, 0x8a, 0x6b, 0x56, 0xec, 0x51, 0x90, 0x2c, 0xd7, 0xac, 0xe4, 0xf7,
+	0x4c, 0x0a, 0x4b, 0x5d, 0x20, 0x00, 0x13, 0xee, 0x31, 0x26, 0xbc, 0x89, 0xef, 0xa4, 0x91, 0x3c,
+	0x18, 0xf5, 0xa6, 0x95, 0x81, 0xf8, 0x7f, 0x91, 0xd0, 0x4c, 0x93, 0x37, 0x98, 0x70, 0xa9, 0x9b,
+	0x17, 0x9c, 0x04, 0x0b, 0x2e, 0x77, 0x07, 0x92, 0xfd, 0x8c, 0x0a, 0x28, 0x6e, 0x7a, 0x46, 0xfd,
+	0x58, 0x42, 0xb3, 0x4d, 0x9f, 0x53, 0xc1, 0x19, 0xde, 0xad, 0x6a, 0xf1, 0x64, 0x4b, 0x61, 0xa5,
+	0x5b, 0x98, 0xec, 0x37, 0xd0, 0x26, 0x0f, 0x98, 0xe0, 0x3f, 0x96, 0xd0, 0x58, 0xf4, 0x21, 0x17,
+	0x7c, 0x2e, 0x7d, 0xef, 0x1a, 0x28, 0x3b, 0xdf, 0x51, 0x5d, 0x20, 0xe7, 0x43, 0x8c, 0x9c, 0x79,
+	0x7c, 0xba, 0x3d, 0x39, 0x21, 0x0a, 0xfe, 0x35, 0xfe, 0xea, 0x7a, 0xf4, 0xf1, 0x12, 0x7c, 0x25,
+	0xfb, 0x24, 0x4b, 0x7c, 0x41, 0xa5, 0xb0, 0xda, 0x3d, 0x50, 0x17, 0x9a, 0x03, 0xd3, 0x58, 0x78,
+	0x14, 0xf8, 0x96, 0x3e, 0xc6, 0xff, 0x20, 0x6e, 0x84, 0x11, 0x21, 0x25, 0xcb, 0x8d, 0x30, 0xe9,
+	0x8d, 0x96, 0x42, 0xb7, 0xee, 0xb0, 0xf2, 0x0a, 0x23, 0xed, 0x12, 0x7e, 0x3d, 0xab, 0x18, 0x14,
+	0x5b, 0x87, 0x5f, 0xc8, 0x81, 0xb2, 0xae, 0xe9, 0xdb, 0x02, 0xf8, 0x6a, 0x17, 0x37, 0xf8, 0xd8,
+	0x4b, 0x09, 0x85, 0x6b, 0x3d, 0xc1, 0x02, 0x1e, 0x7c, 0x98, 0xf1, 0x40, 0xc1, 0xeb, 0x59, 0x34,
+	0x02, 0x04, 0x50, 0x42, 0x1b, 0x71, 0xdc, 0x70, 0xc7, 0xb4, 0x61, 0x07, 0x12, 0x23, 0xc6, 0x71,
+	0x07, 0x4a, 0xbb, 0x58, 0x58, 0x7b, 0xa1, 0xd8, 0x0d, 0x04, 0x90, 0x7e, 0x9e, 0x91, 0xfe, 0x22,
+	0x7e, 0x21, 0xc3, 0xf0, 0xfb, 0x82, 0x86, 0x1f, 0x8a, 0x39, 0x1d, 0x09, 0x3b, 0xce, 0x32, 0xa7,
+	0x93, 0x82, 0xa0, 0x0b, 0x17, 0x3b, 0xae, 0x0f, 0x44, 0xdd, 0x62, 0x44, 0x5d, 0xc3, 0x6b, 0x29,
+	0xc6, 0x93, 0x99, 0x0a, 0x54, 0xdf, 0x06, 0x2d, 0x7c, 0xfc, 0x90, 0xe5, 0xf9, 0x8f, 0xf1, 0x7f,
+	0xc5, 0xff, 0xb7, 0x45, 0x24, 0x42, 0x39, 0x8b, 0x92, 0xab, 0x55, 0xa0, 0x74, 0xe1, 0x4a, 0xd7,
+	0x38, 0xc0, 0x82, 0x9b, 0x8c, 0x05, 0x6b, 0xf8, 0x4a, 0x86, 0x71, 0x8d, 0x7a, 0x21, 0x37, 0x9e,
+	0xb3, 0x07, 0x93, 0x63, 0xa3, 0x71, 0x07, 0xf3, 0x30, 0x1e, 0x9a, 0x5d, 0x28, 0x75, 0x85, 0x01,
+	0x44, 0x5f, 0x65, 0x44, 0x5f, 0xc6, 0xc5, 0x0c, 0x44, 0x8b, 0xf8, 0xeb, 0x04, 0x3d, 0xf6, 0x81,
+	0xc4, 0x50, 0xeb, 0x2c, 0x2b, 0xb7, 0x49, 0x1c, 0x77, 0xa1, 0xd8, 0x0d, 0x44, 0xf6, 0x95, 0x2b,
+	0x52, 0x55, 0x5b, 0xd0, 0xf0, 0xa3, 0xf8, 0xbe, 0x24, 0xc2, 0x53, 0x3b, 0xd9, 0x97, 0x62, 0x81,
+	0xb6, 0x85, 0x62, 0x37, 0x10, 0x40, 0xdd, 0x75, 0x46, 0xdd, 0x0a, 0xbe, 0x9c, 0x7e, 0x28, 0x3d,
+	0xfa, 0x2c, 0x0a, 0x0b, 0xe6, 0x5d, 0x78, 0x14, 0x09, 0xf4, 0x7d, 0x8c, 0xff, 0x33, 0x1e, 0x8d,
+	0x1b, 0x0f, 0x5b, 0xc5, 0x6b, 0x1d, 0x9e, 0xa3, 0x8d, 0x31, 0xb2, 0x85, 0xab, 0xbd, 0x80, 0xca,
+	0xae, 0x95, 0x8b, 0x9e, 0xce, 0xdc, 0xe8, 0x2e, 0xa8, 0xfb, 0x4a, 0x0e, 0x3d, 0x99, 0x26, 0x62,
+	0x14, 0x77, 0xaa, 0x90, 0x6a, 0x1a, 0xea, 0x5a, 0xb8, 0xd5, 0x43, 0x44, 0x60, 0x8a, 0xca, 0x98,
+	0xf2, 0x16, 0x7e, 0x33, 0xbb, 0xe6, 0x46, 0x07, 0xd0, 0xd6, 0xea, 0x9b, 0x4f, 0xe6, 0x62, 0xa1,
+	0xe4, 0xb1, 0x38, 0x53, 0xdc, 0x81, 0x64, 0x99, 0x1c, 0x50, 0x5b, 0x58, 0xeb, 0x01, 0x52, 0xf6,
+	0x53, 0x2f, 0x60, 0x0b, 0x0f, 0x65, 0x56, 0x75, 0x01, 0x16, 0xdb, 0x04, 0xff, 0x3d, 0xf9, 0x1f,
+	0x24, 0x89, 0x98, 0xc8, 0x4e, 0x44, 0xf5, 0xc4, 0xd8, 0xd8, 0xc2, 0x6a, 0xf7, 0x40, 0xc0, 0x85,
+	0x12, 0xe3, 0xc2, 0x05, 0x7c, 0x3e, 0xfb, 0xe4, 0xd8, 0xaa, 0x55, 0x2a, 0xaa, 0x41, 0xe9, 0xfa,
+	0xad, 0x5c, 0xcc, 0xd3, 0x37, 0x29, 0xf8, 0x0e, 0xbf, 0xd1, 0x9b, 0x20, 0x3e, 0xc1, 0x83, 0x1b,
+	0xbd, 0x82, 0x03, 0x4e, 0x68, 0x8c, 0x13, 0xef, 0xe0, 0xb7, 0x3a, 0xb9, 0x66, 0xb3, 0x7f, 0xd6,
+	0xa4, 0xf9, 0x4d, 0xa4, 0x22, 0x9e, 0xfa, 0x18, 0xff, 0x9d, 0x84, 0x26, 0x1b, 0xe2, 0x04, 0xf1,
+	0x85, 0xec, 0x84, 0x84, 0xe7, 0xc2, 0xeb, 0x9d, 0x56, 0xef, 0x62, 0xcf, 0xa4, 0xa3, 0x1e, 0x9b,
+	0xfb, 0x3f, 0x15, 0x8a, 0x85, 0xa4, 0x50, 0x83, 0x2c, 0x8a, 0x85, 0x16, 0x01, 0x0f, 0x85, 0x95,
+	0x6e, 0x61, 0x80, 0xe6, 0x1b, 0x8c, 0xe6, 0x55, 0xbc, 0x92, 0x46, 0xb1, 0xc4, 0xa4, 0x3c, 0xad,
+	0x0e, 0xa4, 0x56, 0xec, 0x72, 0x8c, 0xf8, 0x1f, 0x49, 0xf1, 0x87, 0x41, 0x43, 0x01, 0x0c, 0xb8,
+	0x83, 0xd7, 0xc0, 0x13, 0x82, 0x24, 0x0a, 0x2b, 0xdd, 0xc2, 0x00, 0xf1, 0x97, 0x18, 0xf1, 0xe7,
+	0xf0, 0x2b, 0x59, 0x96, 0x3c, 0xbf, 0x99, 0xc3, 0x5b, 0xee, 0x5f, 0x17, 0x4a, 0x95, 0x20, 0x28,
+	0x21, 0x8b, 0x52, 0x25, 0x1e, 0x64, 0x51, 0x38, 0xdf, 0x51, 0x5d, 0xa0, 0xe6, 0x02, 0xa3, 0xe6,
+	0x65, 0xfc, 0x62, 0x7b, 0x6a, 0x7c, 0xb3, 0x4a, 0xd4, 0xfb, 0xb4, 0xf6, 0xc2, 0x23, 0x1e, 0xc7,
+	0x91, 0x30, 0x72, 0xe1, 0x20, 0x85, 0x4e, 0x46, 0x2e, 0x21, 0x56, 0xa2, 0xb0, 0xd2, 0x2d, 0x4c,
+	0x17, 0x23, 0x27, 0x62, 0x01, 0x36, 0x19, 0x41, 0x9f, 0xc8, 0xc1, 0x09, 0x95, 0xec, 0x9c, 0x9f,
+	0xe5, 0x84, 0x6a, 0x19, 0x26, 0x50, 0x58, 0xed, 0x1e, 0x08, 0x88, 0x5e, 0x67, 0x44, 0x5f, 0xc5,
+	0xab, 0xed, 0x89, 0x16, 0xf1, 0x04, 0x06, 0x40, 0x89, 0xc0, 0x82, 0xd8, 0x6a, 0x0d, 0x98, 0x90,
+	0xec, 0xc8, 0x9f, 0x85, 0x09, 0x2d, 0x43, 0x0a, 0x0a, 0xab, 0xdd, 0x03, 0x65, 0x67, 0x42, 0x2d,
+	0x40, 0x52, 0x23, 0x61, 0x08, 0x31, 0x26, 0xfc, 0x87, 0x04, 0x01, 0xef, 0x09, 0xae, 0xff, 0x38,
+	0x83, 0xe2, 0xba, 0x79, 0xf8, 0x41, 0x61, 0xb9, 0x4b, 0x94, 0xec, 0x9b, 0xb5, 0x53, 0x37, 0x03,
+	0x84, 0x02, 0x0c, 0x62, 0x94, 0xbf, 0x1f, 0x38, 0x9b, 0x84, 0x03, 0x06, 0x32, 0x39, 0x9b, 0x24,
+	0x04, 0x2a, 0x14, 0x2e, 0x76, 0x5c, 0x1f, 0xe8, 0xbc, 0xc2, 0xe8, 0x5c, 0xc2, 0x17, 0xdb, 0xd3,
+	0xc9, 0xa2, 0x0f, 0x54, 0x5f, 0x40, 0xc4, 0x08, 0xfc, 0x85, 0x5c, 0xfc, 0x65, 0xb7, 0x98, 0xdf,
+	0x76, 0x27, 0xd7, 0xb7, 0x26, 0xfe, 0xed, 0x85, 0xab, 0xbd, 0x80, 0x02, 0x0e, 0x28, 0x8c, 0x03,
+	0xd7, 0xf1, 0xd5, 0x2c, 0x0a, 0x09, 0x00, 0xab, 0x97, 0x8b, 0x32, 0xe3, 0xaf, 0x85, 0x83, 0x5d,
+	0xd4, 0xed, 0x3c, 0x8b, 0x83, 0x5d, 0xa2, 0x3b, 0x7b, 0xe1, 0x52, 0xe7, 0x00, 0x40, 0xee, 0x39,
+	0x46, 0xee, 0x87, 0xf0, 0x62, 0x4a, 0x7b, 0x40, 0x88, 0x2e, 0xfc, 0x13, 0x09, 0x1d, 0x4c, 0x76,
+	0x3a, 0xcf, 0xb4, 0x87, 0xb7, 0x72, 0xa0, 0x2f, 0xac, 0x76, 0x0f, 0x14, 0x95, 0x31, 0xcf, 0x49,
+	0xa7, 0xe4, 0x73, 0xed, 0x89, 0xf5, 0x00, 0x4c, 0x65, 0xbe, 0xbd, 0x24, 0xa0, 0x1b, 0xff, 0xb3,
+	0x50, 0xaa, 0x35, 0x78, 0xb8, 0x66, 0x51, 0xaa, 0x35, 0x73, 0xc4, 0x2e, 0x94, 0xba, 0xc2, 0xc8,
+	0x6e, 0xad, 0x4b, 0x78, 0x23, 0x20, 0x3a, 0x79, 0x8b, 0x6f, 0x7e, 0xfd, 0x7b, 0x47, 0xa5, 0x6f,
+	0x7e, 0xef, 0xa8, 0xf4, 0x8f, 0xdf, 0x3b, 0x2a, 0x7d, 0xee, 0xfb, 0x47, 0x9f, 0xf8, 0xe6, 0xf7,
+	0x8f, 0x3e, 0xf1, 0xb7, 0xdf, 0x3f, 0xfa, 0xc4, 0xdb, 0x17, 0x1a, 0xdf, 0x8a, 0xae, 0xb7, 0xf7,
+	0x7c, 0xd0, 0xde, 0xee, 0x4b, 0x0b, 0x0f, 0xa3, 0x8d, 0xb2, 0x67, 0xa4, 0x37, 0x07, 0x59, 0xa0,
+	0xe8, 0x0b, 0xff, 0x3d, 0x00, 0xca, 0x65, 0xb6, 0x07, 0xf9, 0x7b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryValidatorConsumerAvailability returns whether a given validator
+	// is available to validate a given consumer chain
+	QueryValidatorConsumerAvailability(ctx context.Context, in *QueryValidatorConsumerAvailabilityRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAvailabilityResponse, error)
+	// QueryEmergencyOptOutCooldown returns the end of the cooldown during which a given
+	// validator cannot opt in to a given consumer chain after an emergency opt out
+	QueryEmergencyOptOutCooldown(ctx context.Context, in *QueryEmergencyOptOutCooldownRequest, opts ...grpc.CallOption) (*QueryEmergencyOptOutCooldownResponse, error)
+	// QueryReOptInCooldown returns the end of the cooldown during which a given validator
+	// cannot validate a given consumer chain after being jailed for an infraction committed on it
+	QueryReOptInCooldown(ctx context.Context, in *QueryReOptInCooldownRequest, opts ...grpc.CallOption) (*QueryReOptInCooldownResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryNextEpoch returns the current epoch and the height (and estimated time)
+	// at which the next epoch starts and VSC packets are queued for the consumer chains
+	QueryNextEpoch(ctx context.Context, in *QueryNextEpochRequest, opts ...grpc.CallOption) (*QueryNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
+	// QueryValidatorProviderExposure returns the current exposure of a given validator
+	// to the consumer chains, i.e., every consumer chain that the validator validates
+	// or is opted in to, together with its power, commission rate and opt-in status
+	QueryValidatorProviderExposure(ctx context.Context, in *QueryValidatorProviderExposureRequest, opts ...grpc.CallOption) (*QueryValidatorProviderExposureResponse, error)
+	// QueryConsumerTopology returns, for every launched consumer chain,
+	// the provider-side client, connection and channels needed to relay
+	// between the provider and the consumer chain
+	QueryConsumerTopology(ctx context.Context, in *QueryConsumerTopologyRequest, opts ...grpc.CallOption) (*QueryConsumerTopologyResponse, error)
+	// QueryVscIdToHeight returns the provider block height (and time) to which
+	// a valset update id sent to a consumer chain is mapped
+	QueryVscIdToHeight(ctx context.Context, in *QueryVscIdToHeightRequest, opts ...grpc.CallOption) (*QueryVscIdToHeightResponse, error)
+	// QueryConsumerRewardChannel returns the transfer channel on the provider
+	// registered as the source of the ICS rewards of a consumer chain
+	QueryConsumerRewardChannel(ctx context.Context, in *QueryConsumerRewardChannelRequest, opts ...grpc.CallOption) (*QueryConsumerRewardChannelResponse, error)
+	// QueryConsumerEndpoints returns the endpoints (e.g., peers, RPC and genesis URLs)
+	// advertised for a consumer chain
+	QueryConsumerEndpoints(ctx context.Context, in *QueryConsumerEndpointsRequest, opts ...grpc.CallOption) (*QueryConsumerEndpointsResponse, error)
+	// QuerySecurityOverview returns, for every launched or initialized consumer chain,
+	// the opted-in validators, the validators that would be selected if the validator set
+	// was computed now, and the fraction of the provider power securing the chain.
+	// It also returns the number of consumer chains each bonded validator would secure.
+	QuerySecurityOverview(ctx context.Context, in *QuerySecurityOverviewRequest, opts ...grpc.CallOption) (*QuerySecurityOverviewResponse, error)
+	// QueryConsumersByOwner returns the consumer chains owned by the given owner address
+	QueryConsumersByOwner(ctx context.Context, in *QueryConsumersByOwnerRequest, opts ...grpc.CallOption) (*QueryConsumersByOwnerResponse, error)
+	// QueryConsumerChainIdConflicts returns the chain ids that are used by more than one
+	// registered, initialized, or launched consumer chain. Note that a consumer chain is not
+	// launched while another consumer chain with the same chain id is launched.
+	QueryConsumerChainIdConflicts(ctx context.Context, in *QueryConsumerChainIdConflictsRequest, opts ...grpc.CallOption) (*QueryConsumerChainIdConflictsResponse, error)
+	// QueryConsumerChainsCarryingValidator returns the consumer chains that still carry
+	// the given validator, i.e., whose validator set contains the validator or for which
+	// the removal of the validator is still pending
+	QueryConsumerChainsCarryingValidator(ctx context.Context, in *QueryConsumerChainsCarryingValidatorRequest, opts ...grpc.CallOption) (*QueryConsumerChainsCarryingValidatorResponse, error)
+	// QueryConsumerLaunchChecklist returns the checks that a registered or initialized
+	// consumer chain has to pass before it can launch, e.g., the spawn time is set
+	QueryConsumerLaunchChecklist(ctx context.Context, in *QueryConsumerLaunchChecklistRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchChecklistResponse, error)
+	// QueryConsumerChainsFullDump returns all the stored parameters of every consumer chain,
+	// e.g., for auditing the provider state against the governance proposals
+	QueryConsumerChainsFullDump(ctx context.Context, in *QueryConsumerChainsFullDumpRequest, opts ...grpc.CallOption) (*QueryConsumerChainsFullDumpResponse, error)
+	// QueryConsumerValidatorSetAtHeight returns the validator set of a given consumer chain
+	// that was in effect on the provider at a given height, i.e., the validator set computed for
+	// the consumer chain at the latest epoch boundary at or before the height
+	QueryConsumerValidatorSetAtHeight(ctx context.Context, in *QueryConsumerValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetAtHeightResponse, error)
+	// QueryConsumerDump returns the complete provider-side state stored for a given consumer chain,
+	// e.g., for debugging a misbehaving consumer chain (for deleted chains only the retained state is returned)
+	QueryConsumerDump(ctx context.Context, in *QueryConsumerDumpRequest, opts ...grpc.CallOption) (*QueryConsumerDumpResponse, error)
+	// QueryRewardAttributionLog returns the last decisions of the provider on incoming
+	// transfers to the consumer rewards pool attributed to a given consumer chain
+	QueryRewardAttributionLog(ctx context.Context, in *QueryRewardAttributionLogRequest, opts ...grpc.CallOption) (*QueryRewardAttributionLogResponse, error)
+	// QueryConsumerClientStatus returns, for every launched consumer chain,
+	// the status and the remaining trusting period of its client
+	QueryConsumerClientStatus(ctx context.Context, in *QueryConsumerClientStatusRequest, opts ...grpc.CallOption) (*QueryConsumerClientStatusResponse, error)
+	// QueryTimeQueue returns, in chronological order, the entries of the time queue
+	// stored under the given key prefix, i.e., of the spawn-time, removal-time, stop-time or reminder-time queue.
+	// It is meant for debugging the scheduling of consumer chains.
+	QueryTimeQueue(ctx context.Context, in *QueryTimeQueueRequest, opts ...grpc.CallOption) (*QueryTimeQueueResponse, error)
+	// QueryConsumerGenesisBatch returns the genesis states of multiple consumer chains,
+	// together with their phase and chain id
+	QueryConsumerGenesisBatch(ctx context.Context, in *QueryConsumerGenesisBatchRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisBatchResponse, error)
+	// QuerySkippedDowntimeSlashes returns the downtime slashes of a given consumer chain
+	// that were not executed due to the downtime grace period of the consumer chain
+	QuerySkippedDowntimeSlashes(ctx context.Context, in *QuerySkippedDowntimeSlashesRequest, opts ...grpc.CallOption) (*QuerySkippedDowntimeSlashesResponse, error)
+	// QueryUnroutableSlashPackets returns the last slash packets of a given consumer chain
+	// that could not be routed to a provider validator
+	QueryUnroutableSlashPackets(ctx context.Context, in *QueryUnroutableSlashPacketsRequest, opts ...grpc.CallOption) (*QueryUnroutableSlashPacketsResponse, error)
+	// QueryPreOptInInfractions returns the downtime infractions of a given consumer chain
+	// that were dropped, since they were committed before the validators opted in
+	QueryPreOptInInfractions(ctx context.Context, in *QueryPreOptInInfractionsRequest, opts ...grpc.CallOption) (*QueryPreOptInInfractionsResponse, error)
+	// QueryTopNThreshold returns the minimum power (and bonded tokens) an active validator
+	// currently needs to be opted in automatically to a given Top N consumer chain
+	QueryTopNThreshold(ctx context.Context, in *QueryTopNThresholdRequest, opts ...grpc.CallOption) (*QueryTopNThresholdResponse, error)
+	// QueryConsumerEconomicSecurity returns the economic security records of a given consumer chain,
+	// i.e., the total power and provider tokens backing its validator set at every retained epoch
+	QueryConsumerEconomicSecurity(ctx context.Context, in *QueryConsumerEconomicSecurityRequest, opts ...grpc.CallOption) (*QueryConsumerEconomicSecurityResponse, error)
+	// QueryNextConsumerId returns the consumer id that is assigned to the next created consumer chain
+	QueryNextConsumerId(ctx context.Context, in *QueryNextConsumerIdRequest, opts ...grpc.CallOption) (*QueryNextConsumerIdResponse, error)
+	// SimulateCreateConsumer runs the handling of a MsgCreateConsumer on a cached context, i.e., without
+	// persisting anything, and returns the consumer id that would be assigned to the consumer chain
+	// together with its normalized parameters
+	SimulateCreateConsumer(ctx context.Context, in *QuerySimulateCreateConsumerRequest, opts ...grpc.CallOption) (*QuerySimulateCreateConsumerResponse, error)
+	// QueryPendingVSCPackets returns the VSC packets queued on the provider to be sent
+	// to a given consumer chain, in the order in which they are sent
+	QueryPendingVSCPackets(ctx context.Context, in *QueryPendingVSCPacketsRequest, opts ...grpc.CallOption) (*QueryPendingVSCPacketsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
+	out := new(QueryConsumerGenesisResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
+	out := new(QueryConsumerChainsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
+	out := new(QueryValidatorConsumerAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
+	out := new(QueryValidatorProviderAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	out := new(QueryRegisteredConsumerRewardDenomsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	out := new(QueryAllPairsValConsAddrByConsumerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	out := new(QueryConsumerChainOptedInValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	out := new(QueryValidatorConsumerCommissionRateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerAvailability(ctx context.Context, in *QueryValidatorConsumerAvailabilityRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAvailabilityResponse, error) {
+	out := new(QueryValidatorConsumerAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryEmergencyOptOutCooldown(ctx context.Context, in *QueryEmergencyOptOutCooldownRequest, opts ...grpc.CallOption) (*QueryEmergencyOptOutCooldownResponse, error) {
+	out := new(QueryEmergencyOptOutCooldownResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryEmergencyOptOutCooldown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryReOptInCooldown(ctx context.Context, in *QueryReOptInCooldownRequest, opts ...grpc.CallOption) (*QueryReOptInCooldownResponse, error) {
+	out := new(QueryReOptInCooldownResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryReOptInCooldown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
+	out := new(QueryConsumerValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
+	out := new(QueryBlocksUntilNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryNextEpoch(ctx context.Context, in *QueryNextEpochRequest, opts ...grpc.CallOption) (*QueryNextEpochResponse, error) {
+	out := new(QueryNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryNextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
+	out := new(QueryConsumerIdFromClientIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
+	out := new(QueryConsumerChainResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorProviderExposure(ctx context.Context, in *QueryValidatorProviderExposureRequest, opts ...grpc.CallOption) (*QueryValidatorProviderExposureResponse, error) {
+	out := new(QueryValidatorProviderExposureResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderExposure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerTopology(ctx context.Context, in *QueryConsumerTopologyRequest, opts ...grpc.CallOption) (*QueryConsumerTopologyResponse, error) {
+	out := new(QueryConsumerTopologyResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryVscIdToHeight(ctx context.Context, in *QueryVscIdToHeightRequest, opts ...grpc.CallOption) (*QueryVscIdToHeightResponse, error) {
+	out := new(QueryVscIdToHeightResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryVscIdToHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerRewardChannel(ctx context.Context, in *QueryConsumerRewardChannelRequest, opts ...grpc.CallOption) (*QueryConsumerRewardChannelResponse, error) {
+	out := new(QueryConsumerRewardChannelResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerEndpoints(ctx context.Context, in *QueryConsumerEndpointsRequest, opts ...grpc.CallOption) (*QueryConsumerEndpointsResponse, error) {
+	out := new(QueryConsumerEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySecurityOverview(ctx context.Context, in *QuerySecurityOverviewRequest, opts ...grpc.CallOption) (*QuerySecurityOverviewResponse, error) {
+	out := new(QuerySecurityOverviewResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QuerySecurityOverview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumersByOwner(ctx context.Context, in *QueryConsumersByOwnerRequest, opts ...grpc.CallOption) (*QueryConsumersByOwnerResponse, error) {
+	out := new(QueryConsumersByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumersByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainIdConflicts(ctx context.Context, in *QueryConsumerChainIdConflictsRequest, opts ...grpc.CallOption) (*QueryConsumerChainIdConflictsResponse, error) {
+	out := new(QueryConsumerChainIdConflictsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainIdConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainsCarryingValidator(ctx context.Context, in *QueryConsumerChainsCarryingValidatorRequest, opts ...grpc.CallOption) (*QueryConsumerChainsCarryingValidatorResponse, error) {
+	out := new(QueryConsumerChainsCarryingValidatorResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsCarryingValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerLaunchChecklist(ctx context.Context, in *QueryConsumerLaunchChecklistRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchChecklistResponse, error) {
+	out := new(QueryConsumerLaunchChecklistResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchChecklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainsFullDump(ctx context.Context, in *QueryConsumerChainsFullDumpRequest, opts ...grpc.CallOption) (*QueryConsumerChainsFullDumpResponse, error) {
+	out := new(QueryConsumerChainsFullDumpResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsFullDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidatorSetAtHeight(ctx context.Context, in *QueryConsumerValidatorSetAtHeightRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorSetAtHeightResponse, error) {
+	out := new(QueryConsumerValidatorSetAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSetAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerDump(ctx context.Context, in *QueryConsumerDumpRequest, opts ...grpc.CallOption) (*QueryConsumerDumpResponse, error) {
+	out := new(QueryConsumerDumpResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRewardAttributionLog(ctx context.Context, in *QueryRewardAttributionLogRequest, opts ...grpc.CallOption) (*QueryRewardAttributionLogResponse, error) {
+	out := new(QueryRewardAttributionLogResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRewardAttributionLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerClientStatus(ctx context.Context, in *QueryConsumerClientStatusRequest, opts ...grpc.CallOption) (*QueryConsumerClientStatusResponse, error) {
+	out := new(QueryConsumerClientStatusResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTimeQueue(ctx context.Context, in *QueryTimeQueueRequest, opts ...grpc.CallOption) (*QueryTimeQueueResponse, error) {
+	out := new(QueryTimeQueueResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryTimeQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerGenesisBatch(ctx context.Context, in *QueryConsumerGenesisBatchRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisBatchResponse, error) {
+	out := new(QueryConsumerGenesisBatchResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuerySkippedDowntimeSlashes(ctx context.Context, in *QuerySkippedDowntimeSlashesRequest, opts ...grpc.CallOption) (*QuerySkippedDowntimeSlashesResponse, error) {
+	out := new(QuerySkippedDowntimeSlashesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QuerySkippedDowntimeSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryUnroutableSlashPackets(ctx context.Context, in *QueryUnroutableSlashPacketsRequest, opts ...grpc.CallOption) (*QueryUnroutableSlashPacketsResponse, error) {
+	out := new(QueryUnroutableSlashPacketsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryUnroutableSlashPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPreOptInInfractions(ctx context.Context, in *QueryPreOptInInfractionsRequest, opts ...grpc.CallOption) (*QueryPreOptInInfractionsResponse, error) {
+	out := new(QueryPreOptInInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryPreOptInInfractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryTopNThreshold(ctx context.Context, in *QueryTopNThresholdRequest, opts ...grpc.CallOption) (*QueryTopNThresholdResponse, error) {
+	out := new(QueryTopNThresholdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryTopNThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerEconomicSecurity(ctx context.Context, in *QueryConsumerEconomicSecurityRequest, opts ...grpc.CallOption) (*QueryConsumerEconomicSecurityResponse, error) {
+	out := new(QueryConsumerEconomicSecurityResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerEconomicSecurity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryNextConsumerId(ctx context.Context, in *QueryNextConsumerIdRequest, opts ...grpc.CallOption) (*QueryNextConsumerIdResponse, error) {
+	out := new(QueryNextConsumerIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryNextConsumerId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateCreateConsumer(ctx context.Context, in *QuerySimulateCreateConsumerRequest, opts ...grpc.CallOption) (*QuerySimulateCreateConsumerResponse, error) {
+	out := new(QuerySimulateCreateConsumerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/SimulateCreateConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingVSCPackets(ctx context.Context, in *QueryPendingVSCPacketsRequest, opts ...grpc.CallOption) (*QueryPendingVSCPacketsResponse, error) {
+	out := new(QueryPendingVSCPacketsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryPendingVSCPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryValidatorConsumerAvailability returns whether a given validator
+	// is available to validate a given consumer chain
+	QueryValidatorConsumerAvailability(context.Context, *QueryValidatorConsumerAvailabilityRequest) (*QueryValidatorConsumerAvailabilityResponse, error)
+	// QueryEmergencyOptOutCooldown returns the end of the cooldown during which a given
+	// validator cannot opt in to a given consumer chain after an emergency opt out
+	QueryEmergencyOptOutCooldown(context.Context, *QueryEmergencyOptOutCooldownRequest) (*QueryEmergencyOptOutCooldownResponse, error)
+	// QueryReOptInCooldown returns the end of the cooldown during which a given validator
+	// cannot validate a given consumer chain after being jailed for an infraction committed on it
+	QueryReOptInCooldown(context.Context, *QueryReOptInCooldownRequest) (*QueryReOptInCooldownResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryNextEpoch returns the current epoch and the height (and estimated time)
+	// at which the next epoch starts and VSC packets are queued for the consumer chains
+	QueryNextEpoch(context.Context, *QueryNextEpochRequest) (*QueryNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
+	// QueryValidatorProviderExposure returns the current exposure of a given validator
+	// to the consumer chains, i.e., every consumer chain that the validator validates
+	// or is opted in to, together with its power, commission rate and opt-in status
+	QueryValidatorProviderExposure(context.Context, *QueryValidatorProviderExposureRequest) (*QueryValidatorProviderExposureResponse, error)
+	// QueryConsumerTopology returns, for every launched consumer chain,
+	// the provider-side client, connection and channels needed to relay
+	// between the provider and the consumer chain
+	QueryConsumerTopology(context.Context, *QueryConsumerTopologyRequest) (*QueryConsumerTopologyResponse, error)
+	// QueryVscIdToHeight returns the provider block height (and time) to which
+	// a valset update id sent to a consumer chain is mapped
+	QueryVscIdToHeight(context.Context, *QueryVscIdToHeightRequest) (*QueryVscIdToHeightResponse, error)
+	// QueryConsumerRewardChannel returns the transfer channel on the provider
+	// registered as the source of the ICS rewards of a consumer chain
+	QueryConsumerRewardChannel(context.Context, *QueryConsumerRewardChannelRequest) (*QueryConsumerRewardChannelResponse, error)
+	// QueryConsumerEndpoints returns the endpoints (e.g., peers, RPC and genesis URLs)
+	// advertised for a consumer chain
+	QueryConsumerEndpoints(context.Context, *QueryConsumerEndpointsRequest) (*QueryConsumerEndpointsResponse, error)
+	// QuerySecurityOverview returns, for every launched or initialized consumer chain,
+	// the opted-in validators, the validators that would be selected if the validator set
+	// was computed now, and the fraction of the provider power securing the chain.
+	// It also returns the number of consumer chains each bonded validator would secure.
+	QuerySecurityOverview(context.Context, *QuerySecurityOverviewRequest) (*QuerySecurityOverviewResponse, error)
+	// QueryConsumersByOwner returns the consumer chains owned by the given owner address
+	QueryConsumersByOwner(context.Context, *QueryConsumersByOwnerRequest) (*QueryConsumersByOwnerResponse, error)
+	// QueryConsumerChainIdConflicts returns the chain ids that are used by more than one
+	// registered, initialized, or launched consumer chain. Note that a consumer chain is not
+	// launched while another consumer chain with the same chain id is launched.
+	QueryConsumerChainIdConflicts(context.Context, *QueryConsumerChainIdConflictsRequest) (*QueryConsumerChainIdConflictsResponse, error)
+	// QueryConsumerChainsCarryingValidator returns the consumer chains that still carry
+	// the given validator, i.e., whose validator set contains the validator or for which
+	// the removal of the validator is still pending
+	QueryConsumerChainsCarryingValidator(context.Context, *QueryConsumerChainsCarryingValidatorRequest) (*QueryConsumerChainsCarryingValidatorResponse, error)
+	// QueryConsumerLaunchChecklist returns the checks that a registered or initialized
+	// consumer chain has to pass before it can launch, e.g., the spawn time is set
+	QueryConsumerLaunchChecklist(context.Context, *QueryConsumerLaunchChecklistRequest) (*QueryConsumerLaunchChecklistResponse, error)
+	// QueryConsumerChainsFullDump returns all the stored parameters of every consumer chain,
+	// e.g., for auditing the provider state against the governance proposals
+	QueryConsumerChainsFullDump(context.Context, *QueryConsumerChainsFullDumpRequest) (*QueryConsumerChainsFullDumpResponse, error)
+	// QueryConsumerValidatorSetAtHeight returns the validator set of a given consumer chain
+	// that was in effect on the provider at a given height, i.e., the validator set computed for
+	// the consumer chain at the latest epoch boundary at or before the height
+	QueryConsumerValidatorSetAtHeight(context.Context, *QueryConsumerValidatorSetAtHeightRequest) (*QueryConsumerValidatorSetAtHeightResponse, error)
+	// QueryConsumerDump returns the complete provider-side state stored for a given consumer chain,
+	// e.g., for debugging a misbehaving consumer chain (for deleted chains only the retained state is returned)
+	QueryConsumerDump(context.Context, *QueryConsumerDumpRequest) (*QueryConsumerDumpResponse, error)
+	// QueryRewardAttributionLog returns the last decisions of the provider on incoming
+	// transfers to the consumer rewards pool attributed to a given consumer chain
+	QueryRewardAttributionLog(context.Context, *QueryRewardAttributionLogRequest) (*QueryRewardAttributionLogResponse, error)
+	// QueryConsumerClientStatus returns, for every launched consumer chain,
+	// the status and the remaining trusting period of its client
+	QueryConsumerClientStatus(context.Context, *QueryConsumerClientStatusRequest) (*QueryConsumerClientStatusResponse, error)
+	// QueryTimeQueue returns, in chronological order, the entries of the time queue
+	// stored under the given key prefix, i.e., of the spawn-time, removal-time, stop-time or reminder-time queue.
+	// It is meant for debugging the scheduling of consumer chains.
+	QueryTimeQueue(context.Context, *QueryTimeQueueRequest) (*QueryTimeQueueResponse, error)
+	// QueryConsumerGenesisBatch returns the genesis states of multiple consumer chains,
+	// together with their phase and chain id
+	QueryConsumerGenesisBatch(context.Context, *QueryConsumerGenesisBatchRequest) (*QueryConsumerGenesisBatchResponse, error)
+	// QuerySkippedDowntimeSlashes returns the downtime slashes of a given consumer chain
+	// that were not executed due to the downtime grace period of the consumer chain
+	QuerySkippedDowntimeSlashes(context.Context, *QuerySkippedDowntimeSlashesRequest) (*QuerySkippedDowntimeSlashesResponse, error)
+	// QueryUnroutableSlashPackets returns the last slash packets of a given consumer chain
+	// that could not be routed to a provider validator
+	QueryUnroutableSlashPackets(context.Context, *QueryUnroutableSlashPacketsRequest) (*QueryUnroutableSlashPacketsResponse, error)
+	// QueryPreOptInInfractions returns the downtime infractions of a given consumer chain
+	// that were dropped, since they were committed before the validators opted in
+	QueryPreOptInInfractions(context.Context, *QueryPreOptInInfractionsRequest) (*QueryPreOptInInfractionsResponse, error)
+	// QueryTopNThreshold returns the minimum power (and bonded tokens) an active validator
+	// currently needs to be opted in automatically to a given Top N consumer chain
+	QueryTopNThreshold(context.Context, *QueryTopNThresholdRequest) (*QueryTopNThresholdResponse, error)
+	// QueryConsumerEconomicSecurity returns the economic security records of a given consumer chain,
+	// i.e., the total power and provider tokens backing its validator set at every retained epoch
+	QueryConsumerEconomicSecurity(context.Context, *QueryConsumerEconomicSecurityRequest) (*QueryConsumerEconomicSecurityResponse, error)
+	// QueryNextConsumerId returns the consumer id that is assigned to the next created consumer chain
+	QueryNextConsumerId(context.Context, *QueryNextConsumerIdRequest) (*QueryNextConsumerIdResponse, error)
+	// SimulateCreateConsumer runs the handling of a MsgCreateConsumer on a cached context, i.e., without
+	// persisting anything, and returns the consumer id that would be assigned to the consumer chain
+	// together with its normalized parameters
+	SimulateCreateConsumer(context.Context, *QuerySimulateCreateConsumerRequest) (*QuerySimulateCreateConsumerResponse, error)
+	// QueryPendingVSCPackets returns the VSC packets queued on the provider to be sent
+	// to a given consumer chain, in the order in which they are sent
+	QueryPendingVSCPackets(context.Context, *QueryPendingVSCPacketsRequest) (*QueryPendingVSCPacketsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerAvailability(ctx context.Context, req *QueryValidatorConsumerAvailabilityRequest) (*QueryValidatorConsumerAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAvailability not implemented")
+}
+func (*UnimplementedQueryServer) QueryEmergencyOptOutCooldown(ctx context.Context, req *QueryEmergencyOptOutCooldownRequest) (*QueryEmergencyOptOutCooldownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryEmergencyOptOutCooldown not implemented")
+}
+func (*UnimplementedQueryServer) QueryReOptInCooldown(ctx context.Context, req *QueryReOptInCooldownRequest) (*QueryReOptInCooldownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryReOptInCooldown not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+}
+func (*UnimplementedQueryServer) QueryNextEpoch(ctx context.Context, req *QueryNextEpochRequest) (*QueryNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextEpoch not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorProviderExposure(ctx context.Context, req *QueryValidatorProviderExposureRequest) (*QueryValidatorProviderExposureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderExposure not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerTopology(ctx context.Context, req *QueryConsumerTopologyRequest) (*QueryConsumerTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerTopology not implemented")
+}
+func (*UnimplementedQueryServer) QueryVscIdToHeight(ctx context.Context, req *QueryVscIdToHeightRequest) (*QueryVscIdToHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVscIdToHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerRewardChannel(ctx context.Context, req *QueryConsumerRewardChannelRequest) (*QueryConsumerRewardChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerRewardChannel not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerEndpoints(ctx context.Context, req *QueryConsumerEndpointsRequest) (*QueryConsumerEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerEndpoints not implemented")
+}
+func (*UnimplementedQueryServer) QuerySecurityOverview(ctx context.Context, req *QuerySecurityOverviewRequest) (*QuerySecurityOverviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySecurityOverview not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumersByOwner(ctx context.Context, req *QueryConsumersByOwnerRequest) (*QueryConsumersByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumersByOwner not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainIdConflicts(ctx context.Context, req *QueryConsumerChainIdConflictsRequest) (*QueryConsumerChainIdConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainIdConflicts not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainsCarryingValidator(ctx context.Context, req *QueryConsumerChainsCarryingValidatorRequest) (*QueryConsumerChainsCarryingValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsCarryingValidator not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerLaunchChecklist(ctx context.Context, req *QueryConsumerLaunchChecklistRequest) (*QueryConsumerLaunchChecklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerLaunchChecklist not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainsFullDump(ctx context.Context, req *QueryConsumerChainsFullDumpRequest) (*QueryConsumerChainsFullDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsFullDump not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidatorSetAtHeight(ctx context.Context, req *QueryConsumerValidatorSetAtHeightRequest) (*QueryConsumerValidatorSetAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidatorSetAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerDump(ctx context.Context, req *QueryConsumerDumpRequest) (*QueryConsumerDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerDump not implemented")
+}
+func (*UnimplementedQueryServer) QueryRewardAttributionLog(ctx context.Context, req *QueryRewardAttributionLogRequest) (*QueryRewardAttributionLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRewardAttributionLog not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerClientStatus(ctx context.Context, req *QueryConsumerClientStatusRequest) (*QueryConsumerClientStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerClientStatus not implemented")
+}
+func (*UnimplementedQueryServer) QueryTimeQueue(ctx context.Context, req *QueryTimeQueueRequest) (*QueryTimeQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTimeQueue not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerGenesisBatch(ctx context.Context, req *QueryConsumerGenesisBatchRequest) (*QueryConsumerGenesisBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisBatch not implemented")
+}
+func (*UnimplementedQueryServer) QuerySkippedDowntimeSlashes(ctx context.Context, req *QuerySkippedDowntimeSlashesRequest) (*QuerySkippedDowntimeSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySkippedDowntimeSlashes not implemented")
+}
+func (*UnimplementedQueryServer) QueryUnroutableSlashPackets(ctx context.Context, req *QueryUnroutableSlashPacketsRequest) (*QueryUnroutableSlashPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUnroutableSlashPackets not implemented")
+}
+func (*UnimplementedQueryServer) QueryPreOptInInfractions(ctx context.Context, req *QueryPreOptInInfractionsRequest) (*QueryPreOptInInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPreOptInInfractions not implemented")
+}
+func (*UnimplementedQueryServer) QueryTopNThreshold(ctx context.Context, req *QueryTopNThresholdRequest) (*QueryTopNThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTopNThreshold not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerEconomicSecurity(ctx context.Context, req *QueryConsumerEconomicSecurityRequest) (*QueryConsumerEconomicSecurityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerEconomicSecurity not implemented")
+}
+func (*UnimplementedQueryServer) QueryNextConsumerId(ctx context.Context, req *QueryNextConsumerIdRequest) (*QueryNextConsumerIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextConsumerId not implemented")
+}
+func (*UnimplementedQueryServer) SimulateCreateConsumer(ctx context.Context, req *QuerySimulateCreateConsumerRequest) (*QuerySimulateCreateConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateCreateConsumer not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingVSCPackets(ctx context.Context, req *QueryPendingVSCPacketsRequest) (*QueryPendingVSCPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingVSCPackets not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorProviderAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredConsumerRewardDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPairsValConsAddrByConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainOptedInValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerCommissionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerAvailability(ctx, req.(*QueryValidatorConsumerAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryEmergencyOptOutCooldown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmergencyOptOutCooldownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryEmergencyOptOutCooldown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryEmergencyOptOutCooldown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryEmergencyOptOutCooldown(ctx, req.(*QueryEmergencyOptOutCooldownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryReOptInCooldown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReOptInCooldownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryReOptInCooldown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryReOptInCooldown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryReOptInCooldown(ctx, req.(*QueryReOptInCooldownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksUntilNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryNextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextEpoch(ctx, req.(*QueryNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerIdFromClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorProviderExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorProviderExposureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorProviderExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderExposure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorProviderExposure(ctx, req.(*QueryValidatorProviderExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerTopology(ctx, req.(*QueryConsumerTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryVscIdToHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVscIdToHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVscIdToHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryVscIdToHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVscIdToHeight(ctx, req.(*QueryVscIdToHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerRewardChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRewardChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerRewardChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerRewardChannel(ctx, req.(*QueryConsumerRewardChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerEndpoints(ctx, req.(*QueryConsumerEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySecurityOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecurityOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySecurityOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QuerySecurityOverview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySecurityOverview(ctx, req.(*QuerySecurityOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumersByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumersByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumersByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumersByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumersByOwner(ctx, req.(*QueryConsumersByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainIdConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainIdConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainIdConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainIdConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainIdConflicts(ctx, req.(*QueryConsumerChainIdConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainsCarryingValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsCarryingValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainsCarryingValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsCarryingValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainsCarryingValidator(ctx, req.(*QueryConsumerChainsCarryingValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerLaunchChecklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerLaunchChecklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerLaunchChecklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchChecklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerLaunchChecklist(ctx, req.(*QueryConsumerLaunchChecklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainsFullDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsFullDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainsFullDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsFullDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainsFullDump(ctx, req.(*QueryConsumerChainsFullDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidatorSetAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorSetAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidatorSetAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorSetAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidatorSetAtHeight(ctx, req.(*QueryConsumerValidatorSetAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerDump(ctx, req.(*QueryConsumerDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRewardAttributionLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardAttributionLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRewardAttributionLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRewardAttributionLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRewardAttributionLog(ctx, req.(*QueryRewardAttributionLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerClientStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerClientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerClientStatus(ctx, req.(*QueryConsumerClientStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTimeQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTimeQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryTimeQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTimeQueue(ctx, req.(*QueryTimeQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerGenesisBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesisBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesisBatch(ctx, req.(*QueryConsumerGenesisBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySkippedDowntimeSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedDowntimeSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySkippedDowntimeSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QuerySkippedDowntimeSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySkippedDowntimeSlashes(ctx, req.(*QuerySkippedDowntimeSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryUnroutableSlashPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnroutableSlashPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryUnroutableSlashPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryUnroutableSlashPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryUnroutableSlashPackets(ctx, req.(*QueryUnroutableSlashPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPreOptInInfractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreOptInInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPreOptInInfractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryPreOptInInfractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPreOptInInfractions(ctx, req.(*QueryPreOptInInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryTopNThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopNThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTopNThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryTopNThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTopNThreshold(ctx, req.(*QueryTopNThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerEconomicSecurity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerEconomicSecurityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerEconomicSecurity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerEconomicSecurity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerEconomicSecurity(ctx, req.(*QueryConsumerEconomicSecurityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNextConsumerId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextConsumerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextConsumerId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryNextConsumerId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextConsumerId(ctx, req.(*QueryNextConsumerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateCreateConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateCreateConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateCreateConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/SimulateCreateConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateCreateConsumer(ctx, req.(*QuerySimulateCreateConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingVSCPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVSCPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingVSCPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryPendingVSCPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingVSCPackets(ctx, req.(*QueryPendingVSCPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.provider.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryConsumerGenesis",
+			Handler:    _Query_QueryConsumerGenesis_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChains",
+			Handler:    _Query_QueryConsumerChains_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerAddr",
+			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
+		},
+		{
+			MethodName: "QueryValidatorProviderAddr",
+			Handler:    _Query_QueryValidatorProviderAddr_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QueryRegisteredConsumerRewardDenoms",
+			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
+		},
+		{
+			MethodName: "QueryAllPairsValConsAddrByConsumer",
+			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainOptedInValidators",
+			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainsValidatorHasToValidate",
+			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerCommissionRate",
+			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerAvailability",
+			Handler:    _Query_QueryValidatorConsumerAvailability_Handler,
+		},
+		{
+			MethodName: "QueryEmergencyOptOutCooldown",
+			Handler:    _Query_QueryEmergencyOptOutCooldown_Handler,
+		},
+		{
+			MethodName: "QueryReOptInCooldown",
+			Handler:    _Query_QueryReOptInCooldown_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidators",
+			Handler:    _Query_QueryConsumerValidators_Handler,
+		},
+		{
+			MethodName: "QueryBlocksUntilNextEpoch",
+			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
+		},
+		{
+			MethodName: "QueryNextEpoch",
+			Handler:    _Query_QueryNextEpoch_Handler,
+		},
+		{
+			MethodName: "QueryConsumerIdFromClientId",
+			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChain",
+			Handler:    _Query_QueryConsumerChain_Handler,
+		},
+		{
+			MethodName: "QueryValidatorProviderExposure",
+			Handler:    _Query_QueryValidatorProviderExposure_Handler,
+		},
+		{
+			MethodName: "QueryConsumerTopology",
+			Handler:    _Query_QueryConsumerTopology_Handler,
+		},
+		{
+			MethodName: "QueryVscIdToHeight",
+			Handler:    _Query_QueryVscIdToHeight_Handler,
+		},
+		{
+			MethodName: "QueryConsumerRewardChannel",
+			Handler:    _Query_QueryConsumerRewardChannel_Handler,
+		},
+		{
+			MethodName: "QueryConsumerEndpoints",
+			Handler:    _Query_QueryConsumerEndpoints_Handler,
+		},
+		{
+			MethodName: "QuerySecurityOverview",
+			Handler:    _Query_QuerySecurityOverview_Handler,
+		},
+		{
+			MethodName: "QueryConsumersByOwner",
+			Handler:    _Query_QueryConsumersByOwner_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainIdConflicts",
+			Handler:    _Query_QueryConsumerChainIdConflicts_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainsCarryingValidator",
+			Handler:    _Query_QueryConsumerChainsCarryingValidator_Handler,
+		},
+		{
+			MethodName: "QueryConsumerLaunchChecklist",
+			Handler:    _Query_QueryConsumerLaunchChecklist_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainsFullDump",
+			Handler:    _Query_QueryConsumerChainsFullDump_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidatorSetAtHeight",
+			Handler:    _Query_QueryConsumerValidatorSetAtHeight_Handler,
+		},
+		{
+			MethodName: "QueryConsumerDump",
+			Handler:    _Query_QueryConsumerDump_Handler,
+		},
+		{
+			MethodName: "QueryRewardAttributionLog",
+			Handler:    _Query_QueryRewardAttributionLog_Handler,
+		},
+		{
+			MethodName: "QueryConsumerClientStatus",
+			Handler:    _Query_QueryConsumerClientStatus_Handler,
+		},
+		{
+			MethodName: "QueryTimeQueue",
+			Handler:    _Query_QueryTimeQueue_Handler,
+		},
+		{
+			MethodName: "QueryConsumerGenesisBatch",
+			Handler:    _Query_QueryConsumerGenesisBatch_Handler,
+		},
+		{
+			MethodName: "QuerySkippedDowntimeSlashes",
+			Handler:    _Query_QuerySkippedDowntimeSlashes_Handler,
+		},
+		{
+			MethodName: "QueryUnroutableSlashPackets",
+			Handler:    _Query_QueryUnroutableSlashPackets_Handler,
+		},
+		{
+			MethodName: "QueryPreOptInInfractions",
+			Handler:    _Query_QueryPreOptInInfractions_Handler,
+		},
+		{
+			MethodName: "QueryTopNThreshold",
+			Handler:    _Query_QueryTopNThreshold_Handler,
+		},
+		{
+			MethodName: "QueryConsumerEconomicSecurity",
+			Handler:    _Query_QueryConsumerEconomicSecurity_Handler,
+		},
+		{
+			MethodName: "QueryNextConsumerId",
+			Handler:    _Query_QueryNextConsumerId_Handler,
+		},
+		{
+			MethodName: "SimulateCreateConsumer",
+			Handler:    _Query_SimulateCreateConsumer_Handler,
+		},
+		{
+			MethodName: "QueryPendingVSCPackets",
+			Handler:    _Query_QueryPendingVSCPackets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+}
+
+func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetVersion) > 0 {
+		i -= len(m.TargetVersion)
+		copy(dAtA[i:], m.TargetVersion)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransformedGenesisState) > 0 {
+		i -= len(m.TransformedGenesisState)
+		copy(dAtA[i:], m.TransformedGenesisState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TransformedGenesisState)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Chain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemovalRecord != nil {
+		{
+			size, err := m.RemovalRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.CreationRecord != nil {
+		{
+			size, err := m.CreationRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.EvidenceSubmissionPaused {
+		i--
+		if m.EvidenceSubmissionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.LastPacketReceivedTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastPacketReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastPacketReceivedTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintQuery(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Dormant {
+		i--
+		if m.Dormant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxProviderRank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxProviderRank))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		{
+			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinPowerInTop_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.KeyAssignmentNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.KeyAssignmentNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashMeterAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerCommissionRateSource != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerCommissionRateSource))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ConsumerCommissionRate.Size()
+		i -= size
+		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatesCurrentEpoch {
+		i--
+		if m.ValidatesCurrentEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProviderPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ProviderTokens.Size()
+		i -= size
+		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ProviderOperatorAddress) > 0 {
+		i -= len(m.ProviderOperatorAddress)
+		copy(dAtA[i:], m.ProviderOperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ProviderCommissionRate.Size()
+		i -= size
+		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ConsumerCommissionRate.Size()
+		i -= size
+		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ConsumerPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAvailabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAvailabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAvailabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyOptOutCooldownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyOptOutCooldownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyOptOutCooldownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmergencyOptOutCooldownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmergencyOptOutCooldownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmergencyOptOutCooldownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CooldownEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEndTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintQuery(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if m.InCooldown {
+		i--
+		if m.InCooldown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReOptInCooldownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReOptInCooldownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReOptInCooldownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReOptInCooldownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReOptInCooldownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReOptInCooldownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CooldownEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEndTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	if m.InCooldown {
+		i--
+		if m.InCooldown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EstimatedNextEpochStartTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EstimatedNextEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EstimatedNextEpochStartTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintQuery(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlocksUntilNextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextEpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextEpochStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CurrentEpochEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochEndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentEpochStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpochStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemovalRecord != nil {
+		{
+			size, err := m.RemovalRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.CreationRecord != nil {
+		{
+			size, err := m.CreationRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.HeldBackRewards) > 0 {
+		for iNdEx := len(m.HeldBackRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldBackRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.RewardsPaused {
+		i--
+		if m.RewardsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.UpgradeNotices) > 0 {
+		for iNdEx := len(m.UpgradeNotices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeNotices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.RemovalInitiator != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemovalInitiator))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.RemainingLifetime != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.RemainingLifetime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RemainingLifetime):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintQuery(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SunsetTime != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SunsetTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SunsetTime):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintQuery(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PowerShapingAdmin) > 0 {
+		i -= len(m.PowerShapingAdmin)
+		copy(dAtA[i:], m.PowerShapingAdmin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PowerShapingAdmin)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EndpointInfo != nil {
+		{
+			size, err := m.EndpointInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PowerShapingParams != nil {
+		{
+			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InitParams != nil {
+		{
+			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderExposureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderExposureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderExposureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderOperatorAddress) > 0 {
+		i -= len(m.ProviderOperatorAddress)
+		copy(dAtA[i:], m.ProviderOperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderExposureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderExposureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderExposureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerExposure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerExposure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerExposure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpgradeNotices) > 0 {
+		for iNdEx := len(m.UpgradeNotices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpgradeNotices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.ConsumerCommissionRate.Size()
+		i -= size
+		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.PendingOptOut {
+		i--
+		if m.PendingOptOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForcedByTopN {
+		i--
+		if m.ForcedByTopN {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OptedIn {
+		i--
+		if m.OptedIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConsumerPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InValidatorSet {
+		i--
+		if m.InValidatorSet {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerTopologyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerTopologyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerTopologyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerTopologyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerTopologyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerTopologyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Topologies) > 0 {
+		for iNdEx := len(m.Topologies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Topologies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerTopology) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerTopology) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerTopology) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Established {
+		i--
+		if m.Established {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CounterpartyChannelId) > 0 {
+		i -= len(m.CounterpartyChannelId)
+		copy(dAtA[i:], m.CounterpartyChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVscIdToHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVscIdToHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVscIdToHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVscIdToHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVscIdToHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVscIdToHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintQuery(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerEndpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerEndpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerEndpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerEndpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerEndpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerEndpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndpointInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecurityOverviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecurityOverviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecurityOverviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecurityOverviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecurityOverviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecurityOverviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TotalBondedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalBondedPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerSecurityOverview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerSecurityOverview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerSecurityOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvictedValidators) > 0 {
+		for iNdEx := len(m.EvictedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EvictedValidators[iNdEx])
+			copy(dAtA[i:], m.EvictedValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.EvictedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.SecurityFraction.Size()
+		i -= size
+		if _, err := m.SecurityFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SelectedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SelectedPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.NextValidators) > 0 {
+		for iNdEx := len(m.NextValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextValidators[iNdEx])
+			copy(dAtA[i:], m.NextValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NextValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OptedInValidators) > 0 {
+		for iNdEx := len(m.OptedInValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptedInValidators[iNdEx])
+			copy(dAtA[i:], m.OptedInValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OptedInValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSecurityOverview) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSecurityOverview) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSecurityOverview) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSecuredConsumers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSecuredConsumers))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumersByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumersByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumersByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainIdConflictsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainIdConflictsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainIdConflictsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainIdConflictsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainIdConflictsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainIdConflictsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for iNdEx := len(m.Conflicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conflicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerChainIdConflict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerChainIdConflict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerChainIdConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LaunchedConsumerId) > 0 {
+		i -= len(m.LaunchedConsumerId)
+		copy(dAtA[i:], m.LaunchedConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LaunchedConsumerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsCarryingValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsCarryingValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsCarryingValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchChecklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchChecklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchChecklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerLaunchCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerLaunchCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerLaunchCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Details) > 0 {
+		i -= len(m.Details)
+		copy(dAtA[i:], m.Details)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Details)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Passed {
+		i--
+		if m.Passed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchChecklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchChecklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchChecklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsFullDumpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsFullDumpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsFullDumpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IncludeDeleted {
+		i--
+		if m.IncludeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerChainDump) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerChainDump) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerChainDump) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RemovalTime != nil {
+		n32, err32 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RemovalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovalTime):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintQuery(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsFullDumpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsFullDumpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsFullDumpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerChains) > 0 {
+		for iNdEx := len(m.ConsumerChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerDumpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerDumpRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerDumpRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerDumpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerDumpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerDumpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerStateExport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerStateExport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerStateExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CleanupPending {
+		i--
+		if m.CleanupPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.CommissionRates) > 0 {
+		for iNdEx := len(m.CommissionRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.KeyAssignments) > 0 {
+		for iNdEx := len(m.KeyAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.OptedInValidators) > 0 {
+		for iNdEx := len(m.OptedInValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptedInValidators[iNdEx])
+			copy(dAtA[i:], m.OptedInValidators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.OptedInValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashAcks[iNdEx])
+			copy(dAtA[i:], m.SlashAcks[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashAcks[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.PendingVscPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingVscPackets))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ProviderFeePoolAddress) > 0 {
+		i -= len(m.ProviderFeePoolAddress)
+		copy(dAtA[i:], m.ProviderFeePoolAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderFeePoolAddress)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.AllowlistedRewardDenoms) > 0 {
+		for iNdEx := len(m.AllowlistedRewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistedRewardDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowlistedRewardDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowlistedRewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RewardChannelId) > 0 {
+		i -= len(m.RewardChannelId)
+		copy(dAtA[i:], m.RewardChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardChannelId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.EquivocationEvidenceMinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EquivocationEvidenceMinHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinimumPowerInTopN != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinimumPowerInTopN))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.InitChainHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InitChainHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TimeoutPeriods != nil {
+		{
+			size, err := m.TimeoutPeriods.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EvidenceSubmissionPaused {
+		i--
+		if m.EvidenceSubmissionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Dormant {
+		i--
+		if m.Dormant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastPacketReceivedTime != nil {
+		n38, err38 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastPacketReceivedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastPacketReceivedTime):])
+		if err38 != nil {
+			return 0, err38
+		}
+		i -= n38
+		i = encodeVarintQuery(dAtA, i, uint64(n38))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RemovalTime != nil {
+		n39, err39 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RemovalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovalTime):])
+		if err39 != nil {
+			return 0, err39
+		}
+		i -= n39
+		i = encodeVarintQuery(dAtA, i, uint64(n39))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Chain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerStateExportValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerStateExportValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerStateExportValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JoinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JoinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerStateExportKeyAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerStateExportKeyAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerStateExportKeyAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerStateExportCommissionRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerStateExportCommissionRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerStateExportCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardAttributionLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardAttributionLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardAttributionLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardAttributionLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardAttributionLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardAttributionLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prefix != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Prefix))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintQuery(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerGenesisEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerGenesisEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerGenesisEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GenesisState != nil {
+		{
+			size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedDowntimeSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedDowntimeSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedDowntimeSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedDowntimeSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedDowntimeSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedDowntimeSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SkippedSlashes) > 0 {
+		for iNdEx := len(m.SkippedSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SkippedSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnroutableSlashPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnroutableSlashPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnroutableSlashPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnroutableSlashPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnroutableSlashPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnroutableSlashPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashPackets) > 0 {
+		for iNdEx := len(m.SlashPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreOptInInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreOptInInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreOptInInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPreOptInInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPreOptInInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPreOptInInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopNThresholdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopNThresholdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopNThresholdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTopNThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTopNThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTopNThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BoundaryValidators) > 0 {
+		for iNdEx := len(m.BoundaryValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BoundaryValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NumActiveValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumActiveValidators))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RankCutoff != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RankCutoff))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MinTokens.Size()
+		i -= size
+		if _, err := m.MinTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MinPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TopN != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TopN))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NotApplicableReason) > 0 {
+		i -= len(m.NotApplicableReason)
+		copy(dAtA[i:], m.NotApplicableReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NotApplicableReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Applicable {
+		i--
+		if m.Applicable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TopNBoundaryValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TopNBoundaryValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopNBoundaryValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Rank != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerEconomicSecurityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerEconomicSecurityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerEconomicSecurityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextConsumerIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextConsumerIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextConsumerIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextConsumerIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextConsumerIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextConsumerIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextConsumerId) > 0 {
+		i -= len(m.NextConsumerId)
+		copy(dAtA[i:], m.NextConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCreateConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCreateConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCreateConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateCreateConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateCreateConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateCreateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowlistedRewardDenoms) > 0 {
+		for iNdEx := len(m.AllowlistedRewardDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowlistedRewardDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowlistedRewardDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowlistedRewardDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerIdCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerIdCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVSCPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVSCPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVSCPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingVSCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingVSCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingVSCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderUpgradeNotice != nil {
+		{
+			size, err := m.ProviderUpgradeNotice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashAcks[iNdEx])
+			copy(dAtA[i:], m.SlashAcks[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashAcks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VscId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VscId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidatorUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidatorUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidatorUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVSCPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVSCPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVSCPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConsumerGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TargetVersion)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TransformedGenesisState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Chain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovQuery(uint64(m.Top_N))
+	}
+	if m.MinPowerInTop_N != 0 {
+		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinStake != 0 {
+		n += 1 + sovQuery(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		l = m.AllowlistedRewardDenoms.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxProviderRank != 0 {
+		n += 1 + sovQuery(uint64(m.MaxProviderRank))
+	}
+	if m.Dormant {
+		n += 3
+	}
+	if m.LastPacketReceivedTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastPacketReceivedTime)
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Verified {
+		n += 3
+	}
+	if m.EvidenceSubmissionPaused {
+		n += 3
+	}
+	if m.CreationRecord != nil {
+		l = m.CreationRecord.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.RemovalRecord != nil {
+		l = m.RemovalRecord.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.KeyAssignmentNonce != 0 {
+		n += 1 + sovQuery(uint64(m.KeyAssignmentNonce))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	if m.SlashMeterAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for _, e := range m.PairValConAddr {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for _, s := range m.ValidatorsProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ConsumerCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsumerCommissionRateSource != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerCommissionRateSource))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsumerPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerPower))
+	}
+	l = m.ConsumerCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProviderCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProviderOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.ProviderTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProviderPower != 0 {
+		n += 1 + sovQuery(uint64(m.ProviderPower))
+	}
+	if m.ValidatesCurrentEpoch {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorConsumerAvailabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Available {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEmergencyOptOutCooldownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmergencyOptOutCooldownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InCooldown {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReOptInCooldownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReOptInCooldownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InCooldown {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CooldownEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	}
+	return n
+}
+
+func (m *QueryNextEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if m.CurrentEpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochStartHeight))
+	}
+	if m.CurrentEpochEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpochEndHeight))
+	}
+	if m.NextEpochStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextEpochStartHeight))
+	}
+	if m.BlocksUntilNextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	}
+	if m.EstimatedNextEpochStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EstimatedNextEpochStartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InitParams != nil {
+		l = m.InitParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PowerShapingParams != nil {
+		l = m.PowerShapingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndpointInfo != nil {
+		l = m.EndpointInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PowerShapingAdmin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SunsetTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SunsetTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingLifetime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RemainingLifetime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemovalInitiator != 0 {
+		n += 1 + sovQuery(uint64(m.RemovalInitiator))
+	}
+	if len(m.UpgradeNotices) > 0 {
+		for _, e := range m.UpgradeNotices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RewardsPaused {
+		n += 2
+	}
+	if len(m.HeldBackRewards) > 0 {
+		for _, e := range m.HeldBackRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CreationRecord != nil {
+		l = m.CreationRecord.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.RemovalRecord != nil {
+		l = m.RemovalRecord.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderExposureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderExposureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorConsumerExposure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InValidatorSet {
+		n += 2
+	}
+	if m.ConsumerPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerPower))
+	}
+	if m.OptedIn {
+		n += 2
+	}
+	if m.ForcedByTopN {
+		n += 2
+	}
+	if m.PendingOptOut {
+		n += 2
+	}
+	l = m.ConsumerCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UpgradeNotices) > 0 {
+		for _, e := range m.UpgradeNotices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerTopologyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerTopologyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topologies) > 0 {
+		for _, e := range m.Topologies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerTopology) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Established {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryVscIdToHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VscId != 0 {
+		n += 1 + sovQuery(uint64(m.VscId))
+	}
+	return n
+}
+
+func (m *QueryVscIdToHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerRewardChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRewardChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerEndpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerEndpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndpointInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySecurityOverviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySecurityOverviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalBondedPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalBondedPower))
+	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerSecurityOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.OptedInValidators) > 0 {
+		for _, s := range m.OptedInValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NextValidators) > 0 {
+		for _, s := range m.NextValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SelectedPower != 0 {
+		n += 1 + sovQuery(uint64(m.SelectedPower))
+	}
+	l = m.SecurityFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvictedValidators) > 0 {
+		for _, s := range m.EvictedValidators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorSecurityOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if m.NumSecuredConsumers != 0 {
+		n += 1 + sovQuery(uint64(m.NumSecuredConsumers))
+	}
+	return n
+}
+
+func (m *QueryConsumersByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumersByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainIdConflictsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerChainIdConflictsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conflicts) > 0 {
+		for _, e := range m.Conflicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerChainIdConflict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.LaunchedConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsCarryingValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsCarryingValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchChecklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerLaunchCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Passed {
+		n += 2
+	}
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchChecklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Ready {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsFullDumpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IncludeDeleted {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerChainDump) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemovalTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovalTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsFullDumpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerChains) > 0 {
+		for _, e := range m.ConsumerChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetAtHeightValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorSetAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SnapshotHeight))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerDumpRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerDumpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ConsumerStateExport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Chain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemovalTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RemovalTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastPacketReceivedTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastPacketReceivedTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Dormant {
+		n += 2
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.EvidenceSubmissionPaused {
+		n += 2
+	}
+	if m.TimeoutPeriods != nil {
+		l = m.TimeoutPeriods.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InitChainHeight != 0 {
+		n += 1 + sovQuery(uint64(m.InitChainHeight))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinimumPowerInTopN != 0 {
+		n += 1 + sovQuery(uint64(m.MinimumPowerInTopN))
+	}
+	if m.EquivocationEvidenceMinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EquivocationEvidenceMinHeight))
+	}
+	l = len(m.RewardChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AllowlistedRewardDenoms) > 0 {
+		for _, s := range m.AllowlistedRewardDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ProviderFeePoolAddress)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.PendingVscPackets != 0 {
+		n += 2 + sovQuery(uint64(m.PendingVscPackets))
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, s := range m.SlashAcks {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.OptedInValidators) > 0 {
+		for _, s := range m.OptedInValidators {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.KeyAssignments) > 0 {
+		for _, e := range m.KeyAssignments {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CommissionRates) > 0 {
+		for _, e := range m.CommissionRates {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CleanupPending {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerStateExportValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if m.JoinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.JoinHeight))
+	}
+	return n
+}
+
+func (m *ConsumerStateExportKeyAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerStateExportCommissionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardAttributionLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardAttributionLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerClientStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerClientStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTimeQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prefix != 0 {
+		n += 1 + sovQuery(uint64(m.Prefix))
+	}
+	return n
+}
+
+func (m *QueryTimeQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TimeQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerGenesisEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.GenesisState != nil {
+		l = m.GenesisState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySkippedDowntimeSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySkippedDowntimeSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SkippedSlashes) > 0 {
+		for _, e := range m.SkippedSlashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnroutableSlashPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnroutableSlashPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SlashPackets) > 0 {
+		for _, e := range m.SlashPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPreOptInInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPreOptInInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTopNThresholdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTopNThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applicable {
+		n += 2
+	}
+	l = len(m.NotApplicableReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TopN != 0 {
+		n += 1 + sovQuery(uint64(m.TopN))
+	}
+	if m.MinPower != 0 {
+		n += 1 + sovQuery(uint64(m.MinPower))
+	}
+	l = m.MinTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RankCutoff != 0 {
+		n += 1 + sovQuery(uint64(m.RankCutoff))
+	}
+	if m.NumActiveValidators != 0 {
+		n += 1 + sovQuery(uint64(m.NumActiveValidators))
+	}
+	if len(m.BoundaryValidators) > 0 {
+		for _, e := range m.BoundaryValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TopNBoundaryValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovQuery(uint64(m.Rank))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryConsumerEconomicSecurityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryConsumerEconomicSecurityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextConsumerIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextConsumerIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateCreateConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulateCreateConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerIdCounter != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerIdCounter))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	l = m.InitializationParameters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PowerShapingParameters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AllowlistedRewardDenoms) > 0 {
+		for _, s := range m.AllowlistedRewardDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Warnings) > 0 {
+		for _, s := range m.Warnings {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingVSCPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingVSCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscId != 0 {
+		n += 1 + sovQuery(uint64(m.VscId))
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, s := range m.SlashAcks {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreationHeight))
+	}
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProviderUpgradeNotice != nil {
+		l = m.ProviderUpgradeNotice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingValidatorUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryPendingVSCPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransformedGenesisState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransformedGenesisState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			}
+			m.MinPowerInTop_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowlistedRewardDenoms == nil {
+				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			}
+			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderRank", wireType)
+			}
+			m.MaxProviderRank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProviderRank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dormant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dormant = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPacketReceivedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPacketReceivedTime == nil {
+				m.LastPacketReceivedTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastPacketReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceSubmissionPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EvidenceSubmissionPaused = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationRecord == nil {
+				m.CreationRecord = &ConsumerCreationRecord{}
+			}
+			if err := m.CreationRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovalRecord == nil {
+				m.RemovalRecord = &ConsumerRemovalRecord{}
+			}
+			if err := m.RemovalRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignmentNonce", wireType)
+			}
+			m.KeyAssignmentNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyAssignmentNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
+			}
+			m.SlashMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
+			}
+			m.SlashMeterAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterAllowance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
+			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &QueryConsumerChainOptedInValidatorsValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRateSource", wireType)
+			}
+			m.ConsumerCommissionRateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerCommissionRateSource |= ConsumerCommissionRateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			}
+			m.ConsumerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
+			}
+			m.ProviderPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatesCurrentEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAvailabilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAvailabilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAvailabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAvailabilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAvailabilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencyOptOutCooldownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencyOptOutCooldownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencyOptOutCooldownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmergencyOptOutCooldownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmergencyOptOutCooldownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmergencyOptOutCooldownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InCooldown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InCooldown = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CooldownEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReOptInCooldownRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReOptInCooldownRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReOptInCooldownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReOptInCooldownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReOptInCooldownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReOptInCooldownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InCooldown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InCooldown = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CooldownEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			}
+			m.BlocksUntilNextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartHeight", wireType)
+			}
+			m.CurrentEpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochEndHeight", wireType)
+			}
+			m.CurrentEpochEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEpochStartHeight", wireType)
+			}
+			m.NextEpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			}
+			m.BlocksUntilNextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedNextEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedNextEpochStartTime == nil {
+				m.EstimatedNextEpochStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EstimatedNextEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitParams == nil {
+				m.InitParams = &ConsumerInitializationParameters{}
+			}
+			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerShapingParams == nil {
+				m.PowerShapingParams = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndpointInfo == nil {
+				m.EndpointInfo = &EndpointInfo{}
+			}
+			if err := m.EndpointInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerShapingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SunsetTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SunsetTime == nil {
+				m.SunsetTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SunsetTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemainingLifetime == nil {
+				m.RemainingLifetime = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.RemainingLifetime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalInitiator", wireType)
+			}
+			m.RemovalInitiator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalInitiator |= ConsumerRemovalInitiator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeNotices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeNotices = append(m.UpgradeNotices, ConsumerUpgradeNotice{})
+			if err := m.UpgradeNotices[len(m.UpgradeNotices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardsPaused = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldBackRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldBackRewards = append(m.HeldBackRewards, types2.DecCoin{})
+			if err := m.HeldBackRewards[len(m.HeldBackRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreationRecord == nil {
+				m.CreationRecord = &ConsumerCreationRecord{}
+			}
+			if err := m.CreationRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovalRecord == nil {
+				m.RemovalRecord = &ConsumerRemovalRecord{}
+			}
+			if err := m.RemovalRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderExposureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderExposureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderExposureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderExposureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderExposureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderExposureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, ValidatorConsumerExposure{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsumerExposure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsumerExposure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsumerExposure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InValidatorSet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InValidatorSet = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			}
+			m.ConsumerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptedIn = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedByTopN", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForcedByTopN = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOptOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingOptOut = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeNotices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradeNotices = append(m.UpgradeNotices, ConsumerUpgradeNotice{})
+			if err := m.UpgradeNotices[len(m.UpgradeNotices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerTopologyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerTopologyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerTopologyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerTopologyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerTopologyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerTopologyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topologies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topologies = append(m.Topologies, ConsumerTopology{})
+			if err := m.Topologies[len(m.Topologies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerTopology) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerTopology: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerTopology: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Established", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Established = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVscIdToHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVscIdToHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVscIdToHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVscIdToHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVscIdToHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVscIdToHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRewardChannelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRewardChannelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRewardChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerRewardChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerRewardChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerRewardChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerEndpointsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerEndpointsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerEndpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerEndpointsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerEndpointsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerEndpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndpointInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecurityOverviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecurityOverviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecurityOverviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecurityOverviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecurityOverviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecurityOverviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedPower", wireType)
+			}
+			m.TotalBondedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBondedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumers = append(m.Consumers, ConsumerSecurityOverview{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorSecurityOverview{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerSecurityOverview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerSecurityOverview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerSecurityOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptedInValidators = append(m.OptedInValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValidators = append(m.NextValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPower", wireType)
+			}
+			m.SelectedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelectedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecurityFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvictedValidators = append(m.EvictedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSecurityOverview) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSecurityOverview: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSecurityOverview: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSecuredConsumers", wireType)
+			}
+			m.NumSecuredConsumers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSecuredConsumers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumersByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumersByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainIdConflictsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainIdConflictsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainIdConflictsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainIdConflictsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainIdConflictsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainIdConflictsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conflicts = append(m.Conflicts, ConsumerChainIdConflict{})
+			if err := m.Conflicts[len(m.Conflicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerChainIdConflict) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerChainIdConflict: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerChainIdConflict: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchedConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LaunchedConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsCarryingValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsCarryingValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsCarryingValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsCarryingValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsCarryingValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsCarryingValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchChecklistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchChecklistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchChecklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerLaunchCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerLaunchCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerLaunchCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Passed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchChecklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchChecklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchChecklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, ConsumerLaunchCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsFullDumpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsFullDumpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsFullDumpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeDeleted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerChainDump) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerChainDump: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerChainDump: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovalTime == nil {
+				m.RemovalTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RemovalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsFullDumpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsFullDumpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsFullDumpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChains = append(m.ConsumerChains, ConsumerChainDump{})
+			if err := m.ConsumerChains[len(m.ConsumerChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetAtHeightValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorSetAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorSetAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
+			}
+			m.SnapshotHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, QueryConsumerValidatorSetAtHeightValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerDumpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerDumpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerDumpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerDumpResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerDumpResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerDumpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerStateExport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerStateExport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerStateExport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Chain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovalTime == nil {
+				m.RemovalTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RemovalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPacketReceivedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPacketReceivedTime == nil {
+				m.LastPacketReceivedTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastPacketReceivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dormant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dormant = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Verified = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceSubmissionPaused", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.EvidenceSubmissionPaused = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPeriods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4602,79 +25808,52 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.TimeoutPeriods == nil {
+				m.TimeoutPeriods = &ConsumerTimeoutPeriods{}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.TimeoutPeriods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitChainHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InitChainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitChainHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4684,80 +25863,50 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumPowerInTopN", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MinimumPowerInTopN = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumPowerInTopN |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivocationEvidenceMinHeight", wireType)
 			}
-			m.Phase = 0
+			m.EquivocationEvidenceMinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4767,16 +25916,16 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				m.EquivocationEvidenceMinHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChannelId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4786,83 +25935,29 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4872,31 +25967,29 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowlistedRewardDenoms = append(m.AllowlistedRewardDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4906,81 +25999,46 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderFeePoolAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Chain) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVscPackets", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingVscPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingVscPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5008,13 +26066,13 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5024,29 +26082,31 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, ConsumerStateExportValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedInValidators", wireType)
 			}
-			m.Top_N = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5056,16 +26116,29 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.MinPowerInTop_N = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptedInValidators = append(m.OptedInValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyAssignments", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5075,16 +26148,31 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ValidatorsPowerCap = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyAssignments = append(m.KeyAssignments, ConsumerStateExportKeyAssignment{})
+			if err := m.KeyAssignments[len(m.KeyAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5094,16 +26182,31 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ValidatorSetCap = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionRates = append(m.CommissionRates, ConsumerStateExportCommissionRate{})
+			if err := m.CommissionRates[len(m.CommissionRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5113,14 +26216,27 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5148,11 +26264,81 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 8:
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CleanupPending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerStateExportValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerStateExportValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerStateExportValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5180,13 +26366,13 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5196,29 +26382,33 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5228,30 +26418,16 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
 			}
-			m.MinStake = 0
+			m.JoinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5261,16 +26437,66 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				m.JoinHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerStateExportKeyAssignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerStateExportKeyAssignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerStateExportKeyAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5280,15 +26506,27 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5316,11 +26554,11 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5347,10 +26585,10 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowlistedRewardDenoms == nil {
-				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
 			}
-			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5375,7 +26613,7 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerStateExportCommissionRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5398,10 +26636,10 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerStateExportCommissionRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerStateExportCommissionRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5438,7 +26676,7 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5466,7 +26704,9 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5489,7 +26729,7 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardAttributionLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5512,15 +26752,15 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardAttributionLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardAttributionLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5548,7 +26788,7 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5571,7 +26811,7 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardAttributionLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5594,17 +26834,17 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardAttributionLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardAttributionLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5614,56 +26854,76 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			m.Records = append(m.Records, RewardAttributionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerClientStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerClientStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerClientStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5685,7 +26945,7 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerClientStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5708,17 +26968,17 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerClientStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerClientStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5728,23 +26988,25 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.Clients = append(m.Clients, ConsumerClientExpiry{})
+			if err := m.Clients[len(m.Clients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5767,7 +27029,7 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5790,12 +27052,31 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			m.Prefix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Prefix |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5817,7 +27098,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTimeQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5840,53 +27121,15 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTimeQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTimeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
-			}
-			m.SlashMeter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashMeter |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
-			}
-			m.SlashMeterAllowance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashMeterAllowance |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5913,7 +27156,8 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, TimeQueueEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5938,7 +27182,7 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *TimeQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5961,65 +27205,48 @@ func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6047,7 +27274,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6070,7 +27297,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerGenesisBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6093,15 +27320,15 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerGenesisBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerGenesisBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6129,7 +27356,7 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6152,7 +27379,7 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerGenesisBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6175,15 +27402,15 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerGenesisBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerGenesisBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6210,8 +27437,8 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
-			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, ConsumerGenesisEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6236,7 +27463,7 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGenesisEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6259,15 +27486,15 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGenesisEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGenesisEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6295,11 +27522,11 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6327,11 +27554,30 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6343,27 +27589,91 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GenesisState == nil {
+				m.GenesisState = &types.ConsumerGenesisState{}
+			}
+			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6386,7 +27696,7 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySkippedDowntimeSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6409,12 +27719,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySkippedDowntimeSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySkippedDowntimeSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6436,7 +27778,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySkippedDowntimeSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6459,15 +27801,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySkippedDowntimeSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySkippedDowntimeSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedSlashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6494,7 +27836,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SkippedSlashes = append(m.SkippedSlashes, SkippedDowntimeSlash{})
+			if err := m.SkippedSlashes[len(m.SkippedSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6519,7 +27862,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnroutableSlashPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6542,10 +27885,10 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnroutableSlashPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnroutableSlashPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6601,7 +27944,7 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnroutableSlashPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6624,17 +27967,17 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnroutableSlashPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnroutableSlashPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPackets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6644,23 +27987,25 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			m.SlashPackets = append(m.SlashPackets, UnroutableSlashPacket{})
+			if err := m.SlashPackets[len(m.SlashPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6683,7 +28028,7 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPreOptInInfractionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6706,10 +28051,10 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreOptInInfractionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreOptInInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6765,7 +28110,7 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+func (m *QueryPreOptInInfractionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6788,47 +28133,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPreOptInInfractionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPreOptInInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6855,88 +28168,64 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Infractions = append(m.Infractions, PreOptInInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopNThresholdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsumerPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopNThresholdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopNThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6964,49 +28253,63 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTopNThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTopNThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTopNThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicable", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7016,28 +28319,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+			m.Applicable = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotApplicableReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7065,13 +28355,13 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.NotApplicableReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TopN", wireType)
 			}
-			var v int
+			m.TopN = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7081,17 +28371,16 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.TopN |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPower", wireType)
 			}
-			m.Status = 0
+			m.MinPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7101,14 +28390,14 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= types1.BondStatus(b&0x7F) << shift
+				m.MinPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7136,15 +28425,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RankCutoff", wireType)
 			}
-			m.ProviderPower = 0
+			m.RankCutoff = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7154,16 +28443,16 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProviderPower |= int64(b&0x7F) << shift
+				m.RankCutoff |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveValidators", wireType)
 			}
-			var v int
+			m.NumActiveValidators = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7173,65 +28462,14 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NumActiveValidators |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ValidatesCurrentEpoch = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryValidators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7258,8 +28496,8 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BoundaryValidators = append(m.BoundaryValidators, TopNBoundaryValidator{})
+			if err := m.BoundaryValidators[len(m.BoundaryValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7284,7 +28522,7 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+func (m *TopNBoundaryValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7307,10 +28545,10 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopNBoundaryValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopNBoundaryValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7345,59 +28583,9 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 			}
 			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7425,8 +28613,46 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7448,7 +28674,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerEconomicSecurityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7471,10 +28697,10 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerEconomicSecurityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerEconomicSecurityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7510,10 +28736,29 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7523,24 +28768,11 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7562,7 +28794,7 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerEconomicSecurityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7585,17 +28817,17 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerEconomicSecurityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerEconomicSecurityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7605,23 +28837,23 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ConsumerEconomicSecurity{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7646,7 +28878,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextConsumerIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7669,10 +28901,10 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextConsumerIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextConsumerIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7696,7 +28928,7 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextConsumerIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7719,17 +28951,17 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextConsumerIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextConsumerIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextConsumerId", wireType)
 			}
-			m.BlocksUntilNextEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7739,11 +28971,24 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7765,7 +29010,7 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCreateConsumerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7788,17 +29033,17 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCreateConsumerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCreateConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7808,24 +29053,48 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			if m.Msg == nil {
+				m.Msg = &MsgCreateConsumer{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7847,7 +29116,7 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateCreateConsumerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7870,10 +29139,10 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateCreateConsumerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateCreateConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7908,6 +29177,174 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIdCounter", wireType)
+			}
+			m.ConsumerIdCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerIdCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowlistedRewardDenoms = append(m.AllowlistedRewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7929,7 +29366,7 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingVSCPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7952,10 +29389,10 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingVSCPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingVSCPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7990,6 +29427,42 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8011,7 +29484,7 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+func (m *PendingVSCPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8034,17 +29507,36 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingVSCPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingVSCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscId", wireType)
+			}
+			m.VscId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VscId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8054,27 +29546,29 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorUpdates = append(m.ValidatorUpdates, PendingValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8102,11 +29596,30 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8134,13 +29647,13 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUpgradeNotice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8150,29 +29663,83 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			if m.ProviderUpgradeNotice == nil {
+				m.ProviderUpgradeNotice = &types.ProviderUpgradeNotice{}
+			}
+			if err := m.ProviderUpgradeNotice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidatorUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidatorUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidatorUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8182,28 +29749,96 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVSCPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVSCPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVSCPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8230,16 +29865,14 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitParams == nil {
-				m.InitParams = &ConsumerInitializationParameters{}
-			}
-			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Packets = append(m.Packets, PendingVSCPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8266,10 +29899,10 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerShapingParams == nil {
-				m.PowerShapingParams = &PowerShapingParameters{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/signing/textual"
+)
+
+const (
+	// ConsumerKeyScalar is the `cosmos_proto.scalar` of the fields holding consumer public keys
+	// in JSON format, e.g., the `consumer_key` fields of MsgAssignConsumerKey and MsgOptIn
+	ConsumerKeyScalar = "interchain_security.ConsumerKey"
+
+	// consumerKeyTitle is the title of the SIGN_MODE_TEXTUAL screen displaying the `key` of a consumer public key
+	consumerKeyTitle = "Key"
+)
+
+// NewTextualSignModeHandler returns a SIGN_MODE_TEXTUAL handler with the value renderers of the provider module,
+// i.e., the value renderer of the `interchain_security.ConsumerKey` scalar
+func NewTextualSignModeHandler(o textual.SignModeOptions) (*textual.SignModeHandler, error) {
+	handler, err := textual.NewSignModeHandler(o)
+	if err != nil {
+		return nil, err
+	}
+	handler.DefineScalar(ConsumerKeyScalar, NewConsumerKeyValueRenderer)
+	return handler, nil
+}
+
+// consumerKeyValueRenderer is a SIGN_MODE_TEXTUAL value renderer for consumer public keys in JSON format.
+// A consumer key, e.g., `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`,
+// is rendered as two screens, i.e., the key type and the base64-encoded key:
+//
+//	Consumer key: /cosmos.crypto.ed25519.PubKey
+//	> Key: Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=
+//
+// Values that are not in the canonical JSON format (e.g., invalid keys) are rendered as strings on a single screen.
+type consumerKeyValueRenderer struct{}
+
+var _ textual.ValueRenderer = consumerKeyValueRenderer{}
+
+// NewConsumerKeyValueRenderer returns a SIGN_MODE_TEXTUAL value renderer for consumer public keys in JSON format
+func NewConsumerKeyValueRenderer(_ protoreflect.FieldDescriptor) textual.ValueRenderer {
+	return consumerKeyValueRenderer{}
+}
+
+// consumerKeyJSON is the canonical JSON format of a consumer public key
+type consumerKeyJSON struct {
+	Type string `json:"@type"`
+	Key  string `json:"key"`
+}
+
+// marshalConsumerKey returns the canonical JSON format of a consumer public key
+func marshalConsumerKey(pkType, key string) (string, error) {
+	bz, err := json.Marshal(consumerKeyJSON{Type: pkType, Key: key})
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// Format implements the textual.ValueRenderer interface
+func (vr consumerKeyValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]textual.Screen, error) {
+	consumerKey := v.String()
+
+	// only render the key type and the key separately if the value can be parsed back,
+	// i.e., if it is in the canonical JSON format
+	pkType, key, err := ParseConsumerKeyFromJson(consumerKey)
+	if err == nil && pkType != "" && key != "" {
+		if canonical, err := marshalConsumerKey(pkType, key); err == nil && canonical == consumerKey {
+			return []textual.Screen{
+				{Content: pkType},
+				{Title: consumerKeyTitle, Content: key, Indent: 1},
+			}, nil
+		}
+	}
+
+	return []textual.Screen{{Content: consumerKey}}, nil
+}
+
+// Parse implements the textual.ValueRenderer interface
+func (vr consumerKeyValueRenderer) Parse(_ context.Context, screens []textual.Screen) (protoreflect.Value, error) {
+	switch len(screens) {
+	case 1:
+		return protoreflect.ValueOfString(screens[0].Content), nil
+	case 2:
+		if screens[1].Title != consumerKeyTitle {
+			return protoreflect.Value{}, fmt.Errorf("expected %s screen, got %s", consumerKeyTitle, screens[1].Title)
+		}
+		consumerKey, err := marshalConsumerKey(screens[0].Content, screens[1].Content)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfString(consumerKey), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("expected 1 or 2 screens, got %d", len(screens))
+	}
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/interchain-security/v6/x/ccv/provider/types"
+)
+
+func TestConsumerKeyValueRenderer(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		expScreens []textual.Screen
+	}{
+		{
+			name:  "ed25519 consumer key",
+			value: `{"@type":"/cosmos.crypto.ed25519.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`,
+			expScreens: []textual.Screen{
+				{Content: "/cosmos.crypto.ed25519.PubKey"},
+				{Title: "Key", Content: "Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=", Indent: 1},
+			},
+		},
+		{
+			name:  "secp256k1 consumer key",
+			value: `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A/3z/aFn4UHgdOd+nJtFDw76rDmk7rxiOC6TGbgBm8KM"}`,
+			expScreens: []textual.Screen{
+				{Content: "/cosmos.crypto.secp256k1.PubKey"},
+				{Title: "Key", Content: "A/3z/aFn4UHgdOd+nJtFDw76rDmk7rxiOC6TGbgBm8KM", Indent: 1},
+			},
+		},
+		{
+			name:       "non-canonical JSON is rendered as a string",
+			value:      `{"key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=","@type":"/cosmos.crypto.ed25519.PubKey"}`,
+			expScreens: []textual.Screen{{Content: `{"key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=","@type":"/cosmos.crypto.ed25519.PubKey"}`}},
+		},
+		{
+			name:       "missing key type is rendered as a string",
+			value:      `{"@type":"","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`,
+			expScreens: []textual.Screen{{Content: `{"@type":"","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`}},
+		},
+		{
+			name:       "invalid JSON is rendered as a string",
+			value:      "invalid",
+			expScreens: []textual.Screen{{Content: "invalid"}},
+		},
+	}
+
+	vr := types.NewConsumerKeyValueRenderer(nil)
+	for _, tc := range testCases {
+		screens, err := vr.Format(context.Background(), protoreflect.ValueOfString(tc.value))
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expScreens, screens, tc.name)
+
+		// Parse is the inverse of Format
+		value, err := vr.Parse(context.Background(), screens)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.value, value.String(), tc.name)
+	}
+
+	// invalid screens
+	_, err := vr.Parse(context.Background(), []textual.Screen{})
+	require.Error(t, err)
+	_, err = vr.Parse(context.Background(), []textual.Screen{{Content: "/cosmos.crypto.ed25519.PubKey"}, {Title: "Other", Content: "key", Indent: 1}})
+	require.Error(t, err)
+}
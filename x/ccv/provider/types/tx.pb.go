@@ -54,6 +54,10 @@ type MsgAssignConsumerKey struct {
 	Signer      string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 	// the consumer id of the consumer chain to assign a consensus public key to
 	ConsumerId string `protobuf:"bytes,5,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the key assignment nonce of the validator on the consumer chain, i.e., the number of
+	// consumer keys the validator assigned on the consumer chain with MsgAssignConsumerKey;
+	// a zero nonce is only accepted while the nonce of the validator is zero
+	Nonce uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgAssignConsumerKey) Reset()         { *m = MsgAssignConsumerKey{} }
@@ -697,6 +701,8 @@ func (m *MsgConsumerRemoval) GetAuthority() string {
 
 // MsgRemoveConsumer defines the message used to remove (and stop) a consumer chain.
 // If it passes, all the consumer chain's state is eventually removed from the provider chain.
+// Note that a consumer chain that is not launched yet (i.e., in its registered or initialized phase)
+// is not stopped, but its launch is cancelled and it is deleted immediately.
 type MsgRemoveConsumer struct {
 	// the consumer id of the consumer chain to be stopped
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
@@ -788,6 +794,130 @@ func (m *MsgRemoveConsumerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveConsumerResponse proto.InternalMessageInfo
 
+// MsgConsumerHardFork defines the message used when a launched consumer chain hard forks to a new chain id.
+// If it passes, the chain id of the consumer chain is updated and a new client to the forked chain is created,
+// while the opt-ins, key assignments and commission rates of the consumer chain are preserved.
+// Note that the owner of a Top N consumer chain is the gov module, i.e., the hard fork of a Top N chain
+// must be approved by governance.
+type MsgConsumerHardFork struct {
+	// the consumer id of the consumer chain that hard forks
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the address of the owner of the consumer chain
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the chain id of the forked consumer chain
+	NewChainId string `protobuf:"bytes,3,opt,name=new_chain_id,json=newChainId,proto3" json:"new_chain_id,omitempty"`
+	// the height of the first block of the forked consumer chain,
+	// i.e., the height at which the new client to the consumer chain is created
+	InitialHeight types1.Height `protobuf:"bytes,4,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height"`
+}
+
+func (m *MsgConsumerHardFork) Reset()         { *m = MsgConsumerHardFork{} }
+func (m *MsgConsumerHardFork) String() string { return proto.CompactTextString(m) }
+func (*MsgConsumerHardFork) ProtoMessage()    {}
+func (*MsgConsumerHardFork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{12}
+}
+func (m *MsgConsumerHardFork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsumerHardFork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsumerHardFork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConsumerHardFork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsumerHardFork.Merge(m, src)
+}
+func (m *MsgConsumerHardFork) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsumerHardFork) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsumerHardFork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConsumerHardFork proto.InternalMessageInfo
+
+func (m *MsgConsumerHardFork) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MsgConsumerHardFork) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgConsumerHardFork) GetNewChainId() string {
+	if m != nil {
+		return m.NewChainId
+	}
+	return ""
+}
+
+func (m *MsgConsumerHardFork) GetInitialHeight() types1.Height {
+	if m != nil {
+		return m.InitialHeight
+	}
+	return types1.Height{}
+}
+
+// MsgConsumerHardForkResponse defines response type for MsgConsumerHardFork messages
+type MsgConsumerHardForkResponse struct {
+	// the id of the client created for the forked consumer chain
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+}
+
+func (m *MsgConsumerHardForkResponse) Reset()         { *m = MsgConsumerHardForkResponse{} }
+func (m *MsgConsumerHardForkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConsumerHardForkResponse) ProtoMessage()    {}
+func (*MsgConsumerHardForkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{13}
+}
+func (m *MsgConsumerHardForkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConsumerHardForkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConsumerHardForkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConsumerHardForkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConsumerHardForkResponse.Merge(m, src)
+}
+func (m *MsgConsumerHardForkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConsumerHardForkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConsumerHardForkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConsumerHardForkResponse proto.InternalMessageInfo
+
+func (m *MsgConsumerHardForkResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
 // ChangeRewardDenomsProposal is a governance proposal on the provider chain to
 // mutate the set of denoms accepted by the provider as rewards.
 //
@@ -805,7 +935,7 @@ func (m *MsgChangeRewardDenoms) Reset()         { *m = MsgChangeRewardDenoms{} }
 func (m *MsgChangeRewardDenoms) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeRewardDenoms) ProtoMessage()    {}
 func (*MsgChangeRewardDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{12}
+	return fileDescriptor_43221a4391e9fbf4, []int{14}
 }
 func (m *MsgChangeRewardDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +993,7 @@ func (m *MsgChangeRewardDenomsResponse) Reset()         { *m = MsgChangeRewardDe
 func (m *MsgChangeRewardDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeRewardDenomsResponse) ProtoMessage()    {}
 func (*MsgChangeRewardDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{13}
+	return fileDescriptor_43221a4391e9fbf4, []int{15}
 }
 func (m *MsgChangeRewardDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -892,6 +1022,216 @@ func (m *MsgChangeRewardDenomsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRewardDenomsResponse proto.InternalMessageInfo
 
+// MsgSetConsumerVerified defines the message used by the governance module
+// to mark a consumer chain as verified (or to clear the verified flag).
+type MsgSetConsumerVerified struct {
+	// the consumer id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// whether the consumer chain is verified
+	Verified bool `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetConsumerVerified) Reset()         { *m = MsgSetConsumerVerified{} }
+func (m *MsgSetConsumerVerified) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerVerified) ProtoMessage()    {}
+func (*MsgSetConsumerVerified) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{16}
+}
+func (m *MsgSetConsumerVerified) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerVerified) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerVerified.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerVerified) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerVerified.Merge(m, src)
+}
+func (m *MsgSetConsumerVerified) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerVerified) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerVerified.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerVerified proto.InternalMessageInfo
+
+func (m *MsgSetConsumerVerified) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MsgSetConsumerVerified) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *MsgSetConsumerVerified) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSetConsumerVerifiedResponse defines response type for MsgSetConsumerVerified messages
+type MsgSetConsumerVerifiedResponse struct {
+}
+
+func (m *MsgSetConsumerVerifiedResponse) Reset()         { *m = MsgSetConsumerVerifiedResponse{} }
+func (m *MsgSetConsumerVerifiedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerVerifiedResponse) ProtoMessage()    {}
+func (*MsgSetConsumerVerifiedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{17}
+}
+func (m *MsgSetConsumerVerifiedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerVerifiedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerVerifiedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerVerifiedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerVerifiedResponse.Merge(m, src)
+}
+func (m *MsgSetConsumerVerifiedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerVerifiedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerVerifiedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerVerifiedResponse proto.InternalMessageInfo
+
+// MsgSetConsumerEvidenceSubmissionPaused defines the message used by the governance module
+// to pause (or to resume) the submission of equivocation evidence for a consumer chain.
+type MsgSetConsumerEvidenceSubmissionPaused struct {
+	// the consumer id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// whether the submission of equivocation evidence is paused
+	Paused bool `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPaused) Reset() {
+	*m = MsgSetConsumerEvidenceSubmissionPaused{}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerEvidenceSubmissionPaused) ProtoMessage()    {}
+func (*MsgSetConsumerEvidenceSubmissionPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{18}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPaused.Merge(m, src)
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerEvidenceSubmissionPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPaused proto.InternalMessageInfo
+
+func (m *MsgSetConsumerEvidenceSubmissionPaused) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSetConsumerEvidenceSubmissionPausedResponse defines response type for MsgSetConsumerEvidenceSubmissionPaused messages
+type MsgSetConsumerEvidenceSubmissionPausedResponse struct {
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) Reset() {
+	*m = MsgSetConsumerEvidenceSubmissionPausedResponse{}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSetConsumerEvidenceSubmissionPausedResponse) ProtoMessage() {}
+func (*MsgSetConsumerEvidenceSubmissionPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{19}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPausedResponse.Merge(m, src)
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerEvidenceSubmissionPausedResponse proto.InternalMessageInfo
+
 type MsgOptIn struct {
 	// [DEPRECATED] use `consumer_id` instead
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // Deprecated: Do not use.
@@ -906,13 +1246,16 @@ type MsgOptIn struct {
 	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
 	// the consumer id of the consumer chain to opt in to
 	ConsumerId string `protobuf:"bytes,5,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// (optional) the hash of the terms of the consumer chain acknowledged by the validator;
+	// required if the consumer chain has terms, i.e., its metadata has a `terms_hash`
+	AcknowledgedTermsHash string `protobuf:"bytes,6,opt,name=acknowledged_terms_hash,json=acknowledgedTermsHash,proto3" json:"acknowledged_terms_hash,omitempty"`
 }
 
 func (m *MsgOptIn) Reset()         { *m = MsgOptIn{} }
 func (m *MsgOptIn) String() string { return proto.CompactTextString(m) }
 func (*MsgOptIn) ProtoMessage()    {}
 func (*MsgOptIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{14}
+	return fileDescriptor_43221a4391e9fbf4, []int{20}
 }
 func (m *MsgOptIn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -942,13 +1285,16 @@ func (m *MsgOptIn) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgOptIn proto.InternalMessageInfo
 
 type MsgOptInResponse struct {
+	// a warning if the validator is opted in to more consumer chains than the
+	// max consumer participation allows (the opt-in is recorded regardless)
+	Warning string `protobuf:"bytes,1,opt,name=warning,proto3" json:"warning,omitempty"`
 }
 
 func (m *MsgOptInResponse) Reset()         { *m = MsgOptInResponse{} }
 func (m *MsgOptInResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOptInResponse) ProtoMessage()    {}
 func (*MsgOptInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{15}
+	return fileDescriptor_43221a4391e9fbf4, []int{21}
 }
 func (m *MsgOptInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,6 +1323,13 @@ func (m *MsgOptInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOptInResponse proto.InternalMessageInfo
 
+func (m *MsgOptInResponse) GetWarning() string {
+	if m != nil {
+		return m.Warning
+	}
+	return ""
+}
+
 type MsgOptOut struct {
 	// [DEPRECATED] use `consumer_id` instead
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // Deprecated: Do not use.
@@ -992,7 +1345,7 @@ func (m *MsgOptOut) Reset()         { *m = MsgOptOut{} }
 func (m *MsgOptOut) String() string { return proto.CompactTextString(m) }
 func (*MsgOptOut) ProtoMessage()    {}
 func (*MsgOptOut) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{16}
+	return fileDescriptor_43221a4391e9fbf4, []int{22}
 }
 func (m *MsgOptOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1381,7 @@ func (m *MsgOptOutResponse) Reset()         { *m = MsgOptOutResponse{} }
 func (m *MsgOptOutResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgOptOutResponse) ProtoMessage()    {}
 func (*MsgOptOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{17}
+	return fileDescriptor_43221a4391e9fbf4, []int{23}
 }
 func (m *MsgOptOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1057,34 +1410,32 @@ func (m *MsgOptOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgOptOutResponse proto.InternalMessageInfo
 
-// MsgSetConsumerCommissionRate allows validators to set
-// a per-consumer chain commission rate
-type MsgSetConsumerCommissionRate struct {
-	// The validator address on the provider
-	ProviderAddr string `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty" yaml:"address"`
-	// [DEPRECATED] use `consumer_id` instead
-	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // Deprecated: Do not use.
-	// The rate to charge delegators on the consumer chain, as a fraction
-	// TODO: migrate rate from sdk.Dec to math.LegacyDec
-	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+// MsgEmergencyOptOut allows validators to opt out of a launched consumer chain right away,
+// instead of at the end of the epoch (e.g., if the consumer chain is compromised), in return
+// for the emergency opt-out penalty (see `emergency_opt_out_slash_fraction` and
+// `emergency_opt_out_cooldown`). Validators that have to validate a Top N chain can only
+// emergency opt out if the chain allows it (see `allow_top_n_emergency_opt_out`).
+type MsgEmergencyOptOut struct {
+	// the consumer id of the consumer chain to opt out from
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the validator address on the provider
+	ProviderAddr string `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty" yaml:"address"`
 	// submitter address
-	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
-	// the consumer id of the consumer chain to set the commission rate
-	ConsumerId string `protobuf:"bytes,5,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func (m *MsgSetConsumerCommissionRate) Reset()         { *m = MsgSetConsumerCommissionRate{} }
-func (m *MsgSetConsumerCommissionRate) String() string { return proto.CompactTextString(m) }
-func (*MsgSetConsumerCommissionRate) ProtoMessage()    {}
-func (*MsgSetConsumerCommissionRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{18}
+func (m *MsgEmergencyOptOut) Reset()         { *m = MsgEmergencyOptOut{} }
+func (m *MsgEmergencyOptOut) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyOptOut) ProtoMessage()    {}
+func (*MsgEmergencyOptOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{24}
 }
-func (m *MsgSetConsumerCommissionRate) XXX_Unmarshal(b []byte) error {
+func (m *MsgEmergencyOptOut) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetConsumerCommissionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgEmergencyOptOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetConsumerCommissionRate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgEmergencyOptOut.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1094,26 +1445,111 @@ func (m *MsgSetConsumerCommissionRate) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSetConsumerCommissionRate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetConsumerCommissionRate.Merge(m, src)
+func (m *MsgEmergencyOptOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyOptOut.Merge(m, src)
 }
-func (m *MsgSetConsumerCommissionRate) XXX_Size() int {
+func (m *MsgEmergencyOptOut) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetConsumerCommissionRate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetConsumerCommissionRate.DiscardUnknown(m)
+func (m *MsgEmergencyOptOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyOptOut.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetConsumerCommissionRate proto.InternalMessageInfo
+var xxx_messageInfo_MsgEmergencyOptOut proto.InternalMessageInfo
 
-type MsgSetConsumerCommissionRateResponse struct {
+type MsgEmergencyOptOutResponse struct {
+}
+
+func (m *MsgEmergencyOptOutResponse) Reset()         { *m = MsgEmergencyOptOutResponse{} }
+func (m *MsgEmergencyOptOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyOptOutResponse) ProtoMessage()    {}
+func (*MsgEmergencyOptOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{25}
+}
+func (m *MsgEmergencyOptOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyOptOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyOptOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyOptOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyOptOutResponse.Merge(m, src)
+}
+func (m *MsgEmergencyOptOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyOptOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyOptOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEmergencyOptOutResponse proto.InternalMessageInfo
+
+// MsgSetConsumerCommissionRate allows validators to set
+// a per-consumer chain commission rate
+type MsgSetConsumerCommissionRate struct {
+	// The validator address on the provider
+	ProviderAddr string `protobuf:"bytes,1,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty" yaml:"address"`
+	// [DEPRECATED] use `consumer_id` instead
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"` // Deprecated: Do not use.
+	// The rate to charge delegators on the consumer chain, as a fraction
+	// TODO: migrate rate from sdk.Dec to math.LegacyDec
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// submitter address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the consumer id of the consumer chain to set the commission rate
+	ConsumerId string `protobuf:"bytes,5,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *MsgSetConsumerCommissionRate) Reset()         { *m = MsgSetConsumerCommissionRate{} }
+func (m *MsgSetConsumerCommissionRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerCommissionRate) ProtoMessage()    {}
+func (*MsgSetConsumerCommissionRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{26}
+}
+func (m *MsgSetConsumerCommissionRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerCommissionRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerCommissionRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerCommissionRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerCommissionRate.Merge(m, src)
+}
+func (m *MsgSetConsumerCommissionRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerCommissionRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerCommissionRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerCommissionRate proto.InternalMessageInfo
+
+type MsgSetConsumerCommissionRateResponse struct {
 }
 
 func (m *MsgSetConsumerCommissionRateResponse) Reset()         { *m = MsgSetConsumerCommissionRateResponse{} }
 func (m *MsgSetConsumerCommissionRateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetConsumerCommissionRateResponse) ProtoMessage()    {}
 func (*MsgSetConsumerCommissionRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{19}
+	return fileDescriptor_43221a4391e9fbf4, []int{27}
 }
 func (m *MsgSetConsumerCommissionRateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,6 +1578,91 @@ func (m *MsgSetConsumerCommissionRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetConsumerCommissionRateResponse proto.InternalMessageInfo
 
+// MsgSetConsumerAvailability allows opted-in validators to temporarily step out of
+// the validator set of a consumer chain (e.g., during maintenance of their consumer node)
+// without opting out. Validators that are required to validate a Top N chain cannot
+// mark themselves as unavailable.
+type MsgSetConsumerAvailability struct {
+	// the consumer id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the validator address on the provider
+	ProviderAddr string `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty" yaml:"address"`
+	// whether the validator is available to validate the consumer chain; an unavailable validator
+	// is excluded from the validator set of the consumer chain from the next epoch on
+	Available bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// submitter address
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSetConsumerAvailability) Reset()         { *m = MsgSetConsumerAvailability{} }
+func (m *MsgSetConsumerAvailability) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerAvailability) ProtoMessage()    {}
+func (*MsgSetConsumerAvailability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{28}
+}
+func (m *MsgSetConsumerAvailability) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerAvailability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerAvailability.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerAvailability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerAvailability.Merge(m, src)
+}
+func (m *MsgSetConsumerAvailability) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerAvailability) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerAvailability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerAvailability proto.InternalMessageInfo
+
+type MsgSetConsumerAvailabilityResponse struct {
+}
+
+func (m *MsgSetConsumerAvailabilityResponse) Reset()         { *m = MsgSetConsumerAvailabilityResponse{} }
+func (m *MsgSetConsumerAvailabilityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsumerAvailabilityResponse) ProtoMessage()    {}
+func (*MsgSetConsumerAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43221a4391e9fbf4, []int{29}
+}
+func (m *MsgSetConsumerAvailabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsumerAvailabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsumerAvailabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsumerAvailabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsumerAvailabilityResponse.Merge(m, src)
+}
+func (m *MsgSetConsumerAvailabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsumerAvailabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsumerAvailabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetConsumerAvailabilityResponse proto.InternalMessageInfo
+
 // [DEPRECATED] Use `MsgUpdateConsumer` instead
 //
 // Deprecated: Do not use.
@@ -1183,7 +1704,7 @@ func (m *MsgConsumerModification) Reset()         { *m = MsgConsumerModification
 func (m *MsgConsumerModification) String() string { return proto.CompactTextString(m) }
 func (*MsgConsumerModification) ProtoMessage()    {}
 func (*MsgConsumerModification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{20}
+	return fileDescriptor_43221a4391e9fbf4, []int{30}
 }
 func (m *MsgConsumerModification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1296,7 +1817,7 @@ func (m *MsgConsumerModificationResponse) Reset()         { *m = MsgConsumerModi
 func (m *MsgConsumerModificationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConsumerModificationResponse) ProtoMessage()    {}
 func (*MsgConsumerModificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{21}
+	return fileDescriptor_43221a4391e9fbf4, []int{31}
 }
 func (m *MsgConsumerModificationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1337,13 +1858,17 @@ type MsgCreateConsumer struct {
 	PowerShapingParameters   *PowerShapingParameters           `protobuf:"bytes,5,opt,name=power_shaping_parameters,json=powerShapingParameters,proto3" json:"power_shaping_parameters,omitempty"`
 	// allowlisted reward denoms of the consumer
 	AllowlistedRewardDenoms *AllowlistedRewardDenoms `protobuf:"bytes,6,opt,name=allowlisted_reward_denoms,json=allowlistedRewardDenoms,proto3" json:"allowlisted_reward_denoms,omitempty"`
+	// the id of the governance proposal that contains this message (optional);
+	// it can only be set if the submitter is the governance authority and it must be
+	// the id of the proposal whose messages are being executed
+	ProposalId uint64 `protobuf:"varint,7,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
 func (m *MsgCreateConsumer) Reset()         { *m = MsgCreateConsumer{} }
 func (m *MsgCreateConsumer) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateConsumer) ProtoMessage()    {}
 func (*MsgCreateConsumer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{22}
+	return fileDescriptor_43221a4391e9fbf4, []int{32}
 }
 func (m *MsgCreateConsumer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1414,16 +1939,28 @@ func (m *MsgCreateConsumer) GetAllowlistedRewardDenoms() *AllowlistedRewardDenom
 	return nil
 }
 
+func (m *MsgCreateConsumer) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 // MsgCreateConsumerResponse defines response type for MsgCreateConsumer
 type MsgCreateConsumerResponse struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the phase of the consumer chain after its creation, i.e., registered or initialized
+	Phase ConsumerPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the spawn time (in UTC) at which the consumer chain is scheduled to launch
+	// (not set if the chain is not initialized)
+	SpawnTime *time.Time `protobuf:"bytes,3,opt,name=spawn_time,json=spawnTime,proto3,stdtime" json:"spawn_time,omitempty"`
 }
 
 func (m *MsgCreateConsumerResponse) Reset()         { *m = MsgCreateConsumerResponse{} }
 func (m *MsgCreateConsumerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateConsumerResponse) ProtoMessage()    {}
 func (*MsgCreateConsumerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{23}
+	return fileDescriptor_43221a4391e9fbf4, []int{33}
 }
 func (m *MsgCreateConsumerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,9 +1996,25 @@ func (m *MsgCreateConsumerResponse) GetConsumerId() string {
 	return ""
 }
 
+func (m *MsgCreateConsumerResponse) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+func (m *MsgCreateConsumerResponse) GetSpawnTime() *time.Time {
+	if m != nil {
+		return m.SpawnTime
+	}
+	return nil
+}
+
 // MsgUpdateConsumer defines the message used to modify a consumer chain.
 type MsgUpdateConsumer struct {
 	// the address of the owner of the consumer chain to be updated
+	// (or of its power-shaping admin, in which case the message can only update
+	// the allowlist and denylist of the power-shaping parameters)
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	// the consumer id of the consumer chain to be updated
 	ConsumerId string `protobuf:"bytes,2,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
@@ -1475,13 +2028,42 @@ type MsgUpdateConsumer struct {
 	PowerShapingParameters *PowerShapingParameters `protobuf:"bytes,6,opt,name=power_shaping_parameters,json=powerShapingParameters,proto3" json:"power_shaping_parameters,omitempty"`
 	// allowlisted reward denoms of the consumer (if provided they overwrite previously set reward denoms)
 	AllowlistedRewardDenoms *AllowlistedRewardDenoms `protobuf:"bytes,7,opt,name=allowlisted_reward_denoms,json=allowlistedRewardDenoms,proto3" json:"allowlisted_reward_denoms,omitempty"`
+	// the transfer channel on the provider on which the consumer chain sends ICS rewards
+	// (if provided it overwrites the previously registered reward channel)
+	RewardChannelId string `protobuf:"bytes,8,opt,name=reward_channel_id,json=rewardChannelId,proto3" json:"reward_channel_id,omitempty"`
+	// the endpoints (e.g., peers, RPC and genesis URLs) advertised for the consumer chain
+	// (if provided they overwrite the previously set endpoints)
+	EndpointInfo *EndpointInfo `protobuf:"bytes,9,opt,name=endpoint_info,json=endpointInfo,proto3" json:"endpoint_info,omitempty"`
+	// timeout periods can only be updated after a chain has launched
+	// (before the chain launches, they are part of the initialization parameters)
+	TimeoutPeriods *ConsumerTimeoutPeriods `protobuf:"bytes,10,opt,name=timeout_periods,json=timeoutPeriods,proto3" json:"timeout_periods,omitempty"`
+	// the address permitted to update the allowlist and denylist of the consumer chain
+	// on behalf of the owner (if provided it overwrites the previously set power-shaping admin;
+	// an empty address removes the power-shaping admin)
+	PowerShapingAdmin *PowerShapingAdmin `protobuf:"bytes,11,opt,name=power_shaping_admin,json=powerShapingAdmin,proto3" json:"power_shaping_admin,omitempty"`
+	// whether the power-shaping admin is retained when the owner of the consumer changes
+	// (by default, the power-shaping admin is removed on ownership transfer)
+	RetainPowerShapingAdmin bool `protobuf:"varint,12,opt,name=retain_power_shaping_admin,json=retainPowerShapingAdmin,proto3" json:"retain_power_shaping_admin,omitempty"`
+	// the duration by which the lifetime of a launched consumer chain with a maximum lifetime
+	// is extended; it can only be extended before the lifetime elapses
+	LifetimeExtension *time.Duration `protobuf:"bytes,13,opt,name=lifetime_extension,json=lifetimeExtension,proto3,stdduration" json:"lifetime_extension,omitempty"`
+	// the notice informing the validators of a launched consumer chain about an upcoming upgrade
+	// (if provided it overwrites the previously published notice with the same name)
+	UpgradeNotice *ConsumerUpgradeNotice `protobuf:"bytes,14,opt,name=upgrade_notice,json=upgradeNotice,proto3" json:"upgrade_notice,omitempty"`
+	// whether the reward distribution of the consumer chain is paused (if provided it overwrites
+	// the previous setting); the rewards held back while paused are distributed once unpaused
+	RewardsPaused *RewardsPaused `protobuf:"bytes,15,opt,name=rewards_paused,json=rewardsPaused,proto3" json:"rewards_paused,omitempty"`
+	// the message types that the interchain accounts controlled by the owner of the consumer chain are allowed
+	// to execute on the consumer chain (if provided it overwrites the previous list; an empty list removes
+	// the restriction); it can only be updated if the owner of the chain is the gov module
+	AllowedIcaMsgTypes *AllowedIcaMsgTypes `protobuf:"bytes,16,opt,name=allowed_ica_msg_types,json=allowedIcaMsgTypes,proto3" json:"allowed_ica_msg_types,omitempty"`
 }
 
 func (m *MsgUpdateConsumer) Reset()         { *m = MsgUpdateConsumer{} }
 func (m *MsgUpdateConsumer) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConsumer) ProtoMessage()    {}
 func (*MsgUpdateConsumer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{24}
+	return fileDescriptor_43221a4391e9fbf4, []int{34}
 }
 func (m *MsgUpdateConsumer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1559,15 +2141,100 @@ func (m *MsgUpdateConsumer) GetAllowlistedRewardDenoms() *AllowlistedRewardDenom
 	return nil
 }
 
+func (m *MsgUpdateConsumer) GetRewardChannelId() string {
+	if m != nil {
+		return m.RewardChannelId
+	}
+	return ""
+}
+
+func (m *MsgUpdateConsumer) GetEndpointInfo() *EndpointInfo {
+	if m != nil {
+		return m.EndpointInfo
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetTimeoutPeriods() *ConsumerTimeoutPeriods {
+	if m != nil {
+		return m.TimeoutPeriods
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetPowerShapingAdmin() *PowerShapingAdmin {
+	if m != nil {
+		return m.PowerShapingAdmin
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetRetainPowerShapingAdmin() bool {
+	if m != nil {
+		return m.RetainPowerShapingAdmin
+	}
+	return false
+}
+
+func (m *MsgUpdateConsumer) GetLifetimeExtension() *time.Duration {
+	if m != nil {
+		return m.LifetimeExtension
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetUpgradeNotice() *ConsumerUpgradeNotice {
+	if m != nil {
+		return m.UpgradeNotice
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetRewardsPaused() *RewardsPaused {
+	if m != nil {
+		return m.RewardsPaused
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumer) GetAllowedIcaMsgTypes() *AllowedIcaMsgTypes {
+	if m != nil {
+		return m.AllowedIcaMsgTypes
+	}
+	return nil
+}
+
 // MsgUpdateConsumerResponse defines response type for MsgUpdateConsumer messages
 type MsgUpdateConsumerResponse struct {
+	// the fields of MsgUpdateConsumer that were applied (e.g., "metadata", "power_shaping_parameters")
+	UpdatedFields []string `protobuf:"bytes,1,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"`
+	// the owner of the consumer chain after the update
+	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// the phase of the consumer chain after the update
+	Phase ConsumerPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	// the metadata of the consumer chain after the update
+	Metadata ConsumerMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata"`
+	// the initialization parameters of the consumer chain after the update
+	// (e.g., the spawn time at which the chain is scheduled to launch)
+	InitializationParameters ConsumerInitializationParameters `protobuf:"bytes,5,opt,name=initialization_parameters,json=initializationParameters,proto3" json:"initialization_parameters"`
+	// the power-shaping parameters of the consumer chain after the update
+	PowerShapingParameters PowerShapingParameters `protobuf:"bytes,6,opt,name=power_shaping_parameters,json=powerShapingParameters,proto3" json:"power_shaping_parameters"`
+	// the timeout periods of the consumer chain after the update
+	TimeoutPeriods ConsumerTimeoutPeriods `protobuf:"bytes,7,opt,name=timeout_periods,json=timeoutPeriods,proto3" json:"timeout_periods"`
+	// the consumer id of the updated consumer chain
+	ConsumerId string `protobuf:"bytes,8,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the spawn time (in UTC) at which the consumer chain is scheduled to launch
+	// (not set if the chain is not initialized after the update)
+	SpawnTime *time.Time `protobuf:"bytes,9,opt,name=spawn_time,json=spawnTime,proto3,stdtime" json:"spawn_time,omitempty"`
+	// the power-shaping admin of the consumer chain after the update (empty if not set)
+	PowerShapingAdmin string `protobuf:"bytes,10,opt,name=power_shaping_admin,json=powerShapingAdmin,proto3" json:"power_shaping_admin,omitempty"`
 }
 
 func (m *MsgUpdateConsumerResponse) Reset()         { *m = MsgUpdateConsumerResponse{} }
 func (m *MsgUpdateConsumerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConsumerResponse) ProtoMessage()    {}
 func (*MsgUpdateConsumerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43221a4391e9fbf4, []int{25}
+	return fileDescriptor_43221a4391e9fbf4, []int{35}
 }
 func (m *MsgUpdateConsumerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,6 +2263,76 @@ func (m *MsgUpdateConsumerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConsumerResponse proto.InternalMessageInfo
 
+func (m *MsgUpdateConsumerResponse) GetUpdatedFields() []string {
+	if m != nil {
+		return m.UpdatedFields
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumerResponse) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateConsumerResponse) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return CONSUMER_PHASE_UNSPECIFIED
+}
+
+func (m *MsgUpdateConsumerResponse) GetMetadata() ConsumerMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return ConsumerMetadata{}
+}
+
+func (m *MsgUpdateConsumerResponse) GetInitializationParameters() ConsumerInitializationParameters {
+	if m != nil {
+		return m.InitializationParameters
+	}
+	return ConsumerInitializationParameters{}
+}
+
+func (m *MsgUpdateConsumerResponse) GetPowerShapingParameters() PowerShapingParameters {
+	if m != nil {
+		return m.PowerShapingParameters
+	}
+	return PowerShapingParameters{}
+}
+
+func (m *MsgUpdateConsumerResponse) GetTimeoutPeriods() ConsumerTimeoutPeriods {
+	if m != nil {
+		return m.TimeoutPeriods
+	}
+	return ConsumerTimeoutPeriods{}
+}
+
+func (m *MsgUpdateConsumerResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *MsgUpdateConsumerResponse) GetSpawnTime() *time.Time {
+	if m != nil {
+		return m.SpawnTime
+	}
+	return nil
+}
+
+func (m *MsgUpdateConsumerResponse) GetPowerShapingAdmin() string {
+	if m != nil {
+		return m.PowerShapingAdmin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgAssignConsumerKey)(nil), "interchain_security.ccv.provider.v1.MsgAssignConsumerKey")
 	proto.RegisterType((*MsgAssignConsumerKeyResponse)(nil), "interchain_security.ccv.provider.v1.MsgAssignConsumerKeyResponse")
@@ -1609,14 +2346,24 @@ func init() {
 	proto.RegisterType((*MsgConsumerRemoval)(nil), "interchain_security.ccv.provider.v1.MsgConsumerRemoval")
 	proto.RegisterType((*MsgRemoveConsumer)(nil), "interchain_security.ccv.provider.v1.MsgRemoveConsumer")
 	proto.RegisterType((*MsgRemoveConsumerResponse)(nil), "interchain_security.ccv.provider.v1.MsgRemoveConsumerResponse")
+	proto.RegisterType((*MsgConsumerHardFork)(nil), "interchain_security.ccv.provider.v1.MsgConsumerHardFork")
+	proto.RegisterType((*MsgConsumerHardForkResponse)(nil), "interchain_security.ccv.provider.v1.MsgConsumerHardForkResponse")
 	proto.RegisterType((*MsgChangeRewardDenoms)(nil), "interchain_security.ccv.provider.v1.MsgChangeRewardDenoms")
 	proto.RegisterType((*MsgChangeRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.MsgChangeRewardDenomsResponse")
+	proto.RegisterType((*MsgSetConsumerVerified)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerVerified")
+	proto.RegisterType((*MsgSetConsumerVerifiedResponse)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerVerifiedResponse")
+	proto.RegisterType((*MsgSetConsumerEvidenceSubmissionPaused)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerEvidenceSubmissionPaused")
+	proto.RegisterType((*MsgSetConsumerEvidenceSubmissionPausedResponse)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerEvidenceSubmissionPausedResponse")
 	proto.RegisterType((*MsgOptIn)(nil), "interchain_security.ccv.provider.v1.MsgOptIn")
 	proto.RegisterType((*MsgOptInResponse)(nil), "interchain_security.ccv.provider.v1.MsgOptInResponse")
 	proto.RegisterType((*MsgOptOut)(nil), "interchain_security.ccv.provider.v1.MsgOptOut")
 	proto.RegisterType((*MsgOptOutResponse)(nil), "interchain_security.ccv.provider.v1.MsgOptOutResponse")
+	proto.RegisterType((*MsgEmergencyOptOut)(nil), "interchain_security.ccv.provider.v1.MsgEmergencyOptOut")
+	proto.RegisterType((*MsgEmergencyOptOutResponse)(nil), "interchain_security.ccv.provider.v1.MsgEmergencyOptOutResponse")
 	proto.RegisterType((*MsgSetConsumerCommissionRate)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerCommissionRate")
 	proto.RegisterType((*MsgSetConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerCommissionRateResponse")
+	proto.RegisterType((*MsgSetConsumerAvailability)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerAvailability")
+	proto.RegisterType((*MsgSetConsumerAvailabilityResponse)(nil), "interchain_security.ccv.provider.v1.MsgSetConsumerAvailabilityResponse")
 	proto.RegisterType((*MsgConsumerModification)(nil), "interchain_security.ccv.provider.v1.MsgConsumerModification")
 	proto.RegisterType((*MsgConsumerModificationResponse)(nil), "interchain_security.ccv.provider.v1.MsgConsumerModificationResponse")
 	proto.RegisterType((*MsgCreateConsumer)(nil), "interchain_security.ccv.provider.v1.MsgCreateConsumer")
@@ -1630,136 +2377,193 @@ func init() {
 }
 
 var fileDescriptor_43221a4391e9fbf4 = []byte{
-	// 2054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
-	0x15, 0x76, 0x8f, 0xc7, 0xce, 0x4c, 0xd9, 0xf1, 0x4f, 0xd9, 0x59, 0xb7, 0x27, 0x59, 0x8f, 0x33,
-	0x2c, 0xbb, 0x56, 0x58, 0xf7, 0x6c, 0x0c, 0x1b, 0x84, 0x09, 0x48, 0xfe, 0x09, 0xc4, 0x0b, 0x8e,
-	0xbd, 0xed, 0x90, 0x95, 0x40, 0xa2, 0x55, 0xd3, 0x5d, 0xe9, 0x29, 0x65, 0xba, 0xab, 0xd5, 0x55,
-	0x33, 0x8e, 0x39, 0xa1, 0x3d, 0xed, 0x71, 0x91, 0x38, 0x70, 0xdc, 0x03, 0x1c, 0x90, 0x40, 0xca,
-	0x61, 0x8f, 0x5c, 0x91, 0x22, 0x71, 0x59, 0xf6, 0x84, 0x10, 0x0a, 0x28, 0x39, 0x2c, 0x17, 0x2e,
-	0xdc, 0x38, 0x81, 0xea, 0xa7, 0x7b, 0xa6, 0x67, 0xc6, 0x76, 0x7b, 0xa2, 0x65, 0x0f, 0x7b, 0x19,
-	0x75, 0xd7, 0xfb, 0xde, 0xf7, 0x7e, 0xaa, 0xea, 0xbd, 0xaa, 0x1e, 0xf0, 0x26, 0x09, 0x39, 0x8e,
-	0xdd, 0x26, 0x22, 0xa1, 0xc3, 0xb0, 0xdb, 0x8e, 0x09, 0x3f, 0xa9, 0xbb, 0x6e, 0xa7, 0x1e, 0xc5,
-	0xb4, 0x43, 0x3c, 0x1c, 0xd7, 0x3b, 0x37, 0xeb, 0xfc, 0xb1, 0x15, 0xc5, 0x94, 0x53, 0xf8, 0x95,
-	0x21, 0x68, 0xcb, 0x75, 0x3b, 0x56, 0x82, 0xb6, 0x3a, 0x37, 0x2b, 0xf3, 0x28, 0x20, 0x21, 0xad,
-	0xcb, 0x5f, 0xa5, 0x57, 0xb9, 0xe6, 0x53, 0xea, 0xb7, 0x70, 0x1d, 0x45, 0xa4, 0x8e, 0xc2, 0x90,
-	0x72, 0xc4, 0x09, 0x0d, 0x99, 0x96, 0x56, 0xb5, 0x54, 0xbe, 0x35, 0xda, 0x0f, 0xeb, 0x9c, 0x04,
-	0x98, 0x71, 0x14, 0x44, 0x1a, 0xb0, 0xd2, 0x0f, 0xf0, 0xda, 0xb1, 0x64, 0xd0, 0xf2, 0xe5, 0x7e,
-	0x39, 0x0a, 0x4f, 0xb4, 0x68, 0xd1, 0xa7, 0x3e, 0x95, 0x8f, 0x75, 0xf1, 0x94, 0x28, 0xb8, 0x94,
-	0x05, 0x94, 0x39, 0x4a, 0xa0, 0x5e, 0xb4, 0x68, 0x49, 0xbd, 0xd5, 0x03, 0xe6, 0x8b, 0xd0, 0x03,
-	0xe6, 0x27, 0x5e, 0x92, 0x86, 0x5b, 0x77, 0x69, 0x8c, 0xeb, 0x6e, 0x8b, 0xe0, 0x90, 0x0b, 0xa9,
-	0x7a, 0xd2, 0x80, 0x8d, 0x3c, 0xa9, 0x4c, 0x13, 0xa5, 0x74, 0xea, 0x82, 0xb4, 0x45, 0xfc, 0x26,
-	0x57, 0x54, 0xac, 0xce, 0x71, 0xe8, 0xe1, 0x38, 0x20, 0xca, 0x40, 0xf7, 0x2d, 0xf1, 0xa2, 0x47,
-	0xce, 0x4f, 0x22, 0xcc, 0xea, 0x58, 0xf0, 0x85, 0x2e, 0x56, 0x80, 0xda, 0x7f, 0x0c, 0xb0, 0xb8,
-	0xcf, 0xfc, 0x2d, 0xc6, 0x88, 0x1f, 0xee, 0xd0, 0x90, 0xb5, 0x03, 0x1c, 0xff, 0x00, 0x9f, 0xc0,
-	0x57, 0x41, 0x49, 0xf9, 0x46, 0x3c, 0xd3, 0x58, 0x35, 0xd6, 0xca, 0xdb, 0x05, 0xd3, 0xb0, 0x2f,
-	0xc9, 0xb1, 0x3d, 0x0f, 0x7e, 0x13, 0x5c, 0x4e, 0x7c, 0x73, 0x90, 0xe7, 0xc5, 0x66, 0x41, 0x62,
-	0xe0, 0xbf, 0x9f, 0x55, 0x67, 0x4e, 0x50, 0xd0, 0xda, 0xac, 0x89, 0x51, 0xcc, 0x58, 0xcd, 0x9e,
-	0x4e, 0x80, 0x5b, 0x9e, 0x17, 0xc3, 0xeb, 0x60, 0xda, 0xd5, 0x66, 0x9c, 0x47, 0xf8, 0xc4, 0x1c,
-	0x17, 0x7a, 0xf6, 0x94, 0xdb, 0x63, 0xfa, 0x2d, 0x30, 0x29, 0xbc, 0xc1, 0xb1, 0x59, 0x94, 0xa4,
-	0xe6, 0xa7, 0x1f, 0xaf, 0x2f, 0xea, 0xac, 0x6f, 0x29, 0xd6, 0x23, 0x1e, 0x93, 0xd0, 0xb7, 0x35,
-	0x0e, 0x56, 0x41, 0x4a, 0x20, 0xfc, 0x9d, 0x90, 0x9c, 0x20, 0x19, 0xda, 0xf3, 0x36, 0x17, 0x3e,
-	0xf8, 0xa8, 0x3a, 0xf6, 0xcf, 0x8f, 0xaa, 0x63, 0xef, 0x7f, 0xf6, 0xe4, 0x86, 0xd6, 0xaa, 0xad,
-	0x80, 0x6b, 0xc3, 0x42, 0xb7, 0x31, 0x8b, 0x68, 0xc8, 0x70, 0xed, 0xb9, 0x01, 0x5e, 0xdd, 0x67,
-	0xfe, 0x51, 0xbb, 0x11, 0x10, 0x9e, 0x00, 0xf6, 0x09, 0x6b, 0xe0, 0x26, 0xea, 0x10, 0xda, 0x8e,
-	0xe1, 0x2d, 0x50, 0x66, 0x52, 0xca, 0x71, 0xac, 0xb3, 0x74, 0xba, 0xb3, 0x5d, 0x28, 0x3c, 0x04,
-	0xd3, 0x41, 0x0f, 0x8f, 0x4c, 0xde, 0xd4, 0xc6, 0x9b, 0x16, 0x69, 0xb8, 0x56, 0xef, 0xf4, 0x5a,
-	0x3d, 0x13, 0xda, 0xb9, 0x69, 0xf5, 0xda, 0xb6, 0x33, 0x0c, 0xfd, 0x19, 0x18, 0x1f, 0xc8, 0xc0,
-	0x2b, 0xbd, 0x19, 0xe8, 0xba, 0x52, 0x7b, 0x03, 0x7c, 0xf5, 0xcc, 0x18, 0xd3, 0x6c, 0xfc, 0xb9,
-	0x30, 0x24, 0x1b, 0xbb, 0xb4, 0xdd, 0x68, 0xe1, 0x07, 0x94, 0x93, 0xd0, 0x1f, 0x39, 0x1b, 0x0e,
-	0x58, 0xf2, 0xda, 0x51, 0x8b, 0xb8, 0x88, 0x63, 0xa7, 0x43, 0x39, 0x76, 0x92, 0x45, 0xaa, 0x13,
-	0xf3, 0x46, 0x6f, 0x1e, 0xe4, 0x32, 0xb6, 0x76, 0x13, 0x85, 0x07, 0x94, 0xe3, 0x3b, 0x1a, 0x6e,
-	0x5f, 0xf1, 0x86, 0x0d, 0xc3, 0x9f, 0x82, 0x25, 0x12, 0x3e, 0x8c, 0x91, 0x2b, 0x8a, 0x80, 0xd3,
-	0x68, 0x51, 0xf7, 0x91, 0xd3, 0xc4, 0xc8, 0xc3, 0xb1, 0x4c, 0xd4, 0xd4, 0xc6, 0xeb, 0xe7, 0x65,
-	0xfe, 0xae, 0x44, 0xdb, 0x57, 0xba, 0x34, 0xdb, 0x82, 0x45, 0x0d, 0xf7, 0x27, 0xbf, 0xf8, 0x52,
-	0xc9, 0xef, 0x4d, 0x69, 0x9a, 0xfc, 0x5f, 0x1b, 0x60, 0x76, 0x9f, 0xf9, 0x3f, 0x8a, 0x3c, 0xc4,
-	0xf1, 0x21, 0x8a, 0x51, 0xc0, 0x44, 0xba, 0x51, 0x9b, 0x37, 0xa9, 0x28, 0x1c, 0xe7, 0xa7, 0x3b,
-	0x85, 0xc2, 0x3d, 0x30, 0x19, 0x49, 0x06, 0x9d, 0xdd, 0xaf, 0x59, 0x39, 0xca, 0xb4, 0xa5, 0x8c,
-	0x6e, 0x17, 0x9f, 0x3e, 0xab, 0x8e, 0xd9, 0x9a, 0x60, 0x73, 0x46, 0xc6, 0x93, 0x52, 0xd7, 0x96,
-	0xc1, 0x52, 0x9f, 0x97, 0x69, 0x04, 0x7f, 0x2b, 0x81, 0x85, 0x7d, 0xe6, 0x27, 0x51, 0x6e, 0x79,
-	0x1e, 0x11, 0x69, 0x84, 0xcb, 0xfd, 0x75, 0xa6, 0x5b, 0x63, 0xbe, 0x0f, 0x66, 0x48, 0x48, 0x38,
-	0x41, 0x2d, 0xa7, 0x89, 0xc5, 0xdc, 0x68, 0x87, 0x2b, 0x72, 0xb6, 0x44, 0x6d, 0xb5, 0x74, 0x45,
-	0x95, 0x33, 0x24, 0x10, 0xda, 0xbf, 0xcb, 0x5a, 0x4f, 0x0d, 0x8a, 0x9a, 0xe3, 0xe3, 0x10, 0x33,
-	0xc2, 0x9c, 0x26, 0x62, 0x4d, 0x39, 0xe9, 0xd3, 0xf6, 0x94, 0x1e, 0xbb, 0x8b, 0x58, 0x53, 0x4c,
-	0x61, 0x83, 0x84, 0x28, 0x3e, 0x51, 0x88, 0xa2, 0x44, 0x00, 0x35, 0x24, 0x01, 0x3b, 0x00, 0xb0,
-	0x08, 0x1d, 0x87, 0x8e, 0xe8, 0x36, 0xb2, 0xc2, 0x08, 0x47, 0x54, 0x27, 0xb1, 0x92, 0x4e, 0x62,
-	0xdd, 0x4f, 0x5a, 0xd1, 0x76, 0x49, 0x38, 0xf2, 0xe1, 0xdf, 0xab, 0x86, 0x5d, 0x96, 0x7a, 0x42,
-	0x02, 0xef, 0x81, 0xb9, 0x76, 0xd8, 0xa0, 0xa1, 0x47, 0x42, 0xdf, 0x89, 0x70, 0x4c, 0xa8, 0x67,
-	0x4e, 0x4a, 0xaa, 0xe5, 0x01, 0xaa, 0x5d, 0xdd, 0xb4, 0x14, 0xd3, 0xaf, 0x04, 0xd3, 0x6c, 0xaa,
-	0x7c, 0x28, 0x75, 0xe1, 0xbb, 0x00, 0xba, 0x6e, 0x47, 0xba, 0x44, 0xdb, 0x3c, 0x61, 0xbc, 0x94,
-	0x9f, 0x71, 0xce, 0x75, 0x3b, 0xf7, 0x95, 0xb6, 0xa6, 0xfc, 0x09, 0x58, 0xe2, 0x31, 0x0a, 0xd9,
-	0x43, 0x1c, 0xf7, 0xf3, 0x96, 0xf2, 0xf3, 0x5e, 0x49, 0x38, 0xb2, 0xe4, 0x77, 0xc1, 0x6a, 0xba,
-	0x51, 0x62, 0xec, 0x11, 0xc6, 0x63, 0xd2, 0x68, 0xcb, 0x5d, 0x99, 0xec, 0x2b, 0xb3, 0x2c, 0x17,
-	0xc1, 0x4a, 0x82, 0xb3, 0x33, 0xb0, 0xef, 0x69, 0x14, 0x3c, 0x00, 0xaf, 0xc9, 0x7d, 0xcc, 0x84,
-	0x73, 0x4e, 0x86, 0x49, 0x9a, 0x0e, 0x08, 0x63, 0x82, 0x0d, 0xac, 0x1a, 0x6b, 0xe3, 0xf6, 0x75,
-	0x85, 0x3d, 0xc4, 0xf1, 0x6e, 0x0f, 0xf2, 0x7e, 0x0f, 0x10, 0xae, 0x03, 0xd8, 0x24, 0x8c, 0xd3,
-	0x98, 0xb8, 0xa8, 0xe5, 0xe0, 0x90, 0xc7, 0x04, 0x33, 0x73, 0x4a, 0xaa, 0xcf, 0x77, 0x25, 0x77,
-	0x94, 0x00, 0xbe, 0x03, 0xae, 0x9f, 0x6a, 0xd4, 0x71, 0x9b, 0x28, 0x0c, 0x71, 0xcb, 0x9c, 0x96,
-	0xa1, 0x54, 0xbd, 0x53, 0x6c, 0xee, 0x28, 0x18, 0x5c, 0x00, 0x13, 0x9c, 0x46, 0xce, 0x3d, 0xf3,
-	0xf2, 0xaa, 0xb1, 0x76, 0xd9, 0x2e, 0x72, 0x1a, 0xdd, 0x83, 0x6f, 0x81, 0xc5, 0x0e, 0x6a, 0x11,
-	0x0f, 0x71, 0x1a, 0x33, 0x27, 0xa2, 0xc7, 0x38, 0x76, 0x5c, 0x14, 0x99, 0x33, 0x12, 0x03, 0xbb,
-	0xb2, 0x43, 0x21, 0xda, 0x41, 0x11, 0xbc, 0x01, 0xe6, 0xd3, 0x51, 0x87, 0x61, 0x2e, 0xe1, 0xb3,
-	0x12, 0x3e, 0x9b, 0x0a, 0x8e, 0x30, 0x17, 0xd8, 0x6b, 0xa0, 0x8c, 0x5a, 0x2d, 0x7a, 0xdc, 0x22,
-	0x8c, 0x9b, 0x73, 0xab, 0xe3, 0x6b, 0x65, 0xbb, 0x3b, 0x00, 0x2b, 0xa0, 0xe4, 0xe1, 0xf0, 0x44,
-	0x0a, 0xe7, 0xa5, 0x30, 0x7d, 0xcf, 0x56, 0x1d, 0x98, 0xbf, 0xea, 0x5c, 0x05, 0xe5, 0x40, 0xd4,
-	0x17, 0x8e, 0x1e, 0x61, 0x73, 0x61, 0xd5, 0x58, 0x2b, 0xda, 0xa5, 0x80, 0x84, 0x47, 0xe2, 0x1d,
-	0x5a, 0x60, 0x41, 0x5a, 0x77, 0x48, 0x28, 0xe6, 0xb7, 0x83, 0x9d, 0x0e, 0x6a, 0x31, 0x73, 0x71,
-	0xd5, 0x58, 0x2b, 0xd9, 0xf3, 0x52, 0xb4, 0xa7, 0x25, 0x0f, 0x50, 0x8b, 0x6d, 0xce, 0x65, 0xeb,
-	0x8e, 0x69, 0xd4, 0xfe, 0x60, 0x00, 0xd8, 0x53, 0x5e, 0x6c, 0x1c, 0xd0, 0x0e, 0x6a, 0x9d, 0x55,
-	0x5d, 0xb6, 0x40, 0x99, 0x89, 0xb4, 0xcb, 0xfd, 0x5c, 0xb8, 0xc0, 0x7e, 0x2e, 0x09, 0x35, 0xb9,
-	0x9d, 0x33, 0xb9, 0x18, 0xcf, 0x9d, 0x8b, 0x21, 0xee, 0x47, 0x60, 0x7e, 0x9f, 0xf9, 0xd2, 0x6b,
-	0x9c, 0xc4, 0xd0, 0xdf, 0x56, 0x8c, 0xfe, 0xb6, 0x02, 0x2d, 0x30, 0x41, 0x8f, 0xc5, 0x39, 0xa9,
-	0x70, 0x8e, 0x6d, 0x05, 0xdb, 0x04, 0xc2, 0xae, 0x7a, 0xae, 0x5d, 0x05, 0xcb, 0x03, 0x16, 0xd3,
-	0x62, 0xfd, 0x7b, 0x03, 0x5c, 0x11, 0xd9, 0x6c, 0xa2, 0xd0, 0xc7, 0x36, 0x3e, 0x46, 0xb1, 0xb7,
-	0x8b, 0x43, 0x1a, 0x30, 0x58, 0x03, 0x97, 0x3d, 0xf9, 0xe4, 0x70, 0x2a, 0x0e, 0x7e, 0xa6, 0x21,
-	0xd7, 0xc7, 0x94, 0x1a, 0xbc, 0x4f, 0xb7, 0x3c, 0x0f, 0xae, 0x81, 0xb9, 0x2e, 0x26, 0x96, 0x16,
-	0xcc, 0x82, 0x84, 0xcd, 0x24, 0x30, 0x65, 0x77, 0xe4, 0x04, 0xf6, 0xf7, 0x9d, 0xaa, 0x3c, 0x9a,
-	0x0c, 0xba, 0x9b, 0x06, 0xf4, 0x2f, 0x03, 0x94, 0xf6, 0x99, 0x7f, 0x10, 0xf1, 0xbd, 0xf0, 0xcb,
-	0x70, 0xb4, 0x85, 0x60, 0x2e, 0x09, 0x37, 0xcd, 0xc1, 0x9f, 0x0c, 0x50, 0x56, 0x83, 0x07, 0x6d,
-	0xfe, 0xb9, 0x25, 0xa1, 0x1b, 0xe1, 0xf8, 0x68, 0x11, 0x16, 0xf3, 0x45, 0xb8, 0x20, 0x77, 0x8c,
-	0x0a, 0x26, 0x0d, 0xf1, 0x37, 0x05, 0x79, 0xa4, 0x17, 0x45, 0x4e, 0xab, 0xef, 0xd0, 0x40, 0x57,
-	0x5b, 0x1b, 0x71, 0x3c, 0x18, 0x96, 0x91, 0x33, 0xac, 0xde, 0x74, 0x15, 0x06, 0xd3, 0x75, 0x07,
-	0x14, 0x63, 0xc4, 0xb1, 0x8e, 0xf9, 0xa6, 0xa8, 0x15, 0x7f, 0x7d, 0x56, 0xbd, 0xaa, 0xe2, 0x66,
-	0xde, 0x23, 0x8b, 0xd0, 0x7a, 0x80, 0x78, 0xd3, 0xfa, 0x21, 0xf6, 0x91, 0x7b, 0xb2, 0x8b, 0xdd,
-	0x4f, 0x3f, 0x5e, 0x07, 0x3a, 0x2d, 0xbb, 0xd8, 0xb5, 0xa5, 0xfa, 0xff, 0x6d, 0x79, 0xbc, 0x0e,
-	0x5e, 0x3b, 0x2b, 0x4d, 0x69, 0x3e, 0x9f, 0x8c, 0xcb, 0x03, 0x5d, 0x7a, 0x2f, 0xa0, 0x1e, 0x79,
-	0x28, 0x8e, 0xd7, 0xa2, 0x61, 0x2e, 0x82, 0x09, 0x4e, 0x78, 0x0b, 0xeb, 0xba, 0xa4, 0x5e, 0xe0,
-	0x2a, 0x98, 0xf2, 0x30, 0x73, 0x63, 0x12, 0xc9, 0x66, 0x5e, 0x50, 0x5b, 0xa0, 0x67, 0x28, 0x53,
-	0x92, 0xc7, 0xb3, 0x25, 0x39, 0x6d, 0x84, 0xc5, 0x1c, 0x8d, 0x70, 0xe2, 0x62, 0x8d, 0x70, 0x32,
-	0x47, 0x23, 0xbc, 0x74, 0x56, 0x23, 0x2c, 0x9d, 0xd5, 0x08, 0xcb, 0x23, 0x36, 0x42, 0x90, 0xaf,
-	0x11, 0x4e, 0xe5, 0x6f, 0x84, 0xd7, 0x41, 0xf5, 0x94, 0x19, 0x4b, 0x67, 0xf5, 0x8f, 0x45, 0xb9,
-	0x77, 0x76, 0x62, 0x8c, 0x78, 0xb7, 0xdb, 0x8c, 0x7a, 0x7b, 0x5b, 0xee, 0xdf, 0x19, 0xdd, 0xf9,
-	0x7c, 0x0f, 0x94, 0x02, 0xcc, 0x91, 0x87, 0x38, 0xd2, 0x17, 0xad, 0xb7, 0x73, 0xdd, 0x35, 0x52,
-	0xef, 0xb5, 0xb2, 0x3e, 0xd5, 0xa7, 0x64, 0xf0, 0x7d, 0x03, 0x2c, 0xeb, 0x23, 0x3e, 0xf9, 0x99,
-	0x0c, 0xce, 0x91, 0x37, 0x12, 0xcc, 0x71, 0xcc, 0xe4, 0xea, 0x99, 0xda, 0xb8, 0x73, 0x21, 0x53,
-	0x7b, 0x19, 0xb6, 0xc3, 0x94, 0xcc, 0x36, 0xc9, 0x29, 0x12, 0xd8, 0x06, 0xa6, 0x5a, 0x8d, 0xac,
-	0x89, 0x22, 0x79, 0xa0, 0xef, 0xba, 0xa0, 0xee, 0x07, 0xdf, 0xce, 0x77, 0xb3, 0x12, 0x24, 0x47,
-	0x8a, 0xa3, 0xc7, 0xf0, 0x2b, 0xd1, 0xd0, 0x71, 0xf8, 0x18, 0x2c, 0xa7, 0x0b, 0x14, 0x7b, 0x4e,
-	0x2c, 0xdb, 0x9d, 0xa3, 0x1a, 0xab, 0xbe, 0x4c, 0xdc, 0xce, 0x65, 0x77, 0xab, 0xcb, 0x92, 0xe9,
-	0x99, 0x4b, 0x68, 0xb8, 0x40, 0x77, 0xdd, 0xee, 0xed, 0xf5, 0xb6, 0x3c, 0x42, 0x64, 0x97, 0x51,
-	0xb2, 0xc8, 0xce, 0x3d, 0xbc, 0xd4, 0xfe, 0xab, 0x56, 0xa1, 0xba, 0x2c, 0xa6, 0xab, 0x30, 0x3d,
-	0xd2, 0x18, 0xb9, 0x8e, 0x34, 0xfd, 0x66, 0x0a, 0x03, 0x67, 0xa4, 0x5d, 0x30, 0x1f, 0xe2, 0x63,
-	0x47, 0xa2, 0x1d, 0x5d, 0xdc, 0xcf, 0x6d, 0x4d, 0xb3, 0x21, 0x3e, 0x3e, 0x10, 0x1a, 0x7a, 0x18,
-	0xbe, 0xdb, 0xb3, 0x92, 0x8b, 0x2f, 0xb1, 0x92, 0x73, 0xaf, 0xe1, 0x89, 0x2f, 0x7e, 0x0d, 0x4f,
-	0x7e, 0x41, 0x6b, 0xf8, 0xd2, 0xe7, 0xb9, 0x86, 0x07, 0x8f, 0xc0, 0xd9, 0x05, 0x98, 0xac, 0xdf,
-	0x8d, 0xa7, 0xd3, 0x60, 0x7c, 0x9f, 0xf9, 0xf0, 0x17, 0x06, 0x98, 0x1f, 0xfc, 0x3a, 0xfa, 0xad,
-	0x5c, 0xde, 0x0d, 0xfb, 0xba, 0x58, 0xd9, 0x1a, 0x59, 0x35, 0xdd, 0x5b, 0xbf, 0x33, 0x40, 0xe5,
-	0x8c, 0xaf, 0x92, 0xdb, 0x79, 0x2d, 0x9c, 0xce, 0x51, 0x79, 0xe7, 0xe5, 0x39, 0xce, 0x70, 0x37,
-	0xf3, 0xd9, 0x70, 0x44, 0x77, 0x7b, 0x39, 0x46, 0x75, 0x77, 0xd8, 0xb7, 0x36, 0xf8, 0x81, 0x01,
-	0x66, 0xfa, 0x7b, 0x63, 0x5e, 0xfa, 0xac, 0x5e, 0xe5, 0xbb, 0xa3, 0xe9, 0x65, 0x5c, 0xe9, 0x2b,
-	0x90, 0xb9, 0x5d, 0xc9, 0xea, 0xe5, 0x77, 0x65, 0xf8, 0x7e, 0x90, 0xae, 0xf4, 0xdd, 0x4f, 0x73,
-	0xbb, 0x92, 0xd5, 0xcb, 0xef, 0xca, 0xf0, 0xdb, 0xa9, 0xa8, 0x9c, 0xd3, 0x99, 0x2f, 0xa1, 0xdf,
-	0xb8, 0x58, 0x6c, 0x4a, 0xab, 0x72, 0x7b, 0x14, 0xad, 0xd4, 0x89, 0x00, 0x4c, 0xa8, 0xdb, 0xe4,
-	0x7a, 0x5e, 0x1a, 0x09, 0xaf, 0xbc, 0x7d, 0x21, 0x78, 0x6a, 0x2e, 0x02, 0x93, 0xfa, 0xe2, 0x66,
-	0x5d, 0x80, 0xe0, 0xa0, 0xcd, 0x2b, 0xb7, 0x2e, 0x86, 0x4f, 0x2d, 0xfe, 0xd6, 0x00, 0xcb, 0xa7,
-	0x5f, 0xa4, 0x72, 0x57, 0xb1, 0x53, 0x29, 0x2a, 0x7b, 0x2f, 0x4d, 0x91, 0xfa, 0xfa, 0x4b, 0x03,
-	0xc0, 0x21, 0x1f, 0x2b, 0x36, 0x73, 0x6f, 0xbf, 0x01, 0xdd, 0xca, 0xf6, 0xe8, 0xba, 0x89, 0x5b,
-	0x95, 0x89, 0x9f, 0x7f, 0xf6, 0xe4, 0x86, 0xb1, 0xfd, 0xde, 0xd3, 0xe7, 0x2b, 0xc6, 0x27, 0xcf,
-	0x57, 0x8c, 0x7f, 0x3c, 0x5f, 0x31, 0x3e, 0x7c, 0xb1, 0x32, 0xf6, 0xc9, 0x8b, 0x95, 0xb1, 0xbf,
-	0xbc, 0x58, 0x19, 0xfb, 0xf1, 0x77, 0x7c, 0xc2, 0x9b, 0xed, 0x86, 0xe5, 0xd2, 0x40, 0xff, 0xad,
-	0x58, 0xef, 0x5a, 0x5d, 0x4f, 0xff, 0x15, 0xec, 0xdc, 0xaa, 0x3f, 0xce, 0xfe, 0x35, 0x28, 0xff,
-	0x04, 0x69, 0x4c, 0xca, 0xef, 0x54, 0x5f, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0xeb,
-	0x11, 0x01, 0x96, 0x1d, 0x00, 0x00,
+	// 2962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3a, 0xcb, 0x6f, 0xdc, 0xc6,
+	0xf9, 0xa6, 0x5e, 0x5e, 0x7d, 0x7a, 0x2e, 0x25, 0x47, 0xd4, 0xda, 0x96, 0xe4, 0x75, 0xe2, 0x28,
+	0x4e, 0xb4, 0x6b, 0x2b, 0x3f, 0x3b, 0xbf, 0xae, 0xf3, 0xa8, 0x5e, 0x8e, 0x95, 0x54, 0xb6, 0x42,
+	0x3b, 0x0e, 0xda, 0x02, 0x25, 0x46, 0xe4, 0x88, 0x3b, 0xf1, 0x2e, 0x49, 0x70, 0x66, 0x57, 0x56,
+	0x7b, 0x29, 0x52, 0x14, 0x08, 0x5a, 0xb4, 0x4d, 0x81, 0x02, 0xed, 0x31, 0x40, 0x0f, 0x45, 0x81,
+	0x1e, 0x72, 0xc8, 0x3f, 0xd0, 0x53, 0x72, 0x6b, 0x10, 0xa0, 0x45, 0x51, 0x14, 0x69, 0xe0, 0x1c,
+	0xdc, 0x6b, 0x7b, 0x6e, 0x8b, 0x62, 0x86, 0x43, 0x2e, 0xb9, 0xdc, 0x95, 0xa8, 0x95, 0x9d, 0x1c,
+	0x7a, 0x11, 0x76, 0xbe, 0xf7, 0x63, 0xe6, 0x9b, 0xf9, 0x3e, 0x11, 0x9e, 0x23, 0x0e, 0xc3, 0xbe,
+	0x59, 0x45, 0xc4, 0x31, 0x28, 0x36, 0x1b, 0x3e, 0x61, 0xfb, 0x65, 0xd3, 0x6c, 0x96, 0x3d, 0xdf,
+	0x6d, 0x12, 0x0b, 0xfb, 0xe5, 0xe6, 0xe5, 0x32, 0xbb, 0x5f, 0xf2, 0x7c, 0x97, 0xb9, 0xea, 0xf9,
+	0x0e, 0xd4, 0x25, 0xd3, 0x6c, 0x96, 0x42, 0xea, 0x52, 0xf3, 0x72, 0x21, 0x8f, 0xea, 0xc4, 0x71,
+	0xcb, 0xe2, 0x6f, 0xc0, 0x57, 0x38, 0x63, 0xbb, 0xae, 0x5d, 0xc3, 0x65, 0xe4, 0x91, 0x32, 0x72,
+	0x1c, 0x97, 0x21, 0x46, 0x5c, 0x87, 0x4a, 0xec, 0xbc, 0xc4, 0x8a, 0xd5, 0x4e, 0x63, 0xb7, 0xcc,
+	0x48, 0x1d, 0x53, 0x86, 0xea, 0x9e, 0x24, 0x98, 0x6b, 0x27, 0xb0, 0x1a, 0xbe, 0x90, 0x20, 0xf1,
+	0xb3, 0xed, 0x78, 0xe4, 0xec, 0x4b, 0xd4, 0xb4, 0xed, 0xda, 0xae, 0xf8, 0x59, 0xe6, 0xbf, 0x42,
+	0x06, 0xd3, 0xa5, 0x75, 0x97, 0x1a, 0x01, 0x22, 0x58, 0x48, 0xd4, 0x4c, 0xb0, 0x2a, 0xd7, 0xa9,
+	0xcd, 0x5d, 0xaf, 0x53, 0x3b, 0xb4, 0x92, 0xec, 0x98, 0x65, 0xd3, 0xf5, 0x71, 0xd9, 0xac, 0x11,
+	0xec, 0x30, 0x8e, 0x0d, 0x7e, 0x49, 0x82, 0xe5, 0x2c, 0xa1, 0x0c, 0x7f, 0x4b, 0x9e, 0x32, 0x17,
+	0x5a, 0x23, 0x76, 0x95, 0x05, 0xa2, 0x68, 0x99, 0x61, 0xc7, 0xc2, 0x7e, 0x9d, 0x04, 0x0a, 0x5a,
+	0xab, 0xd0, 0x8a, 0x18, 0x9e, 0xed, 0x7b, 0x98, 0x96, 0x31, 0x97, 0xe7, 0x98, 0x38, 0x20, 0x28,
+	0xfe, 0xa9, 0x0f, 0xa6, 0xb7, 0xa8, 0xbd, 0x42, 0x29, 0xb1, 0x9d, 0x35, 0xd7, 0xa1, 0x8d, 0x3a,
+	0xf6, 0x5f, 0xc7, 0xfb, 0xea, 0x59, 0xc8, 0x05, 0xb6, 0x11, 0x4b, 0x53, 0x16, 0x94, 0xc5, 0xe1,
+	0xd5, 0x3e, 0x4d, 0xd1, 0x4f, 0x0a, 0xd8, 0xa6, 0xa5, 0xbe, 0x00, 0x63, 0xa1, 0x6d, 0x06, 0xb2,
+	0x2c, 0x5f, 0xeb, 0x13, 0x34, 0xea, 0x3f, 0x3f, 0x9b, 0x1f, 0xdf, 0x47, 0xf5, 0x5a, 0xa5, 0xc8,
+	0xa1, 0x98, 0xd2, 0xa2, 0x3e, 0x1a, 0x12, 0xae, 0x58, 0x96, 0xaf, 0x5e, 0x87, 0x51, 0x53, 0xaa,
+	0x31, 0xee, 0xe1, 0x7d, 0xad, 0x5f, 0xf0, 0x9d, 0xff, 0xf4, 0xc3, 0xa5, 0xf9, 0x4e, 0xbb, 0x25,
+	0x66, 0x92, 0x3e, 0x62, 0xc6, 0xec, 0xbb, 0x04, 0x43, 0xdc, 0x64, 0xec, 0x6b, 0x03, 0x42, 0x82,
+	0xf6, 0xe9, 0x87, 0x4b, 0xd3, 0x32, 0x35, 0x2b, 0x81, 0xea, 0xdb, 0xcc, 0x27, 0x8e, 0xad, 0x4b,
+	0x3a, 0x75, 0x1e, 0x22, 0x01, 0xdc, 0xa9, 0x41, 0xce, 0xa6, 0x43, 0x08, 0xda, 0xb4, 0xd4, 0x69,
+	0x18, 0x74, 0x5c, 0xc7, 0xc4, 0xda, 0xd0, 0x82, 0xb2, 0x38, 0xa0, 0x07, 0x8b, 0xca, 0x95, 0x77,
+	0xdf, 0x9f, 0x3f, 0xf1, 0xf7, 0xf7, 0xe7, 0x4f, 0xbc, 0xf3, 0xf0, 0x83, 0x8b, 0x52, 0xd6, 0x8f,
+	0x1e, 0x7e, 0x70, 0xf1, 0x6c, 0x94, 0xa7, 0x4e, 0xf1, 0x2b, 0xce, 0xc1, 0x99, 0x4e, 0x70, 0x1d,
+	0x53, 0xcf, 0x75, 0x28, 0x2e, 0xfe, 0xa4, 0x0f, 0xce, 0x6e, 0x51, 0xfb, 0x76, 0x63, 0xa7, 0x4e,
+	0x58, 0x48, 0xb0, 0x45, 0xe8, 0x0e, 0xae, 0xa2, 0x26, 0x71, 0x1b, 0xbe, 0x7a, 0x15, 0x86, 0xa9,
+	0xc0, 0x32, 0xec, 0x6b, 0xca, 0x21, 0x4e, 0xb6, 0x48, 0xd5, 0x6d, 0x18, 0xad, 0xc7, 0xe4, 0x88,
+	0xcc, 0x8c, 0x2c, 0x3f, 0x57, 0x22, 0x3b, 0x66, 0x29, 0xbe, 0x77, 0x4a, 0xb1, 0xdd, 0xd2, 0xbc,
+	0x5c, 0x8a, 0xeb, 0xd6, 0x13, 0x12, 0xda, 0x23, 0xd7, 0xdf, 0x1e, 0xb9, 0xca, 0x4a, 0x3c, 0x46,
+	0x2d, 0x53, 0x78, 0x98, 0x2e, 0xc4, 0xc3, 0xd4, 0xdd, 0xdb, 0xe2, 0xd3, 0xf0, 0xd4, 0x81, 0x04,
+	0x51, 0xe0, 0xfe, 0xd5, 0x29, 0x70, 0xeb, 0x6e, 0x63, 0xa7, 0x86, 0xef, 0xba, 0x8c, 0x38, 0x76,
+	0xcf, 0x81, 0x33, 0x60, 0xc6, 0x6a, 0x78, 0x35, 0x62, 0x22, 0x86, 0x8d, 0xa6, 0xcb, 0xb0, 0x11,
+	0x1e, 0x16, 0x19, 0xc3, 0xa7, 0xe3, 0x21, 0x13, 0xc7, 0xa9, 0xb4, 0x1e, 0x32, 0xdc, 0x75, 0x19,
+	0xde, 0x90, 0xe4, 0xfa, 0x29, 0xab, 0x13, 0x58, 0xfd, 0x0e, 0xcc, 0x10, 0x67, 0xd7, 0x47, 0x26,
+	0x2f, 0x46, 0xc6, 0x4e, 0xcd, 0x35, 0xef, 0x19, 0x55, 0x8c, 0x2c, 0xec, 0x8b, 0x98, 0x8e, 0x2c,
+	0x5f, 0x38, 0x2c, 0x49, 0x37, 0x04, 0xb5, 0x7e, 0xaa, 0x25, 0x66, 0x95, 0x4b, 0x09, 0xc0, 0xed,
+	0x79, 0x1a, 0x78, 0x44, 0x79, 0x8a, 0x07, 0xb7, 0x63, 0x9e, 0xe2, 0x04, 0x51, 0x9e, 0x7e, 0xaf,
+	0xc0, 0xc4, 0x16, 0xb5, 0xdf, 0xf4, 0x2c, 0xc4, 0xf0, 0x36, 0xf2, 0x51, 0x9d, 0xf2, 0xcc, 0xa0,
+	0x06, 0xab, 0xba, 0xfc, 0x68, 0x1f, 0x9e, 0x99, 0x88, 0x54, 0xdd, 0x84, 0x21, 0x4f, 0x48, 0x90,
+	0x89, 0x78, 0xb6, 0x94, 0xe1, 0x66, 0x29, 0x05, 0x4a, 0x57, 0x07, 0x3e, 0xfe, 0x6c, 0xfe, 0x84,
+	0x2e, 0x05, 0x54, 0x9e, 0x15, 0xae, 0x47, 0xa2, 0xb9, 0xeb, 0x5a, 0xdc, 0xf5, 0xb8, 0xbd, 0xc5,
+	0x59, 0x98, 0x69, 0x03, 0x45, 0xee, 0xfd, 0x3b, 0x07, 0x53, 0x5b, 0xd4, 0x0e, 0x43, 0xb0, 0x62,
+	0x59, 0x84, 0xa7, 0x43, 0x9d, 0x6d, 0xaf, 0x9b, 0xad, 0x9a, 0xf9, 0x2a, 0x8c, 0x13, 0x87, 0x30,
+	0x82, 0x6a, 0x46, 0x15, 0xf3, 0x1c, 0x4b, 0x6f, 0x0a, 0x22, 0xeb, 0xfc, 0xae, 0x28, 0xc9, 0x1b,
+	0x42, 0x64, 0x9a, 0x53, 0x48, 0xe3, 0xc7, 0x24, 0x5f, 0x00, 0x54, 0xcf, 0xc1, 0xa8, 0x8d, 0x1d,
+	0x4c, 0x09, 0x35, 0xaa, 0x88, 0x56, 0xc5, 0xe6, 0x19, 0xd5, 0x47, 0x24, 0xec, 0x06, 0xa2, 0x55,
+	0xbe, 0x15, 0x76, 0x88, 0x83, 0xfc, 0xfd, 0x80, 0x62, 0x40, 0x50, 0x40, 0x00, 0x12, 0x04, 0x6b,
+	0x00, 0xd4, 0x43, 0x7b, 0x8e, 0xc1, 0x6f, 0x4f, 0x6d, 0x50, 0x1a, 0x12, 0xdc, 0x8c, 0xa5, 0xf0,
+	0x66, 0x2c, 0xdd, 0x09, 0xaf, 0xd6, 0xd5, 0x1c, 0x37, 0xe4, 0xbd, 0xbf, 0xcd, 0x2b, 0xfa, 0xb0,
+	0xe0, 0xe3, 0x18, 0xf5, 0x26, 0x4c, 0x36, 0x9c, 0x1d, 0xd7, 0xb1, 0x88, 0x63, 0x1b, 0x1e, 0xf6,
+	0x89, 0x6b, 0x89, 0xe2, 0x39, 0xb2, 0x3c, 0x9b, 0x12, 0xb5, 0x2e, 0x2f, 0xe1, 0x40, 0xd2, 0xaf,
+	0xb8, 0xa4, 0x89, 0x88, 0x79, 0x5b, 0xf0, 0xaa, 0x6f, 0x80, 0x6a, 0x9a, 0x4d, 0x61, 0x92, 0xdb,
+	0x60, 0xa1, 0xc4, 0x93, 0xd9, 0x25, 0x4e, 0x9a, 0x66, 0xf3, 0x4e, 0xc0, 0x2d, 0x45, 0x7e, 0x1b,
+	0x66, 0x98, 0x8f, 0x1c, 0xba, 0x8b, 0xfd, 0x76, 0xb9, 0xb9, 0xec, 0x72, 0x4f, 0x85, 0x32, 0x92,
+	0xc2, 0x6f, 0xc0, 0x42, 0x74, 0xe0, 0x7c, 0x6c, 0x11, 0xca, 0x7c, 0xb2, 0xd3, 0x10, 0xa7, 0x3b,
+	0x3c, 0x9f, 0xda, 0xb0, 0xd8, 0x04, 0x73, 0x21, 0x9d, 0x9e, 0x20, 0xbb, 0x2e, 0xa9, 0xd4, 0x5b,
+	0xf0, 0xa4, 0xa8, 0x07, 0x94, 0x1b, 0x67, 0x24, 0x24, 0x09, 0xd5, 0x75, 0x42, 0x29, 0x97, 0x06,
+	0x0b, 0xca, 0x62, 0xbf, 0x7e, 0x2e, 0xa0, 0xdd, 0xc6, 0xfe, 0x7a, 0x8c, 0xf2, 0x4e, 0x8c, 0x50,
+	0x5d, 0x02, 0xb5, 0x4a, 0x28, 0x73, 0x7d, 0x62, 0xa2, 0x9a, 0x81, 0x1d, 0xe6, 0x13, 0x4c, 0xb5,
+	0x11, 0xc1, 0x9e, 0x6f, 0x61, 0x36, 0x02, 0x84, 0xfa, 0x1a, 0x9c, 0xeb, 0xaa, 0xd4, 0x30, 0xab,
+	0xc8, 0x71, 0x70, 0x4d, 0x1b, 0x15, 0xae, 0xcc, 0x5b, 0x5d, 0x74, 0xae, 0x05, 0x64, 0xea, 0x14,
+	0x0c, 0x32, 0xd7, 0x33, 0x6e, 0x6a, 0x63, 0x0b, 0xca, 0xe2, 0x98, 0x3e, 0xc0, 0x5c, 0xef, 0xa6,
+	0x7a, 0x09, 0xa6, 0x9b, 0xa8, 0x46, 0x2c, 0xc4, 0x5c, 0x9f, 0x1a, 0x9e, 0xbb, 0x87, 0x7d, 0xc3,
+	0x44, 0x9e, 0x36, 0x2e, 0x68, 0xd4, 0x16, 0x6e, 0x9b, 0xa3, 0xd6, 0x90, 0xa7, 0x5e, 0x84, 0x7c,
+	0x04, 0x35, 0x28, 0x66, 0x82, 0x7c, 0x42, 0x90, 0x4f, 0x44, 0x88, 0xdb, 0x98, 0x71, 0xda, 0x33,
+	0x30, 0x8c, 0x6a, 0x35, 0x77, 0xaf, 0x46, 0x28, 0xd3, 0x26, 0x17, 0xfa, 0x17, 0x87, 0xf5, 0x16,
+	0x40, 0x2d, 0x40, 0xce, 0xc2, 0xce, 0xbe, 0x40, 0xe6, 0x05, 0x32, 0x5a, 0x27, 0x4b, 0x92, 0x9a,
+	0xbd, 0x24, 0x9d, 0x86, 0xe1, 0x3a, 0x2f, 0x3e, 0x0c, 0xdd, 0xc3, 0xda, 0x94, 0x78, 0x30, 0xe4,
+	0xea, 0xc4, 0xb9, 0xcd, 0xd7, 0x6a, 0x09, 0xa6, 0x84, 0x76, 0x83, 0x38, 0x3c, 0xbf, 0x4d, 0x6c,
+	0x34, 0x51, 0x8d, 0x6a, 0xd3, 0x0b, 0xca, 0x62, 0x4e, 0xcf, 0x0b, 0xd4, 0xa6, 0xc4, 0xdc, 0x45,
+	0x35, 0x5a, 0xb9, 0x9c, 0x2e, 0x4a, 0x67, 0xe2, 0x45, 0xa9, 0xbd, 0xca, 0x68, 0x4a, 0xf1, 0xaf,
+	0x0a, 0xa8, 0x31, 0x8c, 0x8e, 0xeb, 0x6e, 0x13, 0xd5, 0x0e, 0x2a, 0x3f, 0x2b, 0x30, 0x4c, 0x79,
+	0x5e, 0xc4, 0x81, 0xef, 0x3b, 0xc2, 0x81, 0xcf, 0x71, 0x36, 0x71, 0xde, 0x13, 0xc1, 0xea, 0xcf,
+	0x1c, 0xac, 0xca, 0xa5, 0xb4, 0x7f, 0xa7, 0x3b, 0xf9, 0x27, 0xbd, 0xd0, 0x94, 0xe2, 0xcf, 0x14,
+	0xc8, 0x6f, 0x51, 0x5b, 0x00, 0x70, 0x88, 0x6e, 0xbf, 0xe0, 0x94, 0xd4, 0x13, 0xae, 0x04, 0x83,
+	0xee, 0x1e, 0x7f, 0x14, 0xf6, 0x1d, 0x62, 0x5c, 0x40, 0x56, 0x79, 0x86, 0x1b, 0x16, 0xfc, 0xe6,
+	0x46, 0x15, 0xe2, 0x46, 0x25, 0x75, 0x17, 0x4f, 0xc3, 0x6c, 0x0a, 0x18, 0xdd, 0x06, 0xff, 0x51,
+	0x12, 0xb7, 0xc1, 0x0d, 0xe4, 0x5b, 0xd7, 0x5d, 0xff, 0xde, 0x23, 0x37, 0x58, 0x5d, 0x80, 0x51,
+	0x07, 0xef, 0x19, 0x51, 0x8e, 0xe5, 0x5b, 0xcc, 0xc1, 0x7b, 0x6b, 0x5d, 0x6f, 0x99, 0x81, 0x9e,
+	0x6e, 0x99, 0xe0, 0xa6, 0x6c, 0xc5, 0xa6, 0xe3, 0x86, 0x0c, 0x1d, 0x2d, 0x56, 0xe0, 0x74, 0x07,
+	0x70, 0x18, 0x1f, 0x7e, 0x5a, 0x02, 0xa5, 0xad, 0x28, 0xe4, 0x02, 0xc0, 0xa6, 0x55, 0xfc, 0x44,
+	0x81, 0x53, 0x9c, 0xb9, 0x8a, 0x1c, 0x1b, 0xeb, 0x78, 0x0f, 0xf9, 0xd6, 0x3a, 0x76, 0xdc, 0x3a,
+	0x55, 0x8b, 0x30, 0x66, 0x89, 0x5f, 0x06, 0x73, 0x79, 0x9b, 0xa1, 0x29, 0xe2, 0xf4, 0x8e, 0x04,
+	0xc0, 0x3b, 0xee, 0x8a, 0x65, 0xa9, 0x8b, 0x30, 0xd9, 0xa2, 0xf1, 0x45, 0x7a, 0xb4, 0x3e, 0x41,
+	0x36, 0x1e, 0x92, 0x05, 0x49, 0xeb, 0x79, 0xf7, 0x76, 0x38, 0x9d, 0x73, 0x89, 0x60, 0xa4, 0x0c,
+	0x2f, 0xce, 0xc3, 0xd9, 0x8e, 0x88, 0xf8, 0xeb, 0xe8, 0x09, 0xfe, 0x8e, 0xc2, 0xd1, 0x23, 0xea,
+	0x2e, 0xf6, 0xc9, 0x2e, 0xc1, 0xd6, 0xe1, 0x7b, 0xa6, 0x00, 0xb9, 0xa6, 0x24, 0x16, 0xdb, 0x26,
+	0xa7, 0x47, 0xeb, 0x9e, 0x7d, 0x5c, 0x4e, 0xfb, 0x38, 0x9f, 0x78, 0x11, 0xa6, 0x0d, 0x2d, 0x2e,
+	0xc0, 0x5c, 0x67, 0x4c, 0xe4, 0xe5, 0x1f, 0x15, 0xb8, 0x90, 0x24, 0x09, 0xdf, 0xc2, 0xe2, 0x01,
+	0x29, 0x6e, 0x8d, 0x6d, 0xd4, 0xa0, 0x59, 0xbc, 0x7e, 0x82, 0xbf, 0x01, 0x1b, 0x34, 0xf2, 0x59,
+	0xae, 0x7a, 0xf6, 0xb8, 0x92, 0xf6, 0xf8, 0xe9, 0x36, 0x8f, 0xbb, 0x19, 0x5b, 0xbc, 0x04, 0xa5,
+	0x6c, 0x6e, 0x45, 0x91, 0xf8, 0xbc, 0x0f, 0x72, 0x5b, 0xd4, 0xbe, 0xe5, 0xb1, 0x4d, 0xe7, 0x7f,
+	0xb1, 0xb7, 0xbe, 0x0a, 0x33, 0xc8, 0xbc, 0xe7, 0xb8, 0x7b, 0x35, 0x6c, 0xd9, 0xd8, 0x32, 0x18,
+	0xf6, 0xeb, 0xf2, 0xf5, 0x3a, 0x24, 0x88, 0x4f, 0xc5, 0xd1, 0x77, 0x38, 0x96, 0x3f, 0x53, 0x2b,
+	0xcf, 0x74, 0xe9, 0xbe, 0xf3, 0xf1, 0x54, 0x89, 0xa8, 0x16, 0x9f, 0x83, 0xc9, 0xf0, 0x77, 0x54,
+	0x77, 0x34, 0x38, 0xb9, 0x87, 0x7c, 0x87, 0x38, 0x76, 0x78, 0x1b, 0xca, 0x65, 0xf1, 0x81, 0x02,
+	0xc3, 0x01, 0xf9, 0xad, 0x06, 0x7b, 0x6c, 0x19, 0x69, 0x45, 0xb2, 0xbf, 0xb7, 0x48, 0xa6, 0x7b,
+	0xb8, 0x8b, 0x5d, 0x22, 0xa2, 0xb6, 0x45, 0xe4, 0x56, 0x83, 0x15, 0xa7, 0x20, 0x1f, 0x2d, 0xe2,
+	0x87, 0x92, 0xbf, 0x1c, 0x36, 0xea, 0xd8, 0xb7, 0xb1, 0x63, 0xee, 0xcb, 0x10, 0x1c, 0x7a, 0x00,
+	0xbf, 0xbc, 0x20, 0x54, 0x9e, 0xef, 0xe2, 0x63, 0xe2, 0xd1, 0xd0, 0xe6, 0x40, 0xf1, 0x0c, 0x14,
+	0xd2, 0xd0, 0xc8, 0xeb, 0x3f, 0xf4, 0xc1, 0x99, 0xe4, 0x99, 0x5d, 0x73, 0xeb, 0xf2, 0xac, 0xea,
+	0x88, 0xe1, 0xb4, 0x7b, 0x4a, 0x46, 0xf7, 0xe2, 0x7b, 0xa7, 0x2f, 0xbd, 0x77, 0x36, 0x60, 0xc0,
+	0x47, 0x0c, 0x4b, 0xdf, 0x2f, 0xf3, 0x9b, 0xf6, 0x2f, 0x9f, 0xcd, 0x9f, 0x0e, 0xfc, 0xa7, 0xd6,
+	0xbd, 0x12, 0x71, 0xcb, 0x75, 0xc4, 0xaa, 0xa5, 0x6f, 0x60, 0x1b, 0x99, 0xfb, 0xeb, 0xd8, 0xfc,
+	0xf4, 0xc3, 0x25, 0x90, 0xe1, 0x59, 0xc7, 0xa6, 0x2e, 0xd8, 0x1f, 0xc3, 0x99, 0xac, 0xbc, 0xd4,
+	0x25, 0xca, 0x4f, 0x75, 0x29, 0xfc, 0xc9, 0x80, 0x15, 0x2f, 0xc0, 0x93, 0x07, 0xe1, 0xa3, 0xc8,
+	0xff, 0xa0, 0x0f, 0x0a, 0x49, 0xc2, 0x95, 0x26, 0x22, 0x35, 0xb4, 0x43, 0x6a, 0xfc, 0x21, 0xfd,
+	0xf8, 0xf6, 0x1d, 0x6f, 0x0a, 0x02, 0x4d, 0xb5, 0x20, 0xfc, 0x39, 0xbd, 0x05, 0x38, 0x7a, 0x40,
+	0x2b, 0xd7, 0xba, 0xc4, 0xeb, 0x7c, 0x97, 0x78, 0xc5, 0xdd, 0x2c, 0x3e, 0x09, 0xc5, 0xee, 0xd8,
+	0xd6, 0xd9, 0xec, 0x17, 0x13, 0x87, 0x90, 0x66, 0xcb, 0xb5, 0xc8, 0x2e, 0x9f, 0x23, 0xf1, 0x8e,
+	0x6e, 0x1a, 0x06, 0x19, 0x61, 0x35, 0x2c, 0x43, 0x14, 0x2c, 0xd4, 0x05, 0x18, 0xb1, 0x30, 0x35,
+	0x7d, 0xe2, 0x71, 0xa2, 0x20, 0x36, 0x7a, 0x1c, 0x94, 0x68, 0x09, 0xfa, 0x93, 0x2d, 0x41, 0xd4,
+	0xa9, 0x0d, 0x64, 0xe8, 0xd4, 0x06, 0x8f, 0xd6, 0xa9, 0x0d, 0x65, 0xe8, 0xd4, 0x4e, 0x1e, 0xd4,
+	0xa9, 0xe5, 0x0e, 0xea, 0xd4, 0x86, 0x7b, 0xec, 0xd4, 0x20, 0x5b, 0xa7, 0x36, 0xd2, 0xad, 0x53,
+	0xbb, 0x92, 0x7e, 0x35, 0x2c, 0x74, 0x7a, 0x18, 0xc7, 0x33, 0xa7, 0x29, 0xc5, 0x73, 0x30, 0xdf,
+	0x05, 0x19, 0xa5, 0xfe, 0x87, 0x83, 0xa2, 0x58, 0xaf, 0xf9, 0x18, 0xb1, 0x56, 0xc7, 0xd3, 0xeb,
+	0x2c, 0x73, 0xb6, 0xbd, 0x28, 0xb5, 0x92, 0xfe, 0x16, 0xe4, 0xea, 0x98, 0x21, 0x0b, 0x31, 0x24,
+	0xc7, 0x8e, 0x57, 0x32, 0x8d, 0xd3, 0x22, 0xeb, 0x25, 0xb3, 0xec, 0x1a, 0x22, 0x61, 0xea, 0x3b,
+	0x0a, 0xcc, 0xca, 0x16, 0x82, 0x7c, 0x57, 0x38, 0x67, 0x88, 0xa1, 0x1b, 0x66, 0xd8, 0xa7, 0xb2,
+	0x0b, 0xd9, 0x38, 0x92, 0xaa, 0xcd, 0x84, 0xb4, 0xed, 0x48, 0x98, 0xae, 0x91, 0x2e, 0x18, 0xb5,
+	0x01, 0x5a, 0xb0, 0x65, 0x69, 0x15, 0x79, 0x62, 0x2c, 0xd5, 0x32, 0x21, 0x98, 0x72, 0x5d, 0xcb,
+	0x36, 0x3c, 0xe4, 0x42, 0x6e, 0x07, 0x32, 0x62, 0x8a, 0x9f, 0xf0, 0x3a, 0xc2, 0xd5, 0xfb, 0x30,
+	0x1b, 0xed, 0x62, 0x6c, 0x19, 0xbe, 0x78, 0xf3, 0x1b, 0x41, 0x03, 0x22, 0x47, 0x62, 0x2f, 0x66,
+	0xd2, 0xbb, 0xd2, 0x92, 0x92, 0x68, 0x1c, 0x66, 0x50, 0x67, 0x04, 0xaf, 0x9f, 0x9e, 0xef, 0x7a,
+	0x2e, 0x45, 0x35, 0x83, 0x04, 0xc3, 0xb2, 0x01, 0x1d, 0x42, 0xd0, 0xa6, 0x55, 0x59, 0x4a, 0x0f,
+	0x7b, 0x13, 0x7d, 0x6e, 0x72, 0xc7, 0x15, 0x3f, 0x52, 0x60, 0x36, 0x05, 0x8d, 0x1e, 0x54, 0x87,
+	0x56, 0xeb, 0x1b, 0x30, 0xe8, 0x55, 0x11, 0x0d, 0x26, 0x0c, 0xe3, 0xcb, 0xcb, 0x47, 0xca, 0xf7,
+	0x36, 0xe7, 0xd4, 0x03, 0x01, 0xea, 0x2b, 0x89, 0x09, 0x65, 0xff, 0xa1, 0x03, 0x8b, 0x81, 0xb6,
+	0xe9, 0x64, 0xf1, 0x37, 0x23, 0x90, 0x8f, 0xc6, 0xb7, 0xd1, 0x89, 0x8a, 0x3a, 0x6e, 0x25, 0x5b,
+	0xc7, 0xdd, 0xe6, 0x71, 0x5f, 0xca, 0xe3, 0x75, 0xc8, 0xf3, 0x96, 0x5c, 0x50, 0x1b, 0xf2, 0x2a,
+	0x3a, 0xf4, 0xa5, 0x33, 0xe1, 0xe0, 0xbd, 0x5b, 0x9c, 0x43, 0x82, 0xd5, 0x37, 0x62, 0xa7, 0x72,
+	0xe0, 0x18, 0xa7, 0x32, 0xf3, 0x79, 0x1c, 0xfc, 0xea, 0xcf, 0xe3, 0xd0, 0x57, 0x74, 0x1e, 0x4f,
+	0x3e, 0xce, 0xf3, 0x78, 0x11, 0xf2, 0x52, 0x9b, 0x1c, 0x9b, 0x1a, 0x24, 0x18, 0x35, 0x0f, 0xeb,
+	0x13, 0x01, 0x42, 0xce, 0x49, 0x37, 0x2d, 0xf5, 0x2e, 0x8c, 0x61, 0xc7, 0xf2, 0x5c, 0xc2, 0x07,
+	0x23, 0xce, 0xae, 0x2b, 0xae, 0xb5, 0x91, 0xe5, 0xcb, 0x99, 0x2c, 0xdb, 0x90, 0x9c, 0x9b, 0xce,
+	0xae, 0xab, 0x8f, 0xe2, 0xd8, 0x4a, 0xb5, 0x60, 0x22, 0x39, 0xeb, 0xa6, 0x1a, 0x1c, 0x21, 0xd6,
+	0x61, 0xba, 0x13, 0xc3, 0x6e, 0xaa, 0x8f, 0xb3, 0xc4, 0x5a, 0xdd, 0x85, 0xa9, 0x64, 0x6a, 0x91,
+	0x55, 0x27, 0x8e, 0xb8, 0x3b, 0x47, 0x96, 0xaf, 0x1e, 0x39, 0xab, 0x2b, 0x9c, 0x5b, 0xcf, 0x7b,
+	0xed, 0x20, 0xf5, 0x1a, 0x14, 0x7c, 0xcc, 0xb8, 0x9c, 0x4e, 0xea, 0x46, 0xc5, 0x55, 0x3d, 0x13,
+	0x50, 0xa4, 0xe4, 0xa9, 0x37, 0x41, 0xad, 0x91, 0x5d, 0xcc, 0x4d, 0x37, 0xf0, 0x7d, 0x86, 0x1d,
+	0x31, 0x46, 0x1f, 0x3b, 0x6c, 0xf4, 0x3f, 0x20, 0xc6, 0xfe, 0xf9, 0x90, 0x75, 0x23, 0xe4, 0x54,
+	0x11, 0x8c, 0x37, 0x3c, 0xdb, 0x47, 0x16, 0x36, 0x1c, 0x97, 0x11, 0x13, 0x8b, 0x09, 0xf6, 0xc8,
+	0x72, 0xe5, 0x48, 0x91, 0x7d, 0x33, 0x10, 0x71, 0x53, 0x48, 0xd0, 0xc7, 0x1a, 0xf1, 0xa5, 0xfa,
+	0x4d, 0x18, 0x0f, 0x36, 0x0a, 0x35, 0xe4, 0xc4, 0x63, 0x42, 0xa8, 0xc8, 0x56, 0x4b, 0x83, 0xcd,
+	0x48, 0xe5, 0xfc, 0x61, 0xcc, 0x8f, 0x2f, 0xd5, 0xb7, 0xe1, 0x94, 0xd8, 0xb7, 0xd8, 0x32, 0x88,
+	0x89, 0x8c, 0x3a, 0xb5, 0x0d, 0xf1, 0x7f, 0x4c, 0x6d, 0x52, 0x68, 0x78, 0x21, 0xfb, 0x91, 0xc0,
+	0xd6, 0xa6, 0x89, 0xb6, 0xa8, 0x7d, 0x87, 0xb3, 0xeb, 0x2a, 0x4a, 0xc1, 0x0e, 0x9c, 0xad, 0x26,
+	0x6b, 0x72, 0xf1, 0xbd, 0x21, 0x98, 0x4d, 0x41, 0xa3, 0x3b, 0xe7, 0x29, 0x1e, 0x72, 0x8e, 0xb1,
+	0x8c, 0x5d, 0x82, 0x6b, 0x16, 0x95, 0x63, 0xc0, 0x31, 0x09, 0xbd, 0x2e, 0x80, 0xea, 0x79, 0x18,
+	0x4b, 0xd6, 0xe0, 0xa0, 0x54, 0x8f, 0xba, 0xf1, 0x32, 0x1b, 0x5d, 0x4f, 0xfd, 0xc7, 0xbd, 0x9e,
+	0xde, 0x7a, 0x44, 0x05, 0x3b, 0xf5, 0x8c, 0x7a, 0xf7, 0x4b, 0x2b, 0xdb, 0x52, 0x75, 0xf7, 0xe2,
+	0xfd, 0xbd, 0xc7, 0x5a, 0xbc, 0xa5, 0xfa, 0x6e, 0x25, 0xfc, 0xed, 0x74, 0x11, 0x3b, 0x79, 0xec,
+	0x22, 0x26, 0x75, 0xb6, 0x97, 0xb2, 0xb6, 0x4b, 0x3e, 0x97, 0xba, 0xe4, 0x93, 0x8f, 0x91, 0xe1,
+	0x23, 0x3f, 0x46, 0x78, 0xa3, 0xd1, 0xa9, 0x7a, 0x81, 0xd0, 0x94, 0x2e, 0x7a, 0xcb, 0xff, 0x50,
+	0xa1, 0x7f, 0x8b, 0xda, 0xea, 0xcf, 0x15, 0xc8, 0xa7, 0xbf, 0xce, 0xf9, 0x5a, 0xa6, 0x10, 0x74,
+	0xfa, 0x00, 0xa5, 0xb0, 0xd2, 0x33, 0x6b, 0x74, 0x20, 0x7f, 0xa7, 0x40, 0xe1, 0x80, 0x0f, 0x57,
+	0x56, 0xb3, 0x6a, 0xe8, 0x2e, 0xa3, 0xf0, 0xda, 0xf1, 0x65, 0x1c, 0x60, 0x6e, 0xe2, 0x73, 0x91,
+	0x1e, 0xcd, 0x8d, 0xcb, 0x28, 0xbc, 0x76, 0x7c, 0x19, 0x91, 0xb9, 0xef, 0x2a, 0x30, 0xde, 0xde,
+	0x05, 0x66, 0x15, 0x9f, 0xe4, 0x2b, 0xbc, 0xdc, 0x1b, 0x5f, 0xc2, 0x94, 0xb6, 0xe7, 0x73, 0x66,
+	0x53, 0x92, 0x7c, 0x85, 0x97, 0x7b, 0xe3, 0x4b, 0x98, 0xd2, 0xf6, 0xdf, 0xc0, 0xcc, 0xa6, 0x24,
+	0xf9, 0x0a, 0x2f, 0xf7, 0xc6, 0x17, 0x99, 0xf2, 0x8e, 0x02, 0xa3, 0x89, 0xcf, 0x5a, 0xfe, 0xef,
+	0x68, 0xbe, 0x05, 0x5c, 0x85, 0x17, 0x7b, 0xe1, 0x8a, 0x8c, 0xa8, 0xc3, 0x60, 0xf0, 0xcf, 0x84,
+	0xa5, 0xac, 0x62, 0x04, 0x79, 0xe1, 0xca, 0x91, 0xc8, 0x23, 0x75, 0x1e, 0x0c, 0xc9, 0x39, 0x71,
+	0xe9, 0x08, 0x02, 0x6e, 0x35, 0x58, 0xe1, 0xea, 0xd1, 0xe8, 0x23, 0x8d, 0x3f, 0x56, 0x60, 0xa2,
+	0x7d, 0x46, 0xfd, 0x42, 0x56, 0x59, 0x6d, 0x8c, 0x85, 0x57, 0x7a, 0x64, 0x8c, 0xac, 0xf9, 0xad,
+	0x02, 0xb3, 0xdd, 0x67, 0xc7, 0x99, 0x6b, 0x6a, 0x57, 0x11, 0x85, 0xcd, 0x63, 0x8b, 0x88, 0x6c,
+	0xfd, 0xb5, 0x02, 0x33, 0xdd, 0xa6, 0xad, 0xaf, 0xf4, 0xa0, 0x26, 0x2e, 0xa0, 0xf0, 0xea, 0x31,
+	0x05, 0x44, 0x56, 0xfe, 0x42, 0x01, 0xb5, 0xc3, 0xbf, 0x7c, 0x2b, 0x99, 0x4b, 0x56, 0x8a, 0xb7,
+	0xb0, 0xda, 0x3b, 0x6f, 0x64, 0xd6, 0x2f, 0x15, 0x98, 0xea, 0xf4, 0x5f, 0xd9, 0x6b, 0x3d, 0xf8,
+	0x1d, 0x32, 0x17, 0xd6, 0x8e, 0xc1, 0x1c, 0x59, 0xf6, 0x91, 0x02, 0xe7, 0xb3, 0xfc, 0x27, 0xf5,
+	0xf5, 0x1e, 0x94, 0x75, 0x13, 0x56, 0xb8, 0xfd, 0x08, 0x85, 0x45, 0x9e, 0xfc, 0x54, 0x81, 0xc9,
+	0xd4, 0xa7, 0x12, 0xff, 0x9f, 0x39, 0x79, 0x6d, 0x9c, 0x85, 0xaf, 0xf7, 0xca, 0x19, 0x1a, 0x54,
+	0x18, 0xfc, 0xfe, 0xc3, 0x0f, 0x2e, 0x2a, 0xab, 0x6f, 0x7d, 0xfc, 0x60, 0x4e, 0xf9, 0xe4, 0xc1,
+	0x9c, 0xf2, 0xf9, 0x83, 0x39, 0xe5, 0xbd, 0x2f, 0xe6, 0x4e, 0x7c, 0xf2, 0xc5, 0xdc, 0x89, 0x3f,
+	0x7f, 0x31, 0x77, 0xe2, 0x5b, 0x2f, 0xd9, 0x84, 0x55, 0x1b, 0x3b, 0x25, 0xd3, 0xad, 0xcb, 0xef,
+	0xbf, 0xcb, 0x2d, 0x9d, 0x4b, 0xd1, 0xe7, 0xdb, 0xcd, 0xab, 0xe5, 0xfb, 0xc9, 0x6f, 0xb8, 0x45,
+	0x77, 0xb5, 0x33, 0x24, 0x5e, 0x88, 0xcf, 0xff, 0x77, 0x00, 0x57, 0x9e, 0x91, 0xf3, 0x3f, 0x2f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1783,8 +2587,13 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	OptIn(ctx context.Context, in *MsgOptIn, opts ...grpc.CallOption) (*MsgOptInResponse, error)
 	OptOut(ctx context.Context, in *MsgOptOut, opts ...grpc.CallOption) (*MsgOptOutResponse, error)
+	EmergencyOptOut(ctx context.Context, in *MsgEmergencyOptOut, opts ...grpc.CallOption) (*MsgEmergencyOptOutResponse, error)
 	SetConsumerCommissionRate(ctx context.Context, in *MsgSetConsumerCommissionRate, opts ...grpc.CallOption) (*MsgSetConsumerCommissionRateResponse, error)
+	SetConsumerAvailability(ctx context.Context, in *MsgSetConsumerAvailability, opts ...grpc.CallOption) (*MsgSetConsumerAvailabilityResponse, error)
 	ChangeRewardDenoms(ctx context.Context, in *MsgChangeRewardDenoms, opts ...grpc.CallOption) (*MsgChangeRewardDenomsResponse, error)
+	SetConsumerVerified(ctx context.Context, in *MsgSetConsumerVerified, opts ...grpc.CallOption) (*MsgSetConsumerVerifiedResponse, error)
+	SetConsumerEvidenceSubmissionPaused(ctx context.Context, in *MsgSetConsumerEvidenceSubmissionPaused, opts ...grpc.CallOption) (*MsgSetConsumerEvidenceSubmissionPausedResponse, error)
+	ConsumerHardFork(ctx context.Context, in *MsgConsumerHardFork, opts ...grpc.CallOption) (*MsgConsumerHardForkResponse, error)
 }
 
 type msgClient struct {
@@ -1876,6 +2685,15 @@ func (c *msgClient) OptOut(ctx context.Context, in *MsgOptOut, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) EmergencyOptOut(ctx context.Context, in *MsgEmergencyOptOut, opts ...grpc.CallOption) (*MsgEmergencyOptOutResponse, error) {
+	out := new(MsgEmergencyOptOutResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/EmergencyOptOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SetConsumerCommissionRate(ctx context.Context, in *MsgSetConsumerCommissionRate, opts ...grpc.CallOption) (*MsgSetConsumerCommissionRateResponse, error) {
 	out := new(MsgSetConsumerCommissionRateResponse)
 	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/SetConsumerCommissionRate", in, out, opts...)
@@ -1885,6 +2703,15 @@ func (c *msgClient) SetConsumerCommissionRate(ctx context.Context, in *MsgSetCon
 	return out, nil
 }
 
+func (c *msgClient) SetConsumerAvailability(ctx context.Context, in *MsgSetConsumerAvailability, opts ...grpc.CallOption) (*MsgSetConsumerAvailabilityResponse, error) {
+	out := new(MsgSetConsumerAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/SetConsumerAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) ChangeRewardDenoms(ctx context.Context, in *MsgChangeRewardDenoms, opts ...grpc.CallOption) (*MsgChangeRewardDenomsResponse, error) {
 	out := new(MsgChangeRewardDenomsResponse)
 	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/ChangeRewardDenoms", in, out, opts...)
@@ -1894,19 +2721,51 @@ func (c *msgClient) ChangeRewardDenoms(ctx context.Context, in *MsgChangeRewardD
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AssignConsumerKey(context.Context, *MsgAssignConsumerKey) (*MsgAssignConsumerKeyResponse, error)
-	SubmitConsumerMisbehaviour(context.Context, *MsgSubmitConsumerMisbehaviour) (*MsgSubmitConsumerMisbehaviourResponse, error)
-	SubmitConsumerDoubleVoting(context.Context, *MsgSubmitConsumerDoubleVoting) (*MsgSubmitConsumerDoubleVotingResponse, error)
-	CreateConsumer(context.Context, *MsgCreateConsumer) (*MsgCreateConsumerResponse, error)
-	UpdateConsumer(context.Context, *MsgUpdateConsumer) (*MsgUpdateConsumerResponse, error)
-	RemoveConsumer(context.Context, *MsgRemoveConsumer) (*MsgRemoveConsumerResponse, error)
+func (c *msgClient) SetConsumerVerified(ctx context.Context, in *MsgSetConsumerVerified, opts ...grpc.CallOption) (*MsgSetConsumerVerifiedResponse, error) {
+	out := new(MsgSetConsumerVerifiedResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/SetConsumerVerified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetConsumerEvidenceSubmissionPaused(ctx context.Context, in *MsgSetConsumerEvidenceSubmissionPaused, opts ...grpc.CallOption) (*MsgSetConsumerEvidenceSubmissionPausedResponse, error) {
+	out := new(MsgSetConsumerEvidenceSubmissionPausedResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/SetConsumerEvidenceSubmissionPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConsumerHardFork(ctx context.Context, in *MsgConsumerHardFork, opts ...grpc.CallOption) (*MsgConsumerHardForkResponse, error) {
+	out := new(MsgConsumerHardForkResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Msg/ConsumerHardFork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AssignConsumerKey(context.Context, *MsgAssignConsumerKey) (*MsgAssignConsumerKeyResponse, error)
+	SubmitConsumerMisbehaviour(context.Context, *MsgSubmitConsumerMisbehaviour) (*MsgSubmitConsumerMisbehaviourResponse, error)
+	SubmitConsumerDoubleVoting(context.Context, *MsgSubmitConsumerDoubleVoting) (*MsgSubmitConsumerDoubleVotingResponse, error)
+	CreateConsumer(context.Context, *MsgCreateConsumer) (*MsgCreateConsumerResponse, error)
+	UpdateConsumer(context.Context, *MsgUpdateConsumer) (*MsgUpdateConsumerResponse, error)
+	RemoveConsumer(context.Context, *MsgRemoveConsumer) (*MsgRemoveConsumerResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	OptIn(context.Context, *MsgOptIn) (*MsgOptInResponse, error)
 	OptOut(context.Context, *MsgOptOut) (*MsgOptOutResponse, error)
+	EmergencyOptOut(context.Context, *MsgEmergencyOptOut) (*MsgEmergencyOptOutResponse, error)
 	SetConsumerCommissionRate(context.Context, *MsgSetConsumerCommissionRate) (*MsgSetConsumerCommissionRateResponse, error)
+	SetConsumerAvailability(context.Context, *MsgSetConsumerAvailability) (*MsgSetConsumerAvailabilityResponse, error)
 	ChangeRewardDenoms(context.Context, *MsgChangeRewardDenoms) (*MsgChangeRewardDenomsResponse, error)
+	SetConsumerVerified(context.Context, *MsgSetConsumerVerified) (*MsgSetConsumerVerifiedResponse, error)
+	SetConsumerEvidenceSubmissionPaused(context.Context, *MsgSetConsumerEvidenceSubmissionPaused) (*MsgSetConsumerEvidenceSubmissionPausedResponse, error)
+	ConsumerHardFork(context.Context, *MsgConsumerHardFork) (*MsgConsumerHardForkResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1940,12 +2799,27 @@ func (*UnimplementedMsgServer) OptIn(ctx context.Context, req *MsgOptIn) (*MsgOp
 func (*UnimplementedMsgServer) OptOut(ctx context.Context, req *MsgOptOut) (*MsgOptOutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OptOut not implemented")
 }
+func (*UnimplementedMsgServer) EmergencyOptOut(ctx context.Context, req *MsgEmergencyOptOut) (*MsgEmergencyOptOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyOptOut not implemented")
+}
 func (*UnimplementedMsgServer) SetConsumerCommissionRate(ctx context.Context, req *MsgSetConsumerCommissionRate) (*MsgSetConsumerCommissionRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetConsumerCommissionRate not implemented")
 }
+func (*UnimplementedMsgServer) SetConsumerAvailability(ctx context.Context, req *MsgSetConsumerAvailability) (*MsgSetConsumerAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsumerAvailability not implemented")
+}
 func (*UnimplementedMsgServer) ChangeRewardDenoms(ctx context.Context, req *MsgChangeRewardDenoms) (*MsgChangeRewardDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRewardDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetConsumerVerified(ctx context.Context, req *MsgSetConsumerVerified) (*MsgSetConsumerVerifiedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsumerVerified not implemented")
+}
+func (*UnimplementedMsgServer) SetConsumerEvidenceSubmissionPaused(ctx context.Context, req *MsgSetConsumerEvidenceSubmissionPaused) (*MsgSetConsumerEvidenceSubmissionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsumerEvidenceSubmissionPaused not implemented")
+}
+func (*UnimplementedMsgServer) ConsumerHardFork(ctx context.Context, req *MsgConsumerHardFork) (*MsgConsumerHardForkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumerHardFork not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2113,6 +2987,24 @@ func _Msg_OptOut_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_EmergencyOptOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyOptOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyOptOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Msg/EmergencyOptOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyOptOut(ctx, req.(*MsgEmergencyOptOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SetConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSetConsumerCommissionRate)
 	if err := dec(in); err != nil {
@@ -2131,6 +3023,24 @@ func _Msg_SetConsumerCommissionRate_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetConsumerAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConsumerAvailability)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsumerAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Msg/SetConsumerAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsumerAvailability(ctx, req.(*MsgSetConsumerAvailability))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ChangeRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgChangeRewardDenoms)
 	if err := dec(in); err != nil {
@@ -2149,6 +3059,60 @@ func _Msg_ChangeRewardDenoms_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetConsumerVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConsumerVerified)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsumerVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Msg/SetConsumerVerified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsumerVerified(ctx, req.(*MsgSetConsumerVerified))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetConsumerEvidenceSubmissionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConsumerEvidenceSubmissionPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsumerEvidenceSubmissionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Msg/SetConsumerEvidenceSubmissionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsumerEvidenceSubmissionPaused(ctx, req.(*MsgSetConsumerEvidenceSubmissionPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConsumerHardFork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConsumerHardFork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConsumerHardFork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Msg/ConsumerHardFork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConsumerHardFork(ctx, req.(*MsgConsumerHardFork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "interchain_security.ccv.provider.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2189,14 +3153,34 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OptOut",
 			Handler:    _Msg_OptOut_Handler,
 		},
+		{
+			MethodName: "EmergencyOptOut",
+			Handler:    _Msg_EmergencyOptOut_Handler,
+		},
 		{
 			MethodName: "SetConsumerCommissionRate",
 			Handler:    _Msg_SetConsumerCommissionRate_Handler,
 		},
+		{
+			MethodName: "SetConsumerAvailability",
+			Handler:    _Msg_SetConsumerAvailability_Handler,
+		},
 		{
 			MethodName: "ChangeRewardDenoms",
 			Handler:    _Msg_ChangeRewardDenoms_Handler,
 		},
+		{
+			MethodName: "SetConsumerVerified",
+			Handler:    _Msg_SetConsumerVerified_Handler,
+		},
+		{
+			MethodName: "SetConsumerEvidenceSubmissionPaused",
+			Handler:    _Msg_SetConsumerEvidenceSubmissionPaused_Handler,
+		},
+		{
+			MethodName: "ConsumerHardFork",
+			Handler:    _Msg_ConsumerHardFork_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "interchain_security/ccv/provider/v1/tx.proto",
@@ -2222,6 +3206,11 @@ func (m *MsgAssignConsumerKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -2782,6 +3771,90 @@ func (m *MsgRemoveConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgConsumerHardFork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsumerHardFork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsumerHardFork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewChainId) > 0 {
+		i -= len(m.NewChainId)
+		copy(dAtA[i:], m.NewChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConsumerHardForkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConsumerHardForkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConsumerHardForkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgChangeRewardDenoms) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2853,7 +3926,7 @@ func (m *MsgChangeRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOptIn) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerVerified) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2863,55 +3936,44 @@ func (m *MsgOptIn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOptIn) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerVerified) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerVerified) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConsumerKey) > 0 {
-		i -= len(m.ConsumerKey)
-		copy(dAtA[i:], m.ConsumerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerKey)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
+	if m.Verified {
 		i--
-		dAtA[i] = 0x12
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOptInResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerVerifiedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2921,12 +3983,12 @@ func (m *MsgOptInResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOptInResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerVerifiedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerVerifiedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2934,7 +3996,7 @@ func (m *MsgOptInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOptOut) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerEvidenceSubmissionPaused) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2944,48 +4006,44 @@ func (m *MsgOptOut) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOptOut) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerEvidenceSubmissionPaused) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerEvidenceSubmissionPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgOptOutResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2995,12 +4053,12 @@ func (m *MsgOptOutResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3008,7 +4066,7 @@ func (m *MsgOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetConsumerCommissionRate) Marshal() (dAtA []byte, err error) {
+func (m *MsgOptIn) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3018,16 +4076,23 @@ func (m *MsgSetConsumerCommissionRate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetConsumerCommissionRate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOptIn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetConsumerCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AcknowledgedTermsHash) > 0 {
+		i -= len(m.AcknowledgedTermsHash)
+		copy(dAtA[i:], m.AcknowledgedTermsHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AcknowledgedTermsHash)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -3042,34 +4107,31 @@ func (m *MsgSetConsumerCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x22
 	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.ConsumerKey) > 0 {
+		i -= len(m.ConsumerKey)
+		copy(dAtA[i:], m.ConsumerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerKey)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.ProviderAddr) > 0 {
 		i -= len(m.ProviderAddr)
 		copy(dAtA[i:], m.ProviderAddr)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgOptInResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3079,20 +4141,27 @@ func (m *MsgSetConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOptInResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOptInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Warning) > 0 {
+		i -= len(m.Warning)
+		copy(dAtA[i:], m.Warning)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Warning)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgConsumerModification) Marshal() (dAtA []byte, err error) {
+func (m *MsgOptOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3102,96 +4171,48 @@ func (m *MsgConsumerModification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgConsumerModification) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOptOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConsumerModification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.MinStake != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorSetCap))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x22
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorsPowerCap))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x1a
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Top_N))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
 	if len(m.ChainId) > 0 {
 		i -= len(m.ChainId)
 		copy(dAtA[i:], m.ChainId)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgConsumerModificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgOptOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3201,12 +4222,12 @@ func (m *MsgConsumerModificationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgConsumerModificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConsumerModificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3214,7 +4235,7 @@ func (m *MsgConsumerModificationResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateConsumer) Marshal() (dAtA []byte, err error) {
+func (m *MsgEmergencyOptOut) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3224,80 +4245,41 @@ func (m *MsgCreateConsumer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateConsumer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEmergencyOptOut) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEmergencyOptOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AllowlistedRewardDenoms != nil {
-		{
-			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PowerShapingParameters != nil {
-		{
-			size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.InitializationParameters != nil {
-		{
-			size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateConsumerResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgEmergencyOptOutResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3307,27 +4289,20 @@ func (m *MsgCreateConsumerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEmergencyOptOutResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEmergencyOptOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateConsumer) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerCommissionRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3337,89 +4312,58 @@ func (m *MsgUpdateConsumer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateConsumer) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerCommissionRate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.AllowlistedRewardDenoms != nil {
-		{
-			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
-	if m.PowerShapingParameters != nil {
-		{
-			size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.InitializationParameters != nil {
-		{
-			size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.NewOwnerAddress) > 0 {
-		i -= len(m.NewOwnerAddress)
-		copy(dAtA[i:], m.NewOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
-		i--
-		dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateConsumerResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3429,12 +4373,12 @@ func (m *MsgUpdateConsumerResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3442,564 +4386,2932 @@ func (m *MsgUpdateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgAssignConsumerKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetConsumerAvailability) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAssignConsumerKeyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetConsumerAvailability) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitConsumerMisbehaviour) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetConsumerAvailability) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Misbehaviour != nil {
-		l = m.Misbehaviour.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitConsumerMisbehaviourResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetConsumerAvailabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitConsumerDoubleVoting) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DuplicateVoteEvidence != nil {
-		l = m.DuplicateVoteEvidence.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.InfractionBlockHeader != nil {
-		l = m.InfractionBlockHeader.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetConsumerAvailabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitConsumerDoubleVotingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetConsumerAvailabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConsumerModification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConsumerModification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConsumerAddition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConsumerModification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovTx(uint64(m.BlocksPerDistributionTransmission))
-	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovTx(uint64(m.HistoricalEntries))
+	if m.MinStake != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovTx(uint64(m.Top_N))
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorsPowerCap))
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	if m.ValidatorSetCap != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorSetCap))
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 2 + l + sovTx(uint64(l))
-		}
+	if m.Top_N != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MinStake != 0 {
-		n += 2 + sovTx(uint64(m.MinStake))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowInactiveVals {
-		n += 3
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConsumerRemoval) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConsumerModificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveConsumer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgConsumerModificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveConsumerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConsumerModificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeRewardDenoms) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DenomsToAdd) > 0 {
-		for _, s := range m.DenomsToAdd {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		{
+			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.DenomsToRemove) > 0 {
-		for _, s := range m.DenomsToRemove {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.PowerShapingParameters != nil {
+		{
+			size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.InitializationParameters != nil {
+		{
+			size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgChangeRewardDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgOptIn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgOptInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgCreateConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgOptOut) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SpawnTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpawnTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintTx(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Phase != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *MsgOptOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetConsumerCommissionRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetConsumerCommissionRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConsumerModification) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.AllowedIcaMsgTypes != nil {
+		{
+			size, err := m.AllowedIcaMsgTypes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RewardsPaused != nil {
+		{
+			size, err := m.RewardsPaused.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UpgradeNotice != nil {
+		{
+			size, err := m.UpgradeNotice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovTx(uint64(m.Top_N))
+	if m.LifetimeExtension != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.LifetimeExtension, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.LifetimeExtension):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintTx(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorsPowerCap))
+	if m.RetainPowerShapingAdmin {
+		i--
+		if m.RetainPowerShapingAdmin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovTx(uint64(m.ValidatorSetCap))
+	if m.PowerShapingAdmin != nil {
+		{
+			size, err := m.PowerShapingAdmin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutPeriods != nil {
+		{
+			size, err := m.TimeoutPeriods.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if m.EndpointInfo != nil {
+		{
+			size, err := m.EndpointInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RewardChannelId) > 0 {
+		i -= len(m.RewardChannelId)
+		copy(dAtA[i:], m.RewardChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardChannelId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.MinStake != 0 {
-		n += 1 + sovTx(uint64(m.MinStake))
+	if m.AllowlistedRewardDenoms != nil {
+		{
+			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.AllowInactiveVals {
-		n += 2
+	if m.PowerShapingParameters != nil {
+		{
+			size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *MsgConsumerModificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.InitializationParameters != nil {
+		{
+			size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwnerAddress) > 0 {
+		i -= len(m.NewOwnerAddress)
+		copy(dAtA[i:], m.NewOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	return n
+	if len(m.PowerShapingAdmin) > 0 {
+		i -= len(m.PowerShapingAdmin)
+		copy(dAtA[i:], m.PowerShapingAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PowerShapingAdmin)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.SpawnTime != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpawnTime):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintTx(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.TimeoutPeriods.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.PowerShapingParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.InitializationParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Phase != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UpdatedFields) > 0 {
+		for iNdEx := len(m.UpdatedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpdatedFields[iNdEx])
+			copy(dAtA[i:], m.UpdatedFields[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UpdatedFields[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateConsumer) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAssignConsumerKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Submitter)
+	l = len(m.ChainId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChainId)
+	l = len(m.ProviderAddr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.InitializationParameters != nil {
-		l = m.InitializationParameters.Size()
+	l = len(m.ConsumerKey)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.PowerShapingParameters != nil {
-		l = m.PowerShapingParameters.Size()
+	l = len(m.Signer)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.AllowlistedRewardDenoms != nil {
-		l = m.AllowlistedRewardDenoms.Size()
+	l = len(m.ConsumerId)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
 	return n
 }
 
-func (m *MsgCreateConsumerResponse) Size() (n int) {
+func (m *MsgAssignConsumerKeyResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgUpdateConsumer) Size() (n int) {
+func (m *MsgSubmitConsumerMisbehaviour) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Owner)
+	l = len(m.Submitter)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Misbehaviour != nil {
+		l = m.Misbehaviour.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.ConsumerId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.NewOwnerAddress)
+	return n
+}
+
+func (m *MsgSubmitConsumerMisbehaviourResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitConsumerDoubleVoting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
+	if m.DuplicateVoteEvidence != nil {
+		l = m.DuplicateVoteEvidence.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.InitializationParameters != nil {
-		l = m.InitializationParameters.Size()
+	if m.InfractionBlockHeader != nil {
+		l = m.InfractionBlockHeader.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.PowerShapingParameters != nil {
-		l = m.PowerShapingParameters.Size()
+	l = len(m.ConsumerId)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.AllowlistedRewardDenoms != nil {
-		l = m.AllowlistedRewardDenoms.Size()
+	return n
+}
+
+func (m *MsgSubmitConsumerDoubleVotingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateConsumerResponse) Size() (n int) {
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConsumerAddition) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovTx(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovTx(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovTx(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 2 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.MinStake != 0 {
+		n += 2 + sovTx(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgConsumerRemoval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConsumerHardFork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConsumerHardForkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeRewardDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomsToAdd) > 0 {
+		for _, s := range m.DenomsToAdd {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DenomsToRemove) > 0 {
+		for _, s := range m.DenomsToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeRewardDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConsumerVerified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConsumerVerifiedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AcknowledgedTermsHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Warning)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEmergencyOptOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEmergencyOptOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConsumerCommissionRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConsumerCommissionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConsumerAvailability) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetConsumerAvailabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConsumerModification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovTx(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovTx(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovTx(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgConsumerModificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.InitializationParameters != nil {
+		l = m.InitializationParameters.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PowerShapingParameters != nil {
+		l = m.PowerShapingParameters.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		l = m.AllowlistedRewardDenoms.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgCreateConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTx(uint64(m.Phase))
+	}
+	if m.SpawnTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpawnTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InitializationParameters != nil {
+		l = m.InitializationParameters.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PowerShapingParameters != nil {
+		l = m.PowerShapingParameters.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		l = m.AllowlistedRewardDenoms.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RewardChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndpointInfo != nil {
+		l = m.EndpointInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutPeriods != nil {
+		l = m.TimeoutPeriods.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PowerShapingAdmin != nil {
+		l = m.PowerShapingAdmin.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RetainPowerShapingAdmin {
+		n += 2
+	}
+	if m.LifetimeExtension != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.LifetimeExtension)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpgradeNotice != nil {
+		l = m.UpgradeNotice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardsPaused != nil {
+		l = m.RewardsPaused.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AllowedIcaMsgTypes != nil {
+		l = m.AllowedIcaMsgTypes.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UpdatedFields) > 0 {
+		for _, s := range m.UpdatedFields {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovTx(uint64(m.Phase))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.InitializationParameters.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PowerShapingParameters.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TimeoutPeriods.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SpawnTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SpawnTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PowerShapingAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAssignConsumerKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAssignConsumerKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAssignConsumerKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAssignConsumerKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAssignConsumerKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviour: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &_07_tendermint.Misbehaviour{}
+			}
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConsumerMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviourResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVoting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVoting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateVoteEvidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DuplicateVoteEvidence == nil {
+				m.DuplicateVoteEvidence = &types.DuplicateVoteEvidence{}
+			}
+			if err := m.DuplicateVoteEvidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionBlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfractionBlockHeader == nil {
+				m.InfractionBlockHeader = &_07_tendermint.Header{}
+			}
+			if err := m.InfractionBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitConsumerDoubleVotingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVotingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVotingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConsumerAddition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConsumerAddition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			}
+			m.BlocksPerDistributionTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,10 +7334,10 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignConsumerKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumerRemoval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignConsumerKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumerRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4062,9 +7374,9 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4074,27 +7386,28 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4122,11 +7435,61 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4154,11 +7517,11 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4186,7 +7549,7 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4209,7 +7572,7 @@ func (m *MsgAssignConsumerKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssignConsumerKeyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveConsumerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4232,10 +7595,10 @@ func (m *MsgAssignConsumerKeyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignConsumerKeyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveConsumerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignConsumerKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4259,7 +7622,7 @@ func (m *MsgAssignConsumerKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumerHardFork) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4282,15 +7645,15 @@ func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviour: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumerHardFork: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumerHardFork: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4318,13 +7681,13 @@ func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4334,31 +7697,27 @@ func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Misbehaviour == nil {
-				m.Misbehaviour = &_07_tendermint.Misbehaviour{}
-			}
-			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4386,7 +7745,40 @@ func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.NewChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4409,7 +7801,7 @@ func (m *MsgSubmitConsumerMisbehaviour) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitConsumerMisbehaviourResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumerHardForkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4432,12 +7824,44 @@ func (m *MsgSubmitConsumerMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviourResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumerHardForkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitConsumerMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumerHardForkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4459,7 +7883,7 @@ func (m *MsgSubmitConsumerMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4482,15 +7906,15 @@ func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVoting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeRewardDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVoting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4518,49 +7942,13 @@ func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateVoteEvidence", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DuplicateVoteEvidence == nil {
-				m.DuplicateVoteEvidence = &types.DuplicateVoteEvidence{}
-			}
-			if err := m.DuplicateVoteEvidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfractionBlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4570,31 +7958,27 @@ func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfractionBlockHeader == nil {
-				m.InfractionBlockHeader = &_07_tendermint.Header{}
-			}
-			if err := m.InfractionBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4622,7 +8006,7 @@ func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4645,7 +8029,7 @@ func (m *MsgSubmitConsumerDoubleVoting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitConsumerDoubleVotingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgChangeRewardDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4668,10 +8052,10 @@ func (m *MsgSubmitConsumerDoubleVotingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVotingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgChangeRewardDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitConsumerDoubleVotingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgChangeRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4695,7 +8079,7 @@ func (m *MsgSubmitConsumerDoubleVotingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerVerified) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4718,15 +8102,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerVerified: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerVerified: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4754,13 +8138,33 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4770,24 +8174,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4810,7 +8213,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerVerifiedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4833,10 +8236,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerVerifiedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerVerifiedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4860,7 +8263,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerEvidenceSubmissionPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4883,15 +8286,15 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsumerAddition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerEvidenceSubmissionPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsumerAddition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerEvidenceSubmissionPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4919,13 +8322,13 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4935,64 +8338,17 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Paused = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5002,130 +8358,129 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetConsumerEvidenceSubmissionPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetConsumerEvidenceSubmissionPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetConsumerEvidenceSubmissionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5135,28 +8490,27 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5184,49 +8538,11 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
-			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
-			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5254,68 +8570,11 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
-			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
-			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5343,11 +8602,11 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5375,11 +8634,11 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedTermsHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5407,32 +8666,63 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.AcknowledgedTermsHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOptInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warning", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5442,12 +8732,24 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warning = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5469,7 +8771,7 @@ func (m *MsgConsumerAddition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
+func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5492,10 +8794,10 @@ func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsumerRemoval: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOptOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsumerRemoval: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5532,9 +8834,9 @@ func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5544,28 +8846,27 @@ func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5593,7 +8894,39 @@ func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5616,7 +8949,7 @@ func (m *MsgConsumerRemoval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
+func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5639,10 +8972,60 @@ func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveConsumer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOptOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyOptOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyOptOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5679,7 +9062,7 @@ func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5707,7 +9090,39 @@ func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5730,7 +9145,7 @@ func (m *MsgRemoveConsumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveConsumerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEmergencyOptOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5753,10 +9168,10 @@ func (m *MsgRemoveConsumerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveConsumerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEmergencyOptOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEmergencyOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5780,7 +9195,7 @@ func (m *MsgRemoveConsumerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5803,15 +9218,15 @@ func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRewardDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerCommissionRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerCommissionRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5839,11 +9254,11 @@ func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5871,11 +9286,11 @@ func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5903,7 +9318,73 @@ func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5926,7 +9407,7 @@ func (m *MsgChangeRewardDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5949,10 +9430,10 @@ func (m *MsgChangeRewardDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRewardDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerCommissionRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5976,7 +9457,7 @@ func (m *MsgChangeRewardDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerAvailability) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5999,15 +9480,15 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerAvailability: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerAvailability: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6035,7 +9516,7 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6070,10 +9551,10 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6083,24 +9564,12 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Available = bool(v != 0)
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -6133,38 +9602,6 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 			}
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6186,7 +9623,7 @@ func (m *MsgOptIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsumerAvailabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6209,10 +9646,10 @@ func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsumerAvailabilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsumerAvailabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6236,7 +9673,7 @@ func (m *MsgOptInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6259,15 +9696,15 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumerModification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumerModification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6295,11 +9732,11 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6327,11 +9764,11 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6359,11 +9796,68 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6391,8 +9885,111 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6414,7 +10011,7 @@ func (m *MsgOptOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConsumerModificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6437,10 +10034,10 @@ func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOptOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConsumerModificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOptOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConsumerModificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6464,7 +10061,7 @@ func (m *MsgOptOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6487,15 +10084,15 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetConsumerCommissionRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateConsumer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetConsumerCommissionRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6523,7 +10120,7 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6559,9 +10156,9 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6571,31 +10168,30 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6605,29 +10201,33 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.InitializationParameters == nil {
+				m.InitializationParameters = &ConsumerInitializationParameters{}
+			}
+			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6637,24 +10237,83 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if m.PowerShapingParameters == nil {
+				m.PowerShapingParameters = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowlistedRewardDenoms == nil {
+				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			}
+			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6676,7 +10335,7 @@ func (m *MsgSetConsumerCommissionRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateConsumerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6699,12 +10358,99 @@ func (m *MsgSetConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetConsumerCommissionRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateConsumerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpawnTime == nil {
+				m.SpawnTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6726,7 +10472,7 @@ func (m *MsgSetConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6749,15 +10495,15 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsumerModification: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConsumer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsumerModification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6785,11 +10531,11 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6817,11 +10563,11 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6849,51 +10595,13 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
-			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.ValidatorSetCap = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6903,16 +10611,33 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ConsumerMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6922,29 +10647,33 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			if m.InitializationParameters == nil {
+				m.InitializationParameters = &ConsumerInitializationParameters{}
+			}
+			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6954,29 +10683,33 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			if m.PowerShapingParameters == nil {
+				m.PowerShapingParameters = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6986,29 +10719,33 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if m.AllowlistedRewardDenoms == nil {
+				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			}
+			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChannelId", wireType)
 			}
-			m.MinStake = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7018,16 +10755,29 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointInfo", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7037,117 +10787,33 @@ func (m *MsgConsumerModification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgConsumerModificationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConsumerModificationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConsumerModificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.EndpointInfo == nil {
+				m.EndpointInfo = &EndpointInfo{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EndpointInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateConsumer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPeriods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7157,29 +10823,33 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			if m.TimeoutPeriods == nil {
+				m.TimeoutPeriods = &ConsumerTimeoutPeriods{}
+			}
+			if err := m.TimeoutPeriods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingAdmin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7189,27 +10859,51 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			if m.PowerShapingAdmin == nil {
+				m.PowerShapingAdmin = &PowerShapingAdmin{}
+			}
+			if err := m.PowerShapingAdmin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainPowerShapingAdmin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetainPowerShapingAdmin = bool(v != 0)
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeExtension", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7236,13 +10930,16 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LifetimeExtension == nil {
+				m.LifetimeExtension = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.LifetimeExtension, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeNotice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7269,16 +10966,16 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitializationParameters == nil {
-				m.InitializationParameters = &ConsumerInitializationParameters{}
+			if m.UpgradeNotice == nil {
+				m.UpgradeNotice = &ConsumerUpgradeNotice{}
 			}
-			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UpgradeNotice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPaused", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7305,16 +11002,16 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerShapingParameters == nil {
-				m.PowerShapingParameters = &PowerShapingParameters{}
+			if m.RewardsPaused == nil {
+				m.RewardsPaused = &RewardsPaused{}
 			}
-			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardsPaused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedIcaMsgTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7341,10 +11038,10 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowlistedRewardDenoms == nil {
-				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			if m.AllowedIcaMsgTypes == nil {
+				m.AllowedIcaMsgTypes = &AllowedIcaMsgTypes{}
 			}
-			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllowedIcaMsgTypes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7369,7 +11066,7 @@ func (m *MsgCreateConsumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateConsumerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConsumerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7392,15 +11089,15 @@ func (m *MsgCreateConsumerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateConsumerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConsumerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7428,61 +11125,11 @@ func (m *MsgCreateConsumerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.UpdatedFields = append(m.UpdatedFields, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConsumer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7510,13 +11157,32 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7526,29 +11192,30 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7558,27 +11225,28 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7605,16 +11273,13 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ConsumerMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitializationParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7641,18 +11306,15 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitializationParameters == nil {
-				m.InitializationParameters = &ConsumerInitializationParameters{}
-			}
-			if err := m.InitializationParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TimeoutPeriods.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7662,31 +11324,27 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerShapingParameters == nil {
-				m.PowerShapingParameters = &PowerShapingParameters{}
-			}
-			if err := m.PowerShapingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7713,63 +11371,45 @@ func (m *MsgUpdateConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowlistedRewardDenoms == nil {
-				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			if m.SpawnTime == nil {
+				m.SpawnTime = new(time.Time)
 			}
-			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingAdmin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateConsumerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConsumerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.PowerShapingAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
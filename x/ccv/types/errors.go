@@ -25,4 +25,6 @@ var (
 	ErrStoreKeyNotFound            = errorsmod.Register(ModuleName, 17, "store key not found")
 	ErrStoreUnmarshal              = errorsmod.Register(ModuleName, 18, "cannot unmarshal value from store")
 	ErrInvalidConsumerId           = errorsmod.Register(ModuleName, 19, "invalid consumer id")
+	ErrGenesisHashMismatch         = errorsmod.Register(ModuleName, 20, "consumer genesis hash mismatch")
+	ErrInvalidRewardMemo           = errorsmod.Register(ModuleName, 21, "invalid reward memo")
 )
@@ -14,6 +14,7 @@ const (
 	EventTypeSubmitConsumerDoubleVoting = "submit_consumer_double_voting"
 	EventTypeExecuteConsumerChainSlash  = "execute_consumer_chain_slash"
 	EventTypeConsumerSlashRequest       = "consumer_slash_request"
+	EventTypeProviderKeyOnConsumer      = "provider_key_on_consumer"
 
 	AttributeKeyAckSuccess            = "success"
 	AttributeKeyAck                   = "acknowledgement"
@@ -35,5 +36,6 @@ const (
 	AttributeChainID                  = "chain_id"
 	AttributeValidatorAddress         = "validator_address"
 	AttributeInfractionType           = "infraction_type"
+	AttributeInfractionTypeValue      = "infraction_type_value"
 	AttributeValSetUpdateID           = "valset_update_id"
 )
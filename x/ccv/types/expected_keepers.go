@@ -14,8 +14,10 @@ import (
 	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -80,6 +82,8 @@ type SlashingKeeper interface {
 	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
 	Tombstone(context.Context, sdk.ConsAddress) error
 	IsTombstoned(context.Context, sdk.ConsAddress) bool
+	GetParams(context.Context) (slashingtypes.Params, error) // called from consumer keeper only
+	SetParams(context.Context, slashingtypes.Params) error   // called from consumer keeper only
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
@@ -98,6 +102,7 @@ type ChannelKeeper interface {
 	WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error
 	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
 	GetChannelConnection(ctx sdk.Context, portID, channelID string) (string, ibcexported.ConnectionI, error)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
 }
 
 // PortKeeper defines the expected IBC port keeper
@@ -138,6 +143,8 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	HasDenomMetaData(ctx context.Context, denom string) bool
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations
@@ -166,3 +173,15 @@ type ScopedKeeper interface {
 	AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool
 	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
 }
+
+// UpgradeKeeper defines the expected interface needed to retrieve the upgrade
+// plan scheduled on the provider chain
+type UpgradeKeeper interface {
+	GetUpgradePlan(ctx context.Context) (plan upgradetypes.Plan, err error)
+}
+
+// TokenizedSharesKeeper defines the expected interface needed to retrieve the amount
+// of a validator's delegated tokens that were tokenized through liquid staking (e.g., LSM)
+type TokenizedSharesKeeper interface {
+	GetValidatorTokenizedShareTokens(ctx context.Context, valAddr sdk.ValAddress) (math.Int, error)
+}
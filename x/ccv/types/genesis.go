@@ -1,6 +1,10 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
 	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +24,7 @@ func NewInitialConsumerGenesisState(cs *ibctmtypes.ClientState, consState *ibctm
 			ConsensusState: consState,
 			InitialValSet:  initValSet,
 		},
+		CcvGenesisVersion: CcvGenesisVersion,
 	}
 }
 
@@ -32,6 +37,9 @@ func DefaultConsumerGenesisState() *ConsumerGenesisState {
 }
 
 func (gs ConsumerGenesisState) Validate() error {
+	if err := ValidateCcvGenesisVersion(gs.CcvGenesisVersion); err != nil {
+		return err
+	}
 	if !gs.Params.Enabled {
 		return nil
 	}
@@ -60,3 +68,28 @@ func (gs ConsumerGenesisState) Validate() error {
 	}
 	return nil
 }
+
+// Hash returns the canonical hash of the consumer genesis state, i.e.,
+// the SHA-256 hash of its deterministic protobuf encoding
+func (gs ConsumerGenesisState) Hash() ([]byte, error) {
+	bz, err := gs.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+// VerifyConsumerGenesisHash recomputes the hash of the consumer genesis state
+// and returns an error if it does not match the expected hash
+func VerifyConsumerGenesisHash(gs ConsumerGenesisState, expectedHash []byte) error {
+	hash, err := gs.Hash()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "cannot compute consumer genesis hash: %s", err.Error())
+	}
+	if !bytes.Equal(hash, expectedHash) {
+		return errorsmod.Wrapf(ErrGenesisHashMismatch, "expected %s, got %s",
+			hex.EncodeToString(expectedHash), hex.EncodeToString(hash))
+	}
+	return nil
+}
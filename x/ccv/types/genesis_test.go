@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v6/testutil/crypto"
+	"github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+// TestConsumerGenesisHash tests that the hash of a consumer genesis state is stable
+// across marshal/unmarshal round trips and that it changes with the genesis state
+func TestConsumerGenesisHash(t *testing.T) {
+	pubKey := crypto.NewCryptoIdentityFromIntSeed(238934).TMCryptoPubKey()
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	cs := ibctmtypes.NewClientState("provider", ibctmtypes.DefaultTrustLevel, 2*time.Hour, 3*time.Hour, 10*time.Second,
+		clienttypes.NewHeight(0, 4), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+	consensusState := ibctmtypes.NewConsensusState(time.Unix(1000000, 0).UTC(),
+		commitmenttypes.NewMerkleRoot([]byte("apphash")), valSet.Hash())
+	params := types.DefaultParams()
+	params.Enabled = true
+	gen := *types.NewInitialConsumerGenesisState(cs, consensusState, tmtypes.TM2PB.ValidatorUpdates(valSet), params)
+
+	hash, err := gen.Hash()
+	require.NoError(t, err)
+	require.Len(t, hash, 32)
+	require.NoError(t, types.VerifyConsumerGenesisHash(gen, hash))
+
+	// the hash is stable across a protobuf round trip
+	bz, err := gen.Marshal()
+	require.NoError(t, err)
+	var protoGen types.ConsumerGenesisState
+	require.NoError(t, protoGen.Unmarshal(bz))
+	require.NoError(t, types.VerifyConsumerGenesisHash(protoGen, hash))
+
+	// the hash is stable across a JSON round trip (e.g., when the genesis is copied into the consumer genesis file)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	jsonBz, err := cdc.MarshalJSON(&gen)
+	require.NoError(t, err)
+	var jsonGen types.ConsumerGenesisState
+	require.NoError(t, cdc.UnmarshalJSON(jsonBz, &jsonGen))
+	require.NoError(t, types.VerifyConsumerGenesisHash(jsonGen, hash))
+
+	// the hash changes with the genesis state
+	modifiedGen := jsonGen
+	modifiedGen.Params.BlocksPerDistributionTransmission++
+	err = types.VerifyConsumerGenesisHash(modifiedGen, hash)
+	require.ErrorIs(t, err, types.ErrGenesisHashMismatch)
+
+	err = types.VerifyConsumerGenesisHash(gen, []byte("invalid hash"))
+	require.ErrorIs(t, err, types.ErrGenesisHashMismatch)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// The versions of the consumer genesis format, i.e., the ICS major versions that changed
+// the format of the consumer genesis state
+const (
+	ConsumerGenesisVersionV4 = "v4"
+	ConsumerGenesisVersionV5 = "v5"
+	ConsumerGenesisVersionV6 = "v6"
+
+	// CcvGenesisVersion is the version of the consumer genesis format of this ICS version
+	CcvGenesisVersion = ConsumerGenesisVersionV6
+)
+
+// SupportedConsumerGenesisVersions are the versions of the consumer genesis format that are understood by
+// this ICS version, i.e., the versions to which a consumer genesis state can be transformed
+var SupportedConsumerGenesisVersions = []string{
+	ConsumerGenesisVersionV4,
+	ConsumerGenesisVersionV5,
+	ConsumerGenesisVersionV6,
+}
+
+// consumerParamsAddedInV6 are the JSON names of the consumer params introduced by the v6 consumer genesis format
+var consumerParamsAddedInV6 = []string{
+	"consumer_id",
+	"consumer_denom",
+	"consumer_denom_metadata",
+	"provider_client_expiry_warning_fraction",
+	"halt_on_provider_client_expiry",
+	"provider_client_expiry_halt_delay",
+	"signed_blocks_window",
+	"min_signed_per_window",
+	"denom_redistribution_fractions",
+	"expected_provider_epoch_duration",
+	"valset_staleness_warning_epochs",
+	"provider_accepts_handshake_metadata",
+}
+
+// ValidateCcvGenesisVersion returns an error if the consumer genesis format `version` is not supported by this
+// ICS version. An empty version is accepted, as the consumer genesis states generated by previous versions
+// do not embed their version.
+func ValidateCcvGenesisVersion(version string) error {
+	if version == "" || slices.Contains(SupportedConsumerGenesisVersions, version) {
+		return nil
+	}
+	return errorsmod.Wrapf(ErrInvalidGenesis, "unsupported consumer genesis version %s (supported versions: %v)",
+		version, SupportedConsumerGenesisVersions)
+}
+
+// TransformConsumerGenesis returns the JSON encoding of the consumer genesis state `gen` in the format of the
+// consumer genesis version `targetVersion`. The fields that are unknown to the target version are removed.
+func TransformConsumerGenesis(gen ConsumerGenesisState, targetVersion string) (json.RawMessage, error) {
+	if !slices.Contains(SupportedConsumerGenesisVersions, targetVersion) {
+		return nil, errorsmod.Wrapf(ErrInvalidGenesis, "unsupported target version %s (supported versions: %v)",
+			targetVersion, SupportedConsumerGenesisVersions)
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(&gen)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling consumer genesis failed: %w", err)
+	}
+	if targetVersion == CcvGenesisVersion {
+		return bz, nil
+	}
+
+	// the v4 and v5 formats do not have the fields introduced by the v6 format
+	genState := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bz, &genState); err != nil {
+		return nil, fmt.Errorf("unmarshalling consumer genesis failed: %w", err)
+	}
+	delete(genState, "ccv_genesis_version")
+
+	params := map[string]json.RawMessage{}
+	if err := json.Unmarshal(genState["params"], &params); err != nil {
+		return nil, fmt.Errorf("unmarshalling 'params' failed: %w", err)
+	}
+	for _, name := range consumerParamsAddedInV6 {
+		delete(params, name)
+	}
+	// v4 consumers still apply the soft opt-out and hence require a valid threshold;
+	// a zero threshold matches the behaviour of later versions
+	if targetVersion == ConsumerGenesisVersionV4 && gen.Params.SoftOptOutThreshold == "" {
+		params["soft_opt_out_threshold"] = json.RawMessage(`"0"`)
+	}
+	if genState["params"], err = json.Marshal(params); err != nil {
+		return nil, fmt.Errorf("marshalling 'params' failed: %w", err)
+	}
+
+	provider := map[string]json.RawMessage{}
+	if err := json.Unmarshal(genState["provider"], &provider); err != nil {
+		return nil, fmt.Errorf("unmarshalling 'provider' failed: %w", err)
+	}
+	delete(provider, "assigned_provider_keys")
+	if genState["provider"], err = json.Marshal(provider); err != nil {
+		return nil, fmt.Errorf("marshalling 'provider' failed: %w", err)
+	}
+
+	result, err := json.Marshal(genState)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling transformation result failed: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,108 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v6/testutil/crypto"
+	"github.com/cosmos/interchain-security/v6/x/ccv/types"
+)
+
+var updateGoldenFiles = flag.Bool("update-golden", false, "update the golden files of the consumer genesis transformations")
+
+// getTestConsumerGenesis returns a deterministic consumer genesis state that uses the fields of the v6 format
+func getTestConsumerGenesis() types.ConsumerGenesisState {
+	identity := crypto.NewCryptoIdentityFromIntSeed(238934)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(identity.TMCryptoPubKey(), 1)})
+
+	cs := ibctmtypes.NewClientState("provider", ibctmtypes.DefaultTrustLevel, 2*time.Hour, 3*time.Hour, 10*time.Second,
+		clienttypes.NewHeight(0, 4), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+	consensusState := ibctmtypes.NewConsensusState(time.Unix(1000000, 0).UTC(),
+		commitmenttypes.NewMerkleRoot([]byte("apphash")), valSet.Hash())
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.ConsumerId = "0"
+	params.ConsumerDenom = "ucons"
+	params.SoftOptOutThreshold = ""
+	gen := *types.NewInitialConsumerGenesisState(cs, consensusState, tmtypes.TM2PB.ValidatorUpdates(valSet), params)
+	gen.Provider.AssignedProviderKeys = append(gen.Provider.AssignedProviderKeys,
+		crypto.NewCryptoIdentityFromIntSeed(238935).TMProtoCryptoPublicKey())
+	return gen
+}
+
+// TestTransformConsumerGenesis tests that a consumer genesis state is transformed to the format
+// of every supported consumer genesis version
+func TestTransformConsumerGenesis(t *testing.T) {
+	gen := getTestConsumerGenesis()
+	require.Equal(t, types.CcvGenesisVersion, gen.CcvGenesisVersion)
+
+	for _, targetVersion := range types.SupportedConsumerGenesisVersions {
+		t.Run(targetVersion, func(t *testing.T) {
+			bz, err := types.TransformConsumerGenesis(gen, targetVersion)
+			require.NoError(t, err)
+
+			var indented bytes.Buffer
+			require.NoError(t, json.Indent(&indented, bz, "", "  "))
+			indented.WriteString("\n")
+
+			path := filepath.Join("testdata", "consumer_genesis_"+targetVersion+".golden.json")
+			if *updateGoldenFiles {
+				require.NoError(t, os.MkdirAll("testdata", 0o755))
+				require.NoError(t, os.WriteFile(path, indented.Bytes(), 0o600))
+			}
+			expected, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), indented.String())
+		})
+	}
+
+	// the current format is the JSON encoding of the genesis state
+	bz, err := types.TransformConsumerGenesis(gen, types.CcvGenesisVersion)
+	require.NoError(t, err)
+	var currentGen types.ConsumerGenesisState
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &currentGen))
+	hash, err := gen.Hash()
+	require.NoError(t, err)
+	require.NoError(t, types.VerifyConsumerGenesisHash(currentGen, hash))
+
+	// the previous formats do not have the fields introduced by the v6 format
+	for _, targetVersion := range []string{types.ConsumerGenesisVersionV4, types.ConsumerGenesisVersionV5} {
+		bz, err := types.TransformConsumerGenesis(gen, targetVersion)
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), "ccv_genesis_version")
+		require.NotContains(t, string(bz), "consumer_id")
+		require.NotContains(t, string(bz), "assigned_provider_keys")
+	}
+
+	_, err = types.TransformConsumerGenesis(gen, "v3")
+	require.ErrorIs(t, err, types.ErrInvalidGenesis)
+	_, err = types.TransformConsumerGenesis(gen, "")
+	require.ErrorIs(t, err, types.ErrInvalidGenesis)
+}
+
+// TestValidateCcvGenesisVersion tests that only the genesis states of a supported
+// (or unknown, i.e., previous) consumer genesis version are valid
+func TestValidateCcvGenesisVersion(t *testing.T) {
+	require.NoError(t, types.ValidateCcvGenesisVersion(""))
+	for _, version := range types.SupportedConsumerGenesisVersions {
+		require.NoError(t, types.ValidateCcvGenesisVersion(version))
+	}
+	require.ErrorIs(t, types.ValidateCcvGenesisVersion("v7"), types.ErrInvalidGenesis)
+
+	gen := getTestConsumerGenesis()
+	require.NoError(t, gen.Validate())
+	gen.CcvGenesisVersion = "v7"
+	require.ErrorIs(t, gen.Validate(), types.ErrInvalidGenesis)
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -41,6 +42,25 @@ const (
 
 	// Default retry delay period is 1 hour.
 	DefaultRetryDelayPeriod = time.Hour
+
+	// By default, warning events are emitted once less than a third of the
+	// trusting period of the provider client remains.
+	DefaultProviderClientExpiryWarningFraction = "0.33"
+
+	// By default, the consumer chain does not halt once the provider client expired.
+	DefaultHaltOnProviderClientExpiry = false
+
+	// Default delay (in blocks) between the detection of the provider client expiry
+	// and the halt of the consumer chain, i.e., about a day at 6 seconds per block.
+	DefaultProviderClientExpiryHaltDelay = int64(14400)
+
+	// Default expected duration of a provider epoch, i.e., the default blocks per epoch
+	// of the provider chain (600) at 6 seconds per block.
+	DefaultExpectedProviderEpochDuration = time.Hour
+
+	// By default, warning events are emitted once no VSC packet was applied for
+	// four expected provider epochs.
+	DefaultValsetStalenessWarningEpochs = int64(4)
 )
 
 // Reflection based keys for params subspace
@@ -78,7 +98,11 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 	consumerRedistributionFraction string, historicalEntries int64,
 	consumerUnbondingPeriod time.Duration,
 	rewardDenoms, providerRewardDenoms []string, retryDelayPeriod time.Duration,
-	consumerId string,
+	consumerId, consumerDenom string, consumerDenomMetadata *banktypes.Metadata,
+	providerClientExpiryWarningFraction string, haltOnProviderClientExpiry bool, providerClientExpiryHaltDelay int64,
+	signedBlocksWindow int64, minSignedPerWindow string,
+	denomRedistributionFractions []DenomRedistributionFraction,
+	expectedProviderEpochDuration time.Duration, valsetStalenessWarningEpochs int64,
 ) ConsumerParams {
 	return ConsumerParams{
 		Enabled:                           enabled,
@@ -91,11 +115,25 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 		HistoricalEntries:                 historicalEntries,
 		UnbondingPeriod:                   consumerUnbondingPeriod,
 		// DEPRECATED but setting here to 0 (i.e., disabled) for older versions of interchain-security
-		SoftOptOutThreshold:  "0",
-		RewardDenoms:         rewardDenoms,
-		ProviderRewardDenoms: providerRewardDenoms,
-		RetryDelayPeriod:     retryDelayPeriod,
-		ConsumerId:           consumerId,
+		SoftOptOutThreshold:   "0",
+		RewardDenoms:          rewardDenoms,
+		ProviderRewardDenoms:  providerRewardDenoms,
+		RetryDelayPeriod:      retryDelayPeriod,
+		ConsumerId:            consumerId,
+		ConsumerDenom:         consumerDenom,
+		ConsumerDenomMetadata: consumerDenomMetadata,
+
+		ProviderClientExpiryWarningFraction: providerClientExpiryWarningFraction,
+		HaltOnProviderClientExpiry:          haltOnProviderClientExpiry,
+		ProviderClientExpiryHaltDelay:       providerClientExpiryHaltDelay,
+
+		SignedBlocksWindow: signedBlocksWindow,
+		MinSignedPerWindow: minSignedPerWindow,
+
+		DenomRedistributionFractions: denomRedistributionFractions,
+
+		ExpectedProviderEpochDuration: expectedProviderEpochDuration,
+		ValsetStalenessWarningEpochs:  valsetStalenessWarningEpochs,
 	}
 }
 
@@ -117,6 +155,16 @@ func DefaultParams() ConsumerParams {
 		provideRewardDenoms,
 		DefaultRetryDelayPeriod,
 		"0",
+		"",
+		nil,
+		DefaultProviderClientExpiryWarningFraction,
+		DefaultHaltOnProviderClientExpiry,
+		DefaultProviderClientExpiryHaltDelay,
+		0,
+		"",
+		nil,
+		DefaultExpectedProviderEpochDuration,
+		DefaultValsetStalenessWarningEpochs,
 	)
 }
 
@@ -143,7 +191,8 @@ func (p ConsumerParams) Validate() error {
 	if err := ValidateStringFraction(p.ConsumerRedistributionFraction); err != nil {
 		return err
 	}
-	if err := ValidatePositiveInt64(p.HistoricalEntries); err != nil {
+	// zero historical entries means that no historical info is persisted
+	if err := ValidateNonNegativeInt64(p.HistoricalEntries); err != nil {
 		return err
 	}
 	if err := ValidateDuration(p.UnbondingPeriod); err != nil {
@@ -161,6 +210,27 @@ func (p ConsumerParams) Validate() error {
 	if err := ValidateConsumerId(p.ConsumerId); err != nil {
 		return err
 	}
+	if err := ValidateConsumerDenom(p.ConsumerDenom, p.ConsumerDenomMetadata); err != nil {
+		return err
+	}
+	if err := ValidateProviderClientExpiryWarningFraction(p.ProviderClientExpiryWarningFraction); err != nil {
+		return err
+	}
+	if err := ValidateNonNegativeInt64(p.ProviderClientExpiryHaltDelay); err != nil {
+		return err
+	}
+	if err := ValidateDowntimeWindow(p.SignedBlocksWindow, p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := ValidateDenomRedistributionFractions(p.DenomRedistributionFractions); err != nil {
+		return err
+	}
+	if err := ValidateNonNegativeDuration(p.ExpectedProviderEpochDuration); err != nil {
+		return err
+	}
+	if err := ValidateNonNegativeInt64(p.ValsetStalenessWarningEpochs); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -181,7 +251,7 @@ func (p *ConsumerParams) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyConsumerRedistributionFrac,
 			p.ConsumerRedistributionFraction, ValidateStringFraction),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries,
-			p.HistoricalEntries, ValidatePositiveInt64),
+			p.HistoricalEntries, ValidateNonNegativeInt64),
 		paramtypes.NewParamSetPair(KeyConsumerUnbondingPeriod,
 			p.UnbondingPeriod, ValidateDuration),
 		paramtypes.NewParamSetPair(KeyRewardDenoms,
@@ -202,6 +272,77 @@ func ValidateProviderFeePoolAddrStr(i interface{}) error {
 	return nil
 }
 
+// ValidateProviderClientExpiryWarningFraction validates the fraction of the trusting period
+// of the provider client below which warning events are emitted. Accept empty string as valid,
+// since this will be the value of consumer chains started before the param was introduced.
+func ValidateProviderClientExpiryWarningFraction(i interface{}) error {
+	if i == "" {
+		return nil
+	}
+	return ValidateStringFraction(i)
+}
+
+// ValidateConsumerDenom validates the optional staking/mint denom of a consumer chain
+// and its optional bank metadata. If the metadata is set, then its base denom must
+// match the denom.
+func ValidateConsumerDenom(denom string, metadata *banktypes.Metadata) error {
+	if denom == "" {
+		if metadata != nil {
+			return fmt.Errorf("denom metadata cannot be set without a denom")
+		}
+		return nil
+	}
+	if err := sdktypes.ValidateDenom(denom); err != nil {
+		return err
+	}
+	if metadata == nil {
+		return nil
+	}
+	if err := metadata.Validate(); err != nil {
+		return fmt.Errorf("invalid denom metadata: %w", err)
+	}
+	if metadata.Base != denom {
+		return fmt.Errorf("base of denom metadata (%s) does not match denom (%s)", metadata.Base, denom)
+	}
+	return nil
+}
+
+// ValidateDowntimeWindow validates the optional downtime window of a consumer chain, i.e.,
+// the slashing module's SignedBlocksWindow and MinSignedPerWindow. A zero window and an
+// empty fraction are valid, since they mean that the values of the genesis file are kept.
+func ValidateDowntimeWindow(signedBlocksWindow int64, minSignedPerWindow string) error {
+	if err := ValidateNonNegativeInt64(signedBlocksWindow); err != nil {
+		return fmt.Errorf("invalid signed blocks window: %w", err)
+	}
+	if minSignedPerWindow == "" {
+		return nil
+	}
+	if err := ValidateStringFraction(minSignedPerWindow); err != nil {
+		return fmt.Errorf("invalid min signed per window: %w", err)
+	}
+	return nil
+}
+
+// ValidateDenomRedistributionFractions validates the optional denom-specific overrides of the
+// consumer redistribution fraction, i.e., every denom must be valid and listed at most once,
+// and every fraction must be within [0, 1].
+func ValidateDenomRedistributionFractions(fractions []DenomRedistributionFraction) error {
+	seen := make(map[string]bool, len(fractions))
+	for _, f := range fractions {
+		if err := sdktypes.ValidateDenom(f.Denom); err != nil {
+			return fmt.Errorf("invalid denom redistribution fraction: %w", err)
+		}
+		if seen[f.Denom] {
+			return fmt.Errorf("duplicate denom redistribution fraction for denom %s", f.Denom)
+		}
+		seen[f.Denom] = true
+		if err := ValidateStringFraction(f.Fraction); err != nil {
+			return fmt.Errorf("invalid redistribution fraction for denom %s: %w", f.Denom, err)
+		}
+	}
+	return nil
+}
+
 func ValidateDenoms(i interface{}) error {
 	v, ok := i.([]string)
 	if !ok {
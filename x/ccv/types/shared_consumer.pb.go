@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cometbft/cometbft/abci/types"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -78,6 +80,56 @@ type ConsumerParams struct {
 	// The consumer ID of this consumer chain. Used by the consumer module to send
 	// ICS rewards.
 	ConsumerId string `protobuf:"bytes,14,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The staking/mint denom of the consumer chain, as set by the consumer
+	// chain owner on the provider. Optional.
+	ConsumerDenom string `protobuf:"bytes,15,opt,name=consumer_denom,json=consumerDenom,proto3" json:"consumer_denom,omitempty"`
+	// The bank metadata of `consumer_denom`. If set, it is registered with the
+	// bank module on InitGenesis unless metadata for the denom already exists.
+	ConsumerDenomMetadata *types.Metadata `protobuf:"bytes,16,opt,name=consumer_denom_metadata,json=consumerDenomMetadata,proto3" json:"consumer_denom_metadata,omitempty"`
+	// The fraction of the trusting period of the provider client below which
+	// the consumer emits warning events every block, i.e., when the remaining
+	// time until the provider client expires is less than this fraction of
+	// its trusting period. The fraction is a string representing a decimal
+	// number. For example "0.33" would represent 33%. If empty or zero, no
+	// warning events are emitted.
+	ProviderClientExpiryWarningFraction string `protobuf:"bytes,17,opt,name=provider_client_expiry_warning_fraction,json=providerClientExpiryWarningFraction,proto3" json:"provider_client_expiry_warning_fraction,omitempty"`
+	// Whether the consumer chain halts once the provider client expired.
+	HaltOnProviderClientExpiry bool `protobuf:"varint,18,opt,name=halt_on_provider_client_expiry,json=haltOnProviderClientExpiry,proto3" json:"halt_on_provider_client_expiry,omitempty"`
+	// The number of blocks after the expiry of the provider client is detected
+	// at which the consumer chain halts (if halt_on_provider_client_expiry is
+	// set). The halt height is announced in the emitted events so that the
+	// validators can coordinate the recovery of the chain.
+	ProviderClientExpiryHaltDelay int64 `protobuf:"varint,19,opt,name=provider_client_expiry_halt_delay,json=providerClientExpiryHaltDelay,proto3" json:"provider_client_expiry_halt_delay,omitempty"`
+	// The number of blocks of the downtime window, set as the
+	// `SignedBlocksWindow` of the slashing module on InitGenesis. If zero,
+	// the slashing params of the genesis file are kept.
+	SignedBlocksWindow int64 `protobuf:"varint,20,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// The minimum fraction of blocks a validator must have signed in the
+	// downtime window, set as the `MinSignedPerWindow` of the slashing module
+	// on InitGenesis. The fraction is a string representing a decimal number.
+	// If empty, the slashing params of the genesis file are kept.
+	MinSignedPerWindow string `protobuf:"bytes,21,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	// Denom-specific overrides of `consumer_redistribution_fraction`, i.e., the
+	// fraction of the tokens of a denom allocated to the consumer redistribution
+	// address during distribution events. Denoms without an override are split
+	// according to `consumer_redistribution_fraction`. Optional.
+	DenomRedistributionFractions []DenomRedistributionFraction `protobuf:"bytes,22,rep,name=denom_redistribution_fractions,json=denomRedistributionFractions,proto3" json:"denom_redistribution_fractions"`
+	// The expected duration of an epoch of the provider chain, i.e., the
+	// expected time between two consecutive VSC packets sent by the provider
+	// chain while the validator set changes.
+	ExpectedProviderEpochDuration time.Duration `protobuf:"bytes,23,opt,name=expected_provider_epoch_duration,json=expectedProviderEpochDuration,proto3,stdduration" json:"expected_provider_epoch_duration"`
+	// The number of expected provider epochs without any VSC packet applied
+	// after which the validator set of the consumer chain is considered stale
+	// and the consumer emits warning events every block. If zero, no warning
+	// events are emitted.
+	ValsetStalenessWarningEpochs int64 `protobuf:"varint,24,opt,name=valset_staleness_warning_epochs,json=valsetStalenessWarningEpochs,proto3" json:"valset_staleness_warning_epochs,omitempty"`
+	// Whether the provider chain accepts the handshake metadata of the
+	// consumer chain, i.e., whether the consumer declares its unbonding period
+	// as JSON encoded metadata in OnChanOpenInit. It is set by the providers
+	// that verify the declared unbonding period when generating the consumer
+	// genesis. Otherwise, the consumer uses the plain CCV version, as
+	// providers running a previous ICS version only accept the plain version.
+	ProviderAcceptsHandshakeMetadata bool `protobuf:"varint,25,opt,name=provider_accepts_handshake_metadata,json=providerAcceptsHandshakeMetadata,proto3" json:"provider_accepts_handshake_metadata,omitempty"`
 }
 
 func (m *ConsumerParams) Reset()         { *m = ConsumerParams{} }
@@ -212,6 +264,139 @@ func (m *ConsumerParams) GetConsumerId() string {
 	return ""
 }
 
+func (m *ConsumerParams) GetConsumerDenom() string {
+	if m != nil {
+		return m.ConsumerDenom
+	}
+	return ""
+}
+
+func (m *ConsumerParams) GetConsumerDenomMetadata() *types.Metadata {
+	if m != nil {
+		return m.ConsumerDenomMetadata
+	}
+	return nil
+}
+
+func (m *ConsumerParams) GetProviderClientExpiryWarningFraction() string {
+	if m != nil {
+		return m.ProviderClientExpiryWarningFraction
+	}
+	return ""
+}
+
+func (m *ConsumerParams) GetHaltOnProviderClientExpiry() bool {
+	if m != nil {
+		return m.HaltOnProviderClientExpiry
+	}
+	return false
+}
+
+func (m *ConsumerParams) GetProviderClientExpiryHaltDelay() int64 {
+	if m != nil {
+		return m.ProviderClientExpiryHaltDelay
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetMinSignedPerWindow() string {
+	if m != nil {
+		return m.MinSignedPerWindow
+	}
+	return ""
+}
+
+func (m *ConsumerParams) GetDenomRedistributionFractions() []DenomRedistributionFraction {
+	if m != nil {
+		return m.DenomRedistributionFractions
+	}
+	return nil
+}
+
+func (m *ConsumerParams) GetExpectedProviderEpochDuration() time.Duration {
+	if m != nil {
+		return m.ExpectedProviderEpochDuration
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetValsetStalenessWarningEpochs() int64 {
+	if m != nil {
+		return m.ValsetStalenessWarningEpochs
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetProviderAcceptsHandshakeMetadata() bool {
+	if m != nil {
+		return m.ProviderAcceptsHandshakeMetadata
+	}
+	return false
+}
+
+// DenomRedistributionFraction defines the fraction of the tokens of a denom
+// allocated to the consumer redistribution address during distribution events
+type DenomRedistributionFraction struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The fraction is a string representing a decimal number.
+	// For example "1" would represent 100%.
+	Fraction string `protobuf:"bytes,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (m *DenomRedistributionFraction) Reset()         { *m = DenomRedistributionFraction{} }
+func (m *DenomRedistributionFraction) String() string { return proto.CompactTextString(m) }
+func (*DenomRedistributionFraction) ProtoMessage()    {}
+func (*DenomRedistributionFraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+}
+func (m *DenomRedistributionFraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRedistributionFraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRedistributionFraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRedistributionFraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRedistributionFraction.Merge(m, src)
+}
+func (m *DenomRedistributionFraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRedistributionFraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRedistributionFraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRedistributionFraction proto.InternalMessageInfo
+
+func (m *DenomRedistributionFraction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRedistributionFraction) GetFraction() string {
+	if m != nil {
+		return m.Fraction
+	}
+	return ""
+}
+
 // ConsumerGenesisState defines shared genesis information between provider and
 // consumer
 type ConsumerGenesisState struct {
@@ -219,13 +404,16 @@ type ConsumerGenesisState struct {
 	Provider ProviderInfo   `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
 	// true for new chain, false for chain restart.
 	NewChain bool `protobuf:"varint,3,opt,name=new_chain,json=newChain,proto3" json:"new_chain,omitempty"`
+	// the version of the consumer genesis format, i.e., the ICS major version of the provider
+	// that generated the genesis state (empty for genesis states generated by previous versions)
+	CcvGenesisVersion string `protobuf:"bytes,4,opt,name=ccv_genesis_version,json=ccvGenesisVersion,proto3" json:"ccv_genesis_version,omitempty"`
 }
 
 func (m *ConsumerGenesisState) Reset()         { *m = ConsumerGenesisState{} }
 func (m *ConsumerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ConsumerGenesisState) ProtoMessage()    {}
 func (*ConsumerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
 }
 func (m *ConsumerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,6 +463,13 @@ func (m *ConsumerGenesisState) GetNewChain() bool {
 	return false
 }
 
+func (m *ConsumerGenesisState) GetCcvGenesisVersion() string {
+	if m != nil {
+		return m.CcvGenesisVersion
+	}
+	return ""
+}
+
 // ProviderInfo defines all information a consumer needs from a provider
 // Shared data type between provider and consumer
 type ProviderInfo struct {
@@ -283,14 +478,18 @@ type ProviderInfo struct {
 	// ProviderConsensusState filled in on new chain, nil on restart.
 	ConsensusState *_07_tendermint.ConsensusState `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
 	// InitialValset filled in on new chain and on restart.
-	InitialValSet []types.ValidatorUpdate `protobuf:"bytes,3,rep,name=initial_val_set,json=initialValSet,proto3" json:"initial_val_set"`
+	InitialValSet []types1.ValidatorUpdate `protobuf:"bytes,3,rep,name=initial_val_set,json=initialValSet,proto3" json:"initial_val_set"`
+	// AssignedProviderKeys filled in on new chain, contains the provider consensus
+	// public keys of the initial validators that assigned a different consumer key.
+	// A consumer node signing with one of these keys is misconfigured.
+	AssignedProviderKeys []crypto.PublicKey `protobuf:"bytes,4,rep,name=assigned_provider_keys,json=assignedProviderKeys,proto3" json:"assigned_provider_keys"`
 }
 
 func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
 func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
 func (*ProviderInfo) ProtoMessage()    {}
 func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{3}
 }
 func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -333,15 +532,23 @@ func (m *ProviderInfo) GetConsensusState() *_07_tendermint.ConsensusState {
 	return nil
 }
 
-func (m *ProviderInfo) GetInitialValSet() []types.ValidatorUpdate {
+func (m *ProviderInfo) GetInitialValSet() []types1.ValidatorUpdate {
 	if m != nil {
 		return m.InitialValSet
 	}
 	return nil
 }
 
+func (m *ProviderInfo) GetAssignedProviderKeys() []crypto.PublicKey {
+	if m != nil {
+		return m.AssignedProviderKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConsumerParams)(nil), "interchain_security.ccv.v1.ConsumerParams")
+	proto.RegisterType((*DenomRedistributionFraction)(nil), "interchain_security.ccv.v1.DenomRedistributionFraction")
 	proto.RegisterType((*ConsumerGenesisState)(nil), "interchain_security.ccv.v1.ConsumerGenesisState")
 	proto.RegisterType((*ProviderInfo)(nil), "interchain_security.ccv.v1.ProviderInfo")
 }
@@ -351,60 +558,86 @@ func init() {
 }
 
 var fileDescriptor_d0a8be0efc64dfbc = []byte{
-	// 833 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x73, 0xdc, 0x34,
-	0x14, 0x8e, 0xb3, 0x25, 0xd9, 0x68, 0xf3, 0xa3, 0x88, 0x50, 0x4c, 0x3a, 0xb3, 0xd9, 0x06, 0x0e,
-	0x3b, 0x30, 0xb5, 0x49, 0xe8, 0xc0, 0x0c, 0x37, 0x92, 0x50, 0xda, 0x1e, 0x92, 0xad, 0x13, 0xca,
-	0x0c, 0x1c, 0x34, 0xb2, 0xf4, 0x76, 0xad, 0xc1, 0x96, 0x3c, 0x92, 0xec, 0x90, 0x3b, 0x33, 0x5c,
-	0x39, 0xf2, 0x27, 0x95, 0x5b, 0x8f, 0x9c, 0x80, 0x49, 0xfe, 0x11, 0xc6, 0xb2, 0xbd, 0xf1, 0x32,
-	0x04, 0xda, 0x9b, 0x9f, 0xf4, 0x7d, 0x9f, 0xf5, 0xbd, 0xa7, 0xf7, 0x84, 0x3e, 0x11, 0xd2, 0x82,
-	0x66, 0x09, 0x15, 0x92, 0x18, 0x60, 0x85, 0x16, 0xf6, 0x32, 0x64, 0xac, 0x0c, 0xcb, 0xfd, 0xd0,
-	0x24, 0x54, 0x03, 0x27, 0x4c, 0x49, 0x53, 0x64, 0xa0, 0x83, 0x5c, 0x2b, 0xab, 0xf0, 0xce, 0xbf,
-	0x30, 0x02, 0xc6, 0xca, 0xa0, 0xdc, 0xdf, 0xb9, 0x6f, 0x41, 0x72, 0xd0, 0x99, 0x90, 0x36, 0xa4,
-	0x31, 0x13, 0xa1, 0xbd, 0xcc, 0xc1, 0xd4, 0xc4, 0x9d, 0x50, 0xc4, 0x2c, 0x4c, 0xc5, 0x2c, 0xb1,
-	0x2c, 0x15, 0x20, 0xad, 0x09, 0x3b, 0xe8, 0x72, 0xbf, 0x13, 0x35, 0x84, 0xe1, 0x4c, 0xa9, 0x59,
-	0x0a, 0xa1, 0x8b, 0xe2, 0x62, 0x1a, 0xf2, 0x42, 0x53, 0x2b, 0x94, 0x6c, 0xf6, 0xb7, 0x67, 0x6a,
-	0xa6, 0xdc, 0x67, 0x58, 0x7d, 0xd5, 0xab, 0x7b, 0x3f, 0xad, 0xa2, 0xcd, 0xa3, 0xe6, 0xc8, 0x13,
-	0xaa, 0x69, 0x66, 0xb0, 0x8f, 0x56, 0x41, 0xd2, 0x38, 0x05, 0xee, 0x7b, 0x23, 0x6f, 0xdc, 0x8f,
-	0xda, 0x10, 0x9f, 0xa2, 0x0f, 0xe3, 0x54, 0xb1, 0x1f, 0x0c, 0xc9, 0x41, 0x13, 0x2e, 0x8c, 0xd5,
-	0x22, 0x2e, 0xaa, 0x7f, 0x10, 0xab, 0xa9, 0x34, 0x99, 0x30, 0x46, 0x28, 0xe9, 0x2f, 0x8f, 0xbc,
-	0x71, 0x2f, 0x7a, 0x50, 0x63, 0x27, 0xa0, 0x8f, 0x3b, 0xc8, 0xf3, 0x0e, 0x10, 0x3f, 0x43, 0x0f,
-	0x6e, 0x55, 0x21, 0x2c, 0xa1, 0x52, 0x42, 0xea, 0xf7, 0x46, 0xde, 0x78, 0x2d, 0xda, 0xe5, 0xb7,
-	0x88, 0x1c, 0xd5, 0x30, 0xfc, 0x05, 0xda, 0xc9, 0xb5, 0x2a, 0x05, 0x07, 0x4d, 0xa6, 0x00, 0x24,
-	0x57, 0x2a, 0x25, 0x94, 0x73, 0x4d, 0x8c, 0xd5, 0xfe, 0x1d, 0x27, 0x72, 0xaf, 0x45, 0x3c, 0x06,
-	0x98, 0x28, 0x95, 0x7e, 0xc9, 0xb9, 0x3e, 0xb3, 0x1a, 0x3f, 0x47, 0x98, 0xb1, 0x92, 0x58, 0x91,
-	0x81, 0x2a, 0x6c, 0xe5, 0x4e, 0x28, 0xee, 0xbf, 0x35, 0xf2, 0xc6, 0x83, 0x83, 0xf7, 0x83, 0x3a,
-	0xb1, 0x41, 0x9b, 0xd8, 0xe0, 0xb8, 0x49, 0xec, 0x61, 0xff, 0xe5, 0x1f, 0xbb, 0x4b, 0xbf, 0xfe,
-	0xb9, 0xeb, 0x45, 0x77, 0x19, 0x2b, 0xcf, 0x6b, 0xf6, 0xc4, 0x91, 0xf1, 0xf7, 0xe8, 0x3d, 0xe7,
-	0x66, 0x0a, 0xfa, 0x9f, 0xba, 0x2b, 0xaf, 0xaf, 0xfb, 0x6e, 0xab, 0xb1, 0x28, 0xfe, 0x04, 0x8d,
-	0xda, 0x7b, 0x46, 0x34, 0x2c, 0xa4, 0x70, 0xaa, 0x29, 0xab, 0x3e, 0xfc, 0x55, 0xe7, 0x78, 0xd8,
-	0xe2, 0xa2, 0x05, 0xd8, 0xe3, 0x06, 0x85, 0x1f, 0x22, 0x9c, 0x08, 0x63, 0x95, 0x16, 0x8c, 0xa6,
-	0x04, 0xa4, 0xd5, 0x02, 0x8c, 0xdf, 0x77, 0x05, 0x7c, 0xfb, 0x66, 0xe7, 0xab, 0x7a, 0x03, 0x9f,
-	0xa0, 0xbb, 0x85, 0x8c, 0x95, 0xe4, 0x42, 0xce, 0x5a, 0x3b, 0x6b, 0xaf, 0x6f, 0x67, 0x6b, 0x4e,
-	0x6e, 0x8c, 0x7c, 0x8e, 0xee, 0x19, 0x35, 0xb5, 0x44, 0xe5, 0x96, 0x54, 0x19, 0xb2, 0x89, 0x06,
-	0x93, 0xa8, 0x94, 0xfb, 0xa8, 0x3a, 0xfe, 0xe1, 0xb2, 0xef, 0x45, 0xef, 0x54, 0x88, 0xd3, 0xdc,
-	0x9e, 0x16, 0xf6, 0xbc, 0xdd, 0xc6, 0x1f, 0xa0, 0x0d, 0x0d, 0x17, 0x54, 0x73, 0xc2, 0x41, 0xaa,
-	0xcc, 0xf8, 0x83, 0x51, 0x6f, 0xbc, 0x16, 0xad, 0xd7, 0x8b, 0xc7, 0x6e, 0x0d, 0x3f, 0x42, 0xf3,
-	0x82, 0x93, 0x45, 0xf4, 0xba, 0x43, 0x6f, 0xb7, 0xbb, 0x51, 0x97, 0xf5, 0x1c, 0x61, 0x0d, 0x56,
-	0x5f, 0x12, 0x0e, 0x29, 0xbd, 0x6c, 0x5d, 0x6e, 0xbc, 0xc1, 0x65, 0x70, 0xf4, 0xe3, 0x8a, 0xdd,
-	0xd8, 0xdc, 0x45, 0x83, 0x79, 0xbd, 0x04, 0xf7, 0x37, 0x5d, 0x69, 0x50, 0xbb, 0xf4, 0x94, 0xef,
-	0xfd, 0xe6, 0xa1, 0xed, 0xb6, 0x0d, 0xbf, 0x06, 0x09, 0x46, 0x98, 0x33, 0x4b, 0x2d, 0xe0, 0x27,
-	0x68, 0x25, 0x77, 0x6d, 0xe9, 0x7a, 0x71, 0x70, 0xf0, 0x51, 0x70, 0xfb, 0x40, 0x09, 0x16, 0x1b,
-	0xf9, 0xf0, 0x4e, 0x75, 0xa2, 0xa8, 0xe1, 0xe3, 0x67, 0xa8, 0xdf, 0xda, 0x75, 0x0d, 0x3a, 0x38,
-	0x18, 0xff, 0x97, 0xd6, 0xa4, 0xc1, 0x3e, 0x95, 0x53, 0xd5, 0x28, 0xcd, 0xf9, 0xf8, 0x3e, 0x5a,
-	0x93, 0x70, 0x41, 0x1c, 0xd3, 0xf5, 0x67, 0x3f, 0xea, 0x4b, 0xb8, 0x38, 0xaa, 0xe2, 0xbd, 0x9f,
-	0x97, 0xd1, 0x7a, 0x97, 0x8d, 0x4f, 0xd0, 0x7a, 0x3d, 0xc3, 0x88, 0xa9, 0x3c, 0x35, 0x4e, 0x3e,
-	0x0e, 0x44, 0xcc, 0x82, 0xee, 0x84, 0x0b, 0x3a, 0x33, 0xad, 0x72, 0xe3, 0x56, 0x5d, 0x1a, 0xa2,
-	0x01, 0xbb, 0x09, 0xf0, 0xb7, 0x68, 0xab, 0x4a, 0x1d, 0x48, 0x53, 0x98, 0x46, 0xb2, 0x36, 0x14,
-	0xfc, 0xaf, 0x64, 0x4b, 0xab, 0x55, 0x37, 0xd9, 0x42, 0x8c, 0x4f, 0xd0, 0x96, 0x90, 0xc2, 0x0a,
-	0x9a, 0x92, 0x92, 0xa6, 0xc4, 0x80, 0xf5, 0x7b, 0xa3, 0xde, 0x78, 0x70, 0x30, 0xea, 0xea, 0x54,
-	0xa3, 0x3a, 0x78, 0x41, 0x53, 0xc1, 0xa9, 0x55, 0xfa, 0x9b, 0x9c, 0x53, 0x0b, 0x4d, 0x86, 0x36,
-	0x1a, 0xfa, 0x0b, 0x9a, 0x9e, 0x81, 0x3d, 0x3c, 0x79, 0x79, 0x35, 0xf4, 0x5e, 0x5d, 0x0d, 0xbd,
-	0xbf, 0xae, 0x86, 0xde, 0x2f, 0xd7, 0xc3, 0xa5, 0x57, 0xd7, 0xc3, 0xa5, 0xdf, 0xaf, 0x87, 0x4b,
-	0xdf, 0x3d, 0x9a, 0x09, 0x9b, 0x14, 0x71, 0xc0, 0x54, 0x16, 0x32, 0x65, 0x32, 0x65, 0xc2, 0x9b,
-	0x5a, 0x3c, 0x9c, 0x3f, 0x2d, 0xe5, 0x67, 0xe1, 0x8f, 0xee, 0x7d, 0x71, 0x2f, 0x43, 0xbc, 0xe2,
-	0x6e, 0xdd, 0xa7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xf0, 0xde, 0x5e, 0x87, 0x06, 0x00,
-	0x00,
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6f, 0x1b, 0xb7,
+	0x16, 0xb6, 0xec, 0x3c, 0x64, 0xca, 0x8f, 0x84, 0x91, 0x9d, 0x89, 0xe3, 0xc8, 0x8a, 0x73, 0x2f,
+	0xae, 0x70, 0x8b, 0xcc, 0xc4, 0x6e, 0xd0, 0x00, 0xdd, 0xc5, 0x8f, 0xd4, 0x49, 0x10, 0x5b, 0x91,
+	0x9d, 0xa4, 0x68, 0x17, 0x04, 0x45, 0x1e, 0x4b, 0x84, 0x47, 0xe4, 0x80, 0xa4, 0xc6, 0xd1, 0xba,
+	0x7f, 0xa0, 0xcb, 0xfe, 0xa4, 0x2c, 0xb3, 0x29, 0xd0, 0x55, 0x1f, 0xc9, 0xaf, 0xe8, 0xae, 0x18,
+	0xce, 0x70, 0x2c, 0x15, 0x76, 0x9a, 0xee, 0x86, 0x3c, 0xdf, 0xf7, 0xcd, 0x79, 0x91, 0x3c, 0xe8,
+	0x81, 0x90, 0x16, 0x34, 0xeb, 0x53, 0x21, 0x89, 0x01, 0x36, 0xd4, 0xc2, 0x8e, 0x22, 0xc6, 0xd2,
+	0x28, 0xdd, 0x88, 0x4c, 0x9f, 0x6a, 0xe0, 0x84, 0x29, 0x69, 0x86, 0x03, 0xd0, 0x61, 0xa2, 0x95,
+	0x55, 0x78, 0xe5, 0x1c, 0x46, 0xc8, 0x58, 0x1a, 0xa6, 0x1b, 0x2b, 0xb7, 0x2d, 0x48, 0x0e, 0x7a,
+	0x20, 0xa4, 0x8d, 0x68, 0x97, 0x89, 0xc8, 0x8e, 0x12, 0x30, 0x39, 0x71, 0x65, 0x75, 0xcc, 0xc8,
+	0xf4, 0x28, 0xb1, 0x2a, 0x3a, 0x81, 0x91, 0xb7, 0x46, 0xa2, 0xcb, 0xa2, 0x58, 0xf4, 0xfa, 0x96,
+	0xc5, 0x02, 0xa4, 0x35, 0xd1, 0x18, 0x3c, 0xdd, 0x18, 0x5b, 0x15, 0x84, 0x46, 0x4f, 0xa9, 0x5e,
+	0x0c, 0x91, 0x5b, 0x75, 0x87, 0xc7, 0x11, 0x1f, 0x6a, 0x6a, 0x85, 0x92, 0x85, 0xbd, 0xde, 0x53,
+	0x3d, 0xe5, 0x3e, 0xa3, 0xec, 0xcb, 0xb3, 0x98, 0x32, 0x03, 0x65, 0xa2, 0x2e, 0x95, 0x27, 0x51,
+	0xba, 0xd1, 0x05, 0x4b, 0x37, 0xdc, 0x22, 0xb7, 0xaf, 0xff, 0x3c, 0x8f, 0x16, 0xb6, 0x8b, 0x80,
+	0xdb, 0x54, 0xd3, 0x81, 0xc1, 0x01, 0xba, 0x0a, 0x92, 0x76, 0x63, 0xe0, 0x41, 0xa5, 0x59, 0x69,
+	0x55, 0x3b, 0x7e, 0x89, 0x0f, 0xd0, 0x7f, 0xba, 0xb1, 0x62, 0x27, 0x86, 0x24, 0xa0, 0x09, 0x17,
+	0xc6, 0x6a, 0xd1, 0x1d, 0x66, 0x3e, 0x10, 0xab, 0xa9, 0x34, 0x03, 0x61, 0x8c, 0x50, 0x32, 0x98,
+	0x6e, 0x56, 0x5a, 0x33, 0x9d, 0xbb, 0x39, 0xb6, 0x0d, 0x7a, 0x67, 0x0c, 0x79, 0x34, 0x06, 0xc4,
+	0xcf, 0xd0, 0xdd, 0x0b, 0x55, 0x08, 0xeb, 0x53, 0x29, 0x21, 0x0e, 0x66, 0x9a, 0x95, 0xd6, 0x6c,
+	0x67, 0x8d, 0x5f, 0x20, 0xb2, 0x9d, 0xc3, 0xf0, 0xd7, 0x68, 0x25, 0xd1, 0x2a, 0x15, 0x1c, 0x34,
+	0x39, 0x06, 0x20, 0x89, 0x52, 0x31, 0xa1, 0x9c, 0x6b, 0x62, 0xac, 0x0e, 0x2e, 0x39, 0x91, 0x65,
+	0x8f, 0x78, 0x02, 0xd0, 0x56, 0x2a, 0x7e, 0xcc, 0xb9, 0x3e, 0xb4, 0x1a, 0xbf, 0x44, 0x98, 0xb1,
+	0x94, 0x58, 0x31, 0x00, 0x35, 0xb4, 0x59, 0x74, 0x42, 0xf1, 0xe0, 0x72, 0xb3, 0xd2, 0xaa, 0x6d,
+	0xde, 0x0a, 0xf3, 0xc4, 0x87, 0x3e, 0xf1, 0xe1, 0x4e, 0x91, 0xf8, 0xad, 0xea, 0xbb, 0x5f, 0xd7,
+	0xa6, 0x7e, 0xfa, 0x6d, 0xad, 0xd2, 0xb9, 0xc6, 0x58, 0x7a, 0x94, 0xb3, 0xdb, 0x8e, 0x8c, 0xbf,
+	0x47, 0x37, 0x5d, 0x34, 0xc7, 0xa0, 0xff, 0xae, 0x7b, 0xe5, 0xf3, 0x75, 0x97, 0xbc, 0xc6, 0xa4,
+	0xf8, 0x1e, 0x6a, 0xfa, 0x2e, 0x25, 0x1a, 0x26, 0x52, 0x78, 0xac, 0x29, 0xcb, 0x3e, 0x82, 0xab,
+	0x2e, 0xe2, 0x86, 0xc7, 0x75, 0x26, 0x60, 0x4f, 0x0a, 0x14, 0xbe, 0x8f, 0x70, 0x5f, 0x18, 0xab,
+	0xb4, 0x60, 0x34, 0x26, 0x20, 0xad, 0x16, 0x60, 0x82, 0xaa, 0x2b, 0xe0, 0xf5, 0x33, 0xcb, 0x6e,
+	0x6e, 0xc0, 0xfb, 0xe8, 0xda, 0x50, 0x76, 0x95, 0xe4, 0x42, 0xf6, 0x7c, 0x38, 0xb3, 0x9f, 0x1f,
+	0xce, 0x62, 0x49, 0x2e, 0x02, 0x79, 0x84, 0x96, 0x8d, 0x3a, 0xb6, 0x44, 0x25, 0x96, 0x64, 0x19,
+	0xb2, 0x7d, 0x0d, 0xa6, 0xaf, 0x62, 0x1e, 0xa0, 0xcc, 0xfd, 0xad, 0xe9, 0xa0, 0xd2, 0xb9, 0x91,
+	0x21, 0x0e, 0x12, 0x7b, 0x30, 0xb4, 0x47, 0xde, 0x8c, 0xef, 0xa1, 0x79, 0x0d, 0xa7, 0x54, 0x73,
+	0xc2, 0x41, 0xaa, 0x81, 0x09, 0x6a, 0xcd, 0x99, 0xd6, 0x6c, 0x67, 0x2e, 0xdf, 0xdc, 0x71, 0x7b,
+	0xf8, 0x21, 0x2a, 0x0b, 0x4e, 0x26, 0xd1, 0x73, 0x0e, 0x5d, 0xf7, 0xd6, 0xce, 0x38, 0xeb, 0x25,
+	0xc2, 0x1a, 0xac, 0x1e, 0x11, 0x0e, 0x31, 0x1d, 0xf9, 0x28, 0xe7, 0xff, 0x45, 0x33, 0x38, 0xfa,
+	0x4e, 0xc6, 0x2e, 0xc2, 0x5c, 0x43, 0xb5, 0xb2, 0x5e, 0x82, 0x07, 0x0b, 0xae, 0x34, 0xc8, 0x6f,
+	0x3d, 0xe5, 0xf8, 0xbf, 0x68, 0xa1, 0x04, 0x38, 0x17, 0x83, 0x45, 0x87, 0x99, 0xf7, 0xbb, 0xce,
+	0x37, 0xfc, 0x0a, 0xdd, 0x9c, 0x84, 0x91, 0x01, 0x58, 0xca, 0xa9, 0xa5, 0xc1, 0x35, 0xe7, 0xdf,
+	0x9d, 0x30, 0x3f, 0xef, 0xa1, 0x3b, 0xe2, 0xc5, 0x79, 0x0f, 0x5f, 0x14, 0xa0, 0xce, 0xd2, 0x84,
+	0x9c, 0xdf, 0xc6, 0x47, 0xe8, 0x7f, 0x65, 0x9e, 0xf2, 0xdb, 0x88, 0xc0, 0xdb, 0x44, 0xe8, 0x11,
+	0x39, 0xa5, 0x5a, 0x66, 0xa5, 0x2e, 0xbb, 0xea, 0xba, 0x73, 0xeb, 0x9e, 0x87, 0x6f, 0x3b, 0xf4,
+	0xae, 0x03, 0xbf, 0xc9, 0xb1, 0x65, 0x6b, 0x6d, 0xa1, 0x46, 0x9f, 0xc6, 0x96, 0x28, 0x49, 0xce,
+	0x57, 0x0f, 0xb0, 0xbb, 0x5e, 0x56, 0x32, 0xd4, 0x81, 0x6c, 0x9f, 0x23, 0x89, 0xf7, 0xd0, 0xdd,
+	0x0b, 0x3c, 0x73, 0xd2, 0xae, 0x42, 0xc1, 0x0d, 0xd7, 0xad, 0x77, 0xce, 0xf3, 0x69, 0x8f, 0xc6,
+	0xd6, 0x15, 0x02, 0x3f, 0x40, 0x75, 0x23, 0x7a, 0x12, 0x38, 0x29, 0xae, 0xb0, 0x53, 0x21, 0xb9,
+	0x3a, 0x0d, 0xea, 0x8e, 0x8c, 0x73, 0xdb, 0x96, 0x33, 0xbd, 0x71, 0x16, 0xbc, 0x81, 0x96, 0x06,
+	0xd9, 0x9d, 0x9f, 0xb3, 0xb2, 0x1b, 0xaf, 0xa0, 0x2c, 0xb9, 0x1c, 0xe0, 0x81, 0x90, 0x87, 0xce,
+	0xd6, 0x06, 0x5d, 0x50, 0x7e, 0xa8, 0xa0, 0x46, 0x5e, 0x97, 0x0b, 0x4e, 0xa5, 0x09, 0x96, 0x9b,
+	0x33, 0xad, 0xda, 0xe6, 0xa3, 0xf0, 0xe2, 0x57, 0x25, 0x74, 0xc5, 0x39, 0xff, 0xbc, 0x6e, 0x5d,
+	0xca, 0xba, 0xac, 0xb3, 0xca, 0x2f, 0x86, 0x18, 0x1c, 0xa3, 0x26, 0xbc, 0x4d, 0x80, 0xd9, 0xcc,
+	0x6d, 0x9f, 0x3d, 0x48, 0x14, 0xeb, 0x13, 0xff, 0x66, 0x04, 0x37, 0x3f, 0xbf, 0x9d, 0xef, 0x78,
+	0x31, 0x5f, 0xa1, 0xdd, 0x4c, 0xca, 0x03, 0xf1, 0x2e, 0x5a, 0x4b, 0x69, 0x6c, 0xc0, 0x12, 0x63,
+	0x69, 0x0c, 0x12, 0x8c, 0x29, 0xdb, 0xc6, 0xfd, 0xd4, 0x04, 0x81, 0xcb, 0xf1, 0x6a, 0x0e, 0x3b,
+	0xf4, 0xa8, 0xa2, 0x5f, 0x9c, 0x9a, 0xc1, 0x2f, 0x50, 0xd9, 0x54, 0x84, 0x32, 0x06, 0x89, 0x35,
+	0xa4, 0x4f, 0x25, 0x37, 0x7d, 0x7a, 0x02, 0x67, 0x6d, 0x7e, 0xcb, 0xb5, 0x4c, 0xd3, 0x43, 0x1f,
+	0xe7, 0xc8, 0x3d, 0x0f, 0xf4, 0x2d, 0xbd, 0x7e, 0x80, 0x6e, 0x7f, 0x22, 0x8d, 0xb8, 0x8e, 0x2e,
+	0xe7, 0xc7, 0xac, 0xe2, 0x6a, 0x99, 0x2f, 0xf0, 0x0a, 0xaa, 0x96, 0x8d, 0x3e, 0xed, 0x0c, 0xe5,
+	0x7a, 0xfd, 0xcf, 0x0a, 0xaa, 0xfb, 0x87, 0xf2, 0x9b, 0xcc, 0x7f, 0x61, 0x0e, 0x2d, 0xb5, 0x80,
+	0xf7, 0xd0, 0x95, 0xc4, 0x3d, 0x9c, 0x4e, 0xab, 0xb6, 0xf9, 0xff, 0x4f, 0x95, 0x76, 0xf2, 0xa9,
+	0x2d, 0xaa, 0x59, 0xf0, 0xf1, 0x33, 0x54, 0xf5, 0x71, 0xb9, 0xdf, 0xd7, 0x36, 0x5b, 0x9f, 0xd2,
+	0xf2, 0xe5, 0x78, 0x2a, 0x8f, 0x55, 0xa1, 0x54, 0xf2, 0xf1, 0x6d, 0x34, 0x2b, 0xe1, 0x94, 0x38,
+	0xa6, 0x7b, 0x41, 0xab, 0x9d, 0xaa, 0x84, 0xd3, 0xed, 0x6c, 0x8d, 0x43, 0x74, 0x23, 0x7b, 0xee,
+	0x7a, 0x79, 0x18, 0x24, 0x05, 0xed, 0x9e, 0xed, 0xfc, 0x8d, 0xbc, 0xce, 0x58, 0x5a, 0x04, 0xf8,
+	0x3a, 0x37, 0xac, 0xff, 0x31, 0x8d, 0xe6, 0xc6, 0xff, 0x86, 0xf7, 0xd1, 0x5c, 0x71, 0x1a, 0x4d,
+	0x96, 0x83, 0x22, 0xf2, 0x2f, 0x42, 0xd1, 0x65, 0xe1, 0xf8, 0x4c, 0x13, 0x8e, 0x4d, 0x31, 0x59,
+	0xf4, 0x6e, 0xd7, 0xa5, 0xad, 0x53, 0x63, 0x67, 0x0b, 0xfc, 0x06, 0x2d, 0x66, 0x37, 0x13, 0x48,
+	0x33, 0x34, 0x85, 0x64, 0x9e, 0x80, 0xf0, 0x1f, 0x25, 0x3d, 0x2d, 0x57, 0x5d, 0x60, 0x13, 0x6b,
+	0xbc, 0x8f, 0x16, 0x85, 0x14, 0x56, 0xd0, 0x98, 0xa4, 0x34, 0x26, 0x06, 0x6c, 0x30, 0xe3, 0x0e,
+	0x60, 0x73, 0x5c, 0x27, 0x1b, 0xdd, 0xc2, 0xd7, 0x34, 0x16, 0x9c, 0x5a, 0xa5, 0x5f, 0x25, 0x9c,
+	0x5a, 0x28, 0x32, 0x3a, 0x5f, 0xd0, 0x5f, 0xd3, 0xf8, 0x10, 0x2c, 0xfe, 0x16, 0x2d, 0x53, 0xe3,
+	0x6f, 0x04, 0xdf, 0xae, 0xd9, 0x54, 0x17, 0x5c, 0x72, 0xb2, 0xab, 0xe3, 0xb2, 0xf9, 0xd0, 0x17,
+	0xb6, 0x87, 0xdd, 0x58, 0xb0, 0xe7, 0x30, 0x2a, 0x24, 0xeb, 0x5e, 0xc1, 0xa7, 0xf4, 0x39, 0x8c,
+	0xcc, 0xd6, 0xfe, 0xbb, 0x0f, 0x8d, 0xca, 0xfb, 0x0f, 0x8d, 0xca, 0xef, 0x1f, 0x1a, 0x95, 0x1f,
+	0x3f, 0x36, 0xa6, 0xde, 0x7f, 0x6c, 0x4c, 0xfd, 0xf2, 0xb1, 0x31, 0xf5, 0xdd, 0xc3, 0x9e, 0xb0,
+	0xfd, 0x61, 0x37, 0x64, 0x6a, 0x10, 0x15, 0xd3, 0xdc, 0x59, 0x57, 0xdc, 0x2f, 0x87, 0xd8, 0xf4,
+	0xab, 0xe8, 0xad, 0x9b, 0x64, 0xdd, 0x0c, 0xda, 0xbd, 0xe2, 0x8e, 0xf4, 0x97, 0x7f, 0x0d, 0x00,
+	0xd6, 0x6e, 0x08, 0xef, 0xf1, 0x0a, 0x00, 0x00,
 }
 
 func (m *ConsumerParams) Marshal() (dAtA []byte, err error) {
@@ -427,6 +660,116 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProviderAcceptsHandshakeMetadata {
+		i--
+		if m.ProviderAcceptsHandshakeMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.ValsetStalenessWarningEpochs != 0 {
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(m.ValsetStalenessWarningEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpectedProviderEpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpectedProviderEpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if len(m.DenomRedistributionFractions) > 0 {
+		for iNdEx := len(m.DenomRedistributionFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRedistributionFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.MinSignedPerWindow) > 0 {
+		i -= len(m.MinSignedPerWindow)
+		copy(dAtA[i:], m.MinSignedPerWindow)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.MinSignedPerWindow)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.ProviderClientExpiryHaltDelay != 0 {
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(m.ProviderClientExpiryHaltDelay))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.HaltOnProviderClientExpiry {
+		i--
+		if m.HaltOnProviderClientExpiry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.ProviderClientExpiryWarningFraction) > 0 {
+		i -= len(m.ProviderClientExpiryWarningFraction)
+		copy(dAtA[i:], m.ProviderClientExpiryWarningFraction)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.ProviderClientExpiryWarningFraction)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ConsumerDenomMetadata != nil {
+		{
+			size, err := m.ConsumerDenomMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ConsumerDenom) > 0 {
+		i -= len(m.ConsumerDenom)
+		copy(dAtA[i:], m.ConsumerDenom)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.ConsumerDenom)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -434,12 +777,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x72
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x6a
 	if len(m.ProviderRewardDenoms) > 0 {
@@ -467,12 +810,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x4a
 	if m.HistoricalEntries != 0 {
@@ -487,20 +830,20 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ProviderFeePoolAddrStr) > 0 {
@@ -535,6 +878,43 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRedistributionFraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRedistributionFraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRedistributionFraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsumerGenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -555,6 +935,13 @@ func (m *ConsumerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CcvGenesisVersion) > 0 {
+		i -= len(m.CcvGenesisVersion)
+		copy(dAtA[i:], m.CcvGenesisVersion)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.CcvGenesisVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.NewChain {
 		i--
 		if m.NewChain {
@@ -608,6 +995,20 @@ func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AssignedProviderKeys) > 0 {
+		for iNdEx := len(m.AssignedProviderKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssignedProviderKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.InitialValSet) > 0 {
 		for iNdEx := len(m.InitialValSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -715,6 +1116,62 @@ func (m *ConsumerParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
+	l = len(m.ConsumerDenom)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	if m.ConsumerDenomMetadata != nil {
+		l = m.ConsumerDenomMetadata.Size()
+		n += 2 + l + sovSharedConsumer(uint64(l))
+	}
+	l = len(m.ProviderClientExpiryWarningFraction)
+	if l > 0 {
+		n += 2 + l + sovSharedConsumer(uint64(l))
+	}
+	if m.HaltOnProviderClientExpiry {
+		n += 3
+	}
+	if m.ProviderClientExpiryHaltDelay != 0 {
+		n += 2 + sovSharedConsumer(uint64(m.ProviderClientExpiryHaltDelay))
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 2 + sovSharedConsumer(uint64(m.SignedBlocksWindow))
+	}
+	l = len(m.MinSignedPerWindow)
+	if l > 0 {
+		n += 2 + l + sovSharedConsumer(uint64(l))
+	}
+	if len(m.DenomRedistributionFractions) > 0 {
+		for _, e := range m.DenomRedistributionFractions {
+			l = e.Size()
+			n += 2 + l + sovSharedConsumer(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpectedProviderEpochDuration)
+	n += 2 + l + sovSharedConsumer(uint64(l))
+	if m.ValsetStalenessWarningEpochs != 0 {
+		n += 2 + sovSharedConsumer(uint64(m.ValsetStalenessWarningEpochs))
+	}
+	if m.ProviderAcceptsHandshakeMetadata {
+		n += 3
+	}
+	return n
+}
+
+func (m *DenomRedistributionFraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	l = len(m.Fraction)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
 	return n
 }
 
@@ -731,6 +1188,10 @@ func (m *ConsumerGenesisState) Size() (n int) {
 	if m.NewChain {
 		n += 2
 	}
+	l = len(m.CcvGenesisVersion)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
 	return n
 }
 
@@ -754,9 +1215,15 @@ func (m *ProviderInfo) Size() (n int) {
 			n += 1 + l + sovSharedConsumer(uint64(l))
 		}
 	}
-	return n
-}
-
+	if len(m.AssignedProviderKeys) > 0 {
+		for _, e := range m.AssignedProviderKeys {
+			l = e.Size()
+			n += 1 + l + sovSharedConsumer(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSharedConsumer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -833,7 +1300,350 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddrStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFeePoolAddrStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptOutThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SoftOptOutThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenoms = append(m.RewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderRewardDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderRewardDenoms = append(m.ProviderRewardDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDelayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetryDelayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -861,11 +1671,11 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddrStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -893,11 +1703,11 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderFeePoolAddrStr = string(dAtA[iNdEx:postIndex])
+			m.ConsumerDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDenomMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -924,15 +1734,18 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsumerDenomMetadata == nil {
+				m.ConsumerDenomMetadata = &types.Metadata{}
+			}
+			if err := m.ConsumerDenomMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderClientExpiryWarningFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -942,30 +1755,29 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderClientExpiryWarningFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltOnProviderClientExpiry", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -975,29 +1787,36 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSharedConsumer
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSharedConsumer
+			m.HaltOnProviderClientExpiry = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderClientExpiryHaltDelay", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ProviderClientExpiryHaltDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderClientExpiryHaltDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.SignedBlocksWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1007,16 +1826,16 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1026,30 +1845,29 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MinSignedPerWindow = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoftOptOutThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRedistributionFractions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1059,29 +1877,31 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SoftOptOutThreshold = string(dAtA[iNdEx:postIndex])
+			m.DenomRedistributionFractions = append(m.DenomRedistributionFractions, DenomRedistributionFraction{})
+			if err := m.DenomRedistributionFractions[len(m.DenomRedistributionFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 11:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProviderEpochDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1091,29 +1911,30 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenoms = append(m.RewardDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpectedProviderEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderRewardDenoms", wireType)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetStalenessWarningEpochs", wireType)
 			}
-			var stringLen uint64
+			m.ValsetStalenessWarningEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1123,29 +1944,86 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ValsetStalenessWarningEpochs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSharedConsumer
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAcceptsHandshakeMetadata", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProviderAcceptsHandshakeMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderRewardDenoms = append(m.ProviderRewardDenoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRedistributionFraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRedistributionFraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRedistributionFraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryDelayPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1155,28 +2033,27 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetryDelayPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1204,7 +2081,7 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Fraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1342,6 +2219,38 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NewChain = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvGenesisVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CcvGenesisVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
@@ -1493,11 +2402,45 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialValSet = append(m.InitialValSet, types.ValidatorUpdate{})
+			m.InitialValSet = append(m.InitialValSet, types1.ValidatorUpdate{})
 			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedProviderKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedProviderKeys = append(m.AssignedProviderKeys, crypto.PublicKey{})
+			if err := m.AssignedProviderKeys[len(m.AssignedProviderKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
@@ -32,6 +32,17 @@ func ValidateDuration(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeDuration(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < time.Duration(0) {
+		return fmt.Errorf("duration cannot be negative")
+	}
+	return nil
+}
+
 func ValidateBool(i interface{}) error {
 	if _, ok := i.(bool); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -56,6 +67,16 @@ func ValidatePositiveInt64(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeInt64(i interface{}) error {
+	if err := ValidateInt64(i); err != nil {
+		return err
+	}
+	if i.(int64) < int64(0) {
+		return fmt.Errorf("int cannot be negative")
+	}
+	return nil
+}
+
 func ValidateString(i interface{}) error {
 	if _, ok := i.(string); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -31,16 +33,63 @@ func (vsc ValidatorSetChangePacketData) Validate() error {
 	if vsc.ValsetUpdateId == 0 {
 		return errorsmod.Wrap(ErrInvalidPacketData, "valset update id cannot be equal to zero")
 	}
+	if vsc.ProviderUpgradeNotice != nil {
+		if err := vsc.ProviderUpgradeNotice.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // GetBytes marshals the ValidatorSetChangePacketData into JSON string bytes
 // to be sent over the wire with IBC.
+//
+// Note that consumer chains reject unknown fields. Thus, if the packet does not carry
+// a provider upgrade notice, it is marshaled as ValidatorSetChangePacketDataV2, i.e.,
+// without the provider_upgrade_notice field, and if it does not update the provider
+// fee pool address either, it is marshaled as ValidatorSetChangePacketDataV1, i.e.,
+// without the provider_fee_pool_addr field.
 func (vsc ValidatorSetChangePacketData) GetBytes() []byte {
+	if vsc.ProviderUpgradeNotice == nil {
+		if vsc.ProviderFeePoolAddr == "" {
+			return ModuleCdc.MustMarshalJSON(&ValidatorSetChangePacketDataV1{
+				ValidatorUpdates: vsc.ValidatorUpdates,
+				ValsetUpdateId:   vsc.ValsetUpdateId,
+				SlashAcks:        vsc.SlashAcks,
+			})
+		}
+		return ModuleCdc.MustMarshalJSON(&ValidatorSetChangePacketDataV2{
+			ValidatorUpdates:    vsc.ValidatorUpdates,
+			ValsetUpdateId:      vsc.ValsetUpdateId,
+			SlashAcks:           vsc.SlashAcks,
+			ProviderFeePoolAddr: vsc.ProviderFeePoolAddr,
+		})
+	}
 	valUpdateBytes := ModuleCdc.MustMarshalJSON(&vsc)
 	return valUpdateBytes
 }
 
+// IsCleared returns true if the notice signals that the previously communicated
+// upgrade of the provider chain is no longer scheduled
+func (n ProviderUpgradeNotice) IsCleared() bool {
+	return n.Name == ""
+}
+
+// Validate is used for validating the provider upgrade notice. Note that a notice
+// clearing the previously communicated upgrade must have no other fields set.
+func (n ProviderUpgradeNotice) Validate() error {
+	if n.IsCleared() {
+		if n.Height != 0 || !n.EstimatedTime.IsZero() {
+			return errorsmod.Wrap(ErrInvalidPacketData, "cleared provider upgrade notice cannot have a height or an estimated time")
+		}
+		return nil
+	}
+	if n.Height <= 0 {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "provider upgrade notice height must be positive: %d", n.Height)
+	}
+	return nil
+}
+
 func NewVSCMaturedPacketData(valUpdateID uint64) *VSCMaturedPacketData {
 	return &VSCMaturedPacketData{
 		ValsetUpdateId: valUpdateID,
@@ -196,10 +245,31 @@ func NewConsumerPacketData(cpdType ConsumerPacketDataType, data isConsumerPacket
 	}
 }
 
+const (
+	// RewardMemoVersionAggregate is the version of the reward memos that attribute the ICS rewards
+	// to the consumer chain only. Note that reward memos without a version are aggregate memos.
+	RewardMemoVersionAggregate uint32 = 1
+	// RewardMemoVersionBreakdown is the version of the reward memos that can break the ICS rewards
+	// down by the consumer validators that generated them
+	RewardMemoVersionBreakdown uint32 = 2
+)
+
 type RewardMemo struct {
 	ConsumerId string `json:"consumerId"`
 	ChainId    string `json:"chainId"`
 	Memo       string `json:"memo"`
+	// the version of the memo schema, which is omitted by aggregate memos
+	Version uint32 `json:"version,omitempty"`
+	// the optional per-validator breakdown of the ICS rewards (see RewardMemoVersionBreakdown)
+	Breakdown []ValidatorRewardShare `json:"breakdown,omitempty"`
+}
+
+// ValidatorRewardShare is the share of the ICS rewards of a transfer generated by a consumer validator
+type ValidatorRewardShare struct {
+	// the bech32 consensus address of the validator on the consumer chain
+	ConsumerConsAddr string `json:"consumerConsAddr"`
+	// the amount of the transferred tokens generated by the validator
+	Amount string `json:"amount"`
 }
 
 func NewRewardMemo(consumerId, chainId, memo string) RewardMemo {
@@ -214,7 +284,20 @@ func NewRewardMemo(consumerId, chainId, memo string) RewardMemo {
 // Note that the memo follows the Fungible Token Transfer v2 standard
 // https://github.com/cosmos/ibc/blob/main/spec/app/ics-020-fungible-token-transfer/README.md#using-the-memo-field
 func CreateTransferMemo(consumerId, chainId string) (string, error) {
+	return createTransferMemo(NewRewardMemo(consumerId, chainId, "ICS rewards"))
+}
+
+// CreateTransferMemoWithBreakdown creates a memo for the IBC transfer of ICS rewards that breaks
+// the transferred tokens down by the consumer validators that generated them.
+// Note that the amounts of the breakdown must sum to the amount of the transfer.
+func CreateTransferMemoWithBreakdown(consumerId, chainId string, breakdown []ValidatorRewardShare) (string, error) {
 	memo := NewRewardMemo(consumerId, chainId, "ICS rewards")
+	memo.Version = RewardMemoVersionBreakdown
+	memo.Breakdown = breakdown
+	return createTransferMemo(memo)
+}
+
+func createTransferMemo(memo RewardMemo) (string, error) {
 	memoBytes, err := json.Marshal(memo)
 	if err != nil {
 		return "", err
@@ -226,6 +309,59 @@ func CreateTransferMemo(consumerId, chainId string) (string, error) {
 	), nil
 }
 
+// HasBreakdown returns true if the reward memo breaks the ICS rewards down by consumer validator
+func (m RewardMemo) HasBreakdown() bool {
+	return len(m.Breakdown) > 0
+}
+
+// ValidateBreakdown validates the per-validator breakdown of the reward memo of a transfer of `amount` tokens, i.e.,
+// the memo has a version that supports breakdowns, every share has a valid consumer consensus address (with any
+// bech32 prefix) that is not a duplicate and a positive amount, and the amounts of the shares sum to `amount`.
+// It returns the consumer consensus addresses of the shares together with their amounts.
+func (m RewardMemo) ValidateBreakdown(amount math.Int) ([]sdk.ConsAddress, []math.Int, error) {
+	if m.Version != RewardMemoVersionBreakdown {
+		return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+			"memo version %d does not support per-validator breakdowns", m.Version)
+	}
+
+	addrs := make([]sdk.ConsAddress, 0, len(m.Breakdown))
+	amounts := make([]math.Int, 0, len(m.Breakdown))
+	seen := map[string]bool{}
+	sum := math.ZeroInt()
+	for i, share := range m.Breakdown {
+		_, addr, err := bech32.DecodeAndConvert(share.ConsumerConsAddr)
+		if err != nil {
+			return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+				"invalid consumer consensus address of share %d: %s", i, err.Error())
+		}
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+				"invalid consumer consensus address of share %d: %s", i, err.Error())
+		}
+		if seen[string(addr)] {
+			return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+				"duplicate consumer consensus address of share %d", i)
+		}
+		seen[string(addr)] = true
+
+		shareAmount, ok := math.NewIntFromString(share.Amount)
+		if !ok || !shareAmount.IsPositive() {
+			return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+				"invalid amount of share %d: %s", i, share.Amount)
+		}
+		sum = sum.Add(shareAmount)
+
+		addrs = append(addrs, sdk.ConsAddress(addr))
+		amounts = append(amounts, shareAmount)
+	}
+
+	if !sum.Equal(amount) {
+		return nil, nil, errorsmod.Wrapf(ErrInvalidRewardMemo,
+			"the amounts of the shares (%s) do not sum to the transferred amount (%s)", sum, amount)
+	}
+	return addrs, amounts, nil
+}
+
 func GetRewardMemoFromTransferMemo(memo string) (RewardMemo, error) {
 	memoData := map[string]json.RawMessage{}
 	err := json.Unmarshal([]byte(memo), &memoData)
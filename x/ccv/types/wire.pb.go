@@ -9,15 +9,20 @@ import (
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -102,6 +107,15 @@ type ValidatorSetChangePacketData struct {
 	// consensus address of consumer chain validators
 	// successfully slashed on the provider chain
 	SlashAcks []string `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+	// the current address of the provider fee pool, set only if it differs
+	// from the address last communicated to the consumer chain
+	// (either during the CCV channel handshake or in a previous VSC packet)
+	ProviderFeePoolAddr string `protobuf:"bytes,4,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
+	// the upgrade of the provider chain that is scheduled, set only if it differs
+	// from the upgrade last communicated to the consumer chain; a notice with
+	// an empty name signals that the previously communicated upgrade is no longer
+	// scheduled (i.e., it was either applied or cancelled)
+	ProviderUpgradeNotice *ProviderUpgradeNotice `protobuf:"bytes,5,opt,name=provider_upgrade_notice,json=providerUpgradeNotice,proto3" json:"provider_upgrade_notice,omitempty"`
 }
 
 func (m *ValidatorSetChangePacketData) Reset()         { *m = ValidatorSetChangePacketData{} }
@@ -158,6 +172,224 @@ func (m *ValidatorSetChangePacketData) GetSlashAcks() []string {
 	return nil
 }
 
+func (m *ValidatorSetChangePacketData) GetProviderFeePoolAddr() string {
+	if m != nil {
+		return m.ProviderFeePoolAddr
+	}
+	return ""
+}
+
+func (m *ValidatorSetChangePacketData) GetProviderUpgradeNotice() *ProviderUpgradeNotice {
+	if m != nil {
+		return m.ProviderUpgradeNotice
+	}
+	return nil
+}
+
+// ValidatorSetChangePacketDataV1 is the ValidatorSetChangePacketData without the
+// provider fee pool address and the provider upgrade notice. It is used to marshal
+// VSC packets that do not update the provider fee pool address, so that they remain
+// compatible over the wire with consumer chains that do not support such updates.
+// Note that it is not used for internal storage.
+type ValidatorSetChangePacketDataV1 struct {
+	ValidatorUpdates []types.ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates" yaml:"validator_updates"`
+	ValsetUpdateId   uint64                  `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	SlashAcks        []string                `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketDataV1) Reset()         { *m = ValidatorSetChangePacketDataV1{} }
+func (m *ValidatorSetChangePacketDataV1) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketDataV1) ProtoMessage()    {}
+func (*ValidatorSetChangePacketDataV1) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{1}
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketDataV1.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketDataV1.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketDataV1) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketDataV1.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketDataV1 proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketDataV1) GetValidatorUpdates() []types.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *ValidatorSetChangePacketDataV1) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketDataV1) GetSlashAcks() []string {
+	if m != nil {
+		return m.SlashAcks
+	}
+	return nil
+}
+
+// ValidatorSetChangePacketDataV2 is the ValidatorSetChangePacketData without the
+// provider upgrade notice. It is used to marshal VSC packets that update the provider
+// fee pool address, but carry no provider upgrade notice, so that they remain compatible
+// over the wire with consumer chains that do not support provider upgrade notices.
+// Note that it is not used for internal storage.
+type ValidatorSetChangePacketDataV2 struct {
+	ValidatorUpdates    []types.ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates" yaml:"validator_updates"`
+	ValsetUpdateId      uint64                  `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	SlashAcks           []string                `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks,omitempty"`
+	ProviderFeePoolAddr string                  `protobuf:"bytes,4,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketDataV2) Reset()         { *m = ValidatorSetChangePacketDataV2{} }
+func (m *ValidatorSetChangePacketDataV2) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketDataV2) ProtoMessage()    {}
+func (*ValidatorSetChangePacketDataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{2}
+}
+func (m *ValidatorSetChangePacketDataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketDataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketDataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketDataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketDataV2.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketDataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketDataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketDataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketDataV2 proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketDataV2) GetValidatorUpdates() []types.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *ValidatorSetChangePacketDataV2) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketDataV2) GetSlashAcks() []string {
+	if m != nil {
+		return m.SlashAcks
+	}
+	return nil
+}
+
+func (m *ValidatorSetChangePacketDataV2) GetProviderFeePoolAddr() string {
+	if m != nil {
+		return m.ProviderFeePoolAddr
+	}
+	return ""
+}
+
+// ProviderUpgradeNotice notifies a consumer chain about an upgrade
+// of the provider chain scheduled through the x/upgrade module
+type ProviderUpgradeNotice struct {
+	// the name of the upgrade plan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the provider height at which the upgrade is applied
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the estimated time at which the upgrade is applied, based on the average
+	// block time of the provider chain; zero if the average block time is not known
+	EstimatedTime time.Time `protobuf:"bytes,3,opt,name=estimated_time,json=estimatedTime,proto3,stdtime" json:"estimated_time"`
+}
+
+func (m *ProviderUpgradeNotice) Reset()         { *m = ProviderUpgradeNotice{} }
+func (m *ProviderUpgradeNotice) String() string { return proto.CompactTextString(m) }
+func (*ProviderUpgradeNotice) ProtoMessage()    {}
+func (*ProviderUpgradeNotice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+}
+func (m *ProviderUpgradeNotice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderUpgradeNotice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderUpgradeNotice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderUpgradeNotice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderUpgradeNotice.Merge(m, src)
+}
+func (m *ProviderUpgradeNotice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderUpgradeNotice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderUpgradeNotice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderUpgradeNotice proto.InternalMessageInfo
+
+func (m *ProviderUpgradeNotice) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderUpgradeNotice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ProviderUpgradeNotice) GetEstimatedTime() time.Time {
+	if m != nil {
+		return m.EstimatedTime
+	}
+	return time.Time{}
+}
+
 // This packet is sent from the consumer chain to the provider chain
 // to notify that a VSC packet reached maturity on the consumer chain.
 type VSCMaturedPacketData struct {
@@ -169,7 +401,7 @@ func (m *VSCMaturedPacketData) Reset()         { *m = VSCMaturedPacketData{} }
 func (m *VSCMaturedPacketData) String() string { return proto.CompactTextString(m) }
 func (*VSCMaturedPacketData) ProtoMessage()    {}
 func (*VSCMaturedPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{1}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
 }
 func (m *VSCMaturedPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +452,7 @@ func (m *SlashPacketData) Reset()         { *m = SlashPacketData{} }
 func (m *SlashPacketData) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketData) ProtoMessage()    {}
 func (*SlashPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{2}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
 }
 func (m *SlashPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +515,7 @@ func (m *ConsumerPacketData) Reset()         { *m = ConsumerPacketData{} }
 func (m *ConsumerPacketData) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketData) ProtoMessage()    {}
 func (*ConsumerPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
 }
 func (m *ConsumerPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,13 +600,20 @@ func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 type HandshakeMetadata struct {
 	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the unbonding period the consumer chain is configured with; it is only set by the consumer
+	// in OnChanOpenInit, so that the provider can verify it against the initialization parameters
+	// of the consumer chain in OnChanOpenTry (the metadata of the consumer is JSON encoded)
+	ConsumerUnbondingPeriod *time.Duration `protobuf:"bytes,3,opt,name=consumer_unbonding_period,json=consumerUnbondingPeriod,proto3,stdduration" json:"consumer_unbonding_period,omitempty"`
+	// whether the consumer chain accepts provider upgrade notices in VSC packets; it is only set by the
+	// consumer in OnChanOpenInit, as consumer chains running a previous ICS version reject them
+	AcceptsProviderUpgradeNotices bool `protobuf:"varint,4,opt,name=accepts_provider_upgrade_notices,json=acceptsProviderUpgradeNotices,proto3" json:"accepts_provider_upgrade_notices,omitempty"`
 }
 
 func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
 func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
 func (*HandshakeMetadata) ProtoMessage()    {}
 func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{7}
 }
 func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +656,20 @@ func (m *HandshakeMetadata) GetVersion() string {
 	return ""
 }
 
+func (m *HandshakeMetadata) GetConsumerUnbondingPeriod() *time.Duration {
+	if m != nil {
+		return m.ConsumerUnbondingPeriod
+	}
+	return nil
+}
+
+func (m *HandshakeMetadata) GetAcceptsProviderUpgradeNotices() bool {
+	if m != nil {
+		return m.AcceptsProviderUpgradeNotices
+	}
+	return false
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 // that is compatible with ICS v1 and v2 over the wire. It is not used for internal storage.
 type ConsumerPacketDataV1 struct {
@@ -431,7 +684,7 @@ func (m *ConsumerPacketDataV1) Reset()         { *m = ConsumerPacketDataV1{} }
 func (m *ConsumerPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketDataV1) ProtoMessage()    {}
 func (*ConsumerPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{8}
 }
 func (m *ConsumerPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +779,7 @@ func (m *SlashPacketDataV1) Reset()         { *m = SlashPacketDataV1{} }
 func (m *SlashPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketDataV1) ProtoMessage()    {}
 func (*SlashPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{9}
 }
 func (m *SlashPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,6 +833,9 @@ func init() {
 	proto.RegisterEnum("interchain_security.ccv.v1.ConsumerPacketDataType", ConsumerPacketDataType_name, ConsumerPacketDataType_value)
 	proto.RegisterEnum("interchain_security.ccv.v1.InfractionType", InfractionType_name, InfractionType_value)
 	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketData")
+	proto.RegisterType((*ValidatorSetChangePacketDataV1)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketDataV1")
+	proto.RegisterType((*ValidatorSetChangePacketDataV2)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketDataV2")
+	proto.RegisterType((*ProviderUpgradeNotice)(nil), "interchain_security.ccv.v1.ProviderUpgradeNotice")
 	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
 	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
 	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
@@ -593,60 +849,74 @@ func init() {
 }
 
 var fileDescriptor_8fd0dc67df6b10ed = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x26, 0x65, 0x23, 0xad, 0x57, 0x85, 0x4c, 0x33, 0x6a, 0xc0, 0x32, 0xad, 0x42, 0x10, 0x2d,
-	0x20, 0xb8, 0x08, 0x59, 0xc9, 0x41, 0x0f, 0xed, 0xa5, 0xfa, 0xa1, 0x6b, 0xb6, 0xb1, 0x2c, 0x90,
-	0x92, 0x82, 0xf4, 0x42, 0xac, 0xc8, 0xb5, 0xb4, 0x90, 0xc4, 0x25, 0xb8, 0x2b, 0xa6, 0x7a, 0x83,
-	0x42, 0xa7, 0xbe, 0x80, 0x4e, 0x45, 0x0f, 0x79, 0x8c, 0xde, 0x72, 0x0c, 0xd0, 0x4b, 0x2e, 0x0d,
-	0x0a, 0xfb, 0x0d, 0xfa, 0x04, 0x05, 0xa9, 0x5f, 0x4b, 0xb4, 0x81, 0x00, 0x05, 0x9a, 0x1b, 0x39,
-	0x3b, 0xdf, 0xb7, 0x33, 0xdf, 0x37, 0x8b, 0x01, 0x5f, 0x60, 0x9f, 0xa1, 0xd0, 0xed, 0x43, 0xec,
-	0x3b, 0x14, 0xb9, 0xe3, 0x10, 0xb3, 0x89, 0xee, 0xba, 0x91, 0x1e, 0x95, 0xf4, 0x17, 0x38, 0x44,
-	0x5a, 0x10, 0x12, 0x46, 0x44, 0x39, 0x25, 0x4d, 0x73, 0xdd, 0x48, 0x8b, 0x4a, 0xf2, 0xe7, 0x2e,
-	0xa1, 0x23, 0x42, 0x75, 0xca, 0xe0, 0x00, 0xfb, 0x3d, 0x3d, 0x2a, 0x75, 0x11, 0x83, 0xa5, 0xe5,
-	0xff, 0x9c, 0x41, 0xce, 0xf7, 0x48, 0x8f, 0x24, 0x9f, 0x7a, 0xfc, 0xb5, 0x88, 0x3e, 0x64, 0xc8,
-	0xf7, 0x50, 0x38, 0xc2, 0x3e, 0xd3, 0x61, 0xd7, 0xc5, 0x3a, 0x9b, 0x04, 0x88, 0xce, 0x0f, 0xd5,
-	0x37, 0x3c, 0xf8, 0xb4, 0x03, 0x87, 0xd8, 0x83, 0x8c, 0x84, 0x36, 0x62, 0xb5, 0x3e, 0xf4, 0x7b,
-	0xa8, 0x09, 0xdd, 0x01, 0x62, 0x75, 0xc8, 0xa0, 0x48, 0xc0, 0x51, 0xb4, 0x3c, 0x77, 0xc6, 0x81,
-	0x07, 0x19, 0xa2, 0x12, 0xaf, 0xec, 0x15, 0xb3, 0x65, 0x45, 0x5b, 0x33, 0x6b, 0x31, 0xb3, 0xb6,
-	0x62, 0x6a, 0x27, 0x89, 0x55, 0xe5, 0xd5, 0xdb, 0x47, 0xdc, 0x3f, 0x6f, 0x1f, 0x49, 0x13, 0x38,
-	0x1a, 0x7e, 0xa3, 0xee, 0x10, 0xa9, 0x96, 0x10, 0xdd, 0x84, 0x50, 0xb1, 0x08, 0xe2, 0x18, 0x45,
-	0x6c, 0x91, 0xe4, 0x60, 0x4f, 0xca, 0x28, 0x7c, 0x71, 0xdf, 0xca, 0xcd, 0xe3, 0xf3, 0x44, 0xd3,
-	0x13, 0x3f, 0x03, 0x80, 0x0e, 0x21, 0xed, 0x3b, 0xd0, 0x1d, 0x50, 0x69, 0x4f, 0xd9, 0x2b, 0x1e,
-	0x58, 0x07, 0x49, 0xa4, 0xe2, 0x0e, 0xa8, 0xfa, 0x1d, 0xc8, 0x77, 0xec, 0xda, 0x39, 0x64, 0xe3,
-	0x10, 0x79, 0x1b, 0x1d, 0xa5, 0x5d, 0xc0, 0xa7, 0x5d, 0xa0, 0xfe, 0xc9, 0x83, 0x43, 0x3b, 0xe6,
-	0xdb, 0x40, 0x5b, 0xe0, 0x60, 0x55, 0x72, 0x02, 0xcb, 0x96, 0xe5, 0xdb, 0x75, 0xa8, 0x4a, 0x0b,
-	0x05, 0x84, 0x2d, 0x05, 0x54, 0x6b, 0x4d, 0xf3, 0x0e, 0x2d, 0x57, 0x01, 0xc0, 0xfe, 0x65, 0x08,
-	0x5d, 0x86, 0x89, 0x2f, 0xed, 0x29, 0x7c, 0x31, 0x57, 0x56, 0xb5, 0xf9, 0x70, 0x68, 0xcb, 0x61,
-	0x58, 0x0c, 0x87, 0x66, 0xae, 0x32, 0xad, 0x0d, 0x94, 0xfa, 0x7b, 0x06, 0x88, 0x35, 0xe2, 0xd3,
-	0xf1, 0x08, 0x85, 0x1b, 0x8d, 0x9d, 0x82, 0xfd, 0x78, 0x30, 0x92, 0x9e, 0x72, 0xe5, 0xb2, 0x76,
-	0xfb, 0x34, 0x6a, 0xbb, 0xe8, 0xd6, 0x24, 0x40, 0x56, 0x82, 0x17, 0x9f, 0x81, 0x43, 0x7a, 0x53,
-	0xb3, 0xa4, 0x97, 0x6c, 0xf9, 0xcb, 0xbb, 0x28, 0xb7, 0x64, 0x3e, 0xe3, 0xac, 0x6d, 0x16, 0xf1,
-	0x12, 0xe4, 0x23, 0xea, 0xee, 0xf8, 0x99, 0xa8, 0x90, 0x2d, 0x7f, 0x75, 0x17, 0x7b, 0xda, 0x1c,
-	0x9c, 0x71, 0x56, 0x2a, 0x5f, 0xf5, 0x1e, 0xd8, 0xf7, 0x20, 0x83, 0x6a, 0x17, 0x1c, 0x9d, 0x41,
-	0xdf, 0xa3, 0x7d, 0x38, 0x40, 0xe7, 0x88, 0xc1, 0x38, 0x28, 0x9e, 0x80, 0x07, 0x41, 0x48, 0x22,
-	0xec, 0xa1, 0xd0, 0xb9, 0x44, 0xc8, 0x09, 0x08, 0x19, 0x3a, 0xd0, 0xf3, 0xe6, 0xb3, 0x70, 0x60,
-	0xdd, 0x5f, 0x9e, 0x9e, 0x22, 0xd4, 0x24, 0x64, 0x58, 0xf1, 0xbc, 0x50, 0x94, 0xc0, 0x07, 0x11,
-	0x0a, 0x69, 0x6c, 0x59, 0x26, 0xc9, 0x5a, 0xfe, 0xaa, 0x2f, 0x33, 0x20, 0xbf, 0xab, 0x66, 0xa7,
-	0xf4, 0x9f, 0xb9, 0xf1, 0xfc, 0x36, 0x37, 0x1e, 0xbf, 0x83, 0x1b, 0x9d, 0xd2, 0xfb, 0xe0, 0xc7,
-	0x5f, 0x3c, 0x38, 0xda, 0x29, 0xec, 0x7f, 0x7e, 0x8f, 0x3f, 0xa4, 0xbc, 0xc7, 0xe3, 0xbb, 0x3a,
-	0x5f, 0xbf, 0xc9, 0xc4, 0xa4, 0x0d, 0xf4, 0xf1, 0x1f, 0x3c, 0x78, 0x90, 0xee, 0xa5, 0xf8, 0x2d,
-	0x50, 0x6a, 0x17, 0x0d, 0xbb, 0x7d, 0x6e, 0x58, 0x4e, 0xb3, 0x52, 0xfb, 0xd1, 0x68, 0x39, 0xad,
-	0xe7, 0x4d, 0xc3, 0x69, 0x37, 0xec, 0xa6, 0x51, 0x33, 0x4f, 0x4d, 0xa3, 0x2e, 0x70, 0xf2, 0xc7,
-	0xd3, 0x99, 0x72, 0xd4, 0xf6, 0x69, 0x80, 0x5c, 0x7c, 0x89, 0x97, 0x1a, 0x8a, 0x3a, 0x90, 0x53,
-	0xc1, 0xf6, 0xd3, 0x8a, 0x7d, 0x26, 0xf0, 0xf2, 0xe1, 0x74, 0xa6, 0x64, 0x37, 0x84, 0x15, 0x4f,
-	0xc0, 0x27, 0xa9, 0x80, 0xd8, 0x35, 0x21, 0x23, 0xe7, 0xa7, 0x33, 0x45, 0xe8, 0x6c, 0x39, 0x25,
-	0xef, 0xff, 0xf2, 0x5b, 0x81, 0x3b, 0x7e, 0xc9, 0x83, 0xdc, 0xcd, 0x16, 0xc5, 0x27, 0xe0, 0xa1,
-	0xd9, 0x38, 0xb5, 0x2a, 0xb5, 0x96, 0x79, 0xd1, 0x48, 0x2b, 0xfb, 0xfe, 0x74, 0xa6, 0x1c, 0xae,
-	0x41, 0xc6, 0x28, 0x60, 0x13, 0x51, 0xdf, 0x45, 0xd5, 0x2f, 0xda, 0xd5, 0xa7, 0x86, 0x63, 0x9b,
-	0xdf, 0x37, 0x04, 0x5e, 0xce, 0x4d, 0x67, 0x0a, 0xa8, 0x93, 0x71, 0x77, 0x88, 0x6c, 0xdc, 0xf3,
-	0xc5, 0x63, 0x20, 0xed, 0x02, 0x9e, 0x35, 0x5a, 0xe6, 0xb9, 0x21, 0x64, 0xe4, 0x8f, 0xa6, 0x33,
-	0xe5, 0xc3, 0x3a, 0x79, 0xe1, 0x33, 0x3c, 0x42, 0xf3, 0x5a, 0xab, 0x8d, 0x57, 0x57, 0x05, 0xfe,
-	0xf5, 0x55, 0x81, 0xff, 0xfb, 0xaa, 0xc0, 0xff, 0x7a, 0x5d, 0xe0, 0x5e, 0x5f, 0x17, 0xb8, 0x37,
-	0xd7, 0x05, 0xee, 0xa7, 0x27, 0x3d, 0xcc, 0xfa, 0xe3, 0xae, 0xe6, 0x92, 0x91, 0xbe, 0x58, 0xbc,
-	0x6b, 0x4b, 0x1f, 0xaf, 0x56, 0x78, 0xf4, 0xb5, 0xfe, 0x73, 0xb2, 0xc7, 0x93, 0x85, 0xda, 0xbd,
-	0x97, 0x6c, 0xd4, 0x93, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x32, 0x02, 0xb3, 0xda, 0xef, 0x07,
-	0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x15, 0x65, 0x7d, 0xf9, 0xe2, 0x71, 0x2b, 0xcb, 0x13, 0xdb, 0xa1, 0x99, 0x46, 0x26, 0x88,
+	0x16, 0x10, 0x5c, 0x84, 0xac, 0xe4, 0xa0, 0x8b, 0x76, 0x53, 0xeb, 0xc7, 0xb1, 0x9a, 0x58, 0x16,
+	0xa8, 0x9f, 0x20, 0xed, 0x82, 0x18, 0x91, 0x63, 0x6a, 0x60, 0x89, 0x43, 0x70, 0x46, 0x4c, 0xfd,
+	0x06, 0x81, 0x36, 0xf5, 0xb2, 0x1b, 0xad, 0x8a, 0x2e, 0xf2, 0x18, 0xdd, 0x65, 0x19, 0xa0, 0x9b,
+	0xa2, 0x40, 0xd3, 0xc2, 0x7e, 0x83, 0xae, 0xba, 0x2c, 0x48, 0x51, 0xb2, 0x6c, 0xd1, 0x2a, 0x02,
+	0x14, 0x68, 0x8b, 0xee, 0x38, 0x73, 0xef, 0xb9, 0x98, 0x7b, 0xce, 0x9d, 0xc3, 0x01, 0x1f, 0x10,
+	0x87, 0x63, 0xcf, 0xec, 0x22, 0xe2, 0x18, 0x0c, 0x9b, 0x03, 0x8f, 0xf0, 0x53, 0xcd, 0x34, 0x7d,
+	0xcd, 0xcf, 0x6b, 0xcf, 0x89, 0x87, 0x55, 0xd7, 0xa3, 0x9c, 0x42, 0x29, 0x26, 0x4d, 0x35, 0x4d,
+	0x5f, 0xf5, 0xf3, 0xd2, 0xfb, 0x26, 0x65, 0x7d, 0xca, 0x34, 0xc6, 0xd1, 0x09, 0x71, 0x6c, 0xcd,
+	0xcf, 0x77, 0x30, 0x47, 0xf9, 0xc9, 0x7a, 0x5c, 0x41, 0x5a, 0xb7, 0xa9, 0x4d, 0xc3, 0x4f, 0x2d,
+	0xf8, 0x8a, 0x76, 0xb3, 0x36, 0xa5, 0x76, 0x0f, 0x6b, 0xe1, 0xaa, 0x33, 0x38, 0xd6, 0xac, 0x81,
+	0x87, 0x38, 0xa1, 0x4e, 0x14, 0xdf, 0xbe, 0x1e, 0xe7, 0xa4, 0x8f, 0x19, 0x47, 0x7d, 0x37, 0x4a,
+	0xb8, 0xc7, 0xb1, 0x63, 0x61, 0xaf, 0x4f, 0x1c, 0xae, 0xa1, 0x8e, 0x49, 0x34, 0x7e, 0xea, 0x62,
+	0x36, 0x0e, 0x2a, 0xbf, 0x27, 0xc1, 0x7b, 0x6d, 0xd4, 0x23, 0x16, 0xe2, 0xd4, 0x6b, 0x60, 0x5e,
+	0xea, 0x22, 0xc7, 0xc6, 0x75, 0x64, 0x9e, 0x60, 0x5e, 0x46, 0x1c, 0x41, 0x0a, 0xd6, 0xfc, 0x49,
+	0xdc, 0x18, 0xb8, 0x16, 0xe2, 0x98, 0x89, 0x82, 0xbc, 0x94, 0x5b, 0x29, 0xc8, 0xea, 0x65, 0x65,
+	0x35, 0xa8, 0xac, 0x4e, 0x2b, 0xb5, 0xc2, 0xc4, 0xa2, 0xfc, 0xea, 0xcd, 0x76, 0xe2, 0xb7, 0x37,
+	0xdb, 0xe2, 0x29, 0xea, 0xf7, 0x3e, 0x51, 0xe6, 0x0a, 0x29, 0x7a, 0xc6, 0xbf, 0x0a, 0x61, 0x30,
+	0x07, 0x82, 0x3d, 0x86, 0x79, 0x94, 0x64, 0x10, 0x4b, 0x4c, 0xca, 0x42, 0x2e, 0xa5, 0xa7, 0xc7,
+	0xfb, 0xe3, 0xc4, 0xaa, 0x05, 0xef, 0x03, 0xc0, 0x7a, 0x88, 0x75, 0x0d, 0x64, 0x9e, 0x30, 0x71,
+	0x49, 0x5e, 0xca, 0x2d, 0xeb, 0xcb, 0xe1, 0xce, 0x9e, 0x79, 0xc2, 0xe0, 0x2e, 0xd8, 0x74, 0x3d,
+	0xea, 0x13, 0x0b, 0x7b, 0xc6, 0x31, 0xc6, 0x86, 0x4b, 0x69, 0xcf, 0x40, 0x96, 0xe5, 0x89, 0x29,
+	0x59, 0xc8, 0x2d, 0xeb, 0x77, 0x26, 0xd1, 0x7d, 0x8c, 0xeb, 0x94, 0xf6, 0xf6, 0x2c, 0xcb, 0x83,
+	0x04, 0xdc, 0x9d, 0x82, 0x06, 0xae, 0xed, 0x21, 0x0b, 0x1b, 0x0e, 0xe5, 0xc4, 0xc4, 0xe2, 0xff,
+	0x64, 0x21, 0xb7, 0x52, 0xc8, 0xab, 0x37, 0xeb, 0xac, 0xd6, 0x23, 0x68, 0x6b, 0x8c, 0xac, 0x85,
+	0x40, 0x7d, 0xc3, 0x8d, 0xdb, 0x56, 0x7e, 0x12, 0x40, 0x76, 0x11, 0xf5, 0xed, 0xfc, 0xbf, 0x97,
+	0x7c, 0xe5, 0xeb, 0xe4, 0x9f, 0x34, 0x57, 0xf8, 0x8f, 0x4d, 0x96, 0x72, 0x26, 0x80, 0x8d, 0xd8,
+	0xf9, 0x80, 0x10, 0xa4, 0x1c, 0xd4, 0xc7, 0xa2, 0x10, 0x82, 0xc3, 0x6f, 0xb8, 0x09, 0x6e, 0x75,
+	0x31, 0xb1, 0xbb, 0x3c, 0x3c, 0xe1, 0x92, 0x1e, 0xad, 0xe0, 0x63, 0x90, 0xc6, 0x8c, 0x93, 0x3e,
+	0xe2, 0xd8, 0x32, 0x82, 0x9b, 0x2e, 0x2e, 0x85, 0x63, 0x29, 0xa9, 0x63, 0x1b, 0x50, 0x27, 0x36,
+	0xa0, 0x36, 0x27, 0x36, 0x50, 0xbc, 0x1d, 0x70, 0x75, 0xf6, 0xcb, 0xb6, 0xa0, 0xbf, 0x3b, 0xc5,
+	0x06, 0x51, 0xe5, 0x33, 0xb0, 0xde, 0x6e, 0x94, 0x0e, 0x11, 0x1f, 0x78, 0xd8, 0x9a, 0xb9, 0xf3,
+	0x71, 0x44, 0x09, 0x71, 0x44, 0x29, 0x3f, 0x08, 0x60, 0xb5, 0x11, 0xf0, 0x32, 0x83, 0xd6, 0xc1,
+	0xf2, 0x94, 0x7a, 0x51, 0x88, 0x4e, 0x77, 0xa3, 0x9e, 0x45, 0x31, 0x52, 0x32, 0x73, 0x4d, 0x49,
+	0x45, 0xbf, 0x2c, 0xf3, 0x16, 0xd2, 0x15, 0x01, 0x20, 0xce, 0xb1, 0x87, 0xcc, 0xc0, 0x22, 0x43,
+	0x72, 0xd2, 0x05, 0x45, 0x1d, 0xfb, 0xaf, 0x3a, 0xf1, 0xdb, 0xc8, 0x7f, 0xd5, 0xea, 0x34, 0x53,
+	0x9f, 0x41, 0x29, 0xdf, 0x25, 0x01, 0x2c, 0x51, 0x87, 0x0d, 0xfa, 0xd8, 0x9b, 0x69, 0x6c, 0x1f,
+	0xa4, 0x02, 0xeb, 0x0c, 0x7b, 0x4a, 0x17, 0x0a, 0x8b, 0x8c, 0x60, 0x1e, 0xdd, 0x3c, 0x75, 0xb1,
+	0x1e, 0xe2, 0xe1, 0x53, 0xb0, 0xca, 0xae, 0x72, 0x16, 0xf6, 0xb2, 0x52, 0xf8, 0x70, 0x51, 0xc9,
+	0x6b, 0x34, 0x1f, 0x24, 0xf4, 0xeb, 0x55, 0xe0, 0x31, 0x58, 0xf7, 0x99, 0x39, 0xa7, 0x67, 0x34,
+	0x22, 0x1f, 0x2d, 0xaa, 0x1e, 0x37, 0x07, 0x07, 0x09, 0x3d, 0xb6, 0x5e, 0xf1, 0x16, 0x48, 0x59,
+	0x88, 0x23, 0xe5, 0x45, 0x12, 0xac, 0x1d, 0x20, 0xc7, 0x62, 0x5d, 0x74, 0x82, 0x0f, 0x31, 0x47,
+	0xc1, 0xee, 0x82, 0xdb, 0x21, 0xdc, 0xec, 0xbb, 0x22, 0xf8, 0xbf, 0x8f, 0x3d, 0x16, 0x68, 0x96,
+	0x0c, 0xb3, 0x26, 0x4b, 0xf8, 0x25, 0xd8, 0x32, 0x23, 0x36, 0x8d, 0x81, 0xd3, 0xa1, 0x8e, 0x45,
+	0x1c, 0xdb, 0x70, 0xb1, 0x47, 0xa8, 0x15, 0x75, 0xb6, 0x35, 0x37, 0xfc, 0xe5, 0xe8, 0x1f, 0x59,
+	0x4c, 0x7d, 0x13, 0xcc, 0xfd, 0xdd, 0x49, 0x85, 0xd6, 0xa4, 0x40, 0x3d, 0xc4, 0xc3, 0x47, 0x40,
+	0x46, 0xa6, 0x89, 0x5d, 0xce, 0x8c, 0x1b, 0x6c, 0x9f, 0x85, 0x77, 0xfa, 0xb6, 0x7e, 0x3f, 0xca,
+	0x8b, 0xbd, 0xc2, 0x4c, 0x79, 0x99, 0x04, 0xeb, 0xf3, 0xa2, 0xb7, 0xf3, 0x7f, 0xd9, 0xd0, 0x3c,
+	0xbb, 0x69, 0x68, 0x1e, 0xbc, 0xc5, 0xd0, 0xb4, 0xf3, 0xff, 0x84, 0xb1, 0xf9, 0x59, 0x00, 0x6b,
+	0x73, 0x07, 0xfb, 0x9b, 0x6d, 0xe3, 0xf3, 0x18, 0xdb, 0xd8, 0x59, 0xd4, 0xf9, 0xa5, 0x75, 0x84,
+	0x22, 0xcd, 0xa0, 0x77, 0xbe, 0x17, 0xc0, 0x66, 0xbc, 0x96, 0xf0, 0x53, 0x20, 0x97, 0x8e, 0x6a,
+	0x8d, 0xd6, 0x61, 0x45, 0x37, 0xea, 0x7b, 0xa5, 0xc7, 0x95, 0xa6, 0xd1, 0x7c, 0x56, 0xaf, 0x18,
+	0xad, 0x5a, 0xa3, 0x5e, 0x29, 0x55, 0xf7, 0xab, 0x95, 0x72, 0x26, 0x21, 0x6d, 0x0c, 0x47, 0xf2,
+	0x5a, 0xcb, 0x61, 0x2e, 0x36, 0xc9, 0x31, 0x99, 0x70, 0x08, 0x35, 0x20, 0xc5, 0x82, 0x1b, 0x4f,
+	0xf6, 0x1a, 0x07, 0x19, 0x41, 0x5a, 0x1d, 0x8e, 0xe4, 0x95, 0x19, 0x62, 0xe1, 0x2e, 0xd8, 0x8a,
+	0x05, 0x04, 0xaa, 0x65, 0x92, 0xd2, 0xfa, 0x70, 0x24, 0x67, 0xda, 0xd7, 0x94, 0x92, 0x52, 0x2f,
+	0xbe, 0xcd, 0x26, 0x76, 0x5e, 0x0a, 0x20, 0x7d, 0xb5, 0x45, 0xf8, 0x10, 0xdc, 0xab, 0xd6, 0xf6,
+	0xf5, 0xbd, 0x52, 0xb3, 0x7a, 0x54, 0x8b, 0x3b, 0xf6, 0x9d, 0xe1, 0x48, 0x5e, 0xbd, 0x04, 0x55,
+	0xfa, 0x2e, 0x3f, 0x85, 0xda, 0x3c, 0xaa, 0x7c, 0xd4, 0x2a, 0x3e, 0xa9, 0x18, 0x8d, 0xea, 0xa3,
+	0x5a, 0x46, 0x90, 0xd2, 0xc3, 0x91, 0x0c, 0xca, 0x74, 0xd0, 0xe9, 0xe1, 0x06, 0xb1, 0x1d, 0xb8,
+	0x03, 0xc4, 0x79, 0xc0, 0xd3, 0x5a, 0xb3, 0x7a, 0x58, 0xc9, 0x24, 0xa5, 0x77, 0x86, 0x23, 0xf9,
+	0x76, 0x99, 0x3e, 0x77, 0x82, 0x7f, 0xdf, 0xf8, 0xac, 0xc5, 0xda, 0xab, 0xf3, 0xac, 0xf0, 0xfa,
+	0x3c, 0x2b, 0xfc, 0x7a, 0x9e, 0x15, 0xce, 0x2e, 0xb2, 0x89, 0xd7, 0x17, 0xd9, 0xc4, 0x8f, 0x17,
+	0xd9, 0xc4, 0x17, 0x0f, 0x6d, 0xc2, 0xbb, 0x83, 0x8e, 0x6a, 0xd2, 0xbe, 0x16, 0x3d, 0xc1, 0x2f,
+	0x25, 0x7d, 0x30, 0x7d, 0xcc, 0xfb, 0x1f, 0x6b, 0x5f, 0x85, 0x2f, 0xfa, 0xf0, 0x65, 0xdc, 0xb9,
+	0x15, 0xda, 0xc8, 0xee, 0x1f, 0x03, 0x00, 0x03, 0x71, 0x40, 0xfd, 0xf9, 0x0b, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -669,6 +939,25 @@ func (m *ValidatorSetChangePacketData) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.ProviderUpgradeNotice != nil {
+		{
+			size, err := m.ProviderUpgradeNotice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.SlashAcks) > 0 {
 		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SlashAcks[iNdEx])
@@ -700,7 +989,7 @@ func (m *ValidatorSetChangePacketData) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorSetChangePacketDataV1) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -710,25 +999,48 @@ func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VSCMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketDataV1) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VSCMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashAcks[iNdEx])
+			copy(dAtA[i:], m.SlashAcks[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.SlashAcks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ValsetUpdateId != 0 {
 		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWire(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorSetChangePacketDataV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -738,40 +1050,55 @@ func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketDataV2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Infraction != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashAcks[iNdEx])
+			copy(dAtA[i:], m.SlashAcks[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.SlashAcks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
 	if m.ValsetUpdateId != 0 {
 		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
 		dAtA[i] = 0x10
 	}
-	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintWire(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintWire(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ProviderUpgradeNotice) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -781,34 +1108,148 @@ func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProviderUpgradeNotice) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProviderUpgradeNotice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EstimatedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	if m.Type != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+	i -= n2
+	i = encodeVarintWire(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSCMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VSCMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWire(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -870,6 +1311,26 @@ func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AcceptsProviderUpgradeNotices {
+		i--
+		if m.AcceptsProviderUpgradeNotices {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConsumerUnbondingPeriod != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConsumerUnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConsumerUnbondingPeriod):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintWire(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Version) > 0 {
 		i -= len(m.Version)
 		copy(dAtA[i:], m.Version)
@@ -1021,6 +1482,38 @@ func encodeVarintWire(dAtA []byte, offset int, v uint64) int {
 	return base
 }
 func (m *ValidatorSetChangePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, s := range m.SlashAcks {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.ProviderUpgradeNotice != nil {
+		l = m.ProviderUpgradeNotice.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChangePacketDataV1) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1044,6 +1537,52 @@ func (m *ValidatorSetChangePacketData) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorSetChangePacketDataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, s := range m.SlashAcks {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderUpgradeNotice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovWire(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EstimatedTime)
+	n += 1 + l + sovWire(uint64(l))
+	return n
+}
+
 func (m *VSCMaturedPacketData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1126,6 +1665,13 @@ func (m *HandshakeMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWire(uint64(l))
 	}
+	if m.ConsumerUnbondingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConsumerUnbondingPeriod)
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if m.AcceptsProviderUpgradeNotices {
+		n += 2
+	}
 	return n
 }
 
@@ -1182,16 +1728,354 @@ func (m *SlashPacketDataV1) Size() (n int) {
 	if m.Infraction != 0 {
 		n += 1 + sovWire(uint64(m.Infraction))
 	}
-	return n
-}
+	return n
+}
+
+func sovWire(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWire(x uint64) (n int) {
+	return sovWire(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderUpgradeNotice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderUpgradeNotice == nil {
+				m.ProviderUpgradeNotice = &ProviderUpgradeNotice{}
+			}
+			if err := m.ProviderUpgradeNotice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChangePacketDataV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovWire(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozWire(x uint64) (n int) {
-	return sovWire(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSetChangePacketDataV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,10 +2098,10 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetChangePacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetChangePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSetChangePacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1305,6 +2189,172 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderUpgradeNotice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderUpgradeNotice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderUpgradeNotice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EstimatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -1748,6 +2798,62 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerUnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerUnbondingPeriod == nil {
+				m.ConsumerUnbondingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConsumerUnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptsProviderUpgradeNotices", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AcceptsProviderUpgradeNotices = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -3,11 +3,16 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -146,6 +151,77 @@ func TestVSCPacketDataWireBytes(t *testing.T) {
 	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
 
 	require.Equal(t, expectedStr, str)
+
+	// packets updating the provider fee pool address include the provider_fee_pool_addr field
+	pd.ProviderFeePoolAddr = "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+	jsonBz = pd.GetBytes()
+	expectedStr = strings.TrimSuffix(expectedStr, "}") + `,"provider_fee_pool_addr":"cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"}`
+	require.Equal(t, expectedStr, string(jsonBz))
+
+	var recovered types.ValidatorSetChangePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(jsonBz, &recovered))
+	require.Equal(t, pd, recovered)
+
+	// packets without the provider_fee_pool_addr field are still decoded
+	recovered = types.ValidatorSetChangePacketData{}
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON([]byte(str), &recovered))
+	require.Empty(t, recovered.ProviderFeePoolAddr)
+	require.Equal(t, pd.ValsetUpdateId, recovered.ValsetUpdateId)
+
+	// packets carrying a provider upgrade notice include the provider_upgrade_notice field
+	pd.ProviderUpgradeNotice = &types.ProviderUpgradeNotice{
+		Name:          "v7",
+		Height:        1000,
+		EstimatedTime: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	jsonBz = pd.GetBytes()
+	expectedStr = strings.TrimSuffix(expectedStr, "}") +
+		`,"provider_upgrade_notice":{"name":"v7","height":"1000","estimated_time":"2026-01-02T03:04:05Z"}}`
+	require.Equal(t, expectedStr, string(jsonBz))
+
+	recovered = types.ValidatorSetChangePacketData{}
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(jsonBz, &recovered))
+	require.Equal(t, pd, recovered)
+
+	// packets that carry a provider upgrade notice, but do not update the
+	// provider fee pool address, include both fields nevertheless
+	pd.ProviderFeePoolAddr = ""
+	pd.ProviderUpgradeNotice = &types.ProviderUpgradeNotice{}
+	jsonBz = pd.GetBytes()
+	require.Equal(t, strings.TrimSuffix(str, "}")+
+		`,"provider_fee_pool_addr":"","provider_upgrade_notice":{"name":"","height":"0","estimated_time":"0001-01-01T00:00:00Z"}}`,
+		string(jsonBz))
+
+	recovered = types.ValidatorSetChangePacketData{}
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(jsonBz, &recovered))
+	require.NotNil(t, recovered.ProviderUpgradeNotice)
+	require.True(t, recovered.ProviderUpgradeNotice.IsCleared())
+	require.NoError(t, recovered.Validate())
+}
+
+func TestProviderUpgradeNoticeValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		notice   types.ProviderUpgradeNotice
+		expError bool
+	}{
+		{"valid notice", types.ProviderUpgradeNotice{Name: "v7", Height: 10, EstimatedTime: time.Now()}, false},
+		{"valid notice without estimated time", types.ProviderUpgradeNotice{Name: "v7", Height: 10}, false},
+		{"valid cleared notice", types.ProviderUpgradeNotice{}, false},
+		{"invalid: zero height", types.ProviderUpgradeNotice{Name: "v7"}, true},
+		{"invalid: negative height", types.ProviderUpgradeNotice{Name: "v7", Height: -1}, true},
+		{"invalid: cleared notice with height", types.ProviderUpgradeNotice{Height: 10}, true},
+		{"invalid: cleared notice with estimated time", types.ProviderUpgradeNotice{EstimatedTime: time.Now()}, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.notice.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
 }
 
 // TestSlashPacketDataWireBytes is a regression test that the JSON schema
@@ -237,3 +313,121 @@ func TestCreateTransferMemo(t *testing.T) {
 	require.Equal(t, chainId, rewardMemo.ChainId)
 	require.Equal(t, "ICS rewards", rewardMemo.Memo)
 }
+
+func TestCreateTransferMemoWithBreakdown(t *testing.T) {
+	consumerId := "13"
+	chainId := "chain-13"
+	breakdown := []types.ValidatorRewardShare{
+		{ConsumerConsAddr: crypto.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress().String(), Amount: "60"},
+		{ConsumerConsAddr: crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress().String(), Amount: "40"},
+	}
+
+	transferMemo, err := types.CreateTransferMemoWithBreakdown(consumerId, chainId, breakdown)
+	require.NoError(t, err)
+
+	rewardMemo, err := types.GetRewardMemoFromTransferMemo(transferMemo)
+	require.NoError(t, err)
+	require.Equal(t, consumerId, rewardMemo.ConsumerId)
+	require.Equal(t, chainId, rewardMemo.ChainId)
+	require.Equal(t, types.RewardMemoVersionBreakdown, rewardMemo.Version)
+	require.True(t, rewardMemo.HasBreakdown())
+	require.Equal(t, breakdown, rewardMemo.Breakdown)
+
+	// aggregate memos are not versioned and have no breakdown
+	transferMemo, err = types.CreateTransferMemo(consumerId, chainId)
+	require.NoError(t, err)
+	require.NotContains(t, transferMemo, "version")
+	require.NotContains(t, transferMemo, "breakdown")
+	rewardMemo, err = types.GetRewardMemoFromTransferMemo(transferMemo)
+	require.NoError(t, err)
+	require.Zero(t, rewardMemo.Version)
+	require.False(t, rewardMemo.HasBreakdown())
+}
+
+func TestRewardMemoValidateBreakdown(t *testing.T) {
+	addr0 := crypto.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress()
+	addr1 := crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	// consumer chains can use a different bech32 prefix than the provider chain
+	consumerAddr1, err := bech32.ConvertAndEncode("consumervalcons", addr1)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		malleate   func(memo *types.RewardMemo)
+		expAddrs   []sdk.ConsAddress
+		expAmounts []math.Int
+		expErr     bool
+	}{
+		{
+			"valid breakdown",
+			func(memo *types.RewardMemo) {},
+			[]sdk.ConsAddress{addr0, addr1},
+			[]math.Int{math.NewInt(60), math.NewInt(40)},
+			false,
+		},
+		{
+			"aggregate memo version",
+			func(memo *types.RewardMemo) { memo.Version = types.RewardMemoVersionAggregate },
+			nil, nil, true,
+		},
+		{
+			"unversioned memo",
+			func(memo *types.RewardMemo) { memo.Version = 0 },
+			nil, nil, true,
+		},
+		{
+			"unknown memo version",
+			func(memo *types.RewardMemo) { memo.Version = types.RewardMemoVersionBreakdown + 1 },
+			nil, nil, true,
+		},
+		{
+			"invalid consumer address",
+			func(memo *types.RewardMemo) { memo.Breakdown[0].ConsumerConsAddr = "invalid" },
+			nil, nil, true,
+		},
+		{
+			"duplicate consumer address",
+			func(memo *types.RewardMemo) { memo.Breakdown[1].ConsumerConsAddr = addr0.String() },
+			nil, nil, true,
+		},
+		{
+			"invalid amount",
+			func(memo *types.RewardMemo) { memo.Breakdown[0].Amount = "sixty" },
+			nil, nil, true,
+		},
+		{
+			"non-positive amount",
+			func(memo *types.RewardMemo) {
+				memo.Breakdown[0].Amount = "100"
+				memo.Breakdown[1].Amount = "0"
+			},
+			nil, nil, true,
+		},
+		{
+			"amounts do not sum to the transferred amount",
+			func(memo *types.RewardMemo) { memo.Breakdown[1].Amount = "39" },
+			nil, nil, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo := types.NewRewardMemo("13", "chain-13", "ICS rewards")
+			memo.Version = types.RewardMemoVersionBreakdown
+			memo.Breakdown = []types.ValidatorRewardShare{
+				{ConsumerConsAddr: addr0.String(), Amount: "60"},
+				{ConsumerConsAddr: consumerAddr1, Amount: "40"},
+			}
+			tc.malleate(&memo)
+
+			addrs, amounts, err := memo.ValidateBreakdown(math.NewInt(100))
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidRewardMemo)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expAddrs, addrs)
+			require.Equal(t, tc.expAmounts, amounts)
+		})
+	}
+}